@@ -9,7 +9,11 @@ import {
 } from '../helpers/constants';
 import { OrderFromDatabase } from '../../src';
 import {
-  IndexerOrder, IndexerOrder_ConditionType, IndexerOrder_Side, IndexerOrder_TimeInForce,
+  IndexerOrder,
+  IndexerOrder_ConditionType,
+  IndexerOrder_SelfTradePrevention,
+  IndexerOrder_Side,
+  IndexerOrder_TimeInForce,
 } from '@dydxprotocol-indexer/v4-protos';
 import { ORDER_FLAG_CONDITIONAL, ORDER_FLAG_LONG_TERM } from '@dydxprotocol-indexer/v4-proto-parser';
 import Long from 'long';
@@ -55,6 +59,10 @@ describe('orderTranslations', () => {
         clientMetadata: 0,
         conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
         conditionalOrderTriggerSubticks: Long.fromValue(0, true),
+        trailingOffsetSubticks: Long.fromValue(0, true),
+        trailingOffsetPpm: 0,
+        orderGroupId: 0,
+        selfTradePrevention: IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED,
       };
       const indexerOrder: IndexerOrder = await convertToIndexerOrder(order, defaultPerpetualMarket);
       expect(indexerOrder).toEqual(expectedOrder);
@@ -87,6 +95,10 @@ describe('orderTranslations', () => {
       conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS,
       // 19_000 * 1e-10 / 1e-6 / 1e-8 = 190_000_000
       conditionalOrderTriggerSubticks: Long.fromValue(190_000_000, true),
+      trailingOffsetSubticks: Long.fromValue(0, true),
+      trailingOffsetPpm: 0,
+      orderGroupId: 0,
+      selfTradePrevention: IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED,
     };
     const indexerOrder: IndexerOrder = await convertToIndexerOrder(order, defaultPerpetualMarket);
     expect(indexerOrder).toEqual(expectedOrder);
@@ -3,6 +3,7 @@ import { bigIntToBytes, ORDER_FLAG_LONG_TERM, ORDER_FLAG_SHORT_TERM } from '@dyd
 import {
   IndexerOrder,
   IndexerOrder_ConditionType,
+  IndexerOrder_SelfTradePrevention,
   IndexerOrder_Side,
   IndexerOrder_TimeInForce,
   IndexerOrderId,
@@ -64,6 +65,10 @@ describe('protocolTranslations', () => {
     clientMetadata: 0,
     conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
     conditionalOrderTriggerSubticks: Long.fromValue(0, true),
+    trailingOffsetSubticks: Long.fromValue(0, true),
+    trailingOffsetPpm: 0,
+    orderGroupId: 0,
+    selfTradePrevention: IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED,
   };
   const goodTilBlockTimeOrder: IndexerOrder = {
     ...goodTilBlockOrder,
@@ -212,6 +217,11 @@ describe('protocolTranslations', () => {
       ['UNRECOGNIZED', IndexerOrder_ConditionType.UNRECOGNIZED, OrderType.LIMIT],
       ['STOP_LOSS', IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS, OrderType.STOP_LIMIT],
       ['TAKE_PROFIT', IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT, OrderType.TAKE_PROFIT],
+      [
+        'TRAILING_STOP',
+        IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP,
+        OrderType.TRAILING_STOP,
+      ],
     ])('successfully gets order type given protocol condition type: %s', (
       _name: string,
       conditionType: IndexerOrder_ConditionType,
@@ -237,11 +247,6 @@ describe('protocolTranslations', () => {
         IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
       ],
       ['MARKET', OrderType.MARKET, IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED],
-      [
-        'TRAILING_STOP',
-        OrderType.TRAILING_STOP,
-        IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
-      ],
       ['STOP_LIMIT', OrderType.STOP_LIMIT, IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS],
       ['STOP_MARKET', OrderType.STOP_MARKET, IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS],
       ['TAKE_PROFIT', OrderType.TAKE_PROFIT, IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT],
@@ -250,6 +255,11 @@ describe('protocolTranslations', () => {
         OrderType.TAKE_PROFIT_MARKET,
         IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT,
       ],
+      [
+        'TRAILING_STOP',
+        OrderType.TRAILING_STOP,
+        IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP,
+      ],
     ])('successfully gets order type given protocol condition type: %s', (
       _name: string,
       orderType: OrderType,
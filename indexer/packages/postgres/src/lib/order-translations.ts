@@ -1,5 +1,9 @@
 import { logger } from '@dydxprotocol-indexer/base';
-import { IndexerOrder, IndexerOrder_Side } from '@dydxprotocol-indexer/v4-protos';
+import {
+  IndexerOrder,
+  IndexerOrder_SelfTradePrevention,
+  IndexerOrder_Side,
+} from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
 
 import * as OrderTable from '../stores/order-table';
@@ -73,6 +77,15 @@ export async function convertToIndexerOrder(
     clientMetadata: Number(order.clientMetadata),
     conditionType: orderTypeToProtocolConditionType(order.type),
     conditionalOrderTriggerSubticks: triggerSubticks,
+    // Trailing offsets only move the trigger price of untriggered orders, which are never placed
+    // on the orderbook, so they aren't stored.
+    trailingOffsetSubticks: Long.fromValue(0, true),
+    trailingOffsetPpm: 0,
+    // Order groups are only used by the protocol to cancel the other orders in the group, and the
+    // Indexer receives a removal event for each of those orders, so they aren't stored.
+    orderGroupId: 0,
+    // Self-trade prevention only applies when the protocol matches the order, so it isn't stored.
+    selfTradePrevention: IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED,
   };
 
   return indexerOrder;
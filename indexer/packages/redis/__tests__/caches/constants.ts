@@ -8,6 +8,7 @@ import {
   RedisOrder_TickerType,
   IndexerOrderId,
   IndexerOrder_ConditionType,
+  IndexerOrder_SelfTradePrevention,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
 import { DateTime } from 'luxon';
@@ -40,6 +41,10 @@ export const order: IndexerOrder = {
   clientMetadata: 0,
   conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
   conditionalOrderTriggerSubticks: Long.fromValue(0, true),
+  trailingOffsetSubticks: Long.fromValue(0, true),
+  trailingOffsetPpm: 0,
+  orderGroupId: 0,
+  selfTradePrevention: IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED,
 };
 export const orderGoodTilBlockTIme: IndexerOrder = {
   ...order,
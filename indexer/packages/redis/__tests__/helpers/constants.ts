@@ -16,6 +16,7 @@ import {
   RedisOrder_TickerType,
   IndexerSubaccountId,
   IndexerOrder_ConditionType,
+  IndexerOrder_SelfTradePrevention,
   OrderRemovalReason,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
@@ -78,6 +79,10 @@ export const defaultOrder: IndexerOrder = {
   clientMetadata: 0,
   conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
   conditionalOrderTriggerSubticks: Long.fromValue(0, true),
+  trailingOffsetSubticks: Long.fromValue(0, true),
+  trailingOffsetPpm: 0,
+  orderGroupId: 0,
+  selfTradePrevention: IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED,
 };
 export const defaultOrderGoodTilBlockTime: IndexerOrder = {
   ...defaultOrder,
@@ -208,6 +208,15 @@ export enum Order_ConditionType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a fixed offset as the market moves in the
+   * order's favor. The trigger price of a sell only ever moves up, and the
+   * trigger price of a buy only ever moves down.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export enum Order_ConditionTypeSDKType {
@@ -230,6 +239,15 @@ export enum Order_ConditionTypeSDKType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a fixed offset as the market moves in the
+   * order's favor. The trigger price of a sell only ever moves up, and the
+   * trigger price of a buy only ever moves down.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export function order_ConditionTypeFromJSON(object: any): Order_ConditionType {
@@ -246,6 +264,10 @@ export function order_ConditionTypeFromJSON(object: any): Order_ConditionType {
     case "CONDITION_TYPE_TAKE_PROFIT":
       return Order_ConditionType.CONDITION_TYPE_TAKE_PROFIT;
 
+    case 3:
+    case "CONDITION_TYPE_TRAILING_STOP":
+      return Order_ConditionType.CONDITION_TYPE_TRAILING_STOP;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -263,11 +285,148 @@ export function order_ConditionTypeToJSON(object: Order_ConditionType): string {
     case Order_ConditionType.CONDITION_TYPE_TAKE_PROFIT:
       return "CONDITION_TYPE_TAKE_PROFIT";
 
+    case Order_ConditionType.CONDITION_TYPE_TRAILING_STOP:
+      return "CONDITION_TYPE_TRAILING_STOP";
+
     case Order_ConditionType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
   }
 }
+/**
+ * SelfTradePrevention indicates how a match between this order, as the
+ * taker order, and a resting order placed by the same subaccount is
+ * prevented. Orders placed by the same subaccount never match.
+ */
+
+export enum Order_SelfTradePrevention {
+  /**
+   * SELF_TRADE_PREVENTION_UNSPECIFIED - SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior, which
+   * is the same as SELF_TRADE_PREVENTION_CANCEL_MAKER.
+   */
+  SELF_TRADE_PREVENTION_UNSPECIFIED = 0,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_MAKER - SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting order, after which
+   * the taker order continues matching.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_MAKER = 1,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_TAKER - SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+   * taker order and leaves the resting order on the book.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_TAKER = 2,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_BOTH - SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting order and the
+   * remaining size of the taker order.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_BOTH = 3,
+
+  /**
+   * SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL - SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting order and
+   * decrements the remaining size of the taker order by the remaining size
+   * of the resting order, after which the taker order continues matching.
+   * Resting orders can't be resized, so the resting order is canceled even
+   * if it's larger than the taker order. The remaining size of a decremented
+   * taker order is never placed on the book.
+   */
+  SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL = 4,
+  UNRECOGNIZED = -1,
+}
+/**
+ * SelfTradePrevention indicates how a match between this order, as the
+ * taker order, and a resting order placed by the same subaccount is
+ * prevented. Orders placed by the same subaccount never match.
+ */
+
+export enum Order_SelfTradePreventionSDKType {
+  /**
+   * SELF_TRADE_PREVENTION_UNSPECIFIED - SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior, which
+   * is the same as SELF_TRADE_PREVENTION_CANCEL_MAKER.
+   */
+  SELF_TRADE_PREVENTION_UNSPECIFIED = 0,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_MAKER - SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting order, after which
+   * the taker order continues matching.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_MAKER = 1,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_TAKER - SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+   * taker order and leaves the resting order on the book.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_TAKER = 2,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_BOTH - SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting order and the
+   * remaining size of the taker order.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_BOTH = 3,
+
+  /**
+   * SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL - SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting order and
+   * decrements the remaining size of the taker order by the remaining size
+   * of the resting order, after which the taker order continues matching.
+   * Resting orders can't be resized, so the resting order is canceled even
+   * if it's larger than the taker order. The remaining size of a decremented
+   * taker order is never placed on the book.
+   */
+  SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL = 4,
+  UNRECOGNIZED = -1,
+}
+export function order_SelfTradePreventionFromJSON(object: any): Order_SelfTradePrevention {
+  switch (object) {
+    case 0:
+    case "SELF_TRADE_PREVENTION_UNSPECIFIED":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED;
+
+    case 1:
+    case "SELF_TRADE_PREVENTION_CANCEL_MAKER":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_MAKER;
+
+    case 2:
+    case "SELF_TRADE_PREVENTION_CANCEL_TAKER":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_TAKER;
+
+    case 3:
+    case "SELF_TRADE_PREVENTION_CANCEL_BOTH":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_BOTH;
+
+    case 4:
+    case "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Order_SelfTradePrevention.UNRECOGNIZED;
+  }
+}
+export function order_SelfTradePreventionToJSON(object: Order_SelfTradePrevention): string {
+  switch (object) {
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED:
+      return "SELF_TRADE_PREVENTION_UNSPECIFIED";
+
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_MAKER:
+      return "SELF_TRADE_PREVENTION_CANCEL_MAKER";
+
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_TAKER:
+      return "SELF_TRADE_PREVENTION_CANCEL_TAKER";
+
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_BOTH:
+      return "SELF_TRADE_PREVENTION_CANCEL_BOTH";
+
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL:
+      return "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL";
+
+    case Order_SelfTradePrevention.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 /** OrderId refers to a single order belonging to a Subaccount. */
 
 export interface OrderId {
@@ -466,6 +625,36 @@ export interface StatefulOrderTimeSliceValueSDKType {
    */
   order_ids: OrderIdSDKType[];
 }
+/**
+ * OrderGroupValue represents the type of the value of an order group in
+ * state. Order groups are keyed by the order group id and the subaccount id
+ * of the orders in the group. This `OrderGroupValue` in state is used for
+ * canceling all other orders in a one-cancels-other group once one of its
+ * orders is filled or triggered.
+ */
+
+export interface OrderGroupValue {
+  /**
+   * A unique list of stateful order_ids in the order group, sorted in
+   * ascending order.
+   */
+  orderIds: OrderId[];
+}
+/**
+ * OrderGroupValue represents the type of the value of an order group in
+ * state. Order groups are keyed by the order group id and the subaccount id
+ * of the orders in the group. This `OrderGroupValue` in state is used for
+ * canceling all other orders in a one-cancels-other group once one of its
+ * orders is filled or triggered.
+ */
+
+export interface OrderGroupValueSDKType {
+  /**
+   * A unique list of stateful order_ids in the order group, sorted in
+   * ascending order.
+   */
+  order_ids: OrderIdSDKType[];
+}
 /**
  * LongTermOrderPlacement represents the placement of a stateful order in
  * state. It stores the stateful order itself and the `BlockHeight` and
@@ -603,6 +792,34 @@ export interface Order {
    */
 
   conditionalOrderTriggerSubticks: Long;
+  /**
+   * trailing_offset_subticks represents the absolute distance, in subticks,
+   * between the oracle price and the trigger price of a trailing stop order.
+   * Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
+   * orderId.ClobPairId`). Exactly one of trailing_offset_subticks and
+   * trailing_offset_ppm must be nonzero if the condition_type is
+   * CONDITION_TYPE_TRAILING_STOP, otherwise both are enforced to be 0.
+   */
+
+  trailingOffsetSubticks: Long;
+  /**
+   * trailing_offset_ppm represents the relative distance, in parts per
+   * million of the oracle price, between the oracle price and the trigger
+   * price of a trailing stop order. Must be less than 1,000,000.
+   */
+
+  trailingOffsetPpm: number;
+  /**
+   * order_group_id links stateful orders of the same subaccount into a
+   * one-cancels-other group. When any order in a group is filled or
+   * triggered, all other orders in the group are canceled. A value of 0
+   * means the order is not part of a group. Must be 0 for Short-Term orders.
+   */
+
+  orderGroupId: number;
+  /** The self-trade prevention mode of this order. */
+
+  selfTradePrevention: Order_SelfTradePrevention;
 }
 /**
  * Order represents a single order belonging to a `Subaccount`
@@ -673,6 +890,34 @@ export interface OrderSDKType {
    */
 
   conditional_order_trigger_subticks: Long;
+  /**
+   * trailing_offset_subticks represents the absolute distance, in subticks,
+   * between the oracle price and the trigger price of a trailing stop order.
+   * Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
+   * orderId.ClobPairId`). Exactly one of trailing_offset_subticks and
+   * trailing_offset_ppm must be nonzero if the condition_type is
+   * CONDITION_TYPE_TRAILING_STOP, otherwise both are enforced to be 0.
+   */
+
+  trailing_offset_subticks: Long;
+  /**
+   * trailing_offset_ppm represents the relative distance, in parts per
+   * million of the oracle price, between the oracle price and the trigger
+   * price of a trailing stop order. Must be less than 1,000,000.
+   */
+
+  trailing_offset_ppm: number;
+  /**
+   * order_group_id links stateful orders of the same subaccount into a
+   * one-cancels-other group. When any order in a group is filled or
+   * triggered, all other orders in the group are canceled. A value of 0
+   * means the order is not part of a group. Must be 0 for Short-Term orders.
+   */
+
+  order_group_id: number;
+  /** The self-trade prevention mode of this order. */
+
+  self_trade_prevention: Order_SelfTradePreventionSDKType;
 }
 /**
  * TransactionOrdering represents a unique location in the block where a
@@ -968,6 +1213,51 @@ export const StatefulOrderTimeSliceValue = {
 
 };
 
+function createBaseOrderGroupValue(): OrderGroupValue {
+  return {
+    orderIds: []
+  };
+}
+
+export const OrderGroupValue = {
+  encode(message: OrderGroupValue, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.orderIds) {
+      OrderId.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderGroupValue {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrderGroupValue();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.orderIds.push(OrderId.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<OrderGroupValue>): OrderGroupValue {
+    const message = createBaseOrderGroupValue();
+    message.orderIds = object.orderIds?.map(e => OrderId.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
 function createBaseLongTermOrderPlacement(): LongTermOrderPlacement {
   return {
     order: undefined,
@@ -1100,7 +1390,11 @@ function createBaseOrder(): Order {
     reduceOnly: false,
     clientMetadata: 0,
     conditionType: 0,
-    conditionalOrderTriggerSubticks: Long.UZERO
+    conditionalOrderTriggerSubticks: Long.UZERO,
+    trailingOffsetSubticks: Long.UZERO,
+    trailingOffsetPpm: 0,
+    orderGroupId: 0,
+    selfTradePrevention: 0
   };
 }
 
@@ -1150,6 +1444,22 @@ export const Order = {
       writer.uint32(88).uint64(message.conditionalOrderTriggerSubticks);
     }
 
+    if (!message.trailingOffsetSubticks.isZero()) {
+      writer.uint32(96).uint64(message.trailingOffsetSubticks);
+    }
+
+    if (message.trailingOffsetPpm !== 0) {
+      writer.uint32(104).uint32(message.trailingOffsetPpm);
+    }
+
+    if (message.orderGroupId !== 0) {
+      writer.uint32(112).uint32(message.orderGroupId);
+    }
+
+    if (message.selfTradePrevention !== 0) {
+      writer.uint32(120).int32(message.selfTradePrevention);
+    }
+
     return writer;
   },
 
@@ -1206,6 +1516,22 @@ export const Order = {
           message.conditionalOrderTriggerSubticks = (reader.uint64() as Long);
           break;
 
+        case 12:
+          message.trailingOffsetSubticks = (reader.uint64() as Long);
+          break;
+
+        case 13:
+          message.trailingOffsetPpm = reader.uint32();
+          break;
+
+        case 14:
+          message.orderGroupId = reader.uint32();
+          break;
+
+        case 15:
+          message.selfTradePrevention = (reader.int32() as any);
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -1228,6 +1554,10 @@ export const Order = {
     message.clientMetadata = object.clientMetadata ?? 0;
     message.conditionType = object.conditionType ?? 0;
     message.conditionalOrderTriggerSubticks = object.conditionalOrderTriggerSubticks !== undefined && object.conditionalOrderTriggerSubticks !== null ? Long.fromValue(object.conditionalOrderTriggerSubticks) : Long.UZERO;
+    message.trailingOffsetSubticks = object.trailingOffsetSubticks !== undefined && object.trailingOffsetSubticks !== null ? Long.fromValue(object.trailingOffsetSubticks) : Long.UZERO;
+    message.trailingOffsetPpm = object.trailingOffsetPpm ?? 0;
+    message.orderGroupId = object.orderGroupId ?? 0;
+    message.selfTradePrevention = object.selfTradePrevention ?? 0;
     return message;
   }
 
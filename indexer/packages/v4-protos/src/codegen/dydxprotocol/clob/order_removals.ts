@@ -59,6 +59,14 @@ export enum OrderRemoval_RemovalReason {
    * was fully filled and should therefore be removed from state.
    */
   REMOVAL_REASON_FULLY_FILLED = 7,
+
+  /**
+   * REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED - REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED represents a removal of a
+   * stateful order whose remaining size was canceled by its self-trade
+   * prevention mode, because it would have matched an order of the same
+   * subaccount on the proposers orderbook.
+   */
+  REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED = 8,
   UNRECOGNIZED = -1,
 }
 export enum OrderRemoval_RemovalReasonSDKType {
@@ -119,6 +127,14 @@ export enum OrderRemoval_RemovalReasonSDKType {
    * was fully filled and should therefore be removed from state.
    */
   REMOVAL_REASON_FULLY_FILLED = 7,
+
+  /**
+   * REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED - REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED represents a removal of a
+   * stateful order whose remaining size was canceled by its self-trade
+   * prevention mode, because it would have matched an order of the same
+   * subaccount on the proposers orderbook.
+   */
+  REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED = 8,
   UNRECOGNIZED = -1,
 }
 export function orderRemoval_RemovalReasonFromJSON(object: any): OrderRemoval_RemovalReason {
@@ -155,6 +171,10 @@ export function orderRemoval_RemovalReasonFromJSON(object: any): OrderRemoval_Re
     case "REMOVAL_REASON_FULLY_FILLED":
       return OrderRemoval_RemovalReason.REMOVAL_REASON_FULLY_FILLED;
 
+    case 8:
+    case "REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED":
+      return OrderRemoval_RemovalReason.REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -187,6 +207,9 @@ export function orderRemoval_RemovalReasonToJSON(object: OrderRemoval_RemovalRea
     case OrderRemoval_RemovalReason.REMOVAL_REASON_FULLY_FILLED:
       return "REMOVAL_REASON_FULLY_FILLED";
 
+    case OrderRemoval_RemovalReason.REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED:
+      return "REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED";
+
     case OrderRemoval_RemovalReason.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -551,6 +551,8 @@ export interface StatefulOrderEventV1 {
   conditionalOrderPlacement?: StatefulOrderEventV1_ConditionalOrderPlacementV1;
   conditionalOrderTriggered?: StatefulOrderEventV1_ConditionalOrderTriggeredV1;
   longTermOrderPlacement?: StatefulOrderEventV1_LongTermOrderPlacementV1;
+  conditionalOrderTriggerSubticksUpdate?: StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1;
+  longTermOrderReplacement?: StatefulOrderEventV1_LongTermOrderReplacementV1;
 }
 /**
  * StatefulOrderEvent message contains information about a change to a stateful
@@ -565,6 +567,8 @@ export interface StatefulOrderEventV1SDKType {
   conditional_order_placement?: StatefulOrderEventV1_ConditionalOrderPlacementV1SDKType;
   conditional_order_triggered?: StatefulOrderEventV1_ConditionalOrderTriggeredV1SDKType;
   long_term_order_placement?: StatefulOrderEventV1_LongTermOrderPlacementV1SDKType;
+  conditional_order_trigger_subticks_update?: StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1SDKType;
+  long_term_order_replacement?: StatefulOrderEventV1_LongTermOrderReplacementV1SDKType;
 }
 /** A stateful order placement contains an order. */
 
@@ -636,6 +640,46 @@ export interface StatefulOrderEventV1_LongTermOrderPlacementV1 {
 export interface StatefulOrderEventV1_LongTermOrderPlacementV1SDKType {
   order?: IndexerOrderSDKType;
 }
+/**
+ * A conditional order trigger subticks update event contains the id of an
+ * untriggered conditional order and its new trigger subticks. It is emitted
+ * when a trailing stop order's trigger price trails the oracle price.
+ */
+
+export interface StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
+  orderId?: IndexerOrderId;
+  conditionalOrderTriggerSubticks: Long;
+}
+/**
+ * A conditional order trigger subticks update event contains the id of an
+ * untriggered conditional order and its new trigger subticks. It is emitted
+ * when a trailing stop order's trigger price trails the oracle price.
+ */
+
+export interface StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1SDKType {
+  order_id?: IndexerOrderIdSDKType;
+  conditional_order_trigger_subticks: Long;
+}
+/**
+ * A long term order replacement contains the id of the order that was
+ * replaced and the order that replaced it. Note that the ids of both orders
+ * may be the same.
+ */
+
+export interface StatefulOrderEventV1_LongTermOrderReplacementV1 {
+  oldOrderId?: IndexerOrderId;
+  order?: IndexerOrder;
+}
+/**
+ * A long term order replacement contains the id of the order that was
+ * replaced and the order that replaced it. Note that the ids of both orders
+ * may be the same.
+ */
+
+export interface StatefulOrderEventV1_LongTermOrderReplacementV1SDKType {
+  old_order_id?: IndexerOrderIdSDKType;
+  order?: IndexerOrderSDKType;
+}
 /**
  * AssetCreateEventV1 message contains all the information about an new Asset on
  * the dYdX chain.
@@ -1885,7 +1929,9 @@ function createBaseStatefulOrderEventV1(): StatefulOrderEventV1 {
     orderRemoval: undefined,
     conditionalOrderPlacement: undefined,
     conditionalOrderTriggered: undefined,
-    longTermOrderPlacement: undefined
+    longTermOrderPlacement: undefined,
+    conditionalOrderTriggerSubticksUpdate: undefined,
+    longTermOrderReplacement: undefined
   };
 }
 
@@ -1911,6 +1957,14 @@ export const StatefulOrderEventV1 = {
       StatefulOrderEventV1_LongTermOrderPlacementV1.encode(message.longTermOrderPlacement, writer.uint32(58).fork()).ldelim();
     }
 
+    if (message.conditionalOrderTriggerSubticksUpdate !== undefined) {
+      StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.encode(message.conditionalOrderTriggerSubticksUpdate, writer.uint32(66).fork()).ldelim();
+    }
+
+    if (message.longTermOrderReplacement !== undefined) {
+      StatefulOrderEventV1_LongTermOrderReplacementV1.encode(message.longTermOrderReplacement, writer.uint32(74).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -1943,6 +1997,14 @@ export const StatefulOrderEventV1 = {
           message.longTermOrderPlacement = StatefulOrderEventV1_LongTermOrderPlacementV1.decode(reader, reader.uint32());
           break;
 
+        case 8:
+          message.conditionalOrderTriggerSubticksUpdate = StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.decode(reader, reader.uint32());
+          break;
+
+        case 9:
+          message.longTermOrderReplacement = StatefulOrderEventV1_LongTermOrderReplacementV1.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -1959,6 +2021,8 @@ export const StatefulOrderEventV1 = {
     message.conditionalOrderPlacement = object.conditionalOrderPlacement !== undefined && object.conditionalOrderPlacement !== null ? StatefulOrderEventV1_ConditionalOrderPlacementV1.fromPartial(object.conditionalOrderPlacement) : undefined;
     message.conditionalOrderTriggered = object.conditionalOrderTriggered !== undefined && object.conditionalOrderTriggered !== null ? StatefulOrderEventV1_ConditionalOrderTriggeredV1.fromPartial(object.conditionalOrderTriggered) : undefined;
     message.longTermOrderPlacement = object.longTermOrderPlacement !== undefined && object.longTermOrderPlacement !== null ? StatefulOrderEventV1_LongTermOrderPlacementV1.fromPartial(object.longTermOrderPlacement) : undefined;
+    message.conditionalOrderTriggerSubticksUpdate = object.conditionalOrderTriggerSubticksUpdate !== undefined && object.conditionalOrderTriggerSubticksUpdate !== null ? StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.fromPartial(object.conditionalOrderTriggerSubticksUpdate) : undefined;
+    message.longTermOrderReplacement = object.longTermOrderReplacement !== undefined && object.longTermOrderReplacement !== null ? StatefulOrderEventV1_LongTermOrderReplacementV1.fromPartial(object.longTermOrderReplacement) : undefined;
     return message;
   }
 
@@ -2199,6 +2263,116 @@ export const StatefulOrderEventV1_LongTermOrderPlacementV1 = {
 
 };
 
+function createBaseStatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1(): StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
+  return {
+    orderId: undefined,
+    conditionalOrderTriggerSubticks: Long.UZERO
+  };
+}
+
+export const StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 = {
+  encode(message: StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.orderId !== undefined) {
+      IndexerOrderId.encode(message.orderId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (!message.conditionalOrderTriggerSubticks.isZero()) {
+      writer.uint32(16).uint64(message.conditionalOrderTriggerSubticks);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.orderId = IndexerOrderId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.conditionalOrderTriggerSubticks = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1>): StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
+    const message = createBaseStatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1();
+    message.orderId = object.orderId !== undefined && object.orderId !== null ? IndexerOrderId.fromPartial(object.orderId) : undefined;
+    message.conditionalOrderTriggerSubticks = object.conditionalOrderTriggerSubticks !== undefined && object.conditionalOrderTriggerSubticks !== null ? Long.fromValue(object.conditionalOrderTriggerSubticks) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseStatefulOrderEventV1_LongTermOrderReplacementV1(): StatefulOrderEventV1_LongTermOrderReplacementV1 {
+  return {
+    oldOrderId: undefined,
+    order: undefined
+  };
+}
+
+export const StatefulOrderEventV1_LongTermOrderReplacementV1 = {
+  encode(message: StatefulOrderEventV1_LongTermOrderReplacementV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.oldOrderId !== undefined) {
+      IndexerOrderId.encode(message.oldOrderId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.order !== undefined) {
+      IndexerOrder.encode(message.order, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatefulOrderEventV1_LongTermOrderReplacementV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatefulOrderEventV1_LongTermOrderReplacementV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.oldOrderId = IndexerOrderId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.order = IndexerOrder.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<StatefulOrderEventV1_LongTermOrderReplacementV1>): StatefulOrderEventV1_LongTermOrderReplacementV1 {
+    const message = createBaseStatefulOrderEventV1_LongTermOrderReplacementV1();
+    message.oldOrderId = object.oldOrderId !== undefined && object.oldOrderId !== null ? IndexerOrderId.fromPartial(object.oldOrderId) : undefined;
+    message.order = object.order !== undefined && object.order !== null ? IndexerOrder.fromPartial(object.order) : undefined;
+    return message;
+  }
+
+};
+
 function createBaseAssetCreateEventV1(): AssetCreateEventV1 {
   return {
     id: 0,
@@ -208,6 +208,15 @@ export enum IndexerOrder_ConditionType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a fixed offset as the market moves in the
+   * order's favor. The trigger price of a sell only ever moves up, and the
+   * trigger price of a buy only ever moves down.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export enum IndexerOrder_ConditionTypeSDKType {
@@ -230,6 +239,15 @@ export enum IndexerOrder_ConditionTypeSDKType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a fixed offset as the market moves in the
+   * order's favor. The trigger price of a sell only ever moves up, and the
+   * trigger price of a buy only ever moves down.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export function indexerOrder_ConditionTypeFromJSON(object: any): IndexerOrder_ConditionType {
@@ -246,6 +264,10 @@ export function indexerOrder_ConditionTypeFromJSON(object: any): IndexerOrder_Co
     case "CONDITION_TYPE_TAKE_PROFIT":
       return IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT;
 
+    case 3:
+    case "CONDITION_TYPE_TRAILING_STOP":
+      return IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -263,11 +285,148 @@ export function indexerOrder_ConditionTypeToJSON(object: IndexerOrder_ConditionT
     case IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT:
       return "CONDITION_TYPE_TAKE_PROFIT";
 
+    case IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP:
+      return "CONDITION_TYPE_TRAILING_STOP";
+
     case IndexerOrder_ConditionType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
   }
 }
+/**
+ * SelfTradePrevention indicates how a match between this order, as the
+ * taker order, and a resting order placed by the same subaccount is
+ * prevented. Orders placed by the same subaccount never match.
+ */
+
+export enum IndexerOrder_SelfTradePrevention {
+  /**
+   * SELF_TRADE_PREVENTION_UNSPECIFIED - SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior, which
+   * is the same as SELF_TRADE_PREVENTION_CANCEL_MAKER.
+   */
+  SELF_TRADE_PREVENTION_UNSPECIFIED = 0,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_MAKER - SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting order, after which
+   * the taker order continues matching.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_MAKER = 1,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_TAKER - SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+   * taker order and leaves the resting order on the book.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_TAKER = 2,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_BOTH - SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting order and the
+   * remaining size of the taker order.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_BOTH = 3,
+
+  /**
+   * SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL - SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting order and
+   * decrements the remaining size of the taker order by the remaining size
+   * of the resting order, after which the taker order continues matching.
+   * Resting orders can't be resized, so the resting order is canceled even
+   * if it's larger than the taker order. The remaining size of a decremented
+   * taker order is never placed on the book.
+   */
+  SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL = 4,
+  UNRECOGNIZED = -1,
+}
+/**
+ * SelfTradePrevention indicates how a match between this order, as the
+ * taker order, and a resting order placed by the same subaccount is
+ * prevented. Orders placed by the same subaccount never match.
+ */
+
+export enum IndexerOrder_SelfTradePreventionSDKType {
+  /**
+   * SELF_TRADE_PREVENTION_UNSPECIFIED - SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior, which
+   * is the same as SELF_TRADE_PREVENTION_CANCEL_MAKER.
+   */
+  SELF_TRADE_PREVENTION_UNSPECIFIED = 0,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_MAKER - SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting order, after which
+   * the taker order continues matching.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_MAKER = 1,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_TAKER - SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+   * taker order and leaves the resting order on the book.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_TAKER = 2,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_BOTH - SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting order and the
+   * remaining size of the taker order.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_BOTH = 3,
+
+  /**
+   * SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL - SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting order and
+   * decrements the remaining size of the taker order by the remaining size
+   * of the resting order, after which the taker order continues matching.
+   * Resting orders can't be resized, so the resting order is canceled even
+   * if it's larger than the taker order. The remaining size of a decremented
+   * taker order is never placed on the book.
+   */
+  SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL = 4,
+  UNRECOGNIZED = -1,
+}
+export function indexerOrder_SelfTradePreventionFromJSON(object: any): IndexerOrder_SelfTradePrevention {
+  switch (object) {
+    case 0:
+    case "SELF_TRADE_PREVENTION_UNSPECIFIED":
+      return IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED;
+
+    case 1:
+    case "SELF_TRADE_PREVENTION_CANCEL_MAKER":
+      return IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_MAKER;
+
+    case 2:
+    case "SELF_TRADE_PREVENTION_CANCEL_TAKER":
+      return IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_TAKER;
+
+    case 3:
+    case "SELF_TRADE_PREVENTION_CANCEL_BOTH":
+      return IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_BOTH;
+
+    case 4:
+    case "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL":
+      return IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return IndexerOrder_SelfTradePrevention.UNRECOGNIZED;
+  }
+}
+export function indexerOrder_SelfTradePreventionToJSON(object: IndexerOrder_SelfTradePrevention): string {
+  switch (object) {
+    case IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED:
+      return "SELF_TRADE_PREVENTION_UNSPECIFIED";
+
+    case IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_MAKER:
+      return "SELF_TRADE_PREVENTION_CANCEL_MAKER";
+
+    case IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_TAKER:
+      return "SELF_TRADE_PREVENTION_CANCEL_TAKER";
+
+    case IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_BOTH:
+      return "SELF_TRADE_PREVENTION_CANCEL_BOTH";
+
+    case IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL:
+      return "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL";
+
+    case IndexerOrder_SelfTradePrevention.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 /**
  * Status of the CLOB.
  * Defined in clob.clob_pair
@@ -554,6 +713,34 @@ export interface IndexerOrder {
    */
 
   conditionalOrderTriggerSubticks: Long;
+  /**
+   * trailing_offset_subticks represents the absolute distance, in subticks,
+   * between the oracle price and the trigger price of a trailing stop order.
+   * Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
+   * orderId.ClobPairId`). Exactly one of trailing_offset_subticks and
+   * trailing_offset_ppm must be nonzero if the condition_type is
+   * CONDITION_TYPE_TRAILING_STOP, otherwise both are enforced to be 0.
+   */
+
+  trailingOffsetSubticks: Long;
+  /**
+   * trailing_offset_ppm represents the relative distance, in parts per
+   * million of the oracle price, between the oracle price and the trigger
+   * price of a trailing stop order. Must be less than 1,000,000.
+   */
+
+  trailingOffsetPpm: number;
+  /**
+   * order_group_id links stateful orders of the same subaccount into a
+   * one-cancels-other group. When any order in a group is filled or
+   * triggered, all other orders in the group are canceled. A value of 0
+   * means the order is not part of a group. Must be 0 for Short-Term orders.
+   */
+
+  orderGroupId: number;
+  /** The self-trade prevention mode of this order. */
+
+  selfTradePrevention: IndexerOrder_SelfTradePrevention;
 }
 /**
  * IndexerOrderV1 represents a single order belonging to a `Subaccount`
@@ -624,6 +811,34 @@ export interface IndexerOrderSDKType {
    */
 
   conditional_order_trigger_subticks: Long;
+  /**
+   * trailing_offset_subticks represents the absolute distance, in subticks,
+   * between the oracle price and the trigger price of a trailing stop order.
+   * Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
+   * orderId.ClobPairId`). Exactly one of trailing_offset_subticks and
+   * trailing_offset_ppm must be nonzero if the condition_type is
+   * CONDITION_TYPE_TRAILING_STOP, otherwise both are enforced to be 0.
+   */
+
+  trailing_offset_subticks: Long;
+  /**
+   * trailing_offset_ppm represents the relative distance, in parts per
+   * million of the oracle price, between the oracle price and the trigger
+   * price of a trailing stop order. Must be less than 1,000,000.
+   */
+
+  trailing_offset_ppm: number;
+  /**
+   * order_group_id links stateful orders of the same subaccount into a
+   * one-cancels-other group. When any order in a group is filled or
+   * triggered, all other orders in the group are canceled. A value of 0
+   * means the order is not part of a group. Must be 0 for Short-Term orders.
+   */
+
+  order_group_id: number;
+  /** The self-trade prevention mode of this order. */
+
+  self_trade_prevention: IndexerOrder_SelfTradePreventionSDKType;
 }
 
 function createBaseIndexerOrderId(): IndexerOrderId {
@@ -713,7 +928,11 @@ function createBaseIndexerOrder(): IndexerOrder {
     reduceOnly: false,
     clientMetadata: 0,
     conditionType: 0,
-    conditionalOrderTriggerSubticks: Long.UZERO
+    conditionalOrderTriggerSubticks: Long.UZERO,
+    trailingOffsetSubticks: Long.UZERO,
+    trailingOffsetPpm: 0,
+    orderGroupId: 0,
+    selfTradePrevention: 0
   };
 }
 
@@ -763,6 +982,22 @@ export const IndexerOrder = {
       writer.uint32(88).uint64(message.conditionalOrderTriggerSubticks);
     }
 
+    if (!message.trailingOffsetSubticks.isZero()) {
+      writer.uint32(96).uint64(message.trailingOffsetSubticks);
+    }
+
+    if (message.trailingOffsetPpm !== 0) {
+      writer.uint32(104).uint32(message.trailingOffsetPpm);
+    }
+
+    if (message.orderGroupId !== 0) {
+      writer.uint32(112).uint32(message.orderGroupId);
+    }
+
+    if (message.selfTradePrevention !== 0) {
+      writer.uint32(120).int32(message.selfTradePrevention);
+    }
+
     return writer;
   },
 
@@ -819,6 +1054,22 @@ export const IndexerOrder = {
           message.conditionalOrderTriggerSubticks = (reader.uint64() as Long);
           break;
 
+        case 12:
+          message.trailingOffsetSubticks = (reader.uint64() as Long);
+          break;
+
+        case 13:
+          message.trailingOffsetPpm = reader.uint32();
+          break;
+
+        case 14:
+          message.orderGroupId = reader.uint32();
+          break;
+
+        case 15:
+          message.selfTradePrevention = (reader.int32() as any);
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -841,6 +1092,10 @@ export const IndexerOrder = {
     message.clientMetadata = object.clientMetadata ?? 0;
     message.conditionType = object.conditionType ?? 0;
     message.conditionalOrderTriggerSubticks = object.conditionalOrderTriggerSubticks !== undefined && object.conditionalOrderTriggerSubticks !== null ? Long.fromValue(object.conditionalOrderTriggerSubticks) : Long.UZERO;
+    message.trailingOffsetSubticks = object.trailingOffsetSubticks !== undefined && object.trailingOffsetSubticks !== null ? Long.fromValue(object.trailingOffsetSubticks) : Long.UZERO;
+    message.trailingOffsetPpm = object.trailingOffsetPpm ?? 0;
+    message.orderGroupId = object.orderGroupId ?? 0;
+    message.selfTradePrevention = object.selfTradePrevention ?? 0;
     return message;
   }
 
@@ -75,6 +75,18 @@ export enum OrderRemovalReason {
    * equity tier requirements.
    */
   ORDER_REMOVAL_REASON_EQUITY_TIER = 13,
+
+  /**
+   * ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED - The order was canceled because another order in its one-cancels-other
+   * order group was filled or triggered.
+   */
+  ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED = 14,
+
+  /**
+   * ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED - The order was canceled by the self-trade prevention mode requested by its
+   * subaccount, instead of matching against an order of the same subaccount.
+   */
+  ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED = 15,
   UNRECOGNIZED = -1,
 }
 /** OrderRemovalReason is an enum of all the reasons an order was removed. */
@@ -155,6 +167,18 @@ export enum OrderRemovalReasonSDKType {
    * equity tier requirements.
    */
   ORDER_REMOVAL_REASON_EQUITY_TIER = 13,
+
+  /**
+   * ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED - The order was canceled because another order in its one-cancels-other
+   * order group was filled or triggered.
+   */
+  ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED = 14,
+
+  /**
+   * ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED - The order was canceled by the self-trade prevention mode requested by its
+   * subaccount, instead of matching against an order of the same subaccount.
+   */
+  ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED = 15,
   UNRECOGNIZED = -1,
 }
 export function orderRemovalReasonFromJSON(object: any): OrderRemovalReason {
@@ -215,6 +239,14 @@ export function orderRemovalReasonFromJSON(object: any): OrderRemovalReason {
     case "ORDER_REMOVAL_REASON_EQUITY_TIER":
       return OrderRemovalReason.ORDER_REMOVAL_REASON_EQUITY_TIER;
 
+    case 14:
+    case "ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED;
+
+    case 15:
+    case "ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -265,6 +297,12 @@ export function orderRemovalReasonToJSON(object: OrderRemovalReason): string {
     case OrderRemovalReason.ORDER_REMOVAL_REASON_EQUITY_TIER:
       return "ORDER_REMOVAL_REASON_EQUITY_TIER";
 
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED:
+      return "ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED";
+
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED:
+      return "ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED";
+
     case OrderRemovalReason.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
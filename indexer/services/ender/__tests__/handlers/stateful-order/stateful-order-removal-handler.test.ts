@@ -104,14 +104,23 @@ describe('statefulOrderRemovalHandler', () => {
     });
   });
 
-  it('successfully cancels and removes order', async () => {
+  it.each([
+    ['replaced', OrderRemovalReason.ORDER_REMOVAL_REASON_REPLACED],
+    ['order group canceled', OrderRemovalReason.ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED],
+  ])('successfully cancels and removes order (%s)', async (
+    _name: string,
+    removalReason: OrderRemovalReason,
+  ) => {
     await OrderTable.create({
       ...testConstants.defaultOrder,
       clientId: '0',
     });
-    const kafkaMessage: KafkaMessage = createKafkaMessageFromStatefulOrderEvent(
-      defaultStatefulOrderEvent,
-    );
+    const kafkaMessage: KafkaMessage = createKafkaMessageFromStatefulOrderEvent({
+      orderRemoval: {
+        removedOrderId: defaultOrderId,
+        reason: removalReason,
+      },
+    });
 
     await onMessage(kafkaMessage);
     const order: OrderFromDatabase | undefined = await OrderTable.findById(orderId);
@@ -126,7 +135,7 @@ describe('statefulOrderRemovalHandler', () => {
     const expectedOffchainUpdate: OffChainUpdateV1 = {
       orderRemove: {
         removedOrderId: defaultOrderId,
-        reason,
+        reason: removalReason,
         removalStatus: OrderRemoveV1_OrderRemovalStatus.ORDER_REMOVAL_STATUS_CANCELED,
       },
     };
@@ -23,6 +23,7 @@ import {
   Timestamp,
   TransferEventV1,
   IndexerOrder_ConditionType,
+  IndexerOrder_SelfTradePrevention,
   OrderRemovalReason,
   AssetCreateEventV1,
   PerpetualMarketCreateEventV1,
@@ -187,6 +188,10 @@ export const defaultMakerOrder: IndexerOrder = {
   clientMetadata: 0,
   conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
   conditionalOrderTriggerSubticks: Long.fromValue(0, true),
+  trailingOffsetSubticks: Long.fromValue(0, true),
+  trailingOffsetPpm: 0,
+  orderGroupId: 0,
+  selfTradePrevention: IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED,
 };
 export const defaultTakerOrder: IndexerOrder = {
   orderId: defaultOrderId2,
@@ -199,6 +204,10 @@ export const defaultTakerOrder: IndexerOrder = {
   clientMetadata: 0,
   conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
   conditionalOrderTriggerSubticks: Long.fromValue(0, true),
+  trailingOffsetSubticks: Long.fromValue(0, true),
+  trailingOffsetPpm: 0,
+  orderGroupId: 0,
+  selfTradePrevention: IndexerOrder_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED,
 };
 export const defaultLiquidationOrder: LiquidationOrderV1 = {
   liquidated: defaultSubaccountId,
@@ -361,6 +370,15 @@ export const defaultConditionalOrderTriggeredEvent: StatefulOrderEventV1 = {
     },
   },
 };
+export const defaultConditionalOrderTriggerSubticksUpdateEvent: StatefulOrderEventV1 = {
+  conditionalOrderTriggerSubticksUpdate: {
+    orderId: {
+      ...defaultOrderId,
+      orderFlags: ORDER_FLAG_CONDITIONAL,
+    },
+    conditionalOrderTriggerSubticks: Long.fromValue(1000000, true),
+  },
+};
 export const defaultLongTermOrderPlacementEvent: StatefulOrderEventV1 = {
   longTermOrderPlacement: {
     order: {
@@ -373,3 +391,20 @@ export const defaultLongTermOrderPlacementEvent: StatefulOrderEventV1 = {
     },
   },
 };
+export const defaultLongTermOrderReplacementEvent: StatefulOrderEventV1 = {
+  longTermOrderReplacement: {
+    oldOrderId: {
+      ...defaultMakerOrder.orderId!,
+      orderFlags: ORDER_FLAG_LONG_TERM,
+    },
+    order: {
+      ...defaultMakerOrder,
+      orderId: {
+        ...defaultMakerOrder.orderId!,
+        clientId: defaultMakerOrder.orderId!.clientId + 1,
+        orderFlags: ORDER_FLAG_LONG_TERM,
+      },
+      goodTilBlockTime: 123,
+    },
+  },
+};
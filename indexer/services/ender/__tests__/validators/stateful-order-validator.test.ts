@@ -9,11 +9,16 @@ import {
 } from '@dydxprotocol-indexer/v4-protos';
 import { DydxIndexerSubtypes } from '../../src/lib/types';
 import { StatefulOrderValidator } from '../../src/validators/stateful-order-validator';
+import { Handler } from '../../src/handlers/handler';
+import { StatefulOrderPlacementHandler } from '../../src/handlers/stateful-order/stateful-order-placement-handler';
+import { StatefulOrderRemovalHandler } from '../../src/handlers/stateful-order/stateful-order-removal-handler';
 import {
   defaultConditionalOrderPlacementEvent,
   defaultConditionalOrderTriggeredEvent,
+  defaultConditionalOrderTriggerSubticksUpdateEvent,
   defaultHeight,
   defaultLongTermOrderPlacementEvent,
+  defaultLongTermOrderReplacementEvent,
   defaultMakerOrder,
   defaultOrderId,
   defaultStatefulOrderPlacementEvent,
@@ -42,6 +47,23 @@ describe('stateful-order-validator', () => {
       ['conditional order placement', defaultConditionalOrderPlacementEvent],
       ['conditional order triggered', defaultConditionalOrderTriggeredEvent],
       ['long term order placement', defaultLongTermOrderPlacementEvent],
+      ['long term order replacement', defaultLongTermOrderReplacementEvent],
+      [
+        'conditional order trigger subticks update',
+        defaultConditionalOrderTriggerSubticksUpdateEvent,
+      ],
+      [
+        'trailing stop conditional order placement',
+        {
+          conditionalOrderPlacement: {
+            order: {
+              ...defaultConditionalOrderPlacementEvent.conditionalOrderPlacement!.order!,
+              conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP,
+              trailingOffsetPpm: 10_000,
+            },
+          },
+        },
+      ],
     ])('does not throw error on valid %s', (_message: string, event: StatefulOrderEventV1) => {
       const validator: StatefulOrderValidator = new StatefulOrderValidator(
         event,
@@ -57,8 +79,9 @@ describe('stateful-order-validator', () => {
       [
         'does not contain any event',
         {},
-        'One of orderPlace, orderRemoval, conditionalOrderPlacement, ' +
-        'conditionalOrderTriggered, longTermOrderPlacement must be defined in StatefulOrderEvent',
+        'One of orderPlace, orderRemoval, conditionalOrderPlacement, conditionalOrderTriggered, ' +
+        'longTermOrderPlacement, conditionalOrderTriggerSubticksUpdate, longTermOrderReplacement ' +
+        'must be defined in StatefulOrderEvent',
       ],
 
       // TODO(IND-334): Remove tests after deprecating StatefulOrderPlacement events
@@ -314,6 +337,32 @@ describe('stateful-order-validator', () => {
         },
         'StatefulOrderEvent conditional order must have valid condition type',
       ],
+      [
+        'trailing stop conditional order placement does not contain a trailing offset',
+        {
+          conditionalOrderPlacement: {
+            order: {
+              ...defaultConditionalOrderPlacementEvent.conditionalOrderPlacement!.order!,
+              conditionType: IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP,
+            },
+          },
+        },
+        'StatefulOrderEvent trailing stop order must have exactly one of ' +
+        'trailingOffsetSubticks and trailingOffsetPpm set',
+      ],
+      [
+        'stop loss conditional order placement contains a trailing offset',
+        {
+          conditionalOrderPlacement: {
+            order: {
+              ...defaultConditionalOrderPlacementEvent.conditionalOrderPlacement!.order!,
+              trailingOffsetSubticks: Long.fromValue(1000, true),
+            },
+          },
+        },
+        'StatefulOrderEvent conditional order that is not a trailing stop order must not have ' +
+        'a trailing offset',
+      ],
 
       // Conditional order triggered Validations
       [
@@ -338,6 +387,80 @@ describe('stateful-order-validator', () => {
         `StatefulOrderEvent conditional order triggered must have order flag ${ORDER_FLAG_CONDITIONAL}`,
       ],
 
+      // Conditional order trigger subticks update Validations
+      [
+        'conditional order trigger subticks update does not contain orderId',
+        {
+          conditionalOrderTriggerSubticksUpdate: {
+            ...defaultConditionalOrderTriggerSubticksUpdateEvent.conditionalOrderTriggerSubticksUpdate!,
+            orderId: undefined,
+          },
+        },
+        'StatefulOrderEvent conditional order trigger subticks update must contain an orderId',
+      ],
+      [
+        'conditional order trigger subticks update does not contain the correct order flag',
+        {
+          conditionalOrderTriggerSubticksUpdate: {
+            ...defaultConditionalOrderTriggerSubticksUpdateEvent.conditionalOrderTriggerSubticksUpdate!,
+            orderId: {
+              ...defaultOrderId,
+              orderFlags: ORDER_FLAG_SHORT_TERM,
+            },
+          },
+        },
+        'StatefulOrderEvent conditional order trigger subticks update must have order flag ' +
+        `${ORDER_FLAG_CONDITIONAL}`,
+      ],
+      [
+        'conditional order trigger subticks update does not contain a trigger subticks greater than zero',
+        {
+          conditionalOrderTriggerSubticksUpdate: {
+            ...defaultConditionalOrderTriggerSubticksUpdateEvent.conditionalOrderTriggerSubticksUpdate!,
+            conditionalOrderTriggerSubticks: Long.fromValue(0, true),
+          },
+        },
+        'StatefulOrderEvent conditional order trigger subticks update must have trigger price > 0',
+      ],
+
+      // Long term order replacement Validations
+      [
+        'long term order replacement does not contain oldOrderId',
+        {
+          longTermOrderReplacement: {
+            ...defaultLongTermOrderReplacementEvent.longTermOrderReplacement!,
+            oldOrderId: undefined,
+          },
+        },
+        'StatefulOrderEvent long term order replacement must contain an oldOrderId',
+      ],
+      [
+        'long term order replacement does not contain an order',
+        {
+          longTermOrderReplacement: {
+            ...defaultLongTermOrderReplacementEvent.longTermOrderReplacement!,
+            order: undefined,
+          },
+        },
+        'StatefulOrderEvent long term order replacement must contain an order',
+      ],
+      [
+        'long term order replacement does not contain the correct order flag',
+        {
+          longTermOrderReplacement: {
+            ...defaultLongTermOrderReplacementEvent.longTermOrderReplacement!,
+            order: {
+              ...defaultLongTermOrderReplacementEvent.longTermOrderReplacement!.order!,
+              orderId: {
+                ...defaultLongTermOrderReplacementEvent.longTermOrderReplacement!.order!.orderId!,
+                orderFlags: ORDER_FLAG_CONDITIONAL,
+              },
+            },
+          },
+        },
+        `StatefulOrderEvent long term order must have order flag ${ORDER_FLAG_LONG_TERM}`,
+      ],
+
     ])('throws error if event %s', (
       _message: string,
       event: StatefulOrderEventV1,
@@ -356,6 +479,34 @@ describe('stateful-order-validator', () => {
       );
     });
   });
+
+  describe('createHandlers', () => {
+    it('creates a removal and a placement handler for a long term order replacement', () => {
+      const event: StatefulOrderEventV1 = defaultLongTermOrderReplacementEvent;
+      const block: IndexerTendermintBlock = createBlock(event);
+      const validator: StatefulOrderValidator = new StatefulOrderValidator(event, block);
+
+      const handlers: Handler<StatefulOrderEventV1>[] = validator.createHandlers(
+        block.events[0],
+        0,
+      );
+
+      expect(handlers).toHaveLength(2);
+      expect(handlers[0]).toBeInstanceOf(StatefulOrderRemovalHandler);
+      expect(handlers[0].event).toEqual({
+        orderRemoval: {
+          removedOrderId: event.longTermOrderReplacement!.oldOrderId,
+          reason: OrderRemovalReason.ORDER_REMOVAL_REASON_REPLACED,
+        },
+      });
+      expect(handlers[1]).toBeInstanceOf(StatefulOrderPlacementHandler);
+      expect(handlers[1].event).toEqual({
+        longTermOrderPlacement: {
+          order: event.longTermOrderReplacement!.order,
+        },
+      });
+    });
+  });
 });
 
 function createBlock(
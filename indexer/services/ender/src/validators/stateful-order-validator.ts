@@ -9,12 +9,15 @@ import {
   StatefulOrderEventV1_ConditionalOrderPlacementV1,
   StatefulOrderEventV1_ConditionalOrderTriggeredV1,
   StatefulOrderEventV1_LongTermOrderPlacementV1,
+  StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1,
+  StatefulOrderEventV1_LongTermOrderReplacementV1,
   IndexerOrder_ConditionType,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
 
 import { Handler, HandlerInitializer } from '../handlers/handler';
 import { ConditionalOrderPlacementHandler } from '../handlers/stateful-order/conditional-order-placement-handler';
+import { ConditionalOrderTriggerSubticksUpdateHandler } from '../handlers/stateful-order/conditional-order-trigger-subticks-update-handler';
 import { ConditionalOrderTriggeredHandler } from '../handlers/stateful-order/conditional-order-triggered-handler';
 import { StatefulOrderPlacementHandler } from '../handlers/stateful-order/stateful-order-placement-handler';
 import { StatefulOrderRemovalHandler } from '../handlers/stateful-order/stateful-order-removal-handler';
@@ -28,11 +31,14 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       this.event.orderRemoval === undefined &&
       this.event.conditionalOrderPlacement === undefined &&
       this.event.conditionalOrderTriggered === undefined &&
-      this.event.longTermOrderPlacement === undefined
+      this.event.longTermOrderPlacement === undefined &&
+      this.event.conditionalOrderTriggerSubticksUpdate === undefined &&
+      this.event.longTermOrderReplacement === undefined
     ) {
       return this.logAndThrowParseMessageError(
         'One of orderPlace, orderRemoval, conditionalOrderPlacement, conditionalOrderTriggered, ' +
-        'longTermOrderPlacement must be defined in StatefulOrderEvent',
+        'longTermOrderPlacement, conditionalOrderTriggerSubticksUpdate, longTermOrderReplacement ' +
+        'must be defined in StatefulOrderEvent',
         { event: this.event },
       );
     }
@@ -44,8 +50,14 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       this.validateConditionalOrderPlacement(this.event.conditionalOrderPlacement);
     } else if (this.event.conditionalOrderTriggered !== undefined) {
       this.validateConditionalOrderTriggered(this.event.conditionalOrderTriggered);
-    } else { // longTermOrderPlacement
-      this.validateLongTermOrderPlacement(this.event.longTermOrderPlacement!);
+    } else if (this.event.longTermOrderPlacement !== undefined) {
+      this.validateLongTermOrderPlacement(this.event.longTermOrderPlacement);
+    } else if (this.event.conditionalOrderTriggerSubticksUpdate !== undefined) {
+      this.validateConditionalOrderTriggerSubticksUpdate(
+        this.event.conditionalOrderTriggerSubticksUpdate,
+      );
+    } else { // longTermOrderReplacement
+      this.validateLongTermOrderReplacement(this.event.longTermOrderReplacement!);
     }
   }
 
@@ -141,6 +153,24 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
         { event: this.event },
       );
     }
+
+    const hasTrailingOffset: boolean = !order.trailingOffsetSubticks.isZero() ||
+      order.trailingOffsetPpm !== 0;
+    if (order.conditionType === IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP) {
+      if (order.trailingOffsetSubticks.isZero() === (order.trailingOffsetPpm === 0)) {
+        return this.logAndThrowParseMessageError(
+          'StatefulOrderEvent trailing stop order must have exactly one of ' +
+          'trailingOffsetSubticks and trailingOffsetPpm set',
+          { event: this.event },
+        );
+      }
+    } else if (hasTrailingOffset) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order that is not a trailing stop order must not have ' +
+        'a trailing offset',
+        { event: this.event },
+      );
+    }
   }
 
   private validateConditionalOrderTriggered(
@@ -172,6 +202,42 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
     }
   }
 
+  private validateConditionalOrderTriggerSubticksUpdate(
+    triggerSubticksUpdate: StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1,
+  ): void {
+    if (triggerSubticksUpdate.orderId === undefined) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger subticks update must contain an orderId',
+        { event: this.event },
+      );
+    }
+
+    if (triggerSubticksUpdate.orderId.orderFlags !== ORDER_FLAG_CONDITIONAL) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger subticks update must have order flag ' +
+        `${ORDER_FLAG_CONDITIONAL}`,
+        { event: this.event },
+      );
+    }
+
+    const orderIdErrorMessage: string | undefined = validateOrderIdAndReturnErrorMessage(
+      triggerSubticksUpdate.orderId,
+    );
+    if (orderIdErrorMessage !== undefined) {
+      return this.logAndThrowParseMessageError(
+        `StatefulOrderEvent conditional order trigger subticks update ${orderIdErrorMessage}`,
+        { event: this.event },
+      );
+    }
+
+    if (triggerSubticksUpdate.conditionalOrderTriggerSubticks.isZero()) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger subticks update must have trigger price > 0',
+        { event: this.event },
+      );
+    }
+  }
+
   private validateLongTermOrderPlacement(
     longTermOrderPlacement: StatefulOrderEventV1_LongTermOrderPlacementV1,
   ): void {
@@ -193,6 +259,36 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
     }
   }
 
+  private validateLongTermOrderReplacement(
+    longTermOrderReplacement: StatefulOrderEventV1_LongTermOrderReplacementV1,
+  ): void {
+    if (longTermOrderReplacement.oldOrderId === undefined) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent long term order replacement must contain an oldOrderId',
+        { event: this.event },
+      );
+    }
+
+    const orderIdErrorMessage: string | undefined = validateOrderIdAndReturnErrorMessage(
+      longTermOrderReplacement.oldOrderId,
+    );
+    if (orderIdErrorMessage !== undefined) {
+      return this.logAndThrowParseMessageError(
+        `StatefulOrderEvent long term order replacement ${orderIdErrorMessage}`,
+        { event: this.event },
+      );
+    }
+
+    if (longTermOrderReplacement.order === undefined) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent long term order replacement must contain an order',
+        { event: this.event },
+      );
+    }
+
+    this.validateLongTermOrderPlacement({ order: longTermOrderReplacement.order });
+  }
+
   public getHandlerInitializer() : HandlerInitializer | undefined {
     if (this.event.orderPlace !== undefined) {
       return StatefulOrderPlacementHandler;
@@ -204,6 +300,8 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       return ConditionalOrderTriggeredHandler;
     } else if (this.event.longTermOrderPlacement !== undefined) {
       return StatefulOrderPlacementHandler;
+    } else if (this.event.conditionalOrderTriggerSubticksUpdate !== undefined) {
+      return ConditionalOrderTriggerSubticksUpdateHandler;
     }
     return undefined;
   }
@@ -212,6 +310,36 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
     indexerTendermintEvent: IndexerTendermintEvent,
     txId: number,
   ): Handler<StatefulOrderEventV1>[] {
+    // A long term order replacement is handled as the removal of the replaced order followed by
+    // the placement of the new order.
+    if (this.event.longTermOrderReplacement !== undefined) {
+      const replacement: StatefulOrderEventV1_LongTermOrderReplacementV1 = this.event
+        .longTermOrderReplacement;
+      return [
+        new StatefulOrderRemovalHandler(
+          this.block,
+          indexerTendermintEvent,
+          txId,
+          {
+            orderRemoval: {
+              removedOrderId: replacement.oldOrderId,
+              reason: OrderRemovalReason.ORDER_REMOVAL_REASON_REPLACED,
+            },
+          },
+        ),
+        new StatefulOrderPlacementHandler(
+          this.block,
+          indexerTendermintEvent,
+          txId,
+          {
+            longTermOrderPlacement: {
+              order: replacement.order,
+            },
+          },
+        ),
+      ];
+    }
+
     const Initializer:
     HandlerInitializer | undefined = this.getHandlerInitializer();
     if (Initializer === undefined) {
@@ -0,0 +1,180 @@
+import {
+  logger,
+  ParseMessageError,
+  stats,
+  wrapBackgroundTask,
+} from '@dydxprotocol-indexer/base';
+import { synchronizeWrapBackgroundTask } from '@dydxprotocol-indexer/dev';
+import { createKafkaMessage, producer } from '@dydxprotocol-indexer/kafka';
+import {
+  dbHelpers,
+  OrderSide,
+  perpetualMarketRefresher,
+  protocolTranslations,
+  testConstants,
+  testMocks,
+} from '@dydxprotocol-indexer/postgres';
+import {
+  OrderData,
+  OrdersCache,
+  OrdersDataCache,
+  redis,
+  redisTestConstants,
+} from '@dydxprotocol-indexer/redis';
+import {
+  IndexerOrder,
+  OffChainUpdateV1,
+  OrderPlaceV1_OrderPlacementStatus,
+  RedisOrder,
+} from '@dydxprotocol-indexer/v4-protos';
+import { KafkaMessage } from 'kafkajs';
+import Long from 'long';
+
+import { OrderReplaceHandler } from '../../src/handlers/order-replace-handler';
+import { redisClient } from '../../src/helpers/redis/redis-controller';
+import { onMessage } from '../../src/lib/on-message';
+import {
+  expectOpenOrderIds,
+  expectOrderbookLevelCache,
+  handleInitialOrderPlace,
+  handleOrderUpdate,
+} from '../helpers/helpers';
+
+jest.mock('@dydxprotocol-indexer/base', () => ({
+  ...jest.requireActual('@dydxprotocol-indexer/base'),
+  wrapBackgroundTask: jest.fn(),
+}));
+
+describe('OrderReplaceHandler', () => {
+  beforeAll(async () => {
+    jest.useFakeTimers();
+    await dbHelpers.migrate();
+  });
+
+  beforeEach(async () => {
+    await testMocks.seedData();
+    await perpetualMarketRefresher.updatePerpetualMarkets();
+    jest.spyOn(stats, 'timing');
+    jest.spyOn(stats, 'increment');
+    jest.spyOn(logger, 'info');
+    jest.spyOn(logger, 'error');
+    jest.spyOn(producer, 'send').mockReturnThis();
+    synchronizeWrapBackgroundTask(wrapBackgroundTask);
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    await redis.deleteAllAsync(redisClient);
+    jest.resetAllMocks();
+  });
+
+  afterAll(async () => {
+    jest.useRealTimers();
+    await dbHelpers.teardown();
+  });
+
+  const totalFilledQuantums: Long = redisTestConstants.orderUpdate.orderUpdate.totalFilledQuantums;
+  const orderSide: OrderSide = protocolTranslations.protocolOrderSideToOrderSide(
+    redisTestConstants.defaultOrder.side,
+  );
+
+  async function placeRestingOrder(): Promise<void> {
+    await handleInitialOrderPlace(redisTestConstants.orderPlace);
+    await handleOrderUpdate(redisTestConstants.orderUpdate);
+    jest.runOnlyPendingTimers();
+  }
+
+  async function handleOrderReplace(order: IndexerOrder): Promise<void> {
+    const update: OffChainUpdateV1 = {
+      orderReplace: {
+        oldOrderId: redisTestConstants.defaultOrderId,
+        order,
+        placementStatus:
+          OrderPlaceV1_OrderPlacementStatus.ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
+      },
+    };
+    const message: KafkaMessage = createKafkaMessage(
+      Buffer.from(Uint8Array.from(OffChainUpdateV1.encode(update).finish())),
+    );
+    await onMessage(message);
+  }
+
+  it('replaces an order with a reduced size, keeping its total filled quantums', async () => {
+    await placeRestingOrder();
+
+    const reducedOrder: IndexerOrder = {
+      ...redisTestConstants.defaultOrder,
+      quantums: Long.fromValue(600_000, true),
+    };
+    await handleOrderReplace(reducedOrder);
+
+    const orderData: OrderData | null = await OrdersDataCache.getOrderData(
+      redisTestConstants.defaultOrderId,
+      redisClient,
+    );
+    expect(orderData).toEqual(expect.objectContaining({
+      totalFilledQuantums: totalFilledQuantums.toString(),
+      restingOnBook: true,
+    }));
+    const redisOrder: RedisOrder | null = await OrdersCache.getOrder(
+      redisTestConstants.defaultRedisOrder.id,
+      redisClient,
+    );
+    expect(redisOrder!.order).toEqual(reducedOrder);
+    await expectOrderbookLevelCache(
+      testConstants.defaultPerpetualMarket.ticker,
+      orderSide,
+      redisTestConstants.defaultRedisOrder.price,
+      reducedOrder.quantums.sub(totalFilledQuantums).toString(),
+    );
+    await expectOpenOrderIds(
+      testConstants.defaultPerpetualMarket.clobPairId,
+      [redisTestConstants.defaultRedisOrder.id],
+    );
+  });
+
+  it('replaces an order with a new order id, removing the old order', async () => {
+    await placeRestingOrder();
+
+    const newOrder: IndexerOrder = {
+      ...redisTestConstants.defaultOrder,
+      orderId: {
+        ...redisTestConstants.defaultOrderId,
+        clientId: redisTestConstants.defaultOrderId.clientId + 1,
+      },
+    };
+    await handleOrderReplace(newOrder);
+
+    expect(await OrdersCache.getOrder(
+      redisTestConstants.defaultRedisOrder.id,
+      redisClient,
+    )).toBeNull();
+    const newOrderData: OrderData | null = await OrdersDataCache.getOrderData(
+      newOrder.orderId!,
+      redisClient,
+    );
+    expect(newOrderData).toEqual(expect.objectContaining({
+      totalFilledQuantums: '0',
+      restingOnBook: true,
+    }));
+    await expectOrderbookLevelCache(
+      testConstants.defaultPerpetualMarket.ticker,
+      orderSide,
+      redisTestConstants.defaultRedisOrder.price,
+      newOrder.quantums.toString(),
+    );
+  });
+
+  it('throws a ParseMessageError if the old order id is undefined', async () => {
+    await expect(new OrderReplaceHandler().handleUpdate({
+      orderReplace: {
+        oldOrderId: undefined,
+        order: redisTestConstants.defaultOrder,
+        placementStatus:
+          OrderPlaceV1_OrderPlacementStatus.ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
+      },
+    })).rejects.toThrow(
+      new ParseMessageError('Invalid OrderReplace, old order id is undefined'),
+    );
+  });
+});
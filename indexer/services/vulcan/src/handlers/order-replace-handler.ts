@@ -0,0 +1,119 @@
+import { logger, runFuncWithTimingStat } from '@dydxprotocol-indexer/base';
+import { OrderStatus, OrderTable } from '@dydxprotocol-indexer/postgres';
+import { OrderData, OrdersDataCache } from '@dydxprotocol-indexer/redis';
+import {
+  IndexerOrderId,
+  OffChainUpdateV1,
+  OrderPlaceV1_OrderPlacementStatus,
+  OrderRemovalReason,
+  OrderRemoveV1_OrderRemovalStatus,
+  OrderReplaceV1,
+} from '@dydxprotocol-indexer/v4-protos';
+import Long from 'long';
+
+import { redisClient } from '../helpers/redis/redis-controller';
+import { Handler } from './handler';
+import { OrderPlaceHandler } from './order-place-handler';
+import { OrderRemoveHandler } from './order-remove-handler';
+import { OrderUpdateHandler } from './order-update-handler';
+
+/**
+ * Handler for OrderReplace messages.
+ * The behavior is as follows:
+ * - Remove the replaced order using the OrderRemoveHandler with reason REPLACED and status
+ *   BEST_EFFORT_CANCELED
+ * - Place the new order using the OrderPlaceHandler
+ * - Update the total filled quantums of the new order using the OrderUpdateHandler, so the new
+ *   order is resting on the book. If the new order has the same order id as the replaced order
+ *   (e.g. the size of the order was reduced), the total filled quantums of the replaced order is
+ *   carried over and the status of the order in Postgres is restored to OPEN
+ */
+export class OrderReplaceHandler extends Handler {
+  protected async handle(update: OffChainUpdateV1): Promise<void> {
+    logger.info({
+      at: 'OrderReplaceHandler#handle',
+      message: 'Received OffChainUpdate with OrderReplace.',
+      update,
+      txHash: this.txHash,
+    });
+    const orderReplace: OrderReplaceV1 = update.orderReplace!;
+    this.validateOrderReplace(orderReplace);
+
+    const oldOrderId: IndexerOrderId = orderReplace.oldOrderId!;
+    const newOrderId: IndexerOrderId = orderReplace.order!.orderId!;
+    const isSameOrderId: boolean = OrderTable.orderIdToUuid(oldOrderId) ===
+      OrderTable.orderIdToUuid(newOrderId);
+
+    let totalFilledQuantums: Long = Long.UZERO;
+    if (isSameOrderId) {
+      const oldOrderData: OrderData | null = await runFuncWithTimingStat(
+        OrdersDataCache.getOrderData(oldOrderId, redisClient),
+        this.generateTimingStatsOptions('get_old_order_data'),
+      );
+      if (oldOrderData !== null) {
+        totalFilledQuantums = Long.fromString(oldOrderData.totalFilledQuantums, true);
+      }
+    }
+
+    await new OrderRemoveHandler(this.txHash).handleUpdate({
+      orderRemove: {
+        removedOrderId: oldOrderId,
+        reason: OrderRemovalReason.ORDER_REMOVAL_REASON_REPLACED,
+        removalStatus: OrderRemoveV1_OrderRemovalStatus.ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+      },
+    });
+
+    if (isSameOrderId) {
+      await runFuncWithTimingStat(
+        OrderTable.update({
+          id: OrderTable.orderIdToUuid(newOrderId),
+          status: OrderStatus.OPEN,
+        }),
+        this.generateTimingStatsOptions('reopen_order_in_postgres'),
+      );
+    }
+
+    await new OrderPlaceHandler(this.txHash).handleUpdate({
+      orderPlace: {
+        order: orderReplace.order,
+        placementStatus: orderReplace.placementStatus,
+      },
+    });
+
+    await new OrderUpdateHandler(this.txHash).handleUpdate({
+      orderUpdate: {
+        orderId: newOrderId,
+        totalFilledQuantums,
+      },
+    });
+  }
+
+  protected validateOrderReplace(orderReplace: OrderReplaceV1): void {
+    if (orderReplace.oldOrderId === undefined) {
+      this.logAndThrowParseMessageError('Invalid OrderReplace, old order id is undefined');
+      return;
+    }
+
+    if (orderReplace.oldOrderId.subaccountId === undefined) {
+      this.logAndThrowParseMessageError('Invalid OrderReplace, old subaccount id is undefined');
+      return;
+    }
+
+    if (orderReplace.order === undefined) {
+      this.logAndThrowParseMessageError('Invalid OrderReplace, order is undefined');
+      return;
+    }
+
+    if (orderReplace.order.orderId === undefined) {
+      this.logAndThrowParseMessageError('Invalid OrderReplace, order id is undefined');
+      return;
+    }
+
+    if (
+      orderReplace
+        .placementStatus === OrderPlaceV1_OrderPlacementStatus.ORDER_PLACEMENT_STATUS_UNSPECIFIED
+    ) {
+      this.logAndThrowParseMessageError('Invalid OrderReplace, placement status is UNSPECIFIED');
+    }
+  }
+}
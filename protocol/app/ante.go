@@ -127,6 +127,10 @@ func newAnteDecoratorChain(options HandlerOptions) []sdk.AnteDecorator {
 			),
 		),
 
+		// Note: grantees are the signers of clob messages they submit on behalf of a subaccount owner, so
+		// trading permissions must be validated after signature verification. Grantees are never signers of
+		// `x/sending` messages and therefore cannot transfer or withdraw funds from the subaccount.
+		clobante.NewTradingPermissionDecorator(options.ClobKeeper),
 		clobante.NewRateLimitDecorator(options.ClobKeeper),
 		clobante.NewClobDecorator(options.ClobKeeper),
 	}
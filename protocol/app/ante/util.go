@@ -34,6 +34,18 @@ func ShouldSkipSequenceValidation(msgs []sdk.Msg) (shouldSkipValidation bool) {
 			}
 			// This is a `GoodTilBlock` message, continue to check the next message.
 			continue
+		case
+			*clobtypes.MsgBatchPlaceOrders,
+			*clobtypes.MsgBatchCancel:
+			// Batch messages only reference Short-Term orders, continue to check the next message.
+			continue
+		case
+			*clobtypes.MsgReplaceOrder:
+			if !typedMsg.IsShortTermReplacement() {
+				return false
+			}
+			// This is a Short-Term order replacement, continue to check the next message.
+			continue
 		default:
 			// Early return for messages that require sequence number validation.
 			return false
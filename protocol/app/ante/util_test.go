@@ -28,6 +28,24 @@ func TestSkipSequenceValidation(t *testing.T) {
 			},
 			shouldSkipValidation: true,
 		},
+		"single batch place orders message": {
+			msgs: []sdk.Msg{
+				constants.Msg_BatchPlaceOrders,
+			},
+			shouldSkipValidation: true,
+		},
+		"single batch cancel message": {
+			msgs: []sdk.Msg{
+				constants.Msg_BatchCancel,
+			},
+			shouldSkipValidation: true,
+		},
+		"single replace order message": {
+			msgs: []sdk.Msg{
+				constants.Msg_ReplaceOrder,
+			},
+			shouldSkipValidation: true,
+		},
 		"single transfer message": {
 			msgs: []sdk.Msg{
 				constants.Msg_Transfer,
@@ -52,6 +70,12 @@ func TestSkipSequenceValidation(t *testing.T) {
 			},
 			shouldSkipValidation: false,
 		},
+		"single long term replace order": {
+			msgs: []sdk.Msg{
+				constants.Msg_ReplaceOrder_LongTerm,
+			},
+			shouldSkipValidation: false,
+		},
 		"single conditional order": {
 			msgs: []sdk.Msg{
 				constants.Msg_PlaceOrder_Conditional,
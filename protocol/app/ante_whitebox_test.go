@@ -69,6 +69,7 @@ func newTestHandlerOptions() HandlerOptions {
 		nil,
 		nil,
 		nil,
+		nil,
 		flags.GetDefaultClobFlags(),
 		rate_limit.NewNoOpRateLimiter[*types.MsgPlaceOrder](),
 		rate_limit.NewNoOpRateLimiter[*types.MsgCancelOrder](),
@@ -147,6 +148,7 @@ func TestAnteHandlerChainOrder_Valid(t *testing.T) {
 		"ante.AppInjectedMsgAnteWrapper(ante.SigGasConsumeDecorator)",
 		"ante.AppInjectedMsgAnteWrapper(ante.SigVerificationDecorator)",
 		"ante.AppInjectedMsgAnteWrapper(ante.ShortTermSingleMsgClobTxAnteWrapper(ante.IncrementSequenceDecorator))",
+		"ante.TradingPermissionDecorator",
 		"ante.ClobRateLimitDecorator",
 		"ante.ClobDecorator",
 	}
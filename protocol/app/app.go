@@ -265,7 +265,7 @@ type App struct {
 
 	StatsKeeper statsmodulekeeper.Keeper
 
-	SubaccountsKeeper subaccountsmodulekeeper.Keeper
+	SubaccountsKeeper *subaccountsmodulekeeper.Keeper
 
 	ClobKeeper *clobmodulekeeper.Keeper
 
@@ -582,6 +582,12 @@ func New(
 	// The in-memory data structure is shared by the x/clob module and liquidations daemon.
 	liquidatableSubaccountIds := liquidationtypes.NewLiquidatableSubaccountIds()
 	app.Server.WithLiquidatableSubaccountIds(liquidatableSubaccountIds)
+	// The in-memory data structure is shared by the x/subaccounts module and liquidations daemon, so that
+	// the daemon only rechecks subaccounts updated in recently committed blocks.
+	updatedSubaccountIds := liquidationtypes.NewUpdatedSubaccountIds(
+		liquidationtypes.UpdatedSubaccountIdsRetainedBlocks,
+	)
+	app.Server.WithUpdatedSubaccountIds(updatedSubaccountIds)
 
 	// Setup server for bridge messages.
 	// The in-memory data structure is shared by the x/bridge module and bridge daemon.
@@ -619,7 +625,19 @@ func New(
 
 		// Non-validating full-nodes have no need to run the price daemon.
 		if !appFlags.NonValidatingFullNode && daemonFlags.Price.Enabled {
-			exchangeQueryConfig := constants.StaticExchangeQueryConfig
+			exchangeQueryConfig, exchangeDetails, err := constants.GetExchangeQueryConfigsAndDetails(
+				daemonFlags.Price.JsonPathExchangesConfig,
+			)
+			if err != nil {
+				// An invalid json path exchanges config should not halt the node. The daemon continues to
+				// query the static exchanges, and markets that are only priced by json path exchanges are
+				// skipped until the config is fixed.
+				logger.Error(
+					"Failed to load json path exchanges config, only static exchanges will be queried",
+					"error",
+					err,
+				)
+			}
 			app.Server.ExpectPricefeedDaemon(daemonservertypes.MaximumAcceptableUpdateDelay(daemonFlags.Price.LoopDelayMs))
 			// Start pricefeed client for sending prices for the pricefeed server to consume. These prices
 			// are retrieved via third-party APIs like Binance and then are encoded in-memory and
@@ -633,7 +651,7 @@ func New(
 				logger,
 				&daemontypes.GrpcClientImpl{},
 				exchangeQueryConfig,
-				constants.StaticExchangeDetails,
+				exchangeDetails,
 				&pricefeedclient.SubTaskRunnerImpl{},
 			)
 		}
@@ -738,6 +756,7 @@ func New(
 		bridgeEventManager,
 		app.BankKeeper,
 		app.DelayMsgKeeper,
+		app.StakingKeeper,
 		// gov module and delayMsg module accounts are allowed to send messages to the bridge module.
 		[]string{
 			lib.GovModuleAddress.String(),
@@ -776,6 +795,9 @@ func New(
 	app.FeeTiersKeeper = *feetiersmodulekeeper.NewKeeper(
 		appCodec,
 		app.StatsKeeper,
+		app.AssetsKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
 		keys[feetiersmoduletypes.StoreKey],
 		// set the governance and delaymsg module accounts as the authority for conducting upgrades
 		[]string{
@@ -806,6 +828,7 @@ func New(
 		app.BankKeeper,
 		app.FeeTiersKeeper,
 		app.PricesKeeper,
+		app.EpochsKeeper,
 		// set the governance and delaymsg module accounts as the authority for conducting upgrades
 		[]string{
 			lib.GovModuleAddress.String(),
@@ -814,17 +837,14 @@ func New(
 	)
 	rewardsModule := rewardsmodule.NewAppModule(appCodec, app.RewardsKeeper)
 
-	app.SubaccountsKeeper = *subaccountsmodulekeeper.NewKeeper(
+	app.SubaccountsKeeper = subaccountsmodulekeeper.NewKeeper(
 		appCodec,
 		keys[satypes.StoreKey],
 		app.AssetsKeeper,
 		app.BankKeeper,
 		app.PerpetualsKeeper,
 		app.IndexerEventManager,
-	)
-	subaccountsModule := subaccountsmodule.NewAppModule(
-		appCodec,
-		app.SubaccountsKeeper,
+		updatedSubaccountIds,
 	)
 
 	clobFlags := clobflags.GetClobFlagValuesFromOptions(appOpts)
@@ -852,6 +872,7 @@ func New(
 		app.StatsKeeper,
 		app.RewardsKeeper,
 		app.IndexerEventManager,
+		liquidatableSubaccountIds,
 		txConfig.TxDecoder(),
 		clobFlags,
 		rate_limit.NewPanicRateLimiter[*clobmoduletypes.MsgPlaceOrder](),
@@ -866,6 +887,11 @@ func New(
 		liquidatableSubaccountIds,
 	)
 	app.PerpetualsKeeper.SetClobKeeper(app.ClobKeeper)
+	app.SubaccountsKeeper.SetClobKeeper(app.ClobKeeper)
+	subaccountsModule := subaccountsmodule.NewAppModule(
+		appCodec,
+		*app.SubaccountsKeeper,
+	)
 
 	app.SendingKeeper = *sendingmodulekeeper.NewKeeper(
 		appCodec,
@@ -873,6 +899,8 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.SubaccountsKeeper,
+		app.PerpetualsKeeper,
+		app.ClobKeeper,
 		app.IndexerEventManager,
 		// gov module and delayMsg module accounts are allowed to send messages to the sending module.
 		[]string{
@@ -991,6 +1019,7 @@ func New(
 
 	app.ModuleManager.SetOrderCommiters(
 		clobmoduletypes.ModuleName,
+		satypes.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderEndBlockers(
@@ -1252,6 +1281,8 @@ func (app *App) hydrateMemclobWithOrderbooksAndStatefulOrders() {
 	// Initialize memclob with all existing stateful orders.
 	// TODO(DEC-1348): Emit indexer messages to indicate that application restarted.
 	app.ClobKeeper.InitStatefulOrders(checkStateCtx)
+	// Set the `checkState` context that gRPC queries simulating against the memclob read state from.
+	app.ClobKeeper.SetCheckStateContext(checkStateCtx)
 }
 
 // hydrateKeeperInMemoryDataStructures hydrates the keeper with ClobPairId and PerpetualId mapping
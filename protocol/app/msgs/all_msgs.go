@@ -138,26 +138,46 @@ var (
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse": {},
 
 		// bridge
-		"/dydxprotocol.bridge.MsgAcknowledgeBridges":          {},
-		"/dydxprotocol.bridge.MsgAcknowledgeBridgesResponse":  {},
-		"/dydxprotocol.bridge.MsgCompleteBridge":              {},
-		"/dydxprotocol.bridge.MsgCompleteBridgeResponse":      {},
-		"/dydxprotocol.bridge.MsgUpdateEventParams":           {},
-		"/dydxprotocol.bridge.MsgUpdateEventParamsResponse":   {},
-		"/dydxprotocol.bridge.MsgUpdateProposeParams":         {},
-		"/dydxprotocol.bridge.MsgUpdateProposeParamsResponse": {},
-		"/dydxprotocol.bridge.MsgUpdateSafetyParams":          {},
-		"/dydxprotocol.bridge.MsgUpdateSafetyParamsResponse":  {},
+		"/dydxprotocol.bridge.MsgAcknowledgeBridges":              {},
+		"/dydxprotocol.bridge.MsgAcknowledgeBridgesResponse":      {},
+		"/dydxprotocol.bridge.MsgAttestBridgeOut":                 {},
+		"/dydxprotocol.bridge.MsgAttestBridgeOutResponse":         {},
+		"/dydxprotocol.bridge.MsgBridgeOut":                       {},
+		"/dydxprotocol.bridge.MsgBridgeOutResponse":               {},
+		"/dydxprotocol.bridge.MsgCompleteBridge":                  {},
+		"/dydxprotocol.bridge.MsgCompleteBridgeResponse":          {},
+		"/dydxprotocol.bridge.MsgSetBridgeSigner":                 {},
+		"/dydxprotocol.bridge.MsgSetBridgeSignerResponse":         {},
+		"/dydxprotocol.bridge.MsgUpdateEventParams":               {},
+		"/dydxprotocol.bridge.MsgUpdateEventParamsResponse":       {},
+		"/dydxprotocol.bridge.MsgUpdateProposeParams":             {},
+		"/dydxprotocol.bridge.MsgUpdateProposeParamsResponse":     {},
+		"/dydxprotocol.bridge.MsgUpdateRateLimitParams":           {},
+		"/dydxprotocol.bridge.MsgUpdateRateLimitParamsResponse":   {},
+		"/dydxprotocol.bridge.MsgUpdateSafetyParams":              {},
+		"/dydxprotocol.bridge.MsgUpdateSafetyParamsResponse":      {},
+		"/dydxprotocol.bridge.MsgUpdateSourceChainParams":         {},
+		"/dydxprotocol.bridge.MsgUpdateSourceChainParamsResponse": {},
 
 		// clob
+		"/dydxprotocol.clob.MsgBatchCancel":                                {},
+		"/dydxprotocol.clob.MsgBatchCancelResponse":                        {},
+		"/dydxprotocol.clob.MsgBatchPlaceOrders":                           {},
+		"/dydxprotocol.clob.MsgBatchPlaceOrdersResponse":                   {},
 		"/dydxprotocol.clob.MsgCancelOrder":                                {},
 		"/dydxprotocol.clob.MsgCancelOrderResponse":                        {},
 		"/dydxprotocol.clob.MsgCreateClobPair":                             {},
 		"/dydxprotocol.clob.MsgCreateClobPairResponse":                     {},
+		"/dydxprotocol.clob.MsgGrantTradingPermission":                     {},
+		"/dydxprotocol.clob.MsgGrantTradingPermissionResponse":             {},
 		"/dydxprotocol.clob.MsgPlaceOrder":                                 {},
 		"/dydxprotocol.clob.MsgPlaceOrderResponse":                         {},
 		"/dydxprotocol.clob.MsgProposedOperations":                         {},
 		"/dydxprotocol.clob.MsgProposedOperationsResponse":                 {},
+		"/dydxprotocol.clob.MsgReplaceOrder":                               {},
+		"/dydxprotocol.clob.MsgReplaceOrderResponse":                       {},
+		"/dydxprotocol.clob.MsgRevokeTradingPermission":                    {},
+		"/dydxprotocol.clob.MsgRevokeTradingPermissionResponse":            {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration":          {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse":  {},
 		"/dydxprotocol.clob.MsgUpdateClobPair":                             {},
@@ -166,14 +186,30 @@ var (
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse": {},
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfig":                   {},
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse":           {},
+		"/dydxprotocol.clob.MsgUpdateMakerUptimeRewardsConfig":             {},
+		"/dydxprotocol.clob.MsgUpdateMakerUptimeRewardsConfigResponse":     {},
 
 		// delaymsg
 		"/dydxprotocol.delaymsg.MsgDelayMessage":         {},
 		"/dydxprotocol.delaymsg.MsgDelayMessageResponse": {},
 
 		// feetiers
+		"/dydxprotocol.feetiers.MsgDeleteFeeTierOverride":            {},
+		"/dydxprotocol.feetiers.MsgDeleteFeeTierOverrideResponse":    {},
+		"/dydxprotocol.feetiers.MsgDeleteMarketFeeSchedule":          {},
+		"/dydxprotocol.feetiers.MsgDeleteMarketFeeScheduleResponse":  {},
+		"/dydxprotocol.feetiers.MsgRegisterReferralCode":             {},
+		"/dydxprotocol.feetiers.MsgRegisterReferralCodeResponse":     {},
+		"/dydxprotocol.feetiers.MsgSetFeeTierOverride":               {},
+		"/dydxprotocol.feetiers.MsgSetFeeTierOverrideResponse":       {},
+		"/dydxprotocol.feetiers.MsgSetMarketFeeSchedule":             {},
+		"/dydxprotocol.feetiers.MsgSetMarketFeeScheduleResponse":     {},
+		"/dydxprotocol.feetiers.MsgSetReferrer":                      {},
+		"/dydxprotocol.feetiers.MsgSetReferrerResponse":              {},
 		"/dydxprotocol.feetiers.MsgUpdatePerpetualFeeParams":         {},
 		"/dydxprotocol.feetiers.MsgUpdatePerpetualFeeParamsResponse": {},
+		"/dydxprotocol.feetiers.MsgUpdateReferralParams":             {},
+		"/dydxprotocol.feetiers.MsgUpdateReferralParamsResponse":     {},
 
 		// perpetuals
 		"/dydxprotocol.perpetuals.MsgAddPremiumVotes":               {},
@@ -196,14 +232,18 @@ var (
 		"/dydxprotocol.prices.MsgUpdateMarketParamResponse":  {},
 
 		// sending
-		"/dydxprotocol.sending.MsgCreateTransfer":                  {},
-		"/dydxprotocol.sending.MsgCreateTransferResponse":          {},
-		"/dydxprotocol.sending.MsgDepositToSubaccount":             {},
-		"/dydxprotocol.sending.MsgDepositToSubaccountResponse":     {},
-		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":          {},
-		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse":  {},
-		"/dydxprotocol.sending.MsgSendFromModuleToAccount":         {},
-		"/dydxprotocol.sending.MsgSendFromModuleToAccountResponse": {},
+		"/dydxprotocol.sending.MsgCreateTransfer":                    {},
+		"/dydxprotocol.sending.MsgCreateTransferResponse":            {},
+		"/dydxprotocol.sending.MsgDepositToSubaccount":               {},
+		"/dydxprotocol.sending.MsgDepositToSubaccountResponse":       {},
+		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":            {},
+		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse":    {},
+		"/dydxprotocol.sending.MsgSendFromModuleToAccount":           {},
+		"/dydxprotocol.sending.MsgSendFromModuleToAccountResponse":   {},
+		"/dydxprotocol.sending.MsgSetIsolatedMargin":                 {},
+		"/dydxprotocol.sending.MsgSetIsolatedMarginResponse":         {},
+		"/dydxprotocol.sending.MsgTransferPerpetualPosition":         {},
+		"/dydxprotocol.sending.MsgTransferPerpetualPositionResponse": {},
 
 		// stats
 		"/dydxprotocol.stats.MsgUpdateParams":         {},
@@ -216,8 +256,12 @@ var (
 		"/dydxprotocol.vest.MsgDeleteVestEntryResponse": {},
 
 		// rewards
-		"/dydxprotocol.rewards.MsgUpdateParams":         {},
-		"/dydxprotocol.rewards.MsgUpdateParamsResponse": {},
+		"/dydxprotocol.rewards.MsgDeleteRewardProgram":         {},
+		"/dydxprotocol.rewards.MsgDeleteRewardProgramResponse": {},
+		"/dydxprotocol.rewards.MsgSetRewardProgram":            {},
+		"/dydxprotocol.rewards.MsgSetRewardProgramResponse":    {},
+		"/dydxprotocol.rewards.MsgUpdateParams":                {},
+		"/dydxprotocol.rewards.MsgUpdateParamsResponse":        {},
 
 		// ibc.applications
 		"/ibc.applications.transfer.v1.MsgTransfer":           {},
@@ -83,14 +83,18 @@ var (
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse": nil,
 
 		// bridge
-		"/dydxprotocol.bridge.MsgCompleteBridge":              &bridge.MsgCompleteBridge{},
-		"/dydxprotocol.bridge.MsgCompleteBridgeResponse":      nil,
-		"/dydxprotocol.bridge.MsgUpdateEventParams":           &bridge.MsgUpdateEventParams{},
-		"/dydxprotocol.bridge.MsgUpdateEventParamsResponse":   nil,
-		"/dydxprotocol.bridge.MsgUpdateProposeParams":         &bridge.MsgUpdateProposeParams{},
-		"/dydxprotocol.bridge.MsgUpdateProposeParamsResponse": nil,
-		"/dydxprotocol.bridge.MsgUpdateSafetyParams":          &bridge.MsgUpdateSafetyParams{},
-		"/dydxprotocol.bridge.MsgUpdateSafetyParamsResponse":  nil,
+		"/dydxprotocol.bridge.MsgCompleteBridge":                  &bridge.MsgCompleteBridge{},
+		"/dydxprotocol.bridge.MsgCompleteBridgeResponse":          nil,
+		"/dydxprotocol.bridge.MsgUpdateEventParams":               &bridge.MsgUpdateEventParams{},
+		"/dydxprotocol.bridge.MsgUpdateEventParamsResponse":       nil,
+		"/dydxprotocol.bridge.MsgUpdateProposeParams":             &bridge.MsgUpdateProposeParams{},
+		"/dydxprotocol.bridge.MsgUpdateProposeParamsResponse":     nil,
+		"/dydxprotocol.bridge.MsgUpdateRateLimitParams":           &bridge.MsgUpdateRateLimitParams{},
+		"/dydxprotocol.bridge.MsgUpdateRateLimitParamsResponse":   nil,
+		"/dydxprotocol.bridge.MsgUpdateSafetyParams":              &bridge.MsgUpdateSafetyParams{},
+		"/dydxprotocol.bridge.MsgUpdateSafetyParamsResponse":      nil,
+		"/dydxprotocol.bridge.MsgUpdateSourceChainParams":         &bridge.MsgUpdateSourceChainParams{},
+		"/dydxprotocol.bridge.MsgUpdateSourceChainParamsResponse": nil,
 
 		// clob
 		"/dydxprotocol.clob.MsgCreateClobPair":                             &clob.MsgCreateClobPair{},
@@ -103,14 +107,26 @@ var (
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse": nil,
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfig":                   &clob.MsgUpdateLiquidationsConfig{},
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse":           nil,
+		"/dydxprotocol.clob.MsgUpdateMakerUptimeRewardsConfig":             &clob.MsgUpdateMakerUptimeRewardsConfig{},
+		"/dydxprotocol.clob.MsgUpdateMakerUptimeRewardsConfigResponse":     nil,
 
 		// delaymsg
 		"/dydxprotocol.delaymsg.MsgDelayMessage":         &delaymsg.MsgDelayMessage{},
 		"/dydxprotocol.delaymsg.MsgDelayMessageResponse": nil,
 
 		// feetiers
+		"/dydxprotocol.feetiers.MsgDeleteFeeTierOverride":            &feetiers.MsgDeleteFeeTierOverride{},
+		"/dydxprotocol.feetiers.MsgDeleteFeeTierOverrideResponse":    nil,
+		"/dydxprotocol.feetiers.MsgDeleteMarketFeeSchedule":          &feetiers.MsgDeleteMarketFeeSchedule{},
+		"/dydxprotocol.feetiers.MsgDeleteMarketFeeScheduleResponse":  nil,
+		"/dydxprotocol.feetiers.MsgSetFeeTierOverride":               &feetiers.MsgSetFeeTierOverride{},
+		"/dydxprotocol.feetiers.MsgSetFeeTierOverrideResponse":       nil,
+		"/dydxprotocol.feetiers.MsgSetMarketFeeSchedule":             &feetiers.MsgSetMarketFeeSchedule{},
+		"/dydxprotocol.feetiers.MsgSetMarketFeeScheduleResponse":     nil,
 		"/dydxprotocol.feetiers.MsgUpdatePerpetualFeeParams":         &feetiers.MsgUpdatePerpetualFeeParams{},
 		"/dydxprotocol.feetiers.MsgUpdatePerpetualFeeParamsResponse": nil,
+		"/dydxprotocol.feetiers.MsgUpdateReferralParams":             &feetiers.MsgUpdateReferralParams{},
+		"/dydxprotocol.feetiers.MsgUpdateReferralParamsResponse":     nil,
 
 		// perpetuals
 		"/dydxprotocol.perpetuals.MsgCreatePerpetual":               &perpetuals.MsgCreatePerpetual{},
@@ -129,8 +145,12 @@ var (
 		"/dydxprotocol.prices.MsgUpdateMarketParamResponse":  nil,
 
 		// rewards
-		"/dydxprotocol.rewards.MsgUpdateParams":         &rewards.MsgUpdateParams{},
-		"/dydxprotocol.rewards.MsgUpdateParamsResponse": nil,
+		"/dydxprotocol.rewards.MsgDeleteRewardProgram":         &rewards.MsgDeleteRewardProgram{},
+		"/dydxprotocol.rewards.MsgDeleteRewardProgramResponse": nil,
+		"/dydxprotocol.rewards.MsgSetRewardProgram":            &rewards.MsgSetRewardProgram{},
+		"/dydxprotocol.rewards.MsgSetRewardProgramResponse":    nil,
+		"/dydxprotocol.rewards.MsgUpdateParams":                &rewards.MsgUpdateParams{},
+		"/dydxprotocol.rewards.MsgUpdateParamsResponse":        nil,
 
 		// sending
 		"/dydxprotocol.sending.MsgSendFromModuleToAccount":         &sending.MsgSendFromModuleToAccount{},
@@ -74,8 +74,12 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/dydxprotocol.bridge.MsgUpdateEventParamsResponse",
 		"/dydxprotocol.bridge.MsgUpdateProposeParams",
 		"/dydxprotocol.bridge.MsgUpdateProposeParamsResponse",
+		"/dydxprotocol.bridge.MsgUpdateRateLimitParams",
+		"/dydxprotocol.bridge.MsgUpdateRateLimitParamsResponse",
 		"/dydxprotocol.bridge.MsgUpdateSafetyParams",
 		"/dydxprotocol.bridge.MsgUpdateSafetyParamsResponse",
+		"/dydxprotocol.bridge.MsgUpdateSourceChainParams",
+		"/dydxprotocol.bridge.MsgUpdateSourceChainParamsResponse",
 
 		// clob
 		"/dydxprotocol.clob.MsgCreateClobPair",
@@ -88,14 +92,26 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse",
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfig",
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse",
+		"/dydxprotocol.clob.MsgUpdateMakerUptimeRewardsConfig",
+		"/dydxprotocol.clob.MsgUpdateMakerUptimeRewardsConfigResponse",
 
 		// delaymsg
 		"/dydxprotocol.delaymsg.MsgDelayMessage",
 		"/dydxprotocol.delaymsg.MsgDelayMessageResponse",
 
 		// feetiers
+		"/dydxprotocol.feetiers.MsgDeleteFeeTierOverride",
+		"/dydxprotocol.feetiers.MsgDeleteFeeTierOverrideResponse",
+		"/dydxprotocol.feetiers.MsgDeleteMarketFeeSchedule",
+		"/dydxprotocol.feetiers.MsgDeleteMarketFeeScheduleResponse",
+		"/dydxprotocol.feetiers.MsgSetFeeTierOverride",
+		"/dydxprotocol.feetiers.MsgSetFeeTierOverrideResponse",
+		"/dydxprotocol.feetiers.MsgSetMarketFeeSchedule",
+		"/dydxprotocol.feetiers.MsgSetMarketFeeScheduleResponse",
 		"/dydxprotocol.feetiers.MsgUpdatePerpetualFeeParams",
 		"/dydxprotocol.feetiers.MsgUpdatePerpetualFeeParamsResponse",
+		"/dydxprotocol.feetiers.MsgUpdateReferralParams",
+		"/dydxprotocol.feetiers.MsgUpdateReferralParamsResponse",
 
 		// perpeutals
 		"/dydxprotocol.perpetuals.MsgCreatePerpetual",
@@ -114,6 +130,10 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/dydxprotocol.prices.MsgUpdateMarketParamResponse",
 
 		// rewards
+		"/dydxprotocol.rewards.MsgDeleteRewardProgram",
+		"/dydxprotocol.rewards.MsgDeleteRewardProgramResponse",
+		"/dydxprotocol.rewards.MsgSetRewardProgram",
+		"/dydxprotocol.rewards.MsgSetRewardProgramResponse",
 		"/dydxprotocol.rewards.MsgUpdateParams",
 		"/dydxprotocol.rewards.MsgUpdateParamsResponse",
 
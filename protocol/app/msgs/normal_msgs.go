@@ -17,7 +17,9 @@ import (
 	ibcconn "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	ibccore "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
+	bridge "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	clob "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	feetiers "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
 	sending "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
 )
 
@@ -120,23 +122,51 @@ var (
 		"/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal": nil,
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal":       nil,
 
+		// bridge
+		"/dydxprotocol.bridge.MsgAttestBridgeOut":         &bridge.MsgAttestBridgeOut{},
+		"/dydxprotocol.bridge.MsgAttestBridgeOutResponse": nil,
+		"/dydxprotocol.bridge.MsgBridgeOut":               &bridge.MsgBridgeOut{},
+		"/dydxprotocol.bridge.MsgBridgeOutResponse":       nil,
+		"/dydxprotocol.bridge.MsgSetBridgeSigner":         &bridge.MsgSetBridgeSigner{},
+		"/dydxprotocol.bridge.MsgSetBridgeSignerResponse": nil,
+
 		// clob
-		"/dydxprotocol.clob.MsgCancelOrder":         &clob.MsgCancelOrder{},
-		"/dydxprotocol.clob.MsgCancelOrderResponse": nil,
-		"/dydxprotocol.clob.MsgPlaceOrder":          &clob.MsgPlaceOrder{},
-		"/dydxprotocol.clob.MsgPlaceOrderResponse":  nil,
+		"/dydxprotocol.clob.MsgBatchCancel":                     &clob.MsgBatchCancel{},
+		"/dydxprotocol.clob.MsgBatchCancelResponse":             nil,
+		"/dydxprotocol.clob.MsgBatchPlaceOrders":                &clob.MsgBatchPlaceOrders{},
+		"/dydxprotocol.clob.MsgBatchPlaceOrdersResponse":        nil,
+		"/dydxprotocol.clob.MsgCancelOrder":                     &clob.MsgCancelOrder{},
+		"/dydxprotocol.clob.MsgCancelOrderResponse":             nil,
+		"/dydxprotocol.clob.MsgGrantTradingPermission":          &clob.MsgGrantTradingPermission{},
+		"/dydxprotocol.clob.MsgGrantTradingPermissionResponse":  nil,
+		"/dydxprotocol.clob.MsgPlaceOrder":                      &clob.MsgPlaceOrder{},
+		"/dydxprotocol.clob.MsgPlaceOrderResponse":              nil,
+		"/dydxprotocol.clob.MsgReplaceOrder":                    &clob.MsgReplaceOrder{},
+		"/dydxprotocol.clob.MsgReplaceOrderResponse":            nil,
+		"/dydxprotocol.clob.MsgRevokeTradingPermission":         &clob.MsgRevokeTradingPermission{},
+		"/dydxprotocol.clob.MsgRevokeTradingPermissionResponse": nil,
+
+		// feetiers
+		"/dydxprotocol.feetiers.MsgRegisterReferralCode":         &feetiers.MsgRegisterReferralCode{},
+		"/dydxprotocol.feetiers.MsgRegisterReferralCodeResponse": nil,
+		"/dydxprotocol.feetiers.MsgSetReferrer":                  &feetiers.MsgSetReferrer{},
+		"/dydxprotocol.feetiers.MsgSetReferrerResponse":          nil,
 
 		// perpetuals
 
 		// prices
 
 		// sending
-		"/dydxprotocol.sending.MsgCreateTransfer":                 &sending.MsgCreateTransfer{},
-		"/dydxprotocol.sending.MsgCreateTransferResponse":         nil,
-		"/dydxprotocol.sending.MsgDepositToSubaccount":            &sending.MsgDepositToSubaccount{},
-		"/dydxprotocol.sending.MsgDepositToSubaccountResponse":    nil,
-		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":         &sending.MsgWithdrawFromSubaccount{},
-		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse": nil,
+		"/dydxprotocol.sending.MsgCreateTransfer":                    &sending.MsgCreateTransfer{},
+		"/dydxprotocol.sending.MsgCreateTransferResponse":            nil,
+		"/dydxprotocol.sending.MsgDepositToSubaccount":               &sending.MsgDepositToSubaccount{},
+		"/dydxprotocol.sending.MsgDepositToSubaccountResponse":       nil,
+		"/dydxprotocol.sending.MsgSetIsolatedMargin":                 &sending.MsgSetIsolatedMargin{},
+		"/dydxprotocol.sending.MsgSetIsolatedMarginResponse":         nil,
+		"/dydxprotocol.sending.MsgTransferPerpetualPosition":         &sending.MsgTransferPerpetualPosition{},
+		"/dydxprotocol.sending.MsgTransferPerpetualPositionResponse": nil,
+		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":            &sending.MsgWithdrawFromSubaccount{},
+		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse":    nil,
 
 		// ibc.applications
 		"/ibc.applications.transfer.v1.MsgTransfer":           &ibctransfer.MsgTransfer{},
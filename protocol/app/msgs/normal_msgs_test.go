@@ -107,11 +107,35 @@ func TestNormalMsgs_Key(t *testing.T) {
 		"/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal",
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
 
+		// bridge
+		"/dydxprotocol.bridge.MsgAttestBridgeOut",
+		"/dydxprotocol.bridge.MsgAttestBridgeOutResponse",
+		"/dydxprotocol.bridge.MsgBridgeOut",
+		"/dydxprotocol.bridge.MsgBridgeOutResponse",
+		"/dydxprotocol.bridge.MsgSetBridgeSigner",
+		"/dydxprotocol.bridge.MsgSetBridgeSignerResponse",
+
 		// clob
+		"/dydxprotocol.clob.MsgBatchCancel",
+		"/dydxprotocol.clob.MsgBatchCancelResponse",
+		"/dydxprotocol.clob.MsgBatchPlaceOrders",
+		"/dydxprotocol.clob.MsgBatchPlaceOrdersResponse",
 		"/dydxprotocol.clob.MsgCancelOrder",
 		"/dydxprotocol.clob.MsgCancelOrderResponse",
+		"/dydxprotocol.clob.MsgGrantTradingPermission",
+		"/dydxprotocol.clob.MsgGrantTradingPermissionResponse",
 		"/dydxprotocol.clob.MsgPlaceOrder",
 		"/dydxprotocol.clob.MsgPlaceOrderResponse",
+		"/dydxprotocol.clob.MsgReplaceOrder",
+		"/dydxprotocol.clob.MsgReplaceOrderResponse",
+		"/dydxprotocol.clob.MsgRevokeTradingPermission",
+		"/dydxprotocol.clob.MsgRevokeTradingPermissionResponse",
+
+		// feetiers
+		"/dydxprotocol.feetiers.MsgRegisterReferralCode",
+		"/dydxprotocol.feetiers.MsgRegisterReferralCodeResponse",
+		"/dydxprotocol.feetiers.MsgSetReferrer",
+		"/dydxprotocol.feetiers.MsgSetReferrerResponse",
 
 		// perpetuals
 
@@ -122,6 +146,10 @@ func TestNormalMsgs_Key(t *testing.T) {
 		"/dydxprotocol.sending.MsgCreateTransferResponse",
 		"/dydxprotocol.sending.MsgDepositToSubaccount",
 		"/dydxprotocol.sending.MsgDepositToSubaccountResponse",
+		"/dydxprotocol.sending.MsgSetIsolatedMargin",
+		"/dydxprotocol.sending.MsgSetIsolatedMarginResponse",
+		"/dydxprotocol.sending.MsgTransferPerpetualPosition",
+		"/dydxprotocol.sending.MsgTransferPerpetualPositionResponse",
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccount",
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse",
 
@@ -99,6 +99,14 @@ func TestRemoveDisallowMsgs(t *testing.T) {
 			txs:         [][]byte{constants.ValidMsgUpdateMarketPricesTxBytes},
 			expectedTxs: nil,
 		},
+		"Single Tx, Single Msg Tx, Disallowed Batch Place Orders Msg": {
+			txs:         [][]byte{constants.Msg_BatchPlaceOrders_TxBytes},
+			expectedTxs: nil,
+		},
+		"Single Tx, Single Msg Tx, Disallowed Batch Cancel Msg": {
+			txs:         [][]byte{constants.Msg_BatchCancel_TxBytes},
+			expectedTxs: nil,
+		},
 		"Single Tx, Single Msg Tx, Allowed Msg": {
 			txs:         [][]byte{constants.Msg_Send_TxBytes},
 			expectedTxs: [][]byte{constants.Msg_Send_TxBytes},
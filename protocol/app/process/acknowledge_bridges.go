@@ -3,6 +3,7 @@ package process
 import (
 	"reflect"
 
+	errorsmod "cosmossdk.io/errors"
 	gometrics "github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,12 +60,13 @@ func DecodeAcknowledgeBridgesTx(
 
 // Validate returns an error if:
 // - msg fails `ValidateBasic`.
-// - bridge events are non empty and bridging is disabled.
-// - first bridge event ID is not the one to be next acknowledged.
-// - last bridge event ID has not been recognized.
+// - the source chain of any bridge event does not exist.
+// - bridge events of a source chain are non empty and bridging from it is disabled.
+// - first bridge event ID of a source chain is not the one to be next acknowledged.
+// - last bridge event ID of a source chain has not been recognized.
 // - a bridge event's content is not the same as in server state.
 func (abt *AcknowledgeBridgesTx) Validate() error {
-	// `ValidateBasic` validates that bridge event IDs are consecutive.
+	// `ValidateBasic` validates that bridge event IDs are consecutive on each source chain.
 	if err := abt.msg.ValidateBasic(); err != nil {
 		telemetry.IncrCounterWithLabels(
 			[]string{
@@ -79,17 +81,39 @@ func (abt *AcknowledgeBridgesTx) Validate() error {
 		return getValidateBasicError(abt.msg, err)
 	}
 
-	if len(abt.msg.Events) == 0 {
-		// If there is no bridge event, return nil.
-		return nil
-	} else if abt.bridgeKeeper.GetSafetyParams(abt.ctx).IsDisabled {
-		// If there is any bridge event when bridging is disabled, return error.
+	// Group bridge events by source chain, in order of first appearance.
+	ethChainIds := make([]uint64, 0)
+	eventsByChain := make(map[uint64][]types.BridgeEvent)
+	for _, event := range abt.msg.Events {
+		if _, ok := eventsByChain[event.EthChainId]; !ok {
+			ethChainIds = append(ethChainIds, event.EthChainId)
+		}
+		eventsByChain[event.EthChainId] = append(eventsByChain[event.EthChainId], event)
+	}
+
+	for _, ethChainId := range ethChainIds {
+		if err := abt.validateSourceChainEvents(ethChainId, eventsByChain[ethChainId]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateSourceChainEvents validates the non-empty, consecutive bridge events of the source chain with
+// Ethereum chain ID `ethChainId`.
+func (abt *AcknowledgeBridgesTx) validateSourceChainEvents(ethChainId uint64, events []types.BridgeEvent) error {
+	sourceChainParams, found := abt.bridgeKeeper.GetSourceChainParams(abt.ctx, ethChainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSourceChainNotFound, "Ethereum chain ID %d", ethChainId)
+	} else if sourceChainParams.SafetyParams.IsDisabled {
+		// If there is any bridge event when bridging from the source chain is disabled, return error.
 		return types.ErrBridgingDisabled
 	}
 
 	// Validate that first bridge event ID is the one to be next acknowledged.
-	acknowledgedEventInfo := abt.bridgeKeeper.GetAcknowledgedEventInfo(abt.ctx)
-	if acknowledgedEventInfo.NextId != abt.msg.Events[0].Id {
+	acknowledgedEventInfo := abt.bridgeKeeper.GetSourceChainAcknowledgedEventInfo(abt.ctx, ethChainId)
+	if acknowledgedEventInfo.NextId != events[0].Id {
 		telemetry.IncrCounterWithLabels(
 			[]string{
 				ModuleName,
@@ -104,8 +128,8 @@ func (abt *AcknowledgeBridgesTx) Validate() error {
 	}
 
 	// Validate that last bridge event ID has been recognized.
-	recognizedEventInfo := abt.bridgeKeeper.GetRecognizedEventInfo(abt.ctx)
-	if recognizedEventInfo.NextId <= abt.msg.Events[len(abt.msg.Events)-1].Id {
+	recognizedEventInfo := abt.bridgeKeeper.GetSourceChainRecognizedEventInfo(abt.ctx, ethChainId)
+	if recognizedEventInfo.NextId <= events[len(events)-1].Id {
 		telemetry.IncrCounterWithLabels(
 			[]string{
 				ModuleName,
@@ -120,8 +144,8 @@ func (abt *AcknowledgeBridgesTx) Validate() error {
 	}
 
 	// Validate that bridge events' content is the same as in server state.
-	for _, event := range abt.msg.Events {
-		eventInState, found := abt.bridgeKeeper.GetBridgeEventFromServer(abt.ctx, event.Id)
+	for _, event := range events {
+		eventInState, found := abt.bridgeKeeper.GetBridgeEventFromServer(abt.ctx, ethChainId, event.Id)
 		if !found {
 			return types.ErrBridgeEventNotFound
 		}
@@ -68,7 +68,7 @@ func TestDecodeAcknowledgeBridgesTx(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx, k, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+			ctx, k, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
 			abt, err := process.DecodeAcknowledgeBridgesTx(ctx, k, encodingCfg.TxConfig.TxDecoder(), tc.txBytes)
 			if tc.expectedErr != nil {
 				require.ErrorContains(t, err, tc.expectedErr.Error())
@@ -90,6 +90,10 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 		bridgeEventsInServer  []types.BridgeEvent // events in bridge server that a bridge tx is validated against.
 		acknowledgedEventInfo types.BridgeEventInfo
 		recognizedEventInfo   types.BridgeEventInfo
+		// Params and event infos of the L2 source chain. The source chain does not exist if params are nil.
+		l2Params                *types.SourceChainParams
+		l2AcknowledgedEventInfo types.BridgeEventInfo
+		l2RecognizedEventInfo   types.BridgeEventInfo
 
 		// Expectations.
 		expectedErr error
@@ -201,21 +205,101 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
 			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
 		},
+		"Valid: events of multiple source chains": {
+			txBytes:                 constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1_TxBytes,
+			bridgeEventsInServer:    constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1.Events,
+			acknowledgedEventInfo:   constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:     constants.RecognizedEventInfo_Id2_Height0,
+			l2Params:                &constants.SourceChainParams_L2,
+			l2AcknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			l2RecognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+		},
+		"Error: source chain not found": {
+			txBytes:               constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1_TxBytes,
+			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1.Events,
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			expectedErr:           types.ErrSourceChainNotFound,
+		},
+		"Error: bridging from source chain disabled": {
+			txBytes:               constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1_TxBytes,
+			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1.Events,
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			l2Params: &types.SourceChainParams{
+				EventParams: constants.SourceChainParams_L2.EventParams,
+				SafetyParams: types.SafetyParams{
+					IsDisabled: true,
+				},
+			},
+			l2AcknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			l2RecognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			expectedErr:             types.ErrBridgingDisabled,
+		},
+		"Error: source chain event ID not next to be acknowledged": {
+			txBytes:               constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1_TxBytes,
+			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1.Events,
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			l2Params:              &constants.SourceChainParams_L2,
+			l2AcknowledgedEventInfo: types.BridgeEventInfo{
+				NextId:         1,
+				EthBlockHeight: 2,
+			},
+			l2RecognizedEventInfo: constants.RecognizedEventInfo_Id2_Height0,
+			expectedErr:           types.ErrBridgeIdNotNextToAcknowledge,
+		},
+		"Error: source chain event ID not recognized": {
+			txBytes:                 constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1_TxBytes,
+			bridgeEventsInServer:    constants.MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1.Events,
+			acknowledgedEventInfo:   constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:     constants.RecognizedEventInfo_Id2_Height0,
+			l2Params:                &constants.SourceChainParams_L2,
+			l2AcknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			l2RecognizedEventInfo: types.BridgeEventInfo{
+				NextId:         1,
+				EthBlockHeight: 2,
+			},
+			expectedErr: types.ErrBridgeIdNotRecognized,
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Setup.
-			ctx, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+			ctx, _, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
 			mockBridgeKeeper := &mocks.ProcessBridgeKeeper{}
-			mockBridgeKeeper.On("GetSafetyParams", ctx).Return(types.SafetyParams{
-				IsDisabled:  tc.bridgingDisabled,
-				DelayBlocks: 7, // dummy value
-			})
-			mockBridgeKeeper.On("GetAcknowledgedEventInfo", ctx).Return(tc.acknowledgedEventInfo)
-			mockBridgeKeeper.On("GetRecognizedEventInfo", ctx).Return(tc.recognizedEventInfo)
+			mockBridgeKeeper.On("GetSourceChainParams", ctx, uint64(0)).Return(
+				types.SourceChainParams{
+					SafetyParams: types.SafetyParams{
+						IsDisabled:  tc.bridgingDisabled,
+						DelayBlocks: 7, // dummy value
+					},
+				},
+				true,
+			)
+			mockBridgeKeeper.On("GetSourceChainAcknowledgedEventInfo", ctx, uint64(0)).Return(tc.acknowledgedEventInfo)
+			mockBridgeKeeper.On("GetSourceChainRecognizedEventInfo", ctx, uint64(0)).Return(tc.recognizedEventInfo)
+			if tc.l2Params != nil {
+				mockBridgeKeeper.On("GetSourceChainParams", ctx, constants.L2EthChainId).Return(*tc.l2Params, true)
+			} else {
+				mockBridgeKeeper.On("GetSourceChainParams", ctx, constants.L2EthChainId).Return(
+					types.SourceChainParams{},
+					false,
+				)
+			}
+			mockBridgeKeeper.On(
+				"GetSourceChainAcknowledgedEventInfo",
+				ctx,
+				constants.L2EthChainId,
+			).Return(tc.l2AcknowledgedEventInfo)
+			mockBridgeKeeper.On(
+				"GetSourceChainRecognizedEventInfo",
+				ctx,
+				constants.L2EthChainId,
+			).Return(tc.l2RecognizedEventInfo)
 			for _, event := range tc.bridgeEventsInServer {
-				mockBridgeKeeper.On("GetBridgeEventFromServer", ctx, event.Id).Return(event, true)
+				mockBridgeKeeper.On("GetBridgeEventFromServer", ctx, event.EthChainId, event.Id).Return(event, true)
 			}
 
 			abt, err := process.DecodeAcknowledgeBridgesTx(
@@ -256,7 +340,7 @@ func TestAcknowledgeBridgesTx_GetMsg(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			var msg sdk.Msg
 			if tc.txBytes != nil {
-				ctx, k, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+				ctx, k, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
 				abt, err := process.DecodeAcknowledgeBridgesTx(ctx, k, constants.TestEncodingCfg.TxConfig.TxDecoder(), tc.txBytes)
 				require.NoError(t, err)
 				msg = abt.GetMsg()
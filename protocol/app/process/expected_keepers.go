@@ -59,12 +59,21 @@ type ProcessPerpetualKeeper interface {
 
 // ProcessBridgeKeeper defines the expected bridge keeper used for `ProcessProposal`.
 type ProcessBridgeKeeper interface {
-	GetAcknowledgedEventInfo(
+	GetSourceChainAcknowledgedEventInfo(
 		ctx sdk.Context,
+		ethChainId uint64,
 	) (acknowledgedEventInfo bridgetypes.BridgeEventInfo)
-	GetRecognizedEventInfo(
+	GetSourceChainRecognizedEventInfo(
 		ctx sdk.Context,
+		ethChainId uint64,
 	) (recognizedEventInfo bridgetypes.BridgeEventInfo)
-	GetBridgeEventFromServer(ctx sdk.Context, id uint32) (event bridgetypes.BridgeEvent, found bool)
-	GetSafetyParams(ctx sdk.Context) (safetyParams bridgetypes.SafetyParams)
+	GetBridgeEventFromServer(
+		ctx sdk.Context,
+		ethChainId uint64,
+		id uint32,
+	) (event bridgetypes.BridgeEvent, found bool)
+	GetSourceChainParams(
+		ctx sdk.Context,
+		ethChainId uint64,
+	) (params bridgetypes.SourceChainParams, found bool)
 }
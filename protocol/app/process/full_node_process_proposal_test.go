@@ -71,7 +71,7 @@ func TestFullNodeProcessProposalHandler(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Setup.
-			_, bridgeKeeper, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+			_, bridgeKeeper, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
 
 			ctx, pricesKeeper, _, indexPriceCache, _, mockTimeProvider := keepertest.PricesKeepers(t)
 			mockTimeProvider.On("Now").Return(constants.TimeT)
@@ -268,14 +268,28 @@ func TestProcessProposalHandler_Error(t *testing.T) {
 			mockClobKeeper.On("RecordMevMetrics", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 			mockBridgeKeeper := &mocks.ProcessBridgeKeeper{}
-			mockBridgeKeeper.On("GetSafetyParams", mock.Anything).Return(bridgetypes.SafetyParams{
-				IsDisabled:  tc.bridgingDisabled,
-				DelayBlocks: 5, // dummy value, not considered by ProcessProposal.
-			})
-			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything).Return(constants.AcknowledgedEventInfo_Id0_Height0)
-			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything).Return(constants.RecognizedEventInfo_Id2_Height0)
+			mockBridgeKeeper.On("GetSourceChainParams", mock.Anything, uint64(0)).Return(
+				bridgetypes.SourceChainParams{
+					SafetyParams: bridgetypes.SafetyParams{
+						IsDisabled:  tc.bridgingDisabled,
+						DelayBlocks: 5, // dummy value, not considered by ProcessProposal.
+					},
+				},
+				true,
+			)
+			mockBridgeKeeper.On("GetSourceChainAcknowledgedEventInfo", mock.Anything, uint64(0)).Return(
+				constants.AcknowledgedEventInfo_Id0_Height0,
+			)
+			mockBridgeKeeper.On("GetSourceChainRecognizedEventInfo", mock.Anything, uint64(0)).Return(
+				constants.RecognizedEventInfo_Id2_Height0,
+			)
 			for _, bridgeEvent := range tc.bridgeEventsInServer {
-				mockBridgeKeeper.On("GetBridgeEventFromServer", mock.Anything, bridgeEvent.Id).Return(bridgeEvent, true).Once()
+				mockBridgeKeeper.On(
+					"GetBridgeEventFromServer",
+					mock.Anything,
+					bridgeEvent.EthChainId,
+					bridgeEvent.Id,
+				).Return(bridgeEvent, true).Once()
 			}
 
 			handler := process.ProcessProposalHandler(
@@ -107,7 +107,7 @@ func TestDecodeProcessProposalTxs_Error(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Setup.
-			_, bridgeKeeper, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+			_, bridgeKeeper, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
 			ctx, pricesKeeper, _, _, _, _ := keepertest.PricesKeepers(t)
 
 			// Run.
@@ -189,7 +189,7 @@ func TestDecodeProcessProposalTxs_Valid(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// Setup.
 			ctx, pricesKeeper, _, _, _, _ := keepertest.PricesKeepers(t)
-			_, bridgeKeeper, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+			_, bridgeKeeper, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
 
 			// Run.
 			ppt, err := process.DecodeProcessProposalTxs(
@@ -324,20 +324,28 @@ func TestProcessProposalTxs_Validate_Error(t *testing.T) {
 			indexPriceCache.UpdatePrices(constants.AtTimeTSingleExchangePriceUpdate)
 
 			mockBridgeKeeper := &mocks.ProcessBridgeKeeper{}
-			mockBridgeKeeper.On("GetSafetyParams", mock.Anything).Return(
-				bridgetypes.SafetyParams{
-					IsDisabled:  tc.bridgingDisabled,
-					DelayBlocks: 5, // dummy value, not considered by Validate.
+			mockBridgeKeeper.On("GetSourceChainParams", mock.Anything, uint64(0)).Return(
+				bridgetypes.SourceChainParams{
+					SafetyParams: bridgetypes.SafetyParams{
+						IsDisabled:  tc.bridgingDisabled,
+						DelayBlocks: 5, // dummy value, not considered by Validate.
+					},
 				},
+				true,
 			)
-			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything).Return(
+			mockBridgeKeeper.On("GetSourceChainAcknowledgedEventInfo", mock.Anything, uint64(0)).Return(
 				constants.AcknowledgedEventInfo_Id0_Height0,
 			)
-			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything).Return(
+			mockBridgeKeeper.On("GetSourceChainRecognizedEventInfo", mock.Anything, uint64(0)).Return(
 				constants.RecognizedEventInfo_Id2_Height0,
 			)
 			for _, bridgeEvent := range validAcknowledgeBridgesMsg.Events {
-				mockBridgeKeeper.On("GetBridgeEventFromServer", mock.Anything, bridgeEvent.Id).Return(bridgeEvent, true).Once()
+				mockBridgeKeeper.On(
+					"GetBridgeEventFromServer",
+					mock.Anything,
+					bridgeEvent.EthChainId,
+					bridgeEvent.Id,
+				).Return(bridgeEvent, true).Once()
 			}
 
 			ppt, err := process.DecodeProcessProposalTxs(
@@ -431,20 +439,28 @@ func TestProcessProposalTxs_Validate_Valid(t *testing.T) {
 			indexPriceCache.UpdatePrices(constants.AtTimeTSingleExchangePriceUpdate)
 
 			mockBridgeKeeper := &mocks.ProcessBridgeKeeper{}
-			mockBridgeKeeper.On("GetSafetyParams", mock.Anything).Return(
-				bridgetypes.SafetyParams{
-					IsDisabled:  tc.bridgingDisabled,
-					DelayBlocks: 5, // dummy value, not considered by Validate.
+			mockBridgeKeeper.On("GetSourceChainParams", mock.Anything, uint64(0)).Return(
+				bridgetypes.SourceChainParams{
+					SafetyParams: bridgetypes.SafetyParams{
+						IsDisabled:  tc.bridgingDisabled,
+						DelayBlocks: 5, // dummy value, not considered by Validate.
+					},
 				},
+				true,
 			)
-			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything).Return(
+			mockBridgeKeeper.On("GetSourceChainAcknowledgedEventInfo", mock.Anything, uint64(0)).Return(
 				constants.AcknowledgedEventInfo_Id0_Height0,
 			)
-			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything).Return(
+			mockBridgeKeeper.On("GetSourceChainRecognizedEventInfo", mock.Anything, uint64(0)).Return(
 				constants.RecognizedEventInfo_Id2_Height0,
 			)
 			for _, bridgeEvent := range validAcknowledgeBridgesMsg.Events {
-				mockBridgeKeeper.On("GetBridgeEventFromServer", mock.Anything, bridgeEvent.Id).Return(bridgeEvent, true).Once()
+				mockBridgeKeeper.On(
+					"GetBridgeEventFromServer",
+					mock.Anything,
+					bridgeEvent.EthChainId,
+					bridgeEvent.Id,
+				).Return(bridgeEvent, true).Once()
 			}
 
 			ppt, err := process.DecodeProcessProposalTxs(
@@ -18,6 +18,14 @@ func IsDisallowClobOrderMsgInOtherTxs(targetMsg sdk.Msg) bool {
 		order := msg.GetOrder()
 		orderId := order.GetOrderId()
 		return !orderId.IsStatefulOrder() // not stateful -> returns true -> disallow
+	// Batch place and cancel orders only reference Short-Term orders, so they are never allowed in
+	// the proposed blocks.
+	case *clobtypes.MsgBatchPlaceOrders, *clobtypes.MsgBatchCancel:
+		return true
+	// Short-Term order replacements are not allowed in the proposed blocks, since the replacement order
+	// is app-injected as part of MsgProposedOperation tx.
+	case *clobtypes.MsgReplaceOrder:
+		return msg.IsShortTermReplacement()
 	}
 	return false
 }
@@ -25,7 +25,12 @@ func TestIsDisallowClobOrderMsgInOtherTxs(t *testing.T) {
 	for _, msg := range allMsgSamples {
 		result := process.IsDisallowClobOrderMsgInOtherTxs(msg)
 		switch msg.(type) {
-		case *clobtypes.MsgCancelOrder, *clobtypes.MsgPlaceOrder:
+		case
+			*clobtypes.MsgCancelOrder,
+			*clobtypes.MsgPlaceOrder,
+			*clobtypes.MsgBatchPlaceOrders,
+			*clobtypes.MsgBatchCancel,
+			*clobtypes.MsgReplaceOrder:
 			// The sample msgs are short-term orders, so we expect these to be disallowed.
 			require.True(t, result) // true -> disallow
 		default:
@@ -37,6 +42,7 @@ func TestIsDisallowClobOrderMsgInOtherTxs(t *testing.T) {
 	longTermOrders := []sdk.Msg{
 		constants.Msg_PlaceOrder_LongTerm,
 		constants.Msg_CancelOrder_LongTerm,
+		constants.Msg_ReplaceOrder_LongTerm,
 	}
 	for _, msg := range longTermOrders {
 		result := process.IsDisallowClobOrderMsgInOtherTxs(msg)
@@ -2,10 +2,14 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
+	"sync"
 	"time"
 
+	gometrics "github.com/armon/go-metrics"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	appflags "github.com/dydxprotocol/v4-chain/protocol/app/flags"
@@ -78,6 +82,27 @@ func Start(
 	}
 	defer func() { ethClient.Close() }()
 
+	// Initialize a client for each additional source chain, keyed by the chain ID of its node.
+	sourceChainEthClients := make(map[uint64]types.EthClient)
+	for _, endpoint := range strings.Split(flags.Bridge.SourceChainRpcEndpoints, ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		sourceChainEthClient, err := ethclient.Dial(endpoint)
+		if err != nil {
+			logger.Error("Failed to establish connection to source chain node", "endpoint", endpoint, "error", err)
+			return err
+		}
+		defer sourceChainEthClient.Close()
+		chainId, err := sourceChainEthClient.ChainID(ctx)
+		if err != nil {
+			logger.Error("Failed to fetch chain ID of source chain node", "endpoint", endpoint, "error", err)
+			return err
+		}
+		sourceChainEthClients[chainId.Uint64()] = sourceChainEthClient
+	}
+
 	// Run the main task loop at an interval.
 	ticker := time.NewTicker(time.Duration(flags.Bridge.LoopDelayMs) * time.Millisecond)
 	for ; true; <-ticker.C {
@@ -85,6 +110,7 @@ func Start(
 			ctx,
 			logger,
 			ethClient,
+			sourceChainEthClients,
 			queryClient,
 			serviceClient,
 		); err != nil {
@@ -98,12 +124,13 @@ func Start(
 
 // RunBridgeDaemonTaskLoop does the following:
 // 1) Fetches configuration information by querying the gRPC server.
-// 2) Fetches Ethereum events from a configured Ethereum client.
+// 2) Fetches Ethereum events of the primary chain and of each additional source chain in parallel.
 // 3) Sends newly-recognized bridge events to the gRPC server.
 func RunBridgeDaemonTaskLoop(
 	ctx context.Context,
 	logger log.Logger,
 	ethClient types.EthClient,
+	sourceChainEthClients map[uint64]types.EthClient,
 	queryClient bridgetypes.QueryClient,
 	serviceClient api.BridgeServiceClient,
 ) error {
@@ -120,9 +147,8 @@ func RunBridgeDaemonTaskLoop(
 	//   - EthAddress: Address of the bridge contract to query events from.
 	// - ProposeParams
 	//   - MaxBridgesPerBlock: Number of bridge events to query for.
-	// - RecognizedEventInfo
-	//   - EthBlockHeight: Ethereum block height from which to start querying events.
-	//   - NextId: Next bridge event ID to query for.
+	// - SourceChainParams
+	//   - EventParams of each additional source chain.
 	eventParams, err := queryClient.EventParams(ctx, &bridgetypes.QueryEventParamsRequest{})
 	if err != nil {
 		return fmt.Errorf("failed to fetch event params: %w", err)
@@ -131,7 +157,63 @@ func RunBridgeDaemonTaskLoop(
 	if err != nil {
 		return fmt.Errorf("failed to fetch propose params: %w", err)
 	}
-	recognizedEventInfo, err := queryClient.RecognizedEventInfo(ctx, &bridgetypes.QueryRecognizedEventInfoRequest{})
+	sourceChainParams, err := queryClient.SourceChainParams(ctx, &bridgetypes.QuerySourceChainParamsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch source chain params: %w", err)
+	}
+
+	// Fetch events of each source chain in parallel. Events of the primary chain are keyed by
+	// source chain ID 0 while events of additional source chains are keyed by their chain ID.
+	var wg sync.WaitGroup
+	errs := make([]error, len(sourceChainParams.Params)+1)
+	runTask := func(i int, ethChainId uint64, client types.EthClient, params bridgetypes.EventParams) {
+		defer wg.Done()
+		errs[i] = runSourceChainTask(
+			ctx,
+			ethChainId,
+			client,
+			params,
+			proposeParams.Params.MaxBridgesPerBlock,
+			queryClient,
+			serviceClient,
+		)
+	}
+
+	wg.Add(1)
+	go runTask(0, 0, ethClient, eventParams.Params)
+	for i, params := range sourceChainParams.Params {
+		ethChainId := params.EventParams.EthChainId
+		sourceChainEthClient, exists := sourceChainEthClients[ethChainId]
+		if !exists {
+			errs[i+1] = fmt.Errorf("no rpc endpoint configured for source chain %d", ethChainId)
+			continue
+		}
+		wg.Add(1)
+		go runTask(i+1, ethChainId, sourceChainEthClient, params.EventParams)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// runSourceChainTask fetches bridge events of a single source chain, starting from the source
+// chain's `RecognizedEventInfo`, and sends them to the bridge server.
+func runSourceChainTask(
+	ctx context.Context,
+	ethChainId uint64,
+	ethClient types.EthClient,
+	eventParams bridgetypes.EventParams,
+	maxBridgesPerBlock uint32,
+	queryClient bridgetypes.QueryClient,
+	serviceClient api.BridgeServiceClient,
+) error {
+	// Fetch RecognizedEventInfo of the source chain. Relevant fields are:
+	// - EthBlockHeight: Ethereum block height from which to start querying events.
+	// - NextId: Next bridge event ID to query for.
+	recognizedEventInfo, err := queryClient.RecognizedEventInfo(
+		ctx,
+		&bridgetypes.QueryRecognizedEventInfoRequest{EthChainId: ethChainId},
+	)
 	if err != nil {
 		return fmt.Errorf("failed to fetch recognized event info: %w", err)
 	}
@@ -141,36 +223,42 @@ func RunBridgeDaemonTaskLoop(
 	if err != nil {
 		return fmt.Errorf("failed to fetch chain ID: %w", err)
 	}
-	if chainId.Uint64() != eventParams.Params.EthChainId {
+	if chainId.Uint64() != eventParams.EthChainId {
 		return fmt.Errorf(
 			"expected chain ID %d but node has chain ID %d",
-			eventParams.Params.EthChainId,
+			eventParams.EthChainId,
 			chainId,
 		)
 	}
 
 	// Fetch logs from Ethereum Node.
 	filterQuery := getFilterQuery(
-		eventParams.Params.EthAddress,
+		eventParams.EthAddress,
 		recognizedEventInfo.Info.EthBlockHeight,
 		recognizedEventInfo.Info.NextId,
-		proposeParams.Params.MaxBridgesPerBlock,
+		maxBridgesPerBlock,
 	)
 	logs, err := ethClient.FilterLogs(ctx, filterQuery)
 	if err != nil {
 		return fmt.Errorf("failed to fetch logs: %w", err)
 	}
-	telemetry.IncrCounter(
+	telemetry.IncrCounterWithLabels(
+		[]string{
+			metrics.BridgeDaemon,
+			metrics.NewEthLogs,
+			metrics.Count,
+		},
 		float32(len(logs)),
-		metrics.BridgeDaemon,
-		metrics.NewEthLogs,
-		metrics.Count,
+		[]gometrics.Label{
+			metrics.GetLabelForIntValue(metrics.EthChainId, int(eventParams.EthChainId)),
+		},
 	)
 
 	// Parse logs into bridge events.
 	newBridgeEvents := make([]bridgetypes.BridgeEvent, len(logs))
 	for i, log := range logs {
-		newBridgeEvents[i] = libeth.BridgeLogToEvent(log, eventParams.Params.Denom)
+		newBridgeEvents[i] = libeth.BridgeLogToEvent(log, eventParams.Denom)
+		newBridgeEvents[i].EthChainId = ethChainId
 	}
 
 	// Send bridge events to bridge server.
@@ -8,7 +8,9 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	appflags "github.com/dydxprotocol/v4-chain/protocol/app/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/api"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/client"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/client/types"
 	d_constants "github.com/dydxprotocol/v4-chain/protocol/daemons/constants"
 	daemonflags "github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
@@ -102,6 +104,7 @@ func TestStart_UnixSocketConnectionFails(t *testing.T) {
 func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 	errParams := errors.New("error getting event params")
 	errPropose := errors.New("error getting propose params")
+	errSourceChainParams := errors.New("error getting source chain params")
 	errRecognizedEventInfo := errors.New("error getting recognized event info")
 	errChainId := errors.New("error getting chain id")
 	errEthereumLogs := errors.New("error getting Ethereum logs")
@@ -112,6 +115,8 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 		eventParamsErr         error
 		proposeParams          bridgetypes.ProposeParams
 		proposeParamsErr       error
+		sourceChainParams      []bridgetypes.SourceChainParams
+		sourceChainParamsErr   error
 		recognizedEventInfo    bridgetypes.BridgeEventInfo
 		recognizedEventInfoErr error
 		chainId                int
@@ -119,9 +124,15 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 		filterLogs             []ethcoretypes.Log
 		filterLogsErr          error
 		addBridgeEventsErr     error
+		// Chain ID of the node of the L2 source chain. No client is configured for the L2 source chain if 0.
+		l2ChainId     int
+		l2FilterLogs  []ethcoretypes.Log
+		l2FilterLogsE error
 
 		expectedErrorString string
-		expectedError       error
+		// Expected number of events sent to bridge server per source chain.
+		expectedNumEvents map[uint64]int
+		expectedError     error
 	}{
 		"Success": {
 			eventParams:         constants.EventParams,
@@ -132,6 +143,23 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 				constants.EthLog_Event0,
 				constants.EthLog_Event1,
 			},
+			expectedNumEvents: map[uint64]int{0: 2},
+		},
+		"Success: multiple source chains": {
+			eventParams:         constants.EventParams,
+			proposeParams:       constants.ProposeParams,
+			sourceChainParams:   []bridgetypes.SourceChainParams{constants.SourceChainParams_L2},
+			recognizedEventInfo: constants.RecognizedEventInfo_Id2_Height0,
+			chainId:             constants.EthChainId,
+			filterLogs: []ethcoretypes.Log{
+				constants.EthLog_Event0,
+			},
+			l2ChainId: int(constants.L2EthChainId),
+			l2FilterLogs: []ethcoretypes.Log{
+				constants.EthLog_Event1,
+				constants.EthLog_Event2,
+			},
+			expectedNumEvents: map[uint64]int{0: 1, constants.L2EthChainId: 2},
 		},
 		"Error getting event params": {
 			eventParamsErr: errParams,
@@ -142,6 +170,12 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 			proposeParamsErr: errPropose,
 			expectedError:    errPropose,
 		},
+		"Error getting source chain params": {
+			eventParams:          constants.EventParams,
+			proposeParams:        constants.ProposeParams,
+			sourceChainParamsErr: errSourceChainParams,
+			expectedError:        errSourceChainParams,
+		},
 		"Error getting recognized event info": {
 			eventParams:            constants.EventParams,
 			proposeParams:          constants.ProposeParams,
@@ -184,6 +218,49 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 			},
 			addBridgeEventsErr: errAddBridgeEvents,
 			expectedError:      errAddBridgeEvents,
+			expectedNumEvents:  map[uint64]int{0: 1},
+		},
+		"Error no rpc endpoint configured for source chain, primary chain still succeeds": {
+			eventParams:         constants.EventParams,
+			proposeParams:       constants.ProposeParams,
+			sourceChainParams:   []bridgetypes.SourceChainParams{constants.SourceChainParams_L2},
+			recognizedEventInfo: constants.RecognizedEventInfo_Id2_Height0,
+			chainId:             constants.EthChainId,
+			filterLogs: []ethcoretypes.Log{
+				constants.EthLog_Event0,
+			},
+			expectedErrorString: fmt.Sprintf(
+				"no rpc endpoint configured for source chain %d",
+				constants.L2EthChainId,
+			),
+			expectedNumEvents: map[uint64]int{0: 1},
+		},
+		"Error getting Ethereum logs of source chain, primary chain still succeeds": {
+			eventParams:         constants.EventParams,
+			proposeParams:       constants.ProposeParams,
+			sourceChainParams:   []bridgetypes.SourceChainParams{constants.SourceChainParams_L2},
+			recognizedEventInfo: constants.RecognizedEventInfo_Id2_Height0,
+			chainId:             constants.EthChainId,
+			filterLogs: []ethcoretypes.Log{
+				constants.EthLog_Event0,
+			},
+			l2ChainId:         int(constants.L2EthChainId),
+			l2FilterLogsE:     errEthereumLogs,
+			expectedError:     errEthereumLogs,
+			expectedNumEvents: map[uint64]int{0: 1},
+		},
+		"Error source chain ID not as expected": {
+			eventParams:         constants.EventParams,
+			proposeParams:       constants.ProposeParams,
+			sourceChainParams:   []bridgetypes.SourceChainParams{constants.SourceChainParams_L2},
+			recognizedEventInfo: constants.RecognizedEventInfo_Id2_Height0,
+			chainId:             constants.EthChainId,
+			l2ChainId:           int(constants.EthChainId),
+			expectedErrorString: fmt.Sprintf(
+				"expected chain ID %d but node has chain ID %d",
+				constants.L2EthChainId,
+				constants.EthChainId,
+			),
 		},
 	}
 
@@ -192,6 +269,7 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 			ctx := grpc.Ctx
 			mockLogger := mocks.Logger{}
 			mockEthClient := mocks.EthClient{}
+			mockL2EthClient := mocks.EthClient{}
 			mockQueryClient := mocks.BridgeQueryClient{}
 			mockServiceClient := mocks.BridgeServiceClient{}
 
@@ -207,6 +285,12 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 				},
 				tc.proposeParamsErr,
 			)
+			mockQueryClient.On("SourceChainParams", ctx, mock.Anything).Return(
+				&bridgetypes.QuerySourceChainParamsResponse{
+					Params: tc.sourceChainParams,
+				},
+				tc.sourceChainParamsErr,
+			)
 			mockQueryClient.On("RecognizedEventInfo", ctx, mock.Anything).Return(
 				&bridgetypes.QueryRecognizedEventInfoResponse{
 					Info: tc.recognizedEventInfo,
@@ -215,12 +299,20 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 			)
 			mockEthClient.On("ChainID", ctx).Return(big.NewInt(int64(tc.chainId)), tc.chainIdError)
 			mockEthClient.On("FilterLogs", ctx, mock.Anything).Return(tc.filterLogs, tc.filterLogsErr)
+			mockL2EthClient.On("ChainID", ctx).Return(big.NewInt(int64(tc.l2ChainId)), nil)
+			mockL2EthClient.On("FilterLogs", ctx, mock.Anything).Return(tc.l2FilterLogs, tc.l2FilterLogsE)
 			mockServiceClient.On("AddBridgeEvents", ctx, mock.Anything).Return(nil, tc.addBridgeEventsErr)
 
+			sourceChainEthClients := map[uint64]types.EthClient{}
+			if tc.l2ChainId != 0 {
+				sourceChainEthClients[constants.L2EthChainId] = &mockL2EthClient
+			}
+
 			err := client.RunBridgeDaemonTaskLoop(
 				grpc.Ctx,
 				&mockLogger,
 				&mockEthClient,
+				sourceChainEthClients,
 				&mockQueryClient,
 				&mockServiceClient,
 			)
@@ -232,6 +324,22 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 				require.Error(t, err)
 				require.ErrorIs(t, err, tc.expectedError)
 			}
+			if tc.expectedErrorString == "" && tc.expectedError == nil {
+				require.NoError(t, err)
+			}
+
+			// Verify that events of each source chain are sent to bridge server with their source chain ID.
+			numEvents := map[uint64]int{}
+			for _, call := range mockServiceClient.Calls {
+				request := call.Arguments.Get(1).(*api.AddBridgeEventsRequest)
+				for _, event := range request.BridgeEvents {
+					numEvents[event.EthChainId]++
+				}
+			}
+			if tc.expectedNumEvents == nil {
+				tc.expectedNumEvents = map[uint64]int{}
+			}
+			require.Equal(t, tc.expectedNumEvents, numEvents)
 		})
 	}
 }
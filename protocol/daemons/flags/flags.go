@@ -14,14 +14,20 @@ const (
 	FlagPriceDaemonEnabled     = "price-daemon-enabled"
 	FlagPriceDaemonLoopDelayMs = "price-daemon-loop-delay-ms"
 
+	FlagPriceDaemonJsonPathExchangesConfig = "price-daemon-json-path-exchanges-config"
+	FlagPriceDaemonStreamingEnabled        = "price-daemon-streaming-enabled"
+
 	FlagBridgeDaemonEnabled        = "bridge-daemon-enabled"
 	FlagBridgeDaemonLoopDelayMs    = "bridge-daemon-loop-delay-ms"
 	FlagBridgeDaemonEthRpcEndpoint = "bridge-daemon-eth-rpc-endpoint"
 
+	FlagBridgeDaemonSourceChainRpcEndpoints = "bridge-daemon-source-chain-rpc-endpoints"
+
 	FlagLiquidationDaemonEnabled             = "liquidation-daemon-enabled"
 	FlagLiquidationDaemonLoopDelayMs         = "liquidation-daemon-loop-delay-ms"
 	FlagLiquidationDaemonSubaccountPageLimit = "liquidation-daemon-subaccount-page-limit"
 	FlagLiquidationDaemonRequestChunkSize    = "liquidation-daemon-request-chunk-size"
+	FlagLiquidationDaemonFullCheckInterval   = "liquidation-daemon-full-check-interval"
 )
 
 // Shared flags contains configuration flags shared by all daemons.
@@ -38,6 +44,9 @@ type BridgeFlags struct {
 	LoopDelayMs uint32
 	// EthRpcEndpoint is the endpoint for the Ethereum node where bridge data is queried.
 	EthRpcEndpoint string
+	// SourceChainRpcEndpoints is a comma-separated list of endpoints for the nodes of additional source
+	// chains where bridge data is queried. Each endpoint is matched to a source chain by its chain ID.
+	SourceChainRpcEndpoints string
 }
 
 // LiquidationFlags contains configuration flags for the Liquidation Daemon.
@@ -49,6 +58,9 @@ type LiquidationFlags struct {
 	// SubaccountPageLimit configures the pagination limit for fetching subaccounts.
 	SubaccountPageLimit uint64
 	RequestChunkSize    uint64
+	// FullCheckInterval configures the number of task loops after which all subaccounts with open
+	// positions are checked, regardless of whether they changed. Disabled if 0.
+	FullCheckInterval uint32
 }
 
 // PriceFlags contains configuration flags for the Price Daemon.
@@ -57,6 +69,12 @@ type PriceFlags struct {
 	Enabled bool
 	// LoopDelayMs configures the update frequency of the price daemon.
 	LoopDelayMs uint32
+	// JsonPathExchangesConfig is the path to a json file configuring additional exchanges that are queried
+	// by the generic json path adapter. No additional exchanges are queried if empty.
+	JsonPathExchangesConfig string
+	// StreamingEnabled toggles streaming prices over websockets from exchanges that support it. If
+	// disabled, all markets are queried over REST.
+	StreamingEnabled bool
 }
 
 // DaemonFlags contains the collected configuration flags for all daemons.
@@ -77,19 +95,23 @@ func GetDefaultDaemonFlags() DaemonFlags {
 				SocketAddress: "/tmp/daemons.sock",
 			},
 			Bridge: BridgeFlags{
-				Enabled:        true,
-				LoopDelayMs:    30_000,
-				EthRpcEndpoint: "",
+				Enabled:                 true,
+				LoopDelayMs:             30_000,
+				EthRpcEndpoint:          "",
+				SourceChainRpcEndpoints: "",
 			},
 			Liquidation: LiquidationFlags{
 				Enabled:             true,
 				LoopDelayMs:         1_600,
 				SubaccountPageLimit: 1_000,
 				RequestChunkSize:    50,
+				FullCheckInterval:   150,
 			},
 			Price: PriceFlags{
-				Enabled:     true,
-				LoopDelayMs: 3_000,
+				Enabled:                 true,
+				LoopDelayMs:             3_000,
+				JsonPathExchangesConfig: "",
+				StreamingEnabled:        false,
 			},
 		}
 	}
@@ -129,6 +151,11 @@ func AddDaemonFlagsToCmd(
 		df.Bridge.EthRpcEndpoint,
 		"Ethereum Node Rpc Endpoint",
 	)
+	cmd.Flags().String(
+		FlagBridgeDaemonSourceChainRpcEndpoints,
+		df.Bridge.SourceChainRpcEndpoints,
+		"Comma-separated list of Rpc Endpoints for nodes of additional bridge source chains",
+	)
 
 	// Liquidation Daemon.
 	cmd.Flags().Bool(
@@ -151,6 +178,12 @@ func AddDaemonFlagsToCmd(
 		df.Liquidation.RequestChunkSize,
 		"Limit on the number of subaccounts per collateralization check in the Liquidation Daemon task loop.",
 	)
+	cmd.Flags().Uint32(
+		FlagLiquidationDaemonFullCheckInterval,
+		df.Liquidation.FullCheckInterval,
+		"Number of Liquidation Daemon task loops after which all subaccounts with open positions are checked. "+
+			"Disabled if 0.",
+	)
 
 	// Price Daemon.
 	cmd.Flags().Bool(
@@ -163,6 +196,16 @@ func AddDaemonFlagsToCmd(
 		df.Price.LoopDelayMs,
 		"Delay in milliseconds between sending price updates to the application.",
 	)
+	cmd.Flags().String(
+		FlagPriceDaemonJsonPathExchangesConfig,
+		df.Price.JsonPathExchangesConfig,
+		"Path to a json file configuring additional exchanges for the Price Daemon to query.",
+	)
+	cmd.Flags().Bool(
+		FlagPriceDaemonStreamingEnabled,
+		df.Price.StreamingEnabled,
+		"Enable streaming prices over websockets from exchanges that support it in the Price Daemon.",
+	)
 }
 
 // GetDaemonFlagValuesFromOptions gets all daemon flag values from the `AppOptions` struct.
@@ -195,6 +238,11 @@ func GetDaemonFlagValuesFromOptions(
 			result.Bridge.EthRpcEndpoint = v
 		}
 	}
+	if option := appOpts.Get(FlagBridgeDaemonSourceChainRpcEndpoints); option != nil {
+		if v, err := cast.ToStringE(option); err == nil {
+			result.Bridge.SourceChainRpcEndpoints = v
+		}
+	}
 
 	// Liquidation Daemon.
 	if option := appOpts.Get(FlagLiquidationDaemonEnabled); option != nil {
@@ -217,6 +265,11 @@ func GetDaemonFlagValuesFromOptions(
 			result.Liquidation.RequestChunkSize = v
 		}
 	}
+	if option := appOpts.Get(FlagLiquidationDaemonFullCheckInterval); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Liquidation.FullCheckInterval = v
+		}
+	}
 
 	// Price Daemon.
 	if option := appOpts.Get(FlagPriceDaemonEnabled); option != nil {
@@ -229,6 +282,16 @@ func GetDaemonFlagValuesFromOptions(
 			result.Price.LoopDelayMs = v
 		}
 	}
+	if option := appOpts.Get(FlagPriceDaemonJsonPathExchangesConfig); option != nil {
+		if v, err := cast.ToStringE(option); err == nil {
+			result.Price.JsonPathExchangesConfig = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonStreamingEnabled); option != nil {
+		if v, err := cast.ToBoolE(option); err == nil {
+			result.Price.StreamingEnabled = v
+		}
+	}
 
 	return result
 }
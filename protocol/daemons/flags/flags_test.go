@@ -20,13 +20,17 @@ func TestAddDaemonFlagsToCmd(t *testing.T) {
 
 		flags.FlagBridgeDaemonEnabled,
 		flags.FlagBridgeDaemonLoopDelayMs,
+		flags.FlagBridgeDaemonSourceChainRpcEndpoints,
 
 		flags.FlagLiquidationDaemonEnabled,
 		flags.FlagLiquidationDaemonLoopDelayMs,
 		flags.FlagLiquidationDaemonSubaccountPageLimit,
+		flags.FlagLiquidationDaemonFullCheckInterval,
 
 		flags.FlagPriceDaemonEnabled,
 		flags.FlagPriceDaemonLoopDelayMs,
+		flags.FlagPriceDaemonJsonPathExchangesConfig,
+		flags.FlagPriceDaemonStreamingEnabled,
 	}
 
 	for _, v := range tests {
@@ -45,14 +49,18 @@ func TestGetDaemonFlagValuesFromOptions_Custom(t *testing.T) {
 	optsMap[flags.FlagBridgeDaemonEnabled] = true
 	optsMap[flags.FlagBridgeDaemonLoopDelayMs] = uint32(1111)
 	optsMap[flags.FlagBridgeDaemonEthRpcEndpoint] = "test-eth-rpc-endpoint"
+	optsMap[flags.FlagBridgeDaemonSourceChainRpcEndpoints] = "test-rpc-endpoint-1,test-rpc-endpoint-2"
 
 	optsMap[flags.FlagLiquidationDaemonEnabled] = true
 	optsMap[flags.FlagLiquidationDaemonLoopDelayMs] = uint32(2222)
 	optsMap[flags.FlagLiquidationDaemonSubaccountPageLimit] = uint64(3333)
 	optsMap[flags.FlagLiquidationDaemonRequestChunkSize] = uint64(4444)
+	optsMap[flags.FlagLiquidationDaemonFullCheckInterval] = uint32(5555)
 
 	optsMap[flags.FlagPriceDaemonEnabled] = true
 	optsMap[flags.FlagPriceDaemonLoopDelayMs] = uint32(4444)
+	optsMap[flags.FlagPriceDaemonJsonPathExchangesConfig] = "test-json-path-exchanges-config"
+	optsMap[flags.FlagPriceDaemonStreamingEnabled] = true
 
 	mockOpts := mocks.AppOptions{}
 	mockOpts.On("Get", mock.Anything).
@@ -69,16 +77,24 @@ func TestGetDaemonFlagValuesFromOptions_Custom(t *testing.T) {
 	require.Equal(t, optsMap[flags.FlagBridgeDaemonEnabled], r.Bridge.Enabled)
 	require.Equal(t, optsMap[flags.FlagBridgeDaemonLoopDelayMs], r.Bridge.LoopDelayMs)
 	require.Equal(t, optsMap[flags.FlagBridgeDaemonEthRpcEndpoint], r.Bridge.EthRpcEndpoint)
+	require.Equal(
+		t,
+		optsMap[flags.FlagBridgeDaemonSourceChainRpcEndpoints],
+		r.Bridge.SourceChainRpcEndpoints,
+	)
 
 	// Liquidation Daemon.
 	require.Equal(t, optsMap[flags.FlagLiquidationDaemonEnabled], r.Liquidation.Enabled)
 	require.Equal(t, optsMap[flags.FlagLiquidationDaemonLoopDelayMs], r.Liquidation.LoopDelayMs)
 	require.Equal(t, optsMap[flags.FlagLiquidationDaemonSubaccountPageLimit], r.Liquidation.SubaccountPageLimit)
 	require.Equal(t, optsMap[flags.FlagLiquidationDaemonRequestChunkSize], r.Liquidation.RequestChunkSize)
+	require.Equal(t, optsMap[flags.FlagLiquidationDaemonFullCheckInterval], r.Liquidation.FullCheckInterval)
 
 	// Price Daemon.
 	require.Equal(t, optsMap[flags.FlagPriceDaemonEnabled], r.Price.Enabled)
 	require.Equal(t, optsMap[flags.FlagPriceDaemonLoopDelayMs], r.Price.LoopDelayMs)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonJsonPathExchangesConfig], r.Price.JsonPathExchangesConfig)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonStreamingEnabled], r.Price.StreamingEnabled)
 }
 
 func TestGetDaemonFlagValuesFromOptions_Defaul(t *testing.T) {
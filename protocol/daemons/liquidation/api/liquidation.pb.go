@@ -35,6 +35,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // subaccount ids against current state before liquidating their positions.
 type LiquidateSubaccountsRequest struct {
 	SubaccountIds []types.SubaccountId `protobuf:"bytes,1,rep,name=subaccount_ids,json=subaccountIds,proto3" json:"subaccount_ids"`
+	// The ids of subaccounts with negative total net collateral that potentially
+	// need to be deleveraged. The application should re-verify these subaccount
+	// ids against current state before deleveraging their positions.
+	NegativeTncSubaccountIds []types.SubaccountId `protobuf:"bytes,2,rep,name=negative_tnc_subaccount_ids,json=negativeTncSubaccountIds,proto3" json:"negative_tnc_subaccount_ids"`
 }
 
 func (m *LiquidateSubaccountsRequest) Reset()         { *m = LiquidateSubaccountsRequest{} }
@@ -77,6 +81,13 @@ func (m *LiquidateSubaccountsRequest) GetSubaccountIds() []types.SubaccountId {
 	return nil
 }
 
+func (m *LiquidateSubaccountsRequest) GetNegativeTncSubaccountIds() []types.SubaccountId {
+	if m != nil {
+		return m.NegativeTncSubaccountIds
+	}
+	return nil
+}
+
 // LiquidateSubaccountsResponse is a response message for
 // LiquidateSubaccountsRequest.
 type LiquidateSubaccountsResponse struct {
@@ -115,9 +126,126 @@ func (m *LiquidateSubaccountsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidateSubaccountsResponse proto.InternalMessageInfo
 
+// GetUpdatedSubaccountIdsRequest is a request message for the ids of
+// subaccounts updated after a sequence number. The sequence number increments
+// once per committed block.
+type GetUpdatedSubaccountIdsRequest struct {
+	// The sequence number of the last update seen by the caller.
+	SinceSequence uint64 `protobuf:"varint,1,opt,name=since_sequence,json=sinceSequence,proto3" json:"since_sequence,omitempty"`
+}
+
+func (m *GetUpdatedSubaccountIdsRequest) Reset()         { *m = GetUpdatedSubaccountIdsRequest{} }
+func (m *GetUpdatedSubaccountIdsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUpdatedSubaccountIdsRequest) ProtoMessage()    {}
+func (*GetUpdatedSubaccountIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66068592911cfa5a, []int{2}
+}
+func (m *GetUpdatedSubaccountIdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUpdatedSubaccountIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUpdatedSubaccountIdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetUpdatedSubaccountIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUpdatedSubaccountIdsRequest.Merge(m, src)
+}
+func (m *GetUpdatedSubaccountIdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUpdatedSubaccountIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUpdatedSubaccountIdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUpdatedSubaccountIdsRequest proto.InternalMessageInfo
+
+func (m *GetUpdatedSubaccountIdsRequest) GetSinceSequence() uint64 {
+	if m != nil {
+		return m.SinceSequence
+	}
+	return 0
+}
+
+// GetUpdatedSubaccountIdsResponse is a response message that contains the ids
+// of subaccounts updated after the requested sequence number.
+type GetUpdatedSubaccountIdsResponse struct {
+	// The ids of subaccounts updated after the requested sequence number. The
+	// list does not contain duplicates.
+	SubaccountIds []types.SubaccountId `protobuf:"bytes,1,rep,name=subaccount_ids,json=subaccountIds,proto3" json:"subaccount_ids"`
+	// The sequence number of the latest committed block.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Whether updates after the requested sequence number are no longer
+	// retained, in which case the caller should re-read all subaccounts.
+	RequiresFullSync bool `protobuf:"varint,3,opt,name=requires_full_sync,json=requiresFullSync,proto3" json:"requires_full_sync,omitempty"`
+}
+
+func (m *GetUpdatedSubaccountIdsResponse) Reset()         { *m = GetUpdatedSubaccountIdsResponse{} }
+func (m *GetUpdatedSubaccountIdsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUpdatedSubaccountIdsResponse) ProtoMessage()    {}
+func (*GetUpdatedSubaccountIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66068592911cfa5a, []int{3}
+}
+func (m *GetUpdatedSubaccountIdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUpdatedSubaccountIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUpdatedSubaccountIdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetUpdatedSubaccountIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUpdatedSubaccountIdsResponse.Merge(m, src)
+}
+func (m *GetUpdatedSubaccountIdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUpdatedSubaccountIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUpdatedSubaccountIdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUpdatedSubaccountIdsResponse proto.InternalMessageInfo
+
+func (m *GetUpdatedSubaccountIdsResponse) GetSubaccountIds() []types.SubaccountId {
+	if m != nil {
+		return m.SubaccountIds
+	}
+	return nil
+}
+
+func (m *GetUpdatedSubaccountIdsResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *GetUpdatedSubaccountIdsResponse) GetRequiresFullSync() bool {
+	if m != nil {
+		return m.RequiresFullSync
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LiquidateSubaccountsRequest)(nil), "dydxprotocol.daemons.liquidation.LiquidateSubaccountsRequest")
 	proto.RegisterType((*LiquidateSubaccountsResponse)(nil), "dydxprotocol.daemons.liquidation.LiquidateSubaccountsResponse")
+	proto.RegisterType((*GetUpdatedSubaccountIdsRequest)(nil), "dydxprotocol.daemons.liquidation.GetUpdatedSubaccountIdsRequest")
+	proto.RegisterType((*GetUpdatedSubaccountIdsResponse)(nil), "dydxprotocol.daemons.liquidation.GetUpdatedSubaccountIdsResponse")
 }
 
 func init() {
@@ -125,25 +253,34 @@ func init() {
 }
 
 var fileDescriptor_66068592911cfa5a = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4a, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x49, 0x4c, 0xcd, 0xcd, 0xcf, 0x2b,
-	0xd6, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x43, 0x66, 0xeb, 0x81,
-	0x15, 0x0a, 0x29, 0x20, 0xeb, 0xd1, 0x83, 0xea, 0xd1, 0x43, 0x52, 0x27, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa1, 0x0f, 0x62, 0x41, 0xf4, 0x49, 0x69, 0xa2, 0xd8, 0x55, 0x5c, 0x9a, 0x94,
-	0x98, 0x9c, 0x9c, 0x5f, 0x9a, 0x57, 0x52, 0x8c, 0xc4, 0x86, 0x28, 0x55, 0x2a, 0xe2, 0x92, 0xf6,
-	0x81, 0x9a, 0x97, 0x1a, 0x8c, 0x50, 0x18, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0x22, 0x14, 0xcc,
-	0xc5, 0x87, 0xd0, 0x12, 0x9f, 0x99, 0x52, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa6,
-	0x87, 0xe2, 0x34, 0x24, 0x2b, 0xf4, 0x10, 0xa6, 0x78, 0xa6, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf,
-	0x10, 0xc4, 0x5b, 0x8c, 0x24, 0x56, 0xac, 0x24, 0xc7, 0x25, 0x83, 0xdd, 0xce, 0xe2, 0x82, 0xfc,
-	0xbc, 0xe2, 0x54, 0xa3, 0x35, 0x8c, 0x5c, 0x42, 0x3e, 0x08, 0x4f, 0x06, 0xa7, 0x16, 0x95, 0x65,
-	0x26, 0xa7, 0x0a, 0x4d, 0x65, 0xe4, 0x12, 0xc1, 0xa6, 0x4f, 0xc8, 0x56, 0x8f, 0x50, 0x38, 0xe9,
-	0xe1, 0xf1, 0xa3, 0x94, 0x1d, 0xb9, 0xda, 0x21, 0xce, 0x75, 0x8a, 0x3e, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc7, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
-	0x5c, 0x7d, 0x94, 0x28, 0x29, 0x33, 0xd1, 0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xc7, 0x9b, 0x20,
-	0x12, 0x0b, 0x32, 0x93, 0xd8, 0xc0, 0x2a, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x71,
-	0x5f, 0x4e, 0x3f, 0x02, 0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xcf, 0x6c, 0x8b, 0x94, 0x91, 0x16, 0x19, 0x0a, 0x86, 0x54, 0xa6, 0x21, 0xa0, 0xac, 0xa0,
+	0x13, 0x58, 0xbd, 0x2a, 0xb6, 0x07, 0x8b, 0xd0, 0x53, 0xa2, 0x17, 0x3d, 0x84, 0xec, 0x64, 0x4c,
+	0x07, 0xd3, 0x99, 0x6c, 0x66, 0x66, 0x71, 0xbf, 0x85, 0x17, 0xaf, 0x7e, 0x16, 0x8f, 0xbd, 0x08,
+	0x3d, 0x7a, 0x52, 0xd9, 0xfd, 0x22, 0xb2, 0x49, 0xb6, 0x49, 0x64, 0xff, 0xc0, 0x4a, 0x6f, 0x6f,
+	0xdf, 0xfb, 0xfd, 0x79, 0xfb, 0xcb, 0x4b, 0xe0, 0x20, 0x99, 0x24, 0x9f, 0xf3, 0x42, 0x6a, 0x49,
+	0x65, 0xe6, 0x27, 0x31, 0xbb, 0x94, 0x42, 0xf9, 0x19, 0x1f, 0x19, 0x9e, 0xc4, 0x9a, 0x4b, 0xd1,
+	0xae, 0x49, 0x09, 0x44, 0x6e, 0x9b, 0x43, 0x6a, 0x0e, 0x69, 0xe1, 0x9c, 0xc3, 0x54, 0xa6, 0xb2,
+	0x44, 0xf8, 0xf3, 0xaa, 0xe2, 0x39, 0x8f, 0x3b, 0x5e, 0xca, 0x0c, 0x63, 0x4a, 0xa5, 0x11, 0x5a,
+	0xb5, 0xea, 0x0a, 0xea, 0xfd, 0x06, 0xf0, 0xe8, 0xbc, 0x16, 0x64, 0x61, 0x83, 0x0c, 0xd8, 0xc8,
+	0x30, 0xa5, 0x51, 0x08, 0x0f, 0x1a, 0x4e, 0xc4, 0x13, 0x65, 0x03, 0x77, 0xa7, 0x7f, 0x77, 0xf0,
+	0x88, 0x74, 0x76, 0x6b, 0x79, 0x90, 0x46, 0xe5, 0x4d, 0x72, 0xba, 0x7b, 0xf5, 0xeb, 0xd8, 0x0a,
+	0xf6, 0x55, 0xab, 0xa7, 0xd0, 0x27, 0x78, 0x24, 0x58, 0x1a, 0x6b, 0x3e, 0x66, 0x91, 0x16, 0x34,
+	0xfa, 0xc7, 0xa1, 0xb7, 0x85, 0x83, 0xbd, 0x10, 0x7c, 0x2b, 0x68, 0x7b, 0xac, 0x3c, 0x0c, 0x1f,
+	0x2c, 0xff, 0x83, 0x2a, 0x97, 0x42, 0x31, 0xef, 0x0c, 0xe2, 0x33, 0xa6, 0xdf, 0xe5, 0xf3, 0x79,
+	0xd2, 0xa1, 0x2e, 0x32, 0x78, 0x08, 0x0f, 0x14, 0x17, 0x94, 0x45, 0x6a, 0xde, 0x10, 0x94, 0xd9,
+	0xc0, 0x05, 0xfd, 0xdd, 0x60, 0xbf, 0xec, 0x86, 0x75, 0xd3, 0xfb, 0x0e, 0xe0, 0xf1, 0x4a, 0xa5,
+	0xca, 0xec, 0x76, 0xe2, 0x74, 0xe0, 0xde, 0xcd, 0x66, 0xbd, 0x72, 0xb3, 0x9b, 0xdf, 0xe8, 0x09,
+	0x44, 0x05, 0x1b, 0x19, 0x5e, 0x30, 0x15, 0x7d, 0x34, 0x59, 0x16, 0xa9, 0x89, 0xa0, 0xf6, 0x8e,
+	0x0b, 0xfa, 0x7b, 0xc1, 0xbd, 0xc5, 0xe4, 0xb5, 0xc9, 0xb2, 0x70, 0x22, 0xe8, 0xe0, 0x47, 0x0f,
+	0xa2, 0xf3, 0xe6, 0xbc, 0x42, 0x56, 0x8c, 0x39, 0x65, 0xe8, 0x2b, 0x80, 0x87, 0xcb, 0x32, 0x44,
+	0x2f, 0xc8, 0xa6, 0x0b, 0x25, 0x6b, 0x8e, 0xcb, 0x79, 0xb9, 0x2d, 0xbd, 0x4e, 0xf3, 0x1b, 0x80,
+	0xf7, 0x57, 0x24, 0x8e, 0x5e, 0x6d, 0xd6, 0x5e, 0xff, 0xd8, 0x9d, 0x93, 0xff, 0x50, 0xa8, 0x16,
+	0x3c, 0xfd, 0x70, 0x35, 0xc5, 0xe0, 0x7a, 0x8a, 0xc1, 0x9f, 0x29, 0x06, 0x5f, 0x66, 0xd8, 0xba,
+	0x9e, 0x61, 0xeb, 0xe7, 0x0c, 0x5b, 0xef, 0x4f, 0x52, 0xae, 0x2f, 0xcc, 0x90, 0x50, 0x79, 0xe9,
+	0x77, 0xde, 0xd6, 0xf1, 0xf3, 0xa7, 0xf4, 0x22, 0xe6, 0xc2, 0x5f, 0xfb, 0xad, 0x88, 0x73, 0x3e,
+	0xbc, 0x53, 0x22, 0x9e, 0xfd, 0x1d, 0x00, 0x36, 0x97, 0x1d, 0xd5, 0x5a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,8 +295,12 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type LiquidationServiceClient interface {
-	// Sends a list of subaccount ids that are potentially liquidatable.
+	// Sends lists of subaccount ids that are potentially liquidatable or need to
+	// be deleveraged.
 	LiquidateSubaccounts(ctx context.Context, in *LiquidateSubaccountsRequest, opts ...grpc.CallOption) (*LiquidateSubaccountsResponse, error)
+	// Returns the ids of subaccounts updated in blocks committed after a given
+	// sequence number.
+	GetUpdatedSubaccountIds(ctx context.Context, in *GetUpdatedSubaccountIdsRequest, opts ...grpc.CallOption) (*GetUpdatedSubaccountIdsResponse, error)
 }
 
 type liquidationServiceClient struct {
@@ -179,10 +320,23 @@ func (c *liquidationServiceClient) LiquidateSubaccounts(ctx context.Context, in
 	return out, nil
 }
 
+func (c *liquidationServiceClient) GetUpdatedSubaccountIds(ctx context.Context, in *GetUpdatedSubaccountIdsRequest, opts ...grpc.CallOption) (*GetUpdatedSubaccountIdsResponse, error) {
+	out := new(GetUpdatedSubaccountIdsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.daemons.liquidation.LiquidationService/GetUpdatedSubaccountIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LiquidationServiceServer is the server API for LiquidationService service.
 type LiquidationServiceServer interface {
-	// Sends a list of subaccount ids that are potentially liquidatable.
+	// Sends lists of subaccount ids that are potentially liquidatable or need to
+	// be deleveraged.
 	LiquidateSubaccounts(context.Context, *LiquidateSubaccountsRequest) (*LiquidateSubaccountsResponse, error)
+	// Returns the ids of subaccounts updated in blocks committed after a given
+	// sequence number.
+	GetUpdatedSubaccountIds(context.Context, *GetUpdatedSubaccountIdsRequest) (*GetUpdatedSubaccountIdsResponse, error)
 }
 
 // UnimplementedLiquidationServiceServer can be embedded to have forward compatible implementations.
@@ -192,6 +346,9 @@ type UnimplementedLiquidationServiceServer struct {
 func (*UnimplementedLiquidationServiceServer) LiquidateSubaccounts(ctx context.Context, req *LiquidateSubaccountsRequest) (*LiquidateSubaccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidateSubaccounts not implemented")
 }
+func (*UnimplementedLiquidationServiceServer) GetUpdatedSubaccountIds(ctx context.Context, req *GetUpdatedSubaccountIdsRequest) (*GetUpdatedSubaccountIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdatedSubaccountIds not implemented")
+}
 
 func RegisterLiquidationServiceServer(s grpc1.Server, srv LiquidationServiceServer) {
 	s.RegisterService(&_LiquidationService_serviceDesc, srv)
@@ -215,6 +372,24 @@ func _LiquidationService_LiquidateSubaccounts_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LiquidationService_GetUpdatedSubaccountIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpdatedSubaccountIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiquidationServiceServer).GetUpdatedSubaccountIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.daemons.liquidation.LiquidationService/GetUpdatedSubaccountIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiquidationServiceServer).GetUpdatedSubaccountIds(ctx, req.(*GetUpdatedSubaccountIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LiquidationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.daemons.liquidation.LiquidationService",
 	HandlerType: (*LiquidationServiceServer)(nil),
@@ -223,6 +398,10 @@ var _LiquidationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidateSubaccounts",
 			Handler:    _LiquidationService_LiquidateSubaccounts_Handler,
 		},
+		{
+			MethodName: "GetUpdatedSubaccountIds",
+			Handler:    _LiquidationService_GetUpdatedSubaccountIds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/daemons/liquidation/liquidation.proto",
@@ -248,6 +427,20 @@ func (m *LiquidateSubaccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.NegativeTncSubaccountIds) > 0 {
+		for iNdEx := len(m.NegativeTncSubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NegativeTncSubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.SubaccountIds) > 0 {
 		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -288,6 +481,86 @@ func (m *LiquidateSubaccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *GetUpdatedSubaccountIdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUpdatedSubaccountIdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUpdatedSubaccountIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceSequence != 0 {
+		i = encodeVarintLiquidation(dAtA, i, uint64(m.SinceSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUpdatedSubaccountIdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUpdatedSubaccountIdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUpdatedSubaccountIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiresFullSync {
+		i--
+		if m.RequiresFullSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintLiquidation(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SubaccountIds) > 0 {
+		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLiquidation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLiquidation(v)
 	base := offset
@@ -311,6 +584,12 @@ func (m *LiquidateSubaccountsRequest) Size() (n int) {
 			n += 1 + l + sovLiquidation(uint64(l))
 		}
 	}
+	if len(m.NegativeTncSubaccountIds) > 0 {
+		for _, e := range m.NegativeTncSubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovLiquidation(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -323,6 +602,39 @@ func (m *LiquidateSubaccountsResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetUpdatedSubaccountIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SinceSequence != 0 {
+		n += 1 + sovLiquidation(uint64(m.SinceSequence))
+	}
+	return n
+}
+
+func (m *GetUpdatedSubaccountIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for _, e := range m.SubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovLiquidation(uint64(l))
+		}
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovLiquidation(uint64(m.Sequence))
+	}
+	if m.RequiresFullSync {
+		n += 2
+	}
+	return n
+}
+
 func sovLiquidation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -392,6 +704,40 @@ func (m *LiquidateSubaccountsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeTncSubaccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NegativeTncSubaccountIds = append(m.NegativeTncSubaccountIds, types.SubaccountId{})
+			if err := m.NegativeTncSubaccountIds[len(m.NegativeTncSubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidation(dAtA[iNdEx:])
@@ -463,6 +809,198 @@ func (m *LiquidateSubaccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetUpdatedSubaccountIdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUpdatedSubaccountIdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUpdatedSubaccountIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceSequence", wireType)
+			}
+			m.SinceSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUpdatedSubaccountIdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUpdatedSubaccountIdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUpdatedSubaccountIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountIds = append(m.SubaccountIds, types.SubaccountId{})
+			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresFullSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresFullSync = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLiquidation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,13 +16,19 @@ import (
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // Start begins a job that periodically:
-// 1) Queries a gRPC server for all subaccounts including their open positions.
-// 2) Checks collateralization statuses of subaccounts with at least one open position.
-// 3) Sends a list of subaccount ids that potentially need to be liquidated to the application.
+// 1) Queries a gRPC server for subaccounts updated since the last task loop, or for all subaccounts
+// including their open positions on the first task loop, and caches subaccounts with open positions.
+// 2) Queries a gRPC server for perpetuals, liquidity tiers and oracle prices to find cached subaccounts
+// whose collateralization may have changed. All cached subaccounts are rechecked periodically.
+// 3) Checks collateralization statuses of subaccounts that may have changed, prioritized by their
+// distance to maintenance margin requirements.
+// 4) Sends a list of subaccount ids that potentially need to be liquidated to the application.
 func Start(
 	ctx context.Context,
 	flags flags.DaemonFlags,
@@ -62,15 +68,21 @@ func Start(
 
 	subaccountQueryClient := satypes.NewQueryClient(queryConn)
 	clobQueryClient := clobtypes.NewQueryClient(queryConn)
+	perpetualsQueryClient := perptypes.NewQueryClient(queryConn)
+	pricesQueryClient := pricestypes.NewQueryClient(queryConn)
 	liquidationServiceClient := api.NewLiquidationServiceClient(daemonConn)
+	subaccountCache := NewSubaccountCache()
 
 	ticker := time.NewTicker(time.Duration(flags.Liquidation.LoopDelayMs) * time.Millisecond)
 	for ; true; <-ticker.C {
 		if err := RunLiquidationDaemonTaskLoop(
 			ctx,
 			flags.Liquidation,
+			subaccountCache,
 			subaccountQueryClient,
 			clobQueryClient,
+			perpetualsQueryClient,
+			pricesQueryClient,
 			liquidationServiceClient,
 		); err != nil {
 			// TODO(DEC-947): Move daemon shutdown to application.
@@ -86,8 +98,11 @@ func Start(
 func RunLiquidationDaemonTaskLoop(
 	ctx context.Context,
 	liqFlags flags.LiquidationFlags,
+	subaccountCache *SubaccountCache,
 	subaccountQueryClient satypes.QueryClient,
 	clobQueryClient clobtypes.QueryClient,
+	perpetualsQueryClient perptypes.QueryClient,
+	pricesQueryClient pricestypes.QueryClient,
 	liquidationServiceClient api.LiquidationServiceClient,
 ) error {
 	defer telemetry.ModuleMeasureSince(
@@ -97,32 +112,56 @@ func RunLiquidationDaemonTaskLoop(
 		metrics.Latency,
 	)
 
-	// 1. Fetch all subaccounts from query service.
-	subaccounts, err := GetAllSubaccounts(
+	// 1. Update the cache with subaccounts updated since the last task loop, or with all subaccounts
+	// if the cache is not in sync with the application.
+	err := UpdateSubaccountCache(
 		ctx,
+		liqFlags,
+		subaccountCache,
 		subaccountQueryClient,
-		liqFlags.SubaccountPageLimit,
+		liquidationServiceClient,
 	)
 	if err != nil {
 		return err
 	}
 
-	// 2. Check collateralization statuses of subaccounts with at least one open position.
+	// 2. Mark cached subaccounts with positions in perpetuals whose params, liquidity tier, funding
+	// index or oracle price changed to be checked, and periodically mark all cached subaccounts.
+	perpetuals, err := GetAllPerpetuals(ctx, perpetualsQueryClient, liqFlags.SubaccountPageLimit)
+	if err != nil {
+		return err
+	}
+	liquidityTiers, err := GetAllLiquidityTiers(ctx, perpetualsQueryClient, liqFlags.SubaccountPageLimit)
+	if err != nil {
+		return err
+	}
+	marketPrices, err := GetAllMarketPrices(ctx, pricesQueryClient, liqFlags.SubaccountPageLimit)
+	if err != nil {
+		return err
+	}
+	subaccountCache.UpdatePerpetualsAndMarketPrices(perpetuals, liquidityTiers, marketPrices)
+	if subaccountCache.MaybeMarkAllSubaccountsToCheck(liqFlags.FullCheckInterval) {
+		telemetry.IncrCounter(1, metrics.LiquidationDaemon, metrics.FullCheck, metrics.Count)
+	}
+
+	// 3. Check collateralization statuses of subaccounts that need to be checked.
 	liquidatableSubaccountIds, err := GetLiquidatableSubaccountIds(
 		ctx,
 		clobQueryClient,
 		liqFlags,
-		subaccounts,
+		subaccountCache,
 	)
 	if err != nil {
 		return err
 	}
 
-	// 3. Send the list of liquidatable subaccount ids to the daemon server.
+	// 4. Send the lists of liquidatable subaccount ids and subaccount ids with negative total net
+	// collateral to the daemon server.
 	err = SendLiquidatableSubaccountIds(
 		ctx,
 		liquidationServiceClient,
 		liquidatableSubaccountIds,
+		subaccountCache.GetNegativeTncSubaccountIds(),
 	)
 	if err != nil {
 		return err
@@ -131,6 +170,67 @@ func RunLiquidationDaemonTaskLoop(
 	return nil
 }
 
+// UpdateSubaccountCache queries the daemon server for the ids of subaccounts updated since the
+// latest updates applied to `subaccountCache`, and applies the updated subaccounts to the cache.
+// All subaccounts are fetched instead if the cache is not synced yet, or if the daemon server no
+// longer retains some of the updates.
+func UpdateSubaccountCache(
+	ctx context.Context,
+	liqFlags flags.LiquidationFlags,
+	subaccountCache *SubaccountCache,
+	subaccountQueryClient satypes.QueryClient,
+	liquidationServiceClient api.LiquidationServiceClient,
+) error {
+	// Updated subaccount ids must be fetched before subaccounts, so that the fetched subaccounts
+	// are at least as recent as the returned sequence number.
+	response, err := liquidationServiceClient.GetUpdatedSubaccountIds(
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{
+			SinceSequence: subaccountCache.GetSequence(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !subaccountCache.IsSynced() || response.RequiresFullSync {
+		telemetry.IncrCounter(1, metrics.LiquidationDaemon, metrics.FullSync, metrics.Count)
+		subaccounts, err := GetAllSubaccounts(ctx, subaccountQueryClient, liqFlags.SubaccountPageLimit)
+		if err != nil {
+			return err
+		}
+		subaccountCache.SetAllSubaccounts(subaccounts, response.Sequence)
+		return nil
+	}
+
+	telemetry.ModuleSetGauge(
+		metrics.LiquidationDaemon,
+		float32(len(response.SubaccountIds)),
+		metrics.UpdatedSubaccountIds,
+		metrics.Count,
+	)
+	subaccounts := make([]satypes.Subaccount, 0, len(response.SubaccountIds))
+	for _, subaccountId := range response.SubaccountIds {
+		subaccountResponse, err := subaccountQueryClient.Subaccount(
+			ctx,
+			&satypes.QueryGetSubaccountRequest{
+				Owner:  subaccountId.Owner,
+				Number: subaccountId.Number,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		subaccount := subaccountResponse.Subaccount
+		// Subaccounts removed from state are returned without an id.
+		id := subaccountId
+		subaccount.Id = &id
+		subaccounts = append(subaccounts, subaccount)
+	}
+	subaccountCache.UpdateSubaccounts(subaccounts, response.Sequence)
+	return nil
+}
+
 // GetAllSubaccounts queries a gRPC server and returns a list of subaccounts and
 // their balances and open positions.
 func GetAllSubaccounts(
@@ -175,13 +275,14 @@ func GetAllSubaccounts(
 	return subaccounts, nil
 }
 
-// GetLiquidatableSubaccountIds verifies collateralization statuses of subaccounts with
-// at least one open position and returns a list of unique and potentially liquidatable subaccount ids.
+// GetLiquidatableSubaccountIds verifies collateralization statuses of subaccounts in `subaccountCache`
+// that need to be checked and returns a list of unique and potentially liquidatable subaccount ids,
+// sorted by their distance to maintenance margin requirements.
 func GetLiquidatableSubaccountIds(
 	ctx context.Context,
 	client clobtypes.QueryClient,
 	liqFlags flags.LiquidationFlags,
-	subaccounts []satypes.Subaccount,
+	subaccountCache *SubaccountCache,
 ) (
 	liquidatableSubaccountIds []satypes.SubaccountId,
 	err error,
@@ -193,23 +294,21 @@ func GetLiquidatableSubaccountIds(
 		metrics.Latency,
 	)
 
-	// Filter out subaccounts with no open positions.
-	subaccountsToCheck := make([]satypes.SubaccountId, 0)
-	for _, subaccount := range subaccounts {
-		if len(subaccount.PerpetualPositions) > 0 {
-			subaccountsToCheck = append(subaccountsToCheck, *subaccount.Id)
-		}
-	}
-
+	subaccountsToCheck := subaccountCache.GetSubaccountIdsToCheck()
 	telemetry.ModuleSetGauge(
 		metrics.LiquidationDaemon,
-		float32(len(subaccountsToCheck)),
+		float32(subaccountCache.NumSubaccounts()),
 		metrics.SubaccountsWithOpenPositions,
 		metrics.Count,
 	)
+	telemetry.ModuleSetGauge(
+		metrics.LiquidationDaemon,
+		float32(len(subaccountsToCheck)),
+		metrics.SubaccountsToCheck,
+		metrics.Count,
+	)
 
-	// Query the gRPC server in chunks of size `liqFlags.RequestChunkSize`.
-	liquidatableSubaccountIds = make([]satypes.SubaccountId, 0)
+	// Query the gRPC server in chunks of size `liqFlags.RequestChunkSize`, in order of priority.
 	for start := 0; start < len(subaccountsToCheck); start += int(liqFlags.RequestChunkSize) {
 		end := lib.Min(start+int(liqFlags.RequestChunkSize), len(subaccountsToCheck))
 
@@ -221,14 +320,9 @@ func GetLiquidatableSubaccountIds(
 		if err != nil {
 			return nil, err
 		}
-
-		for _, result := range results {
-			if result.IsLiquidatable {
-				liquidatableSubaccountIds = append(liquidatableSubaccountIds, result.SubaccountId)
-			}
-		}
+		subaccountCache.SetCollateralizationResults(results)
 	}
-	return liquidatableSubaccountIds, nil
+	return subaccountCache.GetLiquidatableSubaccountIds(), nil
 }
 
 // CheckCollateralizationForSubaccounts queries a gRPC server using `AreSubaccountsLiquidatable`
@@ -258,12 +352,13 @@ func CheckCollateralizationForSubaccounts(
 	return response.Results, nil
 }
 
-// SendLiquidatableSubaccountIds sends a list of unique and potentially liquidatable
-// subaccount ids to a gRPC server via `LiquidateSubaccounts`.
+// SendLiquidatableSubaccountIds sends lists of unique and potentially liquidatable subaccount ids
+// and subaccount ids with negative total net collateral to a gRPC server via `LiquidateSubaccounts`.
 func SendLiquidatableSubaccountIds(
 	ctx context.Context,
 	client api.LiquidationServiceClient,
 	subaccountIds []satypes.SubaccountId,
+	negativeTncSubaccountIds []satypes.SubaccountId,
 ) error {
 	defer telemetry.ModuleMeasureSince(
 		metrics.LiquidationDaemon,
@@ -278,9 +373,16 @@ func SendLiquidatableSubaccountIds(
 		metrics.LiquidatableSubaccountIds,
 		metrics.Count,
 	)
+	telemetry.ModuleSetGauge(
+		metrics.LiquidationDaemon,
+		float32(len(negativeTncSubaccountIds)),
+		metrics.NegativeTncSubaccountIds,
+		metrics.Count,
+	)
 
 	request := &api.LiquidateSubaccountsRequest{
-		SubaccountIds: subaccountIds,
+		SubaccountIds:            subaccountIds,
+		NegativeTncSubaccountIds: negativeTncSubaccountIds,
 	}
 
 	if _, err := client.LiquidateSubaccounts(ctx, request); err != nil {
@@ -289,6 +391,119 @@ func SendLiquidatableSubaccountIds(
 	return nil
 }
 
+// GetAllPerpetuals queries a gRPC server and returns a list of all perpetuals.
+func GetAllPerpetuals(
+	ctx context.Context,
+	client perptypes.QueryClient,
+	limit uint64,
+) (
+	perpetuals []perptypes.Perpetual,
+	err error,
+) {
+	defer telemetry.ModuleMeasureSince(metrics.LiquidationDaemon, time.Now(), metrics.GetAllPerpetuals, metrics.Latency)
+	perpetuals = make([]perptypes.Perpetual, 0)
+
+	var nextKey []byte
+	for {
+		response, err := client.AllPerpetuals(
+			ctx,
+			&perptypes.QueryAllPerpetualsRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: limit,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		perpetuals = append(perpetuals, response.Perpetual...)
+		if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = response.Pagination.NextKey
+	}
+	return perpetuals, nil
+}
+
+// GetAllLiquidityTiers queries a gRPC server and returns a list of all liquidity tiers.
+func GetAllLiquidityTiers(
+	ctx context.Context,
+	client perptypes.QueryClient,
+	limit uint64,
+) (
+	liquidityTiers []perptypes.LiquidityTier,
+	err error,
+) {
+	defer telemetry.ModuleMeasureSince(
+		metrics.LiquidationDaemon,
+		time.Now(),
+		metrics.GetAllLiquidityTiers,
+		metrics.Latency,
+	)
+	liquidityTiers = make([]perptypes.LiquidityTier, 0)
+
+	var nextKey []byte
+	for {
+		response, err := client.AllLiquidityTiers(
+			ctx,
+			&perptypes.QueryAllLiquidityTiersRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: limit,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		liquidityTiers = append(liquidityTiers, response.LiquidityTiers...)
+		if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = response.Pagination.NextKey
+	}
+	return liquidityTiers, nil
+}
+
+// GetAllMarketPrices queries a gRPC server and returns a list of oracle prices of all markets.
+func GetAllMarketPrices(
+	ctx context.Context,
+	client pricestypes.QueryClient,
+	limit uint64,
+) (
+	marketPrices []pricestypes.MarketPrice,
+	err error,
+) {
+	defer telemetry.ModuleMeasureSince(metrics.LiquidationDaemon, time.Now(), metrics.GetAllMarketPrices, metrics.Latency)
+	marketPrices = make([]pricestypes.MarketPrice, 0)
+
+	var nextKey []byte
+	for {
+		response, err := client.AllMarketPrices(
+			ctx,
+			&pricestypes.QueryAllMarketPricesRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: limit,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		marketPrices = append(marketPrices, response.MarketPrices...)
+		if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = response.Pagination.NextKey
+	}
+	return marketPrices, nil
+}
+
 func getSubaccountsFromKey(
 	ctx context.Context,
 	client satypes.QueryClient,
@@ -13,10 +13,13 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/client"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/grpc"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -117,6 +120,7 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 					SubaccountIds: []satypes.SubaccountId{
 						constants.Carl_Num0,
 					},
+					NegativeTncSubaccountIds: []satypes.SubaccountId{},
 				}
 				response3 := &api.LiquidateSubaccountsResponse{}
 				mck.On("LiquidateSubaccounts", ctx, req3).Return(response3, nil)
@@ -137,7 +141,8 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("SubaccountAll", ctx, req).Return(response, nil)
 				req2 := &api.LiquidateSubaccountsRequest{
-					SubaccountIds: []satypes.SubaccountId{},
+					SubaccountIds:            []satypes.SubaccountId{},
+					NegativeTncSubaccountIds: []satypes.SubaccountId{},
 				}
 				response2 := &api.LiquidateSubaccountsResponse{}
 				mck.On("LiquidateSubaccounts", ctx, req2).Return(response2, nil)
@@ -178,12 +183,40 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AreSubaccountsLiquidatable", ctx, req2).Return(response2, nil)
 				req3 := &api.LiquidateSubaccountsRequest{
-					SubaccountIds: []satypes.SubaccountId{},
+					SubaccountIds:            []satypes.SubaccountId{},
+					NegativeTncSubaccountIds: []satypes.SubaccountId{},
 				}
 				response3 := &api.LiquidateSubaccountsResponse{}
 				mck.On("LiquidateSubaccounts", ctx, req3).Return(response3, nil)
 			},
 		},
+		"Panics on error - GetUpdatedSubaccountIds": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				mck.On("GetUpdatedSubaccountIds", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
+			},
+			expectedError: errors.New("test error"),
+		},
+		"Panics on error - AllPerpetuals": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(&satypes.QuerySubaccountAllResponse{}, nil)
+				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
+			},
+			expectedError: errors.New("test error"),
+		},
+		"Panics on error - AllLiquidityTiers": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(&satypes.QuerySubaccountAllResponse{}, nil)
+				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
+			},
+			expectedError: errors.New("test error"),
+		},
+		"Panics on error - AllMarketPrices": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(&satypes.QuerySubaccountAllResponse{}, nil)
+				mck.On("AllMarketPrices", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
+			},
+			expectedError: errors.New("test error"),
+		},
 		"Panics on error - SubaccountAll": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
 				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
@@ -223,10 +256,14 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			queryClientMock := &mocks.QueryClient{}
 			tc.setupMocks(grpc.Ctx, queryClientMock)
+			setupDefaultMocks(grpc.Ctx, queryClientMock)
 
 			err := client.RunLiquidationDaemonTaskLoop(
 				grpc.Ctx,
 				flags.GetDefaultDaemonFlags().Liquidation,
+				client.NewSubaccountCache(),
+				queryClientMock,
+				queryClientMock,
 				queryClientMock,
 				queryClientMock,
 				queryClientMock,
@@ -241,6 +278,240 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 	}
 }
 
+func TestRunLiquidationDaemonTaskLoop_Incremental(t *testing.T) {
+	df := flags.GetDefaultDaemonFlags()
+	queryClientMock := &mocks.QueryClient{}
+	ctx := grpc.Ctx
+	subaccountCache := client.NewSubaccountCache()
+	runTaskLoop := func() {
+		err := client.RunLiquidationDaemonTaskLoop(
+			ctx,
+			df.Liquidation,
+			subaccountCache,
+			queryClientMock,
+			queryClientMock,
+			queryClientMock,
+			queryClientMock,
+			queryClientMock,
+		)
+		require.NoError(t, err)
+		queryClientMock.AssertExpectations(t)
+	}
+	perpetualsResponse := &perptypes.QueryAllPerpetualsResponse{
+		Perpetual: []perptypes.Perpetual{constants.BtcUsd_100PercentMarginRequirement},
+	}
+	marketPricesResponse := &pricestypes.QueryAllMarketPricesResponse{
+		MarketPrices: []pricestypes.MarketPrice{{Id: 0, Exponent: -5, Price: 5_000_000_000}},
+	}
+	liquidityTiersResponse := &perptypes.QueryAllLiquidityTiersResponse{
+		LiquidityTiers: constants.LiquidityTiers,
+	}
+	queryClientMock.On("AllPerpetuals", ctx, mock.Anything).Return(perpetualsResponse, nil)
+
+	// 1. All subaccounts are fetched and checked on the first task loop.
+	queryClientMock.On(
+		"GetUpdatedSubaccountIds",
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: 0},
+	).Return(&api.GetUpdatedSubaccountIdsResponse{Sequence: 1}, nil).Once()
+	queryClientMock.On(
+		"SubaccountAll",
+		ctx,
+		&satypes.QueryAllSubaccountRequest{
+			Pagination: &query.PageRequest{
+				Limit: df.Liquidation.SubaccountPageLimit,
+			},
+		},
+	).Return(&satypes.QuerySubaccountAllResponse{
+		Subaccount: []satypes.Subaccount{
+			constants.Carl_Num0_1BTC_Short,
+			constants.Dave_Num0_1BTC_Long_50000USD,
+			constants.Dave_Num0_599USD, // no open positions
+		},
+	}, nil).Once()
+	queryClientMock.On("AllLiquidityTiers", ctx, mock.Anything).Return(liquidityTiersResponse, nil).Once()
+	queryClientMock.On("AllMarketPrices", ctx, mock.Anything).Return(marketPricesResponse, nil).Once()
+	queryClientMock.On(
+		"AreSubaccountsLiquidatable",
+		ctx,
+		&clobtypes.AreSubaccountsLiquidatableRequest{
+			SubaccountIds: []satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		},
+	).Return(&clobtypes.AreSubaccountsLiquidatableResponse{
+		Results: []clobtypes.AreSubaccountsLiquidatableResponse_Result{
+			{
+				SubaccountId:      constants.Carl_Num0,
+				IsLiquidatable:    true,
+				NetCollateral:     dtypes.NewInt(-10_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(50_000_000_000),
+			},
+			{
+				SubaccountId:      constants.Dave_Num0,
+				IsLiquidatable:    false,
+				NetCollateral:     dtypes.NewInt(100_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(50_000_000_000),
+			},
+		},
+	}, nil).Once()
+	queryClientMock.On(
+		"LiquidateSubaccounts",
+		ctx,
+		&api.LiquidateSubaccountsRequest{
+			SubaccountIds:            []satypes.SubaccountId{constants.Carl_Num0},
+			NegativeTncSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+		},
+	).Return(&api.LiquidateSubaccountsResponse{}, nil).Once()
+	runTaskLoop()
+
+	// 2. Only updated and liquidatable subaccounts are rechecked, closest to maintenance margin first.
+	queryClientMock.On(
+		"GetUpdatedSubaccountIds",
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: 1},
+	).Return(&api.GetUpdatedSubaccountIdsResponse{
+		SubaccountIds: []satypes.SubaccountId{constants.Dave_Num0},
+		Sequence:      2,
+	}, nil).Once()
+	queryClientMock.On(
+		"Subaccount",
+		ctx,
+		&satypes.QueryGetSubaccountRequest{Owner: constants.Dave_Num0.Owner, Number: constants.Dave_Num0.Number},
+	).Return(&satypes.QuerySubaccountResponse{Subaccount: constants.Dave_Num0_1BTC_Long_50000USD}, nil).Once()
+	queryClientMock.On("AllLiquidityTiers", ctx, mock.Anything).Return(liquidityTiersResponse, nil).Once()
+	queryClientMock.On("AllMarketPrices", ctx, mock.Anything).Return(marketPricesResponse, nil).Once()
+	queryClientMock.On(
+		"AreSubaccountsLiquidatable",
+		ctx,
+		&clobtypes.AreSubaccountsLiquidatableRequest{
+			SubaccountIds: []satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		},
+	).Return(&clobtypes.AreSubaccountsLiquidatableResponse{
+		Results: []clobtypes.AreSubaccountsLiquidatableResponse_Result{
+			{
+				SubaccountId:      constants.Carl_Num0,
+				IsLiquidatable:    false,
+				NetCollateral:     dtypes.NewInt(60_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(50_000_000_000),
+			},
+			{
+				SubaccountId:      constants.Dave_Num0,
+				IsLiquidatable:    false,
+				NetCollateral:     dtypes.NewInt(70_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(50_000_000_000),
+			},
+		},
+	}, nil).Once()
+	queryClientMock.On(
+		"LiquidateSubaccounts",
+		ctx,
+		&api.LiquidateSubaccountsRequest{
+			SubaccountIds:            []satypes.SubaccountId{},
+			NegativeTncSubaccountIds: []satypes.SubaccountId{},
+		},
+	).Return(&api.LiquidateSubaccountsResponse{}, nil).Once()
+	runTaskLoop()
+
+	// 3. Nothing is rechecked if neither subaccounts nor oracle prices changed.
+	queryClientMock.On(
+		"GetUpdatedSubaccountIds",
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: 2},
+	).Return(&api.GetUpdatedSubaccountIdsResponse{Sequence: 3}, nil).Once()
+	queryClientMock.On("AllLiquidityTiers", ctx, mock.Anything).Return(liquidityTiersResponse, nil).Once()
+	queryClientMock.On("AllMarketPrices", ctx, mock.Anything).Return(marketPricesResponse, nil).Once()
+	queryClientMock.On(
+		"LiquidateSubaccounts",
+		ctx,
+		&api.LiquidateSubaccountsRequest{
+			SubaccountIds:            []satypes.SubaccountId{},
+			NegativeTncSubaccountIds: []satypes.SubaccountId{},
+		},
+	).Return(&api.LiquidateSubaccountsResponse{}, nil).Once()
+	runTaskLoop()
+	queryClientMock.AssertNumberOfCalls(t, "AreSubaccountsLiquidatable", 2)
+
+	// 4. Subaccounts with positions in markets whose oracle price changed are rechecked.
+	queryClientMock.On(
+		"GetUpdatedSubaccountIds",
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: 3},
+	).Return(&api.GetUpdatedSubaccountIdsResponse{Sequence: 4}, nil).Once()
+	queryClientMock.On("AllLiquidityTiers", ctx, mock.Anything).Return(liquidityTiersResponse, nil).Once()
+	queryClientMock.On("AllMarketPrices", ctx, mock.Anything).Return(&pricestypes.QueryAllMarketPricesResponse{
+		MarketPrices: []pricestypes.MarketPrice{{Id: 0, Exponent: -5, Price: 5_100_000_000}},
+	}, nil).Once()
+	queryClientMock.On(
+		"AreSubaccountsLiquidatable",
+		ctx,
+		&clobtypes.AreSubaccountsLiquidatableRequest{
+			SubaccountIds: []satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		},
+	).Return(&clobtypes.AreSubaccountsLiquidatableResponse{}, nil).Once()
+	queryClientMock.On(
+		"LiquidateSubaccounts",
+		ctx,
+		&api.LiquidateSubaccountsRequest{
+			SubaccountIds:            []satypes.SubaccountId{},
+			NegativeTncSubaccountIds: []satypes.SubaccountId{},
+		},
+	).Return(&api.LiquidateSubaccountsResponse{}, nil).Once()
+	runTaskLoop()
+
+	// 5. Subaccounts with positions in perpetuals whose liquidity tier changed are rechecked.
+	updatedLiquidityTiers := make([]perptypes.LiquidityTier, len(constants.LiquidityTiers))
+	copy(updatedLiquidityTiers, constants.LiquidityTiers)
+	updatedLiquidityTiers[0].MaintenanceFractionPpm++
+	queryClientMock.On(
+		"GetUpdatedSubaccountIds",
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: 4},
+	).Return(&api.GetUpdatedSubaccountIdsResponse{Sequence: 5}, nil).Once()
+	queryClientMock.On("AllLiquidityTiers", ctx, mock.Anything).Return(&perptypes.QueryAllLiquidityTiersResponse{
+		LiquidityTiers: updatedLiquidityTiers,
+	}, nil).Once()
+	queryClientMock.On("AllMarketPrices", ctx, mock.Anything).Return(&pricestypes.QueryAllMarketPricesResponse{
+		MarketPrices: []pricestypes.MarketPrice{{Id: 0, Exponent: -5, Price: 5_100_000_000}},
+	}, nil).Once()
+	queryClientMock.On(
+		"AreSubaccountsLiquidatable",
+		ctx,
+		&clobtypes.AreSubaccountsLiquidatableRequest{
+			SubaccountIds: []satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		},
+	).Return(&clobtypes.AreSubaccountsLiquidatableResponse{}, nil).Once()
+	queryClientMock.On(
+		"LiquidateSubaccounts",
+		ctx,
+		&api.LiquidateSubaccountsRequest{
+			SubaccountIds:            []satypes.SubaccountId{},
+			NegativeTncSubaccountIds: []satypes.SubaccountId{},
+		},
+	).Return(&api.LiquidateSubaccountsResponse{}, nil).Once()
+	runTaskLoop()
+	queryClientMock.AssertNumberOfCalls(t, "AreSubaccountsLiquidatable", 4)
+
+	// 6. All subaccounts are fetched again if the daemon server no longer retains some updates.
+	queryClientMock.On(
+		"GetUpdatedSubaccountIds",
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: 5},
+	).Return(&api.GetUpdatedSubaccountIdsResponse{Sequence: 2_000, RequiresFullSync: true}, nil).Once()
+	queryClientMock.On("SubaccountAll", ctx, mock.Anything).Return(&satypes.QuerySubaccountAllResponse{}, nil).Once()
+	queryClientMock.On("AllLiquidityTiers", ctx, mock.Anything).Return(liquidityTiersResponse, nil).Once()
+	queryClientMock.On("AllMarketPrices", ctx, mock.Anything).Return(marketPricesResponse, nil).Once()
+	queryClientMock.On(
+		"LiquidateSubaccounts",
+		ctx,
+		&api.LiquidateSubaccountsRequest{
+			SubaccountIds:            []satypes.SubaccountId{},
+			NegativeTncSubaccountIds: []satypes.SubaccountId{},
+		},
+	).Return(&api.LiquidateSubaccountsResponse{}, nil).Once()
+	runTaskLoop()
+	require.Equal(t, uint64(2_000), subaccountCache.GetSequence())
+	require.Equal(t, 0, subaccountCache.NumSubaccounts())
+}
+
 func TestGetAllSubaccounts(t *testing.T) {
 	df := flags.GetDefaultDaemonFlags()
 	tests := map[string]struct {
@@ -432,16 +703,28 @@ func TestCheckCollateralizationForSubaccounts(t *testing.T) {
 func TestSendLiquidatableSubaccountIds(t *testing.T) {
 	tests := map[string]struct {
 		// mocks
-		setupMocks    func(ctx context.Context, mck *mocks.QueryClient, ids []satypes.SubaccountId)
-		subaccountIds []satypes.SubaccountId
+		setupMocks func(
+			ctx context.Context,
+			mck *mocks.QueryClient,
+			ids []satypes.SubaccountId,
+			negativeTncIds []satypes.SubaccountId,
+		)
+		subaccountIds            []satypes.SubaccountId
+		negativeTncSubaccountIds []satypes.SubaccountId
 
 		// expectations
 		expectedError error
 	}{
 		"Success": {
-			setupMocks: func(ctx context.Context, mck *mocks.QueryClient, ids []satypes.SubaccountId) {
+			setupMocks: func(
+				ctx context.Context,
+				mck *mocks.QueryClient,
+				ids []satypes.SubaccountId,
+				negativeTncIds []satypes.SubaccountId,
+			) {
 				req := &api.LiquidateSubaccountsRequest{
-					SubaccountIds: ids,
+					SubaccountIds:            ids,
+					NegativeTncSubaccountIds: negativeTncIds,
 				}
 				response := &api.LiquidateSubaccountsResponse{}
 				mck.On("LiquidateSubaccounts", ctx, req).Return(response, nil)
@@ -450,36 +733,70 @@ func TestSendLiquidatableSubaccountIds(t *testing.T) {
 				constants.Alice_Num0,
 				constants.Bob_Num0,
 			},
+			negativeTncSubaccountIds: []satypes.SubaccountId{
+				constants.Bob_Num0,
+			},
 		},
 		"Success Empty": {
-			setupMocks: func(ctx context.Context, mck *mocks.QueryClient, ids []satypes.SubaccountId) {
+			setupMocks: func(
+				ctx context.Context,
+				mck *mocks.QueryClient,
+				ids []satypes.SubaccountId,
+				negativeTncIds []satypes.SubaccountId,
+			) {
 				req := &api.LiquidateSubaccountsRequest{
-					SubaccountIds: ids,
+					SubaccountIds:            ids,
+					NegativeTncSubaccountIds: negativeTncIds,
 				}
 				response := &api.LiquidateSubaccountsResponse{}
 				mck.On("LiquidateSubaccounts", ctx, req).Return(response, nil)
 			},
-			subaccountIds: []satypes.SubaccountId{},
+			subaccountIds:            []satypes.SubaccountId{},
+			negativeTncSubaccountIds: []satypes.SubaccountId{},
 		},
 		"Errors are propagated": {
-			setupMocks: func(ctx context.Context, mck *mocks.QueryClient, ids []satypes.SubaccountId) {
+			setupMocks: func(
+				ctx context.Context,
+				mck *mocks.QueryClient,
+				ids []satypes.SubaccountId,
+				negativeTncIds []satypes.SubaccountId,
+			) {
 				req := &api.LiquidateSubaccountsRequest{
-					SubaccountIds: ids,
+					SubaccountIds:            ids,
+					NegativeTncSubaccountIds: negativeTncIds,
 				}
 				mck.On("LiquidateSubaccounts", ctx, req).Return(nil, errors.New("test error"))
 			},
-			subaccountIds: []satypes.SubaccountId{},
-			expectedError: errors.New("test error"),
+			subaccountIds:            []satypes.SubaccountId{},
+			negativeTncSubaccountIds: []satypes.SubaccountId{},
+			expectedError:            errors.New("test error"),
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			queryClientMock := &mocks.QueryClient{}
-			tc.setupMocks(grpc.Ctx, queryClientMock, tc.subaccountIds)
+			tc.setupMocks(grpc.Ctx, queryClientMock, tc.subaccountIds, tc.negativeTncSubaccountIds)
 
-			err := client.SendLiquidatableSubaccountIds(grpc.Ctx, queryClientMock, tc.subaccountIds)
+			err := client.SendLiquidatableSubaccountIds(
+				grpc.Ctx,
+				queryClientMock,
+				tc.subaccountIds,
+				tc.negativeTncSubaccountIds,
+			)
 			require.Equal(t, tc.expectedError, err)
 		})
 	}
 }
+
+// setupDefaultMocks sets up mocks for the queries of a liquidation daemon task loop that are not
+// set up by a test case, returning no updated subaccounts, perpetuals, liquidity tiers or market prices.
+func setupDefaultMocks(ctx context.Context, mck *mocks.QueryClient) {
+	mck.On("GetUpdatedSubaccountIds", ctx, &api.GetUpdatedSubaccountIdsRequest{}).Return(
+		&api.GetUpdatedSubaccountIdsResponse{},
+		nil,
+	)
+	mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(&perptypes.QueryAllPerpetualsResponse{}, nil)
+	mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(&perptypes.QueryAllLiquidityTiersResponse{}, nil)
+	mck.On("AllMarketPrices", mock.Anything, mock.Anything).Return(&pricestypes.QueryAllMarketPricesResponse{}, nil)
+}
@@ -0,0 +1,287 @@
+package client
+
+import (
+	"math/big"
+	"sort"
+
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// SubaccountCache is a local cache of subaccounts with open positions that the liquidation daemon
+// maintains across task loops, so that only subaccounts whose positions, relevant oracle prices or
+// funding indices changed since they were last checked need to be rechecked.
+// Methods are not goroutine safe.
+type SubaccountCache struct {
+	// Whether the cache has been populated with all subaccounts.
+	synced bool
+	// Sequence number of the latest subaccount updates applied to the cache.
+	sequence uint64
+
+	// Subaccounts with at least one open perpetual position.
+	subaccounts map[satypes.SubaccountId]satypes.Subaccount
+	// Net collateral minus maintenance margin requirements of subaccounts when last checked.
+	marginDistances map[satypes.SubaccountId]*big.Int
+	// Subaccounts that were liquidatable when last checked.
+	liquidatable map[satypes.SubaccountId]struct{}
+	// Subaccounts that had negative total net collateral when last checked.
+	negativeTnc map[satypes.SubaccountId]struct{}
+	// Subaccounts that changed since they were last checked.
+	toCheck map[satypes.SubaccountId]struct{}
+
+	// Number of calls to `MaybeMarkAllSubaccountsToCheck` since all subaccounts were last marked to
+	// be checked.
+	loopsSinceFullCheck uint32
+
+	// Perpetuals, liquidity tiers and oracle prices of markets when last seen.
+	perpetuals     map[uint32]perptypes.Perpetual
+	liquidityTiers map[uint32]perptypes.LiquidityTier
+	marketPrices   map[uint32]uint64
+}
+
+// NewSubaccountCache creates a new empty `SubaccountCache`.
+func NewSubaccountCache() *SubaccountCache {
+	return &SubaccountCache{
+		subaccounts:     make(map[satypes.SubaccountId]satypes.Subaccount),
+		marginDistances: make(map[satypes.SubaccountId]*big.Int),
+		liquidatable:    make(map[satypes.SubaccountId]struct{}),
+		negativeTnc:     make(map[satypes.SubaccountId]struct{}),
+		toCheck:         make(map[satypes.SubaccountId]struct{}),
+		perpetuals:      make(map[uint32]perptypes.Perpetual),
+		liquidityTiers:  make(map[uint32]perptypes.LiquidityTier),
+		marketPrices:    make(map[uint32]uint64),
+	}
+}
+
+// IsSynced returns whether the cache has been populated with all subaccounts.
+func (c *SubaccountCache) IsSynced() bool {
+	return c.synced
+}
+
+// GetSequence returns the sequence number of the latest subaccount updates applied to the cache.
+func (c *SubaccountCache) GetSequence() uint64 {
+	return c.sequence
+}
+
+// NumSubaccounts returns the number of cached subaccounts with open positions.
+func (c *SubaccountCache) NumSubaccounts() int {
+	return len(c.subaccounts)
+}
+
+// SetAllSubaccounts replaces all cached subaccounts with `subaccounts` as of sequence number
+// `sequence`, and marks all subaccounts with open positions to be checked.
+func (c *SubaccountCache) SetAllSubaccounts(subaccounts []satypes.Subaccount, sequence uint64) {
+	c.subaccounts = make(map[satypes.SubaccountId]satypes.Subaccount)
+	c.toCheck = make(map[satypes.SubaccountId]struct{})
+	previousMarginDistances, previousLiquidatable, previousNegativeTnc := c.marginDistances, c.liquidatable, c.negativeTnc
+	c.marginDistances = make(map[satypes.SubaccountId]*big.Int)
+	c.liquidatable = make(map[satypes.SubaccountId]struct{})
+	c.negativeTnc = make(map[satypes.SubaccountId]struct{})
+
+	for _, subaccount := range subaccounts {
+		if len(subaccount.PerpetualPositions) == 0 {
+			continue
+		}
+		id := *subaccount.Id
+		c.subaccounts[id] = subaccount
+		c.toCheck[id] = struct{}{}
+		// Keep the last known state of subaccounts that are still open for prioritization.
+		if distance, exists := previousMarginDistances[id]; exists {
+			c.marginDistances[id] = distance
+		}
+		if _, exists := previousLiquidatable[id]; exists {
+			c.liquidatable[id] = struct{}{}
+		}
+		if _, exists := previousNegativeTnc[id]; exists {
+			c.negativeTnc[id] = struct{}{}
+		}
+	}
+	c.sequence = sequence
+	c.synced = true
+	c.loopsSinceFullCheck = 0
+}
+
+// UpdateSubaccounts applies `subaccounts`, which were updated up to sequence number `sequence`, to
+// the cache and marks them to be checked. Subaccounts without open positions are removed.
+func (c *SubaccountCache) UpdateSubaccounts(subaccounts []satypes.Subaccount, sequence uint64) {
+	for _, subaccount := range subaccounts {
+		id := *subaccount.Id
+		if len(subaccount.PerpetualPositions) == 0 {
+			delete(c.subaccounts, id)
+			delete(c.marginDistances, id)
+			delete(c.liquidatable, id)
+			delete(c.negativeTnc, id)
+			delete(c.toCheck, id)
+			continue
+		}
+		c.subaccounts[id] = subaccount
+		c.toCheck[id] = struct{}{}
+	}
+	c.sequence = sequence
+}
+
+// UpdatePerpetualsAndMarketPrices applies the latest perpetuals, liquidity tiers and oracle prices to
+// the cache, and marks subaccounts with positions in perpetuals whose params, liquidity tier, funding
+// index or oracle price changed to be checked.
+func (c *SubaccountCache) UpdatePerpetualsAndMarketPrices(
+	perpetuals []perptypes.Perpetual,
+	liquidityTiers []perptypes.LiquidityTier,
+	marketPrices []pricestypes.MarketPrice,
+) {
+	changedMarketIds := make(map[uint32]struct{})
+	for _, marketPrice := range marketPrices {
+		if previousPrice, exists := c.marketPrices[marketPrice.Id]; !exists || previousPrice != marketPrice.Price {
+			changedMarketIds[marketPrice.Id] = struct{}{}
+		}
+		c.marketPrices[marketPrice.Id] = marketPrice.Price
+	}
+
+	changedLiquidityTierIds := make(map[uint32]struct{})
+	for _, liquidityTier := range liquidityTiers {
+		if previous, exists := c.liquidityTiers[liquidityTier.Id]; !exists || previous != liquidityTier {
+			changedLiquidityTierIds[liquidityTier.Id] = struct{}{}
+		}
+		c.liquidityTiers[liquidityTier.Id] = liquidityTier
+	}
+
+	changedPerpetualIds := make(map[uint32]struct{})
+	for _, perpetual := range perpetuals {
+		id := perpetual.Params.Id
+		previous, exists := c.perpetuals[id]
+		_, marketChanged := changedMarketIds[perpetual.Params.MarketId]
+		_, liquidityTierChanged := changedLiquidityTierIds[perpetual.Params.LiquidityTier]
+		if marketChanged ||
+			liquidityTierChanged ||
+			!exists ||
+			previous.Params != perpetual.Params ||
+			previous.FundingIndex.Cmp(perpetual.FundingIndex) != 0 {
+			changedPerpetualIds[id] = struct{}{}
+		}
+		c.perpetuals[id] = perpetual
+	}
+	if len(changedPerpetualIds) == 0 {
+		return
+	}
+
+	for id, subaccount := range c.subaccounts {
+		for _, position := range subaccount.PerpetualPositions {
+			if _, changed := changedPerpetualIds[position.PerpetualId]; changed {
+				c.toCheck[id] = struct{}{}
+				break
+			}
+		}
+	}
+}
+
+// MaybeMarkAllSubaccountsToCheck marks all cached subaccounts to be checked once every `interval`
+// calls, so that subaccounts whose collateralization changed without being detected are eventually
+// rechecked. Returns whether all subaccounts were marked. Subaccounts are never marked if `interval`
+// is 0.
+func (c *SubaccountCache) MaybeMarkAllSubaccountsToCheck(interval uint32) bool {
+	if interval == 0 {
+		return false
+	}
+	c.loopsSinceFullCheck++
+	if c.loopsSinceFullCheck < interval {
+		return false
+	}
+	for id := range c.subaccounts {
+		c.toCheck[id] = struct{}{}
+	}
+	c.loopsSinceFullCheck = 0
+	return true
+}
+
+// GetSubaccountIdsToCheck returns the ids of subaccounts that changed since they were last checked
+// or were liquidatable when last checked. Subaccounts are prioritized by their last known distance to
+// maintenance margin requirements, i.e. subaccounts that were never checked come first, followed by
+// subaccounts with the lowest net collateral relative to maintenance margin requirements.
+func (c *SubaccountCache) GetSubaccountIdsToCheck() []satypes.SubaccountId {
+	subaccountIds := make([]satypes.SubaccountId, 0, len(c.toCheck)+len(c.liquidatable))
+	for id := range c.toCheck {
+		subaccountIds = append(subaccountIds, id)
+	}
+	for id := range c.liquidatable {
+		if _, exists := c.toCheck[id]; !exists {
+			subaccountIds = append(subaccountIds, id)
+		}
+	}
+	c.sortByMarginDistance(subaccountIds)
+	return subaccountIds
+}
+
+// SetCollateralizationResults records the collateralization statuses of checked subaccounts.
+func (c *SubaccountCache) SetCollateralizationResults(
+	results []clobtypes.AreSubaccountsLiquidatableResponse_Result,
+) {
+	for _, result := range results {
+		id := result.SubaccountId
+		delete(c.toCheck, id)
+		if _, exists := c.subaccounts[id]; !exists {
+			continue
+		}
+
+		if result.NetCollateral.IsNil() || result.MaintenanceMargin.IsNil() {
+			delete(c.marginDistances, id)
+			delete(c.negativeTnc, id)
+		} else {
+			c.marginDistances[id] = new(big.Int).Sub(
+				result.NetCollateral.BigInt(),
+				result.MaintenanceMargin.BigInt(),
+			)
+			if result.NetCollateral.BigInt().Sign() < 0 {
+				c.negativeTnc[id] = struct{}{}
+			} else {
+				delete(c.negativeTnc, id)
+			}
+		}
+		if result.IsLiquidatable {
+			c.liquidatable[id] = struct{}{}
+		} else {
+			delete(c.liquidatable, id)
+		}
+	}
+}
+
+// GetLiquidatableSubaccountIds returns the ids of subaccounts that were liquidatable when last checked,
+// sorted by their distance to maintenance margin requirements, i.e. most undercollateralized first.
+func (c *SubaccountCache) GetLiquidatableSubaccountIds() []satypes.SubaccountId {
+	subaccountIds := make([]satypes.SubaccountId, 0, len(c.liquidatable))
+	for id := range c.liquidatable {
+		subaccountIds = append(subaccountIds, id)
+	}
+	c.sortByMarginDistance(subaccountIds)
+	return subaccountIds
+}
+
+// GetNegativeTncSubaccountIds returns the ids of subaccounts that had negative total net collateral
+// when last checked, sorted by their distance to maintenance margin requirements.
+func (c *SubaccountCache) GetNegativeTncSubaccountIds() []satypes.SubaccountId {
+	subaccountIds := make([]satypes.SubaccountId, 0, len(c.negativeTnc))
+	for id := range c.negativeTnc {
+		subaccountIds = append(subaccountIds, id)
+	}
+	c.sortByMarginDistance(subaccountIds)
+	return subaccountIds
+}
+
+// sortByMarginDistance sorts subaccount ids in ascending order of their last known distance to
+// maintenance margin requirements. Subaccounts without a known distance come first. Ties are broken
+// by subaccount id.
+func (c *SubaccountCache) sortByMarginDistance(subaccountIds []satypes.SubaccountId) {
+	sort.Slice(subaccountIds, func(i, j int) bool {
+		distanceI, knownI := c.marginDistances[subaccountIds[i]]
+		distanceJ, knownJ := c.marginDistances[subaccountIds[j]]
+		if knownI != knownJ {
+			return !knownI
+		}
+		if knownI {
+			if cmp := distanceI.Cmp(distanceJ); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return satypes.SortedSubaccountIds(subaccountIds).Less(i, j)
+	})
+}
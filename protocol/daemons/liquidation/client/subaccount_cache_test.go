@@ -0,0 +1,211 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/client"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubaccountCache_SetAllSubaccounts(t *testing.T) {
+	cache := client.NewSubaccountCache()
+	require.False(t, cache.IsSynced())
+
+	cache.SetAllSubaccounts(
+		[]satypes.Subaccount{
+			constants.Carl_Num0_1BTC_Short,
+			constants.Dave_Num0_1BTC_Long_50000USD,
+			constants.Alice_Num0_100_000USD, // no open positions
+		},
+		5,
+	)
+	require.True(t, cache.IsSynced())
+	require.Equal(t, uint64(5), cache.GetSequence())
+	require.Equal(t, 2, cache.NumSubaccounts())
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		cache.GetSubaccountIdsToCheck(),
+	)
+	require.Empty(t, cache.GetLiquidatableSubaccountIds())
+}
+
+func TestSubaccountCache_UpdateSubaccounts(t *testing.T) {
+	cache := client.NewSubaccountCache()
+	cache.SetAllSubaccounts(
+		[]satypes.Subaccount{constants.Carl_Num0_1BTC_Short, constants.Dave_Num0_1BTC_Long_50000USD},
+		1,
+	)
+	cache.SetCollateralizationResults([]clobtypes.AreSubaccountsLiquidatableResponse_Result{
+		{SubaccountId: constants.Carl_Num0, IsLiquidatable: true},
+		{SubaccountId: constants.Dave_Num0},
+	})
+	require.Equal(t, []satypes.SubaccountId{constants.Carl_Num0}, cache.GetSubaccountIdsToCheck())
+
+	// Closing all positions removes the subaccount from the cache.
+	cache.UpdateSubaccounts([]satypes.Subaccount{constants.Carl_Num0_599USD}, 2)
+	require.Equal(t, uint64(2), cache.GetSequence())
+	require.Equal(t, 1, cache.NumSubaccounts())
+	require.Empty(t, cache.GetSubaccountIdsToCheck())
+	require.Empty(t, cache.GetLiquidatableSubaccountIds())
+
+	cache.UpdateSubaccounts([]satypes.Subaccount{constants.Dave_Num0_1BTC_Long_50000USD}, 3)
+	require.Equal(t, []satypes.SubaccountId{constants.Dave_Num0}, cache.GetSubaccountIdsToCheck())
+}
+
+func TestSubaccountCache_UpdatePerpetualsAndMarketPrices(t *testing.T) {
+	cache := client.NewSubaccountCache()
+	cache.SetAllSubaccounts(
+		[]satypes.Subaccount{constants.Carl_Num0_1BTC_Short, constants.Dave_Num0_1BTC_Long_50000USD},
+		1,
+	)
+	perpetuals := []perptypes.Perpetual{constants.BtcUsd_100PercentMarginRequirement}
+	liquidityTiers := []perptypes.LiquidityTier{constants.LiquidityTiers[0], constants.LiquidityTiers[1]}
+	marketPrices := []pricestypes.MarketPrice{{Id: 0, Exponent: -5, Price: 5_000_000_000}}
+	cache.UpdatePerpetualsAndMarketPrices(perpetuals, liquidityTiers, marketPrices)
+	cache.SetCollateralizationResults([]clobtypes.AreSubaccountsLiquidatableResponse_Result{
+		{SubaccountId: constants.Carl_Num0},
+		{SubaccountId: constants.Dave_Num0},
+	})
+	require.Empty(t, cache.GetSubaccountIdsToCheck())
+
+	// Unchanged perpetuals, liquidity tiers and prices don't require subaccounts to be checked.
+	cache.UpdatePerpetualsAndMarketPrices(perpetuals, liquidityTiers, marketPrices)
+	require.Empty(t, cache.GetSubaccountIdsToCheck())
+
+	// Price changes require subaccounts with positions in the market to be checked.
+	marketPrices[0].Price = 5_100_000_000
+	cache.UpdatePerpetualsAndMarketPrices(perpetuals, liquidityTiers, marketPrices)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		cache.GetSubaccountIdsToCheck(),
+	)
+	cache.SetCollateralizationResults([]clobtypes.AreSubaccountsLiquidatableResponse_Result{
+		{SubaccountId: constants.Carl_Num0},
+		{SubaccountId: constants.Dave_Num0},
+	})
+
+	// Funding index changes require subaccounts with positions in the perpetual to be checked.
+	perpetual := constants.BtcUsd_100PercentMarginRequirement
+	perpetual.FundingIndex = dtypes.NewInt(1)
+	cache.UpdatePerpetualsAndMarketPrices([]perptypes.Perpetual{perpetual}, liquidityTiers, marketPrices)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		cache.GetSubaccountIdsToCheck(),
+	)
+	cache.SetCollateralizationResults([]clobtypes.AreSubaccountsLiquidatableResponse_Result{
+		{SubaccountId: constants.Carl_Num0},
+		{SubaccountId: constants.Dave_Num0},
+	})
+
+	// Changes to liquidity tiers of other perpetuals don't require subaccounts to be checked.
+	liquidityTiers[1].InitialMarginPpm++
+	cache.UpdatePerpetualsAndMarketPrices([]perptypes.Perpetual{perpetual}, liquidityTiers, marketPrices)
+	require.Empty(t, cache.GetSubaccountIdsToCheck())
+
+	// Liquidity tier changes require subaccounts with positions in perpetuals of the tier to be checked.
+	liquidityTiers[0].MaintenanceFractionPpm++
+	cache.UpdatePerpetualsAndMarketPrices([]perptypes.Perpetual{perpetual}, liquidityTiers, marketPrices)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		cache.GetSubaccountIdsToCheck(),
+	)
+}
+
+func TestSubaccountCache_MaybeMarkAllSubaccountsToCheck(t *testing.T) {
+	cache := client.NewSubaccountCache()
+	cache.SetAllSubaccounts(
+		[]satypes.Subaccount{constants.Carl_Num0_1BTC_Short, constants.Dave_Num0_1BTC_Long_50000USD},
+		1,
+	)
+	cache.SetCollateralizationResults([]clobtypes.AreSubaccountsLiquidatableResponse_Result{
+		{SubaccountId: constants.Carl_Num0},
+		{SubaccountId: constants.Dave_Num0},
+	})
+
+	// Subaccounts are never marked if the interval is 0.
+	for i := 0; i < 5; i++ {
+		require.False(t, cache.MaybeMarkAllSubaccountsToCheck(0))
+	}
+	require.Empty(t, cache.GetSubaccountIdsToCheck())
+
+	// All subaccounts are marked once every interval.
+	require.False(t, cache.MaybeMarkAllSubaccountsToCheck(3))
+	require.False(t, cache.MaybeMarkAllSubaccountsToCheck(3))
+	require.Empty(t, cache.GetSubaccountIdsToCheck())
+	require.True(t, cache.MaybeMarkAllSubaccountsToCheck(3))
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+		cache.GetSubaccountIdsToCheck(),
+	)
+	cache.SetCollateralizationResults([]clobtypes.AreSubaccountsLiquidatableResponse_Result{
+		{SubaccountId: constants.Carl_Num0},
+		{SubaccountId: constants.Dave_Num0},
+	})
+	require.False(t, cache.MaybeMarkAllSubaccountsToCheck(3))
+	require.Empty(t, cache.GetSubaccountIdsToCheck())
+
+	// Populating the cache with all subaccounts restarts the interval.
+	cache.SetAllSubaccounts([]satypes.Subaccount{constants.Carl_Num0_1BTC_Short}, 2)
+	cache.SetCollateralizationResults([]clobtypes.AreSubaccountsLiquidatableResponse_Result{
+		{SubaccountId: constants.Carl_Num0},
+	})
+	require.False(t, cache.MaybeMarkAllSubaccountsToCheck(3))
+	require.False(t, cache.MaybeMarkAllSubaccountsToCheck(3))
+	require.True(t, cache.MaybeMarkAllSubaccountsToCheck(3))
+	require.Equal(t, []satypes.SubaccountId{constants.Carl_Num0}, cache.GetSubaccountIdsToCheck())
+}
+
+func TestSubaccountCache_PrioritizesByMarginDistance(t *testing.T) {
+	cache := client.NewSubaccountCache()
+	cache.SetAllSubaccounts(
+		[]satypes.Subaccount{
+			constants.Carl_Num1_01BTC_Long_4600USD_Short,
+			constants.Carl_Num0_1BTC_Short,
+			constants.Dave_Num0_1BTC_Long_50000USD,
+		},
+		1,
+	)
+	cache.SetCollateralizationResults([]clobtypes.AreSubaccountsLiquidatableResponse_Result{
+		{
+			SubaccountId:      constants.Carl_Num0,
+			IsLiquidatable:    true,
+			NetCollateral:     dtypes.NewInt(40),
+			MaintenanceMargin: dtypes.NewInt(50),
+		},
+		{
+			SubaccountId:      constants.Dave_Num0,
+			IsLiquidatable:    true,
+			NetCollateral:     dtypes.NewInt(-10),
+			MaintenanceMargin: dtypes.NewInt(50),
+		},
+	})
+
+	// Subaccounts that were never checked come first, followed by the most undercollateralized.
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Carl_Num1, constants.Dave_Num0, constants.Carl_Num0},
+		cache.GetSubaccountIdsToCheck(),
+	)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Dave_Num0, constants.Carl_Num0},
+		cache.GetLiquidatableSubaccountIds(),
+	)
+	require.Equal(t, []satypes.SubaccountId{constants.Dave_Num0}, cache.GetNegativeTncSubaccountIds())
+
+	// Subaccounts are no longer reported once their positions are closed.
+	cache.UpdateSubaccounts([]satypes.Subaccount{constants.Dave_Num0_599USD}, 2)
+	require.Equal(t, []satypes.SubaccountId{constants.Carl_Num0}, cache.GetLiquidatableSubaccountIds())
+	require.Empty(t, cache.GetNegativeTncSubaccountIds())
+}
@@ -119,6 +119,9 @@ type ExchangePrice struct {
 	ExchangeId     string     `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
 	Price          uint64     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
 	LastUpdateTime *time.Time `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	// The trailing 24 hour volume of the market on the exchange, in whole units
+	// of the base asset. Zero if the exchange does not report volume.
+	Volume uint64 `protobuf:"varint,4,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
 func (m *ExchangePrice) Reset()         { *m = ExchangePrice{} }
@@ -175,6 +178,13 @@ func (m *ExchangePrice) GetLastUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ExchangePrice) GetVolume() uint64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
 // MarketPriceUpdate represents an update to a single market
 type MarketPriceUpdate struct {
 	MarketId       uint32           `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -240,35 +250,35 @@ func init() {
 }
 
 var fileDescriptor_3d8cd2726a0e97cb = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0x2d, 0xa0, 0xf6, 0xa2, 0x96, 0x72, 0xca, 0x60, 0x0c, 0xb2, 0x2d, 0x4f, 0x5e,
-	0x7a, 0x16, 0x81, 0x05, 0x16, 0xa4, 0x4a, 0x20, 0x75, 0x00, 0x21, 0xf3, 0x22, 0xc1, 0x62, 0x5d,
-	0x7c, 0x4f, 0x1d, 0x8b, 0xd8, 0x67, 0x7c, 0xe7, 0xaa, 0x6c, 0x8c, 0x2c, 0x48, 0x9d, 0xf8, 0x00,
-	0x7c, 0x9a, 0x8e, 0x19, 0x99, 0x00, 0x25, 0x5f, 0x04, 0xf9, 0xce, 0x89, 0x12, 0x02, 0x89, 0xc4,
-	0xf6, 0xbc, 0xfd, 0xef, 0xfe, 0xcf, 0xcf, 0x3e, 0x1c, 0xf1, 0x8f, 0xfc, 0xa2, 0xaa, 0x85, 0x12,
-	0xa9, 0x18, 0x47, 0x9c, 0x41, 0x21, 0x4a, 0x19, 0x55, 0x75, 0x9e, 0xc2, 0x19, 0x00, 0x37, 0x51,
-	0xd2, 0x86, 0x54, 0x4f, 0x11, 0x77, 0x59, 0x40, 0x3b, 0x01, 0x5d, 0x08, 0x9c, 0x7e, 0x26, 0x32,
-	0xa1, 0xfb, 0x51, 0x1b, 0x19, 0x95, 0xe3, 0x65, 0x42, 0x64, 0x63, 0x88, 0x74, 0x36, 0x6c, 0xce,
-	0x22, 0x95, 0x17, 0x20, 0x15, 0x2b, 0x2a, 0x33, 0x10, 0x7c, 0x42, 0xf8, 0xf6, 0xeb, 0x8a, 0x33,
-	0x05, 0xcf, 0x58, 0xfd, 0x1e, 0xd4, 0x8b, 0xf6, 0x40, 0x19, 0xc3, 0x87, 0x06, 0xa4, 0x22, 0x29,
-	0xee, 0x17, 0xba, 0x9c, 0x18, 0x3f, 0x8d, 0x9e, 0x94, 0x36, 0xf2, 0x77, 0xc3, 0xde, 0xe0, 0x1e,
-	0xdd, 0xec, 0x89, 0x2e, 0x1d, 0x69, 0xee, 0x88, 0x49, 0xf1, 0x67, 0x49, 0x06, 0x77, 0xb1, 0xf3,
-	0x37, 0x07, 0xb2, 0x12, 0xa5, 0x84, 0xe0, 0x2b, 0xc2, 0x07, 0x4f, 0x2e, 0xd2, 0x11, 0x2b, 0x33,
-	0xd0, 0x2d, 0xe2, 0xe1, 0x1e, 0x74, 0x85, 0x24, 0xe7, 0x36, 0xf2, 0x51, 0xb8, 0x1f, 0xe3, 0x79,
-	0xe9, 0x94, 0x93, 0x3e, 0xbe, 0xae, 0x3d, 0xd8, 0x3b, 0x3e, 0x0a, 0xaf, 0xc5, 0x26, 0x21, 0xcf,
-	0xf1, 0xd1, 0x98, 0x49, 0xd5, 0xed, 0x90, 0xb4, 0x20, 0xec, 0x5d, 0x1f, 0x85, 0xbd, 0x81, 0x43,
-	0x0d, 0x25, 0x3a, 0xa7, 0x44, 0x5f, 0xcd, 0x29, 0x9d, 0xec, 0x5d, 0xfd, 0xf0, 0xd0, 0xe5, 0x4f,
-	0x0f, 0xc5, 0x87, 0xad, 0xda, 0x18, 0x6d, 0xdb, 0xc1, 0x67, 0x84, 0x6f, 0xad, 0x2d, 0x48, 0xee,
-	0xe0, 0xfd, 0x8e, 0x58, 0x67, 0xed, 0x20, 0xde, 0x33, 0x85, 0x53, 0x4e, 0xde, 0xe0, 0x9b, 0x0b,
-	0xe7, 0xda, 0x94, 0xb4, 0x77, 0x34, 0xc9, 0xe3, 0x6d, 0x24, 0x57, 0x08, 0xc4, 0x87, 0xb0, 0x9c,
-	0xca, 0xc1, 0x37, 0x84, 0x8f, 0x74, 0xf8, 0x14, 0x80, 0xbf, 0x84, 0xfa, 0xbc, 0xdd, 0xf7, 0x0b,
-	0xc2, 0x64, 0x9d, 0x2b, 0x79, 0xb8, 0xed, 0xaa, 0x7f, 0xfe, 0x0d, 0xce, 0xa3, 0xff, 0x91, 0x76,
-	0x9f, 0xd1, 0x3a, 0x79, 0x7b, 0x35, 0x75, 0xd1, 0x64, 0xea, 0xa2, 0x5f, 0x53, 0x17, 0x5d, 0xce,
-	0x5c, 0x6b, 0x32, 0x73, 0xad, 0xef, 0x33, 0xd7, 0x7a, 0xf7, 0x38, 0xcb, 0xd5, 0xa8, 0x19, 0xd2,
-	0x54, 0x14, 0xab, 0xcf, 0xe2, 0xfc, 0xc1, 0x71, 0x3a, 0x62, 0x79, 0x19, 0x6d, 0x78, 0x28, 0xac,
-	0xca, 0x87, 0x37, 0x74, 0xff, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x1e, 0xd0, 0x18,
-	0x55, 0x03, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4d, 0x6b, 0xd4, 0x40,
+	0x18, 0xc7, 0x33, 0x6d, 0x2d, 0xed, 0x2c, 0xad, 0x75, 0x58, 0x24, 0x46, 0x49, 0x42, 0x4e, 0xb9,
+	0x74, 0x82, 0xab, 0x17, 0xbd, 0x08, 0x05, 0x85, 0x1e, 0x14, 0x89, 0x2f, 0xa0, 0x97, 0x30, 0x9b,
+	0x79, 0x9a, 0x0d, 0x26, 0x99, 0x98, 0x99, 0x2c, 0xf5, 0xe6, 0xd1, 0x8b, 0xd0, 0xcf, 0xe0, 0x07,
+	0xf0, 0x73, 0xf4, 0xd8, 0xa3, 0x27, 0x95, 0xdd, 0x2f, 0x22, 0x99, 0xc9, 0x96, 0xad, 0xd5, 0x2e,
+	0xf4, 0xf6, 0xbc, 0xcc, 0x7f, 0x9e, 0xff, 0xf3, 0x4b, 0x06, 0x47, 0xfc, 0x13, 0x3f, 0xae, 0x1b,
+	0xa1, 0x44, 0x2a, 0x8a, 0x88, 0x33, 0x28, 0x45, 0x25, 0xa3, 0xba, 0xc9, 0x53, 0x38, 0x02, 0xe0,
+	0x26, 0x4a, 0xba, 0x90, 0xea, 0x53, 0xc4, 0x5d, 0x16, 0xd0, 0x5e, 0x40, 0xcf, 0x05, 0xce, 0x30,
+	0x13, 0x99, 0xd0, 0xfd, 0xa8, 0x8b, 0x8c, 0xca, 0xf1, 0x32, 0x21, 0xb2, 0x02, 0x22, 0x9d, 0x8d,
+	0xdb, 0xa3, 0x48, 0xe5, 0x25, 0x48, 0xc5, 0xca, 0xda, 0x1c, 0x08, 0x3e, 0x23, 0x7c, 0xe7, 0x4d,
+	0xcd, 0x99, 0x82, 0xe7, 0xac, 0xf9, 0x00, 0xea, 0x65, 0x77, 0xa1, 0x8c, 0xe1, 0x63, 0x0b, 0x52,
+	0x91, 0x14, 0x0f, 0x4b, 0x5d, 0x4e, 0x8c, 0x9f, 0x56, 0x9f, 0x94, 0x36, 0xf2, 0xd7, 0xc3, 0xc1,
+	0xe8, 0x3e, 0xbd, 0xda, 0x13, 0x5d, 0xba, 0xd2, 0xcc, 0x88, 0x49, 0xf9, 0x77, 0x49, 0x06, 0xf7,
+	0xb0, 0xf3, 0x2f, 0x07, 0xb2, 0x16, 0x95, 0x84, 0xe0, 0x3b, 0xc2, 0x3b, 0x4f, 0x8f, 0xd3, 0x09,
+	0xab, 0x32, 0xd0, 0x2d, 0xe2, 0xe1, 0x01, 0xf4, 0x85, 0x24, 0xe7, 0x36, 0xf2, 0x51, 0xb8, 0x1d,
+	0xe3, 0x45, 0xe9, 0x90, 0x93, 0x21, 0xbe, 0xa1, 0x3d, 0xd8, 0x6b, 0x3e, 0x0a, 0x37, 0x62, 0x93,
+	0x90, 0x17, 0x78, 0xaf, 0x60, 0x52, 0xf5, 0x3b, 0x24, 0x1d, 0x08, 0x7b, 0xdd, 0x47, 0xe1, 0x60,
+	0xe4, 0x50, 0x43, 0x89, 0x2e, 0x28, 0xd1, 0xd7, 0x0b, 0x4a, 0x07, 0x5b, 0xa7, 0x3f, 0x3d, 0x74,
+	0xf2, 0xcb, 0x43, 0xf1, 0x6e, 0xa7, 0x36, 0x46, 0xbb, 0x36, 0xb9, 0x8d, 0x37, 0xa7, 0xa2, 0x68,
+	0x4b, 0xb0, 0x37, 0xf4, 0x98, 0x3e, 0x0b, 0xbe, 0x20, 0x7c, 0xeb, 0xd2, 0xe2, 0xe4, 0x2e, 0xde,
+	0xee, 0x49, 0xf6, 0x96, 0x77, 0xe2, 0x2d, 0x53, 0x38, 0xe4, 0xe4, 0x2d, 0xbe, 0x79, 0xbe, 0x91,
+	0x36, 0x2b, 0xed, 0x35, 0x4d, 0x78, 0x7f, 0x15, 0xe1, 0x0b, 0x64, 0xe2, 0x5d, 0x58, 0x4e, 0xe5,
+	0xe8, 0x1b, 0xc2, 0x7b, 0x3a, 0x7c, 0x06, 0xc0, 0x5f, 0x41, 0x33, 0xed, 0x38, 0x7c, 0x45, 0x98,
+	0x5c, 0xe6, 0x4d, 0x1e, 0xad, 0x1a, 0xf5, 0xdf, 0xbf, 0xc4, 0x79, 0x7c, 0x1d, 0x69, 0xff, 0x79,
+	0xad, 0x83, 0x77, 0xa7, 0x33, 0x17, 0x9d, 0xcd, 0x5c, 0xf4, 0x7b, 0xe6, 0xa2, 0x93, 0xb9, 0x6b,
+	0x9d, 0xcd, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3f, 0xc9, 0x72, 0x35, 0x69, 0xc7, 0x34, 0x15,
+	0xe5, 0xc5, 0xe7, 0x32, 0x7d, 0xb8, 0x9f, 0x4e, 0x58, 0x5e, 0x45, 0x57, 0x3c, 0x20, 0x56, 0xe7,
+	0xe3, 0x4d, 0xdd, 0x7f, 0xf0, 0x67, 0x00, 0x59, 0xb0, 0xfe, 0x9a, 0x6d, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -433,6 +443,11 @@ func (m *ExchangePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Volume != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LastUpdateTime != nil {
 		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime):])
 		if err1 != nil {
@@ -552,6 +567,9 @@ func (m *ExchangePrice) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime)
 		n += 1 + l + sovPriceFeed(uint64(l))
 	}
+	if m.Volume != 0 {
+		n += 1 + sovPriceFeed(uint64(m.Volume))
+	}
 	return n
 }
 
@@ -829,6 +847,25 @@ func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPriceFeed(dAtA[iNdEx:])
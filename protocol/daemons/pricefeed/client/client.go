@@ -185,6 +185,10 @@ func (c *Client) start(ctx context.Context,
 		if !exists {
 			return fmt.Errorf("no exchange details exists for exchangeId: %v", exchangeId)
 		}
+		// If streaming is disabled, query all markets of the exchange over REST.
+		if !daemonFlags.Price.StreamingEnabled {
+			exchangeDetails.StreamingDetails = nil
+		}
 
 		// Instantiate shared buffered channel to be written to by the price fetcher and read from
 		// by the price encoder.
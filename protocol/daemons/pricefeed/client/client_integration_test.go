@@ -352,7 +352,7 @@ func (s *PriceDaemonIntegrationTestSuite) expectPricesWithTimeout(
 		time.Sleep(100 * time.Millisecond)
 
 		// Check if the prices cache contains the expected prices.
-		prices := s.exchangePriceCache.GetValidMedianPrices(marketParams, time.Now())
+		prices := s.exchangePriceCache.GetValidIndexPrices(marketParams, time.Now())
 		if len(prices) != len(expectedPrices) {
 			continue
 		}
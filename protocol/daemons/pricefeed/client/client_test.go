@@ -47,6 +47,8 @@ type FakeSubTaskRunner struct {
 	EncoderCallCount       int
 	FetcherCallCount       int
 	MarketUpdaterCallCount int
+	// StreamingFetcherCallCount is the number of fetcher calls for exchanges with streaming details.
+	StreamingFetcherCallCount int
 }
 
 // StartPriceUpdater replaces `client.StartPriceUpdater` and advances `UpdaterCallCount` by one.
@@ -96,6 +98,9 @@ func (f *FakeSubTaskRunner) StartPriceFetcher(
 	defer f.Unlock()
 
 	f.FetcherCallCount += 1
+	if exchangeDetails.StreamingDetails != nil {
+		f.StreamingFetcherCallCount += 1
+	}
 	f.Done()
 }
 
@@ -286,6 +291,56 @@ func TestStart_InvalidConfig(t *testing.T) {
 	}
 }
 
+func TestStart_Streaming(t *testing.T) {
+	// Add streaming details to each exchange.
+	exchangeIdToExchangeDetails := make(map[types.ExchangeId]types.ExchangeQueryDetails)
+	for exchangeId, exchangeDetails := range constants.TestExchangeIdToExchangeQueryDetails {
+		exchangeDetails.StreamingDetails = &types.ExchangeStreamingDetails{}
+		exchangeIdToExchangeDetails[exchangeId] = exchangeDetails
+	}
+
+	tests := map[string]struct {
+		streamingEnabled                  bool
+		expectedStreamingFetcherCallCount int
+	}{
+		"Streaming enabled": {
+			streamingEnabled:                  true,
+			expectedStreamingFetcherCallCount: testExchangeQueryConfigLength,
+		},
+		"Streaming disabled": {
+			streamingEnabled:                  false,
+			expectedStreamingFetcherCallCount: 0,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			faketaskRunner := FakeSubTaskRunner{}
+			faketaskRunner.WaitGroup.Add(testExchangeQueryConfigLength * 2)
+
+			daemonFlags := daemonflags.GetDefaultDaemonFlags()
+			daemonFlags.Price.StreamingEnabled = tc.streamingEnabled
+
+			mockGrpcClient := grpc_util.GenerateMockGrpcClientWithOptionalGrpcConnectionErrors(nil, nil, true)
+			client := newClient()
+			err := client.start(
+				grpc_util.Ctx,
+				daemonFlags,
+				appflags.GetFlagValuesFromOptions(appoptions.GetDefaultTestAppOptions("", nil)),
+				log.NewNopLogger(),
+				mockGrpcClient,
+				constants.TestExchangeQueryConfigs,
+				exchangeIdToExchangeDetails,
+				&faketaskRunner,
+			)
+			require.NoError(t, err)
+
+			faketaskRunner.Wait()
+			require.Equal(t, testExchangeQueryConfigLength, faketaskRunner.FetcherCallCount)
+			require.Equal(t, tc.expectedStreamingFetcherCallCount, faketaskRunner.StreamingFetcherCallCount)
+		})
+	}
+}
+
 // TestStop tests that the Stop interface works as expected. It's difficult to ensure that each go-routine
 // is stopped, but this test ensures that the Stop executes successfully with no hangs.
 func TestStop(t *testing.T) {
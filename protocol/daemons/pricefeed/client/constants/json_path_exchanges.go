@@ -0,0 +1,85 @@
+package constants
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/json_path"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+)
+
+// GetExchangeQueryConfigsAndDetails returns the query configs and details of all exchanges queried by the
+// pricefeed daemon: the static exchanges, plus the json path exchanges configured in the
+// `jsonPathExchangesConfigFile`, if set. See `types.JsonPathExchangesConfigJson` for the file format.
+// If the file cannot be loaded, the static exchanges are returned alongside the error so that the daemon can
+// continue to query them.
+func GetExchangeQueryConfigsAndDetails(
+	jsonPathExchangesConfigFile string,
+) (
+	exchangeIdToQueryConfig map[types.ExchangeId]*types.ExchangeQueryConfig,
+	exchangeIdToExchangeDetails map[types.ExchangeId]types.ExchangeQueryDetails,
+	err error,
+) {
+	if jsonPathExchangesConfigFile == "" {
+		return StaticExchangeQueryConfig, StaticExchangeDetails, nil
+	}
+
+	configBytes, err := os.ReadFile(jsonPathExchangesConfigFile)
+	if err != nil {
+		return StaticExchangeQueryConfig, StaticExchangeDetails, fmt.Errorf(
+			"failed to read json path exchanges config file: %w",
+			err,
+		)
+	}
+	var config types.JsonPathExchangesConfigJson
+	if err := json.Unmarshal(configBytes, &config); err != nil {
+		return StaticExchangeQueryConfig, StaticExchangeDetails, fmt.Errorf(
+			"failed to parse json path exchanges config file: %w",
+			err,
+		)
+	}
+	if err := config.Validate(); err != nil {
+		return StaticExchangeQueryConfig, StaticExchangeDetails, err
+	}
+
+	exchangeIdToQueryConfig = maps.Clone(StaticExchangeQueryConfig)
+	exchangeIdToExchangeDetails = maps.Clone(StaticExchangeDetails)
+	for _, exchange := range config.Exchanges {
+		if _, exists := exchangeIdToExchangeDetails[exchange.ExchangeName]; exists {
+			return StaticExchangeQueryConfig, StaticExchangeDetails, fmt.Errorf(
+				"json path exchange name '%v' conflicts with a static exchange",
+				exchange.ExchangeName,
+			)
+		}
+		exchangeIdToQueryConfig[exchange.ExchangeName] = getJsonPathExchangeQueryConfig(exchange)
+		exchangeIdToExchangeDetails[exchange.ExchangeName] = json_path.GetExchangeQueryDetails(exchange)
+	}
+	return exchangeIdToQueryConfig, exchangeIdToExchangeDetails, nil
+}
+
+// getJsonPathExchangeQueryConfig returns the query config of a json path exchange, using the default query
+// config for any values that are not configured.
+func getJsonPathExchangeQueryConfig(exchange types.JsonPathExchangeConfigJson) *types.ExchangeQueryConfig {
+	queryConfig := &types.ExchangeQueryConfig{
+		ExchangeId: exchange.ExchangeName,
+		IntervalMs: defaultIntervalMs,
+		TimeoutMs:  defaultTimeoutMs,
+		MaxQueries: defaultMaxQueries,
+	}
+	if exchange.IsMultiMarket {
+		queryConfig.MaxQueries = defaultMultiMarketMaxQueries
+	}
+
+	if exchange.IntervalMs != 0 {
+		queryConfig.IntervalMs = exchange.IntervalMs
+	}
+	if exchange.TimeoutMs != 0 {
+		queryConfig.TimeoutMs = exchange.TimeoutMs
+	}
+	if exchange.MaxQueries != 0 {
+		queryConfig.MaxQueries = exchange.MaxQueries
+	}
+	return queryConfig
+}
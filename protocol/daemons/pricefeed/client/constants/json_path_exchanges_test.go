@@ -0,0 +1,132 @@
+package constants_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants/exchange_common"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validJsonPathExchangesConfig = `{"exchanges":[
+		{
+			"exchangeName": "Foo",
+			"url": "https://api.foo.com/v1/ticker?symbol=$",
+			"bidPath": "data.bid",
+			"askPath": "data.ask",
+			"lastPath": "data.last"
+		},
+		{
+			"exchangeName": "Bar",
+			"url": "https://api.bar.com/v1/tickers",
+			"isMultiMarket": true,
+			"tickersPath": "result",
+			"tickerPath": "symbol",
+			"bidPath": "bid",
+			"askPath": "ask",
+			"lastPath": "last",
+			"intervalMs": 5000,
+			"timeoutMs": 1000
+		}
+	]}`
+)
+
+// writeJsonPathExchangesConfig writes `config` to a temporary file and returns its path.
+func writeJsonPathExchangesConfig(t *testing.T, config string) string {
+	path := filepath.Join(t.TempDir(), "json_path_exchanges.json")
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+	return path
+}
+
+func TestGetExchangeQueryConfigsAndDetails_NoConfigFile(t *testing.T) {
+	queryConfigs, details, err := constants.GetExchangeQueryConfigsAndDetails("")
+	require.NoError(t, err)
+	require.Equal(t, constants.StaticExchangeQueryConfig, queryConfigs)
+	require.Len(t, details, len(constants.StaticExchangeDetails))
+}
+
+func TestGetExchangeQueryConfigsAndDetails(t *testing.T) {
+	queryConfigs, details, err := constants.GetExchangeQueryConfigsAndDetails(
+		writeJsonPathExchangesConfig(t, validJsonPathExchangesConfig),
+	)
+	require.NoError(t, err)
+
+	// Static exchanges are unchanged, and are not modified by adding json path exchanges.
+	require.Len(t, queryConfigs, len(constants.StaticExchangeQueryConfig)+2)
+	require.Len(t, details, len(constants.StaticExchangeDetails)+2)
+	require.Equal(
+		t,
+		constants.StaticExchangeQueryConfig[exchange_common.EXCHANGE_ID_BINANCE],
+		queryConfigs[exchange_common.EXCHANGE_ID_BINANCE],
+	)
+	require.NotContains(t, constants.StaticExchangeQueryConfig, "Foo")
+	require.NotContains(t, constants.StaticExchangeDetails, "Foo")
+
+	// Json path exchanges use the default query config unless overridden.
+	require.Equal(
+		t,
+		&types.ExchangeQueryConfig{
+			ExchangeId: "Foo",
+			IntervalMs: 2_000,
+			TimeoutMs:  3_000,
+			MaxQueries: 3,
+		},
+		queryConfigs["Foo"],
+	)
+	require.Equal(
+		t,
+		&types.ExchangeQueryConfig{
+			ExchangeId: "Bar",
+			IntervalMs: 5_000,
+			TimeoutMs:  1_000,
+			MaxQueries: 1,
+		},
+		queryConfigs["Bar"],
+	)
+
+	require.Equal(t, "Foo", details["Foo"].Exchange)
+	require.Equal(t, "https://api.foo.com/v1/ticker?symbol=$", details["Foo"].Url)
+	require.False(t, details["Foo"].IsMultiMarket)
+	require.Equal(t, "Bar", details["Bar"].Exchange)
+	require.True(t, details["Bar"].IsMultiMarket)
+}
+
+func TestGetExchangeQueryConfigsAndDetails_Errors(t *testing.T) {
+	tests := map[string]struct {
+		config        string
+		expectedError string
+	}{
+		"Invalid json": {
+			config:        `{"exchanges":`,
+			expectedError: "failed to parse json path exchanges config file: unexpected end of JSON input",
+		},
+		"Invalid exchange": {
+			config:        `{"exchanges":[{"exchangeName":"Foo"}]}`,
+			expectedError: "invalid json path exchange: url '' for exchange 'Foo' must be an absolute http(s) url",
+		},
+		"Conflicts with static exchange": {
+			config: `{"exchanges":[{"exchangeName":"Binance","url":"https://api.foo.com/$",` +
+				`"bidPath":"bid","askPath":"ask","lastPath":"last"}]}`,
+			expectedError: "json path exchange name 'Binance' conflicts with a static exchange",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			queryConfigs, details, err := constants.GetExchangeQueryConfigsAndDetails(
+				writeJsonPathExchangesConfig(t, tc.config),
+			)
+			require.EqualError(t, err, tc.expectedError)
+			// The static exchanges are still returned so that the daemon can continue to query them.
+			require.Equal(t, constants.StaticExchangeQueryConfig, queryConfigs)
+			require.Equal(t, len(constants.StaticExchangeDetails), len(details))
+		})
+	}
+
+	queryConfigs, _, err := constants.GetExchangeQueryConfigsAndDetails(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorContains(t, err, "failed to read json path exchanges config file")
+	require.Equal(t, constants.StaticExchangeQueryConfig, queryConfigs)
+}
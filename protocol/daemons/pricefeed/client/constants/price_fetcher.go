@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	// 5K is chosen to be >> than the number of messages an exchange could send in any period before the
 	// price encoder is able to read the messages from the buffer, even if we add O(10-100) markets dynamically,
@@ -8,4 +10,17 @@ const (
 	// https://stackoverflow.com/questions/37774624/go-http-get-concurrency-and-connection-reset-by-peer.
 	// This is a good number to start with based on the above link. Adjustments can/will be made accordingly.
 	MaxConnectionsPerExchange = 50
+
+	// StreamReconnectInitialBackoff is the delay before the price fetcher reconnects a websocket price stream
+	// that failed. The delay doubles on each consecutive failure, up to StreamReconnectMaxBackoff.
+	StreamReconnectInitialBackoff = 500 * time.Millisecond
+	// StreamReconnectMaxBackoff is the maximum delay between attempts to reconnect a websocket price stream.
+	StreamReconnectMaxBackoff = 30 * time.Second
+	// StreamReadTimeout is the maximum time to wait for any message on a websocket price stream before the
+	// connection is considered dead and is reconnected.
+	StreamReadTimeout = 30 * time.Second
+	// StreamedPriceFreshness is how long after receiving a streamed price for a market the price fetcher
+	// stops querying the market over REST. Once a market's streamed price is older than this, for example
+	// because the stream is disconnected, the price fetcher falls back to querying the market over REST.
+	StreamedPriceFreshness = 5 * time.Second
 )
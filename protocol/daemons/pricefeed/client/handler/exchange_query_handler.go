@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"io"
 	"strings"
 	"time"
 
@@ -46,7 +48,8 @@ type ExchangeQueryHandler interface {
 // 2) Convert the list of `marketIds` to tickers that are specific for a given exchange. Create a mapping of
 // tickers to price exponents and a reverse mapping of ticker back to `MarketId`.
 // 3) Make API call to an exchange and verify the response status code is not an error status code.
-// 4) Transform the API response to market prices, while tracking unavailable tickers.
+// 4) Transform the API response to market prices, while tracking unavailable tickers, and to market volumes if the
+// exchange reports them.
 // 5) Return dual values:
 // - a slice of `MarketPriceTimestamp`s that contains resolved market prices
 // - a map of marketIds that could not be resolved with corresponding specific errors.
@@ -153,7 +156,16 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 		return nil, nil, fmt.Errorf("%s %v", constants.UnexpectedResponseStatusMessage, response.StatusCode)
 	}
 
-	// 4) Transform the API response to market prices, while tracking unavailable tickers.
+	// 4) Transform the API response to market prices, while tracking unavailable tickers, and to market volumes if
+	// the exchange reports them. The response body is buffered so that it can be read by both functions.
+	var body []byte
+	if exchangeQueryDetails.VolumeFunction != nil {
+		body, err = io.ReadAll(response.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(body))
+	}
 	prices, unavailableTickers, err := exchangeQueryDetails.PriceFunction(
 		response,
 		tickerToPriceExponent,
@@ -162,6 +174,12 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 	if err != nil {
 		return nil, nil, price_function.NewExchangeError(exchangeQueryDetails.Exchange, err.Error())
 	}
+	var volumes map[string]uint64
+	if exchangeQueryDetails.VolumeFunction != nil {
+		response.Body = io.NopCloser(bytes.NewReader(body))
+		// Volumes are optional, so prices are still returned if the volumes cannot be parsed.
+		volumes, _ = exchangeQueryDetails.VolumeFunction(response, tickerToPriceExponent)
+	}
 
 	// 5) Insert prices into MarketPriceTimestamp struct slice, convert unavailable tickers back into marketIds,
 	// and return.
@@ -178,6 +196,7 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 			MarketId:      marketId,
 			Price:         price,
 			LastUpdatedAt: now,
+			Volume:        volumes[ticker],
 		}
 
 		marketPriceTimestamps = append(marketPriceTimestamps, marketPriceTimestamp)
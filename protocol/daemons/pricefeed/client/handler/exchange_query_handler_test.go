@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed/exchange_config"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -369,3 +371,64 @@ func priceFuncWithErr(
 ) (prices map[string]uint64, unavailable map[string]error, err error) {
 	return nil, nil, priceFuncError
 }
+
+func TestQuery_Volumes(t *testing.T) {
+	lastUpdatedAt := time.Unix(0, 0)
+	eqh := ExchangeQueryHandlerImpl{generateMockTimeProvider(lastUpdatedAt)}
+
+	const responseBody = "response body"
+	requestHandler := &mocks.RequestHandler{}
+	requestHandler.On(
+		"Get",
+		context.Background(),
+		CreateRequestUrl(baseEqd.Url, []string{constants.BtcUsdPair}),
+	).Return(&http.Response{StatusCode: successStatus, Body: io.NopCloser(strings.NewReader(responseBody))}, nil)
+
+	// Both the price function and the volume function read the full response body.
+	readBody := func(response *http.Response) {
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		require.Equal(t, responseBody, string(body))
+	}
+	eqd := &types.ExchangeQueryDetails{
+		Url: baseEqd.Url,
+		PriceFunction: func(
+			response *http.Response,
+			tickerToPriceExponent map[string]int32,
+			resolver pft.Resolver,
+		) (prices map[string]uint64, unavailable map[string]error, err error) {
+			readBody(response)
+			return priceFunc(response, tickerToPriceExponent, resolver)
+		},
+		VolumeFunction: func(
+			response *http.Response,
+			tickerToPriceExponent map[string]int32,
+		) (volumes map[string]uint64, err error) {
+			readBody(response)
+			return map[string]uint64{constants.BtcUsdPair: 10}, nil
+		},
+	}
+
+	prices, unavailableMarkets, err := eqh.Query(
+		context.Background(),
+		eqd,
+		baseEmc,
+		[]types.MarketId{exchange_config.MARKET_BTC_USD},
+		requestHandler,
+		testMarketExponentMap,
+	)
+	require.NoError(t, err)
+	require.Empty(t, unavailableMarkets)
+	require.Equal(
+		t,
+		[]*types.MarketPriceTimestamp{
+			{
+				Price:         dummyPrice,
+				MarketId:      exchange_config.MARKET_BTC_USD,
+				LastUpdatedAt: lastUpdatedAt,
+				Volume:        10,
+			},
+		},
+		prices,
+	)
+}
@@ -210,10 +210,18 @@ func (p PriceEncoderImpl) convertPriceUpdate(marketPriceTimestamp *types.MarketP
 		},
 	)
 
+	// The volume of the ticker is in units of its base asset, which is only the base asset of the market if the
+	// ticker price is not inverted.
+	volume := marketPriceTimestamp.Volume
+	if conversionDetails.Invert {
+		volume = 0
+	}
+
 	return &types.MarketPriceTimestamp{
 		MarketId:      marketPriceTimestamp.MarketId,
 		Price:         price,
 		LastUpdatedAt: marketPriceTimestamp.LastUpdatedAt,
+		Volume:        volume,
 	}, nil
 }
 
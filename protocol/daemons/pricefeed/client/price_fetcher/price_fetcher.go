@@ -39,6 +39,12 @@ type PriceFetcher struct {
 	// mutableState contains all mutable state on the price fetcher is consolidated into a single object with access
 	// and update protected by a mutex.
 	mutableState *mutableState
+
+	// streamState tracks which markets have recently received a streamed price, for exchanges that support
+	// streaming.
+	streamState *streamState
+	// streamMarketsUpdated signals the price stream to resubscribe when the exchange's markets are updated.
+	streamMarketsUpdated chan struct{}
 }
 
 // NewPriceFetcher creates a new PriceFetcher struct. It manages querying markets via goroutine
@@ -71,6 +77,10 @@ func NewPriceFetcher(
 		logger:              pfLogger,
 		bCh:                 bCh,
 		mutableState:        &mutableState{},
+		streamState: &streamState{
+			lastStreamedAt: make(map[types.MarketId]time.Time),
+		},
+		streamMarketsUpdated: make(chan struct{}, 1),
 	}
 
 	// This will instantiate the price fetcher's mutable state.
@@ -120,6 +130,7 @@ func (p *PriceFetcher) UpdateMutableExchangeConfig(
 
 	// 3. Perform update.
 	p.mutableState.Update(newConfig, marketExponents, marketIdsRing)
+	p.notifyStreamMarketsUpdated()
 	return nil
 }
 
@@ -156,11 +167,21 @@ func (p *PriceFetcher) getNumQueriesPerTaskLoop() int {
 
 // RunTaskLoop queries the exchange for market prices.
 // Each goroutine makes a single exchange query for a specific set of one or more markets.
+// For exchanges that support streaming, markets that have recently received a streamed price are not queried.
 // RunTaskLoop blocks until all spawned goroutines have completed.
 func (pf *PriceFetcher) RunTaskLoop(requestHandler daemontypes.RequestHandler) {
 	taskLoopDefinition := pf.getTaskLoopDefinition()
+	if pf.exchangeDetails.StreamingDetails != nil {
+		taskLoopDefinition.marketIds = pf.streamState.filterRecentlyStreamedMarkets(
+			taskLoopDefinition.marketIds,
+			pf.queryHandler.Now(),
+		)
+	}
 
 	if pf.isMultiMarketAndHasMarkets() {
+		if len(taskLoopDefinition.marketIds) == 0 {
+			return
+		}
 		pf.runSubTask(
 			requestHandler,
 			taskLoopDefinition.marketIds,
@@ -0,0 +1,255 @@
+package price_fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/gorilla/websocket"
+)
+
+// errStreamMarketsUpdated is returned when a price stream connection is closed because the markets supported
+// by the exchange changed, and the stream must be resubscribed.
+var errStreamMarketsUpdated = errors.New("markets updated")
+
+// streamState tracks when the price fetcher last received a streamed price for each market.
+// All access is synchronized by a mutex.
+type streamState struct {
+	sync.Mutex
+	lastStreamedAt map[types.MarketId]time.Time
+}
+
+// setLastStreamedAt records that a price for `marketId` was streamed at `streamedAt`.
+func (ss *streamState) setLastStreamedAt(marketId types.MarketId, streamedAt time.Time) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	ss.lastStreamedAt[marketId] = streamedAt
+}
+
+// filterRecentlyStreamedMarkets returns the markets in `marketIds` that have not received a streamed price
+// within `constants.StreamedPriceFreshness` of `now`.
+func (ss *streamState) filterRecentlyStreamedMarkets(
+	marketIds []types.MarketId,
+	now time.Time,
+) []types.MarketId {
+	ss.Lock()
+	defer ss.Unlock()
+
+	filtered := make([]types.MarketId, 0, len(marketIds))
+	for _, marketId := range marketIds {
+		lastStreamedAt, ok := ss.lastStreamedAt[marketId]
+		if ok && now.Sub(lastStreamedAt) < constants.StreamedPriceFreshness {
+			continue
+		}
+		filtered = append(filtered, marketId)
+	}
+	return filtered
+}
+
+// notifyStreamMarketsUpdated signals the price stream, if any, to resubscribe with the current markets.
+func (pf *PriceFetcher) notifyStreamMarketsUpdated() {
+	select {
+	case pf.streamMarketsUpdated <- struct{}{}:
+	default:
+	}
+}
+
+// RunPriceStream maintains a websocket subscription to the exchange for all markets supported by the
+// price fetcher, and encodes every streamed price into the price fetcher's buffered channel. Connections
+// that fail are reconnected with exponential backoff, and the subscription is renewed whenever the markets
+// supported by the exchange change. RunPriceStream blocks until `stop` is closed.
+//
+// While a market receives streamed prices, `RunTaskLoop` skips querying it over REST. RunPriceStream
+// must only be called for price fetchers whose exchange details define `StreamingDetails`.
+func (pf *PriceFetcher) RunPriceStream(stop <-chan bool, dialer *websocket.Dialer) {
+	backoff := constants.StreamReconnectInitialBackoff
+	for {
+		connectedAt := time.Now()
+		err := pf.runPriceStreamConnection(stop, dialer)
+
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		if errors.Is(err, errStreamMarketsUpdated) {
+			backoff = constants.StreamReconnectInitialBackoff
+			continue
+		}
+
+		// Reset the backoff if the connection was healthy for a while before failing.
+		if time.Since(connectedAt) >= constants.StreamReconnectMaxBackoff {
+			backoff = constants.StreamReconnectInitialBackoff
+		}
+		pf.logger.Error(
+			"Price stream failed, reconnecting",
+			constants.ErrorLogKey,
+			err,
+			"backoff",
+			backoff,
+		)
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(backoff):
+		}
+		backoff = lib.Min(2*backoff, constants.StreamReconnectMaxBackoff)
+	}
+}
+
+// runPriceStreamConnection opens a single websocket connection to the exchange, subscribes to all markets
+// supported by the price fetcher and processes messages until the connection fails, `stop` is closed or the
+// markets supported by the exchange change.
+func (pf *PriceFetcher) runPriceStreamConnection(stop <-chan bool, dialer *websocket.Dialer) error {
+	streamingDetails := pf.exchangeDetails.StreamingDetails
+
+	// Snapshot all markets supported by the exchange. Any pending market update is already reflected in the
+	// snapshot, so it does not need to trigger a resubscription.
+	select {
+	case <-pf.streamMarketsUpdated:
+	default:
+	}
+	definition := pf.mutableState.getTaskLoopDefinition(true, 0)
+	if len(definition.marketIds) == 0 {
+		select {
+		case <-stop:
+			return nil
+		case <-pf.streamMarketsUpdated:
+			return errStreamMarketsUpdated
+		}
+	}
+
+	tickers := make([]string, 0, len(definition.marketIds))
+	tickerToPriceExponent := make(map[string]int32, len(definition.marketIds))
+	tickerToMarketId := make(map[string]types.MarketId, len(definition.marketIds))
+	for _, marketId := range definition.marketIds {
+		config, ok := definition.mutableExchangeConfig.MarketToMarketConfig[marketId]
+		if !ok {
+			return fmt.Errorf("no market config for market: %v", marketId)
+		}
+		priceExponent, ok := definition.marketExponents[marketId]
+		if !ok {
+			return fmt.Errorf("no market price exponent for id: %v", marketId)
+		}
+		tickers = append(tickers, config.Ticker)
+		tickerToPriceExponent[config.Ticker] = priceExponent
+		tickerToMarketId[config.Ticker] = marketId
+	}
+
+	subscribeMessages, err := streamingDetails.SubscribeMessages(tickers)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTimeout, cancelFunc := context.WithTimeout(
+		context.Background(),
+		time.Duration(pf.exchangeQueryConfig.TimeoutMs)*time.Millisecond,
+	)
+	conn, _, err := dialer.DialContext(ctxWithTimeout, streamingDetails.Url, nil)
+	cancelFunc()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	for _, message := range subscribeMessages {
+		if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			return err
+		}
+	}
+
+	// Close the connection when the price fetcher is stopped or its markets change, which unblocks the
+	// read below.
+	done := make(chan struct{})
+	defer close(done)
+	closeReason := make(chan error, 1)
+	go func() {
+		select {
+		case <-stop:
+		case <-pf.streamMarketsUpdated:
+			closeReason <- errStreamMarketsUpdated
+		case <-done:
+			return
+		}
+		conn.Close()
+	}()
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(constants.StreamReadTimeout)); err != nil {
+			return err
+		}
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			select {
+			case reason := <-closeReason:
+				return reason
+			default:
+				return err
+			}
+		}
+
+		pf.processStreamMessage(message, tickerToPriceExponent, tickerToMarketId)
+	}
+}
+
+// processStreamMessage transforms a single websocket message into market prices and writes them to the price
+// fetcher's buffered channel.
+func (pf *PriceFetcher) processStreamMessage(
+	message []byte,
+	tickerToPriceExponent map[string]int32,
+	tickerToMarketId map[string]types.MarketId,
+) {
+	exchangeId := pf.exchangeQueryConfig.ExchangeId
+
+	prices, err := pf.exchangeDetails.StreamingDetails.PriceFunction(
+		message,
+		tickerToPriceExponent,
+		lib.Median[uint64],
+	)
+	if err != nil {
+		pf.writeToBufferedChannel(exchangeId, nil, price_function.NewExchangeError(exchangeId, err.Error()))
+		return
+	}
+
+	now := pf.queryHandler.Now()
+	for ticker, price := range prices {
+		marketId, ok := tickerToMarketId[ticker]
+		if !ok {
+			continue
+		}
+
+		// No price should validly be zero. A price of zero points to an error in the API queried.
+		if price == uint64(0) {
+			pf.writeToBufferedChannel(
+				exchangeId,
+				nil,
+				fmt.Errorf(
+					"Invalid price of 0 for exchange: '%v' and market: %v",
+					exchangeId,
+					marketId,
+				),
+			)
+			continue
+		}
+
+		pf.streamState.setLastStreamedAt(marketId, now)
+		pf.writeToBufferedChannel(
+			exchangeId,
+			&types.MarketPriceTimestamp{
+				MarketId:      marketId,
+				Price:         price,
+				LastUpdatedAt: now,
+			},
+			nil,
+		)
+	}
+}
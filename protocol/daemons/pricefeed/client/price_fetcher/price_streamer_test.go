@@ -0,0 +1,227 @@
+package price_fetcher
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	pricefeed_cosntants "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// streamTestTimeout is the maximum time to wait for an expected price stream event.
+	streamTestTimeout = 5 * time.Second
+)
+
+var (
+	streamTestTime = time.Unix(1_000_000, 0).UTC()
+)
+
+// newTestStreamingDetails returns streaming details for the fake websocket server at `url`. The subscribe
+// message is the comma-separated list of tickers, and every message from the server is of the form
+// `TICKER:PRICE`, where the price is already shifted by the market exponent.
+func newTestStreamingDetails(url string) *types.ExchangeStreamingDetails {
+	return &types.ExchangeStreamingDetails{
+		Url: url,
+		SubscribeMessages: func(tickers []string) ([][]byte, error) {
+			return [][]byte{[]byte(strings.Join(tickers, ","))}, nil
+		},
+		PriceFunction: func(
+			message []byte,
+			tickerToExponent map[string]int32,
+			resolver pricefeedtypes.Resolver,
+		) (map[string]uint64, error) {
+			ticker, priceString, found := strings.Cut(string(message), ":")
+			if !found {
+				return nil, errors.New("invalid message")
+			}
+			price, err := strconv.ParseUint(priceString, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]uint64{ticker: price}, nil
+		},
+	}
+}
+
+// newTestStreamingPriceFetcher returns a multi-market price fetcher with 2 markets that streams prices from
+// the fake websocket server at `url`.
+func newTestStreamingPriceFetcher(
+	t *testing.T,
+	url string,
+	bCh chan *PriceFetcherSubtaskResponse,
+) (*PriceFetcher, *mocks.ExchangeQueryHandler) {
+	queryDetails := constants.MultiMarketExchangeQueryDetails
+	queryDetails.StreamingDetails = newTestStreamingDetails(url)
+
+	queryHandler := generateMockExchangeQueryHandler()
+	queryHandler.On("Now").Return(streamTestTime)
+
+	pf, err := NewPriceFetcher(
+		constants.Exchange1_1MaxQueries_QueryConfig,
+		queryDetails,
+		&constants.Exchange1_2Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_2Markets,
+		queryHandler,
+		log.NewNopLogger(),
+		bCh,
+	)
+	require.NoError(t, err)
+	return pf, queryHandler
+}
+
+// requireBufferedChannelResponse waits for the next price fetcher response and returns it.
+func requireBufferedChannelResponse(
+	t *testing.T,
+	bCh chan *PriceFetcherSubtaskResponse,
+) *PriceFetcherSubtaskResponse {
+	select {
+	case response := <-bCh:
+		return response
+	case <-time.After(streamTestTimeout):
+		require.FailNow(t, "Timed out waiting for price fetcher response")
+		return nil
+	}
+}
+
+func TestRunPriceStream(t *testing.T) {
+	server := pricefeed.NewFakeWebsocketServer(t)
+	bCh := newTestPriceFetcherBufferedChannel()
+	pf, _ := newTestStreamingPriceFetcher(t, server.Url(), bCh)
+
+	stop := make(chan bool)
+	streamDone := make(chan struct{})
+	go func() {
+		pf.RunPriceStream(stop, websocket.DefaultDialer)
+		close(streamDone)
+	}()
+
+	// The price stream subscribes to all markets of the exchange.
+	conn := server.AcceptConnection(t)
+	require.ElementsMatch(
+		t,
+		[]string{"BTC-USD", "ETH-USD"},
+		strings.Split(pricefeed.ReadMessage(t, conn), ","),
+	)
+
+	// Streamed prices are written to the buffered channel.
+	pricefeed.WriteMessage(t, conn, "BTC-USD:2000000")
+	response := requireBufferedChannelResponse(t, bCh)
+	require.NoError(t, response.Err)
+	require.Equal(
+		t,
+		&types.MarketPriceTimestamp{
+			MarketId:      constants.MarketId7,
+			Price:         2_000_000,
+			LastUpdatedAt: streamTestTime,
+		},
+		response.Price,
+	)
+
+	// Invalid messages and prices produce errors.
+	pricefeed.WriteMessage(t, conn, "invalid")
+	response = requireBufferedChannelResponse(t, bCh)
+	require.ErrorContains(t, response.Err, "invalid message")
+	pricefeed.WriteMessage(t, conn, "ETH-USD:0")
+	response = requireBufferedChannelResponse(t, bCh)
+	require.ErrorContains(t, response.Err, "Invalid price of 0")
+
+	// Prices of unknown tickers are ignored, and do not stop subsequent prices from being processed.
+	pricefeed.WriteMessage(t, conn, "SOL-USD:100")
+	pricefeed.WriteMessage(t, conn, "ETH-USD:3000000")
+	response = requireBufferedChannelResponse(t, bCh)
+	require.NoError(t, response.Err)
+	require.Equal(t, constants.MarketId8, response.Price.MarketId)
+
+	// A dropped connection is reconnected and resubscribed.
+	require.NoError(t, conn.Close())
+	conn = server.AcceptConnection(t)
+	require.ElementsMatch(
+		t,
+		[]string{"BTC-USD", "ETH-USD"},
+		strings.Split(pricefeed.ReadMessage(t, conn), ","),
+	)
+
+	// Updating the markets of the exchange resubscribes the stream with the new markets.
+	require.NoError(
+		t,
+		pf.UpdateMutableExchangeConfig(
+			&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+			constants.MutableMarketConfigs_1Markets,
+		),
+	)
+	conn = server.AcceptConnection(t)
+	require.Equal(t, "BTC-USD", pricefeed.ReadMessage(t, conn))
+
+	// Stopping the price stream closes the connection.
+	close(stop)
+	select {
+	case <-streamDone:
+	case <-time.After(streamTestTimeout):
+		require.FailNow(t, "Timed out waiting for price stream to stop")
+	}
+	server.RequireNoConnection(t, 2*pricefeed_cosntants.StreamReconnectInitialBackoff)
+}
+
+func TestRunTaskLoop_SkipsRecentlyStreamedMarkets(t *testing.T) {
+	bCh := newTestPriceFetcherBufferedChannel()
+	pf, queryHandler := newTestStreamingPriceFetcher(t, "", bCh)
+
+	// Only markets without a recently streamed price are queried over REST.
+	pf.streamState.setLastStreamedAt(constants.MarketId7, streamTestTime)
+	pf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+
+	response := requireBufferedChannelResponse(t, bCh)
+	require.NoError(t, response.Err)
+	require.Equal(t, constants.CanonicalMarketPriceTimestampResponses[constants.MarketId8], response.Price)
+	queryHandler.AssertNumberOfCalls(t, "Query", 1)
+	assertQueryHandlerCalledWithMarkets(
+		t,
+		queryHandler,
+		[]types.MarketId{constants.MarketId8},
+		constants.MutableMarketConfigs_2Markets,
+	)
+
+	// No queries are made while all markets are streamed.
+	pf.streamState.setLastStreamedAt(constants.MarketId8, streamTestTime)
+	pf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+	queryHandler.AssertNumberOfCalls(t, "Query", 1)
+	require.Len(t, bCh, 0)
+}
+
+func TestFilterRecentlyStreamedMarkets(t *testing.T) {
+	ss := &streamState{
+		lastStreamedAt: map[types.MarketId]time.Time{
+			constants.MarketId7: streamTestTime,
+			constants.MarketId8: streamTestTime.Add(-pricefeed_cosntants.StreamedPriceFreshness),
+		},
+	}
+
+	require.Equal(
+		t,
+		[]types.MarketId{constants.MarketId8, constants.MarketId9},
+		ss.filterRecentlyStreamedMarkets(
+			[]types.MarketId{constants.MarketId7, constants.MarketId8, constants.MarketId9},
+			streamTestTime,
+		),
+	)
+	require.Equal(
+		t,
+		[]types.MarketId{constants.MarketId7, constants.MarketId8, constants.MarketId9},
+		ss.filterRecentlyStreamedMarkets(
+			[]types.MarketId{constants.MarketId7, constants.MarketId8, constants.MarketId9},
+			streamTestTime.Add(pricefeed_cosntants.StreamedPriceFreshness),
+		),
+	)
+}
@@ -15,10 +15,12 @@ type BinanceTicker struct {
 	AskPrice  string `json:"askPrice" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bidPrice" validate:"required,positive-float-string"`
 	LastPrice string `json:"lastPrice" validate:"required,positive-float-string"`
+	Volume    string `json:"volume"`
 }
 
 // Ensure that BinanceTicker implements the Ticker interface at compile time.
 var _ price_function.Ticker = (*BinanceTicker)(nil)
+var _ price_function.VolumeTicker = (*BinanceTicker)(nil)
 
 func (t BinanceTicker) GetPair() string {
 	// needs to be wrapped in quotes to be consistent with the API request format.
@@ -37,6 +39,10 @@ func (t BinanceTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t BinanceTicker) GetVolume() string {
+	return t.Volume
+}
+
 // BinancePriceFunction transforms an API response from Binance into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func BinancePriceFunction(
@@ -57,3 +63,18 @@ func BinancePriceFunction(
 		resolver,
 	)
 }
+
+// BinanceVolumeFunction transforms an API response from Binance into a map of tickers to their trailing 24 hour
+// volume in units of the base asset.
+func BinanceVolumeFunction(
+	response *http.Response,
+	tickerToExponent map[string]int32,
+) (tickerToVolume map[string]uint64, err error) {
+	var binanceTickers []BinanceTicker
+	err = json.NewDecoder(response.Body).Decode(&binanceTickers)
+	if err != nil {
+		return nil, err
+	}
+
+	return price_function.GetVolumesFromTickers(binanceTickers, tickerToExponent), nil
+}
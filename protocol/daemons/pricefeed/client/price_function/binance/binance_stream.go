@@ -0,0 +1,92 @@
+package binance
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+const (
+	// binanceStreamTickerEvent is the event type of individual symbol ticker stream messages.
+	binanceStreamTickerEvent = "24hrTicker"
+)
+
+// BinanceStreamTicker is our representation of a ticker message received over the Binance websocket API.
+// It implements interface `Ticker` in util.go.
+// https://binance-docs.github.io/apidocs/spot/en/#individual-symbol-ticker-streams
+type BinanceStreamTicker struct {
+	Event     string `json:"e"`
+	Pair      string `json:"s" validate:"required"`
+	AskPrice  string `json:"a" validate:"required,positive-float-string"`
+	BidPrice  string `json:"b" validate:"required,positive-float-string"`
+	LastPrice string `json:"c" validate:"required,positive-float-string"`
+}
+
+// Ensure that BinanceStreamTicker implements the Ticker interface at compile time.
+var _ price_function.Ticker = (*BinanceStreamTicker)(nil)
+
+func (t BinanceStreamTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t BinanceStreamTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t BinanceStreamTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t BinanceStreamTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+// binanceSubscribeMessage is a request to subscribe to streams over the Binance websocket API.
+type binanceSubscribeMessage struct {
+	Method string   `json:"method"`
+	Params []string `json:"params"`
+	Id     uint32   `json:"id"`
+}
+
+// BinanceSubscribeMessages returns the message that subscribes to the individual symbol ticker stream of
+// each ticker.
+func BinanceSubscribeMessages(tickers []string) ([][]byte, error) {
+	streams := make([]string, 0, len(tickers))
+	for _, ticker := range tickers {
+		streams = append(streams, strings.ToLower(ticker)+"@ticker")
+	}
+
+	message, err := json.Marshal(binanceSubscribeMessage{
+		Method: "SUBSCRIBE",
+		Params: streams,
+		Id:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{message}, nil
+}
+
+// BinanceStreamPriceFunction transforms a websocket message from Binance into a map of tickers to prices
+// that have been shifted by a market specific exponent. Messages other than ticker updates return no prices.
+func BinanceStreamPriceFunction(
+	message []byte,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (tickerToPrice map[string]uint64, err error) {
+	var ticker BinanceStreamTicker
+	if err := json.Unmarshal(message, &ticker); err != nil {
+		return nil, err
+	}
+	if ticker.Event != binanceStreamTickerEvent {
+		return nil, nil
+	}
+
+	return price_function.GetMedianPricesFromStreamedTickers(
+		[]BinanceStreamTicker{ticker},
+		tickerToExponent,
+		resolver,
+	)
+}
@@ -0,0 +1,67 @@
+package binance_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/binance"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinanceSubscribeMessages(t *testing.T) {
+	messages, err := binance.BinanceSubscribeMessages([]string{BTCUSDC_TICKER, ETHUSDC_TICKER})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[][]byte{[]byte(`{"method":"SUBSCRIBE","params":["btcusdt@ticker","ethusdt@ticker"],"id":1}`)},
+		messages,
+	)
+}
+
+func TestBinanceStreamPriceFunction(t *testing.T) {
+	exponentMap := map[string]int32{
+		BTCUSDC_TICKER: constants.BtcUsdExponent,
+		ETHUSDC_TICKER: constants.EthUsdExponent,
+	}
+
+	tests := map[string]struct {
+		message string
+
+		expectedPriceMap map[string]uint64
+		expectedError    string
+	}{
+		"Success - ticker": {
+			message: `{"e":"24hrTicker","s":"BTCUSDT","c":"50000.00","b":"49999.80","a":"50000.20"}`,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDC_TICKER: 5_000_000_000,
+			},
+		},
+		"Success - ticker not subscribed": {
+			message:          `{"e":"24hrTicker","s":"LINKUSDT","c":"5.00","b":"4.99","a":"5.01"}`,
+			expectedPriceMap: map[string]uint64{},
+		},
+		"Success - subscription response": {
+			message: `{"result":null,"id":1}`,
+		},
+		"Failure - invalid ticker": {
+			message:       `{"e":"24hrTicker","s":"BTCUSDT","c":"0","b":"49999.80","a":"50000.20"}`,
+			expectedError: "invalid ticker BTCUSDT",
+		},
+		"Failure - invalid message": {
+			message:       `{,}`,
+			expectedError: "invalid character ','",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prices, err := binance.BinanceStreamPriceFunction([]byte(tc.message), exponentMap, lib.Median[uint64])
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPriceMap, prices)
+		})
+	}
+}
@@ -229,3 +229,29 @@ func TestBinancePriceFunction_Mixed(t *testing.T) {
 		})
 	}
 }
+
+func TestBinanceVolumeFunction(t *testing.T) {
+	var (
+		btcTicker = pricefeed.ReadJsonTestFile(t, "btc_ticker_binance.json")
+		ethTicker = pricefeed.ReadJsonTestFile(t, "eth_ticker_binance.json")
+	)
+
+	// Volumes are rounded down to whole units of the base asset.
+	volumes, err := binance.BinanceVolumeFunction(
+		testutil.CreateResponseFromJson(fmt.Sprintf(`[%s,%s]`, btcTicker, ethTicker)),
+		BtcAndEthExponentMap,
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{BTCUSDC_TICKER: 62_285, ETHUSDC_TICKER: 298_334}, volumes)
+
+	// Tickers that are not requested are ignored.
+	volumes, err = binance.BinanceVolumeFunction(
+		testutil.CreateResponseFromJson(fmt.Sprintf(`[%s,%s]`, btcTicker, ethTicker)),
+		EthExponentMap,
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{ETHUSDC_TICKER: 298_334}, volumes)
+
+	_, err = binance.BinanceVolumeFunction(testutil.CreateResponseFromJson(`[{]`), EthExponentMap)
+	require.Error(t, err)
+}
@@ -7,16 +7,28 @@ import (
 
 var (
 	BinanceDetails = types.ExchangeQueryDetails{
-		Exchange:      exchange_common.EXCHANGE_ID_BINANCE,
-		Url:           "https://data-api.binance.vision/api/v3/ticker/24hr",
-		PriceFunction: BinancePriceFunction,
-		IsMultiMarket: true,
+		Exchange:       exchange_common.EXCHANGE_ID_BINANCE,
+		Url:            "https://data-api.binance.vision/api/v3/ticker/24hr",
+		PriceFunction:  BinancePriceFunction,
+		VolumeFunction: BinanceVolumeFunction,
+		IsMultiMarket:  true,
+		StreamingDetails: &types.ExchangeStreamingDetails{
+			Url:               "wss://data-stream.binance.vision/ws",
+			SubscribeMessages: BinanceSubscribeMessages,
+			PriceFunction:     BinanceStreamPriceFunction,
+		},
 	}
 
 	BinanceUSDetails = types.ExchangeQueryDetails{
-		Exchange:      exchange_common.EXCHANGE_ID_BINANCE_US,
-		Url:           "https://api.binance.us/api/v3/ticker/24hr",
-		PriceFunction: BinancePriceFunction,
-		IsMultiMarket: true,
+		Exchange:       exchange_common.EXCHANGE_ID_BINANCE_US,
+		Url:            "https://api.binance.us/api/v3/ticker/24hr",
+		PriceFunction:  BinancePriceFunction,
+		VolumeFunction: BinanceVolumeFunction,
+		IsMultiMarket:  true,
+		StreamingDetails: &types.ExchangeStreamingDetails{
+			Url:               "wss://stream.binance.us:9443/ws",
+			SubscribeMessages: BinanceSubscribeMessages,
+			PriceFunction:     BinanceStreamPriceFunction,
+		},
 	}
 )
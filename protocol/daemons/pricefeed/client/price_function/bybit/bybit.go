@@ -27,10 +27,12 @@ type BybitTicker struct {
 	AskPrice  string `json:"ask1Price" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bid1Price" validate:"required,positive-float-string"`
 	LastPrice string `json:"lastPrice" validate:"required,positive-float-string"`
+	Volume    string `json:"volume24h"`
 }
 
 // Ensure that BybitTicker implements the Ticker interface at compile time.
 var _ price_function.Ticker = (*BybitTicker)(nil)
+var _ price_function.VolumeTicker = (*BybitTicker)(nil)
 
 func (t BybitTicker) GetPair() string {
 	return t.Pair
@@ -48,6 +50,10 @@ func (t BybitTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t BybitTicker) GetVolume() string {
+	return t.Volume
+}
+
 // BybitPriceFunction transforms an API response from Bybit into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func BybitPriceFunction(
@@ -72,3 +78,22 @@ func BybitPriceFunction(
 		resolver,
 	)
 }
+
+// BybitVolumeFunction transforms an API response from Bybit into a map of tickers to their trailing 24 hour
+// volume in units of the base asset.
+func BybitVolumeFunction(
+	response *http.Response,
+	tickerToExponent map[string]int32,
+) (tickerToVolume map[string]uint64, err error) {
+	var bybitResponseBody BybitResponseBody
+	err = json.NewDecoder(response.Body).Decode(&bybitResponseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if bybitResponseBody.RetCode != 0 {
+		return nil, errors.New("response code is not 0")
+	}
+
+	return price_function.GetVolumesFromTickers(bybitResponseBody.Result.Tickers, tickerToExponent), nil
+}
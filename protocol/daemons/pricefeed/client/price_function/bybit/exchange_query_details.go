@@ -7,9 +7,10 @@ import (
 
 var (
 	BybitDetails = types.ExchangeQueryDetails{
-		Exchange:      exchange_common.EXCHANGE_ID_BYBIT,
-		Url:           "https://api.bybit.com/v5/market/tickers?category=spot",
-		PriceFunction: BybitPriceFunction,
-		IsMultiMarket: true,
+		Exchange:       exchange_common.EXCHANGE_ID_BYBIT,
+		Url:            "https://api.bybit.com/v5/market/tickers?category=spot",
+		PriceFunction:  BybitPriceFunction,
+		VolumeFunction: BybitVolumeFunction,
+		IsMultiMarket:  true,
 	}
 )
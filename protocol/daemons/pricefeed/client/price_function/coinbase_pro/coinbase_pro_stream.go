@@ -0,0 +1,93 @@
+package coinbase_pro
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+const (
+	// coinbaseProStreamTickerType is the type of ticker channel messages.
+	coinbaseProStreamTickerType = "ticker"
+	// coinbaseProStreamErrorType is the type of error messages.
+	coinbaseProStreamErrorType = "error"
+)
+
+// CoinbaseProStreamTicker is our representation of a ticker message received over the Coinbase websocket feed.
+// It implements interface `Ticker` in util.go.
+// https://docs.cloud.coinbase.com/exchange/docs/websocket-channels#ticker-channel
+type CoinbaseProStreamTicker struct {
+	Type      string `json:"type"`
+	Message   string `json:"message"`
+	Pair      string `json:"product_id" validate:"required"`
+	AskPrice  string `json:"best_ask" validate:"required,positive-float-string"`
+	BidPrice  string `json:"best_bid" validate:"required,positive-float-string"`
+	LastPrice string `json:"price" validate:"required,positive-float-string"`
+}
+
+// Ensure that CoinbaseProStreamTicker implements the Ticker interface at compile time.
+var _ price_function.Ticker = (*CoinbaseProStreamTicker)(nil)
+
+func (t CoinbaseProStreamTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t CoinbaseProStreamTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t CoinbaseProStreamTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t CoinbaseProStreamTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+// coinbaseProSubscribeMessage is a request to subscribe to channels over the Coinbase websocket feed.
+type coinbaseProSubscribeMessage struct {
+	Type       string   `json:"type"`
+	ProductIds []string `json:"product_ids"`
+	Channels   []string `json:"channels"`
+}
+
+// CoinbaseProSubscribeMessages returns the message that subscribes to the ticker channel of each ticker.
+func CoinbaseProSubscribeMessages(tickers []string) ([][]byte, error) {
+	message, err := json.Marshal(coinbaseProSubscribeMessage{
+		Type:       "subscribe",
+		ProductIds: tickers,
+		Channels:   []string{coinbaseProStreamTickerType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{message}, nil
+}
+
+// CoinbaseProStreamPriceFunction transforms a websocket message from Coinbase into a map of tickers to prices
+// that have been shifted by a market specific exponent. Messages other than ticker updates return no prices.
+func CoinbaseProStreamPriceFunction(
+	message []byte,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (tickerToPrice map[string]uint64, err error) {
+	var ticker CoinbaseProStreamTicker
+	if err := json.Unmarshal(message, &ticker); err != nil {
+		return nil, err
+	}
+
+	switch ticker.Type {
+	case coinbaseProStreamTickerType:
+		return price_function.GetMedianPricesFromStreamedTickers(
+			[]CoinbaseProStreamTicker{ticker},
+			tickerToExponent,
+			resolver,
+		)
+	case coinbaseProStreamErrorType:
+		return nil, fmt.Errorf("coinbase pro stream error: %s", ticker.Message)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,70 @@
+package coinbase_pro_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/coinbase_pro"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinbaseProSubscribeMessages(t *testing.T) {
+	messages, err := coinbase_pro.CoinbaseProSubscribeMessages([]string{BTCUSDC_TICKER, ETHUSDC_TICKER})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[][]byte{[]byte(`{"type":"subscribe","product_ids":["BTC-USD","ETH-USD"],"channels":["ticker"]}`)},
+		messages,
+	)
+}
+
+func TestCoinbaseProStreamPriceFunction(t *testing.T) {
+	exponentMap := map[string]int32{
+		BTCUSDC_TICKER: constants.BtcUsdExponent,
+		ETHUSDC_TICKER: constants.EthUsdExponent,
+	}
+
+	tests := map[string]struct {
+		message string
+
+		expectedPriceMap map[string]uint64
+		expectedError    string
+	}{
+		"Success - ticker": {
+			message: `{"type":"ticker","product_id":"ETH-USD","price":"2000.5","best_bid":"2000.4","best_ask":"2000.6"}`,
+			expectedPriceMap: map[string]uint64{
+				ETHUSDC_TICKER: 2_000_500_000,
+			},
+		},
+		"Success - subscriptions response": {
+			message: `{"type":"subscriptions","channels":[{"name":"ticker","product_ids":["ETH-USD"]}]}`,
+		},
+		"Success - heartbeat": {
+			message: `{"type":"heartbeat","product_id":"ETH-USD"}`,
+		},
+		"Failure - error message": {
+			message:       `{"type":"error","message":"Failed to subscribe"}`,
+			expectedError: "coinbase pro stream error: Failed to subscribe",
+		},
+		"Failure - invalid ticker": {
+			message:       `{"type":"ticker","product_id":"ETH-USD","price":"-1","best_bid":"2000.4","best_ask":"2000.6"}`,
+			expectedError: "invalid ticker ETH-USD",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prices, err := coinbase_pro.CoinbaseProStreamPriceFunction(
+				[]byte(tc.message),
+				exponentMap,
+				lib.Median[uint64],
+			)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPriceMap, prices)
+		})
+	}
+}
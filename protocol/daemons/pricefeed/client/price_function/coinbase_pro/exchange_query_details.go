@@ -10,5 +10,10 @@ var (
 		Exchange:      exchange_common.EXCHANGE_ID_COINBASE_PRO,
 		Url:           "https://api.pro.coinbase.com/products/$/ticker",
 		PriceFunction: CoinbaseProPriceFunction,
+		StreamingDetails: &types.ExchangeStreamingDetails{
+			Url:               "wss://ws-feed.exchange.coinbase.com",
+			SubscribeMessages: CoinbaseProSubscribeMessages,
+			PriceFunction:     CoinbaseProStreamPriceFunction,
+		},
 	}
 )
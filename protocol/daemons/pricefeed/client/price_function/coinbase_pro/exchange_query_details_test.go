@@ -14,3 +14,7 @@ func TestCoinbaseProUrl(t *testing.T) {
 func TestCoinbaseProIsMultiMarket(t *testing.T) {
 	require.False(t, coinbase_pro.CoinbaseProDetails.IsMultiMarket)
 }
+
+func TestCoinbaseProStreamingUrl(t *testing.T) {
+	require.Equal(t, "wss://ws-feed.exchange.coinbase.com", coinbase_pro.CoinbaseProDetails.StreamingDetails.Url)
+}
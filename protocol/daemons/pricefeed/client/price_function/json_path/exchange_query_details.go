@@ -0,0 +1,15 @@
+package json_path
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+)
+
+// GetExchangeQueryDetails returns the details for querying the json path exchange described by `config`.
+func GetExchangeQueryDetails(config types.JsonPathExchangeConfigJson) types.ExchangeQueryDetails {
+	return types.ExchangeQueryDetails{
+		Exchange:      config.ExchangeName,
+		Url:           config.Url,
+		PriceFunction: NewJsonPathPriceFunction(config),
+		IsMultiMarket: config.IsMultiMarket,
+	}
+}
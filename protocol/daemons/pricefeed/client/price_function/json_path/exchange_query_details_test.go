@@ -0,0 +1,21 @@
+package json_path_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/json_path"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExchangeQueryDetails(t *testing.T) {
+	details := json_path.GetExchangeQueryDetails(singleMarketConfig)
+	require.Equal(t, "Foo", details.Exchange)
+	require.Equal(t, "https://api.foo.com/v1/ticker?symbol=$", details.Url)
+	require.False(t, details.IsMultiMarket)
+	require.NotNil(t, details.PriceFunction)
+	require.Nil(t, details.StreamingDetails)
+
+	details = json_path.GetExchangeQueryDetails(multiMarketArrayConfig)
+	require.Equal(t, "Bar", details.Exchange)
+	require.True(t, details.IsMultiMarket)
+}
@@ -0,0 +1,186 @@
+package json_path
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+// JsonPathTicker is our representation of ticker information extracted from an exchange response using the
+// json paths of a json path exchange. Need to implement interface `Ticker` in util.go.
+type JsonPathTicker struct {
+	Pair      string `validate:"required"`
+	AskPrice  string `validate:"required,positive-float-string"`
+	BidPrice  string `validate:"required,positive-float-string"`
+	LastPrice string `validate:"required,positive-float-string"`
+}
+
+// Ensure that JsonPathTicker implements the Ticker interface at compile time.
+var _ price_function.Ticker = (*JsonPathTicker)(nil)
+
+func (t JsonPathTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t JsonPathTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t JsonPathTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t JsonPathTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+// NewJsonPathPriceFunction returns a price function that transforms an API response from the exchange described
+// by `config` into a map of tickers to prices that have been shifted by a market specific exponent.
+func NewJsonPathPriceFunction(
+	config types.JsonPathExchangeConfigJson,
+) func(
+	response *http.Response,
+	tickerToExponent map[string]int32,
+	resolver pricefeedtypes.Resolver,
+) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+	return func(
+		response *http.Response,
+		tickerToExponent map[string]int32,
+		resolver pricefeedtypes.Resolver,
+	) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+		// Unmarshal response body, keeping numbers in their original decimal representation.
+		var body interface{}
+		decoder := json.NewDecoder(response.Body)
+		decoder.UseNumber()
+		if err = decoder.Decode(&body); err != nil {
+			return nil, nil, err
+		}
+
+		var tickers []JsonPathTicker
+		if config.IsMultiMarket {
+			tickers, err = getMultiMarketTickers(config, body)
+			if err != nil {
+				return nil, nil, err
+			}
+		} else {
+			ticker, _, err := price_function.GetOnlyTickerAndExponent(tickerToExponent, config.ExchangeName)
+			if err != nil {
+				return nil, nil, err
+			}
+			tickers = []JsonPathTicker{getTicker(config, ticker, body)}
+		}
+
+		return price_function.GetMedianPricesFromTickers(
+			tickers,
+			tickerToExponent,
+			resolver,
+		)
+	}
+}
+
+// getMultiMarketTickers extracts all tickers from the response of a multi-market exchange.
+func getMultiMarketTickers(
+	config types.JsonPathExchangeConfigJson,
+	body interface{},
+) ([]JsonPathTicker, error) {
+	tickersValue := body
+	if config.TickersPath != "" {
+		var found bool
+		if tickersValue, found = GetJsonPathValue(body, config.TickersPath); !found {
+			return nil, fmt.Errorf("tickers not found at path '%v'", config.TickersPath)
+		}
+	}
+
+	var tickers []JsonPathTicker
+	switch tickersValue := tickersValue.(type) {
+	case []interface{}:
+		if config.TickerPath == "" {
+			return nil, fmt.Errorf("tickers at path '%v' are an array, but tickerPath is not set", config.TickersPath)
+		}
+		tickers = make([]JsonPathTicker, 0, len(tickersValue))
+		for _, element := range tickersValue {
+			pair, found := GetJsonPathValue(element, config.TickerPath)
+			if !found {
+				continue
+			}
+			pairString, ok := pair.(string)
+			if !ok {
+				continue
+			}
+			tickers = append(tickers, getTicker(config, pairString, element))
+		}
+	case map[string]interface{}:
+		if config.TickerPath != "" {
+			return nil, fmt.Errorf("tickers at path '%v' are an object, but tickerPath is set", config.TickersPath)
+		}
+		tickers = make([]JsonPathTicker, 0, len(tickersValue))
+		for pair, element := range tickersValue {
+			tickers = append(tickers, getTicker(config, pair, element))
+		}
+	default:
+		return nil, fmt.Errorf("tickers at path '%v' must be an array or an object", config.TickersPath)
+	}
+	return tickers, nil
+}
+
+// getTicker extracts the bid, ask and last prices of `pair` from `value`. Prices that are not found are left
+// empty, which fails validation of the ticker.
+func getTicker(
+	config types.JsonPathExchangeConfigJson,
+	pair string,
+	value interface{},
+) JsonPathTicker {
+	return JsonPathTicker{
+		Pair:      pair,
+		AskPrice:  getPriceString(value, config.AskPath),
+		BidPrice:  getPriceString(value, config.BidPath),
+		LastPrice: getPriceString(value, config.LastPath),
+	}
+}
+
+// getPriceString returns the json string or number at `path` as a string, or an empty string if there is no
+// string or number at `path`.
+func getPriceString(value interface{}, path string) string {
+	price, found := GetJsonPathValue(value, path)
+	if !found {
+		return ""
+	}
+	switch price := price.(type) {
+	case string:
+		return price
+	case json.Number:
+		return price.String()
+	default:
+		return ""
+	}
+}
+
+// GetJsonPathValue returns the value at `path` in the unmarshalled json `value`, and whether it was found.
+// Keys of the path index into json objects by name, and into json arrays by position.
+func GetJsonPathValue(value interface{}, path string) (interface{}, bool) {
+	for _, key := range strings.Split(path, types.JsonPathSeparator) {
+		switch current := value.(type) {
+		case map[string]interface{}:
+			next, exists := current[key]
+			if !exists {
+				return nil, false
+			}
+			value = next
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(current) {
+				return nil, false
+			}
+			value = current[index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
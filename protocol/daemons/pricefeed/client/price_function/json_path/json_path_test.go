@@ -0,0 +1,284 @@
+package json_path_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/json_path"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	"github.com/stretchr/testify/require"
+)
+
+// Test tickers for the json path exchanges.
+const (
+	BTCUSD_TICKER = "BTC_USD"
+	ETHUSD_TICKER = "ETH_USD"
+)
+
+// Test exponent maps.
+var (
+	BtcExponentMap = map[string]int32{
+		BTCUSD_TICKER: constants.BtcUsdExponent,
+	}
+	EthExponentMap = map[string]int32{
+		ETHUSD_TICKER: constants.EthUsdExponent,
+	}
+	BtcAndEthExponentMap = map[string]int32{
+		BTCUSD_TICKER: constants.BtcUsdExponent,
+		ETHUSD_TICKER: constants.EthUsdExponent,
+	}
+)
+
+// Test json path exchange configs.
+var (
+	singleMarketConfig = types.JsonPathExchangeConfigJson{
+		ExchangeName: "Foo",
+		Url:          "https://api.foo.com/v1/ticker?symbol=$",
+		BidPath:      "data.quote.bid",
+		AskPath:      "data.quote.ask",
+		LastPath:     "data.trades.0.price",
+	}
+	multiMarketArrayConfig = types.JsonPathExchangeConfigJson{
+		ExchangeName:  "Bar",
+		Url:           "https://api.bar.com/v1/tickers",
+		IsMultiMarket: true,
+		TickersPath:   "result.tickers",
+		TickerPath:    "symbol",
+		BidPath:       "bid",
+		AskPath:       "ask",
+		LastPath:      "last",
+	}
+	multiMarketObjectConfig = types.JsonPathExchangeConfigJson{
+		ExchangeName:  "Baz",
+		Url:           "https://api.baz.com/v1/tickers",
+		IsMultiMarket: true,
+		BidPath:       "b.0",
+		AskPath:       "a.0",
+		LastPath:      "c",
+	}
+)
+
+func TestJsonPathPriceFunction_Mixed(t *testing.T) {
+	tests := map[string]struct {
+		// parameters
+		config              types.JsonPathExchangeConfigJson
+		responseJsonString  string
+		exponentMap         map[string]int32
+		medianFunctionFails bool
+
+		// expectations
+		expectedPriceMap       map[string]uint64
+		expectedUnavailableMap map[string]error
+		expectedError          error
+	}{
+		"Failure - invalid response": {
+			config:             singleMarketConfig,
+			responseJsonString: `{"data":}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("invalid character '}' looking for beginning of value"),
+		},
+		"Failure - single market with multiple tickers": {
+			config:             singleMarketConfig,
+			responseJsonString: `{}`,
+			exponentMap:        BtcAndEthExponentMap,
+			expectedError: errors.New(
+				"Invalid market price exponent map for Foo price function of length: 2, expected length 1",
+			),
+		},
+		"Failure - multi market tickers not found": {
+			config:             multiMarketArrayConfig,
+			responseJsonString: `{"result":{}}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("tickers not found at path 'result.tickers'"),
+		},
+		"Failure - multi market tickers not an array or object": {
+			config:             multiMarketArrayConfig,
+			responseJsonString: `{"result":{"tickers":"BTC_USD"}}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("tickers at path 'result.tickers' must be an array or an object"),
+		},
+		"Failure - multi market tickers object with ticker path": {
+			config:             multiMarketArrayConfig,
+			responseJsonString: `{"result":{"tickers":{"BTC_USD":{}}}}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("tickers at path 'result.tickers' are an object, but tickerPath is set"),
+		},
+		"Unavailable - single market price not found": {
+			config:             singleMarketConfig,
+			responseJsonString: `{"data":{"quote":{"bid":"25841","ask":"25842"},"trades":[]}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   make(map[string]uint64),
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: errors.New(
+					"Key: 'JsonPathTicker.LastPrice' Error:Field validation for 'LastPrice' failed on the " +
+						"'required' tag",
+				),
+			},
+		},
+		"Unavailable - single market price of invalid type": {
+			config:             singleMarketConfig,
+			responseJsonString: `{"data":{"quote":{"bid":"25841","ask":true},"trades":[{"price":"25846"}]}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   make(map[string]uint64),
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: errors.New(
+					"Key: 'JsonPathTicker.AskPrice' Error:Field validation for 'AskPrice' failed on the " +
+						"'required' tag",
+				),
+			},
+		},
+		"Unavailable - single market malformed price": {
+			config:             singleMarketConfig,
+			responseJsonString: `{"data":{"quote":{"bid":"25841","ask":"0"},"trades":[{"price":"25846"}]}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   make(map[string]uint64),
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: errors.New(
+					"Key: 'JsonPathTicker.AskPrice' Error:Field validation for 'AskPrice' failed on the " +
+						"'positive-float-string' tag",
+				),
+			},
+		},
+		"Unavailable - multi market missing ticker": {
+			config: multiMarketArrayConfig,
+			responseJsonString: `{"result":{"tickers":[` +
+				`{"symbol":"ETH_USD","bid":"1734.3","ask":"1734.9","last":"1735"},` +
+				`{"bid":"25841","ask":"25842","last":"25846"}` +
+				`]}}`,
+			exponentMap: BtcAndEthExponentMap,
+			expectedPriceMap: map[string]uint64{
+				ETHUSD_TICKER: uint64(1_734_900_000),
+			},
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: errors.New("no listing found for ticker BTC_USD"),
+			},
+		},
+		"Failure - medianization error": {
+			config:              singleMarketConfig,
+			responseJsonString:  `{"data":{"quote":{"bid":"25841","ask":"25842"},"trades":[{"price":"25846"}]}}`,
+			exponentMap:         BtcExponentMap,
+			medianFunctionFails: true,
+			expectedPriceMap:    make(map[string]uint64),
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: testutil.MedianizationError,
+			},
+		},
+		"Success - single market string prices": {
+			config:             singleMarketConfig,
+			responseJsonString: `{"data":{"quote":{"bid":"25841","ask":"25842"},"trades":[{"price":"25846"}]}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSD_TICKER: uint64(2_584_200_000),
+			},
+		},
+		"Success - single market number prices": {
+			config:             singleMarketConfig,
+			responseJsonString: `{"data":{"quote":{"bid":25841.5,"ask":25842.5},"trades":[{"price":25846}]}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSD_TICKER: uint64(2_584_250_000),
+			},
+		},
+		"Success - multi market array": {
+			config: multiMarketArrayConfig,
+			responseJsonString: `{"result":{"tickers":[` +
+				`{"symbol":"ETH_USD","bid":"1734.3","ask":"1734.9","last":"1735"},` +
+				`{"symbol":"BTC_USD","bid":"25841","ask":"25842","last":"25846"},` +
+				`{"symbol":"SOL_USD","bid":"20","ask":"21","last":"20.5"}` +
+				`]}}`,
+			exponentMap: BtcAndEthExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSD_TICKER: uint64(2_584_200_000),
+				ETHUSD_TICKER: uint64(1_734_900_000),
+			},
+		},
+		"Success - multi market object": {
+			config: multiMarketObjectConfig,
+			responseJsonString: `{` +
+				`"ETH_USD":{"b":["1734.3","1"],"a":["1734.9","2"],"c":"1735"},` +
+				`"BTC_USD":{"b":["25841","1"],"a":["25842","2"],"c":"25846"}` +
+				`}`,
+			exponentMap: EthExponentMap,
+			expectedPriceMap: map[string]uint64{
+				ETHUSD_TICKER: uint64(1_734_900_000),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			response := testutil.CreateResponseFromJson(tc.responseJsonString)
+			priceFunction := json_path.NewJsonPathPriceFunction(tc.config)
+
+			var prices map[string]uint64
+			var unavailable map[string]error
+			var err error
+			if tc.medianFunctionFails {
+				prices, unavailable, err = priceFunction(response, tc.exponentMap, testutil.MedianErr)
+			} else {
+				prices, unavailable, err = priceFunction(response, tc.exponentMap, lib.Median[uint64])
+			}
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, unavailable)
+			} else {
+				require.Equal(t, tc.expectedPriceMap, prices)
+				pricefeed.ErrorMapsEqual(t, tc.expectedUnavailableMap, unavailable)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetJsonPathValue(t *testing.T) {
+	value := map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{"bid": "1"},
+		},
+	}
+
+	tests := map[string]struct {
+		path          string
+		expectedValue interface{}
+		expectedFound bool
+	}{
+		"Object key": {
+			path:          "data",
+			expectedValue: value["data"],
+			expectedFound: true,
+		},
+		"Array index": {
+			path:          "data.0.bid",
+			expectedValue: "1",
+			expectedFound: true,
+		},
+		"Missing object key": {
+			path: "result",
+		},
+		"Array index out of bounds": {
+			path: "data.1.bid",
+		},
+		"Negative array index": {
+			path: "data.-1.bid",
+		},
+		"Non-numeric array index": {
+			path: "data.first.bid",
+		},
+		"Key into a string": {
+			path: "data.0.bid.value",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, found := json_path.GetJsonPathValue(value, tc.path)
+			require.Equal(t, tc.expectedFound, found)
+			require.Equal(t, tc.expectedValue, result)
+		})
+	}
+}
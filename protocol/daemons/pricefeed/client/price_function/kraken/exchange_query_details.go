@@ -11,5 +11,10 @@ var (
 		Url:           "https://api.kraken.com/0/public/Ticker",
 		PriceFunction: KrakenPriceFunction,
 		IsMultiMarket: true,
+		StreamingDetails: &types.ExchangeStreamingDetails{
+			Url:               "wss://ws.kraken.com",
+			SubscribeMessages: KrakenSubscribeMessages,
+			PriceFunction:     KrakenStreamPriceFunction,
+		},
 	}
 )
@@ -14,3 +14,7 @@ func TestKrakenUrl(t *testing.T) {
 func TestKrakenIsMultiMarket(t *testing.T) {
 	require.True(t, kraken.KrakenDetails.IsMultiMarket)
 }
+
+func TestKrakenStreamingUrl(t *testing.T) {
+	require.Equal(t, "wss://ws.kraken.com", kraken.KrakenDetails.StreamingDetails.Url)
+}
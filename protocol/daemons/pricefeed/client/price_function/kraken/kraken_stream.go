@@ -0,0 +1,202 @@
+package kraken
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+const (
+	// krakenStreamTickerChannel is the name of the ticker channel.
+	krakenStreamTickerChannel = "ticker"
+	// krakenStreamSubscriptionStatusEvent is the event type of responses to subscription requests.
+	krakenStreamSubscriptionStatusEvent = "subscriptionStatus"
+)
+
+// KrakenStreamTicker is our representation of a ticker message received over the Kraken websocket API.
+// It implements interface `Ticker` in util.go.
+// https://docs.kraken.com/websockets/#message-ticker
+type KrakenStreamTicker struct {
+	// `Pair` is the REST API pair name of the ticker, which is used to configure Kraken markets.
+	Pair      string `validate:"required"`
+	AskPrice  string `validate:"required,positive-float-string"`
+	BidPrice  string `validate:"required,positive-float-string"`
+	LastPrice string `validate:"required,positive-float-string"`
+}
+
+// Ensure that KrakenStreamTicker implements the Ticker interface at compile time.
+var _ price_function.Ticker = (*KrakenStreamTicker)(nil)
+
+func (t KrakenStreamTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t KrakenStreamTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t KrakenStreamTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t KrakenStreamTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+// krakenStreamTickerData is the ticker data of a ticker channel message. Unlike the REST API, the price
+// stats contain both strings and integers, so only the first element, the price, is decoded.
+type krakenStreamTickerData struct {
+	AskPriceStats   []json.RawMessage `json:"a"`
+	BidPriceStats   []json.RawMessage `json:"b"`
+	ClosePriceStats []json.RawMessage `json:"c"`
+}
+
+// krakenStreamEvent is a non-channel message, such as a heartbeat or a response to a subscription request.
+type krakenStreamEvent struct {
+	Event        string `json:"event"`
+	Status       string `json:"status"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// krakenSubscribeMessage is a request to subscribe to channels over the Kraken websocket API.
+type krakenSubscribeMessage struct {
+	Event        string                  `json:"event"`
+	Pair         []string                `json:"pair"`
+	Subscription krakenSubscriptionInput `json:"subscription"`
+}
+
+type krakenSubscriptionInput struct {
+	Name string `json:"name"`
+}
+
+// KrakenStreamPair converts a Kraken REST API pair name of a USD market, such as `XXBTZUSD` or `SOLUSD`, into
+// the pair name used by the Kraken websocket API, such as `XBT/USD` or `SOL/USD`. Other pair names are returned
+// unchanged.
+func KrakenStreamPair(ticker string) string {
+	if base, ok := strings.CutSuffix(ticker, "ZUSD"); ok {
+		// Legacy asset codes are prefixed with `X`, e.g. `XXBT` is `XBT`.
+		if len(base) == 4 && base[0] == 'X' {
+			base = base[1:]
+		}
+		return base + "/USD"
+	}
+	if base, ok := strings.CutSuffix(ticker, "USD"); ok {
+		return base + "/USD"
+	}
+	return ticker
+}
+
+// KrakenSubscribeMessages returns the message that subscribes to the ticker channel of each ticker.
+func KrakenSubscribeMessages(tickers []string) ([][]byte, error) {
+	pairs := make([]string, 0, len(tickers))
+	for _, ticker := range tickers {
+		pairs = append(pairs, KrakenStreamPair(ticker))
+	}
+
+	message, err := json.Marshal(krakenSubscribeMessage{
+		Event:        "subscribe",
+		Pair:         pairs,
+		Subscription: krakenSubscriptionInput{Name: krakenStreamTickerChannel},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{message}, nil
+}
+
+// KrakenStreamPriceFunction transforms a websocket message from Kraken into a map of tickers to prices that
+// have been shifted by a market specific exponent. Messages other than ticker updates return no prices.
+func KrakenStreamPriceFunction(
+	message []byte,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (tickerToPrice map[string]uint64, err error) {
+	// Channel messages are arrays, while events are objects.
+	if !bytes.HasPrefix(bytes.TrimSpace(message), []byte("[")) {
+		var event krakenStreamEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			return nil, err
+		}
+		if event.Event == krakenStreamSubscriptionStatusEvent && event.Status == "error" {
+			return nil, fmt.Errorf("kraken stream subscription error: %s", event.ErrorMessage)
+		}
+		return nil, nil
+	}
+
+	// Ticker messages are of the form `[channelID, tickerData, channelName, pair]`.
+	var channelMessage []json.RawMessage
+	if err := json.Unmarshal(message, &channelMessage); err != nil {
+		return nil, err
+	}
+	if len(channelMessage) != 4 {
+		return nil, nil
+	}
+	var channelName, pair string
+	if err := json.Unmarshal(channelMessage[2], &channelName); err != nil {
+		return nil, err
+	}
+	if channelName != krakenStreamTickerChannel {
+		return nil, nil
+	}
+	if err := json.Unmarshal(channelMessage[3], &pair); err != nil {
+		return nil, err
+	}
+
+	// Find the configured ticker of the pair.
+	var ticker string
+	for configuredTicker := range tickerToExponent {
+		if KrakenStreamPair(configuredTicker) == pair {
+			ticker = configuredTicker
+			break
+		}
+	}
+	if ticker == "" {
+		return nil, nil
+	}
+
+	var data krakenStreamTickerData
+	if err := json.Unmarshal(channelMessage[1], &data); err != nil {
+		return nil, err
+	}
+	askPrice, err := getFirstStreamPriceStat(data.AskPriceStats)
+	if err != nil {
+		return nil, err
+	}
+	bidPrice, err := getFirstStreamPriceStat(data.BidPriceStats)
+	if err != nil {
+		return nil, err
+	}
+	lastPrice, err := getFirstStreamPriceStat(data.ClosePriceStats)
+	if err != nil {
+		return nil, err
+	}
+
+	return price_function.GetMedianPricesFromStreamedTickers(
+		[]KrakenStreamTicker{
+			{
+				Pair:      ticker,
+				AskPrice:  askPrice,
+				BidPrice:  bidPrice,
+				LastPrice: lastPrice,
+			},
+		},
+		tickerToExponent,
+		resolver,
+	)
+}
+
+// getFirstStreamPriceStat returns the price, which is the first element of a price stat in a ticker message.
+func getFirstStreamPriceStat(stats []json.RawMessage) (string, error) {
+	if len(stats) == 0 {
+		return "", fmt.Errorf("kraken stream ticker is missing price stats")
+	}
+	var price string
+	if err := json.Unmarshal(stats[0], &price); err != nil {
+		return "", err
+	}
+	return price, nil
+}
@@ -0,0 +1,85 @@
+package kraken_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/kraken"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKrakenStreamPair(t *testing.T) {
+	tests := map[string]string{
+		"XXBTZUSD": "XBT/USD",
+		"XETHZUSD": "ETH/USD",
+		"USDTZUSD": "USDT/USD",
+		"SOLUSD":   "SOL/USD",
+		"XDGUSD":   "XDG/USD",
+		"MATICUSD": "MATIC/USD",
+		"XBTEUR":   "XBTEUR",
+	}
+	for ticker, expectedPair := range tests {
+		t.Run(ticker, func(t *testing.T) {
+			require.Equal(t, expectedPair, kraken.KrakenStreamPair(ticker))
+		})
+	}
+}
+
+func TestKrakenSubscribeMessages(t *testing.T) {
+	messages, err := kraken.KrakenSubscribeMessages([]string{BTCUSDC_TICKER, ETHUSDC_TICKER})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[][]byte{[]byte(`{"event":"subscribe","pair":["XBT/USD","ETH/USD"],"subscription":{"name":"ticker"}}`)},
+		messages,
+	)
+}
+
+func TestKrakenStreamPriceFunction(t *testing.T) {
+	tests := map[string]struct {
+		message string
+
+		expectedPriceMap map[string]uint64
+		expectedError    string
+	}{
+		"Success - ticker": {
+			message: `[340,{"a":["50000.20000",1,"1.000"],"b":["49999.80000",2,"2.000"],` +
+				`"c":["50000.00000","0.00398963"],"v":["1.0","2.0"]},"ticker","XBT/USD"]`,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDC_TICKER: 5_000_000_000,
+			},
+		},
+		"Success - ticker not subscribed": {
+			message: `[341,{"a":["5.2",1,"1.000"],"b":["5.1",2,"2.000"],"c":["5.0","1.0"]},"ticker","LINK/USD"]`,
+		},
+		"Success - other channel": {
+			message: `[342,[["50000.0","0.1","1534614057.321597","s","l",""]],"trade","XBT/USD"]`,
+		},
+		"Success - heartbeat": {
+			message: `{"event":"heartbeat"}`,
+		},
+		"Failure - subscription error": {
+			message:       `{"event":"subscriptionStatus","status":"error","errorMessage":"Currency pair not supported"}`,
+			expectedError: "kraken stream subscription error: Currency pair not supported",
+		},
+		"Failure - missing price stats": {
+			message:       `[340,{"a":[],"b":["49999.8",2,"2.000"],"c":["50000.0","0.1"]},"ticker","XBT/USD"]`,
+			expectedError: "kraken stream ticker is missing price stats",
+		},
+		"Failure - invalid ticker": {
+			message:       `[340,{"a":["0",1,"1.000"],"b":["49999.8",2,"2.000"],"c":["50000.0","0.1"]},"ticker","XBT/USD"]`,
+			expectedError: "invalid ticker XXBTZUSD",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prices, err := kraken.KrakenStreamPriceFunction([]byte(tc.message), BtcAndEthExponentMap, lib.Median[uint64])
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPriceMap, prices)
+		})
+	}
+}
@@ -7,9 +7,10 @@ import (
 
 var (
 	OkxDetails = types.ExchangeQueryDetails{
-		Exchange:      exchange_common.EXCHANGE_ID_OKX,
-		Url:           "https://www.okx.com/api/v5/market/tickers?instType=SPOT",
-		PriceFunction: OkxPriceFunction,
-		IsMultiMarket: true,
+		Exchange:       exchange_common.EXCHANGE_ID_OKX,
+		Url:            "https://www.okx.com/api/v5/market/tickers?instType=SPOT",
+		PriceFunction:  OkxPriceFunction,
+		VolumeFunction: OkxVolumeFunction,
+		IsMultiMarket:  true,
 	}
 )
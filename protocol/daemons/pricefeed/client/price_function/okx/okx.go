@@ -22,10 +22,12 @@ type OkxTicker struct {
 	AskPrice  string `json:"askPx" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bidPx" validate:"required,positive-float-string"`
 	LastPrice string `json:"last" validate:"required,positive-float-string"`
+	Volume    string `json:"vol24h"`
 }
 
 // Ensure that OkxTicker implements the Ticker interface at compile time.
 var _ price_function.Ticker = (*OkxTicker)(nil)
+var _ price_function.VolumeTicker = (*OkxTicker)(nil)
 
 func (t OkxTicker) GetPair() string {
 	return t.Pair
@@ -43,6 +45,10 @@ func (t OkxTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t OkxTicker) GetVolume() string {
+	return t.Volume
+}
+
 // OkxPriceFunction transforms an API response from Okx into a map of tickers
 // to prices that have been shifted by a market specific exponent.
 func OkxPriceFunction(
@@ -67,3 +73,22 @@ func OkxPriceFunction(
 		resolver,
 	)
 }
+
+// OkxVolumeFunction transforms an API response from Okx into a map of tickers to their trailing 24 hour volume
+// in units of the base asset.
+func OkxVolumeFunction(
+	response *http.Response,
+	marketPriceExponent map[string]int32,
+) (tickerToVolume map[string]uint64, err error) {
+	var okxResponseBody OkxResponseBody
+	err = json.NewDecoder(response.Body).Decode(&okxResponseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if okxResponseBody.Code != "0" {
+		return nil, errors.New(`okx response code is not "0"`)
+	}
+
+	return price_function.GetVolumesFromTickers(okxResponseBody.Tickers, marketPriceExponent), nil
+}
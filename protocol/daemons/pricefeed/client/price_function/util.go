@@ -173,6 +173,38 @@ type Ticker interface {
 	GetLastPrice() string
 }
 
+// VolumeTicker encodes a ticker response returned by an exchange API that reports the trailing 24 hour volume
+// of the ticker in units of its base asset.
+type VolumeTicker interface {
+	GetPair() string
+	GetVolume() string
+}
+
+// GetVolumesFromTickers returns the trailing volume, rounded down to whole units of the base asset, of each
+// ticker in `tickerToExponent` that is present in `tickers`. Tickers with a missing, invalid or zero volume
+// are omitted, since volume is optional.
+func GetVolumesFromTickers[T VolumeTicker](
+	tickers []T,
+	tickerToExponent map[string]int32,
+) (tickerToVolume map[string]uint64) {
+	tickerToVolume = make(map[string]uint64, len(tickerToExponent))
+	for _, ticker := range tickers {
+		tickerPair := ticker.GetPair()
+		if _, exists := tickerToExponent[tickerPair]; !exists {
+			continue
+		}
+		volume, ok := new(big.Float).SetString(ticker.GetVolume())
+		if !ok || volume.Sign() <= 0 {
+			continue
+		}
+		// `Uint64` rounds towards zero and saturates at `math.MaxUint64`.
+		if wholeVolume, _ := volume.Uint64(); wholeVolume > 0 {
+			tickerToVolume[tickerPair] = wholeVolume
+		}
+	}
+	return tickerToVolume
+}
+
 // GetMedianPricesFromTickers processes through a list of `tickers` and calculates a median price (from
 // `LastPrice`, `AskPrice`, and `BidPrice`) for each ticker in `tickerToExponent` and marks a ticker
 // as unavailable if it's not present in `tickers` or its ticker's validation or calculation fails.
@@ -243,6 +275,30 @@ func GetMedianPricesFromTickers[T Ticker](
 	return tickerToPrice, unavailableTickers, nil
 }
 
+// GetMedianPricesFromStreamedTickers calculates a median price for each of the `tickers` received in a single
+// websocket message that is present in `tickerToExponent`. Unlike `GetMedianPricesFromTickers`, tickers that
+// are missing from the message are not considered unavailable, since a websocket message typically only
+// contains updates for a subset of subscribed tickers. An error is returned if any requested ticker in the
+// message fails validation or price calculation.
+func GetMedianPricesFromStreamedTickers[T Ticker](
+	tickers []T,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (tickerToPrice map[string]uint64, err error) {
+	tickerToPrice, unavailableTickers, err := GetMedianPricesFromTickers(tickers, tickerToExponent, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ticker := range tickers {
+		if err, unavailable := unavailableTickers[ticker.GetPair()]; unavailable {
+			return nil, fmt.Errorf("invalid ticker %v: %w", ticker.GetPair(), err)
+		}
+	}
+
+	return tickerToPrice, nil
+}
+
 // ConvertFloat64ToString converts a `float64` to `string`.
 func ConvertFloat64ToString(num float64) string {
 	return strconv.FormatFloat(num, 'f', -1, 64)
@@ -3,6 +3,7 @@ package price_function
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"testing"
 
@@ -431,3 +432,46 @@ func bigSliceToFloatSlice(bigFloat []*big.Float) []float64 {
 
 	return floatSlice
 }
+
+// testVolumeTicker implements the `VolumeTicker` interface.
+type testVolumeTicker struct {
+	pair   string
+	volume string
+}
+
+func (t testVolumeTicker) GetPair() string {
+	return t.pair
+}
+
+func (t testVolumeTicker) GetVolume() string {
+	return t.volume
+}
+
+func TestGetVolumesFromTickers(t *testing.T) {
+	tickers := []testVolumeTicker{
+		{pair: BTCUSDC, volume: "62285.78422"},
+		{pair: ETHUSDC, volume: "0.5"},
+		{pair: "SOLUSDC", volume: "100"},
+		{pair: "LINKUSDC", volume: "invalid"},
+		{pair: "ATOMUSDC", volume: "-100"},
+		{pair: "DOGEUSDC", volume: ""},
+		{pair: "ADAUSDC", volume: "1e30"},
+	}
+	tickerToExponent := map[string]int32{
+		BTCUSDC:    -5,
+		ETHUSDC:    -6,
+		"LINKUSDC": -6,
+		"ATOMUSDC": -6,
+		"DOGEUSDC": -6,
+		"ADAUSDC":  -6,
+	}
+
+	require.Equal(
+		t,
+		map[string]uint64{
+			BTCUSDC:   62_285,
+			"ADAUSDC": math.MaxUint64,
+		},
+		GetVolumesFromTickers(tickers, tickerToExponent),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
 	pricetypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	"net/http"
+	"sync"
 	"time"
 
 	gometrics "github.com/armon/go-metrics"
@@ -18,6 +19,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/gorilla/websocket"
 )
 
 var (
@@ -149,6 +151,9 @@ func (s *SubTaskRunnerImpl) StartPriceEncoder(
 // NOTE: the subtask response shared channel has a buffer size and goroutines will block if the buffer is full.
 // NOTE: the price fetcher kicks off 1 to n go routines every time the subtask loop runs, but the subtask
 // loop blocks until all go routines are done. This means that these go routines are not tracked by the wait group.
+// NOTE: for exchanges with `StreamingDetails`, the price fetcher also streams prices over a websocket, and
+// only queries markets over REST while they are not receiving streamed prices. The client only passes
+// `StreamingDetails` if streaming is enabled by the daemon flags.
 func (s *SubTaskRunnerImpl) StartPriceFetcher(
 	ticker *time.Ticker,
 	stop <-chan bool,
@@ -190,6 +195,17 @@ func (s *SubTaskRunnerImpl) StartPriceFetcher(
 	// itself to the config's list of exchange config updaters here.
 	configs.AddPriceFetcher(priceFetcher)
 
+	// For exchanges that support streaming, stream prices over a websocket in the background. Markets
+	// that are not receiving streamed prices are still queried by the task loop below.
+	var priceStreamWaitGroup sync.WaitGroup
+	if exchangeDetails.StreamingDetails != nil {
+		priceStreamWaitGroup.Add(1)
+		go func() {
+			defer priceStreamWaitGroup.Done()
+			priceFetcher.RunPriceStream(stop, websocket.DefaultDialer)
+		}()
+	}
+
 	requestHandler := daemontypes.NewRequestHandlerImpl(
 		&HttpClient,
 	)
@@ -203,7 +219,9 @@ func (s *SubTaskRunnerImpl) StartPriceFetcher(
 			priceFetcher.RunTaskLoop(requestHandler)
 
 		case <-stop:
-			// Signal to the encoder that the price fetcher is done.
+			// Wait for the price stream to stop writing to the channel, then signal to the encoder
+			// that the price fetcher is done.
+			priceStreamWaitGroup.Wait()
 			close(bCh)
 			return
 		}
@@ -424,6 +442,7 @@ func transformPriceUpdates(
 				ExchangeId:     exchangeId,
 				Price:          marketPriceTimestamp.Price,
 				LastUpdateTime: &priceUpdateTime,
+				Volume:         marketPriceTimestamp.Volume,
 			}
 			marketPriceUpdate.ExchangePrices = append(marketPriceUpdate.ExchangePrices, exchangePrice)
 		}
@@ -34,3 +34,25 @@ func (ecj *ExchangeConfigJson) Validate(
 	}
 	return nil
 }
+
+// RemoveUnknownExchanges removes the configs of exchanges that are not in `exchangeNames`, i.e. exchanges that are
+// not queried by this daemon, and returns their names. Json path exchanges are configured per validator, so a market
+// may list exchanges that only some daemons query. Configs without an exchange name are kept so that they fail
+// validation.
+func (ecj *ExchangeConfigJson) RemoveUnknownExchanges(exchangeNames []ExchangeId) (unknownExchanges []ExchangeId) {
+	exchangeNameMap := make(map[ExchangeId]struct{}, len(exchangeNames))
+	for _, exchangeName := range exchangeNames {
+		exchangeNameMap[exchangeName] = struct{}{}
+	}
+
+	knownExchanges := make([]ExchangeMarketConfigJson, 0, len(ecj.Exchanges))
+	for _, exchange := range ecj.Exchanges {
+		if _, exists := exchangeNameMap[exchange.ExchangeName]; exists || exchange.ExchangeName == "" {
+			knownExchanges = append(knownExchanges, exchange)
+		} else {
+			unknownExchanges = append(unknownExchanges, exchange.ExchangeName)
+		}
+	}
+	ecj.Exchanges = knownExchanges
+	return unknownExchanges
+}
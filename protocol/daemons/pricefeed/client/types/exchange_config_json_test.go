@@ -52,3 +52,25 @@ func TestExchangeConfigJsonValidate_Mixed(t *testing.T) {
 		})
 	}
 }
+
+func TestExchangeConfigJsonRemoveUnknownExchanges(t *testing.T) {
+	exchangeConfigJson := types.ExchangeConfigJson{
+		Exchanges: []types.ExchangeMarketConfigJson{
+			{ExchangeName: "binance", Ticker: "BTC-USDT"},
+			{ExchangeName: "json-path-exchange", Ticker: "BTC-USDT"},
+			{Ticker: "BTC-USDT"},
+		},
+	}
+
+	unknownExchanges := exchangeConfigJson.RemoveUnknownExchanges([]types.ExchangeId{"binance"})
+	require.Equal(t, []types.ExchangeId{"json-path-exchange"}, unknownExchanges)
+	// Configs without an exchange name are kept so that they fail validation.
+	require.Equal(
+		t,
+		[]types.ExchangeMarketConfigJson{
+			{ExchangeName: "binance", Ticker: "BTC-USDT"},
+			{Ticker: "BTC-USDT"},
+		},
+		exchangeConfigJson.Exchanges,
+	)
+}
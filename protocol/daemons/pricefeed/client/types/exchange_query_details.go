@@ -21,6 +21,18 @@ type ExchangeQueryDetails struct {
 		unavailableTickers map[string]error,
 		err error,
 	)
+	// VolumeFunction, if set, computes a map of tickers to their trailing 24 hour volume, in whole units of the
+	// ticker's base asset, from the same response as `PriceFunction`. Tickers without a volume are omitted.
+	VolumeFunction func(
+		response *http.Response,
+		tickerToPriceExponent map[string]int32,
+	) (
+		tickerToVolume map[string]uint64,
+		err error,
+	)
 	// IsMultiMarket indicates whether the url query response contains multiple tickers.
 	IsMultiMarket bool
+	// StreamingDetails, if set, describes how to stream prices from the exchange over a websocket. Markets
+	// that have not received a streamed price recently are still queried from `Url`.
+	StreamingDetails *ExchangeStreamingDetails
 }
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+// ExchangeStreamingDetails represents the information needed to stream prices from a specific exchange
+// over a persistent websocket connection.
+type ExchangeStreamingDetails struct {
+	// Url is the websocket url of the exchange.
+	Url string
+	// SubscribeMessages returns the messages to send on a new connection in order to subscribe to price
+	// updates for the tickers.
+	SubscribeMessages func(tickers []string) (messages [][]byte, err error)
+	// PriceFunction computes a map of tickers to prices from a single websocket message. Messages that do
+	// not contain price updates, such as subscription acknowledgements and heartbeats, return no prices.
+	PriceFunction func(
+		message []byte,
+		tickerToPriceExponent map[string]int32,
+		resolver types.Resolver,
+	) (
+		tickerToPrice map[string]uint64,
+		err error,
+	)
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// JsonPathSeparator separates the keys of a json path. Keys that are non-negative integers index into
+	// json arrays, e.g. `data.0.bid` is the `bid` field of the first element of the `data` array.
+	JsonPathSeparator = "."
+	// TickerPlaceholder is replaced by the requested ticker(s) in exchange urls.
+	TickerPlaceholder = "$"
+)
+
+// JsonPathExchangesConfigJson demarshals the daemon-side configuration of all exchanges that are queried
+// by the generic json path adapter. These exchanges are queried in addition to the static exchanges of the
+// daemon, and markets reference them by `exchangeName` in their exchange config json like any other exchange.
+type JsonPathExchangesConfigJson struct {
+	Exchanges []JsonPathExchangeConfigJson `json:"exchanges"`
+}
+
+// JsonPathExchangeConfigJson describes how to query an exchange's REST API and where to find the bid, ask and
+// last prices of a ticker in its json response, e.g.
+//
+//	{
+//	  "exchangeName": "Foo",
+//	  "url": "https://api.foo.com/v1/ticker?symbol=$",
+//	  "bidPath": "data.bid",
+//	  "askPath": "data.ask",
+//	  "lastPath": "data.last"
+//	}
+//
+// The price of a ticker is the median of its bid, ask and last prices. Prices may be json strings or numbers.
+type JsonPathExchangeConfigJson struct {
+	ExchangeName string `json:"exchangeName"`
+	// Url is the url to query the exchange. For single-market exchanges, `$` is replaced by the ticker.
+	Url string `json:"url"`
+	// IsMultiMarket indicates whether the url query response contains multiple tickers.
+	IsMultiMarket bool `json:"isMultiMarket,omitempty"`
+	// TickersPath is the path to the tickers in the response of a multi-market exchange. The tickers are either
+	// an array of objects, each containing its ticker at `TickerPath`, or an object keyed by ticker if
+	// `TickerPath` is empty. An empty `TickersPath` refers to the entire response.
+	TickersPath string `json:"tickersPath,omitempty"`
+	// TickerPath is the path to the ticker within each element of the tickers array of a multi-market exchange.
+	TickerPath string `json:"tickerPath,omitempty"`
+	// BidPath, AskPath and LastPath are the paths to the bid, ask and last prices of a ticker, relative to the
+	// response for single-market exchanges and relative to the ticker's element for multi-market exchanges.
+	BidPath  string `json:"bidPath"`
+	AskPath  string `json:"askPath"`
+	LastPath string `json:"lastPath"`
+	// IntervalMs, TimeoutMs and MaxQueries optionally override the default query config of the exchange.
+	IntervalMs uint32 `json:"intervalMs,omitempty"`
+	TimeoutMs  uint32 `json:"timeoutMs,omitempty"`
+	MaxQueries uint32 `json:"maxQueries,omitempty"`
+}
+
+// Validate validates the json path exchanges configuration, checking that each exchange is valid and that
+// exchange names are unique.
+func (jpecj *JsonPathExchangesConfigJson) Validate() error {
+	exchangeNames := make(map[string]struct{}, len(jpecj.Exchanges))
+	for _, exchange := range jpecj.Exchanges {
+		if err := exchange.Validate(); err != nil {
+			return fmt.Errorf("invalid json path exchange: %w", err)
+		}
+		if _, exists := exchangeNames[exchange.ExchangeName]; exists {
+			return fmt.Errorf("duplicate json path exchange name '%v'", exchange.ExchangeName)
+		}
+		exchangeNames[exchange.ExchangeName] = struct{}{}
+	}
+	return nil
+}
+
+// Validate validates the json path exchange configuration. It returns an error if the configuration is invalid.
+func (jpecj *JsonPathExchangeConfigJson) Validate() error {
+	if jpecj.ExchangeName == "" {
+		return fmt.Errorf("exchange name cannot be empty")
+	}
+
+	parsedUrl, err := url.Parse(jpecj.Url)
+	if err != nil {
+		return fmt.Errorf("url '%v' for exchange '%v' is not valid: %w", jpecj.Url, jpecj.ExchangeName, err)
+	}
+	if (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		return fmt.Errorf("url '%v' for exchange '%v' must be an absolute http(s) url", jpecj.Url, jpecj.ExchangeName)
+	}
+
+	if jpecj.IsMultiMarket {
+		if jpecj.TickersPath != "" {
+			if err := validateJsonPath(jpecj.TickersPath); err != nil {
+				return fmt.Errorf("tickersPath for exchange '%v' is not valid: %w", jpecj.ExchangeName, err)
+			}
+		}
+		if jpecj.TickerPath != "" {
+			if err := validateJsonPath(jpecj.TickerPath); err != nil {
+				return fmt.Errorf("tickerPath for exchange '%v' is not valid: %w", jpecj.ExchangeName, err)
+			}
+		}
+	} else {
+		if !strings.Contains(jpecj.Url, TickerPlaceholder) {
+			return fmt.Errorf(
+				"url for single-market exchange '%v' must contain the ticker placeholder '%v'",
+				jpecj.ExchangeName,
+				TickerPlaceholder,
+			)
+		}
+		if jpecj.TickersPath != "" || jpecj.TickerPath != "" {
+			return fmt.Errorf(
+				"tickersPath and tickerPath are only supported by multi-market exchanges, exchange '%v' is "+
+					"single-market",
+				jpecj.ExchangeName,
+			)
+		}
+	}
+
+	if err := validateJsonPath(jpecj.BidPath); err != nil {
+		return fmt.Errorf("bidPath for exchange '%v' is not valid: %w", jpecj.ExchangeName, err)
+	}
+	if err := validateJsonPath(jpecj.AskPath); err != nil {
+		return fmt.Errorf("askPath for exchange '%v' is not valid: %w", jpecj.ExchangeName, err)
+	}
+	if err := validateJsonPath(jpecj.LastPath); err != nil {
+		return fmt.Errorf("lastPath for exchange '%v' is not valid: %w", jpecj.ExchangeName, err)
+	}
+	return nil
+}
+
+// validateJsonPath returns an error if the json path is empty or contains an empty key.
+func validateJsonPath(path string) error {
+	if path == "" {
+		return fmt.Errorf("path cannot be empty")
+	}
+	for _, key := range strings.Split(path, JsonPathSeparator) {
+		if key == "" {
+			return fmt.Errorf("path '%v' contains an empty key", path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	validSingleMarketJsonPathExchangeConfig = types.JsonPathExchangeConfigJson{
+		ExchangeName: "Foo",
+		Url:          "https://api.foo.com/v1/ticker?symbol=$",
+		BidPath:      "data.bid",
+		AskPath:      "data.ask",
+		LastPath:     "data.last",
+	}
+	validMultiMarketJsonPathExchangeConfig = types.JsonPathExchangeConfigJson{
+		ExchangeName:  "Bar",
+		Url:           "https://api.bar.com/v1/tickers",
+		IsMultiMarket: true,
+		TickersPath:   "result",
+		TickerPath:    "symbol",
+		BidPath:       "bid",
+		AskPath:       "ask",
+		LastPath:      "last",
+	}
+)
+
+func TestJsonPathExchangeConfigJsonValidate_Mixed(t *testing.T) {
+	tests := map[string]struct {
+		modify      func(config *types.JsonPathExchangeConfigJson)
+		multiMarket bool
+		expectedErr error
+	}{
+		"Valid - single market": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {},
+		},
+		"Valid - multi market": {
+			modify:      func(config *types.JsonPathExchangeConfigJson) {},
+			multiMarket: true,
+		},
+		"Valid - multi market tickers keyed by ticker at the root": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.TickersPath = ""
+				config.TickerPath = ""
+			},
+			multiMarket: true,
+		},
+		"Invalid - no exchange name": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.ExchangeName = ""
+			},
+			expectedErr: fmt.Errorf("exchange name cannot be empty"),
+		},
+		"Invalid - relative url": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.Url = "/v1/ticker?symbol=$"
+			},
+			expectedErr: fmt.Errorf("url '/v1/ticker?symbol=$' for exchange 'Foo' must be an absolute http(s) url"),
+		},
+		"Invalid - websocket url": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.Url = "wss://api.foo.com/v1/ticker?symbol=$"
+			},
+			expectedErr: fmt.Errorf(
+				"url 'wss://api.foo.com/v1/ticker?symbol=$' for exchange 'Foo' must be an absolute http(s) url",
+			),
+		},
+		"Invalid - single market url without ticker placeholder": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.Url = "https://api.foo.com/v1/ticker"
+			},
+			expectedErr: fmt.Errorf(
+				"url for single-market exchange 'Foo' must contain the ticker placeholder '$'",
+			),
+		},
+		"Invalid - single market with tickers path": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.TickersPath = "data"
+			},
+			expectedErr: fmt.Errorf(
+				"tickersPath and tickerPath are only supported by multi-market exchanges, exchange 'Foo' is " +
+					"single-market",
+			),
+		},
+		"Invalid - multi market tickers path with empty key": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.TickersPath = "result..tickers"
+			},
+			multiMarket: true,
+			expectedErr: fmt.Errorf(
+				"tickersPath for exchange 'Bar' is not valid: path 'result..tickers' contains an empty key",
+			),
+		},
+		"Invalid - no bid path": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.BidPath = ""
+			},
+			expectedErr: fmt.Errorf("bidPath for exchange 'Foo' is not valid: path cannot be empty"),
+		},
+		"Invalid - ask path with empty key": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.AskPath = "data.ask."
+			},
+			expectedErr: fmt.Errorf("askPath for exchange 'Foo' is not valid: path 'data.ask.' contains an empty key"),
+		},
+		"Invalid - no last path": {
+			modify: func(config *types.JsonPathExchangeConfigJson) {
+				config.LastPath = ""
+			},
+			expectedErr: fmt.Errorf("lastPath for exchange 'Foo' is not valid: path cannot be empty"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := validSingleMarketJsonPathExchangeConfig
+			if tc.multiMarket {
+				config = validMultiMarketJsonPathExchangeConfig
+			}
+			tc.modify(&config)
+
+			err := config.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJsonPathExchangesConfigJsonValidate_Mixed(t *testing.T) {
+	tests := map[string]struct {
+		config      types.JsonPathExchangesConfigJson
+		expectedErr error
+	}{
+		"Valid - no exchanges": {
+			config: types.JsonPathExchangesConfigJson{},
+		},
+		"Valid": {
+			config: types.JsonPathExchangesConfigJson{
+				Exchanges: []types.JsonPathExchangeConfigJson{
+					validSingleMarketJsonPathExchangeConfig,
+					validMultiMarketJsonPathExchangeConfig,
+				},
+			},
+		},
+		"Invalid - invalid exchange": {
+			config: types.JsonPathExchangesConfigJson{
+				Exchanges: []types.JsonPathExchangeConfigJson{
+					validSingleMarketJsonPathExchangeConfig,
+					{},
+				},
+			},
+			expectedErr: fmt.Errorf("invalid json path exchange: exchange name cannot be empty"),
+		},
+		"Invalid - duplicate exchange name": {
+			config: types.JsonPathExchangesConfigJson{
+				Exchanges: []types.JsonPathExchangeConfigJson{
+					validSingleMarketJsonPathExchangeConfig,
+					validSingleMarketJsonPathExchangeConfig,
+				},
+			},
+			expectedErr: fmt.Errorf("duplicate json path exchange name 'Foo'"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package types
 
 import "time"
 
-// MarketPriceTimestamp maintains a `MarketId`, `Price` and `LastUpdatedAt`.
+// MarketPriceTimestamp maintains a `MarketId`, `Price` and `LastUpdatedAt`, along with the trailing
+// `Volume` of the market if the exchange reports it.
 type MarketPriceTimestamp struct {
 	MarketId      uint32
 	Price         uint64
 	LastUpdatedAt time.Time
+	Volume        uint64
 }
@@ -40,6 +40,9 @@ func (mtp *MarketToPrice) UpdatePrice(
 		mtp.MarketToPriceTimestamp[marketId] = priceTimestamp
 	}
 	isUpdated := priceTimestamp.UpdatePrice(marketPriceTimestamp.Price, &marketPriceTimestamp.LastUpdatedAt)
+	if isUpdated {
+		priceTimestamp.UpdateVolume(marketPriceTimestamp.Volume)
+	}
 
 	validity := metrics.Valid
 	if !isUpdated {
@@ -61,6 +64,7 @@ func (mtp *MarketToPrice) GetAllPrices() []MarketPriceTimestamp {
 			MarketId:      marketId,
 			LastUpdatedAt: priceTimestamp.LastUpdateTime,
 			Price:         priceTimestamp.Price,
+			Volume:        priceTimestamp.Volume,
 		}
 		marketPricesForExchange = append(marketPricesForExchange, mpt)
 	}
@@ -245,6 +245,18 @@ func (pfmmc *PricefeedMutableMarketConfigsImpl) ValidateAndTransformParams(marke
 			continue
 		}
 
+		// Skip exchanges that this daemon does not query rather than the whole market, since json path exchanges
+		// are only configured on some validators. The market is only invalid if none of its exchanges are queried.
+		unknownExchanges := exchangeConfigJson.RemoveUnknownExchanges(exchangeNames)
+		if len(exchangeConfigJson.Exchanges) == 0 && len(unknownExchanges) > 0 {
+			marketParamErrors[marketParam.Id] = fmt.Errorf(
+				"invalid exchange config json for market param %v: none of the exchanges %v are queried",
+				marketParam.Id,
+				unknownExchanges,
+			)
+			continue
+		}
+
 		err = exchangeConfigJson.Validate(exchangeNames, marketNameToId)
 		if err != nil {
 			marketParamErrors[marketParam.Id] = fmt.Errorf(
@@ -230,8 +230,8 @@ func TestValidateAndTransformParams_Mixed(t *testing.T) {
 			},
 			expectedMarketParamErrors: map[types.MarketId]error{
 				1: errors.New(
-					"invalid exchange config json for market param 1: json: cannot unmarshal string into Go struct " +
-						"field ExchangeConfigJson.exchanges of type []types.ExchangeMarketConfigJson",
+					"invalid market param 1: failed to parse exchange config json for market 1: json: cannot " +
+						"unmarshal string into Go struct field priceAggregationJson.exchanges",
 				),
 			},
 			expectedMutableMarketConfigs:   testEmptyMarketConfigs,
@@ -265,8 +265,7 @@ func TestValidateAndTransformParams_Mixed(t *testing.T) {
 			},
 			expectedMarketParamErrors: map[types.MarketId]error{
 				1: errors.New(
-					"invalid exchange config json for market param 1: invalid exchange: exchange name 'invalid' is " +
-						"not valid",
+					"invalid exchange config json for market param 1: none of the exchanges [invalid] are queried",
 				),
 			},
 			expectedMutableMarketConfigs:   testEmptyMarketConfigs,
@@ -362,6 +361,35 @@ func TestValidateAndTransformParams_Mixed(t *testing.T) {
 				},
 			},
 		},
+		"Valid: unknown exchanges are skipped": {
+			marketParams: []prices_types.MarketParam{
+				validMarketParamWithExchangeConfig(
+					fmt.Sprintf(`{"exchanges":[%s,%s]}`, exchangeConfigInvalidExchangeName, exchangeConfigBinanceBtc),
+				),
+			},
+			expectedMutableMarketConfigs: map[types.MarketId]*types.MutableMarketConfig{
+				1: {
+					Id:           1,
+					Exponent:     -2,
+					Pair:         "BTC-USD",
+					MinExchanges: 1,
+				},
+			},
+			expectedMutableExchangeConfigs: map[types.ExchangeId]*types.MutableExchangeMarketConfig{
+				exchangeIdCoinbase: {
+					Id:                   exchangeIdCoinbase,
+					MarketToMarketConfig: map[types.MarketId]types.MarketConfig{},
+				},
+				exchangeIdBinance: {
+					Id: exchangeIdBinance,
+					MarketToMarketConfig: map[types.MarketId]types.MarketConfig{
+						1: {
+							Ticker: "BTCUSDT",
+						},
+					},
+				},
+			},
+		},
 		"Mixed: 1 invalid (invalid exchange config: missing adjust-by market), 1 valid": {
 			marketParams: []prices_types.MarketParam{
 				{
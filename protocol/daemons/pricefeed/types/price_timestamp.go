@@ -4,10 +4,11 @@ import (
 	"time"
 )
 
-// PriceTimestamp maintains a price and its last update timestamp.
+// PriceTimestamp maintains a price, its last update timestamp and the last reported trailing volume.
 type PriceTimestamp struct {
 	LastUpdateTime time.Time
 	Price          uint64
+	Volume         uint64
 }
 
 // NewPriceTimestamp creates a new PriceTimestamp.
@@ -36,3 +37,11 @@ func (pt *PriceTimestamp) GetValidPrice(cutoffTime time.Time) (uint64, bool) {
 	}
 	return pt.Price, true
 }
+
+// UpdateVolume updates the trailing volume. A volume of zero means the volume was not reported, e.g. by a
+// streamed price, and does not overwrite the last reported volume.
+func (pt *PriceTimestamp) UpdateVolume(volume uint64) {
+	if volume > 0 {
+		pt.Volume = volume
+	}
+}
@@ -14,6 +14,7 @@ import (
 // LiquidationServer defines the fields required for liquidation updates.
 type LiquidationServer struct {
 	liquidatableSubaccountIds *liquidationtypes.LiquidatableSubaccountIds
+	updatedSubaccountIds      *liquidationtypes.UpdatedSubaccountIds
 }
 
 // WithLiquidatableSubaccountIds sets the `liquidatableSubaccountIds` field.
@@ -26,6 +27,16 @@ func (server *Server) WithLiquidatableSubaccountIds(
 	return server
 }
 
+// WithUpdatedSubaccountIds sets the `updatedSubaccountIds` field.
+// This is updated by the `x/subaccounts` module with the ids of subaccounts updated in committed
+// blocks, and read by the liquidation service to only recheck subaccounts that changed.
+func (server *Server) WithUpdatedSubaccountIds(
+	updatedSubaccountIds *liquidationtypes.UpdatedSubaccountIds,
+) *Server {
+	server.updatedSubaccountIds = updatedSubaccountIds
+	return server
+}
+
 // ExpectLiquidationsDaemon registers the liquidations daemon with the server. This is required
 // in order to ensure that the daemon service is called at least once during every
 // maximumAcceptableUpdateDelay duration. It will cause the protocol to panic if the daemon does not
@@ -34,8 +45,8 @@ func (server *Server) ExpectLiquidationsDaemon(maximumAcceptableUpdateDelay time
 	server.registerDaemon(types.LiquidationsDaemonServiceName, maximumAcceptableUpdateDelay)
 }
 
-// LiquidateSubaccounts stores the list of potentially liquidatable subaccount ids
-// in a go-routine safe slice.
+// LiquidateSubaccounts stores the lists of potentially liquidatable subaccount ids and
+// subaccount ids with negative total net collateral in go-routine safe slices.
 func (s *Server) LiquidateSubaccounts(
 	ctx context.Context,
 	req *api.LiquidateSubaccountsRequest,
@@ -47,6 +58,13 @@ func (s *Server) LiquidateSubaccounts(
 		metrics.Received,
 		metrics.Count,
 	)
+	telemetry.ModuleSetGauge(
+		metrics.LiquidationDaemon,
+		float32(len(req.NegativeTncSubaccountIds)),
+		metrics.NegativeTncSubaccountIds,
+		metrics.Received,
+		metrics.Count,
+	)
 	// If the daemon is unable to report a response, there is either an error in the registration of
 	// this daemon, or another one. In either case, the protocol should panic.
 	if err := s.reportResponse(types.LiquidationsDaemonServiceName); err != nil {
@@ -54,5 +72,28 @@ func (s *Server) LiquidateSubaccounts(
 	}
 
 	s.liquidatableSubaccountIds.UpdateSubaccountIds(req.SubaccountIds)
+	s.liquidatableSubaccountIds.UpdateNegativeTncSubaccountIds(req.NegativeTncSubaccountIds)
 	return &api.LiquidateSubaccountsResponse{}, nil
 }
+
+// GetUpdatedSubaccountIds returns the ids of subaccounts updated in blocks committed after the
+// requested sequence number.
+func (s *Server) GetUpdatedSubaccountIds(
+	ctx context.Context,
+	req *api.GetUpdatedSubaccountIdsRequest,
+) (*api.GetUpdatedSubaccountIdsResponse, error) {
+	subaccountIds, sequence, requiresFullSync := s.updatedSubaccountIds.GetSubaccountIdsUpdatedSince(
+		req.SinceSequence,
+	)
+	telemetry.ModuleSetGauge(
+		metrics.LiquidationDaemon,
+		float32(len(subaccountIds)),
+		metrics.UpdatedSubaccountIds,
+		metrics.Count,
+	)
+	return &api.GetUpdatedSubaccountIdsResponse{
+		SubaccountIds:    subaccountIds,
+		Sequence:         sequence,
+		RequiresFullSync: requiresFullSync,
+	}, nil
+}
@@ -29,6 +29,7 @@ func TestLiquidateSubaccounts_Empty_Update(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Empty(t, liquidatableSubaccountIds.GetSubaccountIds())
+	require.Empty(t, liquidatableSubaccountIds.GetNegativeTncSubaccountIds())
 }
 
 func TestLiquidateSubaccounts_Multiple_Subaccount_Ids(t *testing.T) {
@@ -49,11 +50,54 @@ func TestLiquidateSubaccounts_Multiple_Subaccount_Ids(t *testing.T) {
 		constants.Bob_Num0,
 		constants.Carl_Num0,
 	}
+	expectedNegativeTncSubaccountIds := []satypes.SubaccountId{
+		constants.Carl_Num0,
+	}
 	_, err := s.LiquidateSubaccounts(grpc.Ctx, &api.LiquidateSubaccountsRequest{
-		SubaccountIds: expectedSubaccountIds,
+		SubaccountIds:            expectedSubaccountIds,
+		NegativeTncSubaccountIds: expectedNegativeTncSubaccountIds,
 	})
 	require.NoError(t, err)
 
 	actualSubaccountIds := liquidatableSubaccountIds.GetSubaccountIds()
 	require.Equal(t, expectedSubaccountIds, actualSubaccountIds)
+	require.Equal(t, expectedNegativeTncSubaccountIds, liquidatableSubaccountIds.GetNegativeTncSubaccountIds())
+}
+
+func TestGetUpdatedSubaccountIds(t *testing.T) {
+	mockGrpcServer := &mocks.GrpcServer{}
+	mockFileHandler := &mocks.FileHandler{}
+	updatedSubaccountIds := liquidationtypes.NewUpdatedSubaccountIds(2)
+
+	s := createServerWithMocks(
+		t,
+		mockGrpcServer,
+		mockFileHandler,
+	).WithUpdatedSubaccountIds(
+		updatedSubaccountIds,
+	)
+
+	updatedSubaccountIds.AddPendingSubaccountId(constants.Alice_Num1)
+	updatedSubaccountIds.PublishPendingSubaccountIds()
+	response, err := s.GetUpdatedSubaccountIds(grpc.Ctx, &api.GetUpdatedSubaccountIdsRequest{
+		SinceSequence: 0,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &api.GetUpdatedSubaccountIdsResponse{
+		SubaccountIds: []satypes.SubaccountId{constants.Alice_Num1},
+		Sequence:      1,
+	}, response)
+
+	// Updates of sequence 1 are no longer retained after sequence 3.
+	updatedSubaccountIds.PublishPendingSubaccountIds()
+	updatedSubaccountIds.PublishPendingSubaccountIds()
+	response, err = s.GetUpdatedSubaccountIds(grpc.Ctx, &api.GetUpdatedSubaccountIdsRequest{
+		SinceSequence: 0,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &api.GetUpdatedSubaccountIdsResponse{
+		SubaccountIds:    []satypes.SubaccountId{},
+		Sequence:         3,
+		RequiresFullSync: true,
+	}, response)
 }
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	gometrics "github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
@@ -13,20 +14,22 @@ import (
 
 type EventId = uint32
 
-// BridgeEventManager maintains a map of "Recognized" Bridge Events.
+// BridgeEventManager maintains a map of "Recognized" Bridge Events per source chain.
 // That is, events that have been finalized on Ethereum but are
-// not yet in consensus on the V4 chain. Methods are goroutine safe.
+// not yet in consensus on the V4 chain. Source chains are identified by their
+// Ethereum chain ID, where zero refers to the chain of `EventParams`.
+// Methods are goroutine safe.
 type BridgeEventManager struct {
 	// Exclusive mutex taken when reading or writing
 	sync.Mutex
 
-	// Bridge events by ID
-	events map[EventId]BridgeEventWithTime
+	// Bridge events by source chain ID and event ID
+	events map[uint64]map[EventId]BridgeEventWithTime
 
-	// Stores:
+	// Stores for each source chain:
 	// - The next unused key in the bridges map (`NextId`)
 	// - The block height of the last recognized event (`EthBlockHeight`)
-	recognizedEventInfo types.BridgeEventInfo
+	recognizedEventInfos map[uint64]types.BridgeEventInfo
 
 	// Time provider than can mocked out if necessary
 	timeProvider libtime.TimeProvider
@@ -44,18 +47,16 @@ func NewBridgeEventManager(
 	timeProvider libtime.TimeProvider,
 ) *BridgeEventManager {
 	return &BridgeEventManager{
-		events: make(map[uint32]BridgeEventWithTime),
-		recognizedEventInfo: types.BridgeEventInfo{
-			NextId:         0,
-			EthBlockHeight: 0,
-		},
-		timeProvider: timeProvider,
+		events:               make(map[uint64]map[EventId]BridgeEventWithTime),
+		recognizedEventInfos: make(map[uint64]types.BridgeEventInfo),
+		timeProvider:         timeProvider,
 	}
 }
 
 // AddBridgeEvents adds bridge events to the manager (with timestamps).
-// Added events must have contiguous and in-order IDs.
-// Any events with ID less than the `recognizedEventInfo.NextId` are ignored.
+// Added events of each source chain must have contiguous and in-order IDs.
+// Any events with ID less than the `NextId` of their source chain's recognized
+// event info are ignored.
 func (b *BridgeEventManager) AddBridgeEvents(
 	events []types.BridgeEvent,
 ) error {
@@ -67,54 +68,62 @@ func (b *BridgeEventManager) AddBridgeEvents(
 		return nil
 	}
 
-	// Validate events are contiguous and in-order.
-	for i, event := range events {
-		if event.Id != events[0].Id+uint32(i) {
+	// Validate events of each source chain are contiguous and in-order.
+	lastIds := make(map[uint64]EventId)
+	for _, event := range events {
+		if lastId, ok := lastIds[event.EthChainId]; ok && event.Id != lastId+1 {
 			telemetry.IncrCounter(1, metrics.BridgeServer, metrics.AddBridgeEvents, metrics.EventIdNotSequential)
 			return fmt.Errorf("AddBridgeEvents: Events must be contiguous and in-order")
 		}
+		lastIds[event.EthChainId] = event.Id
 	}
 
 	now := b.timeProvider.Now()
 	for _, event := range events {
 		// Ignore stale events which may be the result of a race condition.
-		if event.Id < b.recognizedEventInfo.NextId {
+		if event.Id < b.recognizedEventInfos[event.EthChainId].NextId {
 			telemetry.IncrCounter(1, metrics.BridgeServer, metrics.AddBridgeEvents, metrics.EventIdAlreadyRecognized)
 			continue
 		}
 
 		// Update BridgeEventManager with the new event.
-		b.events[event.Id] = BridgeEventWithTime{
+		if _, ok := b.events[event.EthChainId]; !ok {
+			b.events[event.EthChainId] = make(map[EventId]BridgeEventWithTime)
+		}
+		b.events[event.EthChainId][event.Id] = BridgeEventWithTime{
 			event:     event,
 			timestamp: now,
 		}
 		// Update recognized event info of BridgeEventManager.
-		b.recognizedEventInfo = types.BridgeEventInfo{
+		b.recognizedEventInfos[event.EthChainId] = types.BridgeEventInfo{
 			NextId:         event.Id + 1,
 			EthBlockHeight: event.EthBlockHeight,
 		}
 	}
 
 	// Emit metrics on updated recognized event info.
-	telemetry.SetGauge(
-		float32(b.recognizedEventInfo.NextId),
-		metrics.BridgeServer,
-		metrics.RecognizedEventInfo,
-		metrics.NextId,
-	)
-	telemetry.SetGauge(
-		float32(b.recognizedEventInfo.EthBlockHeight),
-		metrics.BridgeServer,
-		metrics.RecognizedEventInfo,
-		metrics.EthBlockHeight,
-	)
+	for ethChainId := range lastIds {
+		recognizedEventInfo := b.recognizedEventInfos[ethChainId]
+		labels := []gometrics.Label{metrics.GetLabelForIntValue(metrics.EthChainId, int(ethChainId))}
+		telemetry.SetGaugeWithLabels(
+			[]string{metrics.BridgeServer, metrics.RecognizedEventInfo, metrics.NextId},
+			float32(recognizedEventInfo.NextId),
+			labels,
+		)
+		telemetry.SetGaugeWithLabels(
+			[]string{metrics.BridgeServer, metrics.RecognizedEventInfo, metrics.EthBlockHeight},
+			float32(recognizedEventInfo.EthBlockHeight),
+			labels,
+		)
+	}
 
 	return nil
 }
 
-// GetBridgeEventById returns a bridge event by ID.
+// GetBridgeEventById returns a bridge event of source chain `ethChainId` by ID.
 // Found is false if the manager does not have the event.
 func (b *BridgeEventManager) GetBridgeEventById(
+	ethChainId uint64,
 	id uint32,
 ) (
 	event types.BridgeEvent,
@@ -125,7 +134,7 @@ func (b *BridgeEventManager) GetBridgeEventById(
 	defer b.Unlock()
 
 	// Find the event.
-	eventWithTime, found := b.events[id]
+	eventWithTime, found := b.events[ethChainId][id]
 	if !found {
 		return event, timestamp, found // default values
 	}
@@ -133,30 +142,32 @@ func (b *BridgeEventManager) GetBridgeEventById(
 	return eventWithTime.event, eventWithTime.timestamp, true
 }
 
-// GetRecognizedEventInfo returns `recognizedEventInfo`.
-func (b *BridgeEventManager) GetRecognizedEventInfo() types.BridgeEventInfo {
+// GetRecognizedEventInfo returns the recognized event info of source chain `ethChainId`.
+func (b *BridgeEventManager) GetRecognizedEventInfo(ethChainId uint64) types.BridgeEventInfo {
 	b.Lock()
 	defer b.Unlock()
 
-	return b.recognizedEventInfo
+	return b.recognizedEventInfos[ethChainId]
 }
 
-// SetRecognizedEventInfo sets `recognizedEventInfo`. An error is returned
-// and no update occurs if `NextId` or `EthBlockHeight` is lesser than its
-// existing value.
+// SetRecognizedEventInfo sets the recognized event info of source chain `ethChainId`.
+// An error is returned and no update occurs if `NextId` or `EthBlockHeight` is lesser
+// than its existing value.
 func (b *BridgeEventManager) SetRecognizedEventInfo(
+	ethChainId uint64,
 	eventInfo types.BridgeEventInfo,
 ) error {
 	b.Lock()
 	defer b.Unlock()
 
-	if eventInfo.NextId < b.recognizedEventInfo.NextId {
+	recognizedEventInfo := b.recognizedEventInfos[ethChainId]
+	if eventInfo.NextId < recognizedEventInfo.NextId {
 		return fmt.Errorf("NextId cannot be set to a lower value")
-	} else if eventInfo.EthBlockHeight < b.recognizedEventInfo.EthBlockHeight {
+	} else if eventInfo.EthBlockHeight < recognizedEventInfo.EthBlockHeight {
 		return fmt.Errorf("EthBlockHeight cannot be set to a lower value")
 	}
 
-	b.recognizedEventInfo = eventInfo
+	b.recognizedEventInfos[ethChainId] = eventInfo
 	return nil
 }
 
@@ -28,72 +28,72 @@ func setupEventManager() *bdtypes.BridgeEventManager {
 func TestNewBridgeEventManager(t *testing.T) {
 	bem := setupEventManager()
 
-	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo(0))
 }
 
 func TestBridgeEventManager_SetRecognizedEventInfo(t *testing.T) {
 	bem := setupEventManager()
 
 	// Check default value.
-	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo(0))
 
 	// Increase `NextId` by 1.
 	eventInfo := types.BridgeEventInfo{
 		NextId:         1,
 		EthBlockHeight: 0,
 	}
-	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.NoError(t, bem.SetRecognizedEventInfo(0, eventInfo))
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(0))
 
 	// Increase `NextId` by more than 1.
 	eventInfo = types.BridgeEventInfo{
 		NextId:         3,
 		EthBlockHeight: 0,
 	}
-	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.NoError(t, bem.SetRecognizedEventInfo(0, eventInfo))
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(0))
 
 	// Keep `NextId` the same
-	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.NoError(t, bem.SetRecognizedEventInfo(0, eventInfo))
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(0))
 
 	// Cannot decrease `NextId`.
 	eventInfo = types.BridgeEventInfo{
 		NextId:         2,
 		EthBlockHeight: 0,
 	}
-	require.ErrorContains(t, bem.SetRecognizedEventInfo(eventInfo), "NextId cannot be set to a lower value")
+	require.ErrorContains(t, bem.SetRecognizedEventInfo(0, eventInfo), "NextId cannot be set to a lower value")
 
 	// Increase `EthBlockHeight` by 1.
 	eventInfo = types.BridgeEventInfo{
 		NextId:         3,
 		EthBlockHeight: 1,
 	}
-	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.NoError(t, bem.SetRecognizedEventInfo(0, eventInfo))
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(0))
 
 	// Increase `EthBlockHeight` by more than 1.
 	eventInfo = types.BridgeEventInfo{
 		NextId:         3,
 		EthBlockHeight: 4,
 	}
-	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.NoError(t, bem.SetRecognizedEventInfo(0, eventInfo))
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(0))
 
 	// Cannot decrease `EthBlockHeight`.
 	eventInfo = types.BridgeEventInfo{
 		NextId:         3,
 		EthBlockHeight: 3,
 	}
-	require.ErrorContains(t, bem.SetRecognizedEventInfo(eventInfo), "EthBlockHeight cannot be set to a lower value")
+	require.ErrorContains(t, bem.SetRecognizedEventInfo(0, eventInfo), "EthBlockHeight cannot be set to a lower value")
 
 	// Increase `NextId` and `EthBlockHeight` at the same time.
 	eventInfo = types.BridgeEventInfo{
 		NextId:         5,
 		EthBlockHeight: 5,
 	}
-	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.NoError(t, bem.SetRecognizedEventInfo(0, eventInfo))
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(0))
 }
 
 func TestBridgeEventManager_AddBridgeEvents(t *testing.T) {
@@ -172,7 +172,7 @@ func TestBridgeEventManager_AddBridgeEvents(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// setup
 			bem := setupEventManager()
-			err := bem.SetRecognizedEventInfo(tc.initialREI)
+			err := bem.SetRecognizedEventInfo(0, tc.initialREI)
 			require.NoError(t, err)
 
 			// add the events
@@ -185,9 +185,9 @@ func TestBridgeEventManager_AddBridgeEvents(t *testing.T) {
 			}
 
 			// ensure result is correct
-			require.EqualValues(t, tc.expectedREI, bem.GetRecognizedEventInfo())
+			require.EqualValues(t, tc.expectedREI, bem.GetRecognizedEventInfo(0))
 			for _, event := range tc.events {
-				_, _, found := bem.GetBridgeEventById(event.Id)
+				_, _, found := bem.GetBridgeEventById(event.EthChainId, event.Id)
 				if event.Id >= tc.initialREI.NextId {
 					require.True(t, found)
 				} else {
@@ -201,7 +201,7 @@ func TestBridgeEventManager_AddBridgeEvents(t *testing.T) {
 func TestBridgeEventManager_GetBridgeEventById_Empty(t *testing.T) {
 	bem := setupEventManager()
 
-	_, _, found := bem.GetBridgeEventById(0)
+	_, _, found := bem.GetBridgeEventById(0, 0)
 	require.Equal(t, false, found)
 }
 
@@ -213,8 +213,66 @@ func TestBridgeEventManager_GetBridgeEventById_Success(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	result, timestamp, found := bem.GetBridgeEventById(constants.BridgeEvent_Id0_Height0.Id)
+	result, timestamp, found := bem.GetBridgeEventById(0, constants.BridgeEvent_Id0_Height0.Id)
 	require.True(t, found)
 	require.Equal(t, constants.BridgeEvent_Id0_Height0, result)
 	require.Equal(t, constants.TimeT, timestamp)
 }
+
+func TestBridgeEventManager_MultipleSourceChains(t *testing.T) {
+	bem := setupEventManager()
+
+	// Events of each source chain must be contiguous, but may be interleaved.
+	err := bem.AddBridgeEvents([]types.BridgeEvent{
+		constants.BridgeEvent_Id0_Height0,
+		constants.BridgeEvent_L2_Id0_Height2,
+		constants.BridgeEvent_Id1_Height0,
+		constants.BridgeEvent_L2_Id1_Height5,
+	})
+	require.NoError(t, err)
+
+	// Recognized event info is tracked per source chain.
+	require.Equal(t, types.BridgeEventInfo{NextId: 2, EthBlockHeight: 0}, bem.GetRecognizedEventInfo(0))
+	require.Equal(
+		t,
+		types.BridgeEventInfo{NextId: 2, EthBlockHeight: 5},
+		bem.GetRecognizedEventInfo(constants.L2EthChainId),
+	)
+	require.Equal(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo(constants.L2EthChainId+1))
+
+	// Events are looked up per source chain.
+	event, _, found := bem.GetBridgeEventById(0, 0)
+	require.True(t, found)
+	require.Equal(t, constants.BridgeEvent_Id0_Height0, event)
+	event, _, found = bem.GetBridgeEventById(constants.L2EthChainId, 0)
+	require.True(t, found)
+	require.Equal(t, constants.BridgeEvent_L2_Id0_Height2, event)
+	_, _, found = bem.GetBridgeEventById(constants.L2EthChainId+1, 0)
+	require.False(t, found)
+
+	// Events of a source chain are ignored if already recognized, independent of other source chains.
+	require.NoError(t, bem.SetRecognizedEventInfo(
+		constants.L2EthChainId,
+		types.BridgeEventInfo{NextId: 5, EthBlockHeight: 5},
+	))
+	err = bem.AddBridgeEvents([]types.BridgeEvent{
+		constants.BridgeEvent_Id2_Height1,
+		constants.BridgeEvent_L2_Id0_Height2,
+		constants.BridgeEvent_L2_Id1_Height5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.BridgeEventInfo{NextId: 3, EthBlockHeight: 1}, bem.GetRecognizedEventInfo(0))
+	require.Equal(
+		t,
+		types.BridgeEventInfo{NextId: 5, EthBlockHeight: 5},
+		bem.GetRecognizedEventInfo(constants.L2EthChainId),
+	)
+
+	// Events of a source chain that are not contiguous are rejected.
+	err = bem.AddBridgeEvents([]types.BridgeEvent{
+		constants.BridgeEvent_L2_Id0_Height2,
+		constants.BridgeEvent_Id3_Height3,
+		constants.BridgeEvent_L2_Id0_Height2,
+	})
+	require.ErrorContains(t, err, "contiguous")
+}
@@ -6,17 +6,19 @@ import (
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
-// LiquidatableSubaccountIds maintains the list of subaccount ids to be liquidated
-// in the next block. Methods are goroutine safe.
+// LiquidatableSubaccountIds maintains the lists of subaccount ids to be liquidated
+// and deleveraged in the next block. Methods are goroutine safe.
 type LiquidatableSubaccountIds struct {
-	sync.Mutex                           // lock
-	subaccountIds []satypes.SubaccountId // liquidatable subaccount ids
+	sync.Mutex                                      // lock
+	subaccountIds            []satypes.SubaccountId // liquidatable subaccount ids
+	negativeTncSubaccountIds []satypes.SubaccountId // subaccount ids with negative total net collateral
 }
 
 // NewLiquidatableSubaccountIds creates a new `LiquidatableSubaccountIds` struct.
 func NewLiquidatableSubaccountIds() *LiquidatableSubaccountIds {
 	return &LiquidatableSubaccountIds{
-		subaccountIds: make([]satypes.SubaccountId, 0),
+		subaccountIds:            make([]satypes.SubaccountId, 0),
+		negativeTncSubaccountIds: make([]satypes.SubaccountId, 0),
 	}
 }
 
@@ -37,3 +39,22 @@ func (ls *LiquidatableSubaccountIds) GetSubaccountIds() []satypes.SubaccountId {
 	copy(results, ls.subaccountIds)
 	return results
 }
+
+// UpdateNegativeTncSubaccountIds updates the struct with the given a list of subaccount ids
+// with negative total net collateral.
+func (ls *LiquidatableSubaccountIds) UpdateNegativeTncSubaccountIds(updates []satypes.SubaccountId) {
+	ls.Lock()
+	defer ls.Unlock()
+	ls.negativeTncSubaccountIds = make([]satypes.SubaccountId, len(updates))
+	copy(ls.negativeTncSubaccountIds, updates)
+}
+
+// GetNegativeTncSubaccountIds returns the list of subaccount ids with negative total net collateral
+// reported by the liquidation daemon.
+func (ls *LiquidatableSubaccountIds) GetNegativeTncSubaccountIds() []satypes.SubaccountId {
+	ls.Lock()
+	defer ls.Unlock()
+	results := make([]satypes.SubaccountId, len(ls.negativeTncSubaccountIds))
+	copy(results, ls.negativeTncSubaccountIds)
+	return results
+}
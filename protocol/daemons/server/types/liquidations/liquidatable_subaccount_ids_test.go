@@ -65,3 +65,19 @@ func TestLiquidatableSubaccountIds_Empty_Update(t *testing.T) {
 	ls.UpdateSubaccountIds(expectedSubaccountIds)
 	require.Empty(t, ls.GetSubaccountIds())
 }
+
+func TestLiquidatableSubaccountIds_NegativeTncSubaccountIds(t *testing.T) {
+	ls := liquidationstypes.NewLiquidatableSubaccountIds()
+	require.Empty(t, ls.GetNegativeTncSubaccountIds())
+
+	expectedSubaccountIds := []satypes.SubaccountId{
+		constants.Alice_Num1,
+		constants.Bob_Num0,
+	}
+	ls.UpdateNegativeTncSubaccountIds(expectedSubaccountIds)
+	require.Equal(t, expectedSubaccountIds, ls.GetNegativeTncSubaccountIds())
+	require.Empty(t, ls.GetSubaccountIds())
+
+	ls.UpdateNegativeTncSubaccountIds([]satypes.SubaccountId{})
+	require.Empty(t, ls.GetNegativeTncSubaccountIds())
+}
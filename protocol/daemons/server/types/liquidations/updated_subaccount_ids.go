@@ -0,0 +1,85 @@
+package types
+
+import (
+	"sort"
+	"sync"
+
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// UpdatedSubaccountIdsRetainedBlocks is the number of committed blocks for which the ids of
+// updated subaccounts are retained for the liquidation daemon.
+const UpdatedSubaccountIdsRetainedBlocks = 1_000
+
+// UpdatedSubaccountIds tracks the ids of subaccounts updated in recently committed blocks, so that
+// the liquidation daemon only needs to recheck subaccounts that changed. Each committed block is
+// assigned a sequence number, starting from 1. Methods are goroutine safe.
+type UpdatedSubaccountIds struct {
+	sync.Mutex                                          // lock
+	sequence          uint64                            // sequence number of the latest committed block
+	retainedSequences uint64                            // number of sequence numbers to retain updates for
+	pending           map[satypes.SubaccountId]struct{} // ids updated in the block being executed
+	updatedAt         map[satypes.SubaccountId]uint64   // sequence number of the latest update of each id
+}
+
+// NewUpdatedSubaccountIds creates a new `UpdatedSubaccountIds` struct that retains updates of the
+// latest `retainedSequences` committed blocks.
+func NewUpdatedSubaccountIds(retainedSequences uint64) *UpdatedSubaccountIds {
+	return &UpdatedSubaccountIds{
+		retainedSequences: retainedSequences,
+		pending:           make(map[satypes.SubaccountId]struct{}),
+		updatedAt:         make(map[satypes.SubaccountId]uint64),
+	}
+}
+
+// AddPendingSubaccountId records that a subaccount was updated in the block being executed.
+func (u *UpdatedSubaccountIds) AddPendingSubaccountId(subaccountId satypes.SubaccountId) {
+	u.Lock()
+	defer u.Unlock()
+	u.pending[subaccountId] = struct{}{}
+}
+
+// PublishPendingSubaccountIds assigns the next sequence number to the subaccounts updated in the
+// block that was just committed, and prunes updates that are no longer retained.
+func (u *UpdatedSubaccountIds) PublishPendingSubaccountIds() {
+	u.Lock()
+	defer u.Unlock()
+	u.sequence++
+	for subaccountId := range u.pending {
+		u.updatedAt[subaccountId] = u.sequence
+	}
+	u.pending = make(map[satypes.SubaccountId]struct{})
+
+	for subaccountId, sequence := range u.updatedAt {
+		if sequence+u.retainedSequences <= u.sequence {
+			delete(u.updatedAt, subaccountId)
+		}
+	}
+}
+
+// GetSubaccountIdsUpdatedSince returns the sorted ids of subaccounts updated in blocks with a
+// sequence number greater than `sinceSequence`, along with the sequence number of the latest
+// committed block. `requiresFullSync` is true if some of these updates are no longer retained, or if
+// `sinceSequence` is ahead of the latest sequence number (e.g. the node restarted).
+func (u *UpdatedSubaccountIds) GetSubaccountIdsUpdatedSince(
+	sinceSequence uint64,
+) (
+	subaccountIds []satypes.SubaccountId,
+	sequence uint64,
+	requiresFullSync bool,
+) {
+	u.Lock()
+	defer u.Unlock()
+	if sinceSequence > u.sequence || sinceSequence+u.retainedSequences < u.sequence {
+		return []satypes.SubaccountId{}, u.sequence, true
+	}
+
+	subaccountIds = make([]satypes.SubaccountId, 0)
+	for subaccountId, updatedAt := range u.updatedAt {
+		if updatedAt > sinceSequence {
+			subaccountIds = append(subaccountIds, subaccountId)
+		}
+	}
+	sort.Sort(satypes.SortedSubaccountIds(subaccountIds))
+	return subaccountIds, u.sequence, false
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	liquidationstypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/liquidations"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdatedSubaccountIds(t *testing.T) {
+	u := liquidationstypes.NewUpdatedSubaccountIds(10)
+	subaccountIds, sequence, requiresFullSync := u.GetSubaccountIdsUpdatedSince(0)
+	require.Empty(t, subaccountIds)
+	require.Equal(t, uint64(0), sequence)
+	require.False(t, requiresFullSync)
+}
+
+func TestUpdatedSubaccountIds_PendingNotVisibleUntilPublished(t *testing.T) {
+	u := liquidationstypes.NewUpdatedSubaccountIds(10)
+	u.AddPendingSubaccountId(constants.Alice_Num0)
+
+	subaccountIds, sequence, _ := u.GetSubaccountIdsUpdatedSince(0)
+	require.Empty(t, subaccountIds)
+	require.Equal(t, uint64(0), sequence)
+
+	u.PublishPendingSubaccountIds()
+	subaccountIds, sequence, _ = u.GetSubaccountIdsUpdatedSince(0)
+	require.Equal(t, []satypes.SubaccountId{constants.Alice_Num0}, subaccountIds)
+	require.Equal(t, uint64(1), sequence)
+}
+
+func TestUpdatedSubaccountIds_UpdatedSince(t *testing.T) {
+	u := liquidationstypes.NewUpdatedSubaccountIds(10)
+
+	// Block 1.
+	u.AddPendingSubaccountId(constants.Bob_Num0)
+	u.AddPendingSubaccountId(constants.Alice_Num0)
+	u.AddPendingSubaccountId(constants.Alice_Num0)
+	u.PublishPendingSubaccountIds()
+	// Block 2.
+	u.AddPendingSubaccountId(constants.Carl_Num0)
+	u.AddPendingSubaccountId(constants.Bob_Num0)
+	u.PublishPendingSubaccountIds()
+	// Block 3 without updates.
+	u.PublishPendingSubaccountIds()
+
+	subaccountIds, sequence, requiresFullSync := u.GetSubaccountIdsUpdatedSince(0)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Bob_Num0, constants.Alice_Num0, constants.Carl_Num0},
+		subaccountIds,
+	)
+	require.Equal(t, uint64(3), sequence)
+	require.False(t, requiresFullSync)
+
+	subaccountIds, _, _ = u.GetSubaccountIdsUpdatedSince(1)
+	require.Equal(t, []satypes.SubaccountId{constants.Bob_Num0, constants.Carl_Num0}, subaccountIds)
+
+	subaccountIds, _, _ = u.GetSubaccountIdsUpdatedSince(3)
+	require.Empty(t, subaccountIds)
+}
+
+func TestUpdatedSubaccountIds_RequiresFullSync(t *testing.T) {
+	u := liquidationstypes.NewUpdatedSubaccountIds(2)
+
+	u.AddPendingSubaccountId(constants.Alice_Num0)
+	u.PublishPendingSubaccountIds()
+	u.AddPendingSubaccountId(constants.Bob_Num0)
+	u.PublishPendingSubaccountIds()
+	u.AddPendingSubaccountId(constants.Carl_Num0)
+	u.PublishPendingSubaccountIds()
+
+	// Updates of sequence 1 are pruned.
+	subaccountIds, sequence, requiresFullSync := u.GetSubaccountIdsUpdatedSince(0)
+	require.Empty(t, subaccountIds)
+	require.Equal(t, uint64(3), sequence)
+	require.True(t, requiresFullSync)
+
+	subaccountIds, _, requiresFullSync = u.GetSubaccountIdsUpdatedSince(1)
+	require.Equal(t, []satypes.SubaccountId{constants.Bob_Num0, constants.Carl_Num0}, subaccountIds)
+	require.False(t, requiresFullSync)
+
+	// Sequence number ahead of the latest sequence number.
+	_, _, requiresFullSync = u.GetSubaccountIdsUpdatedSince(4)
+	require.True(t, requiresFullSync)
+}
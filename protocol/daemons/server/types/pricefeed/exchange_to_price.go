@@ -41,6 +41,9 @@ func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 		}
 
 		isUpdated := priceTimestamp.UpdatePrice(exchangePrice.Price, exchangePrice.LastUpdateTime)
+		if isUpdated {
+			priceTimestamp.UpdateVolume(exchangePrice.Volume)
+		}
 
 		validity := metrics.Valid
 		if exists && !isUpdated {
@@ -59,18 +62,18 @@ func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 	}
 }
 
-// GetValidPrices returns a list of "valid" prices. Prices are considered
+// GetValidPrices returns a map of exchange id to "valid" price. Prices are considered
 // "valid" iff the last update time is greater than or equal to the given cutoff time.
 func (etp *ExchangeToPrice) GetValidPrices(
 	cutoffTime time.Time,
-) []uint64 {
-	validExchangePricesForMarket := make([]uint64, 0, len(etp.exchangeToPriceTimestamp))
+) map[string]uint64 {
+	validExchangePricesForMarket := make(map[string]uint64, len(etp.exchangeToPriceTimestamp))
 	for exchangeId, priceTimestamp := range etp.exchangeToPriceTimestamp {
 		validity := metrics.Valid
 
 		// PriceTimestamp returns price if the last update time is valid.
 		if price, ok := priceTimestamp.GetValidPrice(cutoffTime); ok {
-			validExchangePricesForMarket = append(validExchangePricesForMarket, price)
+			validExchangePricesForMarket[exchangeId] = price
 		} else {
 			// Price is invalid.
 			validity = metrics.PriceIsInvalid
@@ -93,3 +96,15 @@ func (etp *ExchangeToPrice) GetValidPrices(
 	}
 	return validExchangePricesForMarket
 }
+
+// GetVolumes returns a map of exchange id to the last reported trailing volume of the market on the exchange.
+// Exchanges that have not reported a volume are omitted.
+func (etp *ExchangeToPrice) GetVolumes() map[string]uint64 {
+	volumes := make(map[string]uint64, len(etp.exchangeToPriceTimestamp))
+	for exchangeId, priceTimestamp := range etp.exchangeToPriceTimestamp {
+		if priceTimestamp.Volume > 0 {
+			volumes[exchangeId] = priceTimestamp.Volume
+		}
+	}
+	return volumes
+}
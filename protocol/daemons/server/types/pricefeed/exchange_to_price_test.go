@@ -5,7 +5,6 @@ import (
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
-	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -114,8 +113,7 @@ func TestGetValidPrices(t *testing.T) {
 		})
 
 	r := etp.GetValidPrices(constants.TimeT)
-	require.Len(t, r, 1)
-	require.Equal(t, constants.Price1, r[0])
+	require.Equal(t, map[string]uint64{constants.ExchangeId1: constants.Price1}, r)
 }
 
 func TestGetValidPrices_Empty(t *testing.T) {
@@ -150,8 +148,28 @@ func TestGetValidPrices_ValidAndOldPrices(t *testing.T) {
 
 	// Exchange 1's Price is before cutoff, so it's ignored
 	r := etp.GetValidPrices(constants.TimeTPlus1)
-	require.Len(t, r, 2)
+	expected := map[string]uint64{
+		constants.ExchangeId2: constants.Price3,
+		constants.ExchangeId3: constants.Price4,
+	}
+	require.Equal(t, expected, r)
+}
+
+func TestGetVolumes(t *testing.T) {
+	etp := NewExchangeToPrice(0)
+
+	etp.UpdatePrices(
+		[]*api.ExchangePrice{
+			{ExchangeId: constants.ExchangeId1, Price: constants.Price1, LastUpdateTime: &constants.TimeT, Volume: 5},
+			constants.Exchange2_Price2_TimeT,
+		})
+	require.Equal(t, map[string]uint64{constants.ExchangeId1: 5}, etp.GetVolumes())
 
-	expected := []uint64{constants.Price3, constants.Price4}
-	assert.ElementsMatch(t, expected, r)
+	// Updates without a volume keep the last reported volume.
+	etp.UpdatePrices(
+		[]*api.ExchangePrice{
+			constants.Exchange1_Price2_AfterTimeT,
+		})
+	require.Equal(t, constants.Price2, etp.exchangeToPriceTimestamp[constants.ExchangeId1].Price)
+	require.Equal(t, map[string]uint64{constants.ExchangeId1: 5}, etp.GetVolumes())
 }
@@ -8,28 +8,36 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
-	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
+// cachedPriceAggregationConfig is a price aggregation config parsed from a market's exchange config json.
+type cachedPriceAggregationConfig struct {
+	exchangeConfigJson string
+	config             types.PriceAggregationConfig
+}
+
 // MarketToExchangePrices maintains price info for multiple markets. Each
 // market can support prices from multiple exchange sources. Specifically,
 // MarketToExchangePrices supports methods to update prices and to retrieve
-// median prices. Methods are goroutine safe.
+// index prices. Methods are goroutine safe.
 type MarketToExchangePrices struct {
 	sync.Mutex                                         // lock
 	marketToExchangePrices map[uint32]*ExchangeToPrice // {k: market id, v: exchange prices}
+	// marketToAggregationConfig caches the parsed price aggregation config of each market.
+	marketToAggregationConfig map[uint32]cachedPriceAggregationConfig
 	// maxPriceAge is the maximum age of a price before it is considered too stale to be used.
-	// Prices older than this age will not be used to calculate the median price.
+	// Prices older than this age will not be used to calculate the index price.
 	maxPriceAge time.Duration
 }
 
 // NewMarketToExchangePrices creates a new MarketToExchangePrices.
 func NewMarketToExchangePrices(maxPriceAge time.Duration) *MarketToExchangePrices {
 	return &MarketToExchangePrices{
-		marketToExchangePrices: make(map[uint32]*ExchangeToPrice),
-		maxPriceAge:            maxPriceAge,
+		marketToExchangePrices:    make(map[uint32]*ExchangeToPrice),
+		marketToAggregationConfig: make(map[uint32]cachedPriceAggregationConfig),
+		maxPriceAge:               maxPriceAge,
 	}
 }
 
@@ -51,20 +59,25 @@ func (mte *MarketToExchangePrices) UpdatePrices(
 	}
 }
 
-// GetValidMedianPrices returns median prices for multiple markets.
+// GetValidIndexPrices returns index prices for multiple markets.
 // Specifically, it returns a map where the key is the market ID and the value
-// is the median price for the market. It only returns "valid" prices where
+// is the index price for the market. It only returns "valid" prices where
 // a price is valid iff
 // 1) the last update time is within a predefined threshold away from the given
 // read time.
-// 2) the number of prices that meet 1) are greater than the minimum number of
+// 2) the price is not an outlier compared to the median of the prices that meet 1),
+// if the market's price aggregation config enables outlier rejection.
+// 3) the number of prices that meet 1) and 2) are greater than the minimum number of
 // exchanges specified in the given input.
-func (mte *MarketToExchangePrices) GetValidMedianPrices(
+// The valid prices of each market are aggregated into an index price according to the
+// market's price aggregation config, which defaults to the median. Volume-weighted aggregation
+// uses the last volume reported by each exchange.
+func (mte *MarketToExchangePrices) GetValidIndexPrices(
 	marketParams []types.MarketParam,
 	readTime time.Time,
 ) map[uint32]uint64 {
 	cutoffTime := readTime.Add(-mte.maxPriceAge)
-	marketIdToMedianPrice := make(map[uint32]uint64)
+	marketIdToIndexPrice := make(map[uint32]uint64)
 
 	mte.Lock()
 	defer mte.Unlock()
@@ -87,8 +100,23 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 			continue
 		}
 
+		aggregationConfig := mte.getPriceAggregationConfig(marketParam)
+
 		// GetValidPriceForMarket filters prices based on cutoff time.
 		validPrices := exchangeToPrice.GetValidPrices(cutoffTime)
+		numValidPrices := len(validPrices)
+		validPrices = aggregationConfig.RejectOutliers(validPrices)
+		telemetry.SetGaugeWithLabels(
+			[]string{
+				metrics.PricefeedServer,
+				metrics.OutlierPrices,
+				metrics.Count,
+			},
+			float32(numValidPrices-len(validPrices)),
+			[]gometrics.Label{
+				pricefeedmetrics.GetLabelForMarketId(marketId),
+			},
+		)
 		telemetry.SetGaugeWithLabels(
 			[]string{
 				metrics.PricefeedServer,
@@ -103,8 +131,8 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 
 		// The number of valid prices must be >= min number of exchanges.
 		if len(validPrices) >= int(marketParam.MinExchanges) {
-			// Calculate the median. Returns an error if the input is empty.
-			median, err := lib.Median(validPrices)
+			// Aggregate the prices. Returns an error if the input is empty.
+			indexPrice, err := aggregationConfig.Aggregate(validPrices, exchangeToPrice.GetVolumes())
 			if err != nil {
 				telemetry.IncrCounterWithLabels(
 					[]string{
@@ -119,9 +147,46 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 				)
 				continue
 			}
-			marketIdToMedianPrice[marketId] = median
+			marketIdToIndexPrice[marketId] = indexPrice
 		}
 	}
 
-	return marketIdToMedianPrice
+	return marketIdToIndexPrice
+}
+
+// getPriceAggregationConfig returns the price aggregation config of the market. Configs are cached until the
+// market's exchange config json changes. Invalid configs are not expected, since market params are validated
+// before they are written to state, but fall back to the default median aggregation.
+func (mte *MarketToExchangePrices) getPriceAggregationConfig(
+	marketParam types.MarketParam,
+) types.PriceAggregationConfig {
+	cached, ok := mte.marketToAggregationConfig[marketParam.Id]
+	if ok && cached.exchangeConfigJson == marketParam.ExchangeConfigJson {
+		return cached.config
+	}
+
+	config, err := marketParam.GetPriceAggregationConfig()
+	if err == nil {
+		err = config.Validate()
+	}
+	if err != nil {
+		telemetry.IncrCounterWithLabels(
+			[]string{
+				metrics.PricefeedServer,
+				metrics.InvalidPriceAggregationConfig,
+				metrics.Count,
+			},
+			1,
+			[]gometrics.Label{
+				pricefeedmetrics.GetLabelForMarketId(marketParam.Id),
+			},
+		)
+		config = types.PriceAggregationConfig{}
+	}
+
+	mte.marketToAggregationConfig[marketParam.Id] = cachedPriceAggregationConfig{
+		exchangeConfigJson: marketParam.ExchangeConfigJson,
+		config:             config,
+	}
+	return config
 }
@@ -124,7 +124,7 @@ func TestUpdatePrices_MultiUpdatesMultiPricesRepeated(t *testing.T) {
 	require.True(t, ok8)
 }
 
-func TestGetValidMedianPrices_EmptyResult(t *testing.T) {
+func TestGetValidIndexPrices_EmptyResult(t *testing.T) {
 	tests := map[string]struct {
 		updatePriceInput           []*api.MarketPriceUpdate
 		getPricesInputMarketParams []types.MarketParam
@@ -170,7 +170,7 @@ func TestGetValidMedianPrices_EmptyResult(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
 			mte.UpdatePrices(tc.updatePriceInput)
-			r := mte.GetValidMedianPrices(
+			r := mte.GetValidIndexPrices(
 				tc.getPricesInputMarketParams,
 				tc.getPricesInputTime,
 			)
@@ -180,12 +180,12 @@ func TestGetValidMedianPrices_EmptyResult(t *testing.T) {
 	}
 }
 
-func TestGetValidMedianPrices_MultiMarketSuccess(t *testing.T) {
+func TestGetValidIndexPrices_MultiMarketSuccess(t *testing.T) {
 	mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
 
 	mte.UpdatePrices(constants.MixedTimePriceUpdate)
 
-	r := mte.GetValidMedianPrices(constants.AllMarketParamsMinExchanges2, constants.TimeT)
+	r := mte.GetValidIndexPrices(constants.AllMarketParamsMinExchanges2, constants.TimeT)
 
 	require.Len(t, r, 2)
 	require.Equal(t, uint64(2002), r[constants.MarketId9]) // Median of 1001, 2002, 3003
@@ -193,3 +193,103 @@ func TestGetValidMedianPrices_MultiMarketSuccess(t *testing.T) {
 	// Market7 only has 1 valid price due to update time constraint,
 	// but the min exchanges required is 2. Therefore, no median price.
 }
+
+func TestGetValidIndexPrices_PriceAggregationConfig(t *testing.T) {
+	// The median of the exchange prices is 1,015.
+	priceUpdates := []*api.MarketPriceUpdate{
+		{
+			MarketId: constants.MarketId9,
+			ExchangePrices: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId0, Price: 1_000, LastUpdateTime: &constants.TimeT, Volume: 1},
+				{ExchangeId: constants.ExchangeId1, Price: 1_010, LastUpdateTime: &constants.TimeT, Volume: 1},
+				{ExchangeId: constants.ExchangeId2, Price: 1_020, LastUpdateTime: &constants.TimeT, Volume: 1},
+				{ExchangeId: constants.ExchangeId3, Price: 2_000, LastUpdateTime: &constants.TimeT, Volume: 10},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		exchangeConfigJson string
+		minExchanges       uint32
+		expectedPrice      uint64
+		expectedNoPrice    bool
+	}{
+		"Default median": {
+			exchangeConfigJson: `{"exchanges":[]}`,
+			minExchanges:       2,
+			expectedPrice:      1_015,
+		},
+		"Volume-weighted median": {
+			exchangeConfigJson: `{"exchanges":[],"aggregation":{"strategy":"weighted_median"}}`,
+			minExchanges:       2,
+			expectedPrice:      2_000,
+		},
+		"Volume-weighted median scaled by weights": {
+			exchangeConfigJson: `{"exchanges":[{"exchangeName":"Exchange0","weight":20}],` +
+				`"aggregation":{"strategy":"weighted_median"}}`,
+			minExchanges:  2,
+			expectedPrice: 1_000,
+		},
+		"Trimmed mean": {
+			exchangeConfigJson: `{"exchanges":[],"aggregation":{"strategy":"trimmed_mean"}}`,
+			minExchanges:       2,
+			expectedPrice:      1_258, // Mean of 1000, 1010, 1020, 2000
+		},
+		"Outliers are rejected": {
+			exchangeConfigJson: `{"exchanges":[],"aggregation":{"strategy":"trimmed_mean","maxDeviationPpm":100000}}`,
+			minExchanges:       2,
+			expectedPrice:      1_010, // Mean of 1000, 1010, 1020
+		},
+		"Outliers do not count towards min exchanges": {
+			exchangeConfigJson: `{"exchanges":[],"aggregation":{"maxDeviationPpm":100000}}`,
+			minExchanges:       4,
+			expectedNoPrice:    true,
+		},
+		"Invalid config falls back to median": {
+			exchangeConfigJson: `{"exchanges":[],"aggregation":{"strategy":"unknown"}}`,
+			minExchanges:       2,
+			expectedPrice:      1_015,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+			mte.UpdatePrices(priceUpdates)
+
+			marketParams := []types.MarketParam{
+				{
+					Id:                 constants.MarketId9,
+					MinExchanges:       tc.minExchanges,
+					ExchangeConfigJson: tc.exchangeConfigJson,
+				},
+			}
+			r := mte.GetValidIndexPrices(marketParams, constants.TimeT)
+
+			if tc.expectedNoPrice {
+				require.Empty(t, r)
+			} else {
+				require.Equal(t, map[uint32]uint64{constants.MarketId9: tc.expectedPrice}, r)
+			}
+		})
+	}
+}
+
+func TestGetValidIndexPrices_PriceAggregationConfigUpdated(t *testing.T) {
+	mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+	mte.UpdatePrices(constants.MixedTimePriceUpdate)
+
+	marketParam := types.MarketParam{
+		Id:                 constants.MarketId9,
+		MinExchanges:       1,
+		ExchangeConfigJson: `{"exchanges":[]}`,
+	}
+	r := mte.GetValidIndexPrices([]types.MarketParam{marketParam}, constants.TimeT)
+	require.Equal(t, uint64(2_002), r[constants.MarketId9])
+
+	// The cached config is replaced when the exchange config json changes.
+	marketParam.ExchangeConfigJson = `{"exchanges":[{"exchangeName":"Exchange1","weight":5}],` +
+		`"aggregation":{"strategy":"weighted_median"}}`
+	r = mte.GetValidIndexPrices([]types.MarketParam{marketParam}, constants.TimeT)
+	require.Equal(t, uint64(1_001), r[constants.MarketId9])
+}
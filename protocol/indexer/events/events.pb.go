@@ -198,6 +198,7 @@ type MarketEventV1 struct {
 	// either an event for price update, market creation, or market modification.
 	//
 	// Types that are valid to be assigned to Event:
+	//
 	//	*MarketEventV1_PriceUpdate
 	//	*MarketEventV1_MarketCreate
 	//	*MarketEventV1_MarketModify
@@ -512,8 +513,7 @@ func (m *MarketModifyEventV1) GetBase() *MarketBaseEventV1 {
 
 // SourceOfFunds is the source of funds in a transfer event.
 type SourceOfFunds struct {
-	//	one of below
-	//
+	//  one of below
 	// - a subaccount ID
 	// - a wallet address
 	//
@@ -1022,6 +1022,8 @@ type StatefulOrderEventV1 struct {
 	//	*StatefulOrderEventV1_ConditionalOrderPlacement
 	//	*StatefulOrderEventV1_ConditionalOrderTriggered
 	//	*StatefulOrderEventV1_LongTermOrderPlacement
+	//	*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate
+	//	*StatefulOrderEventV1_LongTermOrderReplacement
 	Event isStatefulOrderEventV1_Event `protobuf_oneof:"event"`
 }
 
@@ -1079,12 +1081,20 @@ type StatefulOrderEventV1_ConditionalOrderTriggered struct {
 type StatefulOrderEventV1_LongTermOrderPlacement struct {
 	LongTermOrderPlacement *StatefulOrderEventV1_LongTermOrderPlacementV1 `protobuf:"bytes,7,opt,name=long_term_order_placement,json=longTermOrderPlacement,proto3,oneof" json:"long_term_order_placement,omitempty"`
 }
+type StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate struct {
+	ConditionalOrderTriggerSubticksUpdate *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 `protobuf:"bytes,8,opt,name=conditional_order_trigger_subticks_update,json=conditionalOrderTriggerSubticksUpdate,proto3,oneof" json:"conditional_order_trigger_subticks_update,omitempty"`
+}
+type StatefulOrderEventV1_LongTermOrderReplacement struct {
+	LongTermOrderReplacement *StatefulOrderEventV1_LongTermOrderReplacementV1 `protobuf:"bytes,9,opt,name=long_term_order_replacement,json=longTermOrderReplacement,proto3,oneof" json:"long_term_order_replacement,omitempty"`
+}
 
-func (*StatefulOrderEventV1_OrderPlace) isStatefulOrderEventV1_Event()                {}
-func (*StatefulOrderEventV1_OrderRemoval) isStatefulOrderEventV1_Event()              {}
-func (*StatefulOrderEventV1_ConditionalOrderPlacement) isStatefulOrderEventV1_Event() {}
-func (*StatefulOrderEventV1_ConditionalOrderTriggered) isStatefulOrderEventV1_Event() {}
-func (*StatefulOrderEventV1_LongTermOrderPlacement) isStatefulOrderEventV1_Event()    {}
+func (*StatefulOrderEventV1_OrderPlace) isStatefulOrderEventV1_Event()                            {}
+func (*StatefulOrderEventV1_OrderRemoval) isStatefulOrderEventV1_Event()                          {}
+func (*StatefulOrderEventV1_ConditionalOrderPlacement) isStatefulOrderEventV1_Event()             {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggered) isStatefulOrderEventV1_Event()             {}
+func (*StatefulOrderEventV1_LongTermOrderPlacement) isStatefulOrderEventV1_Event()                {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate) isStatefulOrderEventV1_Event() {}
+func (*StatefulOrderEventV1_LongTermOrderReplacement) isStatefulOrderEventV1_Event()              {}
 
 func (m *StatefulOrderEventV1) GetEvent() isStatefulOrderEventV1_Event {
 	if m != nil {
@@ -1128,6 +1138,20 @@ func (m *StatefulOrderEventV1) GetLongTermOrderPlacement() *StatefulOrderEventV1
 	return nil
 }
 
+func (m *StatefulOrderEventV1) GetConditionalOrderTriggerSubticksUpdate() *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
+	if x, ok := m.GetEvent().(*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate); ok {
+		return x.ConditionalOrderTriggerSubticksUpdate
+	}
+	return nil
+}
+
+func (m *StatefulOrderEventV1) GetLongTermOrderReplacement() *StatefulOrderEventV1_LongTermOrderReplacementV1 {
+	if x, ok := m.GetEvent().(*StatefulOrderEventV1_LongTermOrderReplacement); ok {
+		return x.LongTermOrderReplacement
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*StatefulOrderEventV1) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1136,6 +1160,8 @@ func (*StatefulOrderEventV1) XXX_OneofWrappers() []interface{} {
 		(*StatefulOrderEventV1_ConditionalOrderPlacement)(nil),
 		(*StatefulOrderEventV1_ConditionalOrderTriggered)(nil),
 		(*StatefulOrderEventV1_LongTermOrderPlacement)(nil),
+		(*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate)(nil),
+		(*StatefulOrderEventV1_LongTermOrderReplacement)(nil),
 	}
 }
 
@@ -1395,6 +1421,124 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) GetOrder() *v1.IndexerOr
 	return nil
 }
 
+// A conditional order trigger subticks update event contains the id of an
+// untriggered conditional order and its new trigger subticks. It is emitted
+// when a trailing stop order's trigger price trails the oracle price.
+type StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 struct {
+	OrderId                         *v1.IndexerOrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ConditionalOrderTriggerSubticks uint64             `protobuf:"varint,2,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Reset() {
+	*m = StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1{}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) ProtoMessage() {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{12, 5}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.Merge(m, src)
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 proto.InternalMessageInfo
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) GetOrderId() *v1.IndexerOrderId {
+	if m != nil {
+		return m.OrderId
+	}
+	return nil
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) GetConditionalOrderTriggerSubticks() uint64 {
+	if m != nil {
+		return m.ConditionalOrderTriggerSubticks
+	}
+	return 0
+}
+
+// A long term order replacement contains the id of the order that was
+// replaced and the order that replaced it. Note that the ids of both orders
+// may be the same.
+type StatefulOrderEventV1_LongTermOrderReplacementV1 struct {
+	OldOrderId *v1.IndexerOrderId `protobuf:"bytes,1,opt,name=old_order_id,json=oldOrderId,proto3" json:"old_order_id,omitempty"`
+	Order      *v1.IndexerOrder   `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Reset() {
+	*m = StatefulOrderEventV1_LongTermOrderReplacementV1{}
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StatefulOrderEventV1_LongTermOrderReplacementV1) ProtoMessage() {}
+func (*StatefulOrderEventV1_LongTermOrderReplacementV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{12, 6}
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatefulOrderEventV1_LongTermOrderReplacementV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatefulOrderEventV1_LongTermOrderReplacementV1.Merge(m, src)
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatefulOrderEventV1_LongTermOrderReplacementV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatefulOrderEventV1_LongTermOrderReplacementV1 proto.InternalMessageInfo
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) GetOldOrderId() *v1.IndexerOrderId {
+	if m != nil {
+		return m.OldOrderId
+	}
+	return nil
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) GetOrder() *v1.IndexerOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
 // AssetCreateEventV1 message contains all the information about an new Asset on
 // the dYdX chain.
 type AssetCreateEventV1 struct {
@@ -1918,6 +2062,8 @@ func init() {
 	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderPlacementV1")
 	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggeredV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggeredV1")
 	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderPlacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggerSubticksUpdateV1")
+	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderReplacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderReplacementV1")
 	proto.RegisterType((*AssetCreateEventV1)(nil), "dydxprotocol.indexer.events.AssetCreateEventV1")
 	proto.RegisterType((*PerpetualMarketCreateEventV1)(nil), "dydxprotocol.indexer.events.PerpetualMarketCreateEventV1")
 	proto.RegisterType((*LiquidityTierUpsertEventV1)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV1")
@@ -1930,127 +2076,134 @@ func init() {
 }
 
 var fileDescriptor_6331dfb59c6fd2bb = []byte{
-	// 1914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x77, 0xcf, 0xb4, 0xc7, 0xf6, 0xb3, 0xc7, 0x99, 0xa9, 0x38, 0xce, 0xd8, 0x06, 0xc7, 0xb4,
-	0x84, 0x64, 0x65, 0x77, 0xc7, 0x71, 0x08, 0x68, 0xc5, 0x01, 0xe1, 0xb1, 0xc7, 0xeb, 0x89, 0x6c,
-	0x67, 0x68, 0x8f, 0xb3, 0xbb, 0x01, 0x6d, 0x53, 0xd3, 0x5d, 0x1e, 0x97, 0xdc, 0x5f, 0xdb, 0xd5,
-	0x6d, 0xe2, 0x48, 0x9c, 0xe1, 0x06, 0x12, 0x67, 0x0e, 0x1c, 0xb8, 0x20, 0x71, 0x40, 0xe2, 0xba,
-	0x27, 0x2e, 0x7b, 0x63, 0xc5, 0x05, 0xc4, 0x21, 0x42, 0xc9, 0x81, 0x7f, 0x03, 0xd5, 0x47, 0xf7,
-	0xcc, 0x78, 0x3e, 0x32, 0x49, 0xcc, 0xc9, 0xd3, 0xef, 0xd5, 0xfb, 0xbd, 0xcf, 0x7a, 0xf5, 0x9e,
-	0x61, 0xd3, 0xb9, 0x72, 0x9e, 0x87, 0x51, 0x10, 0x07, 0x76, 0xe0, 0x6e, 0x51, 0xdf, 0x21, 0xcf,
-	0x49, 0xb4, 0x45, 0x2e, 0x89, 0x1f, 0x33, 0xf5, 0xa7, 0x2a, 0xd8, 0x68, 0xad, 0xf7, 0x64, 0x55,
-	0x9d, 0xac, 0xca, 0x23, 0xab, 0x2b, 0x76, 0xc0, 0xbc, 0x80, 0x59, 0x82, 0xbf, 0x25, 0x3f, 0xa4,
-	0xdc, 0xea, 0x52, 0x27, 0xe8, 0x04, 0x92, 0xce, 0x7f, 0x29, 0xea, 0x83, 0xa1, 0x7a, 0xd9, 0x39,
-	0x8e, 0x88, 0xb3, 0x15, 0x11, 0x2f, 0xb8, 0xc4, 0xae, 0x15, 0x11, 0xcc, 0x02, 0x5f, 0x49, 0x7c,
-	0x30, 0x54, 0x22, 0x23, 0x5c, 0x6e, 0x6f, 0xd9, 0x6e, 0xd0, 0x56, 0x87, 0xb7, 0xdf, 0x78, 0x98,
-	0x25, 0x6d, 0x6c, 0xdb, 0x41, 0xe2, 0xc7, 0x52, 0xc4, 0xf8, 0xbb, 0x06, 0xb7, 0xf6, 0x13, 0xdf,
-	0xa1, 0x7e, 0xe7, 0x34, 0x74, 0x70, 0x4c, 0x9e, 0x6e, 0xa3, 0xef, 0xc0, 0x42, 0x48, 0xa2, 0x90,
-	0xc4, 0x09, 0x76, 0x2d, 0xea, 0x54, 0xb4, 0x0d, 0x6d, 0xb3, 0x68, 0xce, 0x67, 0xb4, 0x86, 0x83,
-	0xee, 0x43, 0xf9, 0x4c, 0x4a, 0x59, 0x97, 0xd8, 0x4d, 0x88, 0x15, 0x86, 0x5e, 0x25, 0xb7, 0xa1,
-	0x6d, 0x4e, 0x9b, 0xb7, 0x14, 0xe3, 0x29, 0xa7, 0x37, 0x43, 0x0f, 0x79, 0x50, 0x4c, 0xcf, 0x0a,
-	0x93, 0x2a, 0xf9, 0x0d, 0x6d, 0x73, 0xa1, 0x76, 0xf0, 0xf5, 0xcb, 0x7b, 0x53, 0xff, 0x7e, 0x79,
-	0xef, 0xc7, 0x1d, 0x1a, 0x9f, 0x27, 0xed, 0xaa, 0x1d, 0x78, 0x5b, 0x7d, 0xf6, 0x5f, 0x3e, 0xfa,
-	0xc8, 0x3e, 0xc7, 0xd4, 0xef, 0x3a, 0xe0, 0xc4, 0x57, 0x21, 0x61, 0xd5, 0x13, 0x12, 0x51, 0xec,
-	0xd2, 0x17, 0xb8, 0xed, 0x92, 0x86, 0x1f, 0x9b, 0x0b, 0x0a, 0xbe, 0xc1, 0xd1, 0x8d, 0xdf, 0xe5,
-	0x60, 0x51, 0x79, 0x54, 0xe7, 0x69, 0x7a, 0xba, 0x8d, 0x0e, 0x61, 0x26, 0x11, 0xce, 0xb1, 0x8a,
-	0xb6, 0x91, 0xdf, 0x9c, 0x7f, 0xf8, 0x61, 0x75, 0x4c, 0x5a, 0xab, 0xd7, 0xe2, 0x51, 0xd3, 0xb9,
-	0xa5, 0x66, 0x0a, 0x81, 0xf6, 0x40, 0xe7, 0x76, 0x08, 0x77, 0x17, 0x1f, 0x3e, 0x98, 0x04, 0x4a,
-	0x19, 0x52, 0x6d, 0x5d, 0x85, 0xc4, 0x14, 0xd2, 0x86, 0x07, 0x3a, 0xff, 0x42, 0x4b, 0x50, 0x6a,
-	0x7d, 0xde, 0xac, 0x5b, 0xa7, 0xc7, 0x27, 0xcd, 0xfa, 0x6e, 0x63, 0xbf, 0x51, 0xdf, 0x2b, 0x4d,
-	0xa1, 0xbb, 0x70, 0x5b, 0x50, 0x9b, 0x66, 0xfd, 0xa8, 0x71, 0x7a, 0x64, 0x9d, 0xec, 0x1c, 0x35,
-	0x0f, 0xeb, 0x25, 0x0d, 0xdd, 0x83, 0x35, 0xc1, 0xd8, 0x3f, 0x3d, 0xde, 0x6b, 0x1c, 0x7f, 0x62,
-	0x99, 0x3b, 0xad, 0xba, 0xb5, 0x73, 0xbc, 0x67, 0x35, 0x8e, 0xf7, 0xea, 0x9f, 0x95, 0x72, 0xe8,
-	0x0e, 0x94, 0xfb, 0x24, 0x9f, 0x3e, 0x69, 0xd5, 0x4b, 0x79, 0xe3, 0x6f, 0x39, 0x28, 0x1e, 0xe1,
-	0xe8, 0x82, 0xc4, 0x69, 0x50, 0xd6, 0x60, 0xce, 0x13, 0x84, 0x6e, 0x8a, 0x67, 0x25, 0xa1, 0xe1,
-	0xa0, 0x67, 0xb0, 0x10, 0x46, 0xd4, 0x26, 0x96, 0x74, 0x5a, 0xf8, 0x3a, 0xff, 0xf0, 0xfb, 0x63,
-	0x7d, 0x95, 0xf0, 0x4d, 0x2e, 0x26, 0x43, 0xa7, 0x34, 0x1d, 0x4c, 0x99, 0xf3, 0x61, 0x97, 0x8a,
-	0x3e, 0x85, 0xa2, 0x52, 0x6c, 0x47, 0x84, 0x83, 0xe7, 0x05, 0xf8, 0x83, 0x09, 0xc0, 0x77, 0x85,
-	0x40, 0x17, 0x77, 0xc1, 0xeb, 0x21, 0xf7, 0x00, 0x7b, 0x81, 0x43, 0xcf, 0xae, 0x2a, 0xfa, 0xc4,
-	0xc0, 0x47, 0x42, 0x60, 0x00, 0x58, 0x92, 0x6b, 0x33, 0x30, 0x2d, 0x4e, 0x1b, 0x8f, 0xa1, 0x32,
-	0xca, 0x4b, 0x54, 0x85, 0xdb, 0x32, 0x64, 0xbf, 0xa0, 0xf1, 0xb9, 0x45, 0x9e, 0x87, 0x81, 0x4f,
-	0xfc, 0x58, 0x44, 0x56, 0x37, 0xcb, 0x82, 0xf5, 0x29, 0x8d, 0xcf, 0xeb, 0x8a, 0x61, 0x7c, 0x06,
-	0x65, 0x89, 0x55, 0xc3, 0x2c, 0x03, 0x41, 0xa0, 0x87, 0x98, 0x46, 0x42, 0x6a, 0xce, 0x14, 0xbf,
-	0xd1, 0x16, 0x2c, 0x79, 0xd4, 0xb7, 0x24, 0xb8, 0x7d, 0x8e, 0xfd, 0x4e, 0xf7, 0xba, 0x15, 0xcd,
-	0xb2, 0x47, 0x7d, 0x61, 0xcd, 0xae, 0xe0, 0x34, 0x43, 0xcf, 0x48, 0xe0, 0xf6, 0x90, 0x70, 0xa1,
-	0x1a, 0xe8, 0x6d, 0xcc, 0x88, 0xc0, 0x9e, 0x7f, 0x58, 0x9d, 0x20, 0x2a, 0x3d, 0x96, 0x99, 0x42,
-	0x16, 0xad, 0xc2, 0x6c, 0xe6, 0x19, 0xd7, 0x5f, 0x36, 0xb3, 0x6f, 0xe3, 0xf3, 0x54, 0x6d, 0x5f,
-	0x30, 0x6f, 0x42, 0xad, 0xf1, 0x67, 0x0d, 0x8a, 0x27, 0x41, 0x12, 0xd9, 0xe4, 0xc9, 0x19, 0xbf,
-	0x52, 0x0c, 0xfd, 0x0c, 0x8a, 0xdd, 0x5e, 0x96, 0x56, 0xf0, 0xc8, 0x0a, 0xcd, 0x08, 0x97, 0xdb,
-	0xd5, 0x86, 0xa4, 0x9d, 0x64, 0xd2, 0x0d, 0x87, 0x27, 0x9c, 0xf5, 0x7c, 0xa3, 0x47, 0x30, 0x83,
-	0x1d, 0x27, 0x22, 0x8c, 0x09, 0x2f, 0xe7, 0x6a, 0x95, 0x7f, 0xfc, 0xf5, 0xa3, 0x25, 0xd5, 0xe0,
-	0x77, 0x24, 0xe7, 0x24, 0x8e, 0xa8, 0xdf, 0x39, 0x98, 0x32, 0xd3, 0xa3, 0xb5, 0x59, 0x28, 0x30,
-	0x61, 0xa4, 0xf1, 0xa7, 0x3c, 0xdc, 0x6a, 0x45, 0xd8, 0x67, 0x67, 0x24, 0x4a, 0xe3, 0xd0, 0x81,
-	0x25, 0x46, 0x7c, 0x87, 0x44, 0xd6, 0xcd, 0x19, 0x6e, 0x22, 0x09, 0xd9, 0x4b, 0x43, 0x1e, 0xdc,
-	0x8d, 0x88, 0x4d, 0x43, 0x4a, 0xfc, 0xf8, 0x9a, 0xae, 0xdc, 0xfb, 0xe8, 0xba, 0x93, 0xa1, 0xf6,
-	0xa9, 0x5b, 0x81, 0x59, 0xcc, 0x98, 0x6c, 0x23, 0x79, 0x51, 0x92, 0x33, 0xe2, 0xbb, 0xe1, 0xa0,
-	0x65, 0x28, 0x60, 0x8f, 0x1f, 0x13, 0x37, 0x51, 0x37, 0xd5, 0x17, 0xaa, 0x41, 0x41, 0xda, 0x5d,
-	0x99, 0x16, 0x06, 0xdd, 0x1f, 0x5b, 0x14, 0x7d, 0x89, 0x37, 0x95, 0x24, 0x3a, 0x80, 0xb9, 0xcc,
-	0x9e, 0x4a, 0xe1, 0xad, 0x61, 0xba, 0xc2, 0xc6, 0x3f, 0xf3, 0x50, 0x7a, 0x12, 0x39, 0x24, 0xda,
-	0xa7, 0xae, 0x9b, 0x66, 0xeb, 0x14, 0xe6, 0x3d, 0x7c, 0x41, 0x22, 0x2b, 0xe0, 0x9c, 0xf1, 0xc5,
-	0x3b, 0x24, 0x70, 0x02, 0x4f, 0x3d, 0x1c, 0x20, 0x80, 0x04, 0x05, 0xed, 0xc3, 0xb4, 0x04, 0xcc,
-	0xbd, 0x0b, 0xe0, 0xc1, 0x94, 0x29, 0xc5, 0xd1, 0x17, 0x50, 0x76, 0xe9, 0x97, 0x09, 0x75, 0x70,
-	0x4c, 0x03, 0x5f, 0x19, 0x29, 0xdb, 0xdd, 0xd6, 0xd8, 0x28, 0x1c, 0x76, 0xa5, 0x04, 0xa4, 0xe8,
-	0x76, 0x25, 0xf7, 0x1a, 0x15, 0xdd, 0x83, 0xf9, 0x33, 0xea, 0xba, 0x96, 0x4a, 0x5f, 0x5e, 0xa4,
-	0x0f, 0x38, 0x69, 0x47, 0xa6, 0x50, 0xbc, 0x1e, 0x3c, 0x3e, 0x67, 0x84, 0x88, 0x2c, 0x22, 0xfe,
-	0x7a, 0x5c, 0x90, 0x68, 0x9f, 0x10, 0xce, 0x8c, 0x33, 0x66, 0x41, 0x32, 0xe3, 0x94, 0xf9, 0x21,
-	0xa0, 0x38, 0x88, 0xb1, 0x6b, 0x71, 0x34, 0xe2, 0x58, 0x42, 0xaa, 0x32, 0x23, 0x34, 0x94, 0x04,
-	0x67, 0x5f, 0x30, 0x8e, 0x38, 0x7d, 0xe0, 0xb4, 0x80, 0xa9, 0xcc, 0x0e, 0x9c, 0x6e, 0x71, 0x7a,
-	0xad, 0x08, 0xf3, 0x71, 0x37, 0x6b, 0xc6, 0xaf, 0x73, 0x80, 0x06, 0x1d, 0x46, 0x3f, 0x05, 0x48,
-	0x1d, 0x26, 0xef, 0x77, 0xff, 0xd2, 0x0c, 0x77, 0xe1, 0xd0, 0x06, 0x2c, 0xf0, 0x89, 0xcc, 0xe2,
-	0xad, 0x3b, 0xbd, 0x72, 0x45, 0x13, 0x38, 0xad, 0x89, 0x69, 0xd4, 0x70, 0x06, 0xc6, 0xab, 0xfc,
-	0xe0, 0x78, 0xf5, 0x6d, 0x00, 0xe9, 0x35, 0xa3, 0x2f, 0x88, 0xba, 0x3c, 0x73, 0x82, 0x72, 0x42,
-	0x5f, 0x10, 0x74, 0x07, 0x0a, 0x94, 0x59, 0xed, 0xe4, 0x4a, 0x44, 0x7e, 0xd6, 0x9c, 0xa6, 0xac,
-	0x96, 0x5c, 0xf1, 0xe6, 0xcc, 0x92, 0x76, 0x4c, 0xed, 0x0b, 0x26, 0xa2, 0xae, 0x9b, 0xd9, 0xb7,
-	0xf1, 0xdf, 0x1c, 0xdc, 0xed, 0x5a, 0xde, 0xff, 0x72, 0x3d, 0xbb, 0xc9, 0x5e, 0x7a, 0xad, 0x93,
-	0xbe, 0x80, 0x35, 0x39, 0x42, 0x38, 0x56, 0xd7, 0xe9, 0x30, 0x60, 0x94, 0x27, 0x84, 0x55, 0xf2,
-	0x62, 0x1c, 0xfb, 0xe1, 0xc4, 0x9a, 0x9a, 0x29, 0x46, 0x53, 0x41, 0x98, 0x2b, 0x0a, 0x7e, 0x80,
-	0xc3, 0x90, 0x0f, 0x77, 0x53, 0xdd, 0xb2, 0x43, 0x75, 0xf5, 0xea, 0x42, 0xef, 0x0f, 0x26, 0xd6,
-	0xbb, 0xc3, 0xe5, 0x33, 0x9d, 0x77, 0x14, 0x6c, 0x1f, 0x95, 0x3d, 0xd6, 0x67, 0x73, 0xa5, 0xbc,
-	0xf1, 0x07, 0x80, 0xa5, 0x93, 0x18, 0xc7, 0xe4, 0x2c, 0x71, 0x45, 0xc5, 0xa5, 0x61, 0xf6, 0x60,
-	0x5e, 0x94, 0xa5, 0x15, 0xba, 0xd8, 0x4e, 0xdf, 0xc3, 0xc7, 0xe3, 0x7b, 0xd6, 0x10, 0x9c, 0x7e,
-	0x62, 0x93, 0x63, 0x79, 0xe9, 0xd8, 0x02, 0x41, 0x46, 0x43, 0x01, 0x14, 0xa5, 0x3a, 0xb5, 0x57,
-	0xa8, 0xf6, 0x70, 0xf0, 0x9e, 0x0a, 0x4d, 0x89, 0x26, 0xa7, 0xa4, 0xa0, 0x87, 0x82, 0x7e, 0xa3,
-	0xc1, 0x9a, 0x1d, 0xf8, 0x8e, 0x88, 0x06, 0x76, 0xad, 0x1e, 0x67, 0xb9, 0x81, 0xaa, 0xd7, 0x1f,
-	0xbd, 0xbd, 0xfe, 0xdd, 0x2e, 0xe8, 0x10, 0x9f, 0x57, 0xec, 0x51, 0xec, 0x11, 0x16, 0xc5, 0x11,
-	0xed, 0x74, 0x48, 0x44, 0x1c, 0xf5, 0x6c, 0xdc, 0x80, 0x45, 0xad, 0x14, 0x72, 0xb8, 0x45, 0x19,
-	0x1b, 0xfd, 0x4a, 0x83, 0x15, 0x37, 0xf0, 0x3b, 0x56, 0x4c, 0x22, 0x6f, 0x20, 0x42, 0x33, 0xef,
-	0x5a, 0x12, 0x87, 0x81, 0xdf, 0x69, 0x91, 0xc8, 0x1b, 0x12, 0x9e, 0x65, 0x77, 0x28, 0x6f, 0xf5,
-	0xe7, 0x50, 0x19, 0x55, 0x48, 0x68, 0x2f, 0x7d, 0xa5, 0xde, 0xe9, 0xd9, 0x53, 0x6f, 0xd4, 0xea,
-	0x57, 0x1a, 0x2c, 0x0f, 0x2f, 0x1d, 0xf4, 0x0c, 0x4a, 0xa2, 0x2a, 0x89, 0xa3, 0x62, 0x90, 0x35,
-	0x9d, 0x07, 0x6f, 0xa7, 0xab, 0xe1, 0x98, 0x8b, 0x0a, 0x49, 0x7d, 0xa3, 0x4f, 0xa0, 0x20, 0x37,
-	0x68, 0xb5, 0xa0, 0x8d, 0x78, 0x0f, 0xe5, 0xd2, 0x5d, 0xed, 0x35, 0xcc, 0x14, 0x62, 0xa6, 0x12,
-	0x5f, 0xb5, 0x61, 0x6d, 0x4c, 0xe5, 0xdd, 0x50, 0x90, 0x7e, 0x39, 0xa8, 0xa4, 0xa7, 0x98, 0xd0,
-	0x17, 0x80, 0xb2, 0x72, 0x7d, 0xff, 0x50, 0x95, 0x32, 0x2c, 0x45, 0xe1, 0x55, 0x30, 0xaa, 0x76,
-	0x6e, 0xc6, 0xc1, 0x6c, 0x77, 0x92, 0xdd, 0xf1, 0xb1, 0x3e, 0x9b, 0x2f, 0xe9, 0xc6, 0x1f, 0x35,
-	0x40, 0xa2, 0x79, 0xf6, 0x6f, 0x28, 0x8b, 0x90, 0xcb, 0x76, 0xd1, 0x1c, 0x15, 0xf3, 0x23, 0xbb,
-	0xf2, 0xda, 0x81, 0x2b, 0xa7, 0x70, 0x53, 0x7d, 0xf1, 0xe7, 0xf1, 0x1c, 0x33, 0x4b, 0xee, 0x68,
-	0xe2, 0xfd, 0x9c, 0x35, 0xe7, 0xce, 0x31, 0x93, 0xeb, 0x43, 0xff, 0x66, 0xab, 0x5f, 0xdb, 0x6c,
-	0x3f, 0x80, 0x32, 0x8e, 0x03, 0x8f, 0xda, 0x56, 0x44, 0x58, 0xe0, 0x26, 0x3c, 0xf0, 0xa2, 0x35,
-	0x95, 0xcd, 0x92, 0x64, 0x98, 0x19, 0xdd, 0xf8, 0x2a, 0x0f, 0xdf, 0xca, 0x1e, 0x96, 0x61, 0x3b,
-	0xd5, 0x75, 0x8b, 0xdf, 0xfc, 0xfa, 0x2f, 0x43, 0x81, 0xbf, 0xc8, 0x24, 0x12, 0x76, 0xcf, 0x99,
-	0xea, 0x6b, 0xbc, 0xd1, 0x07, 0x50, 0x60, 0x31, 0x8e, 0x13, 0x26, 0x2c, 0x5d, 0x9c, 0x24, 0xf5,
-	0xbb, 0x4a, 0xe5, 0x89, 0x90, 0x33, 0x95, 0x3c, 0xfa, 0x11, 0xac, 0x7d, 0x99, 0x60, 0x3f, 0x4e,
-	0x3c, 0xcb, 0x0e, 0xfc, 0x4b, 0x12, 0x31, 0x3e, 0x3f, 0x66, 0x3b, 0x5d, 0x41, 0x04, 0x62, 0x45,
-	0x1d, 0xd9, 0xcd, 0x4e, 0xa4, 0x5b, 0xeb, 0xf0, 0xf0, 0xcd, 0x0c, 0x0f, 0x1f, 0xba, 0x0f, 0xe5,
-	0x74, 0x00, 0xe1, 0xaf, 0xbf, 0xc5, 0x7f, 0x89, 0xd9, 0xad, 0x68, 0xde, 0x4a, 0x19, 0x4d, 0x12,
-	0xb5, 0xa8, 0x7d, 0xc1, 0x07, 0x3d, 0x16, 0x93, 0xd0, 0xe2, 0xfb, 0x9e, 0xa5, 0xf4, 0xb3, 0xca,
-	0x9c, 0x1c, 0xf4, 0x38, 0x87, 0x6f, 0x85, 0x3f, 0x51, 0x74, 0xf4, 0x5d, 0x58, 0x94, 0x33, 0x17,
-	0x8d, 0xaf, 0xac, 0x98, 0x92, 0xa8, 0x02, 0x02, 0xb6, 0x98, 0x51, 0x5b, 0x94, 0x44, 0xc6, 0x4b,
-	0x0d, 0x56, 0x0f, 0x7b, 0x29, 0xa7, 0x21, 0x23, 0x51, 0x3c, 0x2a, 0x7b, 0x08, 0x74, 0x1f, 0x7b,
-	0x44, 0x55, 0x9b, 0xf8, 0xcd, 0xed, 0xa2, 0x3e, 0x8d, 0x29, 0x76, 0x79, 0xbd, 0x75, 0xf8, 0x22,
-	0x1e, 0x7a, 0x6a, 0x66, 0x2b, 0x29, 0xce, 0x91, 0x60, 0x34, 0x43, 0x0f, 0x7d, 0x0c, 0x15, 0x0f,
-	0x53, 0x3f, 0x26, 0x3e, 0xf6, 0x6d, 0x62, 0x9d, 0x45, 0xd8, 0x16, 0x03, 0x3a, 0x97, 0x91, 0x49,
-	0x5d, 0xee, 0xe1, 0xef, 0x2b, 0x36, 0x97, 0x7c, 0x04, 0xcb, 0xc2, 0xf5, 0x74, 0x46, 0xb1, 0xfc,
-	0x40, 0xf6, 0x04, 0x91, 0x72, 0xdd, 0x5c, 0xe2, 0xdc, 0x74, 0xd6, 0x38, 0x56, 0x3c, 0xe3, 0xf7,
-	0x39, 0xb8, 0x23, 0x87, 0xb9, 0x34, 0xdf, 0xa9, 0x6f, 0xd7, 0x2b, 0x51, 0x1b, 0xa8, 0xc4, 0x6e,
-	0x51, 0xe5, 0xfe, 0xbf, 0x45, 0x95, 0x7f, 0x53, 0x51, 0x0d, 0xad, 0x13, 0xfd, 0x6d, 0xea, 0x64,
-	0x7a, 0x78, 0x9d, 0x18, 0x7f, 0xd1, 0x60, 0x59, 0xc6, 0x27, 0xbb, 0xc6, 0x63, 0x9a, 0x8d, 0xba,
-	0x98, 0xb9, 0xd1, 0x17, 0x33, 0x3f, 0x49, 0x37, 0xd1, 0x47, 0x5c, 0x87, 0xc1, 0xa2, 0x9d, 0x1e,
-	0x52, 0xb4, 0x35, 0xf3, 0xeb, 0x57, 0xeb, 0xda, 0x37, 0xaf, 0xd6, 0xb5, 0xff, 0xbc, 0x5a, 0xd7,
-	0x7e, 0xfb, 0x7a, 0x7d, 0xea, 0x9b, 0xd7, 0xeb, 0x53, 0xff, 0x7a, 0xbd, 0x3e, 0xf5, 0xec, 0xe3,
-	0xc9, 0xff, 0x55, 0xda, 0xff, 0x3f, 0xed, 0x76, 0x41, 0x30, 0xbe, 0xf7, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xc3, 0xa2, 0xc7, 0xf2, 0xf9, 0x16, 0x00, 0x00,
+	// 2029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcb, 0x6f, 0x23, 0x59,
+	0xd5, 0x4f, 0xd9, 0x15, 0xc7, 0x39, 0x89, 0xbb, 0x9d, 0xdb, 0xe9, 0xb4, 0x93, 0x7c, 0x5f, 0x12,
+	0x4a, 0x1a, 0x11, 0xe6, 0xe1, 0x74, 0x9a, 0x06, 0x8d, 0x58, 0x20, 0xe2, 0xc4, 0x99, 0xb8, 0x27,
+	0x49, 0x9b, 0x6b, 0xa7, 0x67, 0xba, 0x41, 0x53, 0x54, 0xaa, 0x6e, 0x9c, 0xab, 0xd4, 0x6b, 0xea,
+	0x96, 0x43, 0xa7, 0x25, 0xd6, 0xb0, 0x41, 0x20, 0xb1, 0x66, 0xc9, 0x06, 0x89, 0x05, 0x12, 0xb0,
+	0x9b, 0xd5, 0x6c, 0x66, 0xc7, 0x88, 0x0d, 0x88, 0x45, 0x0b, 0x75, 0x2f, 0xe0, 0xcf, 0x40, 0xf7,
+	0x51, 0x65, 0x3b, 0x7e, 0xc4, 0xdd, 0x09, 0xab, 0xb8, 0xce, 0xb9, 0xe7, 0x77, 0xde, 0xe7, 0x3e,
+	0x02, 0xeb, 0xce, 0x85, 0xf3, 0x3c, 0x8c, 0x82, 0x38, 0xb0, 0x03, 0x77, 0x83, 0xfa, 0x0e, 0x79,
+	0x4e, 0xa2, 0x0d, 0x72, 0x4e, 0xfc, 0x98, 0xa9, 0x3f, 0x65, 0xc1, 0x46, 0xcb, 0xdd, 0x2b, 0xcb,
+	0x6a, 0x65, 0x59, 0x2e, 0x59, 0x5a, 0xb4, 0x03, 0xe6, 0x05, 0xcc, 0x14, 0xfc, 0x0d, 0xf9, 0x21,
+	0xe5, 0x96, 0xe6, 0x5b, 0x41, 0x2b, 0x90, 0x74, 0xfe, 0x4b, 0x51, 0xef, 0x0f, 0xd4, 0xcb, 0x4e,
+	0xad, 0x88, 0x38, 0x1b, 0x11, 0xf1, 0x82, 0x73, 0xcb, 0x35, 0x23, 0x62, 0xb1, 0xc0, 0x57, 0x12,
+	0xef, 0x0d, 0x94, 0x48, 0x09, 0xe7, 0x9b, 0x1b, 0xb6, 0x1b, 0x1c, 0xab, 0xc5, 0x9b, 0x57, 0x2e,
+	0x66, 0xed, 0x63, 0xcb, 0xb6, 0x83, 0xb6, 0x1f, 0x4b, 0x11, 0xe3, 0xaf, 0x1a, 0xdc, 0xde, 0x6d,
+	0xfb, 0x0e, 0xf5, 0x5b, 0x47, 0xa1, 0x63, 0xc5, 0xe4, 0xc9, 0x26, 0xfa, 0x06, 0xcc, 0x86, 0x24,
+	0x0a, 0x49, 0xdc, 0xb6, 0x5c, 0x93, 0x3a, 0x25, 0x6d, 0x4d, 0x5b, 0x2f, 0xe0, 0x99, 0x94, 0x56,
+	0x73, 0xd0, 0xbb, 0x30, 0x77, 0x22, 0xa5, 0xcc, 0x73, 0xcb, 0x6d, 0x13, 0x33, 0x0c, 0xbd, 0x52,
+	0x66, 0x4d, 0x5b, 0x9f, 0xc4, 0xb7, 0x15, 0xe3, 0x09, 0xa7, 0xd7, 0x43, 0x0f, 0x79, 0x50, 0x48,
+	0xd6, 0x0a, 0x93, 0x4a, 0xd9, 0x35, 0x6d, 0x7d, 0xb6, 0xb2, 0xf7, 0xd5, 0xcb, 0xd5, 0x89, 0x7f,
+	0xbe, 0x5c, 0xfd, 0x41, 0x8b, 0xc6, 0xa7, 0xed, 0xe3, 0xb2, 0x1d, 0x78, 0x1b, 0x3d, 0xf6, 0x9f,
+	0x3f, 0xfc, 0xc0, 0x3e, 0xb5, 0xa8, 0xdf, 0x71, 0xc0, 0x89, 0x2f, 0x42, 0xc2, 0xca, 0x0d, 0x12,
+	0x51, 0xcb, 0xa5, 0x2f, 0xac, 0x63, 0x97, 0xd4, 0xfc, 0x18, 0xcf, 0x2a, 0xf8, 0x1a, 0x47, 0x37,
+	0x7e, 0x93, 0x81, 0x5b, 0xca, 0xa3, 0x2a, 0x4f, 0xd3, 0x93, 0x4d, 0xb4, 0x0f, 0x53, 0x6d, 0xe1,
+	0x1c, 0x2b, 0x69, 0x6b, 0xd9, 0xf5, 0x99, 0x07, 0xef, 0x97, 0x47, 0xa4, 0xb5, 0x7c, 0x29, 0x1e,
+	0x15, 0x9d, 0x5b, 0x8a, 0x13, 0x08, 0xb4, 0x03, 0x3a, 0xb7, 0x43, 0xb8, 0x7b, 0xeb, 0xc1, 0xfd,
+	0x71, 0xa0, 0x94, 0x21, 0xe5, 0xe6, 0x45, 0x48, 0xb0, 0x90, 0x36, 0x3c, 0xd0, 0xf9, 0x17, 0x9a,
+	0x87, 0x62, 0xf3, 0x69, 0xbd, 0x6a, 0x1e, 0x1d, 0x36, 0xea, 0xd5, 0xed, 0xda, 0x6e, 0xad, 0xba,
+	0x53, 0x9c, 0x40, 0xf7, 0xe0, 0x8e, 0xa0, 0xd6, 0x71, 0xf5, 0xa0, 0x76, 0x74, 0x60, 0x36, 0xb6,
+	0x0e, 0xea, 0xfb, 0xd5, 0xa2, 0x86, 0x56, 0x61, 0x59, 0x30, 0x76, 0x8f, 0x0e, 0x77, 0x6a, 0x87,
+	0x1f, 0x99, 0x78, 0xab, 0x59, 0x35, 0xb7, 0x0e, 0x77, 0xcc, 0xda, 0xe1, 0x4e, 0xf5, 0xd3, 0x62,
+	0x06, 0xdd, 0x85, 0xb9, 0x1e, 0xc9, 0x27, 0x8f, 0x9b, 0xd5, 0x62, 0xd6, 0xf8, 0x32, 0x03, 0x85,
+	0x03, 0x2b, 0x3a, 0x23, 0x71, 0x12, 0x94, 0x65, 0x98, 0xf6, 0x04, 0xa1, 0x93, 0xe2, 0xbc, 0x24,
+	0xd4, 0x1c, 0xf4, 0x0c, 0x66, 0xc3, 0x88, 0xda, 0xc4, 0x94, 0x4e, 0x0b, 0x5f, 0x67, 0x1e, 0x7c,
+	0x67, 0xa4, 0xaf, 0x12, 0xbe, 0xce, 0xc5, 0x64, 0xe8, 0x94, 0xa6, 0xbd, 0x09, 0x3c, 0x13, 0x76,
+	0xa8, 0xe8, 0x13, 0x28, 0x28, 0xc5, 0x76, 0x44, 0x38, 0x78, 0x56, 0x80, 0xdf, 0x1f, 0x03, 0x7c,
+	0x3b, 0x22, 0x3d, 0xb8, 0xb3, 0x5e, 0x17, 0xb9, 0x0b, 0xd8, 0x0b, 0x1c, 0x7a, 0x72, 0x51, 0xd2,
+	0xc7, 0x06, 0x3e, 0x10, 0x02, 0x7d, 0xc0, 0x92, 0x5c, 0x99, 0x82, 0x49, 0xb1, 0xda, 0x78, 0x04,
+	0xa5, 0x61, 0x5e, 0xa2, 0x32, 0xdc, 0x91, 0x21, 0xfb, 0x29, 0x8d, 0x4f, 0x4d, 0xf2, 0x3c, 0x0c,
+	0x7c, 0xe2, 0xc7, 0x22, 0xb2, 0x3a, 0x9e, 0x13, 0xac, 0x4f, 0x68, 0x7c, 0x5a, 0x55, 0x0c, 0xe3,
+	0x53, 0x98, 0x93, 0x58, 0x15, 0x8b, 0xa5, 0x20, 0x08, 0xf4, 0xd0, 0xa2, 0x91, 0x90, 0x9a, 0xc6,
+	0xe2, 0x37, 0xda, 0x80, 0x79, 0x8f, 0xfa, 0xa6, 0x04, 0xb7, 0x4f, 0x2d, 0xbf, 0xd5, 0x69, 0xb7,
+	0x02, 0x9e, 0xf3, 0xa8, 0x2f, 0xac, 0xd9, 0x16, 0x9c, 0x7a, 0xe8, 0x19, 0x6d, 0xb8, 0x33, 0x20,
+	0x5c, 0xa8, 0x02, 0xfa, 0xb1, 0xc5, 0x88, 0xc0, 0x9e, 0x79, 0x50, 0x1e, 0x23, 0x2a, 0x5d, 0x96,
+	0x61, 0x21, 0x8b, 0x96, 0x20, 0x9f, 0x7a, 0xc6, 0xf5, 0xcf, 0xe1, 0xf4, 0xdb, 0x78, 0x9a, 0xa8,
+	0xed, 0x09, 0xe6, 0x4d, 0xa8, 0x35, 0xfe, 0xa0, 0x41, 0xa1, 0x11, 0xb4, 0x23, 0x9b, 0x3c, 0x3e,
+	0xe1, 0x2d, 0xc5, 0xd0, 0x8f, 0xa1, 0xd0, 0x99, 0x65, 0x49, 0x05, 0x0f, 0xad, 0xd0, 0x94, 0x70,
+	0xbe, 0x59, 0xae, 0x49, 0x5a, 0x23, 0x95, 0xae, 0x39, 0x3c, 0xe1, 0xac, 0xeb, 0x1b, 0x3d, 0x84,
+	0x29, 0xcb, 0x71, 0x22, 0xc2, 0x98, 0xf0, 0x72, 0xba, 0x52, 0xfa, 0xdb, 0x9f, 0x3e, 0x98, 0x57,
+	0x03, 0x7e, 0x4b, 0x72, 0x1a, 0x71, 0x44, 0xfd, 0xd6, 0xde, 0x04, 0x4e, 0x96, 0x56, 0xf2, 0x90,
+	0x63, 0xc2, 0x48, 0xe3, 0xf7, 0x59, 0xb8, 0xdd, 0x8c, 0x2c, 0x9f, 0x9d, 0x90, 0x28, 0x89, 0x43,
+	0x0b, 0xe6, 0x19, 0xf1, 0x1d, 0x12, 0x99, 0x37, 0x67, 0x38, 0x46, 0x12, 0xb2, 0x9b, 0x86, 0x3c,
+	0xb8, 0x17, 0x11, 0x9b, 0x86, 0x94, 0xf8, 0xf1, 0x25, 0x5d, 0x99, 0xeb, 0xe8, 0xba, 0x9b, 0xa2,
+	0xf6, 0xa8, 0x5b, 0x84, 0xbc, 0xc5, 0x98, 0x1c, 0x23, 0x59, 0x51, 0x92, 0x53, 0xe2, 0xbb, 0xe6,
+	0xa0, 0x05, 0xc8, 0x59, 0x1e, 0x5f, 0x26, 0x3a, 0x51, 0xc7, 0xea, 0x0b, 0x55, 0x20, 0x27, 0xed,
+	0x2e, 0x4d, 0x0a, 0x83, 0xde, 0x1d, 0x59, 0x14, 0x3d, 0x89, 0xc7, 0x4a, 0x12, 0xed, 0xc1, 0x74,
+	0x6a, 0x4f, 0x29, 0xf7, 0xc6, 0x30, 0x1d, 0x61, 0xe3, 0xef, 0x59, 0x28, 0x3e, 0x8e, 0x1c, 0x12,
+	0xed, 0x52, 0xd7, 0x4d, 0xb2, 0x75, 0x04, 0x33, 0x9e, 0x75, 0x46, 0x22, 0x33, 0xe0, 0x9c, 0xd1,
+	0xc5, 0x3b, 0x20, 0x70, 0x02, 0x4f, 0x6d, 0x1c, 0x20, 0x80, 0x04, 0x05, 0xed, 0xc2, 0xa4, 0x04,
+	0xcc, 0xbc, 0x0d, 0xe0, 0xde, 0x04, 0x96, 0xe2, 0xe8, 0x33, 0x98, 0x73, 0xe9, 0xe7, 0x6d, 0xea,
+	0x58, 0x31, 0x0d, 0x7c, 0x65, 0xa4, 0x1c, 0x77, 0x1b, 0x23, 0xa3, 0xb0, 0xdf, 0x91, 0x12, 0x90,
+	0x62, 0xda, 0x15, 0xdd, 0x4b, 0x54, 0xb4, 0x0a, 0x33, 0x27, 0xd4, 0x75, 0x4d, 0x95, 0xbe, 0xac,
+	0x48, 0x1f, 0x70, 0xd2, 0x96, 0x4c, 0xa1, 0xd8, 0x3d, 0x78, 0x7c, 0x4e, 0x08, 0x11, 0x59, 0x44,
+	0x7c, 0xf7, 0x38, 0x23, 0xd1, 0x2e, 0x21, 0x9c, 0x19, 0xa7, 0xcc, 0x9c, 0x64, 0xc6, 0x09, 0xf3,
+	0x7d, 0x40, 0x71, 0x10, 0x5b, 0xae, 0xc9, 0xd1, 0x88, 0x63, 0x0a, 0xa9, 0xd2, 0x94, 0xd0, 0x50,
+	0x14, 0x9c, 0x5d, 0xc1, 0x38, 0xe0, 0xf4, 0xbe, 0xd5, 0x02, 0xa6, 0x94, 0xef, 0x5b, 0xdd, 0xe4,
+	0xf4, 0x4a, 0x01, 0x66, 0xe2, 0x4e, 0xd6, 0x8c, 0x5f, 0x64, 0x00, 0xf5, 0x3b, 0x8c, 0x7e, 0x04,
+	0x90, 0x38, 0x4c, 0xae, 0xd7, 0x7f, 0x49, 0x86, 0x3b, 0x70, 0x68, 0x0d, 0x66, 0xf9, 0x89, 0xcc,
+	0xe4, 0xa3, 0x3b, 0x69, 0xb9, 0x02, 0x06, 0x4e, 0xab, 0x5b, 0x34, 0xaa, 0x39, 0x7d, 0xc7, 0xab,
+	0x6c, 0xff, 0xf1, 0xea, 0xff, 0x01, 0xa4, 0xd7, 0x8c, 0xbe, 0x20, 0xaa, 0x79, 0xa6, 0x05, 0xa5,
+	0x41, 0x5f, 0x10, 0x74, 0x17, 0x72, 0x94, 0x99, 0xc7, 0xed, 0x0b, 0x11, 0xf9, 0x3c, 0x9e, 0xa4,
+	0xac, 0xd2, 0xbe, 0xe0, 0xc3, 0x99, 0xb5, 0x8f, 0x63, 0x6a, 0x9f, 0x31, 0x11, 0x75, 0x1d, 0xa7,
+	0xdf, 0xc6, 0xbf, 0x33, 0x70, 0xaf, 0x63, 0x79, 0xef, 0xce, 0xf5, 0xec, 0x26, 0x67, 0xe9, 0xa5,
+	0x49, 0xfa, 0x02, 0x96, 0xe5, 0x11, 0xc2, 0x31, 0x3b, 0x4e, 0x87, 0x01, 0xa3, 0x3c, 0x21, 0xac,
+	0x94, 0x15, 0xc7, 0xb1, 0xef, 0x8d, 0xad, 0xa9, 0x9e, 0x60, 0xd4, 0x15, 0x04, 0x5e, 0x54, 0xf0,
+	0x7d, 0x1c, 0x86, 0x7c, 0xb8, 0x97, 0xe8, 0x96, 0x13, 0xaa, 0xa3, 0x57, 0x17, 0x7a, 0xbf, 0x3b,
+	0xb6, 0xde, 0x2d, 0x2e, 0x9f, 0xea, 0xbc, 0xab, 0x60, 0x7b, 0xa8, 0xec, 0x91, 0x9e, 0xcf, 0x14,
+	0xb3, 0xc6, 0x7f, 0x6e, 0xc3, 0x7c, 0x23, 0xb6, 0x62, 0x72, 0xd2, 0x76, 0x45, 0xc5, 0x25, 0x61,
+	0xf6, 0x60, 0x46, 0x94, 0xa5, 0x19, 0xba, 0x96, 0x9d, 0xec, 0x87, 0x8f, 0x46, 0xcf, 0xac, 0x01,
+	0x38, 0xbd, 0xc4, 0x3a, 0xc7, 0xf2, 0x92, 0x63, 0x0b, 0x04, 0x29, 0x0d, 0x05, 0x50, 0x90, 0xea,
+	0xd4, 0xbd, 0x42, 0x8d, 0x87, 0xbd, 0x6b, 0x2a, 0xc4, 0x12, 0x4d, 0x9e, 0x92, 0x82, 0x2e, 0x0a,
+	0xfa, 0x95, 0x06, 0xcb, 0x76, 0xe0, 0x3b, 0x22, 0x1a, 0x96, 0x6b, 0x76, 0x39, 0xcb, 0x0d, 0x54,
+	0xb3, 0xfe, 0xe0, 0xcd, 0xf5, 0x6f, 0x77, 0x40, 0x07, 0xf8, 0xbc, 0x68, 0x0f, 0x63, 0x0f, 0xb1,
+	0x28, 0x8e, 0x68, 0xab, 0x45, 0x22, 0xe2, 0x94, 0x72, 0x37, 0x65, 0x51, 0x33, 0x81, 0x1c, 0x6c,
+	0x51, 0xca, 0x46, 0x3f, 0xd7, 0x60, 0xd1, 0x0d, 0xfc, 0x96, 0x19, 0x93, 0xc8, 0xeb, 0x8b, 0xd0,
+	0xd4, 0xdb, 0x96, 0xc4, 0x7e, 0xe0, 0xb7, 0x9a, 0x24, 0xf2, 0x06, 0x84, 0x67, 0xc1, 0x1d, 0xc8,
+	0x43, 0x7f, 0xd6, 0xe0, 0x5b, 0x43, 0x63, 0x63, 0x26, 0x73, 0x23, 0x39, 0xff, 0xe7, 0x85, 0x65,
+	0x4f, 0x6f, 0x2c, 0x52, 0x0d, 0x85, 0x9f, 0xdc, 0xb1, 0xf6, 0x26, 0xf0, 0x3b, 0xf6, 0x38, 0x4b,
+	0xd1, 0x2f, 0x35, 0x58, 0xbe, 0x1c, 0xc1, 0x88, 0x74, 0x62, 0x38, 0x2d, 0x2c, 0xdd, 0xbf, 0x66,
+	0x0c, 0x71, 0x07, 0x51, 0x18, 0x57, 0x72, 0x87, 0x70, 0x97, 0x7e, 0x02, 0xa5, 0x61, 0x0d, 0x89,
+	0x76, 0x92, 0xdd, 0xfe, 0xad, 0x8e, 0x0f, 0x6a, 0xaf, 0x5f, 0xfa, 0x42, 0x83, 0x85, 0xc1, 0x2d,
+	0x88, 0x9e, 0x41, 0x51, 0x74, 0x37, 0x71, 0x54, 0x24, 0xd2, 0xe1, 0x7d, 0xff, 0xcd, 0x74, 0xd5,
+	0x1c, 0x7c, 0x4b, 0x21, 0xa9, 0x6f, 0xf4, 0x11, 0xe4, 0xe4, 0x4b, 0x84, 0xba, 0xe8, 0x0e, 0x39,
+	0x57, 0xc8, 0xc7, 0x8b, 0x72, 0xb7, 0x61, 0x58, 0x88, 0x61, 0x25, 0xbe, 0x64, 0xc3, 0xf2, 0x88,
+	0x0e, 0xbe, 0xa1, 0x20, 0xfd, 0xac, 0x5f, 0x49, 0x57, 0x53, 0xa2, 0xcf, 0x00, 0xa5, 0x6d, 0x7f,
+	0xfd, 0x50, 0x15, 0x53, 0x2c, 0x45, 0xe1, 0x55, 0x30, 0xac, 0x07, 0x6f, 0xc8, 0xc1, 0x2f, 0x35,
+	0xf8, 0xe6, 0x98, 0xcd, 0x84, 0x3e, 0x86, 0xfc, 0xb5, 0x7d, 0x9c, 0x0a, 0xe4, 0x0f, 0xf4, 0x31,
+	0x18, 0x57, 0xcf, 0x09, 0x51, 0x23, 0x3a, 0x5e, 0xbd, 0xa2, 0x87, 0x97, 0xfe, 0xa2, 0xc1, 0xd2,
+	0xf0, 0x46, 0x43, 0x18, 0x66, 0x03, 0xf7, 0x06, 0x12, 0x04, 0x81, 0x9b, 0xd6, 0xf1, 0xce, 0xb5,
+	0x8e, 0xdc, 0x2a, 0xfc, 0xe9, 0x13, 0x80, 0xdc, 0xe4, 0x1f, 0xe9, 0xf9, 0x6c, 0x51, 0x37, 0x7e,
+	0xa7, 0x01, 0x12, 0x67, 0x80, 0xde, 0x8b, 0xf6, 0x2d, 0xc8, 0xa4, 0x4f, 0x2a, 0x19, 0x2a, 0xae,
+	0x41, 0xec, 0xc2, 0x3b, 0x0e, 0x5c, 0x79, 0x99, 0xc4, 0xea, 0x8b, 0x9f, 0xf2, 0x4e, 0x2d, 0x66,
+	0xca, 0xa7, 0x06, 0x71, 0x0c, 0xcc, 0xe3, 0xe9, 0x53, 0x8b, 0xc9, 0x5b, 0x70, 0xef, 0x03, 0x8d,
+	0x7e, 0xe9, 0x81, 0xe6, 0x3d, 0x98, 0xb3, 0xe2, 0xc0, 0xa3, 0xb6, 0x19, 0x11, 0x16, 0xb8, 0x6d,
+	0x1e, 0x73, 0xb1, 0xc3, 0xce, 0xe1, 0xa2, 0x64, 0xe0, 0x94, 0x6e, 0x7c, 0x91, 0x85, 0xff, 0x4b,
+	0xcf, 0x47, 0x83, 0x9e, 0x06, 0x2e, 0x5b, 0x7c, 0xf5, 0x21, 0x76, 0x01, 0x72, 0x3c, 0xa3, 0x24,
+	0x12, 0x76, 0x4f, 0x63, 0xf5, 0x35, 0xda, 0xe8, 0x3d, 0xc8, 0xb1, 0xd8, 0x8a, 0xdb, 0xac, 0x34,
+	0x39, 0xea, 0xed, 0xac, 0x3b, 0x17, 0xdb, 0x4a, 0x65, 0x43, 0xc8, 0x61, 0x25, 0x8f, 0xbe, 0x0f,
+	0xcb, 0x9f, 0xb7, 0x2d, 0x3f, 0x6e, 0x7b, 0xa6, 0x1d, 0xf8, 0xe7, 0x24, 0x62, 0xfc, 0x1a, 0x94,
+	0x3e, 0x4d, 0xe4, 0x44, 0x20, 0x16, 0xd5, 0x92, 0xed, 0x74, 0x45, 0xf2, 0xf8, 0x32, 0x38, 0x7c,
+	0x53, 0x83, 0xc3, 0xc7, 0x1f, 0x3b, 0xd3, 0xfd, 0x30, 0xe4, 0xc5, 0x4f, 0xed, 0x33, 0xb1, 0x23,
+	0x16, 0xf0, 0xed, 0x84, 0x51, 0x27, 0x51, 0x93, 0xda, 0x67, 0xfc, 0xbe, 0xc2, 0x62, 0x12, 0x9a,
+	0xfc, 0xd9, 0xc2, 0x54, 0xfa, 0x99, 0xd8, 0x94, 0x74, 0x5c, 0xe4, 0x1c, 0xfe, 0xb8, 0xf1, 0x43,
+	0x45, 0x47, 0xef, 0xc0, 0x2d, 0x79, 0x75, 0xa0, 0xf1, 0x85, 0x19, 0x53, 0x12, 0x95, 0x40, 0xc0,
+	0x16, 0x52, 0x6a, 0x93, 0x92, 0xc8, 0x78, 0xc9, 0xbb, 0xa6, 0x9b, 0x72, 0x14, 0x32, 0x12, 0xc5,
+	0xc3, 0xb2, 0x87, 0x40, 0xf7, 0x2d, 0x8f, 0xa8, 0x6a, 0x13, 0xbf, 0xb9, 0x5d, 0xd4, 0xa7, 0x31,
+	0xb5, 0x5c, 0x5e, 0x6f, 0x2d, 0xfe, 0x9e, 0x14, 0x7a, 0xea, 0xea, 0x51, 0x54, 0x9c, 0x03, 0xc1,
+	0xe0, 0x4f, 0xb6, 0x1f, 0x42, 0xc9, 0xb3, 0xa8, 0x1f, 0x13, 0xdf, 0xf2, 0x6d, 0x62, 0x9e, 0x44,
+	0x96, 0x2d, 0xee, 0x99, 0x5c, 0x46, 0x26, 0x75, 0xa1, 0x8b, 0xbf, 0xab, 0xd8, 0x5c, 0xf2, 0x21,
+	0x2c, 0x08, 0xd7, 0x93, 0xa3, 0xb6, 0xe9, 0x07, 0x72, 0x1c, 0x88, 0x94, 0xeb, 0x78, 0x9e, 0x73,
+	0x93, 0x23, 0xf3, 0xa1, 0xe2, 0x19, 0xbf, 0xcd, 0xc0, 0x5d, 0x39, 0xbd, 0x92, 0x7c, 0x27, 0xbe,
+	0x5d, 0xae, 0x44, 0xad, 0xaf, 0x12, 0x3b, 0x45, 0x95, 0xf9, 0xdf, 0x16, 0x55, 0xf6, 0xaa, 0xa2,
+	0x1a, 0x58, 0x27, 0xfa, 0x9b, 0xd4, 0xc9, 0xe4, 0xe0, 0x3a, 0x31, 0xfe, 0xa8, 0xc1, 0x82, 0x8c,
+	0x4f, 0xda, 0xc6, 0x23, 0x86, 0x8d, 0x6a, 0xcc, 0xcc, 0xf0, 0xc6, 0xcc, 0x8e, 0x33, 0x4d, 0xf4,
+	0x21, 0xed, 0xd0, 0x5f, 0xb4, 0x93, 0x03, 0x8a, 0xb6, 0x82, 0xbf, 0x7a, 0xb5, 0xa2, 0x7d, 0xfd,
+	0x6a, 0x45, 0xfb, 0xd7, 0xab, 0x15, 0xed, 0xd7, 0xaf, 0x57, 0x26, 0xbe, 0x7e, 0xbd, 0x32, 0xf1,
+	0x8f, 0xd7, 0x2b, 0x13, 0xcf, 0x3e, 0x1c, 0xff, 0xc5, 0xbf, 0xf7, 0x5f, 0x33, 0xc7, 0x39, 0xc1,
+	0xf8, 0xf6, 0x7f, 0x07, 0x00, 0x3e, 0x01, 0x28, 0xe3, 0xc0, 0x19, 0x00, 0x00,
 }
 
 func (m *FundingUpdateV1) Marshal() (dAtA []byte, err error) {
@@ -2896,6 +3049,48 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacement) MarshalToSizedBuffer(dAtA
 	}
 	return len(dAtA) - i, nil
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConditionalOrderTriggerSubticksUpdate != nil {
+		{
+			size, err := m.ConditionalOrderTriggerSubticksUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LongTermOrderReplacement != nil {
+		{
+			size, err := m.LongTermOrderReplacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3076,6 +3271,93 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConditionalOrderTriggerSubticks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != nil {
+		{
+			size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OldOrderId != nil {
+		{
+			size, err := m.OldOrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AssetCreateEventV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3749,6 +4031,30 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacement) Size() (n int) {
 	}
 	return n
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConditionalOrderTriggerSubticksUpdate != nil {
+		l = m.ConditionalOrderTriggerSubticksUpdate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LongTermOrderReplacement != nil {
+		l = m.LongTermOrderReplacement.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3817,6 +4123,39 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Size() (n int) {
 	return n
 }
 
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != nil {
+		l = m.OrderId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ConditionalOrderTriggerSubticks != 0 {
+		n += 1 + sovEvents(uint64(m.ConditionalOrderTriggerSubticks))
+	}
+	return n
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldOrderId != nil {
+		l = m.OldOrderId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *AssetCreateEventV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5861,6 +6200,76 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &StatefulOrderEventV1_LongTermOrderPlacement{v}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggerSubticksUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongTermOrderReplacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StatefulOrderEventV1_LongTermOrderReplacementV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_LongTermOrderReplacement{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6331,6 +6740,233 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrderTriggerSubticksUpdateV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrderTriggerSubticksUpdateV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderId == nil {
+				m.OrderId = &v1.IndexerOrderId{}
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggerSubticks", wireType)
+			}
+			m.ConditionalOrderTriggerSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderTriggerSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LongTermOrderReplacementV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LongTermOrderReplacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldOrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldOrderId == nil {
+				m.OldOrderId = &v1.IndexerOrderId{}
+			}
+			if err := m.OldOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &v1.IndexerOrder{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,6 +20,23 @@ func NewLongTermOrderPlacementEvent(
 	}
 }
 
+func NewLongTermOrderReplacementEvent(
+	oldOrderId clobtypes.OrderId,
+	order clobtypes.Order,
+) *StatefulOrderEventV1 {
+	indexerOldOrderId := v1.OrderIdToIndexerOrderId(oldOrderId)
+	indexerOrder := v1.OrderToIndexerOrder(order)
+	orderReplace := StatefulOrderEventV1_LongTermOrderReplacementV1{
+		OldOrderId: &indexerOldOrderId,
+		Order:      &indexerOrder,
+	}
+	return &StatefulOrderEventV1{
+		Event: &StatefulOrderEventV1_LongTermOrderReplacement{
+			LongTermOrderReplacement: &orderReplace,
+		},
+	}
+}
+
 func NewStatefulOrderRemovalEvent(
 	removedOrderId clobtypes.OrderId,
 	reason shared.OrderRemovalReason,
@@ -63,3 +80,19 @@ func NewConditionalOrderTriggeredEvent(
 		},
 	}
 }
+
+func NewConditionalOrderTriggerSubticksUpdateEvent(
+	orderId clobtypes.OrderId,
+	triggerSubticks clobtypes.Subticks,
+) *StatefulOrderEventV1 {
+	indexerOrderId := v1.OrderIdToIndexerOrderId(orderId)
+	triggerSubticksUpdate := StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1{
+		OrderId:                         &indexerOrderId,
+		ConditionalOrderTriggerSubticks: triggerSubticks.ToUint64(),
+	}
+	return &StatefulOrderEventV1{
+		Event: &StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate{
+			ConditionalOrderTriggerSubticksUpdate: &triggerSubticksUpdate,
+		},
+	}
+}
@@ -66,3 +66,16 @@ func TestConditionalOrderTriggeredEvent_Success(t *testing.T) {
 	}
 	require.Equal(t, expectedStatefulOrderEventProto, conditionalOrderTriggeredEvent)
 }
+
+func TestConditionalOrderTriggerSubticksUpdateEvent_Success(t *testing.T) {
+	triggerSubticksUpdateEvent := events.NewConditionalOrderTriggerSubticksUpdateEvent(orderId, 500)
+	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
+		Event: &events.StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate{
+			ConditionalOrderTriggerSubticksUpdate: &events.StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1{
+				OrderId:                         &indexerOrderId,
+				ConditionalOrderTriggerSubticks: 500,
+			},
+		},
+	}
+	require.Equal(t, expectedStatefulOrderEventProto, triggerSubticksUpdateEvent)
+}
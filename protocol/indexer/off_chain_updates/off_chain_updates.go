@@ -91,6 +91,45 @@ func CreateOrderUpdateMessage(
 	return msgsender.Message{Key: orderIdHash, Value: update}, true
 }
 
+// MustCreateOrderReplaceMessage invokes CreateOrderReplaceMessage and panics if creation was unsuccessful.
+func MustCreateOrderReplaceMessage(
+	logger log.Logger,
+	oldOrderId clobtypes.OrderId,
+	order clobtypes.Order,
+) msgsender.Message {
+	msg, ok := CreateOrderReplaceMessage(logger, oldOrderId, order)
+	if !ok {
+		panic(fmt.Errorf("Unable to create replace order message for order %+v", order))
+	}
+	return msg
+}
+
+// CreateOrderReplaceMessage creates an off-chain update message for an order replacing another order.
+// Note that the message is keyed by the new order's id, since all subsequent updates will be for the
+// new order.
+func CreateOrderReplaceMessage(
+	logger log.Logger,
+	oldOrderId clobtypes.OrderId,
+	order clobtypes.Order,
+) (message msgsender.Message, success bool) {
+	errMessage := "Error creating off-chain update message for replacing order."
+	errDetails := fmt.Sprintf("OldOrderId: %+v, Order: %+v", oldOrderId, order)
+
+	orderIdHash, err := GetOrderIdHash(order.OrderId)
+	if err != nil {
+		logger.Error(fmt.Sprintf("%s %s Err: %+v %s\n", errMessage, hashErrMsg, err, errDetails))
+		return msgsender.Message{}, false
+	}
+
+	update, err := newOrderReplaceMessage(oldOrderId, order)
+	if err != nil {
+		logger.Error(fmt.Sprintf("%s %s Err: %+v %s\n", errMessage, createErrMsg, err, errDetails))
+		return msgsender.Message{}, false
+	}
+
+	return msgsender.Message{Key: orderIdHash, Value: update}, true
+}
+
 // MustCreateOrderRemoveMessageWithReason invokes CreateOrderRemoveMessageWithReason and panics if creation was
 // unsuccessful.
 func MustCreateOrderRemoveMessageWithReason(
@@ -242,6 +281,27 @@ func newOrderPlaceMessage(
 	return marshalOffchainUpdate(update, &common.MarshalerImpl{})
 }
 
+// newOrderReplaceMessage returns an `OffChainUpdate` struct populated with an `OrderReplace` struct
+// as the `UpdateMessage` parameter, encoded as a byte slice.
+func newOrderReplaceMessage(
+	oldOrderId clobtypes.OrderId,
+	order clobtypes.Order,
+) ([]byte, error) {
+	indexerOldOrderId := v1.OrderIdToIndexerOrderId(oldOrderId)
+	indexerOrder := v1.OrderToIndexerOrder(order)
+	update := OffChainUpdateV1{
+		UpdateMessage: &OffChainUpdateV1_OrderReplace{
+			&OrderReplaceV1{
+				OldOrderId: &indexerOldOrderId,
+				Order:      &indexerOrder,
+				// Protocol will always send best effort opened messages to indexer.
+				PlacementStatus: OrderPlaceV1_ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
+			},
+		},
+	}
+	return marshalOffchainUpdate(update, &common.MarshalerImpl{})
+}
+
 // newOrderPlaceMessage returns an `OffChainUpdate` struct populated with an `OrderRemove`
 // struct as the `UpdateMessage` parameter, encoded as a byte slice.
 // The `OrderRemove` struct is instantiated with the given orderId, reason and status parameters.
@@ -170,18 +170,26 @@ const (
 	// order will trigger when the oracle price moves at or below the trigger
 	// price for buys and at or above the trigger price for sells.
 	IndexerOrder_CONDITION_TYPE_TAKE_PROFIT IndexerOrder_ConditionType = 2
+	// CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+	// trailing stop order triggers like a stop order, but its trigger price
+	// follows the oracle price at a fixed offset as the market moves in the
+	// order's favor. The trigger price of a sell only ever moves up, and the
+	// trigger price of a buy only ever moves down.
+	IndexerOrder_CONDITION_TYPE_TRAILING_STOP IndexerOrder_ConditionType = 3
 )
 
 var IndexerOrder_ConditionType_name = map[int32]string{
 	0: "CONDITION_TYPE_UNSPECIFIED",
 	1: "CONDITION_TYPE_STOP_LOSS",
 	2: "CONDITION_TYPE_TAKE_PROFIT",
+	3: "CONDITION_TYPE_TRAILING_STOP",
 }
 
 var IndexerOrder_ConditionType_value = map[string]int32{
-	"CONDITION_TYPE_UNSPECIFIED": 0,
-	"CONDITION_TYPE_STOP_LOSS":   1,
-	"CONDITION_TYPE_TAKE_PROFIT": 2,
+	"CONDITION_TYPE_UNSPECIFIED":   0,
+	"CONDITION_TYPE_STOP_LOSS":     1,
+	"CONDITION_TYPE_TAKE_PROFIT":   2,
+	"CONDITION_TYPE_TRAILING_STOP": 3,
 }
 
 func (x IndexerOrder_ConditionType) String() string {
@@ -192,6 +200,57 @@ func (IndexerOrder_ConditionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fac8923e70f7ca3c, []int{1, 2}
 }
 
+// SelfTradePrevention indicates how a match between this order, as the
+// taker order, and a resting order placed by the same subaccount is
+// prevented. Orders placed by the same subaccount never match.
+type IndexerOrder_SelfTradePrevention int32
+
+const (
+	// SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior, which
+	// is the same as SELF_TRADE_PREVENTION_CANCEL_MAKER.
+	IndexerOrder_SELF_TRADE_PREVENTION_UNSPECIFIED IndexerOrder_SelfTradePrevention = 0
+	// SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting order, after which
+	// the taker order continues matching.
+	IndexerOrder_SELF_TRADE_PREVENTION_CANCEL_MAKER IndexerOrder_SelfTradePrevention = 1
+	// SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+	// taker order and leaves the resting order on the book.
+	IndexerOrder_SELF_TRADE_PREVENTION_CANCEL_TAKER IndexerOrder_SelfTradePrevention = 2
+	// SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting order and the
+	// remaining size of the taker order.
+	IndexerOrder_SELF_TRADE_PREVENTION_CANCEL_BOTH IndexerOrder_SelfTradePrevention = 3
+	// SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting order and
+	// decrements the remaining size of the taker order by the remaining size
+	// of the resting order, after which the taker order continues matching.
+	// Resting orders can't be resized, so the resting order is canceled even
+	// if it's larger than the taker order. The remaining size of a decremented
+	// taker order is never placed on the book.
+	IndexerOrder_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL IndexerOrder_SelfTradePrevention = 4
+)
+
+var IndexerOrder_SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_CANCEL_MAKER",
+	2: "SELF_TRADE_PREVENTION_CANCEL_TAKER",
+	3: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+	4: "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL",
+}
+
+var IndexerOrder_SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_UNSPECIFIED":          0,
+	"SELF_TRADE_PREVENTION_CANCEL_MAKER":         1,
+	"SELF_TRADE_PREVENTION_CANCEL_TAKER":         2,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":          3,
+	"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL": 4,
+}
+
+func (x IndexerOrder_SelfTradePrevention) String() string {
+	return proto.EnumName(IndexerOrder_SelfTradePrevention_name, int32(x))
+}
+
+func (IndexerOrder_SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fac8923e70f7ca3c, []int{1, 3}
+}
+
 // IndexerOrderId refers to a single order belonging to a Subaccount.
 type IndexerOrderId struct {
 	// The subaccount ID that opened this order.
@@ -299,6 +358,7 @@ type IndexerOrder struct {
 	// Information about when the order expires.
 	//
 	// Types that are valid to be assigned to GoodTilOneof:
+	//
 	//	*IndexerOrder_GoodTilBlock
 	//	*IndexerOrder_GoodTilBlockTime
 	GoodTilOneof isIndexerOrder_GoodTilOneof `protobuf_oneof:"good_til_oneof"`
@@ -322,6 +382,24 @@ type IndexerOrder struct {
 	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
 	// orderId.ClobPairId`).
 	ConditionalOrderTriggerSubticks uint64 `protobuf:"varint,11,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+	// trailing_offset_subticks represents the absolute distance, in subticks,
+	// between the oracle price and the trigger price of a trailing stop order.
+	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
+	// orderId.ClobPairId`). Exactly one of trailing_offset_subticks and
+	// trailing_offset_ppm must be nonzero if the condition_type is
+	// CONDITION_TYPE_TRAILING_STOP, otherwise both are enforced to be 0.
+	TrailingOffsetSubticks uint64 `protobuf:"varint,12,opt,name=trailing_offset_subticks,json=trailingOffsetSubticks,proto3" json:"trailing_offset_subticks,omitempty"`
+	// trailing_offset_ppm represents the relative distance, in parts per
+	// million of the oracle price, between the oracle price and the trigger
+	// price of a trailing stop order. Must be less than 1,000,000.
+	TrailingOffsetPpm uint32 `protobuf:"varint,13,opt,name=trailing_offset_ppm,json=trailingOffsetPpm,proto3" json:"trailing_offset_ppm,omitempty"`
+	// order_group_id links stateful orders of the same subaccount into a
+	// one-cancels-other group. When any order in a group is filled or
+	// triggered, all other orders in the group are canceled. A value of 0
+	// means the order is not part of a group. Must be 0 for Short-Term orders.
+	OrderGroupId uint32 `protobuf:"varint,14,opt,name=order_group_id,json=orderGroupId,proto3" json:"order_group_id,omitempty"`
+	// The self-trade prevention mode of this order.
+	SelfTradePrevention IndexerOrder_SelfTradePrevention `protobuf:"varint,15,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=dydxprotocol.indexer.protocol.v1.IndexerOrder_SelfTradePrevention" json:"self_trade_prevention,omitempty"`
 }
 
 func (m *IndexerOrder) Reset()         { *m = IndexerOrder{} }
@@ -457,6 +535,34 @@ func (m *IndexerOrder) GetConditionalOrderTriggerSubticks() uint64 {
 	return 0
 }
 
+func (m *IndexerOrder) GetTrailingOffsetSubticks() uint64 {
+	if m != nil {
+		return m.TrailingOffsetSubticks
+	}
+	return 0
+}
+
+func (m *IndexerOrder) GetTrailingOffsetPpm() uint32 {
+	if m != nil {
+		return m.TrailingOffsetPpm
+	}
+	return 0
+}
+
+func (m *IndexerOrder) GetOrderGroupId() uint32 {
+	if m != nil {
+		return m.OrderGroupId
+	}
+	return 0
+}
+
+func (m *IndexerOrder) GetSelfTradePrevention() IndexerOrder_SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return IndexerOrder_SELF_TRADE_PREVENTION_UNSPECIFIED
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*IndexerOrder) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -470,6 +576,7 @@ func init() {
 	proto.RegisterEnum("dydxprotocol.indexer.protocol.v1.IndexerOrder_Side", IndexerOrder_Side_name, IndexerOrder_Side_value)
 	proto.RegisterEnum("dydxprotocol.indexer.protocol.v1.IndexerOrder_TimeInForce", IndexerOrder_TimeInForce_name, IndexerOrder_TimeInForce_value)
 	proto.RegisterEnum("dydxprotocol.indexer.protocol.v1.IndexerOrder_ConditionType", IndexerOrder_ConditionType_name, IndexerOrder_ConditionType_value)
+	proto.RegisterEnum("dydxprotocol.indexer.protocol.v1.IndexerOrder_SelfTradePrevention", IndexerOrder_SelfTradePrevention_name, IndexerOrder_SelfTradePrevention_value)
 	proto.RegisterType((*IndexerOrderId)(nil), "dydxprotocol.indexer.protocol.v1.IndexerOrderId")
 	proto.RegisterType((*IndexerOrder)(nil), "dydxprotocol.indexer.protocol.v1.IndexerOrder")
 }
@@ -479,58 +586,70 @@ func init() {
 }
 
 var fileDescriptor_fac8923e70f7ca3c = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x73, 0xdb, 0x54,
-	0x14, 0xb5, 0x1c, 0xb7, 0x71, 0xae, 0x3f, 0x10, 0x8f, 0x32, 0x15, 0x49, 0xeb, 0x18, 0x2f, 0x20,
-	0x03, 0x83, 0x4d, 0x5a, 0x58, 0xc0, 0xc0, 0xc2, 0x56, 0xe4, 0xf6, 0x4d, 0x54, 0x49, 0x48, 0x0a,
-	0x4c, 0xba, 0xe0, 0x21, 0x4b, 0x2f, 0xee, 0x9b, 0xca, 0x7a, 0x41, 0x96, 0x32, 0xf5, 0x8e, 0x9f,
-	0xc0, 0xcf, 0xea, 0xb2, 0xb0, 0x60, 0x58, 0x31, 0x4c, 0xf2, 0x47, 0x98, 0x27, 0x09, 0x55, 0x8e,
-	0x3b, 0x94, 0xec, 0x74, 0xcf, 0x3d, 0xf7, 0xcc, 0x3d, 0xe7, 0xca, 0x32, 0x7c, 0x1a, 0xac, 0x82,
-	0x17, 0xe7, 0x31, 0x4f, 0xb8, 0xcf, 0xc3, 0x11, 0x8b, 0x02, 0xfa, 0x82, 0xc6, 0xa3, 0x12, 0xb8,
-	0x38, 0x1c, 0xf9, 0x21, 0x9f, 0x0d, 0x33, 0x00, 0xf5, 0xab, 0xe4, 0x61, 0x41, 0x1e, 0x96, 0xc0,
-	0xc5, 0xe1, 0xee, 0xe1, 0x5b, 0xe5, 0x96, 0xe9, 0xcc, 0xf3, 0x7d, 0x9e, 0x46, 0x49, 0x3e, 0xb8,
-	0x7b, 0x67, 0xce, 0xe7, 0x3c, 0x7b, 0x1c, 0x89, 0xa7, 0x1c, 0x1d, 0xfc, 0x2e, 0x41, 0x17, 0xe7,
-	0xe3, 0x66, 0x1c, 0xd0, 0x18, 0x07, 0xe8, 0x27, 0xe8, 0xbc, 0x1e, 0x26, 0x2c, 0x50, 0xa4, 0xbe,
-	0x74, 0xd0, 0x7a, 0xf0, 0xe5, 0xf0, 0x6d, 0x5b, 0x0d, 0x0b, 0x21, 0xa7, 0x9c, 0xc6, 0xc1, 0xa4,
-	0xf1, 0xf2, 0xaf, 0xfd, 0x9a, 0xdd, 0x5e, 0x56, 0x30, 0xb4, 0x07, 0x3b, 0x7e, 0xc8, 0x68, 0xae,
-	0x5e, 0xef, 0x4b, 0x07, 0xdb, 0x76, 0x33, 0x07, 0x70, 0x80, 0xf6, 0xa1, 0xc5, 0xc5, 0x26, 0xe4,
-	0x2c, 0xf4, 0xe6, 0x4b, 0x65, 0xab, 0x2f, 0x1d, 0x74, 0x6c, 0xc8, 0xa0, 0xa9, 0x40, 0x50, 0x1f,
-	0xda, 0x22, 0x2b, 0x72, 0xee, 0xb1, 0x58, 0x08, 0x34, 0x72, 0x86, 0xc0, 0x2c, 0x8f, 0xc5, 0x38,
-	0x18, 0xfc, 0xb1, 0x0d, 0xed, 0xaa, 0x29, 0xf4, 0x1d, 0x34, 0x73, 0xcd, 0xd2, 0xcd, 0xe7, 0xff,
-	0xdb, 0x4d, 0x11, 0x4b, 0x61, 0x64, 0x9b, 0x17, 0x29, 0x3d, 0x82, 0xc6, 0x92, 0x05, 0x34, 0x5b,
-	0xbf, 0xfb, 0xe0, 0xe1, 0xcd, 0xe4, 0x86, 0x0e, 0x0b, 0xa8, 0x9d, 0x09, 0xa0, 0x5d, 0x68, 0xfe,
-	0x9c, 0x7a, 0x51, 0x92, 0x2e, 0x72, 0xb3, 0x0d, 0xbb, 0xac, 0x45, 0x6f, 0x99, 0xce, 0x12, 0xe6,
-	0x3f, 0x5f, 0x66, 0x36, 0x1b, 0x76, 0x59, 0xa3, 0x8f, 0xa0, 0x3b, 0xe7, 0x3c, 0x20, 0x09, 0x0b,
-	0xc9, 0x2c, 0xe4, 0xfe, 0x73, 0xe5, 0x96, 0x08, 0xe2, 0x71, 0xcd, 0x6e, 0x0b, 0xdc, 0x65, 0xe1,
-	0x44, 0xa0, 0x68, 0x04, 0xef, 0xad, 0xf3, 0x48, 0xc2, 0x16, 0x54, 0xb9, 0x2d, 0x62, 0x7f, 0x5c,
-	0xb3, 0xe5, 0x2a, 0xd9, 0x65, 0x0b, 0x8a, 0x7e, 0x84, 0x8e, 0x60, 0x10, 0x16, 0x91, 0x33, 0x1e,
-	0xfb, 0x54, 0xd9, 0xce, 0x2c, 0x7e, 0x7d, 0x43, 0x8b, 0x42, 0x0b, 0x47, 0x53, 0xa1, 0x60, 0xb7,
-	0x92, 0xd7, 0x85, 0x38, 0x70, 0x4c, 0x83, 0xd4, 0xa7, 0x84, 0x47, 0xe1, 0x4a, 0x69, 0xf6, 0xa5,
-	0x83, 0xa6, 0x0d, 0x39, 0x64, 0x46, 0xe1, 0x0a, 0x7d, 0x0c, 0xef, 0x14, 0xaf, 0xc7, 0x82, 0x26,
-	0x5e, 0xe0, 0x25, 0x9e, 0xb2, 0x93, 0xdd, 0xb8, 0x9b, 0xc3, 0x4f, 0x0a, 0x14, 0xf9, 0xd0, 0xf5,
-	0x79, 0x14, 0xb0, 0x84, 0xf1, 0x88, 0x24, 0xab, 0x73, 0xaa, 0x40, 0xb6, 0xea, 0x37, 0x37, 0x5c,
-	0x55, 0xfd, 0x57, 0xc4, 0x5d, 0x9d, 0x53, 0xbb, 0xe3, 0x57, 0x4b, 0x74, 0x0c, 0x83, 0x12, 0xf0,
-	0x42, 0x92, 0xbf, 0x47, 0x49, 0xcc, 0xe6, 0x73, 0x1a, 0x93, 0xf2, 0x3a, 0xad, 0xec, 0x3a, 0xfb,
-	0x15, 0x66, 0x26, 0xed, 0xe6, 0x3c, 0xa7, 0xa0, 0x0d, 0xbe, 0x82, 0x86, 0x38, 0x3d, 0xba, 0x03,
-	0xb2, 0x83, 0x8f, 0x34, 0x72, 0x62, 0x38, 0x96, 0xa6, 0xe2, 0x29, 0xd6, 0x8e, 0xe4, 0x1a, 0x6a,
-	0x43, 0x33, 0x43, 0x27, 0x27, 0xa7, 0xb2, 0x84, 0x3a, 0xb0, 0x93, 0x55, 0x8e, 0xa6, 0xeb, 0x72,
-	0x7d, 0xf0, 0x8b, 0x04, 0xad, 0x4a, 0xa6, 0xe8, 0x3e, 0x7c, 0xe0, 0xe2, 0x27, 0x1a, 0xc1, 0x06,
-	0x99, 0x9a, 0xb6, 0x7a, 0x5d, 0xeb, 0x7d, 0x78, 0x77, 0xbd, 0x8d, 0x4d, 0x55, 0x96, 0xd0, 0x1e,
-	0xdc, 0x5d, 0x87, 0x2d, 0xd3, 0x71, 0x89, 0x69, 0xe8, 0xa7, 0x72, 0x1d, 0xf5, 0x60, 0x77, 0xbd,
-	0x39, 0xc5, 0xba, 0x4e, 0x4c, 0x9b, 0x1c, 0x63, 0x5d, 0x97, 0xb7, 0x06, 0x0b, 0xe8, 0xac, 0x45,
-	0x25, 0x06, 0x54, 0xd3, 0x38, 0xc2, 0x2e, 0x36, 0x0d, 0xe2, 0x9e, 0x5a, 0xd7, 0x97, 0xb8, 0x07,
-	0xca, 0xb5, 0xbe, 0xe3, 0x9a, 0x16, 0xd1, 0x4d, 0xc7, 0x91, 0xa5, 0x37, 0x4c, 0xbb, 0xe3, 0x63,
-	0x8d, 0x58, 0xb6, 0x39, 0xc5, 0xae, 0x5c, 0x9f, 0xc8, 0x95, 0x37, 0x9c, 0x47, 0x94, 0x9f, 0x7d,
-	0xf2, 0x9b, 0x04, 0x5d, 0xb5, 0xf8, 0x9d, 0x3b, 0x89, 0x97, 0xa4, 0xe2, 0x6b, 0x70, 0x4f, 0xd5,
-	0xcd, 0x09, 0xb1, 0xc6, 0xd8, 0x26, 0x8e, 0x3b, 0x76, 0x4f, 0x9c, 0x6b, 0x4b, 0xec, 0xc1, 0xdd,
-	0x0d, 0xc6, 0x58, 0x75, 0xf1, 0xf7, 0x5a, 0x9e, 0xc7, 0x46, 0xd3, 0x1a, 0x9f, 0x38, 0xda, 0x91,
-	0x5c, 0x7f, 0xa3, 0xb6, 0x3a, 0x36, 0x54, 0x4d, 0xcf, 0x13, 0xdb, 0xca, 0x2c, 0x6c, 0x8c, 0x97,
-	0x89, 0x36, 0xd0, 0x87, 0x70, 0x7f, 0xa3, 0x8f, 0x0d, 0xec, 0xe2, 0xb1, 0x8e, 0x9f, 0x62, 0xe3,
-	0x91, 0x7c, 0x6b, 0xf2, 0xc3, 0xcb, 0xcb, 0x9e, 0xf4, 0xea, 0xb2, 0x27, 0xfd, 0x7d, 0xd9, 0x93,
-	0x7e, 0xbd, 0xea, 0xd5, 0x5e, 0x5d, 0xf5, 0x6a, 0x7f, 0x5e, 0xf5, 0x6a, 0x4f, 0xbf, 0x9d, 0xb3,
-	0xe4, 0x59, 0x3a, 0x1b, 0xfa, 0x7c, 0x31, 0x5a, 0xfb, 0xde, 0x5f, 0x7c, 0xf1, 0x99, 0xff, 0xcc,
-	0x63, 0xd1, 0xe8, 0xbf, 0xfe, 0x01, 0x66, 0xb7, 0xb3, 0xe2, 0xe1, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x19, 0x52, 0x63, 0x4b, 0x7b, 0x06, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x73, 0xdb, 0x54,
+	0x17, 0xb6, 0x12, 0xb7, 0x75, 0x8e, 0x3f, 0xaa, 0xde, 0xb4, 0x6f, 0xf5, 0x26, 0xad, 0xe3, 0x7a,
+	0xa0, 0x64, 0xca, 0x60, 0x93, 0x16, 0x66, 0x80, 0x81, 0x85, 0x3f, 0xe4, 0xe4, 0x4e, 0x14, 0xc9,
+	0x48, 0x4a, 0x99, 0x74, 0xc1, 0x45, 0x96, 0xae, 0x5d, 0x4d, 0x65, 0x5d, 0x23, 0xcb, 0x99, 0x66,
+	0xc7, 0x3f, 0x80, 0x9f, 0xd5, 0x65, 0x61, 0xc5, 0x8a, 0x61, 0x92, 0x05, 0x7f, 0x83, 0xb9, 0x57,
+	0x8a, 0x62, 0x3b, 0x81, 0x90, 0x9d, 0xce, 0x73, 0x9e, 0xe7, 0xf1, 0xf9, 0xb2, 0x46, 0xf0, 0xb1,
+	0x77, 0xe2, 0xbd, 0x9d, 0x44, 0x2c, 0x66, 0x2e, 0x0b, 0x9a, 0x7e, 0xe8, 0xd1, 0xb7, 0x34, 0x6a,
+	0x66, 0xc0, 0xf1, 0x4e, 0xd3, 0x0d, 0xd8, 0xa0, 0x21, 0x00, 0x54, 0x9b, 0x27, 0x37, 0x52, 0x72,
+	0x23, 0x03, 0x8e, 0x77, 0x36, 0x76, 0xae, 0xb5, 0x9b, 0xce, 0x06, 0x8e, 0xeb, 0xb2, 0x59, 0x18,
+	0x27, 0xc2, 0x8d, 0xfb, 0x23, 0x36, 0x62, 0xe2, 0xb1, 0xc9, 0x9f, 0x12, 0xb4, 0xfe, 0x9b, 0x04,
+	0x15, 0x9c, 0xc8, 0x8d, 0xc8, 0xa3, 0x11, 0xf6, 0xd0, 0x0f, 0x50, 0xbe, 0x10, 0x13, 0xdf, 0x53,
+	0xa4, 0x9a, 0xb4, 0x5d, 0x7c, 0xfe, 0x79, 0xe3, 0xba, 0xaa, 0x1a, 0xa9, 0x91, 0x95, 0xa9, 0xb1,
+	0xd7, 0xce, 0xbf, 0xfb, 0x63, 0x2b, 0x67, 0x96, 0xa6, 0x73, 0x18, 0xda, 0x84, 0x35, 0x37, 0xf0,
+	0x69, 0xe2, 0xbe, 0x52, 0x93, 0xb6, 0xef, 0x98, 0x85, 0x04, 0xc0, 0x1e, 0xda, 0x82, 0x22, 0xe3,
+	0x95, 0x90, 0x61, 0xe0, 0x8c, 0xa6, 0xca, 0x6a, 0x4d, 0xda, 0x2e, 0x9b, 0x20, 0xa0, 0x1e, 0x47,
+	0x50, 0x0d, 0x4a, 0x7c, 0x56, 0x64, 0xe2, 0xf8, 0x11, 0x37, 0xc8, 0x27, 0x0c, 0x8e, 0xf5, 0x1d,
+	0x3f, 0xc2, 0x5e, 0xfd, 0xaf, 0x22, 0x94, 0xe6, 0x9b, 0x42, 0xdf, 0x42, 0x21, 0xf1, 0xcc, 0xba,
+	0xf9, 0xf4, 0x3f, 0x77, 0x93, 0x8e, 0x25, 0x6d, 0xe4, 0x0e, 0x4b, 0xa7, 0xb4, 0x0b, 0xf9, 0xa9,
+	0xef, 0x51, 0x51, 0x7e, 0xe5, 0xf9, 0x8b, 0x9b, 0xd9, 0x35, 0x2c, 0xdf, 0xa3, 0xa6, 0x30, 0x40,
+	0x1b, 0x50, 0xf8, 0x71, 0xe6, 0x84, 0xf1, 0x6c, 0x9c, 0x34, 0x9b, 0x37, 0xb3, 0x98, 0xe7, 0xa6,
+	0xb3, 0x41, 0xec, 0xbb, 0x6f, 0xa6, 0xa2, 0xcd, 0xbc, 0x99, 0xc5, 0xe8, 0x29, 0x54, 0x46, 0x8c,
+	0x79, 0x24, 0xf6, 0x03, 0x32, 0x08, 0x98, 0xfb, 0x46, 0xb9, 0xc5, 0x07, 0xb1, 0x97, 0x33, 0x4b,
+	0x1c, 0xb7, 0xfd, 0xa0, 0xcd, 0x51, 0xd4, 0x84, 0xf5, 0x45, 0x1e, 0x89, 0xfd, 0x31, 0x55, 0x6e,
+	0xf3, 0xb1, 0xef, 0xe5, 0x4c, 0x79, 0x9e, 0x6c, 0xfb, 0x63, 0x8a, 0xbe, 0x87, 0x32, 0x67, 0x10,
+	0x3f, 0x24, 0x43, 0x16, 0xb9, 0x54, 0xb9, 0x23, 0x5a, 0xfc, 0xea, 0x86, 0x2d, 0x72, 0x2f, 0x1c,
+	0xf6, 0xb8, 0x83, 0x59, 0x8c, 0x2f, 0x02, 0xbe, 0xe0, 0x88, 0x7a, 0x33, 0x97, 0x12, 0x16, 0x06,
+	0x27, 0x4a, 0xa1, 0x26, 0x6d, 0x17, 0x4c, 0x48, 0x20, 0x23, 0x0c, 0x4e, 0xd0, 0x47, 0x70, 0x37,
+	0x3d, 0x8f, 0x31, 0x8d, 0x1d, 0xcf, 0x89, 0x1d, 0x65, 0x4d, 0xec, 0xb8, 0x92, 0xc0, 0x07, 0x29,
+	0x8a, 0x5c, 0xa8, 0xb8, 0x2c, 0xf4, 0xfc, 0xd8, 0x67, 0x21, 0x89, 0x4f, 0x26, 0x54, 0x01, 0x51,
+	0xea, 0xd7, 0x37, 0x2c, 0xb5, 0x73, 0x6e, 0x62, 0x9f, 0x4c, 0xa8, 0x59, 0x76, 0xe7, 0x43, 0xb4,
+	0x0f, 0xf5, 0x0c, 0x70, 0x02, 0x92, 0xdc, 0x51, 0x1c, 0xf9, 0xa3, 0x11, 0x8d, 0x48, 0xb6, 0x9d,
+	0xa2, 0xd8, 0xce, 0xd6, 0x1c, 0x53, 0x58, 0xdb, 0x09, 0xcf, 0x3a, 0x5f, 0xda, 0x17, 0xa0, 0xc4,
+	0x91, 0xe3, 0x07, 0x7e, 0x38, 0x22, 0x6c, 0x38, 0x9c, 0xd2, 0xf8, 0xc2, 0xa2, 0x24, 0x2c, 0xfe,
+	0x77, 0x9e, 0x37, 0x44, 0x3a, 0x53, 0x36, 0x60, 0x7d, 0x59, 0x39, 0x99, 0x8c, 0x95, 0xb2, 0x18,
+	0xcc, 0xbd, 0x45, 0x51, 0x7f, 0x32, 0x46, 0x1f, 0x40, 0x25, 0x29, 0x75, 0x14, 0xb1, 0xd9, 0x84,
+	0x1f, 0x7e, 0x45, 0x50, 0x4b, 0x02, 0xdd, 0xe5, 0x20, 0xf6, 0xd0, 0x31, 0x3c, 0x98, 0xd2, 0x60,
+	0x48, 0xe2, 0xc8, 0xf1, 0x28, 0x99, 0x44, 0xf4, 0x98, 0x86, 0xbc, 0x7c, 0xe5, 0xae, 0x18, 0x64,
+	0xfb, 0xa6, 0x67, 0x4d, 0x83, 0xa1, 0xcd, 0xad, 0xfa, 0x99, 0x93, 0xb9, 0x3e, 0xbd, 0x0c, 0xd6,
+	0xbf, 0x84, 0x3c, 0xff, 0x0b, 0xa0, 0xfb, 0x20, 0x5b, 0xb8, 0xab, 0x92, 0x43, 0xdd, 0xea, 0xab,
+	0x1d, 0xdc, 0xc3, 0x6a, 0x57, 0xce, 0xa1, 0x12, 0x14, 0x04, 0xda, 0x3e, 0x3c, 0x92, 0x25, 0x54,
+	0x86, 0x35, 0x11, 0x59, 0xaa, 0xa6, 0xc9, 0x2b, 0xf5, 0x9f, 0x24, 0x28, 0xce, 0xdd, 0x16, 0x7a,
+	0x0c, 0xff, 0xb7, 0xf1, 0x81, 0x4a, 0xb0, 0x4e, 0x7a, 0x86, 0xd9, 0x59, 0xf6, 0x7a, 0x00, 0xf7,
+	0x16, 0xd3, 0xd8, 0xe8, 0xc8, 0x12, 0xda, 0x84, 0x87, 0x8b, 0x70, 0xdf, 0xb0, 0x6c, 0x62, 0xe8,
+	0xda, 0x91, 0xbc, 0x82, 0xaa, 0xb0, 0xb1, 0x98, 0xec, 0x61, 0x4d, 0x23, 0x86, 0x49, 0xf6, 0xb1,
+	0xa6, 0xc9, 0xab, 0xf5, 0x9f, 0x25, 0x28, 0x2f, 0xdc, 0x0c, 0x57, 0x74, 0x0c, 0xbd, 0x8b, 0x6d,
+	0x6c, 0xe8, 0xc4, 0x3e, 0xea, 0x2f, 0x57, 0xf1, 0x08, 0x94, 0xa5, 0xbc, 0x65, 0x1b, 0x7d, 0xa2,
+	0x19, 0x96, 0x25, 0x4b, 0x57, 0xa8, 0xed, 0xd6, 0xbe, 0x4a, 0xfa, 0xa6, 0xd1, 0xc3, 0xb6, 0xbc,
+	0x82, 0x6a, 0xf0, 0x68, 0x39, 0x6f, 0xb6, 0xb0, 0x86, 0xf5, 0x5d, 0x61, 0x23, 0xaf, 0xd6, 0xcf,
+	0x24, 0x58, 0xbf, 0x62, 0xf8, 0xe8, 0x43, 0x78, 0x62, 0xa9, 0x5a, 0x8f, 0xf3, 0xbb, 0xdc, 0x50,
+	0x7d, 0xa9, 0xea, 0xc2, 0x65, 0xb1, 0xbc, 0xa7, 0x50, 0xbf, 0x9a, 0xd6, 0x69, 0xe9, 0x1d, 0x55,
+	0x23, 0x07, 0xad, 0x7d, 0xd5, 0x94, 0xa5, 0x6b, 0x79, 0xb6, 0xe0, 0xad, 0xfc, 0xf3, 0xcf, 0xa6,
+	0xbc, 0xb6, 0x61, 0xef, 0xc9, 0xab, 0xa8, 0x01, 0xcf, 0xae, 0xa6, 0x75, 0xd5, 0x8e, 0xa9, 0x1e,
+	0xa8, 0xba, 0x4d, 0x5a, 0x7a, 0x37, 0x15, 0xc9, 0xf9, 0xb6, 0x3c, 0xf7, 0xca, 0x63, 0x21, 0x65,
+	0xc3, 0x67, 0xbf, 0x4a, 0x50, 0xe9, 0xa4, 0x2f, 0x7e, 0x2b, 0x76, 0xe2, 0xd9, 0x54, 0x0c, 0x4b,
+	0x33, 0xda, 0xa4, 0xdf, 0xc2, 0x26, 0xb1, 0xec, 0x96, 0x7d, 0x68, 0x2d, 0x75, 0xbb, 0x09, 0x0f,
+	0x2f, 0x31, 0x5a, 0x1d, 0x1b, 0xbf, 0x54, 0x65, 0xe9, 0xca, 0x64, 0xbf, 0x75, 0x68, 0xa9, 0xdd,
+	0x74, 0x11, 0xcb, 0xc9, 0xb4, 0x25, 0x71, 0x3a, 0xab, 0x62, 0x95, 0x97, 0xe4, 0xd9, 0x69, 0xe5,
+	0xd1, 0x13, 0x78, 0x7c, 0x29, 0x8f, 0x75, 0x6c, 0xe3, 0x96, 0x86, 0x5f, 0x61, 0x7d, 0x57, 0xbe,
+	0xd5, 0xfe, 0xee, 0xdd, 0x69, 0x55, 0x7a, 0x7f, 0x5a, 0x95, 0xfe, 0x3c, 0xad, 0x4a, 0xbf, 0x9c,
+	0x55, 0x73, 0xef, 0xcf, 0xaa, 0xb9, 0xdf, 0xcf, 0xaa, 0xb9, 0x57, 0xdf, 0x8c, 0xfc, 0xf8, 0xf5,
+	0x6c, 0xd0, 0x70, 0xd9, 0xb8, 0xb9, 0xf0, 0x01, 0x70, 0xfc, 0xd9, 0x27, 0xee, 0x6b, 0xc7, 0x0f,
+	0x9b, 0xff, 0xf6, 0x49, 0x30, 0xb8, 0x2d, 0x82, 0x17, 0x7f, 0x0f, 0x00, 0x02, 0x11, 0xc6, 0x9f,
+	0x8c, 0x08, 0x00, 0x00,
 }
 
 func (m *IndexerOrderId) Marshal() (dAtA []byte, err error) {
@@ -602,6 +721,26 @@ func (m *IndexerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OrderGroupId != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.OrderGroupId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TrailingOffsetPpm != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.TrailingOffsetPpm))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TrailingOffsetSubticks != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.TrailingOffsetSubticks))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		i = encodeVarintClob(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
@@ -760,6 +899,18 @@ func (m *IndexerOrder) Size() (n int) {
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		n += 1 + sovClob(uint64(m.ConditionalOrderTriggerSubticks))
 	}
+	if m.TrailingOffsetSubticks != 0 {
+		n += 1 + sovClob(uint64(m.TrailingOffsetSubticks))
+	}
+	if m.TrailingOffsetPpm != 0 {
+		n += 1 + sovClob(uint64(m.TrailingOffsetPpm))
+	}
+	if m.OrderGroupId != 0 {
+		n += 1 + sovClob(uint64(m.OrderGroupId))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovClob(uint64(m.SelfTradePrevention))
+	}
 	return n
 }
 
@@ -1165,6 +1316,82 @@ func (m *IndexerOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingOffsetSubticks", wireType)
+			}
+			m.TrailingOffsetSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingOffsetSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingOffsetPpm", wireType)
+			}
+			m.TrailingOffsetPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingOffsetPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroupId", wireType)
+			}
+			m.OrderGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= IndexerOrder_SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClob(dAtA[iNdEx:])
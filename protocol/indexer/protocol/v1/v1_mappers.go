@@ -109,6 +109,12 @@ func OrderConditionTypeToIndexerOrderConditionType(
 	return IndexerOrder_ConditionType(orderConditionType)
 }
 
+func OrderSelfTradePreventionToIndexerOrderSelfTradePrevention(
+	orderSelfTradePrevention clobtypes.Order_SelfTradePrevention,
+) IndexerOrder_SelfTradePrevention {
+	return IndexerOrder_SelfTradePrevention(orderSelfTradePrevention)
+}
+
 func OrderToIndexerOrder(
 	order clobtypes.Order,
 ) IndexerOrder {
@@ -143,6 +149,12 @@ func orderToIndexerOrder_GoodTilBlock(
 		ClientMetadata:                  order.ClientMetadata,
 		ConditionType:                   OrderConditionTypeToIndexerOrderConditionType(order.ConditionType),
 		ConditionalOrderTriggerSubticks: order.ConditionalOrderTriggerSubticks,
+		TrailingOffsetSubticks:          order.TrailingOffsetSubticks,
+		TrailingOffsetPpm:               order.TrailingOffsetPpm,
+		OrderGroupId:                    order.OrderGroupId,
+		SelfTradePrevention: OrderSelfTradePreventionToIndexerOrderSelfTradePrevention(
+			order.SelfTradePrevention,
+		),
 	}
 }
 
@@ -161,6 +173,12 @@ func orderToIndexerOrder_GoodTilBlockTime(
 		ClientMetadata:                  order.ClientMetadata,
 		ConditionType:                   OrderConditionTypeToIndexerOrderConditionType(order.ConditionType),
 		ConditionalOrderTriggerSubticks: order.ConditionalOrderTriggerSubticks,
+		TrailingOffsetSubticks:          order.TrailingOffsetSubticks,
+		TrailingOffsetPpm:               order.TrailingOffsetPpm,
+		OrderGroupId:                    order.OrderGroupId,
+		SelfTradePrevention: OrderSelfTradePreventionToIndexerOrderSelfTradePrevention(
+			order.SelfTradePrevention,
+		),
 	}
 }
 
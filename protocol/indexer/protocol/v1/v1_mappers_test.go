@@ -300,6 +300,41 @@ func TestOrderConditionTypeToIndexerOrderConditionType(t *testing.T) {
 	}
 }
 
+func TestOrderSelfTradePreventionToIndexerOrderSelfTradePrevention(t *testing.T) {
+	tests := map[string]struct {
+		// Input
+		selfTradePrevention clobtypes.Order_SelfTradePrevention
+
+		// Expectations
+		expectedSelfTradePrevention v1.IndexerOrder_SelfTradePrevention
+	}{}
+	// Iterate through all the values for Order_SelfTradePrevention to create test cases.
+	for name, value := range clobtypes.Order_SelfTradePrevention_value {
+		testName := fmt.Sprintf(
+			"Converts Order_SelfTradePrevention %s to IndexerOrderV1_SelfTradePrevention",
+			name,
+		)
+		tests[testName] = struct {
+			selfTradePrevention         clobtypes.Order_SelfTradePrevention
+			expectedSelfTradePrevention v1.IndexerOrder_SelfTradePrevention
+		}{
+			selfTradePrevention: clobtypes.Order_SelfTradePrevention(value),
+			expectedSelfTradePrevention: v1.IndexerOrder_SelfTradePrevention(
+				v1.IndexerOrder_SelfTradePrevention_value[name],
+			),
+		}
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expectedSelfTradePrevention,
+				v1.OrderSelfTradePreventionToIndexerOrderSelfTradePrevention(tc.selfTradePrevention),
+			)
+		})
+	}
+}
+
 func TestOrderToIndexerOrderV1(t *testing.T) {
 	shortTermOrder := constants.Order_Alice_Num1_Id2_Clob1_Buy67_Price5_GTB20
 	statefulOrder := constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell50_Price10_GTBT15
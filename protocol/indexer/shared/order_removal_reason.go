@@ -23,6 +23,8 @@ func ConvertOrderRemovalReasonToIndexerOrderRemovalReason(
 		reason = OrderRemovalReason_ORDER_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER
 	case clobtypes.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE:
 		reason = OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_ERROR
+	case clobtypes.OrderRemoval_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED:
+		reason = OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED
 	case clobtypes.OrderRemoval_REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED:
 		reason = OrderRemovalReason_ORDER_REMOVAL_REASON_FOK_ORDER_COULD_NOT_BE_FULLY_FULLED
 	case clobtypes.OrderRemoval_REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK:
@@ -57,6 +59,8 @@ func GetOrderRemovalReason(
 		return OrderRemovalReason_ORDER_REMOVAL_REASON_IMMEDIATE_OR_CANCEL_WOULD_REST_ON_BOOK, nil
 	case clobtypes.ReduceOnlyResized:
 		return OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE, nil
+	case clobtypes.SelfTradePreventionCanceled:
+		return OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED, nil
 	default:
 		return 0, fmt.Errorf("unrecognized order status %d and error \"%w\"", orderStatus, orderError)
 	}
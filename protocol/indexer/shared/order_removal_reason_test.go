@@ -34,6 +34,11 @@ func TestGetOrderRemovalReason_Success(t *testing.T) {
 			expectedReason: shared.OrderRemovalReason_ORDER_REMOVAL_REASON_IMMEDIATE_OR_CANCEL_WOULD_REST_ON_BOOK,
 			expectedErr:    nil,
 		},
+		"Gets order removal reason for order status SelfTradePreventionCanceled": {
+			orderStatus:    clobtypes.SelfTradePreventionCanceled,
+			expectedReason: shared.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED,
+			expectedErr:    nil,
+		},
 		"Gets order removal reason for order error ErrFokOrderCouldNotBeFullyFilled": {
 			orderError:     clobtypes.ErrFokOrderCouldNotBeFullyFilled,
 			expectedReason: shared.OrderRemovalReason_ORDER_REMOVAL_REASON_FOK_ORDER_COULD_NOT_BE_FULLY_FULLED,
@@ -68,6 +68,12 @@ const (
 	// The order has been removed since the subaccount does not satisfy the
 	// equity tier requirements.
 	OrderRemovalReason_ORDER_REMOVAL_REASON_EQUITY_TIER OrderRemovalReason = 13
+	// The order was canceled because another order in its one-cancels-other
+	// order group was filled or triggered.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED OrderRemovalReason = 14
+	// The order was canceled by the self-trade prevention mode requested by its
+	// subaccount, instead of matching against an order of the same subaccount.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED OrderRemovalReason = 15
 )
 
 var OrderRemovalReason_name = map[int32]string{
@@ -85,6 +91,8 @@ var OrderRemovalReason_name = map[int32]string{
 	11: "ORDER_REMOVAL_REASON_REPLACED",
 	12: "ORDER_REMOVAL_REASON_FULLY_FILLED",
 	13: "ORDER_REMOVAL_REASON_EQUITY_TIER",
+	14: "ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED",
+	15: "ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED",
 }
 
 var OrderRemovalReason_value = map[string]int32{
@@ -102,6 +110,8 @@ var OrderRemovalReason_value = map[string]int32{
 	"ORDER_REMOVAL_REASON_REPLACED":                               11,
 	"ORDER_REMOVAL_REASON_FULLY_FILLED":                           12,
 	"ORDER_REMOVAL_REASON_EQUITY_TIER":                            13,
+	"ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED":                   14,
+	"ORDER_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED":         15,
 }
 
 func (x OrderRemovalReason) String() string {
@@ -121,33 +131,35 @@ func init() {
 }
 
 var fileDescriptor_0d5eea5cab8c58ba = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x13, 0xa0, 0x05, 0x16, 0x90, 0x56, 0x7b, 0x05, 0xac, 0x16, 0x5a, 0x5a, 0xfe, 0xc5,
-	0x48, 0x20, 0x54, 0x01, 0x12, 0xda, 0x78, 0x27, 0xd2, 0x2a, 0x1b, 0x6f, 0x18, 0xdb, 0xd0, 0xe4,
-	0x32, 0x72, 0x63, 0x8b, 0x44, 0x6a, 0x63, 0xe4, 0x96, 0xaa, 0xbc, 0x01, 0x47, 0x1e, 0x8b, 0x63,
-	0x8e, 0x1c, 0x51, 0xf2, 0x22, 0x48, 0x76, 0x40, 0x54, 0x5a, 0x5f, 0x7c, 0xf1, 0xef, 0xf7, 0xcd,
-	0x67, 0xef, 0x2c, 0x7b, 0x91, 0x7d, 0xcb, 0x2e, 0xbe, 0x94, 0xc5, 0x59, 0x31, 0x29, 0x8e, 0xfd,
-	0xd9, 0x3c, 0xcb, 0x2f, 0xf2, 0xd2, 0x3f, 0x9d, 0xa6, 0x65, 0x9e, 0xf9, 0x65, 0x7e, 0x52, 0x9c,
-	0xa7, 0xc7, 0x54, 0xe6, 0xe9, 0x69, 0x31, 0xef, 0x54, 0x98, 0xb8, 0xfb, 0xbf, 0xd1, 0x59, 0x1b,
-	0x9d, 0xda, 0x78, 0xf2, 0x7d, 0x83, 0x09, 0x5b, 0x66, 0x79, 0x89, 0xb5, 0x8a, 0x95, 0x29, 0x76,
-	0xd8, 0x96, 0x45, 0x05, 0x48, 0x08, 0x03, 0xfb, 0x51, 0x1a, 0x42, 0x90, 0x91, 0x0d, 0x29, 0x09,
-	0xa3, 0x21, 0x04, 0xba, 0xa7, 0x41, 0xf1, 0x96, 0xd8, 0x62, 0xf7, 0x9c, 0x14, 0x1c, 0x0e, 0x35,
-	0x82, 0xe2, 0x6d, 0xf1, 0x88, 0x3d, 0x70, 0xe7, 0x44, 0x80, 0x14, 0xc8, 0x30, 0x00, 0x03, 0x8a,
-	0x5f, 0x11, 0xcf, 0xd8, 0x7e, 0xc3, 0x3c, 0x05, 0x18, 0x58, 0x63, 0x64, 0x0c, 0x28, 0x8d, 0x1e,
-	0x83, 0xe2, 0x57, 0xc5, 0x1e, 0x7b, 0xe8, 0xa4, 0x75, 0x18, 0x03, 0x86, 0xd2, 0x10, 0x20, 0x5a,
-	0xe4, 0xd7, 0xc4, 0x63, 0xb6, 0xeb, 0x04, 0x23, 0x30, 0x3d, 0x8a, 0x51, 0x2a, 0x58, 0xa3, 0x1b,
-	0xe2, 0x0d, 0x7b, 0xed, 0x44, 0x87, 0x36, 0x8a, 0xc9, 0x86, 0x66, 0x44, 0x9f, 0x6c, 0x62, 0x14,
-	0x05, 0x68, 0xa3, 0x88, 0x06, 0xb2, 0x0f, 0x48, 0x95, 0xc0, 0x37, 0xc5, 0x7b, 0xf6, 0xd6, 0xdd,
-	0x67, 0x30, 0x00, 0xa5, 0x65, 0x0c, 0x64, 0xff, 0x7e, 0xed, 0x3a, 0x05, 0xa1, 0x4a, 0xa5, 0xae,
-	0xb5, 0x7d, 0x7e, 0x5d, 0xbc, 0x63, 0x07, 0xce, 0x80, 0x9e, 0xed, 0xd7, 0x43, 0x28, 0xa8, 0xb4,
-	0xd0, 0xc6, 0xd4, 0x05, 0xea, 0x25, 0xc6, 0x8c, 0xaa, 0x27, 0x28, 0x7e, 0x43, 0x3c, 0x65, 0x7b,
-	0x4e, 0x1b, 0x41, 0x25, 0x01, 0xd4, 0xe5, 0x11, 0x22, 0x3d, 0x06, 0x7e, 0x53, 0xec, 0xb3, 0x9d,
-	0x86, 0x7f, 0xa7, 0xe0, 0x10, 0xf0, 0xdf, 0xd9, 0x31, 0xb1, 0xcd, 0xee, 0x37, 0xc4, 0x0e, 0x8d,
-	0x0c, 0x40, 0xf1, 0x5b, 0x62, 0x97, 0x6d, 0xbb, 0x7b, 0xd7, 0x05, 0x75, 0x55, 0xf0, 0x76, 0xe3,
-	0x36, 0xc1, 0x87, 0x44, 0xc7, 0x23, 0x8a, 0x35, 0x20, 0xbf, 0xd3, 0xc5, 0x9f, 0x4b, 0xaf, 0xbd,
-	0x58, 0x7a, 0xed, 0xdf, 0x4b, 0xaf, 0xfd, 0x63, 0xe5, 0xb5, 0x16, 0x2b, 0xaf, 0xf5, 0x6b, 0xe5,
-	0xb5, 0xc6, 0x07, 0x9f, 0x67, 0x67, 0xd3, 0xaf, 0x47, 0x9d, 0x49, 0x71, 0xe2, 0x5f, 0x5a, 0xff,
-	0xf3, 0x57, 0xcf, 0x27, 0xd3, 0x74, 0x36, 0xf7, 0x1b, 0x2e, 0xc4, 0xd1, 0x66, 0xf5, 0xe2, 0xe5,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x84, 0xfa, 0x05, 0x36, 0x03, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x5b, 0x60, 0x03, 0xcc, 0x3f, 0xcb, 0xb7, 0x40, 0xb4, 0xc1, 0xc6, 0x36, 0x60, 0x0d,
+	0xd2, 0x10, 0x4c, 0x80, 0x84, 0xdc, 0xf8, 0x14, 0x59, 0x75, 0xe3, 0x70, 0xe2, 0x8c, 0xb5, 0x37,
+	0x56, 0xd7, 0x44, 0xb4, 0xd2, 0xd6, 0xa0, 0x6c, 0x4c, 0xe3, 0x2d, 0x78, 0x2c, 0x2e, 0x77, 0xc9,
+	0x25, 0x6a, 0x5f, 0x80, 0x47, 0x40, 0x4a, 0x0a, 0x03, 0xc9, 0x11, 0x37, 0xb9, 0x88, 0xbf, 0xdf,
+	0x77, 0x3e, 0xfb, 0x9c, 0x43, 0x9e, 0xa5, 0x5f, 0xd2, 0xb3, 0x4f, 0x45, 0x7e, 0x92, 0x8f, 0xf2,
+	0x43, 0x7f, 0x32, 0x4d, 0xb3, 0xb3, 0xac, 0xf0, 0x8f, 0xc7, 0xc3, 0x22, 0x4b, 0xfd, 0x22, 0x3b,
+	0xca, 0x4f, 0x87, 0x87, 0xb6, 0xc8, 0x86, 0xc7, 0xf9, 0xb4, 0x55, 0xca, 0xd8, 0xdd, 0xbf, 0x89,
+	0xd6, 0x82, 0x68, 0x55, 0xc4, 0xe3, 0x9f, 0x4b, 0x84, 0xe9, 0x22, 0xcd, 0x0a, 0xac, 0x50, 0x2c,
+	0x49, 0xb6, 0x46, 0x56, 0x34, 0x0a, 0x40, 0x8b, 0xd0, 0xd3, 0x7b, 0x5c, 0x59, 0x04, 0x1e, 0xeb,
+	0xd0, 0x26, 0x61, 0x1c, 0x41, 0x20, 0x3b, 0x12, 0x04, 0x6d, 0xb0, 0x15, 0x72, 0xcf, 0xa9, 0x82,
+	0xfd, 0x48, 0x22, 0x08, 0xda, 0x64, 0x8f, 0xc8, 0x03, 0xb7, 0x4f, 0x0c, 0x68, 0x03, 0x1e, 0x06,
+	0xa0, 0x40, 0xd0, 0x4b, 0xec, 0x29, 0xd9, 0xac, 0xa9, 0x27, 0x00, 0x03, 0xad, 0x14, 0x37, 0x80,
+	0x5c, 0xc9, 0x01, 0x08, 0x7a, 0x99, 0x6d, 0x90, 0x87, 0x4e, 0xb5, 0x0c, 0x0d, 0x60, 0xc8, 0x95,
+	0x05, 0x44, 0x8d, 0xf4, 0x0a, 0xdb, 0x22, 0xeb, 0x4e, 0x61, 0x0c, 0xaa, 0x63, 0x0d, 0x72, 0x01,
+	0x0b, 0xe9, 0x12, 0x7b, 0x45, 0x5e, 0x38, 0xa5, 0x91, 0x8e, 0x8d, 0xd5, 0xa1, 0xea, 0xdb, 0x0f,
+	0x3a, 0x51, 0xc2, 0x06, 0xa8, 0xe3, 0xd8, 0xf6, 0x78, 0x17, 0xd0, 0x96, 0x00, 0x5d, 0x66, 0x6f,
+	0xc9, 0x6b, 0x77, 0x9e, 0x5e, 0x0f, 0x84, 0xe4, 0x06, 0xac, 0xfe, 0x7d, 0xdb, 0x85, 0x0b, 0x42,
+	0xe9, 0x6a, 0xdb, 0x5a, 0x77, 0xe9, 0x55, 0xf6, 0x86, 0xec, 0x3a, 0x0d, 0x3a, 0xba, 0x5b, 0x15,
+	0xb1, 0x41, 0x89, 0x85, 0xda, 0xd8, 0x36, 0xd8, 0x4e, 0xa2, 0x54, 0xbf, 0xfc, 0x82, 0xa0, 0xd7,
+	0xd8, 0x13, 0xb2, 0xe1, 0xa4, 0x11, 0x44, 0x12, 0x40, 0x15, 0x1e, 0x21, 0x96, 0x03, 0xa0, 0xd7,
+	0xd9, 0x26, 0x59, 0xab, 0x79, 0x3b, 0x01, 0xfb, 0x80, 0x7f, 0x7a, 0x47, 0xd8, 0x2a, 0xb9, 0x5f,
+	0x63, 0x1b, 0x29, 0x1e, 0x80, 0xa0, 0x37, 0xd8, 0x3a, 0x59, 0x75, 0xe7, 0xae, 0x02, 0xca, 0x32,
+	0xe0, 0xcd, 0xda, 0x69, 0x82, 0xf7, 0x89, 0x34, 0x7d, 0x6b, 0x24, 0x20, 0xbd, 0xc5, 0xb6, 0xc9,
+	0x96, 0x53, 0x55, 0xfd, 0x7c, 0x87, 0x3a, 0x89, 0x2e, 0x46, 0xe6, 0x36, 0x7b, 0x49, 0x76, 0xfe,
+	0xd7, 0xdb, 0x08, 0x61, 0x0f, 0x42, 0x23, 0x75, 0x78, 0x01, 0xde, 0x69, 0xe3, 0xb7, 0x99, 0xd7,
+	0x3c, 0x9f, 0x79, 0xcd, 0x1f, 0x33, 0xaf, 0xf9, 0x75, 0xee, 0x35, 0xce, 0xe7, 0x5e, 0xe3, 0xfb,
+	0xdc, 0x6b, 0x0c, 0x76, 0x3f, 0x4e, 0x4e, 0xc6, 0x9f, 0x0f, 0x5a, 0xa3, 0xfc, 0xc8, 0xff, 0x67,
+	0xcd, 0x4e, 0x9f, 0x6f, 0x8f, 0xc6, 0xc3, 0xc9, 0xd4, 0xaf, 0x59, 0xbc, 0x83, 0xe5, 0xf2, 0x60,
+	0xe7, 0xd7, 0x00, 0x93, 0xf2, 0xf2, 0x12, 0x9e, 0x03, 0x00, 0x00,
 }
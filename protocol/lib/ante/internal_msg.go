@@ -68,7 +68,9 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		*bridge.MsgCompleteBridge,
 		*bridge.MsgUpdateEventParams,
 		*bridge.MsgUpdateProposeParams,
+		*bridge.MsgUpdateRateLimitParams,
 		*bridge.MsgUpdateSafetyParams,
+		*bridge.MsgUpdateSourceChainParams,
 
 		// clob
 		*clob.MsgCreateClobPair,
@@ -76,12 +78,18 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		*clob.MsgUpdateClobPair,
 		*clob.MsgUpdateEquityTierLimitConfiguration,
 		*clob.MsgUpdateLiquidationsConfig,
+		*clob.MsgUpdateMakerUptimeRewardsConfig,
 
 		// delaymsg
 		*delaymsg.MsgDelayMessage,
 
 		// feetiers
+		*feetiers.MsgDeleteFeeTierOverride,
+		*feetiers.MsgDeleteMarketFeeSchedule,
+		*feetiers.MsgSetFeeTierOverride,
+		*feetiers.MsgSetMarketFeeSchedule,
 		*feetiers.MsgUpdatePerpetualFeeParams,
+		*feetiers.MsgUpdateReferralParams,
 
 		// perpetuals
 		*perpetuals.MsgCreatePerpetual,
@@ -94,6 +102,8 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		*prices.MsgUpdateMarketParam,
 
 		// rewards
+		*rewards.MsgDeleteRewardProgram,
+		*rewards.MsgSetRewardProgram,
 		*rewards.MsgUpdateParams,
 
 		// sending
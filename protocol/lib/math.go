@@ -210,3 +210,77 @@ func Median[V uint64 | uint32 | int64 | int32](input []V) (V, error) {
 	// x and y are both negative.
 	return x + (y-x)/2, nil
 }
+
+// WeightedMedian returns the weighted median of the input, where each value has the weight at the same index
+// in `weights`. The weighted median is the smallest value at which the cumulative weight of the sorted values
+// exceeds half of the total weight. If the cumulative weight equals exactly half of the total weight, the weighted
+// median is the average of that value and the next value, rounded up. With equal weights, the result is the
+// same as `Median`.
+func WeightedMedian(input []uint64, weights []uint64) (uint64, error) {
+	l := len(input)
+	if l == 0 {
+		return 0, errors.New("input cannot be empty")
+	}
+	if l != len(weights) {
+		return 0, fmt.Errorf("input length %d does not match weights length %d", l, len(weights))
+	}
+
+	indices := make([]int, l)
+	totalWeight := new(big.Int)
+	for i, weight := range weights {
+		if weight == 0 {
+			return 0, errors.New("weights must be positive")
+		}
+		indices[i] = i
+		totalWeight.Add(totalWeight, new(big.Int).SetUint64(weight))
+	}
+	sort.SliceStable(indices, func(i, j int) bool { return input[indices[i]] < input[indices[j]] })
+
+	// Compare twice the cumulative weight against the total weight to avoid division.
+	doubleCumulativeWeight := new(big.Int)
+	for i, idx := range indices {
+		doubleCumulativeWeight.Add(doubleCumulativeWeight, new(big.Int).Lsh(new(big.Int).SetUint64(weights[idx]), 1))
+		cmp := doubleCumulativeWeight.Cmp(totalWeight)
+		if cmp > 0 {
+			return input[idx], nil
+		}
+		if cmp == 0 {
+			// Note x <= y since `indices` is sorted by value. The last value always exceeds half of the total
+			// weight, so there is always a next value here.
+			x := input[idx]
+			y := input[indices[i+1]]
+			return y - (y-x)/2, nil
+		}
+	}
+
+	// Unreachable, since the cumulative weight of all values exceeds half of the total weight.
+	return 0, errors.New("failed to compute weighted median")
+}
+
+// TrimmedMean returns the mean of the input after removing the `trimPpm` fraction of the lowest values and the
+// `trimPpm` fraction of the highest values, rounded down to a whole number of values on each side. The mean is
+// rounded to the nearest integer, with ties rounded up. `trimPpm` must be less than 500,000.
+func TrimmedMean(input []uint64, trimPpm uint32) (uint64, error) {
+	l := len(input)
+	if l == 0 {
+		return 0, errors.New("input cannot be empty")
+	}
+	if trimPpm >= OneMillion/2 {
+		return 0, fmt.Errorf("trim ppm %d must be less than %d", trimPpm, OneMillion/2)
+	}
+
+	inputCopy := make([]uint64, l)
+	copy(inputCopy, input)
+	sort.Slice(inputCopy, func(i, j int) bool { return inputCopy[i] < inputCopy[j] })
+
+	trimCount := int(Uint64MulPpm(uint64(l), trimPpm))
+	trimmed := inputCopy[trimCount : l-trimCount]
+
+	sum := new(big.Int)
+	for _, value := range trimmed {
+		sum.Add(sum, new(big.Int).SetUint64(value))
+	}
+	count := big.NewInt(int64(len(trimmed)))
+	sum.Add(sum, new(big.Int).Div(count, big.NewInt(2)))
+	return sum.Div(sum, count).Uint64(), nil
+}
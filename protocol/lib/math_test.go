@@ -750,3 +750,130 @@ func TestMedian_Uint64(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedMedian(t *testing.T) {
+	tests := map[string]struct {
+		input          []uint64
+		weights        []uint64
+		expectedResult uint64
+		expectedError  string
+	}{
+		"Empty input causes error": {
+			input:         []uint64{},
+			weights:       []uint64{},
+			expectedError: "input cannot be empty",
+		},
+		"Mismatched weights causes error": {
+			input:         []uint64{1, 2},
+			weights:       []uint64{1},
+			expectedError: "input length 2 does not match weights length 1",
+		},
+		"Zero weight causes error": {
+			input:         []uint64{1, 2},
+			weights:       []uint64{1, 0},
+			expectedError: "weights must be positive",
+		},
+		"Equal weights, odd number input": {
+			input:          []uint64{2, 0, 1, 3, 4},
+			weights:        []uint64{1, 1, 1, 1, 1},
+			expectedResult: 2,
+		},
+		"Equal weights, even number input": {
+			input:          []uint64{5, 12, 1, 3, 12, 50}, // median is (5+12)/2=8.5
+			weights:        []uint64{2, 2, 2, 2, 2, 2},
+			expectedResult: 9,
+		},
+		"Heavy weight dominates": {
+			input:          []uint64{100, 200, 300},
+			weights:        []uint64{1, 1, 3},
+			expectedResult: 300,
+		},
+		"Cumulative weight exceeds half": {
+			input:          []uint64{300, 100, 200, 400},
+			weights:        []uint64{1, 2, 2, 1},
+			expectedResult: 200,
+		},
+		"Cumulative weight equals half": {
+			input:          []uint64{100, 200, 300},
+			weights:        []uint64{2, 1, 1},
+			expectedResult: 150,
+		},
+		"Weights near max uint64 do not overflow": {
+			input:          []uint64{100, 200, 300},
+			weights:        []uint64{math.MaxUint64, math.MaxUint64, 1},
+			expectedResult: 200,
+		},
+		"Single value": {
+			input:          []uint64{100},
+			weights:        []uint64{5},
+			expectedResult: 100,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := lib.WeightedMedian(tc.input, tc.weights)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestTrimmedMean(t *testing.T) {
+	tests := map[string]struct {
+		input          []uint64
+		trimPpm        uint32
+		expectedResult uint64
+		expectedError  string
+	}{
+		"Empty input causes error": {
+			input:         []uint64{},
+			expectedError: "input cannot be empty",
+		},
+		"Trim ppm too large causes error": {
+			input:         []uint64{1, 2, 3},
+			trimPpm:       500_000,
+			expectedError: "trim ppm 500000 must be less than 500000",
+		},
+		"No trim": {
+			input:          []uint64{1, 2, 3, 10},
+			expectedResult: 4,
+		},
+		"Trim rounds down to whole values": {
+			input:          []uint64{1, 2, 3, 10},
+			trimPpm:        200_000, // 0.8 values on each side are trimmed, which rounds down to 0.
+			expectedResult: 4,
+		},
+		"Trim one value on each side": {
+			input:          []uint64{10, 1, 1_000, 3, 2},
+			trimPpm:        200_000,
+			expectedResult: 5,
+		},
+		"Mean rounds half up": {
+			input:          []uint64{1, 2},
+			expectedResult: 2,
+		},
+		"Mean rounds down": {
+			input:          []uint64{1, 1, 2},
+			expectedResult: 1,
+		},
+		"Large values do not overflow": {
+			input:          []uint64{math.MaxUint64, math.MaxUint64 - 2},
+			expectedResult: math.MaxUint64 - 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := lib.TrimmedMean(tc.input, tc.trimPpm)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
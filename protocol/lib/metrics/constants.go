@@ -64,14 +64,20 @@ const (
 	// Bridge.
 	AcknowledgeBridges            = "acknowledge_bridges"
 	AcknowledgedEventInfo         = "acknowledged_event_info"
+	AttestBridgeOut               = "attest_bridge_out"
+	BridgeOut                     = "bridge_out"
 	BridgeTokenDenom              = "bridge_token_denom"
 	CompleteBridge                = "complete_bridge"
+	EthChainId                    = "eth_chain_id"
 	GetAcknowledgeBridges         = "get_acknowledge_bridges"
 	LastBridgeEventId             = "last_bridge_event_id"
 	LastBridgeEventEthBlockHeight = "last_bridge_event_eth_block_height"
 	LastCompletedBridgeId         = "last_completed_bridge_id"
+	LastFinalizedWithdrawalId     = "last_finalized_withdrawal_id"
 	NextAcknowledgedEventId       = "next_acknowledge_event_id"
 	NumBridges                    = "num_bridges"
+	RateLimitedBridges            = "rate_limited_bridges"
+	RefundedWithdrawals           = "refunded_withdrawals"
 	UnbridgedBalance              = "unbridged_balance"
 
 	// Bridge Daemon.
@@ -90,6 +96,8 @@ const (
 	// CLOB.
 	AddPerpetualFillAmount                       = "add_perpetual_fill_amount"
 	BaseQuantums                                 = "base_quantums"
+	BatchCancel                                  = "batch_cancel"
+	BatchPlaceOrders                             = "batch_place_orders"
 	BestAskClobPair                              = "best_ask_clob_pair"
 	BestBidClobPair                              = "best_bid_clob_pair"
 	Buy                                          = "buy"
@@ -102,6 +110,7 @@ const (
 	LiquidateSubaccounts_GetLiquidations         = "liquidate_subaccounts_against_orderbook_get_liquidations"
 	LiquidateSubaccounts_PlaceLiquidations       = "liquidate_subaccounts_against_orderbook_place_liquidations"
 	LiquidateSubaccounts_Deleverage              = "liquidate_subaccounts_against_orderbook_deleverage"
+	ClobDeleverageSubaccounts                    = "deleverage_subaccounts"
 	CollateralizationCheck                       = "place_order_collateralization_check"
 	CollateralizationCheckFailed                 = "collateralization_check_failed"
 	CollateralizationCheckSubaccounts            = "collateralization_check_subaccounts"
@@ -158,7 +167,11 @@ const (
 	ReduceOnly                                   = "reduce_only"
 	RemovalReason                                = "removal_reason"
 	RemoveAndClearOperationsQueue                = "remove_and_clear_operations_queue"
+	ReplaceOrder                                 = "replace_order"
+	ReplaceShortTermOrder                        = "replace_short_term_order"
+	ReplaceStatefulOrder                         = "replace_stateful_order"
 	ReplayOperations                             = "replay_operations"
+	SampleMakerUptimeRewards                     = "sample_maker_uptime_rewards"
 	SortLiquidationOrders                        = "sort_liquidation_orders"
 	SendCancelOrderOffchainUpdates               = "send_cancel_order_offchain_updates"
 	SendPlaceOrderOffchainUpdates                = "send_place_order_offchain_updates"
@@ -167,6 +180,7 @@ const (
 	SendProcessProposerMatchesOffchainUpdates    = "send_process_proposer_matches_offchain_updates"
 	SendProposedOperationsOffchainUpdates        = "send_proposed_operations_offchain_updates"
 	SendPurgeOffchainUpdates                     = "send_purge_offchain_updates"
+	SendReplaceOrderOffchainUpdates              = "send_replace_order_offchain_updates"
 	SendUncrossOffchainUpdates                   = "send_uncross_offchain_updates"
 	Sell                                         = "sell"
 	ShortTermOrder                               = "short_term_order"
@@ -233,6 +247,8 @@ const (
 	TotalRewardShareWeight           = "total_reward_share_weight"
 	DistributedRewardTokens          = "distributed_reward_tokens"
 	TreasuryBalanceAfterDistribution = "treasury_balance_after_distribution"
+	ProcessRewardPrograms            = "process_reward_programs"
+	RewardProgramId                  = "reward_program_id"
 
 	// Vest.
 	GetVestEntry          = "get_vest_entry"
@@ -268,19 +284,22 @@ const (
 	UpdateSmoothedPrices                         = "update_smoothed_prices"
 
 	// Sending.
-	Account                       = "account"
-	New                           = "new"
-	ProcessTransfer               = "process_transfer"
-	Transfer                      = "transfer"
-	ProcessDepositToSubaccount    = "process_deposit_to_subaccount"
-	ProcessWithdrawFromSubaccount = "process_withdraw_from_subaccount"
-	SendFromModuleToAccount       = "send_from_module_to_account"
-	AssetId                       = "asset_id"
-	SenderAddress                 = "sender_address"
-	SenderModuleName              = "sender_module_name"
-	SenderSubaccount              = "sender_subaccount"
-	RecipientAddress              = "recipient_address"
-	RecipientSubaccount           = "recipient_subaccount"
+	Account                          = "account"
+	New                              = "new"
+	ProcessTransfer                  = "process_transfer"
+	Transfer                         = "transfer"
+	ProcessDepositToSubaccount       = "process_deposit_to_subaccount"
+	ProcessWithdrawFromSubaccount    = "process_withdraw_from_subaccount"
+	ProcessPerpetualPositionTransfer = "process_perpetual_position_transfer"
+	PerpetualPositionTransfer        = "perpetual_position_transfer"
+	SetIsolatedMargin                = "set_isolated_margin"
+	SendFromModuleToAccount          = "send_from_module_to_account"
+	AssetId                          = "asset_id"
+	SenderAddress                    = "sender_address"
+	SenderModuleName                 = "sender_module_name"
+	SenderSubaccount                 = "sender_subaccount"
+	RecipientAddress                 = "recipient_address"
+	RecipientSubaccount              = "recipient_subaccount"
 
 	// Subaccount.
 	CanUpdateSubaccounts                  = "can_update_subaccounts"
@@ -299,6 +318,14 @@ const (
 	PageLimit                            = "page_limit"
 	SendLiquidatableSubaccountIds        = "send_liquidatable_subaccount_ids"
 	SubaccountsWithOpenPositions         = "subaccounts_with_open_positions"
+	GetAllMarketPrices                   = "get_all_market_prices"
+	GetAllPerpetuals                     = "get_all_perpetuals"
+	GetAllLiquidityTiers                 = "get_all_liquidity_tiers"
+	UpdatedSubaccountIds                 = "updated_subaccount_ids"
+	SubaccountsToCheck                   = "subaccounts_to_check"
+	FullSync                             = "full_sync"
+	FullCheck                            = "full_check"
+	NegativeTncSubaccountIds             = "negative_tnc_subaccount_ids"
 
 	// Liquidation.
 	ConstructLiquidationOrder             = "construct_liquidation_order"
@@ -327,6 +354,8 @@ const (
 	NotEnoughPositionToFullyOffset = "not_enough_position_to_fully_offset"
 	NonOverlappingBankruptcyPrices = "non_overlapping_bankruptcy_prices"
 	NoOpenPositionOnOppositeSide   = "no_open_position_on_opposite_side"
+	NumSubaccountsDeleveraged      = "num_subaccounts_deleveraged"
+	OffsettingSubaccountCandidates = "offsetting_subaccount_candidates"
 
 	// Pricefeed Daemon.
 	Exchange                                = "exchange"
@@ -357,6 +386,8 @@ const (
 	ValidPrices                   = "valid_prices"
 	NoMarketPrice                 = "no_market_price"
 	NoValidMedianPrice            = "no_valid_median_price"
+	OutlierPrices                 = "outlier_prices"
+	InvalidPriceAggregationConfig = "invalid_price_aggregation_config"
 	PricefeedServer               = "pricefeed_server"
 	PricefeedServerUpdatePrices   = "pricefeed_server_update_prices"
 	PricefeedServerValidatePrices = "pricefeed_server_validate_prices"
@@ -28,6 +28,48 @@ func (_m *BridgeKeeper) AcknowledgeBridges(ctx types.Context, bridges []bridgety
 	return r0
 }
 
+// AttestBridgeOut provides a mock function with given fields: ctx, validator, withdrawalId, signature
+func (_m *BridgeKeeper) AttestBridgeOut(ctx types.Context, validator types.ValAddress, withdrawalId uint32, signature []byte) (bool, error) {
+	ret := _m.Called(ctx, validator, withdrawalId, signature)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, types.ValAddress, uint32, []byte) bool); ok {
+		r0 = rf(ctx, validator, withdrawalId, signature)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, types.ValAddress, uint32, []byte) error); ok {
+		r1 = rf(ctx, validator, withdrawalId, signature)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BridgeOut provides a mock function with given fields: ctx, sender, ethRecipient, coin
+func (_m *BridgeKeeper) BridgeOut(ctx types.Context, sender types.AccAddress, ethRecipient string, coin types.Coin) (uint32, error) {
+	ret := _m.Called(ctx, sender, ethRecipient, coin)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(types.Context, types.AccAddress, string, types.Coin) uint32); ok {
+		r0 = rf(ctx, sender, ethRecipient, coin)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, types.AccAddress, string, types.Coin) error); ok {
+		r1 = rf(ctx, sender, ethRecipient, coin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CompleteBridge provides a mock function with given fields: ctx, bridges
 func (_m *BridgeKeeper) CompleteBridge(ctx types.Context, bridges bridgetypes.BridgeEvent) error {
 	ret := _m.Called(ctx, bridges)
@@ -56,6 +98,38 @@ func (_m *BridgeKeeper) GetAcknowledgedEventInfo(ctx types.Context) bridgetypes.
 	return r0
 }
 
+// GetAllRateLimitParams provides a mock function with given fields: ctx
+func (_m *BridgeKeeper) GetAllRateLimitParams(ctx types.Context) []bridgetypes.RateLimitParams {
+	ret := _m.Called(ctx)
+
+	var r0 []bridgetypes.RateLimitParams
+	if rf, ok := ret.Get(0).(func(types.Context) []bridgetypes.RateLimitParams); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bridgetypes.RateLimitParams)
+		}
+	}
+
+	return r0
+}
+
+// GetAllSourceChainParams provides a mock function with given fields: ctx
+func (_m *BridgeKeeper) GetAllSourceChainParams(ctx types.Context) []bridgetypes.SourceChainParams {
+	ret := _m.Called(ctx)
+
+	var r0 []bridgetypes.SourceChainParams
+	if rf, ok := ret.Get(0).(func(types.Context) []bridgetypes.SourceChainParams); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bridgetypes.SourceChainParams)
+		}
+	}
+
+	return r0
+}
+
 // GetEventParams provides a mock function with given fields: ctx
 func (_m *BridgeKeeper) GetEventParams(ctx types.Context) bridgetypes.EventParams {
 	ret := _m.Called(ctx)
@@ -84,6 +158,27 @@ func (_m *BridgeKeeper) GetProposeParams(ctx types.Context) bridgetypes.ProposeP
 	return r0
 }
 
+// GetRateLimitParams provides a mock function with given fields: ctx, ethChainId
+func (_m *BridgeKeeper) GetRateLimitParams(ctx types.Context, ethChainId uint64) (bridgetypes.RateLimitParams, bool) {
+	ret := _m.Called(ctx, ethChainId)
+
+	var r0 bridgetypes.RateLimitParams
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) bridgetypes.RateLimitParams); ok {
+		r0 = rf(ctx, ethChainId)
+	} else {
+		r0 = ret.Get(0).(bridgetypes.RateLimitParams)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(types.Context, uint64) bool); ok {
+		r1 = rf(ctx, ethChainId)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetRecognizedEventInfo provides a mock function with given fields: ctx
 func (_m *BridgeKeeper) GetRecognizedEventInfo(ctx types.Context) bridgetypes.BridgeEventInfo {
 	ret := _m.Called(ctx)
@@ -112,6 +207,48 @@ func (_m *BridgeKeeper) GetSafetyParams(ctx types.Context) bridgetypes.SafetyPar
 	return r0
 }
 
+// GetSourceChainParams provides a mock function with given fields: ctx, ethChainId
+func (_m *BridgeKeeper) GetSourceChainParams(ctx types.Context, ethChainId uint64) (bridgetypes.SourceChainParams, bool) {
+	ret := _m.Called(ctx, ethChainId)
+
+	var r0 bridgetypes.SourceChainParams
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) bridgetypes.SourceChainParams); ok {
+		r0 = rf(ctx, ethChainId)
+	} else {
+		r0 = ret.Get(0).(bridgetypes.SourceChainParams)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(types.Context, uint64) bool); ok {
+		r1 = rf(ctx, ethChainId)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GetWithdrawal provides a mock function with given fields: ctx, id
+func (_m *BridgeKeeper) GetWithdrawal(ctx types.Context, id uint32) (bridgetypes.BridgeWithdrawal, bool) {
+	ret := _m.Called(ctx, id)
+
+	var r0 bridgetypes.BridgeWithdrawal
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) bridgetypes.BridgeWithdrawal); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bridgetypes.BridgeWithdrawal)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(types.Context, uint32) bool); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // HasAuthority provides a mock function with given fields: authority
 func (_m *BridgeKeeper) HasAuthority(authority string) bool {
 	ret := _m.Called(authority)
@@ -126,6 +263,11 @@ func (_m *BridgeKeeper) HasAuthority(authority string) bool {
 	return r0
 }
 
+// SetBridgeSigner provides a mock function with given fields: ctx, validator, ethSigner
+func (_m *BridgeKeeper) SetBridgeSigner(ctx types.Context, validator types.ValAddress, ethSigner string) {
+	_m.Called(ctx, validator, ethSigner)
+}
+
 // UpdateEventParams provides a mock function with given fields: ctx, params
 func (_m *BridgeKeeper) UpdateEventParams(ctx types.Context, params bridgetypes.EventParams) error {
 	ret := _m.Called(ctx, params)
@@ -154,6 +296,20 @@ func (_m *BridgeKeeper) UpdateProposeParams(ctx types.Context, params bridgetype
 	return r0
 }
 
+// UpdateRateLimitParams provides a mock function with given fields: ctx, params
+func (_m *BridgeKeeper) UpdateRateLimitParams(ctx types.Context, params bridgetypes.RateLimitParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, bridgetypes.RateLimitParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSafetyParams provides a mock function with given fields: ctx, params
 func (_m *BridgeKeeper) UpdateSafetyParams(ctx types.Context, params bridgetypes.SafetyParams) error {
 	ret := _m.Called(ctx, params)
@@ -168,6 +324,20 @@ func (_m *BridgeKeeper) UpdateSafetyParams(ctx types.Context, params bridgetypes
 	return r0
 }
 
+// UpdateSourceChainParams provides a mock function with given fields: ctx, params
+func (_m *BridgeKeeper) UpdateSourceChainParams(ctx types.Context, params bridgetypes.SourceChainParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, bridgetypes.SourceChainParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewBridgeKeeper interface {
 	mock.TestingT
 	Cleanup(func())
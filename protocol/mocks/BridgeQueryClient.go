@@ -47,6 +47,36 @@ func (_m *BridgeQueryClient) AcknowledgedEventInfo(ctx context.Context, in *type
 	return r0, r1
 }
 
+// BridgeSigner provides a mock function with given fields: ctx, in, opts
+func (_m *BridgeQueryClient) BridgeSigner(ctx context.Context, in *types.QueryBridgeSignerRequest, opts ...grpc.CallOption) (*types.QueryBridgeSignerResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryBridgeSignerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBridgeSignerRequest, ...grpc.CallOption) *types.QueryBridgeSignerResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBridgeSignerResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBridgeSignerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DelayedCompleteBridgeMessages provides a mock function with given fields: ctx, in, opts
 func (_m *BridgeQueryClient) DelayedCompleteBridgeMessages(ctx context.Context, in *types.QueryDelayedCompleteBridgeMessagesRequest, opts ...grpc.CallOption) (*types.QueryDelayedCompleteBridgeMessagesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -107,6 +137,66 @@ func (_m *BridgeQueryClient) EventParams(ctx context.Context, in *types.QueryEve
 	return r0, r1
 }
 
+// FinalizedWithdrawals provides a mock function with given fields: ctx, in, opts
+func (_m *BridgeQueryClient) FinalizedWithdrawals(ctx context.Context, in *types.QueryFinalizedWithdrawalsRequest, opts ...grpc.CallOption) (*types.QueryFinalizedWithdrawalsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFinalizedWithdrawalsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFinalizedWithdrawalsRequest, ...grpc.CallOption) *types.QueryFinalizedWithdrawalsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFinalizedWithdrawalsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFinalizedWithdrawalsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PendingWithdrawals provides a mock function with given fields: ctx, in, opts
+func (_m *BridgeQueryClient) PendingWithdrawals(ctx context.Context, in *types.QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*types.QueryPendingWithdrawalsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryPendingWithdrawalsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPendingWithdrawalsRequest, ...grpc.CallOption) *types.QueryPendingWithdrawalsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPendingWithdrawalsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPendingWithdrawalsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ProposeParams provides a mock function with given fields: ctx, in, opts
 func (_m *BridgeQueryClient) ProposeParams(ctx context.Context, in *types.QueryProposeParamsRequest, opts ...grpc.CallOption) (*types.QueryProposeParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -137,6 +227,36 @@ func (_m *BridgeQueryClient) ProposeParams(ctx context.Context, in *types.QueryP
 	return r0, r1
 }
 
+// RateLimitParams provides a mock function with given fields: ctx, in, opts
+func (_m *BridgeQueryClient) RateLimitParams(ctx context.Context, in *types.QueryRateLimitParamsRequest, opts ...grpc.CallOption) (*types.QueryRateLimitParamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryRateLimitParamsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryRateLimitParamsRequest, ...grpc.CallOption) *types.QueryRateLimitParamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryRateLimitParamsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryRateLimitParamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecognizedEventInfo provides a mock function with given fields: ctx, in, opts
 func (_m *BridgeQueryClient) RecognizedEventInfo(ctx context.Context, in *types.QueryRecognizedEventInfoRequest, opts ...grpc.CallOption) (*types.QueryRecognizedEventInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -197,6 +317,96 @@ func (_m *BridgeQueryClient) SafetyParams(ctx context.Context, in *types.QuerySa
 	return r0, r1
 }
 
+// SourceChainParams provides a mock function with given fields: ctx, in, opts
+func (_m *BridgeQueryClient) SourceChainParams(ctx context.Context, in *types.QuerySourceChainParamsRequest, opts ...grpc.CallOption) (*types.QuerySourceChainParamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QuerySourceChainParamsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySourceChainParamsRequest, ...grpc.CallOption) *types.QuerySourceChainParamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySourceChainParamsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySourceChainParamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WindowUtilization provides a mock function with given fields: ctx, in, opts
+func (_m *BridgeQueryClient) WindowUtilization(ctx context.Context, in *types.QueryWindowUtilizationRequest, opts ...grpc.CallOption) (*types.QueryWindowUtilizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryWindowUtilizationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryWindowUtilizationRequest, ...grpc.CallOption) *types.QueryWindowUtilizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryWindowUtilizationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryWindowUtilizationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Withdrawal provides a mock function with given fields: ctx, in, opts
+func (_m *BridgeQueryClient) Withdrawal(ctx context.Context, in *types.QueryWithdrawalRequest, opts ...grpc.CallOption) (*types.QueryWithdrawalResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryWithdrawalResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryWithdrawalRequest, ...grpc.CallOption) *types.QueryWithdrawalResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryWithdrawalResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryWithdrawalRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewBridgeQueryClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -47,6 +47,56 @@ func (_m *ClobKeeper) AddOrderToOrderbookCollatCheck(ctx types.Context, clobPair
 	return r0, r1
 }
 
+// BatchCancelShortTermOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) BatchCancelShortTermOrder(ctx types.Context, msg *clobtypes.MsgBatchCancel) ([]clobtypes.OrderBatch, []clobtypes.OrderBatch) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 []clobtypes.OrderBatch
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchCancel) []clobtypes.OrderBatch); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderBatch)
+		}
+	}
+
+	var r1 []clobtypes.OrderBatch
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgBatchCancel) []clobtypes.OrderBatch); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]clobtypes.OrderBatch)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchPlaceShortTermOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) BatchPlaceShortTermOrders(ctx types.Context, msg *clobtypes.MsgBatchPlaceOrders) ([]clobtypes.OrderId, []clobtypes.OrderId) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 []clobtypes.OrderId
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchPlaceOrders) []clobtypes.OrderId); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderId)
+		}
+	}
+
+	var r1 []clobtypes.OrderId
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgBatchPlaceOrders) []clobtypes.OrderId); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]clobtypes.OrderId)
+		}
+	}
+
+	return r0, r1
+}
+
 // CancelShortTermOrder provides a mock function with given fields: ctx, msg
 func (_m *ClobKeeper) CancelShortTermOrder(ctx types.Context, msg *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, msg)
@@ -110,6 +160,27 @@ func (_m *ClobKeeper) CreatePerpetualClobPair(ctx types.Context, clobPairId uint
 	return r0, r1
 }
 
+// CreateSpotClobPair provides a mock function with given fields: ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status
+func (_m *ClobKeeper) CreateSpotClobPair(ctx types.Context, clobPairId uint32, baseAssetId uint32, quoteAssetId uint32, stepSizeInBaseQuantums subaccountstypes.BaseQuantums, quantumConversionExponent int32, subticksPerTick uint32, status clobtypes.ClobPair_Status) (clobtypes.ClobPair, error) {
+	ret := _m.Called(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+
+	var r0 clobtypes.ClobPair
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint32, uint32, subaccountstypes.BaseQuantums, int32, uint32, clobtypes.ClobPair_Status) clobtypes.ClobPair); ok {
+		r0 = rf(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+	} else {
+		r0 = ret.Get(0).(clobtypes.ClobPair)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, uint32, uint32, subaccountstypes.BaseQuantums, int32, uint32, clobtypes.ClobPair_Status) error); ok {
+		r1 = rf(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteLongTermOrderPlacement provides a mock function with given fields: ctx, orderId
 func (_m *ClobKeeper) DeleteLongTermOrderPlacement(ctx types.Context, orderId clobtypes.OrderId) {
 	_m.Called(ctx, orderId)
@@ -447,6 +518,27 @@ func (_m *ClobKeeper) GetSubaccountMaxNotionalLiquidatable(ctx types.Context, su
 	return r0, r1
 }
 
+// GetTradingPermission provides a mock function with given fields: ctx, subaccountId, grantee
+func (_m *ClobKeeper) GetTradingPermission(ctx types.Context, subaccountId subaccountstypes.SubaccountId, grantee string) (clobtypes.TradingPermission, bool) {
+	ret := _m.Called(ctx, subaccountId, grantee)
+
+	var r0 clobtypes.TradingPermission
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, string) clobtypes.TradingPermission); ok {
+		r0 = rf(ctx, subaccountId, grantee)
+	} else {
+		r0 = ret.Get(0).(clobtypes.TradingPermission)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId, string) bool); ok {
+		r1 = rf(ctx, subaccountId, grantee)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // HasAuthority provides a mock function with given fields: authority
 func (_m *ClobKeeper) HasAuthority(authority string) bool {
 	ret := _m.Called(authority)
@@ -510,6 +602,22 @@ func (_m *ClobKeeper) IsLiquidatable(ctx types.Context, subaccountId subaccounts
 	return r0, r1
 }
 
+// LockMemClob provides a mock function with given fields:
+func (_m *ClobKeeper) LockMemClob() func() {
+	ret := _m.Called()
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func() func()); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
 // Logger provides a mock function with given fields: ctx
 func (_m *ClobKeeper) Logger(ctx types.Context) log.Logger {
 	ret := _m.Called(ctx)
@@ -690,6 +798,11 @@ func (_m *ClobKeeper) PlaceStatefulOrder(ctx types.Context, msg *clobtypes.MsgPl
 	return r0
 }
 
+// ProcessMakerDepthSamples provides a mock function with given fields: ctx, samples
+func (_m *ClobKeeper) ProcessMakerDepthSamples(ctx types.Context, samples []clobtypes.MakerDepthSample) {
+	_m.Called(ctx, samples)
+}
+
 // ProcessProposerOperations provides a mock function with given fields: ctx, operations
 func (_m *ClobKeeper) ProcessProposerOperations(ctx types.Context, operations []clobtypes.OperationRaw) error {
 	ret := _m.Called(ctx, operations)
@@ -753,6 +866,34 @@ func (_m *ClobKeeper) PruneStateFillAmountsForShortTermOrders(ctx types.Context)
 	_m.Called(ctx)
 }
 
+// RateLimitBatchCancel provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) RateLimitBatchCancel(ctx types.Context, msg *clobtypes.MsgBatchCancel) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchCancel) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RateLimitBatchPlaceOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) RateLimitBatchPlaceOrders(ctx types.Context, msg *clobtypes.MsgBatchPlaceOrders) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchPlaceOrders) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RateLimitCancelOrder provides a mock function with given fields: ctx, order
 func (_m *ClobKeeper) RateLimitCancelOrder(ctx types.Context, order *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, order)
@@ -781,6 +922,20 @@ func (_m *ClobKeeper) RateLimitPlaceOrder(ctx types.Context, order *clobtypes.Ms
 	return r0
 }
 
+// RateLimitReplaceOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) RateLimitReplaceOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveClobPair provides a mock function with given fields: ctx, id
 func (_m *ClobKeeper) RemoveClobPair(ctx types.Context, id clobtypes.ClobPairId) {
 	_m.Called(ctx, id)
@@ -807,11 +962,81 @@ func (_m *ClobKeeper) RemoveOrderFillAmount(ctx types.Context, orderId clobtypes
 	_m.Called(ctx, orderId)
 }
 
+// RemoveTradingPermission provides a mock function with given fields: ctx, subaccountId, grantee
+func (_m *ClobKeeper) RemoveTradingPermission(ctx types.Context, subaccountId subaccountstypes.SubaccountId, grantee string) error {
+	ret := _m.Called(ctx, subaccountId, grantee)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, string) error); ok {
+		r0 = rf(ctx, subaccountId, grantee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplaceShortTermOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) ReplaceShortTermOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 subaccountstypes.BaseQuantums
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) subaccountstypes.BaseQuantums); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Get(0).(subaccountstypes.BaseQuantums)
+	}
+
+	var r1 clobtypes.OrderStatus
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgReplaceOrder) clobtypes.OrderStatus); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Get(1).(clobtypes.OrderStatus)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r2 = rf(ctx, msg)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReplaceStatefulOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) ReplaceStatefulOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLongTermOrderPlacement provides a mock function with given fields: ctx, order, blockHeight
 func (_m *ClobKeeper) SetLongTermOrderPlacement(ctx types.Context, order clobtypes.Order, blockHeight uint32) {
 	_m.Called(ctx, order, blockHeight)
 }
 
+// SetTradingPermission provides a mock function with given fields: ctx, permission
+func (_m *ClobKeeper) SetTradingPermission(ctx types.Context, permission clobtypes.TradingPermission) error {
+	ret := _m.Called(ctx, permission)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.TradingPermission) error); ok {
+		r0 = rf(ctx, permission)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateClobPair provides a mock function with given fields: ctx, clobPair
 func (_m *ClobKeeper) UpdateClobPair(ctx types.Context, clobPair clobtypes.ClobPair) error {
 	ret := _m.Called(ctx, clobPair)
@@ -840,11 +1065,39 @@ func (_m *ClobKeeper) UpdateLiquidationsConfig(ctx types.Context, config clobtyp
 	return r0
 }
 
+// UpdateMakerUptimeRewardsConfig provides a mock function with given fields: ctx, config
+func (_m *ClobKeeper) UpdateMakerUptimeRewardsConfig(ctx types.Context, config clobtypes.MakerUptimeRewardsConfig) error {
+	ret := _m.Called(ctx, config)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.MakerUptimeRewardsConfig) error); ok {
+		r0 = rf(ctx, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSubaccountLiquidationInfo provides a mock function with given fields: ctx, subaccountId, notionalLiquidatedQuoteQuantums, insuranceFundDeltaQuoteQuantums
 func (_m *ClobKeeper) UpdateSubaccountLiquidationInfo(ctx types.Context, subaccountId subaccountstypes.SubaccountId, notionalLiquidatedQuoteQuantums *big.Int, insuranceFundDeltaQuoteQuantums *big.Int) {
 	_m.Called(ctx, subaccountId, notionalLiquidatedQuoteQuantums, insuranceFundDeltaQuoteQuantums)
 }
 
+// ValidateMsgTradingPermission provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) ValidateMsgTradingPermission(ctx types.Context, msg types.Msg) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.Msg) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewClobKeeper interface {
 	mock.TestingT
 	Cleanup(func())
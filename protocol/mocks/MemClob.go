@@ -224,6 +224,38 @@ func (_m *MemClob) GetOrderRemainingAmount(ctx types.Context, order clobtypes.Or
 	return r0, r1
 }
 
+// GetOrderbookLevels provides a mock function with given fields: ctx, clobPairId, depth, includeOrders
+func (_m *MemClob) GetOrderbookLevels(ctx types.Context, clobPairId clobtypes.ClobPairId, depth uint32, includeOrders bool) ([]clobtypes.OrderbookLevel, []clobtypes.OrderbookLevel, bool) {
+	ret := _m.Called(ctx, clobPairId, depth, includeOrders)
+
+	var r0 []clobtypes.OrderbookLevel
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId, uint32, bool) []clobtypes.OrderbookLevel); ok {
+		r0 = rf(ctx, clobPairId, depth, includeOrders)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderbookLevel)
+		}
+	}
+
+	var r1 []clobtypes.OrderbookLevel
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.ClobPairId, uint32, bool) []clobtypes.OrderbookLevel); ok {
+		r1 = rf(ctx, clobPairId, depth, includeOrders)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]clobtypes.OrderbookLevel)
+		}
+	}
+
+	var r2 bool
+	if rf, ok := ret.Get(2).(func(types.Context, clobtypes.ClobPairId, uint32, bool) bool); ok {
+		r2 = rf(ctx, clobPairId, depth, includeOrders)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	return r0, r1, r2
+}
+
 // GetPricePremium provides a mock function with given fields: ctx, clobPair, params
 func (_m *MemClob) GetPricePremium(ctx types.Context, clobPair clobtypes.ClobPair, params perpetualstypes.GetPricePremiumParams) (int32, error) {
 	ret := _m.Called(ctx, clobPair, params)
@@ -358,6 +390,29 @@ func (_m *MemClob) PurgeInvalidMemclobState(ctx types.Context, fullyFilledOrderI
 	return r0
 }
 
+// ReduceOrderSize provides a mock function with given fields: ctx, order
+func (_m *MemClob) ReduceOrderSize(ctx types.Context, order clobtypes.Order) (*clobtypes.OffchainUpdates, error) {
+	ret := _m.Called(ctx, order)
+
+	var r0 *clobtypes.OffchainUpdates
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.Order) *clobtypes.OffchainUpdates); ok {
+		r0 = rf(ctx, order)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.Order) error); ok {
+		r1 = rf(ctx, order)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveAndClearOperationsQueue provides a mock function with given fields: ctx, localValidatorOperationsQueue
 func (_m *MemClob) RemoveAndClearOperationsQueue(ctx types.Context, localValidatorOperationsQueue []clobtypes.InternalOperation) {
 	_m.Called(ctx, localValidatorOperationsQueue)
@@ -394,6 +449,43 @@ func (_m *MemClob) SetMemclobGauges(ctx types.Context) {
 	_m.Called(ctx)
 }
 
+// SimulatePlaceOrder provides a mock function with given fields: ctx, order
+func (_m *MemClob) SimulatePlaceOrder(ctx types.Context, order clobtypes.Order) ([]clobtypes.MatchWithOrders, subaccountstypes.BaseQuantums, clobtypes.OrderStatus, error) {
+	ret := _m.Called(ctx, order)
+
+	var r0 []clobtypes.MatchWithOrders
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.Order) []clobtypes.MatchWithOrders); ok {
+		r0 = rf(ctx, order)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.MatchWithOrders)
+		}
+	}
+
+	var r1 subaccountstypes.BaseQuantums
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.Order) subaccountstypes.BaseQuantums); ok {
+		r1 = rf(ctx, order)
+	} else {
+		r1 = ret.Get(1).(subaccountstypes.BaseQuantums)
+	}
+
+	var r2 clobtypes.OrderStatus
+	if rf, ok := ret.Get(2).(func(types.Context, clobtypes.Order) clobtypes.OrderStatus); ok {
+		r2 = rf(ctx, order)
+	} else {
+		r2 = ret.Get(2).(clobtypes.OrderStatus)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(types.Context, clobtypes.Order) error); ok {
+		r3 = rf(ctx, order)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 type mockConstructorTestingTNewMemClob interface {
 	mock.TestingT
 	Cleanup(func())
@@ -14,34 +14,20 @@ type ProcessBridgeKeeper struct {
 	mock.Mock
 }
 
-// GetAcknowledgedEventInfo provides a mock function with given fields: ctx
-func (_m *ProcessBridgeKeeper) GetAcknowledgedEventInfo(ctx types.Context) bridgetypes.BridgeEventInfo {
-	ret := _m.Called(ctx)
-
-	var r0 bridgetypes.BridgeEventInfo
-	if rf, ok := ret.Get(0).(func(types.Context) bridgetypes.BridgeEventInfo); ok {
-		r0 = rf(ctx)
-	} else {
-		r0 = ret.Get(0).(bridgetypes.BridgeEventInfo)
-	}
-
-	return r0
-}
-
-// GetBridgeEventFromServer provides a mock function with given fields: ctx, id
-func (_m *ProcessBridgeKeeper) GetBridgeEventFromServer(ctx types.Context, id uint32) (bridgetypes.BridgeEvent, bool) {
-	ret := _m.Called(ctx, id)
+// GetBridgeEventFromServer provides a mock function with given fields: ctx, ethChainId, id
+func (_m *ProcessBridgeKeeper) GetBridgeEventFromServer(ctx types.Context, ethChainId uint64, id uint32) (bridgetypes.BridgeEvent, bool) {
+	ret := _m.Called(ctx, ethChainId, id)
 
 	var r0 bridgetypes.BridgeEvent
-	if rf, ok := ret.Get(0).(func(types.Context, uint32) bridgetypes.BridgeEvent); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(types.Context, uint64, uint32) bridgetypes.BridgeEvent); ok {
+		r0 = rf(ctx, ethChainId, id)
 	} else {
 		r0 = ret.Get(0).(bridgetypes.BridgeEvent)
 	}
 
 	var r1 bool
-	if rf, ok := ret.Get(1).(func(types.Context, uint32) bool); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(types.Context, uint64, uint32) bool); ok {
+		r1 = rf(ctx, ethChainId, id)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
@@ -49,13 +35,13 @@ func (_m *ProcessBridgeKeeper) GetBridgeEventFromServer(ctx types.Context, id ui
 	return r0, r1
 }
 
-// GetRecognizedEventInfo provides a mock function with given fields: ctx
-func (_m *ProcessBridgeKeeper) GetRecognizedEventInfo(ctx types.Context) bridgetypes.BridgeEventInfo {
-	ret := _m.Called(ctx)
+// GetSourceChainAcknowledgedEventInfo provides a mock function with given fields: ctx, ethChainId
+func (_m *ProcessBridgeKeeper) GetSourceChainAcknowledgedEventInfo(ctx types.Context, ethChainId uint64) bridgetypes.BridgeEventInfo {
+	ret := _m.Called(ctx, ethChainId)
 
 	var r0 bridgetypes.BridgeEventInfo
-	if rf, ok := ret.Get(0).(func(types.Context) bridgetypes.BridgeEventInfo); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) bridgetypes.BridgeEventInfo); ok {
+		r0 = rf(ctx, ethChainId)
 	} else {
 		r0 = ret.Get(0).(bridgetypes.BridgeEventInfo)
 	}
@@ -63,15 +49,36 @@ func (_m *ProcessBridgeKeeper) GetRecognizedEventInfo(ctx types.Context) bridget
 	return r0
 }
 
-// GetSafetyParams provides a mock function with given fields: ctx
-func (_m *ProcessBridgeKeeper) GetSafetyParams(ctx types.Context) bridgetypes.SafetyParams {
-	ret := _m.Called(ctx)
+// GetSourceChainParams provides a mock function with given fields: ctx, ethChainId
+func (_m *ProcessBridgeKeeper) GetSourceChainParams(ctx types.Context, ethChainId uint64) (bridgetypes.SourceChainParams, bool) {
+	ret := _m.Called(ctx, ethChainId)
 
-	var r0 bridgetypes.SafetyParams
-	if rf, ok := ret.Get(0).(func(types.Context) bridgetypes.SafetyParams); ok {
-		r0 = rf(ctx)
+	var r0 bridgetypes.SourceChainParams
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) bridgetypes.SourceChainParams); ok {
+		r0 = rf(ctx, ethChainId)
 	} else {
-		r0 = ret.Get(0).(bridgetypes.SafetyParams)
+		r0 = ret.Get(0).(bridgetypes.SourceChainParams)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(types.Context, uint64) bool); ok {
+		r1 = rf(ctx, ethChainId)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GetSourceChainRecognizedEventInfo provides a mock function with given fields: ctx, ethChainId
+func (_m *ProcessBridgeKeeper) GetSourceChainRecognizedEventInfo(ctx types.Context, ethChainId uint64) bridgetypes.BridgeEventInfo {
+	ret := _m.Called(ctx, ethChainId)
+
+	var r0 bridgetypes.BridgeEventInfo
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) bridgetypes.BridgeEventInfo); ok {
+		r0 = rf(ctx, ethChainId)
+	} else {
+		r0 = ret.Get(0).(bridgetypes.BridgeEventInfo)
 	}
 
 	return r0
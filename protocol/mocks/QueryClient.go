@@ -16,9 +16,11 @@ import (
 
 	pricefeedapi "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+
 	subaccountstypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 
-	types "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 )
 
 // QueryClient is an autogenerated mock type for the QueryClient type
@@ -56,8 +58,38 @@ func (_m *QueryClient) AddBridgeEvents(ctx context.Context, in *api.AddBridgeEve
 	return r0, r1
 }
 
+// AllLiquidityTiers provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AllLiquidityTiers(ctx context.Context, in *types.QueryAllLiquidityTiersRequest, opts ...grpc.CallOption) (*types.QueryAllLiquidityTiersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryAllLiquidityTiersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllLiquidityTiersRequest, ...grpc.CallOption) *types.QueryAllLiquidityTiersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAllLiquidityTiersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAllLiquidityTiersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AllMarketParams provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) AllMarketParams(ctx context.Context, in *types.QueryAllMarketParamsRequest, opts ...grpc.CallOption) (*types.QueryAllMarketParamsResponse, error) {
+func (_m *QueryClient) AllMarketParams(ctx context.Context, in *pricestypes.QueryAllMarketParamsRequest, opts ...grpc.CallOption) (*pricestypes.QueryAllMarketParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -67,17 +99,17 @@ func (_m *QueryClient) AllMarketParams(ctx context.Context, in *types.QueryAllMa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *types.QueryAllMarketParamsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllMarketParamsRequest, ...grpc.CallOption) *types.QueryAllMarketParamsResponse); ok {
+	var r0 *pricestypes.QueryAllMarketParamsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryAllMarketParamsRequest, ...grpc.CallOption) *pricestypes.QueryAllMarketParamsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryAllMarketParamsResponse)
+			r0 = ret.Get(0).(*pricestypes.QueryAllMarketParamsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAllMarketParamsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryAllMarketParamsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -87,7 +119,7 @@ func (_m *QueryClient) AllMarketParams(ctx context.Context, in *types.QueryAllMa
 }
 
 // AllMarketPrices provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) AllMarketPrices(ctx context.Context, in *types.QueryAllMarketPricesRequest, opts ...grpc.CallOption) (*types.QueryAllMarketPricesResponse, error) {
+func (_m *QueryClient) AllMarketPrices(ctx context.Context, in *pricestypes.QueryAllMarketPricesRequest, opts ...grpc.CallOption) (*pricestypes.QueryAllMarketPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -97,17 +129,47 @@ func (_m *QueryClient) AllMarketPrices(ctx context.Context, in *types.QueryAllMa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *types.QueryAllMarketPricesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllMarketPricesRequest, ...grpc.CallOption) *types.QueryAllMarketPricesResponse); ok {
+	var r0 *pricestypes.QueryAllMarketPricesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryAllMarketPricesRequest, ...grpc.CallOption) *pricestypes.QueryAllMarketPricesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryAllMarketPricesResponse)
+			r0 = ret.Get(0).(*pricestypes.QueryAllMarketPricesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAllMarketPricesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryAllMarketPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AllPerpetuals provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AllPerpetuals(ctx context.Context, in *types.QueryAllPerpetualsRequest, opts ...grpc.CallOption) (*types.QueryAllPerpetualsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryAllPerpetualsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllPerpetualsRequest, ...grpc.CallOption) *types.QueryAllPerpetualsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAllPerpetualsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAllPerpetualsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -236,6 +298,36 @@ func (_m *QueryClient) ClobPairAll(ctx context.Context, in *clobtypes.QueryAllCl
 	return r0, r1
 }
 
+// DeleveragingCandidates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) DeleveragingCandidates(ctx context.Context, in *clobtypes.QueryDeleveragingCandidatesRequest, opts ...grpc.CallOption) (*clobtypes.QueryDeleveragingCandidatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryDeleveragingCandidatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryDeleveragingCandidatesRequest, ...grpc.CallOption) *clobtypes.QueryDeleveragingCandidatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryDeleveragingCandidatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryDeleveragingCandidatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EquityTierLimitConfiguration provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) EquityTierLimitConfiguration(ctx context.Context, in *clobtypes.QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryEquityTierLimitConfigurationResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -266,6 +358,36 @@ func (_m *QueryClient) EquityTierLimitConfiguration(ctx context.Context, in *clo
 	return r0, r1
 }
 
+// GetUpdatedSubaccountIds provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) GetUpdatedSubaccountIds(ctx context.Context, in *liquidationapi.GetUpdatedSubaccountIdsRequest, opts ...grpc.CallOption) (*liquidationapi.GetUpdatedSubaccountIdsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *liquidationapi.GetUpdatedSubaccountIdsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *liquidationapi.GetUpdatedSubaccountIdsRequest, ...grpc.CallOption) *liquidationapi.GetUpdatedSubaccountIdsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*liquidationapi.GetUpdatedSubaccountIdsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *liquidationapi.GetUpdatedSubaccountIdsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LiquidateSubaccounts provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) LiquidateSubaccounts(ctx context.Context, in *liquidationapi.LiquidateSubaccountsRequest, opts ...grpc.CallOption) (*liquidationapi.LiquidateSubaccountsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -326,8 +448,38 @@ func (_m *QueryClient) LiquidationsConfiguration(ctx context.Context, in *clobty
 	return r0, r1
 }
 
+// MakerUptimeRewardsConfiguration provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MakerUptimeRewardsConfiguration(ctx context.Context, in *clobtypes.QueryMakerUptimeRewardsConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryMakerUptimeRewardsConfigurationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryMakerUptimeRewardsConfigurationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryMakerUptimeRewardsConfigurationRequest, ...grpc.CallOption) *clobtypes.QueryMakerUptimeRewardsConfigurationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryMakerUptimeRewardsConfigurationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryMakerUptimeRewardsConfigurationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarketParam provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) MarketParam(ctx context.Context, in *types.QueryMarketParamRequest, opts ...grpc.CallOption) (*types.QueryMarketParamResponse, error) {
+func (_m *QueryClient) MarketParam(ctx context.Context, in *pricestypes.QueryMarketParamRequest, opts ...grpc.CallOption) (*pricestypes.QueryMarketParamResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -337,17 +489,17 @@ func (_m *QueryClient) MarketParam(ctx context.Context, in *types.QueryMarketPar
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *types.QueryMarketParamResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryMarketParamRequest, ...grpc.CallOption) *types.QueryMarketParamResponse); ok {
+	var r0 *pricestypes.QueryMarketParamResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketParamRequest, ...grpc.CallOption) *pricestypes.QueryMarketParamResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryMarketParamResponse)
+			r0 = ret.Get(0).(*pricestypes.QueryMarketParamResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryMarketParamRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryMarketParamRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -357,7 +509,7 @@ func (_m *QueryClient) MarketParam(ctx context.Context, in *types.QueryMarketPar
 }
 
 // MarketPrice provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) MarketPrice(ctx context.Context, in *types.QueryMarketPriceRequest, opts ...grpc.CallOption) (*types.QueryMarketPriceResponse, error) {
+func (_m *QueryClient) MarketPrice(ctx context.Context, in *pricestypes.QueryMarketPriceRequest, opts ...grpc.CallOption) (*pricestypes.QueryMarketPriceResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -367,17 +519,17 @@ func (_m *QueryClient) MarketPrice(ctx context.Context, in *types.QueryMarketPri
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *types.QueryMarketPriceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryMarketPriceRequest, ...grpc.CallOption) *types.QueryMarketPriceResponse); ok {
+	var r0 *pricestypes.QueryMarketPriceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketPriceRequest, ...grpc.CallOption) *pricestypes.QueryMarketPriceResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryMarketPriceResponse)
+			r0 = ret.Get(0).(*pricestypes.QueryMarketPriceResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryMarketPriceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryMarketPriceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -416,6 +568,216 @@ func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtyp
 	return r0, r1
 }
 
+// OrderbookL2 provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) OrderbookL2(ctx context.Context, in *clobtypes.QueryOrderbookL2Request, opts ...grpc.CallOption) (*clobtypes.QueryOrderbookL2Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryOrderbookL2Response
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryOrderbookL2Request, ...grpc.CallOption) *clobtypes.QueryOrderbookL2Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryOrderbookL2Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryOrderbookL2Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderbookL3 provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) OrderbookL3(ctx context.Context, in *clobtypes.QueryOrderbookL3Request, opts ...grpc.CallOption) (*clobtypes.QueryOrderbookL3Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryOrderbookL3Response
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryOrderbookL3Request, ...grpc.CallOption) *clobtypes.QueryOrderbookL3Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryOrderbookL3Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryOrderbookL3Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Params provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryParamsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) *types.QueryParamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryParamsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Perpetual provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Perpetual(ctx context.Context, in *types.QueryPerpetualRequest, opts ...grpc.CallOption) (*types.QueryPerpetualResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryPerpetualResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPerpetualRequest, ...grpc.CallOption) *types.QueryPerpetualResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPerpetualResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPerpetualRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PremiumSamples provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PremiumSamples(ctx context.Context, in *types.QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*types.QueryPremiumSamplesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryPremiumSamplesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPremiumSamplesRequest, ...grpc.CallOption) *types.QueryPremiumSamplesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPremiumSamplesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPremiumSamplesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PremiumVotes provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PremiumVotes(ctx context.Context, in *types.QueryPremiumVotesRequest, opts ...grpc.CallOption) (*types.QueryPremiumVotesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryPremiumVotesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPremiumVotesRequest, ...grpc.CallOption) *types.QueryPremiumVotesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPremiumVotesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPremiumVotesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimulatePlaceOrder provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SimulatePlaceOrder(ctx context.Context, in *clobtypes.QuerySimulatePlaceOrderRequest, opts ...grpc.CallOption) (*clobtypes.QuerySimulatePlaceOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QuerySimulatePlaceOrderResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QuerySimulatePlaceOrderRequest, ...grpc.CallOption) *clobtypes.QuerySimulatePlaceOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QuerySimulatePlaceOrderResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QuerySimulatePlaceOrderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Subaccount provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Subaccount(ctx context.Context, in *subaccountstypes.QueryGetSubaccountRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -476,6 +838,66 @@ func (_m *QueryClient) SubaccountAll(ctx context.Context, in *subaccountstypes.Q
 	return r0, r1
 }
 
+// SubaccountMargin provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountMargin(ctx context.Context, in *subaccountstypes.QuerySubaccountMarginRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountMarginResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *subaccountstypes.QuerySubaccountMarginResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountMarginRequest, ...grpc.CallOption) *subaccountstypes.QuerySubaccountMarginResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QuerySubaccountMarginResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QuerySubaccountMarginRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingPermission provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) TradingPermission(ctx context.Context, in *clobtypes.QueryTradingPermissionRequest, opts ...grpc.CallOption) (*clobtypes.QueryTradingPermissionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryTradingPermissionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryTradingPermissionRequest, ...grpc.CallOption) *clobtypes.QueryTradingPermissionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryTradingPermissionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryTradingPermissionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMarketPrices provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) UpdateMarketPrices(ctx context.Context, in *pricefeedapi.UpdateMarketPricesRequest, opts ...grpc.CallOption) (*pricefeedapi.UpdateMarketPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	big "math/big"
+
 	cosmos_sdktypes "github.com/cosmos/cosmos-sdk/types"
 	mock "github.com/stretchr/testify/mock"
 
@@ -42,6 +44,43 @@ func (_m *SendingKeeper) ProcessDepositToSubaccount(ctx cosmos_sdktypes.Context,
 	return r0
 }
 
+// ProcessPerpetualPositionTransfer provides a mock function with given fields: ctx, transfer
+func (_m *SendingKeeper) ProcessPerpetualPositionTransfer(ctx cosmos_sdktypes.Context, transfer *types.PerpetualPositionTransfer) (*big.Int, error) {
+	ret := _m.Called(ctx, transfer)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(cosmos_sdktypes.Context, *types.PerpetualPositionTransfer) *big.Int); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(cosmos_sdktypes.Context, *types.PerpetualPositionTransfer) error); ok {
+		r1 = rf(ctx, transfer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProcessSetIsolatedMargin provides a mock function with given fields: ctx, msg
+func (_m *SendingKeeper) ProcessSetIsolatedMargin(ctx cosmos_sdktypes.Context, msg *types.MsgSetIsolatedMargin) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(cosmos_sdktypes.Context, *types.MsgSetIsolatedMargin) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ProcessTransfer provides a mock function with given fields: ctx, transfer
 func (_m *SendingKeeper) ProcessTransfer(ctx cosmos_sdktypes.Context, transfer *types.Transfer) error {
 	ret := _m.Called(ctx, transfer)
@@ -154,6 +154,20 @@ func (_m *SubaccountsKeeper) GetSubaccount(ctx types.Context, id subaccountstype
 	return r0
 }
 
+// SetIsolatedMargin provides a mock function with given fields: ctx, id, isolatedMargin
+func (_m *SubaccountsKeeper) SetIsolatedMargin(ctx types.Context, id subaccountstypes.SubaccountId, isolatedMargin *subaccountstypes.IsolatedMargin) error {
+	ret := _m.Called(ctx, id, isolatedMargin)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, *subaccountstypes.IsolatedMargin) error); ok {
+		r0 = rf(ctx, id, isolatedMargin)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetSubaccount provides a mock function with given fields: ctx, subaccount
 func (_m *SubaccountsKeeper) SetSubaccount(ctx types.Context, subaccount subaccountstypes.Subaccount) {
 	_m.Called(ctx, subaccount)
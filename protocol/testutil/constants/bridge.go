@@ -1,11 +1,16 @@
 package constants
 
 import (
+	"crypto/ecdsa"
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethcoretypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 )
 
 func init() {
@@ -26,6 +31,11 @@ func init() {
 
 	_ = TestTxBuilder.SetMsgs(MsgAcknowledgeBridges_Ids0_55_Height0)
 	MsgAcknowledgeBridges_Ids0_55_Height0_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
+
+	_ = TestTxBuilder.SetMsgs(MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1)
+	MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(
+		TestTxBuilder.GetTx(),
+	)
 }
 
 var (
@@ -76,6 +86,21 @@ var (
 		Coin:           coin,
 		EthBlockHeight: 15,
 	}
+	// Bridge events from the L2 source chain.
+	BridgeEvent_L2_Id0_Height2 = types.BridgeEvent{
+		Id:             0,
+		Address:        CarlAccAddress.String(),
+		Coin:           coin,
+		EthBlockHeight: 2,
+		EthChainId:     L2EthChainId,
+	}
+	BridgeEvent_L2_Id1_Height5 = types.BridgeEvent{
+		Id:             1,
+		Address:        DaveAccAddress.String(),
+		Coin:           coin,
+		EthBlockHeight: 5,
+		EthChainId:     L2EthChainId,
+	}
 
 	// Acknowledge Bridges Tx.
 	MsgAcknowledgeBridges_NoEvents = &types.MsgAcknowledgeBridges{
@@ -120,6 +145,15 @@ var (
 	}
 	MsgAcknowledgeBridges_Ids0_55_Height0_TxBytes []byte
 
+	MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1 = &types.MsgAcknowledgeBridges{
+		Events: []types.BridgeEvent{
+			BridgeEvent_Id0_Height0,
+			BridgeEvent_L2_Id0_Height2,
+			BridgeEvent_L2_Id1_Height5,
+		},
+	}
+	MsgAcknowledgeBridges_Id0_Height0_L2Ids0_1_TxBytes []byte
+
 	// Event Info.
 	AcknowledgedEventInfo_Id0_Height0 = types.BridgeEventInfo{
 		NextId:         0,
@@ -132,6 +166,8 @@ var (
 
 	// Eth Chain ID.
 	EthChainId = 11155111
+	// Eth Chain ID of an L2 source chain.
+	L2EthChainId uint64 = 42161
 	// Eth Log of Bridge event ID 0 at block height 3872013 that bridges 12345 tokens to address
 	// `dydx1qqgzqvzq2ps8pqys5zcvp58q7rluextx92xhln`.
 	EthLog_Event0 = ethcoretypes.Log{
@@ -235,6 +271,28 @@ var (
 		EthChainId: uint64(EthChainId),
 		EthAddress: AliceAccAddress.String(),
 	}
+	// Source Chain Params of an L2 chain.
+	SourceChainParams_L2 = types.SourceChainParams{
+		EventParams: types.EventParams{
+			Denom:      coin.Denom,
+			EthChainId: L2EthChainId,
+			EthAddress: "0x8F9b2B2B1A5B4E5e5d6E8a0B7A8F0fC7d2c3B1A0",
+		},
+		SafetyParams: types.SafetyParams{
+			IsDisabled:  false,
+			DelayBlocks: 5,
+		},
+	}
+	// Rate Limit Params of the chain of event params. Two bridge events of `coin` fit in the window,
+	// but only one of them to the same address.
+	RateLimitParams = types.RateLimitParams{
+		EthChainId:           0,
+		WindowBlocks:         10,
+		WindowCap:            dtypes.NewInt(2_000),
+		AddressWindowCap:     dtypes.NewInt(1_000),
+		LargeBridgeThreshold: dtypes.NewInt(5_000),
+		ExtendedDelayBlocks:  1_000,
+	}
 	// Propose Params.
 	ProposeParams = types.ProposeParams{
 		MaxBridgesPerBlock:           2,
@@ -242,4 +300,36 @@ var (
 		SkipRatePpm:                  0,
 		SkipIfBlockDelayedByDuration: 1,
 	}
+
+	// Withdrawals.
+	// Event params with an Ethereum bridge contract address, which withdrawal digests are signed for.
+	EventParams_EthBridgeAddress = types.EventParams{
+		Denom:      coin.Denom,
+		EthChainId: uint64(EthChainId),
+		EthAddress: "0xEf01c3A30eB57c91c40C52E996d29c202ae72193",
+	}
+	EthRecipientAddress = "0x52908400098527886E0F7030069857D2E4169EE7"
+	// Ethereum keys that validators sign withdrawals with, derived from the validators' private keys.
+	AliceBridgeSignerPrivateKey  = mustEthPrivateKey(AlicePrivateKey.Bytes())
+	BobBridgeSignerPrivateKey    = mustEthPrivateKey(BobPrivateKey.Bytes())
+	CarlBridgeSignerPrivateKey   = mustEthPrivateKey(CarlPrivateKey.Bytes())
+	AliceBridgeSigner            = ethcrypto.PubkeyToAddress(AliceBridgeSignerPrivateKey.PublicKey).Hex()
+	BobBridgeSigner              = ethcrypto.PubkeyToAddress(BobBridgeSignerPrivateKey.PublicKey).Hex()
+	CarlBridgeSigner             = ethcrypto.PubkeyToAddress(CarlBridgeSignerPrivateKey.PublicKey).Hex()
+	BridgeWithdrawal_Id0_Height1 = types.BridgeWithdrawal{
+		Id:             0,
+		Sender:         AliceAccAddress.String(),
+		EthRecipient:   EthRecipientAddress,
+		Coin:           coin,
+		BlockHeight:    1,
+		ExpirationTime: time.Unix(1_700_086_400, 0).UTC(),
+	}
 )
+
+func mustEthPrivateKey(privateKey []byte) *ecdsa.PrivateKey {
+	ethPrivateKey, err := ethcrypto.ToECDSA(privateKey)
+	if err != nil {
+		panic(err)
+	}
+	return ethPrivateKey
+}
@@ -18,6 +18,19 @@ var (
 		QuantumConversionExponent: -8,
 		Status:                    clobtypes.ClobPair_STATUS_ACTIVE,
 	}
+	ClobPair_Spot_Btc_Usdc = clobtypes.ClobPair{
+		Id: 2,
+		Metadata: &clobtypes.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &clobtypes.SpotClobMetadata{
+				BaseAssetId:  1,
+				QuoteAssetId: 0,
+			},
+		},
+		StepBaseQuantums:          5,
+		SubticksPerTick:           5,
+		QuantumConversionExponent: -8,
+		Status:                    clobtypes.ClobPair_STATUS_ACTIVE,
+	}
 	ClobPair_Btc = clobtypes.ClobPair{
 		Id: 0,
 		Metadata: &clobtypes.ClobPair_PerpetualClobMetadata{
@@ -331,7 +331,8 @@ const GenesisState = `{
           "max_notional_liquidated": 100000000000000,
           "max_quantums_insurance_lost": 100000000000000
         }
-      }
+      },
+      "trading_permissions": []
     },
     "crisis": {
       "constant_fee": {
@@ -483,6 +484,7 @@ const GenesisState = `{
     },
     "feetiers": {
       "params": {
+        "staking_tiers": [],
         "tiers": [
           {
             "name": "1",
@@ -21,6 +21,15 @@ func init() {
 	_ = TestTxBuilder.SetMsgs(Msg_CancelOrder)
 	Msg_CancelOrder_TxBtyes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
 
+	_ = TestTxBuilder.SetMsgs(Msg_BatchPlaceOrders)
+	Msg_BatchPlaceOrders_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
+
+	_ = TestTxBuilder.SetMsgs(Msg_BatchCancel)
+	Msg_BatchCancel_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
+
+	_ = TestTxBuilder.SetMsgs(Msg_ReplaceOrder)
+	Msg_ReplaceOrder_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
+
 	_ = TestTxBuilder.SetMsgs(Msg_Send)
 	Msg_Send_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
 
@@ -60,6 +69,36 @@ var (
 	Msg_PlaceOrder_Conditional = &clobtypes.MsgPlaceOrder{
 		Order: ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
 	}
+	Msg_BatchPlaceOrders = &clobtypes.MsgBatchPlaceOrders{
+		Orders: []clobtypes.Order{
+			Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+			Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+		},
+	}
+	Msg_BatchPlaceOrders_TxBytes []byte
+
+	Msg_BatchCancel = &clobtypes.MsgBatchCancel{
+		SubaccountId: Alice_Num0,
+		ShortTermCancels: []clobtypes.OrderBatch{
+			{
+				ClobPairId: 0,
+				ClientIds:  []uint32{0, 1},
+			},
+		},
+		GoodTilBlock: 10,
+	}
+	Msg_BatchCancel_TxBytes []byte
+
+	Msg_ReplaceOrder = &clobtypes.MsgReplaceOrder{
+		OldOrderId: Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+		NewOrder:   Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+	}
+	Msg_ReplaceOrder_TxBytes  []byte
+	Msg_ReplaceOrder_LongTerm = &clobtypes.MsgReplaceOrder{
+		OldOrderId: LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+		NewOrder:   LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+	}
+
 	Msg_Transfer = &sendingtypes.MsgCreateTransfer{
 		Transfer: &sendingtypes.Transfer{
 			Sender:    Carl_Num0,
@@ -0,0 +1,81 @@
+package pricefeed
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// websocketServerTimeout is the maximum time the fake websocket server waits for a connection or message.
+	websocketServerTimeout = 5 * time.Second
+)
+
+// FakeWebsocketServer is a local websocket server that stands in for an exchange's websocket API in tests of
+// streaming price sources. Each accepted connection is handed to the test through `AcceptConnection`.
+type FakeWebsocketServer struct {
+	server      *httptest.Server
+	connections chan *websocket.Conn
+}
+
+// NewFakeWebsocketServer starts a fake websocket server which is closed when the test completes.
+func NewFakeWebsocketServer(t *testing.T) *FakeWebsocketServer {
+	s := &FakeWebsocketServer{
+		connections: make(chan *websocket.Conn, 16),
+	}
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		s.connections <- conn
+	}))
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// Url returns the websocket url of the server.
+func (s *FakeWebsocketServer) Url() string {
+	return "ws" + strings.TrimPrefix(s.server.URL, "http")
+}
+
+// AcceptConnection waits for the next client connection to the server and returns it. The connection is
+// closed when the test completes.
+func (s *FakeWebsocketServer) AcceptConnection(t *testing.T) *websocket.Conn {
+	select {
+	case conn := <-s.connections:
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	case <-time.After(websocketServerTimeout):
+		require.FailNow(t, "Timed out waiting for websocket connection")
+		return nil
+	}
+}
+
+// RequireNoConnection asserts that no client connects to the server within `duration`.
+func (s *FakeWebsocketServer) RequireNoConnection(t *testing.T, duration time.Duration) {
+	select {
+	case <-s.connections:
+		require.FailNow(t, "Unexpected websocket connection")
+	case <-time.After(duration):
+	}
+}
+
+// ReadMessage reads the next message sent by the client over `conn`.
+func ReadMessage(t *testing.T, conn *websocket.Conn) string {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(websocketServerTimeout)))
+	_, message, err := conn.ReadMessage()
+	require.NoError(t, err)
+	return string(message)
+}
+
+// WriteMessage sends `message` to the client over `conn`.
+func WriteMessage(t *testing.T, conn *websocket.Conn, message string) {
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+}
@@ -76,6 +76,11 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 		&clobtypes.MsgProposedOperations{},
 		&clobtypes.MsgPlaceOrder{},
 		&clobtypes.MsgCancelOrder{},
+		&clobtypes.MsgBatchPlaceOrders{},
+		&clobtypes.MsgBatchCancel{},
+		&clobtypes.MsgReplaceOrder{},
+		&clobtypes.MsgGrantTradingPermission{},
+		&clobtypes.MsgRevokeTradingPermission{},
 
 		// Perpetuals.
 		&perpetualtypes.MsgAddPremiumVotes{},
@@ -87,6 +92,8 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 		&sendingtypes.MsgCreateTransfer{},
 		&sendingtypes.MsgDepositToSubaccount{},
 		&sendingtypes.MsgWithdrawFromSubaccount{},
+		&sendingtypes.MsgTransferPerpetualPosition{},
+		&sendingtypes.MsgSetIsolatedMargin{},
 	}
 
 	for _, msg := range msgInterfacesToRegister {
@@ -5,6 +5,7 @@ import (
 	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricetypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -17,4 +18,5 @@ type QueryClient interface {
 	liquidationtypes.LiquidationServiceClient
 	pricefeedtypes.PriceFeedServiceClient
 	pricetypes.QueryClient
+	perptypes.QueryClient
 }
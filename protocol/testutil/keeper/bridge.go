@@ -27,6 +27,7 @@ func BridgeKeepers(
 	bridgeEventManager *bridgeserver_types.BridgeEventManager,
 	bankKeeper *bankkeeper.BaseKeeper,
 	mockDelayMsgKeeper *mocks.DelayMsgKeeper,
+	mockStakingKeeper *mocks.StakingKeeper,
 ) {
 	ctx = initKeepers(t, func(
 		db *tmdb.MemDB,
@@ -38,12 +39,12 @@ func BridgeKeepers(
 		// Define necessary keepers here for unit tests
 		accountKeeper, _ := createAccountKeeper(stateStore, db, cdc, registry)
 		bankKeeper, _ = createBankKeeper(stateStore, db, cdc, accountKeeper)
-		keeper, storeKey, mockTimeProvider, bridgeEventManager, mockDelayMsgKeeper =
+		keeper, storeKey, mockTimeProvider, bridgeEventManager, mockDelayMsgKeeper, mockStakingKeeper =
 			createBridgeKeeper(stateStore, db, cdc, transientStoreKey, bankKeeper)
 		return []GenesisInitializer{keeper}
 	})
 
-	return ctx, keeper, storeKey, mockTimeProvider, bridgeEventManager, bankKeeper, mockDelayMsgKeeper
+	return ctx, keeper, storeKey, mockTimeProvider, bridgeEventManager, bankKeeper, mockDelayMsgKeeper, mockStakingKeeper
 }
 
 func createBridgeKeeper(
@@ -58,6 +59,7 @@ func createBridgeKeeper(
 	*mocks.TimeProvider,
 	*bridgeserver_types.BridgeEventManager,
 	*mocks.DelayMsgKeeper,
+	*mocks.StakingKeeper,
 ) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
@@ -66,6 +68,7 @@ func createBridgeKeeper(
 	bridgeEventManager := bridgeserver_types.NewBridgeEventManager(mockTimeProvider)
 
 	mockDelayMsgKeeper := &mocks.DelayMsgKeeper{}
+	mockStakingKeeper := &mocks.StakingKeeper{}
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -73,11 +76,12 @@ func createBridgeKeeper(
 		bridgeEventManager,
 		bankKeeper,
 		mockDelayMsgKeeper,
+		mockStakingKeeper,
 		[]string{
 			lib.GovModuleAddress.String(),
 			delaymsgtypes.ModuleAddress.String(),
 		},
 	)
 
-	return k, storeKey, mockTimeProvider, bridgeEventManager, mockDelayMsgKeeper
+	return k, storeKey, mockTimeProvider, bridgeEventManager, mockDelayMsgKeeper, mockStakingKeeper
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/rate_limit"
 
+	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/liquidations"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 
@@ -110,6 +111,9 @@ func NewClobKeepersTestContextWithUninitializedMemStore(
 		ks.FeeTiersKeeper, _ = createFeeTiersKeeper(
 			stateStore,
 			ks.StatsKeeper,
+			ks.AssetsKeeper,
+			bankKeeper,
+			&mocks.FeeTiersStakingKeeper{},
 			db,
 			cdc,
 		)
@@ -119,6 +123,7 @@ func NewClobKeepersTestContextWithUninitializedMemStore(
 			bankKeeper,
 			ks.FeeTiersKeeper,
 			ks.PricesKeeper,
+			epochsKeeper,
 			db,
 			cdc,
 		)
@@ -148,6 +153,7 @@ func NewClobKeepersTestContextWithUninitializedMemStore(
 			indexerEventManager,
 			indexerEventsTransientStoreKey,
 		)
+		ks.SubaccountsKeeper.SetClobKeeper(ks.ClobKeeper)
 		ks.Cdc = cdc
 
 		return []GenesisInitializer{
@@ -211,6 +217,7 @@ func createClobKeeper(
 		statsKeeper,
 		rewardsKeeper,
 		indexerEventManager,
+		liquidationtypes.NewLiquidatableSubaccountIds(),
 		constants.TestEncodingCfg.TxConfig.TxDecoder(),
 		flags.GetDefaultClobFlags(),
 		rate_limit.NewNoOpRateLimiter[*types.MsgPlaceOrder](),
@@ -17,6 +17,9 @@ import (
 func createFeeTiersKeeper(
 	stateStore storetypes.CommitMultiStore,
 	statsKeeper *statskeeper.Keeper,
+	assetsKeeper types.AssetsKeeper,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
 	db *tmdb.MemDB,
 	cdc *codec.ProtoCodec,
 ) (*keeper.Keeper, storetypes.StoreKey) {
@@ -33,6 +36,9 @@ func createFeeTiersKeeper(
 	k := keeper.NewKeeper(
 		cdc,
 		statsKeeper,
+		assetsKeeper,
+		bankKeeper,
+		stakingKeeper,
 		storeKey,
 		authorities,
 	)
@@ -13,6 +13,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	assetskeeper "github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	delaymsgtypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
+	epochskeeper "github.com/dydxprotocol/v4-chain/protocol/x/epochs/keeper"
 	feetierskeeper "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/keeper"
 	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
 	rewardskeeper "github.com/dydxprotocol/v4-chain/protocol/x/rewards/keeper"
@@ -58,6 +59,9 @@ func RewardsKeepers(
 		feetiersKeeper, _ = createFeeTiersKeeper(
 			stateStore,
 			statsKeeper,
+			assetsKeeper,
+			bankKeeper,
+			&mocks.FeeTiersStakingKeeper{},
 			db,
 			cdc,
 		)
@@ -67,6 +71,7 @@ func RewardsKeepers(
 			bankKeeper,
 			feetiersKeeper,
 			pricesKeeper,
+			epochsKeeper,
 			db,
 			cdc,
 		)
@@ -87,6 +92,7 @@ func createRewardsKeeper(
 	bankKeeper bankkeeper.Keeper,
 	feeTiersKeeper *feetierskeeper.Keeper,
 	pricesKeeper *priceskeeper.Keeper,
+	epochsKeeper *epochskeeper.Keeper,
 	db *tmdb.MemDB,
 	cdc *codec.ProtoCodec,
 ) (*rewardskeeper.Keeper, storetypes.StoreKey) {
@@ -111,6 +117,7 @@ func createRewardsKeeper(
 		bankKeeper,
 		feeTiersKeeper,
 		pricesKeeper,
+		epochsKeeper,
 		authorities,
 	)
 
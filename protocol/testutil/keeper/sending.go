@@ -33,6 +33,7 @@ type SendingKeepersTestContext struct {
 	PerpetualsKeeper  *perpkeeper.Keeper
 	AssetsKeeper      *assetskeeper.Keeper
 	SubaccountsKeeper types.SubaccountsKeeper
+	ClobKeeper        *mocks.SendingClobKeeper
 	StoreKey          storetypes.StoreKey
 }
 
@@ -88,6 +89,7 @@ func SendingKeepersWithSubaccountsKeeper(t testing.TB, saKeeper types.Subaccount
 		} else {
 			ks.SubaccountsKeeper = saKeeper
 		}
+		ks.ClobKeeper = &mocks.SendingClobKeeper{}
 		ks.SendingKeeper, ks.StoreKey = createSendingKeeper(
 			stateStore,
 			db,
@@ -95,6 +97,8 @@ func SendingKeepersWithSubaccountsKeeper(t testing.TB, saKeeper types.Subaccount
 			ks.AccountKeeper,
 			ks.BankKeeper,
 			ks.SubaccountsKeeper,
+			ks.PerpetualsKeeper,
+			ks.ClobKeeper,
 			transientStoreKey,
 		)
 
@@ -114,6 +118,8 @@ func createSendingKeeper(
 	accKeeper *authkeeper.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	saKeeper types.SubaccountsKeeper,
+	perpKeeper types.PerpetualsKeeper,
+	clobKeeper types.SendingClobKeeper,
 	transientStoreKey storetypes.StoreKey,
 ) (*keeper.Keeper, storetypes.StoreKey) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
@@ -129,6 +135,8 @@ func createSendingKeeper(
 		accKeeper,
 		bankKeeper,
 		saKeeper,
+		perpKeeper,
+		clobKeeper,
 		mockIndexerEventsManager,
 		[]string{
 			delaymsgtypes.ModuleAddress.String(),
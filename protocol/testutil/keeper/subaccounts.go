@@ -25,6 +25,7 @@ import (
 	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
 )
 
 func SubaccountsKeepers(
@@ -102,8 +103,14 @@ func createSubaccountsKeeper(
 		bk,
 		pk,
 		mockIndexerEventsManager,
+		nil,
 	)
 
+	// By default, no asset is the base asset of a spot CLOB pair.
+	mockClobKeeper := &mocks.SubaccountsClobKeeper{}
+	mockClobKeeper.On("IsSpotClobPairBaseAsset", mock.Anything, mock.Anything).Return(false)
+	k.SetClobKeeper(mockClobKeeper)
+
 	return k, storeKey
 }
 
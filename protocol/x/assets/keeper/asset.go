@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
 func (k Keeper) CreateAsset(
@@ -182,6 +183,33 @@ func (k Keeper) GetAllAssets(
 	return list
 }
 
+// GetAssetAndMarketPrice returns the asset and the price of its associated market.
+// Returns an error if the asset does not exist, has no market, or its market price does not exist.
+func (k Keeper) GetAssetAndMarketPrice(
+	ctx sdk.Context,
+	id uint32,
+) (types.Asset, pricestypes.MarketPrice, error) {
+	asset, exists := k.GetAsset(ctx, id)
+	if !exists {
+		return asset, pricestypes.MarketPrice{}, errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
+	}
+
+	if !asset.HasMarket {
+		return asset, pricestypes.MarketPrice{}, errorsmod.Wrapf(
+			types.ErrAssetHasNoMarket,
+			"asset ID %d",
+			id,
+		)
+	}
+
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+	if err != nil {
+		return asset, marketPrice, err
+	}
+
+	return asset, marketPrice, nil
+}
+
 // GetNetCollateral returns the net collateral that a given position (quantums)
 // for a given assetId contributes to an account.
 func (k Keeper) GetNetCollateral(
@@ -208,9 +236,10 @@ func (k Keeper) GetNetCollateral(
 	}
 
 	// Balance is positive.
+	// Non-USDC balances are held for spot trading and do not contribute to net collateral.
 	// TODO(DEC-581): add multi-collateral support.
 	if bigQuantums.Sign() == 1 {
-		return big.NewInt(0), types.ErrNotImplementedMulticollateral
+		return big.NewInt(0), nil
 	}
 
 	// Balance is negative.
@@ -331,12 +331,13 @@ func TestGetNetCollateral(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, new(big.Int).SetInt64(100), netCollateral)
 
-	_, err = keeper.GetNetCollateral(
+	netCollateral, err = keeper.GetNetCollateral(
 		ctx,
 		uint32(1),
 		new(big.Int).SetInt64(100),
 	)
-	require.EqualError(t, types.ErrNotImplementedMulticollateral, err.Error())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int), netCollateral)
 
 	_, err = keeper.GetNetCollateral(
 		ctx,
@@ -346,6 +347,25 @@ func TestGetNetCollateral(t *testing.T) {
 	require.EqualError(t, types.ErrNotImplementedMargin, err.Error())
 }
 
+func TestGetAssetAndMarketPrice(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	items, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
+	require.NoError(t, err)
+
+	asset, marketPrice, err := keeper.GetAssetAndMarketPrice(ctx, items[0].Id)
+	require.NoError(t, err)
+	require.Equal(t, items[0], asset)
+	expectedMarketPrice, err := pricesKeeper.GetMarketPrice(ctx, items[0].MarketId)
+	require.NoError(t, err)
+	require.Equal(t, expectedMarketPrice, marketPrice)
+
+	_, _, err = keeper.GetAssetAndMarketPrice(ctx, items[1].Id)
+	require.ErrorIs(t, err, types.ErrAssetHasNoMarket)
+
+	_, _, err = keeper.GetAssetAndMarketPrice(ctx, uint32(100))
+	require.ErrorIs(t, err, types.ErrAssetDoesNotExist)
+}
+
 func TestGetMarginRequirements(t *testing.T) {
 	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
 	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
@@ -18,6 +18,7 @@ var (
 	ErrInvalidDenomExponent         = errorsmod.Register(ModuleName, 11, "Invalid denom exponent")
 	ErrAssetAlreadyExists           = errorsmod.Register(ModuleName, 12, "Asset already exists")
 	ErrUnexpectedUsdcDenomExponent  = errorsmod.Register(ModuleName, 13, "USDC denom exponent is unexpected")
+	ErrAssetHasNoMarket             = errorsmod.Register(ModuleName, 14, "Asset has no market")
 
 	// Errors for Not Implemented
 	ErrNotImplementedMulticollateral = errorsmod.Register(ModuleName, 401, "Not Implemented: Multi-Collateral")
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
@@ -24,9 +25,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryEventParams())
 	cmd.AddCommand(CmdQueryProposeParams())
 	cmd.AddCommand(CmdQuerySafetyParams())
+	cmd.AddCommand(CmdQuerySourceChainParams())
+	cmd.AddCommand(CmdQueryRateLimitParams())
+	cmd.AddCommand(CmdQueryWindowUtilization())
 	cmd.AddCommand(CmdQueryAcknowledgedEventInfo())
 	cmd.AddCommand(CmdQueryRecognizedEventInfo())
 	cmd.AddCommand(CmdQueryDelayedCompleteBridgeMessages())
+	cmd.AddCommand(CmdQueryWithdrawal())
+	cmd.AddCommand(CmdQueryPendingWithdrawals())
+	cmd.AddCommand(CmdQueryFinalizedWithdrawals())
+	cmd.AddCommand(CmdQueryBridgeSigner())
 
 	return cmd
 }
@@ -100,16 +108,110 @@ func CmdQuerySafetyParams() *cobra.Command {
 	return cmd
 }
 
+func CmdQuerySourceChainParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-source-chain-params",
+		Short: "list the SourceChainParams of all additional source chains",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SourceChainParams(
+				context.Background(),
+				&types.QuerySourceChainParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRateLimitParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-rate-limit-params",
+		Short: "list the RateLimitParams of all source chains",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RateLimitParams(
+				context.Background(),
+				&types.QueryRateLimitParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryWindowUtilization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-window-utilization [eth_chain_id] [address]",
+		Short: "get the amounts bridged from a source chain (and to an address, if given) within the current window",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ethChainId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			address := ""
+			if len(args) > 1 {
+				address = args[1]
+			}
+
+			res, err := queryClient.WindowUtilization(
+				context.Background(),
+				&types.QueryWindowUtilizationRequest{
+					EthChainId: ethChainId,
+					Address:    address,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQueryAcknowledgedEventInfo() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get-acknowledged-event-info",
-		Short: "get the AcknowledgedEventInfo",
+		Use:   "get-acknowledged-event-info [eth_chain_id]",
+		Short: "get the AcknowledgedEventInfo of a source chain, or of the chain of EventParams if not given",
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
+
+			ethChainId := uint64(0)
+			if len(args) > 0 {
+				if ethChainId, err = cast.ToUint64E(args[0]); err != nil {
+					return err
+				}
+			}
+
 			res, err := queryClient.AcknowledgedEventInfo(
 				context.Background(),
-				&types.QueryAcknowledgedEventInfoRequest{},
+				&types.QueryAcknowledgedEventInfoRequest{
+					EthChainId: ethChainId,
+				},
 			)
 			if err != nil {
 				return err
@@ -125,14 +227,25 @@ func CmdQueryAcknowledgedEventInfo() *cobra.Command {
 
 func CmdQueryRecognizedEventInfo() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get-recognized-event-info",
-		Short: "get the RecognizedEventInfo",
+		Use:   "get-recognized-event-info [eth_chain_id]",
+		Short: "get the RecognizedEventInfo of a source chain, or of the chain of EventParams if not given",
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
+
+			ethChainId := uint64(0)
+			if len(args) > 0 {
+				if ethChainId, err = cast.ToUint64E(args[0]); err != nil {
+					return err
+				}
+			}
+
 			res, err := queryClient.RecognizedEventInfo(
 				context.Background(),
-				&types.QueryRecognizedEventInfoRequest{},
+				&types.QueryRecognizedEventInfoRequest{
+					EthChainId: ethChainId,
+				},
 			)
 			if err != nil {
 				return err
@@ -177,3 +290,125 @@ func CmdQueryDelayedCompleteBridgeMessages() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-withdrawal [id]",
+		Short: "get a pending or finalized withdrawal by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Withdrawal(
+				context.Background(),
+				&types.QueryWithdrawalRequest{
+					Id: id,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPendingWithdrawals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-withdrawals",
+		Short: "list withdrawals that are not yet finalized",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingWithdrawals(
+				context.Background(),
+				&types.QueryPendingWithdrawalsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFinalizedWithdrawals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-finalized-withdrawals",
+		Short: "list finalized withdrawals",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalizedWithdrawals(
+				context.Background(),
+				&types.QueryFinalizedWithdrawalsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBridgeSigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-bridge-signer [validator_address]",
+		Short: "get the Ethereum address that a validator signs withdrawals with",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BridgeSigner(
+				context.Background(),
+				&types.QueryBridgeSignerRequest{
+					ValidatorAddress: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -86,6 +86,17 @@ func TestQueryAcknowledgedEventInfo(t *testing.T) {
 	require.Equal(t, types.DefaultGenesis().AcknowledgedEventInfo, resp.Info)
 }
 
+func TestQuerySourceChainParams(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQuerySourceChainParams(), []string{})
+
+	require.NoError(t, err)
+	var resp types.QuerySourceChainParamsResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, []types.SourceChainParams{}, resp.Params)
+}
+
 func TestQueryDelayedCompleteBridgeMessages(t *testing.T) {
 	net, ctx := setupNetwork(t)
 
@@ -23,5 +23,10 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
+
+	cmd.AddCommand(CmdBridgeOut())
+	cmd.AddCommand(CmdSetBridgeSigner())
+	cmd.AddCommand(CmdAttestBridgeOut())
+
 	return cmd
 }
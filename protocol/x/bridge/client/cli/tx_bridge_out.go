@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// CmdBridgeOut withdraws coins from an account to an Ethereum address.
+func CmdBridgeOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-out [sender_key_or_address] [eth_recipient] [coin]",
+		Short: "Withdraw bridged coins from an account to an Ethereum address.",
+		Long: `Withdraw bridged coins from an account to an Ethereum address.
+Note, the '--from' flag is ignored as it is implied from [sender_key_or_address].
+[coin] is escrowed in the bridge module account until the withdrawal is executed on Ethereum.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Sender address validation done in `ValidateBasic()` below.
+			err = cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			argEthRecipient := args[1]
+			argCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBridgeOut(clientCtx.GetFromAddress().String(), argEthRecipient, argCoin)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSetBridgeSigner sets the Ethereum address that a validator signs withdrawals with.
+func CmdSetBridgeSigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bridge-signer [validator_key_or_address] [eth_signer]",
+		Short: "Set the Ethereum address that a validator signs withdrawals with.",
+		Long: `Set the Ethereum address that a validator signs withdrawals with.
+Note, the '--from' flag is ignored as it is implied from [validator_key_or_address], which must be the
+account of the validator operator.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Validator address validation done in `ValidateBasic()` below.
+			err = cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBridgeSigner(clientCtx.GetFromAddress().String(), args[1])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAttestBridgeOut attests to a pending withdrawal with a validator's signature of the withdrawal digest.
+func CmdAttestBridgeOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-bridge-out [validator_key_or_address] [withdrawal_id] [signature]",
+		Short: "Attest to a pending withdrawal with a signature of its digest.",
+		Long: `Attest to a pending withdrawal with a signature of its digest.
+Note, the '--from' flag is ignored as it is implied from [validator_key_or_address], which must be the
+account of the validator operator.
+[signature] is the 0x-prefixed hex-encoded [R || S || V] signature by the validator's bridge signer.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Validator address validation done in `ValidateBasic()` below.
+			err = cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			argWithdrawalId, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argSignature, err := hexutil.Decode(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAttestBridgeOut(clientCtx.GetFromAddress().String(), argWithdrawalId, argSignature)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -22,14 +22,57 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetAcknowledgedEventInfo(ctx, genState.AcknowledgedEventInfo); err != nil {
 		panic(err)
 	}
+	for _, sourceChain := range genState.SourceChains {
+		if err := k.UpdateSourceChainParams(ctx, sourceChain.Params); err != nil {
+			panic(err)
+		}
+		if err := k.SetSourceChainAcknowledgedEventInfo(
+			ctx,
+			sourceChain.Params.EventParams.EthChainId,
+			sourceChain.AcknowledgedEventInfo,
+		); err != nil {
+			panic(err)
+		}
+	}
+	for _, params := range genState.RateLimitParams {
+		if err := k.UpdateRateLimitParams(ctx, params); err != nil {
+			panic(err)
+		}
+	}
+	k.InitializeWithdrawals(ctx, genState.NextWithdrawalId, genState.Withdrawals)
+	for _, bridgeSigner := range genState.BridgeSigners {
+		validator, err := sdk.ValAddressFromBech32(bridgeSigner.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetBridgeSigner(ctx, validator, bridgeSigner.EthSigner)
+	}
+	for _, windowBucket := range genState.WindowBuckets {
+		k.SetWindowBucket(ctx, windowBucket)
+	}
 }
 
 // ExportGenesis returns the bridge module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	allSourceChainParams := k.GetAllSourceChainParams(ctx)
+	sourceChains := make([]types.SourceChain, len(allSourceChainParams))
+	for i, params := range allSourceChainParams {
+		sourceChains[i] = types.SourceChain{
+			Params:                params,
+			AcknowledgedEventInfo: k.GetSourceChainAcknowledgedEventInfo(ctx, params.EventParams.EthChainId),
+		}
+	}
+
 	return &types.GenesisState{
 		EventParams:           k.GetEventParams(ctx),
 		ProposeParams:         k.GetProposeParams(ctx),
 		SafetyParams:          k.GetSafetyParams(ctx),
 		AcknowledgedEventInfo: k.GetAcknowledgedEventInfo(ctx),
+		SourceChains:          sourceChains,
+		RateLimitParams:       k.GetAllRateLimitParams(ctx),
+		NextWithdrawalId:      k.GetNextWithdrawalId(ctx),
+		Withdrawals:           k.GetAllWithdrawals(ctx),
+		BridgeSigners:         k.GetAllBridgeSigners(ctx),
+		WindowBuckets:         k.GetAllWindowBuckets(ctx),
 	}
 }
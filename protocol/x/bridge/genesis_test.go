@@ -1,9 +1,13 @@
 package bridge_test
 
 import (
+	"math/big"
 	"testing"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
@@ -16,3 +20,154 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 	require.Equal(t, types.DefaultGenesis(), got)
 }
+
+func TestGenesis_SourceChains(t *testing.T) {
+	sourceChains := []types.SourceChain{
+		{
+			Params: constants.SourceChainParams_L2,
+			AcknowledgedEventInfo: types.BridgeEventInfo{
+				NextId:         5,
+				EthBlockHeight: 100,
+			},
+		},
+	}
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis cmttypes.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *types.GenesisState) {
+				genesisState.SourceChains = sourceChains
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+
+	got := bridge.ExportGenesis(ctx, tApp.App.BridgeKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, sourceChains, got.SourceChains)
+	require.Equal(
+		t,
+		sourceChains[0].AcknowledgedEventInfo,
+		tApp.App.BridgeKeeper.GetSourceChainAcknowledgedEventInfo(ctx, constants.L2EthChainId),
+	)
+}
+
+func TestGenesis_RateLimitParams(t *testing.T) {
+	rateLimitParams := []types.RateLimitParams{constants.RateLimitParams}
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis cmttypes.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *types.GenesisState) {
+				genesisState.RateLimitParams = rateLimitParams
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+
+	got := bridge.ExportGenesis(ctx, tApp.App.BridgeKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, rateLimitParams, got.RateLimitParams)
+}
+
+func TestGenesis_WindowBuckets(t *testing.T) {
+	rateLimitParams := []types.RateLimitParams{
+		constants.RateLimitParams,
+		{EthChainId: constants.L2EthChainId, WindowBlocks: 10},
+	}
+	windowBuckets := []types.WindowBucket{
+		{
+			EthChainId:  0,
+			BlockHeight: 1,
+			Bucket: types.BridgeWindowBucket{
+				Entries: []types.BridgeWindowEntry{
+					{Address: constants.AliceAccAddress.String(), Amount: dtypes.NewInt(100)},
+					{Address: constants.BobAccAddress.String(), Amount: dtypes.NewInt(200)},
+				},
+			},
+		},
+		{
+			EthChainId:  constants.L2EthChainId,
+			BlockHeight: 1,
+			Bucket: types.BridgeWindowBucket{
+				Entries: []types.BridgeWindowEntry{
+					{Address: constants.AliceAccAddress.String(), Amount: dtypes.NewInt(300)},
+				},
+			},
+		},
+	}
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis cmttypes.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *types.GenesisState) {
+				genesisState.SourceChains = []types.SourceChain{{Params: constants.SourceChainParams_L2}}
+				genesisState.RateLimitParams = rateLimitParams
+				genesisState.WindowBuckets = windowBuckets
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+
+	// Amounts bridged before genesis count towards the rate limits.
+	totalAmount, addressAmount := tApp.App.BridgeKeeper.GetWindowUtilization(
+		ctx,
+		0,
+		constants.AliceAccAddress.String(),
+	)
+	require.Equal(t, big.NewInt(300), totalAmount)
+	require.Equal(t, big.NewInt(100), addressAmount)
+
+	got := bridge.ExportGenesis(ctx, tApp.App.BridgeKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, windowBuckets, got.WindowBuckets)
+}
+
+func TestGenesis_Withdrawals(t *testing.T) {
+	finalizedWithdrawal := constants.BridgeWithdrawal_Id0_Height1
+	finalizedWithdrawal.Id = 3
+	finalizedWithdrawal.FinalizedBlockHeight = 2
+	finalizedWithdrawal.Attestations = []types.BridgeWithdrawalAttestation{
+		{
+			ValidatorAddress: constants.AliceValAddress.String(),
+			EthSigner:        constants.AliceBridgeSigner,
+			Signature:        make([]byte, types.EthSignatureLength),
+			Power:            5,
+		},
+	}
+	withdrawals := []types.BridgeWithdrawal{constants.BridgeWithdrawal_Id0_Height1, finalizedWithdrawal}
+	bridgeSigners := []types.BridgeSigner{
+		{ValidatorAddress: constants.AliceValAddress.String(), EthSigner: constants.AliceBridgeSigner},
+		{ValidatorAddress: constants.BobValAddress.String(), EthSigner: constants.BobBridgeSigner},
+	}
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis cmttypes.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *types.GenesisState) {
+				genesisState.NextWithdrawalId = 5
+				genesisState.Withdrawals = withdrawals
+				genesisState.BridgeSigners = bridgeSigners
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+
+	bridgeKeeper := tApp.App.BridgeKeeper
+	withdrawal, found := bridgeKeeper.GetWithdrawal(ctx, finalizedWithdrawal.Id)
+	require.True(t, found)
+	require.True(t, withdrawal.IsFinalized())
+	bridgeSigner, found := bridgeKeeper.GetBridgeSigner(ctx, constants.BobValAddress)
+	require.True(t, found)
+	require.Equal(t, bridgeSigners[1], bridgeSigner)
+
+	got := bridge.ExportGenesis(ctx, bridgeKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, uint32(5), got.NextWithdrawalId)
+	require.Equal(t, withdrawals, got.Withdrawals)
+	require.ElementsMatch(t, bridgeSigners, got.BridgeSigners)
+}
@@ -4,6 +4,8 @@ import (
 	"math/rand"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	gometrics "github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
@@ -13,18 +15,13 @@ import (
 )
 
 // GetAcknowledgeBridges returns a `MsgAcknowledgeBridges` for recognized but not-yet-acknowledged
-// bridge events, up to a maximum number of `ProposeParams.MaxBridgesPerBlock`.
+// bridge events, up to a maximum number of `ProposeParams.MaxBridgesPerBlock` per source chain.
+// Events of the chain of `EventParams` come first, followed by events of additional source chains
+// sorted by Ethereum chain ID.
 func (k Keeper) GetAcknowledgeBridges(
 	ctx sdk.Context,
 	blockTimestamp time.Time,
 ) (msg *types.MsgAcknowledgeBridges) {
-	// Do not propose bridge events if bridging is disabled.
-	if k.GetSafetyParams(ctx).IsDisabled {
-		return &types.MsgAcknowledgeBridges{
-			Events: []types.BridgeEvent{},
-		}
-	}
-
 	wallClock := k.bridgeEventManager.GetNow()
 	proposeParams := k.GetProposeParams(ctx)
 
@@ -46,26 +43,35 @@ func (k Keeper) GetAcknowledgeBridges(
 		metrics.GetAcknowledgeBridges,
 		metrics.Latency,
 	)
-	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx)
 	recognizedCutoffTime := wallClock.Add(-proposeParams.ProposeDelayDuration)
 	events := make([]types.BridgeEvent, 0)
-	for i := uint32(0); i < proposeParams.MaxBridgesPerBlock; i++ {
-		// 1. Try to retrieve recognized event with id `NextId + i` from BridgeEventManager.
-		eventToAcknowledge, eventRecognizedAt, found := k.bridgeEventManager.GetBridgeEventById(
-			acknowledgedEventInfo.NextId + i)
-		// Stop looking for events with higher IDs if event with current ID is not found.
-		// This assumes that recognized events are assigned IDs that increment by 1 each time.
-		if !found {
-			break
+	for _, ethChainId := range k.GetSourceChainIds(ctx) {
+		// Do not propose bridge events of a source chain if bridging from it is disabled.
+		if params, _ := k.GetSourceChainParams(ctx, ethChainId); params.SafetyParams.IsDisabled {
+			continue
 		}
 
-		// 2. Append the new event if it is recognized before the cutoff time.
-		if eventRecognizedAt.Before(recognizedCutoffTime) {
-			events = append(events, eventToAcknowledge)
-		} else {
-			// Stop looking for events with higher IDs if event with current ID is not old enough.
-			// This assumes that events with lower IDs are recognized before events with higher IDs.
-			break
+		acknowledgedEventInfo := k.GetSourceChainAcknowledgedEventInfo(ctx, ethChainId)
+		for i := uint32(0); i < proposeParams.MaxBridgesPerBlock; i++ {
+			// 1. Try to retrieve recognized event with id `NextId + i` from BridgeEventManager.
+			eventToAcknowledge, eventRecognizedAt, found := k.bridgeEventManager.GetBridgeEventById(
+				ethChainId,
+				acknowledgedEventInfo.NextId+i,
+			)
+			// Stop looking for events with higher IDs if event with current ID is not found.
+			// This assumes that recognized events are assigned IDs that increment by 1 each time.
+			if !found {
+				break
+			}
+
+			// 2. Append the new event if it is recognized before the cutoff time.
+			if eventRecognizedAt.Before(recognizedCutoffTime) {
+				events = append(events, eventToAcknowledge)
+			} else {
+				// Stop looking for events with higher IDs if event with current ID is not old enough.
+				// This assumes that events with lower IDs are recognized before events with higher IDs.
+				break
+			}
 		}
 	}
 
@@ -75,9 +81,10 @@ func (k Keeper) GetAcknowledgeBridges(
 }
 
 // AcknowledgeBridges acknowledges a list of bridge events and returns an error if any of following
-// - bridging is disabled.
+// - the source chain of any bridge event does not exist.
+// - bridging is disabled for the source chain of any bridge event.
 // - fails to delay a `MsgCompleteBridge` for any bridge event.
-// - fails to update `AcknowledgedEventInfo` in state.
+// - fails to update `AcknowledgedEventInfo` of any source chain in state.
 func (k Keeper) AcknowledgeBridges(
 	ctx sdk.Context,
 	bridgeEvents []types.BridgeEvent,
@@ -85,10 +92,28 @@ func (k Keeper) AcknowledgeBridges(
 	if len(bridgeEvents) == 0 {
 		return nil
 	}
-	safetyParams := k.GetSafetyParams(ctx)
-	if safetyParams.IsDisabled {
-		// Do not acknowledge bridges if bridging is disabled.
-		return types.ErrBridgingDisabled
+	sourceChainParams := make(map[uint64]types.SourceChainParams)
+	ethChainIds := make([]uint64, 0)
+	for _, bridgeEvent := range bridgeEvents {
+		if _, ok := sourceChainParams[bridgeEvent.EthChainId]; ok {
+			continue
+		}
+		params, found := k.GetSourceChainParams(ctx, bridgeEvent.EthChainId)
+		if !found {
+			return errorsmod.Wrapf(types.ErrSourceChainNotFound, "Ethereum chain ID %d", bridgeEvent.EthChainId)
+		}
+		if params.SafetyParams.IsDisabled {
+			// Do not acknowledge bridges if bridging from the source chain is disabled.
+			return types.ErrBridgingDisabled
+		}
+		sourceChainParams[bridgeEvent.EthChainId] = params
+		ethChainIds = append(ethChainIds, bridgeEvent.EthChainId)
+	}
+	rateLimiters := make(map[uint64]*rateLimiter)
+	for _, ethChainId := range ethChainIds {
+		if r := k.newRateLimiter(ctx, ethChainId); r != nil {
+			rateLimiters[ethChainId] = r
+		}
 	}
 
 	// Measure latency if there are bridge events to acknowledge.
@@ -99,10 +124,28 @@ func (k Keeper) AcknowledgeBridges(
 		metrics.Latency,
 	)
 
-	// For each bridge event, delay a `MsgCompleteBridge` to be executed `safetyParams.DelayBlocks`
-	// blocks in the future. Returns error if fails to delay any of the messages.
+	// For each bridge event, delay a `MsgCompleteBridge` to be executed `SafetyParams.DelayBlocks`
+	// of its source chain blocks in the future, or `RateLimitParams.ExtendedDelayBlocks` if the bridge
+	// event exceeds any of the value-based limits of its source chain. Returns error if fails to delay
+	// any of the messages.
 	delayMsgModuleAccAddrString := delaymsgtypes.ModuleAddress.String()
+	lastBridgeEvents := make(map[uint64]types.BridgeEvent)
 	for _, bridgeEvent := range bridgeEvents {
+		delayBlocks := sourceChainParams[bridgeEvent.EthChainId].SafetyParams.DelayBlocks
+		if r, ok := rateLimiters[bridgeEvent.EthChainId]; ok {
+			if exceedsLimit, reason := k.addToRateLimiter(ctx, r, bridgeEvent); exceedsLimit {
+				delayBlocks = lib.Max(delayBlocks, r.params.ExtendedDelayBlocks)
+				telemetry.IncrCounterWithLabels(
+					[]string{types.ModuleName, metrics.RateLimitedBridges, metrics.Count},
+					1,
+					[]gometrics.Label{
+						metrics.GetLabelForIntValue(metrics.EthChainId, int(bridgeEvent.EthChainId)),
+						metrics.GetLabelForStringValue(metrics.Reason, reason),
+					},
+				)
+			}
+		}
+
 		// delaymsg module should be the authority for completing bridges.
 		msgCompleteBridge := types.MsgCompleteBridge{
 			Authority: delayMsgModuleAccAddrString,
@@ -111,22 +154,32 @@ func (k Keeper) AcknowledgeBridges(
 		_, err := k.delayMsgKeeper.DelayMessageByBlocks(
 			ctx,
 			&msgCompleteBridge,
-			safetyParams.DelayBlocks,
+			delayBlocks,
 		)
 		if err != nil {
 			return err
 		}
+		lastBridgeEvents[bridgeEvent.EthChainId] = bridgeEvent
 	}
 
-	// Update `AcknowledgedEventInfo` in state.
-	// - `NextId` is set to ID of last acknowledged bridge event + 1
-	// - `EthBlockHeight`is set to block height of last acknowledged bridge event
-	lastBridgeEvent := bridgeEvents[len(bridgeEvents)-1]
-	if err = k.SetAcknowledgedEventInfo(ctx, types.BridgeEventInfo{
-		NextId:         lastBridgeEvent.GetId() + 1,
-		EthBlockHeight: lastBridgeEvent.GetEthBlockHeight(),
-	}); err != nil {
-		return err
+	// Record bridged amounts in the rolling window of each rate limited source chain.
+	for _, ethChainId := range ethChainIds {
+		if r, ok := rateLimiters[ethChainId]; ok {
+			k.recordWindowBridges(ctx, r)
+		}
+	}
+
+	// Update `AcknowledgedEventInfo` of each source chain in state.
+	// - `NextId` is set to ID of last acknowledged bridge event of the source chain + 1
+	// - `EthBlockHeight`is set to block height of last acknowledged bridge event of the source chain
+	for _, ethChainId := range ethChainIds {
+		lastBridgeEvent := lastBridgeEvents[ethChainId]
+		if err = k.SetSourceChainAcknowledgedEventInfo(ctx, ethChainId, types.BridgeEventInfo{
+			NextId:         lastBridgeEvent.GetId() + 1,
+			EthBlockHeight: lastBridgeEvent.GetEthBlockHeight(),
+		}); err != nil {
+			return err
+		}
 	}
 
 	return nil
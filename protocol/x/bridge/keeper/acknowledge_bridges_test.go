@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	delaymsgtypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -77,7 +79,7 @@ func TestAcknowledgeBridges(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Initialize context, keeper, and mockDelayMsgKeeper.
-			ctx, bridgeKeeper, _, _, _, _, mockDelayMsgKeeper := keepertest.BridgeKeepers(t)
+			ctx, bridgeKeeper, _, _, _, _, mockDelayMsgKeeper, _ := keepertest.BridgeKeepers(t)
 			err := bridgeKeeper.UpdateSafetyParams(ctx, types.SafetyParams{
 				IsDisabled:  tc.bridgingDisabled,
 				DelayBlocks: bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
@@ -304,7 +306,7 @@ func TestGetAcknowledgeBridges(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Setup keeper, bridgeEventManager, and mockTimeProvider.
-			ctx, bridgeKeeper, _, mockTimeProvider, bridgeEventManager, _, _ := keepertest.BridgeKeepers(t)
+			ctx, bridgeKeeper, _, mockTimeProvider, bridgeEventManager, _, _, _ := keepertest.BridgeKeepers(t)
 			err := bridgeKeeper.SetAcknowledgedEventInfo(ctx, tc.acknowledgedEventInfo)
 			require.NoError(t, err)
 			err = bridgeKeeper.UpdateProposeParams(ctx, tc.proposeParams)
@@ -327,3 +329,259 @@ func TestGetAcknowledgeBridges(t *testing.T) {
 		})
 	}
 }
+
+func TestAcknowledgeBridges_MultipleSourceChains(t *testing.T) {
+	tests := map[string]struct {
+		// Bridge events to acknowledge.
+		bridgeEvents []types.BridgeEvent
+		// Whether the L2 source chain exists.
+		l2Exists bool
+		// Whether bridging from the L2 source chain is disabled.
+		l2Disabled bool
+
+		// Expected AcknowledgedEventInfo of the chain of event params.
+		expectedAEI types.BridgeEventInfo
+		// Expected AcknowledgedEventInfo of the L2 source chain.
+		expectedL2AEI types.BridgeEventInfo
+		// Whether messages are expected to be delayed.
+		expectDelayed bool
+		// Expected error.
+		expectedError error
+	}{
+		"Success: events from both source chains": {
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_L2_Id0_Height2,
+				constants.BridgeEvent_L2_Id1_Height5,
+			},
+			l2Exists: true,
+			expectedAEI: types.BridgeEventInfo{
+				NextId:         1,
+				EthBlockHeight: 0,
+			},
+			expectedL2AEI: types.BridgeEventInfo{
+				NextId:         2,
+				EthBlockHeight: 5,
+			},
+			expectDelayed: true,
+		},
+		"Error: source chain not found": {
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_L2_Id0_Height2,
+			},
+			expectedError: types.ErrSourceChainNotFound,
+		},
+		"Error: bridging disabled for source chain": {
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_L2_Id0_Height2,
+			},
+			l2Exists:      true,
+			l2Disabled:    true,
+			expectedError: types.ErrBridgingDisabled,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, bridgeKeeper, _, _, _, _, mockDelayMsgKeeper, _ := keepertest.BridgeKeepers(t)
+			if tc.l2Exists {
+				l2Params := constants.SourceChainParams_L2
+				l2Params.SafetyParams.IsDisabled = tc.l2Disabled
+				require.NoError(t, bridgeKeeper.UpdateSourceChainParams(ctx, l2Params))
+			}
+			if tc.expectDelayed {
+				for i, bridgeEvent := range tc.bridgeEvents {
+					// Each message is delayed by `DelayBlocks` of its source chain.
+					delayBlocks := bridgeKeeper.GetSafetyParams(ctx).DelayBlocks
+					if bridgeEvent.EthChainId == constants.L2EthChainId {
+						delayBlocks = constants.SourceChainParams_L2.SafetyParams.DelayBlocks
+					}
+					mockDelayMsgKeeper.On(
+						"DelayMessageByBlocks",
+						ctx,
+						&types.MsgCompleteBridge{
+							Authority: delaymsgtypes.ModuleAddress.String(),
+							Event:     bridgeEvent,
+						},
+						delayBlocks,
+					).Return(uint32(i), nil).Once()
+				}
+			}
+
+			err := bridgeKeeper.AcknowledgeBridges(ctx, tc.bridgeEvents)
+
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				mockDelayMsgKeeper.AssertNotCalled(t, "DelayMessageByBlocks")
+			} else {
+				require.NoError(t, err)
+				mockDelayMsgKeeper.AssertExpectations(t)
+			}
+			require.Equal(t, tc.expectedAEI, bridgeKeeper.GetAcknowledgedEventInfo(ctx))
+			require.Equal(
+				t,
+				tc.expectedL2AEI,
+				bridgeKeeper.GetSourceChainAcknowledgedEventInfo(ctx, constants.L2EthChainId),
+			)
+		})
+	}
+}
+
+func TestGetAcknowledgeBridges_MultipleSourceChains(t *testing.T) {
+	timeNow := time.Now()
+	proposeParams := types.ProposeParams{
+		SkipRatePpm:                  0,           // do not skip based on pseudo-randomness.
+		SkipIfBlockDelayedByDuration: time.Second, // do not skip based on time.
+		MaxBridgesPerBlock:           2,           // propose up to 2 events per source chain per block.
+		ProposeDelayDuration:         time.Second, // propose events recognized at least one second ago.
+	}
+
+	tests := map[string]struct {
+		// Whether bridging from the L2 source chain is disabled.
+		l2Disabled bool
+		// AcknowledgedEventInfo of the L2 source chain.
+		l2AcknowledgedEventInfo types.BridgeEventInfo
+
+		// Expectations.
+		expectedMsg *types.MsgAcknowledgeBridges
+	}{
+		"Up to MaxBridgesPerBlock events are proposed per source chain": {
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+					constants.BridgeEvent_Id1_Height0,
+					constants.BridgeEvent_L2_Id0_Height2,
+					constants.BridgeEvent_L2_Id1_Height5,
+				},
+			},
+		},
+		"Already acknowledged events of source chain are not proposed": {
+			l2AcknowledgedEventInfo: types.BridgeEventInfo{
+				NextId:         1,
+				EthBlockHeight: 2,
+			},
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+					constants.BridgeEvent_Id1_Height0,
+					constants.BridgeEvent_L2_Id1_Height5,
+				},
+			},
+		},
+		"No event of source chain is proposed when bridging from it is disabled": {
+			l2Disabled: true,
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+					constants.BridgeEvent_Id1_Height0,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, bridgeKeeper, _, mockTimeProvider, bridgeEventManager, _, _, _ := keepertest.BridgeKeepers(t)
+			require.NoError(t, bridgeKeeper.UpdateProposeParams(ctx, proposeParams))
+			l2Params := constants.SourceChainParams_L2
+			l2Params.SafetyParams.IsDisabled = tc.l2Disabled
+			require.NoError(t, bridgeKeeper.UpdateSourceChainParams(ctx, l2Params))
+			require.NoError(t, bridgeKeeper.SetSourceChainAcknowledgedEventInfo(
+				ctx,
+				constants.L2EthChainId,
+				tc.l2AcknowledgedEventInfo,
+			))
+			mockTimeProvider.On("Now").Return(timeNow.Add(-time.Second * 2)).Once()
+			require.NoError(t, bridgeEventManager.AddBridgeEvents([]types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id1_Height0,
+				constants.BridgeEvent_Id2_Height1, // exceeds MaxBridgesPerBlock.
+				constants.BridgeEvent_L2_Id0_Height2,
+				constants.BridgeEvent_L2_Id1_Height5,
+			}))
+
+			// Get MsgAcknowledgeBridges.
+			mockTimeProvider.On("Now").Return(timeNow).Once()
+			msg := bridgeKeeper.GetAcknowledgeBridges(ctx, timeNow)
+
+			// Assert expected MsgAcknowledgeBridges.
+			require.Equal(t, tc.expectedMsg, msg)
+		})
+	}
+}
+
+func TestAcknowledgeBridges_RateLimits(t *testing.T) {
+	tests := map[string]struct {
+		// Rate limit params of the chain of event params.
+		rateLimitParams types.RateLimitParams
+		// Bridge events to acknowledge.
+		bridgeEvents []types.BridgeEvent
+
+		// Whether each bridge event is expected to be delayed by `ExtendedDelayBlocks`.
+		expectedExtended []bool
+	}{
+		"Within limits": {
+			rateLimitParams: constants.RateLimitParams,
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id1_Height0,
+			},
+			expectedExtended: []bool{false, false},
+		},
+		"Window cap exceeded": {
+			rateLimitParams: constants.RateLimitParams,
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id1_Height0,
+				constants.BridgeEvent_Id3_Height3,
+			},
+			expectedExtended: []bool{false, false, true},
+		},
+		"Address window cap exceeded": {
+			rateLimitParams: constants.RateLimitParams,
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id1_Height0,
+				constants.BridgeEvent_Id2_Height1,
+			},
+			expectedExtended: []bool{false, true},
+		},
+		"Large bridge": {
+			rateLimitParams: types.RateLimitParams{
+				LargeBridgeThreshold: dtypes.NewInt(888),
+				ExtendedDelayBlocks:  constants.RateLimitParams.ExtendedDelayBlocks,
+			},
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id4_Height0_EmptyCoin,
+			},
+			expectedExtended: []bool{true, false},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, bridgeKeeper, _, _, _, _, mockDelayMsgKeeper, _ := keepertest.BridgeKeepers(t)
+			require.NoError(t, bridgeKeeper.UpdateRateLimitParams(ctx, tc.rateLimitParams))
+			for i, bridgeEvent := range tc.bridgeEvents {
+				delayBlocks := bridgeKeeper.GetSafetyParams(ctx).DelayBlocks
+				if tc.expectedExtended[i] {
+					delayBlocks = tc.rateLimitParams.ExtendedDelayBlocks
+				}
+				mockDelayMsgKeeper.On(
+					"DelayMessageByBlocks",
+					ctx,
+					&types.MsgCompleteBridge{
+						Authority: delaymsgtypes.ModuleAddress.String(),
+						Event:     bridgeEvent,
+					},
+					delayBlocks,
+				).Return(uint32(i), nil).Once()
+			}
+
+			require.NoError(t, bridgeKeeper.AcknowledgeBridges(ctx, tc.bridgeEvents))
+			mockDelayMsgKeeper.AssertExpectations(t)
+		})
+	}
+}
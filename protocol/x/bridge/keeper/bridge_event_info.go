@@ -55,5 +55,5 @@ func (k Keeper) SetAcknowledgedEventInfo(
 func (k Keeper) GetRecognizedEventInfo(
 	ctx sdk.Context,
 ) (recognizedEventInfo types.BridgeEventInfo) {
-	return k.bridgeEventManager.GetRecognizedEventInfo()
+	return k.GetSourceChainRecognizedEventInfo(ctx, 0)
 }
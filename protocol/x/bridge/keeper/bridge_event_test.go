@@ -14,6 +14,8 @@ func TestGetBridgeEventFromServer(t *testing.T) {
 	tests := map[string]struct {
 		// Bridge event to add to server.
 		bridgeEvent types.BridgeEvent
+		// Source chain ID of bridge event to query.
+		ethChainId uint64
 		// Bridge event ID to query.
 		bridgeEventId uint32
 
@@ -32,18 +34,30 @@ func TestGetBridgeEventFromServer(t *testing.T) {
 			bridgeEventId: 1,
 			expectedFound: false,
 		},
+		"Event found on other source chain": {
+			bridgeEvent:   constants.BridgeEvent_L2_Id0_Height2,
+			ethChainId:    constants.L2EthChainId,
+			bridgeEventId: 0,
+			expectedEvent: constants.BridgeEvent_L2_Id0_Height2,
+			expectedFound: true,
+		},
+		"Event not found on source chain": {
+			bridgeEvent:   constants.BridgeEvent_L2_Id0_Height2,
+			bridgeEventId: 0,
+			expectedFound: false,
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Initialize context, keeper, and bridgeEventManager.
-			ctx, bridgeKeeper, _, mockTimeProvider, bridgeEventManager, _, _ := keepertest.BridgeKeepers(t)
+			ctx, bridgeKeeper, _, mockTimeProvider, bridgeEventManager, _, _, _ := keepertest.BridgeKeepers(t)
 			mockTimeProvider.On("Now").Return(time.Now())
 			err := bridgeEventManager.AddBridgeEvents([]types.BridgeEvent{tc.bridgeEvent})
 			require.NoError(t, err)
 
 			// Complete bridge.
-			event, found := bridgeKeeper.GetBridgeEventFromServer(ctx, tc.bridgeEventId)
+			event, found := bridgeKeeper.GetBridgeEventFromServer(ctx, tc.ethChainId, tc.bridgeEventId)
 
 			// Assert expectations.
 			require.Equal(t, tc.expectedEvent, event)
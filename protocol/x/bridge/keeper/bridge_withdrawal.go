@@ -0,0 +1,413 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BridgeOut withdraws `coin` from `sender` to the Ethereum address `ethRecipient`. The coin is escrowed in the
+// bridge module account, which also holds the coins of bridges from Ethereum, and a pending withdrawal is
+// queued for validators to attest. The withdrawal expires after `WithdrawalTimeout`. Returns the id of the
+// withdrawal.
+func (k Keeper) BridgeOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	ethRecipient string,
+	coin sdk.Coin,
+) (id uint32, err error) {
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.BridgeOut,
+		metrics.Latency,
+	)
+
+	// Do not bridge out if bridging is disabled.
+	if k.GetSafetyParams(ctx).IsDisabled {
+		return 0, types.ErrBridgingDisabled
+	}
+
+	// Only the bridged token can be bridged out.
+	if denom := k.GetEventParams(ctx).Denom; coin.Denom != denom {
+		return 0, errorsmod.Wrapf(
+			types.ErrInvalidBridgeOutCoin,
+			"coin denom %s does not match bridged denom %s",
+			coin.Denom,
+			denom,
+		)
+	}
+	if !coin.IsPositive() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidBridgeOutCoin, "coin %s must be positive", coin)
+	}
+	if err := types.ValidateEthAddress(ethRecipient); err != nil {
+		return 0, err
+	}
+
+	// Escrow the coin in the bridge module account.
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		sender,
+		types.ModuleName,
+		sdk.Coins{coin},
+	); err != nil {
+		return 0, err
+	}
+
+	withdrawal := types.BridgeWithdrawal{
+		Id:             k.getAndIncrementNextWithdrawalId(ctx),
+		Sender:         sender.String(),
+		EthRecipient:   common.HexToAddress(ethRecipient).Hex(),
+		Coin:           coin,
+		BlockHeight:    lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		ExpirationTime: ctx.BlockTime().Add(types.WithdrawalTimeout),
+	}
+	k.setWithdrawal(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(types.NewBridgeOutEvent(withdrawal))
+
+	return withdrawal.Id, nil
+}
+
+// AttestBridgeOut records the attestation of the pending withdrawal with id `withdrawalId` by the bonded
+// validator `validator`. `signature` must be a signature of the withdrawal digest by the validator's bridge
+// signer. Expired withdrawals cannot be attested. The withdrawal is finalized once validators with more than two
+// thirds of the last total voting power attest to it. Returns whether the withdrawal is finalized.
+func (k Keeper) AttestBridgeOut(
+	ctx sdk.Context,
+	validator sdk.ValAddress,
+	withdrawalId uint32,
+	signature []byte,
+) (finalized bool, err error) {
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.AttestBridgeOut,
+		metrics.Latency,
+	)
+
+	withdrawal, found := k.GetWithdrawal(ctx, withdrawalId)
+	if !found {
+		return false, errorsmod.Wrapf(types.ErrWithdrawalNotFound, "withdrawal id %d", withdrawalId)
+	}
+	if withdrawal.IsFinalized() {
+		return false, errorsmod.Wrapf(types.ErrWithdrawalAlreadyFinalized, "withdrawal id %d", withdrawalId)
+	}
+	if withdrawal.IsExpired(ctx.BlockTime()) {
+		return false, errorsmod.Wrapf(
+			types.ErrWithdrawalExpired,
+			"withdrawal id %d expired at %s",
+			withdrawalId,
+			withdrawal.ExpirationTime,
+		)
+	}
+	if withdrawal.HasAttestation(validator.String()) {
+		return false, errorsmod.Wrapf(
+			types.ErrDuplicateAttestation,
+			"validator %s, withdrawal id %d",
+			validator,
+			withdrawalId,
+		)
+	}
+
+	// Only validators in the last bonded validator set can attest.
+	power := k.stakingKeeper.GetLastValidatorPower(ctx, validator)
+	if power <= 0 {
+		return false, errorsmod.Wrapf(types.ErrValidatorNotBonded, "validator %s", validator)
+	}
+
+	// Verify that the signature was produced by the validator's bridge signer.
+	bridgeSigner, found := k.GetBridgeSigner(ctx, validator)
+	if !found {
+		return false, errorsmod.Wrapf(types.ErrBridgeSignerNotFound, "validator %s", validator)
+	}
+	eventParams := k.GetEventParams(ctx)
+	digest, err := withdrawal.GetDigest(eventParams.EthChainId, eventParams.EthAddress)
+	if err != nil {
+		return false, err
+	}
+	ethSigner, err := types.RecoverEthSigner(digest, signature)
+	if err != nil {
+		return false, err
+	}
+	if ethSigner != bridgeSigner.EthSigner {
+		return false, errorsmod.Wrapf(
+			types.ErrInvalidWithdrawalSignature,
+			"signature is from %s, but the bridge signer of validator %s is %s",
+			ethSigner,
+			validator,
+			bridgeSigner.EthSigner,
+		)
+	}
+
+	attestation := types.BridgeWithdrawalAttestation{
+		ValidatorAddress: validator.String(),
+		EthSigner:        ethSigner,
+		Signature:        signature,
+		Power:            power,
+	}
+	withdrawal.Attestations = append(withdrawal.Attestations, attestation)
+	ctx.EventManager().EmitEvent(types.NewAttestBridgeOutEvent(withdrawalId, attestation))
+
+	// Finalize the withdrawal once attested by more than two thirds of the voting power.
+	if k.hasSupermajorityAttestation(withdrawal, k.stakingKeeper.GetLastTotalPower(ctx)) {
+		k.finalizeWithdrawal(ctx, &withdrawal)
+	}
+	k.setWithdrawal(ctx, withdrawal)
+
+	return withdrawal.IsFinalized(), nil
+}
+
+// ProcessPendingWithdrawals is called at the end of every block. It refunds pending withdrawals that expired
+// more than `WithdrawalRefundDelay` ago to their senders. If the last total voting power decreased since the
+// previous block, for example because validators that did not attest left the validator set, it also
+// finalizes pending withdrawals that now have a supermajority of attestations.
+func (k Keeper) ProcessPendingWithdrawals(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	previousTotalPower, found := k.getWithdrawalTotalPower(ctx)
+	totalPowerDecreased := !found || totalPower.LT(previousTotalPower)
+	if !found || !totalPower.Equal(previousTotalPower) {
+		k.setWithdrawalTotalPower(ctx, totalPower)
+	}
+
+	// Collect the withdrawals to process before modifying the pending withdrawal store.
+	withdrawals := make([]types.BridgeWithdrawal, 0)
+	iterator := k.getPendingWithdrawalStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal types.BridgeWithdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &withdrawal)
+		// Withdrawals are ordered by id and hence by expiration time, so only the earliest withdrawals
+		// can be refundable.
+		if !totalPowerDecreased && !withdrawal.IsRefundable(blockTime) {
+			break
+		}
+		withdrawals = append(withdrawals, withdrawal)
+	}
+
+	for _, withdrawal := range withdrawals {
+		if withdrawal.IsRefundable(blockTime) {
+			if err := k.refundWithdrawal(ctx, withdrawal); err != nil {
+				k.Logger(ctx).Error(
+					"Failed to refund withdrawal",
+					"withdrawalId",
+					withdrawal.Id,
+					"error",
+					err,
+				)
+			}
+		} else if !withdrawal.IsExpired(blockTime) && k.hasSupermajorityAttestation(withdrawal, totalPower) {
+			k.finalizeWithdrawal(ctx, &withdrawal)
+			k.setWithdrawal(ctx, withdrawal)
+		}
+	}
+}
+
+// hasSupermajorityAttestation returns whether validators with more than two thirds of `totalPower` attested to
+// the withdrawal. Attestations are weighted by the voting power of their validator when it attested.
+func (k Keeper) hasSupermajorityAttestation(withdrawal types.BridgeWithdrawal, totalPower sdkmath.Int) bool {
+	// attestedPower / totalPower > 2 / 3 ==> attestedPower * 3 > totalPower * 2
+	attestedPower := sdkmath.NewInt(withdrawal.GetAttestedPower())
+	return attestedPower.MulRaw(3).GT(totalPower.MulRaw(2))
+}
+
+// finalizeWithdrawal marks the pending withdrawal as finalized at the current block height and removes it from
+// the pending withdrawal store. The caller is responsible for setting the finalized withdrawal.
+func (k Keeper) finalizeWithdrawal(ctx sdk.Context, withdrawal *types.BridgeWithdrawal) {
+	withdrawal.FinalizedBlockHeight = lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	k.deletePendingWithdrawal(ctx, withdrawal.Id)
+	ctx.EventManager().EmitEvent(types.NewBridgeOutFinalizedEvent(withdrawal.Id))
+	telemetry.SetGauge(
+		float32(withdrawal.Id),
+		types.ModuleName,
+		metrics.LastFinalizedWithdrawalId,
+	)
+}
+
+// refundWithdrawal returns the escrowed coin of the pending withdrawal to its sender and deletes the
+// withdrawal.
+func (k Keeper) refundWithdrawal(ctx sdk.Context, withdrawal types.BridgeWithdrawal) error {
+	sender, err := sdk.AccAddressFromBech32(withdrawal.Sender)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		sender,
+		sdk.Coins{withdrawal.Coin},
+	); err != nil {
+		return err
+	}
+	k.deletePendingWithdrawal(ctx, withdrawal.Id)
+	ctx.EventManager().EmitEvent(types.NewBridgeOutRefundedEvent(withdrawal))
+	telemetry.IncrCounter(1, types.ModuleName, metrics.RefundedWithdrawals, metrics.Count)
+	return nil
+}
+
+// getWithdrawalTotalPower returns the last total voting power that pending withdrawals were processed with,
+// and whether it exists. It is not part of genesis, so pending withdrawals are rechecked in the first block
+// after genesis.
+func (k Keeper) getWithdrawalTotalPower(ctx sdk.Context) (totalPower sdkmath.Int, found bool) {
+	b := ctx.KVStore(k.storeKey).Get([]byte(types.WithdrawalTotalPowerKey))
+	if b == nil {
+		return sdkmath.Int{}, false
+	}
+	if err := totalPower.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return totalPower, true
+}
+
+// setWithdrawalTotalPower sets the last total voting power that pending withdrawals were processed with.
+func (k Keeper) setWithdrawalTotalPower(ctx sdk.Context, totalPower sdkmath.Int) {
+	b, err := totalPower.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.WithdrawalTotalPowerKey), b)
+}
+
+// GetWithdrawal returns the pending or finalized withdrawal with id `id`, and whether it exists.
+func (k Keeper) GetWithdrawal(
+	ctx sdk.Context,
+	id uint32,
+) (withdrawal types.BridgeWithdrawal, found bool) {
+	for _, store := range []prefix.Store{k.getPendingWithdrawalStore(ctx), k.getFinalizedWithdrawalStore(ctx)} {
+		b := store.Get(lib.Uint32ToKey(id))
+		if b != nil {
+			k.cdc.MustUnmarshal(b, &withdrawal)
+			return withdrawal, true
+		}
+	}
+	return withdrawal, false
+}
+
+// GetNextWithdrawalId returns the id of the next withdrawal.
+func (k Keeper) GetNextWithdrawalId(ctx sdk.Context) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.NextWithdrawalIdKey))
+	if b == nil {
+		return 0
+	}
+	var nextId gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &nextId)
+	return nextId.Value
+}
+
+// GetAllWithdrawals returns all pending and finalized withdrawals, in order of id.
+func (k Keeper) GetAllWithdrawals(ctx sdk.Context) []types.BridgeWithdrawal {
+	withdrawals := make([]types.BridgeWithdrawal, 0)
+	for _, store := range []prefix.Store{k.getPendingWithdrawalStore(ctx), k.getFinalizedWithdrawalStore(ctx)} {
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			var withdrawal types.BridgeWithdrawal
+			k.cdc.MustUnmarshal(iterator.Value(), &withdrawal)
+			withdrawals = append(withdrawals, withdrawal)
+		}
+		iterator.Close()
+	}
+	sort.Slice(withdrawals, func(i, j int) bool {
+		return withdrawals[i].Id < withdrawals[j].Id
+	})
+	return withdrawals
+}
+
+// InitializeWithdrawals sets the id of the next withdrawal and the pending and finalized withdrawals in state.
+// Used during genesis initialization.
+func (k Keeper) InitializeWithdrawals(
+	ctx sdk.Context,
+	nextWithdrawalId uint32,
+	withdrawals []types.BridgeWithdrawal,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.NextWithdrawalIdKey), k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: nextWithdrawalId}))
+	for _, withdrawal := range withdrawals {
+		k.setWithdrawal(ctx, withdrawal)
+	}
+}
+
+// getAndIncrementNextWithdrawalId returns the id of the next withdrawal and increments it in state.
+func (k Keeper) getAndIncrementNextWithdrawalId(ctx sdk.Context) uint32 {
+	id := k.GetNextWithdrawalId(ctx)
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.NextWithdrawalIdKey), k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: id + 1}))
+	return id
+}
+
+// setWithdrawal sets the withdrawal in the pending or finalized withdrawal store, depending on whether it
+// is finalized.
+func (k Keeper) setWithdrawal(ctx sdk.Context, withdrawal types.BridgeWithdrawal) {
+	store := k.getPendingWithdrawalStore(ctx)
+	if withdrawal.IsFinalized() {
+		store = k.getFinalizedWithdrawalStore(ctx)
+	}
+	store.Set(lib.Uint32ToKey(withdrawal.Id), k.cdc.MustMarshal(&withdrawal))
+}
+
+// deletePendingWithdrawal deletes the withdrawal with id `id` from the pending withdrawal store.
+func (k Keeper) deletePendingWithdrawal(ctx sdk.Context, id uint32) {
+	k.getPendingWithdrawalStore(ctx).Delete(lib.Uint32ToKey(id))
+}
+
+// getPendingWithdrawalStore returns a prefix store for pending withdrawals, keyed by id.
+func (k Keeper) getPendingWithdrawalStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingWithdrawalKeyPrefix))
+}
+
+// getFinalizedWithdrawalStore returns a prefix store for finalized withdrawals, keyed by id.
+func (k Keeper) getFinalizedWithdrawalStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FinalizedWithdrawalKeyPrefix))
+}
+
+// SetBridgeSigner sets the Ethereum address that validator `validator` signs withdrawals with.
+func (k Keeper) SetBridgeSigner(ctx sdk.Context, validator sdk.ValAddress, ethSigner string) {
+	bridgeSigner := types.BridgeSigner{
+		ValidatorAddress: validator.String(),
+		EthSigner:        common.HexToAddress(ethSigner).Hex(),
+	}
+	k.getBridgeSignerStore(ctx).Set(validator, k.cdc.MustMarshal(&bridgeSigner))
+}
+
+// GetBridgeSigner returns the bridge signer of validator `validator`, and whether it exists.
+func (k Keeper) GetBridgeSigner(
+	ctx sdk.Context,
+	validator sdk.ValAddress,
+) (bridgeSigner types.BridgeSigner, found bool) {
+	b := k.getBridgeSignerStore(ctx).Get(validator)
+	if b == nil {
+		return bridgeSigner, false
+	}
+	k.cdc.MustUnmarshal(b, &bridgeSigner)
+	return bridgeSigner, true
+}
+
+// GetAllBridgeSigners returns the bridge signers of all validators.
+func (k Keeper) GetAllBridgeSigners(ctx sdk.Context) []types.BridgeSigner {
+	iterator := k.getBridgeSignerStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	bridgeSigners := make([]types.BridgeSigner, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var bridgeSigner types.BridgeSigner
+		k.cdc.MustUnmarshal(iterator.Value(), &bridgeSigner)
+		bridgeSigners = append(bridgeSigners, bridgeSigner)
+	}
+	return bridgeSigners
+}
+
+// getBridgeSignerStore returns a prefix store for bridge signers, keyed by validator operator address.
+func (k Keeper) getBridgeSignerStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BridgeSignerKeyPrefix))
+}
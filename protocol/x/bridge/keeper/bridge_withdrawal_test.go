@@ -0,0 +1,476 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// setupWithdrawals returns a bridge keeper whose event params have an Ethereum bridge contract address, and
+// funds Alice's account with 1,000 bridged tokens. The block time is `withdrawalBlockTime`.
+var withdrawalBlockTime = time.Unix(1_700_000_000, 0).UTC()
+
+func setupWithdrawals(
+	t *testing.T,
+) (
+	ctx sdk.Context,
+	bridgeKeeper *keeper.Keeper,
+	bankKeeper *bankkeeper.BaseKeeper,
+	mockStakingKeeper *mocks.StakingKeeper,
+) {
+	ctx, bridgeKeeper, _, _, _, bankKeeper, _, mockStakingKeeper = keepertest.BridgeKeepers(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(withdrawalBlockTime)
+	require.NoError(t, bridgeKeeper.UpdateEventParams(ctx, constants.EventParams_EthBridgeAddress))
+
+	coins := sdk.NewCoins(sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, constants.AliceAccAddress, coins))
+	return ctx, bridgeKeeper, bankKeeper, mockStakingKeeper
+}
+
+// signWithdrawal returns the signature of the withdrawal digest by `privateKey`.
+func signWithdrawal(
+	t *testing.T,
+	ctx sdk.Context,
+	bridgeKeeper *keeper.Keeper,
+	withdrawal types.BridgeWithdrawal,
+	privateKey *ecdsa.PrivateKey,
+) []byte {
+	params := bridgeKeeper.GetEventParams(ctx)
+	digest, err := withdrawal.GetDigest(params.EthChainId, params.EthAddress)
+	require.NoError(t, err)
+	signature, err := ethcrypto.Sign(digest, privateKey)
+	require.NoError(t, err)
+	return signature
+}
+
+func TestBridgeOut(t *testing.T) {
+	tests := map[string]struct {
+		// Recipient and coin to bridge out.
+		ethRecipient string
+		coin         sdk.Coin
+		// Whether bridging is disabled.
+		bridgingDisabled bool
+
+		// Expected error, if any.
+		expectedError error
+		// Expected balance of Alice after bridging out.
+		expectedBalance sdkmath.Int
+	}{
+		"Success": {
+			ethRecipient:    constants.EthRecipientAddress,
+			coin:            sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			expectedBalance: sdkmath.NewInt(112), // 1000 - 888.
+		},
+		"Success: lowercase eth recipient": {
+			ethRecipient:    "0x52908400098527886e0f7030069857d2e4169ee7",
+			coin:            sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)),
+			expectedBalance: sdkmath.ZeroInt(),
+		},
+		"Failure: bridging is disabled": {
+			ethRecipient:     constants.EthRecipientAddress,
+			coin:             sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			bridgingDisabled: true,
+			expectedError:    types.ErrBridgingDisabled,
+			expectedBalance:  sdkmath.NewInt(1_000),
+		},
+		"Failure: coin is not the bridged token": {
+			ethRecipient:    constants.EthRecipientAddress,
+			coin:            sdk.NewCoin("bridge-token", sdkmath.NewInt(888)),
+			expectedError:   types.ErrInvalidBridgeOutCoin,
+			expectedBalance: sdkmath.NewInt(1_000),
+		},
+		"Failure: coin amount is 0": {
+			ethRecipient:    constants.EthRecipientAddress,
+			coin:            sdk.NewCoin("adv4tnt", sdkmath.ZeroInt()),
+			expectedError:   types.ErrInvalidBridgeOutCoin,
+			expectedBalance: sdkmath.NewInt(1_000),
+		},
+		"Failure: invalid eth recipient": {
+			ethRecipient:    constants.BobAccAddress.String(),
+			coin:            sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			expectedError:   types.ErrInvalidEthAddress,
+			expectedBalance: sdkmath.NewInt(1_000),
+		},
+		"Failure: insufficient balance": {
+			ethRecipient:    constants.EthRecipientAddress,
+			coin:            sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_001)),
+			expectedError:   sdkerrors.ErrInsufficientFunds,
+			expectedBalance: sdkmath.NewInt(1_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, bridgeKeeper, bankKeeper, _ := setupWithdrawals(t)
+			require.NoError(t, bridgeKeeper.UpdateSafetyParams(ctx, types.SafetyParams{
+				IsDisabled:  tc.bridgingDisabled,
+				DelayBlocks: bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
+			}))
+
+			id, err := bridgeKeeper.BridgeOut(ctx, constants.AliceAccAddress, tc.ethRecipient, tc.coin)
+
+			modAccBalance := bankKeeper.GetBalance(ctx, types.ModuleAddress, "adv4tnt")
+			require.Equal(t, tc.expectedBalance, bankKeeper.GetBalance(ctx, constants.AliceAccAddress, "adv4tnt").Amount)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				require.True(t, modAccBalance.IsZero())
+				require.Equal(t, uint32(0), bridgeKeeper.GetNextWithdrawalId(ctx))
+				_, found := bridgeKeeper.GetWithdrawal(ctx, 0)
+				require.False(t, found)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, uint32(0), id)
+			require.Equal(t, uint32(1), bridgeKeeper.GetNextWithdrawalId(ctx))
+			require.Equal(t, tc.coin, modAccBalance)
+
+			withdrawal, found := bridgeKeeper.GetWithdrawal(ctx, id)
+			require.True(t, found)
+			require.Equal(
+				t,
+				types.BridgeWithdrawal{
+					Id:             0,
+					Sender:         constants.AliceAccAddress.String(),
+					EthRecipient:   constants.EthRecipientAddress,
+					Coin:           tc.coin,
+					BlockHeight:    1,
+					ExpirationTime: withdrawalBlockTime.Add(types.WithdrawalTimeout),
+				},
+				withdrawal,
+			)
+			require.Contains(t, ctx.EventManager().Events(), types.NewBridgeOutEvent(withdrawal))
+		})
+	}
+}
+
+func TestBridgeOut_IncrementsId(t *testing.T) {
+	ctx, bridgeKeeper, _, _ := setupWithdrawals(t)
+	coin := sdk.NewCoin("adv4tnt", sdkmath.NewInt(100))
+
+	for i := uint32(0); i < 3; i++ {
+		id, err := bridgeKeeper.BridgeOut(ctx, constants.AliceAccAddress, constants.EthRecipientAddress, coin)
+		require.NoError(t, err)
+		require.Equal(t, i, id)
+	}
+	require.Equal(t, uint32(3), bridgeKeeper.GetNextWithdrawalId(ctx))
+}
+
+func TestSetBridgeSigner(t *testing.T) {
+	ctx, bridgeKeeper, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+
+	_, found := bridgeKeeper.GetBridgeSigner(ctx, constants.AliceValAddress)
+	require.False(t, found)
+
+	// Signers are stored checksummed.
+	bridgeKeeper.SetBridgeSigner(ctx, constants.AliceValAddress, "0x52908400098527886e0f7030069857d2e4169ee7")
+	bridgeSigner, found := bridgeKeeper.GetBridgeSigner(ctx, constants.AliceValAddress)
+	require.True(t, found)
+	require.Equal(
+		t,
+		types.BridgeSigner{
+			ValidatorAddress: constants.AliceValAddress.String(),
+			EthSigner:        constants.EthRecipientAddress,
+		},
+		bridgeSigner,
+	)
+
+	// Signers can be rotated.
+	bridgeKeeper.SetBridgeSigner(ctx, constants.AliceValAddress, constants.AliceBridgeSigner)
+	bridgeSigner, found = bridgeKeeper.GetBridgeSigner(ctx, constants.AliceValAddress)
+	require.True(t, found)
+	require.Equal(t, constants.AliceBridgeSigner, bridgeSigner.EthSigner)
+
+	_, found = bridgeKeeper.GetBridgeSigner(ctx, constants.BobValAddress)
+	require.False(t, found)
+}
+
+func TestAttestBridgeOut(t *testing.T) {
+	// Alice, Bob, and Carl have 50%, 30%, and 20% of the voting power. Dave is not bonded.
+	validatorPowers := map[string]int64{
+		constants.AliceValAddress.String(): 5,
+		constants.BobValAddress.String():   3,
+		constants.CarlValAddress.String():  2,
+		constants.DaveValAddress.String():  0,
+	}
+	type attestation struct {
+		validator  sdk.ValAddress
+		privateKey *ecdsa.PrivateKey
+	}
+	alice := attestation{constants.AliceValAddress, constants.AliceBridgeSignerPrivateKey}
+	bob := attestation{constants.BobValAddress, constants.BobBridgeSignerPrivateKey}
+	carl := attestation{constants.CarlValAddress, constants.CarlBridgeSignerPrivateKey}
+
+	tests := map[string]struct {
+		// Attestations that succeed before the attestation under test.
+		previousAttestations []attestation
+		// Attestation under test.
+		attestation  attestation
+		withdrawalId uint32
+		// Last total voting power, if not 10.
+		totalPower sdkmath.Int
+		// Time of the attestation under test after the expiration time of the withdrawal, if any.
+		timeAfterExpiration time.Duration
+
+		// Expected error, if any.
+		expectedError error
+		// Expected finalization status after the attestation.
+		expectedFinalized bool
+	}{
+		"Success: first attestation is not finalized": {
+			attestation: alice,
+		},
+		"Success: 50% of the voting power is not finalized": {
+			previousAttestations: []attestation{bob},
+			attestation:          carl,
+		},
+		"Success: exactly two thirds of the voting power is not finalized": {
+			previousAttestations: []attestation{alice},
+			attestation:          bob,
+			totalPower:           sdkmath.NewInt(12), // (5 + 3) / 12 = 2 / 3.
+		},
+		"Success: 80% of the voting power is finalized": {
+			previousAttestations: []attestation{alice},
+			attestation:          bob,
+			expectedFinalized:    true,
+		},
+		"Success: attestation at the expiration time": {
+			previousAttestations: []attestation{alice},
+			attestation:          bob,
+			expectedFinalized:    true,
+		},
+		"Failure: withdrawal expired": {
+			previousAttestations: []attestation{alice},
+			attestation:          bob,
+			timeAfterExpiration:  time.Second,
+			expectedError:        types.ErrWithdrawalExpired,
+		},
+		"Failure: withdrawal not found": {
+			attestation:   alice,
+			withdrawalId:  1,
+			expectedError: types.ErrWithdrawalNotFound,
+		},
+		"Failure: withdrawal already finalized": {
+			previousAttestations: []attestation{alice, bob},
+			attestation:          carl,
+			expectedError:        types.ErrWithdrawalAlreadyFinalized,
+			expectedFinalized:    true,
+		},
+		"Failure: duplicate attestation": {
+			previousAttestations: []attestation{alice},
+			attestation:          alice,
+			expectedError:        types.ErrDuplicateAttestation,
+		},
+		"Failure: validator not bonded": {
+			attestation:   attestation{constants.DaveValAddress, constants.AliceBridgeSignerPrivateKey},
+			expectedError: types.ErrValidatorNotBonded,
+		},
+		"Failure: signature not from the validator's bridge signer": {
+			attestation:   attestation{constants.AliceValAddress, constants.BobBridgeSignerPrivateKey},
+			expectedError: types.ErrInvalidWithdrawalSignature,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, bridgeKeeper, _, mockStakingKeeper := setupWithdrawals(t)
+			mockStakingKeeper.On("GetLastValidatorPower", mock.Anything, mock.Anything).Return(
+				func(_ sdk.Context, validator sdk.ValAddress) int64 {
+					return validatorPowers[validator.String()]
+				},
+			)
+			totalPower := sdkmath.NewInt(10)
+			if !tc.totalPower.IsNil() {
+				totalPower = tc.totalPower
+			}
+			mockStakingKeeper.On("GetLastTotalPower", mock.Anything).Return(totalPower)
+			bridgeKeeper.SetBridgeSigner(ctx, constants.AliceValAddress, constants.AliceBridgeSigner)
+			bridgeKeeper.SetBridgeSigner(ctx, constants.BobValAddress, constants.BobBridgeSigner)
+			bridgeKeeper.SetBridgeSigner(ctx, constants.CarlValAddress, constants.CarlBridgeSigner)
+			bridgeKeeper.SetBridgeSigner(ctx, constants.DaveValAddress, constants.AliceBridgeSigner)
+
+			id, err := bridgeKeeper.BridgeOut(
+				ctx,
+				constants.AliceAccAddress,
+				constants.EthRecipientAddress,
+				sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			)
+			require.NoError(t, err)
+			withdrawal, found := bridgeKeeper.GetWithdrawal(ctx, id)
+			require.True(t, found)
+
+			for _, previous := range tc.previousAttestations {
+				_, err := bridgeKeeper.AttestBridgeOut(
+					ctx,
+					previous.validator,
+					id,
+					signWithdrawal(t, ctx, bridgeKeeper, withdrawal, previous.privateKey),
+				)
+				require.NoError(t, err)
+			}
+
+			ctx = ctx.WithBlockHeight(2)
+			if tc.timeAfterExpiration != 0 {
+				ctx = ctx.WithBlockTime(withdrawal.ExpirationTime.Add(tc.timeAfterExpiration))
+			}
+			signature := signWithdrawal(t, ctx, bridgeKeeper, withdrawal, tc.attestation.privateKey)
+			finalized, err := bridgeKeeper.AttestBridgeOut(ctx, tc.attestation.validator, tc.withdrawalId, signature)
+
+			withdrawal, found = bridgeKeeper.GetWithdrawal(ctx, id)
+			require.True(t, found)
+			require.Equal(t, tc.expectedFinalized, withdrawal.IsFinalized())
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				require.Len(t, withdrawal.Attestations, len(tc.previousAttestations))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFinalized, finalized)
+			require.Len(t, withdrawal.Attestations, len(tc.previousAttestations)+1)
+			attestation := types.BridgeWithdrawalAttestation{
+				ValidatorAddress: tc.attestation.validator.String(),
+				EthSigner:        ethcrypto.PubkeyToAddress(tc.attestation.privateKey.PublicKey).Hex(),
+				Signature:        signature,
+				Power:            validatorPowers[tc.attestation.validator.String()],
+			}
+			require.Equal(t, attestation, withdrawal.Attestations[len(withdrawal.Attestations)-1])
+			require.Contains(t, ctx.EventManager().Events(), types.NewAttestBridgeOutEvent(id, attestation))
+			if tc.expectedFinalized {
+				require.Equal(t, uint32(2), withdrawal.FinalizedBlockHeight)
+				require.Contains(t, ctx.EventManager().Events(), types.NewBridgeOutFinalizedEvent(id))
+			}
+		})
+	}
+}
+
+func TestAttestBridgeOut_BridgeSignerNotFound(t *testing.T) {
+	ctx, bridgeKeeper, _, mockStakingKeeper := setupWithdrawals(t)
+	mockStakingKeeper.On("GetLastValidatorPower", ctx, constants.AliceValAddress).Return(int64(1))
+
+	id, err := bridgeKeeper.BridgeOut(
+		ctx,
+		constants.AliceAccAddress,
+		constants.EthRecipientAddress,
+		sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+	)
+	require.NoError(t, err)
+	withdrawal, _ := bridgeKeeper.GetWithdrawal(ctx, id)
+
+	_, err = bridgeKeeper.AttestBridgeOut(
+		ctx,
+		constants.AliceValAddress,
+		id,
+		signWithdrawal(t, ctx, bridgeKeeper, withdrawal, constants.AliceBridgeSignerPrivateKey),
+	)
+	require.ErrorIs(t, err, types.ErrBridgeSignerNotFound)
+}
+
+func TestProcessPendingWithdrawals(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, mockStakingKeeper := setupWithdrawals(t)
+	mockStakingKeeper.On("GetLastValidatorPower", ctx, constants.AliceValAddress).Return(int64(5))
+	totalPower := sdkmath.NewInt(10)
+	mockStakingKeeper.On("GetLastTotalPower", mock.Anything).Return(
+		func(sdk.Context) sdkmath.Int {
+			return totalPower
+		},
+	)
+	bridgeKeeper.SetBridgeSigner(ctx, constants.AliceValAddress, constants.AliceBridgeSigner)
+	coin := sdk.NewCoin("adv4tnt", sdkmath.NewInt(100))
+
+	// Alice attests to withdrawal 0 with half of the voting power. Withdrawal 1 is created an hour later.
+	id0, err := bridgeKeeper.BridgeOut(ctx, constants.AliceAccAddress, constants.EthRecipientAddress, coin)
+	require.NoError(t, err)
+	withdrawal0, _ := bridgeKeeper.GetWithdrawal(ctx, id0)
+	_, err = bridgeKeeper.AttestBridgeOut(
+		ctx,
+		constants.AliceValAddress,
+		id0,
+		signWithdrawal(t, ctx, bridgeKeeper, withdrawal0, constants.AliceBridgeSignerPrivateKey),
+	)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(withdrawalBlockTime.Add(time.Hour))
+	id1, err := bridgeKeeper.BridgeOut(ctx, constants.AliceAccAddress, constants.EthRecipientAddress, coin)
+	require.NoError(t, err)
+	withdrawal1, _ := bridgeKeeper.GetWithdrawal(ctx, id1)
+
+	// Nothing is finalized while the total voting power is unchanged.
+	bridgeKeeper.ProcessPendingWithdrawals(ctx)
+	withdrawal0, _ = bridgeKeeper.GetWithdrawal(ctx, id0)
+	require.False(t, withdrawal0.IsFinalized())
+
+	// Withdrawal 0 is finalized once validators that did not attest leave the validator set.
+	totalPower = sdkmath.NewInt(7)
+	ctx = ctx.WithBlockHeight(2)
+	bridgeKeeper.ProcessPendingWithdrawals(ctx)
+	withdrawal0, _ = bridgeKeeper.GetWithdrawal(ctx, id0)
+	require.Equal(t, uint32(2), withdrawal0.FinalizedBlockHeight)
+	require.Contains(t, ctx.EventManager().Events(), types.NewBridgeOutFinalizedEvent(id0))
+	withdrawal1, _ = bridgeKeeper.GetWithdrawal(ctx, id1)
+	require.False(t, withdrawal1.IsFinalized())
+
+	// Expired withdrawals are not refunded until the refund delay has passed.
+	ctx = ctx.WithBlockTime(withdrawal1.ExpirationTime.Add(types.WithdrawalRefundDelay))
+	bridgeKeeper.ProcessPendingWithdrawals(ctx)
+	_, found := bridgeKeeper.GetWithdrawal(ctx, id1)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(800), bankKeeper.GetBalance(ctx, constants.AliceAccAddress, "adv4tnt").Amount)
+
+	// Withdrawal 1 is refunded to Alice. Finalized withdrawal 0 is not.
+	ctx = ctx.WithBlockTime(withdrawal1.ExpirationTime.Add(types.WithdrawalRefundDelay + time.Second))
+	bridgeKeeper.ProcessPendingWithdrawals(ctx)
+	_, found = bridgeKeeper.GetWithdrawal(ctx, id1)
+	require.False(t, found)
+	require.Contains(t, ctx.EventManager().Events(), types.NewBridgeOutRefundedEvent(withdrawal1))
+	require.Equal(t, sdkmath.NewInt(900), bankKeeper.GetBalance(ctx, constants.AliceAccAddress, "adv4tnt").Amount)
+	require.Equal(t, coin, bankKeeper.GetBalance(ctx, types.ModuleAddress, "adv4tnt"))
+	withdrawal0, found = bridgeKeeper.GetWithdrawal(ctx, id0)
+	require.True(t, found)
+	require.True(t, withdrawal0.IsFinalized())
+}
+
+func TestProcessPendingWithdrawals_ExpiredWithdrawalsAreNotFinalized(t *testing.T) {
+	ctx, bridgeKeeper, _, mockStakingKeeper := setupWithdrawals(t)
+	mockStakingKeeper.On("GetLastValidatorPower", ctx, constants.AliceValAddress).Return(int64(5))
+	mockStakingKeeper.On("GetLastTotalPower", mock.Anything).Return(sdkmath.NewInt(10)).Once()
+	mockStakingKeeper.On("GetLastTotalPower", mock.Anything).Return(sdkmath.NewInt(5))
+	bridgeKeeper.SetBridgeSigner(ctx, constants.AliceValAddress, constants.AliceBridgeSigner)
+
+	id, err := bridgeKeeper.BridgeOut(
+		ctx,
+		constants.AliceAccAddress,
+		constants.EthRecipientAddress,
+		sdk.NewCoin("adv4tnt", sdkmath.NewInt(100)),
+	)
+	require.NoError(t, err)
+	withdrawal, _ := bridgeKeeper.GetWithdrawal(ctx, id)
+	_, err = bridgeKeeper.AttestBridgeOut(
+		ctx,
+		constants.AliceValAddress,
+		id,
+		signWithdrawal(t, ctx, bridgeKeeper, withdrawal, constants.AliceBridgeSignerPrivateKey),
+	)
+	require.NoError(t, err)
+
+	// The total voting power decreases after the withdrawal expired, so the withdrawal stays pending until it
+	// is refunded.
+	ctx = ctx.WithBlockTime(withdrawal.ExpirationTime.Add(time.Second))
+	bridgeKeeper.ProcessPendingWithdrawals(ctx)
+	withdrawal, found := bridgeKeeper.GetWithdrawal(ctx, id)
+	require.True(t, found)
+	require.False(t, withdrawal.IsFinalized())
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
@@ -23,9 +24,12 @@ func (k Keeper) CompleteBridge(
 		metrics.Latency,
 	)
 
-	// Do not complete bridge if bridging is disabled.
-	safetyParams := k.GetSafetyParams(ctx)
-	if safetyParams.IsDisabled {
+	// Do not complete bridge if bridging from its source chain is disabled.
+	sourceChainParams, found := k.GetSourceChainParams(ctx, bridge.EthChainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSourceChainNotFound, "Ethereum chain ID %d", bridge.EthChainId)
+	}
+	if sourceChainParams.SafetyParams.IsDisabled {
 		return types.ErrBridgingDisabled
 	}
 
@@ -20,6 +20,10 @@ func TestCompleteBridge(t *testing.T) {
 		bridgeEvent types.BridgeEvent
 		// Whether bridging is disabled.
 		bridgingDisabled bool
+		// Whether the L2 source chain exists.
+		l2Exists bool
+		// Whether bridging from the L2 source chain is disabled.
+		l2Disabled bool
 
 		// Expected error, if any.
 		expectedError string
@@ -78,17 +82,53 @@ func TestCompleteBridge(t *testing.T) {
 			expectedError:         types.ErrBridgingDisabled.Error(),
 			expectedModAccBalance: sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)), // same as initial.
 		},
+		"Success: bridge event of L2 source chain": {
+			initialModAccBalance: sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)),
+			bridgeEvent:          constants.BridgeEvent_L2_Id0_Height2,
+			l2Exists:             true,
+			expectedModAccBalance: sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000).Sub(
+				constants.BridgeEvent_L2_Id0_Height2.Coin.Amount,
+			)),
+		},
+		"Success: bridge event of L2 source chain when bridging from other source chain is disabled": {
+			initialModAccBalance: sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)),
+			bridgeEvent:          constants.BridgeEvent_L2_Id0_Height2,
+			bridgingDisabled:     true,
+			l2Exists:             true,
+			expectedModAccBalance: sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000).Sub(
+				constants.BridgeEvent_L2_Id0_Height2.Coin.Amount,
+			)),
+		},
+		"Failure: source chain not found": {
+			initialModAccBalance:  sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)),
+			bridgeEvent:           constants.BridgeEvent_L2_Id0_Height2,
+			expectedError:         types.ErrSourceChainNotFound.Error(),
+			expectedModAccBalance: sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)), // same as initial.
+		},
+		"Failure: bridging from L2 source chain is disabled": {
+			initialModAccBalance:  sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)),
+			bridgeEvent:           constants.BridgeEvent_L2_Id0_Height2,
+			l2Exists:              true,
+			l2Disabled:            true,
+			expectedError:         types.ErrBridgingDisabled.Error(),
+			expectedModAccBalance: sdk.NewCoin("adv4tnt", sdkmath.NewInt(1_000)), // same as initial.
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Initialize context and keeper.
-			ctx, bridgeKeeper, _, _, _, bankKeeper, _ := keepertest.BridgeKeepers(t)
+			ctx, bridgeKeeper, _, _, _, bankKeeper, _, _ := keepertest.BridgeKeepers(t)
 			err := bridgeKeeper.UpdateSafetyParams(ctx, types.SafetyParams{
 				IsDisabled:  tc.bridgingDisabled,
 				DelayBlocks: bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
 			})
 			require.NoError(t, err)
+			if tc.l2Exists {
+				l2Params := constants.SourceChainParams_L2
+				l2Params.SafetyParams.IsDisabled = tc.l2Disabled
+				require.NoError(t, bridgeKeeper.UpdateSourceChainParams(ctx, l2Params))
+			}
 			// Fund bridge module account with enough balance.
 			err = bankKeeper.MintCoins(
 				ctx,
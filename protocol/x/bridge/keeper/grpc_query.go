@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -68,7 +72,75 @@ func (k Keeper) SafetyParams(
 	}, nil
 }
 
-// AcknowledgedEventInfo processes a query request/response for `AcknowledgedEventInfo` from state.
+// SourceChainParams processes a query request/response for the parameters of all additional source
+// chains from state.
+func (k Keeper) SourceChainParams(
+	c context.Context,
+	req *types.QuerySourceChainParamsRequest,
+) (
+	*types.QuerySourceChainParamsResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySourceChainParamsResponse{
+		Params: k.GetAllSourceChainParams(ctx),
+	}, nil
+}
+
+// RateLimitParams processes a query request/response for the `RateLimitParams` of all source chains
+// from state.
+func (k Keeper) RateLimitParams(
+	c context.Context,
+	req *types.QueryRateLimitParamsRequest,
+) (
+	*types.QueryRateLimitParamsResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryRateLimitParamsResponse{
+		Params: k.GetAllRateLimitParams(ctx),
+	}, nil
+}
+
+// WindowUtilization processes a query request/response for the amounts bridged from a source chain
+// within the current rolling window of its `RateLimitParams` from state.
+func (k Keeper) WindowUtilization(
+	c context.Context,
+	req *types.QueryWindowUtilizationRequest,
+) (
+	*types.QueryWindowUtilizationResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, found := k.GetRateLimitParams(ctx, req.EthChainId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("rate limit params of source chain %d not found", req.EthChainId),
+		)
+	}
+	totalAmount, addressAmount := k.GetWindowUtilization(ctx, req.EthChainId, req.Address)
+	return &types.QueryWindowUtilizationResponse{
+		Params:        params,
+		TotalAmount:   dtypes.NewIntFromBigInt(totalAmount),
+		AddressAmount: dtypes.NewIntFromBigInt(addressAmount),
+	}, nil
+}
+
+// AcknowledgedEventInfo processes a query request/response for `AcknowledgedEventInfo` of a source chain
+// from state.
 func (k Keeper) AcknowledgedEventInfo(
 	c context.Context,
 	req *types.QueryAcknowledgedEventInfoRequest,
@@ -81,13 +153,16 @@ func (k Keeper) AcknowledgedEventInfo(
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx)
+	if _, found := k.GetSourceChainParams(ctx, req.EthChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("source chain %d not found", req.EthChainId))
+	}
+	acknowledgedEventInfo := k.GetSourceChainAcknowledgedEventInfo(ctx, req.EthChainId)
 	return &types.QueryAcknowledgedEventInfoResponse{
 		Info: acknowledgedEventInfo,
 	}, nil
 }
 
-// RecognizedEventInfo processes a query request/response for the following
+// RecognizedEventInfo processes a query request/response for the following of a source chain
 // that has a greater `NextId`:
 // - the `AcknowledgedEventInfo` from state
 // - the `RecognizedEventInfo` from memory
@@ -105,8 +180,11 @@ func (k Keeper) RecognizedEventInfo(
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx)
-	recognizedEventInfo := k.bridgeEventManager.GetRecognizedEventInfo()
+	if _, found := k.GetSourceChainParams(ctx, req.EthChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("source chain %d not found", req.EthChainId))
+	}
+	acknowledgedEventInfo := k.GetSourceChainAcknowledgedEventInfo(ctx, req.EthChainId)
+	recognizedEventInfo := k.GetSourceChainRecognizedEventInfo(ctx, req.EthChainId)
 
 	// If `AcknowledgedEventInfo` from state has a greater `NextId`, use that in response.
 	// This implies that the EventInfo that has a greater `NextId` also has a equal-or-higher
@@ -135,3 +213,123 @@ func (k Keeper) DelayedCompleteBridgeMessages(
 		Messages: k.GetDelayedCompleteBridgeMessages(ctx, req.Address),
 	}, nil
 }
+
+// Withdrawal processes a query request/response for a pending or finalized withdrawal from state.
+func (k Keeper) Withdrawal(
+	c context.Context,
+	req *types.QueryWithdrawalRequest,
+) (
+	*types.QueryWithdrawalResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawal, found := k.GetWithdrawal(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("Withdrawal id %d not found.", req.Id))
+	}
+	return &types.QueryWithdrawalResponse{
+		Withdrawal: withdrawal,
+	}, nil
+}
+
+// PendingWithdrawals processes a query request/response for withdrawals that are not yet finalized,
+// in order of id.
+func (k Keeper) PendingWithdrawals(
+	c context.Context,
+	req *types.QueryPendingWithdrawalsRequest,
+) (
+	*types.QueryPendingWithdrawalsResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawals, pageRes, err := k.paginateWithdrawals(k.getPendingWithdrawalStore(ctx), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingWithdrawalsResponse{
+		Withdrawals: withdrawals,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// FinalizedWithdrawals processes a query request/response for finalized withdrawals, in order of id.
+func (k Keeper) FinalizedWithdrawals(
+	c context.Context,
+	req *types.QueryFinalizedWithdrawalsRequest,
+) (
+	*types.QueryFinalizedWithdrawalsResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawals, pageRes, err := k.paginateWithdrawals(k.getFinalizedWithdrawalStore(ctx), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFinalizedWithdrawalsResponse{
+		Withdrawals: withdrawals,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// paginateWithdrawals returns the page of withdrawals in `withdrawalStore` requested by `pageReq`.
+func (k Keeper) paginateWithdrawals(
+	withdrawalStore prefix.Store,
+	pageReq *query.PageRequest,
+) (
+	withdrawals []types.BridgeWithdrawal,
+	pageRes *query.PageResponse,
+	err error,
+) {
+	pageRes, err = query.Paginate(withdrawalStore, pageReq, func(key []byte, value []byte) error {
+		var withdrawal types.BridgeWithdrawal
+		if err := k.cdc.Unmarshal(value, &withdrawal); err != nil {
+			return err
+		}
+
+		withdrawals = append(withdrawals, withdrawal)
+		return nil
+	})
+	return withdrawals, pageRes, err
+}
+
+// BridgeSigner processes a query request/response for the bridge signer of a validator from state.
+func (k Keeper) BridgeSigner(
+	c context.Context,
+	req *types.QueryBridgeSignerRequest,
+) (
+	*types.QueryBridgeSignerResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	validator, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	bridgeSigner, found := k.GetBridgeSigner(ctx, validator)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("Bridge signer of validator %s not found.", req.ValidatorAddress),
+		)
+	}
+	return &types.QueryBridgeSignerResponse{
+		Signer: bridgeSigner,
+	}, nil
+}
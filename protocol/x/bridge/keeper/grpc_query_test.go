@@ -1,13 +1,16 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
@@ -126,10 +129,135 @@ func TestSafetyParams(t *testing.T) {
 	}
 }
 
+func TestSourceChainParams(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.BridgeKeeper
+	require.NoError(t, k.UpdateSourceChainParams(ctx, constants.SourceChainParams_L2))
+
+	for name, tc := range map[string]struct {
+		req *types.QuerySourceChainParamsRequest
+		res *types.QuerySourceChainParamsResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QuerySourceChainParamsRequest{},
+			res: &types.QuerySourceChainParamsResponse{
+				Params: []types.SourceChainParams{constants.SourceChainParams_L2},
+			},
+			err: nil,
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.SourceChainParams(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
+
+func TestRateLimitParams(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.BridgeKeeper
+	require.NoError(t, k.UpdateRateLimitParams(ctx, constants.RateLimitParams))
+
+	for name, tc := range map[string]struct {
+		req *types.QueryRateLimitParamsRequest
+		res *types.QueryRateLimitParamsResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QueryRateLimitParamsRequest{},
+			res: &types.QueryRateLimitParamsResponse{
+				Params: []types.RateLimitParams{constants.RateLimitParams},
+			},
+			err: nil,
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.RateLimitParams(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
+
+func TestWindowUtilization(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.BridgeKeeper
+	require.NoError(t, k.UpdateRateLimitParams(ctx, constants.RateLimitParams))
+
+	for name, tc := range map[string]struct {
+		req *types.QueryWindowUtilizationRequest
+		res *types.QueryWindowUtilizationResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QueryWindowUtilizationRequest{
+				Address: constants.AliceAccAddress.String(),
+			},
+			res: &types.QueryWindowUtilizationResponse{
+				Params:        constants.RateLimitParams,
+				TotalAmount:   dtypes.NewInt(0),
+				AddressAmount: dtypes.NewInt(0),
+			},
+			err: nil,
+		},
+		"Not found": {
+			req: &types.QueryWindowUtilizationRequest{
+				EthChainId: constants.L2EthChainId,
+			},
+			res: nil,
+			err: status.Error(codes.NotFound, "rate limit params of source chain 42161 not found"),
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.WindowUtilization(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
+
 func TestAcknowledgedEventInfo(t *testing.T) {
 	tApp := testapp.NewTestAppBuilder(t).Build()
 	ctx := tApp.InitChain()
 	k := tApp.App.BridgeKeeper
+	l2Info := types.BridgeEventInfo{
+		NextId:         3,
+		EthBlockHeight: 50,
+	}
+	require.NoError(t, k.UpdateSourceChainParams(ctx, constants.SourceChainParams_L2))
+	require.NoError(t, k.SetSourceChainAcknowledgedEventInfo(ctx, constants.L2EthChainId, l2Info))
 
 	for name, tc := range map[string]struct {
 		req *types.QueryAcknowledgedEventInfoRequest
@@ -143,6 +271,18 @@ func TestAcknowledgedEventInfo(t *testing.T) {
 			},
 			err: nil,
 		},
+		"Success: L2 source chain": {
+			req: &types.QueryAcknowledgedEventInfoRequest{EthChainId: constants.L2EthChainId},
+			res: &types.QueryAcknowledgedEventInfoResponse{
+				Info: l2Info,
+			},
+			err: nil,
+		},
+		"Source chain not found": {
+			req: &types.QueryAcknowledgedEventInfoRequest{EthChainId: constants.L2EthChainId + 1},
+			res: nil,
+			err: status.Error(codes.NotFound, fmt.Sprintf("source chain %d not found", constants.L2EthChainId+1)),
+		},
 		"Nil": {
 			req: nil,
 			res: nil,
@@ -165,6 +305,13 @@ func TestRecognizedEventInfo(t *testing.T) {
 	tApp := testapp.NewTestAppBuilder(t).Build()
 	ctx := tApp.InitChain()
 	k := tApp.App.BridgeKeeper
+	// Acknowledged event info of L2 source chain has a greater `NextId` than its recognized event info.
+	l2Info := types.BridgeEventInfo{
+		NextId:         3,
+		EthBlockHeight: 50,
+	}
+	require.NoError(t, k.UpdateSourceChainParams(ctx, constants.SourceChainParams_L2))
+	require.NoError(t, k.SetSourceChainAcknowledgedEventInfo(ctx, constants.L2EthChainId, l2Info))
 
 	for name, tc := range map[string]struct {
 		req *types.QueryRecognizedEventInfoRequest
@@ -181,6 +328,18 @@ func TestRecognizedEventInfo(t *testing.T) {
 			},
 			err: nil,
 		},
+		"Success: L2 source chain": {
+			req: &types.QueryRecognizedEventInfoRequest{EthChainId: constants.L2EthChainId},
+			res: &types.QueryRecognizedEventInfoResponse{
+				Info: l2Info,
+			},
+			err: nil,
+		},
+		"Source chain not found": {
+			req: &types.QueryRecognizedEventInfoRequest{EthChainId: constants.L2EthChainId + 1},
+			res: nil,
+			err: status.Error(codes.NotFound, fmt.Sprintf("source chain %d not found", constants.L2EthChainId+1)),
+		},
 		"Nil": {
 			req: nil,
 			res: nil,
@@ -289,3 +448,72 @@ func TestDelayedCompleteBridgeMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestWithdrawalQueries(t *testing.T) {
+	ctx, k, _, mockStakingKeeper := setupWithdrawals(t)
+	mockStakingKeeper.On("GetLastValidatorPower", ctx, constants.AliceValAddress).Return(int64(1))
+	mockStakingKeeper.On("GetLastTotalPower", ctx).Return(sdkmath.NewInt(1))
+	k.SetBridgeSigner(ctx, constants.AliceValAddress, constants.AliceBridgeSigner)
+
+	// Bridge out twice, and finalize the first withdrawal.
+	withdrawals := make([]types.BridgeWithdrawal, 0)
+	for i := 0; i < 2; i++ {
+		id, err := k.BridgeOut(
+			ctx,
+			constants.AliceAccAddress,
+			constants.EthRecipientAddress,
+			sdk.NewCoin("adv4tnt", sdkmath.NewInt(100)),
+		)
+		require.NoError(t, err)
+		withdrawal, _ := k.GetWithdrawal(ctx, id)
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	finalized, err := k.AttestBridgeOut(
+		ctx,
+		constants.AliceValAddress,
+		0,
+		signWithdrawal(t, ctx, k, withdrawals[0], constants.AliceBridgeSignerPrivateKey),
+	)
+	require.NoError(t, err)
+	require.True(t, finalized)
+	finalizedWithdrawal, _ := k.GetWithdrawal(ctx, 0)
+	pendingWithdrawal := withdrawals[1]
+
+	// Withdrawal.
+	res, err := k.Withdrawal(ctx, &types.QueryWithdrawalRequest{Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, finalizedWithdrawal, res.Withdrawal)
+	res, err = k.Withdrawal(ctx, &types.QueryWithdrawalRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, pendingWithdrawal, res.Withdrawal)
+	_, err = k.Withdrawal(ctx, &types.QueryWithdrawalRequest{Id: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = k.Withdrawal(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	// Pending and finalized withdrawals.
+	pendingRes, err := k.PendingWithdrawals(ctx, &types.QueryPendingWithdrawalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgeWithdrawal{pendingWithdrawal}, pendingRes.Withdrawals)
+	finalizedRes, err := k.FinalizedWithdrawals(ctx, &types.QueryFinalizedWithdrawalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgeWithdrawal{finalizedWithdrawal}, finalizedRes.Withdrawals)
+	_, err = k.PendingWithdrawals(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	_, err = k.FinalizedWithdrawals(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	// Bridge signer.
+	signerRes, err := k.BridgeSigner(
+		ctx,
+		&types.QueryBridgeSignerRequest{ValidatorAddress: constants.AliceValAddress.String()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, constants.AliceBridgeSigner, signerRes.Signer.EthSigner)
+	_, err = k.BridgeSigner(ctx, &types.QueryBridgeSignerRequest{ValidatorAddress: constants.BobValAddress.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = k.BridgeSigner(ctx, &types.QueryBridgeSignerRequest{ValidatorAddress: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.BridgeSigner(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -23,6 +23,7 @@ type (
 		bridgeEventManager *bridgeserver.BridgeEventManager
 		bankKeeper         types.BankKeeper
 		delayMsgKeeper     delaymsgtypes.DelayMsgKeeper
+		stakingKeeper      types.StakingKeeper
 
 		// authorities stores addresses capable of sending a bridge message.
 		authorities map[string]struct{}
@@ -35,6 +36,7 @@ func NewKeeper(
 	bridgeEventManager *bridgeserver.BridgeEventManager,
 	bankKeeper types.BankKeeper,
 	delayMsgKeeper delaymsgtypes.DelayMsgKeeper,
+	stakingKeeper types.StakingKeeper,
 	authorities []string,
 ) *Keeper {
 	return &Keeper{
@@ -43,6 +45,7 @@ func NewKeeper(
 		bridgeEventManager: bridgeEventManager,
 		bankKeeper:         bankKeeper,
 		delayMsgKeeper:     delayMsgKeeper,
+		stakingKeeper:      stakingKeeper,
 		authorities:        lib.UniqueSliceToSet(authorities),
 	}
 }
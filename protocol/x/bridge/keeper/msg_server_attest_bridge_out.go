@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+)
+
+// AttestBridgeOut records a validator's signature of a pending withdrawal. The message must be signed by the
+// account of the validator operator.
+func (k msgServer) AttestBridgeOut(
+	goCtx context.Context,
+	msg *types.MsgAttestBridgeOut,
+) (*types.MsgAttestBridgeOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	finalized, err := k.Keeper.AttestBridgeOut(ctx, sdk.ValAddress(validator), msg.WithdrawalId, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestBridgeOutResponse{Finalized: finalized}, nil
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerAttestBridgeOut(t *testing.T) {
+	_, ms, goCtx := setupMsgServer(t)
+
+	tests := map[string]struct {
+		testMsg      types.MsgAttestBridgeOut
+		expectedResp *types.MsgAttestBridgeOutResponse
+		expectedErr  string
+	}{
+		"Failure: invalid validator": {
+			testMsg: types.MsgAttestBridgeOut{
+				Validator:    "12345",
+				WithdrawalId: 0,
+				Signature:    make([]byte, types.EthSignatureLength),
+			},
+			expectedErr: "decoding bech32 failed",
+		},
+		"Failure: withdrawal not found": {
+			testMsg: types.MsgAttestBridgeOut{
+				Validator:    constants.AliceAccAddress.String(),
+				WithdrawalId: 0,
+				Signature:    make([]byte, types.EthSignatureLength),
+			},
+			expectedErr: types.ErrWithdrawalNotFound.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := ms.AttestBridgeOut(goCtx, &tc.testMsg)
+
+			// Assert msg server response.
+			require.Equal(t, tc.expectedResp, resp)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+)
+
+// BridgeOut escrows coins from the sender and queues a withdrawal of them to an Ethereum address.
+func (k msgServer) BridgeOut(
+	goCtx context.Context,
+	msg *types.MsgBridgeOut,
+) (*types.MsgBridgeOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.BridgeOut(ctx, sender, msg.EthRecipient, msg.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBridgeOutResponse{Id: id}, nil
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerBridgeOut(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	require.NoError(t, k.UpdateEventParams(ctx, constants.EventParams_EthBridgeAddress))
+
+	tests := map[string]struct {
+		testMsg      types.MsgBridgeOut
+		expectedResp *types.MsgBridgeOutResponse
+		expectedErr  string
+	}{
+		"Success": {
+			testMsg: types.MsgBridgeOut{
+				Sender:       constants.AliceAccAddress.String(),
+				EthRecipient: constants.EthRecipientAddress,
+				Coin:         sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			},
+			expectedResp: &types.MsgBridgeOutResponse{Id: 0},
+		},
+		"Failure: invalid sender": {
+			testMsg: types.MsgBridgeOut{
+				Sender:       "12345",
+				EthRecipient: constants.EthRecipientAddress,
+				Coin:         sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			},
+			expectedErr: "decoding bech32 failed",
+		},
+		"Failure: coin is not the bridged token": {
+			testMsg: types.MsgBridgeOut{
+				Sender:       constants.AliceAccAddress.String(),
+				EthRecipient: constants.EthRecipientAddress,
+				Coin:         sdk.NewCoin("bridge-token", sdkmath.NewInt(888)),
+			},
+			expectedErr: types.ErrInvalidBridgeOutCoin.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := ms.BridgeOut(goCtx, &tc.testMsg)
+
+			// Assert msg server response.
+			require.Equal(t, tc.expectedResp, resp)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
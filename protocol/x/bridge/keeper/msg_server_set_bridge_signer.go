@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+)
+
+// SetBridgeSigner sets the Ethereum address that a validator signs withdrawals with. The message must be
+// signed by the account of the validator operator.
+func (k msgServer) SetBridgeSigner(
+	goCtx context.Context,
+	msg *types.MsgSetBridgeSigner,
+) (*types.MsgSetBridgeSignerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetBridgeSigner(ctx, sdk.ValAddress(validator), msg.EthSigner)
+
+	return &types.MsgSetBridgeSignerResponse{}, nil
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerSetBridgeSigner(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tests := map[string]struct {
+		testMsg      types.MsgSetBridgeSigner
+		expectedResp *types.MsgSetBridgeSignerResponse
+		expectedErr  string
+	}{
+		"Success": {
+			testMsg: types.MsgSetBridgeSigner{
+				Validator: constants.AliceAccAddress.String(),
+				EthSigner: constants.AliceBridgeSigner,
+			},
+			expectedResp: &types.MsgSetBridgeSignerResponse{},
+		},
+		"Failure: invalid validator": {
+			testMsg: types.MsgSetBridgeSigner{
+				Validator: "12345",
+				EthSigner: constants.AliceBridgeSigner,
+			},
+			expectedErr: "decoding bech32 failed",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := ms.SetBridgeSigner(goCtx, &tc.testMsg)
+
+			// Assert msg server response.
+			require.Equal(t, tc.expectedResp, resp)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+
+				// The signer is set for the validator operated by the signing account.
+				bridgeSigner, found := k.GetBridgeSigner(ctx, constants.AliceValAddress)
+				require.True(t, found)
+				require.Equal(t, tc.testMsg.EthSigner, bridgeSigner.EthSigner)
+			}
+		})
+	}
+}
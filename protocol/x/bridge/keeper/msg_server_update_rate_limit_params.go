@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+)
+
+// UpdateRateLimitParams sets the RateLimitParams of a source chain in state.
+func (k msgServer) UpdateRateLimitParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateRateLimitParams,
+) (*types.MsgUpdateRateLimitParamsResponse, error) {
+	if !k.Keeper.HasAuthority(msg.GetAuthority()) {
+		return nil, errors.Wrapf(
+			types.ErrInvalidAuthority,
+			"message authority %s is not valid for sending update rate limit params messages",
+			msg.GetAuthority(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.UpdateRateLimitParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateRateLimitParamsResponse{}, nil
+}
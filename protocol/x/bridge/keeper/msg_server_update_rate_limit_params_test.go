@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerUpdateRateLimitParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	tests := map[string]struct {
+		testMsg      types.MsgUpdateRateLimitParams
+		expectedResp *types.MsgUpdateRateLimitParamsResponse
+		expectedErr  string
+	}{
+		"Success": {
+			testMsg: types.MsgUpdateRateLimitParams{
+				Authority: lib.GovModuleAddress.String(),
+				Params:    constants.RateLimitParams,
+			},
+			expectedResp: &types.MsgUpdateRateLimitParamsResponse{},
+		},
+		"Failure: invalid authority": {
+			testMsg: types.MsgUpdateRateLimitParams{
+				Authority: "12345",
+				Params:    constants.RateLimitParams,
+			},
+			expectedErr: fmt.Sprintf(
+				"message authority %s is not valid for sending update rate limit params messages",
+				"12345",
+			),
+		},
+		"Failure: source chain not found": {
+			testMsg: types.MsgUpdateRateLimitParams{
+				Authority: lib.GovModuleAddress.String(),
+				Params: types.RateLimitParams{
+					EthChainId: constants.L2EthChainId,
+				},
+			},
+			expectedErr: types.ErrSourceChainNotFound.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := ms.UpdateRateLimitParams(ctx, &tc.testMsg)
+
+			// Assert msg server response.
+			require.Equal(t, tc.expectedResp, resp)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				params, found := k.GetRateLimitParams(sdk.UnwrapSDKContext(ctx), tc.testMsg.Params.EthChainId)
+				require.True(t, found)
+				require.Equal(t, tc.testMsg.Params, params)
+			}
+		})
+	}
+}
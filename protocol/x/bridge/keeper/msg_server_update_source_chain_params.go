@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+)
+
+// UpdateSourceChainParams adds or updates the SourceChainParams of an additional source chain in state.
+func (k msgServer) UpdateSourceChainParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateSourceChainParams,
+) (*types.MsgUpdateSourceChainParamsResponse, error) {
+	if !k.Keeper.HasAuthority(msg.GetAuthority()) {
+		return nil, errors.Wrapf(
+			types.ErrInvalidAuthority,
+			"message authority %s is not valid for sending update source chain params messages",
+			msg.GetAuthority(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.UpdateSourceChainParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateSourceChainParamsResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerUpdateSourceChainParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	tests := map[string]struct {
+		testMsg      types.MsgUpdateSourceChainParams
+		expectedResp *types.MsgUpdateSourceChainParamsResponse
+		expectedErr  string
+	}{
+		"Success": {
+			testMsg: types.MsgUpdateSourceChainParams{
+				Authority: lib.GovModuleAddress.String(),
+				Params:    constants.SourceChainParams_L2,
+			},
+			expectedResp: &types.MsgUpdateSourceChainParamsResponse{},
+		},
+		"Failure: invalid authority": {
+			testMsg: types.MsgUpdateSourceChainParams{
+				Authority: "12345",
+				Params:    constants.SourceChainParams_L2,
+			},
+			expectedErr: fmt.Sprintf(
+				"message authority %s is not valid for sending update source chain params messages",
+				"12345",
+			),
+		},
+		"Failure: source chain is chain of event params": {
+			testMsg: types.MsgUpdateSourceChainParams{
+				Authority: lib.GovModuleAddress.String(),
+				Params: types.SourceChainParams{
+					EventParams:  types.DefaultGenesis().EventParams,
+					SafetyParams: constants.SourceChainParams_L2.SafetyParams,
+				},
+			},
+			expectedErr: types.ErrInvalidSourceChain.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := ms.UpdateSourceChainParams(ctx, &tc.testMsg)
+
+			// Assert msg server response.
+			require.Equal(t, tc.expectedResp, resp)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				params, found := k.GetSourceChainParams(sdk.UnwrapSDKContext(ctx), tc.testMsg.Params.EventParams.EthChainId)
+				require.True(t, found)
+				require.Equal(t, tc.testMsg.Params, params)
+			}
+		})
+	}
+}
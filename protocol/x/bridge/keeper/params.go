@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 )
@@ -26,6 +27,13 @@ func (k Keeper) UpdateEventParams(
 	if err := params.Validate(); err != nil {
 		return err
 	}
+	if _, found := k.GetSourceChainParams(ctx, params.EthChainId); params.EthChainId != 0 && found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidSourceChain,
+			"Ethereum chain ID %d is an additional source chain",
+			params.EthChainId,
+		)
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&params)
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
 )
@@ -32,6 +33,18 @@ func TestUpdateEventParams_Success(t *testing.T) {
 	require.Equal(t, params, k.GetEventParams(ctx))
 }
 
+func TestUpdateEventParams_SourceChainError(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.BridgeKeeper
+	require.NoError(t, k.UpdateSourceChainParams(ctx, constants.SourceChainParams_L2))
+
+	// Event params cannot refer to a chain that is already an additional source chain.
+	params := constants.SourceChainParams_L2.EventParams
+	require.ErrorIs(t, k.UpdateEventParams(ctx, params), types.ErrInvalidSourceChain)
+	require.Equal(t, types.DefaultGenesis().EventParams, k.GetEventParams(ctx))
+}
+
 func TestGetProposeParams(t *testing.T) {
 	tApp := testapp.NewTestAppBuilder(t).Build()
 	ctx := tApp.InitChain()
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+)
+
+// GetRateLimitParams returns the `RateLimitParams` of the source chain with Ethereum chain ID
+// `ethChainId`, and whether they exist. Chain ID zero refers to the chain of `EventParams`.
+func (k Keeper) GetRateLimitParams(
+	ctx sdk.Context,
+	ethChainId uint64,
+) (
+	params types.RateLimitParams,
+	found bool,
+) {
+	b := k.getRateLimitParamsStore(ctx).Get(sdk.Uint64ToBigEndian(ethChainId))
+	if b == nil {
+		return params, false
+	}
+	k.cdc.MustUnmarshal(b, &params)
+	return params, true
+}
+
+// GetAllRateLimitParams returns the `RateLimitParams` of all source chains, sorted by Ethereum
+// chain ID.
+func (k Keeper) GetAllRateLimitParams(
+	ctx sdk.Context,
+) (
+	allParams []types.RateLimitParams,
+) {
+	iterator := k.getRateLimitParamsStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	allParams = make([]types.RateLimitParams, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var params types.RateLimitParams
+		k.cdc.MustUnmarshal(iterator.Value(), &params)
+		allParams = append(allParams, params)
+	}
+	return allParams
+}
+
+// UpdateRateLimitParams sets the `RateLimitParams` of a source chain in state.
+// Returns an error iff validation fails or the source chain does not exist.
+func (k Keeper) UpdateRateLimitParams(
+	ctx sdk.Context,
+	params types.RateLimitParams,
+) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if _, found := k.GetSourceChainParams(ctx, params.EthChainId); !found {
+		return errorsmod.Wrapf(types.ErrSourceChainNotFound, "Ethereum chain ID %d", params.EthChainId)
+	}
+
+	b := k.cdc.MustMarshal(&params)
+	k.getRateLimitParamsStore(ctx).Set(sdk.Uint64ToBigEndian(params.EthChainId), b)
+
+	return nil
+}
+
+// GetWindowUtilization returns the total amount bridged from the source chain with Ethereum chain
+// ID `ethChainId` within the current rolling window of its `RateLimitParams`, and the amount bridged
+// to `address` within the window. Both amounts are zero if the source chain is not rate limited.
+func (k Keeper) GetWindowUtilization(
+	ctx sdk.Context,
+	ethChainId uint64,
+	address string,
+) (
+	totalAmount *big.Int,
+	addressAmount *big.Int,
+) {
+	totalAmount = new(big.Int)
+	addressAmount = new(big.Int)
+	params, found := k.GetRateLimitParams(ctx, ethChainId)
+	if !found || params.WindowBlocks == 0 {
+		return totalAmount, addressAmount
+	}
+
+	iterator := k.getWindowBucketStore(ctx, ethChainId).Iterator(
+		lib.Uint32ToKey(getWindowStartBlockHeight(ctx, params.WindowBlocks)),
+		nil,
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket types.BridgeWindowBucket
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+		for _, entry := range bucket.Entries {
+			totalAmount.Add(totalAmount, entry.Amount.BigInt())
+			if entry.Address == address {
+				addressAmount.Add(addressAmount, entry.Amount.BigInt())
+			}
+		}
+	}
+	return totalAmount, addressAmount
+}
+
+// GetAllWindowBuckets returns the amounts bridged within the rolling windows of all source chains, sorted by
+// Ethereum chain ID and block height.
+func (k Keeper) GetAllWindowBuckets(ctx sdk.Context) []types.WindowBucket {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(types.WindowBucketKeyPrefix))
+	defer iterator.Close()
+
+	windowBuckets := make([]types.WindowBucket, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		// Keys are the Ethereum chain ID followed by the block height.
+		key := iterator.Key()[len(types.WindowBucketKeyPrefix):]
+		windowBucket := types.WindowBucket{
+			EthChainId:  sdk.BigEndianToUint64(key[:8]),
+			BlockHeight: binary.BigEndian.Uint32(key[8:]),
+		}
+		k.cdc.MustUnmarshal(iterator.Value(), &windowBucket.Bucket)
+		windowBuckets = append(windowBuckets, windowBucket)
+	}
+	return windowBuckets
+}
+
+// SetWindowBucket sets the amounts bridged from a source chain in a block. Used during genesis initialization.
+func (k Keeper) SetWindowBucket(ctx sdk.Context, windowBucket types.WindowBucket) {
+	k.getWindowBucketStore(ctx, windowBucket.EthChainId).Set(
+		lib.Uint32ToKey(windowBucket.BlockHeight),
+		k.cdc.MustMarshal(&windowBucket.Bucket),
+	)
+}
+
+// rateLimiter tracks the amounts bridged from a source chain within the rolling window of its
+// `RateLimitParams` while acknowledging bridge events.
+type rateLimiter struct {
+	params         types.RateLimitParams
+	totalAmount    *big.Int
+	addressAmounts map[string]*big.Int
+	newEntries     []types.BridgeWindowEntry
+}
+
+// newRateLimiter returns a `rateLimiter` for the source chain with Ethereum chain ID `ethChainId`,
+// or nil if the source chain is not rate limited.
+func (k Keeper) newRateLimiter(ctx sdk.Context, ethChainId uint64) *rateLimiter {
+	params, found := k.GetRateLimitParams(ctx, ethChainId)
+	if !found {
+		return nil
+	}
+	totalAmount, _ := k.GetWindowUtilization(ctx, ethChainId, "")
+	return &rateLimiter{
+		params:         params,
+		totalAmount:    totalAmount,
+		addressAmounts: make(map[string]*big.Int),
+	}
+}
+
+// addToRateLimiter adds the amount of `bridgeEvent` to the rolling window of rate limiter `r` and
+// returns whether the bridge event exceeds any of the limits, i.e. whether it should be delayed by
+// `ExtendedDelayBlocks`, along with the limit that is exceeded.
+func (k Keeper) addToRateLimiter(
+	ctx sdk.Context,
+	r *rateLimiter,
+	bridgeEvent types.BridgeEvent,
+) (exceedsLimit bool, reason string) {
+	amount := bridgeEvent.Coin.Amount.BigInt()
+	if amount.Sign() <= 0 {
+		return false, ""
+	}
+
+	addressAmount, exists := r.addressAmounts[bridgeEvent.Address]
+	if !exists {
+		_, addressAmount = k.GetWindowUtilization(ctx, bridgeEvent.EthChainId, bridgeEvent.Address)
+		r.addressAmounts[bridgeEvent.Address] = addressAmount
+	}
+	r.totalAmount.Add(r.totalAmount, amount)
+	addressAmount.Add(addressAmount, amount)
+	if r.params.WindowBlocks > 0 {
+		r.newEntries = append(r.newEntries, types.BridgeWindowEntry{
+			Address: bridgeEvent.Address,
+			Amount:  dtypes.NewIntFromBigInt(amount),
+		})
+	}
+
+	switch {
+	case types.IsLimitSet(r.params.LargeBridgeThreshold) &&
+		amount.Cmp(r.params.LargeBridgeThreshold.BigInt()) >= 0:
+		return true, "large_bridge"
+	case types.IsLimitSet(r.params.WindowCap) &&
+		r.totalAmount.Cmp(r.params.WindowCap.BigInt()) > 0:
+		return true, "window_cap"
+	case types.IsLimitSet(r.params.AddressWindowCap) &&
+		addressAmount.Cmp(r.params.AddressWindowCap.BigInt()) > 0:
+		return true, "address_window_cap"
+	}
+	return false, ""
+}
+
+// recordWindowBridges stores the amounts bridged in the current block to the rolling window of the
+// rate limiter's source chain and prunes amounts that are no longer within the window.
+func (k Keeper) recordWindowBridges(ctx sdk.Context, r *rateLimiter) {
+	if r.params.WindowBlocks == 0 {
+		return
+	}
+	store := k.getWindowBucketStore(ctx, r.params.EthChainId)
+
+	// Prune buckets of blocks before the start of the window.
+	iterator := store.Iterator(nil, lib.Uint32ToKey(getWindowStartBlockHeight(ctx, r.params.WindowBlocks)))
+	keysToDelete := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+
+	if len(r.newEntries) == 0 {
+		return
+	}
+	key := lib.Uint32ToKey(lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+	var bucket types.BridgeWindowBucket
+	if b := store.Get(key); b != nil {
+		k.cdc.MustUnmarshal(b, &bucket)
+	}
+	bucket.Entries = append(bucket.Entries, r.newEntries...)
+	store.Set(key, k.cdc.MustMarshal(&bucket))
+}
+
+// getWindowStartBlockHeight returns the first block height within a rolling window of `windowBlocks`
+// blocks that ends at the current block.
+func getWindowStartBlockHeight(ctx sdk.Context, windowBlocks uint32) uint32 {
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	if blockHeight < windowBlocks {
+		return 0
+	}
+	return blockHeight - windowBlocks + 1
+}
+
+// getRateLimitParamsStore returns a prefix store for the `RateLimitParams` of source chains, keyed by
+// Ethereum chain ID.
+func (k Keeper) getRateLimitParamsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitParamsKeyPrefix))
+}
+
+// getWindowBucketStore returns a prefix store for the `BridgeWindowBucket`s of the source chain with
+// Ethereum chain ID `ethChainId`, keyed by block height.
+func (k Keeper) getWindowBucketStore(ctx sdk.Context, ethChainId uint64) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append([]byte(types.WindowBucketKeyPrefix), sdk.Uint64ToBigEndian(ethChainId)...),
+	)
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateRateLimitParams(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+
+	// No source chain is rate limited initially.
+	_, found := k.GetRateLimitParams(ctx, 0)
+	require.False(t, found)
+	require.Empty(t, k.GetAllRateLimitParams(ctx))
+
+	// Rate limit the chain of event params and the L2 source chain.
+	require.NoError(t, k.UpdateSourceChainParams(ctx, constants.SourceChainParams_L2))
+	l2Params := constants.RateLimitParams
+	l2Params.EthChainId = constants.L2EthChainId
+	require.NoError(t, k.UpdateRateLimitParams(ctx, l2Params))
+	require.NoError(t, k.UpdateRateLimitParams(ctx, constants.RateLimitParams))
+
+	params, found := k.GetRateLimitParams(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, constants.RateLimitParams, params)
+	require.Equal(
+		t,
+		[]types.RateLimitParams{constants.RateLimitParams, l2Params},
+		k.GetAllRateLimitParams(ctx),
+	)
+
+	// Update existing rate limit params.
+	l2Params.WindowCap = dtypes.NewInt(0)
+	require.NoError(t, k.UpdateRateLimitParams(ctx, l2Params))
+	params, found = k.GetRateLimitParams(ctx, constants.L2EthChainId)
+	require.True(t, found)
+	require.Equal(t, l2Params, params)
+}
+
+func TestUpdateRateLimitParams_Error(t *testing.T) {
+	tests := map[string]struct {
+		params        func() types.RateLimitParams
+		expectedError error
+	}{
+		"Source chain not found": {
+			params: func() types.RateLimitParams {
+				params := constants.RateLimitParams
+				params.EthChainId = constants.L2EthChainId
+				return params
+			},
+			expectedError: types.ErrSourceChainNotFound,
+		},
+		"Window cap without window blocks": {
+			params: func() types.RateLimitParams {
+				params := constants.RateLimitParams
+				params.WindowBlocks = 0
+				return params
+			},
+			expectedError: types.ErrInvalidRateLimitParams,
+		},
+		"Negative large bridge threshold": {
+			params: func() types.RateLimitParams {
+				params := constants.RateLimitParams
+				params.LargeBridgeThreshold = dtypes.NewInt(-1)
+				return params
+			},
+			expectedError: types.ErrInvalidRateLimitParams,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+
+			err := k.UpdateRateLimitParams(ctx, tc.params())
+			require.ErrorIs(t, err, tc.expectedError)
+			require.Empty(t, k.GetAllRateLimitParams(ctx))
+		})
+	}
+}
+
+func TestGetWindowUtilization(t *testing.T) {
+	ctx, k, _, _, _, _, mockDelayMsgKeeper, _ := keepertest.BridgeKeepers(t)
+	mockDelayMsgKeeper.On("DelayMessageByBlocks", mock.Anything, mock.Anything, mock.Anything).Return(uint32(0), nil)
+	alice := constants.BridgeEvent_Id0_Height0.Address
+	bob := constants.BridgeEvent_Id1_Height0.Address
+
+	// Nothing is tracked if the source chain is not rate limited.
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, k.AcknowledgeBridges(ctx, []types.BridgeEvent{constants.BridgeEvent_Id0_Height0}))
+	total, addressAmount := k.GetWindowUtilization(ctx, 0, alice)
+	require.Zero(t, total.Sign())
+	require.Zero(t, addressAmount.Sign())
+
+	// Bridges are tracked within the window once the source chain is rate limited.
+	require.NoError(t, k.UpdateRateLimitParams(ctx, constants.RateLimitParams))
+	require.NoError(t, k.AcknowledgeBridges(ctx, []types.BridgeEvent{
+		constants.BridgeEvent_Id1_Height0,
+		constants.BridgeEvent_Id2_Height1,
+	}))
+	ctx = ctx.WithBlockHeight(6)
+	require.NoError(t, k.AcknowledgeBridges(ctx, []types.BridgeEvent{constants.BridgeEvent_Id3_Height3}))
+
+	total, addressAmount = k.GetWindowUtilization(ctx, 0, bob)
+	require.Equal(t, big.NewInt(3*888), total)
+	require.Equal(t, big.NewInt(2*888), addressAmount)
+	_, addressAmount = k.GetWindowUtilization(ctx, 0, alice)
+	require.Zero(t, addressAmount.Sign())
+
+	// Bridges of block 5 fall out of the window at block 15.
+	ctx = ctx.WithBlockHeight(14)
+	total, _ = k.GetWindowUtilization(ctx, 0, bob)
+	require.Equal(t, big.NewInt(3*888), total)
+	ctx = ctx.WithBlockHeight(15)
+	total, addressAmount = k.GetWindowUtilization(ctx, 0, bob)
+	require.Equal(t, big.NewInt(888), total)
+	require.Zero(t, addressAmount.Sign())
+	ctx = ctx.WithBlockHeight(16)
+	total, _ = k.GetWindowUtilization(ctx, 0, bob)
+	require.Zero(t, total.Sign())
+}
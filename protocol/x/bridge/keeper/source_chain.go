@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	gometrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+)
+
+// GetSourceChainParams returns the parameters of the source chain with Ethereum chain ID `ethChainId`,
+// and whether the source chain exists. Chain ID zero refers to the chain of `EventParams`, whose
+// parameters are `EventParams` and `SafetyParams`.
+func (k Keeper) GetSourceChainParams(
+	ctx sdk.Context,
+	ethChainId uint64,
+) (
+	params types.SourceChainParams,
+	found bool,
+) {
+	if ethChainId == 0 {
+		return types.SourceChainParams{
+			EventParams:  k.GetEventParams(ctx),
+			SafetyParams: k.GetSafetyParams(ctx),
+		}, true
+	}
+
+	b := k.getSourceChainParamsStore(ctx).Get(sdk.Uint64ToBigEndian(ethChainId))
+	if b == nil {
+		return params, false
+	}
+	k.cdc.MustUnmarshal(b, &params)
+	return params, true
+}
+
+// GetAllSourceChainParams returns the parameters of all additional source chains, sorted by
+// Ethereum chain ID. The chain of `EventParams` is not included.
+func (k Keeper) GetAllSourceChainParams(
+	ctx sdk.Context,
+) (
+	allParams []types.SourceChainParams,
+) {
+	iterator := k.getSourceChainParamsStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	allParams = make([]types.SourceChainParams, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var params types.SourceChainParams
+		k.cdc.MustUnmarshal(iterator.Value(), &params)
+		allParams = append(allParams, params)
+	}
+	return allParams
+}
+
+// GetSourceChainIds returns the Ethereum chain IDs of all source chains. The chain of `EventParams`
+// comes first as chain ID zero, followed by additional source chains sorted by Ethereum chain ID.
+func (k Keeper) GetSourceChainIds(ctx sdk.Context) []uint64 {
+	allParams := k.GetAllSourceChainParams(ctx)
+	ethChainIds := make([]uint64, 0, len(allParams)+1)
+	ethChainIds = append(ethChainIds, 0)
+	for _, params := range allParams {
+		ethChainIds = append(ethChainIds, params.EventParams.EthChainId)
+	}
+	return ethChainIds
+}
+
+// UpdateSourceChainParams adds or updates the parameters of an additional source chain in state.
+// Returns an error iff validation fails or the source chain is the chain of `EventParams`.
+func (k Keeper) UpdateSourceChainParams(
+	ctx sdk.Context,
+	params types.SourceChainParams,
+) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if params.EventParams.EthChainId == k.GetEventParams(ctx).EthChainId {
+		return errorsmod.Wrapf(
+			types.ErrInvalidSourceChain,
+			"Ethereum chain ID %d is the chain of event params",
+			params.EventParams.EthChainId,
+		)
+	}
+
+	b := k.cdc.MustMarshal(&params)
+	k.getSourceChainParamsStore(ctx).Set(sdk.Uint64ToBigEndian(params.EventParams.EthChainId), b)
+
+	return nil
+}
+
+// GetSourceChainAcknowledgedEventInfo returns the `AcknowledgedEventInfo` of the source chain with
+// Ethereum chain ID `ethChainId` from state. Chain ID zero refers to the chain of `EventParams`.
+func (k Keeper) GetSourceChainAcknowledgedEventInfo(
+	ctx sdk.Context,
+	ethChainId uint64,
+) (acknowledgedEventInfo types.BridgeEventInfo) {
+	if ethChainId == 0 {
+		return k.GetAcknowledgedEventInfo(ctx)
+	}
+
+	b := k.getSourceChainAcknowledgedEventInfoStore(ctx).Get(sdk.Uint64ToBigEndian(ethChainId))
+	if b == nil {
+		return acknowledgedEventInfo
+	}
+	k.cdc.MustUnmarshal(b, &acknowledgedEventInfo)
+	return acknowledgedEventInfo
+}
+
+// SetSourceChainAcknowledgedEventInfo sets the `AcknowledgedEventInfo` of the source chain with
+// Ethereum chain ID `ethChainId` in state. Chain ID zero refers to the chain of `EventParams`.
+func (k Keeper) SetSourceChainAcknowledgedEventInfo(
+	ctx sdk.Context,
+	ethChainId uint64,
+	acknowledgedEventInfo types.BridgeEventInfo,
+) error {
+	if ethChainId == 0 {
+		return k.SetAcknowledgedEventInfo(ctx, acknowledgedEventInfo)
+	}
+	if err := acknowledgedEventInfo.Validate(); err != nil {
+		return err
+	}
+
+	b := k.cdc.MustMarshal(&acknowledgedEventInfo)
+	k.getSourceChainAcknowledgedEventInfoStore(ctx).Set(sdk.Uint64ToBigEndian(ethChainId), b)
+
+	// Emit metrics on acknowledged event info.
+	labels := []gometrics.Label{metrics.GetLabelForIntValue(metrics.EthChainId, int(ethChainId))}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, metrics.AcknowledgedEventInfo, metrics.NextId},
+		float32(acknowledgedEventInfo.NextId),
+		labels,
+	)
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, metrics.AcknowledgedEventInfo, metrics.EthBlockHeight},
+		float32(acknowledgedEventInfo.EthBlockHeight),
+		labels,
+	)
+
+	return nil
+}
+
+// GetSourceChainRecognizedEventInfo returns the `RecognizedEventInfo` of the source chain with
+// Ethereum chain ID `ethChainId` from `BridgeEventManager`. Chain ID zero refers to the chain of
+// `EventParams`. These values are not in-consensus.
+func (k Keeper) GetSourceChainRecognizedEventInfo(
+	ctx sdk.Context,
+	ethChainId uint64,
+) (recognizedEventInfo types.BridgeEventInfo) {
+	return k.bridgeEventManager.GetRecognizedEventInfo(ethChainId)
+}
+
+// getSourceChainParamsStore returns a prefix store for the parameters of additional source chains,
+// keyed by Ethereum chain ID.
+func (k Keeper) getSourceChainParamsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SourceChainParamsKeyPrefix))
+}
+
+// getSourceChainAcknowledgedEventInfoStore returns a prefix store for the `AcknowledgedEventInfo` of
+// additional source chains, keyed by Ethereum chain ID.
+func (k Keeper) getSourceChainAcknowledgedEventInfoStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SourceChainAcknowledgedEventInfoKeyPrefix))
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSourceChainParams_PrimaryChain(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+
+	params, found := k.GetSourceChainParams(ctx, 0)
+	require.True(t, found)
+	require.Equal(
+		t,
+		types.SourceChainParams{
+			EventParams:  k.GetEventParams(ctx),
+			SafetyParams: k.GetSafetyParams(ctx),
+		},
+		params,
+	)
+}
+
+func TestUpdateSourceChainParams(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+
+	// Source chain does not exist initially.
+	_, found := k.GetSourceChainParams(ctx, constants.L2EthChainId)
+	require.False(t, found)
+	require.Empty(t, k.GetAllSourceChainParams(ctx))
+	require.Equal(t, []uint64{0}, k.GetSourceChainIds(ctx))
+
+	// Add source chains.
+	otherParams := constants.SourceChainParams_L2
+	otherParams.EventParams.EthChainId = 10
+	require.NoError(t, k.UpdateSourceChainParams(ctx, constants.SourceChainParams_L2))
+	require.NoError(t, k.UpdateSourceChainParams(ctx, otherParams))
+
+	params, found := k.GetSourceChainParams(ctx, constants.L2EthChainId)
+	require.True(t, found)
+	require.Equal(t, constants.SourceChainParams_L2, params)
+	require.Equal(
+		t,
+		[]types.SourceChainParams{otherParams, constants.SourceChainParams_L2},
+		k.GetAllSourceChainParams(ctx),
+	)
+	require.Equal(t, []uint64{0, 10, constants.L2EthChainId}, k.GetSourceChainIds(ctx))
+
+	// Update an existing source chain.
+	disabledParams := constants.SourceChainParams_L2
+	disabledParams.SafetyParams.IsDisabled = true
+	require.NoError(t, k.UpdateSourceChainParams(ctx, disabledParams))
+	params, found = k.GetSourceChainParams(ctx, constants.L2EthChainId)
+	require.True(t, found)
+	require.Equal(t, disabledParams, params)
+}
+
+func TestUpdateSourceChainParams_Error(t *testing.T) {
+	tests := map[string]struct {
+		params        func(primary types.EventParams) types.SourceChainParams
+		expectedError error
+	}{
+		"Chain ID is zero": {
+			params: func(primary types.EventParams) types.SourceChainParams {
+				params := constants.SourceChainParams_L2
+				params.EventParams.EthChainId = 0
+				return params
+			},
+			expectedError: types.ErrInvalidSourceChain,
+		},
+		"Chain ID is chain of event params": {
+			params: func(primary types.EventParams) types.SourceChainParams {
+				params := constants.SourceChainParams_L2
+				params.EventParams.EthChainId = primary.EthChainId
+				return params
+			},
+			expectedError: types.ErrInvalidSourceChain,
+		},
+		"Empty Ethereum address": {
+			params: func(primary types.EventParams) types.SourceChainParams {
+				params := constants.SourceChainParams_L2
+				params.EventParams.EthAddress = ""
+				return params
+			},
+			expectedError: types.ErrInvalidEthAddress,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+
+			err := k.UpdateSourceChainParams(ctx, tc.params(k.GetEventParams(ctx)))
+			require.ErrorIs(t, err, tc.expectedError)
+			require.Empty(t, k.GetAllSourceChainParams(ctx))
+		})
+	}
+}
+
+func TestSetSourceChainAcknowledgedEventInfo(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+
+	primaryInfo := types.BridgeEventInfo{
+		NextId:         3,
+		EthBlockHeight: 10,
+	}
+	l2Info := types.BridgeEventInfo{
+		NextId:         7,
+		EthBlockHeight: 200,
+	}
+
+	// Chain ID zero refers to the acknowledged event info of the chain of event params.
+	require.NoError(t, k.SetSourceChainAcknowledgedEventInfo(ctx, 0, primaryInfo))
+	require.Equal(t, primaryInfo, k.GetAcknowledgedEventInfo(ctx))
+	require.Equal(t, types.BridgeEventInfo{}, k.GetSourceChainAcknowledgedEventInfo(ctx, constants.L2EthChainId))
+
+	// Acknowledged event info of other source chains is stored separately.
+	require.NoError(t, k.SetSourceChainAcknowledgedEventInfo(ctx, constants.L2EthChainId, l2Info))
+	require.Equal(t, l2Info, k.GetSourceChainAcknowledgedEventInfo(ctx, constants.L2EthChainId))
+	require.Equal(t, primaryInfo, k.GetSourceChainAcknowledgedEventInfo(ctx, 0))
+}
+
+func TestGetSourceChainRecognizedEventInfo(t *testing.T) {
+	ctx, k, _, _, bridgeEventManager, _, _, _ := keepertest.BridgeKeepers(t)
+
+	l2Info := types.BridgeEventInfo{
+		NextId:         7,
+		EthBlockHeight: 200,
+	}
+	require.NoError(t, bridgeEventManager.SetRecognizedEventInfo(constants.L2EthChainId, l2Info))
+
+	require.Equal(t, l2Info, k.GetSourceChainRecognizedEventInfo(ctx, constants.L2EthChainId))
+	require.Equal(t, types.BridgeEventInfo{}, k.GetSourceChainRecognizedEventInfo(ctx, 0))
+	require.Equal(t, types.BridgeEventInfo{}, k.GetRecognizedEventInfo(ctx))
+}
@@ -2,6 +2,7 @@ package bridge_test
 
 import (
 	"bytes"
+	sdkmath "cosmossdk.io/math"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -45,7 +46,7 @@ func createAppModuleWithKeeper(t *testing.T) (
 	interfaceRegistry := types.NewInterfaceRegistry()
 	appCodec := codec.NewProtoCodec(interfaceRegistry)
 
-	ctx, keeper, _, _, bridgeEventManager, bankKeeper, _ := keeper.BridgeKeepers(t)
+	ctx, keeper, _, _, bridgeEventManager, bankKeeper, _, _ := keeper.BridgeKeepers(t)
 
 	return bridge.NewAppModule(
 		appCodec,
@@ -98,7 +99,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	mockRegistry.On("RegisterImplementations", (*sdk.Msg)(nil), mock.Anything).Return()
 	mockRegistry.On("RegisterImplementations", (*tx.MsgResponse)(nil), mock.Anything).Return()
 	am.RegisterInterfaces(mockRegistry)
-	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 10)
+	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 20)
 	mockRegistry.AssertExpectations(t)
 }
 
@@ -117,7 +118,9 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 			`"eth_address":"0xEf01c3A30eB57c91c40C52E996d29c202ae72193"},"propose_params":`+
 			`{"max_bridges_per_block":10,"propose_delay_duration":"60s","skip_rate_ppm":800000,`+
 			`"skip_if_block_delayed_by_duration":"5s"},"safety_params":{"is_disabled":false,`+
-			`"delay_blocks":86400},"acknowledged_event_info":{"next_id":0,"eth_block_height":"0"}}`,
+			`"delay_blocks":86400},"acknowledged_event_info":{"next_id":0,"eth_block_height":"0"},`+
+			`"source_chains":[],"rate_limit_params":[],"next_withdrawal_id":0,"withdrawals":[],`+
+			`"bridge_signers":[],"window_buckets":[]}`,
 		string(json),
 	)
 }
@@ -230,7 +233,10 @@ func TestAppModuleBasic_GetTxCmd(t *testing.T) {
 
 	cmd := am.GetTxCmd()
 	require.Equal(t, "bridge", cmd.Use)
-	require.Equal(t, 0, len(cmd.Commands()))
+	require.Equal(t, 3, len(cmd.Commands()))
+	require.Equal(t, "attest-bridge-out", cmd.Commands()[0].Name())
+	require.Equal(t, "bridge-out", cmd.Commands()[1].Name())
+	require.Equal(t, "set-bridge-signer", cmd.Commands()[2].Name())
 }
 
 func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
@@ -238,13 +244,20 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "bridge", cmd.Use)
-	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, 13, len(cmd.Commands()))
 	require.Equal(t, "get-acknowledged-event-info", cmd.Commands()[0].Name())
-	require.Equal(t, "get-delayed-complete-bridge-messages", cmd.Commands()[1].Name())
-	require.Equal(t, "get-event-params", cmd.Commands()[2].Name())
-	require.Equal(t, "get-propose-params", cmd.Commands()[3].Name())
-	require.Equal(t, "get-recognized-event-info", cmd.Commands()[4].Name())
-	require.Equal(t, "get-safety-params", cmd.Commands()[5].Name())
+	require.Equal(t, "get-bridge-signer", cmd.Commands()[1].Name())
+	require.Equal(t, "get-delayed-complete-bridge-messages", cmd.Commands()[2].Name())
+	require.Equal(t, "get-event-params", cmd.Commands()[3].Name())
+	require.Equal(t, "get-propose-params", cmd.Commands()[4].Name())
+	require.Equal(t, "get-recognized-event-info", cmd.Commands()[5].Name())
+	require.Equal(t, "get-safety-params", cmd.Commands()[6].Name())
+	require.Equal(t, "get-window-utilization", cmd.Commands()[7].Name())
+	require.Equal(t, "get-withdrawal", cmd.Commands()[8].Name())
+	require.Equal(t, "list-finalized-withdrawals", cmd.Commands()[9].Name())
+	require.Equal(t, "list-pending-withdrawals", cmd.Commands()[10].Name())
+	require.Equal(t, "list-rate-limit-params", cmd.Commands()[11].Name())
+	require.Equal(t, "list-source-chain-params", cmd.Commands()[12].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -300,7 +313,8 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected += `"eth_address":"0xEf01c3A30eB57c91c40C52E996d29c202ae72193"},"propose_params":{`
 	expected += `"max_bridges_per_block":10,"propose_delay_duration":"60s","skip_rate_ppm":800000,`
 	expected += `"skip_if_block_delayed_by_duration":"5s"},"safety_params":{"is_disabled":false,"delay_blocks":86400},`
-	expected += `"acknowledged_event_info":{"next_id":0,"eth_block_height":"0"}}`
+	expected += `"acknowledged_event_info":{"next_id":0,"eth_block_height":"0"},"source_chains":[],"rate_limit_params":[],`
+	expected += `"next_withdrawal_id":0,"withdrawals":[],"bridge_signers":[],"window_buckets":[]}`
 	require.Equal(t, expected, string(genesisJson))
 }
 
@@ -327,10 +341,12 @@ func TestAppModule_BeginBlock(t *testing.T) {
 }
 
 func TestAppModule_EndBlock(t *testing.T) {
-	am := createAppModule(t)
+	ctx, bridgeKeeper, _, _, _, _, _, mockStakingKeeper := keeper.BridgeKeepers(t)
+	mockStakingKeeper.On("GetLastTotalPower", ctx).Return(sdkmath.NewInt(10))
+	am := bridge.NewAppModule(codec.NewProtoCodec(types.NewInterfaceRegistry()), *bridgeKeeper)
 
-	var ctx sdk.Context
 	var req abci.RequestEndBlock
 	result := am.EndBlock(ctx, req)
 	require.Equal(t, 0, len(result))
+	mockStakingKeeper.AssertExpectations(t)
 }
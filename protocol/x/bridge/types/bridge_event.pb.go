@@ -35,6 +35,9 @@ type BridgeEvent struct {
 	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	// The Ethereum block height of the event.
 	EthBlockHeight uint64 `protobuf:"varint,4,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	// The numerical chain ID of the Ethereum chain that the event was emitted
+	// on. Zero refers to the chain of `EventParams`.
+	EthChainId uint64 `protobuf:"varint,5,opt,name=eth_chain_id,json=ethChainId,proto3" json:"eth_chain_id,omitempty"`
 }
 
 func (m *BridgeEvent) Reset()         { *m = BridgeEvent{} }
@@ -98,6 +101,13 @@ func (m *BridgeEvent) GetEthBlockHeight() uint64 {
 	return 0
 }
 
+func (m *BridgeEvent) GetEthChainId() uint64 {
+	if m != nil {
+		return m.EthChainId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BridgeEvent)(nil), "dydxprotocol.bridge.BridgeEvent")
 }
@@ -107,27 +117,28 @@ func init() {
 }
 
 var fileDescriptor_d8b4b572ecddaf6f = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbd, 0x4e, 0xeb, 0x30,
-	0x18, 0x86, 0xe3, 0x9e, 0xe8, 0x20, 0x52, 0x51, 0xa1, 0xd0, 0x21, 0xed, 0x60, 0x22, 0x06, 0x94,
-	0xa5, 0xb1, 0xda, 0x32, 0xb0, 0x12, 0x84, 0xc4, 0x1c, 0x36, 0x96, 0x28, 0xfe, 0x91, 0x6d, 0xd1,
-	0xc6, 0x55, 0x6c, 0xaa, 0xf6, 0x2e, 0xb8, 0x14, 0x06, 0x2e, 0xa2, 0x63, 0xc5, 0xc4, 0x84, 0x50,
-	0x7b, 0x23, 0x28, 0x76, 0x8a, 0x60, 0xb2, 0xbf, 0xf7, 0x79, 0xfc, 0x5a, 0xfa, 0x82, 0x4b, 0xba,
-	0xa6, 0xab, 0x45, 0xad, 0x8c, 0x22, 0x6a, 0x86, 0x70, 0x2d, 0x29, 0x67, 0xed, 0x51, 0xb0, 0x25,
-	0xab, 0x4c, 0x6a, 0x61, 0x78, 0xf6, 0xdb, 0x4b, 0x9d, 0x30, 0xec, 0x73, 0xc5, 0x95, 0x0d, 0x51,
-	0x73, 0x73, 0xea, 0x70, 0x40, 0x94, 0x9e, 0x2b, 0x5d, 0x38, 0xe0, 0x86, 0x16, 0x41, 0x37, 0x21,
-	0x5c, 0x6a, 0x86, 0x96, 0x63, 0xcc, 0x4c, 0x39, 0x46, 0x44, 0xc9, 0xca, 0xf1, 0x8b, 0x57, 0x10,
-	0x74, 0x33, 0xdb, 0x7d, 0xd7, 0xfc, 0x1d, 0xf6, 0x82, 0x8e, 0xa4, 0x11, 0x88, 0x41, 0x72, 0x92,
-	0x77, 0x24, 0x0d, 0xa7, 0x81, 0xdf, 0xd8, 0x51, 0x27, 0x06, 0x49, 0x77, 0x32, 0x48, 0xdb, 0xf2,
-	0xa6, 0x2e, 0x6d, 0xeb, 0xd2, 0x5b, 0x25, 0xab, 0xcc, 0xdf, 0x7c, 0x9e, 0x7b, 0xb9, 0x95, 0xc3,
-	0x49, 0x70, 0x54, 0x52, 0x5a, 0x33, 0xad, 0xa3, 0x7f, 0x31, 0x48, 0x8e, 0xb3, 0xe8, 0xfd, 0x6d,
-	0xd4, 0x6f, 0x9f, 0xde, 0x38, 0xf2, 0x60, 0x6a, 0x59, 0xf1, 0xfc, 0x20, 0x86, 0x49, 0x70, 0xca,
-	0x8c, 0x28, 0xf0, 0x4c, 0x91, 0xa7, 0x42, 0x30, 0xc9, 0x85, 0x89, 0xfc, 0x18, 0x24, 0x7e, 0xde,
-	0x63, 0x46, 0x64, 0x4d, 0x7c, 0x6f, 0xd3, 0x2c, 0xdf, 0xec, 0x20, 0xd8, 0xee, 0x20, 0xf8, 0xda,
-	0x41, 0xf0, 0xb2, 0x87, 0xde, 0x76, 0x0f, 0xbd, 0x8f, 0x3d, 0xf4, 0x1e, 0xaf, 0xb9, 0x34, 0xe2,
-	0x19, 0xa7, 0x44, 0xcd, 0xd1, 0x9f, 0x2d, 0x2f, 0xaf, 0x46, 0x44, 0x94, 0xb2, 0x42, 0x3f, 0xc9,
-	0xea, 0xb0, 0x79, 0xb3, 0x5e, 0x30, 0x8d, 0xff, 0x5b, 0x30, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0xcc, 0x75, 0xa1, 0x2a, 0x9d, 0x01, 0x00, 0x00,
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x31, 0x4e, 0xf3, 0x30,
+	0x18, 0x86, 0xe3, 0xfe, 0xf9, 0x41, 0xb8, 0x50, 0xa1, 0xd0, 0x21, 0xed, 0x60, 0x22, 0x06, 0x94,
+	0xa5, 0xb1, 0xda, 0x32, 0xb0, 0x92, 0x0a, 0x09, 0xd6, 0xb0, 0xb1, 0x44, 0x49, 0x6c, 0xc5, 0x16,
+	0x6d, 0x5c, 0xc5, 0xa6, 0x6a, 0x6f, 0xc1, 0x61, 0x38, 0x44, 0xc7, 0xaa, 0x13, 0x13, 0x42, 0xed,
+	0x45, 0x90, 0xed, 0x14, 0xc1, 0x64, 0x7f, 0xef, 0xf3, 0xf8, 0xf5, 0xf0, 0xc1, 0x6b, 0xb2, 0x22,
+	0xcb, 0x79, 0x2d, 0x94, 0x28, 0xc4, 0x14, 0xe7, 0x35, 0x27, 0x25, 0x6d, 0x8e, 0x94, 0x2e, 0x68,
+	0xa5, 0x22, 0x03, 0xbd, 0x8b, 0xdf, 0x5e, 0x64, 0x85, 0x7e, 0xb7, 0x14, 0xa5, 0x30, 0x21, 0xd6,
+	0x37, 0xab, 0xf6, 0x7b, 0x85, 0x90, 0x33, 0x21, 0x53, 0x0b, 0xec, 0xd0, 0x20, 0x64, 0x27, 0x9c,
+	0x67, 0x92, 0xe2, 0xc5, 0x30, 0xa7, 0x2a, 0x1b, 0xe2, 0x42, 0xf0, 0xca, 0xf2, 0xab, 0x2d, 0x80,
+	0xed, 0xd8, 0x74, 0xdf, 0xeb, 0xbf, 0xbd, 0x0e, 0x6c, 0x71, 0xe2, 0x83, 0x00, 0x84, 0x67, 0x49,
+	0x8b, 0x13, 0x6f, 0x0c, 0x5d, 0x6d, 0xfb, 0xad, 0x00, 0x84, 0xed, 0x51, 0x2f, 0x6a, 0xca, 0x75,
+	0x5d, 0xd4, 0xd4, 0x45, 0x13, 0xc1, 0xab, 0xd8, 0x5d, 0x7f, 0x5e, 0x3a, 0x89, 0x91, 0xbd, 0x11,
+	0x3c, 0xce, 0x08, 0xa9, 0xa9, 0x94, 0xfe, 0xbf, 0x00, 0x84, 0x27, 0xb1, 0xbf, 0x7d, 0x1f, 0x74,
+	0x9b, 0xa7, 0x77, 0x96, 0x3c, 0xa9, 0x9a, 0x57, 0x65, 0x72, 0x10, 0xbd, 0x10, 0x9e, 0x53, 0xc5,
+	0xd2, 0x7c, 0x2a, 0x8a, 0x97, 0x94, 0x51, 0x5e, 0x32, 0xe5, 0xbb, 0x01, 0x08, 0xdd, 0xa4, 0x43,
+	0x15, 0x8b, 0x75, 0xfc, 0x60, 0x52, 0x2f, 0x80, 0xa7, 0xda, 0x2c, 0x58, 0xc6, 0xab, 0x94, 0x13,
+	0xff, 0xbf, 0xb1, 0x20, 0x55, 0x6c, 0xa2, 0xa3, 0x47, 0x12, 0x27, 0xeb, 0x1d, 0x02, 0x9b, 0x1d,
+	0x02, 0x5f, 0x3b, 0x04, 0xde, 0xf6, 0xc8, 0xd9, 0xec, 0x91, 0xf3, 0xb1, 0x47, 0xce, 0xf3, 0x6d,
+	0xc9, 0x15, 0x7b, 0xcd, 0xa3, 0x42, 0xcc, 0xf0, 0x9f, 0x3d, 0x2c, 0x6e, 0x06, 0xa6, 0x10, 0xff,
+	0x24, 0xcb, 0xc3, 0x6e, 0xd4, 0x6a, 0x4e, 0x65, 0x7e, 0x64, 0xc0, 0xf8, 0x7b, 0x00, 0x8c, 0x4e,
+	0xe3, 0xc0, 0xbf, 0x01, 0x00, 0x00,
 }
 
 func (m *BridgeEvent) Marshal() (dAtA []byte, err error) {
@@ -150,6 +161,11 @@ func (m *BridgeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EthChainId != 0 {
+		i = encodeVarintBridgeEvent(dAtA, i, uint64(m.EthChainId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.EthBlockHeight != 0 {
 		i = encodeVarintBridgeEvent(dAtA, i, uint64(m.EthBlockHeight))
 		i--
@@ -209,6 +225,9 @@ func (m *BridgeEvent) Size() (n int) {
 	if m.EthBlockHeight != 0 {
 		n += 1 + sovBridgeEvent(uint64(m.EthBlockHeight))
 	}
+	if m.EthChainId != 0 {
+		n += 1 + sovBridgeEvent(uint64(m.EthChainId))
+	}
 	return n
 }
 
@@ -350,6 +369,25 @@ func (m *BridgeEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			}
+			m.EthChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridgeEvent(dAtA[iNdEx:])
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// EthSignatureLength is the length of an [R || S || V] secp256k1 signature.
+	EthSignatureLength = crypto.SignatureLength
+
+	// WithdrawalTimeout is how long after its creation a withdrawal can be executed by the Ethereum contract.
+	// It is much shorter than the unbonding time so that validators remain slashable for their attestations
+	// until the withdrawal expires.
+	WithdrawalTimeout = 24 * time.Hour
+
+	// WithdrawalRefundDelay is how long after its expiration a pending withdrawal is refunded to its sender.
+	// The delay accounts for clock drift between this chain and Ethereum.
+	WithdrawalRefundDelay = time.Hour
+)
+
+var (
+	// withdrawalDigestArguments are the abi types of the values hashed into a withdrawal digest.
+	withdrawalDigestArguments = abi.Arguments{
+		{Type: mustNewAbiType("uint256")}, // Ethereum chain id.
+		{Type: mustNewAbiType("address")}, // Ethereum bridge contract address.
+		{Type: mustNewAbiType("uint256")}, // Withdrawal id.
+		{Type: mustNewAbiType("address")}, // Ethereum recipient.
+		{Type: mustNewAbiType("uint256")}, // Amount.
+		{Type: mustNewAbiType("uint256")}, // Expiration time, in unix seconds.
+	}
+)
+
+func mustNewAbiType(t string) abi.Type {
+	abiType, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return abiType
+}
+
+// ValidateEthAddress returns an error if `address` is not a hex-encoded Ethereum address.
+func ValidateEthAddress(address string) error {
+	if !common.IsHexAddress(address) {
+		return errorsmod.Wrapf(ErrInvalidEthAddress, "'%s' is not a hex-encoded Ethereum address", address)
+	}
+	return nil
+}
+
+// Validate returns an error if the withdrawal is invalid.
+func (w BridgeWithdrawal) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(w.Sender); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "withdrawal id %d sender '%s': %v", w.Id, w.Sender, err)
+	}
+	if err := ValidateEthAddress(w.EthRecipient); err != nil {
+		return err
+	}
+	if err := w.Coin.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidBridgeOutCoin, "withdrawal id %d: %v", w.Id, err)
+	}
+	if !w.Coin.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBridgeOutCoin, "withdrawal id %d coin %s must be positive", w.Id, w.Coin)
+	}
+	validators := make(map[string]struct{}, len(w.Attestations))
+	for _, attestation := range w.Attestations {
+		if _, err := sdk.ValAddressFromBech32(attestation.ValidatorAddress); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidAddress,
+				"withdrawal id %d attestation validator '%s': %v",
+				w.Id,
+				attestation.ValidatorAddress,
+				err,
+			)
+		}
+		if _, exists := validators[attestation.ValidatorAddress]; exists {
+			return errorsmod.Wrapf(
+				ErrDuplicateAttestation,
+				"validator %s, withdrawal id %d",
+				attestation.ValidatorAddress,
+				w.Id,
+			)
+		}
+		validators[attestation.ValidatorAddress] = struct{}{}
+		if err := ValidateEthAddress(attestation.EthSigner); err != nil {
+			return err
+		}
+		if len(attestation.Signature) != EthSignatureLength {
+			return errorsmod.Wrapf(
+				ErrInvalidWithdrawalSignature,
+				"withdrawal id %d signature must be %d bytes, got %d",
+				w.Id,
+				EthSignatureLength,
+				len(attestation.Signature),
+			)
+		}
+		if attestation.Power <= 0 {
+			return errorsmod.Wrapf(
+				ErrValidatorNotBonded,
+				"withdrawal id %d attestation power %d must be positive",
+				w.Id,
+				attestation.Power,
+			)
+		}
+	}
+	return nil
+}
+
+// GetDigest returns the digest of the withdrawal that validators sign and the Ethereum bridge contract at
+// `ethBridgeAddress` on the chain with id `ethChainId` verifies. The digest is
+//
+//	keccak256(abi.encode(ethChainId, ethBridgeAddress, id, ethRecipient, amount, expirationTime))
+//
+// The chain id and contract address are included so that signatures cannot be replayed on other contracts.
+// The contract must reject withdrawals after their expiration time, as pending withdrawals are refunded.
+func (w BridgeWithdrawal) GetDigest(ethChainId uint64, ethBridgeAddress string) ([]byte, error) {
+	if err := ValidateEthAddress(ethBridgeAddress); err != nil {
+		return nil, err
+	}
+	if err := ValidateEthAddress(w.EthRecipient); err != nil {
+		return nil, err
+	}
+	encoded, err := withdrawalDigestArguments.Pack(
+		new(big.Int).SetUint64(ethChainId),
+		common.HexToAddress(ethBridgeAddress),
+		new(big.Int).SetUint64(uint64(w.Id)),
+		common.HexToAddress(w.EthRecipient),
+		w.Coin.Amount.BigInt(),
+		big.NewInt(w.ExpirationTime.Unix()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(encoded), nil
+}
+
+// HasAttestation returns whether the validator with operator address `validatorAddress` attested to the
+// withdrawal.
+func (w BridgeWithdrawal) HasAttestation(validatorAddress string) bool {
+	for _, attestation := range w.Attestations {
+		if attestation.ValidatorAddress == validatorAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinalized returns whether validators with more than two thirds of the voting power attested to the
+// withdrawal.
+func (w BridgeWithdrawal) IsFinalized() bool {
+	return w.FinalizedBlockHeight != 0
+}
+
+// IsExpired returns whether the Ethereum contract rejects the withdrawal at `blockTime`.
+func (w BridgeWithdrawal) IsExpired(blockTime time.Time) bool {
+	return blockTime.After(w.ExpirationTime)
+}
+
+// IsRefundable returns whether the withdrawal, if still pending at `blockTime`, is refunded to its sender.
+func (w BridgeWithdrawal) IsRefundable(blockTime time.Time) bool {
+	return blockTime.After(w.ExpirationTime.Add(WithdrawalRefundDelay))
+}
+
+// GetAttestedPower returns the sum of the consensus power that validators had when they attested to the
+// withdrawal.
+func (w BridgeWithdrawal) GetAttestedPower() int64 {
+	attestedPower := int64(0)
+	for _, attestation := range w.Attestations {
+		attestedPower += attestation.Power
+	}
+	return attestedPower
+}
+
+// Validate returns an error if the bridge signer is invalid.
+func (s BridgeSigner) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(s.ValidatorAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "bridge signer validator '%s': %v", s.ValidatorAddress, err)
+	}
+	return ValidateEthAddress(s.EthSigner)
+}
+
+// RecoverEthSigner returns the hex-encoded Ethereum address that produced the [R || S || V] secp256k1
+// `signature` of `digest`, as Ethereum's `ecrecover` would. V may be either 0/1 or 27/28.
+func RecoverEthSigner(digest []byte, signature []byte) (string, error) {
+	if len(signature) != EthSignatureLength {
+		return "", errorsmod.Wrapf(
+			ErrInvalidWithdrawalSignature,
+			"signature must be %d bytes, got %d",
+			EthSignatureLength,
+			len(signature),
+		)
+	}
+
+	// Normalize V to 0/1, which is what go-ethereum expects.
+	normalizedSignature := make([]byte, EthSignatureLength)
+	copy(normalizedSignature, signature)
+	if normalizedSignature[crypto.RecoveryIDOffset] >= 27 {
+		normalizedSignature[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(digest, normalizedSignature)
+	if err != nil {
+		return "", errorsmod.Wrap(ErrInvalidWithdrawalSignature, err.Error())
+	}
+	return crypto.PubkeyToAddress(*pubKey).Hex(), nil
+}
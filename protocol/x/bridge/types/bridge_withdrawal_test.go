@@ -0,0 +1,299 @@
+package types_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+// ecrecoverPrecompileAddress is the address of Ethereum's `ecrecover` precompiled contract.
+var ecrecoverPrecompileAddress = common.BytesToAddress([]byte{1})
+
+// withdrawalVerifierContract is the EVM bytecode of a minimal bridge contract that verifies a withdrawal the way
+// the Ethereum bridge contract does. Its calldata is
+//
+//	abi.encode(id, ethRecipient, amount, expirationTime, v, r, s)
+//
+// It reverts if the block timestamp is after the expiration time. Otherwise it computes the withdrawal digest
+// from the chain id and its own address and returns the address that `ecrecover` recovers from the signature.
+var withdrawalVerifierContract = []byte{
+	byte(vm.PUSH1), 0x60, byte(vm.CALLDATALOAD), // expirationTime
+	byte(vm.TIMESTAMP), byte(vm.GT), // block.timestamp > expirationTime
+	byte(vm.PUSH1), 0x38, byte(vm.JUMPI), // Jump to revert.
+	byte(vm.CHAINID), byte(vm.PUSH1), 0x00, byte(vm.MSTORE), // mem[0x00:0x20] = chain id
+	byte(vm.ADDRESS), byte(vm.PUSH1), 0x20, byte(vm.MSTORE), // mem[0x20:0x40] = address(this)
+	// mem[0x40:0xc0] = id, ethRecipient, amount, expirationTime
+	byte(vm.PUSH1), 0x80, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x40, byte(vm.CALLDATACOPY),
+	// mem[0x00:0x20] = keccak256(mem[0x00:0xc0])
+	byte(vm.PUSH1), 0xc0, byte(vm.PUSH1), 0x00, byte(vm.KECCAK256), byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+	// mem[0x20:0x80] = v, r, s
+	byte(vm.PUSH1), 0x60, byte(vm.PUSH1), 0x80, byte(vm.PUSH1), 0x20, byte(vm.CALLDATACOPY),
+	// mem[0xc0:0xe0] = ecrecover(mem[0x00:0x80])
+	byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0xc0, byte(vm.PUSH1), 0x80, byte(vm.PUSH1), 0x00,
+	byte(vm.PUSH1), 0x01, byte(vm.GAS), byte(vm.STATICCALL), byte(vm.POP),
+	byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0xc0, byte(vm.RETURN),
+	byte(vm.JUMPDEST), byte(vm.PUSH1), 0x00, byte(vm.DUP1), byte(vm.REVERT),
+}
+
+func TestValidateEthAddress(t *testing.T) {
+	require.NoError(t, types.ValidateEthAddress(constants.EthRecipientAddress))
+	require.NoError(t, types.ValidateEthAddress("52908400098527886e0f7030069857d2e4169ee7"))
+	require.ErrorIs(t, types.ValidateEthAddress(""), types.ErrInvalidEthAddress)
+	require.ErrorIs(t, types.ValidateEthAddress("0x1234"), types.ErrInvalidEthAddress)
+	require.ErrorIs(t, types.ValidateEthAddress(constants.AliceAccAddress.String()), types.ErrInvalidEthAddress)
+}
+
+func TestBridgeWithdrawal_GetDigest(t *testing.T) {
+	withdrawal := constants.BridgeWithdrawal_Id0_Height1
+	params := constants.EventParams_EthBridgeAddress
+
+	digest, err := withdrawal.GetDigest(params.EthChainId, params.EthAddress)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		// keccak256(abi.encode(ethChainId, ethBridgeAddress, id, ethRecipient, amount, expirationTime))
+		ethcrypto.Keccak256(
+			common.LeftPadBytes(new(big.Int).SetUint64(params.EthChainId).Bytes(), 32),
+			common.LeftPadBytes(common.HexToAddress(params.EthAddress).Bytes(), 32),
+			common.LeftPadBytes(new(big.Int).SetUint64(uint64(withdrawal.Id)).Bytes(), 32),
+			common.LeftPadBytes(common.HexToAddress(withdrawal.EthRecipient).Bytes(), 32),
+			common.LeftPadBytes(withdrawal.Coin.Amount.BigInt().Bytes(), 32),
+			common.LeftPadBytes(big.NewInt(withdrawal.ExpirationTime.Unix()).Bytes(), 32),
+		),
+		digest,
+	)
+
+	// The digest commits to the chain id, bridge contract, and withdrawal.
+	otherDigests := make([][]byte, 0)
+	otherDigest, err := withdrawal.GetDigest(params.EthChainId+1, params.EthAddress)
+	require.NoError(t, err)
+	otherDigests = append(otherDigests, otherDigest)
+	otherDigest, err = withdrawal.GetDigest(params.EthChainId, constants.EthRecipientAddress)
+	require.NoError(t, err)
+	otherDigests = append(otherDigests, otherDigest)
+	otherWithdrawal := withdrawal
+	otherWithdrawal.Id = 1
+	otherDigest, err = otherWithdrawal.GetDigest(params.EthChainId, params.EthAddress)
+	require.NoError(t, err)
+	otherDigests = append(otherDigests, otherDigest)
+	otherWithdrawal = withdrawal
+	otherWithdrawal.ExpirationTime = withdrawal.ExpirationTime.Add(time.Second)
+	otherDigest, err = otherWithdrawal.GetDigest(params.EthChainId, params.EthAddress)
+	require.NoError(t, err)
+	otherDigests = append(otherDigests, otherDigest)
+	for _, otherDigest := range otherDigests {
+		require.NotEqual(t, digest, otherDigest)
+	}
+
+	// Invalid addresses.
+	_, err = withdrawal.GetDigest(params.EthChainId, "invalid")
+	require.ErrorIs(t, err, types.ErrInvalidEthAddress)
+	otherWithdrawal.EthRecipient = "invalid"
+	_, err = otherWithdrawal.GetDigest(params.EthChainId, params.EthAddress)
+	require.ErrorIs(t, err, types.ErrInvalidEthAddress)
+}
+
+func TestBridgeWithdrawal_HasAttestation(t *testing.T) {
+	withdrawal := constants.BridgeWithdrawal_Id0_Height1
+	require.False(t, withdrawal.HasAttestation(constants.AliceValAddress.String()))
+
+	withdrawal.Attestations = []types.BridgeWithdrawalAttestation{
+		{
+			ValidatorAddress: constants.AliceValAddress.String(),
+			EthSigner:        constants.AliceBridgeSigner,
+		},
+	}
+	require.True(t, withdrawal.HasAttestation(constants.AliceValAddress.String()))
+	require.False(t, withdrawal.HasAttestation(constants.BobValAddress.String()))
+}
+
+func TestBridgeWithdrawal_IsFinalized(t *testing.T) {
+	withdrawal := constants.BridgeWithdrawal_Id0_Height1
+	require.False(t, withdrawal.IsFinalized())
+
+	withdrawal.FinalizedBlockHeight = 2
+	require.True(t, withdrawal.IsFinalized())
+}
+
+func TestBridgeWithdrawal_IsExpired(t *testing.T) {
+	withdrawal := constants.BridgeWithdrawal_Id0_Height1
+	require.False(t, withdrawal.IsExpired(withdrawal.ExpirationTime.Add(-time.Second)))
+	require.False(t, withdrawal.IsExpired(withdrawal.ExpirationTime))
+	require.True(t, withdrawal.IsExpired(withdrawal.ExpirationTime.Add(time.Second)))
+}
+
+func TestBridgeWithdrawal_IsRefundable(t *testing.T) {
+	withdrawal := constants.BridgeWithdrawal_Id0_Height1
+	require.False(t, withdrawal.IsRefundable(withdrawal.ExpirationTime.Add(time.Second)))
+	require.False(t, withdrawal.IsRefundable(withdrawal.ExpirationTime.Add(types.WithdrawalRefundDelay)))
+	require.True(
+		t,
+		withdrawal.IsRefundable(withdrawal.ExpirationTime.Add(types.WithdrawalRefundDelay+time.Second)),
+	)
+}
+
+func TestBridgeWithdrawal_GetAttestedPower(t *testing.T) {
+	withdrawal := constants.BridgeWithdrawal_Id0_Height1
+	require.Equal(t, int64(0), withdrawal.GetAttestedPower())
+
+	withdrawal.Attestations = []types.BridgeWithdrawalAttestation{
+		{ValidatorAddress: constants.AliceValAddress.String(), Power: 5},
+		{ValidatorAddress: constants.BobValAddress.String(), Power: 3},
+	}
+	require.Equal(t, int64(8), withdrawal.GetAttestedPower())
+}
+
+func TestRecoverEthSigner(t *testing.T) {
+	digest := ethcrypto.Keccak256([]byte("withdrawal"))
+	signature, err := ethcrypto.Sign(digest, constants.AliceBridgeSignerPrivateKey)
+	require.NoError(t, err)
+
+	// V of 0/1.
+	signer, err := types.RecoverEthSigner(digest, signature)
+	require.NoError(t, err)
+	require.Equal(t, constants.AliceBridgeSigner, signer)
+
+	// V of 27/28, as produced by Ethereum wallets. The signature passed in is not modified.
+	ethSignature := append([]byte{}, signature...)
+	ethSignature[ethcrypto.RecoveryIDOffset] += 27
+	signer, err = types.RecoverEthSigner(digest, ethSignature)
+	require.NoError(t, err)
+	require.Equal(t, constants.AliceBridgeSigner, signer)
+	require.Equal(t, signature[ethcrypto.RecoveryIDOffset]+27, ethSignature[ethcrypto.RecoveryIDOffset])
+
+	// Signature of a different digest recovers a different signer.
+	signer, err = types.RecoverEthSigner(ethcrypto.Keccak256([]byte("other")), signature)
+	require.NoError(t, err)
+	require.NotEqual(t, constants.AliceBridgeSigner, signer)
+
+	// Invalid signatures.
+	_, err = types.RecoverEthSigner(digest, signature[:64])
+	require.ErrorIs(t, err, types.ErrInvalidWithdrawalSignature)
+	invalidSignature := append([]byte{}, signature...)
+	invalidSignature[ethcrypto.RecoveryIDOffset] = 5
+	_, err = types.RecoverEthSigner(digest, invalidSignature)
+	require.ErrorIs(t, err, types.ErrInvalidWithdrawalSignature)
+}
+
+// TestRecoverEthSigner_Evm verifies that the Ethereum bridge contract recovers the same signer from a
+// withdrawal signature by running the EVM's `ecrecover` precompiled contract.
+func TestRecoverEthSigner_Evm(t *testing.T) {
+	ecrecover := vm.PrecompiledContractsBerlin[ecrecoverPrecompileAddress]
+
+	withdrawal := constants.BridgeWithdrawal_Id0_Height1
+	params := constants.EventParams_EthBridgeAddress
+	digest, err := withdrawal.GetDigest(params.EthChainId, params.EthAddress)
+	require.NoError(t, err)
+
+	for expectedSigner, privateKey := range map[string]*ecdsa.PrivateKey{
+		constants.AliceBridgeSigner: constants.AliceBridgeSignerPrivateKey,
+		constants.BobBridgeSigner:   constants.BobBridgeSignerPrivateKey,
+	} {
+		signature, err := ethcrypto.Sign(digest, privateKey)
+		require.NoError(t, err)
+
+		signer, err := types.RecoverEthSigner(digest, signature)
+		require.NoError(t, err)
+		require.Equal(t, expectedSigner, signer)
+
+		// ecrecover(hash, v, r, s) with v of 27/28, each argument abi-encoded as a 32-byte word.
+		input := make([]byte, 0, 128)
+		input = append(input, digest...)
+		input = append(input, common.LeftPadBytes([]byte{signature[ethcrypto.RecoveryIDOffset] + 27}, 32)...)
+		input = append(input, signature[:64]...)
+		output, err := ecrecover.Run(input)
+		require.NoError(t, err)
+		require.Equal(t, signer, common.BytesToAddress(output).Hex())
+	}
+}
+
+// TestBridgeWithdrawal_EvmVerification verifies withdrawal signatures with a bridge contract running on a
+// simulated EVM.
+func TestBridgeWithdrawal_EvmVerification(t *testing.T) {
+	withdrawal := constants.BridgeWithdrawal_Id0_Height1
+	ethChainId := constants.EventParams_EthBridgeAddress.EthChainId
+	chainConfig := *params.AllEthashProtocolChanges
+	chainConfig.ChainID = new(big.Int).SetUint64(ethChainId)
+	// The address that `runtime.Execute` runs contracts at.
+	contractAddress := common.BytesToAddress([]byte("contract"))
+
+	verify := func(withdrawal types.BridgeWithdrawal, signature []byte, blockTime time.Time) ([]byte, error) {
+		input, err := abi.Arguments{
+			{Type: mustNewAbiType(t, "uint256")},
+			{Type: mustNewAbiType(t, "address")},
+			{Type: mustNewAbiType(t, "uint256")},
+			{Type: mustNewAbiType(t, "uint256")},
+			{Type: mustNewAbiType(t, "uint8")},
+			{Type: mustNewAbiType(t, "bytes32")},
+			{Type: mustNewAbiType(t, "bytes32")},
+		}.Pack(
+			new(big.Int).SetUint64(uint64(withdrawal.Id)),
+			common.HexToAddress(withdrawal.EthRecipient),
+			withdrawal.Coin.Amount.BigInt(),
+			big.NewInt(withdrawal.ExpirationTime.Unix()),
+			signature[ethcrypto.RecoveryIDOffset]+27,
+			[32]byte(signature[:32]),
+			[32]byte(signature[32:64]),
+		)
+		require.NoError(t, err)
+		output, _, err := runtime.Execute(
+			withdrawalVerifierContract,
+			input,
+			&runtime.Config{ChainConfig: &chainConfig, Time: uint64(blockTime.Unix())},
+		)
+		return output, err
+	}
+
+	digest, err := withdrawal.GetDigest(ethChainId, contractAddress.Hex())
+	require.NoError(t, err)
+	for expectedSigner, privateKey := range map[string]*ecdsa.PrivateKey{
+		constants.AliceBridgeSigner: constants.AliceBridgeSignerPrivateKey,
+		constants.BobBridgeSigner:   constants.BobBridgeSignerPrivateKey,
+	} {
+		signature, err := ethcrypto.Sign(digest, privateKey)
+		require.NoError(t, err)
+		signer, err := types.RecoverEthSigner(digest, signature)
+		require.NoError(t, err)
+		require.Equal(t, expectedSigner, signer)
+
+		// The contract recovers the bridge signer until the withdrawal expires.
+		output, err := verify(withdrawal, signature, withdrawal.ExpirationTime)
+		require.NoError(t, err)
+		require.Equal(t, expectedSigner, common.BytesToAddress(output).Hex())
+		_, err = verify(withdrawal, signature, withdrawal.ExpirationTime.Add(time.Second))
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+		// The contract recovers a different signer if the withdrawal was modified.
+		modifiedWithdrawal := withdrawal
+		modifiedWithdrawal.Coin.Amount = withdrawal.Coin.Amount.AddRaw(1)
+		output, err = verify(modifiedWithdrawal, signature, withdrawal.ExpirationTime)
+		require.NoError(t, err)
+		require.NotEqual(t, expectedSigner, common.BytesToAddress(output).Hex())
+	}
+
+	// Signatures for a contract on another chain are not recovered as the bridge signer.
+	otherDigest, err := withdrawal.GetDigest(ethChainId+1, contractAddress.Hex())
+	require.NoError(t, err)
+	signature, err := ethcrypto.Sign(otherDigest, constants.AliceBridgeSignerPrivateKey)
+	require.NoError(t, err)
+	output, err := verify(withdrawal, signature, withdrawal.ExpirationTime)
+	require.NoError(t, err)
+	require.NotEqual(t, constants.AliceBridgeSigner, common.BytesToAddress(output).Hex())
+}
+
+func mustNewAbiType(t *testing.T, abiType string) abi.Type {
+	typ, err := abi.NewType(abiType, "", nil)
+	require.NoError(t, err)
+	return typ
+}
@@ -41,6 +41,71 @@ var (
 		7,
 		"Invalid Ethereum address",
 	)
+	ErrInvalidAddress = errorsmod.Register(
+		ModuleName,
+		8,
+		"Invalid address",
+	)
+	ErrInvalidBridgeOutCoin = errorsmod.Register(
+		ModuleName,
+		9,
+		"Invalid bridge out coin",
+	)
+	ErrWithdrawalNotFound = errorsmod.Register(
+		ModuleName,
+		10,
+		"Withdrawal not found",
+	)
+	ErrWithdrawalAlreadyFinalized = errorsmod.Register(
+		ModuleName,
+		11,
+		"Withdrawal is already finalized",
+	)
+	ErrBridgeSignerNotFound = errorsmod.Register(
+		ModuleName,
+		12,
+		"Bridge signer not found",
+	)
+	ErrInvalidWithdrawalSignature = errorsmod.Register(
+		ModuleName,
+		13,
+		"Invalid withdrawal signature",
+	)
+	ErrDuplicateAttestation = errorsmod.Register(
+		ModuleName,
+		14,
+		"Validator already attested to the withdrawal",
+	)
+	ErrValidatorNotBonded = errorsmod.Register(
+		ModuleName,
+		15,
+		"Validator is not bonded",
+	)
+	ErrInvalidSourceChain = errorsmod.Register(
+		ModuleName,
+		16,
+		"Invalid source chain",
+	)
+	ErrSourceChainNotFound = errorsmod.Register(
+		ModuleName,
+		17,
+		"Source chain not found",
+	)
+	ErrInvalidRateLimitParams = errorsmod.Register(
+		ModuleName,
+		18,
+		"Invalid rate limit params",
+	)
+	ErrWithdrawalExpired = errorsmod.Register(
+		ModuleName,
+		19,
+		"Withdrawal is expired",
+	)
+	ErrInvalidGenesisState = errorsmod.Register(
+		ModuleName,
+		20,
+		"Invalid genesis state",
+	)
 
 	ErrNegativeDuration = errorsmod.Register(
 		ModuleName,
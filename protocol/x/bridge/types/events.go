@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bridge module event types
+const (
+	EventTypeBridgeOut          = "bridge_out"
+	EventTypeAttestBridgeOut    = "attest_bridge_out"
+	EventTypeBridgeOutFinalized = "bridge_out_finalized"
+	EventTypeBridgeOutRefunded  = "bridge_out_refunded"
+
+	AttributeKeyWithdrawalId = "withdrawal_id"
+	AttributeKeySender       = "sender"
+	AttributeKeyEthRecipient = "eth_recipient"
+	AttributeKeyCoin         = "coin"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyEthSigner    = "eth_signer"
+)
+
+// NewBridgeOutEvent constructs a new bridge_out sdk.Event
+func NewBridgeOutEvent(withdrawal BridgeWithdrawal) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeBridgeOut,
+		sdk.NewAttribute(AttributeKeyWithdrawalId, fmt.Sprintf("%d", withdrawal.Id)),
+		sdk.NewAttribute(AttributeKeySender, withdrawal.Sender),
+		sdk.NewAttribute(AttributeKeyEthRecipient, withdrawal.EthRecipient),
+		sdk.NewAttribute(AttributeKeyCoin, withdrawal.Coin.String()),
+	)
+}
+
+// NewAttestBridgeOutEvent constructs a new attest_bridge_out sdk.Event
+func NewAttestBridgeOutEvent(withdrawalId uint32, attestation BridgeWithdrawalAttestation) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeAttestBridgeOut,
+		sdk.NewAttribute(AttributeKeyWithdrawalId, fmt.Sprintf("%d", withdrawalId)),
+		sdk.NewAttribute(AttributeKeyValidator, attestation.ValidatorAddress),
+		sdk.NewAttribute(AttributeKeyEthSigner, attestation.EthSigner),
+	)
+}
+
+// NewBridgeOutFinalizedEvent constructs a new bridge_out_finalized sdk.Event
+func NewBridgeOutFinalizedEvent(withdrawalId uint32) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeBridgeOutFinalized,
+		sdk.NewAttribute(AttributeKeyWithdrawalId, fmt.Sprintf("%d", withdrawalId)),
+	)
+}
+
+// NewBridgeOutRefundedEvent constructs a new bridge_out_refunded sdk.Event
+func NewBridgeOutRefundedEvent(withdrawal BridgeWithdrawal) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeBridgeOutRefunded,
+		sdk.NewAttribute(AttributeKeyWithdrawalId, fmt.Sprintf("%d", withdrawal.Id)),
+		sdk.NewAttribute(AttributeKeySender, withdrawal.Sender),
+		sdk.NewAttribute(AttributeKeyCoin, withdrawal.Coin.String()),
+	)
+}
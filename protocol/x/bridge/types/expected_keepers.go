@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // BankKeeper defines the expected bank keeper.
 type BankKeeper interface {
@@ -11,5 +14,17 @@ type BankKeeper interface {
 		recipientAddr sdk.AccAddress,
 		amt sdk.Coins,
 	) error
+	SendCoinsFromAccountToModule(
+		ctx sdk.Context,
+		senderAddr sdk.AccAddress,
+		recipientModule string,
+		amt sdk.Coins,
+	) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
+
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) (power int64)
+	GetLastTotalPower(ctx sdk.Context) sdkmath.Int
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default bridge genesis state.
@@ -26,6 +29,12 @@ func DefaultGenesis() *GenesisState {
 			NextId:         0,
 			EthBlockHeight: 0,
 		},
+		SourceChains:     []SourceChain{},
+		RateLimitParams:  []RateLimitParams{},
+		NextWithdrawalId: 0,
+		Withdrawals:      []BridgeWithdrawal{},
+		BridgeSigners:    []BridgeSigner{},
+		WindowBuckets:    []WindowBucket{},
 	}
 }
 
@@ -45,5 +54,117 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate that source chains are valid and unique and do not include the chain of `EventParams`.
+	ethChainIds := map[uint64]struct{}{
+		gs.EventParams.EthChainId: {},
+	}
+	for _, sourceChain := range gs.SourceChains {
+		if err := sourceChain.Params.Validate(); err != nil {
+			return err
+		}
+		ethChainId := sourceChain.Params.EventParams.EthChainId
+		if _, exists := ethChainIds[ethChainId]; exists {
+			return errorsmod.Wrapf(ErrInvalidSourceChain, "duplicate Ethereum chain ID %d", ethChainId)
+		}
+		ethChainIds[ethChainId] = struct{}{}
+		if err := sourceChain.AcknowledgedEventInfo.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate that rate limit params are valid and unique and refer to existing source chains.
+	rateLimitedEthChainIds := make(map[uint64]struct{})
+	for _, params := range gs.RateLimitParams {
+		if err := params.Validate(); err != nil {
+			return err
+		}
+		if _, exists := rateLimitedEthChainIds[params.EthChainId]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidRateLimitParams,
+				"duplicate Ethereum chain ID %d",
+				params.EthChainId,
+			)
+		}
+		rateLimitedEthChainIds[params.EthChainId] = struct{}{}
+		if _, exists := ethChainIds[params.EthChainId]; params.EthChainId != 0 &&
+			(!exists || params.EthChainId == gs.EventParams.EthChainId) {
+			return errorsmod.Wrapf(ErrSourceChainNotFound, "Ethereum chain ID %d", params.EthChainId)
+		}
+	}
+
+	// Validate that withdrawals are valid and have unique ids less than the next withdrawal id.
+	withdrawalIds := make(map[uint32]struct{}, len(gs.Withdrawals))
+	for _, withdrawal := range gs.Withdrawals {
+		if err := withdrawal.Validate(); err != nil {
+			return err
+		}
+		if _, exists := withdrawalIds[withdrawal.Id]; exists {
+			return errorsmod.Wrapf(ErrInvalidGenesisState, "duplicate withdrawal id %d", withdrawal.Id)
+		}
+		withdrawalIds[withdrawal.Id] = struct{}{}
+		if withdrawal.Id >= gs.NextWithdrawalId {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisState,
+				"withdrawal id %d must be less than the next withdrawal id %d",
+				withdrawal.Id,
+				gs.NextWithdrawalId,
+			)
+		}
+	}
+
+	// Validate that bridge signers are valid and unique per validator.
+	validators := make(map[string]struct{}, len(gs.BridgeSigners))
+	for _, bridgeSigner := range gs.BridgeSigners {
+		if err := bridgeSigner.Validate(); err != nil {
+			return err
+		}
+		if _, exists := validators[bridgeSigner.ValidatorAddress]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisState,
+				"duplicate bridge signer for validator %s",
+				bridgeSigner.ValidatorAddress,
+			)
+		}
+		validators[bridgeSigner.ValidatorAddress] = struct{}{}
+	}
+
+	// Validate that window buckets are valid and unique and refer to rate limited source chains.
+	type windowBucketKey struct {
+		ethChainId  uint64
+		blockHeight uint32
+	}
+	windowBucketKeys := make(map[windowBucketKey]struct{}, len(gs.WindowBuckets))
+	for _, windowBucket := range gs.WindowBuckets {
+		if _, exists := rateLimitedEthChainIds[windowBucket.EthChainId]; !exists {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisState,
+				"window bucket of Ethereum chain ID %d that is not rate limited",
+				windowBucket.EthChainId,
+			)
+		}
+		key := windowBucketKey{windowBucket.EthChainId, windowBucket.BlockHeight}
+		if _, exists := windowBucketKeys[key]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisState,
+				"duplicate window bucket of Ethereum chain ID %d at block height %d",
+				windowBucket.EthChainId,
+				windowBucket.BlockHeight,
+			)
+		}
+		windowBucketKeys[key] = struct{}{}
+		for _, entry := range windowBucket.Bucket.Entries {
+			if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+				return errorsmod.Wrapf(ErrInvalidAddress, "window bucket address '%s': %v", entry.Address, err)
+			}
+			if entry.Amount.IsNil() || entry.Amount.BigInt().Sign() <= 0 {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisState,
+					"window bucket amount bridged to %s must be positive",
+					entry.Address,
+				)
+			}
+		}
+	}
+
 	return nil
 }
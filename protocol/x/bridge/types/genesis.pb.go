@@ -33,6 +33,20 @@ type GenesisState struct {
 	// - the next event ID to be added to consensus.
 	// - Ethereum block height of the most recently acknowledged bridge event.
 	AcknowledgedEventInfo BridgeEventInfo `protobuf:"bytes,4,opt,name=acknowledged_event_info,json=acknowledgedEventInfo,proto3" json:"acknowledged_event_info"`
+	// Additional Ethereum chains to recognize bridge events from.
+	SourceChains []SourceChain `protobuf:"bytes,5,rep,name=source_chains,json=sourceChains,proto3" json:"source_chains"`
+	// Value-based safety parameters of source chains.
+	RateLimitParams []RateLimitParams `protobuf:"bytes,6,rep,name=rate_limit_params,json=rateLimitParams,proto3" json:"rate_limit_params"`
+	// The id of the next withdrawal.
+	NextWithdrawalId uint32 `protobuf:"varint,7,opt,name=next_withdrawal_id,json=nextWithdrawalId,proto3" json:"next_withdrawal_id,omitempty"`
+	// Pending and finalized withdrawals, in order of id.
+	Withdrawals []BridgeWithdrawal `protobuf:"bytes,8,rep,name=withdrawals,proto3" json:"withdrawals"`
+	// The Ethereum addresses that validators sign withdrawals with.
+	BridgeSigners []BridgeSigner `protobuf:"bytes,9,rep,name=bridge_signers,json=bridgeSigners,proto3" json:"bridge_signers"`
+	// Amounts bridged within the rolling windows of rate limited source chains.
+	// Buckets are keyed by block height, so the chain must continue from the
+	// exported height for the windows to carry over.
+	WindowBuckets []WindowBucket `protobuf:"bytes,10,rep,name=window_buckets,json=windowBuckets,proto3" json:"window_buckets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,34 +110,217 @@ func (m *GenesisState) GetAcknowledgedEventInfo() BridgeEventInfo {
 	return BridgeEventInfo{}
 }
 
+func (m *GenesisState) GetSourceChains() []SourceChain {
+	if m != nil {
+		return m.SourceChains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitParams() []RateLimitParams {
+	if m != nil {
+		return m.RateLimitParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextWithdrawalId() uint32 {
+	if m != nil {
+		return m.NextWithdrawalId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetWithdrawals() []BridgeWithdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeSigners() []BridgeSigner {
+	if m != nil {
+		return m.BridgeSigners
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWindowBuckets() []WindowBucket {
+	if m != nil {
+		return m.WindowBuckets
+	}
+	return nil
+}
+
+// SourceChain defines the genesis state of an additional Ethereum chain to
+// recognize bridge events from.
+type SourceChain struct {
+	// The parameters of the chain.
+	Params SourceChainParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// Acknowledged event info of the chain.
+	AcknowledgedEventInfo BridgeEventInfo `protobuf:"bytes,2,opt,name=acknowledged_event_info,json=acknowledgedEventInfo,proto3" json:"acknowledged_event_info"`
+}
+
+func (m *SourceChain) Reset()         { *m = SourceChain{} }
+func (m *SourceChain) String() string { return proto.CompactTextString(m) }
+func (*SourceChain) ProtoMessage()    {}
+func (*SourceChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d57e751403447d26, []int{1}
+}
+func (m *SourceChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceChain.Merge(m, src)
+}
+func (m *SourceChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceChain proto.InternalMessageInfo
+
+func (m *SourceChain) GetParams() SourceChainParams {
+	if m != nil {
+		return m.Params
+	}
+	return SourceChainParams{}
+}
+
+func (m *SourceChain) GetAcknowledgedEventInfo() BridgeEventInfo {
+	if m != nil {
+		return m.AcknowledgedEventInfo
+	}
+	return BridgeEventInfo{}
+}
+
+// WindowBucket defines the genesis state of the amounts bridged from a source
+// chain in a single block.
+type WindowBucket struct {
+	// The Ethereum chain ID of the source chain. Zero refers to the chain of
+	// `EventParams`.
+	EthChainId uint64 `protobuf:"varint,1,opt,name=eth_chain_id,json=ethChainId,proto3" json:"eth_chain_id,omitempty"`
+	// The block height that the bridge events were acknowledged in.
+	BlockHeight uint32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The amounts bridged.
+	Bucket BridgeWindowBucket `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket"`
+}
+
+func (m *WindowBucket) Reset()         { *m = WindowBucket{} }
+func (m *WindowBucket) String() string { return proto.CompactTextString(m) }
+func (*WindowBucket) ProtoMessage()    {}
+func (*WindowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d57e751403447d26, []int{2}
+}
+func (m *WindowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowBucket.Merge(m, src)
+}
+func (m *WindowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowBucket proto.InternalMessageInfo
+
+func (m *WindowBucket) GetEthChainId() uint64 {
+	if m != nil {
+		return m.EthChainId
+	}
+	return 0
+}
+
+func (m *WindowBucket) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *WindowBucket) GetBucket() BridgeWindowBucket {
+	if m != nil {
+		return m.Bucket
+	}
+	return BridgeWindowBucket{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.bridge.GenesisState")
+	proto.RegisterType((*SourceChain)(nil), "dydxprotocol.bridge.SourceChain")
+	proto.RegisterType((*WindowBucket)(nil), "dydxprotocol.bridge.WindowBucket")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/bridge/genesis.proto", fileDescriptor_d57e751403447d26) }
 
 var fileDescriptor_d57e751403447d26 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x8b, 0x0b, 0x09, 0x23, 0x2b, 0xd1, 0x83,
-	0x28, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x0b, 0xea, 0x83, 0x58, 0x10, 0xa5, 0x52, 0xda,
-	0xd8, 0x4c, 0x83, 0x50, 0xf1, 0xa9, 0x65, 0xa9, 0x79, 0x25, 0xf1, 0x99, 0x79, 0x69, 0x30, 0xc5,
-	0x0a, 0xd8, 0x14, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x6d, 0x56, 0xba, 0xc3, 0xc4, 0xc5, 0xe3,
-	0x0e, 0x71, 0x4b, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x27, 0x17, 0x0f, 0xc4, 0x18, 0x88, 0x32,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x05, 0x3d, 0x2c, 0x2e, 0xd4, 0x73, 0x05, 0x29, 0x0c,
-	0x00, 0xab, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x88, 0x3b, 0x15, 0x21, 0x24, 0xe4, 0xcf,
-	0xc5, 0x57, 0x50, 0x94, 0x5f, 0x90, 0x5f, 0x9c, 0x0a, 0x33, 0x8c, 0x09, 0x6c, 0x98, 0x12, 0x56,
-	0xc3, 0x02, 0x20, 0x4a, 0x51, 0x8c, 0xe3, 0x2d, 0x40, 0x16, 0x14, 0xf2, 0xe1, 0xe2, 0x2d, 0x4e,
-	0x4c, 0x4b, 0x2d, 0xa9, 0x84, 0x99, 0xc7, 0x0c, 0x36, 0x4f, 0x11, 0xab, 0x79, 0xc1, 0x60, 0x95,
-	0x28, 0xc6, 0xf1, 0x14, 0x23, 0x89, 0x09, 0x25, 0x71, 0x89, 0x27, 0x26, 0x67, 0xe7, 0xe5, 0x97,
-	0xe7, 0xa4, 0xa6, 0xa4, 0xa7, 0xa6, 0x20, 0x85, 0x9e, 0x04, 0x0b, 0xd8, 0x5c, 0x15, 0xac, 0xe6,
-	0x3a, 0x81, 0x29, 0xb0, 0xd7, 0x3d, 0xf3, 0xd2, 0xf2, 0xa1, 0x46, 0x8b, 0x22, 0x1b, 0x85, 0x90,
-	0x0c, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x94, 0x58, 0x2a, 0x33, 0xd1, 0x4d, 0xce, 0x48,
-	0xcc, 0xcc, 0xd3, 0x87, 0x8b, 0x54, 0xc0, 0x62, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0x2c, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xce, 0xa8, 0x6a, 0x58, 0x02, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x8f, 0xd2, 0x4e,
+	0x1c, 0xc7, 0xe9, 0xc2, 0xbf, 0x7f, 0x1d, 0xca, 0xaa, 0xa3, 0xc6, 0x66, 0x0f, 0xb5, 0x10, 0x75,
+	0x37, 0x51, 0x21, 0x51, 0x0f, 0x9e, 0xd1, 0x8d, 0x12, 0x57, 0xdd, 0x94, 0xc4, 0x4d, 0xbc, 0x34,
+	0x7d, 0x18, 0xda, 0x09, 0xd0, 0x69, 0x3a, 0xc3, 0x16, 0xde, 0x85, 0x67, 0x0f, 0xbe, 0x0d, 0xdf,
+	0xc2, 0x1e, 0xf7, 0xe8, 0xc9, 0x18, 0x78, 0x23, 0xa6, 0x33, 0x2d, 0x4c, 0x63, 0x61, 0x3d, 0x78,
+	0x2a, 0xf9, 0xfe, 0x3e, 0xf3, 0xe5, 0xf7, 0x34, 0x03, 0xda, 0xfe, 0xc2, 0x9f, 0xc7, 0x09, 0x61,
+	0xc4, 0x23, 0x93, 0x9e, 0x9b, 0x60, 0x3f, 0x40, 0xbd, 0x00, 0x45, 0x88, 0x62, 0xda, 0xe5, 0x3a,
+	0xbc, 0x2d, 0x23, 0x5d, 0x81, 0x1c, 0xdc, 0x09, 0x48, 0x40, 0xb8, 0xd8, 0xcb, 0x7e, 0x09, 0xf4,
+	0xe0, 0x71, 0x95, 0x9b, 0xf8, 0xd8, 0xe8, 0x1c, 0x45, 0xcc, 0xc6, 0xd1, 0xa8, 0x80, 0x0f, 0x77,
+	0xc0, 0x29, 0x8e, 0x7c, 0x92, 0xfe, 0x85, 0x6b, 0x8a, 0x59, 0xe8, 0x27, 0x4e, 0xea, 0x4c, 0x72,
+	0xd8, 0xac, 0x82, 0x63, 0x27, 0x71, 0xa6, 0x79, 0x3d, 0x9d, 0x6f, 0x2a, 0xd0, 0xde, 0x88, 0x0a,
+	0x87, 0xcc, 0x61, 0x08, 0x0e, 0x80, 0x26, 0x92, 0x13, 0x98, 0xae, 0x98, 0xca, 0x51, 0xf3, 0x99,
+	0xd9, 0xad, 0xa8, 0xbb, 0x7b, 0x9c, 0x81, 0xa7, 0x9c, 0xeb, 0x37, 0x2e, 0x7e, 0xde, 0xaf, 0x59,
+	0x4d, 0xb4, 0x91, 0xe0, 0x47, 0xb0, 0x1f, 0x27, 0x24, 0x26, 0x14, 0x15, 0x66, 0x7b, 0xdc, 0xac,
+	0x53, 0x69, 0x76, 0x2a, 0xd0, 0x92, 0x5d, 0x2b, 0x96, 0x45, 0x78, 0x02, 0x5a, 0xd4, 0x19, 0x21,
+	0xb6, 0x28, 0xfc, 0xea, 0xdc, 0xaf, 0x5d, 0xe9, 0x37, 0xe4, 0x64, 0xc9, 0x4e, 0xa3, 0x92, 0x06,
+	0x5d, 0x70, 0xcf, 0xf1, 0xc6, 0x11, 0x49, 0x27, 0xc8, 0x0f, 0x90, 0x2f, 0xcd, 0x44, 0x6f, 0x70,
+	0xdf, 0x07, 0x95, 0xbe, 0x7d, 0xfe, 0xe1, 0xa5, 0x0f, 0xa2, 0x11, 0xc9, 0xad, 0xef, 0xca, 0x56,
+	0xeb, 0x20, 0x7c, 0x07, 0x5a, 0x94, 0xcc, 0x12, 0x0f, 0xd9, 0x5e, 0xe8, 0xe0, 0x88, 0xea, 0xff,
+	0x99, 0xf5, 0xad, 0xed, 0x1c, 0x72, 0xf2, 0x55, 0x06, 0xae, 0x13, 0xde, 0x48, 0x14, 0x7e, 0x02,
+	0xb7, 0x12, 0x87, 0x21, 0x7b, 0x82, 0xa7, 0x78, 0x3d, 0x1f, 0xd5, 0xac, 0x6f, 0x4d, 0xd5, 0x72,
+	0x18, 0x3a, 0xc9, 0xe0, 0x52, 0x17, 0x6e, 0x24, 0x65, 0x19, 0x3e, 0x01, 0x30, 0x42, 0x73, 0x26,
+	0xad, 0x8f, 0x8d, 0x7d, 0xfd, 0x7f, 0x53, 0x39, 0x6a, 0x59, 0x37, 0xb3, 0xc8, 0xd9, 0x3a, 0x30,
+	0xf0, 0xe1, 0x7b, 0xd0, 0xdc, 0x80, 0x54, 0xbf, 0xc6, 0xff, 0xff, 0xe1, 0x8e, 0x56, 0x6d, 0x4e,
+	0x17, 0x4b, 0x22, 0x9d, 0x87, 0x1f, 0xc0, 0x7e, 0xbe, 0xbd, 0x14, 0x07, 0x11, 0x4a, 0xa8, 0x7e,
+	0xdd, 0xac, 0x6f, 0x1d, 0xaa, 0x70, 0x1c, 0x72, 0xb2, 0xd8, 0x11, 0x57, 0xd2, 0xb8, 0x9f, 0xb8,
+	0x2f, 0xb6, 0x3b, 0xf3, 0xc6, 0x88, 0x51, 0x1d, 0xec, 0xf0, 0x3b, 0xe3, 0x68, 0x9f, 0x93, 0x85,
+	0x5f, 0x2a, 0x69, 0xb4, 0xf3, 0x5d, 0x01, 0x4d, 0x69, 0x30, 0xf0, 0x35, 0x50, 0x4b, 0x37, 0xe3,
+	0xd1, 0x55, 0xa3, 0x2c, 0xf5, 0x5e, 0x8d, 0xaf, 0xdc, 0xbd, 0xbd, 0x7f, 0xb4, 0x7b, 0x9d, 0xaf,
+	0x0a, 0xd0, 0xe4, 0xfa, 0xa0, 0x09, 0x34, 0xc4, 0x42, 0xb1, 0x89, 0xd9, 0x84, 0xb3, 0x02, 0x1a,
+	0x16, 0x40, 0x2c, 0xe4, 0x89, 0x0e, 0x7c, 0xd8, 0x06, 0x9a, 0x3b, 0x21, 0xde, 0xd8, 0x0e, 0x11,
+	0x0e, 0x42, 0xc6, 0x73, 0x69, 0x59, 0x4d, 0xae, 0xbd, 0xe5, 0x12, 0x3c, 0x06, 0xaa, 0x68, 0x6c,
+	0x7e, 0xf9, 0x0e, 0x77, 0x4e, 0xfe, 0x8f, 0xee, 0xe6, 0x87, 0xfb, 0xd6, 0xc5, 0xd2, 0x50, 0x2e,
+	0x97, 0x86, 0xf2, 0x6b, 0x69, 0x28, 0x5f, 0x56, 0x46, 0xed, 0x72, 0x65, 0xd4, 0x7e, 0xac, 0x8c,
+	0xda, 0xe7, 0x97, 0x01, 0x66, 0xe1, 0xcc, 0xed, 0x7a, 0x64, 0xda, 0x2b, 0x3d, 0x5f, 0xe7, 0x2f,
+	0x9e, 0xf2, 0xd4, 0x7b, 0x6b, 0x65, 0x5e, 0x3c, 0x69, 0x6c, 0x11, 0x23, 0xea, 0xaa, 0x3c, 0xf0,
+	0xfc, 0xf7, 0x00, 0xe7, 0x2f, 0x9e, 0x64, 0xc7, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -146,6 +343,81 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WindowBuckets) > 0 {
+		for iNdEx := len(m.WindowBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindowBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BridgeSigners) > 0 {
+		for iNdEx := len(m.BridgeSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NextWithdrawalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextWithdrawalId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RateLimitParams) > 0 {
+		for iNdEx := len(m.RateLimitParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SourceChains) > 0 {
+		for iNdEx := len(m.SourceChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.AcknowledgedEventInfo.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -189,6 +461,92 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SourceChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AcknowledgedEventInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EthChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -214,6 +572,69 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.AcknowledgedEventInfo.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SourceChains) > 0 {
+		for _, e := range m.SourceChains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitParams) > 0 {
+		for _, e := range m.RateLimitParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextWithdrawalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextWithdrawalId))
+	}
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeSigners) > 0 {
+		for _, e := range m.BridgeSigners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WindowBuckets) > 0 {
+		for _, e := range m.WindowBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AcknowledgedEventInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *WindowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.EthChainId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	l = m.Bucket.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -384,6 +805,432 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChains = append(m.SourceChains, SourceChain{})
+			if err := m.SourceChains[len(m.SourceChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitParams = append(m.RateLimitParams, RateLimitParams{})
+			if err := m.RateLimitParams[len(m.RateLimitParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWithdrawalId", wireType)
+			}
+			m.NextWithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWithdrawalId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, BridgeWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeSigners = append(m.BridgeSigners, BridgeSigner{})
+			if err := m.BridgeSigners[len(m.BridgeSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowBuckets = append(m.WindowBuckets, WindowBucket{})
+			if err := m.WindowBuckets[len(m.WindowBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedEventInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AcknowledgedEventInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			}
+			m.EthChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,10 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +43,240 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			err: types.ErrNegativeDuration.Error(),
 		},
+		"valid SourceChains": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				SourceChains: []types.SourceChain{
+					{
+						Params: types.SourceChainParams{
+							EventParams: types.EventParams{
+								Denom:      "test-coin",
+								EthChainId: 2,
+								EthAddress: "test",
+							},
+						},
+						AcknowledgedEventInfo: types.BridgeEventInfo{
+							NextId:         3,
+							EthBlockHeight: 4,
+						},
+					},
+				},
+			},
+		},
+		"invalid SourceChains: chain ID zero": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				SourceChains: []types.SourceChain{
+					{
+						Params: types.SourceChainParams{
+							EventParams: types.EventParams{
+								Denom:      "test-coin",
+								EthChainId: 0,
+								EthAddress: "test",
+							},
+						},
+					},
+				},
+			},
+			err: types.ErrInvalidSourceChain.Error(),
+		},
+		"invalid SourceChains: chain ID of EventParams": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				SourceChains: []types.SourceChain{
+					{
+						Params: types.SourceChainParams{
+							EventParams: types.DefaultGenesis().EventParams,
+						},
+					},
+				},
+			},
+			err: types.ErrInvalidSourceChain.Error(),
+		},
+		"invalid SourceChains: duplicate chain ID": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				SourceChains: []types.SourceChain{
+					{
+						Params: types.SourceChainParams{
+							EventParams: types.EventParams{
+								Denom:      "test-coin",
+								EthChainId: 2,
+								EthAddress: "test",
+							},
+						},
+					},
+					{
+						Params: types.SourceChainParams{
+							EventParams: types.EventParams{
+								Denom:      "other-coin",
+								EthChainId: 2,
+								EthAddress: "other",
+							},
+						},
+					},
+				},
+			},
+			err: types.ErrInvalidSourceChain.Error(),
+		},
+		"valid RateLimitParams": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				SourceChains: []types.SourceChain{
+					{
+						Params: constants.SourceChainParams_L2,
+					},
+				},
+				RateLimitParams: []types.RateLimitParams{
+					constants.RateLimitParams,
+					{
+						EthChainId: constants.L2EthChainId,
+					},
+				},
+			},
+		},
+		"invalid RateLimitParams": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				RateLimitParams: []types.RateLimitParams{
+					{
+						WindowCap: dtypes.NewInt(1),
+					},
+				},
+			},
+			err: types.ErrInvalidRateLimitParams.Error(),
+		},
+		"invalid RateLimitParams: duplicate chain ID": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				RateLimitParams: []types.RateLimitParams{
+					constants.RateLimitParams,
+					constants.RateLimitParams,
+				},
+			},
+			err: types.ErrInvalidRateLimitParams.Error(),
+		},
+		"invalid RateLimitParams: source chain not found": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				RateLimitParams: []types.RateLimitParams{
+					{
+						EthChainId: constants.L2EthChainId,
+					},
+				},
+			},
+			err: types.ErrSourceChainNotFound.Error(),
+		},
+		"invalid RateLimitParams: chain ID of EventParams": {
+			genState: &types.GenesisState{
+				EventParams: types.DefaultGenesis().EventParams,
+				RateLimitParams: []types.RateLimitParams{
+					{
+						EthChainId: types.DefaultGenesis().EventParams.EthChainId,
+					},
+				},
+			},
+			err: types.ErrSourceChainNotFound.Error(),
+		},
+		"valid Withdrawals and BridgeSigners": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {}),
+		},
+		"invalid Withdrawals: id not less than next withdrawal id": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.NextWithdrawalId = 1
+			}),
+			err: "withdrawal id 1 must be less than the next withdrawal id 1",
+		},
+		"invalid Withdrawals: duplicate id": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.Withdrawals[1].Id = 0
+			}),
+			err: "duplicate withdrawal id 0",
+		},
+		"invalid Withdrawals: invalid sender": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.Withdrawals[0].Sender = "invalid"
+			}),
+			err: types.ErrInvalidAddress.Error(),
+		},
+		"invalid Withdrawals: invalid eth recipient": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.Withdrawals[0].EthRecipient = "invalid"
+			}),
+			err: types.ErrInvalidEthAddress.Error(),
+		},
+		"invalid Withdrawals: zero coin": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.Withdrawals[0].Coin = sdk.NewCoin("adv4tnt", sdkmath.ZeroInt())
+			}),
+			err: types.ErrInvalidBridgeOutCoin.Error(),
+		},
+		"invalid Withdrawals: duplicate attestation": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.Withdrawals[1].Attestations = append(
+					genState.Withdrawals[1].Attestations,
+					genState.Withdrawals[1].Attestations[0],
+				)
+			}),
+			err: types.ErrDuplicateAttestation.Error(),
+		},
+		"invalid Withdrawals: invalid attestation signature": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.Withdrawals[1].Attestations[0].Signature = []byte{1}
+			}),
+			err: types.ErrInvalidWithdrawalSignature.Error(),
+		},
+		"invalid Withdrawals: attestation without power": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.Withdrawals[1].Attestations[0].Power = 0
+			}),
+			err: "attestation power 0 must be positive",
+		},
+		"invalid BridgeSigners: invalid validator": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.BridgeSigners[0].ValidatorAddress = constants.AliceAccAddress.String()
+			}),
+			err: types.ErrInvalidAddress.Error(),
+		},
+		"invalid BridgeSigners: invalid eth signer": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.BridgeSigners[0].EthSigner = "invalid"
+			}),
+			err: types.ErrInvalidEthAddress.Error(),
+		},
+		"invalid BridgeSigners: duplicate validator": {
+			genState: withdrawalsGenesisState(func(genState *types.GenesisState) {
+				genState.BridgeSigners[1].ValidatorAddress = genState.BridgeSigners[0].ValidatorAddress
+			}),
+			err: "duplicate bridge signer for validator",
+		},
+		"valid WindowBuckets": {
+			genState: windowBucketsGenesisState(func(genState *types.GenesisState) {}),
+		},
+		"invalid WindowBuckets: source chain not rate limited": {
+			genState: windowBucketsGenesisState(func(genState *types.GenesisState) {
+				genState.RateLimitParams = []types.RateLimitParams{}
+			}),
+			err: "window bucket of Ethereum chain ID 0 that is not rate limited",
+		},
+		"invalid WindowBuckets: duplicate block height": {
+			genState: windowBucketsGenesisState(func(genState *types.GenesisState) {
+				genState.WindowBuckets[1].BlockHeight = genState.WindowBuckets[0].BlockHeight
+			}),
+			err: "duplicate window bucket of Ethereum chain ID 0 at block height 5",
+		},
+		"invalid WindowBuckets: invalid address": {
+			genState: windowBucketsGenesisState(func(genState *types.GenesisState) {
+				genState.WindowBuckets[0].Bucket.Entries[0].Address = "invalid"
+			}),
+			err: types.ErrInvalidAddress.Error(),
+		},
+		"invalid WindowBuckets: zero amount": {
+			genState: windowBucketsGenesisState(func(genState *types.GenesisState) {
+				genState.WindowBuckets[0].Bucket.Entries[0].Amount = dtypes.NewInt(0)
+			}),
+			err: "must be positive",
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -51,3 +289,58 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+// withdrawalsGenesisState returns a valid genesis state with a pending and a finalized withdrawal and two bridge
+// signers, modified by `modify`.
+func withdrawalsGenesisState(modify func(genState *types.GenesisState)) *types.GenesisState {
+	finalizedWithdrawal := constants.BridgeWithdrawal_Id0_Height1
+	finalizedWithdrawal.Id = 1
+	finalizedWithdrawal.FinalizedBlockHeight = 2
+	finalizedWithdrawal.Attestations = []types.BridgeWithdrawalAttestation{
+		{
+			ValidatorAddress: constants.AliceValAddress.String(),
+			EthSigner:        constants.AliceBridgeSigner,
+			Signature:        make([]byte, types.EthSignatureLength),
+			Power:            5,
+		},
+	}
+
+	genState := types.DefaultGenesis()
+	genState.NextWithdrawalId = 2
+	genState.Withdrawals = []types.BridgeWithdrawal{constants.BridgeWithdrawal_Id0_Height1, finalizedWithdrawal}
+	genState.BridgeSigners = []types.BridgeSigner{
+		{ValidatorAddress: constants.AliceValAddress.String(), EthSigner: constants.AliceBridgeSigner},
+		{ValidatorAddress: constants.BobValAddress.String(), EthSigner: constants.BobBridgeSigner},
+	}
+	modify(genState)
+	return genState
+}
+
+// windowBucketsGenesisState returns a valid genesis state with two window buckets of the rate limited chain of
+// `EventParams`, modified by `modify`.
+func windowBucketsGenesisState(modify func(genState *types.GenesisState)) *types.GenesisState {
+	genState := types.DefaultGenesis()
+	genState.RateLimitParams = []types.RateLimitParams{constants.RateLimitParams}
+	genState.WindowBuckets = []types.WindowBucket{
+		{
+			EthChainId:  0,
+			BlockHeight: 5,
+			Bucket: types.BridgeWindowBucket{
+				Entries: []types.BridgeWindowEntry{
+					{Address: constants.AliceAccAddress.String(), Amount: dtypes.NewInt(100)},
+				},
+			},
+		},
+		{
+			EthChainId:  0,
+			BlockHeight: 6,
+			Bucket: types.BridgeWindowBucket{
+				Entries: []types.BridgeWindowEntry{
+					{Address: constants.BobAccAddress.String(), Amount: dtypes.NewInt(200)},
+				},
+			},
+		},
+	}
+	modify(genState)
+	return genState
+}
@@ -22,4 +22,36 @@ const (
 
 	// SafetyParamsKey defines the key for the SafetyParams
 	SafetyParamsKey = "SafetyParams"
+
+	// NextWithdrawalIdKey defines the key for the id of the next withdrawal
+	NextWithdrawalIdKey = "NextWdId"
+
+	// WithdrawalTotalPowerKey defines the key for the last total voting power that pending withdrawals were
+	// processed with
+	WithdrawalTotalPowerKey = "WdTotalPower"
+)
+
+// Prefixes
+const (
+	// PendingWithdrawalKeyPrefix is the prefix to retrieve all pending BridgeWithdrawals
+	PendingWithdrawalKeyPrefix = "PendingWd:"
+
+	// FinalizedWithdrawalKeyPrefix is the prefix to retrieve all finalized BridgeWithdrawals
+	FinalizedWithdrawalKeyPrefix = "FinalizedWd:"
+
+	// BridgeSignerKeyPrefix is the prefix to retrieve all BridgeSigners
+	BridgeSignerKeyPrefix = "Signer:"
+
+	// SourceChainParamsKeyPrefix is the prefix to retrieve all SourceChainParams
+	SourceChainParamsKeyPrefix = "SrcChainParams:"
+
+	// SourceChainAcknowledgedEventInfoKeyPrefix is the prefix to retrieve the AcknowledgedEventInfo of all
+	// additional source chains
+	SourceChainAcknowledgedEventInfoKeyPrefix = "SrcChainAckEventInfo:"
+
+	// RateLimitParamsKeyPrefix is the prefix to retrieve the RateLimitParams of all source chains
+	RateLimitParamsKeyPrefix = "RateLimitParams:"
+
+	// WindowBucketKeyPrefix is the prefix to retrieve all BridgeWindowBuckets
+	WindowBucketKeyPrefix = "WindowBucket:"
 )
@@ -17,4 +17,16 @@ func TestStateKeys(t *testing.T) {
 	require.Equal(t, "EventParams", types.EventParamsKey)
 	require.Equal(t, "ProposeParams", types.ProposeParamsKey)
 	require.Equal(t, "SafetyParams", types.SafetyParamsKey)
+	require.Equal(t, "NextWdId", types.NextWithdrawalIdKey)
+	require.Equal(t, "WdTotalPower", types.WithdrawalTotalPowerKey)
+}
+
+func TestStatePrefixes(t *testing.T) {
+	require.Equal(t, "PendingWd:", types.PendingWithdrawalKeyPrefix)
+	require.Equal(t, "FinalizedWd:", types.FinalizedWithdrawalKeyPrefix)
+	require.Equal(t, "Signer:", types.BridgeSignerKeyPrefix)
+	require.Equal(t, "SrcChainParams:", types.SourceChainParamsKeyPrefix)
+	require.Equal(t, "SrcChainAckEventInfo:", types.SourceChainAcknowledgedEventInfoKeyPrefix)
+	require.Equal(t, "RateLimitParams:", types.RateLimitParamsKeyPrefix)
+	require.Equal(t, "WindowBucket:", types.WindowBucketKeyPrefix)
 }
@@ -10,11 +10,13 @@ func (msg *MsgAcknowledgeBridges) GetSigners() []sdk.AccAddress {
 }
 
 func (msg *MsgAcknowledgeBridges) ValidateBasic() error {
-	// Validates that bridge event IDs are consecutive.
-	for i, event := range msg.Events {
-		if i > 0 && msg.Events[i-1].Id != event.Id-1 {
+	// Validates that bridge event IDs are consecutive on each source chain.
+	lastIds := make(map[uint64]uint32)
+	for _, event := range msg.Events {
+		if lastId, ok := lastIds[event.EthChainId]; ok && lastId != event.Id-1 {
 			return ErrBridgeIdsNotConsecutive
 		}
+		lastIds[event.EthChainId] = event.Id
 	}
 	return nil
 }
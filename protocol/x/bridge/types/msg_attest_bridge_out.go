@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMsgAttestBridgeOut constructs a `MsgAttestBridgeOut` from the account of a validator operator, the id
+// of a pending withdrawal, and the validator's signature of the withdrawal digest.
+func NewMsgAttestBridgeOut(validator string, withdrawalId uint32, signature []byte) *MsgAttestBridgeOut {
+	return &MsgAttestBridgeOut{
+		Validator:    validator,
+		WithdrawalId: withdrawalId,
+		Signature:    signature,
+	}
+}
+
+// GetSigners panics if `Validator` is not a valid bech32 address, which `ValidateBasic` rejects.
+func (msg *MsgAttestBridgeOut) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Validator)}
+}
+
+func (msg *MsgAttestBridgeOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAddress,
+			fmt.Sprintf(
+				"validator '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Validator,
+				err.Error(),
+			),
+		)
+	}
+	if len(msg.Signature) != EthSignatureLength {
+		return errorsmod.Wrapf(
+			ErrInvalidWithdrawalSignature,
+			"signature must be %d bytes, got %d",
+			EthSignatureLength,
+			len(msg.Signature),
+		)
+	}
+	return nil
+}
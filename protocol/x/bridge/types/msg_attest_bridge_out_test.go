@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAttestBridgeOut_GetSigners(t *testing.T) {
+	msg := types.NewMsgAttestBridgeOut(constants.AliceAccAddress.String(), 0, make([]byte, types.EthSignatureLength))
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+}
+
+func TestMsgAttestBridgeOut_GetSigners_InvalidAddress(t *testing.T) {
+	msg := types.MsgAttestBridgeOut{Validator: "invalid"}
+	require.Panics(t, func() { msg.GetSigners() })
+}
+
+func TestMsgAttestBridgeOut_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgAttestBridgeOut
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgAttestBridgeOut{
+				Validator:    constants.AliceAccAddress.String(),
+				WithdrawalId: 7,
+				Signature:    make([]byte, types.EthSignatureLength),
+			},
+		},
+		"Failure: invalid validator": {
+			msg: types.MsgAttestBridgeOut{
+				Validator:    "invalid",
+				WithdrawalId: 7,
+				Signature:    make([]byte, types.EthSignatureLength),
+			},
+			expectedErr: types.ErrInvalidAddress,
+		},
+		"Failure: empty signature": {
+			msg: types.MsgAttestBridgeOut{
+				Validator:    constants.AliceAccAddress.String(),
+				WithdrawalId: 7,
+			},
+			expectedErr: types.ErrInvalidWithdrawalSignature,
+		},
+		"Failure: signature too long": {
+			msg: types.MsgAttestBridgeOut{
+				Validator:    constants.AliceAccAddress.String(),
+				WithdrawalId: 7,
+				Signature:    make([]byte, types.EthSignatureLength+1),
+			},
+			expectedErr: types.ErrInvalidWithdrawalSignature,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMsgBridgeOut constructs a `MsgBridgeOut` from a `sender` account, an Ethereum recipient address,
+// and the coin to withdraw.
+func NewMsgBridgeOut(sender string, ethRecipient string, coin sdk.Coin) *MsgBridgeOut {
+	return &MsgBridgeOut{
+		Sender:       sender,
+		EthRecipient: ethRecipient,
+		Coin:         coin,
+	}
+}
+
+// GetSigners panics if `Sender` is not a valid bech32 address, which `ValidateBasic` rejects.
+func (msg *MsgBridgeOut) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+func (msg *MsgBridgeOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAddress,
+			fmt.Sprintf(
+				"sender '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Sender,
+				err.Error(),
+			),
+		)
+	}
+	if err := ValidateEthAddress(msg.EthRecipient); err != nil {
+		return err
+	}
+	if !msg.Coin.IsValid() || !msg.Coin.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBridgeOutCoin, "coin '%s' must be valid and positive", msg.Coin)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBridgeOut_GetSigners(t *testing.T) {
+	msg := types.NewMsgBridgeOut(
+		constants.AliceAccAddress.String(),
+		constants.EthRecipientAddress,
+		sdk.NewCoin("adv4tnt", sdkmath.NewInt(1)),
+	)
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+}
+
+func TestMsgBridgeOut_GetSigners_InvalidAddress(t *testing.T) {
+	msg := types.MsgBridgeOut{Sender: "invalid"}
+	require.Panics(t, func() { msg.GetSigners() })
+}
+
+func TestMsgBridgeOut_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgBridgeOut
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgBridgeOut{
+				Sender:       constants.AliceAccAddress.String(),
+				EthRecipient: constants.EthRecipientAddress,
+				Coin:         sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			},
+		},
+		"Failure: invalid sender": {
+			msg: types.MsgBridgeOut{
+				Sender:       "invalid",
+				EthRecipient: constants.EthRecipientAddress,
+				Coin:         sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			},
+			expectedErr: types.ErrInvalidAddress,
+		},
+		"Failure: invalid eth recipient": {
+			msg: types.MsgBridgeOut{
+				Sender:       constants.AliceAccAddress.String(),
+				EthRecipient: constants.BobAccAddress.String(),
+				Coin:         sdk.NewCoin("adv4tnt", sdkmath.NewInt(888)),
+			},
+			expectedErr: types.ErrInvalidEthAddress,
+		},
+		"Failure: zero coin": {
+			msg: types.MsgBridgeOut{
+				Sender:       constants.AliceAccAddress.String(),
+				EthRecipient: constants.EthRecipientAddress,
+				Coin:         sdk.NewCoin("adv4tnt", sdkmath.ZeroInt()),
+			},
+			expectedErr: types.ErrInvalidBridgeOutCoin,
+		},
+		"Failure: invalid denom": {
+			msg: types.MsgBridgeOut{
+				Sender:       constants.AliceAccAddress.String(),
+				EthRecipient: constants.EthRecipientAddress,
+				Coin: sdk.Coin{
+					Denom:  "1",
+					Amount: sdkmath.NewInt(888),
+				},
+			},
+			expectedErr: types.ErrInvalidBridgeOutCoin,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMsgSetBridgeSigner constructs a `MsgSetBridgeSigner` from the account of a validator operator and
+// the Ethereum address that the validator signs withdrawals with.
+func NewMsgSetBridgeSigner(validator string, ethSigner string) *MsgSetBridgeSigner {
+	return &MsgSetBridgeSigner{
+		Validator: validator,
+		EthSigner: ethSigner,
+	}
+}
+
+// GetSigners panics if `Validator` is not a valid bech32 address, which `ValidateBasic` rejects.
+func (msg *MsgSetBridgeSigner) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Validator)}
+}
+
+func (msg *MsgSetBridgeSigner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAddress,
+			fmt.Sprintf(
+				"validator '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Validator,
+				err.Error(),
+			),
+		)
+	}
+	return ValidateEthAddress(msg.EthSigner)
+}
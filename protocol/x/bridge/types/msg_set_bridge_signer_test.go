@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetBridgeSigner_GetSigners(t *testing.T) {
+	msg := types.NewMsgSetBridgeSigner(constants.AliceAccAddress.String(), constants.AliceBridgeSigner)
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+}
+
+func TestMsgSetBridgeSigner_GetSigners_InvalidAddress(t *testing.T) {
+	msg := types.MsgSetBridgeSigner{Validator: "invalid"}
+	require.Panics(t, func() { msg.GetSigners() })
+}
+
+func TestMsgSetBridgeSigner_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetBridgeSigner
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgSetBridgeSigner{
+				Validator: constants.AliceAccAddress.String(),
+				EthSigner: constants.AliceBridgeSigner,
+			},
+		},
+		"Failure: invalid validator": {
+			msg: types.MsgSetBridgeSigner{
+				Validator: "invalid",
+				EthSigner: constants.AliceBridgeSigner,
+			},
+			expectedErr: types.ErrInvalidAddress,
+		},
+		"Failure: invalid eth signer": {
+			msg: types.MsgSetBridgeSigner{
+				Validator: constants.AliceAccAddress.String(),
+				EthSigner: "0x1234",
+			},
+			expectedErr: types.ErrInvalidEthAddress,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateRateLimitParams_GetSigners(t *testing.T) {
+	msg := types.MsgUpdateRateLimitParams{
+		Authority: constants.BobAccAddress.String(),
+	}
+	require.Equal(t, []sdk.AccAddress{constants.BobAccAddress}, msg.GetSigners())
+}
+
+func TestMsgUpdateRateLimitParams_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgUpdateRateLimitParams
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgUpdateRateLimitParams{
+				Authority: validAuthority,
+				Params:    constants.RateLimitParams,
+			},
+		},
+		"Failure: empty authority": {
+			msg: types.MsgUpdateRateLimitParams{
+				Authority: "",
+			},
+			expectedErr: types.ErrInvalidAuthority.Error(),
+		},
+		"Failure: invalid authority": {
+			msg: types.MsgUpdateRateLimitParams{
+				Authority: "dydx1abc",
+			},
+			expectedErr: types.ErrInvalidAuthority.Error(),
+		},
+		"Failure: invalid params": {
+			msg: types.MsgUpdateRateLimitParams{
+				Authority: validAuthority,
+				Params: types.RateLimitParams{
+					LargeBridgeThreshold: dtypes.NewInt(100),
+				},
+			},
+			expectedErr: types.ErrInvalidRateLimitParams.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateSourceChainParams_GetSigners(t *testing.T) {
+	msg := types.MsgUpdateSourceChainParams{
+		Authority: constants.BobAccAddress.String(),
+	}
+	require.Equal(t, []sdk.AccAddress{constants.BobAccAddress}, msg.GetSigners())
+}
+
+func TestMsgUpdateSourceChainParams_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgUpdateSourceChainParams
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgUpdateSourceChainParams{
+				Authority: validAuthority,
+				Params:    constants.SourceChainParams_L2,
+			},
+		},
+		"Failure: empty authority": {
+			msg: types.MsgUpdateSourceChainParams{
+				Authority: "",
+			},
+			expectedErr: types.ErrInvalidAuthority.Error(),
+		},
+		"Failure: invalid authority": {
+			msg: types.MsgUpdateSourceChainParams{
+				Authority: "dydx1abc",
+			},
+			expectedErr: types.ErrInvalidAuthority.Error(),
+		},
+		"Failure: chain ID zero": {
+			msg: types.MsgUpdateSourceChainParams{
+				Authority: validAuthority,
+				Params: types.SourceChainParams{
+					EventParams: types.EventParams{
+						Denom:      "test-coin",
+						EthChainId: 0,
+						EthAddress: "test",
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidSourceChain.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
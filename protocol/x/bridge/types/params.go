@@ -3,6 +3,7 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 )
 
@@ -30,3 +31,35 @@ func (m *ProposeParams) Validate() error {
 func (m *SafetyParams) Validate() error {
 	return nil
 }
+
+func (m *SourceChainParams) Validate() error {
+	// Chain ID zero refers to the chain of `EventParams`.
+	if m.EventParams.EthChainId == 0 {
+		return errorsmod.Wrap(ErrInvalidSourceChain, "Ethereum chain ID cannot be zero")
+	}
+	if err := m.EventParams.Validate(); err != nil {
+		return err
+	}
+	return m.SafetyParams.Validate()
+}
+
+func (m *RateLimitParams) Validate() error {
+	for _, limit := range []dtypes.SerializableInt{m.WindowCap, m.AddressWindowCap, m.LargeBridgeThreshold} {
+		if !limit.IsNil() && limit.BigInt().Sign() < 0 {
+			return errorsmod.Wrapf(ErrInvalidRateLimitParams, "limit %s cannot be negative", limit)
+		}
+	}
+	if (IsLimitSet(m.WindowCap) || IsLimitSet(m.AddressWindowCap)) && m.WindowBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimitParams, "window blocks must be positive if a window cap is set")
+	}
+	if (IsLimitSet(m.WindowCap) || IsLimitSet(m.AddressWindowCap) || IsLimitSet(m.LargeBridgeThreshold)) &&
+		m.ExtendedDelayBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimitParams, "extended delay blocks must be positive if a limit is set")
+	}
+	return nil
+}
+
+// IsLimitSet returns whether a limit of `RateLimitParams` is set, i.e. whether it is positive.
+func IsLimitSet(limit dtypes.SerializableInt) bool {
+	return !limit.IsNil() && limit.BigInt().Sign() > 0
+}
@@ -9,6 +9,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "github.com/cosmos/gogoproto/types"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -229,44 +230,196 @@ func (m *SafetyParams) GetDelayBlocks() uint32 {
 	return 0
 }
 
+// SourceChainParams stores the parameters of an additional Ethereum chain to
+// recognize bridge events from, besides the chain of `EventParams`.
+type SourceChainParams struct {
+	// The parameters about which events to recognize on the chain and which
+	// tokens to mint. The chain is identified by `event_params.eth_chain_id`.
+	EventParams EventParams `protobuf:"bytes,1,opt,name=event_params,json=eventParams,proto3" json:"event_params"`
+	// The safety parameters of bridges from the chain.
+	SafetyParams SafetyParams `protobuf:"bytes,2,opt,name=safety_params,json=safetyParams,proto3" json:"safety_params"`
+}
+
+func (m *SourceChainParams) Reset()         { *m = SourceChainParams{} }
+func (m *SourceChainParams) String() string { return proto.CompactTextString(m) }
+func (*SourceChainParams) ProtoMessage()    {}
+func (*SourceChainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29afb5e8a05168cd, []int{3}
+}
+func (m *SourceChainParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceChainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceChainParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceChainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceChainParams.Merge(m, src)
+}
+func (m *SourceChainParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceChainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceChainParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceChainParams proto.InternalMessageInfo
+
+func (m *SourceChainParams) GetEventParams() EventParams {
+	if m != nil {
+		return m.EventParams
+	}
+	return EventParams{}
+}
+
+func (m *SourceChainParams) GetSafetyParams() SafetyParams {
+	if m != nil {
+		return m.SafetyParams
+	}
+	return SafetyParams{}
+}
+
+// RateLimitParams stores value-based safety parameters for bridges from a
+// source chain. Bridge events that exceed any of the limits are not rejected,
+// but are pending for `extended_delay_blocks` instead of
+// `SafetyParams.delay_blocks`, which gives governance time to review them and
+// to disable bridging if necessary.
+type RateLimitParams struct {
+	// The numerical chain ID of the Ethereum chain that the limits apply to.
+	// Zero refers to the chain of `EventParams`.
+	EthChainId uint64 `protobuf:"varint,1,opt,name=eth_chain_id,json=ethChainId,proto3" json:"eth_chain_id,omitempty"`
+	// The number of blocks of the rolling window over which bridged amounts are
+	// summed up.
+	WindowBlocks uint32 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// The maximum total amount bridged within the rolling window. Zero means no
+	// limit.
+	WindowCap github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=window_cap,json=windowCap,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"window_cap"`
+	// The maximum total amount bridged to a single address within the rolling
+	// window. Zero means no limit.
+	AddressWindowCap github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=address_window_cap,json=addressWindowCap,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"address_window_cap"`
+	// The amount at or above which a single bridge event is delayed by
+	// `extended_delay_blocks`. Zero means no threshold.
+	LargeBridgeThreshold github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,5,opt,name=large_bridge_threshold,json=largeBridgeThreshold,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"large_bridge_threshold"`
+	// The number of blocks that bridges exceeding any of the limits will be
+	// pending until the minted tokens are granted.
+	ExtendedDelayBlocks uint32 `protobuf:"varint,6,opt,name=extended_delay_blocks,json=extendedDelayBlocks,proto3" json:"extended_delay_blocks,omitempty"`
+}
+
+func (m *RateLimitParams) Reset()         { *m = RateLimitParams{} }
+func (m *RateLimitParams) String() string { return proto.CompactTextString(m) }
+func (*RateLimitParams) ProtoMessage()    {}
+func (*RateLimitParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29afb5e8a05168cd, []int{4}
+}
+func (m *RateLimitParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitParams.Merge(m, src)
+}
+func (m *RateLimitParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitParams proto.InternalMessageInfo
+
+func (m *RateLimitParams) GetEthChainId() uint64 {
+	if m != nil {
+		return m.EthChainId
+	}
+	return 0
+}
+
+func (m *RateLimitParams) GetWindowBlocks() uint32 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *RateLimitParams) GetExtendedDelayBlocks() uint32 {
+	if m != nil {
+		return m.ExtendedDelayBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventParams)(nil), "dydxprotocol.bridge.EventParams")
 	proto.RegisterType((*ProposeParams)(nil), "dydxprotocol.bridge.ProposeParams")
 	proto.RegisterType((*SafetyParams)(nil), "dydxprotocol.bridge.SafetyParams")
+	proto.RegisterType((*SourceChainParams)(nil), "dydxprotocol.bridge.SourceChainParams")
+	proto.RegisterType((*RateLimitParams)(nil), "dydxprotocol.bridge.RateLimitParams")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/bridge/params.proto", fileDescriptor_29afb5e8a05168cd) }
 
 var fileDescriptor_29afb5e8a05168cd = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x92, 0xd3, 0x3c,
-	0x14, 0x8d, 0xf7, 0xdb, 0x8f, 0x59, 0xe4, 0xb8, 0x11, 0x81, 0x09, 0x3b, 0x8c, 0x93, 0x4d, 0xb5,
-	0x0d, 0xf6, 0xf0, 0x53, 0xd0, 0x62, 0x42, 0xb1, 0x5d, 0x46, 0x54, 0xd0, 0x68, 0xe4, 0xe8, 0xda,
-	0xd1, 0xac, 0x1d, 0x69, 0x24, 0x65, 0x27, 0x79, 0x0b, 0x4a, 0xde, 0x82, 0xd7, 0xd8, 0x72, 0x4b,
-	0x2a, 0x60, 0x92, 0x17, 0x61, 0x7c, 0xe5, 0xec, 0x2c, 0x1d, 0x9d, 0x75, 0xce, 0xf1, 0xb9, 0xe7,
-	0x5c, 0x89, 0x4c, 0xe5, 0x4e, 0x6e, 0x8d, 0xd5, 0x5e, 0x2f, 0x75, 0x93, 0x97, 0x56, 0xc9, 0x1a,
-	0x72, 0x23, 0xac, 0x68, 0x5d, 0x86, 0x30, 0x7d, 0xf2, 0x50, 0x91, 0x05, 0xc5, 0xf9, 0xa8, 0xd6,
-	0xb5, 0x46, 0x30, 0xef, 0xbe, 0x82, 0xf4, 0x3c, 0xad, 0xb5, 0xae, 0x1b, 0xc8, 0xf1, 0x54, 0x6e,
-	0xaa, 0x5c, 0x6e, 0xac, 0xf0, 0x4a, 0xaf, 0x03, 0x3f, 0xab, 0x48, 0xfc, 0xf1, 0x06, 0xd6, 0x7e,
-	0x81, 0xfe, 0x74, 0x44, 0xfe, 0x97, 0xb0, 0xd6, 0xed, 0x38, 0x9a, 0x46, 0x97, 0x8f, 0x59, 0x38,
-	0xd0, 0x29, 0x19, 0x82, 0x5f, 0xf1, 0xe5, 0x4a, 0xa8, 0x35, 0x57, 0x72, 0x7c, 0x32, 0x8d, 0x2e,
-	0x4f, 0x19, 0x01, 0xbf, 0xfa, 0xd0, 0x41, 0x57, 0x92, 0x4e, 0x48, 0xdc, 0x29, 0x84, 0x94, 0x16,
-	0x9c, 0x1b, 0xff, 0x87, 0x7f, 0x77, 0x82, 0xf7, 0x01, 0x99, 0x7d, 0x3f, 0x21, 0xc9, 0xc2, 0x6a,
-	0xa3, 0x1d, 0xf4, 0xa3, 0x5e, 0x91, 0xa7, 0xad, 0xd8, 0xf2, 0x90, 0xde, 0x71, 0x03, 0x96, 0x97,
-	0x8d, 0x5e, 0x5e, 0xe3, 0xe8, 0x84, 0xd1, 0x56, 0x6c, 0x8b, 0xc0, 0x2d, 0xc0, 0x16, 0x1d, 0x43,
-	0x3f, 0x93, 0x67, 0x26, 0x78, 0x70, 0x09, 0x8d, 0xd8, 0xf1, 0x63, 0x19, 0x4c, 0x14, 0xbf, 0x7e,
-	0x9e, 0x85, 0xb6, 0xd9, 0xb1, 0x6d, 0x36, 0xef, 0x05, 0xc5, 0xd9, 0xed, 0xcf, 0xc9, 0xe0, 0xdb,
-	0xaf, 0x49, 0xc4, 0x46, 0xbd, 0xc5, 0xbc, 0x73, 0x38, 0xf2, 0x74, 0x46, 0x12, 0x77, 0xad, 0x0c,
-	0xb7, 0xc2, 0x03, 0x37, 0xa6, 0xc5, 0x0a, 0x09, 0x8b, 0x3b, 0x90, 0x09, 0x0f, 0x0b, 0xd3, 0xd2,
-	0x86, 0x5c, 0xa0, 0x46, 0x55, 0x21, 0x69, 0x08, 0x01, 0x92, 0x97, 0x0f, 0x92, 0x9c, 0xfe, 0x7b,
-	0x92, 0x17, 0x9d, 0xdb, 0x55, 0x85, 0xdd, 0xe6, 0xc1, 0xaa, 0xb8, 0x4f, 0x34, 0x63, 0x64, 0xf8,
-	0x49, 0x54, 0xe0, 0x77, 0xfd, 0xbe, 0x26, 0x24, 0x56, 0x8e, 0x4b, 0xe5, 0x44, 0xd9, 0x80, 0xc4,
-	0x2d, 0x9d, 0x31, 0xa2, 0xdc, 0xbc, 0x47, 0xe8, 0x05, 0x19, 0x86, 0xad, 0x60, 0x38, 0x87, 0x3b,
-	0x49, 0x58, 0x8c, 0x18, 0xce, 0x70, 0x05, 0xbb, 0xdd, 0xa7, 0xd1, 0xdd, 0x3e, 0x8d, 0x7e, 0xef,
-	0xd3, 0xe8, 0xeb, 0x21, 0x1d, 0xdc, 0x1d, 0xd2, 0xc1, 0x8f, 0x43, 0x3a, 0xf8, 0xf2, 0xae, 0x56,
-	0x7e, 0xb5, 0x29, 0xb3, 0xa5, 0x6e, 0xf3, 0xbf, 0xde, 0xdf, 0xcd, 0xdb, 0x97, 0x78, 0xef, 0xf9,
-	0x3d, 0xb2, 0x3d, 0xbe, 0x49, 0xbf, 0x33, 0xe0, 0xca, 0x47, 0x48, 0xbc, 0xf9, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x2b, 0x28, 0x4e, 0x34, 0xb7, 0x02, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8e, 0xdb, 0xb4, 0x6a, 0xcf, 0xb6, 0x7e, 0x3f, 0xae, 0x69, 0x15, 0x2a, 0xe4, 0xa4, 0x61,
+	0xe9, 0x82, 0x2d, 0x0a, 0x03, 0x23, 0xb8, 0x41, 0x22, 0x52, 0x87, 0xc8, 0x45, 0x42, 0xb0, 0x9c,
+	0xce, 0xb9, 0x37, 0xce, 0xa9, 0xb6, 0xcf, 0xf2, 0x39, 0x6d, 0xc2, 0xc0, 0x67, 0x60, 0xe4, 0x43,
+	0x20, 0xf1, 0x35, 0x3a, 0x76, 0x44, 0x0c, 0x05, 0xb5, 0x33, 0xdf, 0x01, 0xf9, 0xce, 0x6e, 0x53,
+	0xe8, 0xd0, 0xa1, 0x5b, 0xee, 0x79, 0xdf, 0x3c, 0xcf, 0xf3, 0xfe, 0x33, 0xea, 0xb2, 0x39, 0x9b,
+	0x65, 0xb9, 0x28, 0xc4, 0x48, 0xc4, 0x5e, 0x98, 0x73, 0x16, 0x81, 0x97, 0xd1, 0x9c, 0x26, 0xd2,
+	0x55, 0x30, 0xde, 0x58, 0xcc, 0x70, 0x75, 0xc6, 0x76, 0x2b, 0x12, 0x91, 0x50, 0xa0, 0x57, 0xfe,
+	0xd2, 0xa9, 0xdb, 0x4e, 0x24, 0x44, 0x14, 0x83, 0xa7, 0x5e, 0xe1, 0x74, 0xec, 0xb1, 0x69, 0x4e,
+	0x0b, 0x2e, 0x52, 0x1d, 0xef, 0x8d, 0x91, 0xf9, 0xfa, 0x18, 0xd2, 0x62, 0xa8, 0xf8, 0x71, 0x0b,
+	0xad, 0x30, 0x48, 0x45, 0xd2, 0x36, 0xba, 0xc6, 0xee, 0x7a, 0xa0, 0x1f, 0xb8, 0x8b, 0x2c, 0x28,
+	0x26, 0x64, 0x34, 0xa1, 0x3c, 0x25, 0x9c, 0xb5, 0x97, 0xba, 0xc6, 0x6e, 0x33, 0x40, 0x50, 0x4c,
+	0xf6, 0x4b, 0x68, 0xc0, 0x70, 0x07, 0x99, 0x65, 0x06, 0x65, 0x2c, 0x07, 0x29, 0xdb, 0xcb, 0xea,
+	0xdf, 0x65, 0xc2, 0x2b, 0x8d, 0xf4, 0xbe, 0x2d, 0x21, 0x7b, 0x98, 0x8b, 0x4c, 0x48, 0xa8, 0xa4,
+	0x9e, 0xa2, 0xcd, 0x84, 0xce, 0x88, 0x76, 0x2f, 0x49, 0x06, 0x39, 0x09, 0x63, 0x31, 0x3a, 0x52,
+	0xd2, 0x76, 0x80, 0x13, 0x3a, 0xf3, 0x75, 0x6c, 0x08, 0xb9, 0x5f, 0x46, 0xf0, 0x7b, 0xb4, 0x95,
+	0x69, 0x0e, 0xc2, 0x20, 0xa6, 0x73, 0x52, 0x17, 0xa3, 0x1c, 0x99, 0x7b, 0x0f, 0x5d, 0x5d, 0xad,
+	0x5b, 0x57, 0xeb, 0xf6, 0xab, 0x04, 0x7f, 0xed, 0xf4, 0xbc, 0xd3, 0xf8, 0xf2, 0xb3, 0x63, 0x04,
+	0xad, 0x8a, 0xa2, 0x5f, 0x32, 0xd4, 0x71, 0xdc, 0x43, 0xb6, 0x3c, 0xe2, 0x19, 0xc9, 0x69, 0x01,
+	0x24, 0xcb, 0x12, 0x55, 0x82, 0x1d, 0x98, 0x25, 0x18, 0xd0, 0x02, 0x86, 0x59, 0x82, 0x63, 0xb4,
+	0xa3, 0x72, 0xf8, 0x58, 0x3b, 0xd5, 0x26, 0x80, 0x91, 0x70, 0xc1, 0x49, 0xf3, 0xee, 0x4e, 0x1e,
+	0x95, 0x6c, 0x83, 0xb1, 0xaa, 0xad, 0xaf, 0xa9, 0xfc, 0x2b, 0x47, 0xbd, 0x00, 0x59, 0x87, 0x74,
+	0x0c, 0xc5, 0xbc, 0xea, 0x57, 0x07, 0x99, 0x5c, 0x12, 0xc6, 0x25, 0x0d, 0x63, 0x60, 0xaa, 0x4b,
+	0x6b, 0x01, 0xe2, 0xb2, 0x5f, 0x21, 0x78, 0x07, 0x59, 0xba, 0x2b, 0xca, 0x9c, 0x54, 0x3d, 0xb1,
+	0x03, 0x53, 0x61, 0x4a, 0x43, 0xf6, 0xbe, 0x1a, 0xe8, 0xc1, 0xa1, 0x98, 0xe6, 0x23, 0x50, 0x83,
+	0xab, 0x98, 0x07, 0xc8, 0x82, 0x72, 0x07, 0x88, 0x5e, 0x32, 0x45, 0x6d, 0xee, 0x75, 0xdd, 0x5b,
+	0xb6, 0xcc, 0x5d, 0x58, 0x16, 0xbf, 0x59, 0x56, 0x12, 0x98, 0x70, 0x0d, 0xe1, 0x03, 0x64, 0x4b,
+	0x65, 0xba, 0xe6, 0xd2, 0x83, 0xd9, 0xb9, 0x95, 0x6b, 0xb1, 0xbc, 0x8a, 0xcc, 0x92, 0x0b, 0x58,
+	0xef, 0xf7, 0x32, 0xfa, 0xaf, 0x6c, 0xfe, 0x01, 0x4f, 0x78, 0xad, 0xf0, 0xf7, 0x2e, 0x1a, 0xff,
+	0xec, 0xe2, 0x63, 0x64, 0x9f, 0xf0, 0x94, 0x89, 0x93, 0x9b, 0x8d, 0xb0, 0x34, 0xa8, 0x3b, 0x81,
+	0x23, 0x84, 0xaa, 0xa4, 0x11, 0xcd, 0xd4, 0xb0, 0x2d, 0xff, 0x4d, 0x69, 0xe1, 0xc7, 0x79, 0xe7,
+	0x65, 0xc4, 0x8b, 0xc9, 0x34, 0x74, 0x47, 0x22, 0xf1, 0x6e, 0xdc, 0xe2, 0xf1, 0xf3, 0x27, 0x4a,
+	0xd7, 0xbb, 0x42, 0x58, 0x31, 0xcf, 0x40, 0xba, 0x87, 0x90, 0x73, 0x1a, 0xf3, 0x8f, 0xe5, 0x20,
+	0x06, 0x69, 0x11, 0xac, 0x6b, 0xee, 0x7d, 0x9a, 0xe1, 0x63, 0x84, 0xab, 0xab, 0x20, 0x0b, 0x82,
+	0xcd, 0x7b, 0x16, 0xfc, 0xbf, 0xd2, 0x78, 0x77, 0xa5, 0xfb, 0x09, 0x6d, 0xc5, 0x34, 0x8f, 0xa0,
+	0x3a, 0x30, 0x52, 0x4c, 0x72, 0x90, 0x13, 0x11, 0xb3, 0xf6, 0xca, 0x3d, 0x6b, 0xb7, 0x94, 0x8e,
+	0xbe, 0xd5, 0xb7, 0xb5, 0x0a, 0xde, 0x43, 0x9b, 0x30, 0x2b, 0x20, 0x65, 0xc0, 0xc8, 0x8d, 0xb5,
+	0x5c, 0x55, 0xd3, 0xd8, 0xa8, 0x83, 0xfd, 0xeb, 0xf5, 0xf4, 0x83, 0xd3, 0x0b, 0xc7, 0x38, 0xbb,
+	0x70, 0x8c, 0x5f, 0x17, 0x8e, 0xf1, 0xf9, 0xd2, 0x69, 0x9c, 0x5d, 0x3a, 0x8d, 0xef, 0x97, 0x4e,
+	0xe3, 0xc3, 0x8b, 0xbb, 0xbb, 0x9c, 0xd5, 0x9f, 0x4c, 0xe5, 0x36, 0x5c, 0x55, 0x81, 0x67, 0x7f,
+	0x06, 0x00, 0x70, 0xe2, 0x40, 0x6b, 0x56, 0x05, 0x00, 0x00,
 }
 
 func (m *EventParams) Marshal() (dAtA []byte, err error) {
@@ -398,6 +551,117 @@ func (m *SafetyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SourceChainParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceChainParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SafetyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EventParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtendedDelayBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExtendedDelayBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.LargeBridgeThreshold.Size()
+		i -= size
+		if _, err := m.LargeBridgeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AddressWindowCap.Size()
+		i -= size
+		if _, err := m.AddressWindowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.WindowCap.Size()
+		i -= size
+		if _, err := m.WindowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EthChainId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EthChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -463,6 +727,43 @@ func (m *SafetyParams) Size() (n int) {
 	return n
 }
 
+func (m *SourceChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EventParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SafetyParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RateLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthChainId != 0 {
+		n += 1 + sovParams(uint64(m.EthChainId))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.WindowBlocks))
+	}
+	l = m.WindowCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.AddressWindowCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LargeBridgeThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ExtendedDelayBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ExtendedDelayBlocks))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -845,6 +1146,328 @@ func (m *SafetyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SourceChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EventParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafetyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SafetyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			}
+			m.EthChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowCap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressWindowCap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddressWindowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeBridgeThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LargeBridgeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDelayBlocks", wireType)
+			}
+			m.ExtendedDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedDelayBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,7 +4,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
 )
@@ -90,6 +92,56 @@ func TestProposeParams_Validate(t *testing.T) {
 	}
 }
 
+func TestSourceChainParams_Validate(t *testing.T) {
+	tests := map[string]struct {
+		params *types.SourceChainParams
+		err    string
+	}{
+		"Valid": {
+			params: &types.SourceChainParams{
+				EventParams: types.EventParams{
+					Denom:      "denom",
+					EthChainId: 1,
+					EthAddress: "test",
+				},
+				SafetyParams: types.SafetyParams{
+					DelayBlocks: 10,
+				},
+			},
+		},
+		"Invalid: chain ID zero": {
+			params: &types.SourceChainParams{
+				EventParams: types.EventParams{
+					Denom:      "denom",
+					EthChainId: 0,
+					EthAddress: "test",
+				},
+			},
+			err: types.ErrInvalidSourceChain.Error(),
+		},
+		"Invalid: event params": {
+			params: &types.SourceChainParams{
+				EventParams: types.EventParams{
+					Denom:      "denom",
+					EthChainId: 1,
+					EthAddress: "",
+				},
+			},
+			err: types.ErrInvalidEthAddress.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
 func TestSafetyParams_Validate(t *testing.T) {
 	tests := map[string]struct {
 		params *types.SafetyParams
@@ -115,3 +167,57 @@ func TestSafetyParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitParams_Validate(t *testing.T) {
+	tests := map[string]struct {
+		params *types.RateLimitParams
+		err    error
+	}{
+		"empty is valid": {
+			params: &types.RateLimitParams{},
+			err:    nil,
+		},
+		"all limits set is valid": {
+			params: &constants.RateLimitParams,
+			err:    nil,
+		},
+		"only large bridge threshold set is valid": {
+			params: &types.RateLimitParams{
+				LargeBridgeThreshold: dtypes.NewInt(100),
+				ExtendedDelayBlocks:  10,
+			},
+			err: nil,
+		},
+		"negative window cap": {
+			params: &types.RateLimitParams{
+				WindowBlocks:        10,
+				WindowCap:           dtypes.NewInt(-1),
+				ExtendedDelayBlocks: 10,
+			},
+			err: types.ErrInvalidRateLimitParams,
+		},
+		"window cap set without window blocks": {
+			params: &types.RateLimitParams{
+				AddressWindowCap:    dtypes.NewInt(100),
+				ExtendedDelayBlocks: 10,
+			},
+			err: types.ErrInvalidRateLimitParams,
+		},
+		"large bridge threshold set without extended delay blocks": {
+			params: &types.RateLimitParams{
+				LargeBridgeThreshold: dtypes.NewInt(100),
+			},
+			err: types.ErrInvalidRateLimitParams,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -6,9 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -276,16 +278,296 @@ func (m *QuerySafetyParamsResponse) GetParams() SafetyParams {
 	return SafetyParams{}
 }
 
+// QuerySourceChainParamsRequest is a request type for the SourceChainParams
+// RPC method.
+type QuerySourceChainParamsRequest struct {
+}
+
+func (m *QuerySourceChainParamsRequest) Reset()         { *m = QuerySourceChainParamsRequest{} }
+func (m *QuerySourceChainParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceChainParamsRequest) ProtoMessage()    {}
+func (*QuerySourceChainParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{6}
+}
+func (m *QuerySourceChainParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceChainParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySourceChainParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceChainParamsRequest.Merge(m, src)
+}
+func (m *QuerySourceChainParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceChainParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceChainParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySourceChainParamsRequest proto.InternalMessageInfo
+
+// QuerySourceChainParamsResponse is a response type for the SourceChainParams
+// RPC method.
+type QuerySourceChainParamsResponse struct {
+	Params []SourceChainParams `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
+}
+
+func (m *QuerySourceChainParamsResponse) Reset()         { *m = QuerySourceChainParamsResponse{} }
+func (m *QuerySourceChainParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceChainParamsResponse) ProtoMessage()    {}
+func (*QuerySourceChainParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{7}
+}
+func (m *QuerySourceChainParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceChainParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceChainParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySourceChainParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceChainParamsResponse.Merge(m, src)
+}
+func (m *QuerySourceChainParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceChainParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceChainParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySourceChainParamsResponse proto.InternalMessageInfo
+
+func (m *QuerySourceChainParamsResponse) GetParams() []SourceChainParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// QueryRateLimitParamsRequest is a request type for the RateLimitParams RPC
+// method.
+type QueryRateLimitParamsRequest struct {
+}
+
+func (m *QueryRateLimitParamsRequest) Reset()         { *m = QueryRateLimitParamsRequest{} }
+func (m *QueryRateLimitParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitParamsRequest) ProtoMessage()    {}
+func (*QueryRateLimitParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{8}
+}
+func (m *QueryRateLimitParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitParamsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitParamsRequest proto.InternalMessageInfo
+
+// QueryRateLimitParamsResponse is a response type for the RateLimitParams RPC
+// method.
+type QueryRateLimitParamsResponse struct {
+	Params []RateLimitParams `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
+}
+
+func (m *QueryRateLimitParamsResponse) Reset()         { *m = QueryRateLimitParamsResponse{} }
+func (m *QueryRateLimitParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitParamsResponse) ProtoMessage()    {}
+func (*QueryRateLimitParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{9}
+}
+func (m *QueryRateLimitParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitParamsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitParamsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitParamsResponse) GetParams() []RateLimitParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// QueryWindowUtilizationRequest is a request type for the WindowUtilization
+// RPC method.
+type QueryWindowUtilizationRequest struct {
+	// The numerical chain ID of the Ethereum chain to query. Zero refers to the
+	// chain of `EventParams`.
+	EthChainId uint64 `protobuf:"varint,1,opt,name=eth_chain_id,json=ethChainId,proto3" json:"eth_chain_id,omitempty"`
+	// If set, the amount bridged to this address within the window is also
+	// returned.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryWindowUtilizationRequest) Reset()         { *m = QueryWindowUtilizationRequest{} }
+func (m *QueryWindowUtilizationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWindowUtilizationRequest) ProtoMessage()    {}
+func (*QueryWindowUtilizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{10}
+}
+func (m *QueryWindowUtilizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWindowUtilizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWindowUtilizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWindowUtilizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWindowUtilizationRequest.Merge(m, src)
+}
+func (m *QueryWindowUtilizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWindowUtilizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWindowUtilizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWindowUtilizationRequest proto.InternalMessageInfo
+
+func (m *QueryWindowUtilizationRequest) GetEthChainId() uint64 {
+	if m != nil {
+		return m.EthChainId
+	}
+	return 0
+}
+
+func (m *QueryWindowUtilizationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryWindowUtilizationResponse is a response type for the WindowUtilization
+// RPC method.
+type QueryWindowUtilizationResponse struct {
+	// The value-based safety parameters of the chain.
+	Params RateLimitParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// The total amount bridged within the current window.
+	TotalAmount github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"total_amount"`
+	// The amount bridged to `address` within the current window.
+	AddressAmount github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=address_amount,json=addressAmount,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"address_amount"`
+}
+
+func (m *QueryWindowUtilizationResponse) Reset()         { *m = QueryWindowUtilizationResponse{} }
+func (m *QueryWindowUtilizationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWindowUtilizationResponse) ProtoMessage()    {}
+func (*QueryWindowUtilizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{11}
+}
+func (m *QueryWindowUtilizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWindowUtilizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWindowUtilizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWindowUtilizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWindowUtilizationResponse.Merge(m, src)
+}
+func (m *QueryWindowUtilizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWindowUtilizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWindowUtilizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWindowUtilizationResponse proto.InternalMessageInfo
+
+func (m *QueryWindowUtilizationResponse) GetParams() RateLimitParams {
+	if m != nil {
+		return m.Params
+	}
+	return RateLimitParams{}
+}
+
 // QueryAcknowledgedEventInfoRequest is a request type for the
 // AcknowledgedEventInfo RPC method.
 type QueryAcknowledgedEventInfoRequest struct {
+	// The numerical chain ID of the Ethereum chain to query. Zero refers to the
+	// chain of `EventParams`.
+	EthChainId uint64 `protobuf:"varint,1,opt,name=eth_chain_id,json=ethChainId,proto3" json:"eth_chain_id,omitempty"`
 }
 
 func (m *QueryAcknowledgedEventInfoRequest) Reset()         { *m = QueryAcknowledgedEventInfoRequest{} }
 func (m *QueryAcknowledgedEventInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAcknowledgedEventInfoRequest) ProtoMessage()    {}
 func (*QueryAcknowledgedEventInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{6}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{12}
 }
 func (m *QueryAcknowledgedEventInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,6 +596,13 @@ func (m *QueryAcknowledgedEventInfoRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAcknowledgedEventInfoRequest proto.InternalMessageInfo
 
+func (m *QueryAcknowledgedEventInfoRequest) GetEthChainId() uint64 {
+	if m != nil {
+		return m.EthChainId
+	}
+	return 0
+}
+
 // QueryAcknowledgedEventInfoResponse is a response type for the
 // AcknowledgedEventInfo RPC method.
 type QueryAcknowledgedEventInfoResponse struct {
@@ -324,7 +613,7 @@ func (m *QueryAcknowledgedEventInfoResponse) Reset()         { *m = QueryAcknowl
 func (m *QueryAcknowledgedEventInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAcknowledgedEventInfoResponse) ProtoMessage()    {}
 func (*QueryAcknowledgedEventInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{7}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{13}
 }
 func (m *QueryAcknowledgedEventInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,13 +652,16 @@ func (m *QueryAcknowledgedEventInfoResponse) GetInfo() BridgeEventInfo {
 // QueryRecognizedEventInfoRequest is a request type for the
 // RecognizedEventInfo RPC method.
 type QueryRecognizedEventInfoRequest struct {
+	// The numerical chain ID of the Ethereum chain to query. Zero refers to the
+	// chain of `EventParams`.
+	EthChainId uint64 `protobuf:"varint,1,opt,name=eth_chain_id,json=ethChainId,proto3" json:"eth_chain_id,omitempty"`
 }
 
 func (m *QueryRecognizedEventInfoRequest) Reset()         { *m = QueryRecognizedEventInfoRequest{} }
 func (m *QueryRecognizedEventInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRecognizedEventInfoRequest) ProtoMessage()    {}
 func (*QueryRecognizedEventInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{8}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{14}
 }
 func (m *QueryRecognizedEventInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,6 +690,13 @@ func (m *QueryRecognizedEventInfoRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryRecognizedEventInfoRequest proto.InternalMessageInfo
 
+func (m *QueryRecognizedEventInfoRequest) GetEthChainId() uint64 {
+	if m != nil {
+		return m.EthChainId
+	}
+	return 0
+}
+
 // QueryRecognizedEventInfoResponse is a response type for the
 // RecognizedEventInfo RPC method.
 type QueryRecognizedEventInfoResponse struct {
@@ -408,7 +707,7 @@ func (m *QueryRecognizedEventInfoResponse) Reset()         { *m = QueryRecognize
 func (m *QueryRecognizedEventInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRecognizedEventInfoResponse) ProtoMessage()    {}
 func (*QueryRecognizedEventInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{9}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{15}
 }
 func (m *QueryRecognizedEventInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +757,7 @@ func (m *QueryDelayedCompleteBridgeMessagesRequest) String() string {
 }
 func (*QueryDelayedCompleteBridgeMessagesRequest) ProtoMessage() {}
 func (*QueryDelayedCompleteBridgeMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{10}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{16}
 }
 func (m *QueryDelayedCompleteBridgeMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +807,7 @@ func (m *QueryDelayedCompleteBridgeMessagesResponse) String() string {
 }
 func (*QueryDelayedCompleteBridgeMessagesResponse) ProtoMessage() {}
 func (*QueryDelayedCompleteBridgeMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{11}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{17}
 }
 func (m *QueryDelayedCompleteBridgeMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +855,7 @@ func (m *DelayedCompleteBridgeMessage) Reset()         { *m = DelayedCompleteBri
 func (m *DelayedCompleteBridgeMessage) String() string { return proto.CompactTextString(m) }
 func (*DelayedCompleteBridgeMessage) ProtoMessage()    {}
 func (*DelayedCompleteBridgeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{12}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{18}
 }
 func (m *DelayedCompleteBridgeMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,705 +898,1089 @@ func (m *DelayedCompleteBridgeMessage) GetBlockHeight() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryEventParamsRequest)(nil), "dydxprotocol.bridge.QueryEventParamsRequest")
-	proto.RegisterType((*QueryEventParamsResponse)(nil), "dydxprotocol.bridge.QueryEventParamsResponse")
-	proto.RegisterType((*QueryProposeParamsRequest)(nil), "dydxprotocol.bridge.QueryProposeParamsRequest")
-	proto.RegisterType((*QueryProposeParamsResponse)(nil), "dydxprotocol.bridge.QueryProposeParamsResponse")
-	proto.RegisterType((*QuerySafetyParamsRequest)(nil), "dydxprotocol.bridge.QuerySafetyParamsRequest")
-	proto.RegisterType((*QuerySafetyParamsResponse)(nil), "dydxprotocol.bridge.QuerySafetyParamsResponse")
-	proto.RegisterType((*QueryAcknowledgedEventInfoRequest)(nil), "dydxprotocol.bridge.QueryAcknowledgedEventInfoRequest")
-	proto.RegisterType((*QueryAcknowledgedEventInfoResponse)(nil), "dydxprotocol.bridge.QueryAcknowledgedEventInfoResponse")
-	proto.RegisterType((*QueryRecognizedEventInfoRequest)(nil), "dydxprotocol.bridge.QueryRecognizedEventInfoRequest")
-	proto.RegisterType((*QueryRecognizedEventInfoResponse)(nil), "dydxprotocol.bridge.QueryRecognizedEventInfoResponse")
-	proto.RegisterType((*QueryDelayedCompleteBridgeMessagesRequest)(nil), "dydxprotocol.bridge.QueryDelayedCompleteBridgeMessagesRequest")
-	proto.RegisterType((*QueryDelayedCompleteBridgeMessagesResponse)(nil), "dydxprotocol.bridge.QueryDelayedCompleteBridgeMessagesResponse")
-	proto.RegisterType((*DelayedCompleteBridgeMessage)(nil), "dydxprotocol.bridge.DelayedCompleteBridgeMessage")
+// QueryWithdrawalRequest is a request type for the Withdrawal RPC method.
+type QueryWithdrawalRequest struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/bridge/query.proto", fileDescriptor_b4ca11b6b8f7f939) }
-
-var fileDescriptor_b4ca11b6b8f7f939 = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xc1, 0x53, 0xd3, 0x40,
-	0x14, 0xc6, 0xbb, 0x88, 0x80, 0xaf, 0x70, 0x59, 0x74, 0x2c, 0x11, 0x4b, 0x1b, 0x11, 0xab, 0xd2,
-	0x64, 0x40, 0x10, 0xc6, 0x03, 0x20, 0x8a, 0xa3, 0x07, 0x66, 0xb0, 0xdc, 0x18, 0xc7, 0x4e, 0x9a,
-	0x2c, 0x69, 0x86, 0x36, 0x1b, 0x92, 0x80, 0xd4, 0x9b, 0xde, 0xbc, 0x39, 0xe3, 0xc5, 0x83, 0x07,
-	0xff, 0x0d, 0x8f, 0xde, 0xf0, 0xc6, 0x8c, 0x17, 0x4f, 0x8e, 0xd3, 0xfa, 0x87, 0x38, 0xdd, 0x6c,
-	0x3a, 0x49, 0xdd, 0x64, 0x8a, 0xe3, 0x29, 0xb0, 0xfb, 0xbe, 0xf7, 0xfd, 0xde, 0x86, 0xfd, 0x08,
-	0xcc, 0x18, 0x2d, 0xe3, 0xc4, 0x71, 0xa9, 0x4f, 0x75, 0xda, 0x50, 0x6b, 0xae, 0x65, 0x98, 0x44,
-	0x3d, 0x3c, 0x22, 0x6e, 0x4b, 0x61, 0xab, 0x78, 0x32, 0x5a, 0xa0, 0x04, 0x05, 0xd2, 0x65, 0x93,
-	0x9a, 0x94, 0x2d, 0xaa, 0xdd, 0x9f, 0x82, 0x52, 0x69, 0xda, 0xa4, 0xd4, 0x6c, 0x10, 0x55, 0x73,
-	0x2c, 0x55, 0xb3, 0x6d, 0xea, 0x6b, 0xbe, 0x45, 0x6d, 0x8f, 0xef, 0xde, 0x15, 0x39, 0x05, 0x8f,
-	0x2a, 0x39, 0x26, 0xb6, 0x5f, 0xb5, 0xec, 0xfd, 0xb0, 0x55, 0x41, 0x54, 0xec, 0x68, 0xae, 0xd6,
-	0x0c, 0xdb, 0x4d, 0x8b, 0x2a, 0xfc, 0x93, 0x60, 0x57, 0x9e, 0x82, 0xab, 0xcf, 0xbb, 0x43, 0x6c,
-	0x75, 0x1b, 0xef, 0x30, 0x5d, 0x85, 0x1c, 0x1e, 0x11, 0xcf, 0x97, 0xf7, 0x20, 0xf7, 0xf7, 0x96,
-	0xe7, 0x50, 0xdb, 0x23, 0x78, 0x0d, 0x46, 0x02, 0x93, 0x1c, 0x2a, 0xa0, 0x52, 0x76, 0xb1, 0xa0,
-	0x08, 0xa6, 0x57, 0x22, 0xca, 0xcd, 0xe1, 0xd3, 0x9f, 0x33, 0x99, 0x0a, 0x57, 0xc9, 0xd7, 0x60,
-	0x8a, 0xf5, 0xde, 0x71, 0xa9, 0x43, 0x3d, 0x12, 0x37, 0x7e, 0x09, 0x92, 0x68, 0x93, 0x5b, 0x6f,
-	0xf4, 0x59, 0xcb, 0x42, 0xeb, 0x98, 0xb6, 0xcf, 0x5c, 0xe2, 0x83, 0xed, 0x6a, 0xfb, 0xc4, 0x6f,
-	0xc5, 0xbd, 0x5f, 0x70, 0xb0, 0xf8, 0x1e, 0xb7, 0x5e, 0xef, 0xb3, 0x2e, 0x0a, 0xad, 0xa3, 0xd2,
-	0x3e, 0xe7, 0x1b, 0x50, 0x64, 0xdd, 0x1f, 0xea, 0x07, 0x36, 0x7d, 0xd5, 0x20, 0x86, 0x49, 0x0c,
-	0x76, 0x48, 0xcf, 0xec, 0x7d, 0x1a, 0x22, 0x18, 0x20, 0xa7, 0x15, 0xf5, 0xde, 0xc0, 0x70, 0xf7,
-	0xcf, 0x80, 0x93, 0xcc, 0x0a, 0x49, 0x36, 0xd9, 0xa3, 0xa7, 0xe5, 0x30, 0x4c, 0x27, 0x17, 0x61,
-	0x86, 0xb9, 0x54, 0x88, 0x4e, 0x4d, 0xdb, 0x7a, 0x2d, 0x00, 0xa9, 0x41, 0x21, 0xb9, 0xe4, 0x3f,
-	0x61, 0x6c, 0xc1, 0x6d, 0xe6, 0xf1, 0x98, 0x34, 0xb4, 0x16, 0x31, 0x1e, 0xd1, 0xa6, 0xd3, 0x20,
-	0x3e, 0x09, 0x24, 0xdb, 0xc4, 0xf3, 0x34, 0x93, 0x84, 0x2f, 0x07, 0xe7, 0x60, 0x54, 0x33, 0x0c,
-	0x97, 0x78, 0xc1, 0x0b, 0xb8, 0x54, 0x09, 0x7f, 0x95, 0xdf, 0x20, 0xb8, 0x33, 0x48, 0x1f, 0x4e,
-	0xbd, 0x0b, 0x63, 0x4d, 0xbe, 0x96, 0x43, 0x85, 0x0b, 0xa5, 0xec, 0xe2, 0x82, 0x90, 0x3c, 0xad,
-	0x1b, 0x1f, 0xa3, 0xd7, 0x48, 0x7e, 0x87, 0x60, 0x3a, 0x4d, 0x80, 0x9f, 0xc0, 0x28, 0x2f, 0xe6,
-	0xc7, 0x35, 0x27, 0x34, 0xdd, 0xf6, 0xcc, 0xb8, 0x9e, 0x3b, 0x85, 0x62, 0x5c, 0x84, 0xf1, 0x5a,
-	0x83, 0xea, 0x07, 0xd5, 0x3a, 0xb1, 0xcc, 0xba, 0x9f, 0x1b, 0x2a, 0xa0, 0xd2, 0x44, 0x25, 0xcb,
-	0xd6, 0x9e, 0xb2, 0xa5, 0xc5, 0x6f, 0x63, 0x70, 0x91, 0x9d, 0x07, 0xfe, 0x88, 0x20, 0x1b, 0xb9,
-	0x87, 0x78, 0x5e, 0xe8, 0x99, 0x90, 0x01, 0x52, 0x79, 0xc0, 0xea, 0xe0, 0x5c, 0xe5, 0xf9, 0xb7,
-	0xdf, 0x7f, 0x7f, 0x18, 0x9a, 0xc3, 0xb3, 0x6a, 0x2c, 0x74, 0x8e, 0x97, 0xc2, 0xdc, 0x09, 0xf2,
-	0x2b, 0xb8, 0x0d, 0xf8, 0x33, 0x82, 0x89, 0xd8, 0x3d, 0xc5, 0x4a, 0xb2, 0x9d, 0x28, 0x29, 0x24,
-	0x75, 0xe0, 0x7a, 0x0e, 0xa8, 0x30, 0xc0, 0x12, 0x9e, 0x4b, 0x02, 0x74, 0x02, 0x59, 0x88, 0xf8,
-	0x09, 0xc1, 0x78, 0xf4, 0x3e, 0xe3, 0x94, 0x03, 0x11, 0xc4, 0x89, 0xa4, 0x0c, 0x5a, 0xce, 0xf9,
-	0xca, 0x8c, 0xef, 0x16, 0xbe, 0x99, 0xc4, 0xe7, 0x31, 0x55, 0x88, 0xf7, 0x15, 0xc1, 0x15, 0x61,
-	0x4c, 0xe0, 0xfb, 0xc9, 0xc6, 0x69, 0xe1, 0x23, 0xad, 0x9c, 0x5b, 0xc7, 0xc9, 0x57, 0x18, 0xf9,
-	0x02, 0x56, 0x93, 0xc8, 0xb5, 0x88, 0x3c, 0xf2, 0x7f, 0x0c, 0x7f, 0x41, 0x30, 0x29, 0x48, 0x18,
-	0xbc, 0x94, 0x4c, 0x92, 0x9c, 0x59, 0xd2, 0xf2, 0x39, 0x55, 0x9c, 0x7e, 0x99, 0xd1, 0xab, 0xb8,
-	0x9c, 0x44, 0xef, 0xf6, 0xc4, 0x51, 0xf6, 0x36, 0x82, 0xeb, 0xa9, 0x89, 0x83, 0xd7, 0x92, 0x79,
-	0x06, 0x89, 0x3c, 0x69, 0xfd, 0x9f, 0xf5, 0x7c, 0xb2, 0x0d, 0x36, 0xd9, 0x03, 0xbc, 0x9a, 0x34,
-	0x99, 0x11, 0xb4, 0xa9, 0xea, 0xbc, 0x4f, 0x95, 0x7f, 0x6a, 0x84, 0xb9, 0xb6, 0x59, 0x39, 0x6d,
-	0xe7, 0xd1, 0x59, 0x3b, 0x8f, 0x7e, 0xb5, 0xf3, 0xe8, 0x7d, 0x27, 0x9f, 0x39, 0xeb, 0xe4, 0x33,
-	0x3f, 0x3a, 0xf9, 0xcc, 0xde, 0xaa, 0x69, 0xf9, 0xf5, 0xa3, 0x9a, 0xa2, 0xd3, 0x66, 0x7f, 0xf7,
-	0xb2, 0x5e, 0xd7, 0x2c, 0x5b, 0xed, 0xad, 0x9c, 0xf4, 0xbe, 0x3c, 0x5a, 0x0e, 0xf1, 0x6a, 0x23,
-	0x6c, 0xe3, 0xde, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xca, 0x65, 0x64, 0x56, 0x09, 0x00,
-	0x00,
+func (m *QueryWithdrawalRequest) Reset()         { *m = QueryWithdrawalRequest{} }
+func (m *QueryWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalRequest) ProtoMessage()    {}
+func (*QueryWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{19}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries the EventParams.
-	EventParams(ctx context.Context, in *QueryEventParamsRequest, opts ...grpc.CallOption) (*QueryEventParamsResponse, error)
-	// Queries the ProposeParams.
-	ProposeParams(ctx context.Context, in *QueryProposeParamsRequest, opts ...grpc.CallOption) (*QueryProposeParamsResponse, error)
-	// Queries the SafetyParams.
-	SafetyParams(ctx context.Context, in *QuerySafetyParamsRequest, opts ...grpc.CallOption) (*QuerySafetyParamsResponse, error)
-	// Queries the AcknowledgedEventInfo.
-	// An "acknowledged" event is one that is in-consensus and has been stored
-	// in-state.
-	AcknowledgedEventInfo(ctx context.Context, in *QueryAcknowledgedEventInfoRequest, opts ...grpc.CallOption) (*QueryAcknowledgedEventInfoResponse, error)
-	// Queries the RecognizedEventInfo.
-	// A "recognized" event is one that is finalized on the Ethereum blockchain
-	// and has been identified by the queried node. It is not yet in-consensus.
-	RecognizedEventInfo(ctx context.Context, in *QueryRecognizedEventInfoRequest, opts ...grpc.CallOption) (*QueryRecognizedEventInfoResponse, error)
-	// Queries all `MsgCompleteBridge` messages that are delayed (not yet
-	// executed) and corresponding block heights at which they will execute.
-	DelayedCompleteBridgeMessages(ctx context.Context, in *QueryDelayedCompleteBridgeMessagesRequest, opts ...grpc.CallOption) (*QueryDelayedCompleteBridgeMessagesResponse, error)
+func (m *QueryWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalRequest.Merge(m, src)
+}
+func (m *QueryWithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) EventParams(ctx context.Context, in *QueryEventParamsRequest, opts ...grpc.CallOption) (*QueryEventParamsResponse, error) {
-	out := new(QueryEventParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/EventParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawalRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawalRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ProposeParams(ctx context.Context, in *QueryProposeParamsRequest, opts ...grpc.CallOption) (*QueryProposeParamsResponse, error) {
-	out := new(QueryProposeParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/ProposeParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryWithdrawalResponse is a response type for the Withdrawal RPC method.
+type QueryWithdrawalResponse struct {
+	Withdrawal BridgeWithdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal"`
 }
 
-func (c *queryClient) SafetyParams(ctx context.Context, in *QuerySafetyParamsRequest, opts ...grpc.CallOption) (*QuerySafetyParamsResponse, error) {
-	out := new(QuerySafetyParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/SafetyParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWithdrawalResponse) Reset()         { *m = QueryWithdrawalResponse{} }
+func (m *QueryWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalResponse) ProtoMessage()    {}
+func (*QueryWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{20}
+}
+func (m *QueryWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalResponse.Merge(m, src)
+}
+func (m *QueryWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AcknowledgedEventInfo(ctx context.Context, in *QueryAcknowledgedEventInfoRequest, opts ...grpc.CallOption) (*QueryAcknowledgedEventInfoResponse, error) {
-	out := new(QueryAcknowledgedEventInfoResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/AcknowledgedEventInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawalResponse) GetWithdrawal() BridgeWithdrawal {
+	if m != nil {
+		return m.Withdrawal
 	}
-	return out, nil
+	return BridgeWithdrawal{}
 }
 
-func (c *queryClient) RecognizedEventInfo(ctx context.Context, in *QueryRecognizedEventInfoRequest, opts ...grpc.CallOption) (*QueryRecognizedEventInfoResponse, error) {
-	out := new(QueryRecognizedEventInfoResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/RecognizedEventInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryPendingWithdrawalsRequest is a request type for the PendingWithdrawals
+// RPC method.
+type QueryPendingWithdrawalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) DelayedCompleteBridgeMessages(ctx context.Context, in *QueryDelayedCompleteBridgeMessagesRequest, opts ...grpc.CallOption) (*QueryDelayedCompleteBridgeMessagesResponse, error) {
-	out := new(QueryDelayedCompleteBridgeMessagesResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/DelayedCompleteBridgeMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingWithdrawalsRequest) Reset()         { *m = QueryPendingWithdrawalsRequest{} }
+func (m *QueryPendingWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{21}
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWithdrawalsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries the EventParams.
-	EventParams(context.Context, *QueryEventParamsRequest) (*QueryEventParamsResponse, error)
-	// Queries the ProposeParams.
-	ProposeParams(context.Context, *QueryProposeParamsRequest) (*QueryProposeParamsResponse, error)
-	// Queries the SafetyParams.
-	SafetyParams(context.Context, *QuerySafetyParamsRequest) (*QuerySafetyParamsResponse, error)
-	// Queries the AcknowledgedEventInfo.
-	// An "acknowledged" event is one that is in-consensus and has been stored
-	// in-state.
-	AcknowledgedEventInfo(context.Context, *QueryAcknowledgedEventInfoRequest) (*QueryAcknowledgedEventInfoResponse, error)
-	// Queries the RecognizedEventInfo.
-	// A "recognized" event is one that is finalized on the Ethereum blockchain
-	// and has been identified by the queried node. It is not yet in-consensus.
-	RecognizedEventInfo(context.Context, *QueryRecognizedEventInfoRequest) (*QueryRecognizedEventInfoResponse, error)
-	// Queries all `MsgCompleteBridge` messages that are delayed (not yet
-	// executed) and corresponding block heights at which they will execute.
-	DelayedCompleteBridgeMessages(context.Context, *QueryDelayedCompleteBridgeMessagesRequest) (*QueryDelayedCompleteBridgeMessagesResponse, error)
+var xxx_messageInfo_QueryPendingWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryPendingWithdrawalsResponse is a response type for the
+// PendingWithdrawals RPC method.
+type QueryPendingWithdrawalsResponse struct {
+	Withdrawals []BridgeWithdrawal  `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) EventParams(ctx context.Context, req *QueryEventParamsRequest) (*QueryEventParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EventParams not implemented")
+func (m *QueryPendingWithdrawalsResponse) Reset()         { *m = QueryPendingWithdrawalsResponse{} }
+func (m *QueryPendingWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{22}
 }
-func (*UnimplementedQueryServer) ProposeParams(ctx context.Context, req *QueryProposeParamsRequest) (*QueryProposeParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposeParams not implemented")
+func (m *QueryPendingWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SafetyParams(ctx context.Context, req *QuerySafetyParamsRequest) (*QuerySafetyParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SafetyParams not implemented")
+func (m *QueryPendingWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AcknowledgedEventInfo(ctx context.Context, req *QueryAcknowledgedEventInfoRequest) (*QueryAcknowledgedEventInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgedEventInfo not implemented")
+func (m *QueryPendingWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWithdrawalsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RecognizedEventInfo(ctx context.Context, req *QueryRecognizedEventInfoRequest) (*QueryRecognizedEventInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecognizedEventInfo not implemented")
+func (m *QueryPendingWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelayedCompleteBridgeMessages(ctx context.Context, req *QueryDelayedCompleteBridgeMessagesRequest) (*QueryDelayedCompleteBridgeMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelayedCompleteBridgeMessages not implemented")
+func (m *QueryPendingWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWithdrawalsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryPendingWithdrawalsResponse proto.InternalMessageInfo
 
-func _Query_EventParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEventParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EventParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Query/EventParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EventParams(ctx, req.(*QueryEventParamsRequest))
+func (m *QueryPendingWithdrawalsResponse) GetWithdrawals() []BridgeWithdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ProposeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposeParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProposeParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Query/ProposeParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProposeParams(ctx, req.(*QueryProposeParamsRequest))
+func (m *QueryPendingWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SafetyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySafetyParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SafetyParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Query/SafetyParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SafetyParams(ctx, req.(*QuerySafetyParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFinalizedWithdrawalsRequest is a request type for the
+// FinalizedWithdrawals RPC method.
+type QueryFinalizedWithdrawalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_AcknowledgedEventInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAcknowledgedEventInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AcknowledgedEventInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Query/AcknowledgedEventInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AcknowledgedEventInfo(ctx, req.(*QueryAcknowledgedEventInfoRequest))
+func (m *QueryFinalizedWithdrawalsRequest) Reset()         { *m = QueryFinalizedWithdrawalsRequest{} }
+func (m *QueryFinalizedWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryFinalizedWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{23}
+}
+func (m *QueryFinalizedWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFinalizedWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryFinalizedWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedWithdrawalsRequest.DiscardUnknown(m)
 }
 
-func _Query_RecognizedEventInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRecognizedEventInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecognizedEventInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Query/RecognizedEventInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecognizedEventInfo(ctx, req.(*QueryRecognizedEventInfoRequest))
+var xxx_messageInfo_QueryFinalizedWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryFinalizedWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelayedCompleteBridgeMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelayedCompleteBridgeMessagesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelayedCompleteBridgeMessages(ctx, in)
+// QueryFinalizedWithdrawalsResponse is a response type for the
+// FinalizedWithdrawals RPC method.
+type QueryFinalizedWithdrawalsResponse struct {
+	Withdrawals []BridgeWithdrawal  `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFinalizedWithdrawalsResponse) Reset()         { *m = QueryFinalizedWithdrawalsResponse{} }
+func (m *QueryFinalizedWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryFinalizedWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{24}
+}
+func (m *QueryFinalizedWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Query/DelayedCompleteBridgeMessages",
+}
+func (m *QueryFinalizedWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryFinalizedWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedWithdrawalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizedWithdrawalsResponse) GetWithdrawals() []BridgeWithdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelayedCompleteBridgeMessages(ctx, req.(*QueryDelayedCompleteBridgeMessagesRequest))
+	return nil
+}
+
+func (m *QueryFinalizedWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.bridge.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EventParams",
-			Handler:    _Query_EventParams_Handler,
-		},
-		{
-			MethodName: "ProposeParams",
-			Handler:    _Query_ProposeParams_Handler,
-		},
-		{
-			MethodName: "SafetyParams",
-			Handler:    _Query_SafetyParams_Handler,
-		},
-		{
-			MethodName: "AcknowledgedEventInfo",
-			Handler:    _Query_AcknowledgedEventInfo_Handler,
-		},
-		{
-			MethodName: "RecognizedEventInfo",
-			Handler:    _Query_RecognizedEventInfo_Handler,
-		},
-		{
-			MethodName: "DelayedCompleteBridgeMessages",
-			Handler:    _Query_DelayedCompleteBridgeMessages_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/bridge/query.proto",
+// QueryBridgeSignerRequest is a request type for the BridgeSigner RPC method.
+type QueryBridgeSignerRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryEventParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeSignerRequest) Reset()         { *m = QueryBridgeSignerRequest{} }
+func (m *QueryBridgeSignerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeSignerRequest) ProtoMessage()    {}
+func (*QueryBridgeSignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{25}
+}
+func (m *QueryBridgeSignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeSignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeSignerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryEventParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBridgeSignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeSignerRequest.Merge(m, src)
 }
-
-func (m *QueryEventParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBridgeSignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeSignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeSignerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEventParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBridgeSignerRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeSignerRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEventParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBridgeSignerResponse is a response type for the BridgeSigner RPC
+// method.
+type QueryBridgeSignerResponse struct {
+	Signer BridgeSigner `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer"`
 }
 
-func (m *QueryEventParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBridgeSignerResponse) Reset()         { *m = QueryBridgeSignerResponse{} }
+func (m *QueryBridgeSignerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeSignerResponse) ProtoMessage()    {}
+func (*QueryBridgeSignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{26}
+}
+func (m *QueryBridgeSignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeSignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeSignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBridgeSignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeSignerResponse.Merge(m, src)
+}
+func (m *QueryBridgeSignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeSignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeSignerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProposeParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBridgeSignerResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeSignerResponse) GetSigner() BridgeSigner {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return BridgeSigner{}
 }
 
-func (m *QueryProposeParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryEventParamsRequest)(nil), "dydxprotocol.bridge.QueryEventParamsRequest")
+	proto.RegisterType((*QueryEventParamsResponse)(nil), "dydxprotocol.bridge.QueryEventParamsResponse")
+	proto.RegisterType((*QueryProposeParamsRequest)(nil), "dydxprotocol.bridge.QueryProposeParamsRequest")
+	proto.RegisterType((*QueryProposeParamsResponse)(nil), "dydxprotocol.bridge.QueryProposeParamsResponse")
+	proto.RegisterType((*QuerySafetyParamsRequest)(nil), "dydxprotocol.bridge.QuerySafetyParamsRequest")
+	proto.RegisterType((*QuerySafetyParamsResponse)(nil), "dydxprotocol.bridge.QuerySafetyParamsResponse")
+	proto.RegisterType((*QuerySourceChainParamsRequest)(nil), "dydxprotocol.bridge.QuerySourceChainParamsRequest")
+	proto.RegisterType((*QuerySourceChainParamsResponse)(nil), "dydxprotocol.bridge.QuerySourceChainParamsResponse")
+	proto.RegisterType((*QueryRateLimitParamsRequest)(nil), "dydxprotocol.bridge.QueryRateLimitParamsRequest")
+	proto.RegisterType((*QueryRateLimitParamsResponse)(nil), "dydxprotocol.bridge.QueryRateLimitParamsResponse")
+	proto.RegisterType((*QueryWindowUtilizationRequest)(nil), "dydxprotocol.bridge.QueryWindowUtilizationRequest")
+	proto.RegisterType((*QueryWindowUtilizationResponse)(nil), "dydxprotocol.bridge.QueryWindowUtilizationResponse")
+	proto.RegisterType((*QueryAcknowledgedEventInfoRequest)(nil), "dydxprotocol.bridge.QueryAcknowledgedEventInfoRequest")
+	proto.RegisterType((*QueryAcknowledgedEventInfoResponse)(nil), "dydxprotocol.bridge.QueryAcknowledgedEventInfoResponse")
+	proto.RegisterType((*QueryRecognizedEventInfoRequest)(nil), "dydxprotocol.bridge.QueryRecognizedEventInfoRequest")
+	proto.RegisterType((*QueryRecognizedEventInfoResponse)(nil), "dydxprotocol.bridge.QueryRecognizedEventInfoResponse")
+	proto.RegisterType((*QueryDelayedCompleteBridgeMessagesRequest)(nil), "dydxprotocol.bridge.QueryDelayedCompleteBridgeMessagesRequest")
+	proto.RegisterType((*QueryDelayedCompleteBridgeMessagesResponse)(nil), "dydxprotocol.bridge.QueryDelayedCompleteBridgeMessagesResponse")
+	proto.RegisterType((*DelayedCompleteBridgeMessage)(nil), "dydxprotocol.bridge.DelayedCompleteBridgeMessage")
+	proto.RegisterType((*QueryWithdrawalRequest)(nil), "dydxprotocol.bridge.QueryWithdrawalRequest")
+	proto.RegisterType((*QueryWithdrawalResponse)(nil), "dydxprotocol.bridge.QueryWithdrawalResponse")
+	proto.RegisterType((*QueryPendingWithdrawalsRequest)(nil), "dydxprotocol.bridge.QueryPendingWithdrawalsRequest")
+	proto.RegisterType((*QueryPendingWithdrawalsResponse)(nil), "dydxprotocol.bridge.QueryPendingWithdrawalsResponse")
+	proto.RegisterType((*QueryFinalizedWithdrawalsRequest)(nil), "dydxprotocol.bridge.QueryFinalizedWithdrawalsRequest")
+	proto.RegisterType((*QueryFinalizedWithdrawalsResponse)(nil), "dydxprotocol.bridge.QueryFinalizedWithdrawalsResponse")
+	proto.RegisterType((*QueryBridgeSignerRequest)(nil), "dydxprotocol.bridge.QueryBridgeSignerRequest")
+	proto.RegisterType((*QueryBridgeSignerResponse)(nil), "dydxprotocol.bridge.QueryBridgeSignerResponse")
 }
 
-func (m *QueryProposeParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("dydxprotocol/bridge/query.proto", fileDescriptor_b4ca11b6b8f7f939) }
+
+var fileDescriptor_b4ca11b6b8f7f939 = []byte{
+	// 1368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcd, 0x8f, 0xdb, 0xc4,
+	0x1b, 0xc7, 0x77, 0xb6, 0xfd, 0xb5, 0x3f, 0x9e, 0x64, 0x0b, 0x9d, 0x16, 0xba, 0x75, 0xb7, 0x49,
+	0xd6, 0xea, 0x4b, 0xba, 0x2f, 0x76, 0xb3, 0xdb, 0x37, 0x55, 0xa8, 0x2f, 0xe9, 0xbb, 0xa0, 0xd2,
+	0x92, 0x15, 0x42, 0x2a, 0x08, 0x6b, 0x12, 0x4f, 0x1c, 0x53, 0xc7, 0x93, 0xda, 0xce, 0x6e, 0xb7,
+	0x55, 0x0f, 0x70, 0xe3, 0x86, 0xc4, 0x05, 0x24, 0x0e, 0x88, 0x1b, 0x37, 0x2a, 0x71, 0xa9, 0xe0,
+	0x02, 0xa7, 0x1e, 0x2b, 0x71, 0x41, 0x1c, 0x2a, 0xb4, 0xe5, 0x0f, 0x41, 0x19, 0x8f, 0x13, 0xdb,
+	0xb1, 0x8d, 0x53, 0x15, 0x89, 0x53, 0x76, 0x67, 0x9e, 0xef, 0xf3, 0x7c, 0xe6, 0xfd, 0x6b, 0x28,
+	0xeb, 0x5b, 0xfa, 0xfd, 0x9e, 0xc3, 0x3c, 0xd6, 0x62, 0x96, 0xda, 0x74, 0x4c, 0xdd, 0xa0, 0xea,
+	0xbd, 0x3e, 0x75, 0xb6, 0x14, 0xde, 0x8a, 0xf7, 0x85, 0x03, 0x14, 0x3f, 0x40, 0xda, 0x6f, 0x30,
+	0x83, 0xf1, 0x46, 0x75, 0xf0, 0x97, 0x1f, 0x2a, 0xcd, 0x19, 0x8c, 0x19, 0x16, 0x55, 0x49, 0xcf,
+	0x54, 0x89, 0x6d, 0x33, 0x8f, 0x78, 0x26, 0xb3, 0x5d, 0xd1, 0xbb, 0xd0, 0x62, 0x6e, 0x97, 0xb9,
+	0x6a, 0x93, 0xb8, 0xa2, 0x82, 0xba, 0x51, 0x6b, 0x52, 0x8f, 0xd4, 0xd4, 0x1e, 0x31, 0x4c, 0x9b,
+	0x07, 0x8b, 0xd8, 0xc5, 0x24, 0x2a, 0xff, 0x47, 0xdb, 0x34, 0xbd, 0x8e, 0xee, 0x90, 0x4d, 0x62,
+	0xe5, 0x08, 0xa6, 0x1b, 0xd4, 0xf6, 0x34, 0xd3, 0x6e, 0x07, 0x8c, 0x95, 0xa4, 0xe0, 0x1e, 0x71,
+	0x48, 0x37, 0xe0, 0x9c, 0x4b, 0x8a, 0xf0, 0xee, 0xfb, 0xbd, 0xf2, 0x41, 0x38, 0xf0, 0xde, 0x80,
+	0xfd, 0xda, 0x20, 0xf1, 0x1a, 0xd7, 0x35, 0xe8, 0xbd, 0x3e, 0x75, 0x3d, 0xf9, 0x0e, 0xcc, 0x8e,
+	0x77, 0xb9, 0x3d, 0x66, 0xbb, 0x14, 0x5f, 0x80, 0x5d, 0x7e, 0x91, 0x59, 0x54, 0x41, 0xd5, 0xc2,
+	0x4a, 0x45, 0x49, 0x98, 0x56, 0x25, 0xa4, 0xac, 0xef, 0x7c, 0xfa, 0xbc, 0x3c, 0xd5, 0x10, 0x2a,
+	0xf9, 0x10, 0x1c, 0xe4, 0xb9, 0xd7, 0x1c, 0xd6, 0x63, 0x2e, 0x8d, 0x16, 0xfe, 0x18, 0xa4, 0xa4,
+	0x4e, 0x51, 0xfa, 0x52, 0xac, 0xb4, 0x9c, 0x58, 0x3a, 0xa2, 0x8d, 0x15, 0x97, 0xc4, 0xc0, 0xd6,
+	0x49, 0x9b, 0x7a, 0x5b, 0xd1, 0xda, 0x1f, 0xc1, 0xc1, 0x84, 0x3e, 0x51, 0xfa, 0x62, 0xac, 0xf4,
+	0x7c, 0x62, 0xe9, 0xb0, 0x34, 0x56, 0xb9, 0x0c, 0x87, 0xfd, 0xec, 0xac, 0xef, 0xb4, 0xe8, 0x95,
+	0x0e, 0x31, 0xed, 0x68, 0xf9, 0x36, 0x94, 0xd2, 0x02, 0x04, 0xc3, 0xd5, 0x10, 0xc3, 0x8e, 0x6a,
+	0x61, 0xe5, 0x58, 0x32, 0x43, 0x5c, 0x1f, 0x03, 0x39, 0x0c, 0x87, 0x78, 0x9d, 0x06, 0xf1, 0xe8,
+	0xbb, 0x66, 0xd7, 0x8c, 0x2d, 0x7d, 0x13, 0xe6, 0x92, 0xbb, 0x05, 0x44, 0x3d, 0x06, 0x71, 0x24,
+	0x11, 0x22, 0xa6, 0x8e, 0x21, 0x7c, 0x28, 0xe6, 0xe2, 0x03, 0xd3, 0xd6, 0xd9, 0xe6, 0xfb, 0x9e,
+	0x69, 0x99, 0x0f, 0xf8, 0x99, 0x11, 0x10, 0xb8, 0x02, 0x45, 0xea, 0x75, 0xb4, 0xd6, 0x60, 0x10,
+	0x9a, 0xa9, 0xf3, 0x39, 0xdf, 0xd9, 0x00, 0xea, 0x75, 0xf8, 0xb8, 0x6e, 0xe9, 0x78, 0x16, 0x76,
+	0x13, 0x5d, 0x77, 0xa8, 0xeb, 0xce, 0x4e, 0x57, 0x50, 0xf5, 0xb5, 0x46, 0xf0, 0xaf, 0xfc, 0xeb,
+	0x34, 0x94, 0xd2, 0xb2, 0x27, 0x8c, 0x01, 0xbd, 0xdc, 0x18, 0xf0, 0x5d, 0x28, 0x7a, 0xcc, 0x23,
+	0x96, 0x46, 0xba, 0xac, 0x6f, 0x7b, 0x9c, 0xa2, 0x58, 0xbf, 0x39, 0x88, 0xf9, 0xe3, 0x79, 0xf9,
+	0x92, 0x61, 0x7a, 0x9d, 0x7e, 0x53, 0x69, 0xb1, 0xae, 0x1a, 0x39, 0x84, 0x1b, 0xa7, 0x96, 0xf9,
+	0xa8, 0xd4, 0x61, 0x8b, 0xee, 0x6d, 0xf5, 0xa8, 0xab, 0xac, 0x53, 0xc7, 0x24, 0x03, 0xd2, 0xa6,
+	0x45, 0x6f, 0xd9, 0x5e, 0xa3, 0xc0, 0xb3, 0x5f, 0xe6, 0xc9, 0x31, 0x83, 0x3d, 0x62, 0x78, 0x41,
+	0xb9, 0x1d, 0xaf, 0xb8, 0xdc, 0x8c, 0xc8, 0xef, 0x17, 0x94, 0xaf, 0xc1, 0x3c, 0x9f, 0xc3, 0xcb,
+	0xad, 0xbb, 0x36, 0xdb, 0xb4, 0xa8, 0x6e, 0x50, 0x9d, 0x1f, 0xe9, 0x5b, 0x76, 0x9b, 0xe5, 0x5e,
+	0x25, 0x59, 0x07, 0x39, 0x2b, 0xcd, 0xf0, 0x46, 0xd9, 0x39, 0xb8, 0xd6, 0x32, 0x17, 0xa3, 0xce,
+	0x7f, 0x86, 0x5a, 0xb1, 0x18, 0x5c, 0x27, 0x5f, 0x81, 0xb2, 0xbf, 0x65, 0x69, 0x8b, 0x19, 0xb6,
+	0xf9, 0xe0, 0xa5, 0x50, 0x9b, 0x50, 0x49, 0x4f, 0xf2, 0x8a, 0x40, 0xaf, 0xc1, 0x09, 0x5e, 0xe3,
+	0x2a, 0xb5, 0xc8, 0x16, 0xd5, 0xaf, 0xb0, 0x6e, 0xcf, 0xa2, 0x1e, 0xf5, 0x25, 0xb7, 0xa9, 0xeb,
+	0x12, 0x83, 0x06, 0x07, 0x31, 0xbc, 0xc3, 0x51, 0x74, 0x87, 0x7f, 0x8a, 0x60, 0x21, 0x4f, 0x1e,
+	0x41, 0xbd, 0x0e, 0xff, 0xef, 0x8a, 0x36, 0x71, 0x66, 0x6b, 0x89, 0xe4, 0x59, 0xd9, 0xc4, 0x30,
+	0x86, 0x89, 0xe4, 0xcf, 0x11, 0xcc, 0x65, 0x09, 0xf0, 0x75, 0xd8, 0x2d, 0x82, 0xc5, 0x74, 0x25,
+	0xdf, 0x56, 0xb7, 0x5d, 0x23, 0xaa, 0x17, 0x95, 0x02, 0x31, 0x9e, 0x87, 0x62, 0xd3, 0x62, 0xad,
+	0xbb, 0x5a, 0x87, 0x9a, 0x46, 0xc7, 0x3f, 0x67, 0x33, 0x8d, 0x02, 0x6f, 0xbb, 0xc9, 0x9b, 0xe4,
+	0x2a, 0xbc, 0x25, 0x0e, 0x7c, 0xf0, 0x9c, 0x06, 0x73, 0xb8, 0x07, 0xa6, 0xc5, 0x62, 0xcf, 0x34,
+	0xa6, 0x4d, 0x5d, 0x6e, 0xc3, 0x81, 0xb1, 0x48, 0x31, 0x4b, 0xef, 0x00, 0x8c, 0x9e, 0x63, 0x81,
+	0x7c, 0x34, 0x63, 0x85, 0x47, 0x29, 0x04, 0x71, 0x48, 0x2e, 0x77, 0xc4, 0x15, 0xb4, 0x46, 0x6d,
+	0xdd, 0xb4, 0x8d, 0x51, 0xec, 0x70, 0x75, 0xaf, 0x03, 0x8c, 0xac, 0xc2, 0x70, 0x86, 0x7c, 0x5f,
+	0xa1, 0x0c, 0x7c, 0x85, 0xe2, 0x3b, 0x17, 0xe1, 0x2b, 0x94, 0x35, 0x62, 0x50, 0xa1, 0x6d, 0x84,
+	0x94, 0xf2, 0x13, 0x04, 0xe5, 0xd4, 0x52, 0x62, 0x68, 0xb7, 0xa1, 0x30, 0x62, 0x0b, 0xf6, 0xc0,
+	0x44, 0x63, 0x0b, 0xeb, 0xf1, 0x8d, 0x08, 0xfa, 0x34, 0x47, 0x3f, 0xfe, 0x8f, 0xe8, 0x3e, 0x4b,
+	0x84, 0xfd, 0x13, 0x71, 0xe4, 0xae, 0x9b, 0xf6, 0xe0, 0x2a, 0xa2, 0xfa, 0xbf, 0x38, 0x4f, 0x3f,
+	0x21, 0x98, 0xcf, 0x28, 0xf6, 0x1f, 0x9f, 0xa9, 0x1b, 0xc2, 0xb6, 0xf8, 0x45, 0xd7, 0x4d, 0xc3,
+	0xa6, 0x4e, 0x30, 0x43, 0x8b, 0xb0, 0x77, 0x83, 0x58, 0xa6, 0x4e, 0x3c, 0xe6, 0x68, 0xd1, 0x1b,
+	0xe3, 0x8d, 0x61, 0xc7, 0x65, 0x71, 0x75, 0x04, 0x1e, 0x27, 0x9a, 0x68, 0xe4, 0x71, 0x5c, 0xde,
+	0x92, 0xe9, 0x71, 0xc2, 0xd2, 0xe0, 0x4d, 0xf4, 0x65, 0x2b, 0xdf, 0x61, 0xf8, 0x1f, 0x4f, 0x8f,
+	0xbf, 0x42, 0x50, 0x08, 0x59, 0x40, 0xbc, 0x94, 0x98, 0x2a, 0xc5, 0x7e, 0x4a, 0xcb, 0x39, 0xa3,
+	0x7d, 0x6e, 0x79, 0xe9, 0xb3, 0xdf, 0xfe, 0xfa, 0x72, 0xfa, 0x18, 0x3e, 0x12, 0x7f, 0xfb, 0x02,
+	0xcb, 0xeb, 0x5b, 0x67, 0xf1, 0x70, 0x7f, 0x8b, 0x60, 0x26, 0x62, 0x11, 0xb1, 0x92, 0x5e, 0x2e,
+	0xc9, 0xa4, 0x4a, 0x6a, 0xee, 0x78, 0x01, 0xa8, 0x70, 0xc0, 0x2a, 0x3e, 0x96, 0x06, 0xd8, 0xf3,
+	0x65, 0x01, 0xe2, 0x37, 0x08, 0x8a, 0x61, 0x2b, 0x89, 0x33, 0x26, 0x24, 0xc1, 0xc9, 0x4a, 0x4a,
+	0xde, 0x70, 0xc1, 0xb7, 0xcc, 0xf9, 0x8e, 0xe3, 0xa3, 0x69, 0x7c, 0x2e, 0x57, 0x05, 0x78, 0x8f,
+	0x11, 0xec, 0x1d, 0x73, 0x99, 0x78, 0x25, 0xa3, 0x68, 0x8a, 0xe7, 0x95, 0x56, 0x27, 0xd2, 0x08,
+	0xda, 0x55, 0x4e, 0xbb, 0x8c, 0x17, 0x53, 0x69, 0xb9, 0x54, 0x3c, 0xf6, 0x82, 0xf9, 0x7b, 0x04,
+	0xaf, 0xc7, 0x0c, 0x1d, 0x3e, 0x99, 0x5e, 0x3d, 0xd9, 0x1c, 0x4b, 0xb5, 0x09, 0x14, 0x82, 0xb6,
+	0xc6, 0x69, 0x17, 0xf1, 0x89, 0x34, 0x5a, 0x87, 0x78, 0x54, 0xb3, 0x06, 0xca, 0x80, 0xf5, 0x07,
+	0x04, 0x7b, 0xc7, 0xcc, 0x6b, 0xd6, 0xfc, 0xa6, 0xf9, 0x68, 0x69, 0x75, 0x22, 0x8d, 0x20, 0x5e,
+	0xe1, 0xc4, 0x4b, 0x78, 0x21, 0x8d, 0x78, 0x93, 0x4b, 0xb5, 0x7e, 0x08, 0xee, 0x17, 0x04, 0x6f,
+	0x26, 0x9a, 0x3c, 0x7c, 0x26, 0x1d, 0x21, 0xcb, 0x5c, 0x4a, 0x67, 0x27, 0xd6, 0x09, 0xfc, 0xb3,
+	0x1c, 0xbf, 0x86, 0xd5, 0x34, 0x7c, 0x12, 0x92, 0x87, 0xbe, 0xaa, 0xf1, 0x13, 0x04, 0xfb, 0x12,
+	0xdc, 0x1f, 0x3e, 0x95, 0xb1, 0xe8, 0xa9, 0x8e, 0x53, 0x3a, 0x3d, 0xa1, 0x4a, 0xd0, 0x9f, 0xe6,
+	0xf4, 0x2a, 0x5e, 0x4e, 0xdd, 0x2e, 0x43, 0x71, 0x98, 0x7d, 0x1b, 0xc1, 0xe1, 0x4c, 0x37, 0x88,
+	0x2f, 0xa4, 0xf3, 0xe4, 0xb1, 0xa3, 0xd2, 0xc5, 0x97, 0xd6, 0x8b, 0x91, 0x5d, 0xe2, 0x23, 0x3b,
+	0x8f, 0xcf, 0xa5, 0x8d, 0x4c, 0xf7, 0xd3, 0x68, 0x2d, 0x91, 0x47, 0xf3, 0xdb, 0xb5, 0xc0, 0x73,
+	0xe2, 0xaf, 0x11, 0xc0, 0xe8, 0xc1, 0xc5, 0x8b, 0x59, 0x9b, 0x3b, 0xe6, 0x04, 0xa5, 0xa5, 0x7c,
+	0xc1, 0x82, 0xf5, 0x24, 0x67, 0x5d, 0xc0, 0xd5, 0xf4, 0x23, 0x10, 0x68, 0x5c, 0xf5, 0xa1, 0xa9,
+	0x3f, 0xc2, 0x3f, 0x22, 0xc0, 0xe3, 0x16, 0x0c, 0x67, 0x1c, 0xc0, 0x54, 0x6f, 0x28, 0x9d, 0x9a,
+	0x4c, 0x94, 0xf7, 0x5a, 0xec, 0xf9, 0x5a, 0x2d, 0xec, 0x50, 0x7e, 0x46, 0xb0, 0x3f, 0xc9, 0x11,
+	0xe1, 0x8c, 0xed, 0x9b, 0x61, 0xd7, 0xa4, 0x33, 0x93, 0xca, 0xf2, 0x6e, 0xfb, 0x76, 0xa0, 0x8e,
+	0xe0, 0x3f, 0x46, 0x50, 0x0c, 0xfb, 0x91, 0xac, 0x87, 0x32, 0xc1, 0x3b, 0x49, 0x4a, 0xde, 0x70,
+	0x81, 0x59, 0xe7, 0x98, 0x6f, 0xe3, 0xf3, 0x69, 0x98, 0xfe, 0x8f, 0xe6, 0xfb, 0x21, 0x57, 0x7d,
+	0x38, 0xe6, 0xcc, 0x1e, 0xd5, 0x1b, 0x4f, 0xb7, 0x4b, 0xe8, 0xd9, 0x76, 0x09, 0xfd, 0xb9, 0x5d,
+	0x42, 0x5f, 0xbc, 0x28, 0x4d, 0x3d, 0x7b, 0x51, 0x9a, 0xfa, 0xfd, 0x45, 0x69, 0xea, 0xce, 0xb9,
+	0xfc, 0x5f, 0xf1, 0xf7, 0x83, 0x82, 0xfc, 0x6b, 0xbe, 0xb9, 0x8b, 0x77, 0xac, 0xfe, 0x3d, 0x00,
+	0xe4, 0x8f, 0x9b, 0x58, 0x03, 0x15, 0x00, 0x00,
 }
 
-func (m *QueryProposeParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries the EventParams.
+	EventParams(ctx context.Context, in *QueryEventParamsRequest, opts ...grpc.CallOption) (*QueryEventParamsResponse, error)
+	// Queries the ProposeParams.
+	ProposeParams(ctx context.Context, in *QueryProposeParamsRequest, opts ...grpc.CallOption) (*QueryProposeParamsResponse, error)
+	// Queries the SafetyParams.
+	SafetyParams(ctx context.Context, in *QuerySafetyParamsRequest, opts ...grpc.CallOption) (*QuerySafetyParamsResponse, error)
+	// Queries the parameters of all additional Ethereum chains that bridge
+	// events are recognized from.
+	SourceChainParams(ctx context.Context, in *QuerySourceChainParamsRequest, opts ...grpc.CallOption) (*QuerySourceChainParamsResponse, error)
+	// Queries the value-based safety parameters of all source chains.
+	RateLimitParams(ctx context.Context, in *QueryRateLimitParamsRequest, opts ...grpc.CallOption) (*QueryRateLimitParamsResponse, error)
+	// Queries the amounts bridged from a source chain within the current rolling
+	// window of its RateLimitParams.
+	WindowUtilization(ctx context.Context, in *QueryWindowUtilizationRequest, opts ...grpc.CallOption) (*QueryWindowUtilizationResponse, error)
+	// Queries the AcknowledgedEventInfo.
+	// An "acknowledged" event is one that is in-consensus and has been stored
+	// in-state.
+	AcknowledgedEventInfo(ctx context.Context, in *QueryAcknowledgedEventInfoRequest, opts ...grpc.CallOption) (*QueryAcknowledgedEventInfoResponse, error)
+	// Queries the RecognizedEventInfo.
+	// A "recognized" event is one that is finalized on the Ethereum blockchain
+	// and has been identified by the queried node. It is not yet in-consensus.
+	RecognizedEventInfo(ctx context.Context, in *QueryRecognizedEventInfoRequest, opts ...grpc.CallOption) (*QueryRecognizedEventInfoResponse, error)
+	// Queries all `MsgCompleteBridge` messages that are delayed (not yet
+	// executed) and corresponding block heights at which they will execute.
+	DelayedCompleteBridgeMessages(ctx context.Context, in *QueryDelayedCompleteBridgeMessagesRequest, opts ...grpc.CallOption) (*QueryDelayedCompleteBridgeMessagesResponse, error)
+	// Queries a withdrawal by id.
+	Withdrawal(ctx context.Context, in *QueryWithdrawalRequest, opts ...grpc.CallOption) (*QueryWithdrawalResponse, error)
+	// Queries all withdrawals that are not yet attested by validators with more
+	// than two thirds of the voting power.
+	PendingWithdrawals(ctx context.Context, in *QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*QueryPendingWithdrawalsResponse, error)
+	// Queries all withdrawals that are attested by validators with more than
+	// two thirds of the voting power and can be executed on Ethereum.
+	FinalizedWithdrawals(ctx context.Context, in *QueryFinalizedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryFinalizedWithdrawalsResponse, error)
+	// Queries the bridge signer of a validator.
+	BridgeSigner(ctx context.Context, in *QueryBridgeSignerRequest, opts ...grpc.CallOption) (*QueryBridgeSignerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) EventParams(ctx context.Context, in *QueryEventParamsRequest, opts ...grpc.CallOption) (*QueryEventParamsResponse, error) {
+	out := new(QueryEventParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/EventParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProposeParams(ctx context.Context, in *QueryProposeParamsRequest, opts ...grpc.CallOption) (*QueryProposeParamsResponse, error) {
+	out := new(QueryProposeParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/ProposeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SafetyParams(ctx context.Context, in *QuerySafetyParamsRequest, opts ...grpc.CallOption) (*QuerySafetyParamsResponse, error) {
+	out := new(QuerySafetyParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/SafetyParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySafetyParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SourceChainParams(ctx context.Context, in *QuerySourceChainParamsRequest, opts ...grpc.CallOption) (*QuerySourceChainParamsResponse, error) {
+	out := new(QuerySourceChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/SourceChainParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySafetyParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RateLimitParams(ctx context.Context, in *QueryRateLimitParamsRequest, opts ...grpc.CallOption) (*QueryRateLimitParamsResponse, error) {
+	out := new(QueryRateLimitParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/RateLimitParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySafetyParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) WindowUtilization(ctx context.Context, in *QueryWindowUtilizationRequest, opts ...grpc.CallOption) (*QueryWindowUtilizationResponse, error) {
+	out := new(QueryWindowUtilizationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/WindowUtilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySafetyParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AcknowledgedEventInfo(ctx context.Context, in *QueryAcknowledgedEventInfoRequest, opts ...grpc.CallOption) (*QueryAcknowledgedEventInfoResponse, error) {
+	out := new(QueryAcknowledgedEventInfoResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/AcknowledgedEventInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySafetyParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecognizedEventInfo(ctx context.Context, in *QueryRecognizedEventInfoRequest, opts ...grpc.CallOption) (*QueryRecognizedEventInfoResponse, error) {
+	out := new(QueryRecognizedEventInfoResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/RecognizedEventInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySafetyParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) DelayedCompleteBridgeMessages(ctx context.Context, in *QueryDelayedCompleteBridgeMessagesRequest, opts ...grpc.CallOption) (*QueryDelayedCompleteBridgeMessagesResponse, error) {
+	out := new(QueryDelayedCompleteBridgeMessagesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/DelayedCompleteBridgeMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAcknowledgedEventInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Withdrawal(ctx context.Context, in *QueryWithdrawalRequest, opts ...grpc.CallOption) (*QueryWithdrawalResponse, error) {
+	out := new(QueryWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/Withdrawal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAcknowledgedEventInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingWithdrawals(ctx context.Context, in *QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*QueryPendingWithdrawalsResponse, error) {
+	out := new(QueryPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/PendingWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAcknowledgedEventInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) FinalizedWithdrawals(ctx context.Context, in *QueryFinalizedWithdrawalsRequest, opts ...grpc.CallOption) (*QueryFinalizedWithdrawalsResponse, error) {
+	out := new(QueryFinalizedWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/FinalizedWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAcknowledgedEventInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BridgeSigner(ctx context.Context, in *QueryBridgeSignerRequest, opts ...grpc.CallOption) (*QueryBridgeSignerResponse, error) {
+	out := new(QueryBridgeSignerResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/BridgeSigner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAcknowledgedEventInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries the EventParams.
+	EventParams(context.Context, *QueryEventParamsRequest) (*QueryEventParamsResponse, error)
+	// Queries the ProposeParams.
+	ProposeParams(context.Context, *QueryProposeParamsRequest) (*QueryProposeParamsResponse, error)
+	// Queries the SafetyParams.
+	SafetyParams(context.Context, *QuerySafetyParamsRequest) (*QuerySafetyParamsResponse, error)
+	// Queries the parameters of all additional Ethereum chains that bridge
+	// events are recognized from.
+	SourceChainParams(context.Context, *QuerySourceChainParamsRequest) (*QuerySourceChainParamsResponse, error)
+	// Queries the value-based safety parameters of all source chains.
+	RateLimitParams(context.Context, *QueryRateLimitParamsRequest) (*QueryRateLimitParamsResponse, error)
+	// Queries the amounts bridged from a source chain within the current rolling
+	// window of its RateLimitParams.
+	WindowUtilization(context.Context, *QueryWindowUtilizationRequest) (*QueryWindowUtilizationResponse, error)
+	// Queries the AcknowledgedEventInfo.
+	// An "acknowledged" event is one that is in-consensus and has been stored
+	// in-state.
+	AcknowledgedEventInfo(context.Context, *QueryAcknowledgedEventInfoRequest) (*QueryAcknowledgedEventInfoResponse, error)
+	// Queries the RecognizedEventInfo.
+	// A "recognized" event is one that is finalized on the Ethereum blockchain
+	// and has been identified by the queried node. It is not yet in-consensus.
+	RecognizedEventInfo(context.Context, *QueryRecognizedEventInfoRequest) (*QueryRecognizedEventInfoResponse, error)
+	// Queries all `MsgCompleteBridge` messages that are delayed (not yet
+	// executed) and corresponding block heights at which they will execute.
+	DelayedCompleteBridgeMessages(context.Context, *QueryDelayedCompleteBridgeMessagesRequest) (*QueryDelayedCompleteBridgeMessagesResponse, error)
+	// Queries a withdrawal by id.
+	Withdrawal(context.Context, *QueryWithdrawalRequest) (*QueryWithdrawalResponse, error)
+	// Queries all withdrawals that are not yet attested by validators with more
+	// than two thirds of the voting power.
+	PendingWithdrawals(context.Context, *QueryPendingWithdrawalsRequest) (*QueryPendingWithdrawalsResponse, error)
+	// Queries all withdrawals that are attested by validators with more than
+	// two thirds of the voting power and can be executed on Ethereum.
+	FinalizedWithdrawals(context.Context, *QueryFinalizedWithdrawalsRequest) (*QueryFinalizedWithdrawalsResponse, error)
+	// Queries the bridge signer of a validator.
+	BridgeSigner(context.Context, *QueryBridgeSignerRequest) (*QueryBridgeSignerResponse, error)
 }
 
-func (m *QueryAcknowledgedEventInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryRecognizedEventInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) EventParams(ctx context.Context, req *QueryEventParamsRequest) (*QueryEventParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventParams not implemented")
 }
-
-func (m *QueryRecognizedEventInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ProposeParams(ctx context.Context, req *QueryProposeParamsRequest) (*QueryProposeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeParams not implemented")
+}
+func (*UnimplementedQueryServer) SafetyParams(ctx context.Context, req *QuerySafetyParamsRequest) (*QuerySafetyParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SafetyParams not implemented")
+}
+func (*UnimplementedQueryServer) SourceChainParams(ctx context.Context, req *QuerySourceChainParamsRequest) (*QuerySourceChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChainParams not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitParams(ctx context.Context, req *QueryRateLimitParamsRequest) (*QueryRateLimitParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitParams not implemented")
+}
+func (*UnimplementedQueryServer) WindowUtilization(ctx context.Context, req *QueryWindowUtilizationRequest) (*QueryWindowUtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WindowUtilization not implemented")
+}
+func (*UnimplementedQueryServer) AcknowledgedEventInfo(ctx context.Context, req *QueryAcknowledgedEventInfoRequest) (*QueryAcknowledgedEventInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgedEventInfo not implemented")
+}
+func (*UnimplementedQueryServer) RecognizedEventInfo(ctx context.Context, req *QueryRecognizedEventInfoRequest) (*QueryRecognizedEventInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecognizedEventInfo not implemented")
+}
+func (*UnimplementedQueryServer) DelayedCompleteBridgeMessages(ctx context.Context, req *QueryDelayedCompleteBridgeMessagesRequest) (*QueryDelayedCompleteBridgeMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedCompleteBridgeMessages not implemented")
+}
+func (*UnimplementedQueryServer) Withdrawal(ctx context.Context, req *QueryWithdrawalRequest) (*QueryWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+}
+func (*UnimplementedQueryServer) PendingWithdrawals(ctx context.Context, req *QueryPendingWithdrawalsRequest) (*QueryPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) FinalizedWithdrawals(ctx context.Context, req *QueryFinalizedWithdrawalsRequest) (*QueryFinalizedWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) BridgeSigner(ctx context.Context, req *QueryBridgeSignerRequest) (*QueryBridgeSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeSigner not implemented")
 }
 
-func (m *QueryRecognizedEventInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryRecognizedEventInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EventParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEventParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRecognizedEventInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).EventParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/EventParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventParams(ctx, req.(*QueryEventParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRecognizedEventInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_ProposeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposeParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ProposeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/ProposeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposeParams(ctx, req.(*QueryProposeParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelayedCompleteBridgeMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SafetyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySafetyParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SafetyParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/SafetyParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SafetyParams(ctx, req.(*QuerySafetyParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelayedCompleteBridgeMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SourceChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySourceChainParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/SourceChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChainParams(ctx, req.(*QuerySourceChainParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelayedCompleteBridgeMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func _Query_RateLimitParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/RateLimitParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitParams(ctx, req.(*QueryRateLimitParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelayedCompleteBridgeMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_WindowUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWindowUtilizationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).WindowUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/WindowUtilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WindowUtilization(ctx, req.(*QueryWindowUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelayedCompleteBridgeMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func _Query_AcknowledgedEventInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcknowledgedEventInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcknowledgedEventInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/AcknowledgedEventInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcknowledgedEventInfo(ctx, req.(*QueryAcknowledgedEventInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecognizedEventInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecognizedEventInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecognizedEventInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/RecognizedEventInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecognizedEventInfo(ctx, req.(*QueryRecognizedEventInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayedCompleteBridgeMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedCompleteBridgeMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedCompleteBridgeMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/DelayedCompleteBridgeMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedCompleteBridgeMessages(ctx, req.(*QueryDelayedCompleteBridgeMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Withdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/Withdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Withdrawal(ctx, req.(*QueryWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/PendingWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingWithdrawals(ctx, req.(*QueryPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalizedWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizedWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizedWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/FinalizedWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizedWithdrawals(ctx, req.(*QueryFinalizedWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeSignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/BridgeSigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeSigner(ctx, req.(*QueryBridgeSignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.bridge.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EventParams",
+			Handler:    _Query_EventParams_Handler,
+		},
+		{
+			MethodName: "ProposeParams",
+			Handler:    _Query_ProposeParams_Handler,
+		},
+		{
+			MethodName: "SafetyParams",
+			Handler:    _Query_SafetyParams_Handler,
+		},
+		{
+			MethodName: "SourceChainParams",
+			Handler:    _Query_SourceChainParams_Handler,
+		},
+		{
+			MethodName: "RateLimitParams",
+			Handler:    _Query_RateLimitParams_Handler,
+		},
+		{
+			MethodName: "WindowUtilization",
+			Handler:    _Query_WindowUtilization_Handler,
+		},
+		{
+			MethodName: "AcknowledgedEventInfo",
+			Handler:    _Query_AcknowledgedEventInfo_Handler,
+		},
+		{
+			MethodName: "RecognizedEventInfo",
+			Handler:    _Query_RecognizedEventInfo_Handler,
+		},
+		{
+			MethodName: "DelayedCompleteBridgeMessages",
+			Handler:    _Query_DelayedCompleteBridgeMessages_Handler,
+		},
+		{
+			MethodName: "Withdrawal",
+			Handler:    _Query_Withdrawal_Handler,
+		},
+		{
+			MethodName: "PendingWithdrawals",
+			Handler:    _Query_PendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "FinalizedWithdrawals",
+			Handler:    _Query_FinalizedWithdrawals_Handler,
+		},
+		{
+			MethodName: "BridgeSigner",
+			Handler:    _Query_BridgeSigner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/bridge/query.proto",
+}
+
+func (m *QueryEventParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEventParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelayedCompleteBridgeMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEventParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelayedCompleteBridgeMessage) Marshal() (dAtA []byte, err error) {
+func (m *QueryEventParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1307,23 +1990,18 @@ func (m *DelayedCompleteBridgeMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelayedCompleteBridgeMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEventParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelayedCompleteBridgeMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEventParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
 	{
-		size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1335,166 +2013,2270 @@ func (m *DelayedCompleteBridgeMessage) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryEventParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposeParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEventParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProposeParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposeParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposeParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposeParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySafetyParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySafetyParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySafetyParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySafetyParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySafetyParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySafetyParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySafetyParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySafetyParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAcknowledgedEventInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySourceChainParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAcknowledgedEventInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySourceChainParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Info.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecognizedEventInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateLimitParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecognizedEventInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateLimitParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Info.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelayedCompleteBridgeMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWindowUtilizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWindowUtilizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWindowUtilizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.EthChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EthChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelayedCompleteBridgeMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWindowUtilizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWindowUtilizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWindowUtilizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.AddressAmount.Size()
+		i -= size
+		if _, err := m.AddressAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcknowledgedEventInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcknowledgedEventInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcknowledgedEventInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EthChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcknowledgedEventInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcknowledgedEventInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcknowledgedEventInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecognizedEventInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecognizedEventInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecognizedEventInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EthChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecognizedEventInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecognizedEventInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecognizedEventInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedCompleteBridgeMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedCompleteBridgeMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedCompleteBridgeMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedCompleteBridgeMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedCompleteBridgeMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedCompleteBridgeMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedCompleteBridgeMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedCompleteBridgeMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedCompleteBridgeMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeSignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeSignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeSignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Signer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEventParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEventParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposeParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProposeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySafetyParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySafetyParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySourceChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySourceChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRateLimitParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimitParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWindowUtilizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthChainId != 0 {
+		n += 1 + sovQuery(uint64(m.EthChainId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWindowUtilizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AddressAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAcknowledgedEventInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthChainId != 0 {
+		n += 1 + sovQuery(uint64(m.EthChainId))
+	}
+	return n
+}
+
+func (m *QueryAcknowledgedEventInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecognizedEventInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthChainId != 0 {
+		n += 1 + sovQuery(uint64(m.EthChainId))
+	}
+	return n
+}
+
+func (m *QueryRecognizedEventInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelayedCompleteBridgeMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedCompleteBridgeMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelayedCompleteBridgeMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Message.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizedWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizedWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeSignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Signer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEventParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEventParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEventParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEventParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEventParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEventParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposeParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposeParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySafetyParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySafetyParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySafetyParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySafetyParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySafetyParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySafetyParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceChainParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceChainParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, SourceChainParams{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, RateLimitParams{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWindowUtilizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWindowUtilizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWindowUtilizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			}
+			m.EthChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWindowUtilizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWindowUtilizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWindowUtilizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddressAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAcknowledgedEventInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAcknowledgedEventInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAcknowledgedEventInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			}
+			m.EthChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *DelayedCompleteBridgeMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Message.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryAcknowledgedEventInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAcknowledgedEventInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAcknowledgedEventInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEventParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecognizedEventInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1517,12 +4299,31 @@ func (m *QueryEventParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEventParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecognizedEventInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEventParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecognizedEventInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			}
+			m.EthChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1544,7 +4345,7 @@ func (m *QueryEventParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEventParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecognizedEventInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1567,15 +4368,15 @@ func (m *QueryEventParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEventParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecognizedEventInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEventParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecognizedEventInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1602,7 +4403,7 @@ func (m *QueryEventParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1627,7 +4428,7 @@ func (m *QueryEventParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposeParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayedCompleteBridgeMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1650,12 +4451,128 @@ func (m *QueryProposeParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposeParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayedCompleteBridgeMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayedCompleteBridgeMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedCompleteBridgeMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedCompleteBridgeMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedCompleteBridgeMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, DelayedCompleteBridgeMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1677,7 +4594,7 @@ func (m *QueryProposeParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposeParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *DelayedCompleteBridgeMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1700,15 +4617,15 @@ func (m *QueryProposeParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposeParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelayedCompleteBridgeMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelayedCompleteBridgeMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1735,10 +4652,29 @@ func (m *QueryProposeParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1760,7 +4696,7 @@ func (m *QueryProposeParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySafetyParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,12 +4719,31 @@ func (m *QuerySafetyParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySafetyParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySafetyParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1810,7 +4765,7 @@ func (m *QuerySafetyParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySafetyParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1833,15 +4788,15 @@ func (m *QuerySafetyParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySafetyParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySafetyParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1868,7 +4823,7 @@ func (m *QuerySafetyParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1893,7 +4848,7 @@ func (m *QuerySafetyParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAcknowledgedEventInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingWithdrawalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1916,12 +4871,48 @@ func (m *QueryAcknowledgedEventInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAcknowledgedEventInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingWithdrawalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAcknowledgedEventInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1943,7 +4934,7 @@ func (m *QueryAcknowledgedEventInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAcknowledgedEventInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingWithdrawalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1966,15 +4957,15 @@ func (m *QueryAcknowledgedEventInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAcknowledgedEventInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingWithdrawalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAcknowledgedEventInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2001,60 +4992,47 @@ func (m *QueryAcknowledgedEventInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Withdrawals = append(m.Withdrawals, BridgeWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRecognizedEventInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecognizedEventInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecognizedEventInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2076,7 +5054,7 @@ func (m *QueryRecognizedEventInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecognizedEventInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedWithdrawalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,15 +5077,15 @@ func (m *QueryRecognizedEventInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecognizedEventInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedWithdrawalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecognizedEventInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2134,7 +5112,10 @@ func (m *QueryRecognizedEventInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2159,7 +5140,7 @@ func (m *QueryRecognizedEventInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelayedCompleteBridgeMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedWithdrawalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2182,17 +5163,17 @@ func (m *QueryDelayedCompleteBridgeMessagesRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelayedCompleteBridgeMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedWithdrawalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelayedCompleteBridgeMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2202,23 +5183,61 @@ func (m *QueryDelayedCompleteBridgeMessagesRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Withdrawals = append(m.Withdrawals, BridgeWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2241,7 +5260,7 @@ func (m *QueryDelayedCompleteBridgeMessagesRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDelayedCompleteBridgeMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeSignerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2264,17 +5283,17 @@ func (m *QueryDelayedCompleteBridgeMessagesResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelayedCompleteBridgeMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeSignerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelayedCompleteBridgeMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeSignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2284,25 +5303,23 @@ func (m *QueryDelayedCompleteBridgeMessagesResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, DelayedCompleteBridgeMessage{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2325,7 +5342,7 @@ func (m *QueryDelayedCompleteBridgeMessagesResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *DelayedCompleteBridgeMessage) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeSignerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2348,15 +5365,15 @@ func (m *DelayedCompleteBridgeMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelayedCompleteBridgeMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeSignerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelayedCompleteBridgeMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2383,29 +5400,10 @@ func (m *DelayedCompleteBridgeMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
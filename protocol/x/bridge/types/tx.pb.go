@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -478,746 +479,2740 @@ func (m *MsgUpdateSafetyParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateSafetyParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAcknowledgeBridges)(nil), "dydxprotocol.bridge.MsgAcknowledgeBridges")
-	proto.RegisterType((*MsgAcknowledgeBridgesResponse)(nil), "dydxprotocol.bridge.MsgAcknowledgeBridgesResponse")
-	proto.RegisterType((*MsgCompleteBridge)(nil), "dydxprotocol.bridge.MsgCompleteBridge")
-	proto.RegisterType((*MsgCompleteBridgeResponse)(nil), "dydxprotocol.bridge.MsgCompleteBridgeResponse")
-	proto.RegisterType((*MsgUpdateEventParams)(nil), "dydxprotocol.bridge.MsgUpdateEventParams")
-	proto.RegisterType((*MsgUpdateEventParamsResponse)(nil), "dydxprotocol.bridge.MsgUpdateEventParamsResponse")
-	proto.RegisterType((*MsgUpdateProposeParams)(nil), "dydxprotocol.bridge.MsgUpdateProposeParams")
-	proto.RegisterType((*MsgUpdateProposeParamsResponse)(nil), "dydxprotocol.bridge.MsgUpdateProposeParamsResponse")
-	proto.RegisterType((*MsgUpdateSafetyParams)(nil), "dydxprotocol.bridge.MsgUpdateSafetyParams")
-	proto.RegisterType((*MsgUpdateSafetyParamsResponse)(nil), "dydxprotocol.bridge.MsgUpdateSafetyParamsResponse")
+// MsgUpdateSourceChainParams is the Msg/UpdateSourceChainParams request type.
+type MsgUpdateSourceChainParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The parameters to update. Each field must be set.
+	Params SourceChainParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/bridge/tx.proto", fileDescriptor_1851bd29b57dcf2f) }
-
-var fileDescriptor_1851bd29b57dcf2f = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0xaf, 0xd9, 0xa8, 0xb4, 0x0f, 0x69, 0xd2, 0xb2, 0x02, 0x5d, 0x18, 0x59, 0xe8, 0x61, 0x1a,
-	0x45, 0x4b, 0xb4, 0x0e, 0x21, 0x84, 0xd0, 0x60, 0x45, 0x1c, 0x2b, 0x4d, 0x9d, 0x10, 0x12, 0x97,
-	0x29, 0x4d, 0x8c, 0x1b, 0xd1, 0xc4, 0x21, 0xf6, 0xba, 0xf6, 0xca, 0x13, 0x70, 0xe5, 0x82, 0x10,
-	0x4f, 0xc0, 0x81, 0x77, 0x60, 0xc7, 0x89, 0x13, 0x27, 0x84, 0xda, 0x03, 0xaf, 0x81, 0x1a, 0xa7,
-	0x5e, 0xdb, 0xb8, 0x5d, 0xc7, 0x4e, 0x49, 0xfc, 0xfd, 0xfc, 0xfb, 0xe3, 0x7c, 0x9f, 0x0c, 0xeb,
-	0x5e, 0xd7, 0xeb, 0x44, 0x31, 0xe5, 0xd4, 0xa5, 0x2d, 0xbb, 0x11, 0xfb, 0x1e, 0xc1, 0x36, 0xef,
-	0x58, 0xc9, 0x92, 0xb6, 0x3a, 0x5a, 0xb5, 0x44, 0x55, 0x5f, 0x73, 0x29, 0x0b, 0x28, 0x3b, 0x4a,
-	0xd6, 0x6d, 0xf1, 0x21, 0xf0, 0xfa, 0x6d, 0xf1, 0x65, 0x07, 0x8c, 0xd8, 0xed, 0x9d, 0xc1, 0x23,
-	0x2d, 0x6c, 0xaa, 0x64, 0xc4, 0xe3, 0x08, 0xb7, 0x71, 0xc8, 0x53, 0x9c, 0xa9, 0xc2, 0x45, 0x4e,
-	0xec, 0x04, 0x43, 0x89, 0x02, 0xa1, 0x84, 0x0a, 0xe9, 0xc1, 0x9b, 0x58, 0x2d, 0xbd, 0x86, 0x9b,
-	0x35, 0x46, 0xf6, 0xdd, 0x77, 0x21, 0x3d, 0x69, 0x61, 0x8f, 0xe0, 0x6a, 0xb2, 0x95, 0x69, 0x7b,
-	0x90, 0x4f, 0xf8, 0x59, 0x11, 0x99, 0x0b, 0x5b, 0x37, 0x2a, 0xa6, 0xa5, 0x88, 0x64, 0x09, 0xf4,
-	0xcb, 0x01, 0xb0, 0xba, 0x78, 0xfa, 0x7b, 0x23, 0x57, 0x4f, 0x77, 0x95, 0x36, 0xe0, 0xae, 0x92,
-	0xb8, 0x8e, 0x59, 0x44, 0x43, 0x86, 0x4b, 0x9f, 0x10, 0xac, 0xd4, 0x18, 0x79, 0x41, 0x83, 0xa8,
-	0x85, 0x79, 0x5a, 0xd6, 0x1e, 0xc1, 0x92, 0x73, 0xcc, 0x9b, 0x34, 0xf6, 0x79, 0xb7, 0x88, 0x4c,
-	0xb4, 0xb5, 0x54, 0x2d, 0xfe, 0xfc, 0xbe, 0x5d, 0x48, 0x4f, 0x6b, 0xdf, 0xf3, 0x62, 0xcc, 0xd8,
-	0x21, 0x8f, 0xfd, 0x90, 0xd4, 0xcf, 0xa1, 0xda, 0x53, 0xb8, 0x9e, 0x08, 0x17, 0xaf, 0x99, 0xe8,
-	0x12, 0x6e, 0xc5, 0xa6, 0x27, 0xcb, 0x1f, 0xfe, 0x7e, 0x2b, 0x9f, 0xb3, 0x95, 0xee, 0xc0, 0x5a,
-	0xc6, 0x9a, 0x34, 0xfe, 0x19, 0x41, 0xa1, 0xc6, 0xc8, 0xab, 0xc8, 0x73, 0xb8, 0x20, 0x3b, 0x48,
-	0xce, 0xf9, 0xbf, 0xbd, 0xef, 0x41, 0x5e, 0xfc, 0xa9, 0x99, 0xe6, 0x47, 0x94, 0x86, 0x47, 0x2d,
-	0x76, 0x65, 0xdc, 0x1b, 0xb0, 0xae, 0xf2, 0x27, 0x03, 0x7c, 0x45, 0x70, 0x4b, 0x02, 0x0e, 0x62,
-	0x1a, 0x51, 0x86, 0xaf, 0x18, 0xe1, 0xf9, 0x44, 0x84, 0x92, 0x32, 0xc2, 0x98, 0xd6, 0x05, 0x21,
-	0x4c, 0x30, 0xd4, 0x1e, 0x65, 0x8c, 0x2f, 0x28, 0xe9, 0x5d, 0x01, 0x39, 0x74, 0xde, 0x62, 0xde,
-	0xbd, 0x62, 0x8a, 0x67, 0x13, 0x29, 0xee, 0x29, 0x53, 0x8c, 0x4a, 0x5d, 0x10, 0x42, 0x0c, 0x41,
-	0xd6, 0xe1, 0x30, 0x43, 0xe5, 0xc7, 0x22, 0x2c, 0xd4, 0x18, 0xd1, 0x38, 0x68, 0x8a, 0x19, 0x2c,
-	0x2b, 0xf5, 0x95, 0x63, 0xa5, 0x57, 0xe6, 0xc7, 0x0e, 0xd5, 0xb5, 0x26, 0x2c, 0x4f, 0x8c, 0xdf,
-	0xe6, 0x34, 0x96, 0x71, 0x9c, 0x6e, 0xcd, 0x87, 0x93, 0x4a, 0xef, 0x61, 0x25, 0x3b, 0x2f, 0xf7,
-	0xa7, 0x91, 0x64, 0xa0, 0xfa, 0xce, 0xdc, 0x50, 0x29, 0x79, 0x02, 0xab, 0xaa, 0x0e, 0x7f, 0x30,
-	0x9b, 0x69, 0x0c, 0xac, 0xef, 0x5e, 0x02, 0x2c, 0x85, 0x39, 0x68, 0x8a, 0x9e, 0x2c, 0xcf, 0xa6,
-	0x1a, 0xc5, 0x4e, 0xff, 0x97, 0xd3, 0x3b, 0xa9, 0x5a, 0x3f, 0xed, 0x19, 0xe8, 0xac, 0x67, 0xa0,
-	0x3f, 0x3d, 0x03, 0x7d, 0xec, 0x1b, 0xb9, 0xb3, 0xbe, 0x91, 0xfb, 0xd5, 0x37, 0x72, 0x6f, 0x1e,
-	0x13, 0x9f, 0x37, 0x8f, 0x1b, 0x96, 0x4b, 0x03, 0x7b, 0xec, 0x96, 0x68, 0x3f, 0xdc, 0x76, 0x9b,
-	0x8e, 0x1f, 0xda, 0x72, 0xa5, 0x23, 0x2f, 0xb2, 0x6e, 0x84, 0x59, 0x23, 0x9f, 0x14, 0x76, 0xff,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x03, 0x89, 0x6a, 0x67, 0xec, 0x06, 0x00, 0x00,
+func (m *MsgUpdateSourceChainParams) Reset()         { *m = MsgUpdateSourceChainParams{} }
+func (m *MsgUpdateSourceChainParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSourceChainParams) ProtoMessage()    {}
+func (*MsgUpdateSourceChainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{10}
+}
+func (m *MsgUpdateSourceChainParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSourceChainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSourceChainParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSourceChainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSourceChainParams.Merge(m, src)
+}
+func (m *MsgUpdateSourceChainParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSourceChainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSourceChainParams.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgUpdateSourceChainParams proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// AcknowledgeBridges acknowledges bridges and sets them to complete at a
-	// later block.
-	AcknowledgeBridges(ctx context.Context, in *MsgAcknowledgeBridges, opts ...grpc.CallOption) (*MsgAcknowledgeBridgesResponse, error)
-	// CompleteBridge finalizes a bridge by minting coins to an address.
-	CompleteBridge(ctx context.Context, in *MsgCompleteBridge, opts ...grpc.CallOption) (*MsgCompleteBridgeResponse, error)
-	// UpdateEventParams updates the EventParams in state.
-	UpdateEventParams(ctx context.Context, in *MsgUpdateEventParams, opts ...grpc.CallOption) (*MsgUpdateEventParamsResponse, error)
-	// UpdateProposeParams updates the ProposeParams in state.
-	UpdateProposeParams(ctx context.Context, in *MsgUpdateProposeParams, opts ...grpc.CallOption) (*MsgUpdateProposeParamsResponse, error)
-	// UpdateSafetyParams updates the SafetyParams in state.
-	UpdateSafetyParams(ctx context.Context, in *MsgUpdateSafetyParams, opts ...grpc.CallOption) (*MsgUpdateSafetyParamsResponse, error)
+func (m *MsgUpdateSourceChainParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateSourceChainParams) GetParams() SourceChainParams {
+	if m != nil {
+		return m.Params
+	}
+	return SourceChainParams{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgUpdateSourceChainParamsResponse is the Msg/UpdateSourceChainParams
+// response type.
+type MsgUpdateSourceChainParamsResponse struct {
 }
 
-func (c *msgClient) AcknowledgeBridges(ctx context.Context, in *MsgAcknowledgeBridges, opts ...grpc.CallOption) (*MsgAcknowledgeBridgesResponse, error) {
-	out := new(MsgAcknowledgeBridgesResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/AcknowledgeBridges", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSourceChainParamsResponse) Reset()         { *m = MsgUpdateSourceChainParamsResponse{} }
+func (m *MsgUpdateSourceChainParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSourceChainParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateSourceChainParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{11}
+}
+func (m *MsgUpdateSourceChainParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSourceChainParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSourceChainParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateSourceChainParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSourceChainParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateSourceChainParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSourceChainParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSourceChainParamsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CompleteBridge(ctx context.Context, in *MsgCompleteBridge, opts ...grpc.CallOption) (*MsgCompleteBridgeResponse, error) {
-	out := new(MsgCompleteBridgeResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/CompleteBridge", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateSourceChainParamsResponse proto.InternalMessageInfo
+
+// MsgUpdateRateLimitParams is the Msg/UpdateRateLimitParams request type.
+type MsgUpdateRateLimitParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The parameters to update. Each field must be set.
+	Params RateLimitParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (c *msgClient) UpdateEventParams(ctx context.Context, in *MsgUpdateEventParams, opts ...grpc.CallOption) (*MsgUpdateEventParamsResponse, error) {
-	out := new(MsgUpdateEventParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/UpdateEventParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRateLimitParams) Reset()         { *m = MsgUpdateRateLimitParams{} }
+func (m *MsgUpdateRateLimitParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRateLimitParams) ProtoMessage()    {}
+func (*MsgUpdateRateLimitParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{12}
+}
+func (m *MsgUpdateRateLimitParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRateLimitParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRateLimitParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateRateLimitParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRateLimitParams.Merge(m, src)
+}
+func (m *MsgUpdateRateLimitParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRateLimitParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRateLimitParams.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateProposeParams(ctx context.Context, in *MsgUpdateProposeParams, opts ...grpc.CallOption) (*MsgUpdateProposeParamsResponse, error) {
-	out := new(MsgUpdateProposeParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/UpdateProposeParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateRateLimitParams proto.InternalMessageInfo
+
+func (m *MsgUpdateRateLimitParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateSafetyParams(ctx context.Context, in *MsgUpdateSafetyParams, opts ...grpc.CallOption) (*MsgUpdateSafetyParamsResponse, error) {
-	out := new(MsgUpdateSafetyParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/UpdateSafetyParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRateLimitParams) GetParams() RateLimitParams {
+	if m != nil {
+		return m.Params
 	}
-	return out, nil
+	return RateLimitParams{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AcknowledgeBridges acknowledges bridges and sets them to complete at a
-	// later block.
-	AcknowledgeBridges(context.Context, *MsgAcknowledgeBridges) (*MsgAcknowledgeBridgesResponse, error)
-	// CompleteBridge finalizes a bridge by minting coins to an address.
-	CompleteBridge(context.Context, *MsgCompleteBridge) (*MsgCompleteBridgeResponse, error)
-	// UpdateEventParams updates the EventParams in state.
-	UpdateEventParams(context.Context, *MsgUpdateEventParams) (*MsgUpdateEventParamsResponse, error)
-	// UpdateProposeParams updates the ProposeParams in state.
-	UpdateProposeParams(context.Context, *MsgUpdateProposeParams) (*MsgUpdateProposeParamsResponse, error)
-	// UpdateSafetyParams updates the SafetyParams in state.
-	UpdateSafetyParams(context.Context, *MsgUpdateSafetyParams) (*MsgUpdateSafetyParamsResponse, error)
+// MsgUpdateRateLimitParamsResponse is the Msg/UpdateRateLimitParams response
+// type.
+type MsgUpdateRateLimitParamsResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateRateLimitParamsResponse) Reset()         { *m = MsgUpdateRateLimitParamsResponse{} }
+func (m *MsgUpdateRateLimitParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRateLimitParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateRateLimitParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{13}
 }
-
-func (*UnimplementedMsgServer) AcknowledgeBridges(ctx context.Context, req *MsgAcknowledgeBridges) (*MsgAcknowledgeBridgesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeBridges not implemented")
+func (m *MsgUpdateRateLimitParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CompleteBridge(ctx context.Context, req *MsgCompleteBridge) (*MsgCompleteBridgeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteBridge not implemented")
+func (m *MsgUpdateRateLimitParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRateLimitParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateEventParams(ctx context.Context, req *MsgUpdateEventParams) (*MsgUpdateEventParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEventParams not implemented")
+func (m *MsgUpdateRateLimitParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRateLimitParamsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateProposeParams(ctx context.Context, req *MsgUpdateProposeParams) (*MsgUpdateProposeParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposeParams not implemented")
+func (m *MsgUpdateRateLimitParamsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateSafetyParams(ctx context.Context, req *MsgUpdateSafetyParams) (*MsgUpdateSafetyParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSafetyParams not implemented")
+func (m *MsgUpdateRateLimitParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRateLimitParamsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateRateLimitParamsResponse proto.InternalMessageInfo
+
+// MsgBridgeOut is the Msg/BridgeOut request type.
+type MsgBridgeOut struct {
+	// The account address to withdraw tokens from.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The hex-encoded Ethereum address to release the tokens to.
+	EthRecipient string `protobuf:"bytes,2,opt,name=eth_recipient,json=ethRecipient,proto3" json:"eth_recipient,omitempty"`
+	// The tokens to withdraw. Must be of the bridged denom.
+	Coin types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
 }
 
-func _Msg_AcknowledgeBridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcknowledgeBridges)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AcknowledgeBridges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Msg/AcknowledgeBridges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcknowledgeBridges(ctx, req.(*MsgAcknowledgeBridges))
+func (m *MsgBridgeOut) Reset()         { *m = MsgBridgeOut{} }
+func (m *MsgBridgeOut) String() string { return proto.CompactTextString(m) }
+func (*MsgBridgeOut) ProtoMessage()    {}
+func (*MsgBridgeOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{14}
+}
+func (m *MsgBridgeOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBridgeOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBridgeOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBridgeOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBridgeOut.Merge(m, src)
+}
+func (m *MsgBridgeOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBridgeOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBridgeOut.DiscardUnknown(m)
 }
 
-func _Msg_CompleteBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteBridge)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteBridge(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Msg/CompleteBridge",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteBridge(ctx, req.(*MsgCompleteBridge))
+var xxx_messageInfo_MsgBridgeOut proto.InternalMessageInfo
+
+func (m *MsgBridgeOut) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateEventParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateEventParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateEventParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Msg/UpdateEventParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateEventParams(ctx, req.(*MsgUpdateEventParams))
+func (m *MsgBridgeOut) GetEthRecipient() string {
+	if m != nil {
+		return m.EthRecipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateProposeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateProposeParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateProposeParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Msg/UpdateProposeParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateProposeParams(ctx, req.(*MsgUpdateProposeParams))
+func (m *MsgBridgeOut) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Msg_UpdateSafetyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSafetyParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSafetyParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.bridge.Msg/UpdateSafetyParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSafetyParams(ctx, req.(*MsgUpdateSafetyParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.bridge.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AcknowledgeBridges",
-			Handler:    _Msg_AcknowledgeBridges_Handler,
-		},
-		{
-			MethodName: "CompleteBridge",
-			Handler:    _Msg_CompleteBridge_Handler,
-		},
-		{
-			MethodName: "UpdateEventParams",
-			Handler:    _Msg_UpdateEventParams_Handler,
-		},
-		{
-			MethodName: "UpdateProposeParams",
-			Handler:    _Msg_UpdateProposeParams_Handler,
-		},
-		{
-			MethodName: "UpdateSafetyParams",
-			Handler:    _Msg_UpdateSafetyParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/bridge/tx.proto",
+// MsgBridgeOutResponse is the Msg/BridgeOut response type.
+type MsgBridgeOutResponse struct {
+	// The id of the queued withdrawal.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgAcknowledgeBridges) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBridgeOutResponse) Reset()         { *m = MsgBridgeOutResponse{} }
+func (m *MsgBridgeOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBridgeOutResponse) ProtoMessage()    {}
+func (*MsgBridgeOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{15}
 }
-
-func (m *MsgAcknowledgeBridges) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBridgeOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgAcknowledgeBridges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgBridgeOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBridgeOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAcknowledgeBridgesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBridgeOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBridgeOutResponse.Merge(m, src)
 }
-
-func (m *MsgAcknowledgeBridgesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBridgeOutResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgAcknowledgeBridgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBridgeOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBridgeOutResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCompleteBridge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBridgeOutResponse proto.InternalMessageInfo
+
+func (m *MsgBridgeOutResponse) GetId() uint32 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCompleteBridge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetBridgeSigner is the Msg/SetBridgeSigner request type.
+type MsgSetBridgeSigner struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// The hex-encoded Ethereum address of the validator's bridge signer.
+	EthSigner string `protobuf:"bytes,2,opt,name=eth_signer,json=ethSigner,proto3" json:"eth_signer,omitempty"`
 }
 
-func (m *MsgCompleteBridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetBridgeSigner) Reset()         { *m = MsgSetBridgeSigner{} }
+func (m *MsgSetBridgeSigner) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeSigner) ProtoMessage()    {}
+func (*MsgSetBridgeSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{16}
+}
+func (m *MsgSetBridgeSigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBridgeSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBridgeSigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetBridgeSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeSigner.Merge(m, src)
+}
+func (m *MsgSetBridgeSigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBridgeSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeSigner.DiscardUnknown(m)
 }
 
-func (m *MsgCompleteBridgeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetBridgeSigner proto.InternalMessageInfo
+
+func (m *MsgSetBridgeSigner) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCompleteBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetBridgeSigner) GetEthSigner() string {
+	if m != nil {
+		return m.EthSigner
+	}
+	return ""
 }
 
-func (m *MsgCompleteBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetBridgeSignerResponse is the Msg/SetBridgeSigner response type.
+type MsgSetBridgeSignerResponse struct {
 }
 
-func (m *MsgUpdateEventParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetBridgeSignerResponse) Reset()         { *m = MsgSetBridgeSignerResponse{} }
+func (m *MsgSetBridgeSignerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeSignerResponse) ProtoMessage()    {}
+func (*MsgSetBridgeSignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{17}
+}
+func (m *MsgSetBridgeSignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBridgeSignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBridgeSignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetBridgeSignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeSignerResponse.Merge(m, src)
+}
+func (m *MsgSetBridgeSignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBridgeSignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeSignerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateEventParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetBridgeSignerResponse proto.InternalMessageInfo
+
+// MsgAttestBridgeOut is the Msg/AttestBridgeOut request type.
+type MsgAttestBridgeOut struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// The id of the withdrawal to attest.
+	WithdrawalId uint32 `protobuf:"varint,2,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	// The 65-byte [R || S || V] secp256k1 signature of the withdrawal digest by
+	// the validator's bridge signer.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *MsgUpdateEventParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAttestBridgeOut) Reset()         { *m = MsgAttestBridgeOut{} }
+func (m *MsgAttestBridgeOut) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestBridgeOut) ProtoMessage()    {}
+func (*MsgAttestBridgeOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{18}
+}
+func (m *MsgAttestBridgeOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestBridgeOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestBridgeOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgAttestBridgeOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestBridgeOut.Merge(m, src)
+}
+func (m *MsgAttestBridgeOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestBridgeOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestBridgeOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestBridgeOut proto.InternalMessageInfo
+
+func (m *MsgAttestBridgeOut) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateEventParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAttestBridgeOut) GetWithdrawalId() uint32 {
+	if m != nil {
+		return m.WithdrawalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateEventParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAttestBridgeOut) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
 }
 
-func (m *MsgUpdateEventParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgAttestBridgeOutResponse is the Msg/AttestBridgeOut response type.
+type MsgAttestBridgeOutResponse struct {
+	// True if the attestation finalized the withdrawal.
+	Finalized bool `protobuf:"varint,1,opt,name=finalized,proto3" json:"finalized,omitempty"`
 }
 
-func (m *MsgUpdateProposeParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAttestBridgeOutResponse) Reset()         { *m = MsgAttestBridgeOutResponse{} }
+func (m *MsgAttestBridgeOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestBridgeOutResponse) ProtoMessage()    {}
+func (*MsgAttestBridgeOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1851bd29b57dcf2f, []int{19}
 }
-
-func (m *MsgUpdateProposeParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAttestBridgeOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateProposeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAttestBridgeOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestBridgeOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAttestBridgeOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestBridgeOutResponse.Merge(m, src)
+}
+func (m *MsgAttestBridgeOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestBridgeOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestBridgeOutResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateProposeParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAttestBridgeOutResponse proto.InternalMessageInfo
+
+func (m *MsgAttestBridgeOutResponse) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateProposeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgAcknowledgeBridges)(nil), "dydxprotocol.bridge.MsgAcknowledgeBridges")
+	proto.RegisterType((*MsgAcknowledgeBridgesResponse)(nil), "dydxprotocol.bridge.MsgAcknowledgeBridgesResponse")
+	proto.RegisterType((*MsgCompleteBridge)(nil), "dydxprotocol.bridge.MsgCompleteBridge")
+	proto.RegisterType((*MsgCompleteBridgeResponse)(nil), "dydxprotocol.bridge.MsgCompleteBridgeResponse")
+	proto.RegisterType((*MsgUpdateEventParams)(nil), "dydxprotocol.bridge.MsgUpdateEventParams")
+	proto.RegisterType((*MsgUpdateEventParamsResponse)(nil), "dydxprotocol.bridge.MsgUpdateEventParamsResponse")
+	proto.RegisterType((*MsgUpdateProposeParams)(nil), "dydxprotocol.bridge.MsgUpdateProposeParams")
+	proto.RegisterType((*MsgUpdateProposeParamsResponse)(nil), "dydxprotocol.bridge.MsgUpdateProposeParamsResponse")
+	proto.RegisterType((*MsgUpdateSafetyParams)(nil), "dydxprotocol.bridge.MsgUpdateSafetyParams")
+	proto.RegisterType((*MsgUpdateSafetyParamsResponse)(nil), "dydxprotocol.bridge.MsgUpdateSafetyParamsResponse")
+	proto.RegisterType((*MsgUpdateSourceChainParams)(nil), "dydxprotocol.bridge.MsgUpdateSourceChainParams")
+	proto.RegisterType((*MsgUpdateSourceChainParamsResponse)(nil), "dydxprotocol.bridge.MsgUpdateSourceChainParamsResponse")
+	proto.RegisterType((*MsgUpdateRateLimitParams)(nil), "dydxprotocol.bridge.MsgUpdateRateLimitParams")
+	proto.RegisterType((*MsgUpdateRateLimitParamsResponse)(nil), "dydxprotocol.bridge.MsgUpdateRateLimitParamsResponse")
+	proto.RegisterType((*MsgBridgeOut)(nil), "dydxprotocol.bridge.MsgBridgeOut")
+	proto.RegisterType((*MsgBridgeOutResponse)(nil), "dydxprotocol.bridge.MsgBridgeOutResponse")
+	proto.RegisterType((*MsgSetBridgeSigner)(nil), "dydxprotocol.bridge.MsgSetBridgeSigner")
+	proto.RegisterType((*MsgSetBridgeSignerResponse)(nil), "dydxprotocol.bridge.MsgSetBridgeSignerResponse")
+	proto.RegisterType((*MsgAttestBridgeOut)(nil), "dydxprotocol.bridge.MsgAttestBridgeOut")
+	proto.RegisterType((*MsgAttestBridgeOutResponse)(nil), "dydxprotocol.bridge.MsgAttestBridgeOutResponse")
 }
 
-func (m *MsgUpdateProposeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("dydxprotocol/bridge/tx.proto", fileDescriptor_1851bd29b57dcf2f) }
+
+var fileDescriptor_1851bd29b57dcf2f = []byte{
+	// 942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x4f, 0x6f, 0xdc, 0x44,
+	0x18, 0xc6, 0x33, 0x49, 0x89, 0xd8, 0xb7, 0x49, 0x50, 0xdd, 0x84, 0x6e, 0xcc, 0xd6, 0xdd, 0xba,
+	0x55, 0x68, 0x83, 0x62, 0x93, 0x84, 0x7f, 0x8a, 0x50, 0x21, 0x1b, 0x38, 0x20, 0xb1, 0xa2, 0x72,
+	0x84, 0x10, 0x5c, 0x22, 0xef, 0x7a, 0xea, 0x1d, 0x75, 0xd7, 0x63, 0x3c, 0xb3, 0x9b, 0x04, 0xc4,
+	0x01, 0xf8, 0x02, 0x5c, 0xb9, 0x00, 0xe2, 0x00, 0x12, 0x27, 0x0e, 0x7c, 0x88, 0x1e, 0x2b, 0x4e,
+	0x9c, 0x10, 0x4a, 0x0e, 0x7c, 0x8d, 0xca, 0x1e, 0xef, 0x78, 0x63, 0x8f, 0xe3, 0x4d, 0x73, 0xda,
+	0xec, 0xcc, 0x6f, 0xde, 0xe7, 0x79, 0x5e, 0x67, 0xe7, 0x95, 0xa1, 0xe1, 0x1d, 0x7b, 0x47, 0x61,
+	0x44, 0x39, 0xed, 0xd2, 0xbe, 0xdd, 0x89, 0x88, 0xe7, 0x63, 0x9b, 0x1f, 0x59, 0xc9, 0x92, 0x76,
+	0x7d, 0x72, 0xd7, 0x12, 0xbb, 0xfa, 0x6a, 0x97, 0xb2, 0x01, 0x65, 0x07, 0xc9, 0xba, 0x2d, 0xbe,
+	0x08, 0x5e, 0x37, 0xc4, 0x37, 0xbb, 0xe3, 0x32, 0x6c, 0x8f, 0x36, 0x3b, 0x98, 0xbb, 0x9b, 0x76,
+	0x97, 0x92, 0x20, 0xdd, 0xbf, 0x91, 0xee, 0x0f, 0x98, 0x6f, 0x8f, 0x36, 0xe3, 0x8f, 0x74, 0x63,
+	0x4d, 0x65, 0x43, 0x7c, 0x1c, 0xe0, 0x11, 0x0e, 0x78, 0xca, 0x35, 0x55, 0x5c, 0xe8, 0x46, 0xee,
+	0x60, 0x6c, 0x61, 0xd9, 0xa7, 0x3e, 0x15, 0xd6, 0xe2, 0xbf, 0xc4, 0xaa, 0xf9, 0x19, 0xac, 0xb4,
+	0x99, 0xbf, 0xdb, 0x7d, 0x1c, 0xd0, 0xc3, 0x3e, 0xf6, 0x7c, 0xdc, 0x4a, 0x8e, 0x32, 0xed, 0x01,
+	0xcc, 0x27, 0xf5, 0x59, 0x1d, 0x35, 0xe7, 0xee, 0x5d, 0xdd, 0x6a, 0x5a, 0x8a, 0xc8, 0x96, 0xa0,
+	0x3f, 0x8c, 0xc1, 0xd6, 0x95, 0x27, 0xff, 0xde, 0x9a, 0x71, 0xd2, 0x53, 0xe6, 0x2d, 0xb8, 0xa9,
+	0x2c, 0xec, 0x60, 0x16, 0xd2, 0x80, 0x61, 0xf3, 0x47, 0x04, 0xd7, 0xda, 0xcc, 0xdf, 0xa3, 0x83,
+	0xb0, 0x8f, 0x79, 0xba, 0xad, 0xbd, 0x05, 0x35, 0x77, 0xc8, 0x7b, 0x34, 0x22, 0xfc, 0xb8, 0x8e,
+	0x9a, 0xe8, 0x5e, 0xad, 0x55, 0xff, 0xfb, 0xaf, 0x8d, 0xe5, 0xb4, 0x9b, 0xbb, 0x9e, 0x17, 0x61,
+	0xc6, 0xf6, 0x79, 0x44, 0x02, 0xdf, 0xc9, 0x50, 0xed, 0x5d, 0x78, 0x21, 0x11, 0xae, 0xcf, 0x36,
+	0xd1, 0x05, 0xdc, 0x8a, 0x43, 0x3b, 0x4b, 0xdf, 0xfd, 0xff, 0xe7, 0x7a, 0x56, 0xcd, 0x7c, 0x05,
+	0x56, 0x0b, 0xd6, 0xa4, 0xf1, 0x9f, 0x10, 0x2c, 0xb7, 0x99, 0xff, 0x69, 0xe8, 0xb9, 0x5c, 0x14,
+	0x7b, 0x98, 0xf4, 0xf9, 0xb9, 0xbd, 0x3f, 0x80, 0x79, 0xf1, 0xa4, 0xce, 0x35, 0x3f, 0xa1, 0x34,
+	0x6e, 0xb5, 0x38, 0x55, 0x70, 0x6f, 0x40, 0x43, 0xe5, 0x4f, 0x06, 0xf8, 0x15, 0xc1, 0xcb, 0x12,
+	0x78, 0x18, 0xd1, 0x90, 0x32, 0x7c, 0xc9, 0x08, 0xef, 0xe7, 0x22, 0x98, 0xca, 0x08, 0x67, 0xb4,
+	0x2a, 0x42, 0x34, 0xc1, 0x50, 0x7b, 0x94, 0x31, 0x7e, 0x41, 0xb0, 0x22, 0x91, 0x7d, 0xf7, 0x11,
+	0xe6, 0xc7, 0x97, 0x4c, 0xf1, 0x5e, 0x2e, 0xc5, 0x6d, 0x65, 0x8a, 0x49, 0xa9, 0x8a, 0x10, 0xe2,
+	0x47, 0x50, 0x74, 0x28, 0x33, 0xfc, 0x81, 0x40, 0xcf, 0x08, 0x3a, 0x8c, 0xba, 0x78, 0xaf, 0xe7,
+	0x92, 0xe0, 0x92, 0x41, 0x3e, 0xc8, 0x05, 0x59, 0x53, 0x07, 0xc9, 0xeb, 0x55, 0xa4, 0xb9, 0x0b,
+	0x66, 0xb9, 0x57, 0x19, 0xe9, 0x37, 0x04, 0x75, 0x89, 0x39, 0x2e, 0xc7, 0x1f, 0x93, 0x01, 0xb9,
+	0xec, 0x4f, 0xa4, 0x95, 0x0b, 0x74, 0x57, 0x19, 0x28, 0xa7, 0x56, 0x11, 0xc7, 0x84, 0x66, 0x99,
+	0x4f, 0x19, 0xe6, 0x77, 0x04, 0x0b, 0x6d, 0xe6, 0x8b, 0x1b, 0xe0, 0x93, 0x21, 0xd7, 0x5e, 0x87,
+	0x79, 0x86, 0x03, 0x0f, 0x47, 0x95, 0xee, 0x53, 0x4e, 0xbb, 0x03, 0x8b, 0x98, 0xf7, 0x0e, 0x22,
+	0xdc, 0x25, 0x21, 0x19, 0xdf, 0x50, 0x35, 0x67, 0x01, 0xf3, 0x9e, 0x33, 0x5e, 0xd3, 0xb6, 0xe1,
+	0x4a, 0x3c, 0x0d, 0xea, 0x73, 0x49, 0xba, 0x55, 0x2b, 0xad, 0x18, 0x8f, 0x0b, 0x2b, 0x1d, 0x17,
+	0xd6, 0x1e, 0x25, 0x41, 0x1a, 0x29, 0x81, 0x77, 0xae, 0xc6, 0x81, 0x52, 0x19, 0x73, 0x0d, 0x96,
+	0x27, 0x8d, 0x8e, 0x13, 0x68, 0x4b, 0x30, 0x4b, 0xbc, 0xc4, 0xec, 0xa2, 0x33, 0x4b, 0x3c, 0xf3,
+	0x6b, 0xd0, 0xda, 0xcc, 0xdf, 0xc7, 0x5c, 0xa0, 0xfb, 0xc4, 0x0f, 0x70, 0x14, 0x3f, 0x97, 0x91,
+	0xdb, 0x27, 0x9e, 0xcb, 0x69, 0x75, 0xb2, 0x0c, 0xd5, 0x6e, 0x02, 0xc4, 0xe1, 0x58, 0x52, 0x25,
+	0x4d, 0x56, 0xc3, 0xbc, 0x27, 0xca, 0xa6, 0x2d, 0x97, 0xb8, 0xd9, 0x00, 0xbd, 0x28, 0x2e, 0x9b,
+	0xfd, 0x33, 0x4a, 0xbc, 0xed, 0x72, 0x8e, 0x19, 0xcf, 0x5a, 0xfe, 0xbc, 0xde, 0xee, 0xc0, 0xe2,
+	0x21, 0xe1, 0x3d, 0x2f, 0x72, 0x0f, 0xdd, 0xfe, 0x01, 0xf1, 0x12, 0x7b, 0x8b, 0xce, 0x42, 0xb6,
+	0xf8, 0x91, 0xa7, 0x35, 0xa0, 0x16, 0x9b, 0x77, 0xf9, 0x30, 0xc2, 0x49, 0xf7, 0x17, 0x9c, 0x6c,
+	0xa1, 0xe0, 0x7f, 0x07, 0xf4, 0xa2, 0x41, 0xd9, 0xea, 0x06, 0xd4, 0x1e, 0x91, 0xc0, 0xed, 0x93,
+	0xaf, 0xb0, 0xe8, 0xf8, 0x8b, 0x4e, 0xb6, 0xb0, 0xf5, 0x6d, 0x0d, 0xe6, 0xda, 0xcc, 0xd7, 0x38,
+	0x68, 0x8a, 0x71, 0xbb, 0xae, 0xfc, 0x87, 0x56, 0x4e, 0x50, 0x7d, 0x6b, 0x7a, 0x56, 0x7a, 0xeb,
+	0xc1, 0x52, 0x6e, 0xd2, 0xae, 0x95, 0x55, 0x39, 0xcb, 0xe9, 0xd6, 0x74, 0x9c, 0x54, 0xfa, 0x12,
+	0xae, 0x15, 0x47, 0xe3, 0xfd, 0xb2, 0x22, 0x05, 0x54, 0xdf, 0x9c, 0x1a, 0x95, 0x92, 0x87, 0x70,
+	0x5d, 0x35, 0xcc, 0x5e, 0x3b, 0xbf, 0xd2, 0x19, 0x58, 0xdf, 0xbe, 0x00, 0x2c, 0x85, 0x39, 0x68,
+	0x8a, 0xf1, 0xb3, 0x7e, 0x7e, 0xa9, 0x49, 0x56, 0xdf, 0x9a, 0x9e, 0x95, 0xaa, 0xdf, 0x23, 0xb8,
+	0x51, 0x36, 0x31, 0xec, 0x8a, 0x7a, 0xf9, 0x03, 0xfa, 0xdb, 0x17, 0x3c, 0x20, 0x5d, 0x7c, 0x03,
+	0x2b, 0xea, 0x3b, 0x7e, 0xe3, 0xfc, 0x8a, 0x39, 0x5c, 0x7f, 0xf3, 0x42, 0xb8, 0x94, 0xff, 0x1c,
+	0x6a, 0xd9, 0x15, 0x71, 0xbb, 0xac, 0x86, 0x44, 0xf4, 0xfb, 0x95, 0x88, 0x2c, 0xfd, 0x18, 0x5e,
+	0xca, 0xdf, 0x8f, 0xaf, 0x96, 0x9d, 0xce, 0x81, 0xba, 0x3d, 0x25, 0x38, 0x29, 0x96, 0xbf, 0xf0,
+	0x4a, 0xc5, 0x72, 0xa0, 0x6e, 0x4f, 0x09, 0x8e, 0xc5, 0x5a, 0xce, 0x93, 0x13, 0x03, 0x3d, 0x3d,
+	0x31, 0xd0, 0x7f, 0x27, 0x06, 0xfa, 0xe1, 0xd4, 0x98, 0x79, 0x7a, 0x6a, 0xcc, 0xfc, 0x73, 0x6a,
+	0xcc, 0x7c, 0xf1, 0x8e, 0x4f, 0x78, 0x6f, 0xd8, 0xb1, 0xba, 0x74, 0x60, 0x9f, 0x79, 0x95, 0x18,
+	0xbd, 0xb1, 0xd1, 0x8d, 0x9f, 0xbe, 0x2d, 0x57, 0x8e, 0xe4, 0xdb, 0xd0, 0x71, 0x88, 0x59, 0x67,
+	0x3e, 0xd9, 0xd8, 0x7e, 0x36, 0x00, 0x6b, 0xaf, 0x0d, 0xb5, 0x31, 0x0d, 0x00, 0x00,
 }
 
-func (m *MsgUpdateSafetyParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AcknowledgeBridges acknowledges bridges and sets them to complete at a
+	// later block.
+	AcknowledgeBridges(ctx context.Context, in *MsgAcknowledgeBridges, opts ...grpc.CallOption) (*MsgAcknowledgeBridgesResponse, error)
+	// CompleteBridge finalizes a bridge by minting coins to an address.
+	CompleteBridge(ctx context.Context, in *MsgCompleteBridge, opts ...grpc.CallOption) (*MsgCompleteBridgeResponse, error)
+	// UpdateEventParams updates the EventParams in state.
+	UpdateEventParams(ctx context.Context, in *MsgUpdateEventParams, opts ...grpc.CallOption) (*MsgUpdateEventParamsResponse, error)
+	// UpdateProposeParams updates the ProposeParams in state.
+	UpdateProposeParams(ctx context.Context, in *MsgUpdateProposeParams, opts ...grpc.CallOption) (*MsgUpdateProposeParamsResponse, error)
+	// UpdateSafetyParams updates the SafetyParams in state.
+	UpdateSafetyParams(ctx context.Context, in *MsgUpdateSafetyParams, opts ...grpc.CallOption) (*MsgUpdateSafetyParamsResponse, error)
+	// UpdateSourceChainParams adds or updates the parameters of an additional
+	// Ethereum chain to recognize bridge events from.
+	UpdateSourceChainParams(ctx context.Context, in *MsgUpdateSourceChainParams, opts ...grpc.CallOption) (*MsgUpdateSourceChainParamsResponse, error)
+	// UpdateRateLimitParams sets the value-based safety parameters of a source
+	// chain in state.
+	UpdateRateLimitParams(ctx context.Context, in *MsgUpdateRateLimitParams, opts ...grpc.CallOption) (*MsgUpdateRateLimitParamsResponse, error)
+	// BridgeOut withdraws tokens to an Ethereum address by escrowing them in the
+	// bridge module account and queueing a withdrawal for validators to attest.
+	BridgeOut(ctx context.Context, in *MsgBridgeOut, opts ...grpc.CallOption) (*MsgBridgeOutResponse, error)
+	// SetBridgeSigner sets the Ethereum address that a validator signs
+	// withdrawals with.
+	SetBridgeSigner(ctx context.Context, in *MsgSetBridgeSigner, opts ...grpc.CallOption) (*MsgSetBridgeSignerResponse, error)
+	// AttestBridgeOut records a validator's signature of a pending withdrawal.
+	AttestBridgeOut(ctx context.Context, in *MsgAttestBridgeOut, opts ...grpc.CallOption) (*MsgAttestBridgeOutResponse, error)
 }
 
-func (m *MsgUpdateSafetyParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgUpdateSafetyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgUpdateSafetyParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcknowledgeBridges(ctx context.Context, in *MsgAcknowledgeBridges, opts ...grpc.CallOption) (*MsgAcknowledgeBridgesResponse, error) {
+	out := new(MsgAcknowledgeBridgesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/AcknowledgeBridges", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSafetyParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CompleteBridge(ctx context.Context, in *MsgCompleteBridge, opts ...grpc.CallOption) (*MsgCompleteBridgeResponse, error) {
+	out := new(MsgCompleteBridgeResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/CompleteBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSafetyParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateEventParams(ctx context.Context, in *MsgUpdateEventParams, opts ...grpc.CallOption) (*MsgUpdateEventParamsResponse, error) {
+	out := new(MsgUpdateEventParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/UpdateEventParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) UpdateProposeParams(ctx context.Context, in *MsgUpdateProposeParams, opts ...grpc.CallOption) (*MsgUpdateProposeParamsResponse, error) {
+	out := new(MsgUpdateProposeParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/UpdateProposeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgAcknowledgeBridges) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (c *msgClient) UpdateSafetyParams(ctx context.Context, in *MsgUpdateSafetyParams, opts ...grpc.CallOption) (*MsgUpdateSafetyParamsResponse, error) {
+	out := new(MsgUpdateSafetyParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/UpdateSafetyParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAcknowledgeBridgesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateSourceChainParams(ctx context.Context, in *MsgUpdateSourceChainParams, opts ...grpc.CallOption) (*MsgUpdateSourceChainParamsResponse, error) {
+	out := new(MsgUpdateSourceChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/UpdateSourceChainParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCompleteBridge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) UpdateRateLimitParams(ctx context.Context, in *MsgUpdateRateLimitParams, opts ...grpc.CallOption) (*MsgUpdateRateLimitParamsResponse, error) {
+	out := new(MsgUpdateRateLimitParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/UpdateRateLimitParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Event.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgCompleteBridgeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) BridgeOut(ctx context.Context, in *MsgBridgeOut, opts ...grpc.CallOption) (*MsgBridgeOutResponse, error) {
+	out := new(MsgBridgeOutResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/BridgeOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateEventParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SetBridgeSigner(ctx context.Context, in *MsgSetBridgeSigner, opts ...grpc.CallOption) (*MsgSetBridgeSignerResponse, error) {
+	out := new(MsgSetBridgeSignerResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/SetBridgeSigner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateEventParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AttestBridgeOut(ctx context.Context, in *MsgAttestBridgeOut, opts ...grpc.CallOption) (*MsgAttestBridgeOutResponse, error) {
+	out := new(MsgAttestBridgeOutResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Msg/AttestBridgeOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateProposeParams) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AcknowledgeBridges acknowledges bridges and sets them to complete at a
+	// later block.
+	AcknowledgeBridges(context.Context, *MsgAcknowledgeBridges) (*MsgAcknowledgeBridgesResponse, error)
+	// CompleteBridge finalizes a bridge by minting coins to an address.
+	CompleteBridge(context.Context, *MsgCompleteBridge) (*MsgCompleteBridgeResponse, error)
+	// UpdateEventParams updates the EventParams in state.
+	UpdateEventParams(context.Context, *MsgUpdateEventParams) (*MsgUpdateEventParamsResponse, error)
+	// UpdateProposeParams updates the ProposeParams in state.
+	UpdateProposeParams(context.Context, *MsgUpdateProposeParams) (*MsgUpdateProposeParamsResponse, error)
+	// UpdateSafetyParams updates the SafetyParams in state.
+	UpdateSafetyParams(context.Context, *MsgUpdateSafetyParams) (*MsgUpdateSafetyParamsResponse, error)
+	// UpdateSourceChainParams adds or updates the parameters of an additional
+	// Ethereum chain to recognize bridge events from.
+	UpdateSourceChainParams(context.Context, *MsgUpdateSourceChainParams) (*MsgUpdateSourceChainParamsResponse, error)
+	// UpdateRateLimitParams sets the value-based safety parameters of a source
+	// chain in state.
+	UpdateRateLimitParams(context.Context, *MsgUpdateRateLimitParams) (*MsgUpdateRateLimitParamsResponse, error)
+	// BridgeOut withdraws tokens to an Ethereum address by escrowing them in the
+	// bridge module account and queueing a withdrawal for validators to attest.
+	BridgeOut(context.Context, *MsgBridgeOut) (*MsgBridgeOutResponse, error)
+	// SetBridgeSigner sets the Ethereum address that a validator signs
+	// withdrawals with.
+	SetBridgeSigner(context.Context, *MsgSetBridgeSigner) (*MsgSetBridgeSignerResponse, error)
+	// AttestBridgeOut records a validator's signature of a pending withdrawal.
+	AttestBridgeOut(context.Context, *MsgAttestBridgeOut) (*MsgAttestBridgeOutResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AcknowledgeBridges(ctx context.Context, req *MsgAcknowledgeBridges) (*MsgAcknowledgeBridgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeBridges not implemented")
+}
+func (*UnimplementedMsgServer) CompleteBridge(ctx context.Context, req *MsgCompleteBridge) (*MsgCompleteBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteBridge not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEventParams(ctx context.Context, req *MsgUpdateEventParams) (*MsgUpdateEventParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEventParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProposeParams(ctx context.Context, req *MsgUpdateProposeParams) (*MsgUpdateProposeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposeParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSafetyParams(ctx context.Context, req *MsgUpdateSafetyParams) (*MsgUpdateSafetyParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSafetyParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSourceChainParams(ctx context.Context, req *MsgUpdateSourceChainParams) (*MsgUpdateSourceChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSourceChainParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRateLimitParams(ctx context.Context, req *MsgUpdateRateLimitParams) (*MsgUpdateRateLimitParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimitParams not implemented")
+}
+func (*UnimplementedMsgServer) BridgeOut(ctx context.Context, req *MsgBridgeOut) (*MsgBridgeOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeOut not implemented")
+}
+func (*UnimplementedMsgServer) SetBridgeSigner(ctx context.Context, req *MsgSetBridgeSigner) (*MsgSetBridgeSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeSigner not implemented")
+}
+func (*UnimplementedMsgServer) AttestBridgeOut(ctx context.Context, req *MsgAttestBridgeOut) (*MsgAttestBridgeOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestBridgeOut not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AcknowledgeBridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeBridges)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgeBridges(ctx, in)
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/AcknowledgeBridges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgeBridges(ctx, req.(*MsgAcknowledgeBridges))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateProposeParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CompleteBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteBridge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteBridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/CompleteBridge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteBridge(ctx, req.(*MsgCompleteBridge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEventParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEventParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEventParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/UpdateEventParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEventParams(ctx, req.(*MsgUpdateEventParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProposeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProposeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProposeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/UpdateProposeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProposeParams(ctx, req.(*MsgUpdateProposeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSafetyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSafetyParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSafetyParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/UpdateSafetyParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSafetyParams(ctx, req.(*MsgUpdateSafetyParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSourceChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSourceChainParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSourceChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/UpdateSourceChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSourceChainParams(ctx, req.(*MsgUpdateSourceChainParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRateLimitParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRateLimitParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRateLimitParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/UpdateRateLimitParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRateLimitParams(ctx, req.(*MsgUpdateRateLimitParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BridgeOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/BridgeOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeOut(ctx, req.(*MsgBridgeOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBridgeSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeSigner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/SetBridgeSigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeSigner(ctx, req.(*MsgSetBridgeSigner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestBridgeOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestBridgeOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestBridgeOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Msg/AttestBridgeOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestBridgeOut(ctx, req.(*MsgAttestBridgeOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.bridge.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcknowledgeBridges",
+			Handler:    _Msg_AcknowledgeBridges_Handler,
+		},
+		{
+			MethodName: "CompleteBridge",
+			Handler:    _Msg_CompleteBridge_Handler,
+		},
+		{
+			MethodName: "UpdateEventParams",
+			Handler:    _Msg_UpdateEventParams_Handler,
+		},
+		{
+			MethodName: "UpdateProposeParams",
+			Handler:    _Msg_UpdateProposeParams_Handler,
+		},
+		{
+			MethodName: "UpdateSafetyParams",
+			Handler:    _Msg_UpdateSafetyParams_Handler,
+		},
+		{
+			MethodName: "UpdateSourceChainParams",
+			Handler:    _Msg_UpdateSourceChainParams_Handler,
+		},
+		{
+			MethodName: "UpdateRateLimitParams",
+			Handler:    _Msg_UpdateRateLimitParams_Handler,
+		},
+		{
+			MethodName: "BridgeOut",
+			Handler:    _Msg_BridgeOut_Handler,
+		},
+		{
+			MethodName: "SetBridgeSigner",
+			Handler:    _Msg_SetBridgeSigner_Handler,
+		},
+		{
+			MethodName: "AttestBridgeOut",
+			Handler:    _Msg_AttestBridgeOut_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/bridge/tx.proto",
+}
+
+func (m *MsgAcknowledgeBridges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeBridges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeBridges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeBridgesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeBridgesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeBridgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteBridge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteBridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteBridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteBridgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEventParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEventParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEventParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEventParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEventParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEventParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProposeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProposeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProposeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProposeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProposeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProposeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSafetyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSafetyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSafetyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSafetyParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSafetyParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSafetyParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSourceChainParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSourceChainParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSourceChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSourceChainParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSourceChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSourceChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRateLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRateLimitParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimitParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimitParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EthRecipient) > 0 {
+		i -= len(m.EthRecipient)
+		copy(dAtA[i:], m.EthRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthSigner) > 0 {
+		i -= len(m.EthSigner)
+		copy(dAtA[i:], m.EthSigner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestBridgeOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestBridgeOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestBridgeOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WithdrawalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestBridgeOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestBridgeOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestBridgeOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAcknowledgeBridges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeBridgesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Event.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCompleteBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEventParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEventParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProposeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateProposeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSafetyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateSafetyParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSourceChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateSourceChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRateLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRateLimitParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBridgeOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EthRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBridgeOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgSetBridgeSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EthSigner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBridgeSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestBridgeOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WithdrawalId != 0 {
+		n += 1 + sovTx(uint64(m.WithdrawalId))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestBridgeOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAcknowledgeBridges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeBridges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeBridges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, BridgeEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeBridgesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeBridgesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeBridgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteBridge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteBridge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteBridgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteBridgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEventParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEventParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEventParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEventParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEventParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEventParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProposeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProposeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProposeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProposeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProposeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProposeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSafetyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSafetyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSafetyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgUpdateSafetyParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgUpdateSafetyParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSafetyParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSafetyParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSafetyParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAcknowledgeBridges) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSourceChainParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1240,15 +3235,47 @@ func (m *MsgAcknowledgeBridges) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgeBridges: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSourceChainParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgeBridges: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSourceChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1275,8 +3302,7 @@ func (m *MsgAcknowledgeBridges) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, BridgeEvent{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1301,7 +3327,7 @@ func (m *MsgAcknowledgeBridges) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgeBridgesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSourceChainParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1324,10 +3350,10 @@ func (m *MsgAcknowledgeBridgesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgeBridgesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSourceChainParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgeBridgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSourceChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1351,7 +3377,7 @@ func (m *MsgAcknowledgeBridgesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteBridge) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRateLimitParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1374,10 +3400,10 @@ func (m *MsgCompleteBridge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteBridge: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRateLimitParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1414,7 +3440,7 @@ func (m *MsgCompleteBridge) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1441,7 +3467,7 @@ func (m *MsgCompleteBridge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1466,7 +3492,7 @@ func (m *MsgCompleteBridge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteBridgeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRateLimitParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,10 +3515,10 @@ func (m *MsgCompleteBridgeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteBridgeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRateLimitParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRateLimitParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1516,7 +3542,7 @@ func (m *MsgCompleteBridgeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEventParams) Unmarshal(dAtA []byte) error {
+func (m *MsgBridgeOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1539,15 +3565,15 @@ func (m *MsgUpdateEventParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEventParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBridgeOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEventParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBridgeOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1575,11 +3601,43 @@ func (m *MsgUpdateEventParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1606,7 +3664,7 @@ func (m *MsgUpdateEventParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1631,7 +3689,7 @@ func (m *MsgUpdateEventParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEventParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBridgeOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1654,12 +3712,31 @@ func (m *MsgUpdateEventParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEventParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBridgeOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEventParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBridgeOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1681,7 +3758,7 @@ func (m *MsgUpdateEventParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateProposeParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgeSigner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,15 +3781,15 @@ func (m *MsgUpdateProposeParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateProposeParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgeSigner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateProposeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgeSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1740,13 +3817,13 @@ func (m *MsgUpdateProposeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1756,24 +3833,23 @@ func (m *MsgUpdateProposeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1796,7 +3872,7 @@ func (m *MsgUpdateProposeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateProposeParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgeSignerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1819,10 +3895,10 @@ func (m *MsgUpdateProposeParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateProposeParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgeSignerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateProposeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgeSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1846,7 +3922,7 @@ func (m *MsgUpdateProposeParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSafetyParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestBridgeOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1869,15 +3945,15 @@ func (m *MsgUpdateSafetyParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSafetyParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestBridgeOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSafetyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestBridgeOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1905,13 +3981,32 @@ func (m *MsgUpdateSafetyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			m.WithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1921,23 +4016,24 @@ func (m *MsgUpdateSafetyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1961,7 +4057,7 @@ func (m *MsgUpdateSafetyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSafetyParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestBridgeOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1984,12 +4080,32 @@ func (m *MsgUpdateSafetyParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSafetyParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestBridgeOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSafetyParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestBridgeOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
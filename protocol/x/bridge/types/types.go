@@ -29,6 +29,34 @@ type BridgeKeeper interface {
 
 	UpdateSafetyParams(ctx sdk.Context, params SafetyParams) error
 
+	// Source Chain Params
+	GetSourceChainParams(ctx sdk.Context, ethChainId uint64) (params SourceChainParams, found bool)
+
+	GetAllSourceChainParams(ctx sdk.Context) []SourceChainParams
+
+	UpdateSourceChainParams(ctx sdk.Context, params SourceChainParams) error
+
+	// Rate Limit Params
+	GetRateLimitParams(ctx sdk.Context, ethChainId uint64) (params RateLimitParams, found bool)
+
+	GetAllRateLimitParams(ctx sdk.Context) []RateLimitParams
+
+	UpdateRateLimitParams(ctx sdk.Context, params RateLimitParams) error
+
+	// Withdrawals
+	BridgeOut(ctx sdk.Context, sender sdk.AccAddress, ethRecipient string, coin sdk.Coin) (uint32, error)
+
+	SetBridgeSigner(ctx sdk.Context, validator sdk.ValAddress, ethSigner string)
+
+	AttestBridgeOut(
+		ctx sdk.Context,
+		validator sdk.ValAddress,
+		withdrawalId uint32,
+		signature []byte,
+	) (finalized bool, err error)
+
+	GetWithdrawal(ctx sdk.Context, id uint32) (withdrawal BridgeWithdrawal, found bool)
+
 	// Authority.
 	HasAuthority(authority string) bool
 }
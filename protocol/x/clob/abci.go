@@ -70,10 +70,26 @@ func EndBlocker(
 		)
 	}
 
-	// Prune expired untriggered conditional orders from the in-memory UntriggeredConditionalOrders struct.
+	// Prune expired, cancelled and removed untriggered conditional orders from the in-memory
+	// UntriggeredConditionalOrders struct. Untriggered conditional orders are removed in DeliverTx
+	// when another order in their order group is filled.
+	cancelledOrRemovedStatefulOrderIds := make(
+		[]types.OrderId,
+		0,
+		len(processProposerMatchesEvents.PlacedStatefulCancellationOrderIds)+
+			len(processProposerMatchesEvents.RemovedStatefulOrderIds),
+	)
+	cancelledOrRemovedStatefulOrderIds = append(
+		cancelledOrRemovedStatefulOrderIds,
+		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds...,
+	)
+	cancelledOrRemovedStatefulOrderIds = append(
+		cancelledOrRemovedStatefulOrderIds,
+		processProposerMatchesEvents.RemovedStatefulOrderIds...,
+	)
 	keeper.PruneUntriggeredConditionalOrders(
 		expiredStatefulOrderIds,
-		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
+		cancelledOrRemovedStatefulOrderIds,
 	)
 
 	// Update the memstore with expired order ids.
@@ -93,10 +109,16 @@ func EndBlocker(
 	)
 
 	// Poll out all triggered conditional orders from `UntriggeredConditionalOrders` and update state.
-	triggeredConditionalOrderIds := keeper.MaybeTriggerConditionalOrders(ctx)
+	triggeredConditionalOrderIds, orderGroupRemovedOrderIds := keeper.MaybeTriggerConditionalOrders(ctx)
 	// Update the memstore with conditional order ids triggered in the last block.
 	// These triggered conditional orders will be placed in the `PrepareCheckState``.
 	processProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock = triggeredConditionalOrderIds
+	// Update the memstore with order ids removed as part of the order group of a triggered order.
+	// These removed stateful order ids will be purged from the memclob in `PrepareCheckState`.
+	processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
+		orderGroupRemovedOrderIds...,
+	)
 
 	// Write the ProcessProposerMatchcesEvents with all the EndBlocker updates to state.
 	keeper.MustSetProcessProposerMatchesEvents(
@@ -121,6 +143,12 @@ func PrepareCheckState(
 	keeper *keeper.Keeper,
 	liquidatableSubaccountIds *liquidationtypes.LiquidatableSubaccountIds,
 ) {
+	unlock := keeper.LockMemClob()
+	defer unlock()
+
+	// gRPC queries simulating against the memclob read the state of the new `checkState`.
+	keeper.SetCheckStateContext(ctx)
+
 	// Get the events generated from processing the matches in the latest block.
 	processProposerMatchesEvents := keeper.GetProcessProposerMatchesEvents(ctx)
 	if ctx.BlockHeight() != int64(processProposerMatchesEvents.BlockHeight) {
@@ -204,6 +232,10 @@ func PrepareCheckState(
 		panic(err)
 	}
 
+	// 7. Deleverage subaccounts with negative total net collateral against offsetting positions.
+	negativeTncSubaccountIds := liquidatableSubaccountIds.GetNegativeTncSubaccountIds()
+	keeper.DeleverageSubaccounts(ctx, negativeTncSubaccountIds)
+
 	// Send all off-chain Indexer events
 	keeper.SendOffchainMessages(offchainUpdates, nil, metrics.SendPrepareCheckStateOffchainUpdates)
 
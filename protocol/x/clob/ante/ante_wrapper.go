@@ -5,8 +5,8 @@ import (
 )
 
 // SingleMsgClobTxAnteWrapper is a wrapper for Antehandlers that need to be skipped for
-// single msg clob txs `MsgPlaceOrder` and `MsgCancelOrder`. These transactions should always have `0` Gas,
-// and therefore should never be charged a gas fee.
+// single msg clob txs `MsgPlaceOrder`, `MsgCancelOrder`, `MsgBatchPlaceOrders`, and `MsgBatchCancel`.
+// These transactions should always have `0` Gas, and therefore should never be charged a gas fee.
 type SingleMsgClobTxAnteWrapper struct {
 	antehandler sdk.AnteDecorator
 }
@@ -40,7 +40,8 @@ func (anteWrapper SingleMsgClobTxAnteWrapper) AnteHandle(
 }
 
 // ShortTermSingleMsgClobTxAnteWrapper is a wrapper for Antehandlers that need to be skipped for
-// single msg clob txs `MsgPlaceOrder` and `MsgCancelOrder` which reference Short-Term orders.
+// single msg clob txs `MsgPlaceOrder` and `MsgCancelOrder` which reference Short-Term orders, as well as
+// `MsgBatchPlaceOrders` and `MsgBatchCancel`.
 // For example, these transactions do not require sequence number validation.
 type ShortTermSingleMsgClobTxAnteWrapper struct {
 	antehandler sdk.AnteDecorator
@@ -15,18 +15,24 @@ import (
 //   - adding short term order placements and cancelations to the in-memory orderbook (`CheckTx` only).
 //   - adding stateful order placements and cancelations to state (`CheckTx` and `RecheckTx` only).
 //
-// This AnteDecorator also enforces that any Transaction which contains a `MsgPlaceOrder`,
-// or a `MsgCancelOrder`, must consist only of a single message.
+// This AnteDecorator also enforces that any Transaction which contains a `MsgPlaceOrder`, `MsgCancelOrder`,
+// `MsgBatchPlaceOrders`, `MsgBatchCancel`, or `MsgReplaceOrder` must consist only of a single message.
 //
 // This AnteDecorator is a no-op if:
-//   - No messages in the transaction are `MsgPlaceOrder` or `MsgCancelOrder`.
+//   - No messages in the transaction are `MsgPlaceOrder`, `MsgCancelOrder`, `MsgBatchPlaceOrders`,
+//     `MsgBatchCancel`, or `MsgReplaceOrder`.
 //   - This AnteDecorator is called during `DeliverTx`.
 //
 // This AnteDecorator returns an error if:
-//   - The transaction contains multiple messages, and one of them is a `MsgPlaceOrder`
-//     or `MsgCancelOrder` message.
-//   - The underlying `PlaceStatefulOrder`, `PlaceShortTermOrder`, `CancelStatefulOrder`, or `CancelShortTermOrder`
-//     methods on the keeper return errors.
+//   - The transaction contains multiple messages, and one of them is a `MsgPlaceOrder`, `MsgCancelOrder`,
+//     `MsgBatchPlaceOrders`, `MsgBatchCancel`, or `MsgReplaceOrder` message.
+//   - The underlying `PlaceStatefulOrder`, `PlaceShortTermOrder`, `CancelStatefulOrder`, `CancelShortTermOrder`,
+//     `ReplaceStatefulOrder`, or `ReplaceShortTermOrder` methods on the keeper return errors.
+//   - Every order placement in a `MsgBatchPlaceOrders`, or every order cancellation in a `MsgBatchCancel`, fails.
+//   - A `MsgBatchPlaceOrders`, `MsgBatchCancel`, or a `MsgReplaceOrder` of a Short-Term order is rechecked
+//     (`RecheckTx` only). The dYdX fork of CometBFT only keeps Short-Term `MsgPlaceOrder` and `MsgCancelOrder`
+//     transactions out of the mempool, so failing the recheck evicts these transactions from the mempool after
+//     the block they were received in.
 type ClobDecorator struct {
 	clobKeeper types.ClobKeeper
 }
@@ -59,6 +65,11 @@ func (cd ClobDecorator) AnteHandle(
 		return next(ctx, tx, simulate)
 	}
 
+	// gRPC queries read the memclob concurrently with `CheckTx`, so the memclob lock is held while the
+	// clob message modifies the memclob.
+	unlock := cd.clobKeeper.LockMemClob()
+	defer unlock()
+
 	msgs := tx.GetMsgs()
 	var msg = msgs[0]
 
@@ -139,6 +150,123 @@ func (cd ClobDecorator) AnteHandle(
 				lib.TxMode(ctx),
 			)
 		}
+
+	case *types.MsgBatchPlaceOrders:
+		// Evict the transaction from the mempool on `ReCheckTx`, since it can never be included in a block.
+		if ctx.IsReCheckTx() {
+			return ctx, types.ErrBatchMsgRemovedOnRecheck
+		}
+
+		// Note that `msg.ValidateBasic` is called before all AnteHandlers.
+		// This guarantees that `MsgBatchPlaceOrders` has undergone stateless validation.
+		placedOrderIds, failedOrderIds := cd.clobKeeper.BatchPlaceShortTermOrders(ctx, msg)
+		cd.clobKeeper.Logger(ctx).Debug("Received new batch of short term orders",
+			"tx",
+			log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			"msg",
+			msg,
+			"placedOrderIds",
+			placedOrderIds,
+			"failedOrderIds",
+			failedOrderIds,
+			"block",
+			ctx.BlockHeight(),
+			"txMode",
+			lib.TxMode(ctx),
+		)
+
+		// Reject the transaction if none of the orders in the batch were placed.
+		if len(placedOrderIds) == 0 {
+			err = errorsmod.Wrapf(
+				types.ErrBatchPlaceOrdersFailed,
+				"failed order IDs: %+v",
+				failedOrderIds,
+			)
+		}
+
+	case *types.MsgBatchCancel:
+		// Evict the transaction from the mempool on `ReCheckTx`, since it can never be included in a block.
+		if ctx.IsReCheckTx() {
+			return ctx, types.ErrBatchMsgRemovedOnRecheck
+		}
+
+		// Note that `msg.ValidateBasic` is called before the AnteHandlers.
+		// This guarantees that `MsgBatchCancel` has undergone stateless validation.
+		succeeded, failed := cd.clobKeeper.BatchCancelShortTermOrder(ctx, msg)
+		cd.clobKeeper.Logger(ctx).Debug("Received new batch of short term order cancelations",
+			"tx",
+			log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			"msg",
+			msg,
+			"succeeded",
+			succeeded,
+			"failed",
+			failed,
+			"block",
+			ctx.BlockHeight(),
+			"txMode",
+			lib.TxMode(ctx),
+		)
+
+		// Reject the transaction if none of the order cancelations in the batch succeeded.
+		if len(succeeded) == 0 {
+			err = errorsmod.Wrapf(
+				types.ErrBatchCancelFailed,
+				"failed cancelations: %+v",
+				failed,
+			)
+		}
+
+	case *types.MsgReplaceOrder:
+		if !msg.IsShortTermReplacement() {
+			err = cd.clobKeeper.ReplaceStatefulOrder(ctx, msg)
+			cd.clobKeeper.Logger(ctx).Debug("Received new stateful order replacement",
+				"tx",
+				log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+				"orderHash",
+				log.NewLazySprintf("%X", msg.NewOrder.GetOrderHash()),
+				"msg",
+				msg,
+				"err",
+				err,
+				"block",
+				ctx.BlockHeight(),
+				"txMode",
+				lib.TxMode(ctx),
+			)
+		} else {
+			// Evict the transaction from the mempool on `ReCheckTx`, since it can never be included in a block.
+			if ctx.IsReCheckTx() {
+				return ctx, types.ErrShortTermReplaceOrderRemovedOnRecheck
+			}
+
+			var orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums
+			var status types.OrderStatus
+			// Note that `msg.ValidateBasic` is called before all AnteHandlers.
+			// This guarantees that `MsgReplaceOrder` has undergone stateless validation.
+			orderSizeOptimisticallyFilledFromMatchingQuantums, status, err = cd.clobKeeper.ReplaceShortTermOrder(
+				ctx,
+				msg,
+			)
+			cd.clobKeeper.Logger(ctx).Debug("Received new short term order replacement",
+				"tx",
+				log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+				"orderHash",
+				log.NewLazySprintf("%X", msg.NewOrder.GetOrderHash()),
+				"msg",
+				msg,
+				"status",
+				status,
+				"orderSizeOptimisticallyFilledFromMatchingQuantums",
+				orderSizeOptimisticallyFilledFromMatchingQuantums,
+				"err",
+				err,
+				"block",
+				ctx.BlockHeight(),
+				"txMode",
+				lib.TxMode(ctx),
+			)
+		}
 	}
 	if err != nil {
 		return ctx, err
@@ -148,15 +276,20 @@ func (cd ClobDecorator) AnteHandle(
 }
 
 // IsSingleClobMsgTx returns `true` if the supplied `tx` consist of a single clob message
-// (`MsgPlaceOrder` or `MsgCancelOrder`). If `msgs` consist of multiple clob messages,
-// or a mix of on-chain and clob messages, an error is returned.
+// (`MsgPlaceOrder`, `MsgCancelOrder`, `MsgBatchPlaceOrders`, `MsgBatchCancel`, or `MsgReplaceOrder`).
+// If `msgs` consist of multiple clob messages, or a mix of on-chain and clob messages, an error is returned.
 func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	msgs := tx.GetMsgs()
 	var hasMessage = false
 
 	for _, msg := range msgs {
 		switch msg.(type) {
-		case *types.MsgCancelOrder, *types.MsgPlaceOrder:
+		case
+			*types.MsgCancelOrder,
+			*types.MsgPlaceOrder,
+			*types.MsgBatchPlaceOrders,
+			*types.MsgBatchCancel,
+			*types.MsgReplaceOrder:
 			hasMessage = true
 		}
 
@@ -173,7 +306,8 @@ func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	if numMsgs > 1 {
 		return false, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"a transaction containing MsgCancelOrder or MsgPlaceOrder may not contain more than one message",
+			"a transaction containing MsgCancelOrder, MsgPlaceOrder, MsgBatchPlaceOrders, MsgBatchCancel, or "+
+				"MsgReplaceOrder may not contain more than one message",
 		)
 	}
 
@@ -181,8 +315,9 @@ func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 }
 
 // IsShortTermClobMsgTx returns `true` if the supplied `tx` consist of a single clob message
-// (`MsgPlaceOrder` or `MsgCancelOrder`) which references a Short-Term Order. If `msgs` consist of multiple
-// clob messages, or a mix of on-chain and clob messages, an error is returned.
+// (`MsgPlaceOrder`, `MsgCancelOrder`, or `MsgReplaceOrder`) which references a Short-Term Order, or a single
+// `MsgBatchPlaceOrders` or `MsgBatchCancel` message which only reference Short-Term orders. If `msgs` consist
+// of multiple clob messages, or a mix of on-chain and clob messages, an error is returned.
 func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	msgs := tx.GetMsgs()
 
@@ -202,6 +337,17 @@ func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 					isShortTermOrder = true
 				}
 			}
+		case *types.MsgReplaceOrder:
+			{
+				if msg.IsShortTermReplacement() {
+					isShortTermOrder = true
+				}
+			}
+		case *types.MsgBatchPlaceOrders, *types.MsgBatchCancel:
+			{
+				// Batch messages may only reference Short-Term orders.
+				isShortTermOrder = true
+			}
 		}
 
 		if isShortTermOrder {
@@ -217,7 +363,8 @@ func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	if numMsgs > 1 {
 		return false, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"a transaction containing MsgCancelOrder or MsgPlaceOrder may not contain more than one message",
+			"a transaction containing MsgCancelOrder, MsgPlaceOrder, MsgBatchPlaceOrders, MsgBatchCancel, or "+
+				"MsgReplaceOrder may not contain more than one message",
 		)
 	}
 
@@ -50,6 +50,7 @@ func runTestCase(t *testing.T, tc TestCase) {
 	// Setup AnteHandler.
 	mockClobKeeper := &mocks.ClobKeeper{}
 	mockClobKeeper.On("Logger", mock.Anything).Return(log.NewNopLogger()).Maybe()
+	mockClobKeeper.On("LockMemClob").Return(func() {}).Maybe()
 	cd := ante.NewClobDecorator(mockClobKeeper)
 	antehandler := sdk.ChainAnteDecorators(cd)
 	if tc.setupMocks != nil {
@@ -205,6 +206,16 @@ func TestClobDecorator_MsgPlaceOrder(t *testing.T) {
 			useWithIsCheckTxContext: true,
 			expectedErr:             sdkerrors.ErrInvalidRequest,
 		},
+		"Fails if there are multiple batch order placements": {
+			msgs:                    []sdk.Msg{constants.Msg_BatchPlaceOrders, constants.Msg_BatchPlaceOrders},
+			useWithIsCheckTxContext: true,
+			expectedErr:             sdkerrors.ErrInvalidRequest,
+		},
+		"Fails if there is a mix of batch and short term orders": {
+			msgs:                    []sdk.Msg{constants.Msg_BatchPlaceOrders, constants.Msg_PlaceOrder},
+			useWithIsCheckTxContext: true,
+			expectedErr:             sdkerrors.ErrInvalidRequest,
+		},
 		"Fails if there are a mix of off-chain and on-chain messages": {
 			msgs:                    []sdk.Msg{constants.Msg_PlaceOrder, constants.Msg_Send},
 			useWithIsCheckTxContext: true,
@@ -536,3 +547,191 @@ func TestClobDecorator_MsgCancelOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestClobDecorator_MsgBatchPlaceOrders(t *testing.T) {
+	tests := map[string]TestCase{
+		"Succeeds if some of the orders in the batch are placed": {
+			msgs: []sdk.Msg{constants.Msg_BatchPlaceOrders},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("BatchPlaceShortTermOrders",
+					ctx,
+					constants.Msg_BatchPlaceOrders,
+				).Return(
+					[]clobtypes.OrderId{constants.Msg_BatchPlaceOrders.Orders[0].OrderId},
+					[]clobtypes.OrderId{constants.Msg_BatchPlaceOrders.Orders[1].OrderId},
+				)
+			},
+			useWithIsCheckTxContext: true,
+		},
+		"Fails if none of the orders in the batch are placed": {
+			msgs: []sdk.Msg{constants.Msg_BatchPlaceOrders},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("BatchPlaceShortTermOrders",
+					ctx,
+					constants.Msg_BatchPlaceOrders,
+				).Return(
+					[]clobtypes.OrderId{},
+					[]clobtypes.OrderId{
+						constants.Msg_BatchPlaceOrders.Orders[0].OrderId,
+						constants.Msg_BatchPlaceOrders.Orders[1].OrderId,
+					},
+				)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             clobtypes.ErrBatchPlaceOrdersFailed,
+		},
+		"BatchPlaceShortTermOrders is not called on keeper during deliver": {
+			msgs: []sdk.Msg{constants.Msg_BatchPlaceOrders},
+		},
+		"BatchPlaceShortTermOrders is not called on keeper and tx is rejected during recheck": {
+			msgs:                      []sdk.Msg{constants.Msg_BatchPlaceOrders},
+			useWithIsRecheckTxContext: true,
+			expectedErr:               clobtypes.ErrBatchMsgRemovedOnRecheck,
+		},
+	}
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runTestCase(t, tc)
+		})
+	}
+}
+
+func TestClobDecorator_MsgBatchCancel(t *testing.T) {
+	tests := map[string]TestCase{
+		"Succeeds if some of the cancellations in the batch succeed": {
+			msgs: []sdk.Msg{constants.Msg_BatchCancel},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("BatchCancelShortTermOrder",
+					ctx,
+					constants.Msg_BatchCancel,
+				).Return(
+					[]clobtypes.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0}}},
+					[]clobtypes.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{1}}},
+				)
+			},
+			useWithIsCheckTxContext: true,
+		},
+		"Fails if none of the cancellations in the batch succeed": {
+			msgs: []sdk.Msg{constants.Msg_BatchCancel},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("BatchCancelShortTermOrder",
+					ctx,
+					constants.Msg_BatchCancel,
+				).Return(
+					[]clobtypes.OrderBatch{},
+					[]clobtypes.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0, 1}}},
+				)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             clobtypes.ErrBatchCancelFailed,
+		},
+		"BatchCancelShortTermOrder is not called on keeper during deliver": {
+			msgs: []sdk.Msg{constants.Msg_BatchCancel},
+		},
+		"BatchCancelShortTermOrder is not called on keeper and tx is rejected during recheck": {
+			msgs:                      []sdk.Msg{constants.Msg_BatchCancel},
+			useWithIsRecheckTxContext: true,
+			expectedErr:               clobtypes.ErrBatchMsgRemovedOnRecheck,
+		},
+	}
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runTestCase(t, tc)
+		})
+	}
+}
+
+func TestClobDecorator_MsgReplaceOrder(t *testing.T) {
+	tests := map[string]TestCase{
+		"Successfully replaces a short term order": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("ReplaceShortTermOrder",
+					ctx,
+					constants.Msg_ReplaceOrder,
+				).Return(
+					satypes.BaseQuantums(0),
+					clobtypes.Success,
+					nil,
+				)
+			},
+			useWithIsCheckTxContext: true,
+		},
+		"Successfully replaces a long term order": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder_LongTerm},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("ReplaceStatefulOrder",
+					ctx,
+					constants.Msg_ReplaceOrder_LongTerm,
+				).Return(
+					nil,
+				)
+			},
+			useWithIsCheckTxContext: true,
+		},
+		"ReplaceShortTermOrder is not called on keeper during deliver": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder},
+		},
+		"ReplaceShortTermOrder is not called on keeper and tx is rejected during re-check": {
+			msgs:                      []sdk.Msg{constants.Msg_ReplaceOrder},
+			useWithIsRecheckTxContext: true,
+			expectedErr:               clobtypes.ErrShortTermReplaceOrderRemovedOnRecheck,
+		},
+		"ReplaceStatefulOrder is called on keeper during re-check": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder_LongTerm},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("ReplaceStatefulOrder",
+					ctx,
+					constants.Msg_ReplaceOrder_LongTerm,
+				).Return(
+					nil,
+				)
+			},
+			useWithIsRecheckTxContext: true,
+		},
+		"Fails if ReplaceShortTermOrder returns an error": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("ReplaceShortTermOrder",
+					ctx,
+					constants.Msg_ReplaceOrder,
+				).Return(
+					satypes.BaseQuantums(0),
+					clobtypes.OrderStatus(0),
+					clobtypes.ErrInvalidReplaceOrder,
+				)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             clobtypes.ErrInvalidReplaceOrder,
+		},
+		"Fails if ReplaceStatefulOrder returns an error": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder_LongTerm},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("ReplaceStatefulOrder",
+					ctx,
+					constants.Msg_ReplaceOrder_LongTerm,
+				).Return(
+					clobtypes.ErrStatefulOrderDoesNotExist,
+				)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             clobtypes.ErrStatefulOrderDoesNotExist,
+		},
+		"Fails if there is a mix of replacements and short term orders": {
+			msgs:                    []sdk.Msg{constants.Msg_ReplaceOrder, constants.Msg_PlaceOrder},
+			useWithIsCheckTxContext: true,
+			expectedErr:             sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runTestCase(t, tc)
+		})
+	}
+}
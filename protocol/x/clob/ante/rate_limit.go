@@ -7,15 +7,18 @@ import (
 
 var _ sdktypes.AnteDecorator = (*ClobRateLimitDecorator)(nil)
 
-// ClobRateLimitDecorator is an AnteDecorator which is responsible for rate limiting MsgCancelOrder and MsgPlaceOrder
-// requests.
+// ClobRateLimitDecorator is an AnteDecorator which is responsible for rate limiting MsgCancelOrder, MsgPlaceOrder,
+// MsgBatchCancel, MsgBatchPlaceOrders, and MsgReplaceOrder requests. Each order in a batch counts towards the rate
+// limits as if it were submitted in its own MsgPlaceOrder or MsgCancelOrder, and an order replacement counts as
+// an order placement.
 //
 // This AnteDecorator is a no-op if:
-//   - No messages in the transaction are `MsgCancelOrder` or `MsgPlaceOrder`.
+//   - No messages in the transaction are `MsgCancelOrder`, `MsgPlaceOrder`, `MsgBatchCancel`,
+//     `MsgBatchPlaceOrders`, or `MsgReplaceOrder`.
 //
 // This AnteDecorator returns an error if:
-//   - The rate limit is exceeded for any `MsgCancelOrder` messages.
-//   - The rate limit is exceeded for any `MsgPlaceOrder` messages.
+//   - The rate limit is exceeded for any `MsgCancelOrder` or `MsgBatchCancel` messages.
+//   - The rate limit is exceeded for any `MsgPlaceOrder`, `MsgBatchPlaceOrders`, or `MsgReplaceOrder` messages.
 //
 // TODO(CLOB-721): Rate limit short term order cancellations.
 type ClobRateLimitDecorator struct {
@@ -43,6 +46,18 @@ func (r ClobRateLimitDecorator) AnteHandle(
 			if err = r.clobKeeper.RateLimitPlaceOrder(ctx, msg); err != nil {
 				return ctx, err
 			}
+		case *types.MsgBatchCancel:
+			if err = r.clobKeeper.RateLimitBatchCancel(ctx, msg); err != nil {
+				return ctx, err
+			}
+		case *types.MsgBatchPlaceOrders:
+			if err = r.clobKeeper.RateLimitBatchPlaceOrders(ctx, msg); err != nil {
+				return ctx, err
+			}
+		case *types.MsgReplaceOrder:
+			if err = r.clobKeeper.RateLimitReplaceOrder(ctx, msg); err != nil {
+				return ctx, err
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
@@ -0,0 +1,47 @@
+package ante
+
+import (
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+var _ sdktypes.AnteDecorator = (*TradingPermissionDecorator)(nil)
+
+// TradingPermissionDecorator is an AnteDecorator which is responsible for ensuring that `MsgPlaceOrder`,
+// `MsgCancelOrder`, `MsgBatchPlaceOrders`, `MsgBatchCancel`, and `MsgReplaceOrder` messages signed by a
+// grantee on behalf of a subaccount owner are covered by a valid trading permission. The grantee is the
+// signer of such messages, so signature verification must happen before this AnteDecorator.
+//
+// Note that this AnteDecorator runs in every execution mode, including `DeliverTx`, so that Short-Term
+// orders included in a block by the proposer are also validated.
+//
+// This AnteDecorator is a no-op if:
+//   - No messages in the transaction are signed by a grantee.
+//
+// This AnteDecorator returns an error if:
+//   - The grantee does not have a trading permission for the subaccount, or the permission has expired.
+//   - Any order placement or cancellation is for a clob pair the trading permission does not cover.
+//   - Any order placed exceeds the maximum order size of the trading permission.
+type TradingPermissionDecorator struct {
+	clobKeeper types.ClobKeeper
+}
+
+func NewTradingPermissionDecorator(clobKeeper types.ClobKeeper) TradingPermissionDecorator {
+	return TradingPermissionDecorator{
+		clobKeeper,
+	}
+}
+
+func (d TradingPermissionDecorator) AnteHandle(
+	ctx sdktypes.Context,
+	tx sdktypes.Tx,
+	simulate bool,
+	next sdktypes.AnteHandler,
+) (newCtx sdktypes.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if err = d.clobKeeper.ValidateMsgTradingPermission(ctx, msg); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
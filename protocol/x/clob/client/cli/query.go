@@ -26,6 +26,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdGetBlockRateLimitConfiguration())
 	cmd.AddCommand(CmdGetEquityTierLimitConfig())
 	cmd.AddCommand(CmdGetLiquidationsConfiguration())
+	cmd.AddCommand(CmdGetMakerUptimeRewardsConfiguration())
+	cmd.AddCommand(CmdGetOrderbookL2())
+	cmd.AddCommand(CmdGetOrderbookL3())
+	cmd.AddCommand(CmdShowTradingPermission())
+	cmd.AddCommand(CmdGetDeleveragingCandidates())
+	cmd.AddCommand(CmdSimulatePlaceOrder())
 
 	return cmd
 }
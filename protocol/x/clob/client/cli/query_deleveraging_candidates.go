@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetDeleveragingCandidates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-deleveraging-candidates",
+		Short: "get the subaccounts pending deleveraging reported by the node's liquidation daemon",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDeleveragingCandidatesRequest{}
+
+			res, err := queryClient.DeleveragingCandidates(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
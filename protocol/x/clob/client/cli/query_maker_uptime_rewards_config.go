@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetMakerUptimeRewardsConfiguration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-maker-uptime-rewards-config",
+		Short: "get the maker uptime rewards configuration",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMakerUptimeRewardsConfigurationRequest{}
+
+			res, err := queryClient.MakerUptimeRewardsConfiguration(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetOrderbookL2() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-orderbook-l2 [clob-pair-id] [depth]",
+		Short: "get the aggregated price levels of the node's orderbook, optionally limited to depth levels per side",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argClobPairId, argDepth, err := parseOrderbookArgs(args)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOrderbookL2Request{
+				ClobPairId: argClobPairId,
+				Depth:      argDepth,
+			}
+
+			res, err := queryClient.OrderbookL2(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetOrderbookL3() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-orderbook-l3 [clob-pair-id] [depth]",
+		Short: "get the price levels and resting orders of the node's orderbook, optionally limited to depth levels per side",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argClobPairId, argDepth, err := parseOrderbookArgs(args)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOrderbookL3Request{
+				ClobPairId: argClobPairId,
+				Depth:      argDepth,
+			}
+
+			res, err := queryClient.OrderbookL3(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseOrderbookArgs parses the clob pair id and optional depth arguments of the orderbook queries.
+func parseOrderbookArgs(args []string) (clobPairId uint32, depth uint32, err error) {
+	clobPairId, err = cast.ToUint32E(args[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(args) > 1 {
+		depth, err = cast.ToUint32E(args[1])
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return clobPairId, depth, nil
+}
@@ -0,0 +1,49 @@
+//go:build all || integration_test
+
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	tmcli "github.com/cometbft/cometbft/libs/cli"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/client/cli"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmdGetOrderbookL2(t *testing.T) {
+	net, objs := networkWithClobPairObjects(t, 2)
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	args := append([]string{strconv.Itoa(int(objs[0].Id)), "10"}, common...)
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdGetOrderbookL2(), args)
+	require.NoError(t, err)
+	var resp types.QueryOrderbookL2Response
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, objs[0].Id, resp.ClobPairId)
+	require.Empty(t, resp.Bids)
+	require.Empty(t, resp.Asks)
+}
+
+func TestCmdGetOrderbookL3(t *testing.T) {
+	net, objs := networkWithClobPairObjects(t, 2)
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	args := append([]string{strconv.Itoa(int(objs[1].Id))}, common...)
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdGetOrderbookL3(), args)
+	require.NoError(t, err)
+	var resp types.QueryOrderbookL3Response
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, objs[1].Id, resp.ClobPairId)
+	require.Empty(t, resp.Bids)
+	require.Empty(t, resp.Asks)
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSimulatePlaceOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-place-order owner number clientId clobPairId side quantums subticks goodTilBlock timeInForce",
+		Short: "simulate placing a Short-Term order against the node's orderbook without modifying state",
+		Args:  cobra.ExactArgs(9),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argClientId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argClobPairId, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argSide, err := cast.ToInt32E(args[4])
+			if err != nil {
+				return err
+			}
+
+			argQuantums, err := cast.ToUint64E(args[5])
+			if err != nil {
+				return err
+			}
+
+			argSubticks, err := cast.ToUint64E(args[6])
+			if err != nil {
+				return err
+			}
+
+			argGoodTilBlock, err := cast.ToUint32E(args[7])
+			if err != nil {
+				return err
+			}
+
+			argTimeInForce, err := cast.ToInt32E(args[8])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySimulatePlaceOrderRequest{
+				Order: types.Order{
+					OrderId: types.OrderId{
+						ClientId: argClientId,
+						SubaccountId: satypes.SubaccountId{
+							Owner:  argOwner,
+							Number: argNumber,
+						},
+						ClobPairId: argClobPairId,
+					},
+					Side:         types.Order_Side(argSide),
+					Quantums:     argQuantums,
+					Subticks:     argSubticks,
+					GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: argGoodTilBlock},
+					TimeInForce:  types.Order_TimeInForce(argTimeInForce),
+				},
+			}
+
+			res, err := queryClient.SimulatePlaceOrder(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
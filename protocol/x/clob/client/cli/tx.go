@@ -27,6 +27,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdPlaceOrder())
 	cmd.AddCommand(CmdCancelOrder())
+	cmd.AddCommand(CmdGrantTradingPermission())
+	cmd.AddCommand(CmdRevokeTradingPermission())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
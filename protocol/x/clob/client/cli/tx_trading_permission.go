@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagClobPairIds      = "clob-pair-ids"
+	flagMaxOrderQuantums = "max-order-quantums"
+	flagExpirationTime   = "expiration-time"
+)
+
+func CmdGrantTradingPermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-trading-permission owner number grantee",
+		Short: "Broadcast message grant_trading_permission",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argGrantee := args[2]
+
+			clobPairIds, err := cmd.Flags().GetUintSlice(flagClobPairIds)
+			if err != nil {
+				return err
+			}
+
+			maxOrderQuantums, err := cmd.Flags().GetUint64(flagMaxOrderQuantums)
+			if err != nil {
+				return err
+			}
+
+			expirationTime, err := cmd.Flags().GetUint32(flagExpirationTime)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			permission := types.TradingPermission{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  argOwner,
+					Number: argNumber,
+				},
+				Grantee:          argGrantee,
+				MaxOrderQuantums: maxOrderQuantums,
+				ExpirationTime:   expirationTime,
+			}
+			for _, clobPairId := range clobPairIds {
+				argClobPairId, err := cast.ToUint32E(clobPairId)
+				if err != nil {
+					return err
+				}
+				permission.ClobPairIds = append(permission.ClobPairIds, argClobPairId)
+			}
+
+			msg := types.NewMsgGrantTradingPermission(permission)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().UintSlice(flagClobPairIds, nil, "Clob pairs the grantee may trade on (default: all clob pairs)")
+	cmd.Flags().Uint64(flagMaxOrderQuantums, 0, "Maximum size in base quantums of each order (default: no limit)")
+	cmd.Flags().Uint32(flagExpirationTime, 0, "Unix timestamp in seconds at which the permission expires (default: never)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevokeTradingPermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-trading-permission owner number grantee",
+		Short: "Broadcast message revoke_trading_permission",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argGrantee := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeTradingPermission(
+				satypes.SubaccountId{
+					Owner:  argOwner,
+					Number: argNumber,
+				},
+				argGrantee,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,117 @@
+package clob_test
+
+import (
+	"testing"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testtx "github.com/dydxprotocol/v4-chain/protocol/testutil/tx"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchPlaceOrdersAndBatchCancel(t *testing.T) {
+	aliceOrder0 := PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.Order
+	aliceOrder1 := MustScaleOrder(
+		clobtypes.Order{
+			OrderId:      clobtypes.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 1, ClobPairId: 0},
+			Side:         clobtypes.Order_SIDE_BUY,
+			Quantums:     5,
+			Subticks:     5,
+			GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+		},
+		testapp.DefaultGenesis(),
+	)
+	batchPlaceOrders := clobtypes.NewMsgBatchPlaceOrders([]clobtypes.Order{aliceOrder0, aliceOrder1})
+	batchCancel := clobtypes.NewMsgBatchCancel(
+		constants.Alice_Num0,
+		[]clobtypes.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0, 1}}},
+		20,
+	)
+	bobOrder := PlaceOrder_Bob_Num0_Id0_Clob0_Sell5_Price10_GTB20
+
+	tests := map[string]struct {
+		cancelBeforeMatch bool
+
+		expectedAliceOrder0FillAmount uint64
+		expectedBobOrderFillAmount    uint64
+	}{
+		"Batch placed order is matched and included in the next block": {
+			expectedAliceOrder0FillAmount: aliceOrder0.GetBaseQuantums().ToUint64(),
+			expectedBobOrderFillAmount:    bobOrder.Order.GetBaseQuantums().ToUint64(),
+		},
+		"Batch cancelled orders are not matched": {
+			cancelBeforeMatch: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+
+			checkTx := testapp.MustMakeCheckTx(
+				ctx,
+				tApp.App,
+				testapp.MustMakeCheckTxOptions{
+					AccAddressForSigning: testtx.MustGetOnlySignerAddress(batchPlaceOrders),
+				},
+				batchPlaceOrders,
+			)
+			resp := tApp.CheckTx(checkTx)
+			require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+
+			if tc.cancelBeforeMatch {
+				checkTx := testapp.MustMakeCheckTx(
+					ctx,
+					tApp.App,
+					testapp.MustMakeCheckTxOptions{
+						AccAddressForSigning: testtx.MustGetOnlySignerAddress(batchCancel),
+					},
+					batchCancel,
+				)
+				resp := tApp.CheckTx(checkTx)
+				require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, bobOrder) {
+				resp := tApp.CheckTx(checkTx)
+				require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+			_, fillAmount, _ := tApp.App.ClobKeeper.GetOrderFillAmount(ctx, aliceOrder0.OrderId)
+			require.Equal(t, tc.expectedAliceOrder0FillAmount, fillAmount.ToUint64())
+			_, fillAmount, _ = tApp.App.ClobKeeper.GetOrderFillAmount(ctx, aliceOrder1.OrderId)
+			require.Zero(t, fillAmount.ToUint64())
+			_, fillAmount, _ = tApp.App.ClobKeeper.GetOrderFillAmount(ctx, bobOrder.Order.OrderId)
+			require.Equal(t, tc.expectedBobOrderFillAmount, fillAmount.ToUint64())
+		})
+	}
+}
+
+func TestBatchPlaceOrders_FailsIfAllOrdersFail(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	batchPlaceOrders := clobtypes.NewMsgBatchPlaceOrders(
+		[]clobtypes.Order{PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.Order},
+	)
+	for i := 0; i < 2; i++ {
+		checkTx := testapp.MustMakeCheckTx(
+			ctx,
+			tApp.App,
+			testapp.MustMakeCheckTxOptions{
+				AccAddressForSigning: testtx.MustGetOnlySignerAddress(batchPlaceOrders),
+			},
+			batchPlaceOrders,
+		)
+		resp := tApp.CheckTx(checkTx)
+		if i == 0 {
+			require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+		} else {
+			require.Equal(t, clobtypes.ErrBatchPlaceOrdersFailed.ABCICode(), resp.Code)
+		}
+	}
+}
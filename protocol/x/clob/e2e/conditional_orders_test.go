@@ -377,6 +377,61 @@ func TestConditionalOrder(t *testing.T) {
 				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999.OrderId: 25_000_000,
 			},
 		},
+		"Conditional order in an order group is triggered and removes the other orders in the group": {
+			subaccounts: []satypes.Subaccount{
+				constants.Alice_Num0_100_000USD,
+				constants.Dave_Num0_500000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1,
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1,
+				constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11,
+			},
+			priceUpdateForFirstBlock: &prices.MsgUpdateMarketPrices{},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 4_999_700_000),
+				},
+			},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1.OrderId: true,
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1.OrderId: false,
+			},
+			expectedTriggered: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1.OrderId: true,
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1.OrderId: false,
+			},
+			expectedOrderFillAmount: map[clobtypes.OrderId]uint64{
+				constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11.OrderId:                                25_000_000,
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1.OrderId: 25_000_000,
+			},
+		},
+		"Long-Term order in an order group is partially matched and removes the other orders in the group": {
+			subaccounts: []satypes.Subaccount{
+				constants.Alice_Num0_100_000USD,
+				constants.Dave_Num0_500000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1,
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1,
+				constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11,
+			},
+			priceUpdateForFirstBlock:  &prices.MsgUpdateMarketPrices{},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1.OrderId:             true,
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1.OrderId: false,
+			},
+			expectedTriggered: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1.OrderId: false,
+			},
+			expectedOrderFillAmount: map[clobtypes.OrderId]uint64{
+				constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11.OrderId:                    25_000_000,
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1.OrderId: 25_000_000,
+			},
+		},
 		"StopLoss/Buy conditional order is placed, triggered, and partially matched": {
 			subaccounts: []satypes.Subaccount{
 				constants.Alice_Num0_100_000USD,
@@ -651,3 +651,93 @@ func TestPlacePerpetualLiquidation_Deleveraging(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleverageSubaccounts_NegativeTnc(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *prices.GenesisState) {
+				*genesisState = constants.TestPricesGenesisState
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *perptypes.GenesisState) {
+				genesisState.Params = constants.PerpetualsGenesisParams
+				genesisState.LiquidityTiers = constants.LiquidityTiers
+				genesisState.Perpetuals = []perptypes.Perpetual{
+					constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					constants.Carl_Num0_1BTC_Short_49999USD,
+					constants.Dave_Num0_1BTC_Long_50000USD,
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *clobtypes.GenesisState) {
+				genesisState.ClobPairs = []clobtypes.ClobPair{constants.ClobPair_Btc}
+				genesisState.LiquidationsConfig = constants.LiquidationsConfig_FillablePrice_Max_Smmr
+				genesisState.EquityTierLimitConfig = clobtypes.EquityTierLimitConfiguration{}
+			},
+		)
+		return genesis
+	}).Build()
+
+	ctx := tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+	// Carl is reported as having negative TNC, but not as liquidatable.
+	_, err := tApp.App.Server.LiquidateSubaccounts(ctx, &api.LiquidateSubaccountsRequest{
+		SubaccountIds:            []satypes.SubaccountId{},
+		NegativeTncSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+	})
+	require.NoError(t, err)
+
+	response, err := tApp.App.ClobKeeper.DeleveragingCandidates(ctx, &clobtypes.QueryDeleveragingCandidatesRequest{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]clobtypes.QueryDeleveragingCandidatesResponse_DeleveragingCandidate{
+			{
+				SubaccountId:  constants.Carl_Num0,
+				NetCollateral: dtypes.NewInt(-1_000_000),
+				PerpetualIds:  []uint32{0},
+			},
+		},
+		response.Candidates,
+	)
+
+	// Carl's position is offset by Dave's position at Carl's bankruptcy price of $49,999.
+	ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+	require.Equal(
+		t,
+		satypes.Subaccount{
+			Id: &constants.Carl_Num0,
+		},
+		tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Carl_Num0),
+	)
+	require.Equal(
+		t,
+		satypes.Subaccount{
+			Id: &constants.Dave_Num0,
+			AssetPositions: []*satypes.AssetPosition{
+				{
+					AssetId:  assettypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(50_000_000_000 + 49_999_000_000),
+				},
+			},
+		},
+		tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Dave_Num0),
+	)
+
+	response, err = tApp.App.ClobKeeper.DeleveragingCandidates(ctx, &clobtypes.QueryDeleveragingCandidatesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Candidates)
+}
@@ -0,0 +1,111 @@
+package clob_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	feetierstypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketFeeScheduleIsHonoredOnFill(t *testing.T) {
+	// A launch period without maker or taker fees on CLOB pair 0.
+	schedule := feetierstypes.MarketFeeSchedule{
+		ClobPairId:            0,
+		EffectiveFrom:         time.Unix(0, 0).UTC(),
+		MakerFeeMultiplierPpm: 0,
+		TakerFeeMultiplierPpm: 0,
+	}
+
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *govtypesv1.GenesisState) {
+				genesisState.Params.VotingPeriod = &testapp.TestVotingPeriod
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	require.NotZero(t, tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Bob_Num0.Owner, true, 0))
+
+	// Set the fee schedule through governance.
+	ctx = testapp.SubmitAndTallyProposal(
+		t,
+		ctx,
+		tApp,
+		[]sdk.Msg{
+			&feetierstypes.MsgSetMarketFeeSchedule{
+				Authority: lib.GovModuleAddress.String(),
+				Schedule:  schedule,
+			},
+		},
+		false,
+		govtypesv1.ProposalStatus_PROPOSAL_STATUS_PASSED,
+	)
+	actualSchedule, found := tApp.App.FeeTiersKeeper.GetMarketFeeSchedule(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, schedule, actualSchedule)
+
+	// Only CLOB pair 0 is affected by the fee schedule.
+	require.Zero(t, tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Bob_Num0.Owner, true, 0))
+	require.Zero(t, tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Alice_Num0.Owner, false, 0))
+	require.NotZero(t, tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Bob_Num0.Owner, true, 1))
+
+	getUsdcPosition := func(id satypes.SubaccountId) *big.Int {
+		subaccount := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, id)
+		return subaccount.GetUsdcPosition()
+	}
+	aliceUsdcBefore := getUsdcPosition(constants.Alice_Num0)
+	bobUsdcBefore := getUsdcPosition(constants.Bob_Num0)
+
+	// Alice places a maker order that Bob's order takes.
+	aliceOrder := MustScaleOrder(
+		clobtypes.Order{
+			OrderId:      clobtypes.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 0, ClobPairId: 0},
+			Side:         clobtypes.Order_SIDE_BUY,
+			Quantums:     500_000,
+			Subticks:     1000,
+			GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+		},
+		testapp.DefaultGenesis(),
+	)
+	bobOrder := MustScaleOrder(
+		clobtypes.Order{
+			OrderId:      clobtypes.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 0, ClobPairId: 0},
+			Side:         clobtypes.Order_SIDE_SELL,
+			Quantums:     500_000,
+			Subticks:     1000,
+			GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+		},
+		testapp.DefaultGenesis(),
+	)
+	for _, order := range []clobtypes.Order{aliceOrder, bobOrder} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, *clobtypes.NewMsgPlaceOrder(order)) {
+			resp := tApp.CheckTx(checkTx)
+			require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+
+	_, fillAmount, _ := tApp.App.ClobKeeper.GetOrderFillAmount(ctx, bobOrder.OrderId)
+	require.Equal(t, bobOrder.Quantums, fillAmount.ToUint64())
+
+	// Neither Alice nor Bob pays a fee, so the quote quantums exchanged equal the notional of the fill.
+	notional := new(big.Int).SetUint64(tApp.App.StatsKeeper.GetUserStats(ctx, constants.Bob_Num0.Owner).TakerNotional)
+	require.Positive(t, notional.Sign())
+	aliceUsdcAfter := getUsdcPosition(constants.Alice_Num0)
+	bobUsdcAfter := getUsdcPosition(constants.Bob_Num0)
+	require.Equal(t, new(big.Int).Neg(notional), new(big.Int).Sub(aliceUsdcAfter, aliceUsdcBefore))
+	require.Equal(t, notional, new(big.Int).Sub(bobUsdcAfter, bobUsdcBefore))
+}
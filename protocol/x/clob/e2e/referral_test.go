@@ -0,0 +1,148 @@
+package clob_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	feetierstypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	statstypes "github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferralRebateIsPaidOnFill(t *testing.T) {
+	referrer := constants.CarlAccAddress
+	referralParams := feetierstypes.ReferralParams{
+		ReferrerRebateSharePpm:     200_000,
+		RefereeTakerFeeDiscountPpm: 100_000,
+	}
+
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *govtypesv1.GenesisState) {
+				genesisState.Params.VotingPeriod = &testapp.TestVotingPeriod
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+
+	// Enable the referral program through governance.
+	ctx = testapp.SubmitAndTallyProposal(
+		t,
+		ctx,
+		tApp,
+		[]sdk.Msg{
+			&feetierstypes.MsgUpdateReferralParams{
+				Authority: lib.GovModuleAddress.String(),
+				Params:    referralParams,
+			},
+		},
+		false,
+		govtypesv1.ProposalStatus_PROPOSAL_STATUS_PASSED,
+	)
+	require.Equal(t, referralParams, tApp.App.FeeTiersKeeper.GetReferralParams(ctx))
+
+	// Carl registers a referral code, and Bob binds to Carl as his referrer.
+	checkTx := testapp.MustMakeCheckTx(
+		ctx,
+		tApp.App,
+		testapp.MustMakeCheckTxOptions{
+			AccAddressForSigning: referrer.String(),
+			Gas:                  constants.TestGasLimit,
+			FeeAmt:               constants.TestFeeCoins_5Cents,
+		},
+		feetierstypes.NewMsgRegisterReferralCode(referrer.String(), "carl"),
+	)
+	resp := tApp.CheckTx(checkTx)
+	require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+
+	checkTx = testapp.MustMakeCheckTx(
+		ctx,
+		tApp.App,
+		testapp.MustMakeCheckTxOptions{
+			AccAddressForSigning: constants.Bob_Num0.Owner,
+			Gas:                  constants.TestGasLimit,
+			FeeAmt:               constants.TestFeeCoins_5Cents,
+		},
+		feetierstypes.NewMsgSetReferrer(constants.Bob_Num0.Owner, "carl"),
+	)
+	resp = tApp.CheckTx(checkTx)
+	require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+
+	actualReferrer, found := tApp.App.FeeTiersKeeper.GetReferrer(ctx, constants.Bob_Num0.Owner)
+	require.True(t, found)
+	require.Equal(t, referrer.String(), actualReferrer)
+
+	// Bob's taker fee rate is discounted.
+	takerFeePpm := tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Bob_Num0.Owner, true, 0)
+	require.Equal(
+		t,
+		int32(int64(tApp.App.FeeTiersKeeper.GetPerpetualFeeParams(ctx).Tiers[0].TakerFeePpm)*9/10),
+		takerFeePpm,
+	)
+
+	referrerBalanceBefore := tApp.App.BankKeeper.GetBalance(ctx, referrer, assettypes.AssetUsdc.Denom)
+
+	// Alice places a maker order that Bob's order takes.
+	aliceOrder := MustScaleOrder(
+		clobtypes.Order{
+			OrderId:      clobtypes.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 0, ClobPairId: 0},
+			Side:         clobtypes.Order_SIDE_BUY,
+			Quantums:     500_000,
+			Subticks:     1000,
+			GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+		},
+		testapp.DefaultGenesis(),
+	)
+	bobOrder := MustScaleOrder(
+		clobtypes.Order{
+			OrderId:      clobtypes.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 0, ClobPairId: 0},
+			Side:         clobtypes.Order_SIDE_SELL,
+			Quantums:     500_000,
+			Subticks:     1000,
+			GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+		},
+		testapp.DefaultGenesis(),
+	)
+	for _, order := range []clobtypes.Order{aliceOrder, bobOrder} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, *clobtypes.NewMsgPlaceOrder(order)) {
+			resp := tApp.CheckTx(checkTx)
+			require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+
+	_, fillAmount, _ := tApp.App.ClobKeeper.GetOrderFillAmount(ctx, bobOrder.OrderId)
+	require.Equal(t, bobOrder.Quantums, fillAmount.ToUint64())
+
+	// The referrer earns their share of the discounted taker fee paid by Bob.
+	notional := tApp.App.StatsKeeper.GetUserStats(ctx, constants.Bob_Num0.Owner).TakerNotional
+	require.NotZero(t, notional)
+	expectedTakerFee := lib.BigIntMulSignedPpm(new(big.Int).SetUint64(notional), takerFeePpm, true)
+	expectedRebate := lib.BigIntMulPpm(expectedTakerFee, referralParams.ReferrerRebateSharePpm)
+	require.Positive(t, expectedRebate.Sign())
+	require.Equal(
+		t,
+		&statstypes.ReferralStats{
+			NumReferees:      1,
+			RefereeTakerFees: expectedTakerFee.Uint64(),
+			RebatesEarned:    expectedRebate.Uint64(),
+		},
+		tApp.App.StatsKeeper.GetReferralStats(ctx, referrer.String()),
+	)
+
+	referrerBalanceAfter := tApp.App.BankKeeper.GetBalance(ctx, referrer, assettypes.AssetUsdc.Denom)
+	require.Equal(t, expectedRebate, referrerBalanceAfter.Amount.Sub(referrerBalanceBefore.Amount).BigInt())
+}
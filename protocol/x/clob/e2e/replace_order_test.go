@@ -0,0 +1,234 @@
+package clob_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testtx "github.com/dydxprotocol/v4-chain/protocol/testutil/tx"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceShortTermOrder(t *testing.T) {
+	aliceOrder0 := PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.Order
+	aliceOrder1 := aliceOrder0
+	aliceOrder1.OrderId.ClientId = 1
+	bobOrder := PlaceOrder_Bob_Num0_Id0_Clob0_Sell5_Price10_GTB20
+
+	tests := map[string]struct {
+		replaceOrder *clobtypes.MsgReplaceOrder
+
+		expectedCheckTxCode           uint32
+		expectedAliceOrder0FillAmount uint64
+		expectedAliceOrder1FillAmount uint64
+	}{
+		"Replacement order is matched instead of the replaced order": {
+			replaceOrder:                  clobtypes.NewMsgReplaceOrder(aliceOrder0.OrderId, aliceOrder1),
+			expectedAliceOrder1FillAmount: aliceOrder1.GetBaseQuantums().ToUint64(),
+		},
+		"Replacing an order that does not exist fails": {
+			replaceOrder: clobtypes.NewMsgReplaceOrder(
+				clobtypes.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 2, ClobPairId: 0},
+				aliceOrder1,
+			),
+			expectedCheckTxCode:           clobtypes.ErrInvalidReplaceOrder.ABCICode(),
+			expectedAliceOrder0FillAmount: aliceOrder0.GetBaseQuantums().ToUint64(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+				ctx,
+				tApp.App,
+				PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20,
+			) {
+				resp := tApp.CheckTx(checkTx)
+				require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+
+			checkTx := testapp.MustMakeCheckTx(
+				ctx,
+				tApp.App,
+				testapp.MustMakeCheckTxOptions{
+					AccAddressForSigning: testtx.MustGetOnlySignerAddress(tc.replaceOrder),
+				},
+				tc.replaceOrder,
+			)
+			resp := tApp.CheckTx(checkTx)
+			require.Equal(t, tc.expectedCheckTxCode, resp.Code, "Unexpected CheckTx response: %+v", resp)
+
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, bobOrder) {
+				resp := tApp.CheckTx(checkTx)
+				require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+			_, fillAmount, _ := tApp.App.ClobKeeper.GetOrderFillAmount(ctx, aliceOrder0.OrderId)
+			require.Equal(t, tc.expectedAliceOrder0FillAmount, fillAmount.ToUint64())
+			_, fillAmount, _ = tApp.App.ClobKeeper.GetOrderFillAmount(ctx, aliceOrder1.OrderId)
+			require.Equal(t, tc.expectedAliceOrder1FillAmount, fillAmount.ToUint64())
+			_, fillAmount, _ = tApp.App.ClobKeeper.GetOrderFillAmount(ctx, bobOrder.Order.OrderId)
+			require.Equal(t, bobOrder.Order.GetBaseQuantums().ToUint64(), fillAmount.ToUint64())
+		})
+	}
+}
+
+func TestReplaceShortTermOrder_OffchainMessages(t *testing.T) {
+	oldOrder := PlaceOrder_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20.Order
+
+	differentIdOrder := oldOrder
+	differentIdOrder.OrderId.ClientId = 1
+
+	reducedSizeOrder := oldOrder
+	// Size must remain a multiple of the ClobPair's StepBaseQuantums.
+	reducedSizeOrder.Quantums = oldOrder.Quantums - 10
+
+	tests := map[string]struct {
+		newOrder clobtypes.Order
+
+		// Off-chain messages other than the replacement message.
+		expectedOtherMessages []msgsender.Message
+	}{
+		"Replaces order with a different order id": {
+			newOrder: differentIdOrder,
+			expectedOtherMessages: []msgsender.Message{
+				off_chain_updates.MustCreateOrderUpdateMessage(nil, differentIdOrder.OrderId, 0),
+			},
+		},
+		"Reduces the size of an order in place": {
+			newOrder: reducedSizeOrder,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msgSender := msgsender.NewIndexerMessageSenderInMemoryCollector()
+			appOpts := map[string]interface{}{
+				indexer.MsgSenderInstanceForTest: msgSender,
+			}
+			tApp := testapp.NewTestAppBuilder(t).WithAppOptions(appOpts).Build()
+			ctx := tApp.InitChain()
+
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+				ctx,
+				tApp.App,
+				PlaceOrder_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20,
+			) {
+				resp := tApp.CheckTx(checkTx)
+				require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+
+			replaceOrder := clobtypes.NewMsgReplaceOrder(oldOrder.OrderId, tc.newOrder)
+			checkTx := testapp.MustMakeCheckTx(
+				ctx,
+				tApp.App,
+				testapp.MustMakeCheckTxOptions{
+					AccAddressForSigning: testtx.MustGetOnlySignerAddress(replaceOrder),
+				},
+				replaceOrder,
+			)
+			msgSender.Clear()
+			resp := tApp.CheckTx(checkTx)
+			require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+
+			// The removal of the replaced order and the placement of the new order are sent as a single
+			// replacement message.
+			txHashHeader := msgsender.MessageHeader{
+				Key:   msgsender.TransactionHashHeaderKey,
+				Value: tmhash.Sum(checkTx.Tx),
+			}
+			expectedMessages := []msgsender.Message{
+				off_chain_updates.MustCreateOrderReplaceMessage(
+					nil,
+					oldOrder.OrderId,
+					tc.newOrder,
+				).AddHeader(txHashHeader),
+			}
+			for _, message := range tc.expectedOtherMessages {
+				expectedMessages = append(expectedMessages, message.AddHeader(txHashHeader))
+			}
+			require.ElementsMatch(t, expectedMessages, msgSender.GetOffchainMessages())
+		})
+	}
+}
+
+func TestReplaceLongTermOrder(t *testing.T) {
+	oldOrder := LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.Order
+
+	differentIdOrder := oldOrder
+	differentIdOrder.OrderId.ClientId = 1
+
+	reducedSizeOrder := oldOrder
+	// Size must remain a multiple of the ClobPair's StepBaseQuantums.
+	reducedSizeOrder.Quantums = oldOrder.Quantums - 10
+
+	tests := map[string]struct {
+		newOrder clobtypes.Order
+	}{
+		"Replaces order with a different order id": {
+			newOrder: differentIdOrder,
+		},
+		"Reduces the size of an order in place": {
+			newOrder: reducedSizeOrder,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+				ctx,
+				tApp.App,
+				LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			) {
+				resp := tApp.CheckTx(checkTx)
+				require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+			oldPlacement, found := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, oldOrder.OrderId)
+			require.True(t, found)
+
+			replaceOrder := clobtypes.NewMsgReplaceOrder(oldOrder.OrderId, tc.newOrder)
+			checkTx := testapp.MustMakeCheckTx(
+				ctx,
+				tApp.App,
+				testapp.MustMakeCheckTxOptions{
+					AccAddressForSigning: testtx.MustGetOnlySignerAddress(replaceOrder),
+				},
+				replaceOrder,
+			)
+			resp := tApp.CheckTx(checkTx)
+			require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+
+			ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+
+			newPlacement, found := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, tc.newOrder.OrderId)
+			require.True(t, found, "Replacement order should be in state")
+			require.Equal(t, tc.newOrder, newPlacement.Order)
+
+			if tc.newOrder.OrderId == oldOrder.OrderId {
+				require.Equal(t, oldPlacement.PlacementIndex, newPlacement.PlacementIndex)
+			} else {
+				_, found = tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, oldOrder.OrderId)
+				require.False(t, found, "Replaced order should be removed from state")
+			}
+
+			orderbookOrder, found := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, tc.newOrder.OrderId)
+			require.True(t, found, "Replacement order should be on the orderbook")
+			require.Equal(t, tc.newOrder.Quantums, orderbookOrder.Quantums)
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package clob_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testtx "github.com/dydxprotocol/v4-chain/protocol/testutil/tx"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	sendingtypes "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpotClobPairMatch(t *testing.T) {
+	var spotClobPair clobtypes.ClobPair
+	msgSender := msgsender.NewIndexerMessageSenderInMemoryCollector()
+	appOpts := map[string]interface{}{
+		indexer.MsgSenderInstanceForTest: msgSender,
+	}
+	tApp := testapp.NewTestAppBuilder(t).WithAppOptions(appOpts).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *assettypes.GenesisState) {
+				genesisState.Assets = []assettypes.Asset{
+					*constants.Usdc,
+					*constants.BtcUsd,
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *clobtypes.GenesisState) {
+				spotClobPair = constants.ClobPair_Spot_Btc_Usdc
+				spotClobPair.Id = uint32(len(genesisState.ClobPairs))
+				genesisState.ClobPairs = append(genesisState.ClobPairs, spotClobPair)
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: &constants.Alice_Num0,
+						AssetPositions: []*satypes.AssetPosition{
+							&constants.Usdc_Asset_100_000,
+						},
+					},
+					{
+						Id: &constants.Bob_Num0,
+						AssetPositions: []*satypes.AssetPosition{
+							&constants.Usdc_Asset_100_000,
+							{
+								AssetId:  constants.BtcUsd.Id,
+								Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+							},
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+
+	// Alice buys 0.1 BTC from Bob at $20,000 per BTC.
+	aliceBuy := clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId:      clobtypes.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 0, ClobPairId: spotClobPair.Id},
+		Side:         clobtypes.Order_SIDE_BUY,
+		Quantums:     10_000_000,
+		Subticks:     20_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+	bobSell := clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId:      clobtypes.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 0, ClobPairId: spotClobPair.Id},
+		Side:         clobtypes.Order_SIDE_SELL,
+		Quantums:     10_000_000,
+		Subticks:     20_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+	// Alice only owns the 0.1 BTC bought from Bob, so she is unable to sell 0.2 BTC.
+	aliceSell := clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId:      clobtypes.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 1, ClobPairId: spotClobPair.Id},
+		Side:         clobtypes.Order_SIDE_SELL,
+		Quantums:     20_000_000,
+		Subticks:     25_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, *aliceBuy) {
+		resp := tApp.CheckTx(checkTx)
+		require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, *bobSell) {
+		resp := tApp.CheckTx(checkTx)
+		require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, *aliceSell) {
+		resp := tApp.CheckTx(checkTx)
+		require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	// The sell order fails the collateralization check and is not added to the orderbook.
+	_, found := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, aliceSell.Order.OrderId)
+	require.False(t, found)
+
+	// Clear the messages produced prior to the block containing the match.
+	msgSender.Clear()
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+	// $2,000 of USDC is exchanged for 0.1 BTC. Alice is the maker and Bob is the taker.
+	bigFillQuoteQuantums := big.NewInt(2_000_000_000)
+	makerFee := lib.BigIntMulSignedPpm(
+		bigFillQuoteQuantums,
+		tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Alice_Num0.Owner, false, spotClobPair.Id),
+		true,
+	)
+	takerFee := lib.BigIntMulSignedPpm(
+		bigFillQuoteQuantums,
+		tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Bob_Num0.Owner, true, spotClobPair.Id),
+		true,
+	)
+	initialUsdc := constants.Usdc_Asset_100_000.GetBigQuantums()
+
+	alice := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Alice_Num0)
+	require.Equal(
+		t,
+		[]*satypes.AssetPosition{
+			{
+				AssetId: assettypes.AssetUsdc.Id,
+				Quantums: dtypes.NewIntFromBigInt(
+					new(big.Int).Sub(new(big.Int).Sub(initialUsdc, bigFillQuoteQuantums), makerFee),
+				),
+			},
+			{
+				AssetId:  constants.BtcUsd.Id,
+				Quantums: dtypes.NewInt(10_000_000),
+			},
+		},
+		alice.AssetPositions,
+	)
+	require.Empty(t, alice.PerpetualPositions)
+
+	bob := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Bob_Num0)
+	require.Equal(
+		t,
+		[]*satypes.AssetPosition{
+			{
+				AssetId: assettypes.AssetUsdc.Id,
+				Quantums: dtypes.NewIntFromBigInt(
+					new(big.Int).Sub(new(big.Int).Add(initialUsdc, bigFillQuoteQuantums), takerFee),
+				),
+			},
+			{
+				AssetId:  constants.BtcUsd.Id,
+				Quantums: dtypes.NewInt(90_000_000),
+			},
+		},
+		bob.AssetPositions,
+	)
+	require.Empty(t, bob.PerpetualPositions)
+
+	// The fill and the resulting base and quote asset position updates are sent to the indexer.
+	var events []*indexer_manager.IndexerTendermintEvent
+	for _, message := range msgSender.GetOnchainMessages() {
+		var block indexer_manager.IndexerTendermintBlock
+		require.NoError(t, block.Unmarshal(message.Value))
+		events = append(events, block.Events...)
+	}
+	eventBytesBySubtype := make(map[string][][]byte)
+	for _, event := range events {
+		eventBytesBySubtype[event.Subtype] = append(eventBytesBySubtype[event.Subtype], event.DataBytes)
+	}
+	require.ElementsMatch(
+		t,
+		[][]byte{
+			indexer_manager.GetBytes(
+				indexerevents.NewOrderFillEvent(
+					aliceBuy.Order,
+					bobSell.Order,
+					satypes.BaseQuantums(10_000_000),
+					makerFee.Int64(),
+					takerFee.Int64(),
+					satypes.BaseQuantums(10_000_000),
+					satypes.BaseQuantums(10_000_000),
+				),
+			),
+		},
+		eventBytesBySubtype[indexerevents.SubtypeOrderFill],
+	)
+	require.ElementsMatch(
+		t,
+		[][]byte{
+			indexer_manager.GetBytes(
+				indexerevents.NewSubaccountUpdateEvent(&constants.Alice_Num0, nil, alice.AssetPositions, nil),
+			),
+			indexer_manager.GetBytes(
+				indexerevents.NewSubaccountUpdateEvent(&constants.Bob_Num0, nil, bob.AssetPositions, nil),
+			),
+		},
+		eventBytesBySubtype[indexerevents.SubtypeSubaccountUpdate],
+	)
+}
+
+func TestSpotClobPairMatch_FundedByDeposit(t *testing.T) {
+	var spotClobPair clobtypes.ClobPair
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *assettypes.GenesisState) {
+				genesisState.Assets = []assettypes.Asset{
+					*constants.Usdc,
+					*constants.BtcUsd,
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *clobtypes.GenesisState) {
+				spotClobPair = constants.ClobPair_Spot_Btc_Usdc
+				spotClobPair.Id = uint32(len(genesisState.ClobPairs))
+				genesisState.ClobPairs = append(genesisState.ClobPairs, spotClobPair)
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *banktypes.GenesisState) {
+				// Bob owns 1 BTC outside of his subaccounts.
+				genesisState.Balances = append(genesisState.Balances, banktypes.Balance{
+					Address: constants.BobAccAddress.String(),
+					Coins: []sdk.Coin{
+						sdk.NewCoin(constants.BtcUsd.Denom, sdkmath.NewInt(100_000_000)),
+					},
+				})
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: &constants.Alice_Num0,
+						AssetPositions: []*satypes.AssetPosition{
+							&constants.Usdc_Asset_100_000,
+						},
+					},
+					{
+						Id: &constants.Bob_Num0,
+						AssetPositions: []*satypes.AssetPosition{
+							&constants.Usdc_Asset_100_000,
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+
+	deliverSendingMsg := func(msg sdk.Msg, blockHeight uint32) sdk.Context {
+		checkTx := testapp.MustMakeCheckTx(
+			ctx,
+			tApp.App,
+			testapp.MustMakeCheckTxOptions{
+				AccAddressForSigning: testtx.MustGetOnlySignerAddress(msg),
+				Gas:                  100_000,
+				FeeAmt:               constants.TestFeeCoins_5Cents,
+			},
+			msg,
+		)
+		resp := tApp.CheckTx(checkTx)
+		require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+		return tApp.AdvanceToBlock(blockHeight, testapp.AdvanceToBlockOptions{})
+	}
+
+	// Bob deposits 0.1 BTC into his subaccount.
+	ctx = deliverSendingMsg(
+		&sendingtypes.MsgDepositToSubaccount{
+			Sender:    constants.BobAccAddress.String(),
+			Recipient: constants.Bob_Num0,
+			AssetId:   constants.BtcUsd.Id,
+			Quantums:  10_000_000,
+		},
+		2,
+	)
+	require.Equal(
+		t,
+		sdk.NewCoin(constants.BtcUsd.Denom, sdkmath.NewInt(90_000_000)),
+		tApp.App.BankKeeper.GetBalance(ctx, constants.BobAccAddress, constants.BtcUsd.Denom),
+	)
+
+	// Alice buys the deposited 0.1 BTC from Bob at $20,000 per BTC.
+	aliceBuy := clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId:      clobtypes.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 0, ClobPairId: spotClobPair.Id},
+		Side:         clobtypes.Order_SIDE_BUY,
+		Quantums:     10_000_000,
+		Subticks:     20_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+	bobSell := clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId:      clobtypes.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 0, ClobPairId: spotClobPair.Id},
+		Side:         clobtypes.Order_SIDE_SELL,
+		Quantums:     10_000_000,
+		Subticks:     20_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+	for _, msg := range []clobtypes.MsgPlaceOrder{*aliceBuy, *bobSell} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, msg) {
+			resp := tApp.CheckTx(checkTx)
+			require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+	ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+
+	alice := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Alice_Num0)
+	aliceBtc, exists := alice.GetAssetPositionForId(constants.BtcUsd.Id)
+	require.True(t, exists)
+	require.Equal(t, dtypes.NewInt(10_000_000), aliceBtc.Quantums)
+	bob := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Bob_Num0)
+	_, exists = bob.GetAssetPositionForId(constants.BtcUsd.Id)
+	require.False(t, exists)
+
+	// Alice withdraws the bought BTC from her subaccount.
+	ctx = deliverSendingMsg(
+		&sendingtypes.MsgWithdrawFromSubaccount{
+			Sender:    constants.Alice_Num0,
+			Recipient: constants.AliceAccAddress.String(),
+			AssetId:   constants.BtcUsd.Id,
+			Quantums:  10_000_000,
+		},
+		4,
+	)
+	require.Equal(
+		t,
+		sdk.NewCoin(constants.BtcUsd.Denom, sdkmath.NewInt(10_000_000)),
+		tApp.App.BankKeeper.GetBalance(ctx, constants.AliceAccAddress, constants.BtcUsd.Denom),
+	)
+	alice = tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Alice_Num0)
+	_, exists = alice.GetAssetPositionForId(constants.BtcUsd.Id)
+	require.False(t, exists)
+	require.True(
+		t,
+		tApp.App.BankKeeper.GetBalance(ctx, satypes.ModuleAddress, constants.BtcUsd.Denom).IsZero(),
+	)
+}
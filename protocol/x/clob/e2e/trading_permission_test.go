@@ -0,0 +1,148 @@
+package clob_test
+
+import (
+	"testing"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	sendingtypes "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceOrderWithTradingPermission(t *testing.T) {
+	grantee := constants.CarlAccAddress.String()
+	aliceOrder := PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.Order
+	bobOrder := PlaceOrder_Bob_Num0_Id0_Clob0_Sell5_Price10_GTB20
+
+	tests := map[string]struct {
+		permission *clobtypes.TradingPermission
+
+		expectedCheckTxCode     uint32
+		expectedAliceFillAmount uint64
+		expectedBobFillAmount   uint64
+	}{
+		"Grantee without a trading permission cannot place orders": {
+			expectedCheckTxCode: clobtypes.ErrTradingPermissionNotFound.ABCICode(),
+		},
+		"Grantee cannot place orders on clob pairs not covered by the trading permission": {
+			permission: &clobtypes.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      grantee,
+				ClobPairIds:  []uint32{1},
+			},
+			expectedCheckTxCode: clobtypes.ErrTradingPermissionClobPairNotAllowed.ABCICode(),
+		},
+		"Grantee cannot place orders larger than the maximum order size": {
+			permission: &clobtypes.TradingPermission{
+				SubaccountId:     constants.Alice_Num0,
+				Grantee:          grantee,
+				MaxOrderQuantums: aliceOrder.Quantums - 1,
+			},
+			expectedCheckTxCode: clobtypes.ErrTradingPermissionOrderSizeExceeded.ABCICode(),
+		},
+		"Order placed by grantee is matched": {
+			permission: &clobtypes.TradingPermission{
+				SubaccountId:     constants.Alice_Num0,
+				Grantee:          grantee,
+				ClobPairIds:      []uint32{0},
+				MaxOrderQuantums: aliceOrder.Quantums,
+			},
+			expectedAliceFillAmount: aliceOrder.GetBaseQuantums().ToUint64(),
+			expectedBobFillAmount:   bobOrder.Order.GetBaseQuantums().ToUint64(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+
+			if tc.permission != nil {
+				checkTx := testapp.MustMakeCheckTx(
+					ctx,
+					tApp.App,
+					testapp.MustMakeCheckTxOptions{
+						AccAddressForSigning: constants.Alice_Num0.Owner,
+						Gas:                  constants.TestGasLimit,
+						FeeAmt:               constants.TestFeeCoins_5Cents,
+					},
+					clobtypes.NewMsgGrantTradingPermission(*tc.permission),
+				)
+				resp := tApp.CheckTx(checkTx)
+				require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+				ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+				permission, found := tApp.App.ClobKeeper.GetTradingPermission(ctx, constants.Alice_Num0, grantee)
+				require.True(t, found)
+				require.Equal(t, *tc.permission, permission)
+			}
+
+			placeOrder := &clobtypes.MsgPlaceOrder{Order: aliceOrder, Grantee: grantee}
+			checkTx := testapp.MustMakeCheckTx(
+				ctx,
+				tApp.App,
+				testapp.MustMakeCheckTxOptions{
+					AccAddressForSigning: grantee,
+				},
+				placeOrder,
+			)
+			resp := tApp.CheckTx(checkTx)
+			require.Equal(t, tc.expectedCheckTxCode, resp.Code, "Unexpected CheckTx response: %+v", resp)
+
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, bobOrder) {
+				resp := tApp.CheckTx(checkTx)
+				require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+
+			ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+
+			_, fillAmount, _ := tApp.App.ClobKeeper.GetOrderFillAmount(ctx, aliceOrder.OrderId)
+			require.Equal(t, tc.expectedAliceFillAmount, fillAmount.ToUint64())
+			_, fillAmount, _ = tApp.App.ClobKeeper.GetOrderFillAmount(ctx, bobOrder.Order.OrderId)
+			require.Equal(t, tc.expectedBobFillAmount, fillAmount.ToUint64())
+		})
+	}
+}
+
+func TestGranteeCannotWithdrawFromSubaccount(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	grantee := constants.CarlAccAddress.String()
+
+	checkTx := testapp.MustMakeCheckTx(
+		ctx,
+		tApp.App,
+		testapp.MustMakeCheckTxOptions{
+			AccAddressForSigning: constants.Alice_Num0.Owner,
+			Gas:                  constants.TestGasLimit,
+			FeeAmt:               constants.TestFeeCoins_5Cents,
+		},
+		clobtypes.NewMsgGrantTradingPermission(clobtypes.TradingPermission{
+			SubaccountId: constants.Alice_Num0,
+			Grantee:      grantee,
+		}),
+	)
+	resp := tApp.CheckTx(checkTx)
+	require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+	// Withdrawals must be signed by the subaccount owner, so a transaction signed by the grantee is rejected.
+	checkTx = testapp.MustMakeCheckTx(
+		ctx,
+		tApp.App,
+		testapp.MustMakeCheckTxOptions{
+			AccAddressForSigning: grantee,
+			Gas:                  constants.TestGasLimit,
+			FeeAmt:               constants.TestFeeCoins_5Cents,
+		},
+		sendingtypes.NewMsgWithdrawFromSubaccount(
+			constants.Alice_Num0,
+			constants.CarlAccAddress.String(),
+			constants.Usdc.Id,
+			1,
+		),
+	)
+	resp = tApp.CheckTx(checkTx)
+	require.False(t, resp.IsOK(), "Expected CheckTx to fail. Response: %+v", resp)
+}
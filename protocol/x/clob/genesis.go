@@ -15,19 +15,34 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 
 	// Create all `ClobPair` structs.
 	for _, elem := range genState.ClobPairs {
-		perpetualId, err := elem.GetPerpetualId()
-		if err != nil {
-			panic(errorsmod.Wrap(types.ErrInvalidClobPairParameter, err.Error()))
+		var err error
+		switch metadata := elem.Metadata.(type) {
+		case *types.ClobPair_SpotClobMetadata:
+			_, err = k.CreateSpotClobPair(
+				ctx,
+				elem.Id,
+				metadata.SpotClobMetadata.BaseAssetId,
+				metadata.SpotClobMetadata.QuoteAssetId,
+				satypes.BaseQuantums(elem.StepBaseQuantums),
+				elem.QuantumConversionExponent,
+				elem.SubticksPerTick,
+				elem.Status,
+			)
+		default:
+			perpetualId, perpErr := elem.GetPerpetualId()
+			if perpErr != nil {
+				panic(errorsmod.Wrap(types.ErrInvalidClobPairParameter, perpErr.Error()))
+			}
+			_, err = k.CreatePerpetualClobPair(
+				ctx,
+				elem.Id,
+				perpetualId,
+				satypes.BaseQuantums(elem.StepBaseQuantums),
+				elem.QuantumConversionExponent,
+				elem.SubticksPerTick,
+				elem.Status,
+			)
 		}
-		_, err = k.CreatePerpetualClobPair(
-			ctx,
-			elem.Id,
-			perpetualId,
-			satypes.BaseQuantums(elem.StepBaseQuantums),
-			elem.QuantumConversionExponent,
-			elem.SubticksPerTick,
-			elem.Status,
-		)
 		if err != nil {
 			panic(err)
 		}
@@ -46,6 +61,13 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 
+	// Write the trading permissions to state, which must reference the `ClobPair`s created above.
+	for _, permission := range genState.TradingPermissions {
+		if err := k.InitializeTradingPermission(ctx, permission); err != nil {
+			panic(err)
+		}
+	}
+
 	k.InitializeProcessProposerMatchesEvents(ctx)
 }
 
@@ -65,5 +87,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Read the equity tier limit configuration from state.
 	genesis.EquityTierLimitConfig = k.GetEquityTierLimitConfiguration(ctx)
 
+	// Read the trading permissions from state.
+	genesis.TradingPermissions = k.GetAllTradingPermissions(ctx)
+
 	return genesis
 }
@@ -2,6 +2,7 @@ package clob_test
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"fmt"
 	"testing"
 
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
@@ -23,6 +24,18 @@ import (
 )
 
 func TestGenesis(t *testing.T) {
+	spotClobPairWithNonUsdcQuote := types.ClobPair{
+		Metadata: &types.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &types.SpotClobMetadata{
+				BaseAssetId:  0,
+				QuoteAssetId: 1,
+			},
+		},
+		Id:               uint32(0),
+		StepBaseQuantums: 5,
+		SubticksPerTick:  5,
+		Status:           types.ClobPair_STATUS_ACTIVE,
+	}
 	tests := map[string]struct {
 		// Genesis state.
 		genesis types.GenesisState
@@ -144,6 +157,16 @@ func TestGenesis(t *testing.T) {
 					PositionBlockLimits:   constants.PositionBlockLimits_Default,
 					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
 				},
+				TradingPermissions: []types.TradingPermission{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						Grantee:          constants.BobAccAddress.String(),
+						ClobPairIds:      []uint32{1},
+						MaxOrderQuantums: 100,
+						// Expired permissions are imported as-is.
+						ExpirationTime: 1,
+					},
+				},
 			},
 		},
 		"Genesis state is valid when bankruptcy adjustment ppm is greater than one million": {
@@ -207,21 +230,10 @@ func TestGenesis(t *testing.T) {
 			expectedErr:     "Asset orders are not implemented",
 			expectedErrType: types.ErrInvalidClobPairParameter,
 		},
-		"Genesis state is invalid when there is a spot metadata on a CLOB pair": {
+		"Genesis state is invalid when a spot CLOB pair is not quoted in USDC": {
 			genesis: types.GenesisState{
 				ClobPairs: []types.ClobPair{
-					{
-						Metadata: &types.ClobPair_SpotClobMetadata{
-							SpotClobMetadata: &types.SpotClobMetadata{
-								BaseAssetId:  0,
-								QuoteAssetId: 1,
-							},
-						},
-						Id:               uint32(0),
-						StepBaseQuantums: 5,
-						SubticksPerTick:  5,
-						Status:           types.ClobPair_STATUS_ACTIVE,
-					},
+					spotClobPairWithNonUsdcQuote,
 					{
 						Metadata: &types.ClobPair_PerpetualClobMetadata{
 							PerpetualClobMetadata: &types.PerpetualClobMetadata{
@@ -244,7 +256,10 @@ func TestGenesis(t *testing.T) {
 					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
 				},
 			},
-			expectedErr:     "Asset orders are not implemented",
+			expectedErr: fmt.Sprintf(
+				"spot CLOB pair (%+v) must have USDC as the quote asset",
+				&spotClobPairWithNonUsdcQuote,
+			),
 			expectedErrType: types.ErrInvalidClobPairParameter,
 		},
 		"Genesis state is invalid when spread to maintenance margin ratio ppm is 0": {
@@ -422,6 +437,20 @@ func TestGenesis(t *testing.T) {
 				"{UsdTncRequired:-1 Limit:0}",
 			expectedErrType: types.ErrInvalidEquityTierLimitConfig,
 		},
+		"Genesis state is invalid when a trading permission references a CLOB pair that does not exist": {
+			genesis: types.GenesisState{
+				LiquidationsConfig: types.LiquidationsConfig_Default,
+				TradingPermissions: []types.TradingPermission{
+					{
+						SubaccountId: constants.Alice_Num0,
+						Grantee:      constants.BobAccAddress.String(),
+						ClobPairIds:  []uint32{1},
+					},
+				},
+			},
+			expectedErr:     "clob pair 1 does not exist",
+			expectedErrType: types.ErrInvalidTradingPermission,
+		},
 	}
 
 	for name, tc := range tests {
@@ -485,6 +514,7 @@ func TestGenesis(t *testing.T) {
 			require.Equal(t, tc.genesis.LiquidationsConfig, got.LiquidationsConfig)
 			require.Equal(t, tc.genesis.BlockRateLimitConfig, got.BlockRateLimitConfig)
 			require.Equal(t, tc.genesis.EquityTierLimitConfig, got.EquityTierLimitConfig)
+			require.Equal(t, tc.genesis.TradingPermissions, got.TradingPermissions)
 		})
 	}
 }
@@ -110,10 +110,60 @@ func (k Keeper) CreatePerpetualClobPair(
 	return clobPair, nil
 }
 
+// CreateSpotClobPair creates a new spot CLOB pair in the store, trading `baseAssetId` against `quoteAssetId`.
+// Additionally, it creates an order book matching the ID of the newly created CLOB pair.
+//
+// An error will occur if any of the fields fail validation (see validateClobPair for details).
+// In the event of an error, the store will not be updated nor will a matching order book be created.
+//
+// Returns the newly created CLOB pair and an error if one occurs.
+func (k Keeper) CreateSpotClobPair(
+	ctx sdk.Context,
+	clobPairId uint32,
+	baseAssetId uint32,
+	quoteAssetId uint32,
+	stepSizeBaseQuantums satypes.BaseQuantums,
+	quantumConversionExponent int32,
+	subticksPerTick uint32,
+	status types.ClobPair_Status,
+) (types.ClobPair, error) {
+	// If the desired CLOB pair ID is already in use, return an error.
+	if clobPair, exists := k.GetClobPair(ctx, types.ClobPairId(clobPairId)); exists {
+		return types.ClobPair{}, errorsmod.Wrapf(
+			types.ErrClobPairAlreadyExists,
+			"id=%v, existing clob pair=%v",
+			clobPairId,
+			clobPair,
+		)
+	}
+
+	clobPair := types.ClobPair{
+		Metadata: &types.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &types.SpotClobMetadata{
+				BaseAssetId:  baseAssetId,
+				QuoteAssetId: quoteAssetId,
+			},
+		},
+		Id:                        clobPairId,
+		StepBaseQuantums:          stepSizeBaseQuantums.ToUint64(),
+		QuantumConversionExponent: quantumConversionExponent,
+		SubticksPerTick:           subticksPerTick,
+		Status:                    status,
+	}
+	if err := k.validateClobPair(ctx, &clobPair); err != nil {
+		return clobPair, err
+	}
+
+	k.createClobPair(ctx, clobPair)
+
+	return clobPair, nil
+}
+
 // validateClobPair validates a CLOB pair's fields are suitable for CLOB pair creation.
 //
 // Stateful Validation:
-//   - Must be a perpetual CLOB pair with a perpetualId matching a perpetual in the store.
+//   - Perpetual CLOB pairs must have a perpetualId matching a perpetual in the store.
+//   - Spot CLOB pairs must have a quote asset in the store, and a base asset in the store which has a market.
 //
 // Stateless Validation
 //   - `clobPair.Validate()` returns no error.
@@ -122,8 +172,7 @@ func (k Keeper) validateClobPair(ctx sdk.Context, clobPair *types.ClobPair) erro
 		return err
 	}
 
-	// TODO(DEC-1535): update this validation when we implement "spot"/"asset" clob pairs.
-	switch clobPair.Metadata.(type) {
+	switch metadata := clobPair.Metadata.(type) {
 	case *types.ClobPair_PerpetualClobMetadata:
 		perpetualId, err := clobPair.GetPerpetualId()
 		if err != nil {
@@ -141,11 +190,26 @@ func (k Keeper) validateClobPair(ctx sdk.Context, clobPair *types.ClobPair) erro
 				clobPair,
 			)
 		}
+	case *types.ClobPair_SpotClobMetadata:
+		// Validate the quote asset exists, and the base asset exists and has a market for oracle prices.
+		if _, exists := k.assetsKeeper.GetAsset(ctx, metadata.SpotClobMetadata.QuoteAssetId); !exists {
+			return errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) has invalid quote asset.",
+				clobPair,
+			)
+		}
+		if _, _, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, metadata.SpotClobMetadata.BaseAssetId); err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"CLOB pair (%+v) has invalid base asset.",
+				clobPair,
+			)
+		}
 	default:
 		return errorsmod.Wrapf(
 			types.ErrInvalidClobPairParameter,
-			// TODO(DEC-1535): update this error message when we implement "spot"/"asset" clob pairs.
-			"CLOB pair (%+v) is not a perpetual CLOB.",
+			"CLOB pair (%+v) has unknown metadata.",
 			clobPair,
 		)
 	}
@@ -174,6 +238,9 @@ func (k Keeper) createClobPair(ctx sdk.Context, clobPair types.ClobPair) {
 	// Write the `ClobPair` to state.
 	k.setClobPair(ctx, clobPair)
 
+	unlock := k.LockMemClob()
+	defer unlock()
+
 	// Create the corresponding orderbook in the memclob.
 	k.createOrderbook(ctx, clobPair)
 
@@ -425,27 +492,36 @@ func (k Keeper) UpdateClobPair(
 		)
 	}
 
-	// Note, only perpetual clob pairs are currently supported. Neither the old nor the
-	// new clob pair should be spot.
-	perpetualId, err := clobPair.GetPerpetualId()
-	if err != nil {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			err.Error(),
-		)
-	}
-	oldPerpetualId, err := oldClobPair.GetPerpetualId()
-	if err != nil {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			err.Error(),
-		)
-	}
-	if perpetualId != oldPerpetualId {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			"UpdateClobPair: cannot update ClobPair perpetual id",
-		)
+	// The market traded by the ClobPair cannot be updated.
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		oldSpotClobMetadata := oldClobPair.GetSpotClobMetadata()
+		if oldSpotClobMetadata == nil || *oldSpotClobMetadata != *spotClobMetadata {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				"UpdateClobPair: cannot update ClobPair spot assets",
+			)
+		}
+	} else {
+		perpetualId, err := clobPair.GetPerpetualId()
+		if err != nil {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				err.Error(),
+			)
+		}
+		oldPerpetualId, err := oldClobPair.GetPerpetualId()
+		if err != nil {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				err.Error(),
+			)
+		}
+		if perpetualId != oldPerpetualId {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				"UpdateClobPair: cannot update ClobPair perpetual id",
+			)
+		}
 	}
 	if clobPair.StepBaseQuantums != oldClobPair.StepBaseQuantums {
 		return errorsmod.Wrapf(
@@ -616,3 +692,17 @@ func (k Keeper) IsPerpetualClobPairActive(
 
 	return clobPair.Status == types.ClobPair_STATUS_ACTIVE, nil
 }
+
+// IsSpotClobPairBaseAsset returns true if the provided asset is the base asset of any spot CLOB pair.
+func (k Keeper) IsSpotClobPairBaseAsset(
+	ctx sdk.Context,
+	assetId uint32,
+) bool {
+	for _, clobPair := range k.GetAllClobPairs(ctx) {
+		if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil &&
+			spotClobMetadata.BaseAssetId == assetId {
+			return true
+		}
+	}
+	return false
+}
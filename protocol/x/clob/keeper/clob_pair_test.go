@@ -19,6 +19,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/nullify"
 	perptest "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
 	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals"
@@ -318,6 +319,141 @@ func TestCreatePerpetualClobPair(t *testing.T) {
 	}
 }
 
+func TestCreateSpotClobPair(t *testing.T) {
+	tests := map[string]struct {
+		// CLOB pair.
+		clobPair types.ClobPair
+
+		// Expectations.
+		expectedErr string
+	}{
+		"CLOB pair is valid": {
+			clobPair: constants.ClobPair_Spot_Btc_Usdc,
+		},
+		"CLOB pair is invalid when the base asset does not exist": {
+			clobPair: *clobtest.GenerateClobPair(clobtest.WithSpotMetadata(
+				&types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  1000000,
+						QuoteAssetId: assettypes.AssetUsdc.Id,
+					},
+				},
+			)),
+			expectedErr: "has invalid base asset.",
+		},
+		"CLOB pair is invalid when the base asset has no market": {
+			clobPair: *clobtest.GenerateClobPair(clobtest.WithSpotMetadata(
+				&types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  2,
+						QuoteAssetId: assettypes.AssetUsdc.Id,
+					},
+				},
+			)),
+			expectedErr: "Asset has no market",
+		},
+		"CLOB pair is invalid when the quote asset is not USDC": {
+			clobPair: *clobtest.GenerateClobPair(clobtest.WithSpotMetadata(
+				&types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  assettypes.AssetUsdc.Id,
+						QuoteAssetId: constants.BtcUsd.Id,
+					},
+				},
+			)),
+			expectedErr: "must have USDC as the quote asset",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Boilerplate setup.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+			_, err := ks.AssetsKeeper.CreateAsset(
+				ks.Ctx,
+				constants.BtcUsd.Id,
+				constants.BtcUsd.Symbol,
+				constants.BtcUsd.Denom,
+				constants.BtcUsd.DenomExponent,
+				constants.BtcUsd.HasMarket,
+				constants.BtcUsd.MarketId,
+				constants.BtcUsd.AtomicResolution,
+			)
+			require.NoError(t, err)
+			_, err = ks.AssetsKeeper.CreateAsset(ks.Ctx, 2, "NOMARKET", "nomarket-denom", -6, false, 0, -6)
+			require.NoError(t, err)
+
+			// Perform the method under test. No indexer events are expected for spot CLOB pairs.
+			spotClobMetadata := tc.clobPair.GetSpotClobMetadata()
+			createdClobPair, actualErr := ks.ClobKeeper.CreateSpotClobPair(
+				ks.Ctx,
+				tc.clobPair.Id,
+				spotClobMetadata.BaseAssetId,
+				spotClobMetadata.QuoteAssetId,
+				satypes.BaseQuantums(tc.clobPair.StepBaseQuantums),
+				tc.clobPair.QuantumConversionExponent,
+				tc.clobPair.SubticksPerTick,
+				tc.clobPair.Status,
+			)
+			storedClobPair, found := ks.ClobKeeper.GetClobPair(ks.Ctx, types.ClobPairId(tc.clobPair.Id))
+
+			if tc.expectedErr == "" {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.clobPair, createdClobPair)
+				require.True(t, found)
+				require.Equal(t, tc.clobPair, storedClobPair)
+
+				// Spot CLOB pairs are not associated with any perpetual.
+				require.Empty(t, ks.ClobKeeper.PerpetualIdToClobPairId)
+			} else {
+				require.ErrorContains(t, actualErr, tc.expectedErr)
+				require.False(t, found)
+			}
+		})
+	}
+}
+
+func TestIsSpotClobPairBaseAsset(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+	require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+	_, err := ks.AssetsKeeper.CreateAsset(
+		ks.Ctx,
+		constants.BtcUsd.Id,
+		constants.BtcUsd.Symbol,
+		constants.BtcUsd.Denom,
+		constants.BtcUsd.DenomExponent,
+		constants.BtcUsd.HasMarket,
+		constants.BtcUsd.MarketId,
+		constants.BtcUsd.AtomicResolution,
+	)
+	require.NoError(t, err)
+
+	require.False(t, ks.ClobKeeper.IsSpotClobPairBaseAsset(ks.Ctx, constants.BtcUsd.Id))
+
+	clobPair := constants.ClobPair_Spot_Btc_Usdc
+	spotClobMetadata := clobPair.GetSpotClobMetadata()
+	_, err = ks.ClobKeeper.CreateSpotClobPair(
+		ks.Ctx,
+		clobPair.Id,
+		spotClobMetadata.BaseAssetId,
+		spotClobMetadata.QuoteAssetId,
+		satypes.BaseQuantums(clobPair.StepBaseQuantums),
+		clobPair.QuantumConversionExponent,
+		clobPair.SubticksPerTick,
+		clobPair.Status,
+	)
+	require.NoError(t, err)
+
+	require.True(t, ks.ClobKeeper.IsSpotClobPairBaseAsset(ks.Ctx, constants.BtcUsd.Id))
+	require.False(t, ks.ClobKeeper.IsSpotClobPairBaseAsset(ks.Ctx, assettypes.AssetUsdc.Id))
+}
+
 func TestCreateMultipleClobPairs(t *testing.T) {
 	type CreationExpectation struct {
 		// CLOB pair.
@@ -962,7 +1098,37 @@ func TestClobPairValidate(t *testing.T) {
 				SubticksPerTick:  1,
 				Status:           types.ClobPair_STATUS_ACTIVE,
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "must have USDC as the quote asset",
+		},
+		{
+			desc: "Invalid SpotClobMetadata (base asset is quote asset)",
+			clobPair: types.ClobPair{
+				Metadata: &types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  assettypes.AssetUsdc.Id,
+						QuoteAssetId: assettypes.AssetUsdc.Id,
+					},
+				},
+				StepBaseQuantums: 1,
+				SubticksPerTick:  1,
+				Status:           types.ClobPair_STATUS_ACTIVE,
+			},
+			expectedErr: "must have different base and quote assets",
+		},
+		{
+			desc: "Valid spot ClobPair",
+			clobPair: types.ClobPair{
+				Metadata: &types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  1,
+						QuoteAssetId: assettypes.AssetUsdc.Id,
+					},
+				},
+				StepBaseQuantums: 1,
+				SubticksPerTick:  1,
+				Status:           types.ClobPair_STATUS_ACTIVE,
+			},
+			expectedErr: "",
 		},
 		{
 			desc: "Unsupported Status",
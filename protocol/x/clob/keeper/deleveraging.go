@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -20,7 +21,8 @@ import (
 
 // MaybeDeleverageSubaccount is the main entry point to deleverage a subaccount. It attempts to find positions
 // on the opposite side of deltaQuantums and use them to offset the liquidated subaccount's position at
-// the bankruptcy price of the liquidated position.
+// the bankruptcy price of the liquidated position. An isolated subaccount is only deleveraged in the
+// perpetual it is isolated to.
 // Note that the full position size will get deleveraged.
 func (k Keeper) MaybeDeleverageSubaccount(
 	ctx sdk.Context,
@@ -50,6 +52,14 @@ func (k Keeper) MaybeDeleverageSubaccount(
 
 	// Deleverage the entire position for the given perpetual id.
 	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	if !subaccount.CanHoldPerpetualPosition(perpetualId) {
+		return new(big.Int), errorsmod.Wrapf(
+			satypes.ErrViolatesIsolatedMargin,
+			"cannot deleverage subaccount %+v in perpetual %d",
+			subaccountId,
+			perpetualId,
+		)
+	}
 	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
 	if !exists {
 		// Early return to skip deleveraging if the subaccount does not have an open position for the perpetual.
@@ -110,6 +120,82 @@ func (k Keeper) MaybeDeleverageSubaccount(
 	return quantumsDeleveraged, err
 }
 
+// DeleverageSubaccounts takes a list of subaccount IDs with negative total net collateral and
+// proactively deleverages all of their positions against offsetting positions. Subaccounts are
+// processed in the given order, and at most `MaxDeleveragingAttemptsPerBlock` subaccounts are
+// deleveraged. Subaccounts that no longer have negative total net collateral are skipped.
+// Deleveraging is best-effort, so errors are logged and the remaining subaccounts are still processed.
+func (k Keeper) DeleverageSubaccounts(
+	ctx sdk.Context,
+	subaccountIds []satypes.SubaccountId,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	gometrics.AddSample(
+		[]string{
+			metrics.Deleveraging,
+			metrics.NegativeTncSubaccountIds,
+			metrics.Count,
+		},
+		float32(len(subaccountIds)),
+	)
+
+	// Early return if there are 0 subaccounts to deleverage.
+	if len(subaccountIds) == 0 {
+		return
+	}
+
+	defer telemetry.MeasureSince(
+		time.Now(),
+		types.ModuleName,
+		metrics.ClobDeleverageSubaccounts,
+		metrics.Latency,
+	)
+
+	numSubaccountsDeleveraged := uint32(0)
+	for _, subaccountId := range subaccountIds {
+		if numSubaccountsDeleveraged >= k.Flags.MaxDeleveragingAttemptsPerBlock {
+			break
+		}
+
+		// Subaccount might not have negative total net collateral anymore since the liquidation daemon
+		// runs in a separate goroutine, or since it was liquidated earlier in the block.
+		canPerformDeleveraging, err := k.CanDeleverageSubaccount(ctx, subaccountId)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"Failed to check if subaccount can be deleveraged.",
+				"subaccount", subaccountId,
+				"error", err,
+			)
+			continue
+		}
+		if !canPerformDeleveraging {
+			continue
+		}
+		numSubaccountsDeleveraged++
+
+		subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+		for _, position := range subaccount.PerpetualPositions {
+			if _, err := k.MaybeDeleverageSubaccount(ctx, subaccountId, position.PerpetualId); err != nil {
+				k.Logger(ctx).Error(
+					"Failed to deleverage subaccount.",
+					"subaccount", subaccountId,
+					"perpetualId", position.PerpetualId,
+					"error", err,
+				)
+				break
+			}
+		}
+	}
+
+	telemetry.SetGauge(
+		float32(numSubaccountsDeleveraged),
+		types.ModuleName,
+		metrics.ClobDeleverageSubaccounts,
+		metrics.NumSubaccountsDeleveraged,
+	)
+}
+
 // GetInsuranceFundBalance returns the current balance of the insurance fund (in quote quantums).
 // This calls the Bank Keeper’s GetBalance() function for the Module Address of the insurance fund.
 func (k Keeper) GetInsuranceFundBalance(
@@ -178,8 +264,13 @@ func (k Keeper) IsValidInsuranceFundDelta(
 	return new(big.Int).Add(currentInsuranceFundBalance, insuranceFundDelta).Sign() >= 0
 }
 
-// OffsetSubaccountPerpetualPosition iterates over all subaccounts and use those with positions
+// OffsetSubaccountPerpetualPosition iterates over subaccounts and use those with positions
 // on the opposite side to offset the liquidated subaccount's position by `deltaQuantumsTotal`.
+// Offsetting positions are collected until they are large enough to offset the remaining position,
+// and are then ranked by size, i.e. the largest collected offsetting positions are used first so
+// that as few subaccounts as possible are deleveraged. Positions of equal size are used in the
+// pseudo-random order in which they were iterated. Iteration stops as soon as the position is fully
+// offset, or after `MaxDeleveragingSubaccountsToIterate` subaccounts were iterated.
 //
 // This function returns the fills that were processed and the remaining amount to offset.
 // Note that each deleveraging fill is being processed _optimistically_, and the state transitions are
@@ -203,31 +294,28 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 	numSubaccountsIterated := uint32(0)
 	numSubaccountsWithNonOverlappingBankruptcyPrices := uint32(0)
 	numSubaccountsWithNoOpenPositionOnOppositeSide := uint32(0)
+	numOffsettingCandidates := 0
 	deltaQuantumsRemaining = new(big.Int).Set(deltaQuantumsTotal)
 	fills = make([]types.MatchPerpetualDeleveraging_Fill, 0)
 
-	k.subaccountsKeeper.ForEachSubaccountRandomStart(
-		ctx,
-		func(offsettingSubaccount satypes.Subaccount) (finished bool) {
-			// Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
-			if numSubaccountsIterated >= k.Flags.MaxDeleveragingSubaccountsToIterate {
-				return true
-			}
-
-			numSubaccountsIterated++
-			offsettingPosition, _ := offsettingSubaccount.GetPerpetualPositionForId(perpetualId)
-			bigOffsettingPositionQuantums := offsettingPosition.GetBigQuantums()
-
-			// Skip subaccounts that do not have a position in the opposite direction as the liquidated subaccount.
-			if deltaQuantumsRemaining.Sign() != bigOffsettingPositionQuantums.Sign() {
-				numSubaccountsWithNoOpenPositionOnOppositeSide++
-				return false
+	// Subaccounts with positions on the opposite side of the liquidated subaccount that were collected
+	// but not yet used to offset the liquidated subaccount's position, and their total position size.
+	offsettingCandidates := make([]offsettingCandidate, 0)
+	bigOffsettingCandidatesQuantums := new(big.Int)
+	offsetCandidates := func() {
+		// Rank offsetting positions by size, largest first.
+		sort.SliceStable(offsettingCandidates, func(i, j int) bool {
+			return offsettingCandidates[i].positionQuantums.CmpAbs(offsettingCandidates[j].positionQuantums) > 0
+		})
+		for _, candidate := range offsettingCandidates {
+			if deltaQuantumsRemaining.Sign() == 0 {
+				break
 			}
 
 			// TODO(DEC-1495): Determine max amount to offset per offsetting subaccount.
 			var deltaQuantums *big.Int
-			if deltaQuantumsRemaining.CmpAbs(bigOffsettingPositionQuantums) > 0 {
-				deltaQuantums = new(big.Int).Set(bigOffsettingPositionQuantums)
+			if deltaQuantumsRemaining.CmpAbs(candidate.positionQuantums) > 0 {
+				deltaQuantums = new(big.Int).Set(candidate.positionQuantums)
 			} else {
 				deltaQuantums = new(big.Int).Set(deltaQuantumsRemaining)
 			}
@@ -236,7 +324,7 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 			if err := k.ProcessDeleveraging(
 				ctx,
 				liquidatedSubaccountId,
-				*offsettingSubaccount.Id,
+				candidate.subaccountId,
 				perpetualId,
 				deltaQuantums,
 			); err == nil {
@@ -246,7 +334,7 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 					deltaQuantums,
 				)
 				fills = append(fills, types.MatchPerpetualDeleveraging_Fill{
-					OffsettingSubaccountId: *offsettingSubaccount.Id,
+					OffsettingSubaccountId: candidate.subaccountId,
 					FillAmount:             new(big.Int).Abs(deltaQuantums).Uint64(),
 				})
 			} else if errors.Is(err, types.ErrInvalidPerpetualPositionSizeDelta) {
@@ -260,7 +348,7 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 				// If an error is returned, it's likely because the subaccounts' bankruptcy prices do not overlap.
 				// TODO(CLOB-75): Support deleveraging subaccounts with non overlapping bankruptcy prices.
 				liquidatedSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, liquidatedSubaccountId)
-				offsettingSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, *offsettingSubaccount.Id)
+				offsettingSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, candidate.subaccountId)
 				k.Logger(ctx).Debug(
 					"Encountered error when processing deleveraging",
 					"error", err,
@@ -273,11 +361,50 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 				)
 				numSubaccountsWithNonOverlappingBankruptcyPrices++
 			}
+		}
+		offsettingCandidates = offsettingCandidates[:0]
+		bigOffsettingCandidatesQuantums = new(big.Int)
+	}
+
+	k.subaccountsKeeper.ForEachSubaccountRandomStart(
+		ctx,
+		func(offsettingSubaccount satypes.Subaccount) (finished bool) {
+			// Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
+			if numSubaccountsIterated >= k.Flags.MaxDeleveragingSubaccountsToIterate {
+				return true
+			}
+
+			numSubaccountsIterated++
+			offsettingPosition, _ := offsettingSubaccount.GetPerpetualPositionForId(perpetualId)
+			bigOffsettingPositionQuantums := offsettingPosition.GetBigQuantums()
+
+			// Skip subaccounts that do not have a position in the opposite direction as the liquidated subaccount.
+			if deltaQuantumsRemaining.Sign() != bigOffsettingPositionQuantums.Sign() {
+				numSubaccountsWithNoOpenPositionOnOppositeSide++
+				return false
+			}
+
+			offsettingCandidates = append(offsettingCandidates, offsettingCandidate{
+				subaccountId:     *offsettingSubaccount.Id,
+				positionQuantums: bigOffsettingPositionQuantums,
+			})
+			numOffsettingCandidates++
+			bigOffsettingCandidatesQuantums.Add(bigOffsettingCandidatesQuantums, bigOffsettingPositionQuantums)
+
+			// Offset the position once the collected offsetting positions are large enough, and continue
+			// iterating only if some of them could not be used.
+			if bigOffsettingCandidatesQuantums.CmpAbs(deltaQuantumsRemaining) < 0 {
+				return false
+			}
+			offsetCandidates()
 			return deltaQuantumsRemaining.Sign() == 0
 		},
 		k.GetPseudoRand(ctx),
 	)
 
+	// Use the remaining collected offsetting positions if iteration stopped before they were used.
+	offsetCandidates()
+
 	labels := []gometrics.Label{
 		metrics.GetLabelForIntValue(metrics.PerpetualId, int(perpetualId)),
 	}
@@ -302,9 +429,22 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 		float32(numSubaccountsWithNoOpenPositionOnOppositeSide),
 		labels,
 	)
+	gometrics.AddSampleWithLabels(
+		[]string{
+			types.ModuleName, metrics.Deleveraging, metrics.OffsettingSubaccountCandidates, metrics.Count,
+		},
+		float32(numOffsettingCandidates),
+		labels,
+	)
 	return fills, deltaQuantumsRemaining
 }
 
+// offsettingCandidate is a subaccount with a position that can be used to offset a deleveraged position.
+type offsettingCandidate struct {
+	subaccountId     satypes.SubaccountId
+	positionQuantums *big.Int
+}
+
 // ProcessDeleveraging processes a deleveraging operation by closing both the liquidated subaccount's
 // position and the offsetting subaccount's position at the bankruptcy price of the _liquidated_ position.
 // This function takes a `deltaQuantums` argument, which is the delta with respect to the liquidated subaccount's
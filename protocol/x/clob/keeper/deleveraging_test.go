@@ -461,6 +461,77 @@ func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 			},
 			expectedQuantumsRemaining: new(big.Int),
 		},
+		"Uses the largest offsetting positions first": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_54999USD,
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(50_000_000), // 0.5 BTC
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(150_000_000), // 1.5 BTC
+						},
+					},
+				},
+			},
+			liquidatedSubaccountId: constants.Carl_Num0,
+			perpetualId:            0,
+			deltaQuantums:          big.NewInt(100_000_000),
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+				},
+				// Dave's smaller position is not used since his larger position can offset the entire position.
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId:  0,
+							Quantums:     dtypes.NewInt(50_000_000),
+							FundingIndex: dtypes.NewInt(0),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num1,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(
+						big.NewInt(50_000_000_000 + 54_999_000_000),
+					),
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId:  0,
+							Quantums:     dtypes.NewInt(50_000_000),
+							FundingIndex: dtypes.NewInt(0),
+						},
+					},
+				},
+			},
+			expectedFills: []types.MatchPerpetualDeleveraging_Fill{
+				{
+					OffsettingSubaccountId: constants.Dave_Num1,
+					FillAmount:             100_000_000,
+				},
+			},
+			expectedQuantumsRemaining: new(big.Int),
+		},
 		"Skips subaccounts with positions on the same side": {
 			subaccounts: []satypes.Subaccount{
 				constants.Carl_Num0_1BTC_Short_54999USD,
@@ -661,6 +732,143 @@ func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 	}
 }
 
+func TestDeleverageSubaccounts(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		subaccounts                     []satypes.Subaccount
+		maxDeleveragingAttemptsPerBlock uint32
+
+		// Parameters.
+		subaccountIds []satypes.SubaccountId
+
+		// Expectations.
+		expectedSubaccounts []satypes.Subaccount
+	}{
+		"Deleverages subaccount with negative TNC": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_49999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			maxDeleveragingAttemptsPerBlock: 10,
+			subaccountIds:                   []satypes.SubaccountId{constants.Carl_Num0},
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+				},
+				{
+					Id: &constants.Dave_Num0,
+					// TNC of deleveraged subaccount is -$1, which means the bankruptcy price
+					// to close 1 BTC short is $49,999 and we close both positions at this price.
+					AssetPositions: keepertest.CreateUsdcAssetPosition(
+						big.NewInt(50_000_000_000 + 49_999_000_000),
+					),
+				},
+			},
+		},
+		"Skips subaccounts with non-negative TNC": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_54999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			maxDeleveragingAttemptsPerBlock: 10,
+			subaccountIds:                   []satypes.SubaccountId{constants.Carl_Num0},
+			expectedSubaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_54999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+		},
+		"Deleverages at most MaxDeleveragingAttemptsPerBlock subaccounts": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_49999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			maxDeleveragingAttemptsPerBlock: 0,
+			subaccountIds:                   []satypes.SubaccountId{constants.Carl_Num0},
+			expectedSubaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_49999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+		},
+		"Continues deleveraging other subaccounts if a subaccount fails": {
+			subaccounts: []satypes.Subaccount{
+				// The ETH perpetual does not exist, so checking if the subaccount can be deleveraged fails.
+				constants.Dave_Num1_1ETH_Long_50000USD,
+				constants.Carl_Num0_1BTC_Short_49999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			maxDeleveragingAttemptsPerBlock: 10,
+			subaccountIds:                   []satypes.SubaccountId{constants.Dave_Num1, constants.Carl_Num0},
+			expectedSubaccounts: []satypes.Subaccount{
+				constants.Dave_Num1_1ETH_Long_50000USD,
+				{
+					Id: &constants.Carl_Num0,
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(
+						big.NewInt(50_000_000_000 + 49_999_000_000),
+					),
+				},
+			},
+		},
+		"Does nothing for empty subaccount ids": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_49999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			maxDeleveragingAttemptsPerBlock: 10,
+			subaccountIds:                   []satypes.SubaccountId{},
+			expectedSubaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_49999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+			ks.ClobKeeper.Flags.MaxDeleveragingAttemptsPerBlock = tc.maxDeleveragingAttemptsPerBlock
+			ctx := ks.Ctx.WithIsCheckTx(true)
+
+			// Create the default markets.
+			keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+
+			// Create liquidity tiers.
+			keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+
+			err := keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper)
+			require.NoError(t, err)
+
+			_, err = ks.PerpetualsKeeper.CreatePerpetual(
+				ctx,
+				constants.BtcUsd_100PercentMarginRequirement.Params.Id,
+				constants.BtcUsd_100PercentMarginRequirement.Params.Ticker,
+				constants.BtcUsd_100PercentMarginRequirement.Params.MarketId,
+				constants.BtcUsd_100PercentMarginRequirement.Params.AtomicResolution,
+				constants.BtcUsd_100PercentMarginRequirement.Params.DefaultFundingPpm,
+				constants.BtcUsd_100PercentMarginRequirement.Params.LiquidityTier,
+			)
+			require.NoError(t, err)
+
+			for _, subaccount := range tc.subaccounts {
+				ks.SubaccountsKeeper.SetSubaccount(ctx, subaccount)
+			}
+
+			ks.BlockTimeKeeper.SetPreviousBlockInfo(ctx, &blocktimetypes.BlockInfo{
+				Timestamp: time.Unix(5, 0),
+			})
+
+			ks.ClobKeeper.DeleverageSubaccounts(ctx, tc.subaccountIds)
+
+			for _, subaccount := range tc.expectedSubaccounts {
+				require.Equal(t, subaccount, ks.SubaccountsKeeper.GetSubaccount(ctx, *subaccount.Id))
+			}
+		})
+	}
+}
+
 func TestProcessDeleveraging(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -24,14 +26,22 @@ func (k Keeper) AreSubaccountsLiquidatable(
 
 	results := make([]types.AreSubaccountsLiquidatableResponse_Result, len(req.SubaccountIds))
 	for i, subaccountId := range req.SubaccountIds {
-		isLiquidatable, err := k.IsLiquidatable(ctx, subaccountId)
+		bigNetCollateral,
+			_,
+			bigMaintenanceMargin,
+			err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+			ctx,
+			satypes.Update{SubaccountId: subaccountId},
+		)
 
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 		results[i] = types.AreSubaccountsLiquidatableResponse_Result{
-			SubaccountId:   subaccountId,
-			IsLiquidatable: isLiquidatable,
+			SubaccountId:      subaccountId,
+			IsLiquidatable:    isLiquidatable(bigNetCollateral, bigMaintenanceMargin),
+			NetCollateral:     dtypes.NewIntFromBigInt(bigNetCollateral),
+			MaintenanceMargin: dtypes.NewIntFromBigInt(bigMaintenanceMargin),
 		}
 	}
 	return &types.AreSubaccountsLiquidatableResponse{
@@ -66,12 +66,16 @@ func TestAreSubaccountsLiquidatable(t *testing.T) {
 			response: &types.AreSubaccountsLiquidatableResponse{
 				Results: []types.AreSubaccountsLiquidatableResponse_Result{
 					{
-						SubaccountId:   constants.Alice_Num0,
-						IsLiquidatable: true,
+						SubaccountId:      constants.Alice_Num0,
+						IsLiquidatable:    true,
+						NetCollateral:     dtypes.NewInt(-490_000_000_000),
+						MaintenanceMargin: dtypes.NewInt(500_000_000_000),
 					},
 					{
-						SubaccountId:   constants.Bob_Num0,
-						IsLiquidatable: false,
+						SubaccountId:      constants.Bob_Num0,
+						IsLiquidatable:    false,
+						NetCollateral:     dtypes.NewInt(60_000_000_000),
+						MaintenanceMargin: dtypes.NewInt(50_000_000_000),
 					},
 				},
 			},
@@ -90,8 +94,10 @@ func TestAreSubaccountsLiquidatable(t *testing.T) {
 			response: &types.AreSubaccountsLiquidatableResponse{
 				Results: []types.AreSubaccountsLiquidatableResponse_Result{
 					{
-						SubaccountId:   constants.Alice_Num0,
-						IsLiquidatable: false,
+						SubaccountId:      constants.Alice_Num0,
+						IsLiquidatable:    false,
+						NetCollateral:     dtypes.NewInt(0),
+						MaintenanceMargin: dtypes.NewInt(0),
 					},
 				},
 			},
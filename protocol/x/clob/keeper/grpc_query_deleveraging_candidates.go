@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleveragingCandidates returns the subaccounts with negative total net collateral reported by the
+// liquidation daemon that still have negative total net collateral as of the latest state.
+func (k Keeper) DeleveragingCandidates(
+	c context.Context,
+	req *types.QueryDeleveragingCandidatesRequest,
+) (*types.QueryDeleveragingCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	candidates := make([]types.QueryDeleveragingCandidatesResponse_DeleveragingCandidate, 0)
+	for _, subaccountId := range k.liquidatableSubaccountIds.GetNegativeTncSubaccountIds() {
+		bigNetCollateral, _, _, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+			ctx,
+			satypes.Update{SubaccountId: subaccountId},
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if bigNetCollateral.Sign() >= 0 {
+			continue
+		}
+
+		subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+		perpetualIds := make([]uint32, 0, len(subaccount.PerpetualPositions))
+		for _, position := range subaccount.PerpetualPositions {
+			perpetualIds = append(perpetualIds, position.PerpetualId)
+		}
+
+		candidates = append(candidates, types.QueryDeleveragingCandidatesResponse_DeleveragingCandidate{
+			SubaccountId:  subaccountId,
+			NetCollateral: dtypes.NewIntFromBigInt(bigNetCollateral),
+			PerpetualIds:  perpetualIds,
+		})
+	}
+
+	return &types.QueryDeleveragingCandidatesResponse{Candidates: candidates}, nil
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleveragingCandidatesQuery(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+	wctx := sdk.WrapSDKContext(ks.Ctx)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryDeleveragingCandidatesRequest
+		response *types.QueryDeleveragingCandidatesResponse
+		err      error
+	}{
+		{
+			desc:    "No candidates reported",
+			request: &types.QueryDeleveragingCandidatesRequest{},
+			response: &types.QueryDeleveragingCandidatesResponse{
+				Candidates: []types.QueryDeleveragingCandidatesResponse_DeleveragingCandidate{},
+			},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := ks.ClobKeeper.DeleveragingCandidates(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MakerUptimeRewardsConfiguration returns the maker uptime rewards configuration.
+func (k Keeper) MakerUptimeRewardsConfiguration(
+	c context.Context,
+	req *types.QueryMakerUptimeRewardsConfigurationRequest,
+) (*types.QueryMakerUptimeRewardsConfigurationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMakerUptimeRewardsConfigurationResponse{
+		MakerUptimeRewardsConfig: k.GetMakerUptimeRewardsConfig(ctx),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderbookL2 returns the aggregated price levels of the in-memory orderbook for a clob pair.
+func (k Keeper) OrderbookL2(
+	c context.Context,
+	req *types.QueryOrderbookL2Request,
+) (
+	*types.QueryOrderbookL2Response,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bids, asks, err := k.getOrderbookLevels(ctx, types.ClobPairId(req.ClobPairId), req.Depth, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrderbookL2Response{
+		ClobPairId: req.ClobPairId,
+		Bids:       bids,
+		Asks:       asks,
+	}, nil
+}
+
+// OrderbookL3 returns the price levels of the in-memory orderbook for a clob pair, along with
+// the individual orders resting on each level.
+func (k Keeper) OrderbookL3(
+	c context.Context,
+	req *types.QueryOrderbookL3Request,
+) (
+	*types.QueryOrderbookL3Response,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bids, asks, err := k.getOrderbookLevels(ctx, types.ClobPairId(req.ClobPairId), req.Depth, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrderbookL3Response{
+		ClobPairId: req.ClobPairId,
+		Bids:       bids,
+		Asks:       asks,
+	}, nil
+}
+
+// getOrderbookLevels returns the price levels of the in-memory orderbook for the given clob pair.
+// Returns a `NotFound` error if the clob pair does not exist.
+func (k Keeper) getOrderbookLevels(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	depth uint32,
+	includeOrders bool,
+) (
+	bids []types.OrderbookLevel,
+	asks []types.OrderbookLevel,
+	err error,
+) {
+	if _, found := k.GetClobPair(ctx, clobPairId); !found {
+		return nil, nil, status.Error(codes.NotFound, "clob pair not found")
+	}
+
+	unlock := k.rLockMemClob()
+	defer unlock()
+
+	bids, asks, exists := k.MemClob.GetOrderbookLevels(ctx, clobPairId, depth, includeOrders)
+	if !exists {
+		return nil, nil, status.Error(codes.NotFound, "orderbook not found")
+	}
+
+	return bids, asks, nil
+}
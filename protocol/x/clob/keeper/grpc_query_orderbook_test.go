@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOrderbookL2AndL3(t *testing.T) {
+	order := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 0, ClobPairId: 0},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     10,
+		Subticks:     10_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+	}
+
+	tests := map[string]struct {
+		orders []types.Order
+
+		l2Req *types.QueryOrderbookL2Request
+		l2Res *types.QueryOrderbookL2Response
+		l3Req *types.QueryOrderbookL3Request
+		l3Res *types.QueryOrderbookL3Response
+		err   error
+	}{
+		"success: empty orderbook": {
+			l2Req: &types.QueryOrderbookL2Request{ClobPairId: 0},
+			l2Res: &types.QueryOrderbookL2Response{
+				ClobPairId: 0,
+				Bids:       []types.OrderbookLevel{},
+				Asks:       []types.OrderbookLevel{},
+			},
+			l3Req: &types.QueryOrderbookL3Request{ClobPairId: 0},
+			l3Res: &types.QueryOrderbookL3Response{
+				ClobPairId: 0,
+				Bids:       []types.OrderbookLevel{},
+				Asks:       []types.OrderbookLevel{},
+			},
+		},
+		"success: orderbook with resting orders": {
+			orders: []types.Order{order},
+			l2Req:  &types.QueryOrderbookL2Request{ClobPairId: 0, Depth: 10},
+			l2Res: &types.QueryOrderbookL2Response{
+				ClobPairId: 0,
+				Bids: []types.OrderbookLevel{
+					{Subticks: 10_000, Quantums: 10, NumOrders: 1},
+				},
+				Asks: []types.OrderbookLevel{},
+			},
+			l3Req: &types.QueryOrderbookL3Request{ClobPairId: 0, Depth: 10},
+			l3Res: &types.QueryOrderbookL3Response{
+				ClobPairId: 0,
+				Bids: []types.OrderbookLevel{
+					{
+						Subticks:  10_000,
+						Quantums:  10,
+						NumOrders: 1,
+						Orders: []types.OrderbookRestingOrder{
+							{
+								Order:             order,
+								RemainingQuantums: 10,
+							},
+						},
+					},
+				},
+				Asks: []types.OrderbookLevel{},
+			},
+		},
+		"failure: clob pair not found": {
+			l2Req: &types.QueryOrderbookL2Request{ClobPairId: 1000},
+			l3Req: &types.QueryOrderbookL3Request{ClobPairId: 1000},
+			err:   status.Error(codes.NotFound, "clob pair not found"),
+		},
+		"failure: nil request": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+
+			for _, order := range tc.orders {
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+					ctx,
+					tApp.App,
+					*types.NewMsgPlaceOrder(order),
+				) {
+					resp := tApp.CheckTx(checkTx)
+					require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+				}
+			}
+
+			l2Res, err := tApp.App.ClobKeeper.OrderbookL2(sdktypes.WrapSDKContext(ctx), tc.l2Req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.l2Res, l2Res)
+			}
+
+			l3Res, err := tApp.App.ClobKeeper.OrderbookL3(sdktypes.WrapSDKContext(ctx), tc.l3Req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.l3Res, l3Res)
+			}
+		})
+	}
+}
+
+func TestOrderbookL2_WaitsForMemClobLock(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	// Hold the memclob lock as an ABCI method modifying the memclob would.
+	unlock := tApp.App.ClobKeeper.LockMemClob()
+
+	done := make(chan error)
+	go func() {
+		_, err := tApp.App.ClobKeeper.OrderbookL2(
+			sdktypes.WrapSDKContext(ctx),
+			&types.QueryOrderbookL2Request{ClobPairId: 0},
+		)
+		done <- err
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Expected the query to wait for the memclob lock")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	unlock()
+	require.NoError(t, <-done)
+}
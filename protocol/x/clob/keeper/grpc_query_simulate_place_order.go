@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulatePlaceOrder simulates placing a Short-Term order against the in-memory orderbook and the latest
+// `checkState`, which is the state the memclob reflects. The simulation runs against a branched context
+// that is never written, and only reads the memclob. Errors from placing the order are returned in the
+// response. The memclob lock is held for reading during the simulation, so that simulations never run
+// concurrently with ABCI methods that modify the memclob but may run concurrently with each other.
+func (k Keeper) SimulatePlaceOrder(
+	c context.Context,
+	req *types.QuerySimulatePlaceOrderRequest,
+) (*types.QuerySimulatePlaceOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !req.Order.IsShortTermOrder() {
+		return nil, status.Error(codes.InvalidArgument, "only Short-Term orders can be simulated")
+	}
+
+	unlock := k.rLockMemClob()
+	defer unlock()
+
+	// Branch the `checkState` so that none of the simulated state changes are persisted, and simulate the
+	// order as if it were placed in CheckTx.
+	checkStateCtx, found := k.getCheckStateContext()
+	if !found {
+		return nil, status.Error(codes.Unavailable, "memclob is not initialized")
+	}
+	ctx, _ := checkStateCtx.CacheContext()
+
+	response := &types.QuerySimulatePlaceOrderResponse{
+		Fills: make([]types.QuerySimulatePlaceOrderResponse_SimulatedFill, 0),
+	}
+
+	matches, filledQuantums, orderStatus, err := k.SimulatePlaceShortTermOrder(ctx, req.Order)
+	if err != nil {
+		response.Error = err.Error()
+	} else {
+		for _, match := range matches {
+			response.Fills = append(response.Fills, types.QuerySimulatePlaceOrderResponse_SimulatedFill{
+				MakerOrderId: match.MakerOrder.MustGetOrder().OrderId,
+				FillAmount:   match.FillAmount.ToUint64(),
+				Subticks:     match.MakerOrder.GetOrderSubticks().ToUint64(),
+				TakerFee:     match.TakerFee,
+				MakerFee:     match.MakerFee,
+			})
+		}
+		response.FilledQuantums = filledQuantums.ToUint64()
+		response.OrderStatus = uint32(orderStatus)
+		response.OrderStatusName = orderStatus.String()
+	}
+
+	response.Subaccount = k.subaccountsKeeper.GetSubaccount(ctx, req.Order.OrderId.SubaccountId)
+	return response, nil
+}
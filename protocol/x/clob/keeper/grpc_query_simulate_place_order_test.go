@@ -0,0 +1,256 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSimulatePlaceOrder(t *testing.T) {
+	makerOrder := constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10
+	takerOrder := constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10
+
+	withTimeInForce := func(order types.Order, timeInForce types.Order_TimeInForce) types.Order {
+		order.TimeInForce = timeInForce
+		return order
+	}
+	withQuantums := func(order types.Order, quantums uint64) types.Order {
+		order.Quantums = quantums
+		return order
+	}
+
+	tests := map[string]struct {
+		restingOrders []types.Order
+		req           *types.QuerySimulatePlaceOrderRequest
+
+		expectedFills                 []types.OrderId
+		expectedFilledQuantums        uint64
+		expectedOrderStatus           types.OrderStatus
+		expectedError                 error
+		expectedPerpetualPositionSize int64
+		err                           error
+	}{
+		"Order fully fills against a resting order": {
+			restingOrders: []types.Order{makerOrder},
+			req:           &types.QuerySimulatePlaceOrderRequest{Order: takerOrder},
+
+			expectedFills:                 []types.OrderId{makerOrder.OrderId},
+			expectedFilledQuantums:        100_000_000,
+			expectedOrderStatus:           types.Success,
+			expectedPerpetualPositionSize: 100_000_000,
+		},
+		"Order would rest on the orderbook": {
+			req: &types.QuerySimulatePlaceOrderRequest{Order: takerOrder},
+
+			expectedOrderStatus: types.Success,
+		},
+		"IOC order would rest on the orderbook": {
+			req: &types.QuerySimulatePlaceOrderRequest{
+				Order: withTimeInForce(takerOrder, types.Order_TIME_IN_FORCE_IOC),
+			},
+
+			expectedOrderStatus: types.ImmediateOrCancelWouldRestOnBook,
+		},
+		"Post-only order would cross a resting order": {
+			restingOrders: []types.Order{makerOrder},
+			req: &types.QuerySimulatePlaceOrderRequest{
+				Order: withTimeInForce(takerOrder, types.Order_TIME_IN_FORCE_POST_ONLY),
+			},
+
+			expectedError: types.ErrPostOnlyWouldCrossMakerOrder,
+		},
+		"FOK order could not be fully filled": {
+			restingOrders: []types.Order{makerOrder},
+			req: &types.QuerySimulatePlaceOrderRequest{
+				Order: withTimeInForce(
+					withQuantums(takerOrder, 200_000_000),
+					types.Order_TIME_IN_FORCE_FILL_OR_KILL,
+				),
+			},
+
+			expectedError: types.ErrFokOrderCouldNotBeFullyFilled,
+		},
+		"Order fails validation": {
+			req: &types.QuerySimulatePlaceOrderRequest{
+				Order: withQuantums(takerOrder, 0),
+			},
+
+			expectedError: types.ErrInvalidOrderQuantums,
+		},
+		"Stateful orders cannot be simulated": {
+			req: &types.QuerySimulatePlaceOrderRequest{
+				Order: constants.LongTermOrder_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10,
+			},
+			err: status.Error(codes.InvalidArgument, "only Short-Term orders can be simulated"),
+		},
+		"Nil request": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+
+			for _, order := range tc.restingOrders {
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+					ctx,
+					tApp.App,
+					*types.NewMsgPlaceOrder(order),
+				) {
+					resp := tApp.CheckTx(checkTx)
+					require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+				}
+			}
+			carl := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Carl_Num0)
+
+			res, err := tApp.App.ClobKeeper.SimulatePlaceOrder(sdktypes.WrapSDKContext(ctx), tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.expectedError != nil {
+				require.Contains(t, res.Error, tc.expectedError.Error())
+				require.Empty(t, res.Fills)
+				require.Equal(t, carl, res.Subaccount)
+			} else {
+				require.Empty(t, res.Error)
+				require.Equal(t, uint32(tc.expectedOrderStatus), res.OrderStatus)
+				require.Equal(t, tc.expectedOrderStatus.String(), res.OrderStatusName)
+				require.Equal(t, tc.expectedFilledQuantums, res.FilledQuantums)
+
+				bigFillQuoteQuantums := big.NewInt(0)
+				expectedFills := make([]types.QuerySimulatePlaceOrderResponse_SimulatedFill, 0)
+				for _, makerOrderId := range tc.expectedFills {
+					// Each maker order is filled for 1 BTC at $50,000.
+					bigFillQuoteQuantums.Add(bigFillQuoteQuantums, big.NewInt(50_000_000_000))
+					expectedFills = append(expectedFills, types.QuerySimulatePlaceOrderResponse_SimulatedFill{
+						MakerOrderId: makerOrderId,
+						FillAmount:   100_000_000,
+						Subticks:     50_000_000_000,
+						TakerFee: lib.BigIntMulSignedPpm(
+							big.NewInt(50_000_000_000),
+							tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Carl_Num0.Owner, true, 0),
+							true,
+						).Int64(),
+						MakerFee: lib.BigIntMulSignedPpm(
+							big.NewInt(50_000_000_000),
+							tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, makerOrderId.SubaccountId.Owner, false, 0),
+							true,
+						).Int64(),
+					})
+				}
+				require.Equal(t, expectedFills, res.Fills)
+
+				if tc.expectedPerpetualPositionSize == 0 {
+					require.Equal(t, carl, res.Subaccount)
+				} else {
+					require.Len(t, res.Subaccount.PerpetualPositions, 1)
+					require.Equal(
+						t,
+						dtypes.NewInt(tc.expectedPerpetualPositionSize),
+						res.Subaccount.PerpetualPositions[0].Quantums,
+					)
+
+					bigExpectedUsdc := new(big.Int).Sub(carl.GetUsdcPosition(), bigFillQuoteQuantums)
+					for _, fill := range expectedFills {
+						bigExpectedUsdc.Sub(bigExpectedUsdc, big.NewInt(fill.TakerFee))
+					}
+					require.Equal(t, bigExpectedUsdc, res.Subaccount.GetUsdcPosition())
+				}
+			}
+
+			// The simulation does not modify state or the memclob.
+			require.Equal(t, carl, tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Carl_Num0))
+			for _, order := range tc.restingOrders {
+				remainingAmount, hasRemainingAmount := tApp.App.ClobKeeper.MemClob.GetOrderRemainingAmount(ctx, order)
+				require.True(t, hasRemainingAmount)
+				require.Equal(t, order.GetBaseQuantums(), remainingAmount)
+			}
+			_, found := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, tc.req.Order.OrderId)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestSimulatePlaceOrder_WaitsForMemClobLock(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	// Hold the memclob lock as an ABCI method modifying the memclob would.
+	unlock := tApp.App.ClobKeeper.LockMemClob()
+
+	done := make(chan error)
+	go func() {
+		_, err := tApp.App.ClobKeeper.SimulatePlaceOrder(
+			sdktypes.WrapSDKContext(ctx),
+			&types.QuerySimulatePlaceOrderRequest{
+				Order: constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10,
+			},
+		)
+		done <- err
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Expected the query to wait for the memclob lock")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	unlock()
+	require.NoError(t, <-done)
+}
+
+func TestSimulatePlaceOrder_SimulatesAgainstCheckState(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	// Partially fill a resting order in CheckTx. The fill amount of the resting order is only written to
+	// the `checkState`, which the memclob reflects.
+	makerOrder := constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10
+	partialTakerOrder := constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10
+	partialTakerOrder.OrderId.SubaccountId = constants.Alice_Num0
+	partialTakerOrder.Quantums = 40_000_000
+	for _, order := range []types.Order{makerOrder, partialTakerOrder} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+			ctx,
+			tApp.App,
+			*types.NewMsgPlaceOrder(order),
+		) {
+			resp := tApp.CheckTx(checkTx)
+			require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+
+	// gRPC queries are served with a context of the latest committed state.
+	queryCtx, err := tApp.App.CreateQueryContext(ctx.BlockHeight(), false)
+	require.NoError(t, err)
+	res, err := tApp.App.ClobKeeper.SimulatePlaceOrder(
+		sdktypes.WrapSDKContext(queryCtx),
+		&types.QuerySimulatePlaceOrderRequest{
+			Order: constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10,
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, res.Error)
+
+	// The order only fills against the remaining size of the resting order, and the rest of the order
+	// would rest on the orderbook.
+	require.Len(t, res.Fills, 1)
+	require.Equal(t, makerOrder.OrderId, res.Fills[0].MakerOrderId)
+	require.Equal(t, uint64(60_000_000), res.Fills[0].FillAmount)
+	require.Equal(t, uint64(60_000_000), res.FilledQuantums)
+	require.Equal(t, uint32(types.Success), res.OrderStatus)
+}
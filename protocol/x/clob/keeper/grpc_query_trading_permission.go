@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TradingPermission(
+	c context.Context,
+	req *types.QueryTradingPermissionRequest,
+) (*types.QueryTradingPermissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	permission, found := k.GetTradingPermission(
+		ctx,
+		satypes.SubaccountId{
+			Owner:  req.Owner,
+			Number: req.Number,
+		},
+		req.Grantee,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryTradingPermissionResponse{Permission: permission}, nil
+}
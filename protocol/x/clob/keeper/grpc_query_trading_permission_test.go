@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTradingPermissionQuery(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+	wctx := sdk.WrapSDKContext(ks.Ctx)
+
+	permission := types.TradingPermission{
+		SubaccountId:     constants.Alice_Num0,
+		Grantee:          constants.BobAccAddress.String(),
+		MaxOrderQuantums: 10,
+	}
+	require.NoError(t, ks.ClobKeeper.SetTradingPermission(ks.Ctx, permission))
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryTradingPermissionRequest
+		response *types.QueryTradingPermissionResponse
+		err      error
+	}{
+		{
+			desc: "Found",
+			request: &types.QueryTradingPermissionRequest{
+				Owner:   constants.Alice_Num0.Owner,
+				Number:  constants.Alice_Num0.Number,
+				Grantee: constants.BobAccAddress.String(),
+			},
+			response: &types.QueryTradingPermissionResponse{Permission: permission},
+		},
+		{
+			desc: "NotFound",
+			request: &types.QueryTradingPermissionRequest{
+				Owner:   constants.Alice_Num0.Owner,
+				Number:  constants.Alice_Num0.Number,
+				Grantee: constants.CarlAccAddress.String(),
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := ks.ClobKeeper.TradingPermission(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
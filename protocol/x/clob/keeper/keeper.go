@@ -3,8 +3,10 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 
+	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/liquidations"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
@@ -29,7 +31,14 @@ type (
 		transientStoreKey storetypes.StoreKey
 		authorities       map[string]struct{}
 
-		MemClob                      types.MemClob
+		MemClob types.MemClob
+		// memClobLock guards the memclob and the keeper's in-memory data structures against gRPC queries,
+		// which are served concurrently with the ABCI methods. The BaseApp serializes the ABCI methods,
+		// so only the ABCI methods that modify the memclob acquire the lock.
+		memClobLock *sync.RWMutex
+		// checkStateCtx is the latest `checkState` context, which the memclob reflects. It is set while the
+		// memclob lock is held for writing and read by gRPC queries simulating against the memclob.
+		checkStateCtx                *atomic.Pointer[sdk.Context]
 		UntriggeredConditionalOrders map[types.ClobPairId]*UntriggeredConditionalOrders
 		PerpetualIdToClobPairId      map[uint32][]types.ClobPairId
 
@@ -43,6 +52,9 @@ type (
 		rewardsKeeper       types.RewardsKeeper
 		indexerEventManager indexer_manager.IndexerEventManager
 
+		// Subaccount ids reported by the liquidation daemon.
+		liquidatableSubaccountIds *liquidationtypes.LiquidatableSubaccountIds
+
 		memStoreInitialized *atomic.Bool
 
 		Flags flags.ClobFlags
@@ -78,6 +90,7 @@ func NewKeeper(
 	statsKeeper types.StatsKeeper,
 	rewardsKeeper types.RewardsKeeper,
 	indexerEventManager indexer_manager.IndexerEventManager,
+	liquidatableSubaccountIds *liquidationtypes.LiquidatableSubaccountIds,
 	txDecoder sdk.TxDecoder,
 	clobFlags flags.ClobFlags,
 	placeOrderRateLimiter rate_limit.RateLimiter[*types.MsgPlaceOrder],
@@ -90,6 +103,8 @@ func NewKeeper(
 		transientStoreKey:            liquidationsStoreKey,
 		authorities:                  lib.UniqueSliceToSet(authorities),
 		MemClob:                      memClob,
+		memClobLock:                  &sync.RWMutex{},
+		checkStateCtx:                &atomic.Pointer[sdk.Context]{},
 		UntriggeredConditionalOrders: make(map[types.ClobPairId]*UntriggeredConditionalOrders),
 		PerpetualIdToClobPairId:      make(map[uint32][]types.ClobPairId),
 		subaccountsKeeper:            subaccountsKeeper,
@@ -101,6 +116,7 @@ func NewKeeper(
 		statsKeeper:                  statsKeeper,
 		rewardsKeeper:                rewardsKeeper,
 		indexerEventManager:          indexerEventManager,
+		liquidatableSubaccountIds:    liquidatableSubaccountIds,
 		memStoreInitialized:          &atomic.Bool{},
 		txDecoder:                    txDecoder,
 		mevTelemetryConfig: MevTelemetryConfig{
@@ -125,6 +141,37 @@ func (k Keeper) HasAuthority(authority string) bool {
 	return ok
 }
 
+// LockMemClob acquires the memclob lock for writing and returns a function that releases it.
+// It must be held by any ABCI method that modifies the memclob.
+func (k Keeper) LockMemClob() (unlock func()) {
+	k.memClobLock.Lock()
+	return k.memClobLock.Unlock
+}
+
+// rLockMemClob acquires the memclob lock for reading and returns a function that releases it.
+// It must be held by any gRPC query that reads the memclob.
+func (k Keeper) rLockMemClob() (unlock func()) {
+	k.memClobLock.RLock()
+	return k.memClobLock.RUnlock
+}
+
+// SetCheckStateContext sets the latest `checkState` context, which gRPC queries simulating against the
+// memclob read state from. It must be called with the memclob lock held for writing whenever the memclob
+// is updated for a new `checkState`.
+func (k Keeper) SetCheckStateContext(ctx sdk.Context) {
+	k.checkStateCtx.Store(&ctx)
+}
+
+// getCheckStateContext returns the latest `checkState` context and whether it was set. It must be called
+// with the memclob lock held.
+func (k Keeper) getCheckStateContext() (ctx sdk.Context, found bool) {
+	checkStateCtx := k.checkStateCtx.Load()
+	if checkStateCtx == nil {
+		return ctx, false
+	}
+	return *checkStateCtx, true
+}
+
 func (k Keeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
 	return k.indexerEventManager
 }
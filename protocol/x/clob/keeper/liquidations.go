@@ -363,11 +363,15 @@ func (k Keeper) IsLiquidatable(
 		return false, err
 	}
 
-	// The subaccount is liquidatable if both of the following are true:
-	// - The maintenance margin requirements are greater than zero (note that they can never be negative).
-	// - The maintenance margin requirements are greater than the subaccount's net collateral.
-	isLiquidatable := bigMaintenanceMargin.Sign() > 0 && bigMaintenanceMargin.Cmp(bigNetCollateral) == 1
-	return isLiquidatable, nil
+	return isLiquidatable(bigNetCollateral, bigMaintenanceMargin), nil
+}
+
+// isLiquidatable returns whether a subaccount with the given net collateral and maintenance margin
+// requirements is liquidatable, which is the case if both of the following are true:
+// - The maintenance margin requirements are greater than zero (note that they can never be negative).
+// - The maintenance margin requirements are greater than the subaccount's net collateral.
+func isLiquidatable(bigNetCollateral *big.Int, bigMaintenanceMargin *big.Int) bool {
+	return bigMaintenanceMargin.Sign() > 0 && bigMaintenanceMargin.Cmp(bigNetCollateral) == 1
 }
 
 // EnsureIsLiquidatable returns an error if the subaccount is not liquidatable.
@@ -738,7 +742,8 @@ func (k Keeper) GetLiquidationInsuranceFundDelta(
 
 // GetPerpetualPositionToLiquidate determines which position to liquidate on the
 // passed-in subaccount (after accounting for the `update`). It will return the perpetual id that
-// will be used for liquidating the perpetual position.
+// will be used for liquidating the perpetual position. An isolated subaccount is only liquidated
+// in the perpetual it is isolated to.
 // This function returns an error if the subaccount has no perpetual positions to liquidate.
 func (k Keeper) GetPerpetualPositionToLiquidate(
 	ctx sdk.Context,
@@ -759,7 +764,8 @@ func (k Keeper) GetPerpetualPositionToLiquidate(
 			// Note that this could run in O(n^2) time. This is fine for now because we have less than a hundred
 			// perpetuals and only liquidate once per subaccount per block. This means that the position with smallest
 			// id will be liquidated first.
-			if !subaccountLiquidationInfo.HasPerpetualBeenLiquidatedForSubaccount(position.PerpetualId) {
+			if subaccount.CanHoldPerpetualPosition(position.PerpetualId) &&
+				!subaccountLiquidationInfo.HasPerpetualBeenLiquidatedForSubaccount(position.PerpetualId) {
 				return position.PerpetualId, nil
 			}
 		}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMakerUptimeRewardsConfig gets the maker uptime rewards config from state. If the config was
+// never set in state, maker uptime rewards are disabled and an empty config is returned.
+func (k Keeper) GetMakerUptimeRewardsConfig(
+	ctx sdk.Context,
+) (config types.MakerUptimeRewardsConfig) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.MakerUptimeRewardsConfigKey))
+	if b == nil {
+		return config
+	}
+
+	k.cdc.MustUnmarshal(b, &config)
+
+	return config
+}
+
+// UpdateMakerUptimeRewardsConfig updates the maker uptime rewards config in state.
+// It returns an error if the provided maker uptime rewards config fails validation.
+func (k Keeper) UpdateMakerUptimeRewardsConfig(
+	ctx sdk.Context,
+	config types.MakerUptimeRewardsConfig,
+) error {
+	// Validate the maker uptime rewards config before writing it to state.
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&config)
+	store.Set([]byte(types.MakerUptimeRewardsConfigKey), b)
+
+	return nil
+}
+
+// GetMakerDepthSamples samples the resting two-sided depth of all subaccounts near the oracle price
+// from the orderbook of this validator, if maker uptime rewards are enabled and the current block
+// height is a multiple of the sample interval. Otherwise, returns nil. This is used by the block
+// proposer to commit the samples of a block in `MsgProposedOperations`.
+//
+// All orders resting on the orderbook are sampled, which includes Short-Term, Long-Term and triggered
+// conditional orders. An order counts towards the depth of its subaccount if its CLOB pair is active
+// and its price is within `MaxSpreadPpm` of the oracle price. CLOB pairs with an oracle price of zero
+// are skipped. The samples are sorted by CLOB pair ID and then by subaccount ID, and only include
+// subaccounts with resting depth on both sides of a CLOB pair.
+func (k Keeper) GetMakerDepthSamples(ctx sdk.Context) []types.MakerDepthSample {
+	config := k.GetMakerUptimeRewardsConfig(ctx)
+	if !config.IsSampledBlock(ctx.BlockHeight()) {
+		return nil
+	}
+
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.SampleMakerUptimeRewards,
+		metrics.Latency,
+	)
+
+	// The resting depth of a subaccount on a CLOB pair, in quote quantums.
+	type makerDepth struct {
+		bids *big.Int
+		asks *big.Int
+	}
+
+	samples := make([]types.MakerDepthSample, 0)
+	bigRatMaxSpread := lib.BigRatMulPpm(lib.BigRat1(), config.MaxSpreadPpm)
+
+	for _, clobPair := range k.GetAllClobPairs(ctx) {
+		if clobPair.Status != types.ClobPair_STATUS_ACTIVE {
+			continue
+		}
+
+		// Skip the CLOB pair if its oracle price is zero, e.g. if its market has not been updated yet.
+		oraclePriceSubticksRat, err := k.getOraclePriceSubticksRat(ctx, clobPair)
+		if err != nil {
+			continue
+		}
+
+		bids, asks, exists := k.MemClob.GetOrderbookLevels(ctx, clobPair.GetClobPairId(), 0, true)
+		if !exists {
+			continue
+		}
+
+		// Only count the orders with a price within the max spread of the oracle price.
+		bigRatSpreadSubticks := new(big.Rat).Mul(oraclePriceSubticksRat, bigRatMaxSpread)
+		minSubticks := lib.BigRatRound(new(big.Rat).Sub(oraclePriceSubticksRat, bigRatSpreadSubticks), true)
+		maxSubticks := lib.BigRatRound(new(big.Rat).Add(oraclePriceSubticksRat, bigRatSpreadSubticks), false)
+
+		depths := make(map[satypes.SubaccountId]makerDepth)
+		for _, side := range []struct {
+			levels []types.OrderbookLevel
+			isBuy  bool
+		}{
+			{levels: bids, isBuy: true},
+			{levels: asks, isBuy: false},
+		} {
+			for _, level := range side.levels {
+				bigSubticks := new(big.Int).SetUint64(level.Subticks)
+				if bigSubticks.Cmp(minSubticks) < 0 || bigSubticks.Cmp(maxSubticks) > 0 {
+					continue
+				}
+
+				for _, restingOrder := range level.Orders {
+					bigQuoteQuantums := types.FillAmountToQuoteQuantums(
+						types.Subticks(level.Subticks),
+						satypes.BaseQuantums(restingOrder.RemainingQuantums),
+						clobPair.QuantumConversionExponent,
+					)
+
+					subaccountId := restingOrder.Order.GetSubaccountId()
+					depth, found := depths[subaccountId]
+					if !found {
+						depth = makerDepth{
+							bids: big.NewInt(0),
+							asks: big.NewInt(0),
+						}
+						depths[subaccountId] = depth
+					}
+					if side.isBuy {
+						depth.bids.Add(depth.bids, bigQuoteQuantums)
+					} else {
+						depth.asks.Add(depth.asks, bigQuoteQuantums)
+					}
+				}
+			}
+		}
+
+		subaccountIds := lib.GetSortedKeys[satypes.SortedSubaccountIds](depths)
+		for _, subaccountId := range subaccountIds {
+			depth := depths[subaccountId]
+			if depth.bids.Sign() <= 0 || depth.asks.Sign() <= 0 {
+				continue
+			}
+
+			samples = append(samples, types.MakerDepthSample{
+				SubaccountId:     subaccountId,
+				ClobPairId:       clobPair.Id,
+				BidQuoteQuantums: lib.BigUint64Clamp(depth.bids, 0, math.MaxUint64),
+				AskQuoteQuantums: lib.BigUint64Clamp(depth.asks, 0, math.MaxUint64),
+			})
+		}
+	}
+
+	return samples
+}
+
+// ProcessMakerDepthSamples accrues reward shares in x/rewards for the maker depth samples committed by
+// the block proposer in `MsgProposedOperations`. The samples are ignored if this block is not sampled
+// for maker uptime rewards, and samples of CLOB pairs which do not exist or are not active are skipped.
+// The owner of each sampled subaccount accrues `RewardWeightPpm` of its two-sided depth as a reward
+// share, where the two-sided depth is the smaller of the quote quantums of its bids and asks.
+func (k Keeper) ProcessMakerDepthSamples(ctx sdk.Context, samples []types.MakerDepthSample) {
+	if len(samples) == 0 {
+		return
+	}
+
+	config := k.GetMakerUptimeRewardsConfig(ctx)
+	if !config.IsSampledBlock(ctx.BlockHeight()) {
+		k.Logger(ctx).Error(
+			"Ignoring maker depth samples proposed in a block which is not sampled.",
+			"numSamples", len(samples),
+		)
+		return
+	}
+
+	for _, sample := range samples {
+		clobPair, found := k.GetClobPair(ctx, types.ClobPairId(sample.ClobPairId))
+		if !found || clobPair.Status != types.ClobPair_STATUS_ACTIVE {
+			continue
+		}
+
+		weight := lib.BigIntMulPpm(
+			new(big.Int).SetUint64(lib.Min(sample.BidQuoteQuantums, sample.AskQuoteQuantums)),
+			config.RewardWeightPpm,
+		)
+		if weight.Sign() <= 0 {
+			continue
+		}
+
+		if err := k.rewardsKeeper.AddRewardShareToAddress(ctx, sample.SubaccountId.Owner, weight); err != nil {
+			k.Logger(ctx).Error(
+				"Failed to add maker uptime reward share to address.",
+				"address", sample.SubaccountId.Owner,
+				"error", err,
+			)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSetMakerUptimeRewardsConfig(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.ClobKeeper
+
+	// Maker uptime rewards are disabled by default.
+	require.Equal(t, types.MakerUptimeRewardsConfig{}, k.GetMakerUptimeRewardsConfig(ctx))
+
+	config := types.MakerUptimeRewardsConfig{
+		SampleIntervalBlocks: 10,
+		MaxSpreadPpm:         5_000,
+		RewardWeightPpm:      100,
+	}
+	require.NoError(t, k.UpdateMakerUptimeRewardsConfig(ctx, config))
+	require.Equal(t, config, k.GetMakerUptimeRewardsConfig(ctx))
+
+	// Invalid configs are not written to state.
+	err := k.UpdateMakerUptimeRewardsConfig(ctx, types.MakerUptimeRewardsConfig{SampleIntervalBlocks: 10})
+	require.ErrorIs(t, err, types.ErrInvalidMakerUptimeRewardsConfig)
+	require.Equal(t, config, k.GetMakerUptimeRewardsConfig(ctx))
+}
+
+func TestGetMakerDepthSamples(t *testing.T) {
+	// Short-Term orders on CLOB pair 0 placed at an offset, in ticks, from the oracle price.
+	type restingOrder struct {
+		subaccountId satypes.SubaccountId
+		clientId     uint32
+		side         types.Order_Side
+		quantums     uint64
+		offsetTicks  int64
+	}
+	// The expected bid and ask depth of a subaccount, as orders resting on each side.
+	type expectedDepth struct {
+		subaccountId satypes.SubaccountId
+		bids         []restingOrder
+		asks         []restingOrder
+	}
+
+	// The oracle price of CLOB pair 0 is 200_000_000 subticks and each tick is 10_000 subticks, so a
+	// max spread of 2_000 ppm is 40 ticks.
+	config := types.MakerUptimeRewardsConfig{
+		SampleIntervalBlocks: 5,
+		MaxSpreadPpm:         2_000,
+		RewardWeightPpm:      100_000,
+	}
+
+	aliceBid := restingOrder{constants.Alice_Num0, 0, types.Order_SIDE_BUY, 1_000_000, -20}
+	aliceAsk := restingOrder{constants.Alice_Num0, 1, types.Order_SIDE_SELL, 500_000, 20}
+	bobBid := restingOrder{constants.Bob_Num0, 0, types.Order_SIDE_BUY, 300_000, -40}
+	bobAsk := restingOrder{constants.Bob_Num0, 1, types.Order_SIDE_SELL, 400_000, 40}
+
+	tests := map[string]struct {
+		config      types.MakerUptimeRewardsConfig
+		blockHeight int64
+		orders      []restingOrder
+
+		expectedDepths []expectedDepth
+	}{
+		"Two-sided depth within the spread is sampled": {
+			config:      config,
+			blockHeight: 10,
+			orders:      []restingOrder{aliceBid, aliceAsk},
+			expectedDepths: []expectedDepth{
+				{constants.Alice_Num0, []restingOrder{aliceBid}, []restingOrder{aliceAsk}},
+			},
+		},
+		"Depth of all orders of a subaccount on each side is summed": {
+			config:      config,
+			blockHeight: 10,
+			orders: []restingOrder{
+				aliceBid,
+				aliceAsk,
+				{constants.Alice_Num0, 2, types.Order_SIDE_BUY, 100_000, -10},
+				{constants.Alice_Num0, 3, types.Order_SIDE_BUY, 200_000, -20},
+			},
+			expectedDepths: []expectedDepth{
+				{
+					constants.Alice_Num0,
+					[]restingOrder{
+						aliceBid,
+						{constants.Alice_Num0, 2, types.Order_SIDE_BUY, 100_000, -10},
+						{constants.Alice_Num0, 3, types.Order_SIDE_BUY, 200_000, -20},
+					},
+					[]restingOrder{aliceAsk},
+				},
+			},
+		},
+		"Remaining size of partially filled orders counts towards depth": {
+			config:      config,
+			blockHeight: 10,
+			orders: []restingOrder{
+				aliceBid,
+				aliceAsk,
+				{constants.Carl_Num0, 0, types.Order_SIDE_SELL, 800_000, -20},
+			},
+			expectedDepths: []expectedDepth{
+				{
+					constants.Alice_Num0,
+					[]restingOrder{{constants.Alice_Num0, 0, types.Order_SIDE_BUY, 200_000, -20}},
+					[]restingOrder{aliceAsk},
+				},
+			},
+		},
+		"One-sided depth is not sampled": {
+			config:      config,
+			blockHeight: 10,
+			orders: []restingOrder{
+				aliceBid,
+				{constants.Alice_Num0, 1, types.Order_SIDE_BUY, 1_000_000, -10},
+			},
+			expectedDepths: []expectedDepth{},
+		},
+		"Orders outside the spread do not count towards depth": {
+			config:      config,
+			blockHeight: 10,
+			orders: []restingOrder{
+				aliceBid,
+				{constants.Alice_Num0, 1, types.Order_SIDE_SELL, 500_000, 41},
+			},
+			expectedDepths: []expectedDepth{},
+		},
+		"Depth of different subaccounts is not netted and samples are sorted by subaccount": {
+			config:      config,
+			blockHeight: 10,
+			orders: []restingOrder{
+				bobBid,
+				bobAsk,
+				aliceBid,
+				{constants.Carl_Num0, 1, types.Order_SIDE_SELL, 500_000, 20},
+			},
+			expectedDepths: []expectedDepth{
+				{constants.Bob_Num0, []restingOrder{bobBid}, []restingOrder{bobAsk}},
+			},
+		},
+		"Not a sampled block": {
+			config:      config,
+			blockHeight: 11,
+			orders:      []restingOrder{aliceBid, aliceAsk},
+		},
+		"Maker uptime rewards are disabled": {
+			config:      types.MakerUptimeRewardsConfig{},
+			blockHeight: 10,
+			orders:      []restingOrder{aliceBid, aliceAsk},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.ClobKeeper
+			require.NoError(t, k.UpdateMakerUptimeRewardsConfig(ctx, tc.config))
+
+			clobPair, found := k.GetClobPair(ctx, types.ClobPairId(0))
+			require.True(t, found)
+			oraclePriceSubticks := lib.BigRatRound(k.GetOraclePriceSubticksRat(ctx, clobPair), false).Int64()
+			require.Equal(t, int64(200_000_000), oraclePriceSubticks)
+			toOrder := func(o restingOrder) types.Order {
+				return types.Order{
+					OrderId: types.OrderId{
+						SubaccountId: o.subaccountId,
+						ClientId:     o.clientId,
+						ClobPairId:   0,
+					},
+					Side:         o.side,
+					Quantums:     o.quantums,
+					Subticks:     uint64(oraclePriceSubticks + o.offsetTicks*int64(clobPair.SubticksPerTick)),
+					GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+				}
+			}
+
+			for _, o := range tc.orders {
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+					ctx,
+					tApp.App,
+					*types.NewMsgPlaceOrder(toOrder(o)),
+				) {
+					resp := tApp.CheckTx(checkTx)
+					require.True(t, resp.IsOK(), "Expected CheckTx to succeed. Response: %+v", resp)
+				}
+			}
+
+			var expectedSamples []types.MakerDepthSample
+			if tc.expectedDepths != nil {
+				expectedSamples = make([]types.MakerDepthSample, 0, len(tc.expectedDepths))
+			}
+			toQuoteQuantums := func(orders []restingOrder) uint64 {
+				quoteQuantums := big.NewInt(0)
+				for _, o := range orders {
+					order := toOrder(o)
+					quoteQuantums.Add(
+						quoteQuantums,
+						types.FillAmountToQuoteQuantums(
+							order.GetOrderSubticks(),
+							order.GetBaseQuantums(),
+							clobPair.QuantumConversionExponent,
+						),
+					)
+				}
+				return quoteQuantums.Uint64()
+			}
+			for _, depth := range tc.expectedDepths {
+				expectedSamples = append(expectedSamples, types.MakerDepthSample{
+					SubaccountId:     depth.subaccountId,
+					ClobPairId:       0,
+					BidQuoteQuantums: toQuoteQuantums(depth.bids),
+					AskQuoteQuantums: toQuoteQuantums(depth.asks),
+				})
+			}
+
+			require.Equal(t, expectedSamples, k.GetMakerDepthSamples(ctx.WithBlockHeight(tc.blockHeight)))
+		})
+	}
+}
+
+func TestProcessMakerDepthSamples(t *testing.T) {
+	config := types.MakerUptimeRewardsConfig{
+		SampleIntervalBlocks: 5,
+		MaxSpreadPpm:         2_000,
+		RewardWeightPpm:      100_000,
+	}
+
+	tests := map[string]struct {
+		config      types.MakerUptimeRewardsConfig
+		blockHeight int64
+		samples     []types.MakerDepthSample
+
+		expectedWeights map[string]int64
+	}{
+		"Owners accrue a reward share of the smaller side of their depth": {
+			config:      config,
+			blockHeight: 10,
+			samples: []types.MakerDepthSample{
+				{SubaccountId: constants.Alice_Num0, ClobPairId: 0, BidQuoteQuantums: 20_000, AskQuoteQuantums: 10_000},
+				{SubaccountId: constants.Bob_Num0, ClobPairId: 0, BidQuoteQuantums: 3_000, AskQuoteQuantums: 4_000},
+				{SubaccountId: constants.Alice_Num1, ClobPairId: 1, BidQuoteQuantums: 5_000, AskQuoteQuantums: 5_000},
+			},
+			expectedWeights: map[string]int64{
+				constants.Alice_Num0.Owner: 1_500,
+				constants.Bob_Num0.Owner:   300,
+			},
+		},
+		"Samples of CLOB pairs which do not exist are skipped": {
+			config:      config,
+			blockHeight: 10,
+			samples: []types.MakerDepthSample{
+				{SubaccountId: constants.Alice_Num0, ClobPairId: 0, BidQuoteQuantums: 20_000, AskQuoteQuantums: 10_000},
+				{SubaccountId: constants.Bob_Num0, ClobPairId: 1_000, BidQuoteQuantums: 3_000, AskQuoteQuantums: 4_000},
+			},
+			expectedWeights: map[string]int64{
+				constants.Alice_Num0.Owner: 1_000,
+			},
+		},
+		"Samples proposed in a block which is not sampled are ignored": {
+			config:      config,
+			blockHeight: 11,
+			samples: []types.MakerDepthSample{
+				{SubaccountId: constants.Alice_Num0, ClobPairId: 0, BidQuoteQuantums: 20_000, AskQuoteQuantums: 10_000},
+			},
+		},
+		"Samples are ignored if maker uptime rewards are disabled": {
+			config:      types.MakerUptimeRewardsConfig{},
+			blockHeight: 10,
+			samples: []types.MakerDepthSample{
+				{SubaccountId: constants.Alice_Num0, ClobPairId: 0, BidQuoteQuantums: 20_000, AskQuoteQuantums: 10_000},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.ClobKeeper
+			require.NoError(t, k.UpdateMakerUptimeRewardsConfig(ctx, tc.config))
+
+			k.ProcessMakerDepthSamples(ctx.WithBlockHeight(tc.blockHeight), tc.samples)
+
+			for _, address := range []string{constants.Alice_Num0.Owner, constants.Bob_Num0.Owner} {
+				require.Equal(
+					t,
+					dtypes.NewInt(tc.expectedWeights[address]),
+					tApp.App.RewardsKeeper.GetRewardShare(ctx, address).Weight,
+					"address %s",
+					address,
+				)
+			}
+		})
+	}
+}
@@ -413,10 +413,15 @@ func (k Keeper) InitializeCumulativePnLs(
 			)
 		}
 
-		// Get a mapping from perpetual Id to current perpetual funding index.
-		perpetual, err := perpetualKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
-		if err != nil {
-			panic(perptypes.ErrPerpetualDoesNotExist)
+		// Get a mapping from perpetual Id to current perpetual funding index. Spot CLOB pairs
+		// have no funding.
+		fundingIndex := new(big.Int)
+		if !clobPair.IsSpotClobPair() {
+			perpetual, err := perpetualKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
+			if err != nil {
+				panic(perptypes.ErrPerpetualDoesNotExist)
+			}
+			fundingIndex = perpetual.FundingIndex.BigInt()
 		}
 
 		for _, cumulativePnL := range []map[types.ClobPairId]*CumulativePnL{
@@ -430,7 +435,7 @@ func (k Keeper) InitializeCumulativePnLs(
 				VolumeQuoteQuantums:         big.NewInt(0),
 				ClobPair:                    clobPair,
 				MidPriceSubticks:            midPriceSubticks,
-				PerpetualFundingIndex:       perpetual.FundingIndex.BigInt(),
+				PerpetualFundingIndex:       fundingIndex,
 			}
 		}
 	}
@@ -465,9 +470,25 @@ func (k Keeper) GetMEVDataFromOperations(
 			if err != nil {
 				return nil, err
 			}
-			msgPlaceOrder := tx.GetMsgs()[0].(*types.MsgPlaceOrder)
+			msgPlaceOrder, err := types.GetShortTermOrderPlacementMsgPlaceOrder(tx.GetMsgs()[0])
+			if err != nil {
+				return nil, err
+			}
 			order := msgPlaceOrder.Order
 			placedShortTermOrders[order.GetOrderId()] = order
+		case *types.OperationRaw_ShortTermOrderBatchPlacement:
+			// Decode the short-term orders placed as part of a batch for subsequent lookups.
+			batchPlacement := typedOperation.ShortTermOrderBatchPlacement
+			tx, err := k.txDecoder(batchPlacement.TxBytes)
+			if err != nil {
+				return nil, err
+			}
+			msgBatchPlaceOrders := tx.GetMsgs()[0].(*types.MsgBatchPlaceOrders)
+			for _, orderId := range batchPlacement.OrderIds {
+				if order, found := msgBatchPlaceOrders.GetOrder(orderId); found {
+					placedShortTermOrders[order.GetOrderId()] = order
+				}
+			}
 		case *types.OperationRaw_Match:
 			switch match := typedOperation.Match.Match.(type) {
 			case *types.ClobMatch_MatchOrders:
@@ -483,6 +504,7 @@ func (k Keeper) GetMEVDataFromOperations(
 							ctx,
 							takerOrder.GetSubaccountId().Owner,
 							true,
+							takerOrder.GetClobPairId().ToUint32(),
 						),
 
 						MakerOrderSubaccountId: &makerOrder.OrderId.SubaccountId,
@@ -492,6 +514,7 @@ func (k Keeper) GetMEVDataFromOperations(
 							ctx,
 							makerOrder.GetSubaccountId().Owner,
 							false,
+							takerOrder.GetClobPairId().ToUint32(),
 						),
 
 						ClobPairId: takerOrder.OrderId.ClobPairId,
@@ -539,6 +562,7 @@ func (k Keeper) GetMEVDataFromOperations(
 							ctx,
 							makerOrder.GetSubaccountId().Owner,
 							false,
+							matchLiquidation.ClobPairId,
 						),
 
 						ClobPairId: matchLiquidation.ClobPairId,
@@ -671,9 +695,25 @@ func (k Keeper) CalculateSubaccountPnLForMatches(
 			if err != nil {
 				return err
 			}
-			msgPlaceOrder := tx.GetMsgs()[0].(*types.MsgPlaceOrder)
+			msgPlaceOrder, err := types.GetShortTermOrderPlacementMsgPlaceOrder(tx.GetMsgs()[0])
+			if err != nil {
+				return err
+			}
 			order := msgPlaceOrder.Order
 			placedShortTermOrders[order.GetOrderId()] = order
+		case *types.OperationRaw_ShortTermOrderBatchPlacement:
+			// Collect all the short-term orders placed as part of a batch for subsequent lookups.
+			batchPlacement := typedOperation.ShortTermOrderBatchPlacement
+			tx, err := k.txDecoder(batchPlacement.TxBytes)
+			if err != nil {
+				return err
+			}
+			msgBatchPlaceOrders := tx.GetMsgs()[0].(*types.MsgBatchPlaceOrders)
+			for _, orderId := range batchPlacement.OrderIds {
+				if order, found := msgBatchPlaceOrders.GetOrder(orderId); found {
+					placedShortTermOrders[order.GetOrderId()] = order
+				}
+			}
 		case *types.OperationRaw_Match:
 			switch match := typedOperation.Match.Match.(type) {
 			case *types.ClobMatch_MatchOrders:
@@ -753,6 +793,11 @@ func (k Keeper) AddSettlementForPositionDelta(
 	clobPairToPnLs map[types.ClobPairId]*CumulativePnL,
 ) (err error) {
 	for _, cumulativePnL := range clobPairToPnLs {
+		// Spot positions do not pay or receive funding.
+		if cumulativePnL.ClobPair.IsSpotClobPair() {
+			continue
+		}
+
 		perpetualId := cumulativePnL.ClobPair.MustGetPerpetualId()
 		for subaccountId, deltaQuantums := range cumulativePnL.SubaccountPositionSizeDelta {
 			// Get the subaccount and its perpetual positions.
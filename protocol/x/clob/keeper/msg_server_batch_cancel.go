@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// BatchCancel is the entry point for `MsgBatchCancel` messages executed in `runMsgs` during `DeliverTx`.
+// `MsgBatchCancel` only contains Short-Term order cancellations, which are processed in the `ClobDecorator`
+// during `CheckTx` and are never included in blocks. Therefore this handler always returns an error.
+func (k msgServer) BatchCancel(
+	goCtx context.Context,
+	msg *types.MsgBatchCancel,
+) (*types.MsgBatchCancelResponse, error) {
+	return nil, errorsmod.Wrapf(
+		types.ErrBatchMsgNotSupportedInDeliverTx,
+		"BatchCancel: msg (%+v)",
+		msg,
+	)
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// BatchPlaceOrders is the entry point for `MsgBatchPlaceOrders` messages executed in `runMsgs` during `DeliverTx`.
+// `MsgBatchPlaceOrders` only contains Short-Term orders, which are placed in the `ClobDecorator` during `CheckTx`
+// and included in blocks through `MsgProposedOperations`. Therefore this handler always returns an error.
+func (k msgServer) BatchPlaceOrders(
+	goCtx context.Context,
+	msg *types.MsgBatchPlaceOrders,
+) (*types.MsgBatchPlaceOrdersResponse, error) {
+	return nil, errorsmod.Wrapf(
+		types.ErrBatchMsgNotSupportedInDeliverTx,
+		"BatchPlaceOrders: msg (%+v)",
+		msg,
+	)
+}
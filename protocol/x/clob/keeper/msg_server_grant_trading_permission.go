@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// GrantTradingPermission writes the trading permission granted by the owner of a subaccount to state,
+// replacing any existing trading permission of the grantee for the subaccount.
+func (k msgServer) GrantTradingPermission(
+	goCtx context.Context,
+	msg *types.MsgGrantTradingPermission,
+) (*types.MsgGrantTradingPermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetTradingPermission(ctx, msg.Permission); err != nil {
+		return nil, err
+	}
+	return &types.MsgGrantTradingPermissionResponse{}, nil
+}
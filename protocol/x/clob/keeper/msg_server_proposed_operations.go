@@ -29,5 +29,7 @@ func (k msgServer) ProposedOperations(
 		return nil, err
 	}
 
+	k.Keeper.ProcessMakerDepthSamples(ctx, msg.GetMakerDepthSamples())
+
 	return &types.MsgProposedOperationsResponse{}, nil
 }
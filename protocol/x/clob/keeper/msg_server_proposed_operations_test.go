@@ -24,6 +24,7 @@ func TestProposedOperations(t *testing.T) {
 		"Success": {
 			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
 				mck.On("ProcessProposerOperations", ctx, operationsQueue).Return(nil)
+				mck.On("ProcessMakerDepthSamples", ctx, []types.MakerDepthSample(nil)).Return()
 			},
 		},
 		"Propagate Process Error": {
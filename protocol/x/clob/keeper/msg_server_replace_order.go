@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	errorlib "github.com/dydxprotocol/v4-chain/protocol/lib/error"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// ReplaceOrder is the entry point for stateful `MsgReplaceOrder` messages executed in `runMsgs` during `DeliverTx`.
+// Replacements of Short-Term orders are performed in the `ClobDecorator` during `CheckTx` and are not included
+// in blocks as transactions, so this handler is only invoked for replacements of Long-Term orders.
+func (k msgServer) ReplaceOrder(goCtx context.Context, msg *types.MsgReplaceOrder) (
+	resp *types.MsgReplaceOrderResponse,
+	err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.ReplaceOrder,
+			metrics.DeliverTx,
+			msg.NewOrder.GetOrderLabels()...,
+		)
+		if err != nil {
+			errorlib.LogDeliverTxError(k.Keeper.Logger(ctx), err, ctx.BlockHeight(), "ReplaceOrder", msg)
+		}
+	}()
+
+	// 1. Ensure the new order is not a Short-Term order.
+	newOrder := msg.GetNewOrder()
+	newOrder.MustBeStatefulOrder()
+
+	// 2. Return an error if an associated cancellation or removal of either order already exists in the
+	// current block.
+	processProposerMatchesEvents := k.Keeper.GetProcessProposerMatchesEvents(ctx)
+	cancelledOrderIds := lib.UniqueSliceToSet(processProposerMatchesEvents.PlacedStatefulCancellationOrderIds)
+	removedOrderIds := lib.UniqueSliceToSet(processProposerMatchesEvents.RemovedStatefulOrderIds)
+	for _, orderId := range []types.OrderId{msg.OldOrderId, newOrder.OrderId} {
+		if _, found := cancelledOrderIds[orderId]; found {
+			return nil, errorsmod.Wrapf(
+				types.ErrStatefulOrderPreviouslyCancelled,
+				"ReplaceOrder: order (%+v)",
+				orderId,
+			)
+		}
+		if _, found := removedOrderIds[orderId]; found {
+			return nil, errorsmod.Wrapf(
+				types.ErrStatefulOrderPreviouslyRemoved,
+				"ReplaceOrder: order (%+v)",
+				orderId,
+			)
+		}
+	}
+
+	// 3. Replace the order on the ClobKeeper which is responsible for:
+	//   - cancellation and placement validation.
+	//   - collateralization check.
+	//   - writing the orders to state and the memstore.
+	if err := k.Keeper.ReplaceStatefulOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// 4. Add the replaced orders to `ProcessProposerMatchesEvents` for use in `PrepareCheckState`. Orders
+	// with a reduced size are placed again to reduce their size on the orderbook.
+	if msg.OldOrderId != newOrder.OrderId {
+		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds = append(
+			processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
+			msg.OldOrderId,
+		)
+	}
+	placedOrderIds := lib.UniqueSliceToSet(processProposerMatchesEvents.PlacedLongTermOrderIds)
+	if _, found := placedOrderIds[newOrder.OrderId]; !found {
+		processProposerMatchesEvents.PlacedLongTermOrderIds = append(
+			processProposerMatchesEvents.PlacedLongTermOrderIds,
+			newOrder.OrderId,
+		)
+	}
+	k.Keeper.MustSetProcessProposerMatchesEvents(
+		ctx,
+		processProposerMatchesEvents,
+	)
+
+	// 5. Emit the order replacement indexer event.
+	k.Keeper.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewLongTermOrderReplacementEvent(
+				msg.OldOrderId,
+				newOrder,
+			),
+		),
+	)
+
+	return &types.MsgReplaceOrderResponse{}, nil
+}
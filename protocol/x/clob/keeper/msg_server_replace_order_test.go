@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceOrder_Success(t *testing.T) {
+	clobPair := constants.ClobPair_Btc
+	oldOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15
+
+	differentIdOrder := oldOrder
+	differentIdOrder.OrderId.ClientId = 1
+
+	reducedSizeOrder := oldOrder
+	reducedSizeOrder.Quantums = oldOrder.Quantums - clobPair.StepBaseQuantums
+
+	tests := map[string]struct {
+		newOrder types.Order
+	}{
+		"Replaces order with a different order id": {
+			newOrder: differentIdOrder,
+		},
+		"Reduces the size of an order in place": {
+			newOrder: reducedSizeOrder,
+		},
+	}
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Initialize mocks, context, msgServer.
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("CreateOrderbook", mock.Anything, mock.Anything, mock.Anything)
+			indexerEventManager := &mocks.IndexerEventManager{}
+
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+
+			ctx := ks.Ctx.WithBlockHeight(2)
+			ctx = ctx.WithBlockTime(time.Unix(int64(2), 0))
+			ks.BlockTimeKeeper.SetPreviousBlockInfo(ctx, &blocktimetypes.BlockInfo{
+				Height:    2,
+				Timestamp: time.Unix(int64(2), 0),
+			})
+
+			keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+			ks.SubaccountsKeeper.SetSubaccount(ctx, satypes.Subaccount{
+				Id: &constants.Alice_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					&constants.Usdc_Asset_100_000,
+				},
+			})
+			keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+			require.NoError(t, ks.FeeTiersKeeper.SetPerpetualFeeParams(ctx, constants.PerpetualFeeParams))
+
+			// Create Perpetual and ClobPair.
+			perpetual := constants.BtcUsd_100PercentMarginRequirement
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ctx,
+				perpetual.Params.Id,
+				perpetual.Params.Ticker,
+				perpetual.Params.MarketId,
+				perpetual.Params.AtomicResolution,
+				perpetual.Params.DefaultFundingPpm,
+				perpetual.Params.LiquidityTier,
+			)
+			require.NoError(t, err)
+
+			indexerEventManager.On("AddTxnEvent",
+				ctx,
+				indexerevents.SubtypePerpetualMarket,
+				indexerevents.PerpetualMarketEventVersion,
+				mock.Anything,
+			).Once().Return()
+			_, err = ks.ClobKeeper.CreatePerpetualClobPair(
+				ctx,
+				clobPair.Id,
+				clobtest.MustPerpetualId(clobPair),
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				clobPair.Status,
+			)
+			require.NoError(t, err)
+
+			// Add BlockHeight to `ProcessProposerMatchesEvents`. This is normally done in `BeginBlock`.
+			ks.ClobKeeper.MustSetProcessProposerMatchesEvents(
+				ctx,
+				types.ProcessProposerMatchesEvents{
+					BlockHeight: lib.MustConvertIntegerToUint32(2),
+				},
+			)
+
+			// Place the order to replace.
+			indexerEventManager.On(
+				"AddTxnEvent",
+				ctx,
+				indexerevents.SubtypeStatefulOrder,
+				indexerevents.StatefulOrderEventVersion,
+				indexer_manager.GetBytes(indexerevents.NewLongTermOrderPlacementEvent(oldOrder)),
+			).Return().Once()
+			_, err = msgServer.PlaceOrder(ctx, &types.MsgPlaceOrder{Order: oldOrder})
+			require.NoError(t, err)
+
+			// The replacement is sent to Indexer as a single event.
+			indexerEventManager.On(
+				"AddTxnEvent",
+				ctx,
+				indexerevents.SubtypeStatefulOrder,
+				indexerevents.StatefulOrderEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewLongTermOrderReplacementEvent(oldOrder.OrderId, tc.newOrder),
+				),
+			).Return().Once()
+
+			_, err = msgServer.ReplaceOrder(ctx, types.NewMsgReplaceOrder(oldOrder.OrderId, tc.newOrder))
+			require.NoError(t, err)
+
+			placement, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, tc.newOrder.OrderId)
+			require.True(t, found)
+			require.Equal(t, tc.newOrder, placement.Order)
+
+			// Run mock assertions.
+			indexerEventManager.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// RevokeTradingPermission removes the trading permission of a grantee for a subaccount from state.
+// Orders previously placed by the grantee are not canceled.
+func (k msgServer) RevokeTradingPermission(
+	goCtx context.Context,
+	msg *types.MsgRevokeTradingPermission,
+) (*types.MsgRevokeTradingPermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.RemoveTradingPermission(ctx, msg.SubaccountId, msg.Grantee); err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeTradingPermissionResponse{}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// UpdateMakerUptimeRewardsConfig updates the maker uptime rewards config in state.
+func (k msgServer) UpdateMakerUptimeRewardsConfig(
+	goCtx context.Context,
+	msg *types.MsgUpdateMakerUptimeRewardsConfig,
+) (resp *types.MsgUpdateMakerUptimeRewardsConfigResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	if err := k.Keeper.UpdateMakerUptimeRewardsConfig(ctx, msg.MakerUptimeRewardsConfig); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateMakerUptimeRewardsConfigResponse{}, nil
+}
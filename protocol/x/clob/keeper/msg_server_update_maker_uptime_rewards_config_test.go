@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMakerUptimeRewardsConfig(t *testing.T) {
+	testCases := map[string]struct {
+		msg           *types.MsgUpdateMakerUptimeRewardsConfig
+		expectedError error
+	}{
+		"Succeeds": {
+			msg: &types.MsgUpdateMakerUptimeRewardsConfig{
+				Authority: lib.GovModuleAddress.String(),
+				MakerUptimeRewardsConfig: types.MakerUptimeRewardsConfig{
+					SampleIntervalBlocks: 10,
+					MaxSpreadPpm:         5_000,
+					RewardWeightPpm:      100,
+				},
+			},
+		},
+		"Error: invalid maker uptime rewards config": {
+			msg: &types.MsgUpdateMakerUptimeRewardsConfig{
+				Authority: lib.GovModuleAddress.String(),
+				MakerUptimeRewardsConfig: types.MakerUptimeRewardsConfig{
+					SampleIntervalBlocks: 10,
+				},
+			},
+			expectedError: types.ErrInvalidMakerUptimeRewardsConfig,
+		},
+		"Error: invalid authority": {
+			msg: &types.MsgUpdateMakerUptimeRewardsConfig{
+				Authority: "foobar",
+			},
+			expectedError: govtypes.ErrInvalidSigner,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+			_, err := msgServer.UpdateMakerUptimeRewardsConfig(ks.Ctx, tc.msg)
+
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, ks.ClobKeeper.GetMakerUptimeRewardsConfig(ks.Ctx), tc.msg.MakerUptimeRewardsConfig)
+			}
+		})
+	}
+}
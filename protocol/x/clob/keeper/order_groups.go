@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetOrderGroupOrderIds gets a list of all stateful order IDs in the order group `orderGroupId`
+// of subaccount `subaccountId`, sorted by order ID.
+func (k Keeper) GetOrderGroupOrderIds(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	orderGroupId uint32,
+) (orderIds []types.OrderId) {
+	store := k.getOrderGroupStore(ctx)
+	orderGroupBytes := store.Get(orderGroupKey(subaccountId, orderGroupId))
+
+	// If there are no stateful orders in the order group, then return an empty slice.
+	if orderGroupBytes == nil {
+		return []types.OrderId{}
+	}
+
+	var orderGroup types.OrderGroupValue
+	k.cdc.MustUnmarshal(orderGroupBytes, &orderGroup)
+
+	return orderGroup.OrderIds
+}
+
+// RemoveOrderGroupSiblings removes all other stateful orders in the order group of `order` from state
+// and emits an on-chain indexer event for each removal. It returns the removed order IDs sorted by
+// order ID. This function is a no-op if the order is not part of an order group.
+func (k Keeper) RemoveOrderGroupSiblings(
+	ctx sdk.Context,
+	order types.Order,
+) (removedOrderIds []types.OrderId) {
+	removedOrderIds = make([]types.OrderId, 0)
+	if !order.IsInOrderGroup() {
+		return removedOrderIds
+	}
+
+	orderGroupOrderIds := k.GetOrderGroupOrderIds(ctx, order.GetSubaccountId(), order.OrderGroupId)
+	for _, orderId := range orderGroupOrderIds {
+		if orderId == order.OrderId {
+			continue
+		}
+
+		// Remove the stateful order from state. This also removes it from the order group.
+		k.MustRemoveStatefulOrder(ctx, orderId)
+		removedOrderIds = append(removedOrderIds, orderId)
+
+		// Emit an on-chain indexer event for Stateful Order Removal.
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					orderId,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED,
+				),
+			),
+		)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.StatefulOrderRemoved, metrics.Count},
+			1,
+			append(
+				orderId.GetOrderIdLabels(),
+				metrics.GetLabelForStringValue(
+					metrics.RemovalReason,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED.String(),
+				),
+			),
+		)
+	}
+
+	return removedOrderIds
+}
+
+// addOrderToOrderGroup adds the order ID of `order` to its order group in state.
+// This function is a no-op if the order is not part of an order group or if the order ID
+// is already in the order group.
+func (k Keeper) addOrderToOrderGroup(
+	ctx sdk.Context,
+	order types.Order,
+) {
+	if !order.IsInOrderGroup() {
+		return
+	}
+
+	orderIds := k.GetOrderGroupOrderIds(ctx, order.GetSubaccountId(), order.OrderGroupId)
+	for _, orderId := range orderIds {
+		if orderId == order.OrderId {
+			return
+		}
+	}
+
+	k.setOrderGroupOrderIds(
+		ctx,
+		order.GetSubaccountId(),
+		order.OrderGroupId,
+		append(orderIds, order.OrderId),
+	)
+}
+
+// removeOrderFromOrderGroup removes the order ID of `order` from its order group in state. If the
+// order group is empty after removing the order ID, then the order group is pruned from state.
+// This function is a no-op if the order is not part of an order group.
+func (k Keeper) removeOrderFromOrderGroup(
+	ctx sdk.Context,
+	order types.Order,
+) {
+	if !order.IsInOrderGroup() {
+		return
+	}
+
+	orderIds := k.GetOrderGroupOrderIds(ctx, order.GetSubaccountId(), order.OrderGroupId)
+	updatedOrderIds := make([]types.OrderId, 0, len(orderIds))
+	for _, orderId := range orderIds {
+		if orderId != order.OrderId {
+			updatedOrderIds = append(updatedOrderIds, orderId)
+		}
+	}
+
+	k.setOrderGroupOrderIds(ctx, order.GetSubaccountId(), order.OrderGroupId, updatedOrderIds)
+}
+
+// setOrderGroupOrderIds sets a sorted list of order IDs in state for an order group, or deletes the
+// order group from state if `orderIds` is empty. This function automatically sorts the order IDs
+// before writing them to state.
+func (k Keeper) setOrderGroupOrderIds(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	orderGroupId uint32,
+	orderIds []types.OrderId,
+) {
+	store := k.getOrderGroupStore(ctx)
+	key := orderGroupKey(subaccountId, orderGroupId)
+	if len(orderIds) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	// Sort the order IDs.
+	types.MustSortAndHaveNoDuplicates(orderIds)
+
+	orderGroup := types.OrderGroupValue{
+		OrderIds: orderIds,
+	}
+	store.Set(key, k.cdc.MustMarshal(&orderGroup))
+}
+
+// orderGroupKey returns the state key of the order group `orderGroupId` of subaccount `subaccountId`.
+func orderGroupKey(
+	subaccountId satypes.SubaccountId,
+	orderGroupId uint32,
+) []byte {
+	return append(subaccountId.ToStateKey(), lib.Uint32ToKey(orderGroupId)...)
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSetDeleteOrderGroup(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	longTermOrder := constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1
+	takeProfitOrder := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1
+	stopLossOrder := constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1
+
+	// Orders without an order group are not added to any order group.
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15, 1)
+	require.Empty(t, ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 0))
+
+	// Orders in an order group are added to the order group, sorted by order ID.
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, longTermOrder, 1)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, stopLossOrder, 1)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, takeProfitOrder, 1)
+	require.Equal(
+		t,
+		[]types.OrderId{
+			takeProfitOrder.OrderId,
+			stopLossOrder.OrderId,
+			longTermOrder.OrderId,
+		},
+		ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 1),
+	)
+	require.Empty(t, ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Bob_Num0, 1))
+
+	// Replacing an order with the same order group does not duplicate it in the order group.
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, longTermOrder, 2)
+	require.Len(t, ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 1), 3)
+
+	// Replacing an order with a different order group moves it to the new order group.
+	replacementOrder := longTermOrder
+	replacementOrder.OrderGroupId = 2
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, replacementOrder, 3)
+	require.Equal(
+		t,
+		[]types.OrderId{
+			takeProfitOrder.OrderId,
+			stopLossOrder.OrderId,
+		},
+		ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 1),
+	)
+	require.Equal(
+		t,
+		[]types.OrderId{replacementOrder.OrderId},
+		ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 2),
+	)
+
+	// Deleting orders removes them from their order group.
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, replacementOrder.OrderId)
+	require.Empty(t, ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 2))
+
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, stopLossOrder.OrderId)
+	require.Equal(
+		t,
+		[]types.OrderId{takeProfitOrder.OrderId},
+		ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 1),
+	)
+
+	// Triggering a conditional order keeps it in its order group.
+	ks.ClobKeeper.MustTriggerConditionalOrder(ks.Ctx, takeProfitOrder.OrderId)
+	require.Equal(
+		t,
+		[]types.OrderId{takeProfitOrder.OrderId},
+		ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 1),
+	)
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, takeProfitOrder.OrderId)
+	require.Empty(t, ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, constants.Alice_Num0, 1))
+}
+
+func TestRemoveOrderGroupSiblings(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		statefulOrders []types.Order
+		order          types.Order
+
+		// Expectations.
+		expectedRemovedOrderIds []types.OrderId
+		expectedRemainingOrders []types.Order
+	}{
+		"Removes all other orders in the order group": {
+			statefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1,
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1,
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+			order: constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1,
+
+			expectedRemovedOrderIds: []types.OrderId{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1.OrderId,
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy1BTC_Price50000_GTBT10_SL_50001_OrderGroup1.OrderId,
+			},
+			expectedRemainingOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+		},
+		"Does not remove any orders if the order is not in an order group": {
+			statefulOrders: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+			order: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+
+			expectedRemovedOrderIds: []types.OrderId{},
+			expectedRemainingOrders: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49999_OrderGroup1,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+		},
+		"Does not remove any orders if the order is the only order in its order group": {
+			statefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1,
+			},
+			order: constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1,
+
+			expectedRemovedOrderIds: []types.OrderId{},
+			expectedRemainingOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Buy1BTC_Price50000_GTBT10_OrderGroup1,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+
+			for _, order := range tc.statefulOrders {
+				createPartiallyFilledStatefulOrderInState(
+					ks.Ctx,
+					*ks.ClobKeeper,
+					order,
+					time.Unix(int64(order.GetGoodTilBlockTime()), 0),
+				)
+			}
+
+			for _, orderId := range tc.expectedRemovedOrderIds {
+				mockIndexerEventManager.On("AddTxnEvent",
+					ks.Ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewStatefulOrderRemovalEvent(
+							orderId,
+							indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED,
+						),
+					),
+				).Once().Return()
+			}
+
+			removedOrderIds := ks.ClobKeeper.RemoveOrderGroupSiblings(ks.Ctx, tc.order)
+			require.Equal(t, tc.expectedRemovedOrderIds, removedOrderIds)
+
+			for _, orderId := range tc.expectedRemovedOrderIds {
+				_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, orderId)
+				require.False(t, found)
+
+				exists, _, _ := ks.ClobKeeper.GetOrderFillAmount(ks.Ctx, orderId)
+				require.False(t, exists)
+			}
+			require.ElementsMatch(t, tc.expectedRemainingOrders, ks.ClobKeeper.GetAllStatefulOrders(ks.Ctx))
+
+			mockIndexerEventManager.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -10,6 +11,7 @@ import (
 	gometrics "github.com/armon/go-metrics"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
@@ -25,7 +27,8 @@ func (k Keeper) GetOperations(ctx sdk.Context) *types.MsgProposedOperations {
 	operationsQueueRaw := k.MemClob.GetOperationsRaw(ctx)
 
 	msgProposedOperations := &types.MsgProposedOperations{
-		OperationsQueue: operationsQueueRaw,
+		OperationsQueue:   operationsQueueRaw,
+		MakerDepthSamples: k.GetMakerDepthSamples(ctx),
 	}
 
 	if err := msgProposedOperations.ValidateBasic(); err != nil {
@@ -115,6 +118,291 @@ func (k Keeper) PlaceShortTermOrder(
 	return k.placeOrder(ctx, msg, nextBlockHeight, k.MemClob)
 }
 
+// SimulatePlaceShortTermOrder simulates placing a Short-Term order by performing the same validation
+// as `PlaceShortTermOrder` and simulating the placement on the keeper's memclob. The memclob is never
+// modified, but the state changes from the order's matches are written to `ctx`, so callers should pass
+// a branched context that is discarded afterwards. This method uses the next block height.
+//
+// An error will be returned if any of the following conditions are true:
+//   - Stateless or standard stateful validation fails.
+//   - Equity tier limit exceeded.
+//   - The memclob itself returns an error.
+//
+// This method will panic if the provided order is not a Short-Term order.
+func (k Keeper) SimulatePlaceShortTermOrder(
+	ctx sdk.Context,
+	order types.Order,
+) (
+	matches []types.MatchWithOrders,
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	err error,
+) {
+	order.OrderId.MustBeShortTermOrder()
+
+	lib.AssertCheckTxMode(ctx)
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+
+	// Perform stateless validation.
+	if err := types.NewMsgPlaceOrder(order).ValidateBasic(); err != nil {
+		return nil, 0, 0, err
+	}
+
+	// Perform stateful validation. Simulations are not order placements, so no order placement metrics
+	// are emitted.
+	if err := k.performStatefulOrderValidation(ctx, &order, nextBlockHeight, true); err != nil {
+		return nil, 0, 0, err
+	}
+
+	// Validate that adding the order wouldn't exceed subaccount equity tier limits.
+	if err := k.ValidateSubaccountEquityTierLimitForNewOrder(ctx, order); err != nil {
+		return nil, 0, 0, err
+	}
+
+	return k.MemClob.SimulatePlaceOrder(ctx, order)
+}
+
+// BatchCancelShortTermOrder cancels each Short-Term order in the provided `MsgBatchCancel` by calling
+// `CancelShortTermOrder`. Each cancellation is processed independently, and only writes to state if the
+// cancellation succeeds. An event is emitted with the result of each cancellation.
+// This method returns the successful and failed cancellations, grouped by clob pair in the order they
+// appear in the batch. This method is meant to be used in the CheckTx flow.
+func (k Keeper) BatchCancelShortTermOrder(
+	ctx sdk.Context,
+	msg *types.MsgBatchCancel,
+) (
+	succeeded []types.OrderBatch,
+	failed []types.OrderBatch,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.BatchCancel, metrics.Latency)
+	telemetry.IncrCounter(1, types.ModuleName, metrics.BatchCancel, metrics.Count)
+
+	succeededClientIds := make(map[uint32][]uint32)
+	failedClientIds := make(map[uint32][]uint32)
+	for _, msgCancelOrder := range msg.GetMsgCancelOrders() {
+		orderId := msgCancelOrder.OrderId
+
+		// First fork the multistore. If `CancelShortTermOrder` fails, we don't want to write to state.
+		cancelOrderCtx, writeCache := ctx.CacheContext()
+		err := k.CancelShortTermOrder(cancelOrderCtx, msgCancelOrder)
+		if err == nil {
+			writeCache()
+			succeededClientIds[orderId.ClobPairId] = append(succeededClientIds[orderId.ClobPairId], orderId.ClientId)
+		} else {
+			failedClientIds[orderId.ClobPairId] = append(failedClientIds[orderId.ClobPairId], orderId.ClientId)
+		}
+
+		k.Logger(ctx).Debug("BatchCancelShortTermOrder: canceled short term order",
+			"msg",
+			msgCancelOrder,
+			"err",
+			err,
+		)
+
+		ctx.EventManager().EmitEvent(
+			types.NewBatchOrderCancellationEvent(orderId, err),
+		)
+	}
+
+	// Group the results by clob pair, preserving the order of the clob pairs in the batch.
+	succeeded = make([]types.OrderBatch, 0)
+	failed = make([]types.OrderBatch, 0)
+	for _, batch := range msg.ShortTermCancels {
+		if clientIds, exists := succeededClientIds[batch.ClobPairId]; exists {
+			succeeded = append(succeeded, types.OrderBatch{ClobPairId: batch.ClobPairId, ClientIds: clientIds})
+		}
+		if clientIds, exists := failedClientIds[batch.ClobPairId]; exists {
+			failed = append(failed, types.OrderBatch{ClobPairId: batch.ClobPairId, ClientIds: clientIds})
+		}
+	}
+
+	return succeeded, failed
+}
+
+// BatchPlaceShortTermOrders places each Short-Term order in the provided `MsgBatchPlaceOrders` by calling
+// `PlaceShortTermOrder`. Each order placement is processed independently, and only writes to state if the
+// placement succeeds. An event is emitted with the result of each order placement.
+// This method returns the order IDs of the successful and failed order placements, in the order they
+// appear in the batch. This method is meant to be used in the CheckTx flow.
+func (k Keeper) BatchPlaceShortTermOrders(
+	ctx sdk.Context,
+	msg *types.MsgBatchPlaceOrders,
+) (
+	placedOrderIds []types.OrderId,
+	failedOrderIds []types.OrderId,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.BatchPlaceOrders, metrics.Latency)
+	telemetry.IncrCounter(1, types.ModuleName, metrics.BatchPlaceOrders, metrics.Count)
+
+	placedOrderIds = make([]types.OrderId, 0, len(msg.Orders))
+	failedOrderIds = make([]types.OrderId, 0)
+	for _, order := range msg.Orders {
+		// First fork the multistore. If `PlaceShortTermOrder` fails, we don't want to write to state.
+		placeOrderCtx, writeCache := ctx.CacheContext()
+		orderSizeOptimisticallyFilledFromMatchingQuantums, status, err := k.PlaceShortTermOrder(
+			placeOrderCtx,
+			types.NewMsgPlaceOrder(order),
+		)
+		if err == nil {
+			writeCache()
+			placedOrderIds = append(placedOrderIds, order.OrderId)
+		} else {
+			failedOrderIds = append(failedOrderIds, order.OrderId)
+		}
+
+		k.Logger(ctx).Debug("BatchPlaceShortTermOrders: placed short term order",
+			"orderHash",
+			log.NewLazySprintf("%X", order.GetOrderHash()),
+			"order",
+			order,
+			"status",
+			status,
+			"orderSizeOptimisticallyFilledFromMatchingQuantums",
+			orderSizeOptimisticallyFilledFromMatchingQuantums,
+			"err",
+			err,
+		)
+
+		ctx.EventManager().EmitEvent(
+			types.NewBatchOrderPlacementEvent(order.OrderId, err),
+		)
+	}
+
+	return placedOrderIds, failedOrderIds
+}
+
+// ReplaceShortTermOrder atomically replaces a Short-Term order resting on the orderbook with a new Short-Term
+// order. This method is meant to be used in the CheckTx flow. It uses the next block height.
+//
+// If the new order has the same `OrderId` as the resting order and only reduces its size, the resting order is
+// updated in place and retains its queue priority. Otherwise, the new order is placed and the resting order is
+// canceled once the new order was accepted by the orderbook, that is, it was matched or added to the orderbook.
+// If the new order is not accepted by the orderbook, the resting order is left untouched.
+//
+// An error will be returned if any of the following conditions are true:
+//   - The order to replace is not resting on the orderbook.
+//   - Stateful validation of the new order or of the cancellation of the resting order fails.
+//   - The new order is not accepted by the orderbook.
+//
+// This method will panic if the provided order is not a Short-Term order.
+func (k Keeper) ReplaceShortTermOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	err error,
+) {
+	newOrder := msg.NewOrder
+	newOrder.OrderId.MustBeShortTermOrder()
+
+	lib.AssertCheckTxMode(ctx)
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.ReplaceShortTermOrder, metrics.Latency)
+	telemetry.IncrCounter(1, types.ModuleName, metrics.ReplaceShortTermOrder, metrics.Count)
+
+	oldOrder, found := k.MemClob.GetOrder(ctx, msg.OldOrderId)
+	if !found {
+		return 0, 0, errorsmod.Wrapf(
+			types.ErrInvalidReplaceOrder,
+			"ReplaceShortTermOrder: order to replace %+v is not resting on the orderbook",
+			msg.OldOrderId,
+		)
+	}
+
+	// Perform stateful validation on the new order. Note that the equity tier limit is not checked since
+	// the replacement does not increase the number of open orders of the subaccount.
+	if err := k.PerformStatefulOrderValidation(ctx, &newOrder, nextBlockHeight, true); err != nil {
+		return 0, 0, err
+	}
+
+	// If the new order only reduces the size of the resting order, update the resting order in place.
+	if newOrder.IsSizeReductionOf(oldOrder) {
+		offchainUpdates, err := k.MemClob.ReduceOrderSize(ctx, newOrder)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		k.sendOffchainMessagesWithTxHash(
+			offchainUpdates,
+			tmhash.Sum(ctx.TxBytes()),
+			metrics.SendReplaceOrderOffchainUpdates,
+		)
+		return 0, types.Success, nil
+	}
+
+	// Validate the cancellation of the resting order before placing the new order. If both orders have the
+	// same `OrderId`, the memclob replaces the resting order when placing the new order.
+	isSameOrderId := msg.OldOrderId == newOrder.OrderId
+	msgCancelOrder := types.NewMsgCancelOrderShortTerm(msg.OldOrderId, oldOrder.GetGoodTilBlock())
+	if !isSameOrderId {
+		if err := k.PerformOrderCancellationStatefulValidation(ctx, msgCancelOrder, nextBlockHeight); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err := k.MemClob.PlaceOrder(
+		ctx,
+		newOrder,
+	)
+
+	// If the new order was not accepted by the orderbook, leave the resting order untouched.
+	if err != nil || (!orderStatus.IsSuccess() && orderSizeOptimisticallyFilledFromMatchingQuantums == 0) {
+		k.sendOffchainMessagesWithTxHash(
+			offchainUpdates,
+			tmhash.Sum(ctx.TxBytes()),
+			metrics.SendReplaceOrderOffchainUpdates,
+		)
+		if err != nil {
+			return 0, orderStatus, err
+		}
+		return 0, orderStatus, errorsmod.Wrapf(
+			types.ErrReplaceOrderFailed,
+			"ReplaceShortTermOrder: new order (%+v) has status %v",
+			newOrder,
+			orderStatus,
+		)
+	}
+
+	// Cancel the resting order now that the new order was accepted by the orderbook.
+	if !isSameOrderId {
+		cancelOffchainUpdates, err := k.MemClob.CancelOrder(ctx, msgCancelOrder)
+		if err != nil {
+			panic(
+				fmt.Sprintf(
+					"ReplaceShortTermOrder: failed to cancel order %+v after validation: %v",
+					msg.OldOrderId,
+					err,
+				),
+			)
+		}
+		offchainUpdates.Append(cancelOffchainUpdates)
+	}
+
+	// Send the removal of the resting order and the placement of the new order as a single replacement.
+	if k.GetIndexerEventManager().Enabled() {
+		if message, success := off_chain_updates.CreateOrderReplaceMessage(
+			k.Logger(ctx),
+			msg.OldOrderId,
+			newOrder,
+		); success {
+			offchainUpdates.CondenseMessagesForReplacement(msg.OldOrderId, newOrder.OrderId, message)
+		}
+	}
+	k.sendOffchainMessagesWithTxHash(
+		offchainUpdates,
+		tmhash.Sum(ctx.TxBytes()),
+		metrics.SendReplaceOrderOffchainUpdates,
+	)
+
+	return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, nil
+}
+
 // CancelStatefulOrder performs stateful order cancellation validation and removes the stateful order
 // from state and the memstore.
 //
@@ -288,6 +576,118 @@ func (k Keeper) PlaceStatefulOrder(
 	return nil
 }
 
+// ReplaceStatefulOrder atomically replaces an existing Long-Term order with a new Long-Term order.
+//
+// If the new order has the same `OrderId` as the existing order and only reduces its size, the existing order is
+// updated in place and retains its placement index, and thereby its queue priority on the orderbook. Otherwise,
+// the existing order is canceled and the new order is placed, and state is only written to if both succeed.
+//
+// An error will be returned if any of the following conditions are true:
+//   - The order to replace does not exist in state.
+//   - The new order has the same `OrderId` as the existing order but is not a size reduction of it.
+//   - Cancelling the existing order or placing the new order fails.
+//
+// Note that this method conditionally updates state depending on the context, see `PlaceStatefulOrder`. The size of
+// an order is only reduced during `DeliverTx`, and the reduction is applied to the orderbook in `PrepareCheckState`.
+//
+// This method will panic if the provided orders are not stateful orders.
+func (k Keeper) ReplaceStatefulOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (err error) {
+	defer func() {
+		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, metrics.ReplaceStatefulOrder, metrics.Error, metrics.Count},
+				1,
+				[]gometrics.Label{
+					metrics.GetLabelForStringValue(metrics.Callback, metrics.GetCallbackMetricFromCtx(ctx)),
+				},
+			)
+		} else {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, metrics.ReplaceStatefulOrder, metrics.Success, metrics.Count},
+				1,
+				[]gometrics.Label{
+					metrics.GetLabelForStringValue(metrics.Callback, metrics.GetCallbackMetricFromCtx(ctx)),
+				},
+			)
+		}
+	}()
+
+	// 1. Ensure the orders are not Short-Term orders.
+	newOrder := msg.NewOrder
+	newOrder.MustBeStatefulOrder()
+	msg.OldOrderId.MustBeStatefulOrder()
+
+	// 2. Ensure the order to replace exists in state.
+	oldOrderPlacement, found := k.GetLongTermOrderPlacement(ctx, msg.OldOrderId)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrStatefulOrderDoesNotExist,
+			"ReplaceStatefulOrder: order to replace %+v does not exist in state",
+			msg.OldOrderId,
+		)
+	}
+	oldOrder := oldOrderPlacement.GetOrder()
+
+	// 3. If both orders have the same `OrderId`, the new order may only reduce the size of the existing order.
+	if msg.OldOrderId == newOrder.OrderId {
+		if !newOrder.IsSizeReductionOf(oldOrder) {
+			return errorsmod.Wrapf(
+				types.ErrInvalidReplaceOrder,
+				"ReplaceStatefulOrder: order (%+v) with the OrderId of the existing order (%+v) may only reduce its size",
+				newOrder,
+				oldOrder,
+			)
+		}
+		return k.reduceStatefulOrderSize(ctx, newOrder)
+	}
+
+	// 4. Otherwise, cancel the existing order and place the new order. Fork the multistore so that neither
+	// is written to state if the other fails.
+	replaceOrderCtx, writeCache := ctx.CacheContext()
+	if err := k.CancelStatefulOrder(
+		replaceOrderCtx,
+		types.NewMsgCancelOrderStateful(msg.OldOrderId, oldOrder.GetGoodTilBlockTime()),
+	); err != nil {
+		return err
+	}
+	if err := k.PlaceStatefulOrder(replaceOrderCtx, msg.GetMsgPlaceOrder()); err != nil {
+		return err
+	}
+	writeCache()
+
+	return nil
+}
+
+// reduceStatefulOrderSize validates the size reduction of an existing stateful order, and writes the reduced
+// order to state during `DeliverTx`.
+func (k Keeper) reduceStatefulOrderSize(
+	ctx sdk.Context,
+	order types.Order,
+) error {
+	// Perform stateful validation on the order. Note that the order already exists in state.
+	if err := k.PerformStatefulOrderValidation(ctx, &order, 0, true); err != nil {
+		return err
+	}
+
+	if _, fillAmount, _ := k.GetOrderFillAmount(ctx, order.OrderId); fillAmount >= order.GetBaseQuantums() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidReplaceOrder,
+			"ReplaceStatefulOrder: order size %d must be greater than the filled amount %d",
+			order.GetBaseQuantums(),
+			fillAmount,
+		)
+	}
+
+	if lib.IsDeliverTxMode(ctx) {
+		k.MustReduceLongTermOrderPlacementSize(ctx, order)
+	}
+
+	return nil
+}
+
 // ReplayPlaceOrder returns the result of calling `PlaceOrder` on the memclob.
 // This method does not forward events directly to indexer, but instead returns
 // them in the form of `OffchainUpdates`. This method is meant to be used in the
@@ -296,6 +696,7 @@ func (k Keeper) PlaceStatefulOrder(
 //
 // An error will be returned if any of the following conditions are true:
 // - Standard stateful validation fails.
+// - The order is a Short-Term order placed by a grantee whose trading permission is no longer valid.
 // - The memclob itself returns an error.
 func (k Keeper) ReplayPlaceOrder(
 	ctx sdk.Context,
@@ -318,6 +719,14 @@ func (k Keeper) ReplayPlaceOrder(
 		return 0, 0, nil, err
 	}
 
+	// Short-Term orders are re-validated by the AnteHandler when they are included in a block. Ensure
+	// that orders placed by a grantee are only replayed while the grantee's trading permission is valid.
+	if order.IsShortTermOrder() {
+		if err := k.validateReplayedShortTermOrderTradingPermission(ctx); err != nil {
+			return 0, 0, nil, err
+		}
+	}
+
 	// Place the order on the memclob and return the result.
 	orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err = k.MemClob.PlaceOrder(
 		ctx,
@@ -471,6 +880,25 @@ func (k Keeper) PlaceStatefulOrdersFromLastBlock(
 		}
 
 		order := orderPlacement.GetOrder()
+
+		// If the order in state is a size reduction of the order on the orderbook, reduce the size of the
+		// order on the orderbook to retain its queue priority.
+		if restingOrder, found := k.MemClob.GetOrder(ctx, orderId); found && order.IsSizeReductionOf(restingOrder) {
+			reduceOrderSizeOffchainUpdates, err := k.MemClob.ReduceOrderSize(ctx, order)
+			if err != nil {
+				k.Logger(ctx).Error(
+					fmt.Sprintf(
+						"PlaceStatefulOrdersFromLastBlock: ReduceOrderSize() returned an error %+v for order %+v",
+						err,
+						order,
+					),
+				)
+			} else if k.indexerEventManager.Enabled() {
+				existingOffchainUpdates.Append(reduceOrderSizeOffchainUpdates)
+			}
+			continue
+		}
+
 		// Validate and place order.
 		_, orderStatus, placeOrderOffchainUpdates, err := k.AddPreexistingStatefulOrder(
 			ctx,
@@ -739,6 +1167,30 @@ func (k Keeper) PerformStatefulOrderValidation(
 		metrics.ValidateOrder,
 		metrics.Latency,
 	)
+
+	err := k.performStatefulOrderValidation(ctx, order, blockHeight, isPreexistingStatefulOrder)
+	if errors.Is(err, types.ErrOrderConflictsWithClobPairStatus) {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.ValidateOrder, metrics.OrderConflictsWithClobPairStatus, metrics.Count},
+			1,
+			append(
+				order.GetOrderLabels(),
+				metrics.GetLabelForBoolValue(metrics.CheckTx, ctx.IsCheckTx()),
+				metrics.GetLabelForBoolValue(metrics.DeliverTx, lib.IsDeliverTxMode(ctx)),
+			),
+		)
+	}
+	return err
+}
+
+// performStatefulOrderValidation performs the validation of `PerformStatefulOrderValidation` without
+// emitting any order placement metrics.
+func (k Keeper) performStatefulOrderValidation(
+	ctx sdk.Context,
+	order *types.Order,
+	blockHeight uint32,
+	isPreexistingStatefulOrder bool,
+) error {
 	clobPair, found := k.GetClobPair(ctx, order.GetClobPairId())
 	if !found {
 		return errorsmod.Wrapf(
@@ -768,15 +1220,6 @@ func (k Keeper) PerformStatefulOrderValidation(
 
 	// Validates the order against the ClobPair's status.
 	if err := k.validateOrderAgainstClobPairStatus(ctx, order.MustGetOrder(), clobPair); err != nil {
-		telemetry.IncrCounterWithLabels(
-			[]string{types.ModuleName, metrics.ValidateOrder, metrics.OrderConflictsWithClobPairStatus, metrics.Count},
-			1,
-			append(
-				order.GetOrderLabels(),
-				metrics.GetLabelForBoolValue(metrics.CheckTx, ctx.IsCheckTx()),
-				metrics.GetLabelForBoolValue(metrics.DeliverTx, lib.IsDeliverTxMode(ctx)),
-			),
-		)
 		return err
 	}
 
@@ -886,6 +1329,15 @@ func (k Keeper) PerformStatefulOrderValidation(
 					clobPair.StepBaseQuantums,
 				)
 			}
+
+			if order.TrailingOffsetSubticks%uint64(clobPair.SubticksPerTick) != 0 {
+				return errorsmod.Wrapf(
+					types.ErrInvalidPlaceOrder,
+					"Trailing stop order trailing offset subticks %v must be a multiple of the ClobPair's SubticksPerTick %v",
+					order.TrailingOffsetSubticks,
+					clobPair.SubticksPerTick,
+				)
+			}
 		}
 	}
 
@@ -979,9 +1431,7 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 
 	pendingUpdates := types.NewPendingUpdates()
 
-	// Retrieve the associated `PerpetualId` for the `ClobPair`.
 	oraclePriceSubticksRat := k.GetOraclePriceSubticksRat(ctx, clobPair)
-	perpetualId := clobPair.MustGetPerpetualId()
 
 	// TODO(DEC-1713): Complete as many calculations from getPessimisticCollateralCheckPrice as possible here
 	// so we aren't recalculating the same thing within the loop.
@@ -995,7 +1445,7 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 			metrics.Count,
 		)
 
-		makerFeePpm := k.feeTiersKeeper.GetPerpetualFeePpm(ctx, subaccountId.Owner, false)
+		makerFeePpm := k.feeTiersKeeper.GetPerpetualFeePpm(ctx, subaccountId.Owner, false, clobPairId.ToUint32())
 		// For each subaccount ID, create the update from all of its existing open orders for the clob and side.
 		for _, openOrder := range openOrders {
 			if openOrder.ClobPairId != clobPairId {
@@ -1015,8 +1465,8 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 				k.Logger(ctx).Error(
 					fmt.Sprintf(
 						"Integer overflow: oracle price (subticks) exceeded uint64 max. "+
-							"perpetual ID = (%d), oracle price = (%+v), is buy = (%t)",
-						perpetualId,
+							"clob pair ID = (%d), oracle price = (%+v), is buy = (%t)",
+						clobPairId,
 						oraclePriceSubticksRat,
 						openOrder.IsBuy,
 					),
@@ -1025,8 +1475,8 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 				panic(
 					errorsmod.Wrapf(
 						err,
-						"perpetual id = (%d), oracle price = (%+v), is buy = (%t)",
-						perpetualId,
+						"clob pair id = (%d), oracle price = (%+v), is buy = (%t)",
+						clobPairId,
 						oraclePriceSubticksRat,
 						openOrder.IsBuy,
 					),
@@ -1046,14 +1496,25 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 
 			bigFillAmount := openOrder.RemainingQuantums.ToBigInt()
 			addPerpetualFillAmountStart := time.Now()
-			pendingUpdates.AddPerpetualFill(
-				subaccountId,
-				perpetualId,
-				openOrder.IsBuy,
-				makerFeePpm,
-				bigFillAmount,
-				bigFillQuoteQuantums,
-			)
+			if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+				pendingUpdates.AddSpotFill(
+					subaccountId,
+					spotClobMetadata.BaseAssetId,
+					openOrder.IsBuy,
+					makerFeePpm,
+					bigFillAmount,
+					bigFillQuoteQuantums,
+				)
+			} else {
+				pendingUpdates.AddPerpetualFill(
+					subaccountId,
+					clobPair.MustGetPerpetualId(),
+					openOrder.IsBuy,
+					makerFeePpm,
+					bigFillAmount,
+					bigFillQuoteQuantums,
+				)
+			}
 			telemetry.ModuleMeasureSince(
 				types.ModuleName,
 				addPerpetualFillAmountStart,
@@ -1094,7 +1555,22 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 }
 
 // GetOraclePriceSubticksRat returns the oracle price in subticks for the given `ClobPair`.
+// This function will panic if the oracle price is zero.
 func (k Keeper) GetOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPair) *big.Rat {
+	oraclePriceSubticksRat, err := k.getOraclePriceSubticksRat(ctx, clobPair)
+	if err != nil {
+		panic(err)
+	}
+	return oraclePriceSubticksRat
+}
+
+// getOraclePriceSubticksRat returns the oracle price in subticks for the given `ClobPair`, or an
+// `ErrZeroPriceForOracle` error if the oracle price is zero.
+func (k Keeper) getOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPair) (*big.Rat, error) {
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		return k.getSpotOraclePriceSubticksRat(ctx, clobPair, spotClobMetadata.BaseAssetId)
+	}
+
 	// Retrieve the associated `PerpetualId` for the `ClobPair`.
 	perpetualId := clobPair.MustGetPerpetualId()
 
@@ -1113,17 +1589,46 @@ func (k Keeper) GetOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPa
 		lib.QuoteCurrencyAtomicResolution,
 	)
 	if oraclePriceSubticksRat.Cmp(big.NewRat(0, 1)) == 0 {
-		panic(
-			errorsmod.Wrapf(
-				types.ErrZeroPriceForOracle,
-				"clob pair ID = (%d), perpetual ID = (%d), market ID = (%d)",
-				clobPair.Id,
-				perpetualId,
-				marketPrice.Id,
-			),
+		return nil, errorsmod.Wrapf(
+			types.ErrZeroPriceForOracle,
+			"clob pair ID = (%d), perpetual ID = (%d), market ID = (%d)",
+			clobPair.Id,
+			perpetualId,
+			marketPrice.Id,
 		)
 	}
-	return oraclePriceSubticksRat
+	return oraclePriceSubticksRat, nil
+}
+
+// getSpotOraclePriceSubticksRat returns the oracle price in subticks for a spot `ClobPair`, using the
+// market of the pair's base asset, or an `ErrZeroPriceForOracle` error if the oracle price is zero.
+func (k Keeper) getSpotOraclePriceSubticksRat(
+	ctx sdk.Context,
+	clobPair types.ClobPair,
+	baseAssetId uint32,
+) (*big.Rat, error) {
+	asset, marketPrice, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, baseAssetId)
+	// If an error is returned, this implies stateful order validation was not performed properly, therefore panic.
+	if err != nil {
+		panic(errorsmod.Wrapf(err, "asset ID = (%d)", baseAssetId))
+	}
+
+	oraclePriceSubticksRat := types.PriceToSubticks(
+		marketPrice,
+		clobPair,
+		asset.AtomicResolution,
+		lib.QuoteCurrencyAtomicResolution,
+	)
+	if oraclePriceSubticksRat.Cmp(big.NewRat(0, 1)) == 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrZeroPriceForOracle,
+			"clob pair ID = (%d), asset ID = (%d), market ID = (%d)",
+			clobPair.Id,
+			baseAssetId,
+			marketPrice.Id,
+		)
+	}
+	return oraclePriceSubticksRat, nil
 }
 
 // GetStatePosition returns the current size of a subaccount's position for the specified `clobPairId`.
@@ -1137,21 +1642,23 @@ func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.Subaccoun
 		panic(fmt.Sprintf("GetStatePosition: CLOB pair %d not found", clobPairId))
 	}
 
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+
+	// For spot CLOB pairs, the position is the subaccount's balance of the base asset.
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		assetPosition, _ := subaccount.GetAssetPositionForId(spotClobMetadata.BaseAssetId)
+		return assetPosition.GetBigQuantums()
+	}
+
 	// Get the perpetual ID for this CLOB pair, and panic if it is not a perpetual CLOB.
 	perpetualId, err := clobPair.GetPerpetualId()
 	if err != nil {
-		panic(
-			errorsmod.Wrap(
-				err,
-				"GetStatePosition: Reduce-only orders for assets not implemented",
-			),
-		)
+		panic(errorsmod.Wrap(err, "GetStatePosition"))
 	}
 
 	// Get the position size corresponding to `perpetualId` held by this subaccount, negative
 	// if short and positive if long. If the subaccount does not have an open position
 	// corresponding to `perpetualId`, a position size of zero is returned.
-	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
 	position, _ := subaccount.GetPerpetualPositionForId(perpetualId)
 	return position.GetBigQuantums()
 }
@@ -1329,10 +1836,6 @@ func getPessimisticCollateralCheckPrice(
 
 // getFillQuoteQuantums returns the total fillAmount price in quote quantums based on the maker subticks.
 // This value is always positive.
-//
-// Returns an error if:
-// - The Order is not for a `PerpetualClob`.
-// - The underlying `Price` does not exist.
 func getFillQuoteQuantums(
 	clobPair types.ClobPair,
 	makerSubticks types.Subticks,
@@ -1345,10 +1848,6 @@ func getFillQuoteQuantums(
 		metrics.Latency,
 	)
 
-	if perpetualClobMetadata := clobPair.GetPerpetualClobMetadata(); perpetualClobMetadata == nil {
-		return nil, types.ErrAssetOrdersNotImplemented
-	}
-
 	quantumConversionExponent := clobPair.QuantumConversionExponent
 
 	quoteQuantums := types.FillAmountToQuoteQuantums(
@@ -2297,6 +2297,7 @@ func TestPlaceStatefulOrdersFromLastBlock(t *testing.T) {
 			memClob := &mocks.MemClob{}
 
 			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("GetOrder", mock.Anything, mock.Anything).Return(types.Order{}, false).Maybe()
 
 			ks := keepertest.NewClobKeepersTestContext(
 				t,
@@ -2379,6 +2380,60 @@ func TestPlaceStatefulOrdersFromLastBlock(t *testing.T) {
 	}
 }
 
+func TestPlaceStatefulOrdersFromLastBlock_ReducesOrderSize(t *testing.T) {
+	// Setup state.
+	memClob := &mocks.MemClob{}
+	memClob.On("SetClobKeeper", mock.Anything).Return()
+
+	ks := keepertest.NewClobKeepersTestContext(
+		t,
+		memClob,
+		&mocks.BankKeeper{},
+		indexer_manager.NewIndexerEventManagerNoop(),
+	)
+	prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+	perpetuals.InitGenesis(ks.Ctx, *ks.PerpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
+
+	ctx := ks.Ctx.WithBlockHeight(int64(100)).WithBlockTime(time.Unix(5, 0))
+	ctx = ctx.WithIsCheckTx(true)
+	ks.BlockTimeKeeper.SetPreviousBlockInfo(ctx, &blocktimetypes.BlockInfo{
+		Height:    100,
+		Timestamp: time.Unix(int64(5), 0),
+	})
+
+	// Create CLOB pair.
+	memClob.On("CreateOrderbook", mock.Anything, constants.ClobPair_Btc).Return()
+	_, err := ks.ClobKeeper.CreatePerpetualClobPair(
+		ctx,
+		constants.ClobPair_Btc.Id,
+		clobtest.MustPerpetualId(constants.ClobPair_Btc),
+		satypes.BaseQuantums(constants.ClobPair_Btc.StepBaseQuantums),
+		constants.ClobPair_Btc.QuantumConversionExponent,
+		constants.ClobPair_Btc.SubticksPerTick,
+		constants.ClobPair_Btc.Status,
+	)
+	require.NoError(t, err)
+
+	// The order in state is a size reduction of the order resting on the orderbook.
+	restingOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15
+	reducedOrder := restingOrder
+	reducedOrder.Quantums = 50
+	ks.ClobKeeper.SetLongTermOrderPlacement(ctx.WithIsCheckTx(false), reducedOrder, 0)
+
+	memClob.On("GetOrder", mock.Anything, reducedOrder.OrderId).Return(restingOrder, true)
+	memClob.On("ReduceOrderSize", mock.Anything, reducedOrder).Return(types.NewOffchainUpdates(), nil).Once()
+
+	// Run the test and verify the order is not placed again.
+	ks.ClobKeeper.PlaceStatefulOrdersFromLastBlock(
+		ctx,
+		[]types.OrderId{reducedOrder.OrderId},
+		types.NewOffchainUpdates(),
+	)
+
+	memClob.AssertExpectations(t)
+	memClob.AssertNotCalled(t, "PlaceOrder", mock.Anything, mock.Anything)
+}
+
 func TestPlaceConditionalOrdersTriggeredInLastBlock(t *testing.T) {
 	tests := map[string]struct {
 		triggeredOrders             []types.Order
@@ -2426,6 +2481,7 @@ func TestPlaceConditionalOrdersTriggeredInLastBlock(t *testing.T) {
 			memClob := &mocks.MemClob{}
 
 			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("GetOrder", mock.Anything, mock.Anything).Return(types.Order{}, false).Maybe()
 
 			ks := keepertest.NewClobKeepersTestContext(
 				t,
@@ -53,7 +53,9 @@ func (k Keeper) ProcessProposerOperations(
 	// Collect the list of order ids filled and set the field in the `ProcessProposerMatchesEvents` object.
 	processProposerMatchesEvents := k.GenerateProcessProposerMatchesEvents(ctx, operations)
 
-	// Remove fully filled orders from state.
+	// Remove fully filled orders from state, along with all other orders in the order group of each filled order.
+	// Note that a filled order may no longer exist in state if it was removed as part of the order group of an
+	// order that was filled before it.
 	for _, orderId := range processProposerMatchesEvents.OrderIdsFilledInLastBlock {
 		if orderId.IsShortTermOrder() {
 			continue
@@ -61,6 +63,12 @@ func (k Keeper) ProcessProposerOperations(
 
 		orderPlacement, placementExists := k.GetLongTermOrderPlacement(ctx, orderId)
 		if placementExists {
+			// Filling any amount of an order in an order group cancels all other orders in the order group.
+			processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+				processProposerMatchesEvents.RemovedStatefulOrderIds,
+				k.RemoveOrderGroupSiblings(ctx, orderPlacement.Order)...,
+			)
+
 			fillAmountExists, orderStateFillAmount, _ := k.GetOrderFillAmount(ctx, orderId)
 			if !fillAmountExists {
 				panic("ProcessProposerOperations: Order fill amount does not exist in state")
@@ -315,6 +323,18 @@ func (k Keeper) PersistOrderRemovalToState(
 	case types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE:
 		// TODO (CLOB-877)
 		k.statUnverifiedOrderRemoval(ctx, orderRemoval, orderToRemove)
+	case types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED:
+		// TODO (CLOB-877)
+		k.statUnverifiedOrderRemoval(ctx, orderRemoval, orderToRemove)
+
+		// Only the taker order is canceled by the self-trade prevention mode, so the order
+		// should not use the CANCEL_MAKER mode.
+		if orderToRemove.GetEffectiveSelfTradePrevention() == types.Order_SELF_TRADE_PREVENTION_CANCEL_MAKER {
+			return errorsmod.Wrap(
+				types.ErrInvalidOrderRemoval,
+				"Order self-trade prevention mode does not cancel the order.",
+			)
+		}
 	case types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED:
 		// TODO (CLOB-877)
 		k.statUnverifiedOrderRemoval(ctx, orderRemoval, orderToRemove)
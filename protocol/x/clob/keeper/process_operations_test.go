@@ -10,6 +10,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
@@ -19,6 +21,7 @@ import (
 	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -351,6 +354,154 @@ func TestProcessProposerOperations(t *testing.T) {
 				},
 			},
 		},
+		"Succeeds without paying the referral rebate when the fee collector can not fund it": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_100_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(1_000_000_000), // 10 BTC
+						},
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_100_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(1_000_000_000), // 10 BTC
+						},
+					},
+				},
+			},
+			preExistingStatefulOrders: []types.Order{},
+			setupState: func(ctx sdk.Context, ks keepertest.ClobKeepersTestContext) {
+				require.NoError(t, ks.FeeTiersKeeper.SetReferralParams(ctx, feetierstypes.ReferralParams{
+					ReferrerRebateSharePpm: 200_000,
+				}))
+				require.NoError(t, ks.FeeTiersKeeper.RegisterReferralCode(ctx, constants.CarlAccAddress.String(), "carl"))
+				require.NoError(t, ks.FeeTiersKeeper.SetReferrer(ctx, constants.Bob_Num0.Owner, "carl"))
+			},
+			setupMockBankKeeper: func(bk *mocks.BankKeeper) {
+				bk.On(
+					"SendCoinsFromModuleToModule",
+					mock.Anything,
+					mock.Anything,
+					mock.Anything,
+					mock.Anything,
+				).Return(nil)
+				// The fee collector can not pay the rebate, which is skipped without failing the match.
+				bk.On(
+					"SendCoinsFromModuleToAccount",
+					mock.Anything,
+					authtypes.FeeCollectorName,
+					constants.CarlAccAddress,
+					sdk.NewCoins(sdk.NewCoin(assettypes.AssetUsdc.Denom, sdkmath.NewInt(5_000))),
+				).Return(sdkerrors.ErrInsufficientFunds).Once()
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+						Side:         types.Order_SIDE_BUY,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+				),
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+				),
+				clobtest.NewMatchOperationRaw(
+					&types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+					[]types.MakerFill{
+						{
+							FillAmount:   100_000_000,
+							MakerOrderId: types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+						},
+					},
+				),
+			},
+			expectedMatches: []*MatchWithOrdersForTesting{
+				{
+					MatchWithOrders: types.MatchWithOrders{
+						TakerOrder: &types.Order{
+							OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+							Side:         types.Order_SIDE_SELL,
+							Quantums:     100_000_000,
+							Subticks:     50_000_000,
+							GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+						},
+						MakerOrder: &types.Order{
+							OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+							Side:         types.Order_SIDE_BUY,
+							Quantums:     100_000_000,
+							Subticks:     50_000_000,
+							GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+						},
+						FillAmount: 100_000_000,
+						MakerFee:   10_000,
+						TakerFee:   25_000,
+					},
+					TotalFilledMaker: 100_000_000,
+					TotalFilledTaker: 100_000_000,
+				},
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				OrderIdsFilledInLastBlock: []types.OrderId{
+					{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+					{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+				},
+				BlockHeight: blockHeight,
+			},
+			// Expected balances are initial balance + balance change due to order - fees
+			expectedQuoteBalances: map[satypes.SubaccountId]int64{
+				constants.Alice_Num0: constants.Usdc_Asset_100_000.GetBigQuantums().Int64() - 50_000_000 - 10_000,
+				constants.Bob_Num0:   constants.Usdc_Asset_100_000.GetBigQuantums().Int64() + 50_000_000 - 25_000,
+			},
+			expectedPerpetualPositions: map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+				constants.Bob_Num0: {
+					{
+						PerpetualId:  0,
+						Quantums:     dtypes.NewInt(1_000_000_000 - 100_000_000),
+						FundingIndex: dtypes.ZeroInt(),
+					},
+				},
+				constants.Alice_Num0: {
+					{
+						PerpetualId:  0,
+						Quantums:     dtypes.NewInt(1_000_000_000 + 100_000_000),
+						FundingIndex: dtypes.ZeroInt(),
+					},
+				},
+			},
+		},
 		"Succeeds with singular match of a preexisting maker and short term taker": {
 			perpetuals: []*perptypes.Perpetual{
 				&constants.BtcUsd_100PercentMarginRequirement,
@@ -953,6 +1104,53 @@ func TestProcessProposerOperations(t *testing.T) {
 				},
 			},
 		},
+		"Succeeds self-trade prevention order removal of a stateful order": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25_StpCancelBoth,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25_StpCancelBoth.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED,
+				),
+			},
+
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+				RemovedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25_StpCancelBoth.OrderId,
+				},
+			},
+		},
+		"Fails self-trade prevention order removal of an order that cancels the maker order": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED,
+				),
+			},
+
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
 		"Fails when attempting to match order with invalid order side": {
 			perpetuals: []*perptypes.Perpetual{
 				&constants.BtcUsd_100PercentMarginRequirement,
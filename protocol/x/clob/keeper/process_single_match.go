@@ -126,17 +126,29 @@ func (k Keeper) ProcessSingleMatch(
 		)
 	}
 
-	// Retrieve the associated perpetual id for the `ClobPair`.
-	perpetualId, err := clobPair.GetPerpetualId()
-	if err != nil {
-		return false, takerUpdateResult, makerUpdateResult, nil, err
+	// Retrieve the associated perpetual id for perpetual `ClobPair`s. Spot `ClobPair`s have no perpetual
+	// and are never liquidated.
+	var perpetualId uint32
+	if clobPair.IsSpotClobPair() {
+		if takerMatchableOrder.IsLiquidation() {
+			return false, takerUpdateResult, makerUpdateResult, nil, errorsmod.Wrapf(
+				types.ErrInvalidClob,
+				"ProcessSingleMatch: liquidation order for spot CLOB pair %d",
+				clobPair.Id,
+			)
+		}
+	} else {
+		perpetualId, err = clobPair.GetPerpetualId()
+		if err != nil {
+			return false, takerUpdateResult, makerUpdateResult, nil, err
+		}
 	}
 
 	// Calculate taker and maker fee ppms.
 	takerFeePpm := k.feeTiersKeeper.GetPerpetualFeePpm(
-		ctx, matchWithOrders.TakerOrder.GetSubaccountId().Owner, true)
+		ctx, matchWithOrders.TakerOrder.GetSubaccountId().Owner, true, clobPairId.ToUint32())
 	makerFeePpm := k.feeTiersKeeper.GetPerpetualFeePpm(
-		ctx, matchWithOrders.MakerOrder.GetSubaccountId().Owner, false)
+		ctx, matchWithOrders.MakerOrder.GetSubaccountId().Owner, false, clobPairId.ToUint32())
 
 	takerInsuranceFundDelta := new(big.Int)
 	if takerMatchableOrder.IsLiquidation() {
@@ -217,7 +229,7 @@ func (k Keeper) ProcessSingleMatch(
 	takerUpdateResult, makerUpdateResult, err = k.persistMatchedOrders(
 		ctx,
 		matchWithOrders,
-		perpetualId,
+		clobPair,
 		takerFeePpm,
 		makerFeePpm,
 		bigFillQuoteQuantums,
@@ -294,15 +306,15 @@ func (k Keeper) ProcessSingleMatch(
 }
 
 // persistMatchedOrders persists a matched order to the subaccount state,
-// by updating the quoteBalance and perpetual position size of the
-// affected subaccounts.
-// This method also transfers fees to the fee collector module, and
-// transfers insurance fund payments to the insurance fund.
+// by updating the quoteBalance and the perpetual position size (or base asset
+// balance for spot `ClobPair`s) of the affected subaccounts.
+// This method also transfers fees to the fee collector module, pays referral rebates
+// out of the fee collector module, and transfers insurance fund payments to the insurance fund.
 // This method mutates matchWithOrders by setting the fee fields.
 func (k Keeper) persistMatchedOrders(
 	ctx sdk.Context,
 	matchWithOrders *types.MatchWithOrders,
-	perpetualId uint32,
+	clobPair types.ClobPair,
 	takerFeePpm int32,
 	makerFeePpm int32,
 	bigFillQuoteQuantums *big.Int,
@@ -340,15 +352,15 @@ func (k Keeper) persistMatchedOrders(
 	bigTakerQuoteBalanceDelta := new(big.Int).Set(bigFillQuoteQuantums)
 	bigMakerQuoteBalanceDelta := new(big.Int).Set(bigFillQuoteQuantums)
 
-	bigTakerPerpetualQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
-	bigMakerPerpetualQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
+	bigTakerBaseQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
+	bigMakerBaseQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
 
 	if matchWithOrders.TakerOrder.IsBuy() {
 		bigTakerQuoteBalanceDelta.Neg(bigTakerQuoteBalanceDelta)
-		bigMakerPerpetualQuantumsDelta.Neg(bigMakerPerpetualQuantumsDelta)
+		bigMakerBaseQuantumsDelta.Neg(bigMakerBaseQuantumsDelta)
 	} else {
 		bigMakerQuoteBalanceDelta.Neg(bigMakerQuoteBalanceDelta)
-		bigTakerPerpetualQuantumsDelta.Neg(bigTakerPerpetualQuantumsDelta)
+		bigTakerBaseQuantumsDelta.Neg(bigTakerBaseQuantumsDelta)
 	}
 
 	// Subtract quote balance delta with fees paid.
@@ -363,37 +375,19 @@ func (k Keeper) persistMatchedOrders(
 	// Create the subaccount update.
 	updates := []satypes.Update{
 		// Taker update
-		{
-			AssetUpdates: []satypes.AssetUpdate{
-				{
-					AssetId:          assettypes.AssetUsdc.Id,
-					BigQuantumsDelta: bigTakerQuoteBalanceDelta,
-				},
-			},
-			PerpetualUpdates: []satypes.PerpetualUpdate{
-				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: bigTakerPerpetualQuantumsDelta,
-				},
-			},
-			SubaccountId: matchWithOrders.TakerOrder.GetSubaccountId(),
-		},
+		getMatchSubaccountUpdate(
+			clobPair,
+			matchWithOrders.TakerOrder.GetSubaccountId(),
+			bigTakerQuoteBalanceDelta,
+			bigTakerBaseQuantumsDelta,
+		),
 		// Maker update
-		{
-			AssetUpdates: []satypes.AssetUpdate{
-				{
-					AssetId:          assettypes.AssetUsdc.Id,
-					BigQuantumsDelta: bigMakerQuoteBalanceDelta,
-				},
-			},
-			PerpetualUpdates: []satypes.PerpetualUpdate{
-				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: bigMakerPerpetualQuantumsDelta,
-				},
-			},
-			SubaccountId: matchWithOrders.MakerOrder.GetSubaccountId(),
-		},
+		getMatchSubaccountUpdate(
+			clobPair,
+			matchWithOrders.MakerOrder.GetSubaccountId(),
+			bigMakerQuoteBalanceDelta,
+			bigMakerBaseQuantumsDelta,
+		),
 	}
 
 	// Apply the update.
@@ -451,14 +445,36 @@ func (k Keeper) persistMatchedOrders(
 		)
 	}
 
+	// Pay the taker's referrer their share of the taker fee out of the fees just collected. A rebate the
+	// fee collector can not fund is skipped by x/feetiers and does not fail the match.
+	bigReferralRebateQuoteQuantums, err := k.feeTiersKeeper.ProcessReferralRebate(
+		ctx,
+		matchWithOrders.TakerOrder.GetSubaccountId().Owner,
+		bigTakerFeeQuoteQuantums,
+		bigTotalFeeQuoteQuantums,
+	)
+	if err != nil {
+		return takerUpdateResult, makerUpdateResult, errorsmod.Wrapf(
+			types.ErrSubaccountFeeTransferFailed,
+			"persistMatchedOrders: subaccounts (%v, %v) updated, but referral rebate of taker fee "+
+				"(bigTakerFeeQuoteQuantums: %v) failed. Err: %v",
+			matchWithOrders.MakerOrder.GetSubaccountId(),
+			matchWithOrders.TakerOrder.GetSubaccountId(),
+			bigTakerFeeQuoteQuantums,
+			err,
+		)
+	}
+
 	// Process fill in x/stats and x/rewards.
 	k.rewardsKeeper.AddRewardSharesForFill(
 		ctx,
+		clobPair.GetClobPairId().ToUint32(),
 		matchWithOrders.TakerOrder.GetSubaccountId().Owner,
 		matchWithOrders.MakerOrder.GetSubaccountId().Owner,
 		bigFillQuoteQuantums,
 		bigTakerFeeQuoteQuantums,
 		bigMakerFeeQuoteQuantums,
+		bigReferralRebateQuoteQuantums,
 	)
 
 	k.statsKeeper.RecordFill(
@@ -469,6 +485,23 @@ func (k Keeper) persistMatchedOrders(
 	)
 
 	// Emit an event indicating a match occurred.
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		ctx.EventManager().EmitEvent(
+			types.NewCreateSpotMatchEvent(
+				matchWithOrders.TakerOrder.GetSubaccountId(),
+				matchWithOrders.MakerOrder.GetSubaccountId(),
+				bigTakerFeeQuoteQuantums,
+				bigMakerFeeQuoteQuantums,
+				bigTakerQuoteBalanceDelta,
+				bigMakerQuoteBalanceDelta,
+				bigTakerBaseQuantumsDelta,
+				bigMakerBaseQuantumsDelta,
+				spotClobMetadata.BaseAssetId,
+			),
+		)
+		return takerUpdateResult, makerUpdateResult, nil
+	}
+
 	ctx.EventManager().EmitEvent(
 		types.NewCreateMatchEvent(
 			matchWithOrders.TakerOrder.GetSubaccountId(),
@@ -477,18 +510,57 @@ func (k Keeper) persistMatchedOrders(
 			bigMakerFeeQuoteQuantums,
 			bigTakerQuoteBalanceDelta,
 			bigMakerQuoteBalanceDelta,
-			bigTakerPerpetualQuantumsDelta,
-			bigMakerPerpetualQuantumsDelta,
+			bigTakerBaseQuantumsDelta,
+			bigMakerBaseQuantumsDelta,
 			insuranceFundDelta,
 			isTakerLiquidation,
 			false,
-			perpetualId,
+			clobPair.MustGetPerpetualId(),
 		),
 	)
 
 	return takerUpdateResult, makerUpdateResult, nil
 }
 
+// getMatchSubaccountUpdate returns the subaccount update for one side of a match on `clobPair`.
+// Perpetual fills update the subaccount's perpetual position, while spot fills update the
+// subaccount's balance of the base asset.
+func getMatchSubaccountUpdate(
+	clobPair types.ClobPair,
+	subaccountId satypes.SubaccountId,
+	bigQuoteBalanceDelta *big.Int,
+	bigBaseQuantumsDelta *big.Int,
+) satypes.Update {
+	update := satypes.Update{
+		AssetUpdates: []satypes.AssetUpdate{
+			{
+				AssetId:          assettypes.AssetUsdc.Id,
+				BigQuantumsDelta: bigQuoteBalanceDelta,
+			},
+		},
+		SubaccountId: subaccountId,
+	}
+
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		update.AssetUpdates = append(
+			update.AssetUpdates,
+			satypes.AssetUpdate{
+				AssetId:          spotClobMetadata.BaseAssetId,
+				BigQuantumsDelta: bigBaseQuantumsDelta,
+			},
+		)
+		return update
+	}
+
+	update.PerpetualUpdates = []satypes.PerpetualUpdate{
+		{
+			PerpetualId:      clobPair.MustGetPerpetualId(),
+			BigQuantumsDelta: bigBaseQuantumsDelta,
+		},
+	}
+	return update
+}
+
 func (k Keeper) setOrderFillAmountsAndPruning(
 	ctx sdk.Context,
 	order types.Order,
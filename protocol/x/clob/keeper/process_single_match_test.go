@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSingleMatch_SelfTrade(t *testing.T) {
+	for name, selfTradePrevention := range types.Order_SelfTradePrevention_value {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			takerOrder := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+			takerOrder.SelfTradePrevention = types.Order_SelfTradePrevention(selfTradePrevention)
+			makerOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+			makerOrder.Subticks = takerOrder.Subticks
+
+			success, _, _, _, err := ks.ClobKeeper.ProcessSingleMatch(
+				ks.Ctx,
+				&types.MatchWithOrders{
+					TakerOrder: &takerOrder,
+					MakerOrder: &makerOrder,
+					FillAmount: satypes.BaseQuantums(5),
+				},
+			)
+			require.ErrorContains(t, err, "Match constitutes a self-trade")
+			require.False(t, success)
+		})
+	}
+}
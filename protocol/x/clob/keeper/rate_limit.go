@@ -63,6 +63,37 @@ func (k *Keeper) RateLimitPlaceOrder(ctx sdk.Context, msg *types.MsgPlaceOrder)
 	return k.placeOrderRateLimiter.RateLimit(ctx, msg)
 }
 
+// RateLimitBatchCancel rate limits each order cancellation in the batch as if it were submitted in its
+// own `MsgCancelOrder`. This ensures that batching cancellations cannot be used to exceed the configured
+// rate limits. The rate limiting is only performed during `CheckTx` and `ReCheckTx`.
+func (k *Keeper) RateLimitBatchCancel(ctx sdk.Context, msg *types.MsgBatchCancel) error {
+	for _, msgCancelOrder := range msg.GetMsgCancelOrders() {
+		if err := k.RateLimitCancelOrder(ctx, msgCancelOrder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RateLimitBatchPlaceOrders rate limits each order in the batch as if it were placed in its own
+// `MsgPlaceOrder`. This ensures that batching orders cannot be used to exceed the configured rate limits.
+// The rate limiting is only performed during `CheckTx` and `ReCheckTx`.
+func (k *Keeper) RateLimitBatchPlaceOrders(ctx sdk.Context, msg *types.MsgBatchPlaceOrders) error {
+	for _, order := range msg.Orders {
+		if err := k.RateLimitPlaceOrder(ctx, types.NewMsgPlaceOrder(order)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RateLimitReplaceOrder rate limits the order replacement as if the new order were placed in a
+// `MsgPlaceOrder`, since the replacement places a new order on the orderbook.
+// The rate limiting is only performed during `CheckTx` and `ReCheckTx`.
+func (k *Keeper) RateLimitReplaceOrder(ctx sdk.Context, msg *types.MsgReplaceOrder) error {
+	return k.RateLimitPlaceOrder(ctx, msg.GetMsgPlaceOrder())
+}
+
 func (k *Keeper) PruneRateLimits(ctx sdk.Context) {
 	k.placeOrderRateLimiter.PruneRateLimits(ctx)
 	k.cancelOrderRateLimiter.PruneRateLimits(ctx)
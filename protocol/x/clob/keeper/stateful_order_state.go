@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -37,7 +39,7 @@ func (k Keeper) SetLongTermOrderPlacement(
 	// If this is a Short-Term order, panic.
 	order.MustBeStatefulOrder()
 
-	_, found := k.GetLongTermOrderPlacement(ctx, order.GetOrderId())
+	existingLongTermOrderPlacement, found := k.GetLongTermOrderPlacement(ctx, order.GetOrderId())
 
 	// Get the next stateful order block transaction index, defaulting to zero if not set.
 	// Note that the transaction index will always be overwritten at the end of this method.
@@ -62,6 +64,12 @@ func (k Keeper) SetLongTermOrderPlacement(
 	// Write the `LongTermOrderPlacement` to memstore.
 	memStore.Set(orderKey, longTermOrderPlacementBytes)
 
+	// Update the order group of the order, removing a replaced order from its previous order group.
+	if found && existingLongTermOrderPlacement.Order.OrderGroupId != order.OrderGroupId {
+		k.removeOrderFromOrderGroup(ctx, existingLongTermOrderPlacement.Order)
+	}
+	k.addOrderToOrderGroup(ctx, order)
+
 	if !found {
 		// Increment the stateful order count.
 		k.SetStatefulOrderCount(
@@ -81,6 +89,57 @@ func (k Keeper) SetLongTermOrderPlacement(
 	}
 }
 
+// MustReduceLongTermOrderPlacementSize overwrites the order of an existing long term order placement with
+// a size reduction of the order. The `PlacementIndex` of the existing placement is retained, and since only
+// the size of the order changes, the order group and expiry of the order are unaffected.
+// This function will panic if the order doesn't exist in state or the order is not a size reduction of the
+// existing order.
+func (k Keeper) MustReduceLongTermOrderPlacementSize(
+	ctx sdk.Context,
+	order types.Order,
+) {
+	longTermOrderPlacement, found := k.GetLongTermOrderPlacement(ctx, order.OrderId)
+	if !found {
+		panic(
+			fmt.Sprintf(
+				"MustReduceLongTermOrderPlacementSize: order (%+v) does not exist in state",
+				order.OrderId,
+			),
+		)
+	}
+
+	if !order.IsSizeReductionOf(longTermOrderPlacement.Order) {
+		panic(
+			fmt.Sprintf(
+				"MustReduceLongTermOrderPlacementSize: order (%+v) is not a size reduction of order (%+v)",
+				order,
+				longTermOrderPlacement.Order,
+			),
+		)
+	}
+
+	longTermOrderPlacement.Order = order
+	longTermOrderPlacementBytes := k.cdc.MustMarshal(&longTermOrderPlacement)
+
+	store, memStore := k.fetchStateStoresForOrder(ctx, order.OrderId)
+	orderKey := order.OrderId.ToStateKey()
+
+	// Write the `LongTermOrderPlacement` to state.
+	store.Set(orderKey, longTermOrderPlacementBytes)
+
+	// Write the `LongTermOrderPlacement` to memstore.
+	memStore.Set(orderKey, longTermOrderPlacementBytes)
+
+	// Reduce the size of the order in the in-memory `UntriggeredConditionalOrders` if it is an untriggered
+	// conditional order, so that the in-memory copy of the order does not diverge from state.
+	if order.OrderId.IsConditionalOrder() && !k.IsConditionalOrderTriggered(ctx, order.OrderId) {
+		clobPairId := types.ClobPairId(order.GetClobPairId())
+		if untriggeredConditionalOrders, exists := k.UntriggeredConditionalOrders[clobPairId]; exists {
+			untriggeredConditionalOrders.ReduceUntriggeredConditionalOrderSize(order.OrderId, order.GetBaseQuantums())
+		}
+	}
+}
+
 // GetTriggeredConditionalOrderPlacement gets an triggered conditional order placement from the memstore.
 // Returns false if no triggered conditional order exists in memstore with `orderId`.
 func (k Keeper) GetTriggeredConditionalOrderPlacement(
@@ -136,8 +195,8 @@ func (k Keeper) GetLongTermOrderPlacement(
 	return val, true
 }
 
-// DeleteLongTermOrderPlacement deletes a long term order and the placement information from state
-// and decrements the stateful order count if the `orderId` exists.
+// DeleteLongTermOrderPlacement deletes a long term order and the placement information from state,
+// removes the order from its order group and decrements the stateful order count if the `orderId` exists.
 // This function is a no-op if no stateful order exists in state with `orderId`.
 func (k Keeper) DeleteLongTermOrderPlacement(
 	ctx sdk.Context,
@@ -152,7 +211,12 @@ func (k Keeper) DeleteLongTermOrderPlacement(
 	// same regardless of whether the memstore has the order or not.
 	count := k.GetStatefulOrderCount(ctx, orderId.SubaccountId)
 	orderKey := orderId.ToStateKey()
-	if memStore.Has(orderKey) {
+	if b := memStore.Get(orderKey); b != nil {
+		// Remove the order from its order group, if any.
+		var longTermOrderPlacement types.LongTermOrderPlacement
+		k.cdc.MustUnmarshal(b, &longTermOrderPlacement)
+		k.removeOrderFromOrderGroup(ctx, longTermOrderPlacement.Order)
+
 		if count == 0 {
 			k.Logger(ctx).Error(
 				"Stateful order count is zero but order is in the memstore. Underflow",
@@ -283,6 +347,59 @@ func (k Keeper) MustTriggerConditionalOrder(
 	)
 }
 
+// MustSetUntriggeredConditionalOrderTriggerSubticks updates the trigger price of an untriggered
+// conditional order in state and memstore, leaving the rest of its order placement unchanged.
+// This is used for moving the trigger price of trailing stop orders as the oracle price moves.
+// A conditional order trigger subticks update event is emitted if the trigger price changed.
+// This function will panic if the order does not exist in Untriggered state.
+func (k Keeper) MustSetUntriggeredConditionalOrderTriggerSubticks(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	triggerSubticks types.Subticks,
+) {
+	// If this is not a conditional order, panic.
+	orderId.MustBeConditionalOrder()
+
+	untriggeredConditionalOrderMemStore := k.GetUntriggeredConditionalOrderPlacementMemStore(ctx)
+	untriggeredConditionalOrderStore := k.GetUntriggeredConditionalOrderPlacementStore(ctx)
+
+	orderKey := orderId.ToStateKey()
+	bytes := untriggeredConditionalOrderMemStore.Get(orderKey)
+	if bytes == nil {
+		panic(
+			fmt.Sprintf(
+				"MustSetUntriggeredConditionalOrderTriggerSubticks: conditional order Id does not exist in "+
+					"Untriggered state: %+v",
+				orderId,
+			),
+		)
+	}
+	var longTermOrderPlacement types.LongTermOrderPlacement
+	k.cdc.MustUnmarshal(bytes, &longTermOrderPlacement)
+
+	if longTermOrderPlacement.Order.ConditionalOrderTriggerSubticks == triggerSubticks.ToUint64() {
+		return
+	}
+	longTermOrderPlacement.Order.ConditionalOrderTriggerSubticks = triggerSubticks.ToUint64()
+
+	// Write the updated `LongTermOrderPlacement` to the Untriggered state store/memstore.
+	longTermOrderPlacementBytes := k.cdc.MustMarshal(&longTermOrderPlacement)
+	untriggeredConditionalOrderStore.Set(orderKey, longTermOrderPlacementBytes)
+	untriggeredConditionalOrderMemStore.Set(orderKey, longTermOrderPlacementBytes)
+
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewConditionalOrderTriggerSubticksUpdateEvent(
+				orderId,
+				triggerSubticks,
+			),
+		),
+	)
+}
+
 // MustAddOrderToStatefulOrdersTimeSlice adds a new `OrderId` to an existing time slice, or creates a new time slice
 // containing the `OrderId` and writes it to state. It first sorts all order IDs before writing them
 // to state to avoid non-determinism issues.
@@ -2,12 +2,15 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
@@ -250,6 +253,84 @@ func TestMustTriggerConditionalOrder(t *testing.T) {
 	)
 }
 
+func TestMustSetUntriggeredConditionalOrderTriggerSubticks(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+	conditionalOrder := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, conditionalOrder, 0)
+
+	// Setting the trigger subticks to their current value does not emit an indexer event.
+	ks.ClobKeeper.MustSetUntriggeredConditionalOrderTriggerSubticks(
+		ks.Ctx,
+		conditionalOrder.OrderId,
+		types.Subticks(conditionalOrder.ConditionalOrderTriggerSubticks),
+	)
+	indexerEventManager.AssertNotCalled(t, "AddTxnEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// Moving the trigger subticks emits a conditional order trigger subticks update event.
+	indexerEventManager.On("AddTxnEvent",
+		ks.Ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewConditionalOrderTriggerSubticksUpdateEvent(
+				conditionalOrder.OrderId,
+				types.Subticks(25),
+			),
+		),
+	).Once().Return()
+	ks.ClobKeeper.MustSetUntriggeredConditionalOrderTriggerSubticks(
+		ks.Ctx,
+		conditionalOrder.OrderId,
+		types.Subticks(25),
+	)
+	indexerEventManager.AssertExpectations(t)
+
+	longTermOrderPlacement, found := ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, conditionalOrder.OrderId)
+	require.True(t, found)
+	require.Equal(t, uint64(25), longTermOrderPlacement.Order.ConditionalOrderTriggerSubticks)
+}
+
+func TestMustReduceLongTermOrderPlacementSize_UntriggeredConditionalOrder(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	conditionalOrder := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, conditionalOrder, 0)
+	ks.ClobKeeper.AddUntriggeredConditionalOrders(
+		ks.Ctx,
+		[]types.OrderId{conditionalOrder.OrderId},
+		map[types.OrderId]struct{}{},
+		map[types.OrderId]struct{}{},
+	)
+
+	reducedOrder := conditionalOrder
+	reducedOrder.Quantums = conditionalOrder.Quantums - 1
+	ks.ClobKeeper.MustReduceLongTermOrderPlacementSize(ks.Ctx, reducedOrder)
+
+	// The in-memory copy of the untriggered conditional order is reduced along with state.
+	clobPairId := types.ClobPairId(conditionalOrder.GetClobPairId())
+	untriggeredConditionalOrders := ks.ClobKeeper.UntriggeredConditionalOrders[clobPairId]
+	require.Equal(
+		t,
+		[]types.Order{reducedOrder},
+		untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+	)
+
+	// Trigger the order and verify the triggered order has the reduced size.
+	triggeredOrderIds := untriggeredConditionalOrders.PollTriggeredConditionalOrders(
+		big.NewRat(int64(conditionalOrder.ConditionalOrderTriggerSubticks), 1),
+	)
+	require.Equal(t, []types.OrderId{conditionalOrder.OrderId}, triggeredOrderIds)
+	ks.ClobKeeper.MustTriggerConditionalOrder(ks.Ctx, conditionalOrder.OrderId)
+
+	triggeredPlacement, found := ks.ClobKeeper.GetTriggeredConditionalOrderPlacement(ks.Ctx, conditionalOrder.OrderId)
+	require.True(t, found)
+	require.Equal(t, reducedOrder, triggeredPlacement.Order)
+}
+
 func TestGetSetDeleteLongTermOrderState(t *testing.T) {
 	// Setup keeper state and test parameters.
 	memClob := memclob.NewMemClobPriceTimePriority(false)
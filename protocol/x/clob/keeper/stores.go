@@ -112,6 +112,24 @@ func (k Keeper) getStatefulOrdersTimeSliceStore(ctx sdk.Context) prefix.Store {
 	)
 }
 
+// getOrderGroupStore fetches a state store used for creating,
+// reading, updating, and deleting an order group from state.
+func (k Keeper) getOrderGroupStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.OrderGroupKeyPrefix),
+	)
+}
+
+// getTradingPermissionStore fetches a state store used for creating,
+// reading, updating, and deleting a trading permission from state.
+func (k Keeper) getTradingPermissionStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.TradingPermissionKeyPrefix),
+	)
+}
+
 // getTransientStore fetches a transient store used for reading and
 // updating the transient store.
 func (k Keeper) getTransientStore(ctx sdk.Context) sdk.KVStore {
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetTradingPermission gets the trading permission of `grantee` for subaccount `subaccountId` from state.
+// Returns false if the grantee does not have a trading permission for the subaccount.
+func (k Keeper) GetTradingPermission(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	grantee string,
+) (permission types.TradingPermission, found bool) {
+	store := k.getTradingPermissionStore(ctx)
+	b := store.Get(tradingPermissionKey(subaccountId, grantee))
+	if b == nil {
+		return permission, false
+	}
+
+	k.cdc.MustUnmarshal(b, &permission)
+	return permission, true
+}
+
+// GetAllTradingPermissions returns all trading permissions in state, ordered by subaccount and grantee.
+func (k Keeper) GetAllTradingPermissions(ctx sdk.Context) (list []types.TradingPermission) {
+	store := k.getTradingPermissionStore(ctx)
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TradingPermission
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// SetTradingPermission validates and writes a trading permission to state, replacing any existing
+// trading permission of the grantee for the subaccount. It returns an error if the permission fails
+// stateless validation, references a clob pair that does not exist, or has already expired.
+func (k Keeper) SetTradingPermission(
+	ctx sdk.Context,
+	permission types.TradingPermission,
+) error {
+	if err := k.validateTradingPermission(ctx, permission); err != nil {
+		return err
+	}
+
+	if permission.IsExpired(k.blockTimeKeeper.GetPreviousBlockInfo(ctx).Timestamp) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidTradingPermission,
+			"expiration time %d is not after the previous block time",
+			permission.ExpirationTime,
+		)
+	}
+
+	k.setTradingPermission(ctx, permission)
+	return nil
+}
+
+// InitializeTradingPermission validates and writes a trading permission from genesis to state. Unlike
+// `SetTradingPermission`, expired permissions are written as-is since they are never honored.
+func (k Keeper) InitializeTradingPermission(
+	ctx sdk.Context,
+	permission types.TradingPermission,
+) error {
+	if err := k.validateTradingPermission(ctx, permission); err != nil {
+		return err
+	}
+
+	k.setTradingPermission(ctx, permission)
+	return nil
+}
+
+// validateTradingPermission returns an error if the permission fails stateless validation or
+// references a clob pair that does not exist.
+func (k Keeper) validateTradingPermission(
+	ctx sdk.Context,
+	permission types.TradingPermission,
+) error {
+	if err := permission.Validate(); err != nil {
+		return err
+	}
+
+	for _, clobPairId := range permission.ClobPairIds {
+		if _, found := k.GetClobPair(ctx, types.ClobPairId(clobPairId)); !found {
+			return errorsmod.Wrapf(
+				types.ErrInvalidTradingPermission,
+				"clob pair %d does not exist",
+				clobPairId,
+			)
+		}
+	}
+	return nil
+}
+
+// setTradingPermission writes a trading permission to state without validating it.
+func (k Keeper) setTradingPermission(
+	ctx sdk.Context,
+	permission types.TradingPermission,
+) {
+	store := k.getTradingPermissionStore(ctx)
+	store.Set(
+		tradingPermissionKey(permission.SubaccountId, permission.Grantee),
+		k.cdc.MustMarshal(&permission),
+	)
+}
+
+// RemoveTradingPermission removes the trading permission of `grantee` for subaccount `subaccountId`
+// from state. It returns an error if the permission does not exist. Orders previously placed by the
+// grantee are not canceled.
+func (k Keeper) RemoveTradingPermission(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	grantee string,
+) error {
+	store := k.getTradingPermissionStore(ctx)
+	key := tradingPermissionKey(subaccountId, grantee)
+	if !store.Has(key) {
+		return errorsmod.Wrapf(
+			types.ErrTradingPermissionNotFound,
+			"subaccount %+v, grantee %s",
+			subaccountId,
+			grantee,
+		)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// ValidateMsgTradingPermission returns an error if `msg` places or cancels orders on behalf of a
+// subaccount owner and the grantee signing the message does not have a trading permission covering
+// every order placement and cancellation in the message. Messages without a grantee, and messages
+// that do not place or cancel orders, are always valid.
+//
+// Expiration of the trading permission is evaluated against the previous block's `BlockTime`, so that
+// a Short-Term order placed in `CheckTx` remains valid when it is included in the next block.
+func (k Keeper) ValidateMsgTradingPermission(
+	ctx sdk.Context,
+	msg sdk.Msg,
+) error {
+	var (
+		grantee      string
+		subaccountId satypes.SubaccountId
+		placements   []types.Order
+		cancelations []types.ClobPairId
+	)
+
+	switch msg := msg.(type) {
+	case *types.MsgPlaceOrder:
+		grantee = msg.Grantee
+		subaccountId = msg.Order.OrderId.SubaccountId
+		placements = []types.Order{msg.Order}
+	case *types.MsgCancelOrder:
+		grantee = msg.Grantee
+		subaccountId = msg.OrderId.SubaccountId
+		cancelations = []types.ClobPairId{types.ClobPairId(msg.OrderId.GetClobPairId())}
+	case *types.MsgReplaceOrder:
+		grantee = msg.Grantee
+		subaccountId = msg.NewOrder.OrderId.SubaccountId
+		placements = []types.Order{msg.NewOrder}
+		cancelations = []types.ClobPairId{types.ClobPairId(msg.OldOrderId.GetClobPairId())}
+	case *types.MsgBatchPlaceOrders:
+		if len(msg.Orders) == 0 {
+			return nil
+		}
+		grantee = msg.Grantee
+		subaccountId = msg.Orders[0].OrderId.SubaccountId
+		placements = msg.Orders
+	case *types.MsgBatchCancel:
+		grantee = msg.Grantee
+		subaccountId = msg.SubaccountId
+		for _, batch := range msg.ShortTermCancels {
+			cancelations = append(cancelations, types.ClobPairId(batch.ClobPairId))
+		}
+	default:
+		return nil
+	}
+
+	if grantee == "" {
+		return nil
+	}
+
+	permission, found := k.GetTradingPermission(ctx, subaccountId, grantee)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrTradingPermissionNotFound,
+			"subaccount %+v, grantee %s",
+			subaccountId,
+			grantee,
+		)
+	}
+
+	if permission.IsExpired(k.blockTimeKeeper.GetPreviousBlockInfo(ctx).Timestamp) {
+		return errorsmod.Wrapf(
+			types.ErrTradingPermissionExpired,
+			"subaccount %+v, grantee %s, expiration time %d",
+			subaccountId,
+			grantee,
+			permission.ExpirationTime,
+		)
+	}
+
+	for _, order := range placements {
+		if err := permission.ValidateOrder(order); err != nil {
+			return err
+		}
+	}
+
+	for _, clobPairId := range cancelations {
+		if err := permission.ValidateClobPair(clobPairId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateReplayedShortTermOrderTradingPermission decodes the transaction of a replayed Short-Term order
+// from `ctx.TxBytes()` and returns an error if the transaction's message fails trading permission
+// validation. This is a no-op if the context does not contain transaction bytes, or if they cannot be
+// decoded, since transactions placed through `CheckTx` have already been decoded successfully.
+func (k Keeper) validateReplayedShortTermOrderTradingPermission(ctx sdk.Context) error {
+	if len(ctx.TxBytes()) == 0 {
+		return nil
+	}
+
+	tx, err := k.txDecoder(ctx.TxBytes())
+	if err != nil {
+		return nil
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := k.ValidateMsgTradingPermission(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tradingPermissionKey returns the state key of the trading permission of `grantee` for subaccount
+// `subaccountId`.
+func tradingPermissionKey(
+	subaccountId satypes.SubaccountId,
+	grantee string,
+) []byte {
+	return append(subaccountId.ToStateKey(), []byte(grantee)...)
+}
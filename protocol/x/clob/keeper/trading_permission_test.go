@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSetRemoveTradingPermission(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+	keepertest.CreateNClobPair(
+		t,
+		ks.ClobKeeper,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		ks.Ctx,
+		2,
+		mockIndexerEventManager,
+	)
+	ks.BlockTimeKeeper.SetPreviousBlockInfo(ks.Ctx, &blocktimetypes.BlockInfo{
+		Timestamp: time.Unix(100, 0),
+	})
+
+	grantee := constants.BobAccAddress.String()
+	_, found := ks.ClobKeeper.GetTradingPermission(ks.Ctx, constants.Alice_Num0, grantee)
+	require.False(t, found)
+
+	// Permissions for clob pairs that do not exist cannot be granted.
+	err := ks.ClobKeeper.SetTradingPermission(ks.Ctx, types.TradingPermission{
+		SubaccountId: constants.Alice_Num0,
+		Grantee:      grantee,
+		ClobPairIds:  []uint32{0, 2},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidTradingPermission)
+
+	// Permissions that have already expired cannot be granted.
+	err = ks.ClobKeeper.SetTradingPermission(ks.Ctx, types.TradingPermission{
+		SubaccountId:   constants.Alice_Num0,
+		Grantee:        grantee,
+		ExpirationTime: 100,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidTradingPermission)
+
+	// Expired permissions can be imported from genesis.
+	expiredPermission := types.TradingPermission{
+		SubaccountId:   constants.Alice_Num1,
+		Grantee:        grantee,
+		ExpirationTime: 100,
+	}
+	require.NoError(t, ks.ClobKeeper.InitializeTradingPermission(ks.Ctx, expiredPermission))
+	got, found := ks.ClobKeeper.GetTradingPermission(ks.Ctx, constants.Alice_Num1, grantee)
+	require.True(t, found)
+	require.Equal(t, expiredPermission, got)
+	require.NoError(t, ks.ClobKeeper.RemoveTradingPermission(ks.Ctx, constants.Alice_Num1, grantee))
+
+	permission := types.TradingPermission{
+		SubaccountId:     constants.Alice_Num0,
+		Grantee:          grantee,
+		ClobPairIds:      []uint32{1},
+		MaxOrderQuantums: 10,
+		ExpirationTime:   101,
+	}
+	require.NoError(t, ks.ClobKeeper.SetTradingPermission(ks.Ctx, permission))
+	got, found = ks.ClobKeeper.GetTradingPermission(ks.Ctx, constants.Alice_Num0, grantee)
+	require.True(t, found)
+	require.Equal(t, permission, got)
+
+	// Permissions are scoped to a single subaccount and grantee.
+	_, found = ks.ClobKeeper.GetTradingPermission(ks.Ctx, constants.Alice_Num1, grantee)
+	require.False(t, found)
+	_, found = ks.ClobKeeper.GetTradingPermission(ks.Ctx, constants.Alice_Num0, constants.CarlAccAddress.String())
+	require.False(t, found)
+
+	// Granting a permission again replaces the existing permission.
+	permission.ClobPairIds = nil
+	require.NoError(t, ks.ClobKeeper.SetTradingPermission(ks.Ctx, permission))
+	got, found = ks.ClobKeeper.GetTradingPermission(ks.Ctx, constants.Alice_Num0, grantee)
+	require.True(t, found)
+	require.Empty(t, got.ClobPairIds)
+	require.Equal(t, []types.TradingPermission{got}, ks.ClobKeeper.GetAllTradingPermissions(ks.Ctx))
+
+	require.NoError(t, ks.ClobKeeper.RemoveTradingPermission(ks.Ctx, constants.Alice_Num0, grantee))
+	_, found = ks.ClobKeeper.GetTradingPermission(ks.Ctx, constants.Alice_Num0, grantee)
+	require.False(t, found)
+	require.Empty(t, ks.ClobKeeper.GetAllTradingPermissions(ks.Ctx))
+	require.ErrorIs(
+		t,
+		ks.ClobKeeper.RemoveTradingPermission(ks.Ctx, constants.Alice_Num0, grantee),
+		types.ErrTradingPermissionNotFound,
+	)
+}
+
+func TestValidateMsgTradingPermission(t *testing.T) {
+	grantee := constants.BobAccAddress.String()
+	// Buys 5 quantums on clob pair 0.
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+
+	tests := map[string]struct {
+		permission *types.TradingPermission
+		msg        sdk.Msg
+		err        error
+	}{
+		"message without grantee": {
+			msg: types.NewMsgPlaceOrder(order),
+		},
+		"message that does not place or cancel orders": {
+			msg: types.NewMsgRevokeTradingPermission(constants.Alice_Num0, grantee),
+		},
+		"place order: permission not found": {
+			msg: &types.MsgPlaceOrder{Order: order, Grantee: grantee},
+			err: types.ErrTradingPermissionNotFound,
+		},
+		"place order: permission for a different subaccount": {
+			permission: &types.TradingPermission{
+				SubaccountId: constants.Alice_Num1,
+				Grantee:      grantee,
+			},
+			msg: &types.MsgPlaceOrder{Order: order, Grantee: grantee},
+			err: types.ErrTradingPermissionNotFound,
+		},
+		"place order: permission expired": {
+			permission: &types.TradingPermission{
+				SubaccountId:   constants.Alice_Num0,
+				Grantee:        grantee,
+				ExpirationTime: 200,
+			},
+			msg: &types.MsgPlaceOrder{Order: order, Grantee: grantee},
+			err: types.ErrTradingPermissionExpired,
+		},
+		"place order: clob pair not allowed": {
+			permission: &types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      grantee,
+				ClobPairIds:  []uint32{1},
+			},
+			msg: &types.MsgPlaceOrder{Order: order, Grantee: grantee},
+			err: types.ErrTradingPermissionClobPairNotAllowed,
+		},
+		"place order: order size exceeded": {
+			permission: &types.TradingPermission{
+				SubaccountId:     constants.Alice_Num0,
+				Grantee:          grantee,
+				MaxOrderQuantums: 4,
+			},
+			msg: &types.MsgPlaceOrder{Order: order, Grantee: grantee},
+			err: types.ErrTradingPermissionOrderSizeExceeded,
+		},
+		"place order: allowed": {
+			permission: &types.TradingPermission{
+				SubaccountId:     constants.Alice_Num0,
+				Grantee:          grantee,
+				ClobPairIds:      []uint32{0},
+				MaxOrderQuantums: 5,
+				ExpirationTime:   201,
+			},
+			msg: &types.MsgPlaceOrder{Order: order, Grantee: grantee},
+		},
+		"cancel order: clob pair not allowed": {
+			permission: &types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      grantee,
+				ClobPairIds:  []uint32{1},
+			},
+			msg: &types.MsgCancelOrder{
+				OrderId:      order.OrderId,
+				GoodTilOneof: &types.MsgCancelOrder_GoodTilBlock{GoodTilBlock: 15},
+				Grantee:      grantee,
+			},
+			err: types.ErrTradingPermissionClobPairNotAllowed,
+		},
+		"cancel order: allowed": {
+			permission: &types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      grantee,
+				ClobPairIds:  []uint32{0},
+			},
+			msg: &types.MsgCancelOrder{
+				OrderId:      order.OrderId,
+				GoodTilOneof: &types.MsgCancelOrder_GoodTilBlock{GoodTilBlock: 15},
+				Grantee:      grantee,
+			},
+		},
+		"batch cancel: clob pair not allowed": {
+			permission: &types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      grantee,
+				ClobPairIds:  []uint32{0},
+			},
+			msg: &types.MsgBatchCancel{
+				SubaccountId: constants.Alice_Num0,
+				ShortTermCancels: []types.OrderBatch{
+					{ClobPairId: 0, ClientIds: []uint32{0}},
+					{ClobPairId: 1, ClientIds: []uint32{0}},
+				},
+				GoodTilBlock: 15,
+				Grantee:      grantee,
+			},
+			err: types.ErrTradingPermissionClobPairNotAllowed,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+			keepertest.CreateNClobPair(
+				t,
+				ks.ClobKeeper,
+				ks.PerpetualsKeeper,
+				ks.PricesKeeper,
+				ks.Ctx,
+				2,
+				mockIndexerEventManager,
+			)
+
+			ks.BlockTimeKeeper.SetPreviousBlockInfo(ks.Ctx, &blocktimetypes.BlockInfo{
+				Timestamp: time.Unix(100, 0),
+			})
+			if tc.permission != nil {
+				require.NoError(t, ks.ClobKeeper.SetTradingPermission(ks.Ctx, *tc.permission))
+			}
+			ks.BlockTimeKeeper.SetPreviousBlockInfo(ks.Ctx, &blocktimetypes.BlockInfo{
+				Timestamp: time.Unix(200, 0),
+			})
+
+			err := ks.ClobKeeper.ValidateMsgTradingPermission(ks.Ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // UntriggeredConditionalOrders is an in-memory struct stored on the clob Keeper.
@@ -20,12 +21,12 @@ import (
 // optimal runtime a an AVL-tree backed priority queue would work.
 // TODO(CLOB-717) Change list to use priority queue.
 type UntriggeredConditionalOrders struct {
-	// All untriggered take profit buy orders and stop loss sell orders sorted by time priority.
+	// All untriggered take profit buy orders and stop loss and trailing stop sell orders sorted by time priority.
 	// These orders will be triggered when the oracle price goes lower than or equal to the trigger price.
 	// This array functions like a max heap.
 	OrdersToTriggerWhenOraclePriceLTETriggerPrice []types.Order
 
-	// All untriggered take profit sell orders and stop loss buy orders sorted by time priority.
+	// All untriggered take profit sell orders and stop loss and trailing stop buy orders sorted by time priority.
 	// These orders will be triggered when the oracle price goes greater than or equal to the trigger price.
 	// This array functions like a min heap.
 	OrdersToTriggerWhenOraclePriceGTETriggerPrice []types.Order
@@ -104,7 +105,7 @@ func (untriggeredOrders *UntriggeredConditionalOrders) AddUntriggeredConditional
 		}
 	}
 
-	if order.IsStopLossOrder() {
+	if order.IsStopLossOrder() || order.IsTrailingStopOrder() {
 		if order.IsBuy() {
 			untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice = append(
 				untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
@@ -119,6 +120,25 @@ func (untriggeredOrders *UntriggeredConditionalOrders) AddUntriggeredConditional
 	}
 }
 
+// ReduceUntriggeredConditionalOrderSize sets the size of the untriggered conditional order with the provided
+// order id to `quantums`. Only the size of the order is updated, so that the trigger price of trailing stop
+// orders is retained. This is a no-op if the order does not exist in the `UntriggeredConditionalOrders`.
+func (untriggeredOrders *UntriggeredConditionalOrders) ReduceUntriggeredConditionalOrderSize(
+	orderId types.OrderId,
+	quantums satypes.BaseQuantums,
+) {
+	for _, orders := range [][]types.Order{
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+	} {
+		for i := range orders {
+			if orders[i].OrderId == orderId {
+				orders[i].Quantums = quantums.ToUint64()
+			}
+		}
+	}
+}
+
 // PruneUntriggeredConditionalOrders takes in lists of expired and cancelled stateful order ids and removes
 // all respective orders from the in-memory `UntriggeredConditionalOrders` data structure. This data structure
 // stores untriggered orders in a map of ClobPairId -> []Order, so we first group orders by ClobPairId and then
@@ -215,6 +235,33 @@ func (untriggeredOrders *UntriggeredConditionalOrders) RemoveUntriggeredConditio
 	untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice = newOrdersToTriggerWhenOraclePriceGTETriggerPrice
 }
 
+// UpdateTrailingStopTriggerSubticks moves the trigger price of all untriggered trailing stop orders
+// given a new oracle price for a clobPairId. It returns the orders whose trigger price was updated,
+// so that the updated trigger prices can be persisted to state. This is only called in EndBlocker.
+func (untriggeredOrders *UntriggeredConditionalOrders) UpdateTrailingStopTriggerSubticks(
+	oraclePriceSubticksRat *big.Rat,
+	subticksPerTick types.SubticksPerTick,
+) (updatedOrders []types.Order) {
+	updatedOrders = make([]types.Order, 0)
+	for _, orders := range [][]types.Order{
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+	} {
+		for i := range orders {
+			if !orders[i].IsTrailingStopOrder() {
+				continue
+			}
+
+			triggerSubticks := orders[i].GetTrailingStopTriggerSubticks(oraclePriceSubticksRat, subticksPerTick)
+			if triggerSubticks.ToUint64() != orders[i].ConditionalOrderTriggerSubticks {
+				orders[i].ConditionalOrderTriggerSubticks = triggerSubticks.ToUint64()
+				updatedOrders = append(updatedOrders, orders[i])
+			}
+		}
+	}
+	return updatedOrders
+}
+
 // PollTriggeredConditionalOrders removes all triggered conditional orders from the
 // `UntriggeredConditionalOrders` struct given a new oracle price for a clobPairId. It returns
 // a list of order ids that were triggered. This is only called in EndBlocker. We round up to the nearest
@@ -260,15 +307,24 @@ func (untriggeredOrders *UntriggeredConditionalOrders) PollTriggeredConditionalO
 	return triggeredOrderIds
 }
 
-// MaybeTriggerConditionalOrders queries the prices module for price updates and triggers
-// any conditional orders in `UntriggeredConditionalOrders` that can be triggered. For each triggered
+// MaybeTriggerConditionalOrders queries the prices module for price updates, updates the trigger price
+// of untriggered trailing stop orders, and triggers any conditional orders in `UntriggeredConditionalOrders`
+// that can be triggered. For each triggered
 // order, it takes the stateful order placement stored in Untriggered state and moves it to Triggered state.
 // A conditional order trigger event is emitted for each triggered order.
+// Triggering an order in an order group removes all other orders in the order group from state and
+// from `UntriggeredConditionalOrders`. An order that is removed this way is not triggered, even if it was
+// polled out in the same block.
 // Function returns a sorted list of conditional order ids that were triggered, intended to be written
-// to `ProcessProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock`.
+// to `ProcessProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock`, and a list of order ids
+// that were removed as part of the order group of a triggered order, intended to be written to
+// `ProcessProposerMatchesEvents.RemovedStatefulOrderIds`.
 // This function is called in EndBlocker.
-func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditionalOrderIds []types.OrderId) {
-	triggeredConditionalOrderIds = make([]types.OrderId, 0)
+func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (
+	triggeredConditionalOrderIds []types.OrderId,
+	orderGroupRemovedOrderIds []types.OrderId,
+) {
+	polledConditionalOrderIds := make([]types.OrderId, 0)
 	// Sort the keys for the untriggered conditional orders struct. We need to trigger
 	// the conditional orders in an ordered way to have deterministic state writes.
 	sortedKeys := lib.GetSortedKeys[types.SortedClobPairId](k.UntriggeredConditionalOrders)
@@ -287,17 +343,39 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditi
 			)
 		}
 		currentOraclePriceSubticksRat := k.GetOraclePriceSubticksRat(ctx, clobPair)
+
+		// State write - persist the trigger price of trailing stop orders that trailed the oracle price.
+		updatedTrailingStopOrders := untriggeredConditionalOrders.UpdateTrailingStopTriggerSubticks(
+			currentOraclePriceSubticksRat,
+			clobPair.GetClobPairSubticksPerTick(),
+		)
+		for _, order := range updatedTrailingStopOrders {
+			k.MustSetUntriggeredConditionalOrderTriggerSubticks(
+				ctx,
+				order.OrderId,
+				types.Subticks(order.ConditionalOrderTriggerSubticks),
+			)
+		}
+
 		triggeredOrderIds := untriggeredConditionalOrders.PollTriggeredConditionalOrders(
 			currentOraclePriceSubticksRat,
 		)
-		triggeredConditionalOrderIds = append(triggeredConditionalOrderIds, triggeredOrderIds...)
+		polledConditionalOrderIds = append(polledConditionalOrderIds, triggeredOrderIds...)
 		// Set the modified untriggeredConditionalOrders back on the keeper field.
 		k.UntriggeredConditionalOrders[clobPairId] = untriggeredConditionalOrders
 	}
 
 	// State write - move the conditional order placement in state from untriggered to triggered state.
 	// Emit an event for each triggered conditional order.
-	for _, triggeredConditionalOrderId := range triggeredConditionalOrderIds {
+	triggeredConditionalOrderIds = make([]types.OrderId, 0, len(polledConditionalOrderIds))
+	orderGroupRemovedOrderIds = make([]types.OrderId, 0)
+	orderGroupRemovedOrderIdsSet := make(map[types.OrderId]struct{})
+	for _, triggeredConditionalOrderId := range polledConditionalOrderIds {
+		// Skip orders that were removed as part of the order group of a previously triggered order.
+		if _, removed := orderGroupRemovedOrderIdsSet[triggeredConditionalOrderId]; removed {
+			continue
+		}
+
 		k.MustTriggerConditionalOrder(
 			ctx,
 			triggeredConditionalOrderId,
@@ -312,6 +390,18 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditi
 				),
 			),
 		)
+		triggeredConditionalOrderIds = append(triggeredConditionalOrderIds, triggeredConditionalOrderId)
+
+		// State write - remove all other orders in the order group of the triggered order.
+		triggeredOrderPlacement, _ := k.GetTriggeredConditionalOrderPlacement(ctx, triggeredConditionalOrderId)
+		for _, orderId := range k.RemoveOrderGroupSiblings(ctx, triggeredOrderPlacement.Order) {
+			orderGroupRemovedOrderIds = append(orderGroupRemovedOrderIds, orderId)
+			orderGroupRemovedOrderIdsSet[orderId] = struct{}{}
+		}
 	}
-	return triggeredConditionalOrderIds
+
+	// Prune orders removed as part of an order group from the in-memory `UntriggeredConditionalOrders`.
+	k.PruneUntriggeredConditionalOrders([]types.OrderId{}, orderGroupRemovedOrderIds)
+
+	return triggeredConditionalOrderIds, orderGroupRemovedOrderIds
 }
@@ -437,3 +437,104 @@ func TestPollTriggeredConditionalOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateTrailingStopTriggerSubticks(t *testing.T) {
+	trailingStopSell := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20
+	trailingStopSell.ConditionType = types.Order_CONDITION_TYPE_TRAILING_STOP
+	trailingStopSell.TrailingOffsetSubticks = 5
+
+	trailingStopBuy := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+	trailingStopBuy.ConditionType = types.Order_CONDITION_TYPE_TRAILING_STOP
+	trailingStopBuy.TrailingOffsetSubticks = 5
+
+	withTriggerSubticks := func(order types.Order, triggerSubticks uint64) types.Order {
+		order.ConditionalOrderTriggerSubticks = triggerSubticks
+		return order
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		conditionalOrdersToAdd []types.Order
+		currentSubticks        *big.Rat
+
+		// Expectations.
+		expectedUpdatedOrders                                 []types.Order
+		expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice []types.Order
+		expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice []types.Order
+	}{
+		"Trailing stop sell trigger price follows the oracle price up": {
+			conditionalOrdersToAdd: []types.Order{
+				trailingStopSell,
+				constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Sell25_Price10_GTBT15_StopLoss10,
+			},
+			currentSubticks: big.NewRat(30, 1),
+
+			expectedUpdatedOrders: []types.Order{
+				withTriggerSubticks(trailingStopSell, 25),
+			},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				withTriggerSubticks(trailingStopSell, 25),
+				constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Sell25_Price10_GTBT15_StopLoss10,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{},
+		},
+		"Trailing stop buy trigger price follows the oracle price down": {
+			conditionalOrdersToAdd: []types.Order{
+				trailingStopBuy,
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy15_Price25_GTBT15_StopLoss25,
+			},
+			currentSubticks: big.NewRat(12, 1),
+
+			expectedUpdatedOrders: []types.Order{
+				withTriggerSubticks(trailingStopBuy, 17),
+			},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				withTriggerSubticks(trailingStopBuy, 17),
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy15_Price25_GTBT15_StopLoss25,
+			},
+		},
+		"Trailing stop trigger prices do not move against the order": {
+			conditionalOrdersToAdd: []types.Order{
+				trailingStopSell,
+				trailingStopBuy,
+			},
+			currentSubticks: big.NewRat(20, 1),
+
+			expectedUpdatedOrders: []types.Order{},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				trailingStopSell,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				trailingStopBuy,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			untriggeredConditionalOrders := keeper.NewUntriggeredConditionalOrders()
+
+			for _, order := range tc.conditionalOrdersToAdd {
+				untriggeredConditionalOrders.AddUntriggeredConditionalOrder(order)
+			}
+
+			updatedOrders := untriggeredConditionalOrders.UpdateTrailingStopTriggerSubticks(
+				tc.currentSubticks,
+				types.SubticksPerTick(1),
+			)
+
+			require.Equal(t, tc.expectedUpdatedOrders, updatedOrders)
+			require.Equal(
+				t,
+				tc.expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice,
+				untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+			)
+			require.Equal(
+				t,
+				tc.expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice,
+				untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+			)
+		})
+	}
+}
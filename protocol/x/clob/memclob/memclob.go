@@ -436,7 +436,17 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	offchainUpdates = types.NewOffchainUpdates()
 
 	// Validate the order and return an error if any validation fails.
-	if err := m.validateNewOrder(ctx, order); err != nil {
+	validateOrderStartTime := time.Now()
+	err = m.validateNewOrder(ctx, order)
+	telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		validateOrderStartTime,
+		metrics.PlaceOrder,
+		metrics.Memclob,
+		metrics.ValidateOrder,
+		metrics.Latency,
+	)
+	if err != nil {
 		return 0, 0, offchainUpdates, err
 	}
 
@@ -536,6 +546,16 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				)
 			}
 		}
+		// If stateful taker order was canceled by its self-trade prevention mode, add Order Removal
+		// to operations queue to remove the order from state.
+		if takerOrderStatus.OrderStatus == types.SelfTradePreventionCanceled && order.IsStatefulOrder() {
+			if !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
+				m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
+					order.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED,
+				)
+			}
+		}
 		return orderSizeOptimisticallyFilledFromMatchingQuantums, takerOrderStatus.OrderStatus, offchainUpdates, nil
 	}
 
@@ -588,10 +608,19 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	// The taker order has unfilled size which will be added to the orderbook as a maker order.
 	// Verify the maker order can be added to the orderbook by performing the add-to-orderbook
 	// collateralization check.
+	addOrderToOrderbookCollateralizationCheckStartTime := time.Now()
 	addOrderOrderStatus := m.addOrderToOrderbookCollateralizationCheck(
 		ctx,
 		order,
 	)
+	telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		addOrderToOrderbookCollateralizationCheckStartTime,
+		metrics.PlaceOrder,
+		metrics.Memclob,
+		metrics.AddToOrderbookCollateralizationCheck,
+		metrics.Latency,
+	)
 
 	// If the add order to orderbook collateralization check failed, we cannot add the order to the orderbook.
 	if !addOrderOrderStatus.IsSuccess() {
@@ -654,6 +683,68 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	return orderSizeOptimisticallyFilledFromMatchingQuantums, types.Success, offchainUpdates, nil
 }
 
+// SimulatePlaceOrder simulates placing an order on the orderbook. It performs the same validation,
+// matching and collateralization checks as `PlaceOrder` and returns the matches the order would
+// generate along with the resulting order status or error. The memclob is only read and never modified,
+// and no order placement metrics are emitted. The state changes from the matches are written to `ctx` if
+// `PlaceOrder` would have committed them, so callers should pass a branched context that is discarded
+// afterwards.
+func (m *MemClobPriceTimePriority) SimulatePlaceOrder(
+	ctx sdk.Context,
+	order types.Order,
+) (
+	matches []types.MatchWithOrders,
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	// Validate the order and return an error if any validation fails.
+	if err := m.validateNewOrder(ctx, order); err != nil {
+		return nil, 0, 0, err
+	}
+
+	// Attempt to match the order against the orderbook. Unlike `matchOrder`, the matched maker orders
+	// are not updated or removed from the orderbook.
+	branchedContext, writeCache := ctx.CacheContext()
+	_, matches, _, _, _, takerOrderStatus := m.mustPerformTakerOrderMatching(branchedContext, &order)
+
+	// Fill-or-kill orders that were not fully filled and post-only orders that crossed the book are
+	// canceled without any of their matches being committed.
+	if order.TimeInForce == types.Order_TIME_IN_FORCE_FILL_OR_KILL && takerOrderStatus.RemainingQuantums > 0 {
+		return nil, 0, 0, types.ErrFokOrderCouldNotBeFullyFilled
+	}
+	if len(matches) > 0 && order.TimeInForce == types.Order_TIME_IN_FORCE_POST_ONLY {
+		return nil, 0, 0, types.ErrPostOnlyWouldCrossMakerOrder
+	}
+	writeCache()
+
+	orderSizeOptimisticallyFilledFromMatchingQuantums = takerOrderStatus.OrderOptimisticallyFilledQuantums
+
+	// If the status of the taker order is not successful, the order would not be added to the orderbook.
+	if !takerOrderStatus.OrderStatus.IsSuccess() {
+		return matches, orderSizeOptimisticallyFilledFromMatchingQuantums, takerOrderStatus.OrderStatus, nil
+	}
+
+	// If the order has no remaining size, the order would not be added to the orderbook.
+	if takerOrderStatus.RemainingQuantums == 0 {
+		return matches, orderSizeOptimisticallyFilledFromMatchingQuantums, takerOrderStatus.OrderStatus, nil
+	}
+
+	// IOC orders cannot be maker orders, so their remaining size would be canceled.
+	if order.GetTimeInForce() == types.Order_TIME_IN_FORCE_IOC {
+		return matches, orderSizeOptimisticallyFilledFromMatchingQuantums, types.ImmediateOrCancelWouldRestOnBook, nil
+	}
+
+	// The remaining size of the order would be added to the orderbook as a maker order if it passes the
+	// add-to-orderbook collateralization check.
+	return matches,
+		orderSizeOptimisticallyFilledFromMatchingQuantums,
+		m.addOrderToOrderbookCollateralizationCheck(ctx, order),
+		nil
+}
+
 // PlacePerpetualLiquidation matches an IOC liquidation order against the orderbook. Specifically,
 // it will perform the following operations:
 //   - If the liquidation order overlaps the orderbook, it will match orders within that orderbook
@@ -738,6 +829,7 @@ func (m *MemClobPriceTimePriority) matchOrder(
 
 	// Attempt to match the order against the orderbook.
 	newMakerFills,
+		_,
 		matchedOrderHashToOrder,
 		matchedMakerOrderIdToOrder,
 		makerOrdersToRemove,
@@ -1311,14 +1403,6 @@ func (m *MemClobPriceTimePriority) validateNewOrder(
 ) (
 	err error,
 ) {
-	defer telemetry.ModuleMeasureSince(
-		types.ModuleName,
-		time.Now(),
-		metrics.PlaceOrder,
-		metrics.Memclob,
-		metrics.ValidateOrder,
-		metrics.Latency,
-	)
 	orderId := order.OrderId
 
 	if orderId.IsShortTermOrder() {
@@ -1413,14 +1497,6 @@ func (m *MemClobPriceTimePriority) addOrderToOrderbookCollateralizationCheck(
 	ctx sdk.Context,
 	order types.Order,
 ) types.OrderStatus {
-	defer telemetry.ModuleMeasureSince(
-		types.ModuleName,
-		time.Now(),
-		metrics.PlaceOrder,
-		metrics.Memclob,
-		metrics.AddToOrderbookCollateralizationCheck,
-		metrics.Latency,
-	)
 
 	orderId := order.OrderId
 	subaccountId := orderId.SubaccountId
@@ -1492,6 +1568,8 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 ) (
 	// A slice of new maker fills created from matching this taker order.
 	newMakerFills []types.MakerFill,
+	// A slice of the processed matches, including fees, created from matching this taker order.
+	newMatches []types.MatchWithOrders,
 	// A map of matched order hashes to the order.
 	matchedOrderHashToOrder map[types.OrderHash]types.MatchableOrder,
 	// A map of matched maker order IDs to the order.
@@ -1507,6 +1585,7 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 ) {
 	// Initialize return variables.
 	newMakerFills = make([]types.MakerFill, 0)
+	newMatches = make([]types.MatchWithOrders, 0)
 	matchedOrderHashToOrder = make(map[types.OrderHash]types.MatchableOrder)
 	matchedMakerOrderIdToOrder = make(map[types.OrderId]types.Order)
 	takerOrderStatus.OrderStatus = types.Success
@@ -1519,6 +1598,13 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 	takerSubaccountId := newTakerOrder.GetSubaccountId()
 	takerIsLiquidation := newTakerOrder.IsLiquidation()
 
+	// Liquidation orders always cancel resting orders from the liquidated subaccount on a self-match.
+	takerSelfTradePrevention := types.Order_SELF_TRADE_PREVENTION_CANCEL_MAKER
+	if !takerIsLiquidation {
+		takerOrder := newTakerOrder.MustGetOrder()
+		takerSelfTradePrevention = takerOrder.GetEffectiveSelfTradePrevention()
+	}
+
 	// Store the remaining size of the taker order to determine the filled amount of an order after
 	// matching has ended.
 	// If the order is a liquidation, then the remaining size is the full size of the order.
@@ -1538,6 +1624,9 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 		}
 	}
 	takerRemainingSizeBeforeMatching := takerRemainingSize
+	// The size of the taker order that was decremented by the decrement-and-cancel self-trade
+	// prevention mode. This size is unfilled and can no longer be matched.
+	var takerDecrementedSize satypes.BaseQuantums
 
 	// Initialize variables used for tracking matches made during this matching cycle.
 	var makerLevelOrder *types.LevelOrder
@@ -1594,16 +1683,56 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 		}
 
 		// If the matched maker order does not have same order ID and is from the same subaccount
-		// as the taker order, then we cannot match the orders. Cancel the maker order and continue matching.
-		// TODO(DEC-1562): determine if we should handle self-trades differently.
+		// as the taker order, then we cannot match the orders. Handle the self-match according to
+		// the self-trade prevention mode of the taker order:
+		// - CANCEL_MAKER: cancel the maker order and continue matching.
+		// - CANCEL_TAKER: cancel the remaining size of the taker order and stop matching.
+		// - CANCEL_BOTH: cancel the maker order and the remaining size of the taker order, and stop matching.
+		// - DECREMENT_AND_CANCEL: cancel the maker order, decrement the remaining size of the taker order
+		//   by the remaining size of the maker order and continue matching if the taker order has
+		//   remaining size. The taker order is not added to the orderbook after matching.
 		if makerSubaccountId == takerSubaccountId {
-			makerOrdersToRemove = append(
-				makerOrdersToRemove,
-				OrderWithRemovalReason{
-					Order:         makerOrder.Order,
-					RemovalReason: types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
-				},
-			)
+			if takerSelfTradePrevention != types.Order_SELF_TRADE_PREVENTION_CANCEL_TAKER {
+				makerOrdersToRemove = append(
+					makerOrdersToRemove,
+					OrderWithRemovalReason{
+						Order:         makerOrder.Order,
+						RemovalReason: types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+					},
+				)
+			}
+
+			if takerSelfTradePrevention == types.Order_SELF_TRADE_PREVENTION_CANCEL_TAKER ||
+				takerSelfTradePrevention == types.Order_SELF_TRADE_PREVENTION_CANCEL_BOTH {
+				takerOrderStatus.OrderStatus = types.SelfTradePreventionCanceled
+				break
+			}
+
+			if takerSelfTradePrevention == types.Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL {
+				makerRemainingSize, makerHasRemainingSize := m.GetOrderRemainingAmount(ctx, makerOrder.Order)
+				if !makerHasRemainingSize {
+					panic(
+						fmt.Sprintf(
+							"mustPerformTakerOrderMatching: maker order has no remaining amount %v",
+							makerOrder.Order,
+						),
+					)
+				}
+
+				decrementedSize := makerRemainingSize
+				if takerRemainingSize < decrementedSize {
+					decrementedSize = takerRemainingSize
+				}
+				takerRemainingSize -= decrementedSize
+				takerDecrementedSize += decrementedSize
+				takerOrderStatus.OrderStatus = types.SelfTradePreventionCanceled
+
+				// If the taker order was fully decremented, stop matching.
+				if takerRemainingSize == 0 {
+					break
+				}
+			}
+
 			continue
 		}
 
@@ -1787,6 +1916,7 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 			MakerOrderId: makerOrderId,
 			FillAmount:   matchedAmount.ToUint64(),
 		})
+		newMatches = append(newMatches, matchWithOrders)
 
 		// 4.
 		if newTakerOrder.IsReduceOnly() && takerRemainingSize > 0 {
@@ -1804,11 +1934,14 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 		}
 	}
 
-	// Update the remaining size of the taker order now that matching has ended.
-	takerOrderStatus.RemainingQuantums = takerRemainingSize
-	takerOrderStatus.OrderOptimisticallyFilledQuantums = takerRemainingSizeBeforeMatching - takerRemainingSize
+	// Update the remaining size of the taker order now that matching has ended. Size decremented by
+	// self-trade prevention was not filled, so it is included in the remaining size.
+	takerOrderStatus.RemainingQuantums = takerRemainingSize + takerDecrementedSize
+	takerOrderStatus.OrderOptimisticallyFilledQuantums = takerRemainingSizeBeforeMatching -
+		takerOrderStatus.RemainingQuantums
 
 	return newMakerFills,
+		newMatches,
 		matchedOrderHashToOrder,
 		matchedMakerOrderIdToOrder,
 		makerOrdersToRemove,
@@ -2022,6 +2155,88 @@ func (m *MemClobPriceTimePriority) RemoveOrderIfFilled(
 	}
 }
 
+// ReduceOrderSize reduces the size of an order resting on the orderbook without changing its position
+// in the queue of its price level. The provided order must be a size reduction of the resting order with
+// the same `OrderId`, see `Order.IsSizeReductionOf`.
+//
+// An error will be returned if any of the following conditions are true:
+//   - The order is not resting on the orderbook.
+//   - The provided order is not a size reduction of the resting order.
+//   - The resting order was matched in the current block.
+//   - The reduced size is not greater than the filled amount of the order.
+//
+// Must be invoked with `CheckTx` context.
+func (m *MemClobPriceTimePriority) ReduceOrderSize(
+	ctx sdk.Context,
+	order types.Order,
+) (
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	levelOrder, exists := m.openOrders.orderIdToLevelOrder[order.OrderId]
+	if !exists {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidReplaceOrder,
+			"ReduceOrderSize: order %+v is not resting on the orderbook",
+			order.OrderId,
+		)
+	}
+
+	restingOrder := levelOrder.Value.Order
+	if !order.IsSizeReductionOf(restingOrder) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidReplaceOrder,
+			"ReduceOrderSize: order (%+v) is not a size reduction of resting order (%+v)",
+			order,
+			restingOrder,
+		)
+	}
+
+	// The operations queue references orders by their hash, so an order can't be modified after it was matched.
+	if m.operationsToPropose.IsOrderPlacementInOperationsQueue(restingOrder) ||
+		m.operationsToPropose.IsOrderPlacementInOperationsQueue(order) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidReplaceOrder,
+			"ReduceOrderSize: order (%+v) was matched in the current block",
+			order.OrderId,
+		)
+	}
+
+	if filledAmount := m.GetOrderFilledAmount(ctx, order.OrderId); filledAmount >= order.GetBaseQuantums() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidReplaceOrder,
+			"ReduceOrderSize: order size %d must be greater than the filled amount %d",
+			order.GetBaseQuantums(),
+			filledAmount,
+		)
+	}
+
+	// Update the order in place, which preserves its position in the price level.
+	levelOrder.Value.Order = order
+
+	// Short-Term orders are proposed using the bytes of the transaction that placed them, so track the
+	// transaction that reduced the order instead.
+	if order.IsShortTermOrder() {
+		m.operationsToPropose.RemoveShortTermOrderTxBytes(restingOrder)
+		m.operationsToPropose.MustAddShortTermOrderTxBytes(order, ctx.TxBytes())
+	}
+
+	offchainUpdates = types.NewOffchainUpdates()
+	if m.generateOffchainUpdates {
+		if message, success := off_chain_updates.CreateOrderReplaceMessage(
+			m.clobKeeper.Logger(ctx),
+			order.OrderId,
+			order,
+		); success {
+			offchainUpdates.AddReplaceMessage(order.OrderId, message)
+		}
+	}
+
+	return offchainUpdates, nil
+}
+
 // maybeCancelReduceOnlyOrders cancels all open reduce-only orders on the CLOB pair if the new fill would change the
 // position side of the subaccount.
 func (m *MemClobPriceTimePriority) maybeCancelReduceOnlyOrders(
@@ -2107,6 +2322,87 @@ func (m *MemClobPriceTimePriority) GetMidPrice(
 	return subticks, exists
 }
 
+// GetOrderbookLevels returns the price levels of the orderbook for the given clob pair, with bids sorted
+// by descending price and asks sorted by ascending price. At most `depth` levels are returned per side,
+// or all levels if `depth` is zero. If `includeOrders` is true, each level also contains its resting
+// orders in time priority. Returns `exists = false` if no orderbook exists for the clob pair.
+// Note that the size of each level only accounts for the remaining (unfilled) size of its orders.
+func (m *MemClobPriceTimePriority) GetOrderbookLevels(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	depth uint32,
+	includeOrders bool,
+) (
+	bids []types.OrderbookLevel,
+	asks []types.OrderbookLevel,
+	exists bool,
+) {
+	orderbook, exists := m.openOrders.orderbooksMap[clobPairId]
+	if !exists {
+		return nil, nil, false
+	}
+
+	bids = m.getOrderbookLevelsOnSide(ctx, orderbook, true, depth, includeOrders)
+	asks = m.getOrderbookLevelsOnSide(ctx, orderbook, false, depth, includeOrders)
+	return bids, asks, true
+}
+
+// getOrderbookLevelsOnSide returns up to `depth` price levels (or all levels if `depth` is zero) on
+// one side of the orderbook, sorted from the best price to the worst price.
+func (m *MemClobPriceTimePriority) getOrderbookLevelsOnSide(
+	ctx sdk.Context,
+	orderbook *types.Orderbook,
+	isBuy bool,
+	depth uint32,
+	includeOrders bool,
+) []types.OrderbookLevel {
+	levels := orderbook.GetSide(isBuy)
+	sortedSubticks := lib.GetSortedKeys[lib.Sortable[types.Subticks]](levels)
+	if isBuy {
+		// Bids are sorted from the highest price to the lowest price.
+		for i, j := 0, len(sortedSubticks)-1; i < j; i, j = i+1, j-1 {
+			sortedSubticks[i], sortedSubticks[j] = sortedSubticks[j], sortedSubticks[i]
+		}
+	}
+
+	orderbookLevels := make([]types.OrderbookLevel, 0)
+	for _, subticks := range sortedSubticks {
+		if depth != 0 && uint32(len(orderbookLevels)) >= depth {
+			break
+		}
+
+		orderbookLevel := types.OrderbookLevel{
+			Subticks: subticks.ToUint64(),
+		}
+		for levelOrder := levels[subticks].LevelOrders.Front; levelOrder != nil; levelOrder = levelOrder.Next {
+			order := levelOrder.Value.Order
+			remainingAmount, hasRemainingAmount := m.GetOrderRemainingAmount(ctx, order)
+			if !hasRemainingAmount {
+				continue
+			}
+
+			orderbookLevel.Quantums += remainingAmount.ToUint64()
+			orderbookLevel.NumOrders++
+			if includeOrders {
+				orderbookLevel.Orders = append(
+					orderbookLevel.Orders,
+					types.OrderbookRestingOrder{
+						Order:             order,
+						RemainingQuantums: remainingAmount.ToUint64(),
+					},
+				)
+			}
+		}
+
+		// Skip levels that only contain fully filled orders which have not yet been removed.
+		if orderbookLevel.NumOrders == 0 {
+			continue
+		}
+		orderbookLevels = append(orderbookLevels, orderbookLevel)
+	}
+	return orderbookLevels
+}
+
 // getImpactPriceSubticks returns the impact ask or bid price (in subticks), given the clob pair
 // and orderbook. The bid (or ask) impact price is the average price a trader
 // would receive if they sold (or bought) from the order book using `impactNotionalAmount`.
@@ -0,0 +1,149 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderbookLevels(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+
+	placedMatchableOrders := []types.MatchableOrder{
+		&constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+		&constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+		&constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+		&constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+		&constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price15_GTB20,
+		&constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+	}
+
+	tests := map[string]struct {
+		// Parameters.
+		clobPairId    types.ClobPairId
+		depth         uint32
+		includeOrders bool
+		fillAmounts   map[types.OrderId]satypes.BaseQuantums
+
+		// Expectations.
+		expectedBids   []types.OrderbookLevel
+		expectedAsks   []types.OrderbookLevel
+		expectedExists bool
+	}{
+		"Returns all aggregated levels when depth is zero": {
+			clobPairId: 0,
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 25, NumOrders: 2},
+				{Subticks: 5, Quantums: 25, NumOrders: 1},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 20, NumOrders: 2},
+				{Subticks: 35, Quantums: 20, NumOrders: 1},
+			},
+			expectedExists: true,
+		},
+		"Returns only the best levels when depth is set": {
+			clobPairId: 0,
+			depth:      1,
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 25, NumOrders: 2},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 20, NumOrders: 2},
+			},
+			expectedExists: true,
+		},
+		"Returns resting orders in time priority when orders are included": {
+			clobPairId:    0,
+			depth:         1,
+			includeOrders: true,
+
+			expectedBids: []types.OrderbookLevel{
+				{
+					Subticks:  10,
+					Quantums:  25,
+					NumOrders: 2,
+					Orders: []types.OrderbookRestingOrder{
+						{
+							Order:             constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+							RemainingQuantums: 5,
+						},
+						{
+							Order:             constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+							RemainingQuantums: 20,
+						},
+					},
+				},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{
+					Subticks:  15,
+					Quantums:  20,
+					NumOrders: 2,
+					Orders: []types.OrderbookRestingOrder{
+						{
+							Order:             constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+							RemainingQuantums: 10,
+						},
+						{
+							Order:             constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price15_GTB20,
+							RemainingQuantums: 10,
+						},
+					},
+				},
+			},
+			expectedExists: true,
+		},
+		"Only accounts for the remaining size of partially filled orders": {
+			clobPairId: 0,
+			fillAmounts: map[types.OrderId]satypes.BaseQuantums{
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22.OrderId:   15,
+				constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32.OrderId: 20,
+			},
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 10, NumOrders: 2},
+				{Subticks: 5, Quantums: 25, NumOrders: 1},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 20, NumOrders: 2},
+			},
+			expectedExists: true,
+		},
+		"Returns false when the orderbook does not exist": {
+			clobPairId: 1,
+
+			expectedExists: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memclob, fakeMemClobKeeper := setUpMemclobAndOrderbook(
+				t,
+				ctx,
+				placedMatchableOrders,
+				nil,
+				[]types.MatchableOrder{},
+			)
+			for orderId, fillAmount := range tc.fillAmounts {
+				fakeMemClobKeeper.SetOrderFillAmount(ctx, orderId, fillAmount)
+			}
+
+			bids, asks, exists := memclob.GetOrderbookLevels(ctx, tc.clobPairId, tc.depth, tc.includeOrders)
+			require.Equal(t, tc.expectedExists, exists)
+			if !tc.expectedExists {
+				return
+			}
+			require.Equal(t, tc.expectedBids, bids)
+			require.Equal(t, tc.expectedAsks, asks)
+		})
+	}
+}
@@ -663,6 +663,30 @@ func TestPlaceOrder_LongTerm(t *testing.T) {
 				},
 			},
 		},
+		`A Long-term sell order with the cancel-both self-trade prevention mode self-matches against a
+			Long-term buy order from the same subaccount, causing both orders to be removed`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+
+			order: constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25_StpCancelBoth,
+
+			expectedFilledSize:  0,
+			expectedOrderStatus: types.SelfTradePreventionCanceled,
+			expectedOperations:  []types.Operation{},
+			expectedInternalOperations: []types.InternalOperation{
+				types.NewOrderRemovalInternalOperation(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+				),
+				types.NewOrderRemovalInternalOperation(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25_StpCancelBoth.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED,
+				),
+			},
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
 	}
 
 	for name, tc := range tests {
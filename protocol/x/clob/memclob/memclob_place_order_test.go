@@ -4232,3 +4232,187 @@ func TestPlaceOrder_DuplicateOrder(t *testing.T) {
 	_, _, _, err = memclob.PlaceOrder(ctx, order)
 	require.Error(t, err, types.ErrInvalidReplacement)
 }
+
+func TestPlaceOrder_SelfTradePrevention(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+
+	withSelfTradePrevention := func(
+		order types.Order,
+		selfTradePrevention types.Order_SelfTradePrevention,
+	) types.Order {
+		order.SelfTradePrevention = selfTradePrevention
+		return order
+	}
+
+	// The resting self-trade maker order has time priority over the resting maker order from another subaccount.
+	placedMatchableOrders := []types.MatchableOrder{
+		&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+		&constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+	}
+
+	tests := map[string]struct {
+		// Parameters.
+		order types.Order
+
+		// Expectations.
+		expectedFilledSize    satypes.BaseQuantums
+		expectedOrderStatus   types.OrderStatus
+		expectedRemainingBids []OrderWithRemainingSize
+		expectedRemainingAsks []OrderWithRemainingSize
+		expectMatch           bool
+	}{
+		"Unspecified mode cancels the maker order and continues matching": {
+			order: constants.Order_Alice_Num1_Id7_Clob1_Buy35_PriceMax_GTB30,
+
+			expectedFilledSize:  10,
+			expectedOrderStatus: types.Success,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num1_Id7_Clob1_Buy35_PriceMax_GTB30,
+					RemainingSize: 25,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+			expectMatch:           true,
+		},
+		"Cancel-maker mode cancels the maker order and continues matching": {
+			order: withSelfTradePrevention(
+				constants.Order_Alice_Num1_Id7_Clob1_Buy35_PriceMax_GTB30,
+				types.Order_SELF_TRADE_PREVENTION_CANCEL_MAKER,
+			),
+
+			expectedFilledSize:  10,
+			expectedOrderStatus: types.Success,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order: withSelfTradePrevention(
+						constants.Order_Alice_Num1_Id7_Clob1_Buy35_PriceMax_GTB30,
+						types.Order_SELF_TRADE_PREVENTION_CANCEL_MAKER,
+					),
+					RemainingSize: 25,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+			expectMatch:           true,
+		},
+		"Cancel-taker mode cancels the taker order and leaves the maker orders on the orderbook": {
+			order: withSelfTradePrevention(
+				constants.Order_Alice_Num1_Id7_Clob1_Buy35_PriceMax_GTB30,
+				types.Order_SELF_TRADE_PREVENTION_CANCEL_TAKER,
+			),
+
+			expectedFilledSize:    0,
+			expectedOrderStatus:   types.SelfTradePreventionCanceled,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+					RemainingSize: 10,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					RemainingSize: 10,
+				},
+			},
+		},
+		"Cancel-both mode cancels the maker order and the taker order": {
+			order: withSelfTradePrevention(
+				constants.Order_Alice_Num1_Id7_Clob1_Buy35_PriceMax_GTB30,
+				types.Order_SELF_TRADE_PREVENTION_CANCEL_BOTH,
+			),
+
+			expectedFilledSize:    0,
+			expectedOrderStatus:   types.SelfTradePreventionCanceled,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					RemainingSize: 10,
+				},
+			},
+		},
+		"Decrement-and-cancel mode cancels the maker order, decrements the taker order and continues matching": {
+			order: withSelfTradePrevention(
+				constants.Order_Alice_Num1_Id7_Clob1_Buy35_PriceMax_GTB30,
+				types.Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+			),
+
+			expectedFilledSize:    10,
+			expectedOrderStatus:   types.SelfTradePreventionCanceled,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+			expectMatch:           true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup memclob state and test expectations.
+			expectedCollatCheck := []expectedMatch{}
+			expectedOperations := []types.Operation{}
+			expectedInternalOperations := []types.InternalOperation{}
+			if tc.expectMatch {
+				makerFills := []types.MakerFill{
+					{
+						MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+						FillAmount:   10,
+					},
+				}
+				expectedCollatCheck = append(expectedCollatCheck, expectedMatch{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &tc.order,
+					matchedQuantums: 10,
+				})
+				expectedOperations = []types.Operation{
+					clobtest.NewOrderPlacementOperation(constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20),
+					clobtest.NewOrderPlacementOperation(tc.order),
+					clobtest.NewMatchOperation(&tc.order, makerFills),
+				}
+				expectedInternalOperations = []types.InternalOperation{
+					types.NewShortTermOrderPlacementInternalOperation(
+						constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					),
+					types.NewShortTermOrderPlacementInternalOperation(tc.order),
+					types.NewMatchOrdersInternalOperation(tc.order, makerFills),
+				}
+			}
+
+			addOrderToOrderbookSize := satypes.BaseQuantums(0)
+			if tc.expectedOrderStatus.IsSuccess() {
+				addOrderToOrderbookSize = tc.order.GetBaseQuantums() - tc.expectedFilledSize
+			}
+			memclob, fakeMemClobKeeper, expectedNumCollateralizationChecks, numCollateralChecks := placeOrderTestSetup(
+				t,
+				ctx,
+				placedMatchableOrders,
+				&tc.order,
+				expectedCollatCheck,
+				tc.expectedOrderStatus,
+				addOrderToOrderbookSize,
+				nil,
+				map[int]map[satypes.SubaccountId]satypes.UpdateResult{},
+				constants.GetStatePosition_ZeroPositionSize,
+			)
+
+			// Run the test case and verify expectations.
+			placeOrderAndVerifyExpectationsOperations(
+				t,
+				ctx,
+				memclob,
+				tc.order,
+				numCollateralChecks,
+				tc.expectedFilledSize,
+				tc.expectedFilledSize,
+				tc.expectedOrderStatus,
+				nil,
+				expectedNumCollateralizationChecks,
+				tc.expectedRemainingBids,
+				tc.expectedRemainingAsks,
+				expectedOperations,
+				expectedInternalOperations,
+				fakeMemClobKeeper,
+			)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulatePlaceOrder(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+
+	placedMatchableOrders := []types.MatchableOrder{
+		&constants.Order_Bob_Num0_Id8_Clob0_Sell20_Price10_GTB22,
+		&constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+	}
+
+	withTimeInForce := func(order types.Order, timeInForce types.Order_TimeInForce) types.Order {
+		order.TimeInForce = timeInForce
+		return order
+	}
+
+	tests := map[string]struct {
+		// Parameters.
+		order         types.Order
+		collatCheckFn types.AddOrderToOrderbookCollateralizationCheckFn
+
+		// Expectations.
+		expectedMakerFills     []types.MakerFill
+		expectedFilledQuantums satypes.BaseQuantums
+		expectedOrderStatus    types.OrderStatus
+		expectedErr            error
+	}{
+		"Order matches and the remaining size would rest on the orderbook": {
+			order: constants.Order_Alice_Num0_Id0_Clob0_Buy35_Price10_GTB20,
+
+			expectedMakerFills: []types.MakerFill{
+				{
+					MakerOrderId: constants.Order_Bob_Num0_Id8_Clob0_Sell20_Price10_GTB22.OrderId,
+					FillAmount:   20,
+				},
+			},
+			expectedFilledQuantums: 20,
+			expectedOrderStatus:    types.Success,
+		},
+		"IOC order matches and the remaining size would be canceled": {
+			order: withTimeInForce(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy35_Price10_GTB20,
+				types.Order_TIME_IN_FORCE_IOC,
+			),
+
+			expectedMakerFills: []types.MakerFill{
+				{
+					MakerOrderId: constants.Order_Bob_Num0_Id8_Clob0_Sell20_Price10_GTB22.OrderId,
+					FillAmount:   20,
+				},
+			},
+			expectedFilledQuantums: 20,
+			expectedOrderStatus:    types.ImmediateOrCancelWouldRestOnBook,
+		},
+		"Post-only order would cross the orderbook": {
+			order: withTimeInForce(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20,
+				types.Order_TIME_IN_FORCE_POST_ONLY,
+			),
+
+			expectedErr: types.ErrPostOnlyWouldCrossMakerOrder,
+		},
+		"FOK order could not be fully filled": {
+			order: withTimeInForce(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy35_Price10_GTB20,
+				types.Order_TIME_IN_FORCE_FILL_OR_KILL,
+			),
+
+			expectedErr: types.ErrFokOrderCouldNotBeFullyFilled,
+		},
+		"Order fails the add-to-orderbook collateralization check": {
+			order:         constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price5_GTB20,
+			collatCheckFn: constants.CollatCheck_EmptyUpdateResults_Failure,
+
+			expectedMakerFills:  []types.MakerFill{},
+			expectedOrderStatus: types.Undercollateralized,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memclob, fakeMemClobKeeper := setUpMemclobAndOrderbook(
+				t,
+				ctx,
+				placedMatchableOrders,
+				nil,
+				[]types.MatchableOrder{&tc.order},
+			)
+			if tc.collatCheckFn != nil {
+				fakeMemClobKeeper.WithCollatCheckFn(tc.collatCheckFn)
+			}
+
+			expectedOperations := memclob.GetOperationsRaw(ctx)
+
+			matches, filledQuantums, orderStatus, err := memclob.SimulatePlaceOrder(ctx, tc.order)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Empty(t, matches)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedFilledQuantums, filledQuantums)
+				require.Equal(t, tc.expectedOrderStatus, orderStatus)
+
+				makerFills := make([]types.MakerFill, 0, len(matches))
+				for _, match := range matches {
+					require.Equal(t, tc.order, match.TakerOrder.MustGetOrder())
+					makerFills = append(makerFills, types.MakerFill{
+						MakerOrderId: match.MakerOrder.MustGetOrder().OrderId,
+						FillAmount:   match.FillAmount.ToUint64(),
+					})
+				}
+				require.Equal(t, tc.expectedMakerFills, makerFills)
+			}
+
+			// The memclob is not modified. Note that the fake keeper does not branch fill amounts, so
+			// only the presence of orders on the orderbook is verified.
+			for _, matchableOrder := range placedMatchableOrders {
+				order := matchableOrder.MustGetOrder()
+				_, found := memclob.GetOrder(ctx, order.OrderId)
+				require.True(t, found)
+			}
+			require.Equal(t, expectedOperations, memclob.GetOperationsRaw(ctx))
+			_, found := memclob.GetOrder(ctx, tc.order.OrderId)
+			require.False(t, found)
+		})
+	}
+}
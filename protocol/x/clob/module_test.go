@@ -152,7 +152,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	mockRegistry.On("RegisterImplementations", (*sdk.Msg)(nil), mock.Anything).Return()
 	mockRegistry.On("RegisterImplementations", (*tx.MsgResponse)(nil), mock.Anything).Return()
 	am.RegisterInterfaces(mockRegistry)
-	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 16)
+	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 28)
 	mockRegistry.AssertExpectations(t)
 }
 
@@ -174,7 +174,8 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	expected += `"spread_to_maintenance_margin_ratio_ppm":100000}},"block_rate_limit_config":`
 	expected += `{"max_short_term_orders_per_n_blocks":[],"max_stateful_orders_per_n_blocks":[],`
 	expected += `"max_short_term_order_cancellations_per_n_blocks":[]},`
-	expected += `"equity_tier_limit_config":{"short_term_order_equity_tiers":[], "stateful_order_equity_tiers":[]}}`
+	expected += `"equity_tier_limit_config":{"short_term_order_equity_tiers":[], "stateful_order_equity_tiers":[]},`
+	expected += `"trading_permissions":[]}`
 
 	require.JSONEq(t, expected, string(json))
 }
@@ -263,9 +264,11 @@ func TestAppModuleBasic_GetTxCmd(t *testing.T) {
 
 	cmd := am.GetTxCmd()
 	require.Equal(t, "clob", cmd.Use)
-	require.Equal(t, 2, len(cmd.Commands()))
+	require.Equal(t, 4, len(cmd.Commands()))
 	require.Equal(t, "cancel-order", cmd.Commands()[0].Name())
-	require.Equal(t, "place-order", cmd.Commands()[1].Name())
+	require.Equal(t, "grant-trading-permission", cmd.Commands()[1].Name())
+	require.Equal(t, "place-order", cmd.Commands()[2].Name())
+	require.Equal(t, "revoke-trading-permission", cmd.Commands()[3].Name())
 }
 
 func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
@@ -273,12 +276,18 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "clob", cmd.Use)
-	require.Equal(t, 5, len(cmd.Commands()))
+	require.Equal(t, 11, len(cmd.Commands()))
 	require.Equal(t, "get-block-rate-limit-config", cmd.Commands()[0].Name())
-	require.Equal(t, "get-equity-tier-limit-config", cmd.Commands()[1].Name())
-	require.Equal(t, "get-liquidations-config", cmd.Commands()[2].Name())
-	require.Equal(t, "list-clob-pair", cmd.Commands()[3].Name())
-	require.Equal(t, "show-clob-pair", cmd.Commands()[4].Name())
+	require.Equal(t, "get-deleveraging-candidates", cmd.Commands()[1].Name())
+	require.Equal(t, "get-equity-tier-limit-config", cmd.Commands()[2].Name())
+	require.Equal(t, "get-liquidations-config", cmd.Commands()[3].Name())
+	require.Equal(t, "get-maker-uptime-rewards-config", cmd.Commands()[4].Name())
+	require.Equal(t, "get-orderbook-l2", cmd.Commands()[5].Name())
+	require.Equal(t, "get-orderbook-l3", cmd.Commands()[6].Name())
+	require.Equal(t, "list-clob-pair", cmd.Commands()[7].Name())
+	require.Equal(t, "show-clob-pair", cmd.Commands()[8].Name())
+	require.Equal(t, "show-trading-permission", cmd.Commands()[9].Name())
+	require.Equal(t, "simulate-place-order", cmd.Commands()[10].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -471,7 +480,8 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected += `{"limit":1000,"usd_tnc_required":"100000"}],"stateful_order_equity_tiers":[`
 	expected += `{"limit":0,"usd_tnc_required":"0"},{"limit":1,"usd_tnc_required":"20"},`
 	expected += `{"limit":5,"usd_tnc_required":"100"},{"limit":10,"usd_tnc_required":"1000"},`
-	expected += `{"limit":100,"usd_tnc_required":"10000"},{"limit":200,"usd_tnc_required":"100000"}]}}`
+	expected += `{"limit":100,"usd_tnc_required":"10000"},{"limit":200,"usd_tnc_required":"100000"}]},`
+	expected += `"trading_permissions":[]}`
 	require.JSONEq(t, expected, string(genesisJson))
 }
 
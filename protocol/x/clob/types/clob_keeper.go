@@ -22,6 +22,14 @@ type ClobKeeper interface {
 		success bool,
 		successPerUpdate map[satypes.SubaccountId]satypes.UpdateResult,
 	)
+	BatchCancelShortTermOrder(ctx sdk.Context, msg *MsgBatchCancel) (
+		succeeded []OrderBatch,
+		failed []OrderBatch,
+	)
+	BatchPlaceShortTermOrders(ctx sdk.Context, msg *MsgBatchPlaceOrders) (
+		placedOrderIds []OrderId,
+		failedOrderIds []OrderId,
+	)
 	CancelShortTermOrder(ctx sdk.Context, msg *MsgCancelOrder) error
 	CancelStatefulOrder(ctx sdk.Context, msg *MsgCancelOrder) error
 	CreatePerpetualClobPair(
@@ -36,6 +44,19 @@ type ClobKeeper interface {
 		ClobPair,
 		error,
 	)
+	CreateSpotClobPair(
+		ctx sdk.Context,
+		clobPairId uint32,
+		baseAssetId uint32,
+		quoteAssetId uint32,
+		stepSizeInBaseQuantums satypes.BaseQuantums,
+		quantumConversionExponent int32,
+		subticksPerTick uint32,
+		status ClobPair_Status,
+	) (
+		ClobPair,
+		error,
+	)
 	GetAllClobPairs(ctx sdk.Context) (list []ClobPair)
 	GetClobPair(ctx sdk.Context, id ClobPairId) (val ClobPair, found bool)
 	HasAuthority(authority string) bool
@@ -48,12 +69,19 @@ type ClobKeeper interface {
 	PruneStateFillAmountsForShortTermOrders(
 		ctx sdk.Context,
 	)
+	ReplaceShortTermOrder(ctx sdk.Context, msg *MsgReplaceOrder) (
+		orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+		orderStatus OrderStatus,
+		err error,
+	)
+	ReplaceStatefulOrder(ctx sdk.Context, msg *MsgReplaceOrder) error
 
 	RemoveClobPair(ctx sdk.Context, id ClobPairId)
 	ProcessProposerOperations(
 		ctx sdk.Context,
 		operations []OperationRaw,
 	) error
+	ProcessMakerDepthSamples(ctx sdk.Context, samples []MakerDepthSample)
 	GetStatePosition(
 		ctx sdk.Context,
 		subaccountId satypes.SubaccountId,
@@ -119,12 +147,29 @@ type ClobKeeper interface {
 	GetIndexerEventManager() indexer_manager.IndexerEventManager
 	RateLimitCancelOrder(ctx sdk.Context, order *MsgCancelOrder) error
 	RateLimitPlaceOrder(ctx sdk.Context, order *MsgPlaceOrder) error
+	RateLimitBatchCancel(ctx sdk.Context, msg *MsgBatchCancel) error
+	RateLimitBatchPlaceOrders(ctx sdk.Context, msg *MsgBatchPlaceOrders) error
+	RateLimitReplaceOrder(ctx sdk.Context, msg *MsgReplaceOrder) error
 	InitializeBlockRateLimit(ctx sdk.Context, config BlockRateLimitConfiguration) error
 	InitializeEquityTierLimit(ctx sdk.Context, config EquityTierLimitConfiguration) error
 	Logger(ctx sdk.Context) log.Logger
+	LockMemClob() (unlock func())
 	UpdateClobPair(
 		ctx sdk.Context,
 		clobPair ClobPair,
 	) error
 	UpdateLiquidationsConfig(ctx sdk.Context, config LiquidationsConfig) error
+	UpdateMakerUptimeRewardsConfig(ctx sdk.Context, config MakerUptimeRewardsConfig) error
+	GetTradingPermission(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		grantee string,
+	) (permission TradingPermission, found bool)
+	SetTradingPermission(ctx sdk.Context, permission TradingPermission) error
+	RemoveTradingPermission(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		grantee string,
+	) error
+	ValidateMsgTradingPermission(ctx sdk.Context, msg sdk.Msg) error
 }
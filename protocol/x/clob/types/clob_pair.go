@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -63,16 +64,31 @@ func (c *ClobPair) GetClobPairId() ClobPairId {
 	return ClobPairId(c.Id)
 }
 
+// IsSpotClobPair returns true if the provided `clobPair` is a spot CLOB pair.
+func (c *ClobPair) IsSpotClobPair() bool {
+	return c.GetSpotClobMetadata() != nil
+}
+
 // Stateless validation on ClobPair.
 func (c *ClobPair) Validate() error {
-	switch c.Metadata.(type) {
-	// TODO(DEC-1535): update this when additional clob pair types are supported.
+	switch metadata := c.Metadata.(type) {
 	case *ClobPair_SpotClobMetadata:
-		return errorsmod.Wrapf(
-			ErrInvalidClobPairParameter,
-			"CLOB pair (%+v) is not a perpetual CLOB.",
-			c,
-		)
+		// Fees and collateral are denominated in USDC, so spot CLOB pairs must be quoted in USDC.
+		if metadata.SpotClobMetadata == nil ||
+			metadata.SpotClobMetadata.QuoteAssetId != assettypes.AssetUsdc.Id {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"spot CLOB pair (%+v) must have USDC as the quote asset",
+				c,
+			)
+		}
+		if metadata.SpotClobMetadata.BaseAssetId == metadata.SpotClobMetadata.QuoteAssetId {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"spot CLOB pair (%+v) must have different base and quote assets",
+				c,
+			)
+		}
 	}
 
 	if !IsSupportedClobPairStatus(c.Status) {
@@ -463,6 +463,11 @@ var (
 		6002,
 		"Conditional order is untriggered",
 	)
+	ErrInvalidTrailingOffset = errorsmod.Register(
+		ModuleName,
+		6003,
+		"Trailing stop order trailing offset is invalid",
+	)
 
 	// Errors for unimplemented and disabled functionality.
 	ErrAssetOrdersNotImplemented = errorsmod.Register(
@@ -497,4 +502,111 @@ var (
 		10001,
 		"Subaccount cannot open more orders due to equity tier limit.",
 	)
+
+	// Order group errors.
+	ErrInvalidOrderGroupId = errorsmod.Register(
+		ModuleName,
+		11000,
+		"Order group id is invalid",
+	)
+
+	// Batch order errors.
+	ErrInvalidBatchPlaceOrders = errorsmod.Register(
+		ModuleName,
+		12000,
+		"Batch order placement is invalid",
+	)
+	ErrInvalidBatchCancel = errorsmod.Register(
+		ModuleName,
+		12001,
+		"Batch order cancellation is invalid",
+	)
+	ErrBatchPlaceOrdersFailed = errorsmod.Register(
+		ModuleName,
+		12002,
+		"All order placements in the batch failed",
+	)
+	ErrBatchCancelFailed = errorsmod.Register(
+		ModuleName,
+		12003,
+		"All order cancellations in the batch failed",
+	)
+	ErrBatchMsgNotSupportedInDeliverTx = errorsmod.Register(
+		ModuleName,
+		12004,
+		"Batch order messages only contain Short-Term orders and cannot be executed in DeliverTx",
+	)
+	ErrBatchMsgRemovedOnRecheck = errorsmod.Register(
+		ModuleName,
+		12005,
+		"Batch order messages are removed from the mempool on ReCheckTx",
+	)
+
+	// Order replacement errors.
+	ErrInvalidReplaceOrder = errorsmod.Register(
+		ModuleName,
+		13000,
+		"Order replacement is invalid",
+	)
+	ErrReplaceOrderFailed = errorsmod.Register(
+		ModuleName,
+		13001,
+		"Replacement order could not be placed",
+	)
+	ErrShortTermReplaceOrderRemovedOnRecheck = errorsmod.Register(
+		ModuleName,
+		13002,
+		"Short-Term order replacements are removed from the mempool on ReCheckTx",
+	)
+
+	// Trading permission errors.
+	ErrInvalidTradingPermission = errorsmod.Register(
+		ModuleName,
+		14000,
+		"Trading permission is invalid",
+	)
+	ErrInvalidGrantee = errorsmod.Register(
+		ModuleName,
+		14001,
+		"Grantee is invalid",
+	)
+	ErrTradingPermissionNotFound = errorsmod.Register(
+		ModuleName,
+		14002,
+		"Trading permission not found",
+	)
+	ErrTradingPermissionExpired = errorsmod.Register(
+		ModuleName,
+		14003,
+		"Trading permission has expired",
+	)
+	ErrTradingPermissionClobPairNotAllowed = errorsmod.Register(
+		ModuleName,
+		14004,
+		"Trading permission does not allow trading on the clob pair",
+	)
+	ErrTradingPermissionOrderSizeExceeded = errorsmod.Register(
+		ModuleName,
+		14005,
+		"Order size exceeds the maximum order size of the trading permission",
+	)
+
+	// Self-trade prevention errors.
+	ErrInvalidSelfTradePrevention = errorsmod.Register(
+		ModuleName,
+		15000,
+		"Self-trade prevention mode is invalid",
+	)
+
+	// Maker uptime rewards errors.
+	ErrInvalidMakerUptimeRewardsConfig = errorsmod.Register(
+		ModuleName,
+		16000,
+		"Proposed MakerUptimeRewardsConfig is invalid",
+	)
+	ErrInvalidMakerDepthSamples = errorsmod.Register(
+		ModuleName,
+		16001,
+		"Proposed maker depth samples are invalid",
+	)
 )
@@ -10,7 +10,10 @@ import (
 
 // CLOB module event types.
 const (
-	EventTypeMatch = "match"
+	EventTypeMatch                  = "match"
+	EventTypeSpotMatch              = "spot_match"
+	EventTypeBatchOrderPlacement    = "batch_order_placement"
+	EventTypeBatchOrderCancellation = "batch_order_cancellation"
 
 	AttributeKeyTakerSubaccount                         = "taker_subaccount"
 	AttributeKeyTakerSubaccountNumber                   = "taker_subaccount_number"
@@ -26,6 +29,15 @@ const (
 	AttributeKeyIsLiquidation                           = "is_liquidation"
 	AttributeKeyIsDeleverage                            = "is_deleverage"
 	AttributeKeyPerpetualId                             = "perpetual_id"
+	AttributeKeyMakerAssetQuantumsDeltaBaseQuantums     = "maker_asset_quantums_delta_base_quantums"
+	AttributeKeyTakerAssetQuantumsDeltaBaseQuantums     = "taker_asset_quantums_delta_base_quantums"
+	AttributeKeyBaseAssetId                             = "base_asset_id"
+	AttributeKeySubaccount                              = "subaccount"
+	AttributeKeySubaccountNumber                        = "subaccount_number"
+	AttributeKeyClobPairId                              = "clob_pair_id"
+	AttributeKeyClientId                                = "client_id"
+	AttributeKeySuccess                                 = "success"
+	AttributeKeyError                                   = "error"
 )
 
 // NewCreateMatchEvent constructs a new match sdk.Event.
@@ -61,3 +73,69 @@ func NewCreateMatchEvent(
 		sdk.NewAttribute(AttributeKeyPerpetualId, fmt.Sprint(perpetualId)),
 	)
 }
+
+// NewCreateSpotMatchEvent constructs a new spot match sdk.Event.
+func NewCreateSpotMatchEvent(
+	taker satypes.SubaccountId,
+	maker satypes.SubaccountId,
+	takerOrderFee *big.Int,
+	makerOrderFee *big.Int,
+	takerQuoteBalanceDelta *big.Int,
+	makerQuoteBalanceDelta *big.Int,
+	takerAssetQuantumsDelta *big.Int,
+	makerAssetQuantumsDelta *big.Int,
+	baseAssetId uint32,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeSpotMatch,
+		sdk.NewAttribute(AttributeKeyTakerSubaccount, taker.Owner),
+		sdk.NewAttribute(AttributeKeyTakerSubaccountNumber, fmt.Sprint(taker.Number)),
+		sdk.NewAttribute(AttributeKeyMakerSubaccount, maker.Owner),
+		sdk.NewAttribute(AttributeKeyMakerSubaccountNumber, fmt.Sprint(maker.Number)),
+		sdk.NewAttribute(AttributeKeyTakerOrderFeeQuoteQuantums, fmt.Sprint(takerOrderFee)),
+		sdk.NewAttribute(AttributeKeyMakerOrderFeeQuoteQuantums, fmt.Sprint(makerOrderFee)),
+		sdk.NewAttribute(AttributeKeyTakerQuoteBalanceDeltaQuoteQuantums, takerQuoteBalanceDelta.String()),
+		sdk.NewAttribute(AttributeKeyMakerQuoteBalanceDeltaQuoteQuantums, makerQuoteBalanceDelta.String()),
+		sdk.NewAttribute(AttributeKeyTakerAssetQuantumsDeltaBaseQuantums, takerAssetQuantumsDelta.String()),
+		sdk.NewAttribute(AttributeKeyMakerAssetQuantumsDeltaBaseQuantums, makerAssetQuantumsDelta.String()),
+		sdk.NewAttribute(AttributeKeyBaseAssetId, fmt.Sprint(baseAssetId)),
+	)
+}
+
+// NewBatchOrderPlacementEvent constructs a new batch order placement sdk.Event, which describes the
+// result of placing a single order within a `MsgBatchPlaceOrders`.
+func NewBatchOrderPlacementEvent(
+	orderId OrderId,
+	err error,
+) sdk.Event {
+	return newBatchOrderResultEvent(EventTypeBatchOrderPlacement, orderId, err)
+}
+
+// NewBatchOrderCancellationEvent constructs a new batch order cancellation sdk.Event, which describes
+// the result of canceling a single order within a `MsgBatchCancel`.
+func NewBatchOrderCancellationEvent(
+	orderId OrderId,
+	err error,
+) sdk.Event {
+	return newBatchOrderResultEvent(EventTypeBatchOrderCancellation, orderId, err)
+}
+
+func newBatchOrderResultEvent(
+	eventType string,
+	orderId OrderId,
+	err error,
+) sdk.Event {
+	errString := ""
+	if err != nil {
+		errString = err.Error()
+	}
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(AttributeKeySubaccount, orderId.SubaccountId.Owner),
+		sdk.NewAttribute(AttributeKeySubaccountNumber, fmt.Sprint(orderId.SubaccountId.Number)),
+		sdk.NewAttribute(AttributeKeyClobPairId, fmt.Sprint(orderId.ClobPairId)),
+		sdk.NewAttribute(AttributeKeyClientId, fmt.Sprint(orderId.ClientId)),
+		sdk.NewAttribute(AttributeKeySuccess, fmt.Sprint(err == nil)),
+		sdk.NewAttribute(AttributeKeyError, errString),
+	)
+}
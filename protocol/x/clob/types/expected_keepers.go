@@ -75,6 +75,14 @@ type SubaccountsKeeper interface {
 
 type AssetsKeeper interface {
 	GetAsset(ctx sdk.Context, id uint32) (val assettypes.Asset, exists bool)
+	GetAssetAndMarketPrice(
+		ctx sdk.Context,
+		id uint32,
+	) (
+		asset assettypes.Asset,
+		marketPrice pricestypes.MarketPrice,
+		err error,
+	)
 }
 
 type BlockTimeKeeper interface {
@@ -82,7 +90,16 @@ type BlockTimeKeeper interface {
 }
 
 type FeeTiersKeeper interface {
-	GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool) int32
+	GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool, clobPairId uint32) int32
+	ProcessReferralRebate(
+		ctx sdk.Context,
+		takerAddress string,
+		bigTakerFeeQuoteQuantums *big.Int,
+		bigTotalFeeQuoteQuantums *big.Int,
+	) (
+		bigRebateQuoteQuantums *big.Int,
+		err error,
+	)
 }
 
 type PerpetualsKeeper interface {
@@ -158,10 +175,17 @@ type BankKeeper interface {
 type RewardsKeeper interface {
 	AddRewardSharesForFill(
 		ctx sdk.Context,
+		clobPairId uint32,
 		takerAddress string,
 		makerAddress string,
 		bigFillQuoteQuantums *big.Int,
 		bigTakerFeeQuoteQuantums *big.Int,
 		bigMakerFeeQuoteQuantums *big.Int,
+		bigReferralRebateQuoteQuantums *big.Int,
 	)
+	AddRewardShareToAddress(
+		ctx sdk.Context,
+		address string,
+		weight *big.Int,
+	) error
 }
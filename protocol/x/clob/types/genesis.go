@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -11,6 +13,7 @@ func DefaultGenesis() *GenesisState {
 		ClobPairs:             []ClobPair{},
 		EquityTierLimitConfig: EquityTierLimitConfiguration{},
 		LiquidationsConfig:    LiquidationsConfig_Default,
+		TradingPermissions:    []TradingPermission{},
 	}
 }
 
@@ -33,6 +36,31 @@ func (gs GenesisState) Validate() error {
 		expectedId = expectedId + 1
 	}
 
+	// Check that each trading permission is valid, unique, and references existing clobPairs.
+	type tradingPermissionKey struct {
+		subaccountId satypes.SubaccountId
+		grantee      string
+	}
+	tradingPermissionKeys := make(map[tradingPermissionKey]struct{})
+	for _, permission := range gs.TradingPermissions {
+		if err := permission.Validate(); err != nil {
+			return err
+		}
+
+		key := tradingPermissionKey{subaccountId: permission.SubaccountId, grantee: permission.Grantee}
+		if _, ok := tradingPermissionKeys[key]; ok {
+			return fmt.Errorf("duplicated trading permission for subaccount %+v and grantee %s",
+				permission.SubaccountId, permission.Grantee)
+		}
+		tradingPermissionKeys[key] = struct{}{}
+
+		for _, clobPairId := range permission.ClobPairIds {
+			if _, ok := clobPairIdMap[clobPairId]; !ok {
+				return fmt.Errorf("trading permission references clobPair %d which does not exist", clobPairId)
+			}
+		}
+	}
+
 	if err := gs.BlockRateLimitConfig.Validate(); err != nil {
 		return err
 	}
@@ -29,6 +29,7 @@ type GenesisState struct {
 	LiquidationsConfig    LiquidationsConfig           `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
 	BlockRateLimitConfig  BlockRateLimitConfiguration  `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
 	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,4,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
+	TradingPermissions    []TradingPermission          `protobuf:"bytes,5,rep,name=trading_permissions,json=tradingPermissions,proto3" json:"trading_permissions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +93,13 @@ func (m *GenesisState) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
 	return EquityTierLimitConfiguration{}
 }
 
+func (m *GenesisState) GetTradingPermissions() []TradingPermission {
+	if m != nil {
+		return m.TradingPermissions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.clob.GenesisState")
 }
@@ -99,30 +107,32 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/clob/genesis.proto", fileDescriptor_2de77065a6fbee92) }
 
 var fileDescriptor_2de77065a6fbee92 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x4b, 0xf3, 0x30,
-	0x1c, 0xc6, 0xdb, 0x77, 0xe3, 0x05, 0x33, 0x2f, 0xd6, 0x89, 0x65, 0x42, 0x37, 0x05, 0x61, 0x20,
-	0xb6, 0x32, 0xc5, 0xb3, 0x6c, 0x88, 0x97, 0x1d, 0xc6, 0xf4, 0x24, 0x42, 0x49, 0xb3, 0xd8, 0xfd,
-	0x59, 0xd6, 0x6c, 0x69, 0x2a, 0xdb, 0x67, 0xf0, 0xe2, 0xc7, 0xda, 0x71, 0x47, 0x4f, 0x22, 0xdb,
-	0x17, 0x91, 0xa6, 0x65, 0x6c, 0x34, 0xbb, 0x94, 0xf6, 0xe9, 0xef, 0x79, 0x9e, 0xe4, 0x9f, 0xa0,
-	0xfa, 0x60, 0x3e, 0x98, 0x4d, 0x04, 0x97, 0x9c, 0x70, 0xe6, 0x11, 0xc6, 0x03, 0x2f, 0xa4, 0x11,
-	0x8d, 0x21, 0x76, 0x95, 0x6a, 0x1d, 0x6d, 0x03, 0x6e, 0x0a, 0xd4, 0xaa, 0x21, 0x0f, 0xb9, 0x92,
-	0xbc, 0xf4, 0x2d, 0x03, 0x6b, 0x5e, 0x31, 0x29, 0x60, 0x9c, 0x8c, 0x7c, 0x81, 0x25, 0xf5, 0x19,
-	0x8c, 0x41, 0xfa, 0x84, 0x47, 0xef, 0x10, 0xe6, 0x86, 0xf3, 0xa2, 0x21, 0x7d, 0xf8, 0x13, 0x0c,
-	0x22, 0x47, 0x6e, 0x8a, 0x08, 0x9d, 0x26, 0x20, 0xe7, 0xbe, 0x04, 0x2a, 0x74, 0xa1, 0x57, 0x45,
-	0x07, 0x83, 0x69, 0x02, 0x03, 0x2c, 0x81, 0x47, 0xf1, 0x0e, 0x7c, 0xf1, 0x59, 0x42, 0x87, 0x4f,
-	0xd9, 0x6e, 0x9f, 0x25, 0x96, 0xd4, 0x7a, 0x40, 0x68, 0xb3, 0x84, 0xd8, 0x36, 0x1b, 0xa5, 0x66,
-	0xa5, 0x75, 0xe6, 0x16, 0x26, 0xe0, 0x76, 0x18, 0x0f, 0x7a, 0x18, 0x44, 0xbb, 0xbc, 0xf8, 0xa9,
-	0x1b, 0xfd, 0x03, 0x92, 0x7f, 0xc7, 0xd6, 0x1b, 0x3a, 0xd6, 0xf4, 0xd9, 0xff, 0x1a, 0x66, 0xb3,
-	0xd2, 0xba, 0xd4, 0x44, 0x75, 0xb7, 0xe8, 0x8e, 0x82, 0xf3, 0x50, 0x8b, 0x15, 0xfe, 0x58, 0x23,
-	0x74, 0xba, 0x67, 0xa6, 0x76, 0x49, 0x35, 0xb8, 0x9a, 0x86, 0x76, 0xea, 0xe8, 0x63, 0x49, 0xbb,
-	0x29, 0x9f, 0x25, 0x25, 0x42, 0xe5, 0xe6, 0x55, 0xd5, 0x40, 0x83, 0x58, 0x11, 0xb2, 0xf7, 0x0d,
-	0xdb, 0x2e, 0xab, 0x36, 0x4f, 0xd3, 0xf6, 0xa8, 0x2c, 0x2f, 0x40, 0xc5, 0xde, 0xba, 0x13, 0xaa,
-	0x63, 0xda, 0xbd, 0xc5, 0xca, 0x31, 0x97, 0x2b, 0xc7, 0xfc, 0x5d, 0x39, 0xe6, 0xd7, 0xda, 0x31,
-	0x96, 0x6b, 0xc7, 0xf8, 0x5e, 0x3b, 0xc6, 0xeb, 0x7d, 0x08, 0x72, 0x98, 0x04, 0x2e, 0xe1, 0xe3,
-	0xdd, 0x5b, 0xf6, 0x71, 0x77, 0x4d, 0x86, 0x18, 0x22, 0x6f, 0xa3, 0xcc, 0xb2, 0x33, 0x97, 0xf3,
-	0x09, 0x8d, 0x83, 0xff, 0x4a, 0xbe, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x44, 0xfb, 0x91, 0xc2,
-	0xe5, 0x02, 0x00, 0x00,
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xda, 0x40,
+	0x14, 0xc7, 0x93, 0x6a, 0x0b, 0x1d, 0x7b, 0x69, 0xb4, 0x34, 0x58, 0x88, 0xb6, 0xb4, 0x20, 0x2d,
+	0x4d, 0x8a, 0x2d, 0x3d, 0x17, 0xa5, 0xf4, 0xe2, 0x41, 0xac, 0xa7, 0x76, 0x21, 0x4c, 0x92, 0xd9,
+	0xf8, 0x30, 0x66, 0xe2, 0xcc, 0x64, 0xd1, 0x6f, 0xb1, 0x1f, 0xcb, 0xa3, 0xc7, 0x3d, 0x2d, 0x8b,
+	0x5e, 0xf6, 0x63, 0x2c, 0x99, 0x64, 0x45, 0x77, 0xc6, 0x4b, 0xc8, 0xbc, 0xf9, 0xfd, 0xff, 0xff,
+	0xe1, 0xbd, 0x87, 0x3a, 0xd1, 0x3a, 0x5a, 0x65, 0x8c, 0x0a, 0x1a, 0xd2, 0xc4, 0x0b, 0x13, 0x1a,
+	0x78, 0x31, 0x49, 0x09, 0x07, 0xee, 0xca, 0xaa, 0xf5, 0xfa, 0x18, 0x70, 0x0b, 0xa0, 0xdd, 0x8a,
+	0x69, 0x4c, 0x65, 0xc9, 0x2b, 0xfe, 0x4a, 0xb0, 0xed, 0xa9, 0x4e, 0x41, 0x42, 0xc3, 0xb9, 0xcf,
+	0xb0, 0x20, 0x7e, 0x02, 0x0b, 0x10, 0x7e, 0x48, 0xd3, 0x4b, 0x88, 0x2b, 0xc1, 0x7b, 0x55, 0x50,
+	0x7c, 0xfc, 0x0c, 0x03, 0xab, 0x90, 0x6f, 0x2a, 0x42, 0x96, 0x39, 0x88, 0xb5, 0x2f, 0x80, 0x30,
+	0x9d, 0xe9, 0x17, 0x55, 0x91, 0xc0, 0x32, 0x87, 0x08, 0x0b, 0xa0, 0x29, 0x3f, 0x85, 0x3f, 0xab,
+	0xb0, 0x60, 0x38, 0x82, 0x34, 0xf6, 0x33, 0xc2, 0x16, 0xc0, 0x39, 0xd0, 0xb4, 0x64, 0x3f, 0xdc,
+	0xd7, 0xd0, 0xab, 0x3f, 0x65, 0x67, 0xfe, 0x0a, 0x2c, 0x88, 0xf5, 0x0b, 0xa1, 0xc3, 0x73, 0xb9,
+	0x6d, 0x76, 0x6b, 0xbd, 0x46, 0xff, 0x9d, 0xab, 0x74, 0xcb, 0x1d, 0x26, 0x34, 0x18, 0x63, 0x60,
+	0x83, 0xfa, 0xe6, 0xb6, 0x63, 0x4c, 0x5e, 0x86, 0xd5, 0x99, 0x5b, 0x17, 0xa8, 0xa9, 0x79, 0x9b,
+	0xfd, 0xac, 0x6b, 0xf6, 0x1a, 0xfd, 0x4f, 0x1a, 0xab, 0xd1, 0x11, 0x3d, 0x94, 0x70, 0x65, 0x6a,
+	0x25, 0xca, 0x8d, 0x35, 0x47, 0x6f, 0xcf, 0xf4, 0xdf, 0xae, 0xc9, 0x04, 0x57, 0x93, 0x30, 0x28,
+	0x14, 0x13, 0x2c, 0xc8, 0xa8, 0xe0, 0x4b, 0xa7, 0x9c, 0x49, 0xdf, 0x2a, 0xaa, 0x15, 0x68, 0x10,
+	0x2b, 0x45, 0xf6, 0xb9, 0xc1, 0xd8, 0x75, 0x99, 0xe6, 0x69, 0xd2, 0x7e, 0x4b, 0xc9, 0x14, 0x08,
+	0x3b, 0x1b, 0xf7, 0x86, 0xe8, 0x18, 0xeb, 0x3f, 0x6a, 0xaa, 0x93, 0xe2, 0xf6, 0x73, 0x39, 0x85,
+	0x8f, 0x9a, 0xa8, 0x69, 0x49, 0x8f, 0x0f, 0xf0, 0x63, 0xe7, 0xc4, 0xd3, 0x0b, 0x3e, 0x18, 0x6f,
+	0x76, 0x8e, 0xb9, 0xdd, 0x39, 0xe6, 0xdd, 0xce, 0x31, 0xaf, 0xf7, 0x8e, 0xb1, 0xdd, 0x3b, 0xc6,
+	0xcd, 0xde, 0x31, 0xfe, 0xfd, 0x8c, 0x41, 0xcc, 0xf2, 0xc0, 0x0d, 0xe9, 0xe2, 0x74, 0xdd, 0xaf,
+	0x7e, 0x7c, 0x0d, 0x67, 0x18, 0x52, 0xef, 0x50, 0x59, 0x55, 0xfb, 0xb4, 0xce, 0x08, 0x0f, 0x5e,
+	0xc8, 0xf2, 0xf7, 0x87, 0x01, 0x00, 0xcf, 0xca, 0xc6, 0x60, 0x6e, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +155,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TradingPermissions) > 0 {
+		for iNdEx := len(m.TradingPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradingPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -221,6 +245,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.EquityTierLimitConfig.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TradingPermissions) > 0 {
+		for _, e := range m.TradingPermissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -392,6 +422,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradingPermissions = append(m.TradingPermissions, TradingPermission{})
+			if err := m.TradingPermissions[len(m.TradingPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
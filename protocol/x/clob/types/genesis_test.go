@@ -124,6 +124,54 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedError: errors.New("found gap in clobPair id"),
 		},
+		"invalid trading permission": {
+			genState: &types.GenesisState{
+				TradingPermissions: []types.TradingPermission{
+					{
+						SubaccountId: constants.Alice_Num0,
+					},
+				},
+			},
+			expectedError: types.ErrInvalidGrantee,
+		},
+		"duplicated trading permission": {
+			genState: &types.GenesisState{
+				ClobPairs: []types.ClobPair{
+					{
+						Id: uint32(0),
+					},
+				},
+				TradingPermissions: []types.TradingPermission{
+					{
+						SubaccountId: constants.Alice_Num0,
+						Grantee:      constants.BobAccAddress.String(),
+					},
+					{
+						SubaccountId: constants.Alice_Num0,
+						Grantee:      constants.BobAccAddress.String(),
+						ClobPairIds:  []uint32{0},
+					},
+				},
+			},
+			expectedError: errors.New("duplicated trading permission"),
+		},
+		"trading permission references clobPair which does not exist": {
+			genState: &types.GenesisState{
+				ClobPairs: []types.ClobPair{
+					{
+						Id: uint32(0),
+					},
+				},
+				TradingPermissions: []types.TradingPermission{
+					{
+						SubaccountId: constants.Alice_Num0,
+						Grantee:      constants.BobAccAddress.String(),
+						ClobPairIds:  []uint32{1},
+					},
+				},
+			},
+			expectedError: errors.New("trading permission references clobPair 1 which does not exist"),
+		},
 		"spread to maintenance margin ratio of 0 is invalid": {
 			genState: &types.GenesisState{
 				LiquidationsConfig: types.LiquidationsConfig{
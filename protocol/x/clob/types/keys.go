@@ -39,6 +39,9 @@ const (
 	// BlockRateLimitConfigKey is the key to retrieve the block rate limit configuration.
 	BlockRateLimitConfigKey = "RateLimCfg"
 
+	// MakerUptimeRewardsConfigKey is the key to retrieve the maker uptime rewards configuration.
+	MakerUptimeRewardsConfigKey = "MakerUptimeCfg"
+
 	// ClobPairKeyPrefix is the prefix to retrieve all ClobPair
 	ClobPairKeyPrefix = "Clob:"
 
@@ -52,6 +55,14 @@ const (
 	// StatefulOrdersTimeSlicePrefix is the key to retrieve a unique list of the stateful orders that
 	// expire at a given timestamp, sorted by order ID.
 	StatefulOrdersTimeSlicePrefix = "ExpTm:"
+
+	// OrderGroupKeyPrefix is the prefix to retrieve a unique list of the stateful orders that are
+	// part of a one-cancels-other order group, keyed by the order group id and subaccount id.
+	OrderGroupKeyPrefix = "OrderGroup:"
+
+	// TradingPermissionKeyPrefix is the prefix to retrieve the trading permission granted by the
+	// owner of a subaccount to a grantee, keyed by the subaccount id and the grantee.
+	TradingPermissionKeyPrefix = "TradePerm:"
 )
 
 // Store / Memstore
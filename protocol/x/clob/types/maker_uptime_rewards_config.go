@@ -0,0 +1,87 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// IsEnabled returns true if the orderbook is sampled for maker uptime rewards.
+func (c *MakerUptimeRewardsConfig) IsEnabled() bool {
+	return c.SampleIntervalBlocks > 0
+}
+
+// IsSampledBlock returns true if maker uptime rewards are enabled and the orderbook is sampled in the
+// block at height `blockHeight`.
+func (c *MakerUptimeRewardsConfig) IsSampledBlock(blockHeight int64) bool {
+	return c.IsEnabled() && blockHeight%int64(c.SampleIntervalBlocks) == 0
+}
+
+// Validate validates each individual field of the maker uptime rewards config for validity.
+// It returns an error if any of the following conditions are true:
+// - `maxSpreadPpm > 1_000_000`.
+// - `rewardWeightPpm > 1_000_000`.
+// - maker uptime rewards are enabled and `maxSpreadPpm == 0 || rewardWeightPpm == 0`.
+func (c *MakerUptimeRewardsConfig) Validate() error {
+	if c.MaxSpreadPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidMakerUptimeRewardsConfig,
+			"%v is not a valid MaxSpreadPpm",
+			c.MaxSpreadPpm,
+		)
+	}
+
+	if c.RewardWeightPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidMakerUptimeRewardsConfig,
+			"%v is not a valid RewardWeightPpm",
+			c.RewardWeightPpm,
+		)
+	}
+
+	if c.IsEnabled() && (c.MaxSpreadPpm == 0 || c.RewardWeightPpm == 0) {
+		return errorsmod.Wrap(
+			ErrInvalidMakerUptimeRewardsConfig,
+			"MaxSpreadPpm and RewardWeightPpm must be positive when SampleIntervalBlocks is positive",
+		)
+	}
+
+	return nil
+}
+
+// ValidateMakerDepthSamples performs stateless validation of the maker depth samples proposed in a block.
+// It returns an error if any of the following conditions are true:
+// - the subaccount ID of a sample is invalid.
+// - a sample has no depth on either side, since it would not accrue a reward share.
+// - the samples are not sorted by CLOB pair ID and then by subaccount ID, or contain duplicates.
+func ValidateMakerDepthSamples(samples []MakerDepthSample) error {
+	for i, sample := range samples {
+		if err := sample.SubaccountId.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMakerDepthSamples, "sample %+v: %v", sample, err)
+		}
+
+		if sample.BidQuoteQuantums == 0 || sample.AskQuoteQuantums == 0 {
+			return errorsmod.Wrapf(ErrInvalidMakerDepthSamples, "sample %+v has no two-sided depth", sample)
+		}
+
+		if i > 0 && !makerDepthSampleLess(samples[i-1], sample) {
+			return errorsmod.Wrapf(
+				ErrInvalidMakerDepthSamples,
+				"samples %+v and %+v are not sorted or are duplicates",
+				samples[i-1],
+				sample,
+			)
+		}
+	}
+
+	return nil
+}
+
+// makerDepthSampleLess returns true if the sample `a` is ordered before the sample `b`, ordering samples
+// by CLOB pair ID and then by subaccount ID.
+func makerDepthSampleLess(a, b MakerDepthSample) bool {
+	if a.ClobPairId != b.ClobPairId {
+		return a.ClobPairId < b.ClobPairId
+	}
+	return satypes.SortedSubaccountIds{a.SubaccountId, b.SubaccountId}.Less(0, 1)
+}
@@ -0,0 +1,147 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakerUptimeRewardsConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		config        types.MakerUptimeRewardsConfig
+		expectedError string
+	}{
+		"valid: disabled": {
+			config: types.MakerUptimeRewardsConfig{},
+		},
+		"valid: enabled": {
+			config: types.MakerUptimeRewardsConfig{
+				SampleIntervalBlocks: 10,
+				MaxSpreadPpm:         5_000,
+				RewardWeightPpm:      1_000_000,
+			},
+		},
+		"invalid: MaxSpreadPpm greater than 1_000_000": {
+			config: types.MakerUptimeRewardsConfig{
+				SampleIntervalBlocks: 10,
+				MaxSpreadPpm:         1_000_001,
+				RewardWeightPpm:      100,
+			},
+			expectedError: "1000001 is not a valid MaxSpreadPpm",
+		},
+		"invalid: RewardWeightPpm greater than 1_000_000": {
+			config: types.MakerUptimeRewardsConfig{
+				RewardWeightPpm: 1_000_001,
+			},
+			expectedError: "1000001 is not a valid RewardWeightPpm",
+		},
+		"invalid: enabled with zero MaxSpreadPpm": {
+			config: types.MakerUptimeRewardsConfig{
+				SampleIntervalBlocks: 10,
+				RewardWeightPpm:      100,
+			},
+			expectedError: "MaxSpreadPpm and RewardWeightPpm must be positive",
+		},
+		"invalid: enabled with zero RewardWeightPpm": {
+			config: types.MakerUptimeRewardsConfig{
+				SampleIntervalBlocks: 10,
+				MaxSpreadPpm:         5_000,
+			},
+			expectedError: "MaxSpreadPpm and RewardWeightPpm must be positive",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectedError != "" {
+				require.ErrorIs(t, err, types.ErrInvalidMakerUptimeRewardsConfig)
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMakerDepthSamples(t *testing.T) {
+	aliceSample := types.MakerDepthSample{
+		SubaccountId:     constants.Alice_Num0,
+		ClobPairId:       0,
+		BidQuoteQuantums: 100,
+		AskQuoteQuantums: 200,
+	}
+	bobSample := types.MakerDepthSample{
+		SubaccountId:     constants.Bob_Num0,
+		ClobPairId:       0,
+		BidQuoteQuantums: 300,
+		AskQuoteQuantums: 400,
+	}
+	aliceSampleClob1 := aliceSample
+	aliceSampleClob1.ClobPairId = 1
+
+	// Order the samples of CLOB pair 0 by subaccount ID.
+	sortedSamples := []types.MakerDepthSample{aliceSample, bobSample}
+	if !(satypes.SortedSubaccountIds{constants.Alice_Num0, constants.Bob_Num0}).Less(0, 1) {
+		sortedSamples = []types.MakerDepthSample{bobSample, aliceSample}
+	}
+
+	tests := map[string]struct {
+		samples       []types.MakerDepthSample
+		expectedError string
+	}{
+		"valid: no samples": {},
+		"valid: sorted by CLOB pair and subaccount": {
+			samples: append(append([]types.MakerDepthSample{}, sortedSamples...), aliceSampleClob1),
+		},
+		"invalid: subaccount ID": {
+			samples: []types.MakerDepthSample{
+				{
+					SubaccountId:     satypes.SubaccountId{Owner: "invalid"},
+					BidQuoteQuantums: 100,
+					AskQuoteQuantums: 200,
+				},
+			},
+			expectedError: "invalid",
+		},
+		"invalid: no bid depth": {
+			samples: []types.MakerDepthSample{
+				{SubaccountId: constants.Alice_Num0, AskQuoteQuantums: 200},
+			},
+			expectedError: "has no two-sided depth",
+		},
+		"invalid: no ask depth": {
+			samples: []types.MakerDepthSample{
+				{SubaccountId: constants.Alice_Num0, BidQuoteQuantums: 100},
+			},
+			expectedError: "has no two-sided depth",
+		},
+		"invalid: not sorted by CLOB pair": {
+			samples:       []types.MakerDepthSample{aliceSampleClob1, sortedSamples[0]},
+			expectedError: "are not sorted or are duplicates",
+		},
+		"invalid: not sorted by subaccount": {
+			samples:       []types.MakerDepthSample{sortedSamples[1], sortedSamples[0]},
+			expectedError: "are not sorted or are duplicates",
+		},
+		"invalid: duplicate subaccount and CLOB pair": {
+			samples:       []types.MakerDepthSample{aliceSample, aliceSample},
+			expectedError: "are not sorted or are duplicates",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateMakerDepthSamples(tc.samples)
+			if tc.expectedError != "" {
+				require.ErrorIs(t, err, types.ErrInvalidMakerDepthSamples)
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
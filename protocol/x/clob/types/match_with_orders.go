@@ -19,7 +19,8 @@ type MatchWithOrders struct {
 // not perform any state reads, or memclob reads.
 //
 // This validation ensures:
-//   - Order match does not constitute a self-trade.
+//   - Order match does not constitute a self-trade. Every self-trade prevention mode prevents the
+//     orders of a subaccount from matching each other, so this holds regardless of the mode.
 //   - Order match contains a `fillAmount` greater than 0.
 //   - Orders in match are for the same `ClobPairId`.
 //   - Orders in match are for opposing sides.
@@ -32,6 +33,7 @@ func (match MatchWithOrders) Validate() error {
 	takerOrder := match.TakerOrder
 	fillAmount := match.FillAmount
 	// Make sure the maker and taker order are not for the same Subaccount.
+	// Note the self-trade prevention mode of the taker order only determines which orders are canceled.
 	if makerOrder.GetSubaccountId() == takerOrder.GetSubaccountId() {
 		return errors.New("Match constitutes a self-trade")
 	}
@@ -76,6 +76,15 @@ type MemClob interface {
 		ctx sdk.Context,
 		order Order,
 	) (satypes.BaseQuantums, OrderStatus, *OffchainUpdates, error)
+	SimulatePlaceOrder(
+		ctx sdk.Context,
+		order Order,
+	) (
+		matches []MatchWithOrders,
+		orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+		orderStatus OrderStatus,
+		err error,
+	)
 	PlacePerpetualLiquidation(
 		ctx sdk.Context,
 		liquidationOrder LiquidationOrder,
@@ -98,6 +107,10 @@ type MemClob interface {
 		ctx sdk.Context,
 		orderId OrderId,
 	)
+	ReduceOrderSize(
+		ctx sdk.Context,
+		order Order,
+	) (offchainUpdates *OffchainUpdates, err error)
 	GetPricePremium(
 		ctx sdk.Context,
 		clobPair ClobPair,
@@ -134,4 +147,14 @@ type MemClob interface {
 		subticks Subticks,
 		exists bool,
 	)
+	GetOrderbookLevels(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+		depth uint32,
+		includeOrders bool,
+	) (
+		bids []OrderbookLevel,
+		asks []OrderbookLevel,
+		exists bool,
+	)
 }
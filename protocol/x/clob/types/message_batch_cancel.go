@@ -0,0 +1,125 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+const (
+	TypeMsgBatchCancel = "batch_cancel"
+
+	// MaxMsgBatchCancelBatchSize is the maximum number of order cancellations that can be
+	// included across all batches of a single `MsgBatchCancel`.
+	MaxMsgBatchCancelBatchSize = 100
+)
+
+var _ sdk.Msg = &MsgBatchCancel{}
+
+// NewMsgBatchCancel constructs a MsgBatchCancel from a `SubaccountId`, a list of `OrderBatch`
+// and a `GoodTilBlock`.
+func NewMsgBatchCancel(
+	subaccountId satypes.SubaccountId,
+	shortTermCancels []OrderBatch,
+	goodTilBlock uint32,
+) *MsgBatchCancel {
+	return &MsgBatchCancel{
+		SubaccountId:     subaccountId,
+		ShortTermCancels: shortTermCancels,
+		GoodTilBlock:     goodTilBlock,
+	}
+}
+
+func (msg *MsgBatchCancel) GetSigners() []sdk.AccAddress {
+	return getTraderSigners(msg.SubaccountId.Owner, msg.Grantee)
+}
+
+// ValidateBasic performs stateless validation on the batch. It returns an error if the subaccount
+// is invalid, the `GoodTilBlock` is zero, the batch is empty, the total number of cancellations
+// exceeds `MaxMsgBatchCancelBatchSize`, or if any clob pair or client ID is duplicated.
+func (msg *MsgBatchCancel) ValidateBasic() (err error) {
+	defer func() {
+		if err != nil {
+			telemetry.IncrCounter(1, ModuleName, metrics.BatchCancel, metrics.ValidateBasic, metrics.Error, metrics.Count)
+		}
+	}()
+
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateGrantee(msg.SubaccountId.Owner, msg.Grantee); err != nil {
+		return err
+	}
+
+	if msg.GoodTilBlock == 0 {
+		return errorsmod.Wrap(ErrInvalidOrderGoodTilBlock, "batch cancellation goodTilBlock cannot be 0")
+	}
+
+	if len(msg.ShortTermCancels) == 0 {
+		return errorsmod.Wrap(ErrInvalidBatchCancel, "batch cannot be empty")
+	}
+
+	numCancels := 0
+	clobPairIds := make(map[uint32]struct{}, len(msg.ShortTermCancels))
+	for _, batch := range msg.ShortTermCancels {
+		if _, exists := clobPairIds[batch.ClobPairId]; exists {
+			return errorsmod.Wrapf(ErrInvalidBatchCancel, "duplicate clob pair ID %d", batch.ClobPairId)
+		}
+		clobPairIds[batch.ClobPairId] = struct{}{}
+
+		if len(batch.ClientIds) == 0 {
+			return errorsmod.Wrapf(ErrInvalidBatchCancel, "no client IDs for clob pair ID %d", batch.ClobPairId)
+		}
+
+		clientIds := make(map[uint32]struct{}, len(batch.ClientIds))
+		for _, clientId := range batch.ClientIds {
+			if _, exists := clientIds[clientId]; exists {
+				return errorsmod.Wrapf(
+					ErrInvalidBatchCancel,
+					"duplicate client ID %d for clob pair ID %d",
+					clientId,
+					batch.ClobPairId,
+				)
+			}
+			clientIds[clientId] = struct{}{}
+		}
+		numCancels += len(batch.ClientIds)
+	}
+
+	if numCancels > MaxMsgBatchCancelBatchSize {
+		return errorsmod.Wrapf(
+			ErrInvalidBatchCancel,
+			"batch size %d exceeds max batch size %d",
+			numCancels,
+			MaxMsgBatchCancelBatchSize,
+		)
+	}
+
+	return nil
+}
+
+// GetMsgCancelOrders returns a Short-Term `MsgCancelOrder` for each order cancellation in the batch,
+// in the order they appear in the batch.
+func (msg *MsgBatchCancel) GetMsgCancelOrders() []*MsgCancelOrder {
+	msgCancelOrders := make([]*MsgCancelOrder, 0)
+	for _, batch := range msg.ShortTermCancels {
+		for _, clientId := range batch.ClientIds {
+			msgCancelOrders = append(
+				msgCancelOrders,
+				NewMsgCancelOrderShortTerm(
+					OrderId{
+						SubaccountId: msg.SubaccountId,
+						ClientId:     clientId,
+						OrderFlags:   OrderIdFlags_ShortTerm,
+						ClobPairId:   batch.ClobPairId,
+					},
+					msg.GoodTilBlock,
+				),
+			)
+		}
+	}
+	return msgCancelOrders
+}
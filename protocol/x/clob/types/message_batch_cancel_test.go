@@ -0,0 +1,140 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchCancel_ValidateBasic(t *testing.T) {
+	maxClientIds := make([]uint32, 0, types.MaxMsgBatchCancelBatchSize+1)
+	for i := 0; i <= types.MaxMsgBatchCancelBatchSize; i++ {
+		maxClientIds = append(maxClientIds, uint32(i))
+	}
+
+	tests := map[string]struct {
+		msg types.MsgBatchCancel
+		err error
+	}{
+		"valid batch": {
+			msg: *types.NewMsgBatchCancel(
+				constants.Alice_Num0,
+				[]types.OrderBatch{
+					{ClobPairId: 0, ClientIds: []uint32{0, 1, 2}},
+					{ClobPairId: 1, ClientIds: []uint32{0}},
+				},
+				10,
+			),
+		},
+		"valid batch of max size": {
+			msg: *types.NewMsgBatchCancel(
+				constants.Alice_Num0,
+				[]types.OrderBatch{
+					{ClobPairId: 0, ClientIds: maxClientIds[:types.MaxMsgBatchCancelBatchSize]},
+				},
+				10,
+			),
+		},
+		"invalid subaccount": {
+			msg: *types.NewMsgBatchCancel(
+				satypes.SubaccountId{Owner: "invalid_owner"},
+				[]types.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0}}},
+				10,
+			),
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"zero GoodTilBlock": {
+			msg: *types.NewMsgBatchCancel(
+				constants.Alice_Num0,
+				[]types.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0}}},
+				0,
+			),
+			err: types.ErrInvalidOrderGoodTilBlock,
+		},
+		"empty batch": {
+			msg: *types.NewMsgBatchCancel(constants.Alice_Num0, []types.OrderBatch{}, 10),
+			err: types.ErrInvalidBatchCancel,
+		},
+		"no client ids for clob pair": {
+			msg: *types.NewMsgBatchCancel(
+				constants.Alice_Num0,
+				[]types.OrderBatch{{ClobPairId: 0}},
+				10,
+			),
+			err: types.ErrInvalidBatchCancel,
+		},
+		"duplicate clob pair ids": {
+			msg: *types.NewMsgBatchCancel(
+				constants.Alice_Num0,
+				[]types.OrderBatch{
+					{ClobPairId: 0, ClientIds: []uint32{0}},
+					{ClobPairId: 0, ClientIds: []uint32{1}},
+				},
+				10,
+			),
+			err: types.ErrInvalidBatchCancel,
+		},
+		"duplicate client ids": {
+			msg: *types.NewMsgBatchCancel(
+				constants.Alice_Num0,
+				[]types.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0, 1, 0}}},
+				10,
+			),
+			err: types.ErrInvalidBatchCancel,
+		},
+		"batch exceeds max size": {
+			msg: *types.NewMsgBatchCancel(
+				constants.Alice_Num0,
+				[]types.OrderBatch{
+					{ClobPairId: 0, ClientIds: maxClientIds[:types.MaxMsgBatchCancelBatchSize]},
+					{ClobPairId: 1, ClientIds: []uint32{0}},
+				},
+				10,
+			),
+			err: types.ErrInvalidBatchCancel,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBatchCancel_GetMsgCancelOrders(t *testing.T) {
+	msg := types.NewMsgBatchCancel(
+		constants.Alice_Num0,
+		[]types.OrderBatch{
+			{ClobPairId: 1, ClientIds: []uint32{5}},
+			{ClobPairId: 0, ClientIds: []uint32{2, 1}},
+		},
+		10,
+	)
+
+	require.Equal(
+		t,
+		[]*types.MsgCancelOrder{
+			types.NewMsgCancelOrderShortTerm(
+				types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 5, ClobPairId: 1},
+				10,
+			),
+			types.NewMsgCancelOrderShortTerm(
+				types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 2, ClobPairId: 0},
+				10,
+			),
+			types.NewMsgCancelOrderShortTerm(
+				types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 1, ClobPairId: 0},
+				10,
+			),
+		},
+		msg.GetMsgCancelOrders(),
+	)
+}
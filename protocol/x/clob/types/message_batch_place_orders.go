@@ -0,0 +1,110 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+)
+
+const (
+	TypeMsgBatchPlaceOrders = "batch_place_orders"
+
+	// MaxMsgBatchPlaceOrdersBatchSize is the maximum number of orders that can be placed
+	// in a single `MsgBatchPlaceOrders`.
+	MaxMsgBatchPlaceOrdersBatchSize = 100
+)
+
+var _ sdk.Msg = &MsgBatchPlaceOrders{}
+
+func NewMsgBatchPlaceOrders(orders []Order) *MsgBatchPlaceOrders {
+	return &MsgBatchPlaceOrders{
+		Orders: orders,
+	}
+}
+
+// GetSigners returns no signers for an empty batch, which is rejected by `ValidateBasic`.
+func (msg *MsgBatchPlaceOrders) GetSigners() []sdk.AccAddress {
+	if len(msg.Orders) == 0 {
+		return []sdk.AccAddress{}
+	}
+	return getTraderSigners(msg.Orders[0].OrderId.SubaccountId.Owner, msg.Grantee)
+}
+
+// ValidateBasic performs stateless validation on the batch. It returns an error if the batch is
+// empty, exceeds `MaxMsgBatchPlaceOrdersBatchSize`, contains orders for more than one subaccount,
+// contains duplicate order IDs or stateful orders, or if any order fails the stateless validation
+// performed for `MsgPlaceOrder`.
+func (msg *MsgBatchPlaceOrders) ValidateBasic() (err error) {
+	defer func() {
+		if err != nil {
+			telemetry.IncrCounter(1, ModuleName, metrics.BatchPlaceOrders, metrics.ValidateBasic, metrics.Error, metrics.Count)
+		}
+	}()
+
+	numOrders := len(msg.Orders)
+	if numOrders == 0 {
+		return errorsmod.Wrap(ErrInvalidBatchPlaceOrders, "batch cannot be empty")
+	}
+	if numOrders > MaxMsgBatchPlaceOrdersBatchSize {
+		return errorsmod.Wrapf(
+			ErrInvalidBatchPlaceOrders,
+			"batch size %d exceeds max batch size %d",
+			numOrders,
+			MaxMsgBatchPlaceOrdersBatchSize,
+		)
+	}
+
+	subaccountId := msg.Orders[0].OrderId.SubaccountId
+	if err := validateGrantee(subaccountId.Owner, msg.Grantee); err != nil {
+		return err
+	}
+
+	orderIds := make(map[OrderId]struct{}, numOrders)
+	for i, order := range msg.Orders {
+		if err := NewMsgPlaceOrder(order).ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "order at index %d is invalid", i)
+		}
+
+		if !order.IsShortTermOrder() {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceOrders,
+				"order at index %d is not a Short-Term order: %+v",
+				i,
+				order.OrderId,
+			)
+		}
+
+		if order.OrderId.SubaccountId != subaccountId {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceOrders,
+				"order at index %d has subaccount %+v, expected %+v",
+				i,
+				order.OrderId.SubaccountId,
+				subaccountId,
+			)
+		}
+
+		if _, exists := orderIds[order.OrderId]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceOrders,
+				"order at index %d has duplicate order ID %+v",
+				i,
+				order.OrderId,
+			)
+		}
+		orderIds[order.OrderId] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetOrder returns the order in the batch with the provided order ID, and whether it was found.
+func (msg *MsgBatchPlaceOrders) GetOrder(orderId OrderId) (order Order, found bool) {
+	for _, order := range msg.Orders {
+		if order.OrderId == orderId {
+			return order, true
+		}
+	}
+	return Order{}, false
+}
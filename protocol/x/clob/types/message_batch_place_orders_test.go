@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchPlaceOrders_ValidateBasic(t *testing.T) {
+	zeroQuantumsOrder := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+	zeroQuantumsOrder.Quantums = 0
+
+	maxBatch := make([]types.Order, 0, types.MaxMsgBatchPlaceOrdersBatchSize+1)
+	for i := 0; i <= types.MaxMsgBatchPlaceOrdersBatchSize; i++ {
+		order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+		order.OrderId.ClientId = uint32(i)
+		maxBatch = append(maxBatch, order)
+	}
+
+	tests := map[string]struct {
+		msg types.MsgBatchPlaceOrders
+		err error
+	}{
+		"valid batch": {
+			msg: *types.NewMsgBatchPlaceOrders([]types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+				constants.Order_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB15,
+			}),
+		},
+		"valid batch of max size": {
+			msg: *types.NewMsgBatchPlaceOrders(maxBatch[:types.MaxMsgBatchPlaceOrdersBatchSize]),
+		},
+		"empty batch": {
+			msg: *types.NewMsgBatchPlaceOrders([]types.Order{}),
+			err: types.ErrInvalidBatchPlaceOrders,
+		},
+		"batch exceeds max size": {
+			msg: *types.NewMsgBatchPlaceOrders(maxBatch),
+			err: types.ErrInvalidBatchPlaceOrders,
+		},
+		"invalid order": {
+			msg: *types.NewMsgBatchPlaceOrders([]types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				zeroQuantumsOrder,
+			}),
+			err: types.ErrInvalidOrderQuantums,
+		},
+		"stateful order": {
+			msg: *types.NewMsgBatchPlaceOrders([]types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			}),
+			err: types.ErrInvalidBatchPlaceOrders,
+		},
+		"orders for different subaccounts": {
+			msg: *types.NewMsgBatchPlaceOrders([]types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price10_GTB20,
+			}),
+			err: types.ErrInvalidBatchPlaceOrders,
+		},
+		"duplicate order ids": {
+			msg: *types.NewMsgBatchPlaceOrders([]types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+			}),
+			err: types.ErrInvalidBatchPlaceOrders,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBatchPlaceOrders_GetOrder(t *testing.T) {
+	msg := types.NewMsgBatchPlaceOrders([]types.Order{
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+		constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+	})
+
+	order, found := msg.GetOrder(constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15.OrderId)
+	require.True(t, found)
+	require.Equal(t, constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15, order)
+
+	_, found = msg.GetOrder(constants.Order_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB15.OrderId)
+	require.False(t, found)
+}
+
+func TestMsgBatchPlaceOrders_GetSigners(t *testing.T) {
+	msg := types.NewMsgBatchPlaceOrders([]types.Order{
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+	})
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+
+	require.Empty(t, types.NewMsgBatchPlaceOrders([]types.Order{}).GetSigners())
+}
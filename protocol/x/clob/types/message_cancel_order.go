@@ -35,11 +35,7 @@ func NewMsgCancelOrderStateful(orderId OrderId, goodTilBlockTime uint32) *MsgCan
 }
 
 func (msg *MsgCancelOrder) GetSigners() []sdk.AccAddress {
-	creator, err := sdk.AccAddressFromBech32(msg.OrderId.SubaccountId.Owner)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{creator}
+	return getTraderSigners(msg.OrderId.SubaccountId.Owner, msg.Grantee)
 }
 
 func (msg *MsgCancelOrder) ValidateBasic() (err error) {
@@ -59,6 +55,10 @@ func (msg *MsgCancelOrder) ValidateBasic() (err error) {
 		return err
 	}
 
+	if err := validateGrantee(orderId.SubaccountId.Owner, msg.Grantee); err != nil {
+		return err
+	}
+
 	if orderId.IsStatefulOrder() {
 		if msg.GetGoodTilBlockTime() == 0 {
 			return errorsmod.Wrapf(
@@ -34,7 +34,7 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 					Status:           types.ClobPair_STATUS_ACTIVE,
 				},
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "must have USDC as the quote asset",
 		},
 		{
 			desc: "Empty authority",
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgGrantTradingPermission = "grant_trading_permission"
+
+var _ sdk.Msg = &MsgGrantTradingPermission{}
+
+// NewMsgGrantTradingPermission constructs a `MsgGrantTradingPermission` from a `TradingPermission`.
+func NewMsgGrantTradingPermission(permission TradingPermission) *MsgGrantTradingPermission {
+	return &MsgGrantTradingPermission{
+		Permission: permission,
+	}
+}
+
+// GetSigners requires that the `MsgGrantTradingPermission` message is signed by the owner of the subaccount.
+func (msg *MsgGrantTradingPermission) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Permission.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic performs stateless validation on the trading permission being granted.
+func (msg *MsgGrantTradingPermission) ValidateBasic() error {
+	return msg.Permission.Validate()
+}
@@ -4,6 +4,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
 
@@ -18,11 +19,7 @@ func NewMsgPlaceOrder(order Order) *MsgPlaceOrder {
 }
 
 func (msg *MsgPlaceOrder) GetSigners() []sdk.AccAddress {
-	creator, err := sdk.AccAddressFromBech32(msg.Order.OrderId.SubaccountId.Owner)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{creator}
+	return getTraderSigners(msg.Order.OrderId.SubaccountId.Owner, msg.Grantee)
 }
 
 func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
@@ -41,6 +38,10 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		return err
 	}
 
+	if err := validateGrantee(msg.Order.OrderId.SubaccountId.Owner, msg.Grantee); err != nil {
+		return err
+	}
+
 	if _, exists := Order_Side_name[int32(msg.Order.Side)]; !exists {
 		return errorsmod.Wrapf(ErrInvalidOrderSide, "invalid order side (%s)", msg.Order.Side)
 	}
@@ -49,6 +50,14 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		return errorsmod.Wrapf(ErrInvalidOrderSide, "UNSPECIFIED is not a valid order side")
 	}
 
+	if _, exists := Order_SelfTradePrevention_name[int32(msg.Order.SelfTradePrevention)]; !exists {
+		return errorsmod.Wrapf(
+			ErrInvalidSelfTradePrevention,
+			"invalid self-trade prevention mode (%s)",
+			msg.Order.SelfTradePrevention,
+		)
+	}
+
 	if msg.Order.Quantums == uint64(0) {
 		return errorsmod.Wrapf(ErrInvalidOrderQuantums, "order size quantums cannot be 0")
 	}
@@ -103,5 +112,29 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		}
 	}
 
+	if msg.Order.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP {
+		if (msg.Order.TrailingOffsetSubticks == uint64(0)) == (msg.Order.TrailingOffsetPpm == uint32(0)) {
+			return errorsmod.Wrapf(
+				ErrInvalidTrailingOffset,
+				"exactly one of trailing offset subticks and trailing offset ppm must be nonzero for trailing stop order",
+			)
+		}
+
+		if msg.Order.TrailingOffsetPpm >= lib.OneMillion {
+			return errorsmod.Wrapf(
+				ErrInvalidTrailingOffset,
+				"trailing offset ppm %d must be less than %d",
+				msg.Order.TrailingOffsetPpm,
+				lib.OneMillion,
+			)
+		}
+	} else if msg.Order.TrailingOffsetSubticks != uint64(0) || msg.Order.TrailingOffsetPpm != uint32(0) {
+		return errorsmod.Wrapf(ErrInvalidTrailingOffset, "trailing offset specified for non-trailing stop order")
+	}
+
+	if orderId.IsShortTermOrder() && msg.Order.OrderGroupId != uint32(0) {
+		return errorsmod.Wrapf(ErrInvalidOrderGroupId, "order group id specified for short-term order")
+	}
+
 	return nil
 }
@@ -39,6 +39,20 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 			},
 			err: satypes.ErrInvalidSubaccountIdNumber,
 		},
+		"invalid grantee": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+				},
+				Grantee: "invalid_grantee",
+			},
+			err: ErrInvalidGrantee,
+		},
 		"invalid side": {
 			msg: MsgPlaceOrder{
 				Order: Order{
@@ -215,6 +229,23 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 				},
 			},
 		},
+		"success with grantee": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+					Side:         Order_SIDE_BUY,
+					Quantums:     uint64(42),
+					GoodTilOneof: &Order_GoodTilBlock{GoodTilBlock: uint32(100)},
+					Subticks:     uint64(10),
+				},
+				Grantee: sample.AccAddress(),
+			},
+		},
 		"success with fill-or-kill order": {
 			msg: MsgPlaceOrder{
 				Order: Order{
@@ -356,6 +387,242 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidConditionalOrderTriggerSubticks,
 		},
+		"conditional: valid trailing stop with subticks offset": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetSubticks:          uint64(5),
+				},
+			},
+		},
+		"conditional: valid trailing stop with ppm offset": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetPpm:               uint32(10_000),
+				},
+			},
+		},
+		"conditional: trailing stop without trailing offset": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"conditional: trailing stop with both trailing offsets": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetSubticks:          uint64(5),
+					TrailingOffsetPpm:               uint32(10_000),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"conditional: trailing stop with trailing offset ppm of one million": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetPpm:               uint32(1_000_000),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"conditional: stop loss with trailing offset": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetSubticks:          uint64(5),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"non-conditional: greater than zero TrailingOffsetPpm": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TrailingOffsetPpm: uint32(10_000),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"short-term: greater than zero OrderGroupId": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlock{
+						GoodTilBlock: uint32(100),
+					},
+					OrderGroupId: uint32(1),
+				},
+			},
+			err: ErrInvalidOrderGroupId,
+		},
+		"long-term: greater than zero OrderGroupId": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					OrderGroupId: uint32(1),
+				},
+			},
+		},
+		"invalid self-trade prevention mode": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlock{
+						GoodTilBlock: uint32(100),
+					},
+					SelfTradePrevention: Order_SelfTradePrevention(5),
+				},
+			},
+			err: ErrInvalidSelfTradePrevention,
+		},
+		"valid self-trade prevention mode": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlock{
+						GoodTilBlock: uint32(100),
+					},
+					SelfTradePrevention: Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+				},
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -25,7 +25,8 @@ func (msg *MsgProposedOperations) ValidateBasic() error {
 		switch operation := rawOperation.Operation.(type) {
 		case
 			*OperationRaw_Match,
-			*OperationRaw_ShortTermOrderPlacement:
+			*OperationRaw_ShortTermOrderPlacement,
+			*OperationRaw_ShortTermOrderBatchPlacement:
 			// no-op, stateless validation is done in ValidateAndTransformRawOperations
 		case *OperationRaw_OrderRemoval:
 			orderId := operation.OrderRemoval.GetOrderId()
@@ -61,7 +62,8 @@ func (msg *MsgProposedOperations) ValidateBasic() error {
 			)
 		}
 	}
-	return nil
+
+	return ValidateMakerDepthSamples(msg.GetMakerDepthSamples())
 }
 
 // ValidateAndTransformRawOperations performs stateless validation on the proposed operation queue
@@ -109,6 +111,26 @@ func ValidateAndTransformRawOperations(
 			); err != nil {
 				return nil, err
 			}
+		case *OperationRaw_ShortTermOrderBatchPlacement:
+			var batchOperations []InternalOperation
+			batchOperations, err = decodeOperationRawShortTermOrderBatchPlacement(
+				ctx,
+				rawOperation.GetShortTermOrderBatchPlacement(),
+				decoder,
+				anteHandler,
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, batchOperation := range batchOperations {
+				if err = validator.validateShortTermOrderPlacementOperation(
+					batchOperation.GetShortTermOrderPlacement(),
+				); err != nil {
+					return nil, err
+				}
+			}
+			operations = append(operations, batchOperations...)
+			continue
 		case *OperationRaw_OrderRemoval:
 			orderRemoval := rawOperation.GetOrderRemoval()
 			if err := orderRemoval.OrderId.Validate(); err != nil {
@@ -96,6 +96,30 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectedError: errors.New("order removals for invalid reduce-only orders are not allowed"),
 		},
+		"valid maker depth samples": {
+			msg: types.MsgProposedOperations{
+				MakerDepthSamples: []types.MakerDepthSample{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						ClobPairId:       0,
+						BidQuoteQuantums: 100,
+						AskQuoteQuantums: 200,
+					},
+				},
+			},
+		},
+		"invalid maker depth samples returns error": {
+			msg: types.MsgProposedOperations{
+				MakerDepthSamples: []types.MakerDepthSample{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						ClobPairId:       0,
+						BidQuoteQuantums: 100,
+					},
+				},
+			},
+			expectedError: types.ErrInvalidMakerDepthSamples,
+		},
 	}
 
 	for name, tc := range tests {
@@ -511,7 +535,72 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("expected MsgPlaceOrder, got *types.MsgCancelOrder"),
+			expectedError: errors.New("expected MsgPlaceOrder or MsgReplaceOrder, got *types.MsgCancelOrder"),
+		},
+		"Short term order placement tx bytes contains a Short-Term order replacement": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderPlacement{
+						ShortTermOrderPlacement: testtx.MustGetTxBytes(
+							constants.Msg_ReplaceOrder,
+						),
+					},
+				},
+			},
+		},
+		"Short term order placement tx bytes contains a Long-Term order replacement": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderPlacement{
+						ShortTermOrderPlacement: testtx.MustGetTxBytes(
+							constants.Msg_ReplaceOrder_LongTerm,
+						),
+					},
+				},
+			},
+			expectedError: errors.New("expected Short-Term MsgReplaceOrder"),
+		},
+		"Short term order batch placement places every order id in the batch": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: testtx.MustGetTxBytes(constants.Msg_BatchPlaceOrders),
+							OrderIds: []types.OrderId{
+								constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+								constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15.OrderId,
+							},
+						},
+					},
+				},
+			},
+		},
+		"Short term order batch placement does not contain any order ids": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: testtx.MustGetTxBytes(constants.Msg_BatchPlaceOrders),
+						},
+					},
+				},
+			},
+			expectedError: errors.New("batch placement does not contain any order IDs"),
+		},
+		"Short term order batch placement contains an order id not in the batch": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: testtx.MustGetTxBytes(constants.Msg_BatchPlaceOrders),
+							OrderIds: []types.OrderId{
+								constants.Order_Bob_Num0_Id0_Clob0_Sell100BTC_Price101_GTB20.OrderId,
+							},
+						},
+					},
+				},
+			},
+			expectedError: errors.New("not found in MsgBatchPlaceOrders"),
 		},
 	}
 	for name, tc := range tests {
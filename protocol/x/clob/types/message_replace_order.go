@@ -0,0 +1,90 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+)
+
+const TypeMsgReplaceOrder = "replace_order"
+
+var _ sdk.Msg = &MsgReplaceOrder{}
+
+// NewMsgReplaceOrder constructs a `MsgReplaceOrder` from the ID of the order to replace and the
+// new order to place.
+func NewMsgReplaceOrder(oldOrderId OrderId, newOrder Order) *MsgReplaceOrder {
+	return &MsgReplaceOrder{
+		OldOrderId: oldOrderId,
+		NewOrder:   newOrder,
+	}
+}
+
+func (msg *MsgReplaceOrder) GetSigners() []sdk.AccAddress {
+	return getTraderSigners(msg.NewOrder.OrderId.SubaccountId.Owner, msg.Grantee)
+}
+
+// ValidateBasic performs stateless validation on the order replacement. It returns an error if the
+// new order fails the stateless validation performed for `MsgPlaceOrder`, or if the old and new orders
+// are for different subaccounts or clob pairs, or are not both Short-Term or both Long-Term orders.
+func (msg *MsgReplaceOrder) ValidateBasic() (err error) {
+	defer func() {
+		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{ModuleName, metrics.ReplaceOrder, metrics.ValidateBasic, metrics.Error, metrics.Count},
+				1,
+				msg.NewOrder.GetOrderLabels(),
+			)
+		}
+	}()
+
+	if err := msg.GetMsgPlaceOrder().ValidateBasic(); err != nil {
+		return err
+	}
+
+	oldOrderId := msg.OldOrderId
+	newOrderId := msg.NewOrder.OrderId
+	if oldOrderId.SubaccountId != newOrderId.SubaccountId {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"old order subaccount %+v does not match new order subaccount %+v",
+			oldOrderId.SubaccountId,
+			newOrderId.SubaccountId,
+		)
+	}
+
+	if oldOrderId.ClobPairId != newOrderId.ClobPairId {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"old order clob pair %d does not match new order clob pair %d",
+			oldOrderId.ClobPairId,
+			newOrderId.ClobPairId,
+		)
+	}
+
+	// Conditional orders are not supported since the old and new orders may be in different trigger states.
+	if !(oldOrderId.IsShortTermOrder() && newOrderId.IsShortTermOrder()) &&
+		!(oldOrderId.IsLongTermOrder() && newOrderId.IsLongTermOrder()) {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"old order %+v and new order %+v must both be Short-Term or both be Long-Term orders",
+			oldOrderId,
+			newOrderId,
+		)
+	}
+
+	return nil
+}
+
+// IsShortTermReplacement returns true if the order replacement replaces a Short-Term order.
+func (msg *MsgReplaceOrder) IsShortTermReplacement() bool {
+	return msg.NewOrder.IsShortTermOrder()
+}
+
+// GetMsgPlaceOrder returns the `MsgPlaceOrder` for the new order.
+func (msg *MsgReplaceOrder) GetMsgPlaceOrder() *MsgPlaceOrder {
+	return &MsgPlaceOrder{
+		Order:   msg.NewOrder,
+		Grantee: msg.Grantee,
+	}
+}
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReplaceOrder_ValidateBasic(t *testing.T) {
+	zeroQuantumsOrder := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+	zeroQuantumsOrder.Quantums = 0
+
+	tests := map[string]struct {
+		msg types.MsgReplaceOrder
+		err error
+	}{
+		"valid Short-Term replacement": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+				constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+			),
+		},
+		"valid Short-Term replacement with the same order ID": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+				constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+			),
+		},
+		"valid Long-Term replacement": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+			),
+		},
+		"invalid new order": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+				zeroQuantumsOrder,
+			),
+			err: types.ErrInvalidOrderQuantums,
+		},
+		"orders for different subaccounts": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+				constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price10_GTB20,
+			),
+			err: types.ErrInvalidReplaceOrder,
+		},
+		"orders for different clob pairs": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+				constants.Order_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB15,
+			),
+			err: types.ErrInvalidReplaceOrder,
+		},
+		"Short-Term order replaced with Long-Term order": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			),
+			err: types.ErrInvalidReplaceOrder,
+		},
+		"conditional order replacement": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20.OrderId,
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			),
+			err: types.ErrInvalidReplaceOrder,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgReplaceOrder_IsShortTermReplacement(t *testing.T) {
+	require.True(
+		t,
+		types.NewMsgReplaceOrder(
+			constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+			constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+		).IsShortTermReplacement(),
+	)
+	require.False(
+		t,
+		types.NewMsgReplaceOrder(
+			constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+			constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+		).IsShortTermReplacement(),
+	)
+}
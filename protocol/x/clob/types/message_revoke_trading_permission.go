@@ -0,0 +1,46 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+const TypeMsgRevokeTradingPermission = "revoke_trading_permission"
+
+var _ sdk.Msg = &MsgRevokeTradingPermission{}
+
+// NewMsgRevokeTradingPermission constructs a `MsgRevokeTradingPermission` from a subaccount ID and
+// the grantee whose permission is revoked.
+func NewMsgRevokeTradingPermission(
+	subaccountId satypes.SubaccountId,
+	grantee string,
+) *MsgRevokeTradingPermission {
+	return &MsgRevokeTradingPermission{
+		SubaccountId: subaccountId,
+		Grantee:      grantee,
+	}
+}
+
+// GetSigners requires that the `MsgRevokeTradingPermission` message is signed by the owner of the subaccount.
+func (msg *MsgRevokeTradingPermission) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic performs stateless validation on the revocation. It returns an error if the
+// subaccount ID or grantee is invalid.
+func (msg *MsgRevokeTradingPermission) ValidateBasic() error {
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	if msg.Grantee == "" {
+		return errorsmod.Wrap(ErrInvalidGrantee, "grantee cannot be empty")
+	}
+
+	return validateGrantee(msg.SubaccountId.Owner, msg.Grantee)
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRevokeTradingPermission_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg types.MsgRevokeTradingPermission
+		err error
+	}{
+		"invalid subaccount number": {
+			msg: *types.NewMsgRevokeTradingPermission(
+				satypes.SubaccountId{Owner: constants.AliceAccAddress.String(), Number: 9999},
+				constants.BobAccAddress.String(),
+			),
+			err: satypes.ErrInvalidSubaccountIdNumber,
+		},
+		"empty grantee": {
+			msg: *types.NewMsgRevokeTradingPermission(constants.Alice_Num0, ""),
+			err: types.ErrInvalidGrantee,
+		},
+		"grantee is the subaccount owner": {
+			msg: *types.NewMsgRevokeTradingPermission(constants.Alice_Num0, constants.Alice_Num0.Owner),
+			err: types.ErrInvalidGrantee,
+		},
+		"valid": {
+			msg: *types.NewMsgRevokeTradingPermission(constants.Alice_Num0, constants.BobAccAddress.String()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRevokeTradingPermission_GetSigners(t *testing.T) {
+	msg := types.NewMsgRevokeTradingPermission(constants.Alice_Num0, constants.BobAccAddress.String())
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+}
@@ -37,7 +37,7 @@ func TestMsgUpdateClobPair_ValidateBasic(t *testing.T) {
 				SubticksPerTick:  1,
 				Status:           types.ClobPair_STATUS_ACTIVE,
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "must have USDC as the quote asset",
 		},
 		{
 			desc:      "UNSPECIFIED Status",
@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateMakerUptimeRewardsConfig{}
+
+// GetSigners requires that the MsgUpdateMakerUptimeRewardsConfig message is signed by the gov module.
+func (msg *MsgUpdateMakerUptimeRewardsConfig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validates the message's MakerUptimeRewardsConfig. Returns an error if the authority
+// is empty or if the MakerUptimeRewardsConfig is invalid.
+func (msg *MsgUpdateMakerUptimeRewardsConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+
+	return msg.MakerUptimeRewardsConfig.Validate()
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateMakerUptimeRewardsConfig_GetSigners(t *testing.T) {
+	msg := types.MsgUpdateMakerUptimeRewardsConfig{
+		Authority: constants.AliceAccAddress.String(),
+	}
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+}
+
+func TestMsgUpdateMakerUptimeRewardsConfig_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg           types.MsgUpdateMakerUptimeRewardsConfig
+		expectedError string
+	}{
+		"valid": {
+			msg: types.MsgUpdateMakerUptimeRewardsConfig{
+				Authority: constants.AliceAccAddress.String(),
+				MakerUptimeRewardsConfig: types.MakerUptimeRewardsConfig{
+					SampleIntervalBlocks: 10,
+					MaxSpreadPpm:         5_000,
+					RewardWeightPpm:      100,
+				},
+			},
+		},
+		"invalid maker uptime rewards config": {
+			msg: types.MsgUpdateMakerUptimeRewardsConfig{
+				Authority: constants.AliceAccAddress.String(),
+				MakerUptimeRewardsConfig: types.MakerUptimeRewardsConfig{
+					SampleIntervalBlocks: 10,
+				},
+			},
+			expectedError: "MaxSpreadPpm and RewardWeightPpm must be positive",
+		},
+		"invalid authority": {
+			msg:           types.MsgUpdateMakerUptimeRewardsConfig{},
+			expectedError: "Authority is invalid",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
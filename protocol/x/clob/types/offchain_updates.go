@@ -13,6 +13,7 @@ const (
 	PlaceMessageType OffchainUpdateMessageType = iota
 	RemoveMessageType
 	UpdateMessageType
+	ReplaceMessageType
 )
 
 // Represents a single message added to the OffchainUpdates.
@@ -51,6 +52,43 @@ func (om *OffchainUpdates) AddRemoveMessage(orderId OrderId, message msgsender.M
 	om.Messages = append(om.Messages, OffchainUpdateMessage{RemoveMessageType, orderId, message})
 }
 
+// AddReplaceMessage adds an off-chain message for the replacement of an order to the OffchainUpdates.
+// Note that the message is tracked under the new order's `OrderId`.
+func (om *OffchainUpdates) AddReplaceMessage(orderId OrderId, message msgsender.Message) {
+	om.Messages = append(om.Messages, OffchainUpdateMessage{ReplaceMessageType, orderId, message})
+}
+
+// CondenseMessagesForReplacement replaces the removal messages for the replaced order and the place
+// messages for the new order with a single replace message, so that Indexer receives the replacement
+// as one update. The replace message is inserted at the position of the first replaced message.
+// Intended for use after off-chain messages are generated when placing a replacement order.
+func (om *OffchainUpdates) CondenseMessagesForReplacement(
+	oldOrderId OrderId,
+	newOrderId OrderId,
+	replaceMessage msgsender.Message,
+) {
+	newMessages := make([]OffchainUpdateMessage, 0, len(om.Messages)+1)
+	addedReplaceMessage := false
+	for _, msg := range om.Messages {
+		isReplacedMessage := (msg.Type == RemoveMessageType && msg.OrderId == oldOrderId) ||
+			(msg.Type == PlaceMessageType && msg.OrderId == newOrderId)
+		if !isReplacedMessage {
+			newMessages = append(newMessages, msg)
+			continue
+		}
+
+		if !addedReplaceMessage {
+			newMessages = append(newMessages, OffchainUpdateMessage{ReplaceMessageType, newOrderId, replaceMessage})
+			addedReplaceMessage = true
+		}
+	}
+
+	if !addedReplaceMessage {
+		newMessages = append(newMessages, OffchainUpdateMessage{ReplaceMessageType, newOrderId, replaceMessage})
+	}
+	om.Messages = newMessages
+}
+
 // CondenseMessageForReplay removes all but the last off-chain message for each OrderId from the
 // slice of all off-chain messages tracked by the OffchainUpdates struct with the exception of
 // OrderPlace messages.
@@ -67,15 +105,15 @@ func (om *OffchainUpdates) CondenseMessagesForReplay() {
 		}
 		seenOrderIds.Add(msg.OrderId)
 
-		// Since we don't need to keep Place message types as Indexer will already have ingested
-		// them, then...
+		// Since we don't need to keep Place or Replace message types as Indexer will already have
+		// ingested them, then...
 		// 1. If the Place message was the finalmost message, we don't need to keep any messages for
 		//    that OrderId, as we have all the information we need from it.
 		// 2. If the final message was a Remove, we don't need to know about Place or Update messages
 		//    because it'll just be removed anyway.
 		// 3. Since Update messages only have an "amount filled" parameter, we only need the latest
 		//    message.
-		if msg.Type == PlaceMessageType {
+		if msg.Type == PlaceMessageType || msg.Type == ReplaceMessageType {
 			continue
 		}
 
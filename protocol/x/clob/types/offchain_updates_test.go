@@ -140,6 +140,93 @@ func TestAppend(t *testing.T) {
 	}
 }
 
+func TestAddReplaceMessage(t *testing.T) {
+	offchainUpdates := NewOffchainUpdates()
+	offchainUpdates.AddReplaceMessage(orderId0, message0)
+
+	require.Equal(t, message0, offchainUpdates.Messages[0].Message)
+	require.Equal(t, ReplaceMessageType, offchainUpdates.Messages[0].Type)
+	require.Equal(t, orderId0, offchainUpdates.Messages[0].OrderId)
+	require.Equal(t, []msgsender.Message{message0}, offchainUpdates.GetMessages())
+}
+
+func TestCondenseMessagesForReplacement(t *testing.T) {
+	replaceMessage := msgsender.Message{
+		Key:   []byte("replace"),
+		Value: []byte("value0"),
+	}
+
+	tests := map[string]struct {
+		// Inputs
+		messages   []OffchainUpdateMessage
+		oldOrderId OrderId
+		newOrderId OrderId
+
+		// Expectations
+		expectedMessages []OffchainUpdateMessage
+	}{
+		"Replaces removal of old order and placement of new order": {
+			messages: []OffchainUpdateMessage{
+				{PlaceMessageType, orderId1, message1},
+				{UpdateMessageType, orderId1, message1},
+				{RemoveMessageType, orderId0, message0},
+			},
+			oldOrderId: orderId0,
+			newOrderId: orderId1,
+			expectedMessages: []OffchainUpdateMessage{
+				{ReplaceMessageType, orderId1, replaceMessage},
+				{UpdateMessageType, orderId1, message1},
+			},
+		},
+		"Replaces removal and placement of order with the same order ID": {
+			messages: []OffchainUpdateMessage{
+				{RemoveMessageType, orderId0, message0},
+				{PlaceMessageType, orderId0, message1},
+				{UpdateMessageType, orderId0, message1},
+			},
+			oldOrderId: orderId0,
+			newOrderId: orderId0,
+			expectedMessages: []OffchainUpdateMessage{
+				{ReplaceMessageType, orderId0, replaceMessage},
+				{UpdateMessageType, orderId0, message1},
+			},
+		},
+		"Keeps messages for other orders": {
+			messages: []OffchainUpdateMessage{
+				{PlaceMessageType, orderId1, message1},
+				{UpdateMessageType, orderId2, message2},
+				{RemoveMessageType, orderId2, message2},
+				{RemoveMessageType, orderId0, message0},
+			},
+			oldOrderId: orderId0,
+			newOrderId: orderId1,
+			expectedMessages: []OffchainUpdateMessage{
+				{ReplaceMessageType, orderId1, replaceMessage},
+				{UpdateMessageType, orderId2, message2},
+				{RemoveMessageType, orderId2, message2},
+			},
+		},
+		"Appends replace message if there are no replaced messages": {
+			messages: []OffchainUpdateMessage{
+				{UpdateMessageType, orderId2, message2},
+			},
+			oldOrderId: orderId0,
+			newOrderId: orderId1,
+			expectedMessages: []OffchainUpdateMessage{
+				{UpdateMessageType, orderId2, message2},
+				{ReplaceMessageType, orderId1, replaceMessage},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			offchainUpdates := &OffchainUpdates{Messages: tc.messages}
+			offchainUpdates.CondenseMessagesForReplacement(tc.oldOrderId, tc.newOrderId, replaceMessage)
+			require.Equal(t, tc.expectedMessages, offchainUpdates.Messages)
+		})
+	}
+}
+
 func TestGetMessages(t *testing.T) {
 	tests := map[string]struct {
 		// Inputs
@@ -31,9 +31,9 @@ func decodeOperationRawShortTermOrderPlacementBytes(
 		return nil, fmt.Errorf("expected 1 msg, got %d", len(msgs))
 	}
 
-	msg, ok := msgs[0].(*MsgPlaceOrder)
-	if !ok {
-		return nil, fmt.Errorf("expected MsgPlaceOrder, got %T", msgs[0])
+	msg, err := GetShortTermOrderPlacementMsgPlaceOrder(msgs[0])
+	if err != nil {
+		return nil, err
 	}
 
 	return &InternalOperation{
@@ -43,6 +43,71 @@ func decodeOperationRawShortTermOrderPlacementBytes(
 	}, nil
 }
 
+// GetShortTermOrderPlacementMsgPlaceOrder returns the `MsgPlaceOrder` for the order placed by the message
+// of a Short-Term order placement transaction. Short-Term orders are placed by a `MsgPlaceOrder`, or by a
+// `MsgReplaceOrder` which replaces a Short-Term order.
+func GetShortTermOrderPlacementMsgPlaceOrder(msg sdk.Msg) (*MsgPlaceOrder, error) {
+	switch msg := msg.(type) {
+	case *MsgPlaceOrder:
+		return msg, nil
+	case *MsgReplaceOrder:
+		if !msg.IsShortTermReplacement() {
+			return nil, fmt.Errorf("expected Short-Term MsgReplaceOrder, got %+v", msg)
+		}
+		return msg.GetMsgPlaceOrder(), nil
+	default:
+		return nil, fmt.Errorf("expected MsgPlaceOrder or MsgReplaceOrder, got %T", msg)
+	}
+}
+
+// decodeOperationRawShortTermOrderBatchPlacement decodes the `MsgBatchPlaceOrders` transaction of
+// the provided batch placement and returns a Short-Term order placement operation for each order in
+// the batch with one of the provided order IDs. The transaction is only decoded and run through the
+// antehandler once for the entire batch.
+func decodeOperationRawShortTermOrderBatchPlacement(
+	ctx sdk.Context,
+	batchPlacement *ShortTermOrderBatchPlacement,
+	decoder sdk.TxDecoder,
+	anteHandler sdk.AnteHandler,
+) ([]InternalOperation, error) {
+	if len(batchPlacement.OrderIds) == 0 {
+		return nil, fmt.Errorf("batch placement does not contain any order IDs")
+	}
+
+	tx, err := decoder(batchPlacement.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := anteHandler(ctx, tx, false); err != nil {
+		return nil, err
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("expected 1 msg, got %d", len(msgs))
+	}
+
+	msg, ok := msgs[0].(*MsgBatchPlaceOrders)
+	if !ok {
+		return nil, fmt.Errorf("expected MsgBatchPlaceOrders, got %T", msgs[0])
+	}
+
+	operations := make([]InternalOperation, 0, len(batchPlacement.OrderIds))
+	for _, orderId := range batchPlacement.OrderIds {
+		order, found := msg.GetOrder(orderId)
+		if !found {
+			return nil, fmt.Errorf("order %+v not found in MsgBatchPlaceOrders", orderId)
+		}
+		operations = append(operations, InternalOperation{
+			Operation: &InternalOperation_ShortTermOrderPlacement{
+				ShortTermOrderPlacement: NewMsgPlaceOrder(order),
+			},
+		})
+	}
+	return operations, nil
+}
+
 // GetInternalOperationTextString returns the text string representation of this operation.
 // TODO(DEC-1772): Add method for encoding operation protos as JSON to make debugging easier.
 func (o *InternalOperation) GetInternalOperationTextString() string {
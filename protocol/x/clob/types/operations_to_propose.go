@@ -3,6 +3,10 @@ package types
 import (
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -413,6 +417,8 @@ func (o *OperationsToPropose) GetOperationsToReplay() (
 // of OperationRaw.
 func (o *OperationsToPropose) GetOperationsToPropose() []OperationRaw {
 	operationRaws := make([]OperationRaw, 0)
+	// Index in `operationRaws` of the batch placement operation of each `MsgBatchPlaceOrders` transaction.
+	batchPlacementIndexes := make(map[string]int)
 
 	for _, operation := range o.OperationsQueue {
 		switch operation := operation.Operation.(type) {
@@ -436,6 +442,30 @@ func (o *OperationsToPropose) GetOperationsToPropose() []OperationRaw {
 					),
 				)
 			}
+			// Short-Term orders placed as part of the same `MsgBatchPlaceOrders` are proposed as a single
+			// operation at the position of the first placed order of the batch, so the transaction bytes
+			// are only included once.
+			// Note that this can only move placements of a batch earlier in the proposed operations,
+			// never later. This is safe since Short-Term order placements are validated in DeliverTx
+			// independently of all other operations, and a match only requires the placements of its
+			// orders to precede it, which still holds after moving a placement earlier.
+			if isMsgBatchPlaceOrdersTxBytes(operationBytes) {
+				if i, exists := batchPlacementIndexes[string(operationBytes)]; exists {
+					batchPlacement := operationRaws[i].GetShortTermOrderBatchPlacement()
+					batchPlacement.OrderIds = append(batchPlacement.OrderIds, order.OrderId)
+					continue
+				}
+				batchPlacementIndexes[string(operationBytes)] = len(operationRaws)
+				operationRaws = append(operationRaws, OperationRaw{
+					Operation: &OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &ShortTermOrderBatchPlacement{
+							TxBytes:  operationBytes,
+							OrderIds: []OrderId{order.OrderId},
+						},
+					},
+				})
+				continue
+			}
 			operationRaws = append(operationRaws, OperationRaw{
 				Operation: &OperationRaw_ShortTermOrderPlacement{
 					ShortTermOrderPlacement: operationBytes,
@@ -455,3 +485,30 @@ func (o *OperationsToPropose) GetOperationsToPropose() []OperationRaw {
 
 	return operationRaws
 }
+
+// isMsgBatchPlaceOrdersTxBytes returns true if the provided raw transaction bytes contain a
+// `MsgBatchPlaceOrders`. Note that this only inspects the transaction body and does not
+// perform any validation of the transaction.
+func isMsgBatchPlaceOrdersTxBytes(txBytes []byte) bool {
+	msg, ok := getSingleMsg(txBytes)
+	return ok && msg.TypeUrl == sdk.MsgTypeURL(&MsgBatchPlaceOrders{})
+}
+
+// getSingleMsg returns the only message in the provided raw transaction bytes. Returns false if
+// the bytes cannot be decoded or the transaction does not contain exactly one message.
+func getSingleMsg(txBytes []byte) (msg *codectypes.Any, ok bool) {
+	var txRaw txtypes.TxRaw
+	if err := txRaw.Unmarshal(txBytes); err != nil {
+		return nil, false
+	}
+
+	var txBody txtypes.TxBody
+	if err := txBody.Unmarshal(txRaw.BodyBytes); err != nil {
+		return nil, false
+	}
+
+	if len(txBody.Messages) != 1 {
+		return nil, false
+	}
+	return txBody.Messages[0], true
+}
@@ -1049,6 +1049,155 @@ func TestGetOperationsToPropose_Success(t *testing.T) {
 				},
 			},
 		},
+		"Short term orders placed in the same batch are proposed as a single operation": {
+			setup: func(otp *types.OperationsToPropose) {
+				firstOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+				secondOrder := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+				makerOrder := constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss15
+				otp.MustAddShortTermOrderTxBytes(firstOrder, constants.Msg_BatchPlaceOrders_TxBytes)
+				otp.MustAddShortTermOrderTxBytes(secondOrder, constants.Msg_BatchPlaceOrders_TxBytes)
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(firstOrder)
+				// This is not included in operations to propose.
+				otp.MustAddStatefulOrderPlacementToOperationsQueue(makerOrder)
+				otp.MustAddMatchToOperationsQueue(
+					&firstOrder,
+					[]types.MakerFillWithOrder{
+						{
+							MakerFill: types.MakerFill{
+								FillAmount:   5,
+								MakerOrderId: makerOrder.OrderId,
+							},
+							Order: makerOrder,
+						},
+					},
+				)
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(secondOrder)
+			},
+			expectedOperations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: constants.Msg_BatchPlaceOrders_TxBytes,
+							OrderIds: []types.OrderId{
+								constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+								constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15.OrderId,
+							},
+						},
+					},
+				},
+				{
+					Operation: &types.OperationRaw_Match{
+						Match: &types.ClobMatch{
+							Match: &types.ClobMatch_MatchOrders{
+								MatchOrders: &types.MatchOrders{
+									TakerOrderId: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+									Fills: []types.MakerFill{
+										{
+											FillAmount:   5,
+											MakerOrderId: constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss15.OrderId,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"Batch placements are moved before interleaved operations and still precede their matches": {
+			setup: func(otp *types.OperationsToPropose) {
+				firstOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+				secondOrder := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+				takerOrder := constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22
+				makerOrder := constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss15
+				otp.MustAddShortTermOrderTxBytes(firstOrder, constants.Msg_BatchPlaceOrders_TxBytes)
+				otp.MustAddShortTermOrderTxBytes(secondOrder, constants.Msg_BatchPlaceOrders_TxBytes)
+				// Dummy bytes for testing.
+				otp.MustAddShortTermOrderTxBytes(takerOrder, []byte{4, 0, 8})
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(firstOrder)
+				// This is not included in operations to propose.
+				otp.MustAddStatefulOrderPlacementToOperationsQueue(makerOrder)
+				otp.MustAddMatchToOperationsQueue(
+					&firstOrder,
+					[]types.MakerFillWithOrder{
+						{
+							MakerFill: types.MakerFill{
+								FillAmount:   5,
+								MakerOrderId: makerOrder.OrderId,
+							},
+							Order: makerOrder,
+						},
+					},
+				)
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(takerOrder)
+				// The second order of the batch is placed after the unrelated taker order.
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(secondOrder)
+				otp.MustAddMatchToOperationsQueue(
+					&takerOrder,
+					[]types.MakerFillWithOrder{
+						{
+							MakerFill: types.MakerFill{
+								FillAmount:   5,
+								MakerOrderId: secondOrder.OrderId,
+							},
+							Order: secondOrder,
+						},
+					},
+				)
+			},
+			expectedOperations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: constants.Msg_BatchPlaceOrders_TxBytes,
+							OrderIds: []types.OrderId{
+								constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+								constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15.OrderId,
+							},
+						},
+					},
+				},
+				{
+					Operation: &types.OperationRaw_Match{
+						Match: &types.ClobMatch{
+							Match: &types.ClobMatch_MatchOrders{
+								MatchOrders: &types.MatchOrders{
+									TakerOrderId: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+									Fills: []types.MakerFill{
+										{
+											FillAmount:   5,
+											MakerOrderId: constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss15.OrderId,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Operation: &types.OperationRaw_ShortTermOrderPlacement{
+						ShortTermOrderPlacement: []byte{4, 0, 8},
+					},
+				},
+				{
+					Operation: &types.OperationRaw_Match{
+						Match: &types.ClobMatch{
+							Match: &types.ClobMatch_MatchOrders{
+								MatchOrders: &types.MatchOrders{
+									TakerOrderId: constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22.OrderId,
+									Fills: []types.MakerFill{
+										{
+											FillAmount:   5,
+											MakerOrderId: constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15.OrderId,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"Liquidation matches are included in operations to propose": {
 			setup: func(otp *types.OperationsToPropose) {
 				liquidationOrder := constants.LiquidationOrder_Alice_Num0_Clob0_Sell20_Price25_BTC
@@ -9,6 +9,7 @@ import (
 
 	gometrics "github.com/armon/go-metrics"
 	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -114,6 +115,20 @@ func (x *Order) MustCmpReplacementOrder(y *Order) int {
 	return bytes.Compare(xHash[:], yHash[:])
 }
 
+// IsSizeReductionOf returns true if this order is identical to `other` except for having a strictly
+// smaller size. A replacement order that only reduces the size of an existing order retains the queue
+// priority of the existing order.
+func (o *Order) IsSizeReductionOf(other Order) bool {
+	if o.Quantums >= other.Quantums {
+		return false
+	}
+
+	// Compare the orders as if they had the same size.
+	resized := *o
+	resized.Quantums = other.Quantums
+	return resized.GetOrderHash() == other.GetOrderHash()
+}
+
 // GetSubaccountId returns the subaccount ID that placed this order.
 // This function is necessary for the `Order` type to implement the `MatchableOrder` interface.
 func (o *Order) GetSubaccountId() satypes.SubaccountId {
@@ -153,6 +168,25 @@ func (o *Order) IsStopLossOrder() bool {
 	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS
 }
 
+// IsTrailingStopOrder returns whether this is order is a conditional trailing stop order.
+func (o *Order) IsTrailingStopOrder() bool {
+	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP
+}
+
+// IsInOrderGroup returns whether this order is part of a one-cancels-other order group.
+func (o *Order) IsInOrderGroup() bool {
+	return o.OrderGroupId != 0
+}
+
+// GetEffectiveSelfTradePrevention returns the self-trade prevention mode of this order, where an
+// unspecified mode defaults to canceling the maker order.
+func (o *Order) GetEffectiveSelfTradePrevention() Order_SelfTradePrevention {
+	if o.SelfTradePrevention == Order_SELF_TRADE_PREVENTION_UNSPECIFIED {
+		return Order_SELF_TRADE_PREVENTION_CANCEL_MAKER
+	}
+	return o.SelfTradePrevention
+}
+
 // RequiresImmediateExecution returns whether this order has to be executed immediately.
 func (o *Order) RequiresImmediateExecution() bool {
 	return o.GetTimeInForce() == Order_TIME_IN_FORCE_IOC || o.GetTimeInForce() == Order_TIME_IN_FORCE_FILL_OR_KILL
@@ -180,17 +214,76 @@ func (o *Order) CanTrigger(subticks Subticks) bool {
 	o.MustBeConditionalOrder()
 	orderTriggerSubticks := Subticks(o.ConditionalOrderTriggerSubticks)
 
-	// Take profit buys and stop loss sells trigger when the oracle price goes lower
-	// than or equal to the trigger price.
+	// Take profit buys, stop loss sells and trailing stop sells trigger when the oracle price
+	// goes lower than or equal to the trigger price.
 	if o.ConditionType == Order_CONDITION_TYPE_TAKE_PROFIT && o.IsBuy() ||
-		o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS && !o.IsBuy() {
+		o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS && !o.IsBuy() ||
+		o.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP && !o.IsBuy() {
 		return orderTriggerSubticks >= subticks
 	}
-	// Take profit sells and stop loss buys trigger when the oracle price goes higher
-	// than or equal to the trigger price.
+	// Take profit sells, stop loss buys and trailing stop buys trigger when the oracle price
+	// goes higher than or equal to the trigger price.
 	return orderTriggerSubticks <= subticks
 }
 
+// GetTrailingStopTriggerSubticks returns the trigger price of a trailing stop order given the
+// current oracle price. The new trigger price trails the oracle price by the order's trailing
+// offset, and is rounded away from the oracle price to a multiple of `subticksPerTick`. Since the
+// trigger price of a sell only ever moves up and the trigger price of a buy only ever moves down,
+// the order's current trigger price is returned if it is more favorable than the new trigger price.
+// Function will panic if order is not a trailing stop order.
+func (o *Order) GetTrailingStopTriggerSubticks(
+	oraclePriceSubticksRat *big.Rat,
+	subticksPerTick SubticksPerTick,
+) Subticks {
+	if !o.IsTrailingStopOrder() {
+		panic(
+			fmt.Errorf(
+				"GetTrailingStopTriggerSubticks: order (%+v) is not a trailing stop order",
+				o,
+			),
+		)
+	}
+	currentTriggerSubticks := Subticks(o.ConditionalOrderTriggerSubticks)
+
+	var offsetSubticksRat *big.Rat
+	if o.TrailingOffsetSubticks != 0 {
+		offsetSubticksRat = new(big.Rat).SetUint64(o.TrailingOffsetSubticks)
+	} else {
+		offsetSubticksRat = lib.BigRatMulPpm(oraclePriceSubticksRat, o.TrailingOffsetPpm)
+	}
+
+	// Sells trail below the oracle price and are rounded down to the nearest tick, while
+	// buys trail above the oracle price and are rounded up to the nearest tick.
+	isBuy := o.IsBuy()
+	trailingSubticksRat := new(big.Rat)
+	if isBuy {
+		trailingSubticksRat.Add(oraclePriceSubticksRat, offsetSubticksRat)
+	} else {
+		trailingSubticksRat.Sub(oraclePriceSubticksRat, offsetSubticksRat)
+	}
+	subticksPerTickBig := new(big.Int).SetUint64(uint64(subticksPerTick))
+	trailingSubticks := new(big.Int).Mul(
+		lib.BigRatRound(
+			new(big.Rat).Quo(trailingSubticksRat, new(big.Rat).SetInt(subticksPerTickBig)),
+			isBuy,
+		),
+		subticksPerTickBig,
+	)
+
+	// Keep the current trigger price if the trailing price is not a valid positive price.
+	if trailingSubticks.Sign() <= 0 || !trailingSubticks.IsUint64() {
+		return currentTriggerSubticks
+	}
+
+	newTriggerSubticks := Subticks(trailingSubticks.Uint64())
+	if isBuy && newTriggerSubticks < currentTriggerSubticks ||
+		!isBuy && newTriggerSubticks > currentTriggerSubticks {
+		return newTriggerSubticks
+	}
+	return currentTriggerSubticks
+}
+
 // MustGetUnixGoodTilBlockTime returns an instance of `Time` that represents the order's
 // `GoodTilBlockTime`. This function panics when the order is a short-term order or
 // when its `GoodTilBlockTime` is zero.
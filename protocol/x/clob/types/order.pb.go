@@ -56,7 +56,7 @@ func (x Order_Side) String() string {
 }
 
 func (Order_Side) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{7, 0}
+	return fileDescriptor_673c6f4faa93736b, []int{8, 0}
 }
 
 // TimeInForce indicates how long an order will remain active before it
@@ -103,7 +103,7 @@ func (x Order_TimeInForce) String() string {
 }
 
 func (Order_TimeInForce) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{7, 1}
+	return fileDescriptor_673c6f4faa93736b, []int{8, 1}
 }
 
 type Order_ConditionType int32
@@ -120,18 +120,26 @@ const (
 	// order will trigger when the oracle price moves at or below the trigger
 	// price for buys and at or above the trigger price for sells.
 	Order_CONDITION_TYPE_TAKE_PROFIT Order_ConditionType = 2
+	// CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+	// trailing stop order triggers like a stop order, but its trigger price
+	// follows the oracle price at a fixed offset as the market moves in the
+	// order's favor. The trigger price of a sell only ever moves up, and the
+	// trigger price of a buy only ever moves down.
+	Order_CONDITION_TYPE_TRAILING_STOP Order_ConditionType = 3
 )
 
 var Order_ConditionType_name = map[int32]string{
 	0: "CONDITION_TYPE_UNSPECIFIED",
 	1: "CONDITION_TYPE_STOP_LOSS",
 	2: "CONDITION_TYPE_TAKE_PROFIT",
+	3: "CONDITION_TYPE_TRAILING_STOP",
 }
 
 var Order_ConditionType_value = map[string]int32{
-	"CONDITION_TYPE_UNSPECIFIED": 0,
-	"CONDITION_TYPE_STOP_LOSS":   1,
-	"CONDITION_TYPE_TAKE_PROFIT": 2,
+	"CONDITION_TYPE_UNSPECIFIED":   0,
+	"CONDITION_TYPE_STOP_LOSS":     1,
+	"CONDITION_TYPE_TAKE_PROFIT":   2,
+	"CONDITION_TYPE_TRAILING_STOP": 3,
 }
 
 func (x Order_ConditionType) String() string {
@@ -139,7 +147,58 @@ func (x Order_ConditionType) String() string {
 }
 
 func (Order_ConditionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{7, 2}
+	return fileDescriptor_673c6f4faa93736b, []int{8, 2}
+}
+
+// SelfTradePrevention indicates how a match between this order, as the
+// taker order, and a resting order placed by the same subaccount is
+// prevented. Orders placed by the same subaccount never match.
+type Order_SelfTradePrevention int32
+
+const (
+	// SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior, which
+	// is the same as SELF_TRADE_PREVENTION_CANCEL_MAKER.
+	Order_SELF_TRADE_PREVENTION_UNSPECIFIED Order_SelfTradePrevention = 0
+	// SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting order, after which
+	// the taker order continues matching.
+	Order_SELF_TRADE_PREVENTION_CANCEL_MAKER Order_SelfTradePrevention = 1
+	// SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+	// taker order and leaves the resting order on the book.
+	Order_SELF_TRADE_PREVENTION_CANCEL_TAKER Order_SelfTradePrevention = 2
+	// SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting order and the
+	// remaining size of the taker order.
+	Order_SELF_TRADE_PREVENTION_CANCEL_BOTH Order_SelfTradePrevention = 3
+	// SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting order and
+	// decrements the remaining size of the taker order by the remaining size
+	// of the resting order, after which the taker order continues matching.
+	// Resting orders can't be resized, so the resting order is canceled even
+	// if it's larger than the taker order. The remaining size of a decremented
+	// taker order is never placed on the book.
+	Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL Order_SelfTradePrevention = 4
+)
+
+var Order_SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_CANCEL_MAKER",
+	2: "SELF_TRADE_PREVENTION_CANCEL_TAKER",
+	3: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+	4: "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL",
+}
+
+var Order_SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_UNSPECIFIED":          0,
+	"SELF_TRADE_PREVENTION_CANCEL_MAKER":         1,
+	"SELF_TRADE_PREVENTION_CANCEL_TAKER":         2,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":          3,
+	"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL": 4,
+}
+
+func (x Order_SelfTradePrevention) String() string {
+	return proto.EnumName(Order_SelfTradePrevention_name, int32(x))
+}
+
+func (Order_SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{8, 3}
 }
 
 // OrderId refers to a single order belonging to a Subaccount.
@@ -443,6 +502,57 @@ func (m *StatefulOrderTimeSliceValue) GetOrderIds() []OrderId {
 	return nil
 }
 
+// OrderGroupValue represents the type of the value of an order group in
+// state. Order groups are keyed by the order group id and the subaccount id
+// of the orders in the group. This `OrderGroupValue` in state is used for
+// canceling all other orders in a one-cancels-other group once one of its
+// orders is filled or triggered.
+type OrderGroupValue struct {
+	// A unique list of stateful order_ids in the order group, sorted in
+	// ascending order.
+	OrderIds []OrderId `protobuf:"bytes,1,rep,name=order_ids,json=orderIds,proto3" json:"order_ids"`
+}
+
+func (m *OrderGroupValue) Reset()         { *m = OrderGroupValue{} }
+func (m *OrderGroupValue) String() string { return proto.CompactTextString(m) }
+func (*OrderGroupValue) ProtoMessage()    {}
+func (*OrderGroupValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{5}
+}
+func (m *OrderGroupValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderGroupValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderGroupValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderGroupValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderGroupValue.Merge(m, src)
+}
+func (m *OrderGroupValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderGroupValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderGroupValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderGroupValue proto.InternalMessageInfo
+
+func (m *OrderGroupValue) GetOrderIds() []OrderId {
+	if m != nil {
+		return m.OrderIds
+	}
+	return nil
+}
+
 // LongTermOrderPlacement represents the placement of a stateful order in
 // state. It stores the stateful order itself and the `BlockHeight` and
 // `TransactionIndex` at which the order was placed.
@@ -457,7 +567,7 @@ func (m *LongTermOrderPlacement) Reset()         { *m = LongTermOrderPlacement{}
 func (m *LongTermOrderPlacement) String() string { return proto.CompactTextString(m) }
 func (*LongTermOrderPlacement) ProtoMessage()    {}
 func (*LongTermOrderPlacement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{5}
+	return fileDescriptor_673c6f4faa93736b, []int{6}
 }
 func (m *LongTermOrderPlacement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -517,7 +627,7 @@ func (m *ConditionalOrderPlacement) Reset()         { *m = ConditionalOrderPlace
 func (m *ConditionalOrderPlacement) String() string { return proto.CompactTextString(m) }
 func (*ConditionalOrderPlacement) ProtoMessage()    {}
 func (*ConditionalOrderPlacement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{6}
+	return fileDescriptor_673c6f4faa93736b, []int{7}
 }
 func (m *ConditionalOrderPlacement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,13 +717,31 @@ type Order struct {
 	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
 	// orderId.ClobPairId`).
 	ConditionalOrderTriggerSubticks uint64 `protobuf:"varint,11,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+	// trailing_offset_subticks represents the absolute distance, in subticks,
+	// between the oracle price and the trigger price of a trailing stop order.
+	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
+	// orderId.ClobPairId`). Exactly one of trailing_offset_subticks and
+	// trailing_offset_ppm must be nonzero if the condition_type is
+	// CONDITION_TYPE_TRAILING_STOP, otherwise both are enforced to be 0.
+	TrailingOffsetSubticks uint64 `protobuf:"varint,12,opt,name=trailing_offset_subticks,json=trailingOffsetSubticks,proto3" json:"trailing_offset_subticks,omitempty"`
+	// trailing_offset_ppm represents the relative distance, in parts per
+	// million of the oracle price, between the oracle price and the trigger
+	// price of a trailing stop order. Must be less than 1,000,000.
+	TrailingOffsetPpm uint32 `protobuf:"varint,13,opt,name=trailing_offset_ppm,json=trailingOffsetPpm,proto3" json:"trailing_offset_ppm,omitempty"`
+	// order_group_id links stateful orders of the same subaccount into a
+	// one-cancels-other group. When any order in a group is filled or
+	// triggered, all other orders in the group are canceled. A value of 0
+	// means the order is not part of a group. Must be 0 for Short-Term orders.
+	OrderGroupId uint32 `protobuf:"varint,14,opt,name=order_group_id,json=orderGroupId,proto3" json:"order_group_id,omitempty"`
+	// The self-trade prevention mode of this order.
+	SelfTradePrevention Order_SelfTradePrevention `protobuf:"varint,15,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=dydxprotocol.clob.Order_SelfTradePrevention" json:"self_trade_prevention,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{7}
+	return fileDescriptor_673c6f4faa93736b, []int{8}
 }
 func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,6 +870,34 @@ func (m *Order) GetConditionalOrderTriggerSubticks() uint64 {
 	return 0
 }
 
+func (m *Order) GetTrailingOffsetSubticks() uint64 {
+	if m != nil {
+		return m.TrailingOffsetSubticks
+	}
+	return 0
+}
+
+func (m *Order) GetTrailingOffsetPpm() uint32 {
+	if m != nil {
+		return m.TrailingOffsetPpm
+	}
+	return 0
+}
+
+func (m *Order) GetOrderGroupId() uint32 {
+	if m != nil {
+		return m.OrderGroupId
+	}
+	return 0
+}
+
+func (m *Order) GetSelfTradePrevention() Order_SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return Order_SELF_TRADE_PREVENTION_UNSPECIFIED
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Order) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -765,7 +921,7 @@ func (m *TransactionOrdering) Reset()         { *m = TransactionOrdering{} }
 func (m *TransactionOrdering) String() string { return proto.CompactTextString(m) }
 func (*TransactionOrdering) ProtoMessage()    {}
 func (*TransactionOrdering) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{8}
+	return fileDescriptor_673c6f4faa93736b, []int{9}
 }
 func (m *TransactionOrdering) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,11 +968,13 @@ func init() {
 	proto.RegisterEnum("dydxprotocol.clob.Order_Side", Order_Side_name, Order_Side_value)
 	proto.RegisterEnum("dydxprotocol.clob.Order_TimeInForce", Order_TimeInForce_name, Order_TimeInForce_value)
 	proto.RegisterEnum("dydxprotocol.clob.Order_ConditionType", Order_ConditionType_name, Order_ConditionType_value)
+	proto.RegisterEnum("dydxprotocol.clob.Order_SelfTradePrevention", Order_SelfTradePrevention_name, Order_SelfTradePrevention_value)
 	proto.RegisterType((*OrderId)(nil), "dydxprotocol.clob.OrderId")
 	proto.RegisterType((*OrdersFilledDuringLatestBlock)(nil), "dydxprotocol.clob.OrdersFilledDuringLatestBlock")
 	proto.RegisterType((*PotentiallyPrunableOrders)(nil), "dydxprotocol.clob.PotentiallyPrunableOrders")
 	proto.RegisterType((*OrderFillState)(nil), "dydxprotocol.clob.OrderFillState")
 	proto.RegisterType((*StatefulOrderTimeSliceValue)(nil), "dydxprotocol.clob.StatefulOrderTimeSliceValue")
+	proto.RegisterType((*OrderGroupValue)(nil), "dydxprotocol.clob.OrderGroupValue")
 	proto.RegisterType((*LongTermOrderPlacement)(nil), "dydxprotocol.clob.LongTermOrderPlacement")
 	proto.RegisterType((*ConditionalOrderPlacement)(nil), "dydxprotocol.clob.ConditionalOrderPlacement")
 	proto.RegisterType((*Order)(nil), "dydxprotocol.clob.Order")
@@ -826,69 +984,82 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/clob/order.proto", fileDescriptor_673c6f4faa93736b) }
 
 var fileDescriptor_673c6f4faa93736b = []byte{
-	// 989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0xdb, 0x6c, 0x9b, 0x9e, 0xfc, 0xac, 0x3b, 0xdd, 0x05, 0xb7, 0xa5, 0x69, 0x88, 0x50,
-	0x29, 0x42, 0x24, 0xa2, 0xac, 0x90, 0x10, 0xe2, 0x62, 0xdb, 0x26, 0xaa, 0xd5, 0xb4, 0x0e, 0xb6,
-	0x8b, 0xd4, 0x15, 0x62, 0xe4, 0xd8, 0x53, 0x77, 0xb4, 0x13, 0x4f, 0xb0, 0xc7, 0xa8, 0xb9, 0xe3,
-	0x11, 0x78, 0x09, 0xde, 0x82, 0x07, 0xd8, 0xcb, 0xbd, 0xe4, 0x0a, 0xa1, 0xf6, 0x19, 0x10, 0xb7,
-	0x68, 0xc6, 0x6e, 0xea, 0x74, 0xb7, 0x42, 0x68, 0x6f, 0xb8, 0xf3, 0x7c, 0xe7, 0x3b, 0xdf, 0x9c,
-	0xdf, 0x91, 0x61, 0x2b, 0x98, 0x06, 0x57, 0x93, 0x98, 0x0b, 0xee, 0x73, 0xd6, 0xf5, 0x19, 0x1f,
-	0x75, 0x79, 0x1c, 0x90, 0xb8, 0xa3, 0x30, 0xb4, 0x5a, 0x34, 0x77, 0xa4, 0x79, 0xe3, 0x49, 0xc8,
-	0x43, 0xae, 0xa0, 0xae, 0xfc, 0xca, 0x88, 0x1b, 0x9f, 0xcc, 0xe9, 0x24, 0xe9, 0xc8, 0xf3, 0x7d,
-	0x9e, 0x46, 0x22, 0x29, 0x7c, 0x67, 0xd4, 0xf6, 0x6f, 0x1a, 0x2c, 0x5b, 0xf2, 0x0e, 0x33, 0x40,
-	0xdf, 0x42, 0xfd, 0xce, 0x8e, 0x69, 0x60, 0x68, 0x2d, 0x6d, 0xb7, 0xba, 0xb7, 0xd3, 0x99, 0xbb,
-	0xb7, 0x20, 0xd7, 0x71, 0x66, 0xdf, 0x66, 0xb0, 0x5f, 0x7e, 0xf5, 0xc7, 0x76, 0xc9, 0xae, 0x25,
-	0x05, 0x0c, 0x6d, 0xc2, 0x8a, 0xcf, 0x28, 0xc9, 0xe4, 0x16, 0x5a, 0xda, 0xee, 0xb2, 0x5d, 0xc9,
-	0x00, 0x33, 0x40, 0xdb, 0x50, 0x55, 0xe9, 0xe1, 0x0b, 0xe6, 0x85, 0x89, 0xb1, 0xd8, 0xd2, 0x76,
-	0xeb, 0x36, 0x28, 0xa8, 0x2f, 0x11, 0xd4, 0x82, 0x9a, 0xcc, 0x12, 0x4f, 0x3c, 0x1a, 0x4b, 0x81,
-	0x72, 0xc6, 0x90, 0xd8, 0xd0, 0xa3, 0xb1, 0x19, 0xb4, 0x7f, 0x80, 0x2d, 0x15, 0x7d, 0xd2, 0xa7,
-	0x8c, 0x91, 0xe0, 0x30, 0x8d, 0x69, 0x14, 0x0e, 0x3c, 0x41, 0x12, 0xb1, 0xcf, 0xb8, 0xff, 0x12,
-	0x7d, 0x03, 0x2b, 0xd9, 0x1d, 0x34, 0x48, 0x0c, 0xad, 0xb5, 0xb8, 0x5b, 0xdd, 0xdb, 0xe8, 0xbc,
-	0x51, 0xc7, 0x4e, 0x5e, 0x82, 0x3c, 0x87, 0x0a, 0xcf, 0x8e, 0x49, 0xfb, 0x05, 0xac, 0x0f, 0xb9,
-	0x20, 0x91, 0xa0, 0x1e, 0x63, 0xd3, 0x61, 0x9c, 0x46, 0xde, 0x88, 0x91, 0xec, 0xca, 0x77, 0xd5,
-	0x26, 0xd0, 0x50, 0x26, 0x19, 0xba, 0x23, 0x3c, 0x41, 0x64, 0x41, 0x2e, 0x28, 0x63, 0xd8, 0x1b,
-	0xcb, 0xf2, 0xa9, 0xf2, 0x97, 0x6d, 0x90, 0xd0, 0x73, 0x85, 0xa0, 0x3d, 0x78, 0x3a, 0xc9, 0x63,
-	0xc0, 0x23, 0x99, 0x1f, 0xbe, 0x24, 0x34, 0xbc, 0x14, 0xaa, 0xb4, 0x75, 0x7b, 0xed, 0xd6, 0xa8,
-	0x72, 0x3f, 0x52, 0xa6, 0xf6, 0xf7, 0xb0, 0xa9, 0xd4, 0x2f, 0x52, 0xa6, 0xae, 0x73, 0xe9, 0x98,
-	0x38, 0x8c, 0xfa, 0xe4, 0x3b, 0x8f, 0xa5, 0xe4, 0x5d, 0x93, 0xf8, 0x55, 0x83, 0xf7, 0x06, 0x3c,
-	0x0a, 0x5d, 0x12, 0x8f, 0x15, 0x67, 0xc8, 0x3c, 0x9f, 0x8c, 0x49, 0x24, 0xd0, 0x33, 0x78, 0xa4,
-	0x68, 0xf9, 0x18, 0x19, 0x0f, 0xa9, 0xe6, 0x9a, 0x19, 0x19, 0x9d, 0xc1, 0xe3, 0xc9, 0xad, 0x04,
-	0xa6, 0x51, 0x40, 0xae, 0x54, 0x72, 0x6f, 0x8c, 0xa1, 0xf2, 0x77, 0x63, 0x2f, 0x4a, 0x3c, 0x5f,
-	0x50, 0x1e, 0x29, 0x29, 0x1a, 0x85, 0xb9, 0x5a, 0x63, 0x26, 0x62, 0x4a, 0x8d, 0xf6, 0x5f, 0x1a,
-	0xac, 0x1f, 0xf0, 0x28, 0xa0, 0x92, 0xeb, 0xb1, 0xff, 0x71, 0xa8, 0xe8, 0x18, 0xea, 0x22, 0xa6,
-	0x61, 0x28, 0x7b, 0xa2, 0x44, 0x17, 0xff, 0x8b, 0xa8, 0x5d, 0xcb, 0x9d, 0xb3, 0xbc, 0xff, 0x5e,
-	0x82, 0x47, 0xca, 0x84, 0xbe, 0x86, 0xca, 0x6d, 0xa3, 0xf3, 0x34, 0xff, 0xbd, 0xcf, 0xcb, 0x79,
-	0x9f, 0xd1, 0xe7, 0x50, 0x4e, 0x68, 0x40, 0x54, 0x7e, 0x8d, 0xbd, 0xad, 0x87, 0x1c, 0x3b, 0x0e,
-	0x0d, 0x88, 0xad, 0xa8, 0x68, 0x03, 0x2a, 0x3f, 0xa6, 0x5e, 0x24, 0xd2, 0x71, 0xb6, 0xda, 0x65,
-	0x7b, 0x76, 0x96, 0xb6, 0x24, 0x1d, 0x09, 0xea, 0xbf, 0x4c, 0xd4, 0x52, 0x97, 0xed, 0xd9, 0x19,
-	0xed, 0x40, 0x23, 0xe4, 0x3c, 0xc0, 0x82, 0xb2, 0x6c, 0xc6, 0x8d, 0x47, 0x72, 0xb8, 0x8f, 0x4a,
-	0x76, 0x4d, 0xe2, 0x2e, 0x65, 0xd9, 0x66, 0x77, 0x61, 0x6d, 0x9e, 0x87, 0x05, 0x1d, 0x13, 0x63,
-	0x49, 0x3e, 0x32, 0x47, 0x25, 0x5b, 0x2f, 0x92, 0xe5, 0xcc, 0xa3, 0x23, 0xa8, 0x4b, 0x06, 0xa6,
-	0x11, 0xbe, 0xe0, 0xb1, 0x4f, 0x8c, 0x65, 0x95, 0xcc, 0x47, 0x0f, 0x26, 0x23, 0xbd, 0xcc, 0xa8,
-	0x2f, 0xb9, 0x76, 0x55, 0xdc, 0x1d, 0xe4, 0x9e, 0xc6, 0x24, 0x48, 0x7d, 0x82, 0x79, 0xc4, 0xa6,
-	0x46, 0xa5, 0xa5, 0xed, 0x56, 0x6c, 0xc8, 0x20, 0x2b, 0x62, 0x53, 0xf4, 0x31, 0x3c, 0xce, 0x9f,
-	0xbd, 0x31, 0x11, 0x5e, 0xe0, 0x09, 0xcf, 0x58, 0x51, 0x1b, 0xda, 0xc8, 0xe0, 0x93, 0x1c, 0x45,
-	0x27, 0xd0, 0xf0, 0x6f, 0xa7, 0x12, 0x8b, 0xe9, 0x84, 0x18, 0xa0, 0x82, 0xda, 0x79, 0x30, 0xa8,
-	0xd9, 0x10, 0xbb, 0xd3, 0x09, 0xb1, 0xeb, 0x7e, 0xf1, 0x88, 0x8e, 0xa1, 0xed, 0xdf, 0x0d, 0x39,
-	0xce, 0xfa, 0x7d, 0x3b, 0x4c, 0xb3, 0x8a, 0x57, 0x55, 0xc5, 0xb7, 0xfd, 0x7b, 0xeb, 0xe0, 0x66,
-	0x3c, 0x27, 0xa7, 0xb5, 0xbf, 0x82, 0xb2, 0x6c, 0x27, 0x7a, 0x02, 0xba, 0x63, 0x1e, 0xf6, 0xf0,
-	0xd9, 0xa9, 0x33, 0xec, 0x1d, 0x98, 0x7d, 0xb3, 0x77, 0xa8, 0x97, 0x50, 0x0d, 0x2a, 0x0a, 0xdd,
-	0x3f, 0x3b, 0xd7, 0x35, 0x54, 0x87, 0x15, 0x75, 0x72, 0x7a, 0x83, 0x81, 0xbe, 0xd0, 0xfe, 0x59,
-	0x83, 0x6a, 0xa1, 0x7a, 0x68, 0x0b, 0xd6, 0x5d, 0xf3, 0xa4, 0x87, 0xcd, 0x53, 0xdc, 0xb7, 0xec,
-	0x83, 0xfb, 0x5a, 0x4f, 0x61, 0x75, 0xde, 0x6c, 0x5a, 0x07, 0xba, 0x86, 0x36, 0xe1, 0xfd, 0x79,
-	0x78, 0x68, 0x39, 0x2e, 0xb6, 0x4e, 0x07, 0xe7, 0xfa, 0x02, 0x6a, 0xc2, 0xc6, 0xbc, 0xb1, 0x6f,
-	0x0e, 0x06, 0xd8, 0xb2, 0xf1, 0xb1, 0x39, 0x18, 0xe8, 0x8b, 0xed, 0x31, 0xd4, 0xe7, 0x4a, 0x25,
-	0x1d, 0x0e, 0xac, 0xd3, 0x43, 0xd3, 0x35, 0xad, 0x53, 0xec, 0x9e, 0x0f, 0xef, 0x07, 0xf1, 0x01,
-	0x18, 0xf7, 0xec, 0x8e, 0x6b, 0x0d, 0xf1, 0xc0, 0x72, 0x1c, 0x5d, 0x7b, 0x8b, 0xb7, 0xfb, 0xfc,
-	0xb8, 0x87, 0x87, 0xb6, 0xd5, 0x37, 0x5d, 0x7d, 0x61, 0x5f, 0x2f, 0x4c, 0x2d, 0x8f, 0x08, 0xbf,
-	0x68, 0x13, 0x58, 0x7b, 0xcb, 0x7a, 0xa2, 0x0f, 0xa1, 0x36, 0xf7, 0x72, 0x6b, 0x6a, 0x2e, 0xaa,
-	0xa3, 0xbb, 0x17, 0x1b, 0x7d, 0x0a, 0xab, 0xe2, 0xce, 0xb3, 0xf0, 0xb2, 0xd4, 0x6d, 0xbd, 0x60,
-	0x50, 0x0b, 0xbe, 0x3f, 0x7c, 0x75, 0xdd, 0xd4, 0x5e, 0x5f, 0x37, 0xb5, 0x3f, 0xaf, 0x9b, 0xda,
-	0x2f, 0x37, 0xcd, 0xd2, 0xeb, 0x9b, 0x66, 0xe9, 0xf7, 0x9b, 0x66, 0xe9, 0xc5, 0x97, 0x21, 0x15,
-	0x97, 0xe9, 0xa8, 0xe3, 0xf3, 0x71, 0x77, 0xee, 0x87, 0xe0, 0xa7, 0x67, 0x9f, 0xf9, 0x97, 0x1e,
-	0x8d, 0xba, 0x33, 0xe4, 0x2a, 0xfb, 0xd9, 0x90, 0x03, 0x98, 0x8c, 0x96, 0x14, 0xfc, 0xc5, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x0b, 0xbd, 0x8a, 0x8e, 0x08, 0x00, 0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x6d, 0x25, 0x96, 0x47, 0x3f, 0xa6, 0xd7, 0x49, 0xca, 0x38, 0xb1, 0xa2, 0x08, 0xa9,
+	0xeb, 0xfe, 0xc9, 0xa8, 0x1b, 0x14, 0x2d, 0x8a, 0x1e, 0x6c, 0x89, 0x8a, 0x09, 0xd3, 0x22, 0x4b,
+	0x32, 0x01, 0x12, 0x14, 0xdd, 0x52, 0xe4, 0x8a, 0x5e, 0x84, 0x22, 0x55, 0x72, 0x15, 0xc4, 0xb7,
+	0xbe, 0x41, 0xfb, 0x12, 0x7d, 0x8b, 0x3e, 0x40, 0x8e, 0x39, 0xf6, 0x54, 0x14, 0xf6, 0x1b, 0x14,
+	0xe8, 0xbd, 0xd8, 0x25, 0x25, 0x4b, 0x8e, 0x85, 0xa0, 0x48, 0x0f, 0xbd, 0x71, 0xbf, 0xf9, 0xe6,
+	0xdb, 0x99, 0xd9, 0xd9, 0xe1, 0xc2, 0x96, 0x7f, 0xea, 0xbf, 0x1c, 0x25, 0x31, 0x8b, 0xbd, 0x38,
+	0xdc, 0xf5, 0xc2, 0xb8, 0xbf, 0x1b, 0x27, 0x3e, 0x49, 0x5a, 0x02, 0x43, 0xeb, 0xb3, 0xe6, 0x16,
+	0x37, 0x6f, 0xde, 0x08, 0xe2, 0x20, 0x16, 0xd0, 0x2e, 0xff, 0xca, 0x88, 0x9b, 0x1f, 0xce, 0xe9,
+	0xa4, 0xe3, 0xbe, 0xeb, 0x79, 0xf1, 0x38, 0x62, 0xe9, 0xcc, 0x77, 0x46, 0x6d, 0xfe, 0x26, 0xc1,
+	0x8a, 0xc1, 0xf7, 0xd0, 0x7c, 0xf4, 0x2d, 0x54, 0x2f, 0xec, 0x98, 0xfa, 0x8a, 0xd4, 0x90, 0x76,
+	0xca, 0x7b, 0xdb, 0xad, 0xb9, 0x7d, 0x67, 0xe4, 0x5a, 0xf6, 0xf4, 0x5b, 0xf3, 0x0f, 0x8a, 0xaf,
+	0xfe, 0xb8, 0x57, 0xb0, 0x2a, 0xe9, 0x0c, 0x86, 0xee, 0xc0, 0xaa, 0x17, 0x52, 0x92, 0xc9, 0x2d,
+	0x35, 0xa4, 0x9d, 0x15, 0xab, 0x94, 0x01, 0x9a, 0x8f, 0xee, 0x41, 0x59, 0xa4, 0x87, 0x07, 0xa1,
+	0x1b, 0xa4, 0xca, 0x72, 0x43, 0xda, 0xa9, 0x5a, 0x20, 0xa0, 0x2e, 0x47, 0x50, 0x03, 0x2a, 0x3c,
+	0x4b, 0x3c, 0x72, 0x69, 0xc2, 0x05, 0x8a, 0x19, 0x83, 0x63, 0xa6, 0x4b, 0x13, 0xcd, 0x6f, 0x7e,
+	0x0f, 0x5b, 0x22, 0xfa, 0xb4, 0x4b, 0xc3, 0x90, 0xf8, 0x9d, 0x71, 0x42, 0xa3, 0x40, 0x77, 0x19,
+	0x49, 0xd9, 0x41, 0x18, 0x7b, 0xcf, 0xd1, 0x37, 0xb0, 0x9a, 0xed, 0x41, 0xfd, 0x54, 0x91, 0x1a,
+	0xcb, 0x3b, 0xe5, 0xbd, 0xcd, 0xd6, 0x1b, 0x75, 0x6c, 0xe5, 0x25, 0xc8, 0x73, 0x28, 0xc5, 0xd9,
+	0x32, 0x6d, 0x3e, 0x83, 0xdb, 0x66, 0xcc, 0x48, 0xc4, 0xa8, 0x1b, 0x86, 0xa7, 0x66, 0x32, 0x8e,
+	0xdc, 0x7e, 0x48, 0xb2, 0x2d, 0xdf, 0x55, 0x9b, 0x40, 0x4d, 0x98, 0x78, 0xe8, 0x36, 0x73, 0x19,
+	0xe1, 0x05, 0x19, 0xd0, 0x30, 0xc4, 0xee, 0x90, 0x97, 0x4f, 0x94, 0xbf, 0x68, 0x01, 0x87, 0xf6,
+	0x05, 0x82, 0xf6, 0xe0, 0xe6, 0x28, 0x8f, 0x01, 0xf7, 0x79, 0x7e, 0xf8, 0x84, 0xd0, 0xe0, 0x84,
+	0x89, 0xd2, 0x56, 0xad, 0x8d, 0x89, 0x51, 0xe4, 0x7e, 0x28, 0x4c, 0xcd, 0xef, 0xe0, 0x8e, 0x50,
+	0x1f, 0x8c, 0x43, 0xb1, 0x9d, 0x43, 0x87, 0xc4, 0x0e, 0xa9, 0x47, 0x9e, 0xb8, 0xe1, 0x98, 0xbc,
+	0x6b, 0x12, 0x26, 0xac, 0x09, 0xd3, 0xa3, 0x24, 0x1e, 0x8f, 0xfe, 0x13, 0xc5, 0x5f, 0x25, 0xb8,
+	0xa5, 0xc7, 0x51, 0xe0, 0x90, 0x64, 0x28, 0x38, 0x66, 0xe8, 0x7a, 0x64, 0x48, 0x22, 0x86, 0x1e,
+	0xc2, 0x35, 0x41, 0xcb, 0x1b, 0x53, 0x59, 0xa4, 0x9a, 0x6b, 0x66, 0x64, 0xf4, 0x18, 0xd6, 0x46,
+	0x13, 0x09, 0x4c, 0x23, 0x9f, 0xbc, 0x54, 0x96, 0xae, 0x6a, 0x6c, 0xe1, 0xef, 0x24, 0x6e, 0x94,
+	0xba, 0x1e, 0xa3, 0x71, 0x24, 0xa4, 0x68, 0x14, 0xe4, 0x6a, 0xb5, 0xa9, 0x88, 0xc6, 0x35, 0x9a,
+	0x7f, 0x4b, 0x70, 0xbb, 0x1d, 0x47, 0x3e, 0xe5, 0x5c, 0x37, 0xfc, 0x1f, 0x87, 0x8a, 0x8e, 0xa0,
+	0xca, 0x12, 0x1a, 0x04, 0xfc, 0x4c, 0x84, 0xe8, 0xf2, 0xbf, 0x11, 0xb5, 0x2a, 0xb9, 0x73, 0x96,
+	0xf7, 0x5f, 0x00, 0xd7, 0x84, 0x09, 0x7d, 0x0d, 0xa5, 0xc9, 0x41, 0xe7, 0x69, 0xbe, 0xfd, 0x9c,
+	0x57, 0xf2, 0x73, 0x46, 0x9f, 0x41, 0x31, 0xa5, 0x3e, 0x11, 0xf9, 0xd5, 0xf6, 0xb6, 0x16, 0x39,
+	0xb6, 0x6c, 0xea, 0x13, 0x4b, 0x50, 0xd1, 0x26, 0x94, 0x7e, 0x1c, 0xbb, 0x11, 0x1b, 0x0f, 0xb3,
+	0x61, 0x51, 0xb4, 0xa6, 0x6b, 0x6e, 0x4b, 0xc7, 0x7d, 0x46, 0xbd, 0xe7, 0xa9, 0x18, 0x13, 0x45,
+	0x6b, 0xba, 0x46, 0xdb, 0x50, 0x0b, 0xe2, 0xd8, 0xc7, 0x8c, 0x86, 0xd9, 0xad, 0x51, 0xae, 0xf1,
+	0xeb, 0x72, 0x58, 0xb0, 0x2a, 0x1c, 0x77, 0x68, 0x98, 0xcd, 0x8a, 0x5d, 0xd8, 0x98, 0xe7, 0x61,
+	0x46, 0x87, 0x44, 0xb9, 0xce, 0xc7, 0xd6, 0x61, 0xc1, 0x92, 0x67, 0xc9, 0xfc, 0x16, 0xa1, 0x43,
+	0xa8, 0x72, 0x06, 0xa6, 0x11, 0x1e, 0xc4, 0x89, 0x47, 0x94, 0x15, 0x91, 0xcc, 0x83, 0x85, 0xc9,
+	0x70, 0x2f, 0x2d, 0xea, 0x72, 0xae, 0x55, 0x66, 0x17, 0x0b, 0x7e, 0xf3, 0x13, 0xe2, 0x8f, 0x3d,
+	0x82, 0xe3, 0x28, 0x3c, 0x55, 0x4a, 0x0d, 0x69, 0xa7, 0x64, 0x41, 0x06, 0x19, 0x51, 0x78, 0x8a,
+	0x3e, 0x80, 0xb5, 0x7c, 0x90, 0x0e, 0x09, 0x73, 0x7d, 0x97, 0xb9, 0xca, 0xaa, 0xb8, 0xf3, 0xb5,
+	0x0c, 0x3e, 0xce, 0x51, 0x74, 0x0c, 0x35, 0x6f, 0xd2, 0x95, 0x98, 0x9d, 0x8e, 0x88, 0x02, 0x22,
+	0xa8, 0xed, 0x85, 0x41, 0x4d, 0x9b, 0xd8, 0x39, 0x1d, 0x11, 0xab, 0xea, 0xcd, 0x2e, 0xd1, 0x11,
+	0x34, 0xbd, 0x8b, 0x26, 0xc7, 0xd9, 0x79, 0x4f, 0x9a, 0x69, 0x5a, 0xf1, 0xb2, 0xa8, 0xf8, 0x3d,
+	0xef, 0xd2, 0x75, 0x70, 0x32, 0x9e, 0x3d, 0x39, 0x88, 0x2f, 0x41, 0x61, 0x89, 0x4b, 0x43, 0x1a,
+	0x05, 0x38, 0x1e, 0x0c, 0x52, 0xc2, 0x2e, 0x24, 0x2a, 0x42, 0xe2, 0xd6, 0xc4, 0x6e, 0x08, 0xf3,
+	0xd4, 0xb3, 0x05, 0x1b, 0x97, 0x3d, 0x47, 0xa3, 0xa1, 0x52, 0x15, 0x25, 0x58, 0x9f, 0x77, 0x32,
+	0x47, 0x43, 0xf4, 0x00, 0x6a, 0x59, 0xa8, 0x01, 0x9f, 0x4b, 0xbc, 0x41, 0x6b, 0x82, 0x5a, 0x89,
+	0xa7, 0xc3, 0x4a, 0xf3, 0xd1, 0x0f, 0x70, 0x33, 0x25, 0xe1, 0x00, 0xb3, 0xc4, 0xf5, 0x09, 0x1e,
+	0x25, 0xe4, 0x05, 0x9f, 0xf4, 0x71, 0xa4, 0xac, 0x89, 0x92, 0x7d, 0xb2, 0xb8, 0x29, 0x49, 0x38,
+	0x70, 0xb8, 0x93, 0x39, 0xf5, 0xb1, 0x36, 0xd2, 0x37, 0xc1, 0xe6, 0x57, 0x50, 0xe4, 0x0d, 0x8c,
+	0x6e, 0x80, 0x6c, 0x6b, 0x1d, 0x15, 0x3f, 0xee, 0xd9, 0xa6, 0xda, 0xd6, 0xba, 0x9a, 0xda, 0x91,
+	0x0b, 0xa8, 0x02, 0x25, 0x81, 0x1e, 0x3c, 0x7e, 0x2a, 0x4b, 0xa8, 0x0a, 0xab, 0x62, 0x65, 0xab,
+	0xba, 0x2e, 0x2f, 0x35, 0x7f, 0x92, 0xa0, 0x3c, 0xd3, 0x2f, 0x68, 0x0b, 0x6e, 0x3b, 0xda, 0xb1,
+	0x8a, 0xb5, 0x1e, 0xee, 0x1a, 0x56, 0xfb, 0xb2, 0xd6, 0x4d, 0x58, 0x9f, 0x37, 0x6b, 0x46, 0x5b,
+	0x96, 0xd0, 0x1d, 0x78, 0x6f, 0x1e, 0x36, 0x0d, 0xdb, 0xc1, 0x46, 0x4f, 0x7f, 0x2a, 0x2f, 0xa1,
+	0x3a, 0x6c, 0xce, 0x1b, 0xbb, 0x9a, 0xae, 0x63, 0xc3, 0xc2, 0x47, 0x9a, 0xae, 0xcb, 0xcb, 0xcd,
+	0x9f, 0x25, 0xa8, 0xce, 0x75, 0x07, 0xf7, 0x68, 0x1b, 0xbd, 0x8e, 0xe6, 0x68, 0x46, 0x0f, 0x3b,
+	0x4f, 0xcd, 0xcb, 0x51, 0xdc, 0x05, 0xe5, 0x92, 0xdd, 0x76, 0x0c, 0x13, 0xeb, 0x86, 0x6d, 0xcb,
+	0xd2, 0x15, 0xde, 0xce, 0xfe, 0x91, 0x8a, 0x4d, 0xcb, 0xe8, 0x6a, 0x8e, 0xbc, 0x84, 0x1a, 0x70,
+	0xf7, 0xb2, 0xdd, 0xda, 0xd7, 0x74, 0xad, 0xf7, 0x48, 0xc8, 0xc8, 0xcb, 0xcd, 0x73, 0x09, 0x36,
+	0xae, 0x28, 0x3e, 0x7a, 0x1f, 0xee, 0xdb, 0xaa, 0xde, 0xe5, 0xfc, 0x0e, 0x17, 0x54, 0x9f, 0xa8,
+	0x3d, 0xa1, 0x32, 0x1f, 0xde, 0x36, 0x34, 0xaf, 0xa6, 0xb5, 0xf7, 0x7b, 0x6d, 0x55, 0xc7, 0xc7,
+	0xfb, 0x47, 0xaa, 0x25, 0x4b, 0x6f, 0xe5, 0x39, 0x82, 0xb7, 0xb4, 0x78, 0xdb, 0x9c, 0x77, 0x60,
+	0x38, 0x87, 0xf2, 0x32, 0x6a, 0xc1, 0x47, 0x57, 0xd3, 0x3a, 0x6a, 0xdb, 0x52, 0x8f, 0xd5, 0x9e,
+	0x83, 0xf7, 0x7b, 0x9d, 0xdc, 0x49, 0x2e, 0x1e, 0xc8, 0x33, 0x03, 0x2b, 0x8e, 0x48, 0x3c, 0x68,
+	0x12, 0xd8, 0xb8, 0x62, 0x32, 0xa3, 0xfb, 0x50, 0x99, 0x7b, 0x06, 0x48, 0xa2, 0xc9, 0xcb, 0xfd,
+	0x8b, 0xdf, 0x3f, 0xfa, 0x18, 0xd6, 0xd9, 0x85, 0xe7, 0xcc, 0x4f, 0xa5, 0x6a, 0xc9, 0x33, 0x06,
+	0x31, 0xdb, 0x0f, 0xcc, 0x57, 0x67, 0x75, 0xe9, 0xf5, 0x59, 0x5d, 0xfa, 0xf3, 0xac, 0x2e, 0xfd,
+	0x72, 0x5e, 0x2f, 0xbc, 0x3e, 0xaf, 0x17, 0x7e, 0x3f, 0xaf, 0x17, 0x9e, 0x7d, 0x11, 0x50, 0x76,
+	0x32, 0xee, 0xb7, 0xbc, 0x78, 0xb8, 0x3b, 0xf7, 0xba, 0x7c, 0xf1, 0xf0, 0x53, 0xef, 0xc4, 0xa5,
+	0xd1, 0xee, 0x14, 0x79, 0x99, 0xbd, 0x5c, 0xf9, 0xec, 0x49, 0xfb, 0xd7, 0x05, 0xfc, 0xf9, 0x3f,
+	0x03, 0x00, 0xd0, 0x97, 0xa8, 0x7b, 0xdb, 0x0a, 0x00, 0x00,
 }
 
 func (m *OrderId) Marshal() (dAtA []byte, err error) {
@@ -1084,6 +1255,43 @@ func (m *StatefulOrderTimeSliceValue) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *OrderGroupValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderGroupValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderGroupValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		for iNdEx := len(m.OrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LongTermOrderPlacement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1202,6 +1410,26 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OrderGroupId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderGroupId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TrailingOffsetPpm != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TrailingOffsetPpm))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TrailingOffsetSubticks != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TrailingOffsetSubticks))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		i = encodeVarintOrder(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
@@ -1418,6 +1646,21 @@ func (m *StatefulOrderTimeSliceValue) Size() (n int) {
 	return n
 }
 
+func (m *OrderGroupValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		for _, e := range m.OrderIds {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *LongTermOrderPlacement) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1483,6 +1726,18 @@ func (m *Order) Size() (n int) {
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		n += 1 + sovOrder(uint64(m.ConditionalOrderTriggerSubticks))
 	}
+	if m.TrailingOffsetSubticks != 0 {
+		n += 1 + sovOrder(uint64(m.TrailingOffsetSubticks))
+	}
+	if m.TrailingOffsetPpm != 0 {
+		n += 1 + sovOrder(uint64(m.TrailingOffsetPpm))
+	}
+	if m.OrderGroupId != 0 {
+		n += 1 + sovOrder(uint64(m.OrderGroupId))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovOrder(uint64(m.SelfTradePrevention))
+	}
 	return n
 }
 
@@ -1996,6 +2251,90 @@ func (m *StatefulOrderTimeSliceValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OrderGroupValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderGroupValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderGroupValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderIds = append(m.OrderIds, OrderId{})
+			if err := m.OrderIds[len(m.OrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LongTermOrderPlacement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2510,6 +2849,82 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingOffsetSubticks", wireType)
+			}
+			m.TrailingOffsetSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingOffsetSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingOffsetPpm", wireType)
+			}
+			m.TrailingOffsetPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingOffsetPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroupId", wireType)
+			}
+			m.OrderGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= Order_SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -60,6 +60,11 @@ const (
 	// REMOVAL_REASON_FULLY_FILLED represents a removal of an order that
 	// was fully filled and should therefore be removed from state.
 	OrderRemoval_REMOVAL_REASON_FULLY_FILLED OrderRemoval_RemovalReason = 7
+	// REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED represents a removal of a
+	// stateful order whose remaining size was canceled by its self-trade
+	// prevention mode, because it would have matched an order of the same
+	// subaccount on the proposers orderbook.
+	OrderRemoval_REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED OrderRemoval_RemovalReason = 8
 )
 
 var OrderRemoval_RemovalReason_name = map[int32]string{
@@ -71,6 +76,7 @@ var OrderRemoval_RemovalReason_name = map[int32]string{
 	5: "REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED",
 	6: "REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK",
 	7: "REMOVAL_REASON_FULLY_FILLED",
+	8: "REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED",
 }
 
 var OrderRemoval_RemovalReason_value = map[string]int32{
@@ -82,6 +88,7 @@ var OrderRemoval_RemovalReason_value = map[string]int32{
 	"REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED": 5,
 	"REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK":        6,
 	"REMOVAL_REASON_FULLY_FILLED":                              7,
+	"REMOVAL_REASON_SELF_TRADE_PREVENTION_CANCELED":            8,
 }
 
 func (x OrderRemoval_RemovalReason) String() string {
@@ -155,35 +162,36 @@ func init() {
 }
 
 var fileDescriptor_60fa12f781955c9f = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xed, 0x10, 0x5a, 0xb4, 0xd0, 0xca, 0xac, 0x38, 0x54, 0x41, 0xb8, 0x25, 0x12, 0x55,
-	0x2f, 0xb5, 0xa1, 0x14, 0x84, 0x04, 0x17, 0xc7, 0xbb, 0x96, 0x56, 0xd9, 0x7a, 0xa2, 0xb5, 0x53,
-	0xd4, 0x5e, 0x46, 0x49, 0x1c, 0xa5, 0x91, 0xd2, 0x6e, 0xe5, 0x84, 0xaa, 0x7d, 0x0b, 0x4e, 0x3c,
-	0x53, 0x8f, 0x3d, 0x72, 0x42, 0x28, 0x79, 0x03, 0x9e, 0x00, 0x65, 0x1d, 0xa1, 0x26, 0x21, 0x3d,
-	0xd9, 0x33, 0xff, 0xf7, 0xff, 0xff, 0x1c, 0x96, 0xec, 0x66, 0x37, 0xd9, 0xf5, 0x65, 0xae, 0x47,
-	0xba, 0xa3, 0x07, 0x7e, 0x67, 0xa0, 0xdb, 0xbe, 0xce, 0xb3, 0x6e, 0x8e, 0x79, 0xf7, 0x5c, 0x5f,
-	0xb5, 0x06, 0x43, 0xcf, 0x88, 0xf4, 0xf9, 0x7d, 0xce, 0x9b, 0x72, 0x95, 0x17, 0x3d, 0xdd, 0xd3,
-	0x66, 0xe5, 0x4f, 0xff, 0x0a, 0xb0, 0xf2, 0x6a, 0x45, 0x60, 0x21, 0x57, 0x7f, 0x94, 0xc9, 0x33,
-	0x98, 0xce, 0xaa, 0xc8, 0xa7, 0x9f, 0xc9, 0x93, 0xa2, 0xb0, 0x9f, 0x6d, 0xd9, 0x3b, 0xf6, 0xde,
-	0xd3, 0x83, 0x8a, 0xb7, 0xd4, 0xe5, 0x19, 0x8b, 0xc8, 0x6a, 0xe5, 0xdb, 0x5f, 0xdb, 0x96, 0x5a,
-	0xd7, 0xc5, 0x48, 0x53, 0xb2, 0x39, 0xbb, 0x13, 0xf3, 0x6e, 0x6b, 0xa8, 0x2f, 0xb6, 0x4a, 0x3b,
-	0xf6, 0xde, 0xe6, 0xc1, 0xfe, 0xaa, 0x88, 0x59, 0xab, 0x37, 0xfb, 0x2a, 0x63, 0x52, 0x1b, 0xf9,
-	0xfd, 0xb1, 0xfa, 0xa7, 0x44, 0x36, 0xe6, 0x00, 0xea, 0x92, 0x8a, 0xe2, 0x47, 0x70, 0x1c, 0x48,
-	0x54, 0x3c, 0x48, 0x20, 0xc6, 0x66, 0x9c, 0x34, 0x78, 0x28, 0x22, 0xc1, 0x99, 0x63, 0xd1, 0x5d,
-	0x52, 0x5d, 0xd2, 0x19, 0x57, 0x21, 0x48, 0x19, 0xa4, 0x5c, 0x05, 0x52, 0x9c, 0x72, 0xe6, 0xd8,
-	0xff, 0xe1, 0x44, 0x7c, 0x1c, 0x48, 0xc1, 0x50, 0x71, 0xd6, 0x0c, 0x39, 0x42, 0x2c, 0x4f, 0x9c,
-	0x12, 0x3d, 0x24, 0x6f, 0x17, 0xb8, 0x06, 0x24, 0xa9, 0x51, 0xf1, 0x2b, 0x34, 0x25, 0xc3, 0x50,
-	0x41, 0x92, 0xe0, 0x51, 0x50, 0xe7, 0x0a, 0x41, 0x31, 0xae, 0x9c, 0x47, 0xf4, 0x0d, 0x79, 0xbd,
-	0x22, 0x3d, 0xe1, 0x32, 0xc2, 0x54, 0x05, 0x8c, 0x3b, 0x65, 0xfa, 0x85, 0x7c, 0x5a, 0xc0, 0x42,
-	0x88, 0x99, 0x48, 0x05, 0xc4, 0x81, 0xc4, 0x08, 0xea, 0x18, 0x9a, 0x8a, 0x18, 0x52, 0xac, 0x71,
-	0x8c, 0x9a, 0x52, 0x9e, 0x60, 0x24, 0xa4, 0xe4, 0xcc, 0x79, 0x4c, 0x3f, 0x90, 0x77, 0x0f, 0xb8,
-	0x05, 0x84, 0xb3, 0x03, 0x15, 0x37, 0x07, 0x63, 0x0d, 0xa0, 0xee, 0xac, 0xd1, 0x6d, 0xf2, 0x72,
-	0xc1, 0x36, 0x97, 0xbb, 0x5e, 0x6b, 0xdc, 0x8e, 0x5d, 0xfb, 0x6e, 0xec, 0xda, 0xbf, 0xc7, 0xae,
-	0xfd, 0x7d, 0xe2, 0x5a, 0x77, 0x13, 0xd7, 0xfa, 0x39, 0x71, 0xad, 0xd3, 0x8f, 0xbd, 0xfe, 0xe8,
-	0xec, 0x5b, 0xdb, 0xeb, 0xe8, 0x73, 0x7f, 0xee, 0x71, 0x5d, 0x1d, 0xee, 0x77, 0xce, 0x5a, 0xfd,
-	0x0b, 0xff, 0xdf, 0xe6, 0xba, 0x78, 0x70, 0xa3, 0x9b, 0xcb, 0xee, 0xb0, 0xbd, 0x66, 0xd6, 0xef,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x63, 0x2c, 0xa7, 0xa2, 0xe3, 0x02, 0x00, 0x00,
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0xc7, 0x6d, 0x48, 0x01, 0x6d, 0x0b, 0x72, 0x57, 0x3d, 0xa0, 0x54, 0x35, 0x34, 0x52, 0x11,
+	0x97, 0xd8, 0x85, 0xd2, 0xaa, 0x52, 0x7b, 0x71, 0xbc, 0x63, 0x69, 0x95, 0xc5, 0x1b, 0xad, 0x9d,
+	0x54, 0x70, 0x19, 0x25, 0x71, 0x14, 0x22, 0x05, 0x16, 0x39, 0x29, 0x82, 0xb7, 0xe8, 0x4b, 0xf4,
+	0x5d, 0xe8, 0x8d, 0x63, 0x4f, 0x55, 0x95, 0xbc, 0x48, 0x65, 0x3b, 0x6a, 0x49, 0x68, 0x38, 0xd9,
+	0x33, 0xf3, 0xfb, 0x7f, 0x1c, 0x96, 0xec, 0x25, 0x37, 0xc9, 0xf5, 0x65, 0xaa, 0xc7, 0xba, 0xab,
+	0x87, 0x6e, 0x77, 0xa8, 0x3b, 0xae, 0x4e, 0x93, 0x5e, 0x8a, 0x69, 0xef, 0x5c, 0x5f, 0xb5, 0x87,
+	0x23, 0x27, 0x3f, 0xd2, 0xe7, 0xf7, 0x39, 0x27, 0xe3, 0xca, 0x2f, 0xfa, 0xba, 0xaf, 0xf3, 0x95,
+	0x9b, 0xfd, 0x15, 0x60, 0xf9, 0xd5, 0x12, 0xc3, 0xe2, 0x5c, 0xf9, 0x51, 0x22, 0xcf, 0x64, 0x36,
+	0xab, 0xc2, 0x9f, 0x7e, 0x22, 0x1b, 0x45, 0xe0, 0x20, 0xd9, 0x36, 0x77, 0xcd, 0xfd, 0xa7, 0x87,
+	0x65, 0xe7, 0x41, 0x96, 0x93, 0x4b, 0x78, 0x52, 0x2b, 0xdd, 0xfe, 0xda, 0x31, 0xd4, 0xba, 0x2e,
+	0x46, 0x1a, 0x93, 0xad, 0x59, 0x4f, 0x4c, 0x7b, 0xed, 0x91, 0xbe, 0xd8, 0x5e, 0xd9, 0x35, 0xf7,
+	0xb7, 0x0e, 0xab, 0xcb, 0x2c, 0x66, 0xa9, 0xce, 0xec, 0xab, 0x72, 0x91, 0xda, 0x4c, 0xef, 0x8f,
+	0x95, 0xef, 0xab, 0x64, 0x73, 0x0e, 0xa0, 0x36, 0x29, 0x2b, 0x38, 0x96, 0x2d, 0x4f, 0xa0, 0x02,
+	0x2f, 0x92, 0x21, 0x36, 0xc3, 0xa8, 0x01, 0x3e, 0x0f, 0x38, 0x30, 0xcb, 0xa0, 0x7b, 0xa4, 0xf2,
+	0xe0, 0xce, 0x40, 0xf9, 0x52, 0x08, 0x2f, 0x06, 0xe5, 0x09, 0x7e, 0x0a, 0xcc, 0x32, 0xff, 0xc3,
+	0xf1, 0xb0, 0xe5, 0x09, 0xce, 0x50, 0x01, 0x6b, 0xfa, 0x80, 0x32, 0x14, 0x27, 0xd6, 0x0a, 0x3d,
+	0x22, 0x6f, 0x17, 0xb8, 0x86, 0x8c, 0xe2, 0xfc, 0x8a, 0x5f, 0x64, 0x53, 0x30, 0xf4, 0x95, 0x8c,
+	0x22, 0x3c, 0xf6, 0xea, 0xa0, 0x50, 0x2a, 0x06, 0xca, 0x5a, 0xa5, 0x6f, 0xc8, 0xeb, 0x25, 0xee,
+	0x11, 0x88, 0x00, 0x63, 0xe5, 0x31, 0xb0, 0x4a, 0xf4, 0x33, 0xf9, 0xb8, 0x80, 0xf9, 0x32, 0x64,
+	0x3c, 0xe6, 0x32, 0xf4, 0x04, 0x06, 0xb2, 0x8e, 0x7e, 0x1e, 0x11, 0xca, 0x18, 0x6b, 0x80, 0x41,
+	0x53, 0x88, 0x13, 0x0c, 0xb8, 0x10, 0xc0, 0xac, 0x27, 0xf4, 0x3d, 0x39, 0x78, 0x44, 0xcd, 0xa5,
+	0x3f, 0x2b, 0xa8, 0x20, 0x2f, 0x8c, 0x35, 0x29, 0xeb, 0xd6, 0x1a, 0xdd, 0x21, 0x2f, 0x17, 0x64,
+	0x73, 0xbe, 0xeb, 0xf4, 0x80, 0x54, 0x17, 0x80, 0x7f, 0xa5, 0xb1, 0xa1, 0xa0, 0x05, 0x61, 0x96,
+	0x81, 0xbe, 0x17, 0xfa, 0x90, 0x49, 0x36, 0x6a, 0x8d, 0xdb, 0x89, 0x6d, 0xde, 0x4d, 0x6c, 0xf3,
+	0xf7, 0xc4, 0x36, 0xbf, 0x4d, 0x6d, 0xe3, 0x6e, 0x6a, 0x1b, 0x3f, 0xa7, 0xb6, 0x71, 0xfa, 0xa1,
+	0x3f, 0x18, 0x9f, 0x7d, 0xed, 0x38, 0x5d, 0x7d, 0xee, 0xce, 0xbd, 0xc7, 0xab, 0xa3, 0x6a, 0xf7,
+	0xac, 0x3d, 0xb8, 0x70, 0xff, 0x6e, 0xae, 0x8b, 0x37, 0x3a, 0xbe, 0xb9, 0xec, 0x8d, 0x3a, 0x6b,
+	0xf9, 0xfa, 0xdd, 0x9f, 0x01, 0x00, 0xf2, 0x5f, 0x9d, 0xff, 0x16, 0x03, 0x00, 0x00,
 }
 
 func (m *OrderRemoval) Marshal() (dAtA []byte, err error) {
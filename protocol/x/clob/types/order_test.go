@@ -347,6 +347,60 @@ func TestOrder_MustCmpReplacementOrder_PanicsWithNonReplacementOrder(t *testing.
 	)
 }
 
+func TestOrder_IsSizeReductionOf(t *testing.T) {
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16
+
+	smallerOrder := order
+	smallerOrder.Quantums = order.Quantums - 5
+
+	largerOrder := order
+	largerOrder.Quantums = order.Quantums + 5
+
+	smallerOrderWithDifferentPrice := smallerOrder
+	smallerOrderWithDifferentPrice.Subticks = order.Subticks + 5
+
+	smallerOrderWithDifferentGoodTilBlock := smallerOrder
+	smallerOrderWithDifferentGoodTilBlock.GoodTilOneof = &types.Order_GoodTilBlock{GoodTilBlock: 20}
+
+	smallerOrderWithDifferentOrderId := smallerOrder
+	smallerOrderWithDifferentOrderId.OrderId.ClientId = order.OrderId.ClientId + 1
+
+	tests := map[string]struct {
+		order    types.Order
+		expected bool
+	}{
+		"Smaller order is a size reduction": {
+			order:    smallerOrder,
+			expected: true,
+		},
+		"Identical order is not a size reduction": {
+			order:    order,
+			expected: false,
+		},
+		"Larger order is not a size reduction": {
+			order:    largerOrder,
+			expected: false,
+		},
+		"Smaller order with a different price is not a size reduction": {
+			order:    smallerOrderWithDifferentPrice,
+			expected: false,
+		},
+		"Smaller order with a different GoodTilBlock is not a size reduction": {
+			order:    smallerOrderWithDifferentGoodTilBlock,
+			expected: false,
+		},
+		"Smaller order with a different order ID is not a size reduction": {
+			order:    smallerOrderWithDifferentOrderId,
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.order.IsSizeReductionOf(order))
+		})
+	}
+}
+
 func TestOrder_GetSubaccountId(t *testing.T) {
 	expectedSubaccountId := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId.SubaccountId
 	order := types.Order{
@@ -524,3 +578,122 @@ func TestOrder_GetClientMetadata(t *testing.T) {
 	clientMetadata := order.GetClientMetadata()
 	require.Equal(t, uint32(100), clientMetadata)
 }
+
+func TestOrder_GetTrailingStopTriggerSubticks(t *testing.T) {
+	tests := map[string]struct {
+		side                    types.Order_Side
+		triggerSubticks         uint64
+		trailingOffsetSubticks  uint64
+		trailingOffsetPpm       uint32
+		subticksPerTick         types.SubticksPerTick
+		oraclePriceSubticksRat  *big.Rat
+		expectedTriggerSubticks types.Subticks
+	}{
+		"Sell with subticks offset moves trigger up as price rises": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         80,
+			trailingOffsetSubticks:  20,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(120, 1),
+			expectedTriggerSubticks: 100,
+		},
+		"Sell with subticks offset does not move trigger down as price falls": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         80,
+			trailingOffsetSubticks:  20,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(90, 1),
+			expectedTriggerSubticks: 80,
+		},
+		"Sell trigger is rounded down to the nearest tick": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         80,
+			trailingOffsetSubticks:  20,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(125, 1),
+			expectedTriggerSubticks: 100,
+		},
+		"Sell with ppm offset moves trigger up as price rises": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         800,
+			trailingOffsetPpm:       100_000,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(1_000, 1),
+			expectedTriggerSubticks: 900,
+		},
+		"Buy with subticks offset moves trigger down as price falls": {
+			side:                    types.Order_SIDE_BUY,
+			triggerSubticks:         120,
+			trailingOffsetSubticks:  20,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(80, 1),
+			expectedTriggerSubticks: 100,
+		},
+		"Buy with subticks offset does not move trigger up as price rises": {
+			side:                    types.Order_SIDE_BUY,
+			triggerSubticks:         120,
+			trailingOffsetSubticks:  20,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(110, 1),
+			expectedTriggerSubticks: 120,
+		},
+		"Buy trigger is rounded up to the nearest tick": {
+			side:                    types.Order_SIDE_BUY,
+			triggerSubticks:         120,
+			trailingOffsetSubticks:  20,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(75, 1),
+			expectedTriggerSubticks: 100,
+		},
+		"Buy with ppm offset moves trigger down as price falls": {
+			side:                    types.Order_SIDE_BUY,
+			triggerSubticks:         1_200,
+			trailingOffsetPpm:       100_000,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(1_000, 1),
+			expectedTriggerSubticks: 1_100,
+		},
+		"Sell keeps current trigger if trailing price is not positive": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         80,
+			trailingOffsetSubticks:  200,
+			subticksPerTick:         10,
+			oraclePriceSubticksRat:  big.NewRat(150, 1),
+			expectedTriggerSubticks: 80,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			order := types.Order{
+				OrderId: types.OrderId{
+					OrderFlags: types.OrderIdFlags_Conditional,
+				},
+				Side:                            tc.side,
+				ConditionType:                   types.Order_CONDITION_TYPE_TRAILING_STOP,
+				ConditionalOrderTriggerSubticks: tc.triggerSubticks,
+				TrailingOffsetSubticks:          tc.trailingOffsetSubticks,
+				TrailingOffsetPpm:               tc.trailingOffsetPpm,
+			}
+			require.Equal(
+				t,
+				tc.expectedTriggerSubticks,
+				order.GetTrailingStopTriggerSubticks(tc.oraclePriceSubticksRat, tc.subticksPerTick),
+			)
+		})
+	}
+}
+
+func TestOrder_GetTrailingStopTriggerSubticks_PanicsWithNonTrailingStopOrder(t *testing.T) {
+	order := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+	require.PanicsWithError(
+		t,
+		fmt.Sprintf(
+			"GetTrailingStopTriggerSubticks: order (%+v) is not a trailing stop order",
+			&order,
+		),
+		func() {
+			order.GetTrailingStopTriggerSubticks(big.NewRat(10, 1), 1)
+		},
+	)
+}
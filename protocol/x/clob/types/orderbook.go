@@ -179,6 +179,10 @@ const (
 	// LiquidationExceededSubaccountMaxInsuranceLost indicates that the liquidation order could not
 	// be matched because it exceeded the maximum funds lost for the insurance fund in this block.
 	LiquidationExceededSubaccountMaxInsuranceLost
+	// SelfTradePreventionCanceled indicates that the remaining size of the taker order was canceled
+	// or decremented by its self-trade prevention mode after matching against an order from the same
+	// subaccount.
+	SelfTradePreventionCanceled
 )
 
 // String returns a string representation of this `OrderStatus` enum.
@@ -200,6 +204,8 @@ func (os OrderStatus) String() string {
 		return "LiquidationExceededSubaccountMaxNotionalLiquidated"
 	case LiquidationExceededSubaccountMaxInsuranceLost:
 		return "LiquidationExceededSubaccountMaxInsuranceLost"
+	case SelfTradePreventionCanceled:
+		return "SelfTradePreventionCanceled"
 	default:
 		return "Unknown"
 	}
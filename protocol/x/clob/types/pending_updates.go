@@ -48,14 +48,6 @@ func (p *PendingUpdates) ConvertToUpdates() []satypes.Update {
 		)
 
 		pendingAssetUpdates := p.subaccountAssetUpdates[subaccountId]
-		for assetId, bigQuantumsDelta := range pendingAssetUpdates {
-			assetUpdate := satypes.AssetUpdate{
-				AssetId:          assetId,
-				BigQuantumsDelta: bigQuantumsDelta,
-			}
-			assetUpdates = append(assetUpdates, assetUpdate)
-		}
-
 		if _, exists := pendingAssetUpdates[assettypes.AssetUsdc.Id]; !exists {
 			pendingAssetUpdates[assettypes.AssetUsdc.Id] = new(big.Int)
 		}
@@ -66,12 +58,19 @@ func (p *PendingUpdates) ConvertToUpdates() []satypes.Update {
 			p.subaccountFee[subaccountId],
 		)
 
-		// Panic if there is more than one asset updates since we only support
-		// USDC asset at the moment.
-		if len(assetUpdates) > 1 {
-			panic(ErrAssetUpdateNotImplemented)
+		for assetId, bigQuantumsDelta := range pendingAssetUpdates {
+			assetUpdate := satypes.AssetUpdate{
+				AssetId:          assetId,
+				BigQuantumsDelta: bigQuantumsDelta,
+			}
+			assetUpdates = append(assetUpdates, assetUpdate)
 		}
 
+		// Sort the assetIds in ascending order for determinism.
+		sort.Slice(assetUpdates, func(i, j int) bool {
+			return assetUpdates[i].AssetId < assetUpdates[j].AssetId
+		})
+
 		// Create an empty slice to store the perpetual updates for this subaccount.
 		perpetualUpdates := make(
 			[]satypes.PerpetualUpdate,
@@ -177,3 +176,48 @@ func (p *PendingUpdates) AddPerpetualFill(
 	)
 	p.subaccountFee[subaccountId] = totalFee
 }
+
+// AddSpotFill adds a new fill on a spot `ClobPair` to the PendingUpdate object, by
+// updating quoteBalanceDelta, the base asset balance and fees paid or received by a subaccount.
+func (p *PendingUpdates) AddSpotFill(
+	subaccountId satypes.SubaccountId,
+	baseAssetId uint32,
+	isBuy bool,
+	feePpm int32,
+	bigFillBaseQuantums *big.Int,
+	bigFillQuoteQuantums *big.Int,
+) {
+	subaccountAssetUpdates, exists := p.subaccountAssetUpdates[subaccountId]
+	if !exists {
+		subaccountAssetUpdates = make(map[uint32]*big.Int)
+		p.subaccountAssetUpdates[subaccountId] = subaccountAssetUpdates
+	}
+	quoteBalanceUpdate, exists := subaccountAssetUpdates[assettypes.AssetUsdc.Id]
+	if !exists {
+		quoteBalanceUpdate = big.NewInt(0)
+		subaccountAssetUpdates[assettypes.AssetUsdc.Id] = quoteBalanceUpdate
+	}
+	baseBalanceUpdate, exists := subaccountAssetUpdates[baseAssetId]
+	if !exists {
+		baseBalanceUpdate = big.NewInt(0)
+		subaccountAssetUpdates[baseAssetId] = baseBalanceUpdate
+	}
+
+	if isBuy {
+		quoteBalanceUpdate.Sub(quoteBalanceUpdate, bigFillQuoteQuantums)
+		baseBalanceUpdate.Add(baseBalanceUpdate, bigFillBaseQuantums)
+	} else {
+		quoteBalanceUpdate.Add(quoteBalanceUpdate, bigFillQuoteQuantums)
+		baseBalanceUpdate.Sub(baseBalanceUpdate, bigFillBaseQuantums)
+	}
+
+	totalFee, exists := p.subaccountFee[subaccountId]
+	if !exists {
+		totalFee = big.NewInt(0)
+	}
+	totalFee.Add(
+		totalFee,
+		lib.BigIntMulSignedPpm(bigFillQuoteQuantums, feePpm, true),
+	)
+	p.subaccountFee[subaccountId] = totalFee
+}
@@ -312,3 +312,63 @@ func TestPendingUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingUpdates_SpotFills(t *testing.T) {
+	pendingUpdates := types.NewPendingUpdates()
+
+	// Alice buys 100 base quantums of asset 1 for 10_000 quote quantums.
+	pendingUpdates.AddSpotFill(
+		constants.Alice_Num0,
+		uint32(1),
+		true,
+		500, // fee = 5_000_000 / 1_000_000
+		big.NewInt(100),
+		big.NewInt(10_000),
+	)
+	// Bob sells 100 base quantums of asset 1 for 10_000 quote quantums.
+	pendingUpdates.AddSpotFill(
+		constants.Bob_Num0,
+		uint32(1),
+		false,
+		-100, // fee = -1_000_000 / 1_000_000
+		big.NewInt(100),
+		big.NewInt(10_000),
+	)
+
+	require.Equal(
+		t,
+		[]satypes.Update{
+			{
+				SubaccountId: constants.Bob_Num0,
+				AssetUpdates: []satypes.AssetUpdate{
+					{
+						AssetId: constants.Usdc.Id,
+						// 10_000 + (fee) 1
+						BigQuantumsDelta: big.NewInt(10_001),
+					},
+					{
+						AssetId:          uint32(1),
+						BigQuantumsDelta: big.NewInt(-100),
+					},
+				},
+				PerpetualUpdates: []satypes.PerpetualUpdate{},
+			},
+			{
+				SubaccountId: constants.Alice_Num0,
+				AssetUpdates: []satypes.AssetUpdate{
+					{
+						AssetId: constants.Usdc.Id,
+						// - 10_000 - (fee) 5
+						BigQuantumsDelta: big.NewInt(-10_005),
+					},
+					{
+						AssetId:          uint32(1),
+						BigQuantumsDelta: big.NewInt(100),
+					},
+				},
+				PerpetualUpdates: []satypes.PerpetualUpdate{},
+			},
+		},
+		pendingUpdates.ConvertToUpdates(),
+	)
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -317,6 +318,10 @@ func (m *AreSubaccountsLiquidatableResponse) GetResults() []AreSubaccountsLiquid
 type AreSubaccountsLiquidatableResponse_Result struct {
 	SubaccountId   types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
 	IsLiquidatable bool               `protobuf:"varint,2,opt,name=is_liquidatable,json=isLiquidatable,proto3" json:"is_liquidatable,omitempty"`
+	// The net collateral of the subaccount in quote quantums.
+	NetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	// The maintenance margin requirement of the subaccount in quote quantums.
+	MaintenanceMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=maintenance_margin,json=maintenanceMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin"`
 }
 
 func (m *AreSubaccountsLiquidatableResponse_Result) Reset() {
@@ -804,1189 +809,4332 @@ func (m *QueryLiquidationsConfigurationResponse) GetLiquidationsConfig() Liquida
 	return LiquidationsConfig{}
 }
 
-func init() {
-	proto.RegisterType((*QueryGetClobPairRequest)(nil), "dydxprotocol.clob.QueryGetClobPairRequest")
-	proto.RegisterType((*QueryClobPairResponse)(nil), "dydxprotocol.clob.QueryClobPairResponse")
-	proto.RegisterType((*QueryAllClobPairRequest)(nil), "dydxprotocol.clob.QueryAllClobPairRequest")
-	proto.RegisterType((*QueryClobPairAllResponse)(nil), "dydxprotocol.clob.QueryClobPairAllResponse")
-	proto.RegisterType((*AreSubaccountsLiquidatableRequest)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableRequest")
-	proto.RegisterType((*AreSubaccountsLiquidatableResponse)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableResponse")
-	proto.RegisterType((*AreSubaccountsLiquidatableResponse_Result)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableResponse.Result")
-	proto.RegisterType((*MevNodeToNodeCalculationRequest)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationRequest")
-	proto.RegisterType((*MevNodeToNodeCalculationResponse)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse")
-	proto.RegisterType((*MevNodeToNodeCalculationResponse_MevAndVolumePerClob)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse.MevAndVolumePerClob")
-	proto.RegisterType((*QueryEquityTierLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationRequest")
-	proto.RegisterType((*QueryEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationResponse")
-	proto.RegisterType((*QueryBlockRateLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationRequest")
-	proto.RegisterType((*QueryBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationResponse")
-	proto.RegisterType((*QueryLiquidationsConfigurationRequest)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationRequest")
-	proto.RegisterType((*QueryLiquidationsConfigurationResponse)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationResponse")
+// QueryMakerUptimeRewardsConfigurationRequest is a request message for
+// MakerUptimeRewardsConfiguration.
+type QueryMakerUptimeRewardsConfigurationRequest struct {
 }
 
-func init() { proto.RegisterFile("dydxprotocol/clob/query.proto", fileDescriptor_3365c195b25c5bc0) }
-
-var fileDescriptor_3365c195b25c5bc0 = []byte{
-	// 1084 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x1f, 0xcd, 0x38, 0xfd, 0xe6, 0x9b, 0x7e, 0xda, 0x04, 0x98, 0x34, 0x6d, 0x70, 0x52, 0xc7, 0x59,
-	0x88, 0x63, 0xa7, 0x62, 0x97, 0xa6, 0x01, 0x41, 0x5a, 0x21, 0x25, 0x11, 0x44, 0x95, 0x12, 0xe4,
-	0x6e, 0xab, 0x22, 0x41, 0xa5, 0xd5, 0x7a, 0x77, 0x70, 0x46, 0x5d, 0xef, 0x38, 0xfb, 0x4b, 0x89,
-	0x10, 0x17, 0x84, 0x90, 0x2a, 0x38, 0x20, 0x71, 0xe0, 0xc0, 0x91, 0xbf, 0x82, 0x03, 0x02, 0x6e,
-	0x3d, 0x56, 0xe2, 0xc2, 0x01, 0x21, 0x94, 0x70, 0xe6, 0x6f, 0x40, 0x3b, 0x3b, 0xb6, 0x77, 0xbd,
-	0x3f, 0x9c, 0xf8, 0xe2, 0xd8, 0x33, 0x6f, 0xde, 0xbc, 0x37, 0x6f, 0xf6, 0xf3, 0xd9, 0xc0, 0x4d,
-	0xf3, 0xc4, 0x3c, 0xee, 0x3a, 0xcc, 0x63, 0x06, 0xb3, 0x14, 0xc3, 0x62, 0x2d, 0xe5, 0xc8, 0x27,
-	0xce, 0x89, 0xcc, 0xc7, 0xf0, 0x2b, 0xf1, 0x69, 0x39, 0x9c, 0x2e, 0x5f, 0x6b, 0xb3, 0x36, 0xe3,
-	0x43, 0x4a, 0xf8, 0x2d, 0x02, 0x96, 0x97, 0xda, 0x8c, 0xb5, 0x2d, 0xa2, 0xe8, 0x5d, 0xaa, 0xe8,
-	0xb6, 0xcd, 0x3c, 0xdd, 0xa3, 0xcc, 0x76, 0xc5, 0xec, 0xba, 0xc1, 0xdc, 0x0e, 0x73, 0x95, 0x96,
-	0xee, 0x92, 0x88, 0x5f, 0x09, 0x6e, 0xb7, 0x88, 0xa7, 0xdf, 0x56, 0xba, 0x7a, 0x9b, 0xda, 0x1c,
-	0x2c, 0xb0, 0x4a, 0x5a, 0x51, 0xcb, 0x62, 0xc6, 0x53, 0xcd, 0xd1, 0x3d, 0xa2, 0x59, 0xb4, 0x43,
-	0x3d, 0xcd, 0x60, 0xf6, 0xa7, 0xb4, 0x2d, 0x16, 0xac, 0xa4, 0x17, 0x84, 0x1f, 0x5a, 0x57, 0xa7,
-	0x8e, 0x80, 0xbc, 0x99, 0x86, 0x90, 0x23, 0x9f, 0x7a, 0x27, 0x9a, 0x47, 0x89, 0x93, 0x45, 0x7a,
-	0x2b, 0xbd, 0xc2, 0xa2, 0x47, 0x3e, 0x35, 0x23, 0x5f, 0x49, 0xf0, 0x62, 0x1a, 0xdc, 0x21, 0x81,
-	0x98, 0x6c, 0x24, 0x26, 0x5d, 0xbf, 0xa5, 0x1b, 0x06, 0xf3, 0x6d, 0xcf, 0x8d, 0x7d, 0x8f, 0xa0,
-	0x52, 0x03, 0x6e, 0x3c, 0x08, 0x0f, 0x67, 0x8f, 0x78, 0xbb, 0x16, 0x6b, 0x35, 0x75, 0xea, 0xa8,
-	0xe4, 0xc8, 0x27, 0xae, 0x87, 0x67, 0xa1, 0x44, 0xcd, 0x05, 0x54, 0x45, 0xf5, 0x19, 0xb5, 0x44,
-	0x4d, 0xe9, 0x23, 0x98, 0xe7, 0xd0, 0x01, 0xce, 0xed, 0x32, 0xdb, 0x25, 0xf8, 0x3d, 0xb8, 0xdc,
-	0x77, 0xcf, 0xf1, 0x57, 0x36, 0x16, 0xe5, 0x54, 0x8a, 0x72, 0x6f, 0xdd, 0xce, 0xa5, 0xe7, 0x7f,
-	0x2d, 0x4f, 0xa8, 0xd3, 0x86, 0xf8, 0x2d, 0xe9, 0x42, 0xc3, 0xb6, 0x65, 0x0d, 0x6b, 0xf8, 0x00,
-	0x60, 0x90, 0x96, 0xe0, 0xae, 0xc9, 0x51, 0xb4, 0x72, 0x18, 0xad, 0x1c, 0x5d, 0x1d, 0x11, 0xad,
-	0xdc, 0xd4, 0xdb, 0x44, 0xac, 0x55, 0x63, 0x2b, 0xa5, 0x1f, 0x11, 0x2c, 0x24, 0xc4, 0x6f, 0x5b,
-	0x56, 0x9e, 0xfe, 0xc9, 0x0b, 0xea, 0xc7, 0x7b, 0x09, 0x91, 0x25, 0x2e, 0x72, 0x6d, 0xa4, 0xc8,
-	0x68, 0xf3, 0x84, 0xca, 0x63, 0x58, 0xd9, 0x76, 0xc8, 0xc3, 0x41, 0x5e, 0xfb, 0x22, 0x7f, 0xbd,
-	0x65, 0xf5, 0x6c, 0xe1, 0x87, 0x30, 0x3b, 0x48, 0x51, 0xa3, 0xa6, 0x2b, 0x24, 0xd7, 0x92, 0x92,
-	0x63, 0xa9, 0xcb, 0x03, 0xc6, 0xfb, 0xa6, 0x50, 0x3f, 0xe3, 0xc6, 0xc6, 0x5c, 0xe9, 0x59, 0x09,
-	0xa4, 0xa2, 0xad, 0xc5, 0x49, 0x3d, 0x81, 0xff, 0x3b, 0xc4, 0xf5, 0x2d, 0xaf, 0xb7, 0xe9, 0xbd,
-	0x8c, 0x73, 0x1a, 0xcd, 0x23, 0xab, 0x9c, 0x44, 0x48, 0xe9, 0x51, 0x96, 0xbf, 0x44, 0x30, 0x15,
-	0xcd, 0xe0, 0x07, 0x30, 0x93, 0x30, 0xd9, 0x8f, 0xfe, 0x22, 0x1e, 0xaf, 0xc6, 0x3d, 0xe2, 0x35,
-	0x78, 0x89, 0xba, 0x9a, 0x15, 0x93, 0xc3, 0xa3, 0x9a, 0x56, 0x67, 0x69, 0x42, 0xa4, 0xf4, 0x27,
-	0x82, 0xe5, 0x03, 0x12, 0x7c, 0xc8, 0x4c, 0xf2, 0x88, 0x85, 0x9f, 0xbb, 0xba, 0x65, 0xf8, 0x16,
-	0x8f, 0xa8, 0x17, 0xc2, 0x13, 0xb8, 0x1e, 0x55, 0x88, 0xae, 0xc3, 0xba, 0xcc, 0x25, 0x8e, 0xd6,
-	0xd1, 0x3d, 0xe3, 0x90, 0xb8, 0xd9, 0x42, 0xf9, 0xb9, 0x3c, 0xd6, 0xad, 0x70, 0x0f, 0xe6, 0x1c,
-	0x90, 0xe0, 0x20, 0x42, 0xab, 0xd7, 0x38, 0x4b, 0x53, 0x90, 0x88, 0x51, 0xfc, 0x09, 0xcc, 0x07,
-	0x3d, 0xb0, 0xd6, 0x21, 0x81, 0xd6, 0x21, 0x9e, 0x43, 0x0d, 0xb7, 0x7f, 0xb7, 0xd2, 0xe4, 0x09,
-	0xc1, 0x07, 0x11, 0x5c, 0x9d, 0x0b, 0xe2, 0x5b, 0x46, 0x83, 0xd2, 0xbf, 0x08, 0xaa, 0xf9, 0xf6,
-	0x44, 0xd0, 0xed, 0xe1, 0xa0, 0xf7, 0x46, 0xed, 0x99, 0xc1, 0x12, 0x02, 0xb6, 0x6d, 0xf3, 0x31,
-	0xb3, 0xfc, 0x0e, 0x69, 0x12, 0x27, 0x7c, 0x80, 0x86, 0x33, 0xd7, 0x61, 0x2e, 0x03, 0x85, 0xab,
-	0x70, 0xb5, 0xff, 0x48, 0x6a, 0xfd, 0x2a, 0x04, 0xbd, 0x47, 0xee, 0xbe, 0x89, 0x5f, 0x86, 0xc9,
-	0x0e, 0x09, 0xf8, 0x89, 0x94, 0xd4, 0xf0, 0x2b, 0xbe, 0x0e, 0x53, 0x01, 0x27, 0x59, 0x98, 0xac,
-	0xa2, 0xfa, 0x25, 0x55, 0xfc, 0x92, 0xd6, 0xa1, 0xce, 0x1f, 0xfd, 0xf7, 0x79, 0xf9, 0x7d, 0x44,
-	0x89, 0xb3, 0x1f, 0x16, 0xdf, 0x5d, 0x5e, 0x4e, 0x7d, 0x27, 0x9e, 0xab, 0xf4, 0x03, 0x82, 0xc6,
-	0x39, 0xc0, 0xe2, 0x94, 0x6c, 0x58, 0xc8, 0xab, 0xe9, 0xe2, 0x1e, 0x28, 0x19, 0xc7, 0x56, 0x44,
-	0x2d, 0x8e, 0x67, 0x9e, 0x64, 0x61, 0xa4, 0x06, 0xac, 0x71, 0x71, 0x3b, 0xe1, 0xa5, 0x51, 0x75,
-	0x8f, 0xe4, 0x1b, 0xf9, 0x1e, 0x09, 0xd7, 0x85, 0x58, 0xe1, 0xe3, 0x29, 0xdc, 0xc8, 0xe9, 0x77,
-	0xc2, 0x86, 0x9c, 0x61, 0xa3, 0x80, 0x58, 0xb8, 0x88, 0x2e, 0xf7, 0x10, 0x44, 0x5a, 0x83, 0x55,
-	0x2e, 0x6c, 0x3f, 0xd6, 0xdb, 0x32, 0x2d, 0x7c, 0x85, 0xa0, 0x36, 0x0a, 0xd9, 0xaf, 0x4b, 0x73,
-	0x19, 0xad, 0x52, 0x88, 0x5f, 0xcd, 0x10, 0x9f, 0xa6, 0x14, 0x9a, 0xb1, 0x95, 0x9a, 0xd9, 0xf8,
-	0xe9, 0x32, 0xfc, 0x8f, 0x0b, 0xc1, 0x5f, 0x23, 0x98, 0xee, 0xb5, 0x01, 0xbc, 0x9e, 0xc1, 0x9b,
-	0xd3, 0x4b, 0xcb, 0xf5, 0x3c, 0xec, 0x70, 0x33, 0x95, 0x1a, 0x5f, 0xfc, 0xfe, 0xcf, 0x77, 0xa5,
-	0xd7, 0xf0, 0x8a, 0x52, 0xf0, 0x8e, 0xa1, 0x7c, 0x46, 0xcd, 0xcf, 0xf1, 0x37, 0x08, 0xae, 0xc4,
-	0xfa, 0x59, 0xbe, 0xa0, 0x74, 0x63, 0x2d, 0xdf, 0x1a, 0x25, 0x28, 0xd6, 0x20, 0xa5, 0xd7, 0xb9,
-	0xa6, 0x0a, 0x5e, 0x2a, 0xd2, 0x84, 0x9f, 0x21, 0x28, 0xe7, 0xd7, 0x7e, 0xbc, 0x79, 0xc1, 0x56,
-	0x11, 0xe9, 0x7c, 0x6b, 0xac, 0x06, 0x83, 0x7f, 0x41, 0xb0, 0x90, 0x57, 0x9e, 0xf0, 0xc6, 0x85,
-	0x6a, 0x59, 0xa4, 0xe3, 0xce, 0x18, 0xf5, 0x4f, 0xda, 0xe2, 0xe7, 0xb6, 0xb9, 0x85, 0xd6, 0x25,
-	0x45, 0xc9, 0x7c, 0x61, 0xd3, 0x6c, 0x66, 0x12, 0xcd, 0x63, 0xd1, 0x5f, 0x23, 0x26, 0xf2, 0x37,
-	0x04, 0x4b, 0x45, 0x95, 0x02, 0xdf, 0xcd, 0x4b, 0xf0, 0x1c, 0x75, 0xae, 0x7c, 0x6f, 0xbc, 0xc5,
-	0xc2, 0x57, 0x8d, 0xfb, 0xaa, 0xe2, 0x8a, 0x52, 0xf8, 0x92, 0x8b, 0x7f, 0x46, 0xb0, 0x58, 0x50,
-	0x26, 0xf0, 0x56, 0x9e, 0x8a, 0xd1, 0x05, 0xae, 0x7c, 0x77, 0xac, 0xb5, 0xc2, 0xc0, 0x2a, 0x37,
-	0xb0, 0x8c, 0x6f, 0x16, 0xbe, 0xf9, 0xe3, 0x5f, 0x11, 0xbc, 0x9a, 0x5b, 0x7c, 0xf0, 0x3b, 0x79,
-	0x0a, 0x46, 0x55, 0xb6, 0xf2, 0xbb, 0x63, 0xac, 0x14, 0xca, 0x65, 0xae, 0xbc, 0x8e, 0x6b, 0xca,
-	0xb9, 0xfe, 0x5b, 0xd8, 0x69, 0x3e, 0x3f, 0xad, 0xa0, 0x17, 0xa7, 0x15, 0xf4, 0xf7, 0x69, 0x05,
-	0x7d, 0x7b, 0x56, 0x99, 0x78, 0x71, 0x56, 0x99, 0xf8, 0xe3, 0xac, 0x32, 0xf1, 0xf1, 0xdb, 0x6d,
-	0xea, 0x1d, 0xfa, 0x2d, 0xd9, 0x60, 0x9d, 0x24, 0x57, 0xb0, 0xf9, 0x86, 0x71, 0xa8, 0x53, 0x5b,
-	0xe9, 0x8f, 0x1c, 0x47, 0xfc, 0xde, 0x49, 0x97, 0xb8, 0xad, 0x29, 0x3e, 0x7c, 0xe7, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd2, 0x5a, 0xb5, 0xd4, 0xc7, 0x0d, 0x00, 0x00,
+func (m *QueryMakerUptimeRewardsConfigurationRequest) Reset() {
+	*m = QueryMakerUptimeRewardsConfigurationRequest{}
+}
+func (m *QueryMakerUptimeRewardsConfigurationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMakerUptimeRewardsConfigurationRequest) ProtoMessage() {}
+func (*QueryMakerUptimeRewardsConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{14}
+}
+func (m *QueryMakerUptimeRewardsConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMakerUptimeRewardsConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMakerUptimeRewardsConfigurationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMakerUptimeRewardsConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMakerUptimeRewardsConfigurationRequest.Merge(m, src)
+}
+func (m *QueryMakerUptimeRewardsConfigurationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMakerUptimeRewardsConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMakerUptimeRewardsConfigurationRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryMakerUptimeRewardsConfigurationRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryMakerUptimeRewardsConfigurationResponse is a response message that
+// contains the MakerUptimeRewardsConfiguration.
+type QueryMakerUptimeRewardsConfigurationResponse struct {
+	MakerUptimeRewardsConfig MakerUptimeRewardsConfig `protobuf:"bytes,1,opt,name=maker_uptime_rewards_config,json=makerUptimeRewardsConfig,proto3" json:"maker_uptime_rewards_config"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a ClobPair by id.
-	ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error)
-	// Queries a list of ClobPair items.
-	ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error)
-	// Returns whether a subaccount is liquidatable.
-	AreSubaccountsLiquidatable(ctx context.Context, in *AreSubaccountsLiquidatableRequest, opts ...grpc.CallOption) (*AreSubaccountsLiquidatableResponse, error)
-	// Runs the MEV node <> node calculation with the provided parameters.
-	MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error)
-	// Queries EquityTierLimitConfiguration.
-	EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error)
-	// Queries BlockRateLimitConfiguration.
-	BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error)
-	// Queries LiquidationsConfiguration.
-	LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error)
+func (m *QueryMakerUptimeRewardsConfigurationResponse) Reset() {
+	*m = QueryMakerUptimeRewardsConfigurationResponse{}
+}
+func (m *QueryMakerUptimeRewardsConfigurationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMakerUptimeRewardsConfigurationResponse) ProtoMessage() {}
+func (*QueryMakerUptimeRewardsConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{15}
+}
+func (m *QueryMakerUptimeRewardsConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMakerUptimeRewardsConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMakerUptimeRewardsConfigurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMakerUptimeRewardsConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMakerUptimeRewardsConfigurationResponse.Merge(m, src)
+}
+func (m *QueryMakerUptimeRewardsConfigurationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMakerUptimeRewardsConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMakerUptimeRewardsConfigurationResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryMakerUptimeRewardsConfigurationResponse proto.InternalMessageInfo
+
+func (m *QueryMakerUptimeRewardsConfigurationResponse) GetMakerUptimeRewardsConfig() MakerUptimeRewardsConfig {
+	if m != nil {
+		return m.MakerUptimeRewardsConfig
+	}
+	return MakerUptimeRewardsConfig{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryOrderbookL2Request is a request message for OrderbookL2.
+type QueryOrderbookL2Request struct {
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The maximum number of price levels to return per side of the book. If
+	// zero, all price levels are returned.
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-func (c *queryClient) ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error) {
-	out := new(QueryClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOrderbookL2Request) Reset()         { *m = QueryOrderbookL2Request{} }
+func (m *QueryOrderbookL2Request) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderbookL2Request) ProtoMessage()    {}
+func (*QueryOrderbookL2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{16}
+}
+func (m *QueryOrderbookL2Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderbookL2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderbookL2Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOrderbookL2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderbookL2Request.Merge(m, src)
+}
+func (m *QueryOrderbookL2Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderbookL2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderbookL2Request.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error) {
-	out := new(QueryClobPairAllResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrderbookL2Request proto.InternalMessageInfo
+
+func (m *QueryOrderbookL2Request) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AreSubaccountsLiquidatable(ctx context.Context, in *AreSubaccountsLiquidatableRequest, opts ...grpc.CallOption) (*AreSubaccountsLiquidatableResponse, error) {
-	out := new(AreSubaccountsLiquidatableResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/AreSubaccountsLiquidatable", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOrderbookL2Request) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error) {
-	out := new(MevNodeToNodeCalculationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MevNodeToNodeCalculation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryOrderbookL2Response is a response message that contains the aggregated
+// price levels of the orderbook. Bids are sorted by descending price and asks
+// are sorted by ascending price.
+type QueryOrderbookL2Response struct {
+	ClobPairId uint32           `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	Bids       []OrderbookLevel `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	Asks       []OrderbookLevel `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks"`
 }
 
-func (c *queryClient) EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error) {
-	out := new(QueryEquityTierLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/EquityTierLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOrderbookL2Response) Reset()         { *m = QueryOrderbookL2Response{} }
+func (m *QueryOrderbookL2Response) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderbookL2Response) ProtoMessage()    {}
+func (*QueryOrderbookL2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{17}
+}
+func (m *QueryOrderbookL2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderbookL2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderbookL2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOrderbookL2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderbookL2Response.Merge(m, src)
+}
+func (m *QueryOrderbookL2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderbookL2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderbookL2Response.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error) {
-	out := new(QueryBlockRateLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/BlockRateLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrderbookL2Response proto.InternalMessageInfo
+
+func (m *QueryOrderbookL2Response) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error) {
-	out := new(QueryLiquidationsConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/LiquidationsConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOrderbookL2Response) GetBids() []OrderbookLevel {
+	if m != nil {
+		return m.Bids
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a ClobPair by id.
-	ClobPair(context.Context, *QueryGetClobPairRequest) (*QueryClobPairResponse, error)
-	// Queries a list of ClobPair items.
-	ClobPairAll(context.Context, *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error)
-	// Returns whether a subaccount is liquidatable.
-	AreSubaccountsLiquidatable(context.Context, *AreSubaccountsLiquidatableRequest) (*AreSubaccountsLiquidatableResponse, error)
-	// Runs the MEV node <> node calculation with the provided parameters.
-	MevNodeToNodeCalculation(context.Context, *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error)
-	// Queries EquityTierLimitConfiguration.
-	EquityTierLimitConfiguration(context.Context, *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error)
-	// Queries BlockRateLimitConfiguration.
-	BlockRateLimitConfiguration(context.Context, *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error)
-	// Queries LiquidationsConfiguration.
-	LiquidationsConfiguration(context.Context, *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error)
+func (m *QueryOrderbookL2Response) GetAsks() []OrderbookLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryOrderbookL3Request is a request message for OrderbookL3.
+type QueryOrderbookL3Request struct {
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The maximum number of price levels to return per side of the book. If
+	// zero, all price levels are returned.
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-func (*UnimplementedQueryServer) ClobPair(ctx context.Context, req *QueryGetClobPairRequest) (*QueryClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClobPair not implemented")
-}
-func (*UnimplementedQueryServer) ClobPairAll(ctx context.Context, req *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClobPairAll not implemented")
+func (m *QueryOrderbookL3Request) Reset()         { *m = QueryOrderbookL3Request{} }
+func (m *QueryOrderbookL3Request) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderbookL3Request) ProtoMessage()    {}
+func (*QueryOrderbookL3Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{18}
 }
-func (*UnimplementedQueryServer) AreSubaccountsLiquidatable(ctx context.Context, req *AreSubaccountsLiquidatableRequest) (*AreSubaccountsLiquidatableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AreSubaccountsLiquidatable not implemented")
+func (m *QueryOrderbookL3Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) MevNodeToNodeCalculation(ctx context.Context, req *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MevNodeToNodeCalculation not implemented")
+func (m *QueryOrderbookL3Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderbookL3Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EquityTierLimitConfiguration(ctx context.Context, req *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EquityTierLimitConfiguration not implemented")
+func (m *QueryOrderbookL3Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderbookL3Request.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BlockRateLimitConfiguration(ctx context.Context, req *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockRateLimitConfiguration not implemented")
+func (m *QueryOrderbookL3Request) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LiquidationsConfiguration(ctx context.Context, req *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidationsConfiguration not implemented")
+func (m *QueryOrderbookL3Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderbookL3Request.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryOrderbookL3Request proto.InternalMessageInfo
 
-func _Query_ClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetClobPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/ClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClobPair(ctx, req.(*QueryGetClobPairRequest))
+func (m *QueryOrderbookL3Request) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ClobPairAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllClobPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClobPairAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/ClobPairAll",
+func (m *QueryOrderbookL3Request) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClobPairAll(ctx, req.(*QueryAllClobPairRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_AreSubaccountsLiquidatable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AreSubaccountsLiquidatableRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AreSubaccountsLiquidatable(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/AreSubaccountsLiquidatable",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AreSubaccountsLiquidatable(ctx, req.(*AreSubaccountsLiquidatableRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryOrderbookL3Response is a response message that contains the price
+// levels of the orderbook along with the individual orders resting on each
+// level. Bids are sorted by descending price and asks are sorted by ascending
+// price.
+type QueryOrderbookL3Response struct {
+	ClobPairId uint32           `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	Bids       []OrderbookLevel `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	Asks       []OrderbookLevel `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks"`
 }
 
-func _Query_MevNodeToNodeCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MevNodeToNodeCalculationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/MevNodeToNodeCalculation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, req.(*MevNodeToNodeCalculationRequest))
+func (m *QueryOrderbookL3Response) Reset()         { *m = QueryOrderbookL3Response{} }
+func (m *QueryOrderbookL3Response) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderbookL3Response) ProtoMessage()    {}
+func (*QueryOrderbookL3Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{19}
+}
+func (m *QueryOrderbookL3Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderbookL3Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderbookL3Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOrderbookL3Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderbookL3Response.Merge(m, src)
+}
+func (m *QueryOrderbookL3Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderbookL3Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderbookL3Response.DiscardUnknown(m)
 }
 
-func _Query_EquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEquityTierLimitConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/EquityTierLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, req.(*QueryEquityTierLimitConfigurationRequest))
+var xxx_messageInfo_QueryOrderbookL3Response proto.InternalMessageInfo
+
+func (m *QueryOrderbookL3Response) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_BlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockRateLimitConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/BlockRateLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, req.(*QueryBlockRateLimitConfigurationRequest))
+func (m *QueryOrderbookL3Response) GetBids() []OrderbookLevel {
+	if m != nil {
+		return m.Bids
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LiquidationsConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidationsConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidationsConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/LiquidationsConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidationsConfiguration(ctx, req.(*QueryLiquidationsConfigurationRequest))
+func (m *QueryOrderbookL3Response) GetAsks() []OrderbookLevel {
+	if m != nil {
+		return m.Asks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.clob.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ClobPair",
-			Handler:    _Query_ClobPair_Handler,
-		},
-		{
-			MethodName: "ClobPairAll",
-			Handler:    _Query_ClobPairAll_Handler,
-		},
-		{
-			MethodName: "AreSubaccountsLiquidatable",
-			Handler:    _Query_AreSubaccountsLiquidatable_Handler,
-		},
-		{
-			MethodName: "MevNodeToNodeCalculation",
-			Handler:    _Query_MevNodeToNodeCalculation_Handler,
-		},
-		{
-			MethodName: "EquityTierLimitConfiguration",
-			Handler:    _Query_EquityTierLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "BlockRateLimitConfiguration",
-			Handler:    _Query_BlockRateLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "LiquidationsConfiguration",
-			Handler:    _Query_LiquidationsConfiguration_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/clob/query.proto",
+// QueryTradingPermissionRequest is a request message for TradingPermission.
+type QueryTradingPermissionRequest struct {
+	// The owner of the subaccount.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The number of the subaccount.
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// The address the permission was granted to.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (m *QueryGetClobPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTradingPermissionRequest) Reset()         { *m = QueryTradingPermissionRequest{} }
+func (m *QueryTradingPermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradingPermissionRequest) ProtoMessage()    {}
+func (*QueryTradingPermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{20}
+}
+func (m *QueryTradingPermissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradingPermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradingPermissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryTradingPermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradingPermissionRequest.Merge(m, src)
+}
+func (m *QueryTradingPermissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradingPermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradingPermissionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTradingPermissionRequest proto.InternalMessageInfo
+
+func (m *QueryTradingPermissionRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *QueryGetClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryTradingPermissionRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTradingPermissionRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTradingPermissionResponse is a response message that contains the
+// trading permission of a grantee for a subaccount.
+type QueryTradingPermissionResponse struct {
+	Permission TradingPermission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission"`
 }
 
-func (m *QueryClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTradingPermissionResponse) Reset()         { *m = QueryTradingPermissionResponse{} }
+func (m *QueryTradingPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradingPermissionResponse) ProtoMessage()    {}
+func (*QueryTradingPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{21}
+}
+func (m *QueryTradingPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradingPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradingPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllClobPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTradingPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradingPermissionResponse.Merge(m, src)
 }
-
-func (m *QueryAllClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTradingPermissionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryTradingPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradingPermissionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClobPairAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTradingPermissionResponse proto.InternalMessageInfo
+
+func (m *QueryTradingPermissionResponse) GetPermission() TradingPermission {
+	if m != nil {
+		return m.Permission
 	}
-	return dAtA[:n], nil
+	return TradingPermission{}
 }
 
-func (m *QueryClobPairAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDeleveragingCandidatesRequest is a request message for
+// DeleveragingCandidates.
+type QueryDeleveragingCandidatesRequest struct {
 }
 
-func (m *QueryClobPairAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClobPair) > 0 {
-		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDeleveragingCandidatesRequest) Reset()         { *m = QueryDeleveragingCandidatesRequest{} }
+func (m *QueryDeleveragingCandidatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeleveragingCandidatesRequest) ProtoMessage()    {}
+func (*QueryDeleveragingCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{22}
+}
+func (m *QueryDeleveragingCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeleveragingCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeleveragingCandidatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AreSubaccountsLiquidatableRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDeleveragingCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeleveragingCandidatesRequest.Merge(m, src)
+}
+func (m *QueryDeleveragingCandidatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeleveragingCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeleveragingCandidatesRequest.DiscardUnknown(m)
 }
 
-func (m *AreSubaccountsLiquidatableRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDeleveragingCandidatesRequest proto.InternalMessageInfo
+
+// QueryDeleveragingCandidatesResponse is a response message that contains the
+// subaccounts pending deleveraging.
+type QueryDeleveragingCandidatesResponse struct {
+	Candidates []QueryDeleveragingCandidatesResponse_DeleveragingCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates"`
 }
 
-func (m *AreSubaccountsLiquidatableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SubaccountIds) > 0 {
-		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDeleveragingCandidatesResponse) Reset()         { *m = QueryDeleveragingCandidatesResponse{} }
+func (m *QueryDeleveragingCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeleveragingCandidatesResponse) ProtoMessage()    {}
+func (*QueryDeleveragingCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{23}
+}
+func (m *QueryDeleveragingCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeleveragingCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeleveragingCandidatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDeleveragingCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeleveragingCandidatesResponse.Merge(m, src)
+}
+func (m *QueryDeleveragingCandidatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeleveragingCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeleveragingCandidatesResponse.DiscardUnknown(m)
 }
 
-func (m *AreSubaccountsLiquidatableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeleveragingCandidatesResponse proto.InternalMessageInfo
+
+func (m *QueryDeleveragingCandidatesResponse) GetCandidates() []QueryDeleveragingCandidatesResponse_DeleveragingCandidate {
+	if m != nil {
+		return m.Candidates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AreSubaccountsLiquidatableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DeleveragingCandidate is a subaccount with negative total net collateral.
+type QueryDeleveragingCandidatesResponse_DeleveragingCandidate struct {
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The net collateral of the subaccount in quote quantums.
+	NetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	// The ids of the perpetuals the subaccount has open positions in.
+	PerpetualIds []uint32 `protobuf:"varint,3,rep,packed,name=perpetual_ids,json=perpetualIds,proto3" json:"perpetual_ids,omitempty"`
 }
 
-func (m *AreSubaccountsLiquidatableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) Reset() {
+	*m = QueryDeleveragingCandidatesResponse_DeleveragingCandidate{}
+}
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDeleveragingCandidatesResponse_DeleveragingCandidate) ProtoMessage() {}
+func (*QueryDeleveragingCandidatesResponse_DeleveragingCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{23, 0}
+}
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeleveragingCandidatesResponse_DeleveragingCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeleveragingCandidatesResponse_DeleveragingCandidate.Merge(m, src)
+}
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeleveragingCandidatesResponse_DeleveragingCandidate.DiscardUnknown(m)
 }
 
-func (m *AreSubaccountsLiquidatableResponse_Result) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeleveragingCandidatesResponse_DeleveragingCandidate proto.InternalMessageInfo
+
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) GetSubaccountId() types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return dAtA[:n], nil
+	return types.SubaccountId{}
 }
 
-func (m *AreSubaccountsLiquidatableResponse_Result) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) GetPerpetualIds() []uint32 {
+	if m != nil {
+		return m.PerpetualIds
+	}
+	return nil
 }
 
-func (m *AreSubaccountsLiquidatableResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsLiquidatable {
-		i--
-		if m.IsLiquidatable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// QuerySimulatePlaceOrderRequest is request type for the SimulatePlaceOrder
+// method.
+type QuerySimulatePlaceOrderRequest struct {
+	// The Short-Term order to simulate placing.
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+}
+
+func (m *QuerySimulatePlaceOrderRequest) Reset()         { *m = QuerySimulatePlaceOrderRequest{} }
+func (m *QuerySimulatePlaceOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePlaceOrderRequest) ProtoMessage()    {}
+func (*QuerySimulatePlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{24}
+}
+func (m *QuerySimulatePlaceOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePlaceOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePlaceOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	{
-		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *QuerySimulatePlaceOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePlaceOrderRequest.Merge(m, src)
+}
+func (m *QuerySimulatePlaceOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePlaceOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePlaceOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePlaceOrderRequest proto.InternalMessageInfo
+
+func (m *QuerySimulatePlaceOrderRequest) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order{}
+}
+
+// QuerySimulatePlaceOrderResponse is response type for the SimulatePlaceOrder
+// method.
+type QuerySimulatePlaceOrderResponse struct {
+	// The fills the order would generate, in matching order.
+	Fills []QuerySimulatePlaceOrderResponse_SimulatedFill `protobuf:"bytes,1,rep,name=fills,proto3" json:"fills"`
+	// The total amount of the order that would be filled, in base quantums.
+	FilledQuantums uint64 `protobuf:"varint,2,opt,name=filled_quantums,json=filledQuantums,proto3" json:"filled_quantums,omitempty"`
+	// The resulting status of the order, as defined by `OrderStatus`. Only
+	// meaningful if `error` is empty.
+	OrderStatus uint32 `protobuf:"varint,3,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
+	// The human-readable name of `order_status`.
+	OrderStatusName string `protobuf:"bytes,4,opt,name=order_status_name,json=orderStatusName,proto3" json:"order_status_name,omitempty"`
+	// The error the order would be rejected with, if any.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// The state of the order's subaccount after the simulated placement.
+	Subaccount types.Subaccount `protobuf:"bytes,6,opt,name=subaccount,proto3" json:"subaccount"`
+}
+
+func (m *QuerySimulatePlaceOrderResponse) Reset()         { *m = QuerySimulatePlaceOrderResponse{} }
+func (m *QuerySimulatePlaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePlaceOrderResponse) ProtoMessage()    {}
+func (*QuerySimulatePlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{25}
+}
+func (m *QuerySimulatePlaceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePlaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePlaceOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulatePlaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePlaceOrderResponse.Merge(m, src)
+}
+func (m *QuerySimulatePlaceOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePlaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePlaceOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MevNodeToNodeCalculationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulatePlaceOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulatePlaceOrderResponse) GetFills() []QuerySimulatePlaceOrderResponse_SimulatedFill {
+	if m != nil {
+		return m.Fills
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MevNodeToNodeCalculationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulatePlaceOrderResponse) GetFilledQuantums() uint64 {
+	if m != nil {
+		return m.FilledQuantums
+	}
+	return 0
 }
 
-func (m *MevNodeToNodeCalculationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatorMevMetrics != nil {
-		{
-			size, err := m.ValidatorMevMetrics.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QuerySimulatePlaceOrderResponse) GetOrderStatus() uint32 {
+	if m != nil {
+		return m.OrderStatus
 	}
-	if m.BlockProposerMatches != nil {
-		{
-			size, err := m.BlockProposerMatches.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *QuerySimulatePlaceOrderResponse) GetOrderStatusName() string {
+	if m != nil {
+		return m.OrderStatusName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MevNodeToNodeCalculationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulatePlaceOrderResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MevNodeToNodeCalculationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulatePlaceOrderResponse) GetSubaccount() types.Subaccount {
+	if m != nil {
+		return m.Subaccount
+	}
+	return types.Subaccount{}
 }
 
-func (m *MevNodeToNodeCalculationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// SimulatedFill is a fill the simulated order would generate as the taker.
+type QuerySimulatePlaceOrderResponse_SimulatedFill struct {
+	// The id of the matched maker order.
+	MakerOrderId OrderId `protobuf:"bytes,1,opt,name=maker_order_id,json=makerOrderId,proto3" json:"maker_order_id"`
+	// The filled amount, in base quantums.
+	FillAmount uint64 `protobuf:"varint,2,opt,name=fill_amount,json=fillAmount,proto3" json:"fill_amount,omitempty"`
+	// The price of the fill, in subticks.
+	Subticks uint64 `protobuf:"varint,3,opt,name=subticks,proto3" json:"subticks,omitempty"`
+	// The fee paid by the taker, in quote quantums.
+	TakerFee int64 `protobuf:"varint,4,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	// The fee paid by the maker, in quote quantums. Negative for rebates.
+	MakerFee int64 `protobuf:"varint,5,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+}
+
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) Reset() {
+	*m = QuerySimulatePlaceOrderResponse_SimulatedFill{}
+}
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QuerySimulatePlaceOrderResponse_SimulatedFill) ProtoMessage() {}
+func (*QuerySimulatePlaceOrderResponse_SimulatedFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{25, 0}
+}
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePlaceOrderResponse_SimulatedFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePlaceOrderResponse_SimulatedFill.Merge(m, src)
+}
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePlaceOrderResponse_SimulatedFill.DiscardUnknown(m)
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulatePlaceOrderResponse_SimulatedFill proto.InternalMessageInfo
+
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) GetMakerOrderId() OrderId {
+	if m != nil {
+		return m.MakerOrderId
 	}
-	return dAtA[:n], nil
+	return OrderId{}
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) GetFillAmount() uint64 {
+	if m != nil {
+		return m.FillAmount
+	}
+	return 0
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Volume != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Mev != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mev))))
-		i--
-		dAtA[i] = 0x15
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) GetSubticks() uint64 {
+	if m != nil {
+		return m.Subticks
 	}
-	if m.ClobPairId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) GetTakerFee() int64 {
+	if m != nil {
+		return m.TakerFee
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) GetMakerFee() int64 {
+	if m != nil {
+		return m.MakerFee
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryGetClobPairRequest)(nil), "dydxprotocol.clob.QueryGetClobPairRequest")
+	proto.RegisterType((*QueryClobPairResponse)(nil), "dydxprotocol.clob.QueryClobPairResponse")
+	proto.RegisterType((*QueryAllClobPairRequest)(nil), "dydxprotocol.clob.QueryAllClobPairRequest")
+	proto.RegisterType((*QueryClobPairAllResponse)(nil), "dydxprotocol.clob.QueryClobPairAllResponse")
+	proto.RegisterType((*AreSubaccountsLiquidatableRequest)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableRequest")
+	proto.RegisterType((*AreSubaccountsLiquidatableResponse)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableResponse")
+	proto.RegisterType((*AreSubaccountsLiquidatableResponse_Result)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableResponse.Result")
+	proto.RegisterType((*MevNodeToNodeCalculationRequest)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationRequest")
+	proto.RegisterType((*MevNodeToNodeCalculationResponse)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse")
+	proto.RegisterType((*MevNodeToNodeCalculationResponse_MevAndVolumePerClob)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse.MevAndVolumePerClob")
+	proto.RegisterType((*QueryEquityTierLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationRequest")
+	proto.RegisterType((*QueryEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationResponse")
+	proto.RegisterType((*QueryBlockRateLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationRequest")
+	proto.RegisterType((*QueryBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationResponse")
+	proto.RegisterType((*QueryLiquidationsConfigurationRequest)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationRequest")
+	proto.RegisterType((*QueryLiquidationsConfigurationResponse)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationResponse")
+	proto.RegisterType((*QueryMakerUptimeRewardsConfigurationRequest)(nil), "dydxprotocol.clob.QueryMakerUptimeRewardsConfigurationRequest")
+	proto.RegisterType((*QueryMakerUptimeRewardsConfigurationResponse)(nil), "dydxprotocol.clob.QueryMakerUptimeRewardsConfigurationResponse")
+	proto.RegisterType((*QueryOrderbookL2Request)(nil), "dydxprotocol.clob.QueryOrderbookL2Request")
+	proto.RegisterType((*QueryOrderbookL2Response)(nil), "dydxprotocol.clob.QueryOrderbookL2Response")
+	proto.RegisterType((*QueryOrderbookL3Request)(nil), "dydxprotocol.clob.QueryOrderbookL3Request")
+	proto.RegisterType((*QueryOrderbookL3Response)(nil), "dydxprotocol.clob.QueryOrderbookL3Response")
+	proto.RegisterType((*QueryTradingPermissionRequest)(nil), "dydxprotocol.clob.QueryTradingPermissionRequest")
+	proto.RegisterType((*QueryTradingPermissionResponse)(nil), "dydxprotocol.clob.QueryTradingPermissionResponse")
+	proto.RegisterType((*QueryDeleveragingCandidatesRequest)(nil), "dydxprotocol.clob.QueryDeleveragingCandidatesRequest")
+	proto.RegisterType((*QueryDeleveragingCandidatesResponse)(nil), "dydxprotocol.clob.QueryDeleveragingCandidatesResponse")
+	proto.RegisterType((*QueryDeleveragingCandidatesResponse_DeleveragingCandidate)(nil), "dydxprotocol.clob.QueryDeleveragingCandidatesResponse.DeleveragingCandidate")
+	proto.RegisterType((*QuerySimulatePlaceOrderRequest)(nil), "dydxprotocol.clob.QuerySimulatePlaceOrderRequest")
+	proto.RegisterType((*QuerySimulatePlaceOrderResponse)(nil), "dydxprotocol.clob.QuerySimulatePlaceOrderResponse")
+	proto.RegisterType((*QuerySimulatePlaceOrderResponse_SimulatedFill)(nil), "dydxprotocol.clob.QuerySimulatePlaceOrderResponse.SimulatedFill")
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("dydxprotocol/clob/query.proto", fileDescriptor_3365c195b25c5bc0) }
+
+var fileDescriptor_3365c195b25c5bc0 = []byte{
+	// 1937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xfb, 0x23, 0x6b, 0x3f, 0x7b, 0xbc, 0xa4, 0xe2, 0x64, 0x87, 0x71, 0x32, 0xb6, 0x3b,
+	0x89, 0x3f, 0x77, 0xbb, 0x13, 0x4f, 0x36, 0x82, 0x64, 0xb5, 0xc4, 0x36, 0x24, 0x1b, 0x64, 0x2f,
+	0x4e, 0x3b, 0x04, 0x09, 0x56, 0x6a, 0xd5, 0x74, 0x57, 0x26, 0x2d, 0xf7, 0xc7, 0xb8, 0xba, 0x7b,
+	0x92, 0x60, 0x59, 0x42, 0x1c, 0x90, 0x10, 0x20, 0x21, 0x71, 0xe0, 0x00, 0x42, 0x48, 0xdc, 0xb8,
+	0x70, 0xe3, 0x82, 0x10, 0x1f, 0xa7, 0x3d, 0xa1, 0x95, 0xb8, 0xa0, 0x15, 0x5a, 0xa1, 0x84, 0xf3,
+	0x5e, 0xf8, 0x07, 0x50, 0x7d, 0xf4, 0x4c, 0x8f, 0xbb, 0x7b, 0x3e, 0x2c, 0x72, 0xe0, 0x62, 0x4f,
+	0xbf, 0x7a, 0xef, 0xd5, 0xef, 0xbd, 0x7a, 0x55, 0xf5, 0x7b, 0x05, 0x97, 0xed, 0x17, 0xf6, 0xf3,
+	0x26, 0x0d, 0xa2, 0xc0, 0x0a, 0x5c, 0xdd, 0x72, 0x83, 0xba, 0x7e, 0x18, 0x13, 0xfa, 0x42, 0xe3,
+	0x32, 0x74, 0x2e, 0x3d, 0xac, 0xb1, 0xe1, 0xca, 0x6c, 0x23, 0x68, 0x04, 0x5c, 0xa4, 0xb3, 0x5f,
+	0x42, 0xb1, 0x72, 0xa9, 0x11, 0x04, 0x0d, 0x97, 0xe8, 0xb8, 0xe9, 0xe8, 0xd8, 0xf7, 0x83, 0x08,
+	0x47, 0x4e, 0xe0, 0x87, 0x72, 0x74, 0xcd, 0x0a, 0x42, 0x2f, 0x08, 0xf5, 0x3a, 0x0e, 0x89, 0xf0,
+	0xaf, 0xb7, 0x6e, 0xd4, 0x49, 0x84, 0x6f, 0xe8, 0x4d, 0xdc, 0x70, 0x7c, 0xae, 0x2c, 0x75, 0xf5,
+	0x2c, 0xa2, 0xba, 0x1b, 0x58, 0x07, 0x26, 0xc5, 0x11, 0x31, 0x5d, 0xc7, 0x73, 0x22, 0xd3, 0x0a,
+	0xfc, 0x27, 0x4e, 0x43, 0x1a, 0x2c, 0x66, 0x0d, 0xd8, 0x1f, 0xb3, 0x89, 0x1d, 0x2a, 0x55, 0xae,
+	0x67, 0x55, 0xc8, 0x61, 0xec, 0x44, 0x2f, 0xcc, 0xc8, 0x21, 0x34, 0xcf, 0xe9, 0x7a, 0xd6, 0xc2,
+	0x75, 0x0e, 0x63, 0xc7, 0x16, 0x71, 0x75, 0x2b, 0xd7, 0xb2, 0xca, 0x1e, 0x3e, 0x20, 0xd4, 0x8c,
+	0x9b, 0x91, 0xe3, 0x11, 0x93, 0x92, 0x67, 0x98, 0xda, 0x27, 0x8c, 0xe6, 0x72, 0x8c, 0x48, 0x4b,
+	0x0e, 0xe6, 0x2c, 0x4b, 0x40, 0x6d, 0x42, 0x8b, 0x43, 0xe6, 0xc3, 0xf5, 0x20, 0x38, 0x48, 0x52,
+	0x9e, 0x55, 0x89, 0x28, 0xb6, 0x1d, 0xbf, 0x61, 0x36, 0x09, 0xf5, 0x9c, 0x30, 0xec, 0xa4, 0x7c,
+	0xb5, 0x4b, 0x37, 0x8c, 0xeb, 0xd8, 0xb2, 0x82, 0xd8, 0x8f, 0xc2, 0xd4, 0x6f, 0xa1, 0xaa, 0xae,
+	0xc2, 0x5b, 0x0f, 0xd9, 0xfa, 0xdd, 0x27, 0xd1, 0xb6, 0x1b, 0xd4, 0xf7, 0xb0, 0x43, 0x0d, 0x72,
+	0x18, 0x93, 0x30, 0x42, 0x33, 0x30, 0xe2, 0xd8, 0x65, 0x65, 0x41, 0x59, 0x29, 0x19, 0x23, 0x8e,
+	0xad, 0x7e, 0x0b, 0x2e, 0x70, 0xd5, 0x8e, 0x5e, 0xd8, 0x0c, 0xfc, 0x90, 0xa0, 0xf7, 0x61, 0xb2,
+	0xbd, 0x40, 0x5c, 0x7f, 0x6a, 0x63, 0x4e, 0xcb, 0x14, 0x9a, 0x96, 0xd8, 0x6d, 0x8d, 0x7d, 0xfc,
+	0xd9, 0xfc, 0x19, 0x63, 0xc2, 0x92, 0xdf, 0x2a, 0x96, 0x18, 0x36, 0x5d, 0xf7, 0x24, 0x86, 0x7b,
+	0x00, 0x9d, 0x82, 0x92, 0xbe, 0x97, 0x34, 0x51, 0x7d, 0x1a, 0xab, 0x3e, 0x4d, 0x54, 0xb7, 0xac,
+	0x3e, 0x6d, 0x0f, 0x37, 0x88, 0xb4, 0x35, 0x52, 0x96, 0xea, 0x6f, 0x14, 0x28, 0x77, 0x81, 0xdf,
+	0x74, 0xdd, 0x22, 0xfc, 0xa3, 0x43, 0xe2, 0x47, 0xf7, 0xbb, 0x40, 0x8e, 0x70, 0x90, 0xcb, 0x7d,
+	0x41, 0x8a, 0xc9, 0xbb, 0x50, 0x3e, 0x87, 0xc5, 0x4d, 0x4a, 0xf6, 0x3b, 0xeb, 0xb5, 0x23, 0x4b,
+	0x14, 0xd7, 0xdd, 0x24, 0x2c, 0xb4, 0x0f, 0x33, 0x9d, 0x55, 0x34, 0x1d, 0x3b, 0x94, 0x90, 0x97,
+	0xba, 0x21, 0xa7, 0x56, 0x5d, 0xeb, 0x78, 0x7c, 0x60, 0x4b, 0xf4, 0xa5, 0x30, 0x25, 0x0b, 0xd5,
+	0xff, 0x8c, 0x82, 0xda, 0x6b, 0x6a, 0x99, 0xa9, 0x8f, 0xe0, 0x0d, 0x4a, 0xc2, 0xd8, 0x8d, 0x92,
+	0x49, 0xdf, 0xcb, 0xc9, 0x53, 0x7f, 0x3f, 0x9a, 0xc1, 0x9d, 0x48, 0x28, 0x89, 0xcb, 0xca, 0xe7,
+	0x23, 0x70, 0x56, 0x8c, 0xa0, 0x87, 0x50, 0xea, 0x0a, 0xb2, 0xbd, 0xf4, 0xc3, 0xc4, 0x38, 0x9d,
+	0x8e, 0x11, 0x2d, 0xc3, 0x9b, 0x4e, 0x68, 0xba, 0x29, 0x38, 0x7c, 0xa9, 0x26, 0x8c, 0x19, 0xa7,
+	0x0b, 0x24, 0x0a, 0x60, 0xc6, 0x27, 0xec, 0xf8, 0x70, 0x5d, 0x1c, 0x11, 0x8a, 0xdd, 0xf2, 0xe8,
+	0x82, 0xb2, 0x32, 0xbd, 0xf5, 0x01, 0x73, 0xfa, 0xe9, 0x67, 0xf3, 0x77, 0x1b, 0x4e, 0xf4, 0x34,
+	0xae, 0x6b, 0x56, 0xe0, 0x75, 0x9f, 0x6d, 0xad, 0x9b, 0xef, 0x58, 0x4f, 0xb1, 0xe3, 0xeb, 0x6d,
+	0x89, 0x1d, 0xbd, 0x68, 0x92, 0x50, 0xdb, 0x27, 0xd4, 0xc1, 0xae, 0xf3, 0x5d, 0xe6, 0xfe, 0x81,
+	0x1f, 0x19, 0x25, 0x9f, 0x44, 0xdb, 0x6d, 0xf7, 0xe8, 0x19, 0x20, 0x0f, 0x3b, 0x7e, 0x44, 0x7c,
+	0xec, 0x5b, 0xc4, 0xf4, 0x30, 0x6d, 0x38, 0x7e, 0x79, 0xec, 0x7f, 0x3c, 0xe9, 0xb9, 0xd4, 0x1c,
+	0xbb, 0x7c, 0x0a, 0xf5, 0x9f, 0x0a, 0xcc, 0xef, 0x92, 0xd6, 0x87, 0x81, 0x4d, 0x1e, 0x05, 0xec,
+	0xef, 0x36, 0x76, 0xad, 0xd8, 0xe5, 0xc5, 0x98, 0x94, 0xdb, 0x47, 0x70, 0x51, 0x1c, 0xd7, 0x4d,
+	0x1a, 0x34, 0x83, 0x90, 0x50, 0xd3, 0xc3, 0x91, 0xf5, 0x94, 0x84, 0xf9, 0x4b, 0xc2, 0x2b, 0xe0,
+	0x31, 0x76, 0x59, 0x36, 0x03, 0xba, 0x4b, 0x5a, 0xbb, 0x42, 0xdb, 0x98, 0xe5, 0x5e, 0xf6, 0xa4,
+	0x13, 0x29, 0x45, 0xdf, 0x81, 0x0b, 0xad, 0x44, 0xd9, 0xf4, 0x48, 0xcb, 0xf4, 0x48, 0x44, 0x1d,
+	0x2b, 0x6c, 0xef, 0xa2, 0xac, 0xf3, 0x2e, 0xc0, 0xbb, 0x42, 0xdd, 0x38, 0xdf, 0x4a, 0x4f, 0x29,
+	0x84, 0xea, 0xe7, 0x0a, 0x2c, 0x14, 0x87, 0x27, 0x4b, 0xba, 0x71, 0xb2, 0xa4, 0xef, 0xf7, 0x9b,
+	0x33, 0xc7, 0x0b, 0x53, 0xd8, 0xf4, 0xed, 0xc7, 0x81, 0x1b, 0x7b, 0x64, 0x8f, 0x50, 0x76, 0x54,
+	0x9c, 0xac, 0x6e, 0x0c, 0xe7, 0x73, 0xb4, 0xd0, 0x02, 0x4c, 0xb7, 0x0f, 0x1f, 0xb3, 0x7d, 0xde,
+	0x42, 0x72, 0xb8, 0x3c, 0xb0, 0xd1, 0x17, 0x60, 0xd4, 0x23, 0x2d, 0x9e, 0x91, 0x11, 0x83, 0xfd,
+	0x44, 0x17, 0xe1, 0x6c, 0x8b, 0x3b, 0xe1, 0x95, 0x39, 0x66, 0xc8, 0x2f, 0x75, 0x0d, 0x56, 0xf8,
+	0x21, 0xf7, 0x35, 0x7e, 0x17, 0x3e, 0x72, 0x08, 0xdd, 0x61, 0x37, 0xe1, 0x36, 0xbf, 0xa6, 0x62,
+	0x9a, 0x5e, 0x57, 0xf5, 0x17, 0x0a, 0xac, 0x0e, 0xa0, 0x2c, 0xb3, 0xe4, 0x43, 0xb9, 0xe8, 0x82,
+	0x95, 0x75, 0xa0, 0xe7, 0xa4, 0xad, 0x97, 0x6b, 0x99, 0x9e, 0x0b, 0x24, 0x4f, 0x47, 0x5d, 0x85,
+	0x65, 0x0e, 0x6e, 0x8b, 0x15, 0x8d, 0x81, 0x23, 0x52, 0x1c, 0xc8, 0xcf, 0x15, 0x58, 0xe9, 0xaf,
+	0x2b, 0xe3, 0x38, 0x80, 0xb7, 0x0a, 0xc8, 0x87, 0x0c, 0x43, 0xcb, 0x09, 0xa3, 0x87, 0x63, 0x19,
+	0xc5, 0x6c, 0x3d, 0x47, 0x45, 0x5d, 0x86, 0x6b, 0x1c, 0xd8, 0x4e, 0x8a, 0x68, 0xe4, 0x86, 0xf0,
+	0x03, 0x05, 0x96, 0xfa, 0x69, 0xb6, 0x4f, 0xe0, 0xf3, 0x39, 0xbc, 0x45, 0x82, 0xbf, 0x96, 0x03,
+	0x3e, 0xeb, 0x52, 0x62, 0x46, 0x6e, 0x66, 0x44, 0x7d, 0x07, 0xd6, 0x39, 0x8e, 0x5d, 0xc6, 0x76,
+	0xbe, 0xc9, 0xc9, 0x8e, 0x21, 0xb8, 0x4e, 0x2e, 0xee, 0x5f, 0x2b, 0xf0, 0xf6, 0x60, 0xfa, 0x12,
+	0x7d, 0x13, 0xe6, 0x7a, 0x10, 0x29, 0x19, 0xc5, 0x7a, 0xde, 0x06, 0x2c, 0x98, 0x40, 0xc6, 0x52,
+	0xf6, 0x0a, 0xc6, 0xd5, 0x87, 0x92, 0x5b, 0x7c, 0x23, 0xa1, 0x53, 0x3b, 0x1b, 0xc9, 0xc9, 0xd6,
+	0x7f, 0xe7, 0xcd, 0xc2, 0xb8, 0x4d, 0x9a, 0xd1, 0x53, 0xbe, 0xf7, 0x4a, 0x86, 0xf8, 0x50, 0x7f,
+	0x9f, 0x70, 0x89, 0x2e, 0x9f, 0x32, 0xc2, 0xfe, 0x4e, 0xef, 0xc0, 0x58, 0x9d, 0xdd, 0xda, 0x23,
+	0xfc, 0xb4, 0x59, 0xcc, 0x09, 0xb6, 0xe3, 0x97, 0xb4, 0x88, 0x2b, 0x43, 0xe4, 0x46, 0xcc, 0x18,
+	0x87, 0x07, 0x61, 0x79, 0x74, 0x48, 0x63, 0x66, 0x94, 0x93, 0x8b, 0xda, 0x6b, 0xc8, 0x45, 0xed,
+	0xff, 0x22, 0x17, 0x0d, 0xb8, 0xcc, 0x71, 0x3f, 0x12, 0x1c, 0x7a, 0xaf, 0x4d, 0xa1, 0x93, 0x8c,
+	0xcc, 0xc2, 0x78, 0xf0, 0xcc, 0x27, 0x82, 0xd0, 0x4e, 0x1a, 0xe2, 0x83, 0x9d, 0xbc, 0x7e, 0xec,
+	0xd5, 0x09, 0x95, 0x69, 0x90, 0x5f, 0xa8, 0x0c, 0x6f, 0x34, 0x28, 0xf6, 0x23, 0x22, 0x8e, 0xe4,
+	0x49, 0x23, 0xf9, 0x54, 0x5d, 0xa8, 0x16, 0x4d, 0x24, 0xd3, 0xf4, 0x75, 0x80, 0x0e, 0x83, 0x97,
+	0x7b, 0xe0, 0x6a, 0x4e, 0x34, 0x19, 0x0f, 0x32, 0xa0, 0x94, 0xb5, 0x7a, 0x15, 0x54, 0x3e, 0xdb,
+	0x57, 0x89, 0x4b, 0x5a, 0x84, 0x32, 0x6a, 0xd9, 0xd8, 0xc6, 0xbe, 0xcd, 0xf6, 0x39, 0x09, 0x93,
+	0x7d, 0xfb, 0xcb, 0x51, 0xb8, 0xd2, 0x53, 0x4d, 0x22, 0xa3, 0x00, 0x56, 0x5b, 0x2a, 0xaf, 0xc7,
+	0x9d, 0x1c, 0x64, 0x03, 0xf8, 0xd2, 0x72, 0x87, 0x93, 0x08, 0x3a, 0xb3, 0x54, 0xbe, 0x37, 0x02,
+	0x17, 0x72, 0x75, 0x5f, 0x07, 0x27, 0xcc, 0x52, 0xbd, 0x91, 0xd7, 0x4b, 0xf5, 0xae, 0x40, 0xa9,
+	0x49, 0x68, 0x93, 0x44, 0x31, 0x76, 0x39, 0x77, 0x67, 0xc5, 0x5b, 0x32, 0xa6, 0xdb, 0x42, 0x46,
+	0xc6, 0x1f, 0xcb, 0x92, 0xd9, 0x77, 0x3c, 0xc6, 0x33, 0xc8, 0x9e, 0x8b, 0x2d, 0xc2, 0x6b, 0x39,
+	0x29, 0xce, 0x9b, 0x30, 0xce, 0xfb, 0x43, 0x99, 0x82, 0x72, 0x51, 0xed, 0xcb, 0xa0, 0x85, 0xb2,
+	0xfa, 0xdb, 0x31, 0x98, 0x2f, 0x74, 0xdc, 0xbe, 0x5f, 0xc6, 0x9f, 0x38, 0xae, 0x9b, 0xac, 0xf6,
+	0xdd, 0xa2, 0xd5, 0x2e, 0x76, 0xa1, 0x25, 0x43, 0xf6, 0x3d, 0xc7, 0x4d, 0x36, 0x9d, 0x70, 0xca,
+	0x38, 0x38, 0xfb, 0x41, 0x6c, 0xf3, 0x30, 0xc6, 0x7e, 0x14, 0x7b, 0x82, 0xe8, 0x8d, 0x19, 0x33,
+	0x42, 0xfc, 0x50, 0x4a, 0xd1, 0x22, 0x4c, 0x73, 0xcc, 0x66, 0x18, 0xe1, 0x28, 0x0e, 0xf9, 0xa6,
+	0x2a, 0x19, 0x53, 0x5c, 0xb6, 0xcf, 0x45, 0x68, 0x0d, 0xce, 0xa5, 0x55, 0x4c, 0x1f, 0x7b, 0x84,
+	0x93, 0xe6, 0x49, 0xe3, 0xcd, 0x94, 0xde, 0x87, 0xd8, 0x23, 0x6c, 0x33, 0x13, 0x4a, 0x03, 0x5a,
+	0x1e, 0x17, 0x9b, 0x99, 0x7f, 0xb0, 0x8d, 0xd7, 0xa9, 0x86, 0xf2, 0xd9, 0xbc, 0x8d, 0x97, 0x5f,
+	0x4d, 0x49, 0xd9, 0x76, 0x46, 0x2b, 0x7f, 0x53, 0xa0, 0xd4, 0x15, 0x38, 0xba, 0x07, 0x33, 0xe2,
+	0xae, 0x13, 0x28, 0xdb, 0xf5, 0x5a, 0x29, 0x5a, 0xac, 0x4e, 0x8d, 0x72, 0x3b, 0x29, 0x43, 0xf3,
+	0x30, 0xc5, 0x92, 0x63, 0x62, 0x8f, 0xc3, 0x14, 0xf9, 0x02, 0x26, 0xda, 0xe4, 0x12, 0x54, 0x81,
+	0x89, 0x30, 0xae, 0x47, 0x8e, 0x75, 0x10, 0x4a, 0x3e, 0xd8, 0xfe, 0x46, 0x73, 0x30, 0x19, 0x71,
+	0x10, 0x4f, 0x88, 0x48, 0xce, 0xa8, 0x31, 0xc1, 0x05, 0xf7, 0x08, 0x61, 0x83, 0x5e, 0x7b, 0x70,
+	0x5c, 0x0c, 0x7a, 0x72, 0x70, 0xe3, 0x27, 0x08, 0xc6, 0xf9, 0x4a, 0xa3, 0x1f, 0x29, 0x30, 0x91,
+	0xf4, 0xbe, 0x68, 0xad, 0xa8, 0x20, 0xb2, 0x0f, 0x08, 0x95, 0x95, 0x22, 0xdd, 0x93, 0x2f, 0x08,
+	0xea, 0xea, 0xf7, 0xff, 0xfe, 0xef, 0x9f, 0x8d, 0x5c, 0x41, 0x8b, 0x7a, 0x8f, 0xb7, 0x1f, 0xfd,
+	0xc8, 0xb1, 0x8f, 0xd1, 0x8f, 0x15, 0x98, 0x4a, 0x35, 0xf1, 0xc5, 0x80, 0xb2, 0xaf, 0x09, 0x95,
+	0xf5, 0x7e, 0x80, 0x52, 0xaf, 0x02, 0xea, 0x55, 0x8e, 0xa9, 0x8a, 0x2e, 0xf5, 0xc2, 0x84, 0x7e,
+	0xa8, 0x40, 0xa5, 0xb8, 0xe1, 0x45, 0x37, 0x87, 0xec, 0x8f, 0x05, 0xce, 0x77, 0x4f, 0xd5, 0x55,
+	0xa3, 0x3f, 0x29, 0x50, 0x2e, 0xea, 0x54, 0xd0, 0xc6, 0x50, 0x6d, 0x8d, 0xc0, 0x51, 0x3b, 0x45,
+	0x2b, 0xa4, 0xde, 0xe6, 0x79, 0xbb, 0xa9, 0xea, 0x7a, 0xee, 0x83, 0x98, 0xe9, 0x07, 0x36, 0x31,
+	0xa3, 0x40, 0xfc, 0xb7, 0x3a, 0x0e, 0x6e, 0x2b, 0x6b, 0xe8, 0x2f, 0x0a, 0x5c, 0xea, 0xd5, 0x34,
+	0xa0, 0x3b, 0x45, 0x2b, 0x38, 0x40, 0xcb, 0x53, 0x79, 0xef, 0x74, 0xc6, 0x32, 0xae, 0x25, 0x1e,
+	0xd7, 0x02, 0xaa, 0xea, 0x3d, 0x1f, 0x1f, 0xd1, 0x1f, 0x15, 0x98, 0xeb, 0xd1, 0x31, 0xa0, 0xdb,
+	0x45, 0x28, 0xfa, 0xf7, 0x3a, 0x95, 0x3b, 0xa7, 0xb2, 0x95, 0x01, 0x5c, 0xe3, 0x01, 0xcc, 0xa3,
+	0xcb, 0x3d, 0x5f, 0x64, 0xd1, 0x9f, 0x15, 0xf8, 0x62, 0x61, 0x1f, 0x82, 0xbe, 0x54, 0x84, 0xa0,
+	0x5f, 0x93, 0x53, 0xf9, 0xf2, 0x29, 0x2c, 0x25, 0x72, 0x8d, 0x23, 0x5f, 0x41, 0x4b, 0xfa, 0x40,
+	0xaf, 0xb8, 0xe8, 0x53, 0xf6, 0xae, 0xd1, 0xbb, 0x25, 0x41, 0xef, 0x17, 0xc1, 0x19, 0xac, 0xf7,
+	0xa9, 0x7c, 0xe5, 0xd4, 0xf6, 0x32, 0xa8, 0x5b, 0x3c, 0xa8, 0xeb, 0x48, 0xd3, 0x87, 0x7a, 0x6d,
+	0x46, 0xbf, 0x52, 0x60, 0x2a, 0xd5, 0x79, 0x14, 0x1f, 0x80, 0xd9, 0x96, 0xa7, 0xb2, 0x3e, 0x90,
+	0xee, 0x00, 0x00, 0xdb, 0xaf, 0xd3, 0xa6, 0xbb, 0xa1, 0x1f, 0xa5, 0x59, 0xfe, 0xf1, 0x09, 0x80,
+	0xb5, 0x41, 0x00, 0xd6, 0x86, 0x00, 0x58, 0x1b, 0x16, 0x60, 0xed, 0x24, 0xc0, 0xbf, 0x2a, 0x70,
+	0x2e, 0x43, 0xa6, 0xd1, 0xf5, 0xa2, 0xa9, 0x8b, 0x5a, 0x84, 0xca, 0x8d, 0x21, 0x2c, 0x24, 0xe4,
+	0x0f, 0x38, 0xe4, 0x2d, 0x74, 0x57, 0x1f, 0xe4, 0x39, 0x5f, 0x3f, 0xe2, 0x5d, 0xc7, 0xb1, 0x7e,
+	0x24, 0xda, 0x8c, 0x63, 0xfd, 0x48, 0xb6, 0x15, 0xc7, 0xe8, 0x0f, 0x0a, 0x5c, 0xcc, 0xa7, 0xdc,
+	0xe8, 0xdd, 0x61, 0x29, 0xba, 0x08, 0xe7, 0xd6, 0xe9, 0x98, 0xbd, 0xba, 0xc1, 0x63, 0x7a, 0x1b,
+	0xad, 0xe5, 0xc4, 0x64, 0xa7, 0x4c, 0xcd, 0x0e, 0xcb, 0x47, 0xbf, 0x53, 0x00, 0x65, 0x29, 0x24,
+	0xba, 0x31, 0x0c, 0xdd, 0x14, 0xa8, 0x37, 0x86, 0x67, 0xa8, 0x09, 0x62, 0x75, 0x39, 0x07, 0x71,
+	0x28, 0xcd, 0xcc, 0x26, 0xb3, 0x13, 0xe4, 0xed, 0xb6, 0xb2, 0xb6, 0xb5, 0xf7, 0xf1, 0xcb, 0xaa,
+	0xf2, 0xc9, 0xcb, 0xaa, 0xf2, 0xaf, 0x97, 0x55, 0xe5, 0xa7, 0xaf, 0xaa, 0x67, 0x3e, 0x79, 0x55,
+	0x3d, 0xf3, 0x8f, 0x57, 0xd5, 0x33, 0xdf, 0xbe, 0x35, 0x78, 0x93, 0xf0, 0x5c, 0xae, 0x34, 0x6b,
+	0x15, 0xea, 0x67, 0xb9, 0xb8, 0xf6, 0xdf, 0x01, 0x00, 0x00, 0xc9, 0xd9, 0x04, 0xb3, 0x1b, 0x00,
+	0x00,
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a ClobPair by id.
+	ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error)
+	// Queries a list of ClobPair items.
+	ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error)
+	// Returns whether a subaccount is liquidatable.
+	AreSubaccountsLiquidatable(ctx context.Context, in *AreSubaccountsLiquidatableRequest, opts ...grpc.CallOption) (*AreSubaccountsLiquidatableResponse, error)
+	// Runs the MEV node <> node calculation with the provided parameters.
+	MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error)
+	// Queries EquityTierLimitConfiguration.
+	EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error)
+	// Queries BlockRateLimitConfiguration.
+	BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error)
+	// Queries LiquidationsConfiguration.
+	LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error)
+	// Queries MakerUptimeRewardsConfiguration.
+	MakerUptimeRewardsConfiguration(ctx context.Context, in *QueryMakerUptimeRewardsConfigurationRequest, opts ...grpc.CallOption) (*QueryMakerUptimeRewardsConfigurationResponse, error)
+	// Queries the aggregated price levels of the node's in-memory orderbook.
+	OrderbookL2(ctx context.Context, in *QueryOrderbookL2Request, opts ...grpc.CallOption) (*QueryOrderbookL2Response, error)
+	// Queries the price levels and individual resting orders of the node's
+	// in-memory orderbook.
+	OrderbookL3(ctx context.Context, in *QueryOrderbookL3Request, opts ...grpc.CallOption) (*QueryOrderbookL3Response, error)
+	// Queries the trading permission of a grantee for a subaccount.
+	TradingPermission(ctx context.Context, in *QueryTradingPermissionRequest, opts ...grpc.CallOption) (*QueryTradingPermissionResponse, error)
+	// Queries the subaccounts with negative total net collateral reported by the
+	// node's liquidation daemon that are pending deleveraging.
+	DeleveragingCandidates(ctx context.Context, in *QueryDeleveragingCandidatesRequest, opts ...grpc.CallOption) (*QueryDeleveragingCandidatesResponse, error)
+	// Simulates placing a Short-Term order against the node's in-memory
+	// orderbook and current state without modifying either.
+	SimulatePlaceOrder(ctx context.Context, in *QuerySimulatePlaceOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePlaceOrderResponse, error)
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryBlockRateLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error) {
+	out := new(QueryClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPair", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBlockRateLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBlockRateLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error) {
+	out := new(QueryClobPairAllResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AreSubaccountsLiquidatable(ctx context.Context, in *AreSubaccountsLiquidatableRequest, opts ...grpc.CallOption) (*AreSubaccountsLiquidatableResponse, error) {
+	out := new(AreSubaccountsLiquidatableResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/AreSubaccountsLiquidatable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error) {
+	out := new(MevNodeToNodeCalculationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MevNodeToNodeCalculation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error) {
+	out := new(QueryEquityTierLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/EquityTierLimitConfiguration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error) {
+	out := new(QueryBlockRateLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/BlockRateLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error) {
+	out := new(QueryLiquidationsConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/LiquidationsConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MakerUptimeRewardsConfiguration(ctx context.Context, in *QueryMakerUptimeRewardsConfigurationRequest, opts ...grpc.CallOption) (*QueryMakerUptimeRewardsConfigurationResponse, error) {
+	out := new(QueryMakerUptimeRewardsConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MakerUptimeRewardsConfiguration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OrderbookL2(ctx context.Context, in *QueryOrderbookL2Request, opts ...grpc.CallOption) (*QueryOrderbookL2Response, error) {
+	out := new(QueryOrderbookL2Response)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/OrderbookL2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) OrderbookL3(ctx context.Context, in *QueryOrderbookL3Request, opts ...grpc.CallOption) (*QueryOrderbookL3Response, error) {
+	out := new(QueryOrderbookL3Response)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/OrderbookL3", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) TradingPermission(ctx context.Context, in *QueryTradingPermissionRequest, opts ...grpc.CallOption) (*QueryTradingPermissionResponse, error) {
+	out := new(QueryTradingPermissionResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/TradingPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryGetClobPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+
+func (c *queryClient) DeleveragingCandidates(ctx context.Context, in *QueryDeleveragingCandidatesRequest, opts ...grpc.CallOption) (*QueryDeleveragingCandidatesResponse, error) {
+	out := new(QueryDeleveragingCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/DeleveragingCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SimulatePlaceOrder(ctx context.Context, in *QuerySimulatePlaceOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePlaceOrderResponse, error) {
+	out := new(QuerySimulatePlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/SimulatePlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ClobPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllClobPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a ClobPair by id.
+	ClobPair(context.Context, *QueryGetClobPairRequest) (*QueryClobPairResponse, error)
+	// Queries a list of ClobPair items.
+	ClobPairAll(context.Context, *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error)
+	// Returns whether a subaccount is liquidatable.
+	AreSubaccountsLiquidatable(context.Context, *AreSubaccountsLiquidatableRequest) (*AreSubaccountsLiquidatableResponse, error)
+	// Runs the MEV node <> node calculation with the provided parameters.
+	MevNodeToNodeCalculation(context.Context, *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error)
+	// Queries EquityTierLimitConfiguration.
+	EquityTierLimitConfiguration(context.Context, *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error)
+	// Queries BlockRateLimitConfiguration.
+	BlockRateLimitConfiguration(context.Context, *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error)
+	// Queries LiquidationsConfiguration.
+	LiquidationsConfiguration(context.Context, *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error)
+	// Queries MakerUptimeRewardsConfiguration.
+	MakerUptimeRewardsConfiguration(context.Context, *QueryMakerUptimeRewardsConfigurationRequest) (*QueryMakerUptimeRewardsConfigurationResponse, error)
+	// Queries the aggregated price levels of the node's in-memory orderbook.
+	OrderbookL2(context.Context, *QueryOrderbookL2Request) (*QueryOrderbookL2Response, error)
+	// Queries the price levels and individual resting orders of the node's
+	// in-memory orderbook.
+	OrderbookL3(context.Context, *QueryOrderbookL3Request) (*QueryOrderbookL3Response, error)
+	// Queries the trading permission of a grantee for a subaccount.
+	TradingPermission(context.Context, *QueryTradingPermissionRequest) (*QueryTradingPermissionResponse, error)
+	// Queries the subaccounts with negative total net collateral reported by the
+	// node's liquidation daemon that are pending deleveraging.
+	DeleveragingCandidates(context.Context, *QueryDeleveragingCandidatesRequest) (*QueryDeleveragingCandidatesResponse, error)
+	// Simulates placing a Short-Term order against the node's in-memory
+	// orderbook and current state without modifying either.
+	SimulatePlaceOrder(context.Context, *QuerySimulatePlaceOrderRequest) (*QuerySimulatePlaceOrderResponse, error)
 }
 
-func (m *QueryClobPairAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClobPair) > 0 {
-		for _, e := range m.ClobPair {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *AreSubaccountsLiquidatableRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SubaccountIds) > 0 {
-		for _, e := range m.SubaccountIds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) ClobPair(ctx context.Context, req *QueryGetClobPairRequest) (*QueryClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPair not implemented")
+}
+func (*UnimplementedQueryServer) ClobPairAll(ctx context.Context, req *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPairAll not implemented")
+}
+func (*UnimplementedQueryServer) AreSubaccountsLiquidatable(ctx context.Context, req *AreSubaccountsLiquidatableRequest) (*AreSubaccountsLiquidatableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AreSubaccountsLiquidatable not implemented")
+}
+func (*UnimplementedQueryServer) MevNodeToNodeCalculation(ctx context.Context, req *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MevNodeToNodeCalculation not implemented")
+}
+func (*UnimplementedQueryServer) EquityTierLimitConfiguration(ctx context.Context, req *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquityTierLimitConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) BlockRateLimitConfiguration(ctx context.Context, req *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRateLimitConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) LiquidationsConfiguration(ctx context.Context, req *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidationsConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) MakerUptimeRewardsConfiguration(ctx context.Context, req *QueryMakerUptimeRewardsConfigurationRequest) (*QueryMakerUptimeRewardsConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakerUptimeRewardsConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) OrderbookL2(ctx context.Context, req *QueryOrderbookL2Request) (*QueryOrderbookL2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderbookL2 not implemented")
+}
+func (*UnimplementedQueryServer) OrderbookL3(ctx context.Context, req *QueryOrderbookL3Request) (*QueryOrderbookL3Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderbookL3 not implemented")
+}
+func (*UnimplementedQueryServer) TradingPermission(ctx context.Context, req *QueryTradingPermissionRequest) (*QueryTradingPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradingPermission not implemented")
+}
+func (*UnimplementedQueryServer) DeleveragingCandidates(ctx context.Context, req *QueryDeleveragingCandidatesRequest) (*QueryDeleveragingCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleveragingCandidates not implemented")
+}
+func (*UnimplementedQueryServer) SimulatePlaceOrder(ctx context.Context, req *QuerySimulatePlaceOrderRequest) (*QuerySimulatePlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePlaceOrder not implemented")
 }
 
-func (m *AreSubaccountsLiquidatableResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *AreSubaccountsLiquidatableResponse_Result) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetClobPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.SubaccountId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.IsLiquidatable {
-		n += 2
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPair(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPair(ctx, req.(*QueryGetClobPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClobPairAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClobPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BlockProposerMatches != nil {
-		l = m.BlockProposerMatches.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPairAll(ctx, in)
 	}
-	if m.ValidatorMevMetrics != nil {
-		l = m.ValidatorMevMetrics.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPairAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPairAll(ctx, req.(*QueryAllClobPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AreSubaccountsLiquidatable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AreSubaccountsLiquidatableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AreSubaccountsLiquidatable(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/AreSubaccountsLiquidatable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AreSubaccountsLiquidatable(ctx, req.(*AreSubaccountsLiquidatableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MevNodeToNodeCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MevNodeToNodeCalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ClobPairId != 0 {
-		n += 1 + sovQuery(uint64(m.ClobPairId))
+	if interceptor == nil {
+		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, in)
 	}
-	if m.Mev != 0 {
-		n += 5
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/MevNodeToNodeCalculation",
 	}
-	if m.Volume != 0 {
-		n += 1 + sovQuery(uint64(m.Volume))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, req.(*MevNodeToNodeCalculationRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquityTierLimitConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/EquityTierLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, req.(*QueryEquityTierLimitConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRateLimitConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EquityTierLimitConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/BlockRateLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, req.(*QueryBlockRateLimitConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlockRateLimitConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LiquidationsConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidationsConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidationsConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/LiquidationsConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidationsConfiguration(ctx, req.(*QueryLiquidationsConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MakerUptimeRewardsConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMakerUptimeRewardsConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BlockRateLimitConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).MakerUptimeRewardsConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/MakerUptimeRewardsConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MakerUptimeRewardsConfiguration(ctx, req.(*QueryMakerUptimeRewardsConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLiquidationsConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderbookL2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderbookL2Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).OrderbookL2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/OrderbookL2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderbookL2(ctx, req.(*QueryOrderbookL2Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLiquidationsConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderbookL3_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderbookL3Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.LiquidationsConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).OrderbookL3(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/OrderbookL3",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderbookL3(ctx, req.(*QueryOrderbookL3Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Query_TradingPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradingPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradingPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/TradingPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradingPermission(ctx, req.(*QueryTradingPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Query_DeleveragingCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeleveragingCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeleveragingCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/DeleveragingCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeleveragingCandidates(ctx, req.(*QueryDeleveragingCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulatePlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/SimulatePlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePlaceOrder(ctx, req.(*QuerySimulatePlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.clob.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClobPair",
+			Handler:    _Query_ClobPair_Handler,
+		},
+		{
+			MethodName: "ClobPairAll",
+			Handler:    _Query_ClobPairAll_Handler,
+		},
+		{
+			MethodName: "AreSubaccountsLiquidatable",
+			Handler:    _Query_AreSubaccountsLiquidatable_Handler,
+		},
+		{
+			MethodName: "MevNodeToNodeCalculation",
+			Handler:    _Query_MevNodeToNodeCalculation_Handler,
+		},
+		{
+			MethodName: "EquityTierLimitConfiguration",
+			Handler:    _Query_EquityTierLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "BlockRateLimitConfiguration",
+			Handler:    _Query_BlockRateLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "LiquidationsConfiguration",
+			Handler:    _Query_LiquidationsConfiguration_Handler,
+		},
+		{
+			MethodName: "MakerUptimeRewardsConfiguration",
+			Handler:    _Query_MakerUptimeRewardsConfiguration_Handler,
+		},
+		{
+			MethodName: "OrderbookL2",
+			Handler:    _Query_OrderbookL2_Handler,
+		},
+		{
+			MethodName: "OrderbookL3",
+			Handler:    _Query_OrderbookL3_Handler,
+		},
+		{
+			MethodName: "TradingPermission",
+			Handler:    _Query_TradingPermission_Handler,
+		},
+		{
+			MethodName: "DeleveragingCandidates",
+			Handler:    _Query_DeleveragingCandidates_Handler,
+		},
+		{
+			MethodName: "SimulatePlaceOrder",
+			Handler:    _Query_SimulatePlaceOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/clob/query.proto",
+}
+
+func (m *QueryGetClobPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClobPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClobPairAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClobPair) > 0 {
+		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AreSubaccountsLiquidatableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AreSubaccountsLiquidatableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AreSubaccountsLiquidatableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AreSubaccountsLiquidatableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AreSubaccountsLiquidatableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AreSubaccountsLiquidatableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AreSubaccountsLiquidatableResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AreSubaccountsLiquidatableResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AreSubaccountsLiquidatableResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaintenanceMargin.Size()
+		i -= size
+		if _, err := m.MaintenanceMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.IsLiquidatable {
+		i--
+		if m.IsLiquidatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorMevMetrics != nil {
+		{
+			size, err := m.ValidatorMevMetrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockProposerMatches != nil {
+		{
+			size, err := m.BlockProposerMatches.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Volume != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mev != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mev))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationsConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationsConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationsConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationsConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationsConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationsConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMakerUptimeRewardsConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMakerUptimeRewardsConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMakerUptimeRewardsConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMakerUptimeRewardsConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMakerUptimeRewardsConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMakerUptimeRewardsConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MakerUptimeRewardsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderbookL2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderbookL2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderbookL2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderbookL2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderbookL2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderbookL2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderbookL3Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderbookL3Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderbookL3Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderbookL3Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderbookL3Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderbookL3Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradingPermissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradingPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradingPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradingPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradingPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradingPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Permission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeleveragingCandidatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeleveragingCandidatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeleveragingCandidatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeleveragingCandidatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeleveragingCandidatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeleveragingCandidatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerpetualIds) > 0 {
+		dAtA13 := make([]byte, len(m.PerpetualIds)*10)
+		var j12 int
+		for _, num := range m.PerpetualIds {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePlaceOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePlaceOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePlaceOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePlaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Subaccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OrderStatusName) > 0 {
+		i -= len(m.OrderStatusName)
+		copy(dAtA[i:], m.OrderStatusName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderStatusName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FilledQuantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FilledQuantums))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MakerFee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MakerFee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TakerFee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TakerFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Subticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Subticks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FillAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FillAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MakerOrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetClobPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClobPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllClobPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClobPairAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClobPair) > 0 {
+		for _, e := range m.ClobPair {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AreSubaccountsLiquidatableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for _, e := range m.SubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AreSubaccountsLiquidatableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AreSubaccountsLiquidatableResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsLiquidatable {
+		n += 2
+	}
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MevNodeToNodeCalculationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockProposerMatches != nil {
+		l = m.BlockProposerMatches.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValidatorMevMetrics != nil {
+		l = m.ValidatorMevMetrics.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.Mev != 0 {
+		n += 5
+	}
+	if m.Volume != 0 {
+		n += 1 + sovQuery(uint64(m.Volume))
+	}
+	return n
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EquityTierLimitConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockRateLimitConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidationsConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidationsConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidationsConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMakerUptimeRewardsConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMakerUptimeRewardsConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerUptimeRewardsConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderbookL2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryOrderbookL2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOrderbookL3Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryOrderbookL3Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTradingPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradingPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Permission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeleveragingCandidatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeleveragingCandidatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PerpetualIds) > 0 {
+		l = 0
+		for _, e := range m.PerpetualIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QuerySimulatePlaceOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulatePlaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FilledQuantums != 0 {
+		n += 1 + sovQuery(uint64(m.FilledQuantums))
+	}
+	if m.OrderStatus != 0 {
+		n += 1 + sovQuery(uint64(m.OrderStatus))
+	}
+	l = len(m.OrderStatusName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Subaccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerOrderId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FillAmount != 0 {
+		n += 1 + sovQuery(uint64(m.FillAmount))
+	}
+	if m.Subticks != 0 {
+		n += 1 + sovQuery(uint64(m.Subticks))
+	}
+	if m.TakerFee != 0 {
+		n += 1 + sovQuery(uint64(m.TakerFee))
+	}
+	if m.MakerFee != 0 {
+		n += 1 + sovQuery(uint64(m.MakerFee))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetClobPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClobPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClobPair = append(m.ClobPair, ClobPair{})
+			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AreSubaccountsLiquidatableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AreSubaccountsLiquidatableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountIds = append(m.SubaccountIds, types.SubaccountId{})
+			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AreSubaccountsLiquidatableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AreSubaccountsLiquidatableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, AreSubaccountsLiquidatableResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidatable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiquidatable = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerMatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockProposerMatches == nil {
+				m.BlockProposerMatches = &ValidatorMevMatches{}
+			}
+			if err := m.BlockProposerMatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMevMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorMevMetrics == nil {
+				m.ValidatorMevMetrics = &MevNodeToNodeMetrics{}
+			}
+			if err := m.ValidatorMevMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MevNodeToNodeCalculationResponse_MevAndVolumePerClob{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevAndVolumePerClob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevAndVolumePerClob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mev", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Mev = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2009,17 +5157,17 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetClobPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRateLimitConfig", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2029,11 +5177,25 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRateLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2055,7 +5217,7 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2078,15 +5240,65 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClobPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2113,7 +5325,7 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2138,7 +5350,7 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMakerUptimeRewardsConfigurationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2161,15 +5373,65 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllClobPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMakerUptimeRewardsConfigurationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMakerUptimeRewardsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMakerUptimeRewardsConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMakerUptimeRewardsConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMakerUptimeRewardsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerUptimeRewardsConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2196,10 +5458,7 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerUptimeRewardsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2224,7 +5483,7 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderbookL2Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2247,17 +5506,17 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClobPairAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderbookL2Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClobPairAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderbookL2Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
 			}
-			var msglen int
+			m.ClobPairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2267,31 +5526,16 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClobPairId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClobPair = append(m.ClobPair, ClobPair{})
-			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var msglen int
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2301,28 +5545,11 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Depth |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2344,7 +5571,7 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderbookL2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2367,15 +5594,34 @@ func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AreSubaccountsLiquidatableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderbookL2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AreSubaccountsLiquidatableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderbookL2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2402,8 +5648,42 @@ func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountIds = append(m.SubaccountIds, types.SubaccountId{})
-			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, OrderbookLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, OrderbookLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2428,7 +5708,7 @@ func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderbookL3Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2451,17 +5731,17 @@ func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AreSubaccountsLiquidatableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderbookL3Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AreSubaccountsLiquidatableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderbookL3Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
 			}
-			var msglen int
+			m.ClobPairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2471,26 +5751,30 @@ func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClobPairId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			m.Results = append(m.Results, AreSubaccountsLiquidatableResponse_Result{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2512,7 +5796,7 @@ func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderbookL3Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,15 +5819,34 @@ func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderbookL3Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderbookL3Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2570,15 +5873,16 @@ func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, OrderbookLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidatable", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2588,12 +5892,26 @@ func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLiquidatable = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, OrderbookLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2615,7 +5933,7 @@ func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTradingPermissionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2638,17 +5956,17 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradingPermissionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradingPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerMatches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2658,33 +5976,48 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockProposerMatches == nil {
-				m.BlockProposerMatches = &ValidatorMevMatches{}
-			}
-			if err := m.BlockProposerMatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMevMetrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2694,27 +6027,23 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidatorMevMetrics == nil {
-				m.ValidatorMevMetrics = &MevNodeToNodeMetrics{}
-			}
-			if err := m.ValidatorMevMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2737,7 +6066,7 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTradingPermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2760,15 +6089,15 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradingPermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradingPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2795,8 +6124,7 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, MevNodeToNodeCalculationResponse_MevAndVolumePerClob{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Permission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2821,7 +6149,7 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []byte) error {
+func (m *QueryDeleveragingCandidatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2844,61 +6172,12 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevAndVolumePerClob: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeleveragingCandidatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevAndVolumePerClob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeleveragingCandidatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
-			}
-			m.ClobPairId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClobPairId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mev", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Mev = float32(math.Float32frombits(v))
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
-			}
-			m.Volume = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2920,7 +6199,7 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 	}
 	return nil
 }
-func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeleveragingCandidatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2943,12 +6222,46 @@ func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeleveragingCandidatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeleveragingCandidatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, QueryDeleveragingCandidatesResponse_DeleveragingCandidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2970,7 +6283,7 @@ func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeleveragingCandidatesResponse_DeleveragingCandidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2993,15 +6306,15 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleveragingCandidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleveragingCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3028,10 +6341,119 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PerpetualIds = append(m.PerpetualIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PerpetualIds) == 0 {
+					m.PerpetualIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PerpetualIds = append(m.PerpetualIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3053,7 +6475,7 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePlaceOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3076,12 +6498,45 @@ func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePlaceOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePlaceOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3103,7 +6558,7 @@ func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePlaceOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3126,15 +6581,15 @@ func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePlaceOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRateLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3161,60 +6616,146 @@ func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRateLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fills = append(m.Fills, QuerySimulatePlaceOrderResponse_SimulatedFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledQuantums", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.FilledQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderStatus", wireType)
+			}
+			m.OrderStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderStatus |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderStatusName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.OrderStatusName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3236,7 +6777,7 @@ func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePlaceOrderResponse_SimulatedFill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3259,15 +6800,15 @@ func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedFill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedFill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3294,10 +6835,86 @@ func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
+			}
+			m.FillAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subticks", wireType)
+			}
+			m.Subticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Subticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			m.TakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			m.MakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
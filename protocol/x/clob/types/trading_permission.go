@@ -0,0 +1,113 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// Validate performs stateless validation on the trading permission. It returns an error if the
+// subaccount ID or grantee is invalid, if the grantee is the owner of the subaccount, or if the
+// clob pair IDs contain duplicates.
+func (p *TradingPermission) Validate() error {
+	if err := p.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	if p.Grantee == "" {
+		return errorsmod.Wrap(ErrInvalidGrantee, "grantee cannot be empty")
+	}
+
+	if err := validateGrantee(p.SubaccountId.Owner, p.Grantee); err != nil {
+		return err
+	}
+
+	if lib.ContainsDuplicates(p.ClobPairIds) {
+		return errorsmod.Wrapf(
+			ErrInvalidTradingPermission,
+			"clob pair ids cannot contain duplicates: %v",
+			p.ClobPairIds,
+		)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the permission has an expiration time and `blockTime` is at or after it.
+func (p *TradingPermission) IsExpired(blockTime time.Time) bool {
+	return p.ExpirationTime != 0 && blockTime.Unix() >= int64(p.ExpirationTime)
+}
+
+// ValidateClobPair returns an error if the permission does not allow placing or canceling orders
+// on the clob pair `clobPairId`.
+func (p *TradingPermission) ValidateClobPair(clobPairId ClobPairId) error {
+	if len(p.ClobPairIds) == 0 || slices.Contains(p.ClobPairIds, clobPairId.ToUint32()) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		ErrTradingPermissionClobPairNotAllowed,
+		"grantee %s, clob pair %d, allowed clob pairs %v",
+		p.Grantee,
+		clobPairId,
+		p.ClobPairIds,
+	)
+}
+
+// ValidateOrder returns an error if the permission does not allow placing `order`, either because
+// the order is for a clob pair the permission does not cover, or because the size of the order
+// exceeds the maximum order size of the permission.
+func (p *TradingPermission) ValidateOrder(order Order) error {
+	if err := p.ValidateClobPair(order.GetClobPairId()); err != nil {
+		return err
+	}
+
+	if p.MaxOrderQuantums != 0 && order.Quantums > p.MaxOrderQuantums {
+		return errorsmod.Wrapf(
+			ErrTradingPermissionOrderSizeExceeded,
+			"grantee %s, order quantums %d, max order quantums %d",
+			p.Grantee,
+			order.Quantums,
+			p.MaxOrderQuantums,
+		)
+	}
+
+	return nil
+}
+
+// getTraderSigners returns the signers of a message that places or cancels orders for a subaccount
+// owned by `owner`. The message must be signed by `grantee` if it is set, and by the owner otherwise.
+// Returns no signers if the signer is not a valid address, which is rejected by `ValidateBasic`.
+func getTraderSigners(owner string, grantee string) []sdk.AccAddress {
+	signer := owner
+	if grantee != "" {
+		signer = grantee
+	}
+
+	address, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{address}
+}
+
+// validateGrantee returns an error if `grantee` is set and is either not a valid address or is the
+// owner of the subaccount.
+func validateGrantee(owner string, grantee string) error {
+	if grantee == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(grantee); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGrantee, "invalid grantee address (%s): %v", grantee, err)
+	}
+
+	if grantee == owner {
+		return errorsmod.Wrapf(ErrInvalidGrantee, "grantee cannot be the subaccount owner (%s)", owner)
+	}
+
+	return nil
+}
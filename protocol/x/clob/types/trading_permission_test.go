@@ -0,0 +1,151 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradingPermission_Validate(t *testing.T) {
+	tests := map[string]struct {
+		permission types.TradingPermission
+		err        error
+	}{
+		"invalid subaccount owner": {
+			permission: types.TradingPermission{
+				SubaccountId: satypes.SubaccountId{Owner: "invalid_owner"},
+				Grantee:      constants.BobAccAddress.String(),
+			},
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"empty grantee": {
+			permission: types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+			},
+			err: types.ErrInvalidGrantee,
+		},
+		"invalid grantee": {
+			permission: types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      "invalid_grantee",
+			},
+			err: types.ErrInvalidGrantee,
+		},
+		"grantee is the subaccount owner": {
+			permission: types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      constants.Alice_Num0.Owner,
+			},
+			err: types.ErrInvalidGrantee,
+		},
+		"duplicate clob pair ids": {
+			permission: types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      constants.BobAccAddress.String(),
+				ClobPairIds:  []uint32{0, 1, 0},
+			},
+			err: types.ErrInvalidTradingPermission,
+		},
+		"valid: all clob pairs": {
+			permission: types.TradingPermission{
+				SubaccountId: constants.Alice_Num0,
+				Grantee:      constants.BobAccAddress.String(),
+			},
+		},
+		"valid: restricted": {
+			permission: types.TradingPermission{
+				SubaccountId:     constants.Alice_Num0,
+				Grantee:          constants.BobAccAddress.String(),
+				ClobPairIds:      []uint32{0, 1},
+				MaxOrderQuantums: 100,
+				ExpirationTime:   100,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.permission.Validate()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTradingPermission_IsExpired(t *testing.T) {
+	permission := types.TradingPermission{}
+	require.False(t, permission.IsExpired(time.Unix(1_000_000, 0)))
+
+	permission.ExpirationTime = 100
+	require.False(t, permission.IsExpired(time.Unix(99, 0)))
+	require.True(t, permission.IsExpired(time.Unix(100, 0)))
+	require.True(t, permission.IsExpired(time.Unix(101, 0)))
+}
+
+func TestTradingPermission_ValidateOrder(t *testing.T) {
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+
+	tests := map[string]struct {
+		permission types.TradingPermission
+		err        error
+	}{
+		"all clob pairs, no max order size": {
+			permission: types.TradingPermission{},
+		},
+		"clob pair allowed": {
+			permission: types.TradingPermission{ClobPairIds: []uint32{1, 0}},
+		},
+		"clob pair not allowed": {
+			permission: types.TradingPermission{ClobPairIds: []uint32{1}},
+			err:        types.ErrTradingPermissionClobPairNotAllowed,
+		},
+		"order size equal to max order size": {
+			permission: types.TradingPermission{MaxOrderQuantums: order.Quantums},
+		},
+		"order size exceeds max order size": {
+			permission: types.TradingPermission{MaxOrderQuantums: order.Quantums - 1},
+			err:        types.ErrTradingPermissionOrderSizeExceeded,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.permission.ValidateOrder(order)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetSigners_Grantee(t *testing.T) {
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+
+	msg := types.NewMsgPlaceOrder(order)
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+
+	msg.Grantee = constants.BobAccAddress.String()
+	require.Equal(t, []sdk.AccAddress{constants.BobAccAddress}, msg.GetSigners())
+}
+
+func TestGetSigners_InvalidAddress(t *testing.T) {
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	order.OrderId.SubaccountId.Owner = "invalid"
+
+	msg := types.NewMsgPlaceOrder(order)
+	require.Empty(t, msg.GetSigners())
+
+	msg = types.NewMsgPlaceOrder(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15)
+	msg.Grantee = "invalid"
+	require.Empty(t, msg.GetSigners())
+}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -128,6 +129,10 @@ var xxx_messageInfo_MsgCreateClobPairResponse proto.InternalMessageInfo
 type MsgProposedOperations struct {
 	// The list of operations proposed by the block proposer.
 	OperationsQueue []OperationRaw `protobuf:"bytes,1,rep,name=operations_queue,json=operationsQueue,proto3" json:"operations_queue"`
+	// The maker depth samples taken by the block proposer from its orderbook.
+	// Only set in blocks whose height is a multiple of the sample interval of
+	// the maker uptime rewards config.
+	MakerDepthSamples []MakerDepthSample `protobuf:"bytes,2,rep,name=maker_depth_samples,json=makerDepthSamples,proto3" json:"maker_depth_samples"`
 }
 
 func (m *MsgProposedOperations) Reset()         { *m = MsgProposedOperations{} }
@@ -170,6 +175,13 @@ func (m *MsgProposedOperations) GetOperationsQueue() []OperationRaw {
 	return nil
 }
 
+func (m *MsgProposedOperations) GetMakerDepthSamples() []MakerDepthSample {
+	if m != nil {
+		return m.MakerDepthSamples
+	}
+	return nil
+}
+
 // MsgProposedOperationsResponse is the response type of the message injected
 // by block proposers to specify the operations that occurred in a block.
 type MsgProposedOperationsResponse struct {
@@ -211,6 +223,10 @@ var xxx_messageInfo_MsgProposedOperationsResponse proto.InternalMessageInfo
 // MsgPlaceOrder is a request type used for placing orders.
 type MsgPlaceOrder struct {
 	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// The address placing the order on behalf of the subaccount owner. If set,
+	// the message must be signed by the grantee instead of the subaccount owner
+	// and the grantee must have a valid `TradingPermission` for the subaccount.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
 func (m *MsgPlaceOrder) Reset()         { *m = MsgPlaceOrder{} }
@@ -253,6 +269,13 @@ func (m *MsgPlaceOrder) GetOrder() Order {
 	return Order{}
 }
 
+func (m *MsgPlaceOrder) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
 // MsgPlaceOrderResponse is a response type used for placing orders.
 type MsgPlaceOrderResponse struct {
 }
@@ -296,9 +319,14 @@ type MsgCancelOrder struct {
 	// Information about when the order cancellation expires.
 	//
 	// Types that are valid to be assigned to GoodTilOneof:
+	//
 	//	*MsgCancelOrder_GoodTilBlock
 	//	*MsgCancelOrder_GoodTilBlockTime
 	GoodTilOneof isMsgCancelOrder_GoodTilOneof `protobuf_oneof:"good_til_oneof"`
+	// The address canceling the order on behalf of the subaccount owner. If set,
+	// the message must be signed by the grantee instead of the subaccount owner
+	// and the grantee must have a valid `TradingPermission` for the subaccount.
+	Grantee string `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
 func (m *MsgCancelOrder) Reset()         { *m = MsgCancelOrder{} }
@@ -378,6 +406,13 @@ func (m *MsgCancelOrder) GetGoodTilBlockTime() uint32 {
 	return 0
 }
 
+func (m *MsgCancelOrder) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgCancelOrder) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -423,26 +458,38 @@ func (m *MsgCancelOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrderResponse proto.InternalMessageInfo
 
-// MsgUpdateClobPair is a request type used for updating a ClobPair in state.
-type MsgUpdateClobPair struct {
-	// Authority is the address that may send this message.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// `clob_pair` is the ClobPair to write to state.
-	ClobPair ClobPair `protobuf:"bytes,2,opt,name=clob_pair,json=clobPair,proto3" json:"clob_pair"`
-}
-
-func (m *MsgUpdateClobPair) Reset()         { *m = MsgUpdateClobPair{} }
-func (m *MsgUpdateClobPair) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateClobPair) ProtoMessage()    {}
-func (*MsgUpdateClobPair) Descriptor() ([]byte, []int) {
+// MsgReplaceOrder is a request type used for atomically canceling an existing
+// order and placing a new order. The existing order is only canceled if the new
+// order is successfully placed.
+//
+// If the new order has the same order ID as the existing order and only
+// reduces its size, the new order retains the queue priority of the existing
+// order.
+type MsgReplaceOrder struct {
+	// The ID of the existing order to cancel. The existing order must belong to
+	// the same subaccount and clob pair as the new order, and must be of the
+	// same order type (Short-Term or Long-Term).
+	OldOrderId OrderId `protobuf:"bytes,1,opt,name=old_order_id,json=oldOrderId,proto3" json:"old_order_id"`
+	// The new order to place.
+	NewOrder Order `protobuf:"bytes,2,opt,name=new_order,json=newOrder,proto3" json:"new_order"`
+	// The address replacing the order on behalf of the subaccount owner. If set,
+	// the message must be signed by the grantee instead of the subaccount owner
+	// and the grantee must have a valid `TradingPermission` for the subaccount.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *MsgReplaceOrder) Reset()         { *m = MsgReplaceOrder{} }
+func (m *MsgReplaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrder) ProtoMessage()    {}
+func (*MsgReplaceOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{8}
 }
-func (m *MsgUpdateClobPair) XXX_Unmarshal(b []byte) error {
+func (m *MsgReplaceOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReplaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateClobPair.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReplaceOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -452,49 +499,55 @@ func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateClobPair) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateClobPair.Merge(m, src)
+func (m *MsgReplaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrder.Merge(m, src)
 }
-func (m *MsgUpdateClobPair) XXX_Size() int {
+func (m *MsgReplaceOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateClobPair) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateClobPair.DiscardUnknown(m)
+func (m *MsgReplaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateClobPair proto.InternalMessageInfo
+var xxx_messageInfo_MsgReplaceOrder proto.InternalMessageInfo
 
-func (m *MsgUpdateClobPair) GetAuthority() string {
+func (m *MsgReplaceOrder) GetOldOrderId() OrderId {
 	if m != nil {
-		return m.Authority
+		return m.OldOrderId
 	}
-	return ""
+	return OrderId{}
 }
 
-func (m *MsgUpdateClobPair) GetClobPair() ClobPair {
+func (m *MsgReplaceOrder) GetNewOrder() Order {
 	if m != nil {
-		return m.ClobPair
+		return m.NewOrder
 	}
-	return ClobPair{}
+	return Order{}
 }
 
-// MsgUpdateClobPairResponse is a response type used for setting a ClobPair's
-// status.
-type MsgUpdateClobPairResponse struct {
+func (m *MsgReplaceOrder) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
 
-func (m *MsgUpdateClobPairResponse) Reset()         { *m = MsgUpdateClobPairResponse{} }
-func (m *MsgUpdateClobPairResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateClobPairResponse) ProtoMessage()    {}
-func (*MsgUpdateClobPairResponse) Descriptor() ([]byte, []int) {
+// MsgReplaceOrderResponse is a response type used for replacing orders.
+type MsgReplaceOrderResponse struct {
+}
+
+func (m *MsgReplaceOrderResponse) Reset()         { *m = MsgReplaceOrderResponse{} }
+func (m *MsgReplaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrderResponse) ProtoMessage()    {}
+func (*MsgReplaceOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{9}
 }
-func (m *MsgUpdateClobPairResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgReplaceOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReplaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateClobPairResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReplaceOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -504,44 +557,43 @@ func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateClobPairResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateClobPairResponse.Merge(m, src)
+func (m *MsgReplaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrderResponse.Merge(m, src)
 }
-func (m *MsgUpdateClobPairResponse) XXX_Size() int {
+func (m *MsgReplaceOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateClobPairResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateClobPairResponse.DiscardUnknown(m)
+func (m *MsgReplaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateClobPairResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgReplaceOrderResponse proto.InternalMessageInfo
 
-// OperationRaw represents an operation in the proposed operations.
-// Note that the `order_placement` operation is a signed message.
-type OperationRaw struct {
-	// operationRaw represents an operation that occurred, which can be a match,
-	// a signed order placement, or an order removal.
-	//
-	// Types that are valid to be assigned to Operation:
-	//
-	//	*OperationRaw_Match
-	//	*OperationRaw_ShortTermOrderPlacement
-	//	*OperationRaw_OrderRemoval
-	Operation isOperationRaw_Operation `protobuf_oneof:"operation"`
+// MsgBatchPlaceOrders is a request type used for placing multiple Short-Term
+// orders in a single transaction. Each order is placed independently of the
+// other orders in the batch.
+type MsgBatchPlaceOrders struct {
+	// The Short-Term orders to place. All orders must belong to the same
+	// subaccount and have distinct order IDs.
+	Orders []Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	// The address placing the orders on behalf of the subaccount owner. If set,
+	// the message must be signed by the grantee instead of the subaccount owner
+	// and the grantee must have a valid `TradingPermission` for the subaccount.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (m *OperationRaw) Reset()         { *m = OperationRaw{} }
-func (m *OperationRaw) String() string { return proto.CompactTextString(m) }
-func (*OperationRaw) ProtoMessage()    {}
-func (*OperationRaw) Descriptor() ([]byte, []int) {
+func (m *MsgBatchPlaceOrders) Reset()         { *m = MsgBatchPlaceOrders{} }
+func (m *MsgBatchPlaceOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchPlaceOrders) ProtoMessage()    {}
+func (*MsgBatchPlaceOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{10}
 }
-func (m *OperationRaw) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchPlaceOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchPlaceOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OperationRaw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchPlaceOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -551,96 +603,49 @@ func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *OperationRaw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperationRaw.Merge(m, src)
+func (m *MsgBatchPlaceOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchPlaceOrders.Merge(m, src)
 }
-func (m *OperationRaw) XXX_Size() int {
+func (m *MsgBatchPlaceOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *OperationRaw) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperationRaw.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_OperationRaw proto.InternalMessageInfo
-
-type isOperationRaw_Operation interface {
-	isOperationRaw_Operation()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type OperationRaw_Match struct {
-	Match *ClobMatch `protobuf:"bytes,1,opt,name=match,proto3,oneof" json:"match,omitempty"`
-}
-type OperationRaw_ShortTermOrderPlacement struct {
-	ShortTermOrderPlacement []byte `protobuf:"bytes,2,opt,name=short_term_order_placement,json=shortTermOrderPlacement,proto3,oneof" json:"short_term_order_placement,omitempty"`
-}
-type OperationRaw_OrderRemoval struct {
-	OrderRemoval *OrderRemoval `protobuf:"bytes,3,opt,name=order_removal,json=orderRemoval,proto3,oneof" json:"order_removal,omitempty"`
+func (m *MsgBatchPlaceOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchPlaceOrders.DiscardUnknown(m)
 }
 
-func (*OperationRaw_Match) isOperationRaw_Operation()                   {}
-func (*OperationRaw_ShortTermOrderPlacement) isOperationRaw_Operation() {}
-func (*OperationRaw_OrderRemoval) isOperationRaw_Operation()            {}
+var xxx_messageInfo_MsgBatchPlaceOrders proto.InternalMessageInfo
 
-func (m *OperationRaw) GetOperation() isOperationRaw_Operation {
+func (m *MsgBatchPlaceOrders) GetOrders() []Order {
 	if m != nil {
-		return m.Operation
-	}
-	return nil
-}
-
-func (m *OperationRaw) GetMatch() *ClobMatch {
-	if x, ok := m.GetOperation().(*OperationRaw_Match); ok {
-		return x.Match
-	}
-	return nil
-}
-
-func (m *OperationRaw) GetShortTermOrderPlacement() []byte {
-	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderPlacement); ok {
-		return x.ShortTermOrderPlacement
-	}
-	return nil
-}
-
-func (m *OperationRaw) GetOrderRemoval() *OrderRemoval {
-	if x, ok := m.GetOperation().(*OperationRaw_OrderRemoval); ok {
-		return x.OrderRemoval
+		return m.Orders
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*OperationRaw) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*OperationRaw_Match)(nil),
-		(*OperationRaw_ShortTermOrderPlacement)(nil),
-		(*OperationRaw_OrderRemoval)(nil),
+func (m *MsgBatchPlaceOrders) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
+	return ""
 }
 
-// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
-// request type.
-type MsgUpdateEquityTierLimitConfiguration struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the equity tier limit configuration to update to. All fields must
-	// be set.
-	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,2,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
+// MsgBatchPlaceOrdersResponse is a response type used for placing multiple
+// Short-Term orders.
+type MsgBatchPlaceOrdersResponse struct {
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) Reset()         { *m = MsgUpdateEquityTierLimitConfiguration{} }
-func (m *MsgUpdateEquityTierLimitConfiguration) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateEquityTierLimitConfiguration) ProtoMessage()    {}
-func (*MsgUpdateEquityTierLimitConfiguration) Descriptor() ([]byte, []int) {
+func (m *MsgBatchPlaceOrdersResponse) Reset()         { *m = MsgBatchPlaceOrdersResponse{} }
+func (m *MsgBatchPlaceOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchPlaceOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchPlaceOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{11}
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchPlaceOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchPlaceOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchPlaceOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -650,53 +655,41 @@ func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Merge(m, src)
+func (m *MsgBatchPlaceOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchPlaceOrdersResponse.Merge(m, src)
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Size() int {
+func (m *MsgBatchPlaceOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration proto.InternalMessageInfo
-
-func (m *MsgUpdateEquityTierLimitConfiguration) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
+func (m *MsgBatchPlaceOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchPlaceOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
-	if m != nil {
-		return m.EquityTierLimitConfig
-	}
-	return EquityTierLimitConfiguration{}
-}
+var xxx_messageInfo_MsgBatchPlaceOrdersResponse proto.InternalMessageInfo
 
-// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
-// response type.
-type MsgUpdateEquityTierLimitConfigurationResponse struct {
+// OrderBatch represents a batch of Short-Term orders on a single clob pair.
+type OrderBatch struct {
+	// The clob pair ID of all orders in the batch.
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The client IDs of the orders in the batch.
+	// Note that this is a `uint32` rather than a `fixed32` like `OrderId.client_id`
+	// since packed repeated `fixed32` fields are rejected by the Cosmos SDK's
+	// unknown field validation.
+	ClientIds []uint32 `protobuf:"varint,2,rep,packed,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Reset() {
-	*m = MsgUpdateEquityTierLimitConfigurationResponse{}
-}
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgUpdateEquityTierLimitConfigurationResponse) ProtoMessage() {}
-func (*MsgUpdateEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+func (m *OrderBatch) Reset()         { *m = OrderBatch{} }
+func (m *OrderBatch) String() string { return proto.CompactTextString(m) }
+func (*OrderBatch) ProtoMessage()    {}
+func (*OrderBatch) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{12}
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+func (m *OrderBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OrderBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OrderBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -706,39 +699,60 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, de
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Merge(m, src)
+func (m *OrderBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBatch.Merge(m, src)
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Size() int {
+func (m *OrderBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.DiscardUnknown(m)
+func (m *OrderBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_OrderBatch proto.InternalMessageInfo
 
-// MsgUpdateBlockRateLimitConfiguration is the Msg/BlockRateLimitConfiguration
-// request type.
-type MsgUpdateBlockRateLimitConfiguration struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the block rate limit configuration to update to. All fields must be
-	// set.
-	BlockRateLimitConfig BlockRateLimitConfiguration `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
+func (m *OrderBatch) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
+	}
+	return 0
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Reset()         { *m = MsgUpdateBlockRateLimitConfiguration{} }
-func (m *MsgUpdateBlockRateLimitConfiguration) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateBlockRateLimitConfiguration) ProtoMessage()    {}
-func (*MsgUpdateBlockRateLimitConfiguration) Descriptor() ([]byte, []int) {
+func (m *OrderBatch) GetClientIds() []uint32 {
+	if m != nil {
+		return m.ClientIds
+	}
+	return nil
+}
+
+// MsgBatchCancel is a request type used for canceling multiple Short-Term
+// orders in a single transaction. Each cancellation is processed independently
+// of the other cancellations in the batch.
+type MsgBatchCancel struct {
+	// The subaccount whose Short-Term orders are being canceled.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The batches of Short-Term orders to cancel, grouped by clob pair.
+	ShortTermCancels []OrderBatch `protobuf:"bytes,2,rep,name=short_term_cancels,json=shortTermCancels,proto3" json:"short_term_cancels"`
+	// The last block the order cancellations can be executed at.
+	GoodTilBlock uint32 `protobuf:"varint,3,opt,name=good_til_block,json=goodTilBlock,proto3" json:"good_til_block,omitempty"`
+	// The address canceling the orders on behalf of the subaccount owner. If set,
+	// the message must be signed by the grantee instead of the subaccount owner
+	// and the grantee must have a valid `TradingPermission` for the subaccount.
+	Grantee string `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *MsgBatchCancel) Reset()         { *m = MsgBatchCancel{} }
+func (m *MsgBatchCancel) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancel) ProtoMessage()    {}
+func (*MsgBatchCancel) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{13}
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancel.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -748,53 +762,67 @@ func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Merge(m, src)
+func (m *MsgBatchCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancel.Merge(m, src)
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Size() int {
+func (m *MsgBatchCancel) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.DiscardUnknown(m)
+func (m *MsgBatchCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancel proto.InternalMessageInfo
 
-func (m *MsgUpdateBlockRateLimitConfiguration) GetAuthority() string {
+func (m *MsgBatchCancel) GetSubaccountId() types.SubaccountId {
 	if m != nil {
-		return m.Authority
+		return m.SubaccountId
 	}
-	return ""
+	return types.SubaccountId{}
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) GetBlockRateLimitConfig() BlockRateLimitConfiguration {
+func (m *MsgBatchCancel) GetShortTermCancels() []OrderBatch {
 	if m != nil {
-		return m.BlockRateLimitConfig
+		return m.ShortTermCancels
 	}
-	return BlockRateLimitConfiguration{}
+	return nil
 }
 
-// MsgUpdateBlockRateLimitConfiguration is a response type for updating the
-// liquidations config.
-type MsgUpdateBlockRateLimitConfigurationResponse struct {
+func (m *MsgBatchCancel) GetGoodTilBlock() uint32 {
+	if m != nil {
+		return m.GoodTilBlock
+	}
+	return 0
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Reset() {
-	*m = MsgUpdateBlockRateLimitConfigurationResponse{}
+func (m *MsgBatchCancel) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) String() string {
-	return proto.CompactTextString(m)
+
+// MsgBatchCancelResponse is a response type used for canceling multiple
+// Short-Term orders.
+type MsgBatchCancelResponse struct {
+	// The batches of Short-Term orders that were successfully canceled.
+	ShortTermSucceeded []*OrderBatch `protobuf:"bytes,1,rep,name=short_term_succeeded,json=shortTermSucceeded,proto3" json:"short_term_succeeded,omitempty"`
+	// The batches of Short-Term orders that failed to be canceled.
+	ShortTermFailed []*OrderBatch `protobuf:"bytes,2,rep,name=short_term_failed,json=shortTermFailed,proto3" json:"short_term_failed,omitempty"`
 }
-func (*MsgUpdateBlockRateLimitConfigurationResponse) ProtoMessage() {}
-func (*MsgUpdateBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+
+func (m *MsgBatchCancelResponse) Reset()         { *m = MsgBatchCancelResponse{} }
+func (m *MsgBatchCancelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelResponse) ProtoMessage()    {}
+func (*MsgBatchCancelResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{14}
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -804,40 +832,53 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, det
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Merge(m, src)
+func (m *MsgBatchCancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelResponse.Merge(m, src)
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Size() int {
+func (m *MsgBatchCancelResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.DiscardUnknown(m)
+func (m *MsgBatchCancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancelResponse proto.InternalMessageInfo
 
-// MsgUpdateLiquidationsConfig is a request type for updating the liquidations
-// config.
-type MsgUpdateLiquidationsConfig struct {
-	// Authority is the address that may send this message.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the liquidations configuration to update to. All fields must
-	// be set.
-	LiquidationsConfig LiquidationsConfig `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
+func (m *MsgBatchCancelResponse) GetShortTermSucceeded() []*OrderBatch {
+	if m != nil {
+		return m.ShortTermSucceeded
+	}
+	return nil
 }
 
-func (m *MsgUpdateLiquidationsConfig) Reset()         { *m = MsgUpdateLiquidationsConfig{} }
-func (m *MsgUpdateLiquidationsConfig) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateLiquidationsConfig) ProtoMessage()    {}
-func (*MsgUpdateLiquidationsConfig) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCancelResponse) GetShortTermFailed() []*OrderBatch {
+	if m != nil {
+		return m.ShortTermFailed
+	}
+	return nil
+}
+
+// MsgGrantTradingPermission is a request type used by the owner of a
+// subaccount to grant another address the permission to place and cancel
+// orders on behalf of the subaccount. Any existing permission of the grantee
+// for the subaccount is replaced.
+type MsgGrantTradingPermission struct {
+	// The permission to grant. Must be signed by the owner of the subaccount.
+	Permission TradingPermission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission"`
+}
+
+func (m *MsgGrantTradingPermission) Reset()         { *m = MsgGrantTradingPermission{} }
+func (m *MsgGrantTradingPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantTradingPermission) ProtoMessage()    {}
+func (*MsgGrantTradingPermission) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{15}
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantTradingPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantTradingPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateLiquidationsConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantTradingPermission.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -847,48 +888,42 @@ func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateLiquidationsConfig.Merge(m, src)
+func (m *MsgGrantTradingPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantTradingPermission.Merge(m, src)
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Size() int {
+func (m *MsgGrantTradingPermission) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateLiquidationsConfig.DiscardUnknown(m)
+func (m *MsgGrantTradingPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantTradingPermission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateLiquidationsConfig proto.InternalMessageInfo
-
-func (m *MsgUpdateLiquidationsConfig) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
+var xxx_messageInfo_MsgGrantTradingPermission proto.InternalMessageInfo
 
-func (m *MsgUpdateLiquidationsConfig) GetLiquidationsConfig() LiquidationsConfig {
+func (m *MsgGrantTradingPermission) GetPermission() TradingPermission {
 	if m != nil {
-		return m.LiquidationsConfig
+		return m.Permission
 	}
-	return LiquidationsConfig{}
+	return TradingPermission{}
 }
 
-// MsgUpdateLiquidationsConfig is the Msg/LiquidationsConfig response type.
-type MsgUpdateLiquidationsConfigResponse struct {
+// MsgGrantTradingPermissionResponse is a response type used for granting
+// trading permissions.
+type MsgGrantTradingPermissionResponse struct {
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) Reset()         { *m = MsgUpdateLiquidationsConfigResponse{} }
-func (m *MsgUpdateLiquidationsConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateLiquidationsConfigResponse) ProtoMessage()    {}
-func (*MsgUpdateLiquidationsConfigResponse) Descriptor() ([]byte, []int) {
+func (m *MsgGrantTradingPermissionResponse) Reset()         { *m = MsgGrantTradingPermissionResponse{} }
+func (m *MsgGrantTradingPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantTradingPermissionResponse) ProtoMessage()    {}
+func (*MsgGrantTradingPermissionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{16}
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantTradingPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantTradingPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantTradingPermissionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -898,1035 +933,1533 @@ func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Merge(m, src)
+func (m *MsgGrantTradingPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantTradingPermissionResponse.Merge(m, src)
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Size() int {
+func (m *MsgGrantTradingPermissionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.DiscardUnknown(m)
+func (m *MsgGrantTradingPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantTradingPermissionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateLiquidationsConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantTradingPermissionResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateClobPair)(nil), "dydxprotocol.clob.MsgCreateClobPair")
-	proto.RegisterType((*MsgCreateClobPairResponse)(nil), "dydxprotocol.clob.MsgCreateClobPairResponse")
-	proto.RegisterType((*MsgProposedOperations)(nil), "dydxprotocol.clob.MsgProposedOperations")
-	proto.RegisterType((*MsgProposedOperationsResponse)(nil), "dydxprotocol.clob.MsgProposedOperationsResponse")
-	proto.RegisterType((*MsgPlaceOrder)(nil), "dydxprotocol.clob.MsgPlaceOrder")
-	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "dydxprotocol.clob.MsgPlaceOrderResponse")
-	proto.RegisterType((*MsgCancelOrder)(nil), "dydxprotocol.clob.MsgCancelOrder")
-	proto.RegisterType((*MsgCancelOrderResponse)(nil), "dydxprotocol.clob.MsgCancelOrderResponse")
-	proto.RegisterType((*MsgUpdateClobPair)(nil), "dydxprotocol.clob.MsgUpdateClobPair")
-	proto.RegisterType((*MsgUpdateClobPairResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairResponse")
-	proto.RegisterType((*OperationRaw)(nil), "dydxprotocol.clob.OperationRaw")
-	proto.RegisterType((*MsgUpdateEquityTierLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration")
-	proto.RegisterType((*MsgUpdateEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse")
-	proto.RegisterType((*MsgUpdateBlockRateLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration")
-	proto.RegisterType((*MsgUpdateBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse")
-	proto.RegisterType((*MsgUpdateLiquidationsConfig)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfig")
-	proto.RegisterType((*MsgUpdateLiquidationsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse")
+// MsgRevokeTradingPermission is a request type used by the owner of a
+// subaccount to revoke the trading permission of a grantee.
+type MsgRevokeTradingPermission struct {
+	// The subaccount to revoke the permission for. Must be signed by the owner
+	// of the subaccount.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The address whose permission is revoked.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
-
-var fileDescriptor_19b9e2c0de4ab64a = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xdf, 0x21, 0x40, 0x9a, 0xb7, 0x49, 0x48, 0xdd, 0x94, 0xb8, 0x0e, 0xdd, 0x6c, 0x96, 0xa6,
-	0x5a, 0xa0, 0x59, 0x97, 0x50, 0x05, 0x04, 0xe2, 0xdf, 0x46, 0x45, 0x41, 0x6a, 0xd4, 0xc5, 0x04,
-	0x09, 0x01, 0x92, 0xe5, 0xb5, 0xa7, 0xde, 0x51, 0x6d, 0x8f, 0x33, 0x9e, 0x0d, 0xc9, 0xb5, 0x9f,
-	0x80, 0x3b, 0x42, 0xe2, 0x23, 0x70, 0xe0, 0xc0, 0x19, 0x2e, 0x3d, 0x56, 0x9c, 0x2a, 0x21, 0x01,
-	0x4a, 0x0e, 0x7c, 0x0d, 0x64, 0x7b, 0x3c, 0xf1, 0xc6, 0xf6, 0x66, 0x59, 0x71, 0xe8, 0x65, 0x77,
-	0x67, 0xe6, 0xf7, 0xde, 0xef, 0xbd, 0xf7, 0x1b, 0xbf, 0xb7, 0x06, 0xcd, 0x39, 0x76, 0x8e, 0x42,
-	0x46, 0x39, 0xb5, 0xa9, 0xa7, 0xdb, 0x1e, 0xed, 0xeb, 0xfc, 0xa8, 0x93, 0x6c, 0x28, 0x97, 0xf3,
-	0x67, 0x9d, 0xf8, 0x4c, 0xbb, 0x66, 0xd3, 0xc8, 0xa7, 0x91, 0x99, 0xec, 0xea, 0xe9, 0x22, 0x45,
-	0x6b, 0x2b, 0xe9, 0x4a, 0xf7, 0x23, 0x57, 0x3f, 0x7c, 0x33, 0xfe, 0x12, 0x07, 0xcb, 0x2e, 0x75,
-	0x69, 0x6a, 0x10, 0xff, 0x12, 0xbb, 0x7a, 0x91, 0xb8, 0xef, 0x51, 0xfb, 0xa1, 0xc9, 0x2c, 0x8e,
-	0x4d, 0x8f, 0xf8, 0x84, 0x9b, 0x36, 0x0d, 0x1e, 0x90, 0xcc, 0xcd, 0x7a, 0xd1, 0x20, 0xfe, 0x30,
-	0x43, 0x8b, 0x30, 0x01, 0xb9, 0x5d, 0x84, 0xe0, 0x83, 0x21, 0xe1, 0xc7, 0x26, 0x27, 0x98, 0x95,
-	0x39, 0x5d, 0x2b, 0x5a, 0xf8, 0x16, 0xb7, 0x07, 0x38, 0xcb, 0xea, 0x7a, 0x11, 0x40, 0x99, 0x83,
-	0x33, 0xc6, 0x9b, 0x15, 0xc7, 0x26, 0xc3, 0x3e, 0x3d, 0xb4, 0xbc, 0xcc, 0xcd, 0x1b, 0x45, 0x9c,
-	0x47, 0x0e, 0x86, 0xc4, 0xb1, 0x38, 0xa1, 0x41, 0x34, 0x12, 0x54, 0xeb, 0x7b, 0x04, 0x97, 0xf7,
-	0x22, 0x77, 0x87, 0x61, 0x8b, 0xe3, 0x1d, 0x8f, 0xf6, 0x7b, 0x16, 0x61, 0xca, 0x36, 0xcc, 0x59,
-	0x43, 0x3e, 0xa0, 0x8c, 0xf0, 0x63, 0x15, 0x35, 0x51, 0x7b, 0xae, 0xab, 0xfe, 0xfe, 0xf3, 0xe6,
-	0xb2, 0x10, 0xe1, 0x63, 0xc7, 0x61, 0x38, 0x8a, 0x3e, 0xe7, 0x8c, 0x04, 0xae, 0x71, 0x06, 0x55,
-	0x3e, 0x80, 0x39, 0x59, 0x27, 0xf5, 0xb9, 0x26, 0x6a, 0xd7, 0xb7, 0x56, 0x3b, 0x05, 0x65, 0x3b,
-	0x19, 0x4f, 0xf7, 0xf9, 0xc7, 0x7f, 0xae, 0xd5, 0x8c, 0x4b, 0xb6, 0x58, 0xbf, 0xbb, 0xf8, 0xe8,
-	0x9f, 0x9f, 0x5e, 0x3f, 0xf3, 0xd7, 0x5a, 0x85, 0x6b, 0x85, 0xe0, 0x0c, 0x1c, 0x85, 0x34, 0x88,
-	0x70, 0x8b, 0xc0, 0xd5, 0xbd, 0xc8, 0xed, 0x31, 0x1a, 0xd2, 0x08, 0x3b, 0xf7, 0x43, 0xcc, 0xd2,
-	0x04, 0x95, 0x1e, 0x2c, 0x51, 0xb9, 0x32, 0x0f, 0x86, 0x78, 0x88, 0x55, 0xd4, 0x9c, 0x69, 0xd7,
-	0xb7, 0xd6, 0x4a, 0x82, 0x91, 0x86, 0x86, 0xf5, 0xad, 0x08, 0xe8, 0xa5, 0x33, 0xf3, 0xcf, 0x62,
-	0xeb, 0xd6, 0x1a, 0x5c, 0x2f, 0xa5, 0x92, 0xb1, 0xdc, 0x85, 0x85, 0x18, 0xe0, 0x59, 0x36, 0xbe,
-	0x1f, 0x6b, 0xa2, 0xdc, 0x81, 0x17, 0x12, 0x71, 0x92, 0xea, 0xd5, 0xb7, 0xd4, 0x32, 0xe2, 0xf8,
-	0x5c, 0x30, 0xa6, 0xe0, 0xd6, 0x4a, 0x9a, 0x92, 0x74, 0x23, 0xfd, 0xff, 0x82, 0x60, 0x31, 0xae,
-	0x84, 0x15, 0xd8, 0xd8, 0x4b, 0x19, 0xde, 0x83, 0x4b, 0xa9, 0xfc, 0xc4, 0x11, 0x24, 0x5a, 0x15,
-	0xc9, 0xa7, 0x8e, 0xa0, 0x99, 0xa5, 0xe9, 0x52, 0xb9, 0x09, 0x8b, 0x2e, 0xa5, 0x8e, 0xc9, 0x89,
-	0x67, 0x26, 0x8f, 0x42, 0xa2, 0xd6, 0xc2, 0x6e, 0xcd, 0x98, 0x8f, 0xf7, 0xf7, 0x89, 0xd7, 0x8d,
-	0x77, 0x15, 0x1d, 0xae, 0x8c, 0xe2, 0x4c, 0x4e, 0x7c, 0xac, 0xce, 0x34, 0x51, 0x7b, 0x76, 0xb7,
-	0x66, 0x2c, 0xe5, 0xc1, 0xfb, 0xc4, 0xc7, 0xdd, 0xa5, 0x9c, 0x63, 0x1a, 0x60, 0xfa, 0xa0, 0xa5,
-	0xc2, 0xcb, 0xa3, 0x91, 0xcb, 0xa4, 0xc4, 0xdd, 0xfb, 0x22, 0x74, 0x9e, 0xdd, 0xbb, 0x37, 0x1a,
-	0x9c, 0x0c, 0xfd, 0x29, 0x82, 0xf9, 0xfc, 0xc5, 0x89, 0xf5, 0x4e, 0x1e, 0x66, 0x21, 0xc5, 0x2b,
-	0x15, 0xcc, 0x7b, 0x31, 0x66, 0xb7, 0x66, 0xa4, 0x60, 0xe5, 0x7d, 0xd0, 0xa2, 0x01, 0x65, 0xdc,
-	0xe4, 0x98, 0xf9, 0x66, 0x2a, 0x67, 0x18, 0xab, 0xef, 0xe3, 0x80, 0x27, 0x49, 0xcc, 0xef, 0xd6,
-	0x8c, 0x95, 0x04, 0xb3, 0x8f, 0x99, 0x9f, 0xd4, 0xaf, 0x97, 0x01, 0x94, 0x4f, 0x60, 0x61, 0xa4,
-	0x03, 0x24, 0xba, 0x54, 0xdc, 0xf2, 0xb4, 0xf2, 0x09, 0x2c, 0x56, 0x99, 0xe6, 0xd6, 0xdd, 0x3a,
-	0xcc, 0xc9, 0x1b, 0xdf, 0xfa, 0x0b, 0xc1, 0x86, 0x4c, 0xfc, 0x6e, 0xd2, 0xd2, 0xf6, 0x09, 0x66,
-	0xf7, 0xe2, 0x86, 0xb6, 0x93, 0xb4, 0x8e, 0x61, 0x8a, 0x9c, 0x5a, 0xa9, 0x00, 0xd4, 0xaa, 0x56,
-	0x29, 0x84, 0xd3, 0x4b, 0x32, 0x18, 0x17, 0x8a, 0x10, 0xf3, 0x2a, 0x2e, 0xc3, 0x14, 0x94, 0xd5,
-	0x61, 0x73, 0xa2, 0x04, 0xa5, 0xda, 0x7f, 0x20, 0xb8, 0x21, 0x2d, 0x92, 0xbb, 0x6e, 0x58, 0x1c,
-	0xff, 0x8f, 0x15, 0x79, 0x08, 0x2b, 0x15, 0x03, 0x49, 0x48, 0xda, 0x29, 0x29, 0xc8, 0x98, 0x40,
-	0x44, 0x3d, 0x96, 0xfb, 0x25, 0x90, 0x42, 0x39, 0x3a, 0x70, 0x6b, 0x92, 0xe4, 0x64, 0x35, 0x7e,
-	0x43, 0xb0, 0x2a, 0x0d, 0xee, 0xe5, 0x26, 0x4b, 0x0a, 0x9f, 0xba, 0x08, 0xdf, 0xc0, 0x95, 0x92,
-	0x39, 0x25, 0x6e, 0xc4, 0x46, 0x49, 0x01, 0x8a, 0xdc, 0x22, 0x6f, 0xc5, 0x2b, 0x9c, 0x14, 0xb2,
-	0xde, 0x80, 0x57, 0xc7, 0x24, 0x91, 0x25, 0xbb, 0xf5, 0xeb, 0x2c, 0xcc, 0xec, 0x45, 0xae, 0x12,
-	0x82, 0x52, 0x32, 0x69, 0xda, 0x25, 0x51, 0x95, 0x0e, 0x0a, 0xed, 0xf6, 0xa4, 0xc8, 0x8c, 0x59,
-	0xf9, 0x12, 0x20, 0x37, 0x4f, 0x9a, 0x15, 0xf6, 0x12, 0xa1, 0xb5, 0x2f, 0x42, 0x48, 0xcf, 0x5f,
-	0x43, 0x3d, 0x3f, 0x48, 0xd6, 0xcb, 0x0d, 0x73, 0x10, 0xed, 0xb5, 0x0b, 0x21, 0xd2, 0xb9, 0x03,
-	0x8b, 0xe7, 0xfe, 0x4c, 0xdc, 0xa8, 0x30, 0x1e, 0x41, 0x69, 0xb7, 0x26, 0x41, 0xe5, 0x59, 0xce,
-	0x8d, 0x8d, 0x0a, 0x96, 0x51, 0x54, 0x15, 0x4b, 0x79, 0x97, 0x57, 0x7e, 0x44, 0xd0, 0x9a, 0xa0,
-	0x0f, 0xbe, 0x33, 0xce, 0xe9, 0x38, 0x4b, 0xed, 0xa3, 0x69, 0x2d, 0x65, 0x88, 0x3f, 0x20, 0x58,
-	0xbf, 0xb8, 0x2f, 0xbd, 0x3d, 0x8e, 0x67, 0x8c, 0xa1, 0xf6, 0xe1, 0x94, 0x86, 0x32, 0xbe, 0x47,
-	0x08, 0xd4, 0xca, 0x4e, 0xd1, 0x19, 0xe7, 0xbd, 0x88, 0xd7, 0xb6, 0xff, 0x1b, 0x3e, 0x0b, 0xa2,
-	0xdb, 0x7b, 0x7c, 0xd2, 0x40, 0x4f, 0x4e, 0x1a, 0xe8, 0xef, 0x93, 0x06, 0xfa, 0xee, 0xb4, 0x51,
-	0x7b, 0x72, 0xda, 0xa8, 0x3d, 0x3d, 0x6d, 0xd4, 0xbe, 0xda, 0x76, 0x09, 0x1f, 0x0c, 0xfb, 0x1d,
-	0x9b, 0xfa, 0xa3, 0x2f, 0x09, 0x87, 0x77, 0x36, 0xed, 0x81, 0x45, 0x02, 0x5d, 0xee, 0x1c, 0x89,
-	0x37, 0x96, 0xe3, 0x10, 0x47, 0xfd, 0x17, 0x93, 0xed, 0xb7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbb, 0xf1, 0x76, 0x35, 0xd3, 0x0c, 0x00, 0x00,
+func (m *MsgRevokeTradingPermission) Reset()         { *m = MsgRevokeTradingPermission{} }
+func (m *MsgRevokeTradingPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTradingPermission) ProtoMessage()    {}
+func (*MsgRevokeTradingPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{17}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ProposedOperations is a temporary message used by block proposers
-	// for matching orders as part of the ABCI++ workaround.
-	ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error)
-	// PlaceOrder allows accounts to place orders on the orderbook.
-	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
-	// CancelOrder allows accounts to cancel existing orders on the orderbook.
-	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
-	// CreateClobPair creates a new clob pair.
-	CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error)
-	// UpdateClobPair sets the status of a clob pair. Should return an error
-	// if the authority is not in the clob keeper's set of authorities,
-	// if the ClobPair id is not found in state, or if the update includes
-	// an unsupported status transition.
-	UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error)
-	// UpdateEquityTierLimitConfiguration updates the equity tier limit
-	// configuration in state.
-	UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
-	// UpdateBlockRateLimitConfiguration updates the block rate limit
-	// configuration in state.
-	UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
-	// UpdateLiquidationsConfig updates the liquidations configuration in state.
-	UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error)
+func (m *MsgRevokeTradingPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRevokeTradingPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTradingPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRevokeTradingPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTradingPermission.Merge(m, src)
+}
+func (m *MsgRevokeTradingPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTradingPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTradingPermission.DiscardUnknown(m)
 }
 
-func (c *msgClient) ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error) {
-	out := new(MsgProposedOperationsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ProposedOperations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeTradingPermission proto.InternalMessageInfo
+
+func (m *MsgRevokeTradingPermission) GetSubaccountId() types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return out, nil
+	return types.SubaccountId{}
 }
 
-func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
-	out := new(MsgPlaceOrderResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/PlaceOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeTradingPermission) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
-	out := new(MsgCancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRevokeTradingPermissionResponse is a response type used for revoking
+// trading permissions.
+type MsgRevokeTradingPermissionResponse struct {
 }
 
-func (c *msgClient) CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error) {
-	out := new(MsgCreateClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CreateClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeTradingPermissionResponse) Reset()         { *m = MsgRevokeTradingPermissionResponse{} }
+func (m *MsgRevokeTradingPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTradingPermissionResponse) ProtoMessage()    {}
+func (*MsgRevokeTradingPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{18}
+}
+func (m *MsgRevokeTradingPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTradingPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTradingPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRevokeTradingPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTradingPermissionResponse.Merge(m, src)
+}
+func (m *MsgRevokeTradingPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTradingPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTradingPermissionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error) {
-	out := new(MsgUpdateClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRevokeTradingPermissionResponse proto.InternalMessageInfo
+
+// MsgUpdateClobPair is a request type used for updating a ClobPair in state.
+type MsgUpdateClobPair struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// `clob_pair` is the ClobPair to write to state.
+	ClobPair ClobPair `protobuf:"bytes,2,opt,name=clob_pair,json=clobPair,proto3" json:"clob_pair"`
 }
 
-func (c *msgClient) UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
-	out := new(MsgUpdateEquityTierLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateClobPair) Reset()         { *m = MsgUpdateClobPair{} }
+func (m *MsgUpdateClobPair) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClobPair) ProtoMessage()    {}
+func (*MsgUpdateClobPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{19}
+}
+func (m *MsgUpdateClobPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClobPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateClobPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClobPair.Merge(m, src)
+}
+func (m *MsgUpdateClobPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClobPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClobPair.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
-	out := new(MsgUpdateBlockRateLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateClobPair proto.InternalMessageInfo
+
+func (m *MsgUpdateClobPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error) {
-	out := new(MsgUpdateLiquidationsConfigResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateClobPair) GetClobPair() ClobPair {
+	if m != nil {
+		return m.ClobPair
 	}
-	return out, nil
+	return ClobPair{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ProposedOperations is a temporary message used by block proposers
-	// for matching orders as part of the ABCI++ workaround.
-	ProposedOperations(context.Context, *MsgProposedOperations) (*MsgProposedOperationsResponse, error)
-	// PlaceOrder allows accounts to place orders on the orderbook.
-	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
-	// CancelOrder allows accounts to cancel existing orders on the orderbook.
-	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
-	// CreateClobPair creates a new clob pair.
-	CreateClobPair(context.Context, *MsgCreateClobPair) (*MsgCreateClobPairResponse, error)
-	// UpdateClobPair sets the status of a clob pair. Should return an error
-	// if the authority is not in the clob keeper's set of authorities,
-	// if the ClobPair id is not found in state, or if the update includes
-	// an unsupported status transition.
-	UpdateClobPair(context.Context, *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error)
-	// UpdateEquityTierLimitConfiguration updates the equity tier limit
-	// configuration in state.
-	UpdateEquityTierLimitConfiguration(context.Context, *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
-	// UpdateBlockRateLimitConfiguration updates the block rate limit
-	// configuration in state.
-	UpdateBlockRateLimitConfiguration(context.Context, *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
-	// UpdateLiquidationsConfig updates the liquidations configuration in state.
-	UpdateLiquidationsConfig(context.Context, *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error)
+// MsgUpdateClobPairResponse is a response type used for setting a ClobPair's
+// status.
+type MsgUpdateClobPairResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateClobPairResponse) Reset()         { *m = MsgUpdateClobPairResponse{} }
+func (m *MsgUpdateClobPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClobPairResponse) ProtoMessage()    {}
+func (*MsgUpdateClobPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{20}
+}
+func (m *MsgUpdateClobPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClobPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateClobPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClobPairResponse.Merge(m, src)
+}
+func (m *MsgUpdateClobPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClobPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClobPairResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) ProposedOperations(ctx context.Context, req *MsgProposedOperations) (*MsgProposedOperationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposedOperations not implemented")
+var xxx_messageInfo_MsgUpdateClobPairResponse proto.InternalMessageInfo
+
+// OperationRaw represents an operation in the proposed operations.
+// Note that the `order_placement` operation is a signed message.
+type OperationRaw struct {
+	// operationRaw represents an operation that occurred, which can be a match,
+	// a signed order placement, or an order removal.
+	//
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*OperationRaw_Match
+	//	*OperationRaw_ShortTermOrderPlacement
+	//	*OperationRaw_OrderRemoval
+	//	*OperationRaw_ShortTermOrderBatchPlacement
+	Operation isOperationRaw_Operation `protobuf_oneof:"operation"`
 }
-func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+
+func (m *OperationRaw) Reset()         { *m = OperationRaw{} }
+func (m *OperationRaw) String() string { return proto.CompactTextString(m) }
+func (*OperationRaw) ProtoMessage()    {}
+func (*OperationRaw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{21}
 }
-func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+func (m *OperationRaw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateClobPair(ctx context.Context, req *MsgCreateClobPair) (*MsgCreateClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClobPair not implemented")
+func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationRaw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateClobPair(ctx context.Context, req *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClobPair not implemented")
+func (m *OperationRaw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationRaw.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateEquityTierLimitConfiguration(ctx context.Context, req *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEquityTierLimitConfiguration not implemented")
+func (m *OperationRaw) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateBlockRateLimitConfiguration(ctx context.Context, req *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockRateLimitConfiguration not implemented")
+func (m *OperationRaw) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationRaw.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateLiquidationsConfig(ctx context.Context, req *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationsConfig not implemented")
+
+var xxx_messageInfo_OperationRaw proto.InternalMessageInfo
+
+type isOperationRaw_Operation interface {
+	isOperationRaw_Operation()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type OperationRaw_Match struct {
+	Match *ClobMatch `protobuf:"bytes,1,opt,name=match,proto3,oneof" json:"match,omitempty"`
+}
+type OperationRaw_ShortTermOrderPlacement struct {
+	ShortTermOrderPlacement []byte `protobuf:"bytes,2,opt,name=short_term_order_placement,json=shortTermOrderPlacement,proto3,oneof" json:"short_term_order_placement,omitempty"`
+}
+type OperationRaw_OrderRemoval struct {
+	OrderRemoval *OrderRemoval `protobuf:"bytes,3,opt,name=order_removal,json=orderRemoval,proto3,oneof" json:"order_removal,omitempty"`
+}
+type OperationRaw_ShortTermOrderBatchPlacement struct {
+	ShortTermOrderBatchPlacement *ShortTermOrderBatchPlacement `protobuf:"bytes,4,opt,name=short_term_order_batch_placement,json=shortTermOrderBatchPlacement,proto3,oneof" json:"short_term_order_batch_placement,omitempty"`
 }
 
-func _Msg_ProposedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProposedOperations)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProposedOperations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/ProposedOperations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProposedOperations(ctx, req.(*MsgProposedOperations))
+func (*OperationRaw_Match) isOperationRaw_Operation()                        {}
+func (*OperationRaw_ShortTermOrderPlacement) isOperationRaw_Operation()      {}
+func (*OperationRaw_OrderRemoval) isOperationRaw_Operation()                 {}
+func (*OperationRaw_ShortTermOrderBatchPlacement) isOperationRaw_Operation() {}
+
+func (m *OperationRaw) GetOperation() isOperationRaw_Operation {
+	if m != nil {
+		return m.Operation
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/PlaceOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+func (m *OperationRaw) GetMatch() *ClobMatch {
+	if x, ok := m.GetOperation().(*OperationRaw_Match); ok {
+		return x.Match
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/CancelOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+func (m *OperationRaw) GetShortTermOrderPlacement() []byte {
+	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderPlacement); ok {
+		return x.ShortTermOrderPlacement
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateClobPair)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/CreateClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateClobPair(ctx, req.(*MsgCreateClobPair))
+func (m *OperationRaw) GetOrderRemoval() *OrderRemoval {
+	if x, ok := m.GetOperation().(*OperationRaw_OrderRemoval); ok {
+		return x.OrderRemoval
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClobPair)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateClobPair(ctx, in)
+func (m *OperationRaw) GetShortTermOrderBatchPlacement() *ShortTermOrderBatchPlacement {
+	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderBatchPlacement); ok {
+		return x.ShortTermOrderBatchPlacement
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClobPair(ctx, req.(*MsgUpdateClobPair))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateEquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateEquityTierLimitConfiguration)
-	if err := dec(in); err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*OperationRaw) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*OperationRaw_Match)(nil),
+		(*OperationRaw_ShortTermOrderPlacement)(nil),
+		(*OperationRaw_OrderRemoval)(nil),
+		(*OperationRaw_ShortTermOrderBatchPlacement)(nil),
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, in)
+}
+
+// ShortTermOrderBatchPlacement represents the Short-Term order placements that
+// were submitted as part of a single signed `MsgBatchPlaceOrders` transaction.
+type ShortTermOrderBatchPlacement struct {
+	// The raw transaction bytes of the `MsgBatchPlaceOrders` transaction.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// The order IDs of the placed orders within the batch, in the order they
+	// were placed.
+	OrderIds []OrderId `protobuf:"bytes,2,rep,name=order_ids,json=orderIds,proto3" json:"order_ids"`
+}
+
+func (m *ShortTermOrderBatchPlacement) Reset()         { *m = ShortTermOrderBatchPlacement{} }
+func (m *ShortTermOrderBatchPlacement) String() string { return proto.CompactTextString(m) }
+func (*ShortTermOrderBatchPlacement) ProtoMessage()    {}
+func (*ShortTermOrderBatchPlacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{22}
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShortTermOrderBatchPlacement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration",
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShortTermOrderBatchPlacement.Merge(m, src)
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShortTermOrderBatchPlacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShortTermOrderBatchPlacement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShortTermOrderBatchPlacement proto.InternalMessageInfo
+
+func (m *ShortTermOrderBatchPlacement) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, req.(*MsgUpdateEquityTierLimitConfiguration))
+	return nil
+}
+
+func (m *ShortTermOrderBatchPlacement) GetOrderIds() []OrderId {
+	if m != nil {
+		return m.OrderIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateBlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateBlockRateLimitConfiguration)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, req.(*MsgUpdateBlockRateLimitConfiguration))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
+// request type.
+type MsgUpdateEquityTierLimitConfiguration struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the equity tier limit configuration to update to. All fields must
+	// be set.
+	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,2,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
 }
 
-func _Msg_UpdateLiquidationsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateLiquidationsConfig)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, req.(*MsgUpdateLiquidationsConfig))
+func (m *MsgUpdateEquityTierLimitConfiguration) Reset()         { *m = MsgUpdateEquityTierLimitConfiguration{} }
+func (m *MsgUpdateEquityTierLimitConfiguration) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEquityTierLimitConfiguration) ProtoMessage()    {}
+func (*MsgUpdateEquityTierLimitConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{23}
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Merge(m, src)
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.clob.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ProposedOperations",
-			Handler:    _Msg_ProposedOperations_Handler,
-		},
-		{
-			MethodName: "PlaceOrder",
-			Handler:    _Msg_PlaceOrder_Handler,
-		},
-		{
-			MethodName: "CancelOrder",
-			Handler:    _Msg_CancelOrder_Handler,
-		},
-		{
-			MethodName: "CreateClobPair",
-			Handler:    _Msg_CreateClobPair_Handler,
-		},
-		{
-			MethodName: "UpdateClobPair",
-			Handler:    _Msg_UpdateClobPair_Handler,
-		},
-		{
-			MethodName: "UpdateEquityTierLimitConfiguration",
-			Handler:    _Msg_UpdateEquityTierLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "UpdateBlockRateLimitConfiguration",
-			Handler:    _Msg_UpdateBlockRateLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "UpdateLiquidationsConfig",
-			Handler:    _Msg_UpdateLiquidationsConfig_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/clob/tx.proto",
+var xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration proto.InternalMessageInfo
+
+func (m *MsgUpdateEquityTierLimitConfiguration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgCreateClobPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateEquityTierLimitConfiguration) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
+	if m != nil {
+		return m.EquityTierLimitConfig
 	}
-	return dAtA[:n], nil
+	return EquityTierLimitConfiguration{}
 }
 
-func (m *MsgCreateClobPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
+// response type.
+type MsgUpdateEquityTierLimitConfigurationResponse struct {
 }
 
-func (m *MsgCreateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Reset() {
+	*m = MsgUpdateEquityTierLimitConfigurationResponse{}
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateEquityTierLimitConfigurationResponse) ProtoMessage() {}
+func (*MsgUpdateEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{24}
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Merge(m, src)
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse proto.InternalMessageInfo
+
+// MsgUpdateBlockRateLimitConfiguration is the Msg/BlockRateLimitConfiguration
+// request type.
+type MsgUpdateBlockRateLimitConfiguration struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the block rate limit configuration to update to. All fields must be
+	// set.
+	BlockRateLimitConfig BlockRateLimitConfiguration `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
 }
 
-func (m *MsgCreateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlockRateLimitConfiguration) Reset()         { *m = MsgUpdateBlockRateLimitConfiguration{} }
+func (m *MsgUpdateBlockRateLimitConfiguration) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlockRateLimitConfiguration) ProtoMessage()    {}
+func (*MsgUpdateBlockRateLimitConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{25}
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Merge(m, src)
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.DiscardUnknown(m)
 }
 
-func (m *MsgCreateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration proto.InternalMessageInfo
+
+func (m *MsgUpdateBlockRateLimitConfiguration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgProposedOperations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBlockRateLimitConfiguration) GetBlockRateLimitConfig() BlockRateLimitConfiguration {
+	if m != nil {
+		return m.BlockRateLimitConfig
 	}
-	return dAtA[:n], nil
+	return BlockRateLimitConfiguration{}
 }
 
-func (m *MsgProposedOperations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateBlockRateLimitConfiguration is a response type for updating the
+// liquidations config.
+type MsgUpdateBlockRateLimitConfigurationResponse struct {
 }
 
-func (m *MsgProposedOperations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperationsQueue) > 0 {
-		for iNdEx := len(m.OperationsQueue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OperationsQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Reset() {
+	*m = MsgUpdateBlockRateLimitConfigurationResponse{}
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateBlockRateLimitConfigurationResponse) ProtoMessage() {}
+func (*MsgUpdateBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{26}
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgProposedOperationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Merge(m, src)
 }
-
-func (m *MsgProposedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgProposedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse proto.InternalMessageInfo
 
-func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateLiquidationsConfig is a request type for updating the liquidations
+// config.
+type MsgUpdateLiquidationsConfig struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the liquidations configuration to update to. All fields must
+	// be set.
+	LiquidationsConfig LiquidationsConfig `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
 }
 
-func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateLiquidationsConfig) Reset()         { *m = MsgUpdateLiquidationsConfig{} }
+func (m *MsgUpdateLiquidationsConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLiquidationsConfig) ProtoMessage()    {}
+func (*MsgUpdateLiquidationsConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{27}
+}
+func (m *MsgUpdateLiquidationsConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLiquidationsConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateLiquidationsConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLiquidationsConfig.Merge(m, src)
+}
+func (m *MsgUpdateLiquidationsConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLiquidationsConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLiquidationsConfig.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateLiquidationsConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateLiquidationsConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateLiquidationsConfig) GetLiquidationsConfig() LiquidationsConfig {
+	if m != nil {
+		return m.LiquidationsConfig
+	}
+	return LiquidationsConfig{}
 }
 
-func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateLiquidationsConfig is the Msg/LiquidationsConfig response type.
+type MsgUpdateLiquidationsConfigResponse struct {
 }
 
-func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateLiquidationsConfigResponse) Reset()         { *m = MsgUpdateLiquidationsConfigResponse{} }
+func (m *MsgUpdateLiquidationsConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLiquidationsConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateLiquidationsConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{28}
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateLiquidationsConfigResponse proto.InternalMessageInfo
+
+// MsgUpdateMakerUptimeRewardsConfig is a request type for updating the maker
+// uptime rewards config.
+type MsgUpdateMakerUptimeRewardsConfig struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the maker uptime rewards configuration to update to.
+	MakerUptimeRewardsConfig MakerUptimeRewardsConfig `protobuf:"bytes,2,opt,name=maker_uptime_rewards_config,json=makerUptimeRewardsConfig,proto3" json:"maker_uptime_rewards_config"`
 }
 
-func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GoodTilOneof != nil {
-		{
-			size := m.GoodTilOneof.Size()
-			i -= size
-			if _, err := m.GoodTilOneof.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	{
-		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateMakerUptimeRewardsConfig) Reset()         { *m = MsgUpdateMakerUptimeRewardsConfig{} }
+func (m *MsgUpdateMakerUptimeRewardsConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMakerUptimeRewardsConfig) ProtoMessage()    {}
+func (*MsgUpdateMakerUptimeRewardsConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{29}
+}
+func (m *MsgUpdateMakerUptimeRewardsConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMakerUptimeRewardsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMakerUptimeRewardsConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateMakerUptimeRewardsConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMakerUptimeRewardsConfig.Merge(m, src)
+}
+func (m *MsgUpdateMakerUptimeRewardsConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMakerUptimeRewardsConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMakerUptimeRewardsConfig.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrder_GoodTilBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateMakerUptimeRewardsConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateMakerUptimeRewardsConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgCancelOrder_GoodTilBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+func (m *MsgUpdateMakerUptimeRewardsConfig) GetMakerUptimeRewardsConfig() MakerUptimeRewardsConfig {
+	if m != nil {
+		return m.MakerUptimeRewardsConfig
+	}
+	return MakerUptimeRewardsConfig{}
 }
-func (m *MsgCancelOrder_GoodTilBlockTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// MsgUpdateMakerUptimeRewardsConfigResponse is the
+// Msg/UpdateMakerUptimeRewardsConfig response type.
+type MsgUpdateMakerUptimeRewardsConfigResponse struct {
 }
 
-func (m *MsgCancelOrder_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 4
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GoodTilBlockTime))
-	i--
-	dAtA[i] = 0x1d
-	return len(dAtA) - i, nil
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) Reset() {
+	*m = MsgUpdateMakerUptimeRewardsConfigResponse{}
 }
-func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateMakerUptimeRewardsConfigResponse) ProtoMessage() {}
+func (*MsgUpdateMakerUptimeRewardsConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{30}
+}
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMakerUptimeRewardsConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMakerUptimeRewardsConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMakerUptimeRewardsConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateMakerUptimeRewardsConfigResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateClobPair)(nil), "dydxprotocol.clob.MsgCreateClobPair")
+	proto.RegisterType((*MsgCreateClobPairResponse)(nil), "dydxprotocol.clob.MsgCreateClobPairResponse")
+	proto.RegisterType((*MsgProposedOperations)(nil), "dydxprotocol.clob.MsgProposedOperations")
+	proto.RegisterType((*MsgProposedOperationsResponse)(nil), "dydxprotocol.clob.MsgProposedOperationsResponse")
+	proto.RegisterType((*MsgPlaceOrder)(nil), "dydxprotocol.clob.MsgPlaceOrder")
+	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "dydxprotocol.clob.MsgPlaceOrderResponse")
+	proto.RegisterType((*MsgCancelOrder)(nil), "dydxprotocol.clob.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "dydxprotocol.clob.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgReplaceOrder)(nil), "dydxprotocol.clob.MsgReplaceOrder")
+	proto.RegisterType((*MsgReplaceOrderResponse)(nil), "dydxprotocol.clob.MsgReplaceOrderResponse")
+	proto.RegisterType((*MsgBatchPlaceOrders)(nil), "dydxprotocol.clob.MsgBatchPlaceOrders")
+	proto.RegisterType((*MsgBatchPlaceOrdersResponse)(nil), "dydxprotocol.clob.MsgBatchPlaceOrdersResponse")
+	proto.RegisterType((*OrderBatch)(nil), "dydxprotocol.clob.OrderBatch")
+	proto.RegisterType((*MsgBatchCancel)(nil), "dydxprotocol.clob.MsgBatchCancel")
+	proto.RegisterType((*MsgBatchCancelResponse)(nil), "dydxprotocol.clob.MsgBatchCancelResponse")
+	proto.RegisterType((*MsgGrantTradingPermission)(nil), "dydxprotocol.clob.MsgGrantTradingPermission")
+	proto.RegisterType((*MsgGrantTradingPermissionResponse)(nil), "dydxprotocol.clob.MsgGrantTradingPermissionResponse")
+	proto.RegisterType((*MsgRevokeTradingPermission)(nil), "dydxprotocol.clob.MsgRevokeTradingPermission")
+	proto.RegisterType((*MsgRevokeTradingPermissionResponse)(nil), "dydxprotocol.clob.MsgRevokeTradingPermissionResponse")
+	proto.RegisterType((*MsgUpdateClobPair)(nil), "dydxprotocol.clob.MsgUpdateClobPair")
+	proto.RegisterType((*MsgUpdateClobPairResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairResponse")
+	proto.RegisterType((*OperationRaw)(nil), "dydxprotocol.clob.OperationRaw")
+	proto.RegisterType((*ShortTermOrderBatchPlacement)(nil), "dydxprotocol.clob.ShortTermOrderBatchPlacement")
+	proto.RegisterType((*MsgUpdateEquityTierLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration")
+	proto.RegisterType((*MsgUpdateEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse")
+	proto.RegisterType((*MsgUpdateBlockRateLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration")
+	proto.RegisterType((*MsgUpdateBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse")
+	proto.RegisterType((*MsgUpdateLiquidationsConfig)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfig")
+	proto.RegisterType((*MsgUpdateLiquidationsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse")
+	proto.RegisterType((*MsgUpdateMakerUptimeRewardsConfig)(nil), "dydxprotocol.clob.MsgUpdateMakerUptimeRewardsConfig")
+	proto.RegisterType((*MsgUpdateMakerUptimeRewardsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateMakerUptimeRewardsConfigResponse")
 }
 
-func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
+
+var fileDescriptor_19b9e2c0de4ab64a = []byte{
+	// 1606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6f, 0xdc, 0x46,
+	0x12, 0x1e, 0x4a, 0x5e, 0x4b, 0x53, 0x1a, 0xc9, 0x12, 0x25, 0x5b, 0x14, 0x65, 0xbd, 0x68, 0x59,
+	0x90, 0x6c, 0x69, 0xc6, 0x2b, 0x6b, 0xb5, 0x8b, 0xf5, 0x7a, 0x77, 0x33, 0x4e, 0x1c, 0x29, 0xf0,
+	0x40, 0x32, 0x25, 0x03, 0x79, 0x21, 0x04, 0x87, 0x6c, 0x53, 0x8c, 0x48, 0xf6, 0x98, 0xcd, 0xd1,
+	0x03, 0x08, 0x10, 0xc4, 0xb7, 0xdc, 0x72, 0xc8, 0x2d, 0x08, 0x90, 0x1f, 0x90, 0x43, 0x0e, 0xfe,
+	0x07, 0xc9, 0xc1, 0x47, 0xc3, 0xb9, 0x04, 0x08, 0xf2, 0x80, 0x7d, 0xc8, 0x3f, 0xc8, 0x39, 0x60,
+	0x93, 0xec, 0x21, 0x45, 0x72, 0x66, 0xa4, 0x24, 0x40, 0x2e, 0xd2, 0x74, 0xf7, 0x57, 0x55, 0x5f,
+	0x55, 0x57, 0x77, 0x55, 0x13, 0x44, 0xfd, 0x58, 0x3f, 0x6a, 0xb8, 0xd8, 0xc3, 0x1a, 0xb6, 0x2a,
+	0x9a, 0x85, 0xeb, 0x15, 0xef, 0xa8, 0x4c, 0x27, 0xf8, 0x91, 0xf8, 0x5a, 0xd9, 0x5f, 0x13, 0x27,
+	0x34, 0x4c, 0x6c, 0x4c, 0x14, 0x3a, 0x5b, 0x09, 0x06, 0x01, 0x5a, 0x1c, 0x0f, 0x46, 0x15, 0x9b,
+	0x18, 0x95, 0x83, 0xbf, 0xfb, 0xff, 0xc2, 0x85, 0x31, 0x03, 0x1b, 0x38, 0x10, 0xf0, 0x7f, 0x85,
+	0xb3, 0x95, 0xb4, 0xe1, 0xba, 0x85, 0xb5, 0x7d, 0xc5, 0x55, 0x3d, 0xa4, 0x58, 0xa6, 0x6d, 0x7a,
+	0x8a, 0x86, 0x9d, 0x87, 0x66, 0xa4, 0x66, 0x2e, 0x2d, 0xe0, 0xff, 0x51, 0x1a, 0xaa, 0xe9, 0x86,
+	0x90, 0x1b, 0x69, 0x08, 0x7a, 0xd4, 0x34, 0xbd, 0x63, 0xc5, 0x33, 0x91, 0x9b, 0xa5, 0x74, 0x26,
+	0x2d, 0x61, 0xab, 0x9e, 0xb6, 0x87, 0x22, 0xaf, 0xa6, 0xd2, 0x00, 0xec, 0xea, 0x28, 0xb2, 0xb8,
+	0x90, 0xb3, 0xac, 0xb8, 0xc8, 0xc6, 0x07, 0xaa, 0x15, 0xa9, 0xb9, 0x9e, 0xc6, 0x59, 0xe6, 0xa3,
+	0xa6, 0xa9, 0xab, 0x9e, 0x89, 0x1d, 0x92, 0x24, 0x75, 0x33, 0x8b, 0xd4, 0x3e, 0x72, 0x95, 0x66,
+	0xc3, 0x33, 0x6d, 0xa4, 0xb8, 0xe8, 0x50, 0x75, 0xf5, 0x13, 0x42, 0xd7, 0xd2, 0x42, 0x9e, 0xab,
+	0xea, 0xa6, 0x63, 0x28, 0x0d, 0xe4, 0xda, 0x26, 0x21, 0x26, 0x76, 0x42, 0xec, 0x52, 0x02, 0x4b,
+	0x9a, 0x75, 0x55, 0xd3, 0x70, 0xd3, 0xf1, 0x48, 0xec, 0x77, 0x00, 0x95, 0x3e, 0xe3, 0x60, 0xa4,
+	0x46, 0x8c, 0x3b, 0x2e, 0x52, 0x3d, 0x74, 0xc7, 0xc2, 0xf5, 0x6d, 0xd5, 0x74, 0xf9, 0x75, 0x28,
+	0xaa, 0x4d, 0x6f, 0x0f, 0xbb, 0xa6, 0x77, 0x2c, 0x70, 0xb3, 0xdc, 0x62, 0xb1, 0x2a, 0x3c, 0x7f,
+	0xb2, 0x32, 0x16, 0x26, 0xc4, 0x2b, 0xba, 0xee, 0x22, 0x42, 0x76, 0x3c, 0xd7, 0x74, 0x0c, 0xb9,
+	0x05, 0xe5, 0xff, 0x0b, 0x45, 0xb6, 0x67, 0x42, 0xcf, 0x2c, 0xb7, 0x38, 0xb0, 0x3a, 0x59, 0x4e,
+	0x65, 0x59, 0x39, 0xb2, 0x53, 0x3d, 0xf7, 0xf4, 0xc7, 0x99, 0x82, 0xdc, 0xaf, 0x85, 0xe3, 0x7f,
+	0x0f, 0x3d, 0xfe, 0xe5, 0xab, 0x6b, 0x2d, 0x7d, 0xd2, 0x24, 0x4c, 0xa4, 0xc8, 0xc9, 0x88, 0x34,
+	0xb0, 0x43, 0x90, 0xf4, 0x0d, 0x07, 0x17, 0x6b, 0xc4, 0xd8, 0x76, 0x71, 0x03, 0x13, 0xa4, 0x6f,
+	0x35, 0x90, 0x1b, 0x44, 0x9b, 0xdf, 0x86, 0x61, 0xcc, 0x46, 0xca, 0xa3, 0x26, 0x6a, 0x22, 0x81,
+	0x9b, 0xed, 0x5d, 0x1c, 0x58, 0x9d, 0xc9, 0x60, 0xc3, 0x04, 0x65, 0xf5, 0x30, 0x64, 0x74, 0xa1,
+	0x25, 0x7e, 0xdf, 0x97, 0xe6, 0xdf, 0x82, 0xd1, 0x60, 0x8b, 0x74, 0xd4, 0xf0, 0xf6, 0x14, 0xa2,
+	0xda, 0x0d, 0x0b, 0x11, 0xa1, 0x87, 0x2a, 0xbd, 0x92, 0xa1, 0xb4, 0xe6, 0xa3, 0x5f, 0xf5, 0xc1,
+	0x3b, 0x14, 0x1b, 0x2a, 0x1e, 0xb1, 0x4f, 0xcc, 0x13, 0x69, 0x06, 0xa6, 0x32, 0xbd, 0x60, 0x7e,
+	0x2a, 0x30, 0xe8, 0x03, 0x2c, 0x55, 0x43, 0x5b, 0x7e, 0xee, 0xf1, 0x6b, 0xf0, 0x37, 0x9a, 0x84,
+	0x74, 0x67, 0x06, 0x56, 0x85, 0x2c, 0x9f, 0xfc, 0xf5, 0xd0, 0x66, 0x00, 0xe6, 0x05, 0xe8, 0x33,
+	0x5c, 0xd5, 0xf1, 0x10, 0xa2, 0x3b, 0x53, 0x94, 0xa3, 0xa1, 0x34, 0x0e, 0x17, 0x13, 0x06, 0x98,
+	0xe5, 0xe7, 0x1c, 0x0c, 0xf9, 0xf1, 0x57, 0x1d, 0x0d, 0x59, 0x81, 0xed, 0x5b, 0xd0, 0x1f, 0x1c,
+	0x00, 0x53, 0x0f, 0xcd, 0x8b, 0x79, 0xe6, 0x37, 0xf5, 0x90, 0x40, 0x1f, 0x0e, 0x86, 0xfc, 0x02,
+	0x0c, 0x19, 0x18, 0xeb, 0x8a, 0x67, 0x5a, 0x0a, 0xbd, 0x0c, 0x28, 0x93, 0xc1, 0x8d, 0x82, 0x5c,
+	0xf2, 0xe7, 0x77, 0x4d, 0xab, 0xea, 0xcf, 0xf2, 0x15, 0x18, 0x4d, 0xe2, 0x14, 0xff, 0x5c, 0x08,
+	0xbd, 0xb3, 0xdc, 0x62, 0xdf, 0x46, 0x41, 0x1e, 0x8e, 0x83, 0x77, 0x4d, 0x1b, 0xc5, 0x7d, 0x3b,
+	0x97, 0xf0, 0xad, 0x3a, 0x1c, 0x33, 0x89, 0x1d, 0x84, 0x1f, 0x4a, 0x02, 0x5c, 0x4a, 0xfa, 0xc4,
+	0xdc, 0xfd, 0x92, 0x83, 0x0b, 0x35, 0x62, 0xc8, 0xa8, 0xd1, 0x8a, 0x75, 0x15, 0x4a, 0xd8, 0xd2,
+	0x95, 0x53, 0xfb, 0x0c, 0xd8, 0xd2, 0xc3, 0x19, 0xfe, 0x16, 0x14, 0x1d, 0x74, 0x18, 0xe8, 0x10,
+	0x7a, 0xba, 0xda, 0xb3, 0x7e, 0x07, 0x1d, 0x6e, 0x9d, 0xdc, 0xb6, 0xde, 0xe4, 0xb6, 0x4d, 0xc0,
+	0xf8, 0x09, 0xb6, 0xcc, 0x13, 0x03, 0x46, 0x6b, 0xc4, 0xa8, 0xfa, 0x37, 0x5d, 0x6b, 0x5b, 0x09,
+	0xbf, 0x0e, 0xe7, 0x29, 0x09, 0x12, 0x9e, 0x86, 0x4e, 0x2c, 0x42, 0x74, 0x9b, 0xd4, 0x99, 0x82,
+	0xc9, 0x0c, 0x43, 0x8c, 0x47, 0x0d, 0x20, 0xd0, 0xe7, 0x03, 0xf8, 0x59, 0x28, 0xb1, 0xdb, 0x21,
+	0x8a, 0xe5, 0xa0, 0x0c, 0xd1, 0xe9, 0xdf, 0xd4, 0xf9, 0x29, 0x00, 0xcd, 0x32, 0x91, 0xe3, 0x29,
+	0xa6, 0x1e, 0x9c, 0xae, 0x41, 0xb9, 0x18, 0xcc, 0x6c, 0xea, 0x44, 0xfa, 0x35, 0xc8, 0x47, 0xaa,
+	0x2d, 0xd8, 0x40, 0xfe, 0x3e, 0x0c, 0xb6, 0xee, 0xb4, 0xd6, 0x06, 0x2d, 0x24, 0x3d, 0x6b, 0x41,
+	0x48, 0x79, 0x87, 0xfd, 0x66, 0x9b, 0x55, 0x22, 0xb1, 0x39, 0xfe, 0x3e, 0xf0, 0x64, 0x0f, 0xbb,
+	0x9e, 0xe2, 0x21, 0xd7, 0x56, 0x34, 0x6a, 0x27, 0x3a, 0xea, 0x53, 0xb9, 0x11, 0xf3, 0x39, 0x85,
+	0xea, 0x86, 0xa9, 0xf8, 0x2e, 0x72, 0xed, 0x80, 0x24, 0xe1, 0xe7, 0x53, 0x89, 0xdf, 0x4b, 0x7d,
+	0x4f, 0xa6, 0x7d, 0x6e, 0x16, 0x4b, 0x4f, 0x38, 0x9a, 0xb4, 0x31, 0xc7, 0xa3, 0x10, 0xf3, 0x5b,
+	0x30, 0x16, 0x63, 0x4b, 0x9a, 0x9a, 0x86, 0x90, 0x8e, 0x74, 0x81, 0xeb, 0x82, 0xaf, 0xcc, 0x33,
+	0xa6, 0x3b, 0x91, 0x20, 0xbf, 0x09, 0x23, 0x31, 0x85, 0x0f, 0x55, 0xd3, 0x42, 0x7a, 0x57, 0xde,
+	0xcb, 0x17, 0x98, 0xb6, 0xbb, 0x54, 0x4a, 0x32, 0xe8, 0xf5, 0xfd, 0xba, 0xef, 0xc4, 0x6e, 0x50,
+	0xab, 0xb6, 0x59, 0xa9, 0xe2, 0xdf, 0x00, 0x68, 0x15, 0xae, 0x70, 0xdb, 0xe6, 0x33, 0x0c, 0xa4,
+	0x24, 0xa3, 0x13, 0xd6, 0x92, 0x96, 0xae, 0xc0, 0x5c, 0xae, 0x21, 0x96, 0x8c, 0x1f, 0x73, 0x20,
+	0xd2, 0x03, 0x73, 0x80, 0xf7, 0x51, 0x9a, 0xcf, 0x9f, 0x90, 0x49, 0xf9, 0xe7, 0x66, 0x1e, 0xa4,
+	0x7c, 0x2a, 0x8c, 0x71, 0x58, 0x9c, 0x1f, 0x34, 0xf4, 0xbf, 0x6e, 0x71, 0x4e, 0x92, 0x63, 0xd4,
+	0xbf, 0xed, 0x81, 0x52, 0xbc, 0xb0, 0xfa, 0x45, 0x8b, 0x76, 0x5e, 0x61, 0x58, 0x2f, 0xe7, 0x58,
+	0xae, 0xf9, 0x98, 0x8d, 0x82, 0x1c, 0x80, 0xf9, 0xdb, 0x20, 0xc6, 0x92, 0x31, 0xb8, 0x85, 0xe9,
+	0x7d, 0x67, 0x23, 0xc7, 0xa3, 0x4e, 0x94, 0x36, 0x0a, 0xf2, 0x38, 0x4b, 0x3c, 0x9a, 0x8d, 0xdb,
+	0x11, 0x80, 0xbf, 0x0b, 0x83, 0x89, 0x76, 0x8d, 0x1e, 0xbb, 0x9c, 0x2e, 0x20, 0xb8, 0x40, 0x29,
+	0xcc, 0x2f, 0x48, 0x38, 0x36, 0xe6, 0x8f, 0x61, 0x36, 0x45, 0xa3, 0xee, 0x13, 0x8c, 0x91, 0x39,
+	0x47, 0x55, 0x57, 0x32, 0x54, 0xef, 0x24, 0xd8, 0xb5, 0x2e, 0x4b, 0x5f, 0x6c, 0xa3, 0x20, 0x5f,
+	0x26, 0x6d, 0xd6, 0xab, 0x03, 0x50, 0x64, 0xcd, 0x88, 0x74, 0x04, 0x97, 0xdb, 0x29, 0xe3, 0x27,
+	0xa0, 0xdf, 0x3b, 0x52, 0xea, 0xc7, 0x1e, 0x22, 0x34, 0xce, 0x25, 0xb9, 0xcf, 0x3b, 0xaa, 0xfa,
+	0x43, 0xfe, 0x36, 0x14, 0xa3, 0x22, 0x16, 0x5d, 0x66, 0x9d, 0xab, 0x58, 0x7f, 0x58, 0xb9, 0x89,
+	0xf4, 0x13, 0x07, 0x57, 0xd9, 0x6e, 0xbf, 0x46, 0x9b, 0xee, 0x5d, 0x13, 0xb9, 0xf7, 0xfc, 0x96,
+	0xfb, 0x0e, 0xed, 0x53, 0x9b, 0x01, 0xc7, 0x33, 0xa7, 0xa7, 0x03, 0x42, 0x5e, 0x33, 0x2f, 0xf4,
+	0xe4, 0xc6, 0xb6, 0x1d, 0x95, 0xd0, 0x89, 0x8b, 0x28, 0x0b, 0x93, 0x4a, 0xe7, 0x0a, 0xac, 0x74,
+	0xe5, 0x20, 0x4b, 0xf1, 0xef, 0x39, 0x98, 0x67, 0x12, 0xf4, 0x06, 0x97, 0x55, 0x0f, 0xfd, 0x81,
+	0x11, 0xd9, 0x87, 0xf1, 0x9c, 0x27, 0x53, 0x98, 0xc7, 0xe5, 0x8c, 0x80, 0xb4, 0x21, 0x12, 0xc6,
+	0x63, 0xac, 0x9e, 0x01, 0x49, 0x85, 0xa3, 0x0c, 0xcb, 0xdd, 0x38, 0xc7, 0xa2, 0xf1, 0x35, 0x07,
+	0x93, 0x4c, 0xe0, 0x5e, 0xec, 0xed, 0x13, 0xc0, 0xcf, 0x1c, 0x84, 0x77, 0x61, 0x34, 0xe3, 0x25,
+	0x15, 0x66, 0xc4, 0xd5, 0x8c, 0x00, 0xa4, 0x6d, 0x87, 0x7e, 0xf3, 0x56, 0x6a, 0x25, 0xe5, 0xf5,
+	0x55, 0xb8, 0xd2, 0xc6, 0x09, 0xe6, 0xec, 0x0f, 0x1c, 0xcc, 0x31, 0x1c, 0x6d, 0xf5, 0x1f, 0xd0,
+	0xa7, 0x9b, 0x1c, 0xbc, 0xdc, 0x7e, 0xa7, 0xcb, 0x0d, 0x98, 0x6c, 0xf3, 0x1e, 0x0c, 0x5d, 0xbf,
+	0x9e, 0xf7, 0xe8, 0xc8, 0x60, 0x12, 0x06, 0x40, 0xb0, 0x73, 0xd6, 0x53, 0x61, 0xb8, 0x0e, 0x4b,
+	0x1d, 0xdd, 0x8b, 0x82, 0xb1, 0xfa, 0xd1, 0x20, 0xf4, 0xd6, 0x88, 0xc1, 0x37, 0x80, 0xcf, 0x78,
+	0x8b, 0x2d, 0x66, 0xf1, 0xcc, 0x7a, 0xef, 0x88, 0x37, 0xba, 0x45, 0xb2, 0xde, 0xe7, 0x4d, 0x80,
+	0xd8, 0xb3, 0x68, 0x36, 0x47, 0x9e, 0x21, 0xc4, 0xc5, 0x4e, 0x08, 0xa6, 0xf9, 0x1d, 0x18, 0x88,
+	0xbf, 0x7a, 0xe6, 0xb2, 0x05, 0x63, 0x10, 0x71, 0xa9, 0x23, 0x84, 0x29, 0x7f, 0x0f, 0x4a, 0x89,
+	0x37, 0x86, 0x94, 0x2d, 0x1a, 0xc7, 0x88, 0xd7, 0x3a, 0x63, 0x98, 0xfe, 0xf7, 0x61, 0x38, 0xd5,
+	0xfa, 0x2f, 0x64, 0xcb, 0x9f, 0xc4, 0x89, 0xe5, 0xee, 0x70, 0xf1, 0x40, 0xc5, 0xdb, 0xf1, 0xb9,
+	0x36, 0xe2, 0x01, 0x44, 0x5c, 0xea, 0x08, 0x61, 0xca, 0x3f, 0x80, 0x4b, 0x39, 0xcd, 0xe3, 0x72,
+	0xb6, 0x92, 0x6c, 0xb4, 0xb8, 0x76, 0x1a, 0x34, 0xb3, 0xfe, 0x21, 0x8c, 0xe7, 0xf5, 0x8a, 0x2b,
+	0x79, 0xbb, 0x91, 0x09, 0x17, 0xff, 0x71, 0x2a, 0x38, 0x23, 0xa0, 0xc3, 0xd0, 0x89, 0xef, 0x32,
+	0xf3, 0x39, 0x49, 0x96, 0x40, 0x89, 0xcb, 0xdd, 0xa0, 0xe2, 0x56, 0x4e, 0x34, 0x98, 0x39, 0x56,
+	0x92, 0x28, 0x71, 0xb9, 0x1b, 0x14, 0xb3, 0xf2, 0x05, 0x07, 0x52, 0x17, 0xcd, 0xc3, 0xbf, 0xda,
+	0x29, 0x6d, 0x27, 0x29, 0xfe, 0xff, 0xac, 0x92, 0x8c, 0xe2, 0xe7, 0x1c, 0xcc, 0x75, 0x2e, 0xe6,
+	0xff, 0x6c, 0x67, 0xa7, 0x8d, 0xa0, 0xf8, 0xbf, 0x33, 0x0a, 0x32, 0x7e, 0x8f, 0x39, 0x10, 0x72,
+	0xcb, 0x6b, 0xb9, 0x9d, 0xf6, 0x34, 0x5e, 0x5c, 0x3f, 0x1d, 0x9e, 0x91, 0xf8, 0x94, 0x83, 0xe9,
+	0x0e, 0x65, 0x6f, 0xad, 0x9d, 0xea, 0x3c, 0x29, 0xf1, 0x3f, 0x67, 0x91, 0x8a, 0x68, 0x55, 0xb7,
+	0xdf, 0x5e, 0x37, 0x4c, 0x6f, 0xaf, 0x59, 0x2f, 0x6b, 0xd8, 0x4e, 0x7e, 0x7a, 0x3e, 0x58, 0x5b,
+	0xd1, 0xf6, 0x54, 0xd3, 0xa9, 0xb0, 0x99, 0xa3, 0xf0, 0xf3, 0xe9, 0x71, 0x03, 0x91, 0xa7, 0x2f,
+	0xa6, 0xb9, 0x67, 0x2f, 0xa6, 0xb9, 0x9f, 0x5f, 0x4c, 0x73, 0x9f, 0xbc, 0x9c, 0x2e, 0x3c, 0x7b,
+	0x39, 0x5d, 0xf8, 0xee, 0xe5, 0x74, 0xa1, 0x7e, 0x9e, 0xc2, 0x6f, 0xfe, 0x36, 0x00, 0x98, 0x09,
+	0x25, 0xb4, 0x41, 0x17, 0x00, 0x00,
 }
 
-func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ProposedOperations is a temporary message used by block proposers
+	// for matching orders as part of the ABCI++ workaround.
+	ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error)
+	// PlaceOrder allows accounts to place orders on the orderbook.
+	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
+	// CancelOrder allows accounts to cancel existing orders on the orderbook.
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// ReplaceOrder allows accounts to atomically cancel an existing order and
+	// place a new order on the orderbook.
+	ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error)
+	// BatchPlaceOrders allows accounts to place multiple Short-Term orders on
+	// the orderbook in a single transaction.
+	BatchPlaceOrders(ctx context.Context, in *MsgBatchPlaceOrders, opts ...grpc.CallOption) (*MsgBatchPlaceOrdersResponse, error)
+	// BatchCancel allows accounts to cancel multiple Short-Term orders on the
+	// orderbook in a single transaction.
+	BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error)
+	// GrantTradingPermission allows the owner of a subaccount to grant another
+	// address the permission to place and cancel orders on behalf of the
+	// subaccount.
+	GrantTradingPermission(ctx context.Context, in *MsgGrantTradingPermission, opts ...grpc.CallOption) (*MsgGrantTradingPermissionResponse, error)
+	// RevokeTradingPermission allows the owner of a subaccount to revoke a
+	// previously granted trading permission.
+	RevokeTradingPermission(ctx context.Context, in *MsgRevokeTradingPermission, opts ...grpc.CallOption) (*MsgRevokeTradingPermissionResponse, error)
+	// CreateClobPair creates a new clob pair.
+	CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error)
+	// UpdateClobPair sets the status of a clob pair. Should return an error
+	// if the authority is not in the clob keeper's set of authorities,
+	// if the ClobPair id is not found in state, or if the update includes
+	// an unsupported status transition.
+	UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error)
+	// UpdateEquityTierLimitConfiguration updates the equity tier limit
+	// configuration in state.
+	UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
+	// UpdateBlockRateLimitConfiguration updates the block rate limit
+	// configuration in state.
+	UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
+	// UpdateLiquidationsConfig updates the liquidations configuration in state.
+	UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error)
+	// UpdateMakerUptimeRewardsConfig updates the maker uptime rewards
+	// configuration in state.
+	UpdateMakerUptimeRewardsConfig(ctx context.Context, in *MsgUpdateMakerUptimeRewardsConfig, opts ...grpc.CallOption) (*MsgUpdateMakerUptimeRewardsConfigResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error) {
+	out := new(MsgProposedOperationsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ProposedOperations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
+	out := new(MsgPlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error) {
+	out := new(MsgReplaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ReplaceOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BatchPlaceOrders(ctx context.Context, in *MsgBatchPlaceOrders, opts ...grpc.CallOption) (*MsgBatchPlaceOrdersResponse, error) {
+	out := new(MsgBatchPlaceOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/BatchPlaceOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error) {
+	out := new(MsgBatchCancelResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/BatchCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantTradingPermission(ctx context.Context, in *MsgGrantTradingPermission, opts ...grpc.CallOption) (*MsgGrantTradingPermissionResponse, error) {
+	out := new(MsgGrantTradingPermissionResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/GrantTradingPermission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OperationRaw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokeTradingPermission(ctx context.Context, in *MsgRevokeTradingPermission, opts ...grpc.CallOption) (*MsgRevokeTradingPermissionResponse, error) {
+	out := new(MsgRevokeTradingPermissionResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/RevokeTradingPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OperationRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Operation != nil {
-		{
-			size := m.Operation.Size()
-			i -= size
-			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (c *msgClient) CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error) {
+	out := new(MsgCreateClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CreateClobPair", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *OperationRaw_Match) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error) {
+	out := new(MsgUpdateClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateClobPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OperationRaw_Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Match != nil {
-		{
-			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
+	out := new(MsgUpdateEquityTierLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *OperationRaw_ShortTermOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *OperationRaw_ShortTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ShortTermOrderPlacement != nil {
-		i -= len(m.ShortTermOrderPlacement)
-		copy(dAtA[i:], m.ShortTermOrderPlacement)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortTermOrderPlacement)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
+	out := new(MsgUpdateBlockRateLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *OperationRaw_OrderRemoval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *OperationRaw_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OrderRemoval != nil {
-		{
-			size, err := m.OrderRemoval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error) {
+	out := new(MsgUpdateLiquidationsConfigResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *msgClient) UpdateMakerUptimeRewardsConfig(ctx context.Context, in *MsgUpdateMakerUptimeRewardsConfig, opts ...grpc.CallOption) (*MsgUpdateMakerUptimeRewardsConfigResponse, error) {
+	out := new(MsgUpdateMakerUptimeRewardsConfigResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateMakerUptimeRewardsConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ProposedOperations is a temporary message used by block proposers
+	// for matching orders as part of the ABCI++ workaround.
+	ProposedOperations(context.Context, *MsgProposedOperations) (*MsgProposedOperationsResponse, error)
+	// PlaceOrder allows accounts to place orders on the orderbook.
+	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
+	// CancelOrder allows accounts to cancel existing orders on the orderbook.
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	// ReplaceOrder allows accounts to atomically cancel an existing order and
+	// place a new order on the orderbook.
+	ReplaceOrder(context.Context, *MsgReplaceOrder) (*MsgReplaceOrderResponse, error)
+	// BatchPlaceOrders allows accounts to place multiple Short-Term orders on
+	// the orderbook in a single transaction.
+	BatchPlaceOrders(context.Context, *MsgBatchPlaceOrders) (*MsgBatchPlaceOrdersResponse, error)
+	// BatchCancel allows accounts to cancel multiple Short-Term orders on the
+	// orderbook in a single transaction.
+	BatchCancel(context.Context, *MsgBatchCancel) (*MsgBatchCancelResponse, error)
+	// GrantTradingPermission allows the owner of a subaccount to grant another
+	// address the permission to place and cancel orders on behalf of the
+	// subaccount.
+	GrantTradingPermission(context.Context, *MsgGrantTradingPermission) (*MsgGrantTradingPermissionResponse, error)
+	// RevokeTradingPermission allows the owner of a subaccount to revoke a
+	// previously granted trading permission.
+	RevokeTradingPermission(context.Context, *MsgRevokeTradingPermission) (*MsgRevokeTradingPermissionResponse, error)
+	// CreateClobPair creates a new clob pair.
+	CreateClobPair(context.Context, *MsgCreateClobPair) (*MsgCreateClobPairResponse, error)
+	// UpdateClobPair sets the status of a clob pair. Should return an error
+	// if the authority is not in the clob keeper's set of authorities,
+	// if the ClobPair id is not found in state, or if the update includes
+	// an unsupported status transition.
+	UpdateClobPair(context.Context, *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error)
+	// UpdateEquityTierLimitConfiguration updates the equity tier limit
+	// configuration in state.
+	UpdateEquityTierLimitConfiguration(context.Context, *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
+	// UpdateBlockRateLimitConfiguration updates the block rate limit
+	// configuration in state.
+	UpdateBlockRateLimitConfiguration(context.Context, *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
+	// UpdateLiquidationsConfig updates the liquidations configuration in state.
+	UpdateLiquidationsConfig(context.Context, *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error)
+	// UpdateMakerUptimeRewardsConfig updates the maker uptime rewards
+	// configuration in state.
+	UpdateMakerUptimeRewardsConfig(context.Context, *MsgUpdateMakerUptimeRewardsConfig) (*MsgUpdateMakerUptimeRewardsConfigResponse, error)
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) ProposedOperations(ctx context.Context, req *MsgProposedOperations) (*MsgProposedOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposedOperations not implemented")
 }
-
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceOrder(ctx context.Context, req *MsgReplaceOrder) (*MsgReplaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) BatchPlaceOrders(ctx context.Context, req *MsgBatchPlaceOrders) (*MsgBatchPlaceOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPlaceOrders not implemented")
+}
+func (*UnimplementedMsgServer) BatchCancel(ctx context.Context, req *MsgBatchCancel) (*MsgBatchCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancel not implemented")
+}
+func (*UnimplementedMsgServer) GrantTradingPermission(ctx context.Context, req *MsgGrantTradingPermission) (*MsgGrantTradingPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantTradingPermission not implemented")
+}
+func (*UnimplementedMsgServer) RevokeTradingPermission(ctx context.Context, req *MsgRevokeTradingPermission) (*MsgRevokeTradingPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTradingPermission not implemented")
+}
+func (*UnimplementedMsgServer) CreateClobPair(ctx context.Context, req *MsgCreateClobPair) (*MsgCreateClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClobPair not implemented")
+}
+func (*UnimplementedMsgServer) UpdateClobPair(ctx context.Context, req *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClobPair not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEquityTierLimitConfiguration(ctx context.Context, req *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEquityTierLimitConfiguration not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBlockRateLimitConfiguration(ctx context.Context, req *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockRateLimitConfiguration not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLiquidationsConfig(ctx context.Context, req *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationsConfig not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMakerUptimeRewardsConfig(ctx context.Context, req *MsgUpdateMakerUptimeRewardsConfig) (*MsgUpdateMakerUptimeRewardsConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMakerUptimeRewardsConfig not implemented")
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ProposedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposedOperations)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateBlockRateLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).ProposedOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ProposedOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposedOperations(ctx, req.(*MsgProposedOperations))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceOrder(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceOrder(ctx, req.(*MsgReplaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_BatchPlaceOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchPlaceOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchPlaceOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/BatchPlaceOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchPlaceOrders(ctx, req.(*MsgBatchPlaceOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BatchCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancel)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/BatchCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancel(ctx, req.(*MsgBatchCancel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateLiquidationsConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Msg_GrantTradingPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantTradingPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantTradingPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/GrantTradingPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantTradingPermission(ctx, req.(*MsgGrantTradingPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_RevokeTradingPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeTradingPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeTradingPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/RevokeTradingPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeTradingPermission(ctx, req.(*MsgRevokeTradingPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClobPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClobPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/CreateClobPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClobPair(ctx, req.(*MsgCreateClobPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClobPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClobPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateClobPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClobPair(ctx, req.(*MsgUpdateClobPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEquityTierLimitConfiguration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, req.(*MsgUpdateEquityTierLimitConfiguration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockRateLimitConfiguration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, req.(*MsgUpdateBlockRateLimitConfiguration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateLiquidationsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLiquidationsConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, req.(*MsgUpdateLiquidationsConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMakerUptimeRewardsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMakerUptimeRewardsConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMakerUptimeRewardsConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateMakerUptimeRewardsConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMakerUptimeRewardsConfig(ctx, req.(*MsgUpdateMakerUptimeRewardsConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.clob.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProposedOperations",
+			Handler:    _Msg_ProposedOperations_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Msg_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Msg_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "BatchPlaceOrders",
+			Handler:    _Msg_BatchPlaceOrders_Handler,
+		},
+		{
+			MethodName: "BatchCancel",
+			Handler:    _Msg_BatchCancel_Handler,
+		},
+		{
+			MethodName: "GrantTradingPermission",
+			Handler:    _Msg_GrantTradingPermission_Handler,
+		},
+		{
+			MethodName: "RevokeTradingPermission",
+			Handler:    _Msg_RevokeTradingPermission_Handler,
+		},
+		{
+			MethodName: "CreateClobPair",
+			Handler:    _Msg_CreateClobPair_Handler,
+		},
+		{
+			MethodName: "UpdateClobPair",
+			Handler:    _Msg_UpdateClobPair_Handler,
+		},
+		{
+			MethodName: "UpdateEquityTierLimitConfiguration",
+			Handler:    _Msg_UpdateEquityTierLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "UpdateBlockRateLimitConfiguration",
+			Handler:    _Msg_UpdateBlockRateLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "UpdateLiquidationsConfig",
+			Handler:    _Msg_UpdateLiquidationsConfig_Handler,
+		},
+		{
+			MethodName: "UpdateMakerUptimeRewardsConfig",
+			Handler:    _Msg_UpdateMakerUptimeRewardsConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/clob/tx.proto",
+}
+
+func (m *MsgCreateClobPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClobPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1945,7 +2478,7 @@ func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateClobPairResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1955,12 +2488,12 @@ func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1968,277 +2501,3116 @@ func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateClobPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgProposedOperations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.ClobPair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgProposedOperations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProposedOperations) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgProposedOperations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OperationsQueue) > 0 {
-		for _, e := range m.OperationsQueue {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.MakerDepthSamples) > 0 {
+		for iNdEx := len(m.MakerDepthSamples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MakerDepthSamples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.OperationsQueue) > 0 {
+		for iNdEx := len(m.OperationsQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperationsQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProposedOperationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposedOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPlaceOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgProposedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPlaceOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgProposedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OrderId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.GoodTilOneof != nil {
-		n += m.GoodTilOneof.Size()
+func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelOrder_GoodTilBlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovTx(uint64(m.GoodTilBlock))
-	return n
+func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgCancelOrder_GoodTilBlockTime) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	n += 5
-	return n
-}
-func (m *MsgCancelOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	return n
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateClobPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.ClobPair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperationRaw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Operation != nil {
-		n += m.Operation.Size()
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperationRaw_Match) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Match != nil {
-		l = m.Match.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *OperationRaw_ShortTermOrderPlacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShortTermOrderPlacement != nil {
-		l = len(m.ShortTermOrderPlacement)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *OperationRaw_OrderRemoval) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderRemoval != nil {
-		l = m.OrderRemoval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-func (m *MsgUpdateEquityTierLimitConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GoodTilOneof != nil {
+		{
+			size := m.GoodTilOneof.Size()
+			i -= size
+			if _, err := m.GoodTilOneof.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.EquityTierLimitConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCancelOrder_GoodTilBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.BlockRateLimitConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgCancelOrder_GoodTilBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *MsgCancelOrder_GoodTilBlockTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelOrder_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GoodTilBlockTime))
+	i--
+	dAtA[i] = 0x1d
+	return len(dAtA) - i, nil
+}
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateLiquidationsConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReplaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.NewOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OldOrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchPlaceOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchPlaceOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchPlaceOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchPlaceOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchPlaceOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchPlaceOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		dAtA7 := make([]byte, len(m.ClientIds)*10)
+		var j6 int
+		for _, num := range m.ClientIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GoodTilBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ShortTermCancels) > 0 {
+		for iNdEx := len(m.ShortTermCancels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermCancels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShortTermFailed) > 0 {
+		for iNdEx := len(m.ShortTermFailed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermFailed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ShortTermSucceeded) > 0 {
+		for iNdEx := len(m.ShortTermSucceeded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermSucceeded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantTradingPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantTradingPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantTradingPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Permission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantTradingPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantTradingPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantTradingPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTradingPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTradingPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTradingPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTradingPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTradingPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTradingPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationRaw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size := m.Operation.Size()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationRaw_Match) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Match != nil {
+		{
+			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_ShortTermOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_ShortTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShortTermOrderPlacement != nil {
+		i -= len(m.ShortTermOrderPlacement)
+		copy(dAtA[i:], m.ShortTermOrderPlacement)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortTermOrderPlacement)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_OrderRemoval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderRemoval != nil {
+		{
+			size, err := m.OrderRemoval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_ShortTermOrderBatchPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_ShortTermOrderBatchPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShortTermOrderBatchPlacement != nil {
+		{
+			size, err := m.ShortTermOrderBatchPlacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShortTermOrderBatchPlacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShortTermOrderBatchPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShortTermOrderBatchPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		for iNdEx := len(m.OrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationsConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMakerUptimeRewardsConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMakerUptimeRewardsConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMakerUptimeRewardsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MakerUptimeRewardsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClobPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ClobPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposedOperations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperationsQueue) > 0 {
+		for _, e := range m.OperationsQueue {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MakerDepthSamples) > 0 {
+		for _, e := range m.MakerDepthSamples {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposedOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPlaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GoodTilOneof != nil {
+		n += m.GoodTilOneof.Size()
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOrder_GoodTilBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.GoodTilBlock))
+	return n
+}
+func (m *MsgCancelOrder_GoodTilBlockTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 5
+	return n
+}
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldOrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.NewOrder.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBatchPlaceOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchPlaceOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OrderBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovTx(uint64(m.ClobPairId))
+	}
+	if len(m.ClientIds) > 0 {
+		l = 0
+		for _, e := range m.ClientIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgBatchCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ShortTermCancels) > 0 {
+		for _, e := range m.ShortTermCancels {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GoodTilBlock != 0 {
+		n += 1 + sovTx(uint64(m.GoodTilBlock))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShortTermSucceeded) > 0 {
+		for _, e := range m.ShortTermSucceeded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ShortTermFailed) > 0 {
+		for _, e := range m.ShortTermFailed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantTradingPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Permission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantTradingPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeTradingPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeTradingPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateClobPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ClobPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OperationRaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		n += m.Operation.Size()
+	}
+	return n
+}
+
+func (m *OperationRaw_Match) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Match != nil {
+		l = m.Match.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_ShortTermOrderPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortTermOrderPlacement != nil {
+		l = len(m.ShortTermOrderPlacement)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_OrderRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderRemoval != nil {
+		l = m.OrderRemoval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_ShortTermOrderBatchPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortTermOrderBatchPlacement != nil {
+		l = m.ShortTermOrderBatchPlacement.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *ShortTermOrderBatchPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.OrderIds) > 0 {
+		for _, e := range m.OrderIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.EquityTierLimitConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BlockRateLimitConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLiquidationsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LiquidationsConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMakerUptimeRewardsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MakerUptimeRewardsConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClobPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClobPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposedOperations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationsQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationsQueue = append(m.OperationsQueue, OperationRaw{})
+			if err := m.OperationsQueue[len(m.OperationsQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerDepthSamples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MakerDepthSamples = append(m.MakerDepthSamples, MakerDepthSample{})
+			if err := m.MakerDepthSamples[len(m.MakerDepthSamples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposedOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{v}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldOrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchPlaceOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchPlaceOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchPlaceOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchPlaceOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchPlaceOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchPlaceOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClientIds = append(m.ClientIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClientIds) == 0 {
+					m.ClientIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClientIds = append(m.ClientIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermCancels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortTermCancels = append(m.ShortTermCancels, OrderBatch{})
+			if err := m.ShortTermCancels[len(m.ShortTermCancels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			}
+			m.GoodTilBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermSucceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortTermSucceeded = append(m.ShortTermSucceeded, &OrderBatch{})
+			if err := m.ShortTermSucceeded[len(m.ShortTermSucceeded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermFailed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortTermFailed = append(m.ShortTermFailed, &OrderBatch{})
+			if err := m.ShortTermFailed[len(m.ShortTermFailed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.LiquidationsConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantTradingPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2261,47 +5633,15 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClobPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantTradingPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantTradingPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2328,7 +5668,7 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Permission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2353,7 +5693,7 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantTradingPermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2376,10 +5716,10 @@ func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClobPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantTradingPermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantTradingPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2403,7 +5743,7 @@ func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeTradingPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2426,15 +5766,15 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposedOperations: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeTradingPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeTradingPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationsQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2461,11 +5801,42 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperationsQueue = append(m.OperationsQueue, OperationRaw{})
-			if err := m.OperationsQueue[len(m.OperationsQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2487,7 +5858,7 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeTradingPermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2510,10 +5881,10 @@ func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposedOperationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeTradingPermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeTradingPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2537,7 +5908,7 @@ func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,15 +5931,47 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClobPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2595,7 +5998,7 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2620,7 +6023,7 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClobPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2643,10 +6046,10 @@ func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClobPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2670,7 +6073,7 @@ func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+func (m *OperationRaw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2693,15 +6096,15 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationRaw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationRaw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2728,15 +6131,17 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ClobMatch{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Operation = &OperationRaw_Match{v}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermOrderPlacement", wireType)
 			}
-			var v uint32
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2746,73 +6151,95 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{v}
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{v}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Operation = &OperationRaw_ShortTermOrderPlacement{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderRemoval", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			v := &OrderRemoval{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &OperationRaw_OrderRemoval{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermOrderBatchPlacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &ShortTermOrderBatchPlacement{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Operation = &OperationRaw_ShortTermOrderBatchPlacement{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2834,7 +6261,7 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
+func (m *ShortTermOrderBatchPlacement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2857,17 +6284,17 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClobPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShortTermOrderBatchPlacement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShortTermOrderBatchPlacement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2877,27 +6304,29 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2924,7 +6353,8 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrderIds = append(m.OrderIds, OrderId{})
+			if err := m.OrderIds[len(m.OrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2949,57 +6379,7 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClobPairResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClobPairResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperationRaw) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEquityTierLimitConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3022,17 +6402,17 @@ func (m *OperationRaw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationRaw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEquityTierLimitConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationRaw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEquityTierLimitConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3042,32 +6422,29 @@ func (m *OperationRaw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ClobMatch{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Operation = &OperationRaw_Match{v}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermOrderPlacement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3077,60 +6454,75 @@ func (m *OperationRaw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Operation = &OperationRaw_ShortTermOrderPlacement{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderRemoval", wireType)
+			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OrderRemoval{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Operation = &OperationRaw_OrderRemoval{v}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3152,7 +6544,7 @@ func (m *OperationRaw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBlockRateLimitConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3175,10 +6567,10 @@ func (m *MsgUpdateEquityTierLimitConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEquityTierLimitConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBlockRateLimitConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEquityTierLimitConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBlockRateLimitConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3213,9 +6605,9 @@ func (m *MsgUpdateEquityTierLimitConfiguration) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRateLimitConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3242,7 +6634,7 @@ func (m *MsgUpdateEquityTierLimitConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockRateLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3267,7 +6659,7 @@ func (m *MsgUpdateEquityTierLimitConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3290,10 +6682,10 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBlockRateLimitConfigurationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBlockRateLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3317,7 +6709,7 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLiquidationsConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3340,10 +6732,10 @@ func (m *MsgUpdateBlockRateLimitConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBlockRateLimitConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLiquidationsConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBlockRateLimitConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLiquidationsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3378,9 +6770,9 @@ func (m *MsgUpdateBlockRateLimitConfiguration) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRateLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3407,7 +6799,7 @@ func (m *MsgUpdateBlockRateLimitConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRateLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3432,7 +6824,7 @@ func (m *MsgUpdateBlockRateLimitConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLiquidationsConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3455,10 +6847,10 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBlockRateLimitConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLiquidationsConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBlockRateLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLiquidationsConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3482,7 +6874,7 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *MsgUpdateLiquidationsConfig) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMakerUptimeRewardsConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3505,10 +6897,10 @@ func (m *MsgUpdateLiquidationsConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateLiquidationsConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMakerUptimeRewardsConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateLiquidationsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMakerUptimeRewardsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3545,7 +6937,7 @@ func (m *MsgUpdateLiquidationsConfig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerUptimeRewardsConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3572,7 +6964,7 @@ func (m *MsgUpdateLiquidationsConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerUptimeRewardsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3597,7 +6989,7 @@ func (m *MsgUpdateLiquidationsConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateLiquidationsConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMakerUptimeRewardsConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3620,10 +7012,10 @@ func (m *MsgUpdateLiquidationsConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateLiquidationsConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMakerUptimeRewardsConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateLiquidationsConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMakerUptimeRewardsConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -23,6 +23,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryPerpetualFeeParams())
 	cmd.AddCommand(CmdQueryUserFeeTier())
+	cmd.AddCommand(CmdQueryReferralParams())
+	cmd.AddCommand(CmdQueryReferralCode())
+	cmd.AddCommand(CmdQueryUserReferral())
+	cmd.AddCommand(CmdQueryFeeTierOverrides())
+	cmd.AddCommand(CmdQueryMarketFeeSchedules())
 
 	return cmd
 }
@@ -75,3 +80,124 @@ func CmdQueryUserFeeTier() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryReferralParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-referral-params",
+		Short: "get the ReferralParams",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ReferralParams(
+				context.Background(),
+				&types.QueryReferralParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryReferralCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-referral-code [code]",
+		Short: "get the owner of a referral code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ReferralCode(
+				context.Background(),
+				&types.QueryReferralCodeRequest{
+					Code: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryUserReferral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-user-referral [user]",
+		Short: "get the referral code and referrer of a User",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.UserReferral(
+				context.Background(),
+				&types.QueryUserReferralRequest{
+					User: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFeeTierOverrides() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-fee-tier-overrides",
+		Short: "get all fee tier overrides",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeTierOverrides(
+				context.Background(),
+				&types.QueryFeeTierOverridesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryMarketFeeSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-market-fee-schedules",
+		Short: "get all market fee schedules",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketFeeSchedules(
+				context.Background(),
+				&types.QueryMarketFeeSchedulesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
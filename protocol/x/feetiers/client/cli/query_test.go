@@ -62,3 +62,46 @@ func TestQueryUserFeeTier(t *testing.T) {
 	var resp types.QueryUserFeeTierResponse
 	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
 }
+
+func TestQueryReferralParams(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryReferralParams(), []string{})
+
+	require.NoError(t, err)
+	var resp types.QueryReferralParamsResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, types.ReferralParams{}, resp.Params)
+}
+
+func TestQueryUserReferral(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryUserReferral(), []string{"alice"})
+
+	require.NoError(t, err)
+	var resp types.QueryUserReferralResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+}
+
+func TestQueryFeeTierOverrides(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryFeeTierOverrides(), []string{})
+
+	require.NoError(t, err)
+	var resp types.QueryFeeTierOverridesResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Empty(t, resp.Overrides)
+}
+
+func TestQueryMarketFeeSchedules(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryMarketFeeSchedules(), []string{})
+
+	require.NoError(t, err)
+	var resp types.QueryMarketFeeSchedulesResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Empty(t, resp.Schedules)
+}
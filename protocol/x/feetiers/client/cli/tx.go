@@ -18,5 +18,9 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
+
+	cmd.AddCommand(CmdRegisterReferralCode())
+	cmd.AddCommand(CmdSetReferrer())
+
 	return cmd
 }
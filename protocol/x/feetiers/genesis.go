@@ -13,11 +13,42 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetPerpetualFeeParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+
+	if err := k.SetReferralParams(ctx, genState.ReferralParams); err != nil {
+		panic(err)
+	}
+	for _, referralCode := range genState.ReferralCodes {
+		k.SetReferralCode(ctx, referralCode)
+	}
+	for _, referral := range genState.Referrals {
+		k.SetReferral(ctx, referral)
+	}
+
+	for _, override := range genState.FeeTierOverrides {
+		// Expired overrides no longer apply and are not imported.
+		if !override.ExpiryTime.After(ctx.BlockTime()) {
+			continue
+		}
+		if err := k.SetFeeTierOverride(ctx, override); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, schedule := range genState.MarketFeeSchedules {
+		if err := k.SetMarketFeeSchedule(ctx, schedule); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the feetiers module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetPerpetualFeeParams(ctx),
+		Params:             k.GetPerpetualFeeParams(ctx),
+		ReferralParams:     k.GetReferralParams(ctx),
+		ReferralCodes:      append([]types.ReferralCode{}, k.GetAllReferralCodes(ctx)...),
+		Referrals:          append([]types.Referral{}, k.GetAllReferrals(ctx)...),
+		FeeTierOverrides:   append([]types.FeeTierOverride{}, k.GetAllFeeTierOverrides(ctx)...),
+		MarketFeeSchedules: append([]types.MarketFeeSchedule{}, k.GetAllMarketFeeSchedules(ctx)...),
 	}
 }
@@ -2,8 +2,10 @@ package feetiers_test
 
 import (
 	"testing"
+	"time"
 
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	feetiers "github.com/dydxprotocol/v4-chain/protocol/x/feetiers"
 	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
 	"github.com/stretchr/testify/require"
@@ -16,3 +18,82 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 	require.Equal(t, types.DefaultGenesis(), got)
 }
+
+func TestGenesis_Referrals(t *testing.T) {
+	genesisState := *types.DefaultGenesis()
+	genesisState.ReferralParams = types.ReferralParams{
+		ReferrerRebateSharePpm:     100_000,
+		RefereeTakerFeeDiscountPpm: 50_000,
+	}
+	genesisState.ReferralCodes = []types.ReferralCode{
+		{Code: "alice", Owner: constants.AliceAccAddress.String()},
+	}
+	genesisState.Referrals = []types.Referral{
+		{Referee: constants.BobAccAddress.String(), Referrer: constants.AliceAccAddress.String()},
+	}
+
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	feetiers.InitGenesis(ctx, k, genesisState)
+	require.Equal(t, "alice", k.GetOwnerReferralCode(ctx, constants.AliceAccAddress.String()))
+	referrer, found := k.GetReferrer(ctx, constants.BobAccAddress.String())
+	require.True(t, found)
+	require.Equal(t, constants.AliceAccAddress.String(), referrer)
+
+	got := feetiers.ExportGenesis(ctx, k)
+	require.Equal(t, genesisState, *got)
+}
+
+func TestGenesis_FeeTierOverrides(t *testing.T) {
+	genesisTime := time.Unix(1_000, 0).UTC()
+	activeOverride := types.FeeTierOverride{
+		Address:      constants.AliceAccAddress.String(),
+		FeeTierIndex: 8,
+		ExpiryTime:   genesisTime.Add(time.Hour),
+	}
+	expiredOverride := types.FeeTierOverride{
+		Address:      constants.BobAccAddress.String(),
+		FeeTierIndex: 8,
+		ExpiryTime:   genesisTime,
+	}
+	genesisState := *types.DefaultGenesis()
+	genesisState.FeeTierOverrides = []types.FeeTierOverride{activeOverride, expiredOverride}
+
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain().WithBlockTime(genesisTime)
+	k := tApp.App.FeeTiersKeeper
+
+	feetiers.InitGenesis(ctx, k, genesisState)
+
+	// Overrides that have expired by the genesis time are not imported.
+	got := feetiers.ExportGenesis(ctx, k)
+	require.Equal(t, []types.FeeTierOverride{activeOverride}, got.FeeTierOverrides)
+}
+
+func TestGenesis_MarketFeeSchedules(t *testing.T) {
+	genesisState := *types.DefaultGenesis()
+	genesisState.MarketFeeSchedules = []types.MarketFeeSchedule{
+		{
+			ClobPairId:            0,
+			EffectiveFrom:         time.Unix(1_000, 0).UTC(),
+			MakerFeeMultiplierPpm: 0,
+			TakerFeeMultiplierPpm: 500_000,
+		},
+		{
+			ClobPairId:            1,
+			EffectiveFrom:         time.Unix(2_000, 0).UTC(),
+			MakerFeeMultiplierPpm: 1_000_000,
+			TakerFeeMultiplierPpm: 2_000_000,
+		},
+	}
+
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	feetiers.InitGenesis(ctx, k, genesisState)
+	got := feetiers.ExportGenesis(ctx, k)
+	require.Equal(t, genesisState.MarketFeeSchedules, got.MarketFeeSchedules)
+}
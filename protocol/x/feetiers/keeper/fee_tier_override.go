@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+)
+
+// GetFeeTierOverride returns the fee tier override of `address` and whether it exists. The returned
+// override may have expired.
+func (k Keeper) GetFeeTierOverride(
+	ctx sdk.Context,
+	address string,
+) (
+	override types.FeeTierOverride,
+	found bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeeTierOverrideKeyPrefix))
+	b := store.Get([]byte(address))
+	if b == nil {
+		return override, false
+	}
+
+	k.cdc.MustUnmarshal(b, &override)
+	return override, true
+}
+
+// GetAllFeeTierOverrides returns all fee tier overrides in state, including expired ones.
+func (k Keeper) GetAllFeeTierOverrides(
+	ctx sdk.Context,
+) (list []types.FeeTierOverride) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeeTierOverrideKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeTierOverride
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// SetFeeTierOverride creates or replaces the fee tier override of `override.Address`.
+// Returns an error if the override is invalid, grants a fee tier that does not exist,
+// or does not expire after the current block time.
+func (k Keeper) SetFeeTierOverride(
+	ctx sdk.Context,
+	override types.FeeTierOverride,
+) error {
+	if err := override.Validate(); err != nil {
+		return err
+	}
+
+	numTiers := uint32(len(k.GetPerpetualFeeParams(ctx).Tiers))
+	if override.FeeTierIndex >= numTiers {
+		return errorsmod.Wrapf(
+			types.ErrInvalidFeeTierIndex,
+			"fee tier override grants fee tier %d, but there are only %d fee tiers",
+			override.FeeTierIndex,
+			numTiers,
+		)
+	}
+
+	if !override.ExpiryTime.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(
+			types.ErrFeeTierOverrideExpired,
+			"expiry time %v, block time %v",
+			override.ExpiryTime,
+			ctx.BlockTime(),
+		)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeeTierOverrideKeyPrefix))
+	store.Set([]byte(override.Address), k.cdc.MustMarshal(&override))
+
+	return nil
+}
+
+// DeleteFeeTierOverride deletes the fee tier override of `address`.
+// Returns an error if `address` has no fee tier override.
+func (k Keeper) DeleteFeeTierOverride(
+	ctx sdk.Context,
+	address string,
+) error {
+	if _, found := k.GetFeeTierOverride(ctx, address); !found {
+		return errorsmod.Wrapf(types.ErrFeeTierOverrideNotFound, "address %s", address)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeeTierOverrideKeyPrefix))
+	store.Delete([]byte(address))
+
+	return nil
+}
+
+// getActiveFeeTierOverride returns the fee tier override of `address` if it has not expired and
+// grants a fee tier that still exists in `tiers`.
+func (k Keeper) getActiveFeeTierOverride(
+	ctx sdk.Context,
+	address string,
+	tiers []*types.PerpetualFeeTier,
+) (
+	override types.FeeTierOverride,
+	found bool,
+) {
+	override, found = k.GetFeeTierOverride(ctx, address)
+	if !found ||
+		!override.ExpiryTime.After(ctx.BlockTime()) ||
+		override.FeeTierIndex >= uint32(len(tiers)) {
+		return types.FeeTierOverride{}, false
+	}
+
+	return override, true
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	stattypes "github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Bonded amount of each validator account in the test genesis.
+var genesisBondedAmount = new(big.Int).Mul(big.NewInt(500_000), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+
+func TestSetFeeTierOverride(t *testing.T) {
+	tests := map[string]struct {
+		override    types.FeeTierOverride
+		expectedErr error
+	}{
+		"Success": {
+			override: types.FeeTierOverride{
+				Address:      alice,
+				FeeTierIndex: 2,
+				ExpiryTime:   time.Unix(100, 0).UTC(),
+			},
+		},
+		"Failure: invalid address": {
+			override: types.FeeTierOverride{
+				Address:      "alice",
+				FeeTierIndex: 2,
+				ExpiryTime:   time.Unix(100, 0).UTC(),
+			},
+			expectedErr: types.ErrInvalidAccountAddress,
+		},
+		"Failure: fee tier does not exist": {
+			override: types.FeeTierOverride{
+				Address:      alice,
+				FeeTierIndex: 9,
+				ExpiryTime:   time.Unix(100, 0).UTC(),
+			},
+			expectedErr: types.ErrInvalidFeeTierIndex,
+		},
+		"Failure: expires at block time": {
+			override: types.FeeTierOverride{
+				Address:      alice,
+				FeeTierIndex: 2,
+				ExpiryTime:   time.Unix(10, 0).UTC(),
+			},
+			expectedErr: types.ErrFeeTierOverrideExpired,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain().WithBlockTime(time.Unix(10, 0).UTC())
+			k := tApp.App.FeeTiersKeeper
+
+			err := k.SetFeeTierOverride(ctx, tc.override)
+			override, found := k.GetFeeTierOverride(ctx, tc.override.Address)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.False(t, found)
+				require.Empty(t, k.GetAllFeeTierOverrides(ctx))
+			} else {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, tc.override, override)
+				require.Equal(t, []types.FeeTierOverride{tc.override}, k.GetAllFeeTierOverrides(ctx))
+			}
+		})
+	}
+}
+
+func TestDeleteFeeTierOverride(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	require.ErrorIs(t, k.DeleteFeeTierOverride(ctx, alice), types.ErrFeeTierOverrideNotFound)
+
+	require.NoError(t, k.SetFeeTierOverride(ctx, types.FeeTierOverride{
+		Address:      alice,
+		FeeTierIndex: 1,
+		ExpiryTime:   time.Unix(100, 0).UTC(),
+	}))
+	require.NoError(t, k.DeleteFeeTierOverride(ctx, alice))
+
+	_, found := k.GetFeeTierOverride(ctx, alice)
+	require.False(t, found)
+	require.Empty(t, k.GetAllFeeTierOverrides(ctx))
+}
+
+func TestGetUserFeeTier_StakingAndOverrides(t *testing.T) {
+	tests := map[string]struct {
+		user         string
+		userStats    *stattypes.UserStats
+		stakingTiers []types.StakingFeeTier
+		override     *types.FeeTierOverride
+		blockTime    time.Time
+
+		expectedIndex  uint32
+		expectedReason types.FeeTierReason
+	}{
+		"volume tier without staking fee tiers": {
+			user:           alice,
+			userStats:      &stattypes.UserStats{TakerNotional: 1_000},
+			expectedIndex:  1,
+			expectedReason: types.FeeTierReason_FEE_TIER_REASON_VOLUME,
+		},
+		"staking fee tier is higher than volume tier": {
+			user:      alice,
+			userStats: &stattypes.UserStats{TakerNotional: 1_000},
+			stakingTiers: []types.StakingFeeTier{
+				{MinBondedAmount: dtypes.NewInt(1), FeeTierIndex: 1},
+				{MinBondedAmount: dtypes.NewIntFromBigInt(genesisBondedAmount), FeeTierIndex: 2},
+				{
+					MinBondedAmount: dtypes.NewIntFromBigInt(new(big.Int).Add(genesisBondedAmount, big.NewInt(1))),
+					FeeTierIndex:    3,
+				},
+			},
+			expectedIndex:  2,
+			expectedReason: types.FeeTierReason_FEE_TIER_REASON_STAKING,
+		},
+		"staking requirement not met": {
+			user:      alice,
+			userStats: &stattypes.UserStats{TakerNotional: 1_000},
+			stakingTiers: []types.StakingFeeTier{
+				{
+					MinBondedAmount: dtypes.NewIntFromBigInt(new(big.Int).Add(genesisBondedAmount, big.NewInt(1))),
+					FeeTierIndex:    3,
+				},
+			},
+			expectedIndex:  1,
+			expectedReason: types.FeeTierReason_FEE_TIER_REASON_VOLUME,
+		},
+		"volume tier is at least staking fee tier": {
+			user:      alice,
+			userStats: &stattypes.UserStats{TakerNotional: 1_000},
+			stakingTiers: []types.StakingFeeTier{
+				{MinBondedAmount: dtypes.NewInt(1), FeeTierIndex: 1},
+			},
+			expectedIndex:  1,
+			expectedReason: types.FeeTierReason_FEE_TIER_REASON_VOLUME,
+		},
+		"invalid address does not qualify for staking fee tiers": {
+			user:      "alice",
+			userStats: &stattypes.UserStats{},
+			stakingTiers: []types.StakingFeeTier{
+				{MinBondedAmount: dtypes.NewInt(1), FeeTierIndex: 1},
+			},
+			expectedIndex:  0,
+			expectedReason: types.FeeTierReason_FEE_TIER_REASON_VOLUME,
+		},
+		"override takes precedence over volume and staking": {
+			user:      alice,
+			userStats: &stattypes.UserStats{TakerNotional: 1_000},
+			stakingTiers: []types.StakingFeeTier{
+				{MinBondedAmount: dtypes.NewInt(1), FeeTierIndex: 2},
+			},
+			override: &types.FeeTierOverride{
+				Address:      alice,
+				FeeTierIndex: 0,
+				ExpiryTime:   time.Unix(100, 0).UTC(),
+			},
+			blockTime:      time.Unix(99, 0).UTC(),
+			expectedIndex:  0,
+			expectedReason: types.FeeTierReason_FEE_TIER_REASON_OVERRIDE,
+		},
+		"expired override is ignored": {
+			user:      alice,
+			userStats: &stattypes.UserStats{TakerNotional: 1_000},
+			override: &types.FeeTierOverride{
+				Address:      alice,
+				FeeTierIndex: 3,
+				ExpiryTime:   time.Unix(100, 0).UTC(),
+			},
+			blockTime:      time.Unix(100, 0).UTC(),
+			expectedIndex:  1,
+			expectedReason: types.FeeTierReason_FEE_TIER_REASON_VOLUME,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.FeeTiersKeeper
+
+			params := types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{Name: "1", TakerFeePpm: 40, MakerFeePpm: 4},
+					{Name: "2", AbsoluteVolumeRequirement: 1_000, TakerFeePpm: 30, MakerFeePpm: 3},
+					{Name: "3", AbsoluteVolumeRequirement: 1_000_000, TakerFeePpm: 20, MakerFeePpm: 2},
+					{Name: "4", AbsoluteVolumeRequirement: 1_000_000_000, TakerFeePpm: 10, MakerFeePpm: 1},
+				},
+				StakingTiers: tc.stakingTiers,
+			}
+			require.NoError(t, k.SetPerpetualFeeParams(ctx, params))
+			if tc.override != nil {
+				require.NoError(t, k.SetFeeTierOverride(ctx, *tc.override))
+			}
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
+			tApp.App.StatsKeeper.SetUserStats(ctx, tc.user, tc.userStats)
+
+			res, err := k.UserFeeTier(ctx, &types.QueryUserFeeTierRequest{User: tc.user})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedIndex, res.Index)
+			require.Equal(t, params.Tiers[tc.expectedIndex], res.Tier)
+			require.Equal(t, tc.expectedReason, res.Reason)
+
+			require.Equal(t, params.Tiers[tc.expectedIndex].TakerFeePpm, k.GetPerpetualFeePpm(ctx, tc.user, true, 0))
+			require.Equal(t, params.Tiers[tc.expectedIndex].MakerFeePpm, k.GetPerpetualFeePpm(ctx, tc.user, false, 0))
+		})
+	}
+}
+
+func TestGetUserFeeTier_OverrideOfRemovedFeeTierIsIgnored(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	require.NoError(t, k.SetFeeTierOverride(ctx, types.FeeTierOverride{
+		Address:      alice,
+		FeeTierIndex: 8,
+		ExpiryTime:   time.Unix(100, 0).UTC(),
+	}))
+	res, err := k.UserFeeTier(ctx, &types.QueryUserFeeTierRequest{User: alice})
+	require.NoError(t, err)
+	require.Equal(t, uint32(8), res.Index)
+	require.Equal(t, types.FeeTierReason_FEE_TIER_REASON_OVERRIDE, res.Reason)
+
+	// Remove all fee tiers but the first.
+	params := k.GetPerpetualFeeParams(ctx)
+	params.Tiers = params.Tiers[:1]
+	require.NoError(t, k.SetPerpetualFeeParams(ctx, params))
+
+	res, err = k.UserFeeTier(ctx, &types.QueryUserFeeTierRequest{User: alice})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Index)
+	require.Equal(t, types.FeeTierReason_FEE_TIER_REASON_VOLUME, res.Reason)
+}
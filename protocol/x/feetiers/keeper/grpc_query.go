@@ -42,9 +42,102 @@ func (k Keeper) UserFeeTier(
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	index, tier := k.getUserFeeTier(ctx, req.User)
+	index, tier, reason := k.getUserFeeTier(ctx, req.User)
 	return &types.QueryUserFeeTierResponse{
-		Index: index,
-		Tier:  tier,
+		Index:  index,
+		Tier:   tier,
+		Reason: reason,
+	}, nil
+}
+
+func (k Keeper) ReferralParams(
+	c context.Context,
+	req *types.QueryReferralParamsRequest,
+) (
+	*types.QueryReferralParamsResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryReferralParamsResponse{
+		Params: k.GetReferralParams(ctx),
+	}, nil
+}
+
+func (k Keeper) ReferralCode(
+	c context.Context,
+	req *types.QueryReferralCodeRequest,
+) (
+	*types.QueryReferralCodeResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	referralCode, found := k.GetReferralCode(ctx, req.Code)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "referral code %s not found", req.Code)
+	}
+
+	return &types.QueryReferralCodeResponse{
+		ReferralCode: referralCode,
+	}, nil
+}
+
+func (k Keeper) UserReferral(
+	c context.Context,
+	req *types.QueryUserReferralRequest,
+) (
+	*types.QueryUserReferralResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	referrer, _ := k.GetReferrer(ctx, req.User)
+	return &types.QueryUserReferralResponse{
+		ReferralCode: k.GetOwnerReferralCode(ctx, req.User),
+		Referrer:     referrer,
+	}, nil
+}
+
+func (k Keeper) FeeTierOverrides(
+	c context.Context,
+	req *types.QueryFeeTierOverridesRequest,
+) (
+	*types.QueryFeeTierOverridesResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFeeTierOverridesResponse{
+		Overrides: k.GetAllFeeTierOverrides(ctx),
+	}, nil
+}
+
+func (k Keeper) MarketFeeSchedules(
+	c context.Context,
+	req *types.QueryMarketFeeSchedulesRequest,
+) (
+	*types.QueryMarketFeeSchedulesResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMarketFeeSchedulesResponse{
+		Schedules: k.GetAllMarketFeeSchedules(ctx),
 	}, nil
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -70,6 +71,7 @@ func TestUserFeeTier(t *testing.T) {
 					MakerFeePpm:                    -110,
 					TakerFeePpm:                    500,
 				},
+				Reason: types.FeeTierReason_FEE_TIER_REASON_VOLUME,
 			},
 			err: nil,
 		},
@@ -90,3 +92,199 @@ func TestUserFeeTier(t *testing.T) {
 		})
 	}
 }
+
+func TestReferralParams(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+	params := types.ReferralParams{
+		ReferrerRebateSharePpm:     100_000,
+		RefereeTakerFeeDiscountPpm: 50_000,
+	}
+	require.NoError(t, k.SetReferralParams(ctx, params))
+
+	for name, tc := range map[string]struct {
+		req *types.QueryReferralParamsRequest
+		res *types.QueryReferralParamsResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QueryReferralParamsRequest{},
+			res: &types.QueryReferralParamsResponse{
+				Params: params,
+			},
+			err: nil,
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.ReferralParams(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
+
+func TestReferralCode(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+	require.NoError(t, k.RegisterReferralCode(ctx, alice, "alice"))
+
+	for name, tc := range map[string]struct {
+		req *types.QueryReferralCodeRequest
+		res *types.QueryReferralCodeResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QueryReferralCodeRequest{
+				Code: "alice",
+			},
+			res: &types.QueryReferralCodeResponse{
+				ReferralCode: types.ReferralCode{
+					Code:  "alice",
+					Owner: alice,
+				},
+			},
+			err: nil,
+		},
+		"Not found": {
+			req: &types.QueryReferralCodeRequest{
+				Code: "bob",
+			},
+			res: nil,
+			err: status.Error(codes.NotFound, "referral code bob not found"),
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.ReferralCode(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
+
+func TestUserReferral(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+	require.NoError(t, k.RegisterReferralCode(ctx, alice, "alice"))
+	require.NoError(t, k.RegisterReferralCode(ctx, bob, "bob1"))
+	require.NoError(t, k.SetReferrer(ctx, bob, "alice"))
+
+	for name, tc := range map[string]struct {
+		req *types.QueryUserReferralRequest
+		res *types.QueryUserReferralResponse
+		err error
+	}{
+		"Referrer": {
+			req: &types.QueryUserReferralRequest{
+				User: alice,
+			},
+			res: &types.QueryUserReferralResponse{
+				ReferralCode: "alice",
+			},
+			err: nil,
+		},
+		"Referee with referral code": {
+			req: &types.QueryUserReferralRequest{
+				User: bob,
+			},
+			res: &types.QueryUserReferralResponse{
+				ReferralCode: "bob1",
+				Referrer:     alice,
+			},
+			err: nil,
+		},
+		"No referral": {
+			req: &types.QueryUserReferralRequest{
+				User: carl,
+			},
+			res: &types.QueryUserReferralResponse{},
+			err: nil,
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.UserReferral(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
+
+func TestFeeTierOverrides(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	res, err := k.FeeTierOverrides(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	require.Nil(t, res)
+
+	res, err = k.FeeTierOverrides(ctx, &types.QueryFeeTierOverridesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Overrides)
+
+	override := types.FeeTierOverride{
+		Address:      alice,
+		FeeTierIndex: 3,
+		ExpiryTime:   time.Unix(100, 0).UTC(),
+	}
+	require.NoError(t, k.SetFeeTierOverride(ctx, override))
+
+	res, err = k.FeeTierOverrides(ctx, &types.QueryFeeTierOverridesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.FeeTierOverride{override}, res.Overrides)
+}
+
+func TestMarketFeeSchedules(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	res, err := k.MarketFeeSchedules(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	require.Nil(t, res)
+
+	res, err = k.MarketFeeSchedules(ctx, &types.QueryMarketFeeSchedulesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Schedules)
+
+	schedule := types.MarketFeeSchedule{
+		ClobPairId:            1,
+		EffectiveFrom:         time.Unix(100, 0).UTC(),
+		MakerFeeMultiplierPpm: 500_000,
+		TakerFeeMultiplierPpm: 500_000,
+	}
+	require.NoError(t, k.SetMarketFeeSchedule(ctx, schedule))
+
+	res, err = k.MarketFeeSchedules(ctx, &types.QueryMarketFeeSchedulesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.MarketFeeSchedule{schedule}, res.Schedules)
+}
@@ -17,24 +17,33 @@ import (
 
 type (
 	Keeper struct {
-		cdc         codec.BinaryCodec
-		statsKeeper types.StatsKeeper
-		storeKey    storetypes.StoreKey
-		authorities map[string]struct{}
+		cdc           codec.BinaryCodec
+		statsKeeper   types.StatsKeeper
+		assetsKeeper  types.AssetsKeeper
+		bankKeeper    types.BankKeeper
+		stakingKeeper types.StakingKeeper
+		storeKey      storetypes.StoreKey
+		authorities   map[string]struct{}
 	}
 )
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	statsKeeper types.StatsKeeper,
+	assetsKeeper types.AssetsKeeper,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
 	storeKey storetypes.StoreKey,
 	authorities []string,
 ) *Keeper {
 	return &Keeper{
-		cdc:         cdc,
-		statsKeeper: statsKeeper,
-		storeKey:    storeKey,
-		authorities: lib.UniqueSliceToSet(authorities),
+		cdc:           cdc,
+		statsKeeper:   statsKeeper,
+		assetsKeeper:  assetsKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
+		storeKey:      storeKey,
+		authorities:   lib.UniqueSliceToSet(authorities),
 	}
 }
 
@@ -49,12 +58,43 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 func (k Keeper) InitializeForGenesis(ctx sdk.Context) {}
 
-func (k Keeper) getUserFeeTier(ctx sdk.Context, address string) (uint32, *types.PerpetualFeeTier) {
+// getUserFeeTier returns the index of the fee tier `address` is in, the fee tier, and the reason
+// `address` is in the fee tier. An active fee tier override takes precedence over everything else.
+// Otherwise `address` is in the higher of the fee tier it qualifies for by volume and the fee tier
+// granted by the highest staking fee tier it qualifies for.
+func (k Keeper) getUserFeeTier(
+	ctx sdk.Context,
+	address string,
+) (
+	uint32,
+	*types.PerpetualFeeTier,
+	types.FeeTierReason,
+) {
+	feeParams := k.GetPerpetualFeeParams(ctx)
+	tiers := feeParams.Tiers
+
+	if override, found := k.getActiveFeeTierOverride(ctx, address, tiers); found {
+		return override.FeeTierIndex, tiers[override.FeeTierIndex], types.FeeTierReason_FEE_TIER_REASON_OVERRIDE
+	}
+
+	idx := k.getVolumeFeeTierIndex(ctx, address, tiers)
+	if stakingIdx, found := k.getStakingFeeTierIndex(ctx, address, feeParams.StakingTiers); found && stakingIdx > idx {
+		return stakingIdx, tiers[stakingIdx], types.FeeTierReason_FEE_TIER_REASON_STAKING
+	}
+
+	return idx, tiers[idx], types.FeeTierReason_FEE_TIER_REASON_VOLUME
+}
+
+// getVolumeFeeTierIndex returns the index of the fee tier `address` qualifies for by volume.
+func (k Keeper) getVolumeFeeTierIndex(
+	ctx sdk.Context,
+	address string,
+	tiers []*types.PerpetualFeeTier,
+) uint32 {
 	userStats := k.statsKeeper.GetUserStats(ctx, address)
 	globalStats := k.statsKeeper.GetGlobalStats(ctx)
 
 	// Invariant: we know there is at least one tier and that the first tier has no requirements
-	tiers := k.GetPerpetualFeeParams(ctx).Tiers
 	idx := uint32(0)
 
 	// Find the last tier we meet all requirements for
@@ -83,19 +123,50 @@ func (k Keeper) getUserFeeTier(ctx sdk.Context, address string) (uint32, *types.
 		idx = uint32(i)
 	}
 
-	return idx, tiers[idx]
+	return idx
+}
+
+// getStakingFeeTierIndex returns the index of the fee tier granted by the highest staking fee tier
+// `address` qualifies for, and whether `address` qualifies for any staking fee tier.
+func (k Keeper) getStakingFeeTierIndex(
+	ctx sdk.Context,
+	address string,
+	stakingTiers []types.StakingFeeTier,
+) (uint32, bool) {
+	if len(stakingTiers) == 0 {
+		return 0, false
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return 0, false
+	}
+
+	bondedAmount := k.stakingKeeper.GetDelegatorBonded(ctx, accAddress).BigInt()
+	for i := len(stakingTiers) - 1; i >= 0; i-- {
+		if bondedAmount.Cmp(stakingTiers[i].MinBondedAmount.BigInt()) >= 0 {
+			return stakingTiers[i].FeeTierIndex, true
+		}
+	}
+
+	return 0, false
 }
 
-func (k Keeper) GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool) int32 {
-	_, userTier := k.getUserFeeTier(ctx, address)
+// GetPerpetualFeePpm returns the fee rate of `address` in its fee tier for fills of the CLOB pair
+// `clobPairId`, scaled by the fee schedule of the CLOB pair if it is in effect. The taker fee rate
+// of an address with a referrer is discounted according to the ReferralParams.
+func (k Keeper) GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool, clobPairId uint32) int32 {
+	_, userTier, _ := k.getUserFeeTier(ctx, address)
 	if isTaker {
-		return userTier.TakerFeePpm
+		takerFeePpm := k.scaleFeePpmForClobPair(ctx, clobPairId, userTier.TakerFeePpm, true)
+		return k.applyRefereeTakerFeeDiscount(ctx, address, clobPairId, takerFeePpm)
 	}
-	return userTier.MakerFeePpm
+	return k.scaleFeePpmForClobPair(ctx, clobPairId, userTier.MakerFeePpm, false)
 }
 
-// GetLowestMakerFee returns the lowest maker fee among any tiers.
-func (k Keeper) GetLowestMakerFee(ctx sdk.Context) int32 {
+// GetLowestMakerFee returns the lowest maker fee among any tiers for fills of the CLOB pair
+// `clobPairId`, scaled by the fee schedule of the CLOB pair if it is in effect.
+func (k Keeper) GetLowestMakerFee(ctx sdk.Context, clobPairId uint32) int32 {
 	feeParams := k.GetPerpetualFeeParams(ctx)
 
 	lowestMakerFee := int32(math.MaxInt32)
@@ -105,5 +176,5 @@ func (k Keeper) GetLowestMakerFee(ctx sdk.Context) int32 {
 		}
 	}
 
-	return lowestMakerFee
+	return k.scaleFeePpmForClobPair(ctx, clobPairId, lowestMakerFee, false)
 }
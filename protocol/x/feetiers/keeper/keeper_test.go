@@ -107,8 +107,8 @@ func TestGetPerpetualFeePpm(t *testing.T) {
 			statsKeeper.SetUserStats(ctx, user, tc.UserStats)
 			statsKeeper.SetGlobalStats(ctx, tc.GlobalStats)
 
-			require.Equal(t, tc.expectedTakerFeePpm, k.GetPerpetualFeePpm(ctx, user, true))
-			require.Equal(t, tc.expectedMakerFeePpm, k.GetPerpetualFeePpm(ctx, user, false))
+			require.Equal(t, tc.expectedTakerFeePpm, k.GetPerpetualFeePpm(ctx, user, true, 0))
+			require.Equal(t, tc.expectedMakerFeePpm, k.GetPerpetualFeePpm(ctx, user, false, 0))
 		})
 	}
 }
@@ -198,7 +198,7 @@ func TestGetMaxMakerRebate(t *testing.T) {
 			)
 			require.NoError(t, err)
 
-			require.Equal(t, tc.expectedLowestMakerFee, k.GetLowestMakerFee(ctx))
+			require.Equal(t, tc.expectedLowestMakerFee, k.GetLowestMakerFee(ctx, 0))
 		})
 	}
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+)
+
+// GetMarketFeeSchedule returns the fee schedule of the CLOB pair `clobPairId` and whether it exists.
+// The returned fee schedule may not be in effect yet.
+func (k Keeper) GetMarketFeeSchedule(
+	ctx sdk.Context,
+	clobPairId uint32,
+) (
+	schedule types.MarketFeeSchedule,
+	found bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketFeeScheduleKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(clobPairId))
+	if b == nil {
+		return schedule, false
+	}
+
+	k.cdc.MustUnmarshal(b, &schedule)
+	return schedule, true
+}
+
+// GetAllMarketFeeSchedules returns all market fee schedules in state, sorted by CLOB pair id.
+func (k Keeper) GetAllMarketFeeSchedules(
+	ctx sdk.Context,
+) (list []types.MarketFeeSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketFeeScheduleKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MarketFeeSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// SetMarketFeeSchedule creates or replaces the fee schedule of the CLOB pair `schedule.ClobPairId`.
+// Returns an error if the fee schedule is invalid or scales the fee rates of the fee tiers such that
+// a fill can result in a net rebate.
+func (k Keeper) SetMarketFeeSchedule(
+	ctx sdk.Context,
+	schedule types.MarketFeeSchedule,
+) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	feeParams := k.GetPerpetualFeeParams(ctx)
+	if err := feeParams.ValidateMarketFeeSchedule(schedule); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketFeeScheduleKeyPrefix))
+	store.Set(lib.Uint32ToKey(schedule.ClobPairId), k.cdc.MustMarshal(&schedule))
+
+	return nil
+}
+
+// DeleteMarketFeeSchedule deletes the fee schedule of the CLOB pair `clobPairId`.
+// Returns an error if the CLOB pair has no fee schedule.
+func (k Keeper) DeleteMarketFeeSchedule(
+	ctx sdk.Context,
+	clobPairId uint32,
+) error {
+	if _, found := k.GetMarketFeeSchedule(ctx, clobPairId); !found {
+		return errorsmod.Wrapf(types.ErrMarketFeeScheduleNotFound, "clob pair %d", clobPairId)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketFeeScheduleKeyPrefix))
+	store.Delete(lib.Uint32ToKey(clobPairId))
+
+	return nil
+}
+
+// scaleFeePpmForClobPair returns `feePpm` scaled by the fee schedule of the CLOB pair `clobPairId`,
+// or `feePpm` if the CLOB pair has no fee schedule in effect.
+func (k Keeper) scaleFeePpmForClobPair(
+	ctx sdk.Context,
+	clobPairId uint32,
+	feePpm int32,
+	isTaker bool,
+) int32 {
+	schedule, found := k.GetMarketFeeSchedule(ctx, clobPairId)
+	if !found || !schedule.IsEffective(ctx.BlockTime()) {
+		return feePpm
+	}
+
+	return schedule.ScaleFeePpm(feePpm, isTaker)
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	"github.com/stretchr/testify/require"
+)
+
+var marketFeeScheduleTestParams = types.PerpetualFeeParams{
+	Tiers: []*types.PerpetualFeeTier{
+		{Name: "1", TakerFeePpm: 40, MakerFeePpm: 10},
+		{Name: "2", AbsoluteVolumeRequirement: 1_000, TakerFeePpm: 20, MakerFeePpm: -10},
+	},
+}
+
+func TestSetMarketFeeSchedule(t *testing.T) {
+	tests := map[string]struct {
+		schedule    types.MarketFeeSchedule
+		expectedErr error
+	}{
+		"Success": {
+			schedule: types.MarketFeeSchedule{
+				ClobPairId:            1,
+				EffectiveFrom:         time.Unix(100, 0).UTC(),
+				MakerFeeMultiplierPpm: 500_000,
+				TakerFeeMultiplierPpm: 2_000_000,
+			},
+		},
+		"Success: maker rebate equal to lowest taker fee": {
+			schedule: types.MarketFeeSchedule{
+				ClobPairId:            1,
+				MakerFeeMultiplierPpm: 2_000_000,
+				TakerFeeMultiplierPpm: 1_000_000,
+			},
+		},
+		"Failure: multiplier above max": {
+			schedule: types.MarketFeeSchedule{
+				ClobPairId:            1,
+				MakerFeeMultiplierPpm: types.MaxFeeMultiplierPpm + 1,
+				TakerFeeMultiplierPpm: 1_000_000,
+			},
+			expectedErr: types.ErrInvalidMarketFeeSchedule,
+		},
+		"Failure: maker rebate larger than lowest taker fee": {
+			schedule: types.MarketFeeSchedule{
+				ClobPairId:            1,
+				MakerFeeMultiplierPpm: 1_000_000,
+				TakerFeeMultiplierPpm: 0,
+			},
+			expectedErr: types.ErrInvalidFee,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.FeeTiersKeeper
+			require.NoError(t, k.SetPerpetualFeeParams(ctx, marketFeeScheduleTestParams))
+
+			err := k.SetMarketFeeSchedule(ctx, tc.schedule)
+			schedule, found := k.GetMarketFeeSchedule(ctx, tc.schedule.ClobPairId)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.False(t, found)
+				require.Empty(t, k.GetAllMarketFeeSchedules(ctx))
+			} else {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, tc.schedule, schedule)
+				require.Equal(t, []types.MarketFeeSchedule{tc.schedule}, k.GetAllMarketFeeSchedules(ctx))
+			}
+		})
+	}
+}
+
+func TestDeleteMarketFeeSchedule(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	require.ErrorIs(t, k.DeleteMarketFeeSchedule(ctx, 1), types.ErrMarketFeeScheduleNotFound)
+
+	require.NoError(t, k.SetMarketFeeSchedule(ctx, types.MarketFeeSchedule{
+		ClobPairId:            1,
+		MakerFeeMultiplierPpm: 1_000_000,
+		TakerFeeMultiplierPpm: 1_000_000,
+	}))
+	require.NoError(t, k.DeleteMarketFeeSchedule(ctx, 1))
+
+	_, found := k.GetMarketFeeSchedule(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, k.GetAllMarketFeeSchedules(ctx))
+}
+
+func TestSetPerpetualFeeParams_ValidatesMarketFeeSchedules(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+	require.NoError(t, k.SetPerpetualFeeParams(ctx, marketFeeScheduleTestParams))
+
+	// Doubles the maker rebate of the second tier to the lowest taker fee.
+	require.NoError(t, k.SetMarketFeeSchedule(ctx, types.MarketFeeSchedule{
+		ClobPairId:            1,
+		MakerFeeMultiplierPpm: 2_000_000,
+		TakerFeeMultiplierPpm: 1_000_000,
+	}))
+
+	// A larger maker rebate is valid without the fee schedule, but not with it.
+	params := types.PerpetualFeeParams{
+		Tiers: []*types.PerpetualFeeTier{
+			{Name: "1", TakerFeePpm: 40, MakerFeePpm: 10},
+			{Name: "2", AbsoluteVolumeRequirement: 1_000, TakerFeePpm: 20, MakerFeePpm: -11},
+		},
+	}
+	require.NoError(t, params.Validate())
+	require.ErrorIs(t, k.SetPerpetualFeeParams(ctx, params), types.ErrInvalidFee)
+	require.Equal(t, marketFeeScheduleTestParams, k.GetPerpetualFeeParams(ctx))
+
+	require.NoError(t, k.DeleteMarketFeeSchedule(ctx, 1))
+	require.NoError(t, k.SetPerpetualFeeParams(ctx, params))
+}
+
+func TestGetPerpetualFeePpm_MarketFeeSchedule(t *testing.T) {
+	tests := map[string]struct {
+		clobPairId uint32
+		blockTime  time.Time
+
+		expectedTakerFeePpm    int32
+		expectedMakerFeePpm    int32
+		expectedLowestMakerFee int32
+	}{
+		"CLOB pair without fee schedule": {
+			clobPairId:             0,
+			blockTime:              time.Unix(100, 0).UTC(),
+			expectedTakerFeePpm:    40,
+			expectedMakerFeePpm:    10,
+			expectedLowestMakerFee: -10,
+		},
+		"fee schedule not in effect yet": {
+			clobPairId:             1,
+			blockTime:              time.Unix(99, 0).UTC(),
+			expectedTakerFeePpm:    40,
+			expectedMakerFeePpm:    10,
+			expectedLowestMakerFee: -10,
+		},
+		"fee schedule in effect": {
+			clobPairId:             1,
+			blockTime:              time.Unix(100, 0).UTC(),
+			expectedTakerFeePpm:    80,
+			expectedMakerFeePpm:    5,
+			expectedLowestMakerFee: -5,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.FeeTiersKeeper
+			require.NoError(t, k.SetPerpetualFeeParams(ctx, marketFeeScheduleTestParams))
+			require.NoError(t, k.SetMarketFeeSchedule(ctx, types.MarketFeeSchedule{
+				ClobPairId:            1,
+				EffectiveFrom:         time.Unix(100, 0).UTC(),
+				MakerFeeMultiplierPpm: 500_000,
+				TakerFeeMultiplierPpm: 2_000_000,
+			}))
+			ctx = ctx.WithBlockTime(tc.blockTime)
+
+			require.Equal(t, tc.expectedTakerFeePpm, k.GetPerpetualFeePpm(ctx, alice, true, tc.clobPairId))
+			require.Equal(t, tc.expectedMakerFeePpm, k.GetPerpetualFeePpm(ctx, alice, false, tc.clobPairId))
+			require.Equal(t, tc.expectedLowestMakerFee, k.GetLowestMakerFee(ctx, tc.clobPairId))
+		})
+	}
+}
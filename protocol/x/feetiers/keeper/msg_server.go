@@ -40,3 +40,127 @@ func (k msgServer) UpdatePerpetualFeeParams(
 
 	return &types.MsgUpdatePerpetualFeeParamsResponse{}, nil
 }
+
+func (k msgServer) UpdateReferralParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateReferralParams,
+) (*types.MsgUpdateReferralParamsResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetReferralParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateReferralParamsResponse{}, nil
+}
+
+func (k msgServer) RegisterReferralCode(
+	goCtx context.Context,
+	msg *types.MsgRegisterReferralCode,
+) (*types.MsgRegisterReferralCodeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RegisterReferralCode(ctx, msg.Owner, msg.Code); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterReferralCodeResponse{}, nil
+}
+
+func (k msgServer) SetReferrer(
+	goCtx context.Context,
+	msg *types.MsgSetReferrer,
+) (*types.MsgSetReferrerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetReferrer(ctx, msg.Referee, msg.Code); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetReferrerResponse{}, nil
+}
+
+func (k msgServer) SetFeeTierOverride(
+	goCtx context.Context,
+	msg *types.MsgSetFeeTierOverride,
+) (*types.MsgSetFeeTierOverrideResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetFeeTierOverride(ctx, msg.Override); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeTierOverrideResponse{}, nil
+}
+
+func (k msgServer) DeleteFeeTierOverride(
+	goCtx context.Context,
+	msg *types.MsgDeleteFeeTierOverride,
+) (*types.MsgDeleteFeeTierOverrideResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.DeleteFeeTierOverride(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteFeeTierOverrideResponse{}, nil
+}
+
+func (k msgServer) SetMarketFeeSchedule(
+	goCtx context.Context,
+	msg *types.MsgSetMarketFeeSchedule,
+) (*types.MsgSetMarketFeeScheduleResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetMarketFeeSchedule(ctx, msg.Schedule); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMarketFeeScheduleResponse{}, nil
+}
+
+func (k msgServer) DeleteMarketFeeSchedule(
+	goCtx context.Context,
+	msg *types.MsgDeleteMarketFeeSchedule,
+) (*types.MsgDeleteMarketFeeScheduleResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.DeleteMarketFeeSchedule(ctx, msg.ClobPairId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteMarketFeeScheduleResponse{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
@@ -80,3 +81,142 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateReferralParams(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := types.ReferralParams{
+		ReferrerRebateSharePpm:     100_000,
+		RefereeTakerFeeDiscountPpm: 50_000,
+	}
+
+	_, err := ms.UpdateReferralParams(goCtx, &types.MsgUpdateReferralParams{
+		Authority: "invalid",
+		Params:    params,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = ms.UpdateReferralParams(goCtx, &types.MsgUpdateReferralParams{
+		Authority: lib.GovModuleAddress.String(),
+		Params:    types.ReferralParams{ReferrerRebateSharePpm: 1_000_001},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidReferralParams)
+	require.Equal(t, types.ReferralParams{}, k.GetReferralParams(ctx))
+
+	_, err = ms.UpdateReferralParams(goCtx, &types.MsgUpdateReferralParams{
+		Authority: lib.GovModuleAddress.String(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetReferralParams(ctx))
+}
+
+func TestMsgRegisterReferralCodeAndSetReferrer(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := ms.SetReferrer(goCtx, types.NewMsgSetReferrer(bob, "alice"))
+	require.ErrorIs(t, err, types.ErrReferralCodeNotFound)
+
+	_, err = ms.RegisterReferralCode(goCtx, types.NewMsgRegisterReferralCode(alice, "alice"))
+	require.NoError(t, err)
+	_, err = ms.RegisterReferralCode(goCtx, types.NewMsgRegisterReferralCode(bob, "alice"))
+	require.ErrorIs(t, err, types.ErrReferralCodeAlreadyExists)
+
+	_, err = ms.SetReferrer(goCtx, types.NewMsgSetReferrer(bob, "alice"))
+	require.NoError(t, err)
+	referrer, found := k.GetReferrer(ctx, bob)
+	require.True(t, found)
+	require.Equal(t, alice, referrer)
+}
+
+func TestMsgSetAndDeleteFeeTierOverride(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	override := types.FeeTierOverride{
+		Address:      alice,
+		FeeTierIndex: 2,
+		ExpiryTime:   time.Unix(100, 0).UTC(),
+	}
+
+	_, err := ms.SetFeeTierOverride(goCtx, &types.MsgSetFeeTierOverride{
+		Authority: "invalid",
+		Override:  override,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = ms.SetFeeTierOverride(goCtx, &types.MsgSetFeeTierOverride{
+		Authority: lib.GovModuleAddress.String(),
+		Override:  override,
+	})
+	require.NoError(t, err)
+	actual, found := k.GetFeeTierOverride(ctx, alice)
+	require.True(t, found)
+	require.Equal(t, override, actual)
+
+	_, err = ms.DeleteFeeTierOverride(goCtx, &types.MsgDeleteFeeTierOverride{
+		Authority: "invalid",
+		Address:   alice,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = ms.DeleteFeeTierOverride(goCtx, &types.MsgDeleteFeeTierOverride{
+		Authority: lib.GovModuleAddress.String(),
+		Address:   alice,
+	})
+	require.NoError(t, err)
+	_, found = k.GetFeeTierOverride(ctx, alice)
+	require.False(t, found)
+
+	_, err = ms.DeleteFeeTierOverride(goCtx, &types.MsgDeleteFeeTierOverride{
+		Authority: lib.GovModuleAddress.String(),
+		Address:   alice,
+	})
+	require.ErrorIs(t, err, types.ErrFeeTierOverrideNotFound)
+}
+
+func TestMsgSetAndDeleteMarketFeeSchedule(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	schedule := types.MarketFeeSchedule{
+		ClobPairId:            1,
+		EffectiveFrom:         time.Unix(100, 0).UTC(),
+		MakerFeeMultiplierPpm: 500_000,
+		TakerFeeMultiplierPpm: 500_000,
+	}
+
+	_, err := ms.SetMarketFeeSchedule(goCtx, &types.MsgSetMarketFeeSchedule{
+		Authority: "invalid",
+		Schedule:  schedule,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = ms.SetMarketFeeSchedule(goCtx, &types.MsgSetMarketFeeSchedule{
+		Authority: lib.GovModuleAddress.String(),
+		Schedule:  schedule,
+	})
+	require.NoError(t, err)
+	actual, found := k.GetMarketFeeSchedule(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, schedule, actual)
+
+	_, err = ms.DeleteMarketFeeSchedule(goCtx, &types.MsgDeleteMarketFeeSchedule{
+		Authority:  "invalid",
+		ClobPairId: 1,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = ms.DeleteMarketFeeSchedule(goCtx, &types.MsgDeleteMarketFeeSchedule{
+		Authority:  lib.GovModuleAddress.String(),
+		ClobPairId: 1,
+	})
+	require.NoError(t, err)
+	_, found = k.GetMarketFeeSchedule(ctx, 1)
+	require.False(t, found)
+
+	_, err = ms.DeleteMarketFeeSchedule(goCtx, &types.MsgDeleteMarketFeeSchedule{
+		Authority:  lib.GovModuleAddress.String(),
+		ClobPairId: 1,
+	})
+	require.ErrorIs(t, err, types.ErrMarketFeeScheduleNotFound)
+}
@@ -18,7 +18,8 @@ func (k Keeper) GetPerpetualFeeParams(
 }
 
 // SetPerpetualFeeParams updates the PerpetualFeeParams in state.
-// Returns an error iff validation fails.
+// Returns an error iff validation fails, including if any market fee schedule would scale
+// the fee rates of the new fee tiers such that a fill can result in a net rebate.
 func (k Keeper) SetPerpetualFeeParams(
 	ctx sdk.Context,
 	params types.PerpetualFeeParams,
@@ -27,6 +28,12 @@ func (k Keeper) SetPerpetualFeeParams(
 		return err
 	}
 
+	for _, schedule := range k.GetAllMarketFeeSchedules(ctx) {
+		if err := params.ValidateMarketFeeSchedule(schedule); err != nil {
+			return err
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&params)
 	store.Set([]byte(types.PerpetualFeeParamsKey), b)
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+)
+
+// GetReferralParams returns the ReferralParams in state. If the params were never set in state,
+// the referral program is disabled and empty params are returned.
+func (k Keeper) GetReferralParams(
+	ctx sdk.Context,
+) (
+	params types.ReferralParams,
+) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.ReferralParamsKey))
+	if b == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(b, &params)
+	return params
+}
+
+// SetReferralParams updates the ReferralParams in state.
+// Returns an error iff validation fails.
+func (k Keeper) SetReferralParams(
+	ctx sdk.Context,
+	params types.ReferralParams,
+) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&params)
+	store.Set([]byte(types.ReferralParamsKey), b)
+
+	return nil
+}
+
+// GetReferralCode returns the referral code `code` and whether it has been registered.
+func (k Keeper) GetReferralCode(
+	ctx sdk.Context,
+	code string,
+) (
+	referralCode types.ReferralCode,
+	found bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferralCodeKeyPrefix))
+	b := store.Get([]byte(code))
+	if b == nil {
+		return referralCode, false
+	}
+
+	k.cdc.MustUnmarshal(b, &referralCode)
+	return referralCode, true
+}
+
+// GetOwnerReferralCode returns the referral code registered by `owner`, or an empty string if
+// `owner` has not registered a referral code.
+func (k Keeper) GetOwnerReferralCode(ctx sdk.Context, owner string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OwnerReferralCodeKeyPrefix))
+	return string(store.Get([]byte(owner)))
+}
+
+// RegisterReferralCode registers `code` as the referral code of `owner`. Each address can register
+// at most one referral code, and each referral code can only be registered once.
+func (k Keeper) RegisterReferralCode(
+	ctx sdk.Context,
+	owner string,
+	code string,
+) error {
+	if err := types.ValidateReferralCode(code); err != nil {
+		return err
+	}
+
+	if existingCode := k.GetOwnerReferralCode(ctx, owner); existingCode != "" {
+		return errorsmod.Wrapf(
+			types.ErrOwnerAlreadyHasReferralCode,
+			"address %s has already registered referral code %s",
+			owner,
+			existingCode,
+		)
+	}
+
+	if _, found := k.GetReferralCode(ctx, code); found {
+		return errorsmod.Wrapf(types.ErrReferralCodeAlreadyExists, "referral code %s", code)
+	}
+
+	k.SetReferralCode(ctx, types.ReferralCode{
+		Code:  code,
+		Owner: owner,
+	})
+
+	return nil
+}
+
+// SetReferralCode sets the referral code `referralCode` and the referral code of its owner in state.
+func (k Keeper) SetReferralCode(ctx sdk.Context, referralCode types.ReferralCode) {
+	codeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferralCodeKeyPrefix))
+	codeStore.Set([]byte(referralCode.Code), k.cdc.MustMarshal(&referralCode))
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OwnerReferralCodeKeyPrefix))
+	ownerStore.Set([]byte(referralCode.Owner), []byte(referralCode.Code))
+}
+
+// GetAllReferralCodes returns all registered referral codes, sorted by code.
+func (k Keeper) GetAllReferralCodes(ctx sdk.Context) (list []types.ReferralCode) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferralCodeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var referralCode types.ReferralCode
+		k.cdc.MustUnmarshal(iterator.Value(), &referralCode)
+		list = append(list, referralCode)
+	}
+	return list
+}
+
+// GetReferrer returns the referrer of `referee` and whether `referee` is bound to a referrer.
+func (k Keeper) GetReferrer(ctx sdk.Context, referee string) (referrer string, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferrerKeyPrefix))
+	b := store.Get([]byte(referee))
+	if b == nil {
+		return "", false
+	}
+
+	return string(b), true
+}
+
+// SetReferral binds the referee of `referral` to its referrer in state.
+func (k Keeper) SetReferral(ctx sdk.Context, referral types.Referral) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferrerKeyPrefix))
+	store.Set([]byte(referral.Referee), []byte(referral.Referrer))
+}
+
+// GetAllReferrals returns the referrers of all referees, sorted by referee.
+func (k Keeper) GetAllReferrals(ctx sdk.Context) (list []types.Referral) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferrerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.Referral{
+			Referee:  string(iterator.Key()),
+			Referrer: string(iterator.Value()),
+		})
+	}
+	return list
+}
+
+// SetReferrer permanently binds `referee` to the owner of the referral code `code`. Only new
+// addresses, which are not yet bound to a referrer and have never been the taker or maker of a fill,
+// can be bound to a referrer, and an address cannot refer itself.
+func (k Keeper) SetReferrer(
+	ctx sdk.Context,
+	referee string,
+	code string,
+) error {
+	referralCode, found := k.GetReferralCode(ctx, code)
+	if !found {
+		return errorsmod.Wrapf(types.ErrReferralCodeNotFound, "referral code %s", code)
+	}
+
+	if referralCode.Owner == referee {
+		return errorsmod.Wrapf(types.ErrSelfReferral, "address %s", referee)
+	}
+
+	if referrer, found := k.GetReferrer(ctx, referee); found {
+		return errorsmod.Wrapf(
+			types.ErrReferrerAlreadySet,
+			"address %s is already bound to referrer %s",
+			referee,
+			referrer,
+		)
+	}
+
+	if k.statsKeeper.HasTraded(ctx, referee) {
+		return errorsmod.Wrapf(types.ErrRefereeHasTraded, "address %s", referee)
+	}
+
+	k.SetReferral(ctx, types.Referral{
+		Referee:  referee,
+		Referrer: referralCode.Owner,
+	})
+	k.statsKeeper.RecordReferee(ctx, referralCode.Owner)
+
+	return nil
+}
+
+// applyRefereeTakerFeeDiscount returns the taker fee rate of `address` after applying the referee
+// discount if `address` is bound to a referrer. The discount never lowers the taker fee rate below
+// the largest maker rebate of the CLOB pair `clobPairId`, so that no match results in a net rebate.
+func (k Keeper) applyRefereeTakerFeeDiscount(
+	ctx sdk.Context,
+	address string,
+	clobPairId uint32,
+	takerFeePpm int32,
+) int32 {
+	if takerFeePpm <= 0 {
+		return takerFeePpm
+	}
+
+	discountPpm := k.GetReferralParams(ctx).RefereeTakerFeeDiscountPpm
+	if discountPpm == 0 {
+		return takerFeePpm
+	}
+
+	if _, found := k.GetReferrer(ctx, address); !found {
+		return takerFeePpm
+	}
+
+	discountedTakerFeePpm := int32(
+		int64(takerFeePpm) * int64(lib.OneMillion-discountPpm) / int64(lib.OneMillion),
+	)
+	return lib.Max(discountedTakerFeePpm, -k.GetLowestMakerFee(ctx, clobPairId))
+}
+
+// ProcessReferralRebate pays the referrer of `takerAddress` their share of the taker fee of a fill
+// and records it in x/stats. Does nothing if the taker is not bound to a referrer or paid no taker
+// fee. The rebate is paid in USDC from the fee collector module account to the referrer's account,
+// and is capped at the total fees of the fill, so that it is always funded by the fill itself.
+// If the fee collector can not pay the rebate anyway, the rebate is skipped and logged rather than
+// failing the fill, and zero is returned. Returns the rebate paid to the referrer.
+func (k Keeper) ProcessReferralRebate(
+	ctx sdk.Context,
+	takerAddress string,
+	bigTakerFeeQuoteQuantums *big.Int,
+	bigTotalFeeQuoteQuantums *big.Int,
+) (
+	bigRebateQuoteQuantums *big.Int,
+	err error,
+) {
+	if bigTakerFeeQuoteQuantums.Sign() <= 0 {
+		return new(big.Int), nil
+	}
+
+	referrer, found := k.GetReferrer(ctx, takerAddress)
+	if !found {
+		return new(big.Int), nil
+	}
+
+	bigRebateQuoteQuantums = lib.BigMin(
+		lib.BigIntMulPpm(bigTakerFeeQuoteQuantums, k.GetReferralParams(ctx).ReferrerRebateSharePpm),
+		bigTotalFeeQuoteQuantums,
+	)
+	if bigRebateQuoteQuantums.Sign() < 0 {
+		bigRebateQuoteQuantums = new(big.Int)
+	}
+
+	if bigRebateQuoteQuantums.Sign() > 0 {
+		convertedQuantums, coinToTransfer, err := k.assetsKeeper.ConvertAssetToCoin(
+			ctx,
+			assettypes.AssetUsdc.Id,
+			bigRebateQuoteQuantums,
+		)
+		if err != nil {
+			return nil, err
+		}
+		bigRebateQuoteQuantums = convertedQuantums
+
+		// Send the rebate in a branched context so that a failed send leaves no partial state behind.
+		sendCtx, writeCache := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			sendCtx,
+			authtypes.FeeCollectorName,
+			sdk.MustAccAddressFromBech32(referrer),
+			sdk.NewCoins(coinToTransfer),
+		); err != nil {
+			k.Logger(ctx).Error(
+				"Failed to pay referral rebate, skipping it",
+				"referrer",
+				referrer,
+				"rebate",
+				coinToTransfer.String(),
+				constants.ErrorLogKey,
+				err,
+			)
+			return new(big.Int), nil
+		}
+		writeCache()
+	}
+
+	k.statsKeeper.RecordReferralRebate(ctx, referrer, bigTakerFeeQuoteQuantums, bigRebateQuoteQuantums)
+
+	return bigRebateQuoteQuantums, nil
+}
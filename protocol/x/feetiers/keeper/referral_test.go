@@ -0,0 +1,354 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	stattypes "github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice = constants.AliceAccAddress.String()
+	bob   = constants.BobAccAddress.String()
+	carl  = constants.CarlAccAddress.String()
+)
+
+func TestGetSetReferralParams(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	// The referral program is disabled by default.
+	require.Equal(t, types.ReferralParams{}, k.GetReferralParams(ctx))
+
+	params := types.ReferralParams{
+		ReferrerRebateSharePpm:     100_000,
+		RefereeTakerFeeDiscountPpm: 50_000,
+	}
+	require.NoError(t, k.SetReferralParams(ctx, params))
+	require.Equal(t, params, k.GetReferralParams(ctx))
+
+	// Invalid params are not written to state.
+	err := k.SetReferralParams(ctx, types.ReferralParams{ReferrerRebateSharePpm: 1_000_001})
+	require.ErrorIs(t, err, types.ErrInvalidReferralParams)
+	require.Equal(t, params, k.GetReferralParams(ctx))
+}
+
+func TestRegisterReferralCode(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	_, found := k.GetReferralCode(ctx, "alice")
+	require.False(t, found)
+	require.Empty(t, k.GetOwnerReferralCode(ctx, alice))
+
+	require.NoError(t, k.RegisterReferralCode(ctx, alice, "alice"))
+	referralCode, found := k.GetReferralCode(ctx, "alice")
+	require.True(t, found)
+	require.Equal(t, types.ReferralCode{Code: "alice", Owner: alice}, referralCode)
+	require.Equal(t, "alice", k.GetOwnerReferralCode(ctx, alice))
+
+	// Referral codes are case-sensitive.
+	_, found = k.GetReferralCode(ctx, "ALICE")
+	require.False(t, found)
+
+	err := k.RegisterReferralCode(ctx, alice, "alice2")
+	require.ErrorIs(t, err, types.ErrOwnerAlreadyHasReferralCode)
+
+	err = k.RegisterReferralCode(ctx, bob, "alice")
+	require.ErrorIs(t, err, types.ErrReferralCodeAlreadyExists)
+
+	err = k.RegisterReferralCode(ctx, bob, "b")
+	require.ErrorIs(t, err, types.ErrInvalidReferralCode)
+	require.Empty(t, k.GetOwnerReferralCode(ctx, bob))
+
+	require.NoError(t, k.RegisterReferralCode(ctx, bob, "bobby"))
+	require.Equal(
+		t,
+		[]types.ReferralCode{{Code: "alice", Owner: alice}, {Code: "bobby", Owner: bob}},
+		k.GetAllReferralCodes(ctx),
+	)
+}
+
+func TestSetReferrer(t *testing.T) {
+	tests := map[string]struct {
+		referee   string
+		code      string
+		hasTraded bool
+		// UserStats of the referee set without recording the referee as a trader, as for addresses
+		// that traded before traders were recorded.
+		preexistingUserStats *stattypes.UserStats
+		expectedErr          error
+	}{
+		"Success": {
+			referee: bob,
+			code:    "alice",
+		},
+		"Failure: referral code does not exist": {
+			referee:     bob,
+			code:        "carl",
+			expectedErr: types.ErrReferralCodeNotFound,
+		},
+		"Failure: self referral": {
+			referee:     alice,
+			code:        "alice",
+			expectedErr: types.ErrSelfReferral,
+		},
+		"Failure: referee has traded without trading volume in the stats window": {
+			referee:     bob,
+			code:        "alice",
+			hasTraded:   true,
+			expectedErr: types.ErrRefereeHasTraded,
+		},
+		"Failure: referee traded before traders were recorded": {
+			referee:              bob,
+			code:                 "alice",
+			preexistingUserStats: &stattypes.UserStats{TakerNotional: 100},
+			expectedErr:          types.ErrRefereeHasTraded,
+		},
+		"Failure: referee traded before traders were recorded and their volume expired": {
+			referee:              bob,
+			code:                 "alice",
+			preexistingUserStats: &stattypes.UserStats{},
+			expectedErr:          types.ErrRefereeHasTraded,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.FeeTiersKeeper
+			require.NoError(t, k.RegisterReferralCode(ctx, alice, "alice"))
+			if tc.hasTraded {
+				tApp.App.StatsKeeper.RecordFill(ctx, tc.referee, carl, big.NewInt(1))
+				require.Equal(t, &stattypes.UserStats{}, tApp.App.StatsKeeper.GetUserStats(ctx, tc.referee))
+			}
+			if tc.preexistingUserStats != nil {
+				tApp.App.StatsKeeper.SetUserStats(ctx, tc.referee, tc.preexistingUserStats)
+				require.Empty(t, tApp.App.StatsKeeper.GetAllTraders(ctx))
+			}
+
+			err := k.SetReferrer(ctx, tc.referee, tc.code)
+			referrer, found := k.GetReferrer(ctx, tc.referee)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.False(t, found)
+				require.Equal(t, &stattypes.ReferralStats{}, tApp.App.StatsKeeper.GetReferralStats(ctx, alice))
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, alice, referrer)
+			require.Equal(t, []types.Referral{{Referee: tc.referee, Referrer: alice}}, k.GetAllReferrals(ctx))
+			require.Equal(
+				t,
+				&stattypes.ReferralStats{NumReferees: 1},
+				tApp.App.StatsKeeper.GetReferralStats(ctx, alice),
+			)
+
+			// A referee cannot be bound to another referrer.
+			require.NoError(t, k.RegisterReferralCode(ctx, carl, "carl"))
+			require.ErrorIs(t, k.SetReferrer(ctx, tc.referee, "carl"), types.ErrReferrerAlreadySet)
+		})
+	}
+}
+
+func TestGetPerpetualFeePpm_RefereeDiscount(t *testing.T) {
+	tests := map[string]struct {
+		discountPpm         uint32
+		takerFeePpm         int32
+		makerFeePpm         int32
+		hasReferrer         bool
+		expectedTakerFeePpm int32
+	}{
+		"No referrer": {
+			discountPpm:         100_000,
+			takerFeePpm:         500,
+			makerFeePpm:         -110,
+			expectedTakerFeePpm: 500,
+		},
+		"No discount": {
+			takerFeePpm:         500,
+			makerFeePpm:         -110,
+			hasReferrer:         true,
+			expectedTakerFeePpm: 500,
+		},
+		"Discount": {
+			discountPpm:         100_000,
+			takerFeePpm:         500,
+			makerFeePpm:         -110,
+			hasReferrer:         true,
+			expectedTakerFeePpm: 450,
+		},
+		"Discounted taker fee is rounded down": {
+			discountPpm:         333_333,
+			takerFeePpm:         500,
+			makerFeePpm:         -110,
+			hasReferrer:         true,
+			expectedTakerFeePpm: 333,
+		},
+		"Discounted taker fee is capped at the largest maker rebate": {
+			discountPpm:         900_000,
+			takerFeePpm:         500,
+			makerFeePpm:         -110,
+			hasReferrer:         true,
+			expectedTakerFeePpm: 110,
+		},
+		"Full discount without maker rebates": {
+			discountPpm:         1_000_000,
+			takerFeePpm:         500,
+			makerFeePpm:         100,
+			hasReferrer:         true,
+			expectedTakerFeePpm: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.FeeTiersKeeper
+			require.NoError(t, k.SetPerpetualFeeParams(ctx, types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{
+						Name:        "1",
+						TakerFeePpm: tc.takerFeePpm,
+						MakerFeePpm: tc.makerFeePpm,
+					},
+				},
+			}))
+			require.NoError(t, k.SetReferralParams(ctx, types.ReferralParams{
+				RefereeTakerFeeDiscountPpm: tc.discountPpm,
+			}))
+			require.NoError(t, k.RegisterReferralCode(ctx, alice, "alice"))
+			if tc.hasReferrer {
+				require.NoError(t, k.SetReferrer(ctx, bob, "alice"))
+			}
+
+			require.Equal(t, tc.expectedTakerFeePpm, k.GetPerpetualFeePpm(ctx, bob, true, 0))
+			// Maker fees are never discounted.
+			require.Equal(t, tc.makerFeePpm, k.GetPerpetualFeePpm(ctx, bob, false, 0))
+		})
+	}
+}
+
+func TestProcessReferralRebate(t *testing.T) {
+	tests := map[string]struct {
+		taker                string
+		takerFee             *big.Int
+		totalFee             *big.Int
+		expectedRebate       int64
+		expectedRefereeStats bool
+	}{
+		"Referrer earns their share of the taker fee": {
+			taker:                bob,
+			takerFee:             big.NewInt(1_005),
+			totalFee:             big.NewInt(800),
+			expectedRebate:       100,
+			expectedRefereeStats: true,
+		},
+		"Rebate is capped at the total fee": {
+			taker:                bob,
+			takerFee:             big.NewInt(1_000),
+			totalFee:             big.NewInt(50),
+			expectedRebate:       50,
+			expectedRefereeStats: true,
+		},
+		"Taker without referrer": {
+			taker:    carl,
+			takerFee: big.NewInt(1_000),
+			totalFee: big.NewInt(800),
+		},
+		"Taker paid no fee": {
+			taker:    bob,
+			takerFee: big.NewInt(0),
+			totalFee: big.NewInt(-10),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.FeeTiersKeeper
+			bankKeeper := tApp.App.BankKeeper
+			require.NoError(t, k.SetReferralParams(ctx, types.ReferralParams{
+				ReferrerRebateSharePpm: 100_000,
+			}))
+			require.NoError(t, k.RegisterReferralCode(ctx, alice, "alice"))
+			require.NoError(t, k.SetReferrer(ctx, bob, "alice"))
+			require.NoError(t, bankKeeper.SendCoinsFromAccountToModule(
+				ctx,
+				constants.DaveAccAddress,
+				authtypes.FeeCollectorName,
+				sdk.NewCoins(sdk.NewCoin(assettypes.AssetUsdc.Denom, sdkmath.NewInt(10_000))),
+			))
+			balanceBefore := bankKeeper.GetBalance(ctx, constants.AliceAccAddress, assettypes.AssetUsdc.Denom)
+
+			bigRebate, err := k.ProcessReferralRebate(ctx, tc.taker, tc.takerFee, tc.totalFee)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(tc.expectedRebate), bigRebate)
+
+			balanceAfter := bankKeeper.GetBalance(ctx, constants.AliceAccAddress, assettypes.AssetUsdc.Denom)
+			require.Equal(t, tc.expectedRebate, balanceAfter.Amount.Sub(balanceBefore.Amount).Int64())
+
+			expectedStats := &stattypes.ReferralStats{NumReferees: 1}
+			if tc.expectedRefereeStats {
+				expectedStats.RefereeTakerFees = tc.takerFee.Uint64()
+				expectedStats.RebatesEarned = uint64(tc.expectedRebate)
+			}
+			require.Equal(t, expectedStats, tApp.App.StatsKeeper.GetReferralStats(ctx, alice))
+		})
+	}
+}
+
+func TestProcessReferralRebate_InsufficientFeeCollectorBalance(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+	require.NoError(t, k.SetReferralParams(ctx, types.ReferralParams{
+		ReferrerRebateSharePpm: 100_000,
+	}))
+	require.NoError(t, k.RegisterReferralCode(ctx, alice, "alice"))
+	require.NoError(t, k.SetReferrer(ctx, bob, "alice"))
+
+	feeCollectorBalance := tApp.App.BankKeeper.GetBalance(
+		ctx,
+		authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+		assettypes.AssetUsdc.Denom,
+	)
+	referrerBalance := tApp.App.BankKeeper.GetBalance(ctx, constants.AliceAccAddress, assettypes.AssetUsdc.Denom)
+	// The rebate is 10% of the taker fee, which exceeds the balance of the fee collector.
+	bigTakerFee := new(big.Int).Mul(feeCollectorBalance.Amount.AddRaw(1).BigInt(), big.NewInt(10))
+
+	// The rebate is skipped rather than failing the fill, and nothing is paid or recorded.
+	rebate, err := k.ProcessReferralRebate(ctx, bob, bigTakerFee, bigTakerFee)
+	require.NoError(t, err)
+	require.Zero(t, rebate.Sign())
+	require.Equal(t, &stattypes.ReferralStats{NumReferees: 1}, tApp.App.StatsKeeper.GetReferralStats(ctx, alice))
+	require.Equal(
+		t,
+		feeCollectorBalance,
+		tApp.App.BankKeeper.GetBalance(
+			ctx,
+			authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+			assettypes.AssetUsdc.Denom,
+		),
+	)
+	require.Equal(
+		t,
+		referrerBalance,
+		tApp.App.BankKeeper.GetBalance(ctx, constants.AliceAccAddress, assettypes.AssetUsdc.Denom),
+	)
+}
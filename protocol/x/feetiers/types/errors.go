@@ -30,4 +30,95 @@ var (
 		404,
 		"Authority is invalid",
 	)
+
+	// Referral errors.
+	ErrInvalidReferralParams = errorsmod.Register(
+		ModuleName,
+		405,
+		"Referral params are invalid",
+	)
+	ErrInvalidReferralCode = errorsmod.Register(
+		ModuleName,
+		406,
+		"Referral code is invalid",
+	)
+	ErrReferralCodeAlreadyExists = errorsmod.Register(
+		ModuleName,
+		407,
+		"Referral code is already registered",
+	)
+	ErrOwnerAlreadyHasReferralCode = errorsmod.Register(
+		ModuleName,
+		408,
+		"Address has already registered a referral code",
+	)
+	ErrReferralCodeNotFound = errorsmod.Register(
+		ModuleName,
+		409,
+		"Referral code does not exist",
+	)
+	ErrReferrerAlreadySet = errorsmod.Register(
+		ModuleName,
+		410,
+		"Address is already bound to a referrer",
+	)
+	ErrSelfReferral = errorsmod.Register(
+		ModuleName,
+		411,
+		"Address cannot refer itself",
+	)
+	ErrRefereeHasTraded = errorsmod.Register(
+		ModuleName,
+		412,
+		"Only addresses that have never traded can be bound to a referrer",
+	)
+	ErrInvalidAccountAddress = errorsmod.Register(
+		ModuleName,
+		413,
+		"Account address is invalid",
+	)
+
+	// Staking fee tier and fee tier override errors.
+	ErrStakingTiersOutOfOrder = errorsmod.Register(
+		ModuleName,
+		414,
+		"Staking fee tiers must have ascending staking requirements and fee tiers",
+	)
+	ErrInvalidStakingRequirement = errorsmod.Register(
+		ModuleName,
+		415,
+		"Staking fee tier requirement must be positive",
+	)
+	ErrInvalidFeeTierIndex = errorsmod.Register(
+		ModuleName,
+		416,
+		"Fee tier index does not exist",
+	)
+	ErrFeeTierOverrideExpired = errorsmod.Register(
+		ModuleName,
+		417,
+		"Fee tier override must expire after the current block time",
+	)
+	ErrFeeTierOverrideNotFound = errorsmod.Register(
+		ModuleName,
+		418,
+		"Fee tier override does not exist",
+	)
+	ErrDuplicateFeeTierOverride = errorsmod.Register(
+		ModuleName,
+		421,
+		"Address has more than one fee tier override",
+	)
+
+	// Market fee schedule errors.
+	ErrInvalidMarketFeeSchedule = errorsmod.Register(
+		ModuleName,
+		419,
+		"Market fee schedule is invalid",
+	)
+	ErrMarketFeeScheduleNotFound = errorsmod.Register(
+		ModuleName,
+		420,
+		"Market fee schedule does not exist",
+	)
 )
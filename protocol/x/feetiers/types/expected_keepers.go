@@ -1,6 +1,10 @@
 package types
 
 import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
 )
@@ -9,4 +13,35 @@ import (
 type StatsKeeper interface {
 	GetUserStats(ctx sdk.Context, address string) *types.UserStats
 	GetGlobalStats(ctx sdk.Context) *types.GlobalStats
+	HasTraded(ctx sdk.Context, address string) bool
+	RecordReferee(ctx sdk.Context, referrer string)
+	RecordReferralRebate(ctx sdk.Context, referrer string, refereeTakerFee *big.Int, rebate *big.Int)
+}
+
+// AssetsKeeper defines the expected assets keeper used to convert referral rebates to coins.
+type AssetsKeeper interface {
+	ConvertAssetToCoin(
+		ctx sdk.Context,
+		assetId uint32,
+		quantums *big.Int,
+	) (
+		convertedQuantums *big.Int,
+		coin sdk.Coin,
+		err error,
+	)
+}
+
+// BankKeeper defines the expected bank keeper used to pay referral rebates.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(
+		ctx sdk.Context,
+		senderModule string,
+		recipientAddr sdk.AccAddress,
+		amt sdk.Coins,
+	) error
+}
+
+// StakingKeeper defines the expected staking keeper used to determine staking fee tiers.
+type StakingKeeper interface {
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int
 }
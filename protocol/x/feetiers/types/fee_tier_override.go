@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the fee tier override. The fee tier index and expiry time are validated against
+// state when the override is set.
+func (o FeeTierOverride) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAccountAddress, "address '%s': %v", o.Address, err)
+	}
+	return nil
+}
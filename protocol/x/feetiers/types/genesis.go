@@ -1,5 +1,10 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // StandardParams returns the standard feetiers params for long-term operation of the network.
 func StandardParams() PerpetualFeeParams {
 	return PerpetualFeeParams{
@@ -140,7 +145,12 @@ func PromotionalParams() PerpetualFeeParams {
 // DefaultGenesis returns the default feetiers genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: PromotionalParams(),
+		Params:             PromotionalParams(),
+		ReferralParams:     ReferralParams{},
+		ReferralCodes:      []ReferralCode{},
+		Referrals:          []Referral{},
+		FeeTierOverrides:   []FeeTierOverride{},
+		MarketFeeSchedules: []MarketFeeSchedule{},
 	}
 }
 
@@ -151,5 +161,92 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.ReferralParams.Validate(); err != nil {
+		return err
+	}
+
+	codes := make(map[string]struct{}, len(gs.ReferralCodes))
+	owners := make(map[string]struct{}, len(gs.ReferralCodes))
+	for _, referralCode := range gs.ReferralCodes {
+		if err := ValidateReferralCode(referralCode.Code); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(referralCode.Owner); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAccountAddress, "owner of referral code %s: %v", referralCode.Code, err)
+		}
+		if _, exists := codes[referralCode.Code]; exists {
+			return errorsmod.Wrapf(ErrReferralCodeAlreadyExists, "referral code %s", referralCode.Code)
+		}
+		if _, exists := owners[referralCode.Owner]; exists {
+			return errorsmod.Wrapf(
+				ErrOwnerAlreadyHasReferralCode,
+				"address %s owns more than one referral code",
+				referralCode.Owner,
+			)
+		}
+		codes[referralCode.Code] = struct{}{}
+		owners[referralCode.Owner] = struct{}{}
+	}
+
+	referees := make(map[string]struct{}, len(gs.Referrals))
+	for _, referral := range gs.Referrals {
+		if _, err := sdk.AccAddressFromBech32(referral.Referee); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAccountAddress, "referee %s: %v", referral.Referee, err)
+		}
+		if referral.Referee == referral.Referrer {
+			return errorsmod.Wrapf(ErrSelfReferral, "address %s", referral.Referee)
+		}
+		if _, exists := owners[referral.Referrer]; !exists {
+			return errorsmod.Wrapf(
+				ErrReferralCodeNotFound,
+				"referrer %s of referee %s has not registered a referral code",
+				referral.Referrer,
+				referral.Referee,
+			)
+		}
+		if _, exists := referees[referral.Referee]; exists {
+			return errorsmod.Wrapf(ErrReferrerAlreadySet, "address %s is bound to more than one referrer", referral.Referee)
+		}
+		referees[referral.Referee] = struct{}{}
+	}
+
+	overrideAddresses := make(map[string]struct{}, len(gs.FeeTierOverrides))
+	for _, override := range gs.FeeTierOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+		if override.FeeTierIndex >= uint32(len(gs.Params.Tiers)) {
+			return errorsmod.Wrapf(
+				ErrInvalidFeeTierIndex,
+				"fee tier override of address %s grants fee tier %d, but there are only %d fee tiers",
+				override.Address,
+				override.FeeTierIndex,
+				len(gs.Params.Tiers),
+			)
+		}
+		if _, exists := overrideAddresses[override.Address]; exists {
+			return errorsmod.Wrapf(ErrDuplicateFeeTierOverride, "address %s", override.Address)
+		}
+		overrideAddresses[override.Address] = struct{}{}
+	}
+
+	scheduleClobPairIds := make(map[uint32]struct{}, len(gs.MarketFeeSchedules))
+	for _, schedule := range gs.MarketFeeSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if err := gs.Params.ValidateMarketFeeSchedule(schedule); err != nil {
+			return err
+		}
+		if _, exists := scheduleClobPairIds[schedule.ClobPairId]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidMarketFeeSchedule,
+				"clob pair %d has more than one fee schedule",
+				schedule.ClobPairId,
+			)
+		}
+		scheduleClobPairIds[schedule.ClobPairId] = struct{}{}
+	}
+
 	return nil
 }
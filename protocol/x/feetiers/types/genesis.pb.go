@@ -27,6 +27,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// The parameters for perpetual fees.
 	Params PerpetualFeeParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// The parameters of the referral program.
+	ReferralParams ReferralParams `protobuf:"bytes,2,opt,name=referral_params,json=referralParams,proto3" json:"referral_params"`
+	// All registered referral codes. Each address registers at most one code.
+	ReferralCodes []ReferralCode `protobuf:"bytes,3,rep,name=referral_codes,json=referralCodes,proto3" json:"referral_codes"`
+	// The referrers of all referees.
+	Referrals []Referral `protobuf:"bytes,4,rep,name=referrals,proto3" json:"referrals"`
+	// The fee tier overrides of all addresses. Overrides that have expired by
+	// the genesis time are not imported.
+	FeeTierOverrides []FeeTierOverride `protobuf:"bytes,5,rep,name=fee_tier_overrides,json=feeTierOverrides,proto3" json:"fee_tier_overrides"`
+	// The fee schedules of all CLOB pairs. Each CLOB pair has at most one fee
+	// schedule.
+	MarketFeeSchedules []MarketFeeSchedule `protobuf:"bytes,6,rep,name=market_fee_schedules,json=marketFeeSchedules,proto3" json:"market_fee_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +81,41 @@ func (m *GenesisState) GetParams() PerpetualFeeParams {
 	return PerpetualFeeParams{}
 }
 
+func (m *GenesisState) GetReferralParams() ReferralParams {
+	if m != nil {
+		return m.ReferralParams
+	}
+	return ReferralParams{}
+}
+
+func (m *GenesisState) GetReferralCodes() []ReferralCode {
+	if m != nil {
+		return m.ReferralCodes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferrals() []Referral {
+	if m != nil {
+		return m.Referrals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTierOverrides() []FeeTierOverride {
+	if m != nil {
+		return m.FeeTierOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketFeeSchedules() []MarketFeeSchedule {
+	if m != nil {
+		return m.MarketFeeSchedules
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.feetiers.GenesisState")
 }
@@ -78,21 +125,32 @@ func init() {
 }
 
 var fileDescriptor_f9f97b79045cece2 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x4b, 0x4d, 0x2d, 0xc9, 0x4c, 0x2d,
-	0x2a, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0xcb, 0x08, 0x89, 0x22, 0x2b,
-	0xd2, 0x83, 0x29, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x0b, 0xeb, 0x83, 0x58, 0x10, 0xc5,
-	0x52, 0x4a, 0xd8, 0x4d, 0x2c, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x1a, 0xa8, 0x14, 0xce, 0xc5, 0xe3,
-	0x0e, 0xb1, 0x21, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x9d, 0x8b, 0x0d, 0x22, 0x2f, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa9, 0x87, 0xd5, 0x46, 0xbd, 0x80, 0xd4, 0xa2, 0x82, 0xd4, 0x92,
-	0xd2, 0xc4, 0x1c, 0xb7, 0xd4, 0xd4, 0x00, 0xb0, 0x06, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82,
-	0xa0, 0xda, 0x9d, 0x42, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2a,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xc5, 0x85, 0x65, 0x26, 0xba,
-	0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x70, 0x91, 0x0a, 0x84, 0xab, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x52, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xa0, 0x19, 0x66, 0x2d,
-	0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x8b, 0xda, 0x40,
+	0x18, 0xc6, 0x93, 0x6a, 0x85, 0x8e, 0xfd, 0xc7, 0x60, 0x21, 0x78, 0x88, 0xa2, 0x6d, 0xb1, 0x07,
+	0x13, 0xb0, 0x3d, 0xf5, 0xa8, 0xa0, 0xa7, 0x52, 0x51, 0x4f, 0x5e, 0xd2, 0x98, 0xbc, 0x89, 0xa1,
+	0x89, 0x13, 0x66, 0x46, 0xd1, 0xeb, 0x7e, 0x82, 0xfd, 0x58, 0x1e, 0x3d, 0xee, 0x69, 0x59, 0xf4,
+	0x8b, 0x2c, 0x4e, 0x26, 0x6a, 0x76, 0x23, 0xde, 0x86, 0xe7, 0xfd, 0x3d, 0xbf, 0x64, 0x98, 0x17,
+	0x35, 0xdd, 0x8d, 0xbb, 0x8e, 0x29, 0xe1, 0xc4, 0x21, 0xa1, 0xe9, 0x01, 0xf0, 0x00, 0x28, 0x33,
+	0x7d, 0x58, 0x00, 0x0b, 0x98, 0x21, 0x26, 0xf8, 0xcb, 0x25, 0x64, 0xa4, 0x50, 0xb5, 0xe2, 0x13,
+	0x9f, 0x88, 0xd8, 0x3c, 0x9e, 0x12, 0xb8, 0xda, 0xce, 0x37, 0x7a, 0x00, 0xd6, 0xf1, 0x64, 0x91,
+	0x15, 0x50, 0x1a, 0xb8, 0x20, 0x71, 0x33, 0x1f, 0x8f, 0x6c, 0xfa, 0x1f, 0xb8, 0x75, 0x6c, 0x31,
+	0x67, 0x0e, 0xee, 0x32, 0x4c, 0x0b, 0x8d, 0xfc, 0x42, 0x6c, 0x53, 0x3b, 0x92, 0x3f, 0x5c, 0xfd,
+	0x9a, 0xcf, 0x50, 0xf0, 0x80, 0x52, 0x3b, 0x4c, 0xa8, 0xc6, 0x5d, 0x11, 0xbd, 0x1f, 0x24, 0x17,
+	0x1d, 0x73, 0x9b, 0x03, 0x1e, 0xa0, 0x52, 0xa2, 0xd1, 0xd4, 0xba, 0xda, 0x2a, 0x77, 0x7e, 0x18,
+	0xb9, 0x17, 0x37, 0x86, 0x40, 0x63, 0xe0, 0x4b, 0x3b, 0xec, 0x03, 0x0c, 0x45, 0xa1, 0x5b, 0xdc,
+	0x3e, 0xd6, 0x94, 0x91, 0xac, 0xe3, 0x09, 0xfa, 0x94, 0x7e, 0xcb, 0x92, 0xc6, 0x37, 0xc2, 0xf8,
+	0xed, 0x8a, 0x71, 0x24, 0xe9, 0x8c, 0xed, 0x23, 0xcd, 0xa4, 0x78, 0x88, 0x4e, 0x89, 0xe5, 0x10,
+	0x17, 0x98, 0x56, 0xa8, 0x17, 0x5a, 0xe5, 0x4e, 0xf3, 0x86, 0xb4, 0x47, 0x5c, 0x90, 0xca, 0x0f,
+	0xf4, 0x22, 0x63, 0xb8, 0x87, 0xde, 0xa5, 0x01, 0xd3, 0x8a, 0x42, 0x56, 0xbb, 0x21, 0x93, 0xa2,
+	0x73, 0x0f, 0x4f, 0x11, 0x7e, 0xf5, 0xb8, 0x4c, 0x7b, 0x2b, 0x6c, 0xdf, 0xaf, 0xd8, 0xfa, 0x00,
+	0x93, 0x00, 0xe8, 0x5f, 0x89, 0x4b, 0xe9, 0x67, 0x2f, 0x1b, 0x33, 0xfc, 0x0f, 0x55, 0x72, 0x36,
+	0x81, 0x69, 0x25, 0x61, 0x6f, 0x5d, 0xb1, 0xff, 0x11, 0x95, 0x3e, 0xc0, 0x58, 0x16, 0xa4, 0x1f,
+	0x47, 0x2f, 0x07, 0xac, 0x3b, 0xd9, 0xee, 0x75, 0x75, 0xb7, 0xd7, 0xd5, 0xa7, 0xbd, 0xae, 0xde,
+	0x1f, 0x74, 0x65, 0x77, 0xd0, 0x95, 0x87, 0x83, 0xae, 0x4c, 0x7f, 0xfb, 0x01, 0x9f, 0x2f, 0x67,
+	0x86, 0x43, 0xa2, 0xec, 0x92, 0xae, 0x7e, 0xb5, 0x9d, 0xb9, 0x1d, 0x2c, 0xcc, 0x53, 0xb2, 0x3e,
+	0xef, 0x18, 0xdf, 0xc4, 0xc0, 0x66, 0x25, 0x31, 0xfa, 0xf9, 0x3c, 0x00, 0xec, 0xf9, 0x76, 0x38,
+	0x5f, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +173,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MarketFeeSchedules) > 0 {
+		for iNdEx := len(m.MarketFeeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketFeeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeTierOverrides) > 0 {
+		for iNdEx := len(m.FeeTierOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTierOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ReferralCodes) > 0 {
+		for iNdEx := len(m.ReferralCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.ReferralParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +271,32 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ReferralParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReferralCodes) > 0 {
+		for _, e := range m.ReferralCodes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTierOverrides) > 0 {
+		for _, e := range m.FeeTierOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketFeeSchedules) > 0 {
+		for _, e := range m.MarketFeeSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +368,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralCodes = append(m.ReferralCodes, ReferralCode{})
+			if err := m.ReferralCodes[len(m.ReferralCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrals = append(m.Referrals, Referral{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTierOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTierOverrides = append(m.FeeTierOverrides, FeeTierOverride{})
+			if err := m.FeeTierOverrides[len(m.FeeTierOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketFeeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketFeeSchedules = append(m.MarketFeeSchedules, MarketFeeSchedule{})
+			if err := m.MarketFeeSchedules[len(m.MarketFeeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,11 +2,19 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
 	"github.com/stretchr/testify/require"
 )
 
+var (
+	alice = constants.AliceAccAddress.String()
+	bob   = constants.BobAccAddress.String()
+	carl  = constants.CarlAccAddress.String()
+)
+
 func TestGenesisState_Validate(t *testing.T) {
 	tests := map[string]struct {
 		genState *types.GenesisState
@@ -38,6 +46,183 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			err: nil,
 		},
+		"valid referrals": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				ReferralParams: types.ReferralParams{
+					ReferrerRebateSharePpm:     100_000,
+					RefereeTakerFeeDiscountPpm: 50_000,
+				},
+				ReferralCodes: []types.ReferralCode{
+					{Code: "alice", Owner: alice},
+					{Code: "bobby", Owner: bob},
+				},
+				Referrals: []types.Referral{
+					{Referee: bob, Referrer: alice},
+					{Referee: carl, Referrer: alice},
+				},
+			},
+			err: nil,
+		},
+		"invalid referral params": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				ReferralParams: types.ReferralParams{
+					ReferrerRebateSharePpm: 1_000_001,
+				},
+			},
+			err: types.ErrInvalidReferralParams,
+		},
+		"invalid referral code": {
+			genState: &types.GenesisState{
+				Params:        types.PromotionalParams(),
+				ReferralCodes: []types.ReferralCode{{Code: "a!", Owner: alice}},
+			},
+			err: types.ErrInvalidReferralCode,
+		},
+		"invalid referral code owner": {
+			genState: &types.GenesisState{
+				Params:        types.PromotionalParams(),
+				ReferralCodes: []types.ReferralCode{{Code: "alice", Owner: "invalid"}},
+			},
+			err: types.ErrInvalidAccountAddress,
+		},
+		"duplicate referral code": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				ReferralCodes: []types.ReferralCode{
+					{Code: "alice", Owner: alice},
+					{Code: "alice", Owner: bob},
+				},
+			},
+			err: types.ErrReferralCodeAlreadyExists,
+		},
+		"owner with multiple referral codes": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				ReferralCodes: []types.ReferralCode{
+					{Code: "alice", Owner: alice},
+					{Code: "alice2", Owner: alice},
+				},
+			},
+			err: types.ErrOwnerAlreadyHasReferralCode,
+		},
+		"invalid referee": {
+			genState: &types.GenesisState{
+				Params:        types.PromotionalParams(),
+				ReferralCodes: []types.ReferralCode{{Code: "alice", Owner: alice}},
+				Referrals:     []types.Referral{{Referee: "invalid", Referrer: alice}},
+			},
+			err: types.ErrInvalidAccountAddress,
+		},
+		"self referral": {
+			genState: &types.GenesisState{
+				Params:        types.PromotionalParams(),
+				ReferralCodes: []types.ReferralCode{{Code: "alice", Owner: alice}},
+				Referrals:     []types.Referral{{Referee: alice, Referrer: alice}},
+			},
+			err: types.ErrSelfReferral,
+		},
+		"referrer without referral code": {
+			genState: &types.GenesisState{
+				Params:        types.PromotionalParams(),
+				ReferralCodes: []types.ReferralCode{{Code: "alice", Owner: alice}},
+				Referrals:     []types.Referral{{Referee: carl, Referrer: bob}},
+			},
+			err: types.ErrReferralCodeNotFound,
+		},
+		"referee with multiple referrers": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				ReferralCodes: []types.ReferralCode{
+					{Code: "alice", Owner: alice},
+					{Code: "bobby", Owner: bob},
+				},
+				Referrals: []types.Referral{
+					{Referee: carl, Referrer: alice},
+					{Referee: carl, Referrer: bob},
+				},
+			},
+			err: types.ErrReferrerAlreadySet,
+		},
+		"valid fee tier overrides": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				FeeTierOverrides: []types.FeeTierOverride{
+					{Address: alice, FeeTierIndex: 8, ExpiryTime: time.Unix(100, 0).UTC()},
+					{Address: bob, FeeTierIndex: 0, ExpiryTime: time.Unix(100, 0).UTC()},
+				},
+			},
+			err: nil,
+		},
+		"invalid fee tier override address": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				FeeTierOverrides: []types.FeeTierOverride{
+					{Address: "invalid", FeeTierIndex: 0, ExpiryTime: time.Unix(100, 0).UTC()},
+				},
+			},
+			err: types.ErrInvalidAccountAddress,
+		},
+		"fee tier override grants a fee tier that does not exist": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				FeeTierOverrides: []types.FeeTierOverride{
+					{Address: alice, FeeTierIndex: 9, ExpiryTime: time.Unix(100, 0).UTC()},
+				},
+			},
+			err: types.ErrInvalidFeeTierIndex,
+		},
+		"duplicate fee tier override": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				FeeTierOverrides: []types.FeeTierOverride{
+					{Address: alice, FeeTierIndex: 1, ExpiryTime: time.Unix(100, 0).UTC()},
+					{Address: alice, FeeTierIndex: 2, ExpiryTime: time.Unix(200, 0).UTC()},
+				},
+			},
+			err: types.ErrDuplicateFeeTierOverride,
+		},
+		"valid market fee schedules": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				MarketFeeSchedules: []types.MarketFeeSchedule{
+					{ClobPairId: 0, MakerFeeMultiplierPpm: 0, TakerFeeMultiplierPpm: 500_000},
+					{ClobPairId: 1, MakerFeeMultiplierPpm: 1_000_000, TakerFeeMultiplierPpm: 1_000_000},
+				},
+			},
+			err: nil,
+		},
+		"invalid market fee schedule multiplier": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				MarketFeeSchedules: []types.MarketFeeSchedule{
+					{ClobPairId: 0, MakerFeeMultiplierPpm: types.MaxFeeMultiplierPpm + 1},
+				},
+			},
+			err: types.ErrInvalidMarketFeeSchedule,
+		},
+		"market fee schedule results in a net rebate under the fee tiers": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				MarketFeeSchedules: []types.MarketFeeSchedule{
+					// The largest maker rebate of 110 ppm scales to 330 ppm, more than the lowest taker
+					// fee of 250 ppm.
+					{ClobPairId: 0, MakerFeeMultiplierPpm: 3_000_000, TakerFeeMultiplierPpm: 1_000_000},
+				},
+			},
+			err: types.ErrInvalidFee,
+		},
+		"duplicate market fee schedule": {
+			genState: &types.GenesisState{
+				Params: types.PromotionalParams(),
+				MarketFeeSchedules: []types.MarketFeeSchedule{
+					{ClobPairId: 0, MakerFeeMultiplierPpm: 1_000_000, TakerFeeMultiplierPpm: 1_000_000},
+					{ClobPairId: 0, MakerFeeMultiplierPpm: 0, TakerFeeMultiplierPpm: 1_000_000},
+				},
+			},
+			err: types.ErrInvalidMarketFeeSchedule,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -45,7 +230,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			if tc.err == nil {
 				require.NoError(t, err)
 			} else {
-				require.ErrorIs(t, tc.err, err)
+				require.ErrorIs(t, err, tc.err)
 			}
 		})
 	}
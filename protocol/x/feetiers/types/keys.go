@@ -13,4 +13,22 @@ const (
 const (
 	// PerpetualFeeParamsKey defines the key for the PerpetualFeeParams
 	PerpetualFeeParamsKey = "PerpParams"
+
+	// ReferralParamsKey defines the key for the ReferralParams
+	ReferralParamsKey = "RefParams"
+
+	// ReferralCodeKeyPrefix is the prefix to retrieve the ReferralCode for a given code
+	ReferralCodeKeyPrefix = "RefCode:"
+
+	// OwnerReferralCodeKeyPrefix is the prefix to retrieve the referral code registered by a given address
+	OwnerReferralCodeKeyPrefix = "OwnerRefCode:"
+
+	// ReferrerKeyPrefix is the prefix to retrieve the referrer of a given address
+	ReferrerKeyPrefix = "Referrer:"
+
+	// FeeTierOverrideKeyPrefix is the prefix to retrieve the FeeTierOverride of a given address
+	FeeTierOverrideKeyPrefix = "FeeTierOverride:"
+
+	// MarketFeeScheduleKeyPrefix is the prefix to retrieve the MarketFeeSchedule of a given CLOB pair
+	MarketFeeScheduleKeyPrefix = "MarketFeeSchedule:"
 )
@@ -14,4 +14,10 @@ func TestModuleKeys(t *testing.T) {
 
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "PerpParams", types.PerpetualFeeParamsKey)
+	require.Equal(t, "RefParams", types.ReferralParamsKey)
+	require.Equal(t, "RefCode:", types.ReferralCodeKeyPrefix)
+	require.Equal(t, "OwnerRefCode:", types.OwnerReferralCodeKeyPrefix)
+	require.Equal(t, "Referrer:", types.ReferrerKeyPrefix)
+	require.Equal(t, "FeeTierOverride:", types.FeeTierOverrideKeyPrefix)
+	require.Equal(t, "MarketFeeSchedule:", types.MarketFeeScheduleKeyPrefix)
 }
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// MaxFeeMultiplierPpm is the maximum fee multiplier of a market fee schedule.
+const MaxFeeMultiplierPpm = 10 * lib.OneMillion
+
+// Validate validates the fee multipliers of the market fee schedule. Whether the scaled fee rates
+// can result in a net rebate depends on the fee tiers, and is validated by
+// `PerpetualFeeParams.ValidateMarketFeeSchedule`.
+func (s MarketFeeSchedule) Validate() error {
+	if s.MakerFeeMultiplierPpm > MaxFeeMultiplierPpm {
+		return errorsmod.Wrapf(
+			ErrInvalidMarketFeeSchedule,
+			"maker_fee_multiplier_ppm %d cannot be greater than %d",
+			s.MakerFeeMultiplierPpm,
+			MaxFeeMultiplierPpm,
+		)
+	}
+
+	if s.TakerFeeMultiplierPpm > MaxFeeMultiplierPpm {
+		return errorsmod.Wrapf(
+			ErrInvalidMarketFeeSchedule,
+			"taker_fee_multiplier_ppm %d cannot be greater than %d",
+			s.TakerFeeMultiplierPpm,
+			MaxFeeMultiplierPpm,
+		)
+	}
+
+	return nil
+}
+
+// IsEffective returns true if the market fee schedule applies at `blockTime`.
+func (s MarketFeeSchedule) IsEffective(blockTime time.Time) bool {
+	return !blockTime.Before(s.EffectiveFrom)
+}
+
+// ScaleFeePpm returns `feePpm` scaled by the maker or taker fee multiplier of the market fee
+// schedule. The result is rounded towards zero, so that neither fees nor rebates are rounded up.
+func (s MarketFeeSchedule) ScaleFeePpm(feePpm int32, isTaker bool) int32 {
+	multiplierPpm := s.MakerFeeMultiplierPpm
+	if isTaker {
+		multiplierPpm = s.TakerFeeMultiplierPpm
+	}
+
+	scaledFeePpm := int64(feePpm) * int64(multiplierPpm) / int64(lib.OneMillion)
+	return int32(lib.Max(lib.Min(scaledFeePpm, math.MaxInt32), math.MinInt32))
+}
@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketFeeSchedule_Validate(t *testing.T) {
+	tests := map[string]struct {
+		schedule types.MarketFeeSchedule
+		err      error
+	}{
+		"zero multipliers are valid": {
+			schedule: types.MarketFeeSchedule{},
+		},
+		"max multipliers are valid": {
+			schedule: types.MarketFeeSchedule{
+				MakerFeeMultiplierPpm: types.MaxFeeMultiplierPpm,
+				TakerFeeMultiplierPpm: types.MaxFeeMultiplierPpm,
+			},
+		},
+		"maker multiplier above max is invalid": {
+			schedule: types.MarketFeeSchedule{
+				MakerFeeMultiplierPpm: types.MaxFeeMultiplierPpm + 1,
+			},
+			err: types.ErrInvalidMarketFeeSchedule,
+		},
+		"taker multiplier above max is invalid": {
+			schedule: types.MarketFeeSchedule{
+				TakerFeeMultiplierPpm: types.MaxFeeMultiplierPpm + 1,
+			},
+			err: types.ErrInvalidMarketFeeSchedule,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMarketFeeSchedule_IsEffective(t *testing.T) {
+	schedule := types.MarketFeeSchedule{EffectiveFrom: time.Unix(100, 0).UTC()}
+
+	require.False(t, schedule.IsEffective(time.Unix(99, 0).UTC()))
+	require.True(t, schedule.IsEffective(time.Unix(100, 0).UTC()))
+	require.True(t, schedule.IsEffective(time.Unix(101, 0).UTC()))
+}
+
+func TestMarketFeeSchedule_ScaleFeePpm(t *testing.T) {
+	tests := map[string]struct {
+		multiplierPpm uint32
+		feePpm        int32
+
+		expectedFeePpm int32
+	}{
+		"no change": {
+			multiplierPpm:  1_000_000,
+			feePpm:         500,
+			expectedFeePpm: 500,
+		},
+		"half fee": {
+			multiplierPpm:  500_000,
+			feePpm:         500,
+			expectedFeePpm: 250,
+		},
+		"zero fee": {
+			multiplierPpm:  0,
+			feePpm:         500,
+			expectedFeePpm: 0,
+		},
+		"double rebate": {
+			multiplierPpm:  2_000_000,
+			feePpm:         -110,
+			expectedFeePpm: -220,
+		},
+		"fee is rounded towards zero": {
+			multiplierPpm:  333_333,
+			feePpm:         5,
+			expectedFeePpm: 1,
+		},
+		"rebate is rounded towards zero": {
+			multiplierPpm:  333_333,
+			feePpm:         -5,
+			expectedFeePpm: -1,
+		},
+		"fee is capped at max int32": {
+			multiplierPpm:  types.MaxFeeMultiplierPpm,
+			feePpm:         math.MaxInt32,
+			expectedFeePpm: math.MaxInt32,
+		},
+		"rebate is capped at min int32": {
+			multiplierPpm:  types.MaxFeeMultiplierPpm,
+			feePpm:         math.MinInt32,
+			expectedFeePpm: math.MinInt32,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			makerSchedule := types.MarketFeeSchedule{MakerFeeMultiplierPpm: tc.multiplierPpm}
+			require.Equal(t, tc.expectedFeePpm, makerSchedule.ScaleFeePpm(tc.feePpm, false))
+			require.Equal(t, int32(0), makerSchedule.ScaleFeePpm(tc.feePpm, true))
+
+			takerSchedule := types.MarketFeeSchedule{TakerFeeMultiplierPpm: tc.multiplierPpm}
+			require.Equal(t, tc.expectedFeePpm, takerSchedule.ScaleFeePpm(tc.feePpm, true))
+			require.Equal(t, int32(0), takerSchedule.ScaleFeePpm(tc.feePpm, false))
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package types
 
-import "math"
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+)
 
 func (m *PerpetualFeeParams) Validate() error {
 	if len(m.Tiers) == 0 {
@@ -39,5 +43,56 @@ func (m *PerpetualFeeParams) Validate() error {
 		return ErrInvalidFee
 	}
 
+	for i, stakingTier := range m.StakingTiers {
+		if stakingTier.MinBondedAmount.IsNil() || stakingTier.MinBondedAmount.BigInt().Sign() <= 0 {
+			return ErrInvalidStakingRequirement
+		}
+		if stakingTier.FeeTierIndex >= uint32(len(m.Tiers)) {
+			return errorsmod.Wrapf(
+				ErrInvalidFeeTierIndex,
+				"staking fee tier %d grants fee tier %d, but there are only %d fee tiers",
+				i,
+				stakingTier.FeeTierIndex,
+				len(m.Tiers),
+			)
+		}
+		if i > 0 {
+			prevStakingTier := m.StakingTiers[i-1]
+			if prevStakingTier.MinBondedAmount.Cmp(stakingTier.MinBondedAmount) >= 0 ||
+				prevStakingTier.FeeTierIndex >= stakingTier.FeeTierIndex {
+				return ErrStakingTiersOutOfOrder
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateMarketFeeSchedule returns an error if the fee rates of the fee tiers scaled by `schedule`
+// can result in a net rebate.
+func (m *PerpetualFeeParams) ValidateMarketFeeSchedule(schedule MarketFeeSchedule) error {
+	lowestMakerFee := int32(math.MaxInt32)
+	lowestTakerFee := int32(math.MaxInt32)
+	for _, tier := range m.Tiers {
+		if tier.MakerFeePpm < lowestMakerFee {
+			lowestMakerFee = tier.MakerFeePpm
+		}
+		if tier.TakerFeePpm < lowestTakerFee {
+			lowestTakerFee = tier.TakerFeePpm
+		}
+	}
+
+	scaledLowestMakerFee := schedule.ScaleFeePpm(lowestMakerFee, false)
+	scaledLowestTakerFee := schedule.ScaleFeePpm(lowestTakerFee, true)
+	if int64(scaledLowestMakerFee)+int64(scaledLowestTakerFee) < 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidFee,
+			"fee schedule of clob pair %d scales the lowest maker fee to %d ppm and the lowest taker fee to %d ppm",
+			schedule.ClobPairId,
+			scaledLowestMakerFee,
+			scaledLowestTakerFee,
+		)
+	}
+
 	return nil
 }
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -26,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PerpetualFeeParams struct {
 	// Sorted fee tiers (lowest requirements first).
 	Tiers []*PerpetualFeeTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// Sorted staking fee tiers (lowest staking requirement first). A trader that
+	// meets the staking requirement of a staking fee tier is placed in at least
+	// the fee tier it grants, regardless of the trader's volume.
+	StakingTiers []StakingFeeTier `protobuf:"bytes,2,rep,name=staking_tiers,json=stakingTiers,proto3" json:"staking_tiers"`
 }
 
 func (m *PerpetualFeeParams) Reset()         { *m = PerpetualFeeParams{} }
@@ -68,6 +74,13 @@ func (m *PerpetualFeeParams) GetTiers() []*PerpetualFeeTier {
 	return nil
 }
 
+func (m *PerpetualFeeParams) GetStakingTiers() []StakingFeeTier {
+	if m != nil {
+		return m.StakingTiers
+	}
+	return nil
+}
+
 // A fee tier for perpetuals
 type PerpetualFeeTier struct {
 	// Human-readable name of the tier, e.g. "Gold".
@@ -159,9 +172,59 @@ func (m *PerpetualFeeTier) GetTakerFeePpm() int32 {
 	return 0
 }
 
+// A minimum fee tier granted to traders that stake native tokens.
+type StakingFeeTier struct {
+	// The amount of native tokens, in base units, the trader must have bonded to
+	// validators.
+	MinBondedAmount github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=min_bonded_amount,json=minBondedAmount,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"min_bonded_amount"`
+	// The index of the granted fee tier in `tiers`.
+	FeeTierIndex uint32 `protobuf:"varint,2,opt,name=fee_tier_index,json=feeTierIndex,proto3" json:"fee_tier_index,omitempty"`
+}
+
+func (m *StakingFeeTier) Reset()         { *m = StakingFeeTier{} }
+func (m *StakingFeeTier) String() string { return proto.CompactTextString(m) }
+func (*StakingFeeTier) ProtoMessage()    {}
+func (*StakingFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2cb51fc3ff0866a, []int{2}
+}
+func (m *StakingFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingFeeTier.Merge(m, src)
+}
+func (m *StakingFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingFeeTier proto.InternalMessageInfo
+
+func (m *StakingFeeTier) GetFeeTierIndex() uint32 {
+	if m != nil {
+		return m.FeeTierIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PerpetualFeeParams)(nil), "dydxprotocol.feetiers.PerpetualFeeParams")
 	proto.RegisterType((*PerpetualFeeTier)(nil), "dydxprotocol.feetiers.PerpetualFeeTier")
+	proto.RegisterType((*StakingFeeTier)(nil), "dydxprotocol.feetiers.StakingFeeTier")
 }
 
 func init() {
@@ -169,28 +232,37 @@ func init() {
 }
 
 var fileDescriptor_c2cb51fc3ff0866a = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x4b, 0xfb, 0x40,
-	0x1c, 0x87, 0x7b, 0xfd, 0x07, 0xbf, 0x2b, 0x85, 0x9f, 0x07, 0x42, 0x44, 0x38, 0x42, 0x16, 0xb3,
-	0x98, 0x80, 0x3a, 0x09, 0x3a, 0x38, 0x38, 0x38, 0x95, 0x6b, 0x71, 0x70, 0x29, 0xd7, 0xf6, 0x5b,
-	0x1b, 0xcc, 0xf5, 0xce, 0xcb, 0xa5, 0xb4, 0xef, 0xc2, 0x57, 0x25, 0x8e, 0x1d, 0x1d, 0xa5, 0x7d,
-	0x23, 0xd2, 0x3b, 0x6a, 0x12, 0x51, 0xb7, 0xf0, 0xfd, 0x3c, 0xcf, 0x33, 0x24, 0xc1, 0xc1, 0x64,
-	0x35, 0x59, 0x2a, 0x2d, 0x8d, 0x1c, 0xcb, 0x34, 0x9e, 0x02, 0x98, 0x04, 0x74, 0x16, 0x2b, 0xae,
-	0xb9, 0xc8, 0x22, 0x3b, 0x90, 0xc3, 0x32, 0x13, 0xed, 0x99, 0xa0, 0x8f, 0x49, 0x0f, 0xb4, 0x02,
-	0x93, 0xf3, 0xf4, 0x16, 0xa0, 0x67, 0x15, 0x72, 0x85, 0x5b, 0x76, 0xf6, 0x90, 0xdf, 0x08, 0x3b,
-	0x67, 0x27, 0xd1, 0x8f, 0x72, 0x54, 0x36, 0x07, 0x09, 0x68, 0xe6, 0xac, 0xe0, 0xb5, 0x8e, 0xff,
-	0x7f, 0xdf, 0x08, 0xc1, 0xcd, 0x39, 0x17, 0xe0, 0x21, 0x1f, 0x85, 0xff, 0x98, 0x7d, 0x26, 0xd7,
-	0xf8, 0x98, 0x8f, 0x32, 0x99, 0xe6, 0x06, 0x86, 0x0b, 0x99, 0xe6, 0x02, 0x86, 0x1a, 0x9e, 0xf3,
-	0x44, 0x83, 0x80, 0xb9, 0xf1, 0xea, 0x3e, 0x0a, 0x9b, 0xec, 0x68, 0x8f, 0xdc, 0x5b, 0x82, 0x15,
-	0x00, 0xb9, 0xc3, 0x81, 0x91, 0x86, 0xa7, 0x7b, 0x39, 0x9b, 0x71, 0x5d, 0x49, 0x0c, 0x95, 0x12,
-	0x5e, 0xc3, 0x47, 0x61, 0x97, 0x51, 0x4b, 0xba, 0x46, 0x7f, 0xc7, 0x95, 0x42, 0x3d, 0x25, 0x76,
-	0x2d, 0xc1, 0x9f, 0x40, 0xff, 0xdd, 0x6a, 0xba, 0x96, 0x25, 0x7f, 0x6f, 0x05, 0xb8, 0xeb, 0x5a,
-	0x53, 0x00, 0xab, 0xb5, 0x7c, 0x14, 0x1e, 0xb0, 0x8e, 0x3d, 0xee, 0x5e, 0xb3, 0x63, 0x4c, 0x85,
-	0x69, 0x3b, 0xc6, 0x14, 0xcc, 0xcd, 0xe0, 0x6d, 0x43, 0xd1, 0x7a, 0x43, 0xd1, 0xc7, 0x86, 0xa2,
-	0x97, 0x2d, 0xad, 0xad, 0xb7, 0xb4, 0xf6, 0xbe, 0xa5, 0xb5, 0x87, 0xcb, 0xc7, 0xc4, 0xcc, 0xf2,
-	0x51, 0x34, 0x96, 0x22, 0xae, 0x7c, 0xfd, 0xc5, 0xc5, 0xe9, 0x78, 0xc6, 0x93, 0x79, 0xfc, 0x75,
-	0x59, 0x16, 0x7f, 0x84, 0x59, 0x29, 0xc8, 0x46, 0x6d, 0x3b, 0x9d, 0x7f, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0x23, 0x7a, 0x77, 0x37, 0x02, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0xad, 0x9b, 0x84, 0xd7, 0x0e, 0x66, 0x81, 0x54, 0x40, 0xca, 0xa2, 0x08, 0x44,
+	0x2e, 0x24, 0x12, 0x70, 0x42, 0x02, 0x41, 0x0f, 0x88, 0x71, 0xaa, 0xdc, 0x89, 0x03, 0x97, 0xc8,
+	0x69, 0x5e, 0x5b, 0x6b, 0xb1, 0x1d, 0x1c, 0x67, 0xea, 0xf8, 0x14, 0x7c, 0x09, 0x6e, 0x7c, 0x0e,
+	0xb4, 0xe3, 0x8e, 0x88, 0xc3, 0x84, 0xda, 0x2f, 0x82, 0x6c, 0xaf, 0xb4, 0x45, 0x0c, 0x71, 0x8b,
+	0x9e, 0x7f, 0xff, 0xdf, 0x7b, 0xf2, 0x73, 0x70, 0x54, 0x9c, 0x15, 0xb3, 0x4a, 0x2b, 0xa3, 0x46,
+	0xaa, 0x4c, 0xc7, 0x00, 0x86, 0x83, 0xae, 0xd3, 0x8a, 0x69, 0x26, 0xea, 0xc4, 0x1d, 0x90, 0x3b,
+	0xeb, 0x4c, 0xb2, 0x64, 0xee, 0xdd, 0x9e, 0xa8, 0x89, 0x72, 0xe5, 0xd4, 0x7e, 0x79, 0x38, 0xfa,
+	0x82, 0x30, 0x19, 0x80, 0xae, 0xc0, 0x34, 0xac, 0x7c, 0x03, 0x30, 0x70, 0x26, 0xf2, 0x02, 0xef,
+	0xb8, 0x54, 0x0f, 0x85, 0xdb, 0xf1, 0xde, 0x93, 0x47, 0xc9, 0x5f, 0x9d, 0xc9, 0x7a, 0xf2, 0x98,
+	0x83, 0xa6, 0x3e, 0x45, 0x06, 0xb8, 0x5b, 0x1b, 0x76, 0xc2, 0xe5, 0x24, 0xf3, 0x9a, 0x2d, 0xa7,
+	0x79, 0x78, 0x8d, 0x66, 0xe8, 0xd9, 0x2b, 0x49, 0xbf, 0x7d, 0x7e, 0x79, 0xd8, 0xa2, 0x9d, 0x2b,
+	0x83, 0x2d, 0xd5, 0xd1, 0xb7, 0x2d, 0x7c, 0xeb, 0xcf, 0x6e, 0x84, 0xe0, 0xb6, 0x64, 0x02, 0x7a,
+	0x28, 0x44, 0xf1, 0x0d, 0xea, 0xbe, 0xc9, 0x4b, 0x7c, 0x9f, 0xe5, 0xb5, 0x2a, 0x1b, 0x03, 0xd9,
+	0xa9, 0x2a, 0x1b, 0x01, 0x99, 0x86, 0x8f, 0x0d, 0xd7, 0x20, 0x40, 0x9a, 0xde, 0x56, 0x88, 0xe2,
+	0x36, 0xbd, 0xbb, 0x44, 0xde, 0x3b, 0x82, 0xae, 0x00, 0xf2, 0x0e, 0x47, 0x46, 0x19, 0x56, 0x2e,
+	0xc3, 0xf5, 0x94, 0xe9, 0x0d, 0x45, 0x56, 0x55, 0xa2, 0xb7, 0x1d, 0xa2, 0xb8, 0x4b, 0x03, 0x47,
+	0x7a, 0xc7, 0xd0, 0x72, 0x6b, 0xa2, 0x41, 0x25, 0xac, 0x4b, 0xb0, 0x13, 0xd0, 0xff, 0x76, 0xb5,
+	0xbd, 0xcb, 0x91, 0xd7, 0xbb, 0x22, 0xdc, 0xf5, 0xae, 0x31, 0x80, 0x8b, 0xed, 0x84, 0x28, 0x3e,
+	0xa0, 0x7b, 0xae, 0x68, 0x17, 0xe7, 0x19, 0xb3, 0xc1, 0xec, 0x7a, 0xc6, 0xac, 0x98, 0xe8, 0x2b,
+	0xc2, 0xfb, 0x9b, 0xf7, 0x4d, 0x0c, 0x3e, 0x10, 0x5c, 0x66, 0xb9, 0x92, 0x05, 0x14, 0x19, 0x13,
+	0xaa, 0x91, 0xc6, 0xdd, 0x69, 0xa7, 0xff, 0xd6, 0xae, 0xe2, 0xc7, 0xe5, 0xe1, 0xab, 0x09, 0x37,
+	0xd3, 0x26, 0x4f, 0x46, 0x4a, 0xa4, 0x1b, 0x4f, 0xf0, 0xf4, 0xd9, 0xe3, 0xd1, 0x94, 0x71, 0x99,
+	0xfe, 0xae, 0x14, 0xe6, 0xac, 0x82, 0x3a, 0x19, 0x82, 0xe6, 0xac, 0xe4, 0x9f, 0x58, 0x5e, 0xc2,
+	0x91, 0x34, 0xf4, 0xa6, 0xe0, 0xb2, 0xef, 0x3a, 0xbc, 0x76, 0x0d, 0xc8, 0x03, 0xbc, 0x6f, 0xc7,
+	0xb4, 0x2f, 0x20, 0xe3, 0xb2, 0x80, 0x99, 0xdb, 0x4d, 0x97, 0x76, 0xc6, 0x7e, 0xac, 0x23, 0x5b,
+	0xeb, 0x1f, 0x9f, 0xcf, 0x03, 0x74, 0x31, 0x0f, 0xd0, 0xcf, 0x79, 0x80, 0x3e, 0x2f, 0x82, 0xd6,
+	0xc5, 0x22, 0x68, 0x7d, 0x5f, 0x04, 0xad, 0x0f, 0xcf, 0xff, 0x7f, 0xa4, 0xd9, 0xea, 0x4f, 0x71,
+	0xc3, 0xe5, 0xbb, 0xee, 0xe8, 0xe9, 0xaf, 0x01, 0x00, 0x18, 0x66, 0x80, 0x0d, 0x4f, 0x03, 0x00,
+	0x00,
 }
 
 func (m *PerpetualFeeParams) Marshal() (dAtA []byte, err error) {
@@ -213,6 +285,20 @@ func (m *PerpetualFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StakingTiers) > 0 {
+		for iNdEx := len(m.StakingTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Tiers) > 0 {
 		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -285,6 +371,44 @@ func (m *PerpetualFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StakingFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeTierIndex != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeTierIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinBondedAmount.Size()
+		i -= size
+		if _, err := m.MinBondedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -308,6 +432,12 @@ func (m *PerpetualFeeParams) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.StakingTiers) > 0 {
+		for _, e := range m.StakingTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -339,6 +469,20 @@ func (m *PerpetualFeeTier) Size() (n int) {
 	return n
 }
 
+func (m *StakingFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBondedAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.FeeTierIndex != 0 {
+		n += 1 + sovParams(uint64(m.FeeTierIndex))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -408,6 +552,40 @@ func (m *PerpetualFeeParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTiers = append(m.StakingTiers, StakingFeeTier{})
+			if err := m.StakingTiers[len(m.StakingTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -610,6 +788,108 @@ func (m *PerpetualFeeTier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StakingFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBondedAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBondedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTierIndex", wireType)
+			}
+			m.FeeTierIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTierIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
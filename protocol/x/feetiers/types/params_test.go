@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
 	"github.com/stretchr/testify/require"
 )
@@ -81,6 +82,84 @@ func TestPerpetualFeeParams_Validate(t *testing.T) {
 			},
 			err: nil,
 		},
+		"valid staking fee tiers": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{},
+					{AbsoluteVolumeRequirement: 5},
+					{AbsoluteVolumeRequirement: 10},
+				},
+				StakingTiers: []types.StakingFeeTier{
+					{MinBondedAmount: dtypes.NewInt(100), FeeTierIndex: 1},
+					{MinBondedAmount: dtypes.NewInt(1_000), FeeTierIndex: 2},
+				},
+			},
+			err: nil,
+		},
+		"staking fee tier with zero requirement": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{},
+					{AbsoluteVolumeRequirement: 5},
+				},
+				StakingTiers: []types.StakingFeeTier{
+					{MinBondedAmount: dtypes.NewInt(0), FeeTierIndex: 1},
+				},
+			},
+			err: types.ErrInvalidStakingRequirement,
+		},
+		"staking fee tier with nil requirement": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{},
+					{AbsoluteVolumeRequirement: 5},
+				},
+				StakingTiers: []types.StakingFeeTier{
+					{FeeTierIndex: 1},
+				},
+			},
+			err: types.ErrInvalidStakingRequirement,
+		},
+		"staking fee tier grants fee tier that does not exist": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{},
+					{AbsoluteVolumeRequirement: 5},
+				},
+				StakingTiers: []types.StakingFeeTier{
+					{MinBondedAmount: dtypes.NewInt(100), FeeTierIndex: 2},
+				},
+			},
+			err: types.ErrInvalidFeeTierIndex,
+		},
+		"staking fee tier requirements out of order": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{},
+					{AbsoluteVolumeRequirement: 5},
+					{AbsoluteVolumeRequirement: 10},
+				},
+				StakingTiers: []types.StakingFeeTier{
+					{MinBondedAmount: dtypes.NewInt(100), FeeTierIndex: 1},
+					{MinBondedAmount: dtypes.NewInt(100), FeeTierIndex: 2},
+				},
+			},
+			err: types.ErrStakingTiersOutOfOrder,
+		},
+		"staking fee tier fee tiers out of order": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{},
+					{AbsoluteVolumeRequirement: 5},
+					{AbsoluteVolumeRequirement: 10},
+				},
+				StakingTiers: []types.StakingFeeTier{
+					{MinBondedAmount: dtypes.NewInt(100), FeeTierIndex: 2},
+					{MinBondedAmount: dtypes.NewInt(1_000), FeeTierIndex: 1},
+				},
+			},
+			err: types.ErrStakingTiersOutOfOrder,
+		},
 		"maker rebate cannot coexist with no taker fee": {
 			params: &types.PerpetualFeeParams{
 				Tiers: []*types.PerpetualFeeTier{
@@ -104,3 +183,50 @@ func TestPerpetualFeeParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPerpetualFeeParams_ValidateMarketFeeSchedule(t *testing.T) {
+	params := &types.PerpetualFeeParams{
+		Tiers: []*types.PerpetualFeeTier{
+			{MakerFeePpm: 100, TakerFeePpm: 500},
+			{AbsoluteVolumeRequirement: 1_000, MakerFeePpm: -100, TakerFeePpm: 200},
+		},
+	}
+
+	tests := map[string]struct {
+		schedule types.MarketFeeSchedule
+		err      error
+	}{
+		"unchanged fees are valid": {
+			schedule: types.MarketFeeSchedule{
+				MakerFeeMultiplierPpm: 1_000_000,
+				TakerFeeMultiplierPpm: 1_000_000,
+			},
+		},
+		"maker rebate equal to lowest taker fee is valid": {
+			schedule: types.MarketFeeSchedule{
+				MakerFeeMultiplierPpm: 2_000_000,
+				TakerFeeMultiplierPpm: 1_000_000,
+			},
+		},
+		"zero fees are valid": {
+			schedule: types.MarketFeeSchedule{},
+		},
+		"maker rebate larger than lowest taker fee is invalid": {
+			schedule: types.MarketFeeSchedule{
+				MakerFeeMultiplierPpm: 1_000_000,
+				TakerFeeMultiplierPpm: 499_999,
+			},
+			err: types.ErrInvalidFee,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := params.ValidateMarketFeeSchedule(tc.schedule)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -164,6 +164,8 @@ type QueryUserFeeTierResponse struct {
 	// Index of the fee tier in the list queried from PerpetualFeeParams.
 	Index uint32            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	Tier  *PerpetualFeeTier `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	// The reason the user is in the fee tier.
+	Reason FeeTierReason `protobuf:"varint,3,opt,name=reason,proto3,enum=dydxprotocol.feetiers.FeeTierReason" json:"reason,omitempty"`
 }
 
 func (m *QueryUserFeeTierResponse) Reset()         { *m = QueryUserFeeTierResponse{} }
@@ -213,360 +215,2109 @@ func (m *QueryUserFeeTierResponse) GetTier() *PerpetualFeeTier {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryPerpetualFeeParamsRequest)(nil), "dydxprotocol.feetiers.QueryPerpetualFeeParamsRequest")
-	proto.RegisterType((*QueryPerpetualFeeParamsResponse)(nil), "dydxprotocol.feetiers.QueryPerpetualFeeParamsResponse")
-	proto.RegisterType((*QueryUserFeeTierRequest)(nil), "dydxprotocol.feetiers.QueryUserFeeTierRequest")
-	proto.RegisterType((*QueryUserFeeTierResponse)(nil), "dydxprotocol.feetiers.QueryUserFeeTierResponse")
+func (m *QueryUserFeeTierResponse) GetReason() FeeTierReason {
+	if m != nil {
+		return m.Reason
+	}
+	return FeeTierReason_FEE_TIER_REASON_VOLUME
 }
 
-func init() { proto.RegisterFile("dydxprotocol/feetiers/query.proto", fileDescriptor_f31456045d64644f) }
-
-var fileDescriptor_f31456045d64644f = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0xae, 0xd2, 0x40,
-	0x14, 0x6d, 0x09, 0xef, 0x25, 0xce, 0x8b, 0x9b, 0x09, 0xc6, 0xda, 0x98, 0xbe, 0x67, 0x37, 0x48,
-	0x22, 0x1d, 0x83, 0xca, 0x42, 0x57, 0xb2, 0x80, 0x2d, 0x56, 0xdc, 0xb8, 0x21, 0xa5, 0xbd, 0x94,
-	0x31, 0xb4, 0x53, 0x66, 0xa6, 0x06, 0xb6, 0x7e, 0x81, 0x89, 0x1f, 0xe0, 0x4f, 0xb8, 0xf3, 0x07,
-	0x58, 0x12, 0xdd, 0xb8, 0x32, 0x06, 0x4c, 0xfc, 0x0d, 0xd3, 0x69, 0x51, 0xb4, 0x40, 0x78, 0xbb,
-	0xb9, 0x77, 0xce, 0x39, 0xf7, 0x9c, 0x3b, 0x83, 0xee, 0x05, 0x8b, 0x60, 0x9e, 0x70, 0x26, 0x99,
-	0xcf, 0xa6, 0x64, 0x0c, 0x20, 0x29, 0x70, 0x41, 0x66, 0x29, 0xf0, 0x85, 0xa3, 0xfa, 0xf8, 0xd6,
-	0x2e, 0xc4, 0xd9, 0x42, 0xcc, 0x3b, 0x3e, 0x13, 0x11, 0x13, 0x43, 0x75, 0x43, 0xf2, 0x22, 0x67,
-	0x98, 0xb5, 0x90, 0x85, 0x2c, 0xef, 0x67, 0xa7, 0xa2, 0x7b, 0x37, 0x64, 0x2c, 0x9c, 0x02, 0xf1,
-	0x12, 0x4a, 0xbc, 0x38, 0x66, 0xd2, 0x93, 0x94, 0xc5, 0x5b, 0x8e, 0xbd, 0xdf, 0x48, 0xe2, 0x71,
-	0x2f, 0x2a, 0x30, 0xf6, 0x15, 0xb2, 0x5e, 0x64, 0xc6, 0xfa, 0xc0, 0x13, 0x90, 0xa9, 0x37, 0xed,
-	0x02, 0xf4, 0x15, 0xc0, 0x85, 0x59, 0x0a, 0x42, 0xda, 0x6f, 0xd0, 0xe5, 0x41, 0x84, 0x48, 0x58,
-	0x2c, 0x00, 0xf7, 0xd0, 0x79, 0x2e, 0x6a, 0xe8, 0x57, 0xfa, 0xfd, 0x8b, 0x56, 0xc3, 0xd9, 0x9b,
-	0xcf, 0x29, 0x4b, 0x74, 0xaa, 0xcb, 0xef, 0x97, 0x9a, 0x5b, 0xd0, 0xed, 0x1e, 0xba, 0xad, 0x66,
-	0xbd, 0x12, 0xc0, 0xbb, 0x00, 0x03, 0x0a, 0xbc, 0xb0, 0x81, 0x1f, 0xa0, 0x6a, 0x2a, 0x80, 0xab,
-	0x09, 0x37, 0x3a, 0xc6, 0x97, 0x4f, 0xcd, 0x5a, 0xb1, 0xa0, 0xe7, 0x41, 0xc0, 0x41, 0x88, 0x97,
-	0x92, 0xd3, 0x38, 0x74, 0x15, 0xca, 0x8e, 0x90, 0x51, 0x16, 0x2a, 0xdc, 0xd6, 0xd0, 0x19, 0x8d,
-	0x03, 0x98, 0x2b, 0xa9, 0x9b, 0x6e, 0x5e, 0xe0, 0x67, 0xa8, 0x9a, 0x99, 0x34, 0x2a, 0x2a, 0x41,
-	0xfd, 0x84, 0x04, 0x4a, 0x54, 0x91, 0x5a, 0xbf, 0x2a, 0xe8, 0x4c, 0xcd, 0xc3, 0x9f, 0x75, 0x84,
-	0xcb, 0x31, 0xf1, 0x93, 0x03, 0x7a, 0xc7, 0x77, 0x6f, 0xb6, 0xaf, 0x4b, 0xcb, 0x23, 0xda, 0xed,
-	0x77, 0x5f, 0x7f, 0x7e, 0xa8, 0x3c, 0xc4, 0x0e, 0xf9, 0xe7, 0x0b, 0xbc, 0x7d, 0xbc, 0xf3, 0x0b,
-	0xb6, 0xec, 0xe1, 0x18, 0x60, 0x98, 0xef, 0x1f, 0x7f, 0xd4, 0xd1, 0xc5, 0xce, 0xca, 0xb0, 0x73,
-	0x6c, 0x7e, 0xf9, 0x91, 0x4c, 0x72, 0x32, 0xbe, 0x30, 0x4a, 0x94, 0xd1, 0x06, 0xae, 0x1f, 0x36,
-	0x9a, 0xbd, 0xa7, 0xf2, 0x98, 0x95, 0x9d, 0xc1, 0x72, 0x6d, 0xe9, 0xab, 0xb5, 0xa5, 0xff, 0x58,
-	0x5b, 0xfa, 0xfb, 0x8d, 0xa5, 0xad, 0x36, 0x96, 0xf6, 0x6d, 0x63, 0x69, 0xaf, 0x9f, 0x86, 0x54,
-	0x4e, 0xd2, 0x91, 0xe3, 0xb3, 0xe8, 0x7f, 0xb1, 0xa6, 0x3f, 0xf1, 0x68, 0x4c, 0xfe, 0x74, 0xe6,
-	0x7f, 0xd5, 0xe5, 0x22, 0x01, 0x31, 0x3a, 0x57, 0x57, 0x8f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x05, 0x23, 0x9b, 0x30, 0xbb, 0x03, 0x00, 0x00,
+// QueryReferralParamsRequest is a request type for the ReferralParams RPC
+// method.
+type QueryReferralParamsRequest struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryReferralParamsRequest) Reset()         { *m = QueryReferralParamsRequest{} }
+func (m *QueryReferralParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralParamsRequest) ProtoMessage()    {}
+func (*QueryReferralParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{4}
+}
+func (m *QueryReferralParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReferralParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralParamsRequest.Merge(m, src)
+}
+func (m *QueryReferralParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralParamsRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryReferralParamsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries the PerpetualFeeParams.
-	PerpetualFeeParams(ctx context.Context, in *QueryPerpetualFeeParamsRequest, opts ...grpc.CallOption) (*QueryPerpetualFeeParamsResponse, error)
-	// Queries a user's fee tier
-	UserFeeTier(ctx context.Context, in *QueryUserFeeTierRequest, opts ...grpc.CallOption) (*QueryUserFeeTierResponse, error)
+// QueryReferralParamsResponse is a response type for the ReferralParams RPC
+// method.
+type QueryReferralParamsResponse struct {
+	Params ReferralParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryReferralParamsResponse) Reset()         { *m = QueryReferralParamsResponse{} }
+func (m *QueryReferralParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralParamsResponse) ProtoMessage()    {}
+func (*QueryReferralParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryReferralParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) PerpetualFeeParams(ctx context.Context, in *QueryPerpetualFeeParamsRequest, opts ...grpc.CallOption) (*QueryPerpetualFeeParamsResponse, error) {
-	out := new(QueryPerpetualFeeParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/PerpetualFeeParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReferralParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryReferralParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralParamsResponse.Merge(m, src)
+}
+func (m *QueryReferralParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralParamsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserFeeTier(ctx context.Context, in *QueryUserFeeTierRequest, opts ...grpc.CallOption) (*QueryUserFeeTierResponse, error) {
-	out := new(QueryUserFeeTierResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/UserFeeTier", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReferralParamsResponse proto.InternalMessageInfo
+
+func (m *QueryReferralParamsResponse) GetParams() ReferralParams {
+	if m != nil {
+		return m.Params
 	}
-	return out, nil
+	return ReferralParams{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries the PerpetualFeeParams.
-	PerpetualFeeParams(context.Context, *QueryPerpetualFeeParamsRequest) (*QueryPerpetualFeeParamsResponse, error)
-	// Queries a user's fee tier
-	UserFeeTier(context.Context, *QueryUserFeeTierRequest) (*QueryUserFeeTierResponse, error)
+// QueryReferralCodeRequest is a request type for the ReferralCode RPC method.
+type QueryReferralCodeRequest struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryReferralCodeRequest) Reset()         { *m = QueryReferralCodeRequest{} }
+func (m *QueryReferralCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralCodeRequest) ProtoMessage()    {}
+func (*QueryReferralCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{6}
 }
-
-func (*UnimplementedQueryServer) PerpetualFeeParams(ctx context.Context, req *QueryPerpetualFeeParamsRequest) (*QueryPerpetualFeeParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PerpetualFeeParams not implemented")
+func (m *QueryReferralCodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) UserFeeTier(ctx context.Context, req *QueryUserFeeTierRequest) (*QueryUserFeeTierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserFeeTier not implemented")
+func (m *QueryReferralCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralCodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryReferralCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralCodeRequest.Merge(m, src)
 }
-
-func _Query_PerpetualFeeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPerpetualFeeParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PerpetualFeeParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.feetiers.Query/PerpetualFeeParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PerpetualFeeParams(ctx, req.(*QueryPerpetualFeeParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryReferralCodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralCodeRequest.DiscardUnknown(m)
 }
 
-func _Query_UserFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserFeeTierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserFeeTier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.feetiers.Query/UserFeeTier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserFeeTier(ctx, req.(*QueryUserFeeTierRequest))
+var xxx_messageInfo_QueryReferralCodeRequest proto.InternalMessageInfo
+
+func (m *QueryReferralCodeRequest) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.feetiers.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PerpetualFeeParams",
-			Handler:    _Query_PerpetualFeeParams_Handler,
-		},
-		{
-			MethodName: "UserFeeTier",
-			Handler:    _Query_UserFeeTier_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/feetiers/query.proto",
+// QueryReferralCodeResponse is a response type for the ReferralCode RPC
+// method.
+type QueryReferralCodeResponse struct {
+	ReferralCode ReferralCode `protobuf:"bytes,1,opt,name=referral_code,json=referralCode,proto3" json:"referral_code"`
 }
 
-func (m *QueryPerpetualFeeParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReferralCodeResponse) Reset()         { *m = QueryReferralCodeResponse{} }
+func (m *QueryReferralCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralCodeResponse) ProtoMessage()    {}
+func (*QueryReferralCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{7}
+}
+func (m *QueryReferralCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryPerpetualFeeParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReferralCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralCodeResponse.Merge(m, src)
 }
-
-func (m *QueryPerpetualFeeParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryReferralCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralCodeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPerpetualFeeParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReferralCodeResponse proto.InternalMessageInfo
+
+func (m *QueryReferralCodeResponse) GetReferralCode() ReferralCode {
+	if m != nil {
+		return m.ReferralCode
 	}
-	return dAtA[:n], nil
+	return ReferralCode{}
 }
 
-func (m *QueryPerpetualFeeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUserReferralRequest is a request type for the UserReferral RPC method.
+type QueryUserReferralRequest struct {
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (m *QueryPerpetualFeeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUserReferralRequest) Reset()         { *m = QueryUserReferralRequest{} }
+func (m *QueryUserReferralRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserReferralRequest) ProtoMessage()    {}
+func (*QueryUserReferralRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{8}
+}
+func (m *QueryUserReferralRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserReferralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserReferralRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryUserReferralRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserReferralRequest.Merge(m, src)
+}
+func (m *QueryUserReferralRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserReferralRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserReferralRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUserFeeTierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUserReferralRequest proto.InternalMessageInfo
+
+func (m *QueryUserReferralRequest) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUserFeeTierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUserReferralResponse is a response type for the UserReferral RPC
+// method.
+type QueryUserReferralResponse struct {
+	// The referral code registered by the user. Empty if the user has not
+	// registered a referral code.
+	ReferralCode string `protobuf:"bytes,1,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	// The address of the user's referrer. Empty if the user has no referrer.
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
-func (m *QueryUserFeeTierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryUserReferralResponse) Reset()         { *m = QueryUserReferralResponse{} }
+func (m *QueryUserReferralResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserReferralResponse) ProtoMessage()    {}
+func (*QueryUserReferralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{9}
 }
-
-func (m *QueryUserFeeTierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUserReferralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserReferralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserReferralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryUserReferralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserReferralResponse.Merge(m, src)
+}
+func (m *QueryUserReferralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserReferralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserReferralResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUserFeeTierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryUserReferralResponse proto.InternalMessageInfo
+
+func (m *QueryUserReferralResponse) GetReferralCode() string {
+	if m != nil {
+		return m.ReferralCode
+	}
+	return ""
 }
 
-func (m *QueryUserFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Tier != nil {
-		{
-			size, err := m.Tier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryUserReferralResponse) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+// QueryFeeTierOverridesRequest is a request type for the FeeTierOverrides RPC
+// method.
+type QueryFeeTierOverridesRequest struct {
+}
+
+func (m *QueryFeeTierOverridesRequest) Reset()         { *m = QueryFeeTierOverridesRequest{} }
+func (m *QueryFeeTierOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTierOverridesRequest) ProtoMessage()    {}
+func (*QueryFeeTierOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{10}
+}
+func (m *QueryFeeTierOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTierOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTierOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Index != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryFeeTierOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTierOverridesRequest.Merge(m, src)
+}
+func (m *QueryFeeTierOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTierOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTierOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTierOverridesRequest proto.InternalMessageInfo
+
+// QueryFeeTierOverridesResponse is a response type for the FeeTierOverrides
+// RPC method.
+type QueryFeeTierOverridesResponse struct {
+	Overrides []FeeTierOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides"`
+}
+
+func (m *QueryFeeTierOverridesResponse) Reset()         { *m = QueryFeeTierOverridesResponse{} }
+func (m *QueryFeeTierOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTierOverridesResponse) ProtoMessage()    {}
+func (*QueryFeeTierOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{11}
+}
+func (m *QueryFeeTierOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTierOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTierOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeTierOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTierOverridesResponse.Merge(m, src)
+}
+func (m *QueryFeeTierOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTierOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTierOverridesResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryFeeTierOverridesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTierOverridesResponse) GetOverrides() []FeeTierOverride {
+	if m != nil {
+		return m.Overrides
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryPerpetualFeeParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryMarketFeeSchedulesRequest is a request type for the MarketFeeSchedules
+// RPC method.
+type QueryMarketFeeSchedulesRequest struct {
+}
+
+func (m *QueryMarketFeeSchedulesRequest) Reset()         { *m = QueryMarketFeeSchedulesRequest{} }
+func (m *QueryMarketFeeSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketFeeSchedulesRequest) ProtoMessage()    {}
+func (*QueryMarketFeeSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{12}
+}
+func (m *QueryMarketFeeSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketFeeSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketFeeSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryMarketFeeSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketFeeSchedulesRequest.Merge(m, src)
+}
+func (m *QueryMarketFeeSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketFeeSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketFeeSchedulesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPerpetualFeeParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMarketFeeSchedulesRequest proto.InternalMessageInfo
+
+// QueryMarketFeeSchedulesResponse is a response type for the
+// MarketFeeSchedules RPC method.
+type QueryMarketFeeSchedulesResponse struct {
+	Schedules []MarketFeeSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+}
+
+func (m *QueryMarketFeeSchedulesResponse) Reset()         { *m = QueryMarketFeeSchedulesResponse{} }
+func (m *QueryMarketFeeSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketFeeSchedulesResponse) ProtoMessage()    {}
+func (*QueryMarketFeeSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{13}
+}
+func (m *QueryMarketFeeSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketFeeSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketFeeSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryMarketFeeSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketFeeSchedulesResponse.Merge(m, src)
+}
+func (m *QueryMarketFeeSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketFeeSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketFeeSchedulesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUserFeeTierRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMarketFeeSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryMarketFeeSchedulesResponse) GetSchedules() []MarketFeeSchedule {
+	if m != nil {
+		return m.Schedules
 	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPerpetualFeeParamsRequest)(nil), "dydxprotocol.feetiers.QueryPerpetualFeeParamsRequest")
+	proto.RegisterType((*QueryPerpetualFeeParamsResponse)(nil), "dydxprotocol.feetiers.QueryPerpetualFeeParamsResponse")
+	proto.RegisterType((*QueryUserFeeTierRequest)(nil), "dydxprotocol.feetiers.QueryUserFeeTierRequest")
+	proto.RegisterType((*QueryUserFeeTierResponse)(nil), "dydxprotocol.feetiers.QueryUserFeeTierResponse")
+	proto.RegisterType((*QueryReferralParamsRequest)(nil), "dydxprotocol.feetiers.QueryReferralParamsRequest")
+	proto.RegisterType((*QueryReferralParamsResponse)(nil), "dydxprotocol.feetiers.QueryReferralParamsResponse")
+	proto.RegisterType((*QueryReferralCodeRequest)(nil), "dydxprotocol.feetiers.QueryReferralCodeRequest")
+	proto.RegisterType((*QueryReferralCodeResponse)(nil), "dydxprotocol.feetiers.QueryReferralCodeResponse")
+	proto.RegisterType((*QueryUserReferralRequest)(nil), "dydxprotocol.feetiers.QueryUserReferralRequest")
+	proto.RegisterType((*QueryUserReferralResponse)(nil), "dydxprotocol.feetiers.QueryUserReferralResponse")
+	proto.RegisterType((*QueryFeeTierOverridesRequest)(nil), "dydxprotocol.feetiers.QueryFeeTierOverridesRequest")
+	proto.RegisterType((*QueryFeeTierOverridesResponse)(nil), "dydxprotocol.feetiers.QueryFeeTierOverridesResponse")
+	proto.RegisterType((*QueryMarketFeeSchedulesRequest)(nil), "dydxprotocol.feetiers.QueryMarketFeeSchedulesRequest")
+	proto.RegisterType((*QueryMarketFeeSchedulesResponse)(nil), "dydxprotocol.feetiers.QueryMarketFeeSchedulesResponse")
+}
+
+func init() { proto.RegisterFile("dydxprotocol/feetiers/query.proto", fileDescriptor_f31456045d64644f) }
+
+var fileDescriptor_f31456045d64644f = []byte{
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x63, 0x08, 0xe8, 0x66, 0x02, 0xe8, 0x6a, 0xc4, 0xd5, 0x0d, 0x2e, 0x35, 0xd4, 0xd0,
+	0x12, 0x54, 0xb0, 0x21, 0xa4, 0x2c, 0xda, 0x6e, 0x0a, 0x12, 0x54, 0x55, 0xff, 0xd0, 0x40, 0x37,
+	0xdd, 0x44, 0x26, 0x3e, 0x04, 0x97, 0xc4, 0x13, 0x66, 0x1c, 0x04, 0xdb, 0x3e, 0x41, 0xa5, 0x3e,
+	0x40, 0xa5, 0x2e, 0xdb, 0x65, 0xbb, 0xeb, 0x0b, 0xb0, 0x44, 0x74, 0xd3, 0x55, 0x55, 0x41, 0x1f,
+	0xa4, 0xf2, 0x78, 0xc6, 0x38, 0x89, 0x1d, 0x92, 0xee, 0xec, 0xf1, 0xf9, 0xce, 0xf9, 0xcd, 0x39,
+	0x33, 0x5f, 0x82, 0x6e, 0xd9, 0x27, 0xf6, 0x71, 0x83, 0x12, 0x8f, 0x54, 0x48, 0xcd, 0xdc, 0x03,
+	0xf0, 0x1c, 0xa0, 0xcc, 0x3c, 0x6c, 0x02, 0x3d, 0x31, 0xf8, 0x3a, 0xfe, 0x2f, 0x1a, 0x62, 0xc8,
+	0x10, 0x75, 0xa2, 0x42, 0x58, 0x9d, 0xb0, 0x32, 0xff, 0x62, 0x06, 0x2f, 0x81, 0x42, 0x1d, 0xaf,
+	0x92, 0x2a, 0x09, 0xd6, 0xfd, 0x27, 0xb1, 0x3a, 0x59, 0x25, 0xa4, 0x5a, 0x03, 0xd3, 0x6a, 0x38,
+	0xa6, 0xe5, 0xba, 0xc4, 0xb3, 0x3c, 0x87, 0xb8, 0x52, 0xb3, 0x18, 0x0f, 0xb2, 0x07, 0x50, 0xf6,
+	0x9f, 0xca, 0xe4, 0x08, 0x28, 0x75, 0x6c, 0x10, 0xe1, 0x66, 0x7c, 0x78, 0xdd, 0xa2, 0x07, 0xe0,
+	0x95, 0x7d, 0x15, 0xab, 0xec, 0x83, 0xdd, 0xac, 0x49, 0x81, 0x1e, 0x2f, 0x68, 0x58, 0xd4, 0xaa,
+	0x4b, 0x86, 0xd9, 0xf8, 0x18, 0x0a, 0x7b, 0x40, 0xa9, 0x55, 0x0b, 0xa2, 0xf4, 0x69, 0xa4, 0xbd,
+	0xf4, 0xdb, 0xb3, 0x05, 0xb4, 0x01, 0x5e, 0xd3, 0xaa, 0x6d, 0x00, 0x6c, 0xf1, 0x34, 0x25, 0x38,
+	0x6c, 0x02, 0xf3, 0xf4, 0x37, 0x68, 0x2a, 0x31, 0x82, 0x35, 0x88, 0xcb, 0x00, 0x6f, 0xa2, 0xe1,
+	0xa0, 0x74, 0x4e, 0x99, 0x56, 0xf2, 0xd9, 0xc2, 0xbc, 0x11, 0xdb, 0x65, 0xa3, 0x33, 0xc5, 0x5a,
+	0xfa, 0xf4, 0xe7, 0x54, 0xaa, 0x24, 0xe4, 0xfa, 0x26, 0xfa, 0x9f, 0xd7, 0x7a, 0xc5, 0x80, 0x6e,
+	0x00, 0xec, 0x38, 0x40, 0x05, 0x06, 0x5e, 0x40, 0xe9, 0x26, 0x03, 0xca, 0x2b, 0x64, 0xd6, 0x72,
+	0xe7, 0x5f, 0x17, 0xc7, 0xc5, 0x98, 0x1e, 0xd9, 0x36, 0x05, 0xc6, 0xb6, 0x3d, 0xea, 0xb8, 0xd5,
+	0x12, 0x8f, 0xd2, 0x3f, 0x2b, 0x28, 0xd7, 0x99, 0x49, 0xe0, 0x8e, 0xa3, 0x21, 0xc7, 0xb5, 0xe1,
+	0x98, 0xe7, 0x1a, 0x2d, 0x05, 0x2f, 0xf8, 0x01, 0x4a, 0xfb, 0x94, 0xb9, 0x01, 0xbe, 0x85, 0xb9,
+	0x1e, 0xb6, 0xc0, 0x93, 0x72, 0x11, 0x7e, 0x88, 0x86, 0x29, 0x58, 0x8c, 0xb8, 0xb9, 0xc1, 0x69,
+	0x25, 0x3f, 0x56, 0x98, 0x4d, 0x90, 0x87, 0x28, 0x7e, 0x6c, 0x49, 0x68, 0xf4, 0x49, 0xa4, 0x72,
+	0xd8, 0x92, 0x98, 0x4d, 0xeb, 0x00, 0x76, 0xd1, 0x8d, 0xd8, 0xaf, 0x62, 0x37, 0xeb, 0x6d, 0xcd,
+	0xbf, 0x9d, 0x50, 0xba, 0x55, 0xde, 0xd6, 0x78, 0x43, 0xb4, 0x4b, 0x06, 0xad, 0x13, 0x1b, 0x64,
+	0xe7, 0x31, 0x4a, 0x57, 0x88, 0x0d, 0x41, 0xe7, 0x4b, 0xfc, 0x59, 0x3f, 0x40, 0x13, 0x31, 0xf1,
+	0x82, 0xe8, 0x39, 0x1a, 0x95, 0xa7, 0xac, 0x1c, 0x2a, 0xb3, 0x85, 0x99, 0x6b, 0xc0, 0xfc, 0x1c,
+	0x02, 0x6b, 0x84, 0x46, 0xd6, 0xf4, 0xc7, 0x91, 0x59, 0xca, 0xe0, 0xbf, 0x3b, 0x16, 0x47, 0x68,
+	0x22, 0x26, 0x93, 0xc0, 0x9e, 0x89, 0xc3, 0xce, 0xb4, 0xb2, 0xe0, 0x22, 0xfa, 0x27, 0x78, 0x17,
+	0x27, 0xa5, 0x5b, 0xcd, 0x30, 0x52, 0xd7, 0xd0, 0x24, 0xaf, 0x2b, 0xc6, 0xff, 0x42, 0x5c, 0xff,
+	0x70, 0xc4, 0x07, 0xe8, 0x66, 0xc2, 0x77, 0xc1, 0xf6, 0x04, 0x65, 0xa4, 0x67, 0xf8, 0x73, 0x1e,
+	0xcc, 0x67, 0x0b, 0x77, 0xba, 0x1f, 0x31, 0x99, 0x43, 0x74, 0xf4, 0x4a, 0x1e, 0x5e, 0xf9, 0x67,
+	0xdc, 0x5e, 0x36, 0x00, 0xb6, 0x85, 0xb9, 0x84, 0x38, 0x04, 0x4d, 0x25, 0x46, 0x08, 0xa0, 0xa7,
+	0x28, 0x23, 0x3d, 0x49, 0x02, 0xe5, 0x13, 0x80, 0x3a, 0xb2, 0x48, 0xa4, 0x30, 0x41, 0xe1, 0x3c,
+	0x83, 0x86, 0x78, 0x45, 0xfc, 0x4d, 0x41, 0xb8, 0xd3, 0x26, 0xf0, 0xbd, 0x84, 0xdc, 0xdd, 0xbd,
+	0x4b, 0x5d, 0xed, 0x57, 0x16, 0xec, 0x4e, 0x5f, 0x7d, 0xfb, 0xfd, 0xf7, 0xfb, 0x81, 0x25, 0x6c,
+	0xb4, 0x3a, 0xf3, 0x51, 0x31, 0xe2, 0xb5, 0x52, 0xcd, 0xfd, 0x39, 0xb8, 0x46, 0xf8, 0x83, 0x82,
+	0xb2, 0x11, 0xc7, 0xc1, 0x46, 0xb7, 0xfa, 0x9d, 0x26, 0xa7, 0x9a, 0x3d, 0xc7, 0x0b, 0x50, 0x93,
+	0x83, 0xce, 0xe3, 0xb9, 0x64, 0xd0, 0x26, 0x03, 0x5a, 0x96, 0xbf, 0x3c, 0xf8, 0x93, 0x82, 0xc6,
+	0x5a, 0x9d, 0x00, 0x2f, 0x77, 0x2b, 0x1a, 0x6b, 0x49, 0x6a, 0xa1, 0x1f, 0x89, 0x40, 0x5d, 0xe6,
+	0xa8, 0x77, 0xf1, 0x7c, 0x32, 0x6a, 0x78, 0xfd, 0x44, 0x3b, 0x3f, 0x2a, 0x68, 0x24, 0xea, 0x0e,
+	0xd8, 0xec, 0xa5, 0x6e, 0xc4, 0xbb, 0xd4, 0xa5, 0xde, 0x05, 0xbd, 0x77, 0xb4, 0xc5, 0x25, 0x38,
+	0x64, 0xd4, 0x4f, 0xf0, 0xb5, 0x43, 0x6c, 0xf3, 0x30, 0x75, 0xa9, 0x77, 0x41, 0x9f, 0x63, 0x97,
+	0xa4, 0xf8, 0x8b, 0x82, 0xfe, 0x6d, 0x37, 0x17, 0xbc, 0xd2, 0xad, 0x6e, 0x82, 0x55, 0xa9, 0xc5,
+	0xfe, 0x44, 0x02, 0xb8, 0xc8, 0x81, 0x0d, 0xbc, 0x90, 0x0c, 0xdc, 0xf1, 0xe7, 0x88, 0x71, 0x33,
+	0xe8, 0xf4, 0xa0, 0xee, 0x66, 0x90, 0xe8, 0x6a, 0xea, 0x6a, 0xbf, 0xb2, 0xde, 0xcd, 0x20, 0xe6,
+	0x9f, 0x1a, 0x5b, 0xdb, 0x39, 0xbd, 0xd0, 0x94, 0xb3, 0x0b, 0x4d, 0xf9, 0x75, 0xa1, 0x29, 0xef,
+	0x2e, 0xb5, 0xd4, 0xd9, 0xa5, 0x96, 0xfa, 0x71, 0xa9, 0xa5, 0x5e, 0xdf, 0xaf, 0x3a, 0xde, 0x7e,
+	0x73, 0xd7, 0xa8, 0x90, 0x7a, 0x7b, 0xce, 0xc5, 0xca, 0xbe, 0xe5, 0xb8, 0x66, 0xb8, 0x72, 0x7c,
+	0x55, 0xc4, 0x3b, 0x69, 0x00, 0xdb, 0x1d, 0xe6, 0x9f, 0x56, 0xfe, 0x0c, 0x00, 0x4d, 0xd3, 0x0f,
+	0x27, 0xec, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries the PerpetualFeeParams.
+	PerpetualFeeParams(ctx context.Context, in *QueryPerpetualFeeParamsRequest, opts ...grpc.CallOption) (*QueryPerpetualFeeParamsResponse, error)
+	// Queries a user's fee tier
+	UserFeeTier(ctx context.Context, in *QueryUserFeeTierRequest, opts ...grpc.CallOption) (*QueryUserFeeTierResponse, error)
+	// Queries the ReferralParams.
+	ReferralParams(ctx context.Context, in *QueryReferralParamsRequest, opts ...grpc.CallOption) (*QueryReferralParamsResponse, error)
+	// Queries the owner of a referral code.
+	ReferralCode(ctx context.Context, in *QueryReferralCodeRequest, opts ...grpc.CallOption) (*QueryReferralCodeResponse, error)
+	// Queries a user's referral code and referrer.
+	UserReferral(ctx context.Context, in *QueryUserReferralRequest, opts ...grpc.CallOption) (*QueryUserReferralResponse, error)
+	// Queries all fee tier overrides, including expired ones.
+	FeeTierOverrides(ctx context.Context, in *QueryFeeTierOverridesRequest, opts ...grpc.CallOption) (*QueryFeeTierOverridesResponse, error)
+	// Queries all market fee schedules, including those not yet in effect.
+	MarketFeeSchedules(ctx context.Context, in *QueryMarketFeeSchedulesRequest, opts ...grpc.CallOption) (*QueryMarketFeeSchedulesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PerpetualFeeParams(ctx context.Context, in *QueryPerpetualFeeParamsRequest, opts ...grpc.CallOption) (*QueryPerpetualFeeParamsResponse, error) {
+	out := new(QueryPerpetualFeeParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/PerpetualFeeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserFeeTier(ctx context.Context, in *QueryUserFeeTierRequest, opts ...grpc.CallOption) (*QueryUserFeeTierResponse, error) {
+	out := new(QueryUserFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/UserFeeTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReferralParams(ctx context.Context, in *QueryReferralParamsRequest, opts ...grpc.CallOption) (*QueryReferralParamsResponse, error) {
+	out := new(QueryReferralParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/ReferralParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReferralCode(ctx context.Context, in *QueryReferralCodeRequest, opts ...grpc.CallOption) (*QueryReferralCodeResponse, error) {
+	out := new(QueryReferralCodeResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/ReferralCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserReferral(ctx context.Context, in *QueryUserReferralRequest, opts ...grpc.CallOption) (*QueryUserReferralResponse, error) {
+	out := new(QueryUserReferralResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/UserReferral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTierOverrides(ctx context.Context, in *QueryFeeTierOverridesRequest, opts ...grpc.CallOption) (*QueryFeeTierOverridesResponse, error) {
+	out := new(QueryFeeTierOverridesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/FeeTierOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketFeeSchedules(ctx context.Context, in *QueryMarketFeeSchedulesRequest, opts ...grpc.CallOption) (*QueryMarketFeeSchedulesResponse, error) {
+	out := new(QueryMarketFeeSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/MarketFeeSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries the PerpetualFeeParams.
+	PerpetualFeeParams(context.Context, *QueryPerpetualFeeParamsRequest) (*QueryPerpetualFeeParamsResponse, error)
+	// Queries a user's fee tier
+	UserFeeTier(context.Context, *QueryUserFeeTierRequest) (*QueryUserFeeTierResponse, error)
+	// Queries the ReferralParams.
+	ReferralParams(context.Context, *QueryReferralParamsRequest) (*QueryReferralParamsResponse, error)
+	// Queries the owner of a referral code.
+	ReferralCode(context.Context, *QueryReferralCodeRequest) (*QueryReferralCodeResponse, error)
+	// Queries a user's referral code and referrer.
+	UserReferral(context.Context, *QueryUserReferralRequest) (*QueryUserReferralResponse, error)
+	// Queries all fee tier overrides, including expired ones.
+	FeeTierOverrides(context.Context, *QueryFeeTierOverridesRequest) (*QueryFeeTierOverridesResponse, error)
+	// Queries all market fee schedules, including those not yet in effect.
+	MarketFeeSchedules(context.Context, *QueryMarketFeeSchedulesRequest) (*QueryMarketFeeSchedulesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PerpetualFeeParams(ctx context.Context, req *QueryPerpetualFeeParamsRequest) (*QueryPerpetualFeeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerpetualFeeParams not implemented")
+}
+func (*UnimplementedQueryServer) UserFeeTier(ctx context.Context, req *QueryUserFeeTierRequest) (*QueryUserFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserFeeTier not implemented")
+}
+func (*UnimplementedQueryServer) ReferralParams(ctx context.Context, req *QueryReferralParamsRequest) (*QueryReferralParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferralParams not implemented")
+}
+func (*UnimplementedQueryServer) ReferralCode(ctx context.Context, req *QueryReferralCodeRequest) (*QueryReferralCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferralCode not implemented")
+}
+func (*UnimplementedQueryServer) UserReferral(ctx context.Context, req *QueryUserReferralRequest) (*QueryUserReferralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserReferral not implemented")
+}
+func (*UnimplementedQueryServer) FeeTierOverrides(ctx context.Context, req *QueryFeeTierOverridesRequest) (*QueryFeeTierOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTierOverrides not implemented")
+}
+func (*UnimplementedQueryServer) MarketFeeSchedules(ctx context.Context, req *QueryMarketFeeSchedulesRequest) (*QueryMarketFeeSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketFeeSchedules not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PerpetualFeeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPerpetualFeeParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PerpetualFeeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Query/PerpetualFeeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PerpetualFeeParams(ctx, req.(*QueryPerpetualFeeParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserFeeTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserFeeTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Query/UserFeeTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserFeeTier(ctx, req.(*QueryUserFeeTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReferralParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferralParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReferralParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Query/ReferralParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReferralParams(ctx, req.(*QueryReferralParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReferralCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferralCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReferralCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Query/ReferralCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReferralCode(ctx, req.(*QueryReferralCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserReferral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserReferralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserReferral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Query/UserReferral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserReferral(ctx, req.(*QueryUserReferralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTierOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTierOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTierOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Query/FeeTierOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTierOverrides(ctx, req.(*QueryFeeTierOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketFeeSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketFeeSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketFeeSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Query/MarketFeeSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketFeeSchedules(ctx, req.(*QueryMarketFeeSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.feetiers.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PerpetualFeeParams",
+			Handler:    _Query_PerpetualFeeParams_Handler,
+		},
+		{
+			MethodName: "UserFeeTier",
+			Handler:    _Query_UserFeeTier_Handler,
+		},
+		{
+			MethodName: "ReferralParams",
+			Handler:    _Query_ReferralParams_Handler,
+		},
+		{
+			MethodName: "ReferralCode",
+			Handler:    _Query_ReferralCode_Handler,
+		},
+		{
+			MethodName: "UserReferral",
+			Handler:    _Query_UserReferral_Handler,
+		},
+		{
+			MethodName: "FeeTierOverrides",
+			Handler:    _Query_FeeTierOverrides_Handler,
+		},
+		{
+			MethodName: "MarketFeeSchedules",
+			Handler:    _Query_MarketFeeSchedules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/feetiers/query.proto",
+}
+
+func (m *QueryPerpetualFeeParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualFeeParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualFeeParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPerpetualFeeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualFeeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualFeeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserFeeTierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserFeeTierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserFeeTierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserFeeTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserFeeTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Tier != nil {
+		{
+			size, err := m.Tier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReferralCode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserReferralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserReferralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserReferralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserReferralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserReferralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserReferralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReferralCode) > 0 {
+		i -= len(m.ReferralCode)
+		copy(dAtA[i:], m.ReferralCode)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReferralCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTierOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTierOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTierOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTierOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTierOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTierOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketFeeSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketFeeSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketFeeSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketFeeSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketFeeSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketFeeSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPerpetualFeeParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPerpetualFeeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUserFeeTierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserFeeTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if m.Tier != nil {
+		l = m.Tier.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *QueryReferralParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReferralParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReferralCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReferralCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReferralCode.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUserReferralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserReferralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReferralCode)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTierOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTierOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMarketFeeSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMarketFeeSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPerpetualFeeParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualFeeParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualFeeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPerpetualFeeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualFeeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualFeeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserFeeTierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserFeeTierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserFeeTierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserFeeTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserFeeTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserFeeTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tier == nil {
+				m.Tier = &PerpetualFeeTier{}
+			}
+			if err := m.Tier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= FeeTierReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferralParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferralParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferralCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferralCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralCode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryUserFeeTierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovQuery(uint64(m.Index))
-	}
-	if m.Tier != nil {
-		l = m.Tier.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPerpetualFeeParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUserReferralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -589,12 +2340,44 @@ func (m *QueryPerpetualFeeParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPerpetualFeeParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserReferralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPerpetualFeeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserReferralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -616,7 +2399,7 @@ func (m *QueryPerpetualFeeParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPerpetualFeeParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUserReferralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -639,17 +2422,17 @@ func (m *QueryPerpetualFeeParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPerpetualFeeParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserReferralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPerpetualFeeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserReferralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralCode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -659,24 +2442,55 @@ func (m *QueryPerpetualFeeParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ReferralCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -699,7 +2513,7 @@ func (m *QueryPerpetualFeeParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserFeeTierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTierOverridesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -722,17 +2536,67 @@ func (m *QueryUserFeeTierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserFeeTierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTierOverridesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserFeeTierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTierOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTierOverridesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTierOverridesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTierOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -742,23 +2606,25 @@ func (m *QueryUserFeeTierRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Overrides = append(m.Overrides, FeeTierOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -781,7 +2647,7 @@ func (m *QueryUserFeeTierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserFeeTierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketFeeSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -804,34 +2670,65 @@ func (m *QueryUserFeeTierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserFeeTierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketFeeSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserFeeTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketFeeSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 2:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketFeeSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketFeeSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketFeeSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -858,10 +2755,8 @@ func (m *QueryUserFeeTierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tier == nil {
-				m.Tier = &PerpetualFeeTier{}
-			}
-			if err := m.Tier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, MarketFeeSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
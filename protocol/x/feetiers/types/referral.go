@@ -0,0 +1,60 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+const (
+	// MinReferralCodeLength is the minimum length of a referral code.
+	MinReferralCodeLength = 4
+	// MaxReferralCodeLength is the maximum length of a referral code.
+	MaxReferralCodeLength = 16
+)
+
+// Validate validates the referral params.
+func (p ReferralParams) Validate() error {
+	if p.ReferrerRebateSharePpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidReferralParams,
+			"referrer_rebate_share_ppm %d cannot be greater than 1_000_000",
+			p.ReferrerRebateSharePpm,
+		)
+	}
+
+	if p.RefereeTakerFeeDiscountPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidReferralParams,
+			"referee_taker_fee_discount_ppm %d cannot be greater than 1_000_000",
+			p.RefereeTakerFeeDiscountPpm,
+		)
+	}
+
+	return nil
+}
+
+// ValidateReferralCode returns an error if the referral code is not between `MinReferralCodeLength` and
+// `MaxReferralCodeLength` characters long, or contains characters other than ASCII letters and digits.
+func ValidateReferralCode(code string) error {
+	if len(code) < MinReferralCodeLength || len(code) > MaxReferralCodeLength {
+		return errorsmod.Wrapf(
+			ErrInvalidReferralCode,
+			"referral code %q must be between %d and %d characters long",
+			code,
+			MinReferralCodeLength,
+			MaxReferralCodeLength,
+		)
+	}
+
+	for _, c := range code {
+		if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') {
+			return errorsmod.Wrapf(
+				ErrInvalidReferralCode,
+				"referral code %q must only contain letters and digits",
+				code,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferralParams_Validate(t *testing.T) {
+	tests := map[string]struct {
+		params      types.ReferralParams
+		expectedErr string
+	}{
+		"Valid: disabled": {
+			params: types.ReferralParams{},
+		},
+		"Valid": {
+			params: types.ReferralParams{
+				ReferrerRebateSharePpm:     100_000,
+				RefereeTakerFeeDiscountPpm: 50_000,
+			},
+		},
+		"Valid: 100%": {
+			params: types.ReferralParams{
+				ReferrerRebateSharePpm:     1_000_000,
+				RefereeTakerFeeDiscountPpm: 1_000_000,
+			},
+		},
+		"Failure: referrer rebate share greater than 100%": {
+			params: types.ReferralParams{
+				ReferrerRebateSharePpm: 1_000_001,
+			},
+			expectedErr: "referrer_rebate_share_ppm 1000001 cannot be greater than 1_000_000",
+		},
+		"Failure: referee taker fee discount greater than 100%": {
+			params: types.ReferralParams{
+				RefereeTakerFeeDiscountPpm: 1_000_001,
+			},
+			expectedErr: "referee_taker_fee_discount_ppm 1000001 cannot be greater than 1_000_000",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidReferralParams)
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateReferralCode(t *testing.T) {
+	tests := map[string]struct {
+		code        string
+		expectedErr string
+	}{
+		"Valid": {
+			code: "Alice2023",
+		},
+		"Valid: minimum length": {
+			code: "abcd",
+		},
+		"Valid: maximum length": {
+			code: "abcdefghijklmnop",
+		},
+		"Failure: empty": {
+			code:        "",
+			expectedErr: "must be between 4 and 16 characters long",
+		},
+		"Failure: too short": {
+			code:        "abc",
+			expectedErr: "must be between 4 and 16 characters long",
+		},
+		"Failure: too long": {
+			code:        "abcdefghijklmnopq",
+			expectedErr: "must be between 4 and 16 characters long",
+		},
+		"Failure: invalid character": {
+			code:        "alice-2023",
+			expectedErr: "must only contain letters and digits",
+		},
+		"Failure: non-ASCII letter": {
+			code:        "alicé",
+			expectedErr: "must only contain letters and digits",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateReferralCode(tc.code)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidReferralCode)
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
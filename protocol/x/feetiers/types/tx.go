@@ -24,3 +24,151 @@ func (msg *MsgUpdatePerpetualFeeParams) ValidateBasic() error {
 	}
 	return msg.Params.Validate()
 }
+
+func (msg *MsgUpdateReferralParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgUpdateReferralParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	return msg.Params.Validate()
+}
+
+// NewMsgRegisterReferralCode constructs a `MsgRegisterReferralCode` from an owner and a referral code.
+func NewMsgRegisterReferralCode(owner string, code string) *MsgRegisterReferralCode {
+	return &MsgRegisterReferralCode{
+		Owner: owner,
+		Code:  code,
+	}
+}
+
+// GetSigners specifies that the owner of the referral code must sign.
+func (msg *MsgRegisterReferralCode) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic runs validation on the fields of a MsgRegisterReferralCode.
+func (msg *MsgRegisterReferralCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAccountAddress, "owner '%s': %v", msg.Owner, err)
+	}
+	return ValidateReferralCode(msg.Code)
+}
+
+// NewMsgSetReferrer constructs a `MsgSetReferrer` from a referee and the referral code of their referrer.
+func NewMsgSetReferrer(referee string, code string) *MsgSetReferrer {
+	return &MsgSetReferrer{
+		Referee: referee,
+		Code:    code,
+	}
+}
+
+// GetSigners specifies that the referee must sign.
+func (msg *MsgSetReferrer) GetSigners() []sdk.AccAddress {
+	referee, err := sdk.AccAddressFromBech32(msg.Referee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{referee}
+}
+
+// ValidateBasic runs validation on the fields of a MsgSetReferrer.
+func (msg *MsgSetReferrer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Referee); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAccountAddress, "referee '%s': %v", msg.Referee, err)
+	}
+	return ValidateReferralCode(msg.Code)
+}
+
+func (msg *MsgSetFeeTierOverride) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetFeeTierOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	return msg.Override.Validate()
+}
+
+func (msg *MsgDeleteFeeTierOverride) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgDeleteFeeTierOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAccountAddress, "address '%s': %v", msg.Address, err)
+	}
+	return nil
+}
+
+func (msg *MsgSetMarketFeeSchedule) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetMarketFeeSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	return msg.Schedule.Validate()
+}
+
+func (msg *MsgDeleteMarketFeeSchedule) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgDeleteMarketFeeSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	return nil
+}
@@ -122,225 +122,2766 @@ func (m *MsgUpdatePerpetualFeeParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdatePerpetualFeeParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdatePerpetualFeeParams)(nil), "dydxprotocol.feetiers.MsgUpdatePerpetualFeeParams")
-	proto.RegisterType((*MsgUpdatePerpetualFeeParamsResponse)(nil), "dydxprotocol.feetiers.MsgUpdatePerpetualFeeParamsResponse")
+// MsgUpdateReferralParams is the Msg/UpdateReferralParams request type.
+type MsgUpdateReferralParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the parameters to update. All parameters must be supplied.
+	Params ReferralParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/feetiers/tx.proto", fileDescriptor_caa74a3b986b7fd9) }
+func (m *MsgUpdateReferralParams) Reset()         { *m = MsgUpdateReferralParams{} }
+func (m *MsgUpdateReferralParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateReferralParams) ProtoMessage()    {}
+func (*MsgUpdateReferralParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{2}
+}
+func (m *MsgUpdateReferralParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateReferralParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateReferralParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateReferralParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateReferralParams.Merge(m, src)
+}
+func (m *MsgUpdateReferralParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateReferralParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateReferralParams.DiscardUnknown(m)
+}
 
-var fileDescriptor_caa74a3b986b7fd9 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x4b, 0x4d, 0x2d, 0xc9, 0x4c, 0x2d,
-	0x2a, 0xd6, 0x2f, 0xa9, 0xd0, 0x03, 0x0b, 0x0a, 0x89, 0x22, 0xcb, 0xeb, 0xc1, 0xe4, 0xa5, 0x24,
-	0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0x32, 0xfa, 0x10, 0x0e, 0x44, 0x87, 0x94, 0x38,
-	0x84, 0xa7, 0x9f, 0x5b, 0x9c, 0xae, 0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x4a, 0xd8, 0xad, 0x2a,
-	0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x69, 0x16, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x18, 0x0a, 0x62, 0x41,
-	0x44, 0x95, 0xd6, 0x31, 0x72, 0x49, 0xfb, 0x16, 0xa7, 0x87, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0x06,
-	0xa4, 0x16, 0x15, 0xa4, 0x96, 0x94, 0x26, 0xe6, 0xb8, 0xa5, 0xa6, 0x06, 0x80, 0xf5, 0x0a, 0x99,
-	0x71, 0x71, 0x26, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x54, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
-	0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0x75, 0x97, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71,
-	0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x42, 0xa9, 0x90, 0x3b, 0x17, 0x1b, 0xc4, 0x76,
-	0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xbe, 0xd5, 0xc3, 0xb4, 0xd2, 0x89,
-	0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x76, 0x2b, 0xbe, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x06,
-	0x2b, 0xa9, 0x72, 0x29, 0xe3, 0x71, 0x6f, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0xd1,
-	0x24, 0x46, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x2e, 0x46, 0x2e, 0x09, 0x9c, 0x9e, 0x33, 0xc2,
-	0xe1, 0x28, 0x3c, 0x16, 0x48, 0x59, 0x91, 0xae, 0x07, 0xe6, 0x28, 0xa7, 0x90, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x47, 0x89, 0xcb, 0x32, 0x13, 0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x7d, 0xb8,
-	0x48, 0x05, 0x52, 0x52, 0xaa, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x4b, 0x19, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xf0, 0x6c, 0xb3, 0xcc, 0x70, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgUpdateReferralParams proto.InternalMessageInfo
+
+func (m *MsgUpdateReferralParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgUpdateReferralParams) GetParams() ReferralParams {
+	if m != nil {
+		return m.Params
+	}
+	return ReferralParams{}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgUpdateReferralParamsResponse is the Msg/UpdateReferralParams response
+// type.
+type MsgUpdateReferralParamsResponse struct {
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdatePerpetualFeeParams updates the PerpetualFeeParams in state.
-	UpdatePerpetualFeeParams(ctx context.Context, in *MsgUpdatePerpetualFeeParams, opts ...grpc.CallOption) (*MsgUpdatePerpetualFeeParamsResponse, error)
+func (m *MsgUpdateReferralParamsResponse) Reset()         { *m = MsgUpdateReferralParamsResponse{} }
+func (m *MsgUpdateReferralParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateReferralParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateReferralParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{3}
+}
+func (m *MsgUpdateReferralParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateReferralParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateReferralParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateReferralParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateReferralParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateReferralParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateReferralParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateReferralParamsResponse.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgUpdateReferralParamsResponse proto.InternalMessageInfo
+
+// MsgRegisterReferralCode is the Msg/RegisterReferralCode request type.
+type MsgRegisterReferralCode struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The referral code to register.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterReferralCode) Reset()         { *m = MsgRegisterReferralCode{} }
+func (m *MsgRegisterReferralCode) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterReferralCode) ProtoMessage()    {}
+func (*MsgRegisterReferralCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{4}
+}
+func (m *MsgRegisterReferralCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterReferralCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterReferralCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterReferralCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterReferralCode.Merge(m, src)
+}
+func (m *MsgRegisterReferralCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterReferralCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterReferralCode.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdatePerpetualFeeParams(ctx context.Context, in *MsgUpdatePerpetualFeeParams, opts ...grpc.CallOption) (*MsgUpdatePerpetualFeeParamsResponse, error) {
-	out := new(MsgUpdatePerpetualFeeParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/UpdatePerpetualFeeParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterReferralCode proto.InternalMessageInfo
+
+func (m *MsgRegisterReferralCode) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdatePerpetualFeeParams updates the PerpetualFeeParams in state.
-	UpdatePerpetualFeeParams(context.Context, *MsgUpdatePerpetualFeeParams) (*MsgUpdatePerpetualFeeParamsResponse, error)
+func (m *MsgRegisterReferralCode) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgRegisterReferralCodeResponse is the Msg/RegisterReferralCode response
+// type.
+type MsgRegisterReferralCodeResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdatePerpetualFeeParams(ctx context.Context, req *MsgUpdatePerpetualFeeParams) (*MsgUpdatePerpetualFeeParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerpetualFeeParams not implemented")
+func (m *MsgRegisterReferralCodeResponse) Reset()         { *m = MsgRegisterReferralCodeResponse{} }
+func (m *MsgRegisterReferralCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterReferralCodeResponse) ProtoMessage()    {}
+func (*MsgRegisterReferralCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{5}
+}
+func (m *MsgRegisterReferralCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterReferralCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterReferralCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterReferralCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterReferralCodeResponse.Merge(m, src)
+}
+func (m *MsgRegisterReferralCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterReferralCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterReferralCodeResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRegisterReferralCodeResponse proto.InternalMessageInfo
+
+// MsgSetReferrer is the Msg/SetReferrer request type.
+type MsgSetReferrer struct {
+	Referee string `protobuf:"bytes,1,opt,name=referee,proto3" json:"referee,omitempty"`
+	// The referral code of the referrer.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func _Msg_UpdatePerpetualFeeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdatePerpetualFeeParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdatePerpetualFeeParams(ctx, in)
+func (m *MsgSetReferrer) Reset()         { *m = MsgSetReferrer{} }
+func (m *MsgSetReferrer) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReferrer) ProtoMessage()    {}
+func (*MsgSetReferrer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{6}
+}
+func (m *MsgSetReferrer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReferrer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReferrer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.feetiers.Msg/UpdatePerpetualFeeParams",
+}
+func (m *MsgSetReferrer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReferrer.Merge(m, src)
+}
+func (m *MsgSetReferrer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReferrer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReferrer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReferrer proto.InternalMessageInfo
+
+func (m *MsgSetReferrer) GetReferee() string {
+	if m != nil {
+		return m.Referee
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdatePerpetualFeeParams(ctx, req.(*MsgUpdatePerpetualFeeParams))
+	return ""
+}
+
+func (m *MsgSetReferrer) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.feetiers.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdatePerpetualFeeParams",
-			Handler:    _Msg_UpdatePerpetualFeeParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/feetiers/tx.proto",
+// MsgSetReferrerResponse is the Msg/SetReferrer response type.
+type MsgSetReferrerResponse struct {
 }
 
-func (m *MsgUpdatePerpetualFeeParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetReferrerResponse) Reset()         { *m = MsgSetReferrerResponse{} }
+func (m *MsgSetReferrerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReferrerResponse) ProtoMessage()    {}
+func (*MsgSetReferrerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{7}
+}
+func (m *MsgSetReferrerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReferrerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReferrerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetReferrerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReferrerResponse.Merge(m, src)
+}
+func (m *MsgSetReferrerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReferrerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReferrerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdatePerpetualFeeParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetReferrerResponse proto.InternalMessageInfo
+
+// MsgSetFeeTierOverride is the Msg/SetFeeTierOverride request type.
+type MsgSetFeeTierOverride struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The fee tier override to set.
+	Override FeeTierOverride `protobuf:"bytes,2,opt,name=override,proto3" json:"override"`
 }
 
-func (m *MsgUpdatePerpetualFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetFeeTierOverride) Reset()         { *m = MsgSetFeeTierOverride{} }
+func (m *MsgSetFeeTierOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTierOverride) ProtoMessage()    {}
+func (*MsgSetFeeTierOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{8}
+}
+func (m *MsgSetFeeTierOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTierOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTierOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetFeeTierOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTierOverride.Merge(m, src)
+}
+func (m *MsgSetFeeTierOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTierOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTierOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTierOverride proto.InternalMessageInfo
+
+func (m *MsgSetFeeTierOverride) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdatePerpetualFeeParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeeTierOverride) GetOverride() FeeTierOverride {
+	if m != nil {
+		return m.Override
+	}
+	return FeeTierOverride{}
+}
+
+// MsgSetFeeTierOverrideResponse is the Msg/SetFeeTierOverride response type.
+type MsgSetFeeTierOverrideResponse struct {
+}
+
+func (m *MsgSetFeeTierOverrideResponse) Reset()         { *m = MsgSetFeeTierOverrideResponse{} }
+func (m *MsgSetFeeTierOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTierOverrideResponse) ProtoMessage()    {}
+func (*MsgSetFeeTierOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{9}
+}
+func (m *MsgSetFeeTierOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTierOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTierOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeTierOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTierOverrideResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTierOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTierOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTierOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTierOverrideResponse proto.InternalMessageInfo
+
+// MsgDeleteFeeTierOverride is the Msg/DeleteFeeTierOverride request type.
+type MsgDeleteFeeTierOverride struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The address whose fee tier override is deleted.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgDeleteFeeTierOverride) Reset()         { *m = MsgDeleteFeeTierOverride{} }
+func (m *MsgDeleteFeeTierOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteFeeTierOverride) ProtoMessage()    {}
+func (*MsgDeleteFeeTierOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{10}
+}
+func (m *MsgDeleteFeeTierOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteFeeTierOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteFeeTierOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteFeeTierOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteFeeTierOverride.Merge(m, src)
+}
+func (m *MsgDeleteFeeTierOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteFeeTierOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteFeeTierOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteFeeTierOverride proto.InternalMessageInfo
+
+func (m *MsgDeleteFeeTierOverride) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeleteFeeTierOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgDeleteFeeTierOverrideResponse is the Msg/DeleteFeeTierOverride response
+// type.
+type MsgDeleteFeeTierOverrideResponse struct {
+}
+
+func (m *MsgDeleteFeeTierOverrideResponse) Reset()         { *m = MsgDeleteFeeTierOverrideResponse{} }
+func (m *MsgDeleteFeeTierOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteFeeTierOverrideResponse) ProtoMessage()    {}
+func (*MsgDeleteFeeTierOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{11}
+}
+func (m *MsgDeleteFeeTierOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteFeeTierOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteFeeTierOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteFeeTierOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteFeeTierOverrideResponse.Merge(m, src)
+}
+func (m *MsgDeleteFeeTierOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteFeeTierOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteFeeTierOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteFeeTierOverrideResponse proto.InternalMessageInfo
+
+// MsgSetMarketFeeSchedule is the Msg/SetMarketFeeSchedule request type.
+type MsgSetMarketFeeSchedule struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The market fee schedule to set.
+	Schedule MarketFeeSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgSetMarketFeeSchedule) Reset()         { *m = MsgSetMarketFeeSchedule{} }
+func (m *MsgSetMarketFeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarketFeeSchedule) ProtoMessage()    {}
+func (*MsgSetMarketFeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{12}
+}
+func (m *MsgSetMarketFeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarketFeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarketFeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMarketFeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarketFeeSchedule.Merge(m, src)
+}
+func (m *MsgSetMarketFeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarketFeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarketFeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMarketFeeSchedule proto.InternalMessageInfo
+
+func (m *MsgSetMarketFeeSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMarketFeeSchedule) GetSchedule() MarketFeeSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return MarketFeeSchedule{}
+}
+
+// MsgSetMarketFeeScheduleResponse is the Msg/SetMarketFeeSchedule response
+// type.
+type MsgSetMarketFeeScheduleResponse struct {
+}
+
+func (m *MsgSetMarketFeeScheduleResponse) Reset()         { *m = MsgSetMarketFeeScheduleResponse{} }
+func (m *MsgSetMarketFeeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarketFeeScheduleResponse) ProtoMessage()    {}
+func (*MsgSetMarketFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{13}
+}
+func (m *MsgSetMarketFeeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarketFeeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarketFeeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMarketFeeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarketFeeScheduleResponse.Merge(m, src)
+}
+func (m *MsgSetMarketFeeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarketFeeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarketFeeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMarketFeeScheduleResponse proto.InternalMessageInfo
+
+// MsgDeleteMarketFeeSchedule is the Msg/DeleteMarketFeeSchedule request type.
+type MsgDeleteMarketFeeSchedule struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the CLOB pair whose fee schedule is deleted.
+	ClobPairId uint32 `protobuf:"varint,2,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+}
+
+func (m *MsgDeleteMarketFeeSchedule) Reset()         { *m = MsgDeleteMarketFeeSchedule{} }
+func (m *MsgDeleteMarketFeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteMarketFeeSchedule) ProtoMessage()    {}
+func (*MsgDeleteMarketFeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{14}
+}
+func (m *MsgDeleteMarketFeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteMarketFeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteMarketFeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteMarketFeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteMarketFeeSchedule.Merge(m, src)
+}
+func (m *MsgDeleteMarketFeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteMarketFeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteMarketFeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteMarketFeeSchedule proto.InternalMessageInfo
+
+func (m *MsgDeleteMarketFeeSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeleteMarketFeeSchedule) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
+	}
+	return 0
+}
+
+// MsgDeleteMarketFeeScheduleResponse is the Msg/DeleteMarketFeeSchedule
+// response type.
+type MsgDeleteMarketFeeScheduleResponse struct {
+}
+
+func (m *MsgDeleteMarketFeeScheduleResponse) Reset()         { *m = MsgDeleteMarketFeeScheduleResponse{} }
+func (m *MsgDeleteMarketFeeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteMarketFeeScheduleResponse) ProtoMessage()    {}
+func (*MsgDeleteMarketFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caa74a3b986b7fd9, []int{15}
+}
+func (m *MsgDeleteMarketFeeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteMarketFeeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteMarketFeeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteMarketFeeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteMarketFeeScheduleResponse.Merge(m, src)
+}
+func (m *MsgDeleteMarketFeeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteMarketFeeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteMarketFeeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteMarketFeeScheduleResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdatePerpetualFeeParams)(nil), "dydxprotocol.feetiers.MsgUpdatePerpetualFeeParams")
+	proto.RegisterType((*MsgUpdatePerpetualFeeParamsResponse)(nil), "dydxprotocol.feetiers.MsgUpdatePerpetualFeeParamsResponse")
+	proto.RegisterType((*MsgUpdateReferralParams)(nil), "dydxprotocol.feetiers.MsgUpdateReferralParams")
+	proto.RegisterType((*MsgUpdateReferralParamsResponse)(nil), "dydxprotocol.feetiers.MsgUpdateReferralParamsResponse")
+	proto.RegisterType((*MsgRegisterReferralCode)(nil), "dydxprotocol.feetiers.MsgRegisterReferralCode")
+	proto.RegisterType((*MsgRegisterReferralCodeResponse)(nil), "dydxprotocol.feetiers.MsgRegisterReferralCodeResponse")
+	proto.RegisterType((*MsgSetReferrer)(nil), "dydxprotocol.feetiers.MsgSetReferrer")
+	proto.RegisterType((*MsgSetReferrerResponse)(nil), "dydxprotocol.feetiers.MsgSetReferrerResponse")
+	proto.RegisterType((*MsgSetFeeTierOverride)(nil), "dydxprotocol.feetiers.MsgSetFeeTierOverride")
+	proto.RegisterType((*MsgSetFeeTierOverrideResponse)(nil), "dydxprotocol.feetiers.MsgSetFeeTierOverrideResponse")
+	proto.RegisterType((*MsgDeleteFeeTierOverride)(nil), "dydxprotocol.feetiers.MsgDeleteFeeTierOverride")
+	proto.RegisterType((*MsgDeleteFeeTierOverrideResponse)(nil), "dydxprotocol.feetiers.MsgDeleteFeeTierOverrideResponse")
+	proto.RegisterType((*MsgSetMarketFeeSchedule)(nil), "dydxprotocol.feetiers.MsgSetMarketFeeSchedule")
+	proto.RegisterType((*MsgSetMarketFeeScheduleResponse)(nil), "dydxprotocol.feetiers.MsgSetMarketFeeScheduleResponse")
+	proto.RegisterType((*MsgDeleteMarketFeeSchedule)(nil), "dydxprotocol.feetiers.MsgDeleteMarketFeeSchedule")
+	proto.RegisterType((*MsgDeleteMarketFeeScheduleResponse)(nil), "dydxprotocol.feetiers.MsgDeleteMarketFeeScheduleResponse")
+}
+
+func init() { proto.RegisterFile("dydxprotocol/feetiers/tx.proto", fileDescriptor_caa74a3b986b7fd9) }
+
+var fileDescriptor_caa74a3b986b7fd9 = []byte{
+	// 773 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0x22, 0xf2, 0xf1, 0x82, 0x1c, 0x36, 0x20, 0x75, 0x8d, 0xa5, 0x56, 0x30, 0x68, 0xec,
+	0x6e, 0xa8, 0xa4, 0xc6, 0xde, 0x04, 0x83, 0x1f, 0x49, 0x23, 0x69, 0xf1, 0xe2, 0xa5, 0x59, 0x76,
+	0xdf, 0x6e, 0x37, 0xb6, 0x9d, 0x66, 0x66, 0x8b, 0xe5, 0x62, 0xa2, 0x07, 0x4d, 0x3c, 0x79, 0xf2,
+	0x27, 0x98, 0x78, 0xd0, 0x78, 0xf0, 0x47, 0x70, 0x24, 0x9e, 0x3c, 0x19, 0x03, 0x07, 0x7f, 0x86,
+	0xa6, 0xfb, 0x31, 0xb4, 0x74, 0x86, 0xba, 0xc8, 0xa9, 0xbb, 0xf3, 0x3e, 0xef, 0x3c, 0xcf, 0x33,
+	0x6f, 0xe7, 0xc9, 0x42, 0xca, 0xde, 0xb5, 0x3b, 0x2d, 0x4a, 0x3c, 0x62, 0x91, 0xba, 0x51, 0x45,
+	0xf4, 0x5c, 0xa4, 0xcc, 0xf0, 0x3a, 0xba, 0xbf, 0xa8, 0xce, 0xf5, 0xd6, 0xf5, 0xa8, 0xae, 0x5d,
+	0xb2, 0x08, 0x6b, 0x10, 0x56, 0xf1, 0x2b, 0x46, 0xf0, 0x12, 0x74, 0x68, 0xf3, 0xc1, 0x9b, 0xd1,
+	0x60, 0x8e, 0xb1, 0xb3, 0xd2, 0xfd, 0x09, 0x0b, 0x59, 0x31, 0x55, 0x15, 0xb1, 0xd2, 0x7d, 0xaa,
+	0x90, 0x1d, 0xa4, 0xd4, 0xb5, 0x31, 0x84, 0x1b, 0x62, 0x78, 0xc3, 0xa4, 0xcf, 0xd1, 0xab, 0x74,
+	0xbb, 0x98, 0x55, 0x43, 0xbb, 0x5d, 0x8f, 0x1a, 0x32, 0xe2, 0x86, 0x96, 0x49, 0xcd, 0x46, 0x24,
+	0x6e, 0x51, 0x8c, 0xa1, 0x58, 0x45, 0x4a, 0xcd, 0x7a, 0x88, 0x9a, 0x75, 0x88, 0x43, 0x02, 0x6b,
+	0xdd, 0xa7, 0x60, 0x35, 0xf3, 0x45, 0x81, 0xcb, 0x45, 0xe6, 0x3c, 0x6d, 0xd9, 0xa6, 0x87, 0x9b,
+	0x48, 0x5b, 0xe8, 0xb5, 0xcd, 0xfa, 0x06, 0xe2, 0xa6, 0xcf, 0xa0, 0xe6, 0x61, 0xd2, 0x6c, 0x7b,
+	0x35, 0x42, 0x5d, 0x6f, 0x37, 0xa9, 0xa4, 0x95, 0xe5, 0xc9, 0xb5, 0xe4, 0xf7, 0x6f, 0xd9, 0xd9,
+	0xf0, 0x74, 0xee, 0xd9, 0x36, 0x45, 0xc6, 0xca, 0x1e, 0x75, 0x9b, 0x4e, 0xe9, 0x08, 0xaa, 0x3e,
+	0x80, 0xb1, 0x40, 0x63, 0x72, 0x24, 0xad, 0x2c, 0x4f, 0xe5, 0x6e, 0xe8, 0xc2, 0x33, 0xd7, 0x07,
+	0x29, 0xd7, 0x46, 0xf7, 0x7e, 0x2e, 0x24, 0x4a, 0x61, 0x7b, 0x61, 0xe6, 0xf5, 0xef, 0xaf, 0x37,
+	0x8f, 0x36, 0xce, 0x2c, 0xc1, 0xb5, 0x13, 0xf4, 0x96, 0x90, 0xb5, 0x48, 0x93, 0x61, 0xe6, 0xa3,
+	0x02, 0xf3, 0x1c, 0x57, 0x0a, 0x4f, 0xe2, 0x3f, 0x3d, 0xad, 0x1f, 0xf3, 0xb4, 0x24, 0xf1, 0xd4,
+	0x4f, 0x37, 0xc4, 0xcf, 0x55, 0x58, 0x90, 0xe8, 0xe4, 0x5e, 0x5c, 0xdf, 0x4a, 0x09, 0x1d, 0x97,
+	0x79, 0x48, 0x23, 0xd0, 0x3a, 0xb1, 0x51, 0xd5, 0xe1, 0x3c, 0x79, 0xd1, 0x44, 0x3a, 0xd4, 0x46,
+	0x00, 0x53, 0x55, 0x18, 0xb5, 0x88, 0x8d, 0xbe, 0x81, 0xc9, 0x92, 0xff, 0x5c, 0x80, 0xae, 0xa2,
+	0xa0, 0x1e, 0xaa, 0x11, 0x51, 0x71, 0x35, 0x55, 0x98, 0x29, 0x32, 0xa7, 0x8c, 0x5e, 0x50, 0x45,
+	0xaa, 0xe6, 0x60, 0xdc, 0xff, 0xaf, 0x21, 0x0e, 0x95, 0x11, 0x01, 0x85, 0x42, 0xa6, 0xbb, 0x42,
+	0x22, 0x44, 0x26, 0x09, 0x17, 0xfb, 0x79, 0xb8, 0x82, 0x4f, 0x0a, 0xcc, 0x05, 0xa5, 0x0d, 0xc4,
+	0x2d, 0x17, 0xe9, 0x93, 0xf0, 0x92, 0x9d, 0x7a, 0xb2, 0x0f, 0x61, 0x22, 0xba, 0xa8, 0xe1, 0x6c,
+	0xaf, 0x4b, 0x66, 0x7b, 0x8c, 0x31, 0x1c, 0x2e, 0xef, 0x1e, 0x18, 0xef, 0x02, 0x5c, 0x11, 0x4a,
+	0xe5, 0x66, 0x3e, 0x28, 0x90, 0x2c, 0x32, 0xe7, 0x3e, 0xd6, 0xd1, 0xc3, 0xb3, 0xf2, 0x93, 0x83,
+	0x71, 0x33, 0xa8, 0x25, 0x47, 0x86, 0x74, 0x45, 0xc0, 0x01, 0xe5, 0x19, 0x48, 0xcb, 0x74, 0x71,
+	0xf1, 0x9f, 0x83, 0x5b, 0x56, 0x46, 0xaf, 0xe8, 0x27, 0xd8, 0x06, 0x62, 0x39, 0xcc, 0xaf, 0x53,
+	0x6b, 0x7f, 0x0c, 0x13, 0x51, 0x06, 0x86, 0xb3, 0x58, 0x96, 0xcc, 0x62, 0x80, 0x33, 0x9a, 0x46,
+	0xd4, 0x2f, 0xb9, 0x6c, 0x22, 0xb9, 0xdc, 0xd2, 0x1b, 0x05, 0x34, 0xee, 0xfb, 0xec, 0x5c, 0xa5,
+	0x61, 0xda, 0xaa, 0x93, 0xed, 0x4a, 0xcb, 0x74, 0x69, 0xc5, 0xb5, 0x7d, 0x67, 0x17, 0x4a, 0xd0,
+	0x5d, 0xdb, 0x34, 0x5d, 0xfa, 0xc8, 0x1e, 0xd0, 0xba, 0x08, 0x19, 0xb9, 0x8e, 0x48, 0x6e, 0xee,
+	0xcf, 0x38, 0x9c, 0x2b, 0x32, 0x47, 0x7d, 0xa7, 0x40, 0x52, 0x1a, 0xe2, 0x39, 0xd9, 0x01, 0xca,
+	0x83, 0x54, 0x2b, 0xc4, 0xef, 0x89, 0x44, 0xa9, 0x2f, 0x61, 0x56, 0x18, 0xbc, 0xfa, 0xb0, 0x3d,
+	0xfb, 0xf1, 0x5a, 0x3e, 0x1e, 0xbe, 0x97, 0x5f, 0x9c, 0x96, 0xf2, 0xfd, 0x44, 0x78, 0x2d, 0x1f,
+	0x0f, 0xcf, 0xf9, 0x2d, 0x98, 0xea, 0xcd, 0xc7, 0x25, 0xf9, 0x36, 0x3d, 0x30, 0x2d, 0xfb, 0x4f,
+	0x30, 0x4e, 0xd2, 0x01, 0x55, 0x90, 0x80, 0xb7, 0x4e, 0xdc, 0xe4, 0x18, 0x5a, 0x5b, 0x8d, 0x83,
+	0xe6, 0xcc, 0xaf, 0x14, 0x98, 0x13, 0xe7, 0x95, 0x21, 0xdf, 0x4f, 0xd8, 0xa0, 0xdd, 0x89, 0xd9,
+	0xd0, 0x3b, 0x62, 0x61, 0xea, 0xe8, 0x27, 0x3a, 0x1a, 0xc0, 0x6b, 0xf9, 0x78, 0x78, 0xce, 0xff,
+	0x56, 0x81, 0x79, 0x59, 0x46, 0xac, 0x0c, 0x33, 0x35, 0x28, 0xe3, 0x6e, 0xec, 0x96, 0x48, 0xc9,
+	0xda, 0xd6, 0xde, 0x41, 0x4a, 0xd9, 0x3f, 0x48, 0x29, 0xbf, 0x0e, 0x52, 0xca, 0xfb, 0xc3, 0x54,
+	0x62, 0xff, 0x30, 0x95, 0xf8, 0x71, 0x98, 0x4a, 0x3c, 0x2b, 0x38, 0xae, 0x57, 0x6b, 0x6f, 0xeb,
+	0x16, 0x69, 0xf4, 0x7f, 0x77, 0xee, 0xac, 0x66, 0xad, 0x9a, 0xe9, 0x36, 0x0d, 0xbe, 0xd2, 0xe9,
+	0xf9, 0x4a, 0xde, 0x6d, 0x21, 0xdb, 0x1e, 0xf3, 0x4b, 0xb7, 0xff, 0x0e, 0x00, 0x3b, 0x0b, 0x17,
+	0xa8, 0x4b, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdatePerpetualFeeParams updates the PerpetualFeeParams in state.
+	UpdatePerpetualFeeParams(ctx context.Context, in *MsgUpdatePerpetualFeeParams, opts ...grpc.CallOption) (*MsgUpdatePerpetualFeeParamsResponse, error)
+	// UpdateReferralParams updates the ReferralParams in state.
+	UpdateReferralParams(ctx context.Context, in *MsgUpdateReferralParams, opts ...grpc.CallOption) (*MsgUpdateReferralParamsResponse, error)
+	// RegisterReferralCode registers a referral code for an address.
+	RegisterReferralCode(ctx context.Context, in *MsgRegisterReferralCode, opts ...grpc.CallOption) (*MsgRegisterReferralCodeResponse, error)
+	// SetReferrer binds an address to the owner of a referral code.
+	SetReferrer(ctx context.Context, in *MsgSetReferrer, opts ...grpc.CallOption) (*MsgSetReferrerResponse, error)
+	// SetFeeTierOverride creates or replaces the fee tier override of an
+	// address.
+	SetFeeTierOverride(ctx context.Context, in *MsgSetFeeTierOverride, opts ...grpc.CallOption) (*MsgSetFeeTierOverrideResponse, error)
+	// DeleteFeeTierOverride deletes the fee tier override of an address.
+	DeleteFeeTierOverride(ctx context.Context, in *MsgDeleteFeeTierOverride, opts ...grpc.CallOption) (*MsgDeleteFeeTierOverrideResponse, error)
+	// SetMarketFeeSchedule creates or replaces the fee schedule of a CLOB pair.
+	SetMarketFeeSchedule(ctx context.Context, in *MsgSetMarketFeeSchedule, opts ...grpc.CallOption) (*MsgSetMarketFeeScheduleResponse, error)
+	// DeleteMarketFeeSchedule deletes the fee schedule of a CLOB pair.
+	DeleteMarketFeeSchedule(ctx context.Context, in *MsgDeleteMarketFeeSchedule, opts ...grpc.CallOption) (*MsgDeleteMarketFeeScheduleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdatePerpetualFeeParams(ctx context.Context, in *MsgUpdatePerpetualFeeParams, opts ...grpc.CallOption) (*MsgUpdatePerpetualFeeParamsResponse, error) {
+	out := new(MsgUpdatePerpetualFeeParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/UpdatePerpetualFeeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateReferralParams(ctx context.Context, in *MsgUpdateReferralParams, opts ...grpc.CallOption) (*MsgUpdateReferralParamsResponse, error) {
+	out := new(MsgUpdateReferralParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/UpdateReferralParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterReferralCode(ctx context.Context, in *MsgRegisterReferralCode, opts ...grpc.CallOption) (*MsgRegisterReferralCodeResponse, error) {
+	out := new(MsgRegisterReferralCodeResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/RegisterReferralCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReferrer(ctx context.Context, in *MsgSetReferrer, opts ...grpc.CallOption) (*MsgSetReferrerResponse, error) {
+	out := new(MsgSetReferrerResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/SetReferrer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeTierOverride(ctx context.Context, in *MsgSetFeeTierOverride, opts ...grpc.CallOption) (*MsgSetFeeTierOverrideResponse, error) {
+	out := new(MsgSetFeeTierOverrideResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/SetFeeTierOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteFeeTierOverride(ctx context.Context, in *MsgDeleteFeeTierOverride, opts ...grpc.CallOption) (*MsgDeleteFeeTierOverrideResponse, error) {
+	out := new(MsgDeleteFeeTierOverrideResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/DeleteFeeTierOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMarketFeeSchedule(ctx context.Context, in *MsgSetMarketFeeSchedule, opts ...grpc.CallOption) (*MsgSetMarketFeeScheduleResponse, error) {
+	out := new(MsgSetMarketFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/SetMarketFeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteMarketFeeSchedule(ctx context.Context, in *MsgDeleteMarketFeeSchedule, opts ...grpc.CallOption) (*MsgDeleteMarketFeeScheduleResponse, error) {
+	out := new(MsgDeleteMarketFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Msg/DeleteMarketFeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdatePerpetualFeeParams updates the PerpetualFeeParams in state.
+	UpdatePerpetualFeeParams(context.Context, *MsgUpdatePerpetualFeeParams) (*MsgUpdatePerpetualFeeParamsResponse, error)
+	// UpdateReferralParams updates the ReferralParams in state.
+	UpdateReferralParams(context.Context, *MsgUpdateReferralParams) (*MsgUpdateReferralParamsResponse, error)
+	// RegisterReferralCode registers a referral code for an address.
+	RegisterReferralCode(context.Context, *MsgRegisterReferralCode) (*MsgRegisterReferralCodeResponse, error)
+	// SetReferrer binds an address to the owner of a referral code.
+	SetReferrer(context.Context, *MsgSetReferrer) (*MsgSetReferrerResponse, error)
+	// SetFeeTierOverride creates or replaces the fee tier override of an
+	// address.
+	SetFeeTierOverride(context.Context, *MsgSetFeeTierOverride) (*MsgSetFeeTierOverrideResponse, error)
+	// DeleteFeeTierOverride deletes the fee tier override of an address.
+	DeleteFeeTierOverride(context.Context, *MsgDeleteFeeTierOverride) (*MsgDeleteFeeTierOverrideResponse, error)
+	// SetMarketFeeSchedule creates or replaces the fee schedule of a CLOB pair.
+	SetMarketFeeSchedule(context.Context, *MsgSetMarketFeeSchedule) (*MsgSetMarketFeeScheduleResponse, error)
+	// DeleteMarketFeeSchedule deletes the fee schedule of a CLOB pair.
+	DeleteMarketFeeSchedule(context.Context, *MsgDeleteMarketFeeSchedule) (*MsgDeleteMarketFeeScheduleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdatePerpetualFeeParams(ctx context.Context, req *MsgUpdatePerpetualFeeParams) (*MsgUpdatePerpetualFeeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerpetualFeeParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateReferralParams(ctx context.Context, req *MsgUpdateReferralParams) (*MsgUpdateReferralParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReferralParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterReferralCode(ctx context.Context, req *MsgRegisterReferralCode) (*MsgRegisterReferralCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterReferralCode not implemented")
+}
+func (*UnimplementedMsgServer) SetReferrer(ctx context.Context, req *MsgSetReferrer) (*MsgSetReferrerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReferrer not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeTierOverride(ctx context.Context, req *MsgSetFeeTierOverride) (*MsgSetFeeTierOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTierOverride not implemented")
+}
+func (*UnimplementedMsgServer) DeleteFeeTierOverride(ctx context.Context, req *MsgDeleteFeeTierOverride) (*MsgDeleteFeeTierOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeTierOverride not implemented")
+}
+func (*UnimplementedMsgServer) SetMarketFeeSchedule(ctx context.Context, req *MsgSetMarketFeeSchedule) (*MsgSetMarketFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketFeeSchedule not implemented")
+}
+func (*UnimplementedMsgServer) DeleteMarketFeeSchedule(ctx context.Context, req *MsgDeleteMarketFeeSchedule) (*MsgDeleteMarketFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMarketFeeSchedule not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdatePerpetualFeeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePerpetualFeeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePerpetualFeeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Msg/UpdatePerpetualFeeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePerpetualFeeParams(ctx, req.(*MsgUpdatePerpetualFeeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateReferralParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateReferralParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateReferralParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Msg/UpdateReferralParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateReferralParams(ctx, req.(*MsgUpdateReferralParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterReferralCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterReferralCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterReferralCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Msg/RegisterReferralCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterReferralCode(ctx, req.(*MsgRegisterReferralCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReferrer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReferrer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReferrer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Msg/SetReferrer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReferrer(ctx, req.(*MsgSetReferrer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTierOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTierOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTierOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Msg/SetFeeTierOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTierOverride(ctx, req.(*MsgSetFeeTierOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteFeeTierOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteFeeTierOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteFeeTierOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Msg/DeleteFeeTierOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteFeeTierOverride(ctx, req.(*MsgDeleteFeeTierOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarketFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarketFeeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarketFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Msg/SetMarketFeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarketFeeSchedule(ctx, req.(*MsgSetMarketFeeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteMarketFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteMarketFeeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteMarketFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Msg/DeleteMarketFeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteMarketFeeSchedule(ctx, req.(*MsgDeleteMarketFeeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.feetiers.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdatePerpetualFeeParams",
+			Handler:    _Msg_UpdatePerpetualFeeParams_Handler,
+		},
+		{
+			MethodName: "UpdateReferralParams",
+			Handler:    _Msg_UpdateReferralParams_Handler,
+		},
+		{
+			MethodName: "RegisterReferralCode",
+			Handler:    _Msg_RegisterReferralCode_Handler,
+		},
+		{
+			MethodName: "SetReferrer",
+			Handler:    _Msg_SetReferrer_Handler,
+		},
+		{
+			MethodName: "SetFeeTierOverride",
+			Handler:    _Msg_SetFeeTierOverride_Handler,
+		},
+		{
+			MethodName: "DeleteFeeTierOverride",
+			Handler:    _Msg_DeleteFeeTierOverride_Handler,
+		},
+		{
+			MethodName: "SetMarketFeeSchedule",
+			Handler:    _Msg_SetMarketFeeSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteMarketFeeSchedule",
+			Handler:    _Msg_DeleteMarketFeeSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/feetiers/tx.proto",
+}
+
+func (m *MsgUpdatePerpetualFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePerpetualFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePerpetualFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePerpetualFeeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePerpetualFeeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePerpetualFeeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateReferralParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateReferralParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateReferralParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateReferralParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateReferralParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateReferralParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterReferralCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterReferralCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterReferralCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterReferralCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterReferralCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterReferralCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReferrer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReferrer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReferrer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Referee) > 0 {
+		i -= len(m.Referee)
+		copy(dAtA[i:], m.Referee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReferrerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReferrerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReferrerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTierOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTierOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTierOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Override.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTierOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTierOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTierOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFeeTierOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFeeTierOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFeeTierOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFeeTierOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFeeTierOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFeeTierOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarketFeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarketFeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarketFeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarketFeeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarketFeeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarketFeeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteMarketFeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteMarketFeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteMarketFeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteMarketFeeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteMarketFeeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteMarketFeeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdatePerpetualFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePerpetualFeeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateReferralParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateReferralParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterReferralCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterReferralCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetReferrer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetReferrerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeTierOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Override.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFeeTierOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteFeeTierOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteFeeTierOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMarketFeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMarketFeeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteMarketFeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClobPairId != 0 {
+		n += 1 + sovTx(uint64(m.ClobPairId))
+	}
+	return n
+}
+
+func (m *MsgDeleteMarketFeeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdatePerpetualFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePerpetualFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePerpetualFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePerpetualFeeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePerpetualFeeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePerpetualFeeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateReferralParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateReferralParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateReferralParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateReferralParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateReferralParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateReferralParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterReferralCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterReferralCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterReferralCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterReferralCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterReferralCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterReferralCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReferrer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReferrer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReferrer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReferrerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReferrerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReferrerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTierOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTierOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTierOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Override.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTierOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTierOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTierOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteFeeTierOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteFeeTierOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteFeeTierOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdatePerpetualFeeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdatePerpetualFeeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdatePerpetualFeeParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteFeeTierOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteFeeTierOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteFeeTierOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdatePerpetualFeeParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdatePerpetualFeeParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarketFeeSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -363,10 +2904,10 @@ func (m *MsgUpdatePerpetualFeeParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualFeeParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarketFeeSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarketFeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -403,7 +2944,7 @@ func (m *MsgUpdatePerpetualFeeParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -430,7 +2971,7 @@ func (m *MsgUpdatePerpetualFeeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -455,7 +2996,7 @@ func (m *MsgUpdatePerpetualFeeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePerpetualFeeParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarketFeeScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -478,10 +3019,161 @@ func (m *MsgUpdatePerpetualFeeParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualFeeParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarketFeeScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualFeeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarketFeeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteMarketFeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteMarketFeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteMarketFeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteMarketFeeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteMarketFeeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteMarketFeeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
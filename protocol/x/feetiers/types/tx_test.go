@@ -65,3 +65,221 @@ func TestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateReferralParams_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgUpdateReferralParams
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgUpdateReferralParams{
+				Authority: validAuthority,
+				Params: types.ReferralParams{
+					ReferrerRebateSharePpm:     100_000,
+					RefereeTakerFeeDiscountPpm: 50_000,
+				},
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgUpdateReferralParams{
+				Authority: "",
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+		"Failure: Invalid params": {
+			msg: types.MsgUpdateReferralParams{
+				Authority: validAuthority,
+				Params: types.ReferralParams{
+					ReferrerRebateSharePpm: 1_000_001,
+				},
+			},
+			expectedErr: types.ErrInvalidReferralParams,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgRegisterReferralCode(t *testing.T) {
+	msg := types.NewMsgRegisterReferralCode(constants.AliceAccAddress.String(), "alice")
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgRegisterReferralCode("invalid", "alice")
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidAccountAddress)
+
+	msg = types.NewMsgRegisterReferralCode(constants.AliceAccAddress.String(), "a")
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidReferralCode)
+}
+
+func TestMsgSetReferrer(t *testing.T) {
+	msg := types.NewMsgSetReferrer(constants.BobAccAddress.String(), "alice")
+	require.Equal(t, []sdk.AccAddress{constants.BobAccAddress}, msg.GetSigners())
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgSetReferrer("invalid", "alice")
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidAccountAddress)
+
+	msg = types.NewMsgSetReferrer(constants.BobAccAddress.String(), "alice!")
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidReferralCode)
+}
+
+func TestMsgSetFeeTierOverride_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetFeeTierOverride
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgSetFeeTierOverride{
+				Authority: validAuthority,
+				Override: types.FeeTierOverride{
+					Address:      constants.AliceAccAddress.String(),
+					FeeTierIndex: 1,
+				},
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetFeeTierOverride{
+				Authority: "",
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+		"Failure: Invalid address": {
+			msg: types.MsgSetFeeTierOverride{
+				Authority: validAuthority,
+				Override: types.FeeTierOverride{
+					Address: "invalid",
+				},
+			},
+			expectedErr: types.ErrInvalidAccountAddress,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDeleteFeeTierOverride_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgDeleteFeeTierOverride
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgDeleteFeeTierOverride{
+				Authority: validAuthority,
+				Address:   constants.AliceAccAddress.String(),
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgDeleteFeeTierOverride{
+				Authority: "",
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+		"Failure: Invalid address": {
+			msg: types.MsgDeleteFeeTierOverride{
+				Authority: validAuthority,
+				Address:   "invalid",
+			},
+			expectedErr: types.ErrInvalidAccountAddress,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgSetMarketFeeSchedule_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetMarketFeeSchedule
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgSetMarketFeeSchedule{
+				Authority: validAuthority,
+				Schedule: types.MarketFeeSchedule{
+					ClobPairId:            1,
+					MakerFeeMultiplierPpm: 500_000,
+					TakerFeeMultiplierPpm: 500_000,
+				},
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetMarketFeeSchedule{
+				Authority: "",
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+		"Failure: Invalid schedule": {
+			msg: types.MsgSetMarketFeeSchedule{
+				Authority: validAuthority,
+				Schedule: types.MarketFeeSchedule{
+					TakerFeeMultiplierPpm: types.MaxFeeMultiplierPpm + 1,
+				},
+			},
+			expectedErr: types.ErrInvalidMarketFeeSchedule,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDeleteMarketFeeSchedule_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgDeleteMarketFeeSchedule
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgDeleteMarketFeeSchedule{
+				Authority:  validAuthority,
+				ClobPairId: 1,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgDeleteMarketFeeSchedule{
+				Authority: "",
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
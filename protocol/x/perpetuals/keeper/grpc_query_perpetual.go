@@ -71,3 +71,34 @@ func (k Keeper) Perpetual(c context.Context, req *types.QueryPerpetualRequest) (
 
 	return &types.QueryPerpetualResponse{Perpetual: val}, nil
 }
+
+func (k Keeper) AllLiquidityTiers(
+	c context.Context,
+	req *types.QueryAllLiquidityTiersRequest,
+) (*types.QueryAllLiquidityTiersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var liquidityTiers []types.LiquidityTier
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	liquidityTierStore := prefix.NewStore(store, []byte(types.LiquidityTierKeyPrefix))
+
+	pageRes, err := query.Paginate(liquidityTierStore, req.Pagination, func(key []byte, value []byte) error {
+		var liquidityTier types.LiquidityTier
+		if err := k.cdc.Unmarshal(value, &liquidityTier); err != nil {
+			return err
+		}
+
+		liquidityTiers = append(liquidityTiers, liquidityTier)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllLiquidityTiersResponse{LiquidityTiers: liquidityTiers, Pagination: pageRes}, nil
+}
@@ -124,3 +124,51 @@ func TestPerpetualQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestAllLiquidityTiersQueryPaginated(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	wctx := sdk.WrapSDKContext(pc.Ctx)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	msgs := pc.PerpetualsKeeper.GetAllLiquidityTiers(pc.Ctx)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllLiquidityTiersRequest {
+		return &types.QueryAllLiquidityTiersRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := pc.PerpetualsKeeper.AllLiquidityTiers(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.LiquidityTiers), step)
+			require.Subset(t, msgs, resp.LiquidityTiers)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := pc.PerpetualsKeeper.AllLiquidityTiers(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.LiquidityTiers), step)
+			require.Subset(t, msgs, resp.LiquidityTiers)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := pc.PerpetualsKeeper.AllLiquidityTiers(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.LiquidityTiers)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := pc.PerpetualsKeeper.AllLiquidityTiers(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -218,6 +218,106 @@ func (m *QueryAllPerpetualsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAllLiquidityTiersRequest is the request type for the
+// AllLiquidityTiers RPC method.
+type QueryAllLiquidityTiersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllLiquidityTiersRequest) Reset()         { *m = QueryAllLiquidityTiersRequest{} }
+func (m *QueryAllLiquidityTiersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllLiquidityTiersRequest) ProtoMessage()    {}
+func (*QueryAllLiquidityTiersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{4}
+}
+func (m *QueryAllLiquidityTiersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllLiquidityTiersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllLiquidityTiersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllLiquidityTiersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllLiquidityTiersRequest.Merge(m, src)
+}
+func (m *QueryAllLiquidityTiersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllLiquidityTiersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllLiquidityTiersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllLiquidityTiersRequest proto.InternalMessageInfo
+
+func (m *QueryAllLiquidityTiersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllLiquidityTiersResponse is the response type for the
+// AllLiquidityTiers RPC method.
+type QueryAllLiquidityTiersResponse struct {
+	LiquidityTiers []LiquidityTier     `protobuf:"bytes,1,rep,name=liquidity_tiers,json=liquidityTiers,proto3" json:"liquidity_tiers"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllLiquidityTiersResponse) Reset()         { *m = QueryAllLiquidityTiersResponse{} }
+func (m *QueryAllLiquidityTiersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllLiquidityTiersResponse) ProtoMessage()    {}
+func (*QueryAllLiquidityTiersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{5}
+}
+func (m *QueryAllLiquidityTiersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllLiquidityTiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllLiquidityTiersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllLiquidityTiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllLiquidityTiersResponse.Merge(m, src)
+}
+func (m *QueryAllLiquidityTiersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllLiquidityTiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllLiquidityTiersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllLiquidityTiersResponse proto.InternalMessageInfo
+
+func (m *QueryAllLiquidityTiersResponse) GetLiquidityTiers() []LiquidityTier {
+	if m != nil {
+		return m.LiquidityTiers
+	}
+	return nil
+}
+
+func (m *QueryAllLiquidityTiersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryPremiumVotesRequest is the request type for the PremiumVotes RPC method.
 type QueryPremiumVotesRequest struct {
 }
@@ -226,7 +326,7 @@ func (m *QueryPremiumVotesRequest) Reset()         { *m = QueryPremiumVotesReque
 func (m *QueryPremiumVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPremiumVotesRequest) ProtoMessage()    {}
 func (*QueryPremiumVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13b6d29860ccef6b, []int{4}
+	return fileDescriptor_13b6d29860ccef6b, []int{6}
 }
 func (m *QueryPremiumVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +365,7 @@ func (m *QueryPremiumVotesResponse) Reset()         { *m = QueryPremiumVotesResp
 func (m *QueryPremiumVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPremiumVotesResponse) ProtoMessage()    {}
 func (*QueryPremiumVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13b6d29860ccef6b, []int{5}
+	return fileDescriptor_13b6d29860ccef6b, []int{7}
 }
 func (m *QueryPremiumVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +410,7 @@ func (m *QueryPremiumSamplesRequest) Reset()         { *m = QueryPremiumSamplesR
 func (m *QueryPremiumSamplesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPremiumSamplesRequest) ProtoMessage()    {}
 func (*QueryPremiumSamplesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13b6d29860ccef6b, []int{6}
+	return fileDescriptor_13b6d29860ccef6b, []int{8}
 }
 func (m *QueryPremiumSamplesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +449,7 @@ func (m *QueryPremiumSamplesResponse) Reset()         { *m = QueryPremiumSamples
 func (m *QueryPremiumSamplesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPremiumSamplesResponse) ProtoMessage()    {}
 func (*QueryPremiumSamplesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13b6d29860ccef6b, []int{7}
+	return fileDescriptor_13b6d29860ccef6b, []int{9}
 }
 func (m *QueryPremiumSamplesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +493,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13b6d29860ccef6b, []int{8}
+	return fileDescriptor_13b6d29860ccef6b, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +531,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13b6d29860ccef6b, []int{9}
+	return fileDescriptor_13b6d29860ccef6b, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,6 +572,8 @@ func init() {
 	proto.RegisterType((*QueryPerpetualResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualResponse")
 	proto.RegisterType((*QueryAllPerpetualsRequest)(nil), "dydxprotocol.perpetuals.QueryAllPerpetualsRequest")
 	proto.RegisterType((*QueryAllPerpetualsResponse)(nil), "dydxprotocol.perpetuals.QueryAllPerpetualsResponse")
+	proto.RegisterType((*QueryAllLiquidityTiersRequest)(nil), "dydxprotocol.perpetuals.QueryAllLiquidityTiersRequest")
+	proto.RegisterType((*QueryAllLiquidityTiersResponse)(nil), "dydxprotocol.perpetuals.QueryAllLiquidityTiersResponse")
 	proto.RegisterType((*QueryPremiumVotesRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumVotesRequest")
 	proto.RegisterType((*QueryPremiumVotesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumVotesResponse")
 	proto.RegisterType((*QueryPremiumSamplesRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesRequest")
@@ -485,47 +587,52 @@ func init() {
 }
 
 var fileDescriptor_13b6d29860ccef6b = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0xe3, 0x00, 0x91, 0x7a, 0x34, 0x41, 0x3a, 0x0a, 0x14, 0x53, 0x39, 0x60, 0x4a, 0x13,
-	0x02, 0xf8, 0x48, 0xd2, 0x11, 0x06, 0x3a, 0x94, 0x35, 0x84, 0xaa, 0x03, 0x0b, 0x5c, 0x92, 0x93,
-	0x6b, 0xc9, 0xf6, 0x5d, 0x7d, 0x4e, 0xd4, 0x08, 0xb1, 0x30, 0x33, 0x20, 0x31, 0xb3, 0xc1, 0xd8,
-	0x91, 0xff, 0xa1, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0xfe, 0x10, 0x94, 0xbb, 0x73, 0x62, 0xd3,
-	0xb8, 0x4e, 0xba, 0x45, 0xf7, 0xbe, 0xf7, 0xbe, 0xdf, 0x7d, 0xbe, 0xa7, 0x80, 0xfb, 0xbd, 0x61,
-	0xef, 0x88, 0x05, 0x34, 0xa4, 0x5d, 0xea, 0x22, 0x46, 0x02, 0x46, 0xc2, 0x3e, 0x76, 0x39, 0x3a,
-	0xec, 0x93, 0x60, 0x68, 0x89, 0x0a, 0xbc, 0x15, 0x17, 0x59, 0x33, 0x91, 0xbe, 0x66, 0x53, 0x9b,
-	0x8a, 0x02, 0x9a, 0xfc, 0x92, 0x72, 0x7d, 0xc3, 0xa6, 0xd4, 0x76, 0x09, 0xc2, 0xcc, 0x41, 0xd8,
-	0xf7, 0x69, 0x88, 0x43, 0x87, 0xfa, 0x5c, 0x55, 0x6b, 0x5d, 0xca, 0x3d, 0xca, 0x51, 0x07, 0x73,
-	0x22, 0x5d, 0xd0, 0xa0, 0xde, 0x21, 0x21, 0xae, 0x23, 0x86, 0x6d, 0xc7, 0x17, 0x62, 0xa5, 0xdd,
-	0x4c, 0xa3, 0x63, 0x38, 0xc0, 0x5e, 0x34, 0xb1, 0x92, 0xaa, 0x8a, 0x7e, 0x4a, 0xa1, 0x59, 0x01,
-	0x37, 0x5e, 0x4d, 0x0c, 0x5b, 0xd1, 0x79, 0x9b, 0x1c, 0xf6, 0x09, 0x0f, 0x61, 0x09, 0xe4, 0x9d,
-	0xde, 0xba, 0x76, 0x57, 0xab, 0x16, 0xdb, 0x79, 0xa7, 0x67, 0xbe, 0x03, 0x37, 0xff, 0x17, 0x72,
-	0x46, 0x7d, 0x4e, 0xe0, 0x2e, 0x58, 0x99, 0x4e, 0x15, 0x0d, 0x57, 0x1b, 0xa6, 0x95, 0x12, 0x8f,
-	0x35, 0x6d, 0xdf, 0xb9, 0x7c, 0xf2, 0xbb, 0x9c, 0x6b, 0xcf, 0x5a, 0xcd, 0x2e, 0xb8, 0x2d, 0x1c,
-	0x5e, 0xb8, 0xee, 0x54, 0xc5, 0x23, 0x9c, 0x5d, 0x00, 0x66, 0x51, 0x28, 0x97, 0x2d, 0x4b, 0xe6,
-	0x66, 0x4d, 0x72, 0xb3, 0xe4, 0xd7, 0x51, 0xb9, 0x59, 0x2d, 0x6c, 0x13, 0xd5, 0xdb, 0x8e, 0x75,
-	0x9a, 0xc7, 0x1a, 0xd0, 0xe7, 0xb9, 0xcc, 0xbf, 0xcb, 0xa5, 0x0b, 0xde, 0x05, 0xbe, 0x4c, 0xe0,
-	0xe6, 0x05, 0x6e, 0x25, 0x13, 0x57, 0x42, 0x24, 0x78, 0x75, 0xb0, 0x2e, 0x63, 0x0f, 0x88, 0xe7,
-	0xf4, 0xbd, 0x7d, 0x1a, 0x92, 0x28, 0x13, 0xd3, 0x53, 0x81, 0x25, 0x6b, 0xea, 0x26, 0x2d, 0x50,
-	0x64, 0xf2, 0xfc, 0xed, 0x60, 0x52, 0x50, 0x99, 0x3d, 0x48, 0xbf, 0x8d, 0x54, 0xbf, 0x0e, 0x69,
-	0x40, 0xd4, 0x85, 0x56, 0x59, 0x6c, 0xb2, 0xb9, 0xa1, 0x92, 0x8b, 0x84, 0xd8, 0x63, 0xee, 0x0c,
-	0x86, 0x83, 0x3b, 0x73, 0xab, 0x0a, 0x67, 0x0f, 0x5c, 0x8b, 0x70, 0xb8, 0x2c, 0x5d, 0x04, 0xa8,
-	0xc4, 0x12, 0xd3, 0xcd, 0x35, 0x00, 0xa5, 0xa9, 0x78, 0xfb, 0x11, 0xca, 0x1e, 0xb8, 0x9e, 0x38,
-	0x55, 0x08, 0xcf, 0x41, 0x41, 0xee, 0x88, 0x72, 0x2e, 0xa7, 0x3b, 0x0b, 0x99, 0xf2, 0x54, 0x4d,
-	0x8d, 0x1f, 0x05, 0x70, 0x45, 0x8c, 0x85, 0x5f, 0x35, 0xb0, 0x32, 0xfd, 0xf6, 0xd0, 0x4a, 0x1d,
-	0x33, 0x77, 0xb1, 0x74, 0xb4, 0xb0, 0x5e, 0x72, 0x9b, 0xe8, 0xe3, 0xcf, 0xbf, 0x5f, 0xf2, 0x0f,
-	0x61, 0x05, 0x65, 0x2e, 0x35, 0x7a, 0xef, 0xf4, 0x3e, 0xc0, 0x6f, 0x1a, 0x28, 0x26, 0x9e, 0x37,
-	0x6c, 0x9c, 0xef, 0x39, 0x6f, 0xe3, 0xf4, 0xe6, 0x52, 0x3d, 0x8a, 0xb5, 0x26, 0x58, 0x37, 0xa1,
-	0x99, 0xcd, 0x0a, 0xbf, 0x6b, 0x60, 0x35, 0xfe, 0x74, 0x61, 0x3d, 0x23, 0x99, 0xb3, 0x2b, 0xa0,
-	0x37, 0x96, 0x69, 0x51, 0x8c, 0x96, 0x60, 0xac, 0xc2, 0xad, 0x74, 0xc6, 0xf8, 0xe2, 0xc0, 0x63,
-	0x0d, 0x94, 0x92, 0xaf, 0x1a, 0x36, 0x17, 0xb2, 0x4d, 0x6e, 0x88, 0xbe, 0xbd, 0x5c, 0x93, 0xa2,
-	0x7d, 0x2a, 0x68, 0x6b, 0xb0, 0x9a, 0x49, 0xab, 0xf6, 0x0a, 0x7e, 0xd2, 0x40, 0x41, 0xbe, 0x60,
-	0xf8, 0x28, 0xc3, 0x32, 0xbe, 0x36, 0xfa, 0xe3, 0xc5, 0xc4, 0x8a, 0xab, 0x22, 0xb8, 0xee, 0xc1,
-	0x32, 0x3a, 0xff, 0x0f, 0x69, 0x67, 0xff, 0x64, 0x64, 0x68, 0xa7, 0x23, 0x43, 0xfb, 0x33, 0x32,
-	0xb4, 0xcf, 0x63, 0x23, 0x77, 0x3a, 0x36, 0x72, 0xbf, 0xc6, 0x46, 0xee, 0xcd, 0x33, 0xdb, 0x09,
-	0x0f, 0xfa, 0x1d, 0xab, 0x4b, 0xbd, 0xe4, 0x90, 0xc1, 0xf6, 0x93, 0xee, 0x01, 0x76, 0x7c, 0x34,
-	0x3d, 0x39, 0x8a, 0x0f, 0x0e, 0x87, 0x8c, 0xf0, 0x4e, 0x41, 0x14, 0x9b, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xe6, 0x1c, 0x39, 0xe0, 0xaf, 0x07, 0x00, 0x00,
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x18, 0xc7, 0x73, 0x79, 0x5f, 0x8a, 0xfa, 0xd0, 0xa6, 0xe2, 0x28, 0xd0, 0x9a, 0xe2, 0x82, 0x29,
+	0x4d, 0x29, 0xe0, 0xa3, 0x69, 0x05, 0x0b, 0x0c, 0x74, 0x28, 0x0b, 0x43, 0x09, 0xa5, 0x03, 0x4b,
+	0x71, 0x92, 0x93, 0x7b, 0x92, 0x9d, 0x73, 0x6d, 0xa7, 0x6a, 0x84, 0x58, 0x98, 0x19, 0x90, 0x98,
+	0xd9, 0x60, 0xec, 0xca, 0xcc, 0xd8, 0xb1, 0x12, 0x0b, 0x13, 0x42, 0x2d, 0x33, 0x7f, 0x03, 0xca,
+	0xdd, 0x39, 0xb1, 0x5b, 0xbb, 0x4e, 0xaa, 0x6e, 0xd1, 0x3d, 0x3f, 0xbe, 0x9f, 0xfb, 0x9e, 0x9f,
+	0x47, 0x81, 0x5b, 0x8d, 0x76, 0x63, 0xc7, 0xf3, 0x79, 0xc8, 0xeb, 0xdc, 0x21, 0x1e, 0xf5, 0x3d,
+	0x1a, 0xb6, 0x2c, 0x27, 0x20, 0x5b, 0x2d, 0xea, 0xb7, 0x4d, 0x11, 0xc1, 0x57, 0xe3, 0x49, 0x66,
+	0x2f, 0x49, 0x1b, 0xb7, 0xb9, 0xcd, 0x45, 0x80, 0x74, 0x7e, 0xc9, 0x74, 0x6d, 0xca, 0xe6, 0xdc,
+	0x76, 0x28, 0xb1, 0x3c, 0x46, 0xac, 0x66, 0x93, 0x87, 0x56, 0xc8, 0x78, 0x33, 0x50, 0xd1, 0xf9,
+	0x3a, 0x0f, 0x5c, 0x1e, 0x90, 0x9a, 0x15, 0x50, 0xa9, 0x42, 0xb6, 0x17, 0x6a, 0x34, 0xb4, 0x16,
+	0x88, 0x67, 0xd9, 0xac, 0x29, 0x92, 0x55, 0xee, 0x4c, 0x16, 0x9d, 0x67, 0xf9, 0x96, 0x1b, 0x75,
+	0x2c, 0x67, 0x66, 0x45, 0x3f, 0x65, 0xa2, 0x51, 0x86, 0xcb, 0x2f, 0x3a, 0x82, 0xab, 0xd1, 0x79,
+	0x95, 0x6e, 0xb5, 0x68, 0x10, 0xe2, 0x12, 0x14, 0x59, 0x63, 0x02, 0xdd, 0x40, 0x73, 0xa3, 0xd5,
+	0x22, 0x6b, 0x18, 0x6f, 0xe0, 0xca, 0xd1, 0xc4, 0xc0, 0xe3, 0xcd, 0x80, 0xe2, 0x15, 0x18, 0xee,
+	0x76, 0x15, 0x05, 0x17, 0x2a, 0x86, 0x99, 0x61, 0x8f, 0xd9, 0x2d, 0x5f, 0xfe, 0x7f, 0xef, 0xd7,
+	0x74, 0xa1, 0xda, 0x2b, 0x35, 0xea, 0x30, 0x29, 0x14, 0x9e, 0x3a, 0x4e, 0x37, 0x2b, 0x88, 0x70,
+	0x56, 0x00, 0x7a, 0x56, 0x28, 0x95, 0x59, 0x53, 0xfa, 0x66, 0x76, 0x7c, 0x33, 0xe5, 0xeb, 0x28,
+	0xdf, 0xcc, 0x55, 0xcb, 0xa6, 0xaa, 0xb6, 0x1a, 0xab, 0x34, 0x76, 0x11, 0x68, 0x69, 0x2a, 0xe9,
+	0x77, 0xf9, 0xef, 0x94, 0x77, 0xc1, 0xcf, 0x12, 0xb8, 0x45, 0x81, 0x5b, 0xce, 0xc5, 0x95, 0x10,
+	0x09, 0x5e, 0x1b, 0xae, 0x47, 0xb8, 0xcf, 0xd9, 0x56, 0x8b, 0x35, 0x58, 0xd8, 0x5e, 0x63, 0xd4,
+	0x3f, 0x73, 0x63, 0xbe, 0x23, 0xd0, 0xb3, 0x94, 0x94, 0x39, 0xaf, 0x60, 0xcc, 0x89, 0x22, 0x1b,
+	0x61, 0x27, 0xa4, 0x2c, 0x9a, 0xcd, 0xb4, 0x28, 0xd1, 0x49, 0xd9, 0x54, 0x72, 0x12, 0xed, 0xcf,
+	0xce, 0x2b, 0x0d, 0x26, 0xe4, 0x27, 0xea, 0x53, 0x97, 0xb5, 0xdc, 0x75, 0x1e, 0xd2, 0xc8, 0x26,
+	0xc3, 0x85, 0xc9, 0x94, 0x98, 0xba, 0xd8, 0x2a, 0x8c, 0x7a, 0xf2, 0x7c, 0x63, 0xbb, 0x13, 0x50,
+	0x36, 0xde, 0xce, 0x7e, 0x79, 0x99, 0xfd, 0x32, 0xe4, 0x3e, 0x55, 0xb7, 0x1a, 0xf1, 0x62, 0x9d,
+	0x8d, 0x29, 0xf5, 0x95, 0x45, 0x89, 0x96, 0xeb, 0x39, 0x3d, 0x98, 0x00, 0xae, 0xa5, 0x46, 0x15,
+	0xce, 0x1a, 0x8c, 0x45, 0x38, 0x81, 0x0c, 0x9d, 0x06, 0xa8, 0xe4, 0x25, 0xba, 0x1b, 0xe3, 0x80,
+	0xa5, 0xa8, 0xd8, 0x13, 0x11, 0xca, 0x1a, 0x5c, 0x4a, 0x9c, 0x2a, 0x84, 0x27, 0x30, 0x24, 0xf7,
+	0x89, 0x52, 0x9e, 0xce, 0x56, 0x16, 0x69, 0x4a, 0x53, 0x15, 0x55, 0xfe, 0x9e, 0x87, 0x73, 0xa2,
+	0x2d, 0xfe, 0x8c, 0x60, 0xb8, 0x3b, 0x27, 0xd8, 0xcc, 0x6c, 0x93, 0xba, 0x84, 0x34, 0xd2, 0x77,
+	0xbe, 0xe4, 0x36, 0xc8, 0xfb, 0x1f, 0x7f, 0x3e, 0x15, 0xef, 0xe0, 0x32, 0xc9, 0x5d, 0x80, 0xe4,
+	0x2d, 0x6b, 0xbc, 0xc3, 0x5f, 0x10, 0x8c, 0x26, 0x56, 0x01, 0xae, 0x9c, 0xac, 0x99, 0xb6, 0x9d,
+	0xb4, 0xc5, 0x81, 0x6a, 0x14, 0xeb, 0xbc, 0x60, 0x9d, 0xc1, 0x46, 0x3e, 0x2b, 0xfe, 0x86, 0xe0,
+	0xe2, 0xb1, 0xc1, 0xc4, 0x0f, 0x73, 0x65, 0x53, 0x77, 0x86, 0xf6, 0x68, 0xe0, 0x3a, 0x85, 0xfc,
+	0x40, 0x20, 0xcf, 0xe3, 0xb9, 0x4c, 0xe4, 0x23, 0x0b, 0x02, 0x7f, 0x45, 0x30, 0x12, 0x9f, 0x39,
+	0xbc, 0x90, 0xf3, 0xa4, 0xc7, 0x67, 0x57, 0xab, 0x0c, 0x52, 0xa2, 0x48, 0x4d, 0x41, 0x3a, 0x87,
+	0x67, 0xb3, 0xcd, 0x8d, 0x4f, 0x3c, 0xde, 0x45, 0x50, 0x4a, 0x8e, 0x23, 0x5e, 0xec, 0x4b, 0x36,
+	0x39, 0xda, 0xda, 0xd2, 0x60, 0x45, 0x7d, 0xfb, 0x7a, 0x64, 0x21, 0xe0, 0x0f, 0x08, 0x86, 0xe4,
+	0xe8, 0xe1, 0xbb, 0x39, 0x92, 0xf1, 0x79, 0xd7, 0xee, 0xf5, 0x97, 0xac, 0xb8, 0xca, 0x82, 0xeb,
+	0x26, 0x9e, 0x26, 0x27, 0xff, 0xeb, 0x58, 0x5e, 0xdf, 0x3b, 0xd0, 0xd1, 0xfe, 0x81, 0x8e, 0x7e,
+	0x1f, 0xe8, 0xe8, 0xe3, 0xa1, 0x5e, 0xd8, 0x3f, 0xd4, 0x0b, 0x3f, 0x0f, 0xf5, 0xc2, 0xeb, 0xc7,
+	0x36, 0x0b, 0x37, 0x5b, 0x35, 0xb3, 0xce, 0xdd, 0x64, 0x93, 0xed, 0xa5, 0xfb, 0xf5, 0x4d, 0x8b,
+	0x35, 0x49, 0xf7, 0x64, 0x27, 0xde, 0x38, 0x6c, 0x7b, 0x34, 0xa8, 0x0d, 0x89, 0xe0, 0xe2, 0xbf,
+	0x01, 0x00, 0xd7, 0x45, 0x0d, 0x89, 0x94, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -544,6 +651,8 @@ type QueryClient interface {
 	Perpetual(ctx context.Context, in *QueryPerpetualRequest, opts ...grpc.CallOption) (*QueryPerpetualResponse, error)
 	// Queries a list of Perpetual items.
 	AllPerpetuals(ctx context.Context, in *QueryAllPerpetualsRequest, opts ...grpc.CallOption) (*QueryAllPerpetualsResponse, error)
+	// Queries a list of LiquidityTiers.
+	AllLiquidityTiers(ctx context.Context, in *QueryAllLiquidityTiersRequest, opts ...grpc.CallOption) (*QueryAllLiquidityTiersResponse, error)
 	// Queries a list of premium votes.
 	PremiumVotes(ctx context.Context, in *QueryPremiumVotesRequest, opts ...grpc.CallOption) (*QueryPremiumVotesResponse, error)
 	// Queries a list of premium samples.
@@ -578,6 +687,15 @@ func (c *queryClient) AllPerpetuals(ctx context.Context, in *QueryAllPerpetualsR
 	return out, nil
 }
 
+func (c *queryClient) AllLiquidityTiers(ctx context.Context, in *QueryAllLiquidityTiersRequest, opts ...grpc.CallOption) (*QueryAllLiquidityTiersResponse, error) {
+	out := new(QueryAllLiquidityTiersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/AllLiquidityTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PremiumVotes(ctx context.Context, in *QueryPremiumVotesRequest, opts ...grpc.CallOption) (*QueryPremiumVotesResponse, error) {
 	out := new(QueryPremiumVotesResponse)
 	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PremiumVotes", in, out, opts...)
@@ -611,6 +729,8 @@ type QueryServer interface {
 	Perpetual(context.Context, *QueryPerpetualRequest) (*QueryPerpetualResponse, error)
 	// Queries a list of Perpetual items.
 	AllPerpetuals(context.Context, *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error)
+	// Queries a list of LiquidityTiers.
+	AllLiquidityTiers(context.Context, *QueryAllLiquidityTiersRequest) (*QueryAllLiquidityTiersResponse, error)
 	// Queries a list of premium votes.
 	PremiumVotes(context.Context, *QueryPremiumVotesRequest) (*QueryPremiumVotesResponse, error)
 	// Queries a list of premium samples.
@@ -629,6 +749,9 @@ func (*UnimplementedQueryServer) Perpetual(ctx context.Context, req *QueryPerpet
 func (*UnimplementedQueryServer) AllPerpetuals(ctx context.Context, req *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllPerpetuals not implemented")
 }
+func (*UnimplementedQueryServer) AllLiquidityTiers(ctx context.Context, req *QueryAllLiquidityTiersRequest) (*QueryAllLiquidityTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllLiquidityTiers not implemented")
+}
 func (*UnimplementedQueryServer) PremiumVotes(ctx context.Context, req *QueryPremiumVotesRequest) (*QueryPremiumVotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PremiumVotes not implemented")
 }
@@ -679,6 +802,24 @@ func _Query_AllPerpetuals_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllLiquidityTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLiquidityTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllLiquidityTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/AllLiquidityTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllLiquidityTiers(ctx, req.(*QueryAllLiquidityTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PremiumVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPremiumVotesRequest)
 	if err := dec(in); err != nil {
@@ -745,6 +886,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllPerpetuals",
 			Handler:    _Query_AllPerpetuals_Handler,
 		},
+		{
+			MethodName: "AllLiquidityTiers",
+			Handler:    _Query_AllLiquidityTiers_Handler,
+		},
 		{
 			MethodName: "PremiumVotes",
 			Handler:    _Query_PremiumVotes_Handler,
@@ -907,6 +1052,90 @@ func (m *QueryAllPerpetualsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllLiquidityTiersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLiquidityTiersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLiquidityTiersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllLiquidityTiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLiquidityTiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLiquidityTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LiquidityTiers) > 0 {
+		for iNdEx := len(m.LiquidityTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPremiumVotesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1141,6 +1370,38 @@ func (m *QueryAllPerpetualsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllLiquidityTiersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLiquidityTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityTiers) > 0 {
+		for _, e := range m.LiquidityTiers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryPremiumVotesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1565,6 +1826,212 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityTiers = append(m.LiquidityTiers, LiquidityTier{})
+			if err := m.LiquidityTiers[len(m.LiquidityTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
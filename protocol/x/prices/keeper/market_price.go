@@ -153,15 +153,16 @@ func (k Keeper) GetAllMarketPrices(ctx sdk.Context) []types.MarketPrice {
 // An index price is valid iff:
 // 1) the last update time is within a predefined threshold away from the given
 // read time.
-// 2) the number of prices that meet 1) are greater than the minimum number of
-// exchanges specified in the given input.
+// 2) the number of prices that meet 1) and are not rejected as outliers are greater
+// than the minimum number of exchanges specified in the given input.
+// Valid prices are aggregated according to each market's price aggregation config.
 // If a market does not have a valid index price, its `marketId` is not included
 // in returned map.
 func (k Keeper) GetMarketIdToValidIndexPrice(
 	ctx sdk.Context,
 ) map[uint32]types.MarketPrice {
 	allMarketParams := k.GetAllMarketParams(ctx)
-	marketIdToValidIndexPrice := k.indexPriceCache.GetValidMedianPrices(
+	marketIdToValidIndexPrice := k.indexPriceCache.GetValidIndexPrices(
 		allMarketParams,
 		k.timeProvider.Now(),
 	)
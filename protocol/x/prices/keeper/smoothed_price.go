@@ -17,7 +17,7 @@ func (k Keeper) UpdateSmoothedPrices(
 	linearInterpolateFunc func(v0 uint64, v1 uint64, ppm uint32) (uint64, error),
 ) error {
 	allMarketParams := k.GetAllMarketParams(ctx)
-	indexPrices := k.indexPriceCache.GetValidMedianPrices(allMarketParams, k.timeProvider.Now())
+	indexPrices := k.indexPriceCache.GetValidIndexPrices(allMarketParams, k.timeProvider.Now())
 
 	// Track errors for each market.
 	updateErrors := make([]error, 0)
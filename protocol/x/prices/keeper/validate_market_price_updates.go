@@ -101,7 +101,7 @@ func (k Keeper) performNonDeterministicStatefulValidation(
 		allMarketParams[i] = marketParamPrice.Param
 	}
 
-	idToIndexPrice := k.indexPriceCache.GetValidMedianPrices(allMarketParams, k.timeProvider.Now())
+	idToIndexPrice := k.indexPriceCache.GetValidIndexPrices(allMarketParams, k.timeProvider.Now())
 
 	for _, priceUpdate := range marketPriceUpdates.GetMarketPriceUpdates() {
 		// Check market exists.
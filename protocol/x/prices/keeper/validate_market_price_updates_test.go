@@ -355,6 +355,57 @@ func TestPerformStatefulPriceUpdateValidation_Error(t *testing.T) {
 	}
 }
 
+func TestPerformStatefulPriceUpdateValidation_PriceAggregationConfig(t *testing.T) {
+	// Setup.
+	ctx, k, _, indexPriceCache, _, mockTimeProvider := keepertest.PricesKeepers(t)
+	mockTimeProvider.On("Now").Return(constants.TimeT)
+	keepertest.CreateTestMarkets(t, ctx, k)
+
+	// Reject exchange prices that deviate from the median exchange price by more than 0.1%, and aggregate the
+	// remaining exchange prices by their mean.
+	marketParam, exists := k.GetMarketParam(ctx, constants.MarketId0)
+	require.True(t, exists)
+	marketParam.ExchangeConfigJson = `{"exchanges":[],"aggregation":{"strategy":"trimmed_mean","maxDeviationPpm":1000}}`
+	_, err := k.ModifyMarketParam(ctx, marketParam)
+	require.NoError(t, err)
+
+	indexPriceCache.UpdatePrices([]*api.MarketPriceUpdate{
+		{
+			MarketId: constants.MarketId0,
+			ExchangePrices: []*api.ExchangePrice{
+				{
+					ExchangeId:     constants.ExchangeId0,
+					Price:          price_5_005_000_000,
+					LastUpdateTime: &constants.TimeT,
+				},
+				{
+					ExchangeId:     constants.ExchangeId1,
+					Price:          price_5_005_000_000,
+					LastUpdateTime: &constants.TimeT,
+				},
+				{
+					ExchangeId:     constants.ExchangeId2,
+					Price:          6_000_000_000, // Outlier.
+					LastUpdateTime: &constants.TimeT,
+				},
+			},
+		},
+	})
+
+	// Without outlier rejection, the index price would be 5,336,666,667 and this update would be valid.
+	msg := &types.MsgUpdateMarketPrices{
+		MarketPriceUpdates: []*types.MsgUpdateMarketPrices_MarketPrice{
+			types.NewMarketPriceUpdate(constants.MarketId0, price_5_010_000_000),
+		},
+	}
+	err = k.PerformStatefulPriceUpdateValidation(ctx, msg, true)
+	require.ErrorIs(t, err, types.ErrInvalidMarketPriceUpdateNonDeterministic)
+	require.ErrorContains(t, err, "crosses the index price (5005000000)")
+
+	msg.MarketPriceUpdates[0].Price = price_5_005_000_000
+	require.NoError(t, k.PerformStatefulPriceUpdateValidation(ctx, msg, true))
+}
+
 func TestGetMarketsMissingFromPriceUpdates(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
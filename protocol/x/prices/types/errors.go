@@ -26,7 +26,8 @@ var (
 	ErrMarketParamAlreadyExists       = errorsmod.Register(ModuleName, 204, "Market params already exists")
 
 	// 300 - 399: Price related errors.
-	ErrIndexPriceNotAvailable = errorsmod.Register(ModuleName, 300, "Index price is not available")
+	ErrIndexPriceNotAvailable        = errorsmod.Register(ModuleName, 300, "Index price is not available")
+	ErrInvalidPriceAggregationConfig = errorsmod.Register(ModuleName, 301, "Price aggregation config is invalid")
 
 	// 400 - 499: Market price update related errors.
 	ErrInvalidMarketPriceUpdateStateless = errorsmod.Register(
@@ -34,5 +34,14 @@ func (mp *MarketParam) Validate() error {
 		)
 	}
 
+	// Validate price aggregation config.
+	aggregationConfig, err := mp.GetPriceAggregationConfig()
+	if err != nil {
+		return err
+	}
+	if err := aggregationConfig.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
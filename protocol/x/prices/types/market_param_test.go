@@ -64,6 +64,37 @@ func TestMarketParam_Validate(t *testing.T) {
 			},
 			expErrMsg: "ExchangeConfigJson string is not valid",
 		},
+		{
+			name: "Valid price aggregation config",
+			input: types.MarketParam{
+				Pair:              "BTC-USD",
+				MinExchanges:      1,
+				MinPriceChangePpm: 1_000,
+				ExchangeConfigJson: `{"exchanges":[{"exchangeName":"Binance","ticker":"BTCUSDT","weight":2}],` +
+					`"aggregation":{"strategy":"weighted_median","maxDeviationPpm":100000}}`,
+			},
+			expErrMsg: "",
+		},
+		{
+			name: "Invalid price aggregation config",
+			input: types.MarketParam{
+				Pair:               "BTC-USD",
+				MinExchanges:       1,
+				MinPriceChangePpm:  1_000,
+				ExchangeConfigJson: `{"exchanges":[],"aggregation":{"strategy":"mean"}}`,
+			},
+			expErrMsg: "unknown strategy",
+		},
+		{
+			name: "Invalid type in price aggregation config",
+			input: types.MarketParam{
+				Pair:               "BTC-USD",
+				MinExchanges:       1,
+				MinPriceChangePpm:  1_000,
+				ExchangeConfigJson: `{"exchanges":[{"exchangeName":"Binance","weight":-1}]}`,
+			},
+			expErrMsg: "failed to parse exchange config json",
+		},
 	}
 
 	for _, tc := range testCases {
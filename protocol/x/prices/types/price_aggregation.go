@@ -0,0 +1,214 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+const (
+	// PriceAggregationStrategyMedian aggregates exchange prices by taking their median. This is the default.
+	PriceAggregationStrategyMedian = "median"
+	// PriceAggregationStrategyWeightedMedian aggregates exchange prices by taking their median weighted by the
+	// trailing volume of the market on each exchange, scaled by the `weight` of each exchange. If any of the
+	// exchanges does not report a volume, the prices are weighted by the `weight` of each exchange only.
+	PriceAggregationStrategyWeightedMedian = "weighted_median"
+	// PriceAggregationStrategyTrimmedMean aggregates exchange prices by taking their mean after discarding the
+	// `trimPpm` fraction of the lowest and highest prices.
+	PriceAggregationStrategyTrimmedMean = "trimmed_mean"
+
+	// DefaultExchangeWeight is the weight of exchanges that do not specify a weight.
+	DefaultExchangeWeight = uint32(1)
+)
+
+// PriceAggregationConfig defines how the exchange prices of a market are aggregated into an index price.
+// It is configured through the optional `aggregation` object and the optional per-exchange `weight` fields of
+// the market's exchange config json, e.g.
+//
+//	{
+//	  "exchanges": [{"exchangeName": "Binance", "ticker": "BTCUSDT", "weight": 3}, ...],
+//	  "aggregation": {"strategy": "weighted_median", "maxDeviationPpm": 100000}
+//	}
+//
+// Markets without an `aggregation` object use the median of all exchange prices.
+type PriceAggregationConfig struct {
+	// Strategy is the aggregation strategy. An empty strategy is the median.
+	Strategy string `json:"strategy,omitempty"`
+	// TrimPpm is the fraction of the lowest and highest prices, in parts-per-million, that are discarded by the
+	// trimmed mean strategy. It must be less than 500,000.
+	TrimPpm uint32 `json:"trimPpm,omitempty"`
+	// MaxDeviationPpm is the maximum deviation, in parts-per-million, of an exchange price from the median of
+	// all exchange prices. Exchange prices that deviate further are rejected as outliers before aggregation, and
+	// do not count towards the market's min exchanges. Zero disables outlier rejection.
+	MaxDeviationPpm uint32 `json:"maxDeviationPpm,omitempty"`
+	// ExchangeWeights maps exchange names to the factor their volume is scaled by in the weighted median strategy.
+	// Exchanges without a weight have weight `DefaultExchangeWeight`.
+	ExchangeWeights map[string]uint32 `json:"-"`
+}
+
+// priceAggregationJson is the subset of a market's exchange config json that configures price aggregation.
+type priceAggregationJson struct {
+	Exchanges []struct {
+		ExchangeName string `json:"exchangeName"`
+		Weight       uint32 `json:"weight,omitempty"`
+	} `json:"exchanges"`
+	Aggregation PriceAggregationConfig `json:"aggregation"`
+}
+
+// GetPriceAggregationConfig parses the price aggregation config of the market from its exchange config json.
+func (mp *MarketParam) GetPriceAggregationConfig() (PriceAggregationConfig, error) {
+	var aggregationJson priceAggregationJson
+	if err := json.Unmarshal([]byte(mp.ExchangeConfigJson), &aggregationJson); err != nil {
+		return PriceAggregationConfig{}, errorsmod.Wrapf(
+			ErrInvalidPriceAggregationConfig,
+			"failed to parse exchange config json for market %d: %v",
+			mp.Id,
+			err,
+		)
+	}
+
+	config := aggregationJson.Aggregation
+	for _, exchange := range aggregationJson.Exchanges {
+		if exchange.Weight == 0 {
+			continue
+		}
+		if config.ExchangeWeights == nil {
+			config.ExchangeWeights = make(map[string]uint32)
+		}
+		config.ExchangeWeights[exchange.ExchangeName] = exchange.Weight
+	}
+	return config, nil
+}
+
+// Validate checks that the price aggregation config is valid.
+func (pac PriceAggregationConfig) Validate() error {
+	switch pac.Strategy {
+	case "", PriceAggregationStrategyMedian, PriceAggregationStrategyWeightedMedian, PriceAggregationStrategyTrimmedMean:
+	default:
+		return errorsmod.Wrapf(ErrInvalidPriceAggregationConfig, "unknown strategy %q", pac.Strategy)
+	}
+
+	if pac.TrimPpm != 0 && pac.Strategy != PriceAggregationStrategyTrimmedMean {
+		return errorsmod.Wrapf(
+			ErrInvalidPriceAggregationConfig,
+			"trimPpm is only supported by the %q strategy",
+			PriceAggregationStrategyTrimmedMean,
+		)
+	}
+	if pac.TrimPpm >= lib.OneMillion/2 {
+		return errorsmod.Wrapf(
+			ErrInvalidPriceAggregationConfig,
+			"trimPpm %d must be less than %d",
+			pac.TrimPpm,
+			lib.OneMillion/2,
+		)
+	}
+
+	if len(pac.ExchangeWeights) > 0 && pac.Strategy != PriceAggregationStrategyWeightedMedian {
+		return errorsmod.Wrapf(
+			ErrInvalidPriceAggregationConfig,
+			"exchange weights are only supported by the %q strategy",
+			PriceAggregationStrategyWeightedMedian,
+		)
+	}
+
+	return nil
+}
+
+// RejectOutliers returns the exchange prices that deviate from the median of all exchange prices by at most
+// `MaxDeviationPpm`. The median is used as the reference rather than the current oracle price, so that outlier
+// rejection keeps up with the market when it moves further than `MaxDeviationPpm` between oracle price updates.
+// All exchange prices are returned if outlier rejection is disabled.
+func (pac PriceAggregationConfig) RejectOutliers(exchangePrices map[string]uint64) map[string]uint64 {
+	if pac.MaxDeviationPpm == 0 || len(exchangePrices) == 0 {
+		return exchangePrices
+	}
+
+	prices := make([]uint64, 0, len(exchangePrices))
+	for _, price := range exchangePrices {
+		prices = append(prices, price)
+	}
+	// Median does not return an error for non-empty input.
+	medianPrice, _ := lib.Median(prices)
+
+	// deviation / medianPrice <= maxDeviationPpm / 1_000_000 ==>
+	// deviation * 1_000_000 <= medianPrice * maxDeviationPpm
+	maxDeviationPpm := new(big.Int).Mul(
+		new(big.Int).SetUint64(medianPrice),
+		new(big.Int).SetUint64(uint64(pac.MaxDeviationPpm)),
+	)
+	acceptedPrices := make(map[string]uint64, len(exchangePrices))
+	for exchange, price := range exchangePrices {
+		deviationPpm := new(big.Int).Mul(
+			new(big.Int).SetUint64(lib.AbsDiffUint64(price, medianPrice)),
+			lib.BigIntOneMillion(),
+		)
+		if deviationPpm.Cmp(maxDeviationPpm) <= 0 {
+			acceptedPrices[exchange] = price
+		}
+	}
+	return acceptedPrices
+}
+
+// Aggregate aggregates the exchange prices into a single index price using the configured strategy.
+// `exchangeVolumes` holds the trailing volume of the market on each exchange that reports it, and is only used by
+// the weighted median strategy. Returns an error if there are no exchange prices.
+func (pac PriceAggregationConfig) Aggregate(
+	exchangePrices map[string]uint64,
+	exchangeVolumes map[string]uint64,
+) (uint64, error) {
+	// Iterate over exchanges in a consistent order.
+	exchanges := lib.GetSortedKeys[sort.StringSlice](exchangePrices)
+	prices := make([]uint64, 0, len(exchanges))
+	for _, exchange := range exchanges {
+		prices = append(prices, exchangePrices[exchange])
+	}
+
+	switch pac.Strategy {
+	case PriceAggregationStrategyWeightedMedian:
+		return lib.WeightedMedian(prices, pac.getWeights(exchanges, exchangeVolumes))
+	case PriceAggregationStrategyTrimmedMean:
+		return lib.TrimmedMean(prices, pac.TrimPpm)
+	default:
+		return lib.Median(prices)
+	}
+}
+
+// getWeights returns the weight of each exchange in the weighted median strategy. The weight of an exchange is its
+// volume scaled by its configured weight, saturating at `math.MaxUint64`. Volumes are only used if all exchanges
+// report a volume, since exchanges without a volume cannot be weighted against exchanges with a volume.
+func (pac PriceAggregationConfig) getWeights(exchanges []string, exchangeVolumes map[string]uint64) []uint64 {
+	useVolumes := true
+	for _, exchange := range exchanges {
+		if exchangeVolumes[exchange] == 0 {
+			useVolumes = false
+			break
+		}
+	}
+
+	weights := make([]uint64, 0, len(exchanges))
+	for _, exchange := range exchanges {
+		weight, ok := pac.ExchangeWeights[exchange]
+		if !ok {
+			weight = DefaultExchangeWeight
+		}
+		if !useVolumes {
+			weights = append(weights, uint64(weight))
+			continue
+		}
+		bigWeight := new(big.Int).Mul(
+			new(big.Int).SetUint64(exchangeVolumes[exchange]),
+			new(big.Int).SetUint64(uint64(weight)),
+		)
+		if !bigWeight.IsUint64() {
+			weights = append(weights, math.MaxUint64)
+			continue
+		}
+		weights = append(weights, bigWeight.Uint64())
+	}
+	return weights
+}
@@ -0,0 +1,293 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriceAggregationConfig(t *testing.T) {
+	tests := map[string]struct {
+		exchangeConfigJson string
+		expectedConfig     types.PriceAggregationConfig
+	}{
+		"No aggregation config": {
+			exchangeConfigJson: `{"exchanges":[{"exchangeName":"Binance","ticker":"BTCUSDT"}]}`,
+			expectedConfig:     types.PriceAggregationConfig{},
+		},
+		"Empty exchange config": {
+			exchangeConfigJson: `{}`,
+			expectedConfig:     types.PriceAggregationConfig{},
+		},
+		"Weighted median with weights": {
+			exchangeConfigJson: `{"exchanges":[` +
+				`{"exchangeName":"Binance","ticker":"BTCUSDT","weight":3},` +
+				`{"exchangeName":"Kraken","ticker":"XXBTZUSD"}` +
+				`],"aggregation":{"strategy":"weighted_median","maxDeviationPpm":50000}}`,
+			expectedConfig: types.PriceAggregationConfig{
+				Strategy:        types.PriceAggregationStrategyWeightedMedian,
+				MaxDeviationPpm: 50_000,
+				ExchangeWeights: map[string]uint32{"Binance": 3},
+			},
+		},
+		"Trimmed mean": {
+			exchangeConfigJson: `{"exchanges":[],"aggregation":{"strategy":"trimmed_mean","trimPpm":200000}}`,
+			expectedConfig: types.PriceAggregationConfig{
+				Strategy: types.PriceAggregationStrategyTrimmedMean,
+				TrimPpm:  200_000,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			marketParam := types.MarketParam{ExchangeConfigJson: tc.exchangeConfigJson}
+			config, err := marketParam.GetPriceAggregationConfig()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedConfig, config)
+		})
+	}
+}
+
+func TestGetPriceAggregationConfig_Error(t *testing.T) {
+	marketParam := types.MarketParam{
+		Id:                 1,
+		ExchangeConfigJson: `{"aggregation":{"trimPpm":"1"}}`,
+	}
+	_, err := marketParam.GetPriceAggregationConfig()
+	require.ErrorIs(t, err, types.ErrInvalidPriceAggregationConfig)
+	require.ErrorContains(t, err, "failed to parse exchange config json for market 1")
+}
+
+func TestPriceAggregationConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		config        types.PriceAggregationConfig
+		expectedError string
+	}{
+		"Default": {
+			config: types.PriceAggregationConfig{},
+		},
+		"Median with outlier rejection": {
+			config: types.PriceAggregationConfig{
+				Strategy:        types.PriceAggregationStrategyMedian,
+				MaxDeviationPpm: 100_000,
+			},
+		},
+		"Weighted median": {
+			config: types.PriceAggregationConfig{
+				Strategy:        types.PriceAggregationStrategyWeightedMedian,
+				ExchangeWeights: map[string]uint32{"Binance": 2},
+			},
+		},
+		"Trimmed mean": {
+			config: types.PriceAggregationConfig{
+				Strategy: types.PriceAggregationStrategyTrimmedMean,
+				TrimPpm:  499_999,
+			},
+		},
+		"Unknown strategy": {
+			config: types.PriceAggregationConfig{
+				Strategy: "mean",
+			},
+			expectedError: `unknown strategy "mean"`,
+		},
+		"Trim ppm too large": {
+			config: types.PriceAggregationConfig{
+				Strategy: types.PriceAggregationStrategyTrimmedMean,
+				TrimPpm:  500_000,
+			},
+			expectedError: "trimPpm 500000 must be less than 500000",
+		},
+		"Trim ppm without trimmed mean": {
+			config: types.PriceAggregationConfig{
+				TrimPpm: 100_000,
+			},
+			expectedError: `trimPpm is only supported by the "trimmed_mean" strategy`,
+		},
+		"Weights without weighted median": {
+			config: types.PriceAggregationConfig{
+				Strategy:        types.PriceAggregationStrategyTrimmedMean,
+				ExchangeWeights: map[string]uint32{"Binance": 2},
+			},
+			expectedError: `exchange weights are only supported by the "weighted_median" strategy`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectedError != "" {
+				require.ErrorIs(t, err, types.ErrInvalidPriceAggregationConfig)
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPriceAggregationConfig_RejectOutliers(t *testing.T) {
+	// The median of the exchange prices is 1_100.
+	exchangePrices := map[string]uint64{
+		"Binance":  990,
+		"Kraken":   1_100,
+		"Bitstamp": 1_210,
+		"Okx":      1_050,
+		"Gate":     2_000,
+	}
+	tests := map[string]struct {
+		maxDeviationPpm uint32
+		exchangePrices  map[string]uint64
+		expectedPrices  map[string]uint64
+	}{
+		"Outlier rejection disabled": {
+			maxDeviationPpm: 0,
+			exchangePrices:  exchangePrices,
+			expectedPrices:  exchangePrices,
+		},
+		"No prices": {
+			maxDeviationPpm: 100_000,
+			exchangePrices:  map[string]uint64{},
+			expectedPrices:  map[string]uint64{},
+		},
+		"Prices exactly at max deviation are accepted": {
+			maxDeviationPpm: 100_000,
+			exchangePrices:  exchangePrices,
+			expectedPrices: map[string]uint64{
+				"Binance":  990,
+				"Kraken":   1_100,
+				"Bitstamp": 1_210,
+				"Okx":      1_050,
+			},
+		},
+		"Only the median is accepted": {
+			maxDeviationPpm: 1,
+			exchangePrices:  exchangePrices,
+			expectedPrices:  map[string]uint64{"Kraken": 1_100},
+		},
+		"Prices that moved together are accepted": {
+			maxDeviationPpm: 1_000,
+			exchangePrices: map[string]uint64{
+				"Binance": 5_000,
+				"Kraken":  5_001,
+				"Okx":     5_002,
+			},
+			expectedPrices: map[string]uint64{
+				"Binance": 5_000,
+				"Kraken":  5_001,
+				"Okx":     5_002,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := types.PriceAggregationConfig{MaxDeviationPpm: tc.maxDeviationPpm}
+			require.Equal(t, tc.expectedPrices, config.RejectOutliers(tc.exchangePrices))
+		})
+	}
+}
+
+func TestPriceAggregationConfig_Aggregate(t *testing.T) {
+	exchangePrices := map[string]uint64{
+		"Binance":  1_000,
+		"Kraken":   1_010,
+		"Bitstamp": 1_020,
+		"Okx":      2_000,
+	}
+	tests := map[string]struct {
+		config          types.PriceAggregationConfig
+		exchangePrices  map[string]uint64
+		exchangeVolumes map[string]uint64
+		expectedPrice   uint64
+		expectedError   string
+	}{
+		"Default is median": {
+			config:         types.PriceAggregationConfig{},
+			exchangePrices: exchangePrices,
+			expectedPrice:  1_015,
+		},
+		"Median": {
+			config:         types.PriceAggregationConfig{Strategy: types.PriceAggregationStrategyMedian},
+			exchangePrices: exchangePrices,
+			expectedPrice:  1_015,
+		},
+		"Weighted median without weights is median": {
+			config:         types.PriceAggregationConfig{Strategy: types.PriceAggregationStrategyWeightedMedian},
+			exchangePrices: exchangePrices,
+			expectedPrice:  1_015,
+		},
+		"Weighted median": {
+			config: types.PriceAggregationConfig{
+				Strategy:        types.PriceAggregationStrategyWeightedMedian,
+				ExchangeWeights: map[string]uint32{"Binance": 3},
+			},
+			exchangePrices: exchangePrices,
+			expectedPrice:  1_005,
+		},
+		"Volume-weighted median": {
+			config:          types.PriceAggregationConfig{Strategy: types.PriceAggregationStrategyWeightedMedian},
+			exchangePrices:  exchangePrices,
+			exchangeVolumes: map[string]uint64{"Binance": 1, "Kraken": 1, "Bitstamp": 1, "Okx": 10},
+			expectedPrice:   2_000,
+		},
+		"Volume-weighted median scaled by weights": {
+			config: types.PriceAggregationConfig{
+				Strategy:        types.PriceAggregationStrategyWeightedMedian,
+				ExchangeWeights: map[string]uint32{"Binance": 20},
+			},
+			exchangePrices:  exchangePrices,
+			exchangeVolumes: map[string]uint64{"Binance": 1, "Kraken": 1, "Bitstamp": 1, "Okx": 10},
+			expectedPrice:   1_000,
+		},
+		"Volume-weighted median saturates weights": {
+			config: types.PriceAggregationConfig{
+				Strategy:        types.PriceAggregationStrategyWeightedMedian,
+				ExchangeWeights: map[string]uint32{"Okx": 2},
+			},
+			exchangePrices:  exchangePrices,
+			exchangeVolumes: map[string]uint64{"Binance": 1, "Kraken": 1, "Bitstamp": 1, "Okx": math.MaxUint64},
+			expectedPrice:   2_000,
+		},
+		"Volumes are ignored unless all exchanges report a volume": {
+			config:          types.PriceAggregationConfig{Strategy: types.PriceAggregationStrategyWeightedMedian},
+			exchangePrices:  exchangePrices,
+			exchangeVolumes: map[string]uint64{"Okx": 10},
+			expectedPrice:   1_015,
+		},
+		"Volumes are ignored by the median": {
+			config:          types.PriceAggregationConfig{},
+			exchangePrices:  exchangePrices,
+			exchangeVolumes: map[string]uint64{"Binance": 1, "Kraken": 1, "Bitstamp": 1, "Okx": 10},
+			expectedPrice:   1_015,
+		},
+		"Trimmed mean": {
+			config: types.PriceAggregationConfig{
+				Strategy: types.PriceAggregationStrategyTrimmedMean,
+				TrimPpm:  250_000,
+			},
+			exchangePrices: exchangePrices,
+			expectedPrice:  1_015,
+		},
+		"Untrimmed mean": {
+			config:         types.PriceAggregationConfig{Strategy: types.PriceAggregationStrategyTrimmedMean},
+			exchangePrices: exchangePrices,
+			expectedPrice:  1_258,
+		},
+		"No prices": {
+			config:         types.PriceAggregationConfig{},
+			exchangePrices: map[string]uint64{},
+			expectedError:  "input cannot be empty",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			price, err := tc.config.Aggregate(tc.exchangePrices, tc.exchangeVolumes)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedPrice, price)
+			}
+		})
+	}
+}
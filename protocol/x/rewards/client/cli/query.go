@@ -21,6 +21,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryAllRewardPrograms())
+	cmd.AddCommand(CmdQueryAccruedRewards())
 
 	return cmd
 }
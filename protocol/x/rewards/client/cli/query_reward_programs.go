@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
+)
+
+func CmdQueryAllRewardPrograms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-reward-programs",
+		Short: "list all reward programs and their payout status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllRewardPrograms(cmd.Context(), &types.QueryAllRewardProgramsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAccruedRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accrued-rewards [address]",
+		Short: "shows the accrued-but-unpaid rewards of an address in each reward program",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccruedRewards(
+				cmd.Context(),
+				&types.QueryAccruedRewardsRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,12 +13,27 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+
+	for _, program := range genState.RewardPrograms {
+		if err := k.SetRewardProgram(ctx, program); err != nil {
+			panic(err)
+		}
+	}
+	for _, status := range genState.RewardProgramStatuses {
+		k.SetRewardProgramStatus(ctx, status)
+	}
+	for _, share := range genState.RewardProgramShares {
+		k.SetRewardProgramShare(ctx, share)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.RewardPrograms = append(genesis.RewardPrograms, k.GetAllRewardPrograms(ctx)...)
+	genesis.RewardProgramStatuses = append(genesis.RewardProgramStatuses, k.GetAllRewardProgramStatuses(ctx)...)
+	genesis.RewardProgramShares = append(genesis.RewardProgramShares, k.GetAllRewardProgramShares(ctx)...)
 
 	return genesis
 }
@@ -2,16 +2,44 @@ package rewards_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/rewards"
 	"github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		RewardPrograms: []types.RewardProgram{
+			{
+				Id:             1,
+				StartTime:      startTime,
+				EndTime:        startTime.Add(time.Hour),
+				Budget:         dtypes.NewInt(1_000),
+				ClobPairIds:    []uint32{0},
+				TakerWeightPpm: 1_000_000,
+			},
+		},
+		RewardProgramStatuses: []types.RewardProgramStatus{
+			{
+				ProgramId:      1,
+				Distributed:    dtypes.NewInt(100),
+				LastPayoutTime: startTime.Add(time.Minute),
+			},
+		},
+		RewardProgramShares: []types.RewardProgramShare{
+			{
+				ProgramId: 1,
+				Address:   constants.AliceAccAddress.String(),
+				Weight:    dtypes.NewInt(50),
+			},
+		},
 	}
 
 	tApp := testapp.NewTestAppBuilder(t).Build()
@@ -21,4 +49,5 @@ func TestGenesis(t *testing.T) {
 	rewards.InitGenesis(ctx, k, genesisState)
 	got := rewards.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
 }
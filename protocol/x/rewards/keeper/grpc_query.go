@@ -19,3 +19,40 @@ func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*t
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) AllRewardPrograms(
+	goCtx context.Context,
+	req *types.QueryAllRewardProgramsRequest,
+) (*types.QueryAllRewardProgramsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	programs := make([]types.QueryAllRewardProgramsResponse_RewardProgramWithStatus, 0)
+	for _, program := range k.GetAllRewardPrograms(ctx) {
+		programs = append(programs, types.QueryAllRewardProgramsResponse_RewardProgramWithStatus{
+			Program: program,
+			Status:  k.GetRewardProgramStatus(ctx, program.Id),
+		})
+	}
+
+	return &types.QueryAllRewardProgramsResponse{Programs: programs}, nil
+}
+
+func (k Keeper) AccruedRewards(
+	goCtx context.Context,
+	req *types.QueryAccruedRewardsRequest,
+) (*types.QueryAccruedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryAccruedRewardsResponse{
+		AccruedRewards: k.GetAccruedRewardsForAddress(ctx, req.Address),
+	}, nil
+}
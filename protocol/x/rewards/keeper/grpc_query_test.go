@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
 	"github.com/stretchr/testify/require"
@@ -44,3 +47,96 @@ func TestQueryParams(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryAllRewardPrograms(t *testing.T) {
+	tApp, ctx, k := setupRewardProgramTestApp(t, 10_000)
+
+	res, err := k.AllRewardPrograms(ctx, &types.QueryAllRewardProgramsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Programs)
+
+	require.NoError(t, k.SetRewardProgram(ctx, TestRewardProgram))
+	ctx = withStatsEpochStart(tApp, ctx, TestRewardProgramStartTime.Add(time.Hour))
+	require.NoError(t, k.MaybeProcessRewardProgramsForEpoch(ctx))
+
+	res, err = k.AllRewardPrograms(ctx, &types.QueryAllRewardProgramsRequest{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]types.QueryAllRewardProgramsResponse_RewardProgramWithStatus{
+			{
+				Program: TestRewardProgram,
+				Status: types.RewardProgramStatus{
+					ProgramId:      TestRewardProgram.Id,
+					Distributed:    dtypes.NewInt(0),
+					LastPayoutTime: TestRewardProgramStartTime.Add(time.Hour),
+				},
+			},
+		},
+		res.Programs,
+	)
+
+	_, err = k.AllRewardPrograms(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestQueryAccruedRewards(t *testing.T) {
+	_, ctx, k := setupRewardProgramTestApp(t, 10_000)
+	require.NoError(t, k.SetRewardProgram(ctx, TestRewardProgram))
+	k.AddRewardSharesForFill(
+		ctx.WithBlockTime(TestRewardProgramStartTime),
+		0,
+		TestAddress1,
+		TestAddress2,
+		big.NewInt(100),
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+	// 1_000 * 1h / 10h = 100 would be released at this block time.
+	ctx = ctx.WithBlockTime(TestRewardProgramStartTime.Add(time.Hour))
+
+	for name, tc := range map[string]struct {
+		req *types.QueryAccruedRewardsRequest
+		res *types.QueryAccruedRewardsResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QueryAccruedRewardsRequest{Address: TestAddress1},
+			res: &types.QueryAccruedRewardsResponse{
+				AccruedRewards: []types.QueryAccruedRewardsResponse_AccruedReward{
+					{
+						ProgramId:       TestRewardProgram.Id,
+						Weight:          dtypes.NewInt(100),
+						TotalWeight:     dtypes.NewInt(150),
+						EstimatedAmount: dtypes.NewInt(66),
+					},
+				},
+			},
+		},
+		"Success: no accrued rewards": {
+			req: &types.QueryAccruedRewardsRequest{Address: TestAddress3},
+			res: &types.QueryAccruedRewardsResponse{
+				AccruedRewards: []types.QueryAccruedRewardsResponse_AccruedReward{},
+			},
+		},
+		"Invalid address": {
+			req: &types.QueryAccruedRewardsRequest{Address: "invalid"},
+			err: status.Error(codes.InvalidArgument, "decoding bech32 failed: invalid bech32 string length 7"),
+		},
+		"Nil": {
+			req: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.AccruedRewards(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
@@ -37,6 +37,8 @@ type (
 		feeTiersKeeper types.FeeTiersKeeper
 		// Neeeded for retrieve market price of rewards token.
 		pricesKeeper types.PricesKeeper
+		// Needed for paying out reward programs at epoch boundaries.
+		epochsKeeper types.EpochsKeeper
 
 		// the addresses capable of executing a MsgUpdateParams message.
 		authorities map[string]struct{}
@@ -51,6 +53,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	feeTiersKeeper types.FeeTiersKeeper,
 	pricesKeeper types.PricesKeeper,
+	epochsKeeper types.EpochsKeeper,
 	authorities []string,
 ) *Keeper {
 	return &Keeper{
@@ -61,6 +64,7 @@ func NewKeeper(
 		bankKeeper:        bankKeeper,
 		feeTiersKeeper:    feeTiersKeeper,
 		pricesKeeper:      pricesKeeper,
+		epochsKeeper:      epochsKeeper,
 		authorities:       lib.UniqueSliceToSet(authorities),
 	}
 }
@@ -109,25 +113,32 @@ func (k Keeper) GetRewardShare(
 }
 
 // Add reward shares for the maker and taker of a fill. Intended for being called in `x/clob` when a fill is persisted.
+// Reward shares are also accrued in every active reward program that the CLOB pair of the fill is eligible for.
 //
 // Within each block, total reward share score for an address is defined as:
 //
-//	reward_share_score = total_taker_fees_paid - max_possible_maker_rebate*taker_volume + total_positive_maker_fees
+//	reward_share_score = total_taker_fees_paid - max_possible_maker_rebate*taker_volume - referral_rebates_paid +
+//	                     total_positive_maker_fees
 //
 // Hence, for each fill, increment reward share score as follow:
 //   - For maker address, positive maker fees are added directly.
 //   - For taker address, positive taker fees are reduced by the largest possible maker rebate in x/fee-tiers multiplied
-//     by quote quantums of the fill.
+//     by quote quantums of the fill, and by the rebate paid to the taker's referrer out of the taker fee, since
+//     neither is fee revenue of the protocol.
 func (k Keeper) AddRewardSharesForFill(
 	ctx sdk.Context,
+	clobPairId uint32,
 	takerAddress string,
 	makerAddress string,
 	bigFillQuoteQuantums *big.Int,
 	bigTakerFeeQuoteQuantums *big.Int,
 	bigMakerFeeQuoteQuantums *big.Int,
+	bigReferralRebateQuoteQuantums *big.Int,
 ) {
+	k.addRewardProgramSharesForFill(ctx, clobPairId, takerAddress, makerAddress, bigFillQuoteQuantums)
+
 	// Process reward weight for taker.
-	lowestMakerFee := k.feeTiersKeeper.GetLowestMakerFee(ctx)
+	lowestMakerFee := k.feeTiersKeeper.GetLowestMakerFee(ctx, clobPairId)
 	maxMakerRebatePpm := lib.Min(int32(0), lowestMakerFee)
 	// Calculate quote_quantums * max_maker_rebate. Result is non-positive.
 	makerRebateMulTakerVolume := lib.BigIntMulSignedPpm(bigFillQuoteQuantums, maxMakerRebatePpm, false)
@@ -135,6 +146,7 @@ func (k Keeper) AddRewardSharesForFill(
 		bigTakerFeeQuoteQuantums,
 		makerRebateMulTakerVolume,
 	)
+	takerWeight.Sub(takerWeight, bigReferralRebateQuoteQuantums)
 	if takerWeight.Cmp(lib.BigInt0()) > 0 {
 		// We aren't concerned with errors here because we've already validated the weight is positive.
 		if err := k.AddRewardShareToAddress(
@@ -237,10 +249,12 @@ func (k Keeper) getAllRewardSharesAndTotalWeight(ctx sdk.Context) (
 //
 // where:
 //
-//	`T` is the amount of available reward tokens in the `treasury_account`.
+//	`T` is the amount of available reward tokens in the `treasury_account`, excluding the budgets of
+//	    reward programs that have not been distributed yet (see `GetRewardProgramsReservedAmount`).
 //	`F` = fee_multiplier * (total_positive_maker_fees +
 //		                    total taker fees -
-//		                    maximum possible maker rebate * total taker volume)
+//		                    maximum possible maker rebate * total taker volume -
+//		                    total referral rebates)
 //	                     / reward_token_price
 func (k Keeper) ProcessRewardsForBlock(
 	ctx sdk.Context,
@@ -283,11 +297,12 @@ func (k Keeper) ProcessRewardsForBlock(
 	)
 	bigIntRewardTokenAmount := lib.BigRatRound(bigRatRewardTokenAmount, false)
 
-	// Calculate value of `T`, the reward tokens balance in the `treasury_account`.
-	rewardTokenBalance := k.bankKeeper.GetBalance(ctx, types.TreasuryModuleAddress, params.Denom)
+	// Calculate value of `T`, the reward tokens balance in the `treasury_account` not reserved for
+	// reward programs.
+	rewardTokenBalance := k.getUnreservedTreasuryBalance(ctx, params.Denom)
 
 	// Get tokenToDistribute as the min(F, T).
-	tokensToDistribute := lib.BigMin(rewardTokenBalance.Amount.BigInt(), bigIntRewardTokenAmount)
+	tokensToDistribute := lib.BigMin(rewardTokenBalance, bigIntRewardTokenAmount)
 	// Measure distributed token amount.
 	telemetry.SetGauge(
 		metrics.GetMetricValueFromBigInt(tokensToDistribute),
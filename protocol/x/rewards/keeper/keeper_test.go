@@ -149,6 +149,7 @@ func TestAddRewardSharesForFill(t *testing.T) {
 		fillQuoteQuantums    *big.Int
 		takerFeeQuantums     *big.Int
 		makerFeeQuantums     *big.Int
+		referralRebate       *big.Int
 		feeTiers             []*feetierstypes.PerpetualFeeTier
 
 		expectedTakerShare types.RewardShare
@@ -238,6 +239,50 @@ func TestAddRewardSharesForFill(t *testing.T) {
 				Weight:  dtypes.NewInt(500_000),
 			},
 		},
+		"positive maker fee, positive taker fees reduced by maker rebate and referral rebate, no previous share": {
+			prevTakerRewardShare: nil,
+			prevMakerRewardShare: nil,
+			fillQuoteQuantums:    big.NewInt(800_000_000), // $800
+			takerFeeQuantums:     big.NewInt(2_000_000),   // $2
+			makerFeeQuantums:     big.NewInt(1_000_000),   // $1
+			referralRebate:       big.NewInt(400_000),     // $0.4
+			feeTiers: []*feetierstypes.PerpetualFeeTier{
+				{
+					MakerFeePpm: -1_000, // -0.1%
+					TakerFeePpm: 2_000,  // 0.2%
+				},
+			},
+			expectedTakerShare: types.RewardShare{
+				Address: takerAdderss,
+				Weight:  dtypes.NewInt(800_000), // 2 - 0.1% * 800 - 0.4
+			},
+			expectedMakerShare: types.RewardShare{
+				Address: makerAddress,
+				Weight:  dtypes.NewInt(1_000_000),
+			},
+		},
+		"positive maker fee, positive taker fees offset by maker rebate and referral rebate, no previous share": {
+			prevTakerRewardShare: nil,
+			prevMakerRewardShare: nil,
+			fillQuoteQuantums:    big.NewInt(800_000_000), // $800
+			takerFeeQuantums:     big.NewInt(1_000_000),   // $1
+			makerFeeQuantums:     big.NewInt(1_000_000),   // $1
+			referralRebate:       big.NewInt(300_000),     // $0.3
+			feeTiers: []*feetierstypes.PerpetualFeeTier{
+				{
+					MakerFeePpm: -1_000, // -0.1%
+					TakerFeePpm: 2_000,  // 0.2%
+				},
+			},
+			expectedTakerShare: types.RewardShare{
+				Address: takerAdderss,
+				Weight:  dtypes.NewInt(0), // $1 - $800 * 0.1% - $0.3 < 0
+			},
+			expectedMakerShare: types.RewardShare{
+				Address: makerAddress,
+				Weight:  dtypes.NewInt(1_000_000),
+			},
+		},
 		"positive maker fee, positive taker fees, no maker rebate, no previous share": {
 			prevTakerRewardShare: nil,
 			prevMakerRewardShare: nil,
@@ -268,6 +313,11 @@ func TestAddRewardSharesForFill(t *testing.T) {
 			ctx := tApp.InitChain()
 			k := tApp.App.RewardsKeeper
 
+			referralRebate := big.NewInt(0)
+			if tc.referralRebate != nil {
+				referralRebate = tc.referralRebate
+			}
+
 			feeTiersKeeper := tApp.App.FeeTiersKeeper
 			err := feeTiersKeeper.SetPerpetualFeeParams(ctx, feetierstypes.PerpetualFeeParams{
 				Tiers: tc.feeTiers,
@@ -285,11 +335,13 @@ func TestAddRewardSharesForFill(t *testing.T) {
 
 			k.AddRewardSharesForFill(
 				ctx,
+				0,
 				takerAdderss,
 				makerAddress,
 				tc.fillQuoteQuantums,
 				tc.takerFeeQuantums,
 				tc.makerFeeQuantums,
+				referralRebate,
 			)
 
 			// Check the new reward shares.
@@ -40,3 +40,43 @@ func (k msgServer) UpdateParams(
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k msgServer) SetRewardProgram(
+	goCtx context.Context,
+	msg *types.MsgSetRewardProgram,
+) (*types.MsgSetRewardProgramResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ReserveRewardProgramBudget(ctx, msg.Program); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRewardProgramResponse{}, nil
+}
+
+func (k msgServer) DeleteRewardProgram(
+	goCtx context.Context,
+	msg *types.MsgDeleteRewardProgram,
+) (*types.MsgDeleteRewardProgramResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.DeleteRewardProgram(ctx, msg.ProgramId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteRewardProgramResponse{}, nil
+}
@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"context"
-	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"math/big"
 	"testing"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 
@@ -80,3 +83,72 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetRewardProgram(t *testing.T) {
+	_, goCtx, k := setupRewardProgramTestApp(t, 1_500)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := sdk.WrapSDKContext(goCtx)
+
+	_, err := ms.SetRewardProgram(ctx, &types.MsgSetRewardProgram{
+		Authority: "invalid",
+		Program:   TestRewardProgram,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = ms.SetRewardProgram(ctx, &types.MsgSetRewardProgram{
+		Authority: lib.GovModuleAddress.String(),
+		Program:   TestRewardProgram,
+	})
+	require.NoError(t, err)
+	program, found := k.GetRewardProgram(goCtx, TestRewardProgram.Id)
+	require.True(t, found)
+	require.Equal(t, TestRewardProgram, program)
+	require.Equal(t, big.NewInt(1_000), k.GetRewardProgramsReservedAmount(goCtx))
+
+	// The budget already reserved for a program is available when updating it.
+	updatedProgram := TestRewardProgram
+	updatedProgram.Budget = dtypes.NewInt(1_500)
+	_, err = ms.SetRewardProgram(ctx, &types.MsgSetRewardProgram{
+		Authority: lib.GovModuleAddress.String(),
+		Program:   updatedProgram,
+	})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1_500), k.GetRewardProgramsReservedAmount(goCtx))
+
+	// The budget of a new program cannot exceed the unreserved treasury balance.
+	otherProgram := TestRewardProgram
+	otherProgram.Id = 2
+	otherProgram.Budget = dtypes.NewInt(1)
+	_, err = ms.SetRewardProgram(ctx, &types.MsgSetRewardProgram{
+		Authority: lib.GovModuleAddress.String(),
+		Program:   otherProgram,
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientTreasuryBalance)
+	_, found = k.GetRewardProgram(goCtx, otherProgram.Id)
+	require.False(t, found)
+}
+
+func TestMsgDeleteRewardProgram(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	require.NoError(t, k.SetRewardProgram(sdk.UnwrapSDKContext(ctx), TestRewardProgram))
+
+	_, err := ms.DeleteRewardProgram(ctx, &types.MsgDeleteRewardProgram{
+		Authority: "invalid",
+		ProgramId: TestRewardProgram.Id,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = ms.DeleteRewardProgram(ctx, &types.MsgDeleteRewardProgram{
+		Authority: lib.GovModuleAddress.String(),
+		ProgramId: TestRewardProgram.Id,
+	})
+	require.NoError(t, err)
+	_, found := k.GetRewardProgram(sdk.UnwrapSDKContext(ctx), TestRewardProgram.Id)
+	require.False(t, found)
+
+	_, err = ms.DeleteRewardProgram(ctx, &types.MsgDeleteRewardProgram{
+		Authority: lib.GovModuleAddress.String(),
+		ProgramId: TestRewardProgram.Id,
+	})
+	require.ErrorIs(t, err, types.ErrRewardProgramNotFound)
+}
@@ -0,0 +1,568 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	gometrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
+)
+
+// GetRewardProgram returns the reward program with the given id.
+func (k Keeper) GetRewardProgram(ctx sdk.Context, id uint32) (
+	val types.RewardProgram,
+	found bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRewardPrograms returns all reward programs, sorted by id.
+func (k Keeper) GetAllRewardPrograms(ctx sdk.Context) (list []types.RewardProgram) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RewardProgram
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return list
+}
+
+// SetRewardProgram validates and sets a reward program in state. If the program already exists, its
+// payout status and accrued reward shares are kept.
+func (k Keeper) SetRewardProgram(ctx sdk.Context, program types.RewardProgram) error {
+	if err := program.Validate(); err != nil {
+		return err
+	}
+
+	if existingProgram, found := k.GetRewardProgram(ctx, program.Id); found {
+		k.removeRewardProgramFromClobPairIndex(ctx, existingProgram)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramKeyPrefix))
+	b := k.cdc.MustMarshal(&program)
+	store.Set(lib.Uint32ToKey(program.Id), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramByClobPairKeyPrefix))
+	for _, clobPairId := range program.ClobPairIds {
+		indexStore.Set(rewardProgramByClobPairKey(clobPairId, program.Id), lib.Uint32ToKey(program.Id))
+	}
+	return nil
+}
+
+// rewardProgramByClobPairKey returns the key of a reward program in the index of reward programs by
+// CLOB pair, which is the CLOB pair id followed by the program id.
+func rewardProgramByClobPairKey(clobPairId uint32, id uint32) []byte {
+	return append(lib.Uint32ToKey(clobPairId), lib.Uint32ToKey(id)...)
+}
+
+// removeRewardProgramFromClobPairIndex removes a reward program from the index of reward programs by
+// CLOB pair.
+func (k Keeper) removeRewardProgramFromClobPairIndex(ctx sdk.Context, program types.RewardProgram) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramByClobPairKeyPrefix))
+	for _, clobPairId := range program.ClobPairIds {
+		indexStore.Delete(rewardProgramByClobPairKey(clobPairId, program.Id))
+	}
+}
+
+// getRewardProgramsForClobPair returns all reward programs that the CLOB pair is eligible for, sorted
+// by id.
+func (k Keeper) getRewardProgramsForClobPair(ctx sdk.Context, clobPairId uint32) (list []types.RewardProgram) {
+	indexStore := prefix.NewStore(
+		prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramByClobPairKeyPrefix)),
+		lib.Uint32ToKey(clobPairId),
+	)
+	iterator := sdk.KVStorePrefixIterator(indexStore, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := binary.BigEndian.Uint32(iterator.Value())
+		program, found := k.GetRewardProgram(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("reward program %d in index of CLOB pair %d not found", id, clobPairId))
+		}
+		list = append(list, program)
+	}
+	return list
+}
+
+// DeleteRewardProgram deletes a reward program along with its payout status and accrued reward
+// shares. Accrued-but-unpaid rewards of the program are not paid out.
+func (k Keeper) DeleteRewardProgram(ctx sdk.Context, id uint32) error {
+	if _, found := k.GetRewardProgram(ctx, id); !found {
+		return errorsmod.Wrapf(types.ErrRewardProgramNotFound, "id: %d", id)
+	}
+
+	k.removeRewardProgram(ctx, id)
+	return nil
+}
+
+// removeRewardProgram removes a reward program along with its payout status and accrued reward shares
+// from state.
+func (k Keeper) removeRewardProgram(ctx sdk.Context, id uint32) {
+	if program, found := k.GetRewardProgram(ctx, id); found {
+		k.removeRewardProgramFromClobPairIndex(ctx, program)
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramKeyPrefix)).Delete(lib.Uint32ToKey(id))
+	prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramStatusKeyPrefix)).Delete(lib.Uint32ToKey(id))
+	k.clearRewardProgramShares(ctx, id)
+}
+
+// GetRewardProgramStatus returns the payout status of the reward program with the given id. If the
+// program has not paid out yet, a status with nothing distributed is returned.
+func (k Keeper) GetRewardProgramStatus(ctx sdk.Context, id uint32) (val types.RewardProgramStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramStatusKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(id))
+	if b == nil {
+		return types.RewardProgramStatus{
+			ProgramId:   id,
+			Distributed: dtypes.NewInt(0),
+		}
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllRewardProgramStatuses returns the payout statuses of all reward programs that have paid out
+// at least once, sorted by program id.
+func (k Keeper) GetAllRewardProgramStatuses(ctx sdk.Context) (list []types.RewardProgramStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramStatusKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RewardProgramStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return list
+}
+
+// SetRewardProgramStatus sets the payout status of a reward program in state.
+func (k Keeper) SetRewardProgramStatus(ctx sdk.Context, status types.RewardProgramStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramStatusKeyPrefix))
+	b := k.cdc.MustMarshal(&status)
+	store.Set(lib.Uint32ToKey(status.ProgramId), b)
+}
+
+func (k Keeper) getRewardProgramSharesStore(ctx sdk.Context, id uint32) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramShareKeyPrefix))
+	return prefix.NewStore(store, lib.Uint32ToKey(id))
+}
+
+// GetRewardProgramShare returns the accrued-but-unpaid reward share of an address in a reward program.
+// If the address has not accrued a reward share, a share with 0 weight is returned.
+func (k Keeper) GetRewardProgramShare(
+	ctx sdk.Context,
+	id uint32,
+	address string,
+) (val types.RewardProgramShare) {
+	b := k.getRewardProgramSharesStore(ctx, id).Get([]byte(address))
+	if b == nil {
+		return types.RewardProgramShare{
+			ProgramId: id,
+			Address:   address,
+			Weight:    dtypes.NewInt(0),
+		}
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllRewardProgramShares returns the accrued-but-unpaid reward shares of all addresses in all
+// reward programs, sorted by program id and address.
+func (k Keeper) GetAllRewardProgramShares(ctx sdk.Context) (list []types.RewardProgramShare) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RewardProgramShareKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RewardProgramShare
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return list
+}
+
+// SetRewardProgramShare sets the accrued-but-unpaid reward share of an address in a reward program.
+func (k Keeper) SetRewardProgramShare(ctx sdk.Context, share types.RewardProgramShare) {
+	b := k.cdc.MustMarshal(&share)
+	k.getRewardProgramSharesStore(ctx, share.ProgramId).Set([]byte(share.Address), b)
+}
+
+func (k Keeper) getAllRewardProgramSharesAndTotalWeight(ctx sdk.Context, id uint32) (
+	list []types.RewardProgramShare,
+	totalWeight *big.Int,
+) {
+	iterator := sdk.KVStorePrefixIterator(k.getRewardProgramSharesStore(ctx, id), []byte{})
+	defer iterator.Close()
+	totalWeight = big.NewInt(0)
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RewardProgramShare
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+		totalWeight.Add(totalWeight, val.Weight.BigInt())
+	}
+	return list, totalWeight
+}
+
+func (k Keeper) clearRewardProgramShares(ctx sdk.Context, id uint32) {
+	store := k.getRewardProgramSharesStore(ctx, id)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// addRewardProgramShareToAddress increments the accrued reward share of an address in a reward program.
+func (k Keeper) addRewardProgramShareToAddress(
+	ctx sdk.Context,
+	id uint32,
+	address string,
+	weight *big.Int,
+) {
+	if weight.Sign() <= 0 {
+		return
+	}
+
+	share := k.GetRewardProgramShare(ctx, id, address)
+	share.Weight = dtypes.NewIntFromBigInt(new(big.Int).Add(share.Weight.BigInt(), weight))
+	k.SetRewardProgramShare(ctx, share)
+}
+
+// addRewardProgramSharesForFill accrues reward shares for the maker and taker of a fill in every reward
+// program that is active at the current block time and that the CLOB pair of the fill is eligible for.
+// The reward share of the maker and taker is the notional of the fill multiplied by the maker and taker
+// weights of the program, respectively. Only the programs of the CLOB pair are read from state, and
+// programs that have ended are removed at the next `stats-epoch` payout.
+func (k Keeper) addRewardProgramSharesForFill(
+	ctx sdk.Context,
+	clobPairId uint32,
+	takerAddress string,
+	makerAddress string,
+	bigFillQuoteQuantums *big.Int,
+) {
+	blockTime := ctx.BlockTime()
+	for _, program := range k.getRewardProgramsForClobPair(ctx, clobPairId) {
+		if blockTime.Before(program.StartTime) || !blockTime.Before(program.EndTime) {
+			continue
+		}
+
+		k.addRewardProgramShareToAddress(
+			ctx,
+			program.Id,
+			takerAddress,
+			lib.BigIntMulPpm(bigFillQuoteQuantums, program.TakerWeightPpm),
+		)
+		k.addRewardProgramShareToAddress(
+			ctx,
+			program.Id,
+			makerAddress,
+			lib.BigIntMulPpm(bigFillQuoteQuantums, program.MakerWeightPpm),
+		)
+	}
+}
+
+// getRewardProgramRemainingBudget returns the part of the budget of a reward program that has not been
+// distributed yet.
+func getRewardProgramRemainingBudget(
+	program types.RewardProgram,
+	status types.RewardProgramStatus,
+) *big.Int {
+	remainingBudget := new(big.Int).Sub(program.Budget.BigInt(), status.Distributed.BigInt())
+	if remainingBudget.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return remainingBudget
+}
+
+// GetRewardProgramsReservedAmount returns the amount of the rewards token in the treasury account that
+// is reserved for reward programs, which is the sum of the remaining budgets of all reward programs.
+// Reserved tokens are only paid out by reward programs and not by the per-block trading rewards.
+func (k Keeper) GetRewardProgramsReservedAmount(ctx sdk.Context) *big.Int {
+	reservedAmount := big.NewInt(0)
+	for _, program := range k.GetAllRewardPrograms(ctx) {
+		reservedAmount.Add(
+			reservedAmount,
+			getRewardProgramRemainingBudget(program, k.GetRewardProgramStatus(ctx, program.Id)),
+		)
+	}
+	return reservedAmount
+}
+
+// getUnreservedTreasuryBalance returns the balance of `denom` in the treasury account that is not reserved
+// for reward programs.
+func (k Keeper) getUnreservedTreasuryBalance(ctx sdk.Context, denom string) *big.Int {
+	treasuryBalance := k.bankKeeper.GetBalance(ctx, types.TreasuryModuleAddress, denom)
+	unreservedBalance := new(big.Int).Sub(treasuryBalance.Amount.BigInt(), k.GetRewardProgramsReservedAmount(ctx))
+	if unreservedBalance.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return unreservedBalance
+}
+
+// ReserveRewardProgramBudget checks that the treasury account holds enough unreserved rewards tokens to
+// reserve the remaining budget of a reward program that is created or updated, and sets the program in
+// state. If the program already exists, the budget already reserved for it is taken into account.
+func (k Keeper) ReserveRewardProgramBudget(ctx sdk.Context, program types.RewardProgram) error {
+	status := k.GetRewardProgramStatus(ctx, program.Id)
+	budgetToReserve := getRewardProgramRemainingBudget(program, status)
+	availableBalance := k.getUnreservedTreasuryBalance(ctx, k.GetParams(ctx).Denom)
+	if existingProgram, found := k.GetRewardProgram(ctx, program.Id); found {
+		availableBalance.Add(availableBalance, getRewardProgramRemainingBudget(existingProgram, status))
+	}
+
+	if budgetToReserve.Cmp(availableBalance) > 0 {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientTreasuryBalance,
+			"remaining budget %v of reward program %d exceeds unreserved treasury balance %v",
+			budgetToReserve,
+			program.Id,
+			availableBalance,
+		)
+	}
+
+	return k.SetRewardProgram(ctx, program)
+}
+
+// getRewardProgramRelease returns the amount of the budget of a reward program that is released for
+// payout at `payoutTime`, along with the time up to which the budget has then been released. The budget
+// is released linearly over the duration of the program. The amount released is:
+//
+//	min(
+//	  (payout_time - last_payout_time) / (end_time - last_payout_time),
+//	  1
+//	) * (budget - distributed)
+//
+// where `last_payout_time = max(start_time, status.last_payout_time)`. Any part of the budget that was
+// released but not distributed, e.g. because no rewards accrued, is released again over the remaining
+// duration of the program.
+func getRewardProgramRelease(
+	program types.RewardProgram,
+	status types.RewardProgramStatus,
+	payoutTime time.Time,
+) (
+	release *big.Int,
+	releasedUntil time.Time,
+) {
+	lastPayoutTime := program.StartTime
+	if status.LastPayoutTime.After(lastPayoutTime) {
+		lastPayoutTime = status.LastPayoutTime
+	}
+
+	// The program has not started or has already been fully released.
+	if !payoutTime.After(lastPayoutTime) || !program.EndTime.After(lastPayoutTime) {
+		return big.NewInt(0), lastPayoutTime
+	}
+
+	releasedUntil = payoutTime
+	if program.EndTime.Before(payoutTime) {
+		releasedUntil = program.EndTime
+	}
+
+	remainingBudget := getRewardProgramRemainingBudget(program, status)
+	if remainingBudget.Sign() == 0 {
+		return big.NewInt(0), releasedUntil
+	}
+
+	if !payoutTime.Before(program.EndTime) {
+		return remainingBudget, releasedUntil
+	}
+
+	// Convert timestamps to milliseconds for algebraic operations.
+	lastPayoutTimeMilli := lastPayoutTime.UnixMilli()
+	bigRatReleaseProportion := big.NewRat(
+		payoutTime.UnixMilli()-lastPayoutTimeMilli,
+		program.EndTime.UnixMilli()-lastPayoutTimeMilli,
+	)
+	bigRatRelease := new(big.Rat).Mul(new(big.Rat).SetInt(remainingBudget), bigRatReleaseProportion)
+	return lib.BigRatRound(bigRatRelease, false), releasedUntil
+}
+
+// getRewardProgramAmountToDistribute returns the amount of the rewards token a reward program
+// distributes at `payoutTime`, which is the released budget capped at the treasury balance.
+func (k Keeper) getRewardProgramAmountToDistribute(
+	ctx sdk.Context,
+	program types.RewardProgram,
+	status types.RewardProgramStatus,
+	payoutTime time.Time,
+) (
+	amount *big.Int,
+	releasedUntil time.Time,
+) {
+	release, releasedUntil := getRewardProgramRelease(program, status, payoutTime)
+	if release.Sign() == 0 {
+		return release, releasedUntil
+	}
+
+	params := k.GetParams(ctx)
+	treasuryBalance := k.bankKeeper.GetBalance(ctx, types.TreasuryModuleAddress, params.Denom)
+	return lib.BigMin(treasuryBalance.Amount.BigInt(), release), releasedUntil
+}
+
+// GetAccruedRewardsForAddress returns the accrued-but-unpaid rewards of an address in every reward
+// program it has accrued a reward share in. The estimated amount is the amount of the rewards token the
+// address would receive if the program paid out at the current block time.
+func (k Keeper) GetAccruedRewardsForAddress(
+	ctx sdk.Context,
+	address string,
+) []types.QueryAccruedRewardsResponse_AccruedReward {
+	accruedRewards := make([]types.QueryAccruedRewardsResponse_AccruedReward, 0)
+	for _, program := range k.GetAllRewardPrograms(ctx) {
+		share := k.GetRewardProgramShare(ctx, program.Id, address)
+		if share.Weight.BigInt().Sign() == 0 {
+			continue
+		}
+
+		_, totalWeight := k.getAllRewardProgramSharesAndTotalWeight(ctx, program.Id)
+		amount, _ := k.getRewardProgramAmountToDistribute(
+			ctx,
+			program,
+			k.GetRewardProgramStatus(ctx, program.Id),
+			ctx.BlockTime(),
+		)
+		accruedRewards = append(accruedRewards, types.QueryAccruedRewardsResponse_AccruedReward{
+			ProgramId:   program.Id,
+			Weight:      share.Weight,
+			TotalWeight: dtypes.NewIntFromBigInt(totalWeight),
+			EstimatedAmount: dtypes.NewIntFromBigInt(
+				new(big.Int).Div(new(big.Int).Mul(amount, share.Weight.BigInt()), totalWeight),
+			),
+		})
+	}
+	return accruedRewards
+}
+
+// MaybeProcessRewardProgramsForEpoch pays out the accrued reward shares of all reward programs if the
+// current block is the start of a new `stats-epoch`. Otherwise, does nothing.
+//
+// For each program, the budget released since the last payout (see `getRewardProgramRelease`), capped
+// at the balance of the treasury account, is distributed pro rata to the accrued reward shares of the
+// program. The reward shares are then cleared. Programs are removed from state once their entire budget
+// has been released, i.e. at the first payout at or after their end time, so that only programs that
+// can still accrue or pay out rewards are iterated.
+func (k Keeper) MaybeProcessRewardProgramsForEpoch(ctx sdk.Context) error {
+	numBlocks, err := k.epochsKeeper.NumBlocksSinceEpochStart(ctx, epochstypes.StatsEpochInfoName)
+	if err != nil {
+		return err
+	}
+
+	// If the current block is not the start of a new stats-epoch, do nothing.
+	if numBlocks != 0 {
+		return nil
+	}
+
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.ProcessRewardPrograms,
+		metrics.Latency,
+	)
+
+	params := k.GetParams(ctx)
+	for _, program := range k.GetAllRewardPrograms(ctx) {
+		// The program has not started yet.
+		if !ctx.BlockTime().After(program.StartTime) {
+			continue
+		}
+
+		status := k.GetRewardProgramStatus(ctx, program.Id)
+		amountToDistribute, releasedUntil := k.getRewardProgramAmountToDistribute(
+			ctx,
+			program,
+			status,
+			ctx.BlockTime(),
+		)
+
+		// The program has already been fully paid out.
+		if !releasedUntil.After(status.LastPayoutTime) {
+			continue
+		}
+
+		shares, totalWeight := k.getAllRewardProgramSharesAndTotalWeight(ctx, program.Id)
+		bigDistributed := big.NewInt(0)
+		if totalWeight.Sign() > 0 {
+			for _, share := range shares {
+				// Calculate `amountToDistribute` * `share.Weight` / `totalWeight`.
+				// big.Div() rounds down, so the sum of distributed tokens will not exceed `amountToDistribute`.
+				rewardAmountForAddress := new(big.Int).Div(
+					new(big.Int).Mul(amountToDistribute, share.Weight.BigInt()),
+					totalWeight,
+				)
+				if rewardAmountForAddress.Sign() == 0 {
+					continue
+				}
+
+				if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+					ctx,
+					params.TreasuryAccount,
+					sdk.MustAccAddressFromBech32(share.Address),
+					[]sdk.Coin{
+						{
+							Denom:  params.Denom,
+							Amount: sdkmath.NewIntFromBigInt(rewardAmountForAddress),
+						},
+					},
+				); err != nil {
+					k.Logger(ctx).Error(
+						"Failed to send reward program tokens from treasury account to address",
+						"reward_program_id",
+						program.Id,
+						"treasury_account",
+						params.TreasuryAccount,
+						"address",
+						share.Address,
+						constants.ErrorLogKey,
+						err,
+					)
+					continue
+				}
+				bigDistributed.Add(bigDistributed, rewardAmountForAddress)
+			}
+		}
+
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, metrics.DistributedRewardTokens},
+			metrics.GetMetricValueFromBigInt(bigDistributed),
+			[]gometrics.Label{metrics.GetLabelForIntValue(metrics.RewardProgramId, int(program.Id))},
+		)
+
+		// The program has been fully paid out and can no longer accrue or pay out rewards.
+		if !releasedUntil.Before(program.EndTime) {
+			k.removeRewardProgram(ctx, program.Id)
+			continue
+		}
+
+		status.Distributed = dtypes.NewIntFromBigInt(new(big.Int).Add(status.Distributed.BigInt(), bigDistributed))
+		status.LastPayoutTime = releasedUntil
+		k.SetRewardProgramStatus(ctx, status)
+		k.clearRewardProgramShares(ctx, program.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,440 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	cometbfttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/x/rewards/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	TestRewardProgramStartTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	TestRewardProgram          = types.RewardProgram{
+		Id:             1,
+		StartTime:      TestRewardProgramStartTime,
+		EndTime:        TestRewardProgramStartTime.Add(10 * time.Hour),
+		Budget:         dtypes.NewInt(1_000),
+		ClobPairIds:    []uint32{0, 1},
+		MakerWeightPpm: 500_000,
+		TakerWeightPpm: 1_000_000,
+	}
+)
+
+// setupRewardProgramTestApp returns a test app whose rewards treasury holds `treasuryAccountBalance` of
+// `TestRewardTokenDenom`, and whose rewards params distribute `TestRewardTokenDenom`.
+func setupRewardProgramTestApp(
+	t *testing.T,
+	treasuryAccountBalance int64,
+) (*testapp.TestApp, sdk.Context, keeper.Keeper) {
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis cometbfttypes.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		if treasuryAccountBalance == 0 {
+			return genesis
+		}
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *banktypes.GenesisState) {
+				genesisState.Balances = append(genesisState.Balances, banktypes.Balance{
+					Address: types.TreasuryModuleAddress.String(),
+					Coins: []sdk.Coin{
+						sdk.NewCoin(TestRewardTokenDenom, sdkmath.NewInt(treasuryAccountBalance)),
+					},
+				})
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.RewardsKeeper
+
+	params := types.DefaultParams()
+	params.Denom = TestRewardTokenDenom
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return tApp, ctx, k
+}
+
+// withStatsEpochStart returns a context at the start block of the current `stats-epoch` with the given
+// block time.
+func withStatsEpochStart(tApp *testapp.TestApp, ctx sdk.Context, blockTime time.Time) sdk.Context {
+	epochInfo := tApp.App.EpochsKeeper.MustGetStatsEpochInfo(ctx)
+	return ctx.WithBlockHeight(int64(epochInfo.CurrentEpochStartBlock)).WithBlockTime(blockTime)
+}
+
+func TestRewardProgramStorage(t *testing.T) {
+	_, ctx, k := setupRewardProgramTestApp(t, 0)
+
+	_, found := k.GetRewardProgram(ctx, TestRewardProgram.Id)
+	require.False(t, found)
+	require.Empty(t, k.GetAllRewardPrograms(ctx))
+
+	otherProgram := TestRewardProgram
+	otherProgram.Id = 2
+	otherProgram.ClobPairIds = []uint32{2}
+	require.NoError(t, k.SetRewardProgram(ctx, otherProgram))
+	require.NoError(t, k.SetRewardProgram(ctx, TestRewardProgram))
+
+	program, found := k.GetRewardProgram(ctx, TestRewardProgram.Id)
+	require.True(t, found)
+	require.Equal(t, TestRewardProgram, program)
+	require.Equal(t, []types.RewardProgram{TestRewardProgram, otherProgram}, k.GetAllRewardPrograms(ctx))
+
+	// Invalid programs are not set.
+	invalidProgram := TestRewardProgram
+	invalidProgram.Budget = dtypes.NewInt(0)
+	require.ErrorIs(t, k.SetRewardProgram(ctx, invalidProgram), types.ErrInvalidRewardProgram)
+
+	// Updating a program keeps its accrued reward shares.
+	ctx = ctx.WithBlockTime(TestRewardProgramStartTime)
+	k.AddRewardSharesForFill(ctx, 0, TestAddress1, TestAddress2, big.NewInt(100), big.NewInt(0), big.NewInt(0), big.NewInt(0))
+	updatedProgram := TestRewardProgram
+	updatedProgram.Budget = dtypes.NewInt(2_000)
+	require.NoError(t, k.SetRewardProgram(ctx, updatedProgram))
+	require.Equal(t, dtypes.NewInt(100), k.GetRewardProgramShare(ctx, TestRewardProgram.Id, TestAddress1).Weight)
+
+	// Deleting a program removes its accrued reward shares.
+	require.NoError(t, k.DeleteRewardProgram(ctx, TestRewardProgram.Id))
+	_, found = k.GetRewardProgram(ctx, TestRewardProgram.Id)
+	require.False(t, found)
+	require.Equal(t, dtypes.NewInt(0), k.GetRewardProgramShare(ctx, TestRewardProgram.Id, TestAddress1).Weight)
+	require.Equal(t, []types.RewardProgram{otherProgram}, k.GetAllRewardPrograms(ctx))
+
+	require.ErrorIs(t, k.DeleteRewardProgram(ctx, TestRewardProgram.Id), types.ErrRewardProgramNotFound)
+}
+
+func TestAddRewardSharesForFill_RewardPrograms(t *testing.T) {
+	tests := map[string]struct {
+		clobPairId uint32
+		blockTime  time.Time
+
+		expectedTakerWeight int64
+		expectedMakerWeight int64
+	}{
+		"Eligible CLOB pair at program start": {
+			clobPairId: 1,
+			blockTime:  TestRewardProgramStartTime,
+
+			expectedTakerWeight: 1_000,
+			expectedMakerWeight: 500,
+		},
+		"Ineligible CLOB pair": {
+			clobPairId: 2,
+			blockTime:  TestRewardProgramStartTime,
+		},
+		"Before program start": {
+			clobPairId: 0,
+			blockTime:  TestRewardProgramStartTime.Add(-time.Second),
+		},
+		"At program end": {
+			clobPairId: 0,
+			blockTime:  TestRewardProgram.EndTime,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, ctx, k := setupRewardProgramTestApp(t, 0)
+			require.NoError(t, k.SetRewardProgram(ctx, TestRewardProgram))
+
+			ctx = ctx.WithBlockTime(tc.blockTime)
+			k.AddRewardSharesForFill(
+				ctx,
+				tc.clobPairId,
+				TestAddress1,
+				TestAddress2,
+				big.NewInt(1_000),
+				big.NewInt(0),
+				big.NewInt(0),
+				big.NewInt(0),
+			)
+
+			require.Equal(
+				t,
+				dtypes.NewInt(tc.expectedTakerWeight),
+				k.GetRewardProgramShare(ctx, TestRewardProgram.Id, TestAddress1).Weight,
+			)
+			require.Equal(
+				t,
+				dtypes.NewInt(tc.expectedMakerWeight),
+				k.GetRewardProgramShare(ctx, TestRewardProgram.Id, TestAddress2).Weight,
+			)
+		})
+	}
+}
+
+func TestAddRewardSharesForFill_RewardProgramClobPairsUpdated(t *testing.T) {
+	_, ctx, k := setupRewardProgramTestApp(t, 0)
+	ctx = ctx.WithBlockTime(TestRewardProgramStartTime)
+	require.NoError(t, k.SetRewardProgram(ctx, TestRewardProgram))
+
+	// Fills on a CLOB pair that is no longer eligible do not accrue rewards.
+	updatedProgram := TestRewardProgram
+	updatedProgram.ClobPairIds = []uint32{1, 2}
+	require.NoError(t, k.SetRewardProgram(ctx, updatedProgram))
+	k.AddRewardSharesForFill(ctx, 0, TestAddress1, TestAddress2, big.NewInt(100), big.NewInt(0), big.NewInt(0), big.NewInt(0))
+	require.Equal(t, dtypes.NewInt(0), k.GetRewardProgramShare(ctx, TestRewardProgram.Id, TestAddress1).Weight)
+
+	k.AddRewardSharesForFill(ctx, 2, TestAddress1, TestAddress2, big.NewInt(100), big.NewInt(0), big.NewInt(0), big.NewInt(0))
+	require.Equal(t, dtypes.NewInt(100), k.GetRewardProgramShare(ctx, TestRewardProgram.Id, TestAddress1).Weight)
+
+	// Fills do not accrue rewards in deleted programs.
+	require.NoError(t, k.DeleteRewardProgram(ctx, TestRewardProgram.Id))
+	k.AddRewardSharesForFill(ctx, 1, TestAddress1, TestAddress2, big.NewInt(100), big.NewInt(0), big.NewInt(0), big.NewInt(0))
+	require.Empty(t, k.GetAllRewardProgramShares(ctx))
+}
+
+func TestMaybeProcessRewardProgramsForEpoch(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		treasuryAccountBalance int64
+		// Taker and maker fill notionals for TestAddress1 and TestAddress2 respectively. Since the taker
+		// weight is 100% and the maker weight is 50%, TestAddress1 accrues twice the reward share.
+		fillQuoteQuantums int64
+		blockTime         time.Time
+		notEpochStart     bool
+
+		// Expectations.
+		expectedBalance1 int64
+		expectedBalance2 int64
+		expectedStatus   types.RewardProgramStatus
+		expectedRemoved  bool
+	}{
+		"Pays out the budget released since program start pro rata": {
+			treasuryAccountBalance: 10_000,
+			fillQuoteQuantums:      100,
+			blockTime:              TestRewardProgramStartTime.Add(time.Hour),
+			// 1_000 * 1h / 10h = 100 released, split 2:1.
+			expectedBalance1: 66,
+			expectedBalance2: 33,
+			expectedStatus: types.RewardProgramStatus{
+				ProgramId:      TestRewardProgram.Id,
+				Distributed:    dtypes.NewInt(99),
+				LastPayoutTime: TestRewardProgramStartTime.Add(time.Hour),
+			},
+		},
+		"Released budget is capped at the treasury balance": {
+			treasuryAccountBalance: 30,
+			fillQuoteQuantums:      100,
+			blockTime:              TestRewardProgramStartTime.Add(time.Hour),
+			expectedBalance1:       20,
+			expectedBalance2:       10,
+			expectedStatus: types.RewardProgramStatus{
+				ProgramId:      TestRewardProgram.Id,
+				Distributed:    dtypes.NewInt(30),
+				LastPayoutTime: TestRewardProgramStartTime.Add(time.Hour),
+			},
+		},
+		"No reward shares, released budget is not distributed": {
+			treasuryAccountBalance: 10_000,
+			blockTime:              TestRewardProgramStartTime.Add(time.Hour),
+			expectedStatus: types.RewardProgramStatus{
+				ProgramId:      TestRewardProgram.Id,
+				Distributed:    dtypes.NewInt(0),
+				LastPayoutTime: TestRewardProgramStartTime.Add(time.Hour),
+			},
+		},
+		"After program end, the whole budget is paid out and the program is removed": {
+			treasuryAccountBalance: 10_000,
+			fillQuoteQuantums:      100,
+			blockTime:              TestRewardProgram.EndTime.Add(time.Hour),
+			expectedBalance1:       666,
+			expectedBalance2:       333,
+			expectedStatus: types.RewardProgramStatus{
+				ProgramId:   TestRewardProgram.Id,
+				Distributed: dtypes.NewInt(0),
+			},
+			expectedRemoved: true,
+		},
+		"Program has not started": {
+			treasuryAccountBalance: 10_000,
+			blockTime:              TestRewardProgramStartTime,
+			expectedStatus: types.RewardProgramStatus{
+				ProgramId:   TestRewardProgram.Id,
+				Distributed: dtypes.NewInt(0),
+			},
+		},
+		"Not the start of a stats-epoch": {
+			treasuryAccountBalance: 10_000,
+			fillQuoteQuantums:      100,
+			blockTime:              TestRewardProgramStartTime.Add(time.Hour),
+			notEpochStart:          true,
+			expectedStatus: types.RewardProgramStatus{
+				ProgramId:   TestRewardProgram.Id,
+				Distributed: dtypes.NewInt(0),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp, ctx, k := setupRewardProgramTestApp(t, tc.treasuryAccountBalance)
+			require.NoError(t, k.SetRewardProgram(ctx, TestRewardProgram))
+
+			if tc.fillQuoteQuantums > 0 {
+				k.AddRewardSharesForFill(
+					ctx.WithBlockTime(TestRewardProgramStartTime),
+					0,
+					TestAddress1,
+					TestAddress2,
+					big.NewInt(tc.fillQuoteQuantums),
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(0),
+				)
+			}
+
+			ctx = withStatsEpochStart(tApp, ctx, tc.blockTime)
+			if tc.notEpochStart {
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			}
+			require.NoError(t, k.MaybeProcessRewardProgramsForEpoch(ctx))
+
+			require.Equal(
+				t,
+				sdkmath.NewInt(tc.expectedBalance1),
+				tApp.App.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(TestAddress1), TestRewardTokenDenom).Amount,
+			)
+			require.Equal(
+				t,
+				sdkmath.NewInt(tc.expectedBalance2),
+				tApp.App.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(TestAddress2), TestRewardTokenDenom).Amount,
+			)
+			require.Equal(t, tc.expectedStatus, k.GetRewardProgramStatus(ctx, TestRewardProgram.Id))
+			_, found := k.GetRewardProgram(ctx, TestRewardProgram.Id)
+			require.Equal(t, !tc.expectedRemoved, found)
+
+			// Reward shares are cleared if and only if the program paid out.
+			expectedWeight := dtypes.NewInt(0)
+			if tc.expectedStatus.LastPayoutTime.IsZero() && !tc.expectedRemoved {
+				expectedWeight = dtypes.NewInt(tc.fillQuoteQuantums)
+			}
+			require.Equal(t, expectedWeight, k.GetRewardProgramShare(ctx, TestRewardProgram.Id, TestAddress1).Weight)
+		})
+	}
+}
+
+func TestMaybeProcessRewardProgramsForEpoch_MultiplePayouts(t *testing.T) {
+	tApp, ctx, k := setupRewardProgramTestApp(t, 10_000)
+	require.NoError(t, k.SetRewardProgram(ctx, TestRewardProgram))
+
+	// No reward shares accrue in the first hour, so the 100 released is not distributed.
+	ctx = withStatsEpochStart(tApp, ctx, TestRewardProgramStartTime.Add(time.Hour))
+	require.NoError(t, k.MaybeProcessRewardProgramsForEpoch(ctx))
+
+	// The 1_000 remaining is released over the remaining 9 hours, so 333 is released after 3 more hours.
+	k.AddRewardSharesForFill(ctx, 1, TestAddress1, TestAddress2, big.NewInt(150), big.NewInt(0), big.NewInt(0), big.NewInt(0))
+	ctx = withStatsEpochStart(tApp, ctx, TestRewardProgramStartTime.Add(4*time.Hour))
+	require.NoError(t, k.MaybeProcessRewardProgramsForEpoch(ctx))
+	require.Equal(
+		t,
+		types.RewardProgramStatus{
+			ProgramId:      TestRewardProgram.Id,
+			Distributed:    dtypes.NewInt(333),
+			LastPayoutTime: TestRewardProgramStartTime.Add(4 * time.Hour),
+		},
+		k.GetRewardProgramStatus(ctx, TestRewardProgram.Id),
+	)
+
+	// The remaining 667 is paid out after the program ends, after which the program is removed.
+	k.AddRewardSharesForFill(ctx, 1, TestAddress2, TestAddress1, big.NewInt(700), big.NewInt(0), big.NewInt(0), big.NewInt(0))
+	ctx = withStatsEpochStart(tApp, ctx, TestRewardProgram.EndTime.Add(time.Minute))
+	require.NoError(t, k.MaybeProcessRewardProgramsForEpoch(ctx))
+	require.Empty(t, k.GetAllRewardPrograms(ctx))
+	require.Empty(t, k.GetAllRewardProgramStatuses(ctx))
+	require.Empty(t, k.GetAllRewardProgramShares(ctx))
+
+	// 333 * 2/3 + 667 * 1/3 and 333 * 1/3 + 667 * 2/3, each rounded down.
+	require.Equal(
+		t,
+		sdkmath.NewInt(444),
+		tApp.App.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(TestAddress1), TestRewardTokenDenom).Amount,
+	)
+	require.Equal(
+		t,
+		sdkmath.NewInt(555),
+		tApp.App.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(TestAddress2), TestRewardTokenDenom).Amount,
+	)
+
+	// Subsequent epochs do not pay out again.
+	ctx = withStatsEpochStart(tApp, ctx, TestRewardProgram.EndTime.Add(time.Hour))
+	require.NoError(t, k.MaybeProcessRewardProgramsForEpoch(ctx))
+	require.Equal(
+		t,
+		sdkmath.NewInt(10_000-999),
+		tApp.App.BankKeeper.GetBalance(ctx, types.TreasuryModuleAddress, TestRewardTokenDenom).Amount,
+	)
+}
+
+func TestProcessRewardsForBlock_DoesNotDistributeRewardProgramBudgets(t *testing.T) {
+	tApp, ctx, k := setupRewardProgramTestApp(t, 10_000)
+	require.NoError(t, k.SetRewardProgram(ctx, TestRewardProgram))
+	k.AddRewardSharesForFill(
+		ctx.WithBlockTime(TestRewardProgramStartTime),
+		0,
+		TestAddress1,
+		TestAddress2,
+		big.NewInt(100),
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+
+	// The per-block rewards are large enough to distribute the whole treasury balance, but the budget of
+	// the reward program is reserved.
+	require.NoError(t, k.AddRewardShareToAddress(ctx, TestAddress1, big.NewInt(1_000_000_000_000)))
+	ctx = withStatsEpochStart(tApp, ctx, TestRewardProgram.EndTime.Add(time.Hour))
+	require.NoError(t, k.ProcessRewardsForBlock(ctx))
+	require.Equal(
+		t,
+		sdkmath.NewInt(1_000),
+		tApp.App.BankKeeper.GetBalance(ctx, types.TreasuryModuleAddress, TestRewardTokenDenom).Amount,
+	)
+
+	// The reward program pays out its whole budget after the per-block rewards were distributed.
+	require.NoError(t, k.MaybeProcessRewardProgramsForEpoch(ctx))
+	require.Equal(
+		t,
+		sdkmath.NewInt(9_000+666),
+		tApp.App.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(TestAddress1), TestRewardTokenDenom).Amount,
+	)
+	require.Equal(
+		t,
+		sdkmath.NewInt(333),
+		tApp.App.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(TestAddress2), TestRewardTokenDenom).Amount,
+	)
+	require.Equal(t, big.NewInt(0), k.GetRewardProgramsReservedAmount(ctx))
+}
+
+func TestRewardProgramStatusAndShareStorage(t *testing.T) {
+	_, ctx, k := setupRewardProgramTestApp(t, 0)
+	require.Empty(t, k.GetAllRewardProgramStatuses(ctx))
+	require.Empty(t, k.GetAllRewardProgramShares(ctx))
+
+	statuses := []types.RewardProgramStatus{
+		{ProgramId: 1, Distributed: dtypes.NewInt(10), LastPayoutTime: TestRewardProgramStartTime},
+		{ProgramId: 2, Distributed: dtypes.NewInt(20), LastPayoutTime: TestRewardProgramStartTime},
+	}
+	for i := len(statuses) - 1; i >= 0; i-- {
+		k.SetRewardProgramStatus(ctx, statuses[i])
+	}
+	require.Equal(t, statuses, k.GetAllRewardProgramStatuses(ctx))
+
+	shares := []types.RewardProgramShare{
+		{ProgramId: 1, Address: TestAddress1, Weight: dtypes.NewInt(100)},
+		{ProgramId: 2, Address: TestAddress2, Weight: dtypes.NewInt(200)},
+	}
+	for i := len(shares) - 1; i >= 0; i-- {
+		k.SetRewardProgramShare(ctx, shares[i])
+	}
+	require.Equal(t, shares, k.GetAllRewardProgramShares(ctx))
+	require.Equal(t, shares[1], k.GetRewardProgramShare(ctx, 2, TestAddress2))
+}
@@ -160,5 +160,13 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 		)
 	}
 
+	if err := am.keeper.MaybeProcessRewardProgramsForEpoch(ctx); err != nil {
+		am.keeper.Logger(ctx).Error(
+			"failed to process reward programs for epoch",
+			constants.ErrorLogKey,
+			err,
+		)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
@@ -116,8 +116,10 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "rewards", cmd.Use)
-	require.Equal(t, 1, len(cmd.Commands()))
-	require.Equal(t, "params", cmd.Commands()[0].Name())
+	require.Equal(t, 3, len(cmd.Commands()))
+	require.Equal(t, "accrued-rewards", cmd.Commands()[0].Name())
+	require.Equal(t, "list-reward-programs", cmd.Commands()[1].Name())
+	require.Equal(t, "params", cmd.Commands()[2].Name())
 }
 
 func TestAppModule_InitExportGenesis(t *testing.T) {
@@ -6,8 +6,13 @@ import errorsmod "cosmossdk.io/errors"
 
 // x/rewards module sentinel errors
 var (
-	ErrInvalidTreasuryAccount  = errorsmod.Register(ModuleName, 1001, "invalid treasury account")
-	ErrInvalidFeeMultiplierPpm = errorsmod.Register(ModuleName, 1002, "invalid FeeMultiplierPpm")
-	ErrInvalidAuthority        = errorsmod.Register(ModuleName, 1003, "Authority is invalid")
-	ErrNonpositiveWeight       = errorsmod.Register(ModuleName, 1004, "weight must be positive")
+	ErrInvalidTreasuryAccount      = errorsmod.Register(ModuleName, 1001, "invalid treasury account")
+	ErrInvalidFeeMultiplierPpm     = errorsmod.Register(ModuleName, 1002, "invalid FeeMultiplierPpm")
+	ErrInvalidAuthority            = errorsmod.Register(ModuleName, 1003, "Authority is invalid")
+	ErrNonpositiveWeight           = errorsmod.Register(ModuleName, 1004, "weight must be positive")
+	ErrInvalidRewardProgram        = errorsmod.Register(ModuleName, 1005, "invalid reward program")
+	ErrRewardProgramNotFound       = errorsmod.Register(ModuleName, 1006, "reward program not found")
+	ErrInvalidRewardProgramStatus  = errorsmod.Register(ModuleName, 1007, "invalid reward program status")
+	ErrInvalidRewardProgramShare   = errorsmod.Register(ModuleName, 1008, "invalid reward program share")
+	ErrInsufficientTreasuryBalance = errorsmod.Register(ModuleName, 1009, "insufficient treasury balance")
 )
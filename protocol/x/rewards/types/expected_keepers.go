@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	assets "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	prices "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
@@ -20,7 +21,7 @@ type BankKeeper interface {
 }
 
 type FeeTiersKeeper interface {
-	GetLowestMakerFee(ctx sdk.Context) int32
+	GetLowestMakerFee(ctx sdk.Context, clobPairId uint32) int32
 }
 
 type PricesKeeper interface {
@@ -36,3 +37,11 @@ type AssetsKeeper interface {
 		id uint32,
 	) (val assets.Asset, exists bool)
 }
+
+// EpochsKeeper defines the expected epochs keeper to determine reward program payout boundaries.
+type EpochsKeeper interface {
+	NumBlocksSinceEpochStart(
+		ctx sdk.Context,
+		id epochstypes.EpochInfoName,
+	) (uint32, error)
+}
@@ -1,14 +1,79 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                DefaultParams(),
+		RewardPrograms:        []RewardProgram{},
+		RewardProgramStatuses: []RewardProgramStatus{},
+		RewardProgramShares:   []RewardProgramShare{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	programs := make(map[uint32]RewardProgram, len(gs.RewardPrograms))
+	for _, program := range gs.RewardPrograms {
+		if _, exists := programs[program.Id]; exists {
+			return errorsmod.Wrapf(ErrInvalidRewardProgram, "duplicate reward program id %d", program.Id)
+		}
+		if err := program.Validate(); err != nil {
+			return err
+		}
+		programs[program.Id] = program
+	}
+
+	statusProgramIds := make(map[uint32]struct{}, len(gs.RewardProgramStatuses))
+	for _, status := range gs.RewardProgramStatuses {
+		program, exists := programs[status.ProgramId]
+		if !exists {
+			return errorsmod.Wrapf(ErrRewardProgramNotFound, "status of reward program %d", status.ProgramId)
+		}
+		if _, exists := statusProgramIds[status.ProgramId]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidRewardProgramStatus,
+				"duplicate status of reward program %d",
+				status.ProgramId,
+			)
+		}
+		if err := status.Validate(program); err != nil {
+			return err
+		}
+		statusProgramIds[status.ProgramId] = struct{}{}
+	}
+
+	type shareKey struct {
+		programId uint32
+		address   string
+	}
+	shareKeys := make(map[shareKey]struct{}, len(gs.RewardProgramShares))
+	for _, share := range gs.RewardProgramShares {
+		if _, exists := programs[share.ProgramId]; !exists {
+			return errorsmod.Wrapf(ErrRewardProgramNotFound, "share of reward program %d", share.ProgramId)
+		}
+		key := shareKey{programId: share.ProgramId, address: share.Address}
+		if _, exists := shareKeys[key]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidRewardProgramShare,
+				"duplicate share of address %s in reward program %d",
+				share.Address,
+				share.ProgramId,
+			)
+		}
+		if err := share.Validate(); err != nil {
+			return err
+		}
+		shareKeys[key] = struct{}{}
+	}
+
+	return nil
 }
@@ -27,6 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// The parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// The trading reward programs that have not been fully paid out.
+	RewardPrograms []RewardProgram `protobuf:"bytes,2,rep,name=reward_programs,json=rewardPrograms,proto3" json:"reward_programs"`
+	// The payout statuses of reward programs that have paid out at least once.
+	RewardProgramStatuses []RewardProgramStatus `protobuf:"bytes,3,rep,name=reward_program_statuses,json=rewardProgramStatuses,proto3" json:"reward_program_statuses"`
+	// The accrued-but-unpaid reward shares of addresses in reward programs.
+	RewardProgramShares []RewardProgramShare `protobuf:"bytes,4,rep,name=reward_program_shares,json=rewardProgramShares,proto3" json:"reward_program_shares"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +75,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRewardPrograms() []RewardProgram {
+	if m != nil {
+		return m.RewardPrograms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardProgramStatuses() []RewardProgramStatus {
+	if m != nil {
+		return m.RewardProgramStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardProgramShares() []RewardProgramShare {
+	if m != nil {
+		return m.RewardProgramShares
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.rewards.GenesisState")
 }
@@ -78,20 +105,27 @@ func init() {
 }
 
 var fileDescriptor_cf5050587bb71a1f = []byte{
-	// 198 bytes of a gzipped FileDescriptorProto
+	// 305 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xa9, 0x4c, 0xa9,
 	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29,
 	0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x4b, 0x08, 0x89, 0x20, 0xab, 0xd1,
 	0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x8b, 0xea, 0x83, 0x58, 0x10, 0xb5, 0x52,
-	0x8a, 0x58, 0xcd, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x1a, 0xa7, 0xe4, 0xc5, 0xc5, 0xe3, 0x0e,
-	0x31, 0x3f, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x8a, 0x8b, 0x0d, 0x22, 0x2f, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0x6d, 0x24, 0xa3, 0x87, 0xcd, 0x3e, 0xbd, 0x00, 0xb0, 0x1a, 0x27, 0x96, 0x13, 0xf7,
-	0xe4, 0x19, 0x82, 0xa0, 0x3a, 0x9c, 0x82, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0xca, 0x32, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xc5, 0x4d,
-	0x65, 0x26, 0xba, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x70, 0x91, 0x0a, 0xb8, 0x3b, 0x4b, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x32, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0x34,
-	0xc8, 0xaa, 0x1c, 0x01, 0x00, 0x00,
+	0x8a, 0x58, 0xcd, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x1a, 0x27, 0xa5, 0x89, 0x55, 0x09, 0x84,
+	0x8e, 0x2f, 0x28, 0xca, 0x4f, 0x2f, 0x4a, 0xcc, 0x85, 0x28, 0x55, 0x7a, 0xcd, 0xc4, 0xc5, 0xe3,
+	0x0e, 0x71, 0x4b, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x1b, 0xc4, 0x2c, 0x09, 0x46,
+	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x19, 0x3d, 0x6c, 0x6e, 0xd3, 0x0b, 0x00, 0xab, 0x71, 0x62, 0x39,
+	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x43, 0x28, 0x88, 0x8b, 0x1f, 0xd5, 0x92, 0x62, 0x09, 0x26,
+	0x05, 0x66, 0x0d, 0x6e, 0x23, 0x65, 0xec, 0x86, 0x04, 0x81, 0xe9, 0x00, 0x88, 0x5a, 0xa8, 0x59,
+	0x7c, 0x45, 0xc8, 0x82, 0xc5, 0x42, 0xe9, 0x5c, 0xe2, 0xa8, 0x66, 0xc6, 0x17, 0x97, 0x24, 0x96,
+	0x94, 0x16, 0xa7, 0x16, 0x4b, 0x30, 0x83, 0xcd, 0xd6, 0x24, 0xc2, 0xec, 0x60, 0xb0, 0x16, 0xa8,
+	0x0d, 0xa2, 0x45, 0x98, 0x52, 0xa9, 0xc5, 0x42, 0x49, 0x5c, 0xa2, 0xe8, 0x16, 0x65, 0x24, 0x16,
+	0xa5, 0x16, 0x4b, 0xb0, 0x80, 0xad, 0xd1, 0x20, 0xc6, 0x1a, 0x90, 0x06, 0xa8, 0x2d, 0xc2, 0x45,
+	0x18, 0x32, 0xc5, 0x4e, 0xc1, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
+	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65,
+	0x99, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x12, 0x7b, 0x65, 0x26,
+	0xba, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x70, 0x91, 0x0a, 0x78, 0x8c, 0x96, 0x54, 0x16, 0xa4,
+	0x16, 0x27, 0xb1, 0x81, 0x65, 0x8c, 0x01, 0x03, 0x00, 0x37, 0x5d, 0x30, 0xf1, 0x69, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +148,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardProgramShares) > 0 {
+		for iNdEx := len(m.RewardProgramShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardProgramShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RewardProgramStatuses) > 0 {
+		for iNdEx := len(m.RewardProgramStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardProgramStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardPrograms) > 0 {
+		for iNdEx := len(m.RewardPrograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPrograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +222,24 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RewardPrograms) > 0 {
+		for _, e := range m.RewardPrograms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardProgramStatuses) > 0 {
+		for _, e := range m.RewardProgramStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardProgramShares) > 0 {
+		for _, e := range m.RewardProgramShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +311,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPrograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPrograms = append(m.RewardPrograms, RewardProgram{})
+			if err := m.RewardPrograms[len(m.RewardPrograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardProgramStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardProgramStatuses = append(m.RewardProgramStatuses, RewardProgramStatus{})
+			if err := m.RewardProgramStatuses[len(m.RewardProgramStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardProgramShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardProgramShares = append(m.RewardProgramShares, RewardProgramShare{})
+			if err := m.RewardProgramShares[len(m.RewardProgramShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,9 @@ func TestDefaultGenesis(t *testing.T) {
 			MarketId:         1,
 			FeeMultiplierPpm: 990_000, // 0.99
 		},
+		RewardPrograms:        []types.RewardProgram{},
+		RewardProgramStatuses: []types.RewardProgramStatus{},
+		RewardProgramShares:   []types.RewardProgramShare{},
 	}
 
 	require.Equal(t, expectedGenesisState, genState)
@@ -65,6 +69,108 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedErr: "treasury account cannot have empty name",
 		},
+		{
+			desc: "valid: reward programs with statuses and shares",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				RewardPrograms:        []types.RewardProgram{validRewardProgram},
+				RewardProgramStatuses: []types.RewardProgramStatus{validRewardProgramStatus},
+				RewardProgramShares:   []types.RewardProgramShare{validRewardProgramShare},
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "invalid: duplicate reward program",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RewardPrograms: []types.RewardProgram{validRewardProgram, validRewardProgram},
+			},
+			expectedErr: "duplicate reward program id 1",
+		},
+		{
+			desc: "invalid: invalid reward program",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardPrograms: []types.RewardProgram{
+					func() types.RewardProgram {
+						program := validRewardProgram
+						program.Budget = dtypes.NewInt(0)
+						return program
+					}(),
+				},
+			},
+			expectedErr: types.ErrInvalidRewardProgram.Error(),
+		},
+		{
+			desc: "invalid: status of unknown reward program",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				RewardProgramStatuses: []types.RewardProgramStatus{validRewardProgramStatus},
+			},
+			expectedErr: "status of reward program 1",
+		},
+		{
+			desc: "invalid: duplicate reward program status",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RewardPrograms: []types.RewardProgram{validRewardProgram},
+				RewardProgramStatuses: []types.RewardProgramStatus{
+					validRewardProgramStatus,
+					validRewardProgramStatus,
+				},
+			},
+			expectedErr: "duplicate status of reward program 1",
+		},
+		{
+			desc: "invalid: distributed exceeds budget",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RewardPrograms: []types.RewardProgram{validRewardProgram},
+				RewardProgramStatuses: []types.RewardProgramStatus{
+					{
+						ProgramId:      1,
+						Distributed:    dtypes.NewInt(1_000_001),
+						LastPayoutTime: validRewardProgramStatus.LastPayoutTime,
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidRewardProgramStatus.Error(),
+		},
+		{
+			desc: "invalid: share of unknown reward program",
+			genState: &types.GenesisState{
+				Params:              types.DefaultParams(),
+				RewardProgramShares: []types.RewardProgramShare{validRewardProgramShare},
+			},
+			expectedErr: "share of reward program 1",
+		},
+		{
+			desc: "invalid: duplicate reward program share",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RewardPrograms: []types.RewardProgram{validRewardProgram},
+				RewardProgramShares: []types.RewardProgramShare{
+					validRewardProgramShare,
+					validRewardProgramShare,
+				},
+			},
+			expectedErr: "duplicate share of address",
+		},
+		{
+			desc: "invalid: non-positive reward program share",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RewardPrograms: []types.RewardProgram{validRewardProgram},
+				RewardProgramShares: []types.RewardProgramShare{
+					{
+						ProgramId: 1,
+						Address:   validRewardProgramShare.Address,
+						Weight:    dtypes.NewInt(0),
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidRewardProgramShare.Error(),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -19,6 +19,20 @@ const (
 
 	// ParamsKey is the key for the params
 	ParamsKey = "Params"
+
+	// RewardProgramKeyPrefix is the prefix to retrieve all reward programs.
+	RewardProgramKeyPrefix = "Program:"
+
+	// RewardProgramStatusKeyPrefix is the prefix to retrieve the payout status of all reward programs.
+	RewardProgramStatusKeyPrefix = "ProgramStatus:"
+
+	// RewardProgramByClobPairKeyPrefix is the prefix to retrieve the ids of the reward programs that
+	// each CLOB pair is eligible for.
+	RewardProgramByClobPairKeyPrefix = "ProgramByClobPair:"
+
+	// RewardProgramShareKeyPrefix is the prefix to retrieve the accrued reward shares of all addresses
+	// in all reward programs.
+	RewardProgramShareKeyPrefix = "ProgramShare:"
 )
 
 // Module accounts
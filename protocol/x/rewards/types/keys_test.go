@@ -16,6 +16,9 @@ func TestModuleKeys(t *testing.T) {
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "Shares:", types.RewardShareKeyPrefix)
 	require.Equal(t, "Params", types.ParamsKey)
+	require.Equal(t, "Program:", types.RewardProgramKeyPrefix)
+	require.Equal(t, "ProgramStatus:", types.RewardProgramStatusKeyPrefix)
+	require.Equal(t, "ProgramShare:", types.RewardProgramShareKeyPrefix)
 }
 
 func TestModuleAccountKeys(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -111,33 +112,351 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryAllRewardProgramsRequest is a request type for the AllRewardPrograms
+// RPC method.
+type QueryAllRewardProgramsRequest struct {
+}
+
+func (m *QueryAllRewardProgramsRequest) Reset()         { *m = QueryAllRewardProgramsRequest{} }
+func (m *QueryAllRewardProgramsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRewardProgramsRequest) ProtoMessage()    {}
+func (*QueryAllRewardProgramsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94c9749bc31cbdbc, []int{2}
+}
+func (m *QueryAllRewardProgramsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRewardProgramsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRewardProgramsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRewardProgramsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRewardProgramsRequest.Merge(m, src)
+}
+func (m *QueryAllRewardProgramsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRewardProgramsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRewardProgramsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRewardProgramsRequest proto.InternalMessageInfo
+
+// QueryAllRewardProgramsResponse is a response type for the AllRewardPrograms
+// RPC method.
+type QueryAllRewardProgramsResponse struct {
+	Programs []QueryAllRewardProgramsResponse_RewardProgramWithStatus `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs"`
+}
+
+func (m *QueryAllRewardProgramsResponse) Reset()         { *m = QueryAllRewardProgramsResponse{} }
+func (m *QueryAllRewardProgramsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRewardProgramsResponse) ProtoMessage()    {}
+func (*QueryAllRewardProgramsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94c9749bc31cbdbc, []int{3}
+}
+func (m *QueryAllRewardProgramsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRewardProgramsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRewardProgramsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRewardProgramsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRewardProgramsResponse.Merge(m, src)
+}
+func (m *QueryAllRewardProgramsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRewardProgramsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRewardProgramsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRewardProgramsResponse proto.InternalMessageInfo
+
+func (m *QueryAllRewardProgramsResponse) GetPrograms() []QueryAllRewardProgramsResponse_RewardProgramWithStatus {
+	if m != nil {
+		return m.Programs
+	}
+	return nil
+}
+
+// A reward program and its payout status.
+type QueryAllRewardProgramsResponse_RewardProgramWithStatus struct {
+	Program RewardProgram       `protobuf:"bytes,1,opt,name=program,proto3" json:"program"`
+	Status  RewardProgramStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+}
+
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) Reset() {
+	*m = QueryAllRewardProgramsResponse_RewardProgramWithStatus{}
+}
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryAllRewardProgramsResponse_RewardProgramWithStatus) ProtoMessage() {}
+func (*QueryAllRewardProgramsResponse_RewardProgramWithStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94c9749bc31cbdbc, []int{3, 0}
+}
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRewardProgramsResponse_RewardProgramWithStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRewardProgramsResponse_RewardProgramWithStatus.Merge(m, src)
+}
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRewardProgramsResponse_RewardProgramWithStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRewardProgramsResponse_RewardProgramWithStatus proto.InternalMessageInfo
+
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) GetProgram() RewardProgram {
+	if m != nil {
+		return m.Program
+	}
+	return RewardProgram{}
+}
+
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) GetStatus() RewardProgramStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RewardProgramStatus{}
+}
+
+// QueryAccruedRewardsRequest is a request type for the AccruedRewards RPC
+// method.
+type QueryAccruedRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccruedRewardsRequest) Reset()         { *m = QueryAccruedRewardsRequest{} }
+func (m *QueryAccruedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedRewardsRequest) ProtoMessage()    {}
+func (*QueryAccruedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94c9749bc31cbdbc, []int{4}
+}
+func (m *QueryAccruedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedRewardsRequest.Merge(m, src)
+}
+func (m *QueryAccruedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAccruedRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccruedRewardsResponse is a response type for the AccruedRewards RPC
+// method.
+type QueryAccruedRewardsResponse struct {
+	AccruedRewards []QueryAccruedRewardsResponse_AccruedReward `protobuf:"bytes,1,rep,name=accrued_rewards,json=accruedRewards,proto3" json:"accrued_rewards"`
+}
+
+func (m *QueryAccruedRewardsResponse) Reset()         { *m = QueryAccruedRewardsResponse{} }
+func (m *QueryAccruedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedRewardsResponse) ProtoMessage()    {}
+func (*QueryAccruedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94c9749bc31cbdbc, []int{5}
+}
+func (m *QueryAccruedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedRewardsResponse.Merge(m, src)
+}
+func (m *QueryAccruedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAccruedRewardsResponse) GetAccruedRewards() []QueryAccruedRewardsResponse_AccruedReward {
+	if m != nil {
+		return m.AccruedRewards
+	}
+	return nil
+}
+
+// The accrued-but-unpaid rewards of the address in a reward program.
+type QueryAccruedRewardsResponse_AccruedReward struct {
+	// The id of the reward program.
+	ProgramId uint32 `protobuf:"varint,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// The reward share accrued by the address since the last payout.
+	Weight github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"weight"`
+	// The total reward share accrued by all addresses since the last payout.
+	TotalWeight github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"total_weight"`
+	// The estimated amount of the rewards token the address would receive if
+	// the program paid out at the current block time.
+	EstimatedAmount github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=estimated_amount,json=estimatedAmount,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"estimated_amount"`
+}
+
+func (m *QueryAccruedRewardsResponse_AccruedReward) Reset() {
+	*m = QueryAccruedRewardsResponse_AccruedReward{}
+}
+func (m *QueryAccruedRewardsResponse_AccruedReward) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryAccruedRewardsResponse_AccruedReward) ProtoMessage() {}
+func (*QueryAccruedRewardsResponse_AccruedReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94c9749bc31cbdbc, []int{5, 0}
+}
+func (m *QueryAccruedRewardsResponse_AccruedReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedRewardsResponse_AccruedReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedRewardsResponse_AccruedReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedRewardsResponse_AccruedReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedRewardsResponse_AccruedReward.Merge(m, src)
+}
+func (m *QueryAccruedRewardsResponse_AccruedReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedRewardsResponse_AccruedReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedRewardsResponse_AccruedReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedRewardsResponse_AccruedReward proto.InternalMessageInfo
+
+func (m *QueryAccruedRewardsResponse_AccruedReward) GetProgramId() uint32 {
+	if m != nil {
+		return m.ProgramId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.rewards.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.rewards.QueryParamsResponse")
+	proto.RegisterType((*QueryAllRewardProgramsRequest)(nil), "dydxprotocol.rewards.QueryAllRewardProgramsRequest")
+	proto.RegisterType((*QueryAllRewardProgramsResponse)(nil), "dydxprotocol.rewards.QueryAllRewardProgramsResponse")
+	proto.RegisterType((*QueryAllRewardProgramsResponse_RewardProgramWithStatus)(nil), "dydxprotocol.rewards.QueryAllRewardProgramsResponse.RewardProgramWithStatus")
+	proto.RegisterType((*QueryAccruedRewardsRequest)(nil), "dydxprotocol.rewards.QueryAccruedRewardsRequest")
+	proto.RegisterType((*QueryAccruedRewardsResponse)(nil), "dydxprotocol.rewards.QueryAccruedRewardsResponse")
+	proto.RegisterType((*QueryAccruedRewardsResponse_AccruedReward)(nil), "dydxprotocol.rewards.QueryAccruedRewardsResponse.AccruedReward")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/rewards/query.proto", fileDescriptor_94c9749bc31cbdbc) }
 
 var fileDescriptor_94c9749bc31cbdbc = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29,
-	0xd6, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x03, 0x0b, 0x0b, 0x89, 0x20, 0xab, 0xd0, 0x83, 0xaa,
-	0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x8b, 0xea, 0x83, 0x58, 0x10, 0xb5, 0x52, 0x32, 0xe9,
-	0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89, 0x79, 0x79, 0xf9, 0x25, 0x89,
-	0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x45, 0xac, 0x76, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42,
-	0x95, 0x28, 0x89, 0x70, 0x09, 0x05, 0x82, 0xec, 0x0e, 0x00, 0x0b, 0x06, 0xa5, 0x16, 0x96, 0xa6,
-	0x16, 0x97, 0x28, 0x05, 0x72, 0x09, 0xa3, 0x88, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x59,
-	0x71, 0xb1, 0x41, 0x34, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe8, 0x61, 0x73, 0xaa,
-	0x1e, 0x44, 0x97, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x1d, 0x46, 0x13, 0x18, 0xb9,
-	0x58, 0xc1, 0x66, 0x0a, 0xb5, 0x31, 0x72, 0xb1, 0x41, 0x94, 0x08, 0x69, 0x60, 0x37, 0x00, 0xd3,
-	0x45, 0x52, 0x9a, 0x44, 0xa8, 0x84, 0xb8, 0x52, 0x49, 0xbd, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0x8a,
-	0x42, 0xf2, 0xfa, 0x28, 0xde, 0x2f, 0x33, 0x41, 0x0b, 0x01, 0xa7, 0xe0, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0x45, 0x37, 0x44, 0x37, 0x39, 0x23, 0x31, 0x33, 0x4f, 0x1f, 0x2e, 0x52, 0x01, 0x37, 0xb5,
-	0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x2c, 0x63, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf2,
-	0x35, 0xfa, 0xbb, 0xe8, 0x01, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0xb4, 0xa4, 0xf4, 0xfa, 0x0f, 0x8e, 0x48, 0x44, 0xa6, 0x75, 0x1a, 0x77, 0x20,
+	0x19, 0xb0, 0x21, 0x8d, 0x90, 0xe8, 0x02, 0x0d, 0x03, 0x54, 0x62, 0x68, 0x9d, 0xa1, 0x12, 0x4b,
+	0xb8, 0xc4, 0x27, 0xc7, 0xc2, 0xf1, 0xb9, 0xf6, 0x99, 0x36, 0x20, 0x96, 0x0e, 0xcc, 0x48, 0x7c,
+	0x08, 0xc4, 0xc8, 0xca, 0x27, 0xe8, 0x58, 0x89, 0x05, 0x31, 0x54, 0x28, 0xe1, 0x03, 0x20, 0x3e,
+	0x01, 0xca, 0xdd, 0xd9, 0xc2, 0xc1, 0x89, 0x12, 0xd4, 0x29, 0xf1, 0x7b, 0xcf, 0xfb, 0x3e, 0xbf,
+	0x7b, 0xef, 0xde, 0x03, 0x9b, 0x66, 0xcf, 0x3c, 0xf1, 0x7c, 0x42, 0x49, 0x9b, 0x38, 0xba, 0x8f,
+	0x8f, 0x91, 0x6f, 0x06, 0xfa, 0x51, 0x88, 0xfd, 0x9e, 0xc6, 0xc2, 0x30, 0xff, 0xb7, 0x42, 0x13,
+	0x0a, 0x39, 0x6f, 0x11, 0x8b, 0xb0, 0xa8, 0x3e, 0xfc, 0xc7, 0xb5, 0xf2, 0xba, 0x45, 0x88, 0xe5,
+	0x60, 0x1d, 0x79, 0xb6, 0x8e, 0x5c, 0x97, 0x50, 0x44, 0x6d, 0xe2, 0x06, 0x62, 0xb5, 0x94, 0xea,
+	0xe5, 0x21, 0x1f, 0x75, 0x23, 0x49, 0x25, 0x55, 0xc2, 0x7f, 0x9b, 0x9e, 0x4f, 0x2c, 0x1f, 0x75,
+	0xb9, 0x54, 0xcd, 0x03, 0x78, 0x30, 0xc4, 0xdc, 0x67, 0xf9, 0x06, 0x3e, 0x0a, 0x71, 0x40, 0xd5,
+	0x03, 0x70, 0x23, 0x11, 0x0d, 0x3c, 0xe2, 0x06, 0x18, 0xee, 0x80, 0x1c, 0xf7, 0x29, 0x48, 0x9b,
+	0x52, 0x79, 0xa9, 0xba, 0xae, 0xa5, 0xed, 0x4a, 0xe3, 0x59, 0xf5, 0xf9, 0xb3, 0x8b, 0x62, 0xc6,
+	0x10, 0x19, 0x6a, 0x11, 0x6c, 0xb0, 0x92, 0xbb, 0x8e, 0x63, 0x30, 0xdd, 0x3e, 0xe7, 0x88, 0x3d,
+	0xbf, 0x64, 0x81, 0x32, 0x4e, 0x21, 0xfc, 0x5d, 0x70, 0x55, 0xd0, 0x0f, 0x09, 0xe6, 0xca, 0x4b,
+	0xd5, 0x67, 0xe9, 0x04, 0x93, 0xeb, 0x68, 0x89, 0xf0, 0xa1, 0x4d, 0x3b, 0x0d, 0x8a, 0x68, 0x18,
+	0x11, 0xc7, 0x1e, 0xf2, 0x47, 0x09, 0xdc, 0x1c, 0xa3, 0x85, 0x8f, 0xc1, 0x82, 0xd0, 0x89, 0x66,
+	0x6c, 0xa5, 0xa3, 0x24, 0xf2, 0x85, 0x43, 0x94, 0x09, 0x9f, 0x80, 0x5c, 0xc0, 0xca, 0x15, 0xb2,
+	0xac, 0x46, 0x65, 0x8a, 0x1a, 0x09, 0x56, 0x91, 0xae, 0xde, 0x07, 0x32, 0xdf, 0x73, 0xbb, 0xed,
+	0x87, 0xd8, 0xe4, 0x09, 0x51, 0x6b, 0x61, 0x01, 0x2c, 0x20, 0xd3, 0xf4, 0x71, 0xc0, 0x0f, 0x6e,
+	0xd1, 0x88, 0x3e, 0xd5, 0xd3, 0x79, 0x70, 0x2b, 0x35, 0x31, 0xee, 0xf8, 0x1a, 0xe2, 0x2b, 0x4d,
+	0x01, 0x23, 0x1a, 0xff, 0x70, 0x52, 0xe3, 0x53, 0x6b, 0x69, 0x89, 0xb0, 0xe0, 0x5f, 0x45, 0x09,
+	0xad, 0xfc, 0x3b, 0x0b, 0x56, 0x12, 0x3a, 0xb8, 0x01, 0x80, 0xe8, 0x56, 0xd3, 0x36, 0x19, 0xfe,
+	0x8a, 0xb1, 0x28, 0x22, 0x7b, 0x26, 0x7c, 0x01, 0x72, 0xc7, 0xd8, 0xb6, 0x3a, 0x94, 0x75, 0x70,
+	0xb9, 0xfe, 0x74, 0x58, 0xf6, 0xfb, 0x45, 0xf1, 0x91, 0x65, 0xd3, 0x4e, 0xd8, 0xd2, 0xda, 0xa4,
+	0xab, 0x27, 0xa6, 0xe1, 0x55, 0xed, 0x4e, 0xbb, 0x83, 0x6c, 0x57, 0x8f, 0x23, 0x26, 0xed, 0x79,
+	0x38, 0xd0, 0x1a, 0xd8, 0xb7, 0x91, 0x63, 0xbf, 0x46, 0x2d, 0x07, 0xef, 0xb9, 0xd4, 0x10, 0x75,
+	0xe1, 0x4b, 0xb0, 0x4c, 0x09, 0x45, 0x4e, 0x53, 0xf8, 0xcc, 0x5d, 0xb2, 0xcf, 0x12, 0xab, 0x7e,
+	0xc8, 0xcd, 0x02, 0x70, 0x0d, 0x07, 0xd4, 0xee, 0x22, 0x8a, 0xcd, 0x26, 0xea, 0x92, 0xd0, 0xa5,
+	0x85, 0xf9, 0x4b, 0x36, 0x5c, 0x8b, 0x1d, 0x76, 0x99, 0x41, 0xf5, 0xd7, 0x1c, 0xb8, 0xc2, 0x0e,
+	0x0e, 0xbe, 0x93, 0x40, 0x8e, 0x4f, 0x2f, 0x2c, 0x4f, 0x38, 0xe0, 0xc4, 0x63, 0x21, 0x57, 0xa6,
+	0x50, 0xf2, 0x2b, 0xa0, 0xde, 0x3e, 0xfd, 0xfa, 0xf3, 0x43, 0xb6, 0x04, 0x8b, 0xa3, 0xe8, 0x23,
+	0xef, 0x18, 0xfc, 0x24, 0x81, 0xeb, 0xff, 0xcc, 0x2f, 0xdc, 0x9e, 0x6d, 0xda, 0x39, 0x5e, 0xed,
+	0x7f, 0x9e, 0x08, 0xb5, 0xc2, 0x48, 0xb7, 0x60, 0x69, 0x3c, 0x69, 0x44, 0xf5, 0x59, 0x02, 0xab,
+	0xc9, 0x2b, 0x0f, 0xef, 0xce, 0x30, 0x1d, 0x9c, 0xf2, 0xde, 0xcc, 0xf3, 0xa4, 0xee, 0x30, 0xc4,
+	0x1a, 0xac, 0x8e, 0x45, 0x1c, 0x19, 0x5d, 0xfd, 0x8d, 0x18, 0xfb, 0xb7, 0xf5, 0xc6, 0x59, 0x5f,
+	0x91, 0xce, 0xfb, 0x8a, 0xf4, 0xa3, 0xaf, 0x48, 0xef, 0x07, 0x4a, 0xe6, 0x7c, 0xa0, 0x64, 0xbe,
+	0x0d, 0x94, 0xcc, 0xf3, 0x07, 0xd3, 0xdf, 0xaf, 0x93, 0xd8, 0x88, 0x5d, 0xb4, 0x56, 0x8e, 0xad,
+	0x6c, 0xff, 0x19, 0x00, 0x97, 0xac, 0xe4, 0xaa, 0x0e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +473,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Queries the Params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries all reward programs and their payout status.
+	AllRewardPrograms(ctx context.Context, in *QueryAllRewardProgramsRequest, opts ...grpc.CallOption) (*QueryAllRewardProgramsResponse, error)
+	// Queries the accrued-but-unpaid rewards of an address in each reward
+	// program.
+	AccruedRewards(ctx context.Context, in *QueryAccruedRewardsRequest, opts ...grpc.CallOption) (*QueryAccruedRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -173,10 +497,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AllRewardPrograms(ctx context.Context, in *QueryAllRewardProgramsRequest, opts ...grpc.CallOption) (*QueryAllRewardProgramsResponse, error) {
+	out := new(QueryAllRewardProgramsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.rewards.Query/AllRewardPrograms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccruedRewards(ctx context.Context, in *QueryAccruedRewardsRequest, opts ...grpc.CallOption) (*QueryAccruedRewardsResponse, error) {
+	out := new(QueryAccruedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.rewards.Query/AccruedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the Params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries all reward programs and their payout status.
+	AllRewardPrograms(context.Context, *QueryAllRewardProgramsRequest) (*QueryAllRewardProgramsResponse, error)
+	// Queries the accrued-but-unpaid rewards of an address in each reward
+	// program.
+	AccruedRewards(context.Context, *QueryAccruedRewardsRequest) (*QueryAccruedRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +533,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) AllRewardPrograms(ctx context.Context, req *QueryAllRewardProgramsRequest) (*QueryAllRewardProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRewardPrograms not implemented")
+}
+func (*UnimplementedQueryServer) AccruedRewards(ctx context.Context, req *QueryAccruedRewardsRequest) (*QueryAccruedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccruedRewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +562,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllRewardPrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRewardProgramsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRewardPrograms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.rewards.Query/AllRewardPrograms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRewardPrograms(ctx, req.(*QueryAllRewardProgramsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccruedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccruedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccruedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.rewards.Query/AccruedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccruedRewards(ctx, req.(*QueryAccruedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.rewards.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -217,6 +606,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AllRewardPrograms",
+			Handler:    _Query_AllRewardPrograms_Handler,
+		},
+		{
+			MethodName: "AccruedRewards",
+			Handler:    _Query_AccruedRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/rewards/query.proto",
@@ -278,40 +675,351 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllRewardProgramsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAllRewardProgramsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardProgramsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllRewardProgramsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRewardProgramsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardProgramsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Programs) > 0 {
+		for iNdEx := len(m.Programs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Programs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Program.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccruedRewards) > 0 {
+		for iNdEx := len(m.AccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedRewardsResponse_AccruedReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedRewardsResponse_AccruedReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedRewardsResponse_AccruedReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EstimatedAmount.Size()
+		i -= size
+		if _, err := m.EstimatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ProgramId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProgramId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRewardProgramsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllRewardProgramsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Programs) > 0 {
+		for _, e := range m.Programs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Program.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccruedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccruedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccruedRewards) > 0 {
+		for _, e := range m.AccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccruedRewardsResponse_AccruedReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProgramId != 0 {
+		n += 1 + sovQuery(uint64(m.ProgramId))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EstimatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -448,6 +1156,590 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllRewardProgramsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRewardProgramsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRewardProgramsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRewardProgramsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRewardProgramsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRewardProgramsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Programs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Programs = append(m.Programs, QueryAllRewardProgramsResponse_RewardProgramWithStatus{})
+			if err := m.Programs[len(m.Programs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRewardProgramsResponse_RewardProgramWithStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardProgramWithStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardProgramWithStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Program.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccruedRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccruedRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccruedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccruedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccruedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccruedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedRewards = append(m.AccruedRewards, QueryAccruedRewardsResponse_AccruedReward{})
+			if err := m.AccruedRewards[len(m.AccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccruedRewardsResponse_AccruedReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccruedReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccruedReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			m.ProgramId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProgramId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EstimatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,121 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// Validate validates the reward program.
+func (program RewardProgram) Validate() error {
+	if !program.StartTime.Before(program.EndTime) {
+		return errorsmod.Wrapf(
+			ErrInvalidRewardProgram,
+			"start_time must be before end_time: start_time = %v, end_time = %v",
+			program.StartTime,
+			program.EndTime,
+		)
+	}
+
+	if program.StartTime.Location().String() != "UTC" || program.EndTime.Location().String() != "UTC" {
+		return errorsmod.Wrap(ErrInvalidRewardProgram, "start_time and end_time must be in UTC")
+	}
+
+	if program.Budget.IsNil() || program.Budget.BigInt().Sign() <= 0 {
+		return errorsmod.Wrap(ErrInvalidRewardProgram, "budget must be positive")
+	}
+
+	if len(program.ClobPairIds) == 0 {
+		return errorsmod.Wrap(ErrInvalidRewardProgram, "clob_pair_ids cannot be empty")
+	}
+
+	clobPairIds := make(map[uint32]struct{}, len(program.ClobPairIds))
+	for _, clobPairId := range program.ClobPairIds {
+		if _, exists := clobPairIds[clobPairId]; exists {
+			return errorsmod.Wrapf(ErrInvalidRewardProgram, "duplicate clob pair id %d", clobPairId)
+		}
+		clobPairIds[clobPairId] = struct{}{}
+	}
+
+	if program.MakerWeightPpm > lib.OneMillion || program.TakerWeightPpm > lib.OneMillion {
+		return errorsmod.Wrap(
+			ErrInvalidRewardProgram,
+			"maker_weight_ppm and taker_weight_ppm cannot be greater than 1_000_000 (100%)",
+		)
+	}
+
+	if program.MakerWeightPpm == 0 && program.TakerWeightPpm == 0 {
+		return errorsmod.Wrap(
+			ErrInvalidRewardProgram,
+			"at least one of maker_weight_ppm and taker_weight_ppm must be positive",
+		)
+	}
+
+	return nil
+}
+
+// Validate validates the reward program status against the reward program it belongs to.
+func (status RewardProgramStatus) Validate(program RewardProgram) error {
+	if status.ProgramId != program.Id {
+		return errorsmod.Wrapf(
+			ErrInvalidRewardProgramStatus,
+			"program_id %d does not match reward program id %d",
+			status.ProgramId,
+			program.Id,
+		)
+	}
+
+	if status.Distributed.IsNil() || status.Distributed.BigInt().Sign() < 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidRewardProgramStatus,
+			"distributed must be non-negative for reward program %d",
+			status.ProgramId,
+		)
+	}
+
+	if status.Distributed.BigInt().Cmp(program.Budget.BigInt()) > 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidRewardProgramStatus,
+			"distributed %v exceeds budget %v of reward program %d",
+			status.Distributed,
+			program.Budget,
+			status.ProgramId,
+		)
+	}
+
+	if status.LastPayoutTime.After(program.EndTime) {
+		return errorsmod.Wrapf(
+			ErrInvalidRewardProgramStatus,
+			"last_payout_time %v is after end_time %v of reward program %d",
+			status.LastPayoutTime,
+			program.EndTime,
+			status.ProgramId,
+		)
+	}
+
+	return nil
+}
+
+// Validate validates the reward program share.
+func (share RewardProgramShare) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(share.Address); err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidRewardProgramShare,
+			"invalid address %s in reward program %d: %v",
+			share.Address,
+			share.ProgramId,
+			err,
+		)
+	}
+
+	if share.Weight.IsNil() || share.Weight.BigInt().Sign() <= 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidRewardProgramShare,
+			"weight must be positive for address %s in reward program %d",
+			share.Address,
+			share.ProgramId,
+		)
+	}
+
+	return nil
+}
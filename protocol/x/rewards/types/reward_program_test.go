@@ -0,0 +1,222 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	validRewardProgram = types.RewardProgram{
+		Id:             1,
+		StartTime:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:        time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		Budget:         dtypes.NewInt(1_000_000),
+		ClobPairIds:    []uint32{0, 1},
+		MakerWeightPpm: 500_000,
+		TakerWeightPpm: 1_000_000,
+	}
+	validRewardProgramStatus = types.RewardProgramStatus{
+		ProgramId:      1,
+		Distributed:    dtypes.NewInt(100),
+		LastPayoutTime: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	validRewardProgramShare = types.RewardProgramShare{
+		ProgramId: 1,
+		Address:   constants.AliceAccAddress.String(),
+		Weight:    dtypes.NewInt(10),
+	}
+)
+
+func TestRewardProgram_Validate(t *testing.T) {
+	tests := map[string]struct {
+		modify      func(program *types.RewardProgram)
+		expectedErr string
+	}{
+		"Valid": {
+			modify: func(program *types.RewardProgram) {},
+		},
+		"Valid: maker weight is zero": {
+			modify: func(program *types.RewardProgram) {
+				program.MakerWeightPpm = 0
+			},
+		},
+		"Failure: start time equals end time": {
+			modify: func(program *types.RewardProgram) {
+				program.EndTime = program.StartTime
+			},
+			expectedErr: "start_time must be before end_time",
+		},
+		"Failure: start time is not in UTC": {
+			modify: func(program *types.RewardProgram) {
+				program.StartTime = program.StartTime.In(time.FixedZone("UTC+1", 3600))
+			},
+			expectedErr: "start_time and end_time must be in UTC",
+		},
+		"Failure: zero budget": {
+			modify: func(program *types.RewardProgram) {
+				program.Budget = dtypes.NewInt(0)
+			},
+			expectedErr: "budget must be positive",
+		},
+		"Failure: nil budget": {
+			modify: func(program *types.RewardProgram) {
+				program.Budget = dtypes.SerializableInt{}
+			},
+			expectedErr: "budget must be positive",
+		},
+		"Failure: no CLOB pairs": {
+			modify: func(program *types.RewardProgram) {
+				program.ClobPairIds = nil
+			},
+			expectedErr: "clob_pair_ids cannot be empty",
+		},
+		"Failure: duplicate CLOB pairs": {
+			modify: func(program *types.RewardProgram) {
+				program.ClobPairIds = []uint32{0, 1, 0}
+			},
+			expectedErr: "duplicate clob pair id 0",
+		},
+		"Failure: taker weight greater than 100%": {
+			modify: func(program *types.RewardProgram) {
+				program.TakerWeightPpm = 1_000_001
+			},
+			expectedErr: "cannot be greater than 1_000_000",
+		},
+		"Failure: both weights are zero": {
+			modify: func(program *types.RewardProgram) {
+				program.MakerWeightPpm = 0
+				program.TakerWeightPpm = 0
+			},
+			expectedErr: "at least one of maker_weight_ppm and taker_weight_ppm must be positive",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			program := validRewardProgram
+			program.ClobPairIds = append([]uint32{}, validRewardProgram.ClobPairIds...)
+			tc.modify(&program)
+
+			err := program.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidRewardProgram)
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRewardProgramStatus_Validate(t *testing.T) {
+	tests := map[string]struct {
+		modify      func(status *types.RewardProgramStatus)
+		expectedErr string
+	}{
+		"Valid": {
+			modify: func(status *types.RewardProgramStatus) {},
+		},
+		"Valid: never paid out": {
+			modify: func(status *types.RewardProgramStatus) {
+				status.Distributed = dtypes.NewInt(0)
+				status.LastPayoutTime = time.Time{}
+			},
+		},
+		"Valid: whole budget distributed at end time": {
+			modify: func(status *types.RewardProgramStatus) {
+				status.Distributed = validRewardProgram.Budget
+				status.LastPayoutTime = validRewardProgram.EndTime
+			},
+		},
+		"Failure: program id mismatch": {
+			modify: func(status *types.RewardProgramStatus) {
+				status.ProgramId = 2
+			},
+			expectedErr: "does not match reward program id",
+		},
+		"Failure: nil distributed": {
+			modify: func(status *types.RewardProgramStatus) {
+				status.Distributed = dtypes.SerializableInt{}
+			},
+			expectedErr: "distributed",
+		},
+		"Failure: negative distributed": {
+			modify: func(status *types.RewardProgramStatus) {
+				status.Distributed = dtypes.NewInt(-1)
+			},
+			expectedErr: "distributed",
+		},
+		"Failure: distributed exceeds budget": {
+			modify: func(status *types.RewardProgramStatus) {
+				status.Distributed = dtypes.NewInt(1_000_001)
+			},
+			expectedErr: "budget",
+		},
+		"Failure: last payout time after end time": {
+			modify: func(status *types.RewardProgramStatus) {
+				status.LastPayoutTime = validRewardProgram.EndTime.Add(time.Second)
+			},
+			expectedErr: "end_time",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			status := validRewardProgramStatus
+			tc.modify(&status)
+			err := status.Validate(validRewardProgram)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidRewardProgramStatus)
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRewardProgramShare_Validate(t *testing.T) {
+	tests := map[string]struct {
+		modify      func(share *types.RewardProgramShare)
+		expectedErr string
+	}{
+		"Valid": {
+			modify: func(share *types.RewardProgramShare) {},
+		},
+		"Failure: invalid address": {
+			modify: func(share *types.RewardProgramShare) {
+				share.Address = "invalid"
+			},
+			expectedErr: "address",
+		},
+		"Failure: nil weight": {
+			modify: func(share *types.RewardProgramShare) {
+				share.Weight = dtypes.SerializableInt{}
+			},
+			expectedErr: "weight",
+		},
+		"Failure: zero weight": {
+			modify: func(share *types.RewardProgramShare) {
+				share.Weight = dtypes.NewInt(0)
+			},
+			expectedErr: "weight",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			share := validRewardProgramShare
+			tc.modify(&share)
+			err := share.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidRewardProgramShare)
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
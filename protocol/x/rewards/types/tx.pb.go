@@ -121,35 +121,230 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetRewardProgram is the Msg/SetRewardProgram request type.
+type MsgSetRewardProgram struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The reward program to set.
+	Program RewardProgram `protobuf:"bytes,2,opt,name=program,proto3" json:"program"`
+}
+
+func (m *MsgSetRewardProgram) Reset()         { *m = MsgSetRewardProgram{} }
+func (m *MsgSetRewardProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardProgram) ProtoMessage()    {}
+func (*MsgSetRewardProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb349b89bfb07b4, []int{2}
+}
+func (m *MsgSetRewardProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardProgram.Merge(m, src)
+}
+func (m *MsgSetRewardProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardProgram proto.InternalMessageInfo
+
+func (m *MsgSetRewardProgram) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetRewardProgram) GetProgram() RewardProgram {
+	if m != nil {
+		return m.Program
+	}
+	return RewardProgram{}
+}
+
+// MsgSetRewardProgramResponse is the Msg/SetRewardProgram response type.
+type MsgSetRewardProgramResponse struct {
+}
+
+func (m *MsgSetRewardProgramResponse) Reset()         { *m = MsgSetRewardProgramResponse{} }
+func (m *MsgSetRewardProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardProgramResponse) ProtoMessage()    {}
+func (*MsgSetRewardProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb349b89bfb07b4, []int{3}
+}
+func (m *MsgSetRewardProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardProgramResponse.Merge(m, src)
+}
+func (m *MsgSetRewardProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardProgramResponse proto.InternalMessageInfo
+
+// MsgDeleteRewardProgram is the Msg/DeleteRewardProgram request type.
+type MsgDeleteRewardProgram struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the reward program to delete.
+	ProgramId uint32 `protobuf:"varint,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+}
+
+func (m *MsgDeleteRewardProgram) Reset()         { *m = MsgDeleteRewardProgram{} }
+func (m *MsgDeleteRewardProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRewardProgram) ProtoMessage()    {}
+func (*MsgDeleteRewardProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb349b89bfb07b4, []int{4}
+}
+func (m *MsgDeleteRewardProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteRewardProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteRewardProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteRewardProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRewardProgram.Merge(m, src)
+}
+func (m *MsgDeleteRewardProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteRewardProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRewardProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteRewardProgram proto.InternalMessageInfo
+
+func (m *MsgDeleteRewardProgram) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeleteRewardProgram) GetProgramId() uint32 {
+	if m != nil {
+		return m.ProgramId
+	}
+	return 0
+}
+
+// MsgDeleteRewardProgramResponse is the Msg/DeleteRewardProgram response type.
+type MsgDeleteRewardProgramResponse struct {
+}
+
+func (m *MsgDeleteRewardProgramResponse) Reset()         { *m = MsgDeleteRewardProgramResponse{} }
+func (m *MsgDeleteRewardProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRewardProgramResponse) ProtoMessage()    {}
+func (*MsgDeleteRewardProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb349b89bfb07b4, []int{5}
+}
+func (m *MsgDeleteRewardProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteRewardProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteRewardProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteRewardProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRewardProgramResponse.Merge(m, src)
+}
+func (m *MsgDeleteRewardProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteRewardProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRewardProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteRewardProgramResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dydxprotocol.rewards.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dydxprotocol.rewards.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetRewardProgram)(nil), "dydxprotocol.rewards.MsgSetRewardProgram")
+	proto.RegisterType((*MsgSetRewardProgramResponse)(nil), "dydxprotocol.rewards.MsgSetRewardProgramResponse")
+	proto.RegisterType((*MsgDeleteRewardProgram)(nil), "dydxprotocol.rewards.MsgDeleteRewardProgram")
+	proto.RegisterType((*MsgDeleteRewardProgramResponse)(nil), "dydxprotocol.rewards.MsgDeleteRewardProgramResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/rewards/tx.proto", fileDescriptor_ccb349b89bfb07b4) }
 
 var fileDescriptor_ccb349b89bfb07b4 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29,
-	0xd6, 0x2f, 0xa9, 0xd0, 0x03, 0x8b, 0x09, 0x89, 0x20, 0x4b, 0xeb, 0x41, 0xa5, 0xa5, 0x24, 0x93,
-	0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0x12, 0xfa, 0x10, 0x0e, 0x44, 0x83, 0x94, 0x38, 0x84,
-	0xa7, 0x9f, 0x5b, 0x9c, 0xae, 0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x8a, 0x58, 0x2d, 0x2a, 0x48,
-	0x2c, 0x4a, 0xcc, 0x85, 0xe9, 0x15, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x98, 0x09, 0x62, 0x41, 0x44,
-	0x95, 0xa6, 0x32, 0x72, 0xf1, 0xfb, 0x16, 0xa7, 0x87, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0x06, 0x80,
-	0xd5, 0x0b, 0x99, 0x71, 0x71, 0x26, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x54, 0x4a, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0x75, 0x8a, 0x63, 0x4a, 0x4a,
-	0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x42, 0xa9, 0x90, 0x15, 0x17,
-	0x1b, 0xc4, 0x46, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x19, 0x3d, 0x6c, 0xfe, 0xd3, 0x83,
-	0xd8, 0xe2, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x87, 0x15, 0x5f, 0xd3, 0xf3, 0x0d,
-	0x5a, 0x08, 0xb3, 0x94, 0x24, 0xb9, 0xc4, 0xd1, 0x9c, 0x15, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57,
-	0x9c, 0x6a, 0x94, 0xcd, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x94, 0xc2, 0xc5, 0x83, 0xe2, 0x6a, 0x55,
-	0xec, 0xb6, 0xa1, 0x99, 0x22, 0xa5, 0x4b, 0x94, 0x32, 0x98, 0x65, 0x4e, 0xc1, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x99, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
-	0x9c, 0x9f, 0xab, 0x8f, 0x12, 0xfa, 0x65, 0x26, 0xba, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x70,
-	0x91, 0x0a, 0x44, 0xd4, 0x57, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x65, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x8c, 0xc4, 0x35, 0x1f, 0x02, 0x00, 0x00,
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0x05, 0x15, 0x65, 0xf8, 0x2b, 0x37, 0xa2, 0xa9, 0x21, 0x26, 0x04, 0x21, 0xb5,
+	0x88, 0xd8, 0x6a, 0xa9, 0x90, 0xe8, 0x8d, 0xc0, 0x85, 0x83, 0xa5, 0xca, 0x11, 0x17, 0x2e, 0x95,
+	0xeb, 0x5d, 0x6d, 0x2c, 0xd5, 0x59, 0x6b, 0x77, 0x5b, 0xe2, 0x13, 0x12, 0x4f, 0xc0, 0x85, 0x17,
+	0xe0, 0x09, 0x38, 0xf0, 0x10, 0x3d, 0x46, 0x9c, 0x72, 0x42, 0x28, 0x39, 0xf0, 0x1a, 0x28, 0xde,
+	0x75, 0x42, 0x92, 0x8d, 0x14, 0x10, 0xa7, 0xcd, 0xce, 0x7c, 0x33, 0xdf, 0x6f, 0x66, 0x93, 0x40,
+	0x1d, 0xe7, 0xb8, 0x9f, 0x71, 0x26, 0x59, 0xcc, 0xce, 0x7c, 0x4e, 0xde, 0x47, 0x1c, 0x0b, 0x5f,
+	0xf6, 0xbd, 0x22, 0x66, 0x57, 0xff, 0x4c, 0x7b, 0x3a, 0xed, 0xec, 0xc4, 0x4c, 0xa4, 0x4c, 0x9c,
+	0x14, 0x09, 0x5f, 0x5d, 0x54, 0x81, 0xb3, 0xad, 0x6e, 0x7e, 0x2a, 0xa8, 0x7f, 0xb1, 0x3f, 0x39,
+	0x74, 0xe2, 0xa1, 0xd1, 0x28, 0x8b, 0x78, 0x94, 0x96, 0xb5, 0x7b, 0x46, 0x89, 0x3a, 0x27, 0x5e,
+	0x94, 0x47, 0xa9, 0x96, 0x56, 0x29, 0xa3, 0x4c, 0xd9, 0x4f, 0x3e, 0xa9, 0x68, 0xf3, 0x33, 0x82,
+	0xdb, 0x81, 0xa0, 0x6f, 0x33, 0x1c, 0x49, 0x72, 0x5c, 0xb4, 0xb6, 0x9f, 0x43, 0x25, 0x3a, 0x97,
+	0x5d, 0xc6, 0x13, 0x99, 0xd7, 0x50, 0x03, 0xed, 0x56, 0xda, 0xb5, 0xef, 0xdf, 0x5a, 0x55, 0x4d,
+	0xfd, 0x12, 0x63, 0x4e, 0x84, 0xe8, 0x48, 0x9e, 0xf4, 0x68, 0x38, 0x93, 0xda, 0x47, 0xb0, 0xa9,
+	0xe0, 0x6a, 0x1b, 0x0d, 0xb4, 0x7b, 0xfd, 0xe0, 0xbe, 0x67, 0x5a, 0x85, 0xa7, 0x5c, 0xda, 0x57,
+	0x2f, 0x7f, 0x3c, 0xb0, 0x42, 0x5d, 0x71, 0x74, 0xeb, 0xe3, 0xaf, 0xaf, 0x4f, 0x66, 0xbd, 0x9a,
+	0x3b, 0xb0, 0xbd, 0x80, 0x15, 0x12, 0x91, 0xb1, 0x9e, 0x20, 0xcd, 0x2f, 0x08, 0xb6, 0x02, 0x41,
+	0x3b, 0x44, 0x86, 0x45, 0xc7, 0x63, 0x35, 0xe6, 0x3f, 0x63, 0xbf, 0x82, 0x6b, 0x7a, 0x53, 0x9a,
+	0xfb, 0x91, 0x99, 0x7b, 0xce, 0x4d, 0xe3, 0x97, 0x95, 0x4b, 0xfc, 0x75, 0xb8, 0x67, 0x60, 0x9c,
+	0xce, 0xf0, 0x01, 0xee, 0x06, 0x82, 0xbe, 0x26, 0x67, 0x44, 0x92, 0xff, 0x33, 0x45, 0x1d, 0x40,
+	0xb3, 0x9c, 0x24, 0xb8, 0x18, 0xe4, 0x66, 0x58, 0xd1, 0x91, 0x37, 0x78, 0x89, 0xaf, 0x01, 0xae,
+	0x19, 0xa0, 0x44, 0x3c, 0x18, 0x6e, 0xc0, 0x95, 0x40, 0x50, 0x1b, 0xc3, 0x8d, 0xb9, 0x6f, 0xc7,
+	0x63, 0xf3, 0x76, 0x16, 0x5e, 0xcb, 0x69, 0xad, 0x25, 0x2b, 0xdd, 0xec, 0x0c, 0xee, 0x2c, 0x3d,
+	0xe8, 0xde, 0xca, 0x16, 0x8b, 0x52, 0x67, 0x7f, 0x6d, 0xe9, 0xd4, 0x31, 0x87, 0x2d, 0xd3, 0xfe,
+	0x9f, 0xae, 0xec, 0x64, 0x50, 0x3b, 0x87, 0x7f, 0xa3, 0x2e, 0xad, 0xdb, 0x9d, 0xcb, 0x91, 0x8b,
+	0x06, 0x23, 0x17, 0xfd, 0x1c, 0xb9, 0xe8, 0xd3, 0xd8, 0xb5, 0x06, 0x63, 0xd7, 0x1a, 0x8e, 0x5d,
+	0xeb, 0xdd, 0x0b, 0x9a, 0xc8, 0xee, 0xf9, 0xa9, 0x17, 0xb3, 0xd4, 0x9f, 0xfb, 0x69, 0x5f, 0x1c,
+	0xb6, 0xe2, 0x6e, 0x94, 0xf4, 0xfc, 0x69, 0xa4, 0x3f, 0xfb, 0xeb, 0xc9, 0x33, 0x22, 0x4e, 0x37,
+	0x8b, 0xcc, 0xb3, 0xdf, 0x03, 0x00, 0xe6, 0xb5, 0x25, 0x15, 0x9f, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -166,6 +361,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams updates the Params in state.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetRewardProgram sets a RewardProgram in state.
+	SetRewardProgram(ctx context.Context, in *MsgSetRewardProgram, opts ...grpc.CallOption) (*MsgSetRewardProgramResponse, error)
+	// DeleteRewardProgram deletes a RewardProgram from state.
+	DeleteRewardProgram(ctx context.Context, in *MsgDeleteRewardProgram, opts ...grpc.CallOption) (*MsgDeleteRewardProgramResponse, error)
 }
 
 type msgClient struct {
@@ -185,10 +384,32 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetRewardProgram(ctx context.Context, in *MsgSetRewardProgram, opts ...grpc.CallOption) (*MsgSetRewardProgramResponse, error) {
+	out := new(MsgSetRewardProgramResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.rewards.Msg/SetRewardProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteRewardProgram(ctx context.Context, in *MsgDeleteRewardProgram, opts ...grpc.CallOption) (*MsgDeleteRewardProgramResponse, error) {
+	out := new(MsgDeleteRewardProgramResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.rewards.Msg/DeleteRewardProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the Params in state.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetRewardProgram sets a RewardProgram in state.
+	SetRewardProgram(context.Context, *MsgSetRewardProgram) (*MsgSetRewardProgramResponse, error)
+	// DeleteRewardProgram deletes a RewardProgram from state.
+	DeleteRewardProgram(context.Context, *MsgDeleteRewardProgram) (*MsgDeleteRewardProgramResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -198,6 +419,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetRewardProgram(ctx context.Context, req *MsgSetRewardProgram) (*MsgSetRewardProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardProgram not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRewardProgram(ctx context.Context, req *MsgDeleteRewardProgram) (*MsgDeleteRewardProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRewardProgram not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -221,6 +448,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRewardProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.rewards.Msg/SetRewardProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardProgram(ctx, req.(*MsgSetRewardProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteRewardProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRewardProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRewardProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.rewards.Msg/DeleteRewardProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRewardProgram(ctx, req.(*MsgDeleteRewardProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.rewards.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -229,6 +492,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetRewardProgram",
+			Handler:    _Msg_SetRewardProgram_Handler,
+		},
+		{
+			MethodName: "DeleteRewardProgram",
+			Handler:    _Msg_DeleteRewardProgram_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/rewards/tx.proto",
@@ -297,6 +568,127 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetRewardProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Program.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRewardProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRewardProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRewardProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProgramId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProgramId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRewardProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRewardProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRewardProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -332,7 +724,56 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
+func (m *MsgSetRewardProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Program.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRewardProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRewardProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProgramId != 0 {
+		n += 1 + sovTx(uint64(m.ProgramId))
+	}
+	return n
+}
+
+func (m *MsgDeleteRewardProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
@@ -503,6 +944,322 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetRewardProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Program.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRewardProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRewardProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRewardProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			m.ProgramId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProgramId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRewardProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRewardProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRewardProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -60,3 +60,50 @@ func TestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetRewardProgram_ValidateBasic(t *testing.T) {
+	test := map[string]struct {
+		msg         types.MsgSetRewardProgram
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgSetRewardProgram{
+				Authority: validAuthority,
+				Program:   validRewardProgram,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetRewardProgram{
+				Authority: "", // invalid - empty
+				Program:   validRewardProgram,
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+		"Failure: Invalid program": {
+			msg: types.MsgSetRewardProgram{
+				Authority: validAuthority,
+				Program:   types.RewardProgram{}, // invalid - empty
+			},
+			expectedErr: types.ErrInvalidRewardProgram,
+		},
+	}
+	for name, tc := range test {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDeleteRewardProgram_ValidateBasic(t *testing.T) {
+	require.NoError(t, (&types.MsgDeleteRewardProgram{Authority: validAuthority, ProgramId: 1}).ValidateBasic())
+	require.ErrorIs(
+		t,
+		(&types.MsgDeleteRewardProgram{Authority: "", ProgramId: 1}).ValidateBasic(),
+		types.ErrInvalidAuthority,
+	)
+}
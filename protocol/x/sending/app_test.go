@@ -70,14 +70,6 @@ func TestMsgDepositToSubaccount(t *testing.T) {
 			quantums: big.NewInt(7_000_000),
 			asset:    *constants.Usdc,
 		},
-		"Deposit a non-USDC asset": {
-			accountAccAddress:       constants.AliceAccAddress,
-			subaccountId:            constants.Carl_Num0,
-			quantums:                big.NewInt(7_000_000),
-			asset:                   *constants.BtcUsd, // non-USDC asset
-			checkTxResponseContains: "Non-USDC asset transfer not implemented",
-			checkTxIsError:          true,
-		},
 		"Deposit zero amount": {
 			accountAccAddress:       constants.AliceAccAddress,
 			subaccountId:            constants.Carl_Num0,
@@ -264,14 +256,6 @@ func TestMsgWithdrawFromSubaccount(t *testing.T) {
 			quantums:          big.NewInt(7_000_000),
 			asset:             *constants.Usdc,
 		},
-		"Withdraw a non-USDC asset": {
-			accountAccAddress:       constants.AliceAccAddress,
-			subaccountId:            constants.Carl_Num0,
-			quantums:                big.NewInt(7_000_000),
-			asset:                   *constants.BtcUsd, // non-USDC asset
-			checkTxResponseContains: "Non-USDC asset transfer not implemented",
-			checkTxIsError:          true,
-		},
 		"Withdraw zero amount": {
 			accountAccAddress:       constants.AliceAccAddress,
 			subaccountId:            constants.Carl_Num0,
@@ -28,6 +28,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateTransfer())
 	cmd.AddCommand(CmdDepositToSubaccount())
 	cmd.AddCommand(CmdWithdrawFromSubaccount())
+	cmd.AddCommand(CmdTransferPerpetualPosition())
+	cmd.AddCommand(CmdSetIsolatedMargin())
 
 	return cmd
 }
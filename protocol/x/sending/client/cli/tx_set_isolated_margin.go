@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// CmdSetIsolatedMargin sets whether a subaccount is isolated to a single perpetual or cross-margined.
+func CmdSetIsolatedMargin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-isolated-margin [owner_key_or_address] [subaccount_number] [perpetual_id]",
+		Short: "Isolate a subaccount to a perpetual, or make it cross-margined.",
+		Long: `Isolate a subaccount to a perpetual, or make it cross-margined.
+Note, the '--from' flag is ignored as it is implied from [owner_key_or_address].
+[owner_key_or_address] and [subaccount_number] together specify the subaccount.
+[perpetual_id] specifies the perpetual to isolate the subaccount to. If omitted, the subaccount is
+cross-margined.
+`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+			err = cmd.Flags().Set(flags.FlagFrom, argOwner)
+			if err != nil {
+				return err
+			}
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			var isolatedMargin *satypes.IsolatedMargin
+			if len(args) == 3 {
+				argPerpetualId, err := cast.ToUint32E(args[2])
+				if err != nil {
+					return err
+				}
+				isolatedMargin = &satypes.IsolatedMargin{PerpetualId: argPerpetualId}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetIsolatedMargin(
+				satypes.SubaccountId{
+					Owner:  clientCtx.GetFromAddress().String(),
+					Number: argNumber,
+				},
+				isolatedMargin,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdTransferPerpetualPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-perpetual-position owner sender_number recipient_number perpetual_id quantums",
+		Short: "Broadcast message TransferPerpetualPosition",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+			argSenderNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argRecipientNumber, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argPerpetualId, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argQuantums, err := cast.ToUint64E(args[4])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferPerpetualPosition(
+				&types.PerpetualPositionTransfer{
+					Sender: satypes.SubaccountId{
+						Owner:  argOwner,
+						Number: argSenderNumber,
+					},
+					Recipient: satypes.SubaccountId{
+						Owner:  argOwner,
+						Number: argRecipientNumber,
+					},
+					PerpetualId: argPerpetualId,
+					Quantums:    argQuantums,
+				},
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
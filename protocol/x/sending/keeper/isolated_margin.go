@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+)
+
+// ProcessSetIsolatedMargin sets the isolated margin of an `x/subaccounts` subaccount. Collateral is moved
+// in and out of an isolated subaccount with regular transfers, deposits, and withdrawals.
+func (k Keeper) ProcessSetIsolatedMargin(
+	ctx sdk.Context,
+	msg *types.MsgSetIsolatedMargin,
+) error {
+	return k.subaccountsKeeper.SetIsolatedMargin(ctx, msg.SubaccountId, msg.IsolatedMargin)
+}
@@ -21,6 +21,8 @@ type (
 		accountKeeper       types.AccountKeeper
 		bankKeeper          types.BankKeeper
 		subaccountsKeeper   types.SubaccountsKeeper
+		perpetualsKeeper    types.PerpetualsKeeper
+		clobKeeper          types.SendingClobKeeper
 		indexerEventManager indexer_manager.IndexerEventManager
 		authorities         map[string]struct{}
 	}
@@ -32,6 +34,8 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	subaccountsKeeper types.SubaccountsKeeper,
+	perpetualsKeeper types.PerpetualsKeeper,
+	clobKeeper types.SendingClobKeeper,
 	indexerEventManager indexer_manager.IndexerEventManager,
 	authorities []string,
 ) *Keeper {
@@ -41,6 +45,8 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		subaccountsKeeper:   subaccountsKeeper,
+		perpetualsKeeper:    perpetualsKeeper,
+		clobKeeper:          clobKeeper,
 		indexerEventManager: indexerEventManager,
 		authorities:         lib.UniqueSliceToSet(authorities),
 	}
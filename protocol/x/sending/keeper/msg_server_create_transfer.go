@@ -100,6 +100,64 @@ func (k msgServer) WithdrawFromSubaccount(
 	return &types.MsgWithdrawFromSubaccountResponse{}, nil
 }
 
+// TransferPerpetualPosition initiates a transfer of a perpetual position from sender (an `x/subaccounts`
+// subaccount) to a recipient (an `x/subaccounts` subaccount with the same owner).
+func (k msgServer) TransferPerpetualPosition(
+	goCtx context.Context,
+	msg *types.MsgTransferPerpetualPosition,
+) (*types.MsgTransferPerpetualPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Process the transfer by applying subaccount updates.
+	bigQuoteQuantums, err := k.Keeper.ProcessPerpetualPositionTransfer(ctx, msg.Transfer)
+	if err != nil {
+		telemetry.IncrCounter(1, types.ModuleName, metrics.PerpetualPositionTransfer, metrics.Error)
+		return nil, err
+	}
+
+	telemetry.IncrCounter(1, types.ModuleName, metrics.PerpetualPositionTransfer, metrics.Success)
+
+	// emit transfer_perpetual_position event
+	ctx.EventManager().EmitEvent(
+		types.NewTransferPerpetualPositionEvent(
+			msg.Transfer.Sender,
+			msg.Transfer.Recipient,
+			msg.Transfer.PerpetualId,
+			msg.Transfer.Quantums,
+			bigQuoteQuantums,
+		),
+	)
+
+	return &types.MsgTransferPerpetualPositionResponse{}, nil
+}
+
+// SetIsolatedMargin sets whether an `x/subaccounts` subaccount is isolated to a single perpetual or
+// cross-margined.
+func (k msgServer) SetIsolatedMargin(
+	goCtx context.Context,
+	msg *types.MsgSetIsolatedMargin,
+) (*types.MsgSetIsolatedMarginResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.ProcessSetIsolatedMargin(ctx, msg)
+	if err != nil {
+		telemetry.IncrCounter(1, types.ModuleName, metrics.SetIsolatedMargin, metrics.Error)
+		return nil, err
+	}
+
+	telemetry.IncrCounter(1, types.ModuleName, metrics.SetIsolatedMargin, metrics.Success)
+
+	// emit set_isolated_margin event
+	ctx.EventManager().EmitEvent(
+		types.NewSetIsolatedMarginEvent(
+			msg.SubaccountId,
+			msg.IsolatedMargin,
+		),
+	)
+
+	return &types.MsgSetIsolatedMarginResponse{}, nil
+}
+
 // SendFromModuleToAccount sends coins from a module to an account.
 func (k msgServer) SendFromModuleToAccount(
 	goCtx context.Context,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"math/big"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -258,6 +259,85 @@ func TestWithdrawFromSubaccount(t *testing.T) {
 	}
 }
 
+func TestTransferPerpetualPosition(t *testing.T) {
+	testError := errors.New("error")
+	msg := types.NewMsgTransferPerpetualPosition(&types.PerpetualPositionTransfer{
+		Sender:      constants.Carl_Num0,
+		Recipient:   constants.Carl_Num1,
+		PerpetualId: 0,
+		Quantums:    100_000_000,
+	})
+
+	tests := map[string]MsgServerTransferTestCase{
+		"Success": {
+			setupMocks: func(ctx sdk.Context, mck *mocks.SendingKeeper) {
+				mck.On("ProcessPerpetualPositionTransfer", ctx, msg.Transfer).Return(big.NewInt(50_000_000_000), nil)
+			},
+		},
+		"Propagate Error": {
+			setupMocks: func(ctx sdk.Context, mck *mocks.SendingKeeper) {
+				mck.On("ProcessPerpetualPositionTransfer", ctx, msg.Transfer).Return(nil, testError)
+			},
+			expectedErr: testError,
+		},
+	}
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockKeeper, msgServer, goCtx := setUpTestCase(t, tc)
+
+			// Call TransferPerpetualPosition.
+			resp, err := msgServer.TransferPerpetualPosition(goCtx, msg)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+
+				ctx := sdk.UnwrapSDKContext(goCtx)
+				require.Len(t, ctx.EventManager().Events(), 1)
+				event := ctx.EventManager().Events()[0]
+				require.Equal(t, event.Type, types.EventTypeTransferPerpetualPosition)
+				require.Equal(t, event.Attributes, []abci.EventAttribute{
+					{
+						Key:   types.AttributeKeySender,
+						Value: msg.Transfer.Sender.Owner,
+					},
+					{
+						Key:   types.AttributeKeySenderNumber,
+						Value: fmt.Sprintf("%d", msg.Transfer.Sender.Number),
+					},
+					{
+						Key:   types.AttributeKeyRecipient,
+						Value: msg.Transfer.Recipient.Owner,
+					},
+					{
+						Key:   types.AttributeKeyRecipientNumber,
+						Value: fmt.Sprintf("%d", msg.Transfer.Recipient.Number),
+					},
+					{
+						Key:   types.AttributeKeyPerpetualId,
+						Value: fmt.Sprintf("%d", msg.Transfer.PerpetualId),
+					},
+					{
+						Key:   types.AttributeKeyQuantums,
+						Value: fmt.Sprintf("%d", msg.Transfer.Quantums),
+					},
+					{
+						Key:   types.AttributeKeyQuoteQuantums,
+						Value: "50000000000",
+					},
+				})
+			}
+
+			// Assert mock expectations.
+			result := mockKeeper.AssertExpectations(t)
+			require.True(t, result)
+		})
+	}
+}
+
 func TestMsgServerSendFromModuleToAccount(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
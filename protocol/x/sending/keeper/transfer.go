@@ -7,6 +7,7 @@ import (
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	indexer_manager "github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 
+	errorsmod "cosmossdk.io/errors"
 	gometrics "github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,7 +16,7 @@ import (
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
-// ProcessTransfer transfers quote balance between two subaccounts.
+// ProcessTransfer transfers an asset balance between two subaccounts.
 func (k Keeper) ProcessTransfer(
 	ctx sdk.Context,
 	pendingTransfer *types.Transfer,
@@ -78,7 +79,81 @@ func (k Keeper) GenerateTransferEvent(transfer *types.Transfer) *indexerevents.T
 	)
 }
 
-// ProcessDepositToSubaccount transfers quote balance from an account to a subaccount.
+// ProcessPerpetualPositionTransfer transfers a perpetual position between two subaccounts. The recipient
+// pays the sender the value of the transferred position at the oracle price, which is returned. Both
+// subaccounts must remain collateralized after the transfer, and the CLOB pair of the perpetual must be
+// active, since positions of final settlement or paused markets can not be valued at the oracle price.
+func (k Keeper) ProcessPerpetualPositionTransfer(
+	ctx sdk.Context,
+	transfer *types.PerpetualPositionTransfer,
+) (
+	bigQuoteQuantums *big.Int,
+	err error,
+) {
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.ProcessPerpetualPositionTransfer,
+		metrics.Latency,
+	)
+
+	isActive, err := k.clobKeeper.IsPerpetualClobPairActive(ctx, transfer.PerpetualId)
+	if err != nil {
+		return nil, err
+	}
+	if !isActive {
+		return nil, errorsmod.Wrapf(
+			types.ErrPerpetualClobPairNotActive,
+			"Perpetual %d",
+			transfer.PerpetualId,
+		)
+	}
+
+	sender := k.subaccountsKeeper.GetSubaccount(ctx, transfer.Sender)
+	bigPositionQuantums := new(big.Int)
+	if position, exists := sender.GetPerpetualPositionForId(transfer.PerpetualId); exists {
+		bigPositionQuantums = position.GetBigQuantums()
+	}
+
+	bigPositionQuantumsDelta := new(big.Int).SetUint64(transfer.Quantums)
+	if bigPositionQuantums.CmpAbs(bigPositionQuantumsDelta) < 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientPositionSize,
+			"Perpetual %d, position quantums %s, transfer quantums %d",
+			transfer.PerpetualId,
+			bigPositionQuantums.String(),
+			transfer.Quantums,
+		)
+	}
+	// The recipient receives a position in the same direction as the sender's position.
+	if bigPositionQuantums.Sign() < 0 {
+		bigPositionQuantumsDelta.Neg(bigPositionQuantumsDelta)
+	}
+
+	bigQuoteQuantums, err = k.perpetualsKeeper.GetNetNotional(ctx, transfer.PerpetualId, bigPositionQuantumsDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := []satypes.Update{
+		transfer.GetSenderSubaccountUpdate(bigPositionQuantumsDelta, bigQuoteQuantums),
+		transfer.GetRecipientSubaccountUpdate(bigPositionQuantumsDelta, bigQuoteQuantums),
+	}
+
+	success, successPerUpdate, err := k.subaccountsKeeper.UpdateSubaccounts(ctx, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	// If not successful, return error indicating why.
+	if err := satypes.GetErrorFromUpdateResults(success, successPerUpdate, updates); err != nil {
+		return nil, err
+	}
+
+	return bigQuoteQuantums, nil
+}
+
+// ProcessDepositToSubaccount transfers an asset balance from an account to a subaccount.
 func (k Keeper) ProcessDepositToSubaccount(
 	ctx sdk.Context,
 	msgDepositToSubaccount *types.MsgDepositToSubaccount,
@@ -142,7 +217,7 @@ func (k Keeper) GenerateDepositEvent(deposit *types.MsgDepositToSubaccount) *ind
 	)
 }
 
-// ProcessWithdrawFromSubaccount transfers quote balance from a subaccount to an account.
+// ProcessWithdrawFromSubaccount transfers an asset balance from a subaccount to an account.
 func (k Keeper) ProcessWithdrawFromSubaccount(
 	ctx sdk.Context,
 	msgWithdrawFromSubaccount *types.MsgWithdrawFromSubaccount,
@@ -293,6 +293,213 @@ func TestProcessTransfer_CreateRecipientAccount(t *testing.T) {
 	require.True(t, ks.AccountKeeper.HasAccount(ks.Ctx, recipientAddr))
 }
 
+func TestProcessPerpetualPositionTransfer(t *testing.T) {
+	carlNum0Long := satypes.Subaccount{
+		Id: &constants.Carl_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&constants.Usdc_Asset_10_000,
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			&constants.PerpetualPosition_OneBTCLong,
+		},
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		subaccounts      []satypes.Subaccount
+		transfer         *types.PerpetualPositionTransfer
+		inactiveClobPair bool
+		// Expectations.
+		expectedQuoteQuantums     *big.Int
+		expectedSubaccountBalance map[satypes.SubaccountId]*big.Int
+		expectedPositionQuantums  map[satypes.SubaccountId]*big.Int
+		expectedErr               string
+	}{
+		"Transfers a long position": {
+			subaccounts: []satypes.Subaccount{
+				carlNum0Long,
+				{
+					Id: &constants.Carl_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+				},
+			},
+			transfer: &types.PerpetualPositionTransfer{
+				Sender:      constants.Carl_Num0,
+				Recipient:   constants.Carl_Num1,
+				PerpetualId: 0,
+				Quantums:    100_000_000, // 1 BTC
+			},
+			expectedQuoteQuantums: big.NewInt(50_000_000_000),
+			expectedSubaccountBalance: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(60_000_000_000),
+				constants.Carl_Num1: big.NewInt(0),
+			},
+			expectedPositionQuantums: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(0),
+				constants.Carl_Num1: big.NewInt(100_000_000),
+			},
+		},
+		"Transfers part of a short position": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short,
+				{
+					Id: &constants.Carl_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+				},
+			},
+			transfer: &types.PerpetualPositionTransfer{
+				Sender:      constants.Carl_Num0,
+				Recipient:   constants.Carl_Num1,
+				PerpetualId: 0,
+				Quantums:    50_000_000, // 0.5 BTC
+			},
+			expectedQuoteQuantums: big.NewInt(-25_000_000_000),
+			expectedSubaccountBalance: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(75_000_000_000),
+				constants.Carl_Num1: big.NewInt(75_000_000_000),
+			},
+			expectedPositionQuantums: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(-50_000_000),
+				constants.Carl_Num1: big.NewInt(-50_000_000),
+			},
+		},
+		"Recipient is under collateralized": {
+			subaccounts: []satypes.Subaccount{
+				carlNum0Long,
+				{
+					Id: &constants.Carl_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_10_000,
+					},
+				},
+			},
+			transfer: &types.PerpetualPositionTransfer{
+				Sender:      constants.Carl_Num0,
+				Recipient:   constants.Carl_Num1,
+				PerpetualId: 0,
+				Quantums:    100_000_000, // 1 BTC
+			},
+			expectedSubaccountBalance: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(10_000_000_000), // balance unchanged
+				constants.Carl_Num1: big.NewInt(10_000_000_000), // balance unchanged
+			},
+			expectedPositionQuantums: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(100_000_000), // position unchanged
+				constants.Carl_Num1: big.NewInt(0),           // position unchanged
+			},
+			expectedErr: fmt.Sprintf(
+				"Subaccount with id %v failed with UpdateResult: NewlyUndercollateralized: failed to apply subaccount updates",
+				constants.Carl_Num1,
+			),
+		},
+		"Transfer is larger than the sender's position": {
+			subaccounts: []satypes.Subaccount{
+				carlNum0Long,
+			},
+			transfer: &types.PerpetualPositionTransfer{
+				Sender:      constants.Carl_Num0,
+				Recipient:   constants.Carl_Num1,
+				PerpetualId: 0,
+				Quantums:    200_000_000, // 2 BTC
+			},
+			expectedPositionQuantums: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(100_000_000), // position unchanged
+			},
+			expectedErr: types.ErrInsufficientPositionSize.Error(),
+		},
+		"Sender has no position": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_599USD,
+			},
+			transfer: &types.PerpetualPositionTransfer{
+				Sender:      constants.Carl_Num0,
+				Recipient:   constants.Carl_Num1,
+				PerpetualId: 0,
+				Quantums:    1,
+			},
+			expectedErr: types.ErrInsufficientPositionSize.Error(),
+		},
+		"CLOB pair of the perpetual is not active": {
+			subaccounts: []satypes.Subaccount{
+				carlNum0Long,
+				{
+					Id: &constants.Carl_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+				},
+			},
+			transfer: &types.PerpetualPositionTransfer{
+				Sender:      constants.Carl_Num0,
+				Recipient:   constants.Carl_Num1,
+				PerpetualId: 0,
+				Quantums:    100_000_000, // 1 BTC
+			},
+			inactiveClobPair: true,
+			expectedSubaccountBalance: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(10_000_000_000), // balance unchanged
+				constants.Carl_Num1: big.NewInt(50_000_000_000), // balance unchanged
+			},
+			expectedPositionQuantums: map[satypes.SubaccountId]*big.Int{
+				constants.Carl_Num0: big.NewInt(100_000_000), // position unchanged
+				constants.Carl_Num1: big.NewInt(0),           // position unchanged
+			},
+			expectedErr: types.ErrPerpetualClobPairNotActive.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ks := keepertest.SendingKeepers(t)
+			ks.ClobKeeper.On("IsPerpetualClobPairActive", ks.Ctx, tc.transfer.PerpetualId).Return(!tc.inactiveClobPair, nil)
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+
+			p := constants.BtcUsd_100PercentMarginRequirement
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+			)
+			require.NoError(t, err)
+
+			for _, s := range tc.subaccounts {
+				ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, s)
+			}
+
+			bigQuoteQuantums, err := ks.SendingKeeper.ProcessPerpetualPositionTransfer(ks.Ctx, tc.transfer)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedQuoteQuantums, bigQuoteQuantums)
+			}
+
+			for subaccountId, expectedQuoteBalance := range tc.expectedSubaccountBalance {
+				subaccount := ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, subaccountId)
+				require.Equal(t, expectedQuoteBalance.String(), subaccount.GetUsdcPosition().String())
+			}
+			for subaccountId, expectedQuantums := range tc.expectedPositionQuantums {
+				subaccount := ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, subaccountId)
+				bigQuantums := new(big.Int)
+				if position, exists := subaccount.GetPerpetualPositionForId(tc.transfer.PerpetualId); exists {
+					bigQuantums = position.GetBigQuantums()
+				}
+				require.Equal(t, expectedQuantums.String(), bigQuantums.String())
+			}
+		})
+	}
+}
+
 func TestProcessDepositToSubaccount(t *testing.T) {
 	testError := errors.New("error")
 
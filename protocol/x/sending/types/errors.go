@@ -15,12 +15,22 @@ var (
 		5,
 		"Transfer does not contain all required fields",
 	)
-	ErrInvalidAccountAddress              = errorsmod.Register(ModuleName, 6, "Account address is invalid")
-	ErrEmptyModuleName                    = errorsmod.Register(ModuleName, 7, "Module name is empty")
-	ErrInvalidAuthority                   = errorsmod.Register(ModuleName, 8, "Authority is invalid")
-	ErrNonUsdcAssetTransferNotImplemented = errorsmod.Register(
+	ErrInvalidAccountAddress                = errorsmod.Register(ModuleName, 6, "Account address is invalid")
+	ErrEmptyModuleName                      = errorsmod.Register(ModuleName, 7, "Module name is empty")
+	ErrInvalidAuthority                     = errorsmod.Register(ModuleName, 8, "Authority is invalid")
+	ErrSenderOwnerDiffersFromRecipientOwner = errorsmod.Register(
 		ModuleName,
-		1101,
-		"Non-USDC asset transfer not implemented",
+		9,
+		"Sender and recipient subaccounts have different owners",
+	)
+	ErrInsufficientPositionSize = errorsmod.Register(
+		ModuleName,
+		10,
+		"Sender perpetual position is smaller than the transfer amount",
+	)
+	ErrPerpetualClobPairNotActive = errorsmod.Register(
+		ModuleName,
+		11,
+		"Positions can only be transferred for perpetuals with an active CLOB pair",
 	)
 )
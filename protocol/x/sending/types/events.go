@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
@@ -9,16 +10,22 @@ import (
 
 // sending module event types
 const (
-	EventTypeCreateTransfer         = "create_transfer"
-	EventTypeDepositToSubaccount    = "deposit_to_subaccount"
-	EventTypeWithdrawFromSubaccount = "withdraw_from_subaccount"
+	EventTypeCreateTransfer            = "create_transfer"
+	EventTypeDepositToSubaccount       = "deposit_to_subaccount"
+	EventTypeWithdrawFromSubaccount    = "withdraw_from_subaccount"
+	EventTypeTransferPerpetualPosition = "transfer_perpetual_position"
+	EventTypeSetIsolatedMargin         = "set_isolated_margin"
 
-	AttributeKeySender          = "sender"
-	AttributeKeySenderNumber    = "sender_number"
-	AttributeKeyRecipient       = "recipient"
-	AttributeKeyRecipientNumber = "recipient_number"
-	AttributeKeyQuantums        = "quantums"
-	AttributeKeyAssetId         = "asset_id"
+	AttributeKeySender           = "sender"
+	AttributeKeySenderNumber     = "sender_number"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyRecipientNumber  = "recipient_number"
+	AttributeKeyQuantums         = "quantums"
+	AttributeKeyAssetId          = "asset_id"
+	AttributeKeyPerpetualId      = "perpetual_id"
+	AttributeKeyQuoteQuantums    = "quote_quantums"
+	AttributeKeySubaccount       = "subaccount"
+	AttributeKeySubaccountNumber = "subaccount_number"
 )
 
 // NewCreateTransferEvent constructs a new create_transfer sdk.Event
@@ -72,3 +79,42 @@ func NewWithdrawFromSubaccountEvent(
 		sdk.NewAttribute(AttributeKeyQuantums, fmt.Sprintf("%d", quantums)),
 	)
 }
+
+// NewTransferPerpetualPositionEvent constructs a new transfer_perpetual_position sdk.Event
+func NewTransferPerpetualPositionEvent(
+	sender satypes.SubaccountId,
+	recipient satypes.SubaccountId,
+	perpetualId uint32,
+	quantums uint64,
+	quoteQuantums *big.Int,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeTransferPerpetualPosition,
+		sdk.NewAttribute(AttributeKeySender, sender.Owner),
+		sdk.NewAttribute(AttributeKeySenderNumber, fmt.Sprintf("%d", sender.Number)),
+		sdk.NewAttribute(AttributeKeyRecipient, recipient.Owner),
+		sdk.NewAttribute(AttributeKeyRecipientNumber, fmt.Sprintf("%d", recipient.Number)),
+		sdk.NewAttribute(AttributeKeyPerpetualId, fmt.Sprintf("%d", perpetualId)),
+		sdk.NewAttribute(AttributeKeyQuantums, fmt.Sprintf("%d", quantums)),
+		sdk.NewAttribute(AttributeKeyQuoteQuantums, quoteQuantums.String()),
+	)
+}
+
+// NewSetIsolatedMarginEvent constructs a new set_isolated_margin sdk.Event. The perpetual id attribute
+// is only set if the subaccount is isolated.
+func NewSetIsolatedMarginEvent(
+	subaccountId satypes.SubaccountId,
+	isolatedMargin *satypes.IsolatedMargin,
+) sdk.Event {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeySubaccount, subaccountId.Owner),
+		sdk.NewAttribute(AttributeKeySubaccountNumber, fmt.Sprintf("%d", subaccountId.Number)),
+	}
+	if isolatedMargin != nil {
+		attributes = append(
+			attributes,
+			sdk.NewAttribute(AttributeKeyPerpetualId, fmt.Sprintf("%d", isolatedMargin.PerpetualId)),
+		)
+	}
+	return sdk.NewEvent(EventTypeSetIsolatedMargin, attributes...)
+}
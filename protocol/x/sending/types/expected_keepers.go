@@ -52,12 +52,37 @@ type SubaccountsKeeper interface {
 		amount *big.Int,
 	) (err error)
 	SetSubaccount(ctx sdk.Context, subaccount satypes.Subaccount)
+	SetIsolatedMargin(
+		ctx sdk.Context,
+		id satypes.SubaccountId,
+		isolatedMargin *satypes.IsolatedMargin,
+	) error
 	GetSubaccount(
 		ctx sdk.Context,
 		id satypes.SubaccountId,
 	) (val satypes.Subaccount)
 }
 
+// PerpetualsKeeper defines the expected perpetuals keeper used to value transferred positions.
+type PerpetualsKeeper interface {
+	GetNetNotional(
+		ctx sdk.Context,
+		id uint32,
+		bigQuantums *big.Int,
+	) (
+		bigNetNotionalQuoteQuantums *big.Int,
+		err error,
+	)
+}
+
+// SendingClobKeeper defines the expected clob keeper used to determine which positions can be transferred.
+type SendingClobKeeper interface {
+	IsPerpetualClobPairActive(
+		ctx sdk.Context,
+		perpetualId uint32,
+	) (bool, error)
+}
+
 // AccountKeeper defines the expected account keeper used for simulations.
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var _ sdk.Msg = &MsgSetIsolatedMargin{}
+
+// NewMsgSetIsolatedMargin constructs a `MsgSetIsolatedMargin` from an `x/subaccounts` subaccount
+// and the perpetual to isolate it to. A nil `isolatedMargin` makes the subaccount cross-margined.
+func NewMsgSetIsolatedMargin(
+	subaccountId satypes.SubaccountId,
+	isolatedMargin *satypes.IsolatedMargin,
+) *MsgSetIsolatedMargin {
+	return &MsgSetIsolatedMargin{
+		SubaccountId:   subaccountId,
+		IsolatedMargin: isolatedMargin,
+	}
+}
+
+// GetSigners specifies that the owner of the subaccount must sign.
+func (msg *MsgSetIsolatedMargin) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic runs validation on the fields of a MsgSetIsolatedMargin.
+func (msg *MsgSetIsolatedMargin) ValidateBasic() error {
+	return msg.SubaccountId.Validate()
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetIsolatedMargin_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg types.MsgSetIsolatedMargin
+		err error
+	}{
+		"Valid - isolate subaccount": {
+			msg: *types.NewMsgSetIsolatedMargin(
+				constants.Alice_Num0,
+				&satypes.IsolatedMargin{PerpetualId: 1},
+			),
+		},
+		"Valid - cross-margin subaccount": {
+			msg: *types.NewMsgSetIsolatedMargin(constants.Alice_Num0, nil),
+		},
+		"Invalid subaccount owner": {
+			msg: types.MsgSetIsolatedMargin{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  "invalid_owner",
+					Number: uint32(0),
+				},
+			},
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"Invalid subaccount number": {
+			msg: types.MsgSetIsolatedMargin{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  constants.AliceAccAddress.String(),
+					Number: uint32(128),
+				},
+			},
+			err: satypes.ErrInvalidSubaccountIdNumber,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetIsolatedMargin_GetSigners(t *testing.T) {
+	msg := types.NewMsgSetIsolatedMargin(constants.Alice_Num1, nil)
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgTransferPerpetualPosition{}
+
+func NewMsgTransferPerpetualPosition(transfer *PerpetualPositionTransfer) *MsgTransferPerpetualPosition {
+	return &MsgTransferPerpetualPosition{
+		Transfer: transfer,
+	}
+}
+
+// GetSigners returns the owner of the sender subaccount. Returns no signers if the transfer is
+// missing or the owner is not a valid address, which is rejected by `ValidateBasic`.
+func (msg *MsgTransferPerpetualPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.GetTransfer().GetSender().Owner)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgTransferPerpetualPosition) ValidateBasic() error {
+	if msg.Transfer == nil {
+		return ErrMissingFields
+	}
+
+	err := msg.Transfer.Sender.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = msg.Transfer.Recipient.Validate()
+	if err != nil {
+		return err
+	}
+
+	if msg.Transfer.Sender == msg.Transfer.Recipient {
+		return errorsmod.Wrapf(ErrSenderSameAsRecipient, "Sender is the same as recipient (%s)", &msg.Transfer.Sender)
+	}
+
+	if msg.Transfer.Sender.Owner != msg.Transfer.Recipient.Owner {
+		return errorsmod.Wrapf(
+			ErrSenderOwnerDiffersFromRecipientOwner,
+			"Sender owner (%s), recipient owner (%s)",
+			msg.Transfer.Sender.Owner,
+			msg.Transfer.Recipient.Owner,
+		)
+	}
+
+	if msg.Transfer.Quantums == uint64(0) {
+		return ErrInvalidTransferAmount
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferPerpetualPosition_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgTransferPerpetualPosition
+		err  error
+	}{
+		{
+			name: "Missing transfer",
+			msg:  types.MsgTransferPerpetualPosition{},
+			err:  types.ErrMissingFields,
+		},
+		{
+			name: "Invalid sender owner",
+			msg: types.MsgTransferPerpetualPosition{
+				Transfer: &types.PerpetualPositionTransfer{
+					Sender: satypes.SubaccountId{
+						Owner:  "invalid_owner",
+						Number: uint32(0),
+					},
+					Recipient: constants.Carl_Num1,
+					Quantums:  100_000_000,
+				},
+			},
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		{
+			name: "Invalid recipient number",
+			msg: types.MsgTransferPerpetualPosition{
+				Transfer: &types.PerpetualPositionTransfer{
+					Sender: constants.Carl_Num0,
+					Recipient: satypes.SubaccountId{
+						Owner:  constants.Carl_Num0.Owner,
+						Number: uint32(9999),
+					},
+					Quantums: 100_000_000,
+				},
+			},
+			err: satypes.ErrInvalidSubaccountIdNumber,
+		},
+		{
+			name: "Same sender and recipient",
+			msg: types.MsgTransferPerpetualPosition{
+				Transfer: &types.PerpetualPositionTransfer{
+					Sender:    constants.Carl_Num0,
+					Recipient: constants.Carl_Num0,
+					Quantums:  100_000_000,
+				},
+			},
+			err: types.ErrSenderSameAsRecipient,
+		},
+		{
+			name: "Sender and recipient have different owners",
+			msg: types.MsgTransferPerpetualPosition{
+				Transfer: &types.PerpetualPositionTransfer{
+					Sender:    constants.Carl_Num0,
+					Recipient: constants.Dave_Num0,
+					Quantums:  100_000_000,
+				},
+			},
+			err: types.ErrSenderOwnerDiffersFromRecipientOwner,
+		},
+		{
+			name: "Invalid quantums",
+			msg: types.MsgTransferPerpetualPosition{
+				Transfer: &types.PerpetualPositionTransfer{
+					Sender:    constants.Carl_Num0,
+					Recipient: constants.Carl_Num1,
+					Quantums:  0,
+				},
+			},
+			err: types.ErrInvalidTransferAmount,
+		},
+		{
+			name: "Valid",
+			msg: types.MsgTransferPerpetualPosition{
+				Transfer: &types.PerpetualPositionTransfer{
+					Sender:      constants.Carl_Num0,
+					Recipient:   constants.Carl_Num1,
+					PerpetualId: 0,
+					Quantums:    100_000_000,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgTransferPerpetualPosition_GetSigners(t *testing.T) {
+	msg := types.NewMsgTransferPerpetualPosition(&types.PerpetualPositionTransfer{
+		Sender:    constants.Carl_Num0,
+		Recipient: constants.Carl_Num1,
+		Quantums:  100_000_000,
+	})
+	require.Equal(t, []sdk.AccAddress{constants.CarlAccAddress}, msg.GetSigners())
+
+	msg.Transfer.Sender.Owner = "invalid_owner"
+	require.Empty(t, msg.GetSigners())
+
+	require.Empty(t, (&types.MsgTransferPerpetualPosition{}).GetSigners())
+}
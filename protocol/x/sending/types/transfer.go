@@ -3,6 +3,7 @@ package types
 import (
 	"math/big"
 
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -39,3 +40,51 @@ func (t *Transfer) GetRecipientSubaccountUpdate() (update types.Update) {
 func (t *Transfer) GetBigQuantums() (bigNotional *big.Int) {
 	return new(big.Int).SetUint64(t.Amount)
 }
+
+// GetSenderSubaccountUpdate returns the sender subaccount update for this perpetual position transfer.
+// `bigPositionQuantumsDelta` is the change in the recipient's position, and `bigQuoteQuantums` is the
+// value of that change at the oracle price, which the recipient pays to the sender.
+func (t *PerpetualPositionTransfer) GetSenderSubaccountUpdate(
+	bigPositionQuantumsDelta *big.Int,
+	bigQuoteQuantums *big.Int,
+) (update types.Update) {
+	return types.Update{
+		SubaccountId: t.Sender,
+		AssetUpdates: []types.AssetUpdate{
+			{
+				AssetId:          assettypes.AssetUsdc.Id,
+				BigQuantumsDelta: new(big.Int).Set(bigQuoteQuantums),
+			},
+		},
+		PerpetualUpdates: []types.PerpetualUpdate{
+			{
+				PerpetualId:      t.PerpetualId,
+				BigQuantumsDelta: new(big.Int).Neg(bigPositionQuantumsDelta),
+			},
+		},
+	}
+}
+
+// GetRecipientSubaccountUpdate returns the recipient subaccount update for this perpetual position transfer.
+// `bigPositionQuantumsDelta` is the change in the recipient's position, and `bigQuoteQuantums` is the
+// value of that change at the oracle price, which the recipient pays to the sender.
+func (t *PerpetualPositionTransfer) GetRecipientSubaccountUpdate(
+	bigPositionQuantumsDelta *big.Int,
+	bigQuoteQuantums *big.Int,
+) (update types.Update) {
+	return types.Update{
+		SubaccountId: t.Recipient,
+		AssetUpdates: []types.AssetUpdate{
+			{
+				AssetId:          assettypes.AssetUsdc.Id,
+				BigQuantumsDelta: new(big.Int).Neg(bigQuoteQuantums),
+			},
+		},
+		PerpetualUpdates: []types.PerpetualUpdate{
+			{
+				PerpetualId:      t.PerpetualId,
+				BigQuantumsDelta: new(big.Int).Set(bigPositionQuantumsDelta),
+			},
+		},
+	}
+}
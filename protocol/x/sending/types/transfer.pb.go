@@ -100,6 +100,83 @@ func (m *Transfer) GetAmount() uint64 {
 	return 0
 }
 
+// PerpetualPositionTransfer represents a transfer of a perpetual position
+// between two subaccounts with the same owner. The position is transferred at
+// the oracle price of the perpetual's market.
+type PerpetualPositionTransfer struct {
+	// The sender subaccount ID.
+	Sender types.SubaccountId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender"`
+	// The recipient subaccount ID.
+	Recipient types.SubaccountId `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient"`
+	// Id of the perpetual whose position is transferred.
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The number of base quantums of the sender's position to transfer. The
+	// recipient receives a position in the same direction (long or short) as the
+	// sender's position, which must be at least this large.
+	Quantums uint64 `protobuf:"varint,4,opt,name=quantums,proto3" json:"quantums,omitempty"`
+}
+
+func (m *PerpetualPositionTransfer) Reset()         { *m = PerpetualPositionTransfer{} }
+func (m *PerpetualPositionTransfer) String() string { return proto.CompactTextString(m) }
+func (*PerpetualPositionTransfer) ProtoMessage()    {}
+func (*PerpetualPositionTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ef1d018df19de71, []int{1}
+}
+func (m *PerpetualPositionTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualPositionTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualPositionTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualPositionTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualPositionTransfer.Merge(m, src)
+}
+func (m *PerpetualPositionTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualPositionTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualPositionTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualPositionTransfer proto.InternalMessageInfo
+
+func (m *PerpetualPositionTransfer) GetSender() types.SubaccountId {
+	if m != nil {
+		return m.Sender
+	}
+	return types.SubaccountId{}
+}
+
+func (m *PerpetualPositionTransfer) GetRecipient() types.SubaccountId {
+	if m != nil {
+		return m.Recipient
+	}
+	return types.SubaccountId{}
+}
+
+func (m *PerpetualPositionTransfer) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *PerpetualPositionTransfer) GetQuantums() uint64 {
+	if m != nil {
+		return m.Quantums
+	}
+	return 0
+}
+
 // MsgDepositToSubaccount represents a single transfer from an `x/bank`
 // account to an `x/subaccounts` subaccount.
 type MsgDepositToSubaccount struct {
@@ -117,7 +194,7 @@ func (m *MsgDepositToSubaccount) Reset()         { *m = MsgDepositToSubaccount{}
 func (m *MsgDepositToSubaccount) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositToSubaccount) ProtoMessage()    {}
 func (*MsgDepositToSubaccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ef1d018df19de71, []int{1}
+	return fileDescriptor_6ef1d018df19de71, []int{2}
 }
 func (m *MsgDepositToSubaccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +268,7 @@ func (m *MsgWithdrawFromSubaccount) Reset()         { *m = MsgWithdrawFromSubacc
 func (m *MsgWithdrawFromSubaccount) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawFromSubaccount) ProtoMessage()    {}
 func (*MsgWithdrawFromSubaccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ef1d018df19de71, []int{2}
+	return fileDescriptor_6ef1d018df19de71, []int{3}
 }
 func (m *MsgWithdrawFromSubaccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +344,7 @@ func (m *MsgSendFromModuleToAccount) Reset()         { *m = MsgSendFromModuleToA
 func (m *MsgSendFromModuleToAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgSendFromModuleToAccount) ProtoMessage()    {}
 func (*MsgSendFromModuleToAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ef1d018df19de71, []int{3}
+	return fileDescriptor_6ef1d018df19de71, []int{4}
 }
 func (m *MsgSendFromModuleToAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,6 +403,7 @@ func (m *MsgSendFromModuleToAccount) GetCoin() types1.Coin {
 
 func init() {
 	proto.RegisterType((*Transfer)(nil), "dydxprotocol.sending.Transfer")
+	proto.RegisterType((*PerpetualPositionTransfer)(nil), "dydxprotocol.sending.PerpetualPositionTransfer")
 	proto.RegisterType((*MsgDepositToSubaccount)(nil), "dydxprotocol.sending.MsgDepositToSubaccount")
 	proto.RegisterType((*MsgWithdrawFromSubaccount)(nil), "dydxprotocol.sending.MsgWithdrawFromSubaccount")
 	proto.RegisterType((*MsgSendFromModuleToAccount)(nil), "dydxprotocol.sending.MsgSendFromModuleToAccount")
@@ -336,40 +414,42 @@ func init() {
 }
 
 var fileDescriptor_6ef1d018df19de71 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x6d, 0x88, 0xc9, 0x04, 0x45, 0x96, 0x50, 0x37, 0x39, 0xac, 0x21, 0x82, 0x44,
-	0xb1, 0xbb, 0xa6, 0x95, 0x82, 0xbd, 0x35, 0x16, 0xa1, 0x42, 0x3c, 0x24, 0x01, 0xc1, 0x4b, 0x98,
-	0xdd, 0x19, 0x27, 0x03, 0x9d, 0x99, 0x38, 0x33, 0x1b, 0x9b, 0xab, 0x9f, 0xc0, 0x8f, 0xe2, 0xc1,
-	0x0f, 0xd1, 0x9b, 0xc1, 0x93, 0x78, 0x10, 0x49, 0x0e, 0x7e, 0x0c, 0x65, 0x77, 0x87, 0x6c, 0xf6,
-	0x54, 0xb1, 0xd0, 0xd3, 0xce, 0x9b, 0xff, 0x7b, 0x6f, 0xde, 0xef, 0x3d, 0xde, 0xc2, 0x07, 0x78,
-	0x81, 0x2f, 0x66, 0x4a, 0x1a, 0x19, 0xc9, 0xf3, 0x40, 0x13, 0x81, 0x99, 0xa0, 0x81, 0x51, 0x48,
-	0xe8, 0x77, 0x44, 0xf9, 0xa9, 0xe2, 0x34, 0xb6, 0x9d, 0x7c, 0xeb, 0xd4, 0x6a, 0x46, 0x52, 0x73,
-	0xa9, 0x27, 0xa9, 0x10, 0x64, 0x46, 0x16, 0xd0, 0xf2, 0x32, 0x2b, 0x08, 0x91, 0x26, 0xc1, 0xbc,
-	0x17, 0x12, 0x83, 0x7a, 0x41, 0x24, 0x99, 0xb0, 0xfa, 0x3d, 0xab, 0x73, 0x4d, 0x83, 0x79, 0x2f,
-	0xf9, 0x58, 0xa1, 0x41, 0x25, 0x95, 0x59, 0xc2, 0xe4, 0x64, 0x6f, 0x1f, 0x15, 0x8b, 0x8c, 0x43,
-	0x14, 0x45, 0x32, 0x16, 0x46, 0x6f, 0x9d, 0x33, 0xd7, 0xce, 0x57, 0x00, 0xab, 0x63, 0x5b, 0xbd,
-	0x73, 0x0a, 0x2b, 0x49, 0xb1, 0x44, 0xb9, 0xa0, 0x0d, 0xba, 0xf5, 0x83, 0x87, 0x7e, 0x11, 0x24,
-	0x4f, 0xe4, 0x8f, 0x36, 0xe7, 0x33, 0xdc, 0x2f, 0x5f, 0xfe, 0xbc, 0x5f, 0x1a, 0xda, 0x58, 0xe7,
-	0x15, 0xac, 0x29, 0x12, 0xb1, 0x19, 0x23, 0xc2, 0xb8, 0x3b, 0xff, 0x91, 0x28, 0x0f, 0x77, 0x9a,
-	0xb0, 0x8a, 0xb4, 0x26, 0x66, 0xc2, 0xb0, 0xbb, 0xdb, 0x06, 0xdd, 0xdb, 0xc3, 0x5b, 0xa9, 0x7d,
-	0x86, 0x9d, 0x3d, 0x58, 0x41, 0x3c, 0x89, 0x73, 0xcb, 0x6d, 0xd0, 0x2d, 0x0f, 0xad, 0xd5, 0xf9,
-	0x01, 0xe0, 0xde, 0x40, 0xd3, 0x53, 0x32, 0x93, 0x9a, 0x99, 0xb1, 0xcc, 0x1f, 0x70, 0x9e, 0x16,
-	0xf8, 0x6a, 0x7d, 0xf7, 0xdb, 0x97, 0xfd, 0x86, 0x1d, 0xc4, 0x09, 0xc6, 0x8a, 0x68, 0x3d, 0x32,
-	0x8a, 0x09, 0x7a, 0xd3, 0x2c, 0x2d, 0x58, 0x7d, 0x1f, 0x23, 0x61, 0x62, 0xae, 0x2d, 0xcd, 0xc6,
-	0x3e, 0xae, 0x7f, 0xfc, 0xfd, 0xf9, 0xb1, 0xad, 0xa7, 0xb3, 0x04, 0xb0, 0x39, 0xd0, 0xf4, 0x0d,
-	0x33, 0x53, 0xac, 0xd0, 0x87, 0x97, 0x4a, 0xf2, 0x2d, 0xbe, 0x7c, 0x7e, 0x3b, 0xd7, 0x98, 0xdf,
-	0xd1, 0x36, 0xf3, 0x55, 0x8d, 0xba, 0x36, 0x5f, 0xe7, 0x0f, 0x80, 0xad, 0x81, 0xa6, 0x23, 0x22,
-	0x70, 0x82, 0x33, 0x90, 0x38, 0x3e, 0x27, 0x63, 0x79, 0x62, 0x99, 0x8e, 0x60, 0x0d, 0xc5, 0x66,
-	0x2a, 0x15, 0x33, 0x8b, 0xab, 0xab, 0xd9, 0xb8, 0x3a, 0x4f, 0xa0, 0x93, 0xf1, 0x4c, 0x78, 0x9a,
-	0x71, 0x22, 0x10, 0x27, 0x69, 0x5f, 0x6a, 0xc3, 0xbb, 0x99, 0x92, 0x3d, 0xf5, 0x1a, 0x71, 0x52,
-	0x64, 0xde, 0xfd, 0x77, 0xe6, 0x43, 0x58, 0x4e, 0xd6, 0x34, 0x85, 0xaa, 0x1f, 0x34, 0x7d, 0xeb,
-	0x9f, 0xec, 0xb1, 0x6f, 0xf7, 0xd8, 0x7f, 0x21, 0x99, 0xb0, 0x2d, 0x4e, 0x9d, 0x8f, 0xef, 0x24,
-	0x13, 0xcd, 0x4b, 0xed, 0x8f, 0x2e, 0x57, 0x1e, 0x58, 0xae, 0x3c, 0xf0, 0x6b, 0xe5, 0x81, 0x4f,
-	0x6b, 0xaf, 0xb4, 0x5c, 0x7b, 0xa5, 0xef, 0x6b, 0xaf, 0xf4, 0xf6, 0x39, 0x65, 0x66, 0x1a, 0x87,
-	0x7e, 0x24, 0x79, 0x50, 0xd8, 0xe9, 0xf9, 0xb3, 0xfd, 0x68, 0x8a, 0x98, 0x08, 0x36, 0x37, 0x17,
-	0xf9, 0xcf, 0x68, 0x31, 0x23, 0x3a, 0xac, 0xa4, 0xca, 0xe1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x16, 0x70, 0xd0, 0xce, 0xb1, 0x04, 0x00, 0x00,
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xbb, 0xa5, 0xb6, 0x53, 0x15, 0x09, 0x65, 0x6d, 0x7b, 0x88, 0xb5, 0x82, 0x54,
+	0x71, 0x13, 0xbb, 0x2b, 0x0b, 0xee, 0x6d, 0xeb, 0x22, 0x54, 0xa8, 0x2c, 0x6d, 0x41, 0xf0, 0x52,
+	0x26, 0x99, 0x31, 0x1d, 0x68, 0x66, 0xe2, 0xcc, 0xa4, 0x6e, 0xaf, 0x7e, 0x02, 0x3f, 0x8a, 0x07,
+	0x3f, 0xc4, 0xde, 0x2c, 0x9e, 0xc4, 0x83, 0x48, 0x7b, 0xf0, 0xe2, 0x77, 0x50, 0x92, 0x8c, 0x49,
+	0x73, 0x71, 0xc5, 0x05, 0xd9, 0x53, 0xe7, 0xcd, 0xff, 0xbd, 0x97, 0xff, 0x6f, 0x5e, 0x79, 0xf0,
+	0x0e, 0x5e, 0xe0, 0xd3, 0x40, 0x70, 0xc5, 0x5d, 0x3e, 0xb3, 0x25, 0x61, 0x98, 0x32, 0xcf, 0x56,
+	0x02, 0x31, 0xf9, 0x8a, 0x08, 0x2b, 0x56, 0x8c, 0xda, 0x66, 0x92, 0xa5, 0x93, 0x9a, 0x0d, 0x97,
+	0x4b, 0x9f, 0xcb, 0x49, 0x2c, 0xd8, 0x49, 0x90, 0x14, 0x34, 0xcd, 0x24, 0xb2, 0x1d, 0x24, 0x89,
+	0x3d, 0xef, 0x3a, 0x44, 0xa1, 0xae, 0xed, 0x72, 0xca, 0xb4, 0x7e, 0x53, 0xeb, 0xbe, 0xf4, 0xec,
+	0x79, 0x37, 0xfa, 0xd1, 0x42, 0xcd, 0xe3, 0x1e, 0x4f, 0x1a, 0x46, 0x27, 0x7d, 0x7b, 0x2f, 0x6f,
+	0x32, 0x74, 0x90, 0xeb, 0xf2, 0x90, 0x29, 0xb9, 0x71, 0x4e, 0x52, 0xdb, 0x1f, 0x01, 0x2c, 0x8f,
+	0xb5, 0x7b, 0xe3, 0x18, 0x96, 0x22, 0xb3, 0x44, 0xd4, 0x41, 0x0b, 0x74, 0xaa, 0x7b, 0x77, 0xad,
+	0x3c, 0x48, 0xd6, 0xc8, 0x1a, 0xa5, 0xe7, 0x3e, 0xee, 0x15, 0xcf, 0xbe, 0xde, 0x2a, 0x0c, 0x75,
+	0xad, 0xf1, 0x0c, 0x56, 0x04, 0x71, 0x69, 0x40, 0x09, 0x53, 0xf5, 0xad, 0x7f, 0x68, 0x94, 0x95,
+	0x1b, 0x0d, 0x58, 0x46, 0x52, 0x12, 0x35, 0xa1, 0xb8, 0xbe, 0xdd, 0x02, 0x9d, 0x6b, 0xc3, 0x2b,
+	0x71, 0xdc, 0xc7, 0xc6, 0x0e, 0x2c, 0x21, 0x3f, 0xaa, 0xab, 0x17, 0x5b, 0xa0, 0x53, 0x1c, 0xea,
+	0xa8, 0xfd, 0x03, 0xc0, 0xc6, 0x09, 0x11, 0x01, 0x51, 0x21, 0x9a, 0x9d, 0x70, 0x49, 0x15, 0xe5,
+	0xec, 0x12, 0x23, 0xde, 0x86, 0x57, 0x83, 0xdf, 0x76, 0x33, 0xcc, 0x6a, 0x7a, 0xd7, 0xc7, 0x46,
+	0x13, 0x96, 0x5f, 0x87, 0x88, 0xa9, 0xd0, 0x97, 0x1a, 0x36, 0x8d, 0xdb, 0x5f, 0x00, 0xdc, 0x19,
+	0x48, 0xef, 0x98, 0x04, 0x11, 0xea, 0x98, 0x67, 0x1f, 0x33, 0x1e, 0xe6, 0x58, 0x2b, 0xbd, 0xfa,
+	0xa7, 0x0f, 0xbb, 0x35, 0xfd, 0xbf, 0x3b, 0xc2, 0x58, 0x10, 0x29, 0x47, 0x4a, 0x50, 0xe6, 0xfd,
+	0xef, 0xd1, 0xfd, 0x81, 0xe7, 0xb0, 0xfa, 0xf6, 0xfb, 0xfb, 0xfb, 0xda, 0x4f, 0x7b, 0x09, 0x60,
+	0x63, 0x20, 0xbd, 0x17, 0x54, 0x4d, 0xb1, 0x40, 0x6f, 0x9e, 0x0a, 0xee, 0x6f, 0xf0, 0x65, 0xb3,
+	0xdc, 0xba, 0xc0, 0x2c, 0x0f, 0x36, 0x99, 0xcf, 0x7b, 0xa8, 0x0b, 0xf3, 0xb5, 0x7f, 0x02, 0xd8,
+	0x1c, 0x48, 0x6f, 0x44, 0x18, 0x8e, 0x70, 0x06, 0x1c, 0x87, 0x33, 0x32, 0xe6, 0x47, 0x9a, 0xe9,
+	0x00, 0x56, 0x50, 0xa8, 0xa6, 0x5c, 0x50, 0xb5, 0x38, 0xdf, 0x4d, 0x9a, 0x6a, 0x3c, 0x80, 0x46,
+	0xc2, 0x33, 0xf1, 0xe3, 0x8e, 0x13, 0x86, 0x7c, 0x12, 0xbf, 0x4b, 0x65, 0x78, 0x23, 0x51, 0x92,
+	0x4f, 0x3d, 0x47, 0x3e, 0xc9, 0x33, 0x6f, 0xff, 0x3d, 0xf3, 0x3e, 0x2c, 0x46, 0x5b, 0x29, 0x86,
+	0xaa, 0xee, 0x35, 0x2c, 0x9d, 0x1f, 0xad, 0x2d, 0x4b, 0xaf, 0x2d, 0xeb, 0x09, 0xa7, 0x4c, 0x3f,
+	0x71, 0x9c, 0x7c, 0x78, 0x3d, 0x9a, 0x68, 0x66, 0xb5, 0x37, 0x3a, 0x5b, 0x99, 0x60, 0xb9, 0x32,
+	0xc1, 0xb7, 0x95, 0x09, 0xde, 0xad, 0xcd, 0xc2, 0x72, 0x6d, 0x16, 0x3e, 0xaf, 0xcd, 0xc2, 0xcb,
+	0xc7, 0x1e, 0x55, 0xd3, 0xd0, 0xb1, 0x5c, 0xee, 0xdb, 0xb9, 0x15, 0x36, 0x7f, 0xb4, 0xeb, 0x4e,
+	0x11, 0x65, 0x76, 0x7a, 0x73, 0x9a, 0xed, 0xde, 0x45, 0x40, 0xa4, 0x53, 0x8a, 0x95, 0xfd, 0x5f,
+	0x03, 0x00, 0xc2, 0x90, 0x13, 0x4f, 0xa0, 0x05, 0x00, 0x00,
 }
 
 func (m *Transfer) Marshal() (dAtA []byte, err error) {
@@ -425,6 +505,59 @@ func (m *Transfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PerpetualPositionTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualPositionTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualPositionTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quantums != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Quantums))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Sender.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDepositToSubaccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -609,6 +742,25 @@ func (m *Transfer) Size() (n int) {
 	return n
 }
 
+func (m *PerpetualPositionTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sender.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = m.Recipient.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	if m.PerpetualId != 0 {
+		n += 1 + sovTransfer(uint64(m.PerpetualId))
+	}
+	if m.Quantums != 0 {
+		n += 1 + sovTransfer(uint64(m.Quantums))
+	}
+	return n
+}
+
 func (m *MsgDepositToSubaccount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -834,6 +986,160 @@ func (m *Transfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PerpetualPositionTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualPositionTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualPositionTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			m.Quantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDepositToSubaccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,8 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -223,40 +225,234 @@ func (m *MsgSendFromModuleToAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendFromModuleToAccountResponse proto.InternalMessageInfo
 
+// MsgTransferPerpetualPosition is a request type used for initiating new
+// perpetual position transfers.
+type MsgTransferPerpetualPosition struct {
+	Transfer *PerpetualPositionTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (m *MsgTransferPerpetualPosition) Reset()         { *m = MsgTransferPerpetualPosition{} }
+func (m *MsgTransferPerpetualPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPerpetualPosition) ProtoMessage()    {}
+func (*MsgTransferPerpetualPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056a3cb0feba7dbf, []int{5}
+}
+func (m *MsgTransferPerpetualPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPerpetualPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPerpetualPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferPerpetualPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPerpetualPosition.Merge(m, src)
+}
+func (m *MsgTransferPerpetualPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPerpetualPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPerpetualPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferPerpetualPosition proto.InternalMessageInfo
+
+func (m *MsgTransferPerpetualPosition) GetTransfer() *PerpetualPositionTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return nil
+}
+
+// MsgTransferPerpetualPositionResponse is a response type used for new
+// perpetual position transfers.
+type MsgTransferPerpetualPositionResponse struct {
+}
+
+func (m *MsgTransferPerpetualPositionResponse) Reset()         { *m = MsgTransferPerpetualPositionResponse{} }
+func (m *MsgTransferPerpetualPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPerpetualPositionResponse) ProtoMessage()    {}
+func (*MsgTransferPerpetualPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056a3cb0feba7dbf, []int{6}
+}
+func (m *MsgTransferPerpetualPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPerpetualPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPerpetualPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferPerpetualPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPerpetualPositionResponse.Merge(m, src)
+}
+func (m *MsgTransferPerpetualPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPerpetualPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPerpetualPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferPerpetualPositionResponse proto.InternalMessageInfo
+
+// MsgSetIsolatedMargin is a request type used for setting the isolated margin
+// of a subaccount.
+type MsgSetIsolatedMargin struct {
+	// The subaccount ID.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The perpetual to isolate the subaccount to. If unset, the subaccount is
+	// cross-margined.
+	IsolatedMargin *types.IsolatedMargin `protobuf:"bytes,2,opt,name=isolated_margin,json=isolatedMargin,proto3" json:"isolated_margin,omitempty"`
+}
+
+func (m *MsgSetIsolatedMargin) Reset()         { *m = MsgSetIsolatedMargin{} }
+func (m *MsgSetIsolatedMargin) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIsolatedMargin) ProtoMessage()    {}
+func (*MsgSetIsolatedMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056a3cb0feba7dbf, []int{7}
+}
+func (m *MsgSetIsolatedMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIsolatedMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIsolatedMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIsolatedMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIsolatedMargin.Merge(m, src)
+}
+func (m *MsgSetIsolatedMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIsolatedMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIsolatedMargin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIsolatedMargin proto.InternalMessageInfo
+
+func (m *MsgSetIsolatedMargin) GetSubaccountId() types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return types.SubaccountId{}
+}
+
+func (m *MsgSetIsolatedMargin) GetIsolatedMargin() *types.IsolatedMargin {
+	if m != nil {
+		return m.IsolatedMargin
+	}
+	return nil
+}
+
+// MsgSetIsolatedMarginResponse is a response type used for setting the
+// isolated margin of a subaccount.
+type MsgSetIsolatedMarginResponse struct {
+}
+
+func (m *MsgSetIsolatedMarginResponse) Reset()         { *m = MsgSetIsolatedMarginResponse{} }
+func (m *MsgSetIsolatedMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIsolatedMarginResponse) ProtoMessage()    {}
+func (*MsgSetIsolatedMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056a3cb0feba7dbf, []int{8}
+}
+func (m *MsgSetIsolatedMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIsolatedMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIsolatedMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIsolatedMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIsolatedMarginResponse.Merge(m, src)
+}
+func (m *MsgSetIsolatedMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIsolatedMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIsolatedMarginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIsolatedMarginResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateTransfer)(nil), "dydxprotocol.sending.MsgCreateTransfer")
 	proto.RegisterType((*MsgCreateTransferResponse)(nil), "dydxprotocol.sending.MsgCreateTransferResponse")
 	proto.RegisterType((*MsgDepositToSubaccountResponse)(nil), "dydxprotocol.sending.MsgDepositToSubaccountResponse")
 	proto.RegisterType((*MsgWithdrawFromSubaccountResponse)(nil), "dydxprotocol.sending.MsgWithdrawFromSubaccountResponse")
 	proto.RegisterType((*MsgSendFromModuleToAccountResponse)(nil), "dydxprotocol.sending.MsgSendFromModuleToAccountResponse")
+	proto.RegisterType((*MsgTransferPerpetualPosition)(nil), "dydxprotocol.sending.MsgTransferPerpetualPosition")
+	proto.RegisterType((*MsgTransferPerpetualPositionResponse)(nil), "dydxprotocol.sending.MsgTransferPerpetualPositionResponse")
+	proto.RegisterType((*MsgSetIsolatedMargin)(nil), "dydxprotocol.sending.MsgSetIsolatedMargin")
+	proto.RegisterType((*MsgSetIsolatedMarginResponse)(nil), "dydxprotocol.sending.MsgSetIsolatedMarginResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/sending/tx.proto", fileDescriptor_056a3cb0feba7dbf) }
 
 var fileDescriptor_056a3cb0feba7dbf = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4e, 0xcd, 0x4b, 0xc9, 0xcc, 0x4b,
-	0xd7, 0x2f, 0xa9, 0xd0, 0x03, 0x8b, 0x09, 0x89, 0x20, 0x4b, 0xeb, 0x41, 0xa5, 0xa5, 0x94, 0xb1,
-	0x6b, 0x2a, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0x82, 0x68, 0x55, 0xf2, 0xe7, 0x12, 0xf4, 0x2d,
-	0x4e, 0x77, 0x2e, 0x4a, 0x4d, 0x2c, 0x49, 0x0d, 0x81, 0x4a, 0x09, 0x59, 0x71, 0x71, 0xc0, 0x94,
-	0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe9, 0x61, 0xb3, 0x42, 0x0f, 0xa6, 0x23, 0x08,
-	0xae, 0x5e, 0x49, 0x9a, 0x4b, 0x12, 0xc3, 0xc0, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54,
-	0x25, 0x05, 0x2e, 0x39, 0xdf, 0xe2, 0x74, 0x97, 0xd4, 0x82, 0xfc, 0xe2, 0xcc, 0x92, 0x90, 0xfc,
-	0xe0, 0xd2, 0xa4, 0xc4, 0xe4, 0xe4, 0xfc, 0xd2, 0xbc, 0x12, 0xb8, 0x0a, 0x65, 0x2e, 0x45, 0xdf,
-	0xe2, 0xf4, 0xf0, 0xcc, 0x92, 0x8c, 0x94, 0xa2, 0xc4, 0x72, 0xb7, 0xa2, 0xfc, 0x5c, 0x2c, 0x8a,
-	0x54, 0xb8, 0x94, 0x7c, 0x8b, 0xd3, 0x83, 0x53, 0xf3, 0x52, 0x40, 0x0a, 0x7c, 0xf3, 0x53, 0x4a,
-	0x73, 0x52, 0x43, 0xf2, 0x1d, 0x51, 0x55, 0x19, 0xfd, 0x64, 0xe6, 0x62, 0xf6, 0x2d, 0x4e, 0x17,
-	0xca, 0xe2, 0xe2, 0x43, 0xf3, 0x9f, 0x3a, 0x76, 0xdf, 0x60, 0xb8, 0x5b, 0x4a, 0x9f, 0x48, 0x85,
-	0x30, 0x3b, 0x85, 0x2a, 0xb9, 0x84, 0xb1, 0xf8, 0x4e, 0x48, 0x07, 0xa7, 0x39, 0x58, 0x54, 0x4b,
-	0x99, 0x90, 0xa2, 0x1a, 0x6e, 0x75, 0x13, 0x23, 0x97, 0x18, 0xf6, 0x70, 0x13, 0xc2, 0xed, 0x0d,
-	0xec, 0x1a, 0xa4, 0xcc, 0x49, 0xd4, 0x00, 0x77, 0x44, 0x2b, 0x23, 0x97, 0x38, 0x8e, 0x78, 0x11,
-	0x32, 0xc0, 0x69, 0x28, 0x0e, 0x1d, 0x52, 0x16, 0xa4, 0xea, 0x80, 0xb9, 0xc3, 0x29, 0xf8, 0xc4,
-	0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x2c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93,
-	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0x32, 0x48, 0x99, 0x89, 0x6e, 0x72, 0x46, 0x62, 0x66, 0x9e,
-	0x3e, 0x5c, 0xa4, 0x02, 0x91, 0x69, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x32, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x8a, 0x93, 0x09, 0x8e, 0x03, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xf8, 0x27, 0xf4, 0x80, 0xa1, 0x99, 0x0a, 0xb6, 0x30, 0xc2, 0xc8, 0xa6, 0x31, 0x10,
+	0x24, 0xa8, 0x4c, 0x02, 0x76, 0x63, 0x20, 0xa4, 0x09, 0x45, 0x6c, 0x6d, 0x25, 0x24, 0x2e, 0x53,
+	0x9a, 0x18, 0xd7, 0xd0, 0xc6, 0x51, 0xec, 0x40, 0x7b, 0x45, 0xe2, 0xc4, 0x85, 0x0f, 0xc4, 0x07,
+	0xd8, 0x71, 0x47, 0x4e, 0x08, 0xb5, 0x5f, 0x83, 0x03, 0x6a, 0x96, 0xb8, 0x7f, 0x62, 0x4f, 0xed,
+	0x2d, 0x79, 0xfe, 0xfd, 0x7b, 0xf6, 0xb3, 0xe1, 0x4e, 0xd8, 0x0f, 0x7b, 0x71, 0xc2, 0x04, 0x0b,
+	0x58, 0xc7, 0xe5, 0x38, 0x0a, 0x69, 0x44, 0x5c, 0xd1, 0x73, 0xb2, 0x1a, 0xaa, 0x4e, 0x2e, 0x3b,
+	0xf9, 0xb2, 0x59, 0x25, 0x8c, 0xb0, 0xac, 0xea, 0x8e, 0xbe, 0x4e, 0xb1, 0xe6, 0x86, 0x5a, 0x2a,
+	0xf1, 0x23, 0xfe, 0x11, 0x27, 0x39, 0xe8, 0xc1, 0x34, 0x28, 0x6d, 0xf9, 0x41, 0xc0, 0xd2, 0x48,
+	0xf0, 0x89, 0xef, 0x53, 0xa8, 0xfd, 0x0e, 0x96, 0x3d, 0x4e, 0x5e, 0x25, 0xd8, 0x17, 0xb8, 0x99,
+	0xab, 0xa0, 0x5d, 0xb8, 0x5c, 0x28, 0xae, 0x18, 0xeb, 0xc6, 0xf6, 0x95, 0x9a, 0xe5, 0xa8, 0x32,
+	0x3a, 0x05, 0xa3, 0x2e, 0xf1, 0xf6, 0x6d, 0x58, 0x2d, 0x09, 0xd6, 0x31, 0x8f, 0x59, 0xc4, 0xb1,
+	0xbd, 0x0e, 0x96, 0xc7, 0xc9, 0x6b, 0x1c, 0x33, 0x4e, 0x45, 0x93, 0x35, 0x64, 0x1a, 0x89, 0xd8,
+	0x80, 0x7b, 0x1e, 0x27, 0xef, 0xa9, 0x68, 0x87, 0x89, 0xff, 0xf5, 0x4d, 0xc2, 0xba, 0x0a, 0xd0,
+	0x26, 0xd8, 0x1e, 0x27, 0x0d, 0x1c, 0x85, 0x23, 0x80, 0xc7, 0xc2, 0xb4, 0x83, 0x9b, 0xec, 0xe5,
+	0x0c, 0xea, 0x33, 0xac, 0x79, 0x9c, 0x14, 0x19, 0x0e, 0x70, 0x12, 0x63, 0x91, 0xfa, 0x9d, 0x83,
+	0x91, 0x37, 0x65, 0x11, 0x7a, 0x5b, 0xea, 0xd2, 0x55, 0x77, 0x59, 0xa2, 0x2a, 0xda, 0xde, 0x82,
+	0xcd, 0xb3, 0xcc, 0x64, 0xa8, 0x5f, 0x06, 0x54, 0xb3, 0xec, 0x62, 0x9f, 0xb3, 0x8e, 0x2f, 0x70,
+	0xe8, 0xf9, 0x09, 0xa1, 0x11, 0x3a, 0x84, 0x6b, 0xe3, 0xc3, 0x39, 0xa2, 0x61, 0x1e, 0x69, 0x6b,
+	0x26, 0xd2, 0xf8, 0x2c, 0x9d, 0xf1, 0xc6, 0xec, 0x87, 0x7b, 0x17, 0x8e, 0xff, 0xdc, 0xad, 0xd4,
+	0xaf, 0xf2, 0x89, 0x1a, 0x3a, 0x84, 0xeb, 0x34, 0x37, 0x39, 0xea, 0x66, 0x2e, 0x2b, 0xe7, 0x32,
+	0xd1, 0x6d, 0xbd, 0xe8, 0x74, 0xaa, 0xfa, 0x12, 0x9d, 0xfa, 0xb7, 0x2d, 0x58, 0x53, 0xa5, 0x2f,
+	0xda, 0xab, 0xfd, 0xbb, 0x08, 0xe7, 0x3d, 0x4e, 0xd0, 0x27, 0x58, 0x9a, 0x99, 0xa9, 0xfb, 0xea,
+	0xbd, 0x2d, 0xcd, 0x8a, 0xe9, 0xce, 0x09, 0x2c, 0x3c, 0x51, 0x1f, 0x6e, 0x28, 0x26, 0x0a, 0x3d,
+	0xd2, 0xea, 0x28, 0xd0, 0xe6, 0xce, 0x22, 0x68, 0x69, 0xfd, 0xcd, 0x80, 0x9b, 0xea, 0x59, 0x45,
+	0xfa, 0x36, 0xd4, 0x04, 0xf3, 0xd9, 0x82, 0x04, 0x19, 0xe2, 0xbb, 0x01, 0xb7, 0x34, 0x77, 0x01,
+	0x3d, 0xd1, 0x8a, 0x6a, 0x18, 0xe6, 0xf3, 0x45, 0x19, 0x32, 0xc7, 0x0f, 0x03, 0x56, 0xf5, 0xb7,
+	0xad, 0xa6, 0xd5, 0xd5, 0x72, 0xcc, 0xdd, 0xc5, 0x39, 0x32, 0x0d, 0x87, 0xe5, 0xf2, 0x25, 0x7b,
+	0x78, 0x46, 0x73, 0x33, 0x58, 0xb3, 0x36, 0x3f, 0xb6, 0x30, 0xdd, 0x6b, 0x1c, 0x0f, 0x2c, 0xe3,
+	0x64, 0x60, 0x19, 0x7f, 0x07, 0x96, 0xf1, 0x73, 0x68, 0x55, 0x4e, 0x86, 0x56, 0xe5, 0xf7, 0xd0,
+	0xaa, 0x7c, 0x78, 0x41, 0xa8, 0x68, 0xa7, 0x2d, 0x27, 0x60, 0x5d, 0x77, 0xea, 0x75, 0xfe, 0xb2,
+	0xf3, 0x38, 0x68, 0xfb, 0x34, 0x72, 0x65, 0xa5, 0x37, 0x7e, 0xd6, 0xfb, 0x31, 0xe6, 0xad, 0x4b,
+	0xd9, 0xca, 0xd3, 0xff, 0x03, 0x00, 0xcf, 0x8c, 0x1d, 0xbf, 0x46, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -282,6 +478,12 @@ type MsgClient interface {
 	// SendFromModuleToAccount initiates a new transfer from a module to an
 	// `x/bank` account (should only be executed by governance).
 	SendFromModuleToAccount(ctx context.Context, in *MsgSendFromModuleToAccount, opts ...grpc.CallOption) (*MsgSendFromModuleToAccountResponse, error)
+	// TransferPerpetualPosition initiates a new transfer of a perpetual position
+	// between two subaccounts with the same owner.
+	TransferPerpetualPosition(ctx context.Context, in *MsgTransferPerpetualPosition, opts ...grpc.CallOption) (*MsgTransferPerpetualPositionResponse, error)
+	// SetIsolatedMargin sets whether an `x/subaccounts` subaccount is isolated
+	// to a single perpetual or cross-margined.
+	SetIsolatedMargin(ctx context.Context, in *MsgSetIsolatedMargin, opts ...grpc.CallOption) (*MsgSetIsolatedMarginResponse, error)
 }
 
 type msgClient struct {
@@ -328,6 +530,24 @@ func (c *msgClient) SendFromModuleToAccount(ctx context.Context, in *MsgSendFrom
 	return out, nil
 }
 
+func (c *msgClient) TransferPerpetualPosition(ctx context.Context, in *MsgTransferPerpetualPosition, opts ...grpc.CallOption) (*MsgTransferPerpetualPositionResponse, error) {
+	out := new(MsgTransferPerpetualPositionResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.sending.Msg/TransferPerpetualPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIsolatedMargin(ctx context.Context, in *MsgSetIsolatedMargin, opts ...grpc.CallOption) (*MsgSetIsolatedMarginResponse, error) {
+	out := new(MsgSetIsolatedMarginResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.sending.Msg/SetIsolatedMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateTransfer initiates a new transfer between subaccounts.
@@ -341,6 +561,12 @@ type MsgServer interface {
 	// SendFromModuleToAccount initiates a new transfer from a module to an
 	// `x/bank` account (should only be executed by governance).
 	SendFromModuleToAccount(context.Context, *MsgSendFromModuleToAccount) (*MsgSendFromModuleToAccountResponse, error)
+	// TransferPerpetualPosition initiates a new transfer of a perpetual position
+	// between two subaccounts with the same owner.
+	TransferPerpetualPosition(context.Context, *MsgTransferPerpetualPosition) (*MsgTransferPerpetualPositionResponse, error)
+	// SetIsolatedMargin sets whether an `x/subaccounts` subaccount is isolated
+	// to a single perpetual or cross-margined.
+	SetIsolatedMargin(context.Context, *MsgSetIsolatedMargin) (*MsgSetIsolatedMarginResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -359,6 +585,12 @@ func (*UnimplementedMsgServer) WithdrawFromSubaccount(ctx context.Context, req *
 func (*UnimplementedMsgServer) SendFromModuleToAccount(ctx context.Context, req *MsgSendFromModuleToAccount) (*MsgSendFromModuleToAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendFromModuleToAccount not implemented")
 }
+func (*UnimplementedMsgServer) TransferPerpetualPosition(ctx context.Context, req *MsgTransferPerpetualPosition) (*MsgTransferPerpetualPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPerpetualPosition not implemented")
+}
+func (*UnimplementedMsgServer) SetIsolatedMargin(ctx context.Context, req *MsgSetIsolatedMargin) (*MsgSetIsolatedMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsolatedMargin not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -436,6 +668,42 @@ func _Msg_SendFromModuleToAccount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferPerpetualPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPerpetualPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPerpetualPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.sending.Msg/TransferPerpetualPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPerpetualPosition(ctx, req.(*MsgTransferPerpetualPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIsolatedMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIsolatedMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIsolatedMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.sending.Msg/SetIsolatedMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIsolatedMargin(ctx, req.(*MsgSetIsolatedMargin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.sending.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -456,6 +724,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendFromModuleToAccount",
 			Handler:    _Msg_SendFromModuleToAccount_Handler,
 		},
+		{
+			MethodName: "TransferPerpetualPosition",
+			Handler:    _Msg_TransferPerpetualPosition_Handler,
+		},
+		{
+			MethodName: "SetIsolatedMargin",
+			Handler:    _Msg_SetIsolatedMargin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/sending/tx.proto",
@@ -588,6 +864,132 @@ func (m *MsgSendFromModuleToAccountResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferPerpetualPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPerpetualPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPerpetualPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPerpetualPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPerpetualPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPerpetualPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIsolatedMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIsolatedMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIsolatedMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsolatedMargin != nil {
+		{
+			size, err := m.IsolatedMargin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIsolatedMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIsolatedMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIsolatedMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -648,15 +1050,61 @@ func (m *MsgSendFromModuleToAccountResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgCreateTransfer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *MsgTransferPerpetualPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferPerpetualPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetIsolatedMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.IsolatedMargin != nil {
+		l = m.IsolatedMargin.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetIsolatedMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -940,6 +1388,311 @@ func (m *MsgSendFromModuleToAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferPerpetualPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferPerpetualPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferPerpetualPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transfer == nil {
+				m.Transfer = &PerpetualPositionTransfer{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferPerpetualPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferPerpetualPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferPerpetualPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIsolatedMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIsolatedMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIsolatedMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedMargin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IsolatedMargin == nil {
+				m.IsolatedMargin = &types.IsolatedMargin{}
+			}
+			if err := m.IsolatedMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIsolatedMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIsolatedMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIsolatedMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,4 +21,15 @@ type SendingKeeper interface {
 		msg *MsgSendFromModuleToAccount,
 	) error
 	HasAuthority(authority string) bool
+	ProcessPerpetualPositionTransfer(
+		ctx sdk.Context,
+		transfer *PerpetualPositionTransfer,
+	) (
+		bigQuoteQuantums *big.Int,
+		err error,
+	)
+	ProcessSetIsolatedMargin(
+		ctx sdk.Context,
+		msg *MsgSetIsolatedMargin,
+	) error
 }
@@ -25,6 +25,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryStatsMetadata())
 	cmd.AddCommand(CmdQueryGlobalStats())
 	cmd.AddCommand(CmdQueryUserStats())
+	cmd.AddCommand(CmdQueryReferralStats())
 
 	return cmd
 }
@@ -123,3 +124,29 @@ func CmdQueryUserStats() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryReferralStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-referral-stats [user]",
+		Short: "get referral stats of a referrer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ReferralStats(
+				context.Background(),
+				&types.QueryReferralStatsRequest{
+					User: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -82,3 +82,13 @@ func TestQueryUserStats(t *testing.T) {
 	var resp types.QueryUserStatsResponse
 	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
 }
+
+func TestQueryReferralStats(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryReferralStats(), []string{"alice"})
+
+	require.NoError(t, err)
+	var resp types.QueryReferralStatsResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+}
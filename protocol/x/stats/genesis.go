@@ -13,11 +13,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+
+	for _, trader := range genState.Traders {
+		k.SetTrader(ctx, trader)
+	}
+	for _, referralStats := range genState.ReferralStats {
+		stats := referralStats.Stats
+		k.SetReferralStats(ctx, referralStats.Referrer, &stats)
+	}
 }
 
 // ExportGenesis returns the stat module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:        k.GetParams(ctx),
+		Traders:       append([]string{}, k.GetAllTraders(ctx)...),
+		ReferralStats: append([]types.ReferrerReferralStats{}, k.GetAllReferralStats(ctx)...),
 	}
 }
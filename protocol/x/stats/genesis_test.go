@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/stats"
 	"github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
 	"github.com/stretchr/testify/require"
@@ -16,3 +17,29 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 	require.Equal(t, types.DefaultGenesis(), got)
 }
+
+func TestGenesis_TradersAndReferralStats(t *testing.T) {
+	genesisState := *types.DefaultGenesis()
+	genesisState.Traders = []string{constants.BobAccAddress.String(), constants.AliceAccAddress.String()}
+	genesisState.ReferralStats = []types.ReferrerReferralStats{
+		{
+			Referrer: constants.AliceAccAddress.String(),
+			Stats: types.ReferralStats{
+				NumReferees:      1,
+				RefereeTakerFees: 100,
+				RebatesEarned:    10,
+			},
+		},
+	}
+
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.StatsKeeper
+
+	stats.InitGenesis(ctx, k, genesisState)
+	require.True(t, k.HasTraded(ctx, constants.BobAccAddress.String()))
+	require.False(t, k.HasTraded(ctx, constants.CarlAccAddress.String()))
+
+	got := stats.ExportGenesis(ctx, k)
+	require.Equal(t, genesisState, *got)
+}
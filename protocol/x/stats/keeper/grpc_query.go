@@ -83,3 +83,21 @@ func (k Keeper) UserStats(
 		Stats: userStats,
 	}, nil
 }
+
+func (k Keeper) ReferralStats(
+	c context.Context,
+	req *types.QueryReferralStatsRequest,
+) (
+	*types.QueryReferralStatsResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	referralStats := k.GetReferralStats(ctx, req.User)
+	return &types.QueryReferralStatsResponse{
+		Stats: referralStats,
+	}, nil
+}
@@ -166,3 +166,47 @@ func TestUserStats(t *testing.T) {
 		})
 	}
 }
+
+func TestReferralStats(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.StatsKeeper
+	user := "alice"
+	referralStats := &types.ReferralStats{
+		NumReferees:      2,
+		RefereeTakerFees: 100,
+		RebatesEarned:    10,
+	}
+	k.SetReferralStats(ctx, user, referralStats)
+
+	for name, tc := range map[string]struct {
+		req *types.QueryReferralStatsRequest
+		res *types.QueryReferralStatsResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QueryReferralStatsRequest{
+				User: user,
+			},
+			res: &types.QueryReferralStatsResponse{
+				Stats: referralStats,
+			},
+			err: nil,
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.ReferralStats(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
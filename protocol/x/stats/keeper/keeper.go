@@ -73,8 +73,12 @@ func (k Keeper) SetBlockStats(ctx sdk.Context, blockStats *types.BlockStats) {
 	store.Set([]byte(types.BlockStatsKey), b)
 }
 
-// Record a match in BlockStats, which is stored in the transient store
+// Record a match in BlockStats, which is stored in the transient store. The taker and maker are
+// also permanently recorded as traders.
 func (k Keeper) RecordFill(ctx sdk.Context, takerAddress string, makerAddress string, notional *big.Int) {
+	k.SetTrader(ctx, takerAddress)
+	k.SetTrader(ctx, makerAddress)
+
 	blockStats := k.GetBlockStats(ctx)
 	blockStats.Fills = append(
 		blockStats.Fills,
@@ -151,6 +155,97 @@ func (k Keeper) SetUserStats(ctx sdk.Context, address string, userStats *types.U
 	store.Set([]byte(address), b)
 }
 
+// HasTraded returns true if `address` has been the taker or maker of at least one fill. Unlike
+// the notional in UserStats, this never expires. Addresses that traded before traders were recorded
+// are identified by their UserStats, which are set on the first fill of an address and never deleted.
+func (k Keeper) HasTraded(ctx sdk.Context, address string) bool {
+	traderStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TraderKeyPrefix))
+	if traderStore.Has([]byte(address)) {
+		return true
+	}
+
+	userStatsStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserStatsKeyPrefix))
+	return userStatsStore.Has([]byte(address))
+}
+
+// SetTrader records that `address` has been the taker or maker of at least one fill.
+func (k Keeper) SetTrader(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TraderKeyPrefix))
+	if !store.Has([]byte(address)) {
+		store.Set([]byte(address), []byte{})
+	}
+}
+
+// GetAllTraders returns all addresses that have been the taker or maker of at least one fill,
+// sorted by address.
+func (k Keeper) GetAllTraders(ctx sdk.Context) (traders []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TraderKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		traders = append(traders, string(iterator.Key()))
+	}
+	return traders
+}
+
+func (k Keeper) GetReferralStats(ctx sdk.Context, address string) *types.ReferralStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferralStatsKeyPrefix))
+	bytes := store.Get([]byte(address))
+
+	if bytes == nil {
+		return &types.ReferralStats{}
+	}
+
+	var referralStats types.ReferralStats
+	k.cdc.MustUnmarshal(bytes, &referralStats)
+	return &referralStats
+}
+
+func (k Keeper) SetReferralStats(ctx sdk.Context, address string, referralStats *types.ReferralStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferralStatsKeyPrefix))
+	b := k.cdc.MustMarshal(referralStats)
+	store.Set([]byte(address), b)
+}
+
+// GetAllReferralStats returns the ReferralStats of all referrers, sorted by referrer address.
+func (k Keeper) GetAllReferralStats(ctx sdk.Context) (list []types.ReferrerReferralStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferralStatsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var referralStats types.ReferralStats
+		k.cdc.MustUnmarshal(iterator.Value(), &referralStats)
+		list = append(list, types.ReferrerReferralStats{
+			Referrer: string(iterator.Key()),
+			Stats:    referralStats,
+		})
+	}
+	return list
+}
+
+// RecordReferee increments the number of addresses referred by `referrer`.
+func (k Keeper) RecordReferee(ctx sdk.Context, referrer string) {
+	referralStats := k.GetReferralStats(ctx, referrer)
+	referralStats.NumReferees += 1
+	k.SetReferralStats(ctx, referrer, referralStats)
+}
+
+// RecordReferralRebate records the taker fee paid by a referee of `referrer` on a fill and the rebate
+// the referrer earned from it. Unlike UserStats, ReferralStats are cumulative and never expire.
+func (k Keeper) RecordReferralRebate(
+	ctx sdk.Context,
+	referrer string,
+	refereeTakerFee *big.Int,
+	rebate *big.Int,
+) {
+	// NB: These unsigned ints can technically overflow and wrap around, but the trading volume
+	// required to do so is unrealistic.
+	referralStats := k.GetReferralStats(ctx, referrer)
+	referralStats.RefereeTakerFees += refereeTakerFee.Uint64()
+	referralStats.RebatesEarned += rebate.Uint64()
+	k.SetReferralStats(ctx, referrer, referralStats)
+}
+
 func (k Keeper) GetGlobalStats(ctx sdk.Context) *types.GlobalStats {
 	store := ctx.KVStore(k.storeKey)
 	bytes := store.Get([]byte(types.GlobalStatsKey))
@@ -30,12 +30,14 @@ func TestRecordFill(t *testing.T) {
 	tests := map[string]struct {
 		args               []recordFillArgs
 		expectedBlockStats *types.BlockStats
+		expectedTraders    []string
 	}{
 		"no fills": {
 			[]recordFillArgs{},
 			&types.BlockStats{
 				Fills: nil,
 			},
+			nil,
 		},
 		"single fill": {
 			[]recordFillArgs{
@@ -50,6 +52,7 @@ func TestRecordFill(t *testing.T) {
 					},
 				},
 			},
+			[]string{"maker", "taker"},
 		},
 		"multiple fills": {
 			[]recordFillArgs{
@@ -70,6 +73,7 @@ func TestRecordFill(t *testing.T) {
 					},
 				},
 			},
+			[]string{"alice", "bob"},
 		},
 	}
 
@@ -83,10 +87,74 @@ func TestRecordFill(t *testing.T) {
 				k.RecordFill(ctx, fill.taker, fill.maker, fill.notional)
 			}
 			require.Equal(t, tc.expectedBlockStats, k.GetBlockStats(ctx))
+			require.Equal(t, tc.expectedTraders, k.GetAllTraders(ctx))
+			for _, trader := range tc.expectedTraders {
+				require.True(t, k.HasTraded(ctx, trader))
+			}
+			require.False(t, k.HasTraded(ctx, "carl"))
 		})
 	}
 }
 
+func TestHasTraded_PreexistingUserStats(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.StatsKeeper
+
+	// Addresses that traded before traders were recorded only have UserStats, which are kept
+	// after their notional falls out of the stats window.
+	k.SetUserStats(ctx, "alice", &types.UserStats{TakerNotional: 100})
+	k.SetUserStats(ctx, "bob", &types.UserStats{})
+
+	require.Empty(t, k.GetAllTraders(ctx))
+	require.True(t, k.HasTraded(ctx, "alice"))
+	require.True(t, k.HasTraded(ctx, "bob"))
+	require.False(t, k.HasTraded(ctx, "carl"))
+}
+
+func TestRecordReferralStats(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.StatsKeeper
+
+	require.Equal(t, &types.ReferralStats{}, k.GetReferralStats(ctx, "alice"))
+
+	k.RecordReferee(ctx, "alice")
+	k.RecordReferee(ctx, "alice")
+	k.RecordReferralRebate(ctx, "alice", big.NewInt(100), big.NewInt(10))
+	k.RecordReferralRebate(ctx, "alice", big.NewInt(50), big.NewInt(5))
+	k.RecordReferee(ctx, "bob")
+
+	require.Equal(
+		t,
+		&types.ReferralStats{
+			NumReferees:      2,
+			RefereeTakerFees: 150,
+			RebatesEarned:    15,
+		},
+		k.GetReferralStats(ctx, "alice"),
+	)
+	require.Equal(t, &types.ReferralStats{NumReferees: 1}, k.GetReferralStats(ctx, "bob"))
+	require.Equal(
+		t,
+		[]types.ReferrerReferralStats{
+			{
+				Referrer: "alice",
+				Stats: types.ReferralStats{
+					NumReferees:      2,
+					RefereeTakerFees: 150,
+					RebatesEarned:    15,
+				},
+			},
+			{
+				Referrer: "bob",
+				Stats:    types.ReferralStats{NumReferees: 1},
+			},
+		},
+		k.GetAllReferralStats(ctx),
+	)
+}
+
 func TestProcessBlockStats(t *testing.T) {
 	tApp := testapp.NewTestAppBuilder(t).Build()
 
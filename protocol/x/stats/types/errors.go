@@ -15,4 +15,14 @@ var (
 		401,
 		"Authority is invalid",
 	)
+	ErrInvalidAddress = errorsmod.Register(
+		ModuleName,
+		402,
+		"Address is invalid",
+	)
+	ErrDuplicateAddress = errorsmod.Register(
+		ModuleName,
+		403,
+		"Address is duplicated",
+	)
 )
@@ -1,6 +1,11 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default stats genesis state.
 func DefaultGenesis() *GenesisState {
@@ -8,6 +13,8 @@ func DefaultGenesis() *GenesisState {
 		Params: Params{
 			WindowDuration: time.Duration(30 * 24 * time.Hour),
 		},
+		Traders:       []string{},
+		ReferralStats: []ReferrerReferralStats{},
 	}
 }
 
@@ -18,5 +25,27 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	traders := make(map[string]struct{}, len(gs.Traders))
+	for _, trader := range gs.Traders {
+		if _, err := sdk.AccAddressFromBech32(trader); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAddress, "trader %s: %v", trader, err)
+		}
+		if _, exists := traders[trader]; exists {
+			return errorsmod.Wrapf(ErrDuplicateAddress, "trader %s", trader)
+		}
+		traders[trader] = struct{}{}
+	}
+
+	referrers := make(map[string]struct{}, len(gs.ReferralStats))
+	for _, referralStats := range gs.ReferralStats {
+		if _, err := sdk.AccAddressFromBech32(referralStats.Referrer); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAddress, "referrer %s: %v", referralStats.Referrer, err)
+		}
+		if _, exists := referrers[referralStats.Referrer]; exists {
+			return errorsmod.Wrapf(ErrDuplicateAddress, "referral stats of referrer %s", referralStats.Referrer)
+		}
+		referrers[referralStats.Referrer] = struct{}{}
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// The parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// Addresses that have been the taker or maker of at least one fill.
+	Traders []string `protobuf:"bytes,2,rep,name=traders,proto3" json:"traders,omitempty"`
+	// The referral stats of all referrers.
+	ReferralStats []ReferrerReferralStats `protobuf:"bytes,3,rep,name=referral_stats,json=referralStats,proto3" json:"referral_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,27 +74,106 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTraders() []string {
+	if m != nil {
+		return m.Traders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferralStats() []ReferrerReferralStats {
+	if m != nil {
+		return m.ReferralStats
+	}
+	return nil
+}
+
+// ReferrerReferralStats is the ReferralStats of a referrer.
+type ReferrerReferralStats struct {
+	// The address of the referrer.
+	Referrer string `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// The referral stats of the referrer.
+	Stats ReferralStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *ReferrerReferralStats) Reset()         { *m = ReferrerReferralStats{} }
+func (m *ReferrerReferralStats) String() string { return proto.CompactTextString(m) }
+func (*ReferrerReferralStats) ProtoMessage()    {}
+func (*ReferrerReferralStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b31bfab9064c65e, []int{1}
+}
+func (m *ReferrerReferralStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerReferralStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerReferralStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferrerReferralStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerReferralStats.Merge(m, src)
+}
+func (m *ReferrerReferralStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerReferralStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerReferralStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferrerReferralStats proto.InternalMessageInfo
+
+func (m *ReferrerReferralStats) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *ReferrerReferralStats) GetStats() ReferralStats {
+	if m != nil {
+		return m.Stats
+	}
+	return ReferralStats{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.stats.GenesisState")
+	proto.RegisterType((*ReferrerReferralStats)(nil), "dydxprotocol.stats.ReferrerReferralStats")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/stats/genesis.proto", fileDescriptor_8b31bfab9064c65e) }
 
 var fileDescriptor_8b31bfab9064c65e = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x2e, 0x49, 0x2c, 0x29, 0xd6, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x0b, 0x0b, 0x09, 0x21, 0xab, 0xd0, 0x03, 0xab,
-	0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x8b, 0xe9, 0x83, 0x58, 0x10, 0x95, 0x52, 0xf2, 0x58,
-	0xcc, 0x2a, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x1a, 0xa5, 0xe4, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x3b,
-	0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x82, 0x8b, 0x0d, 0x22, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x6d, 0x24, 0xa5, 0x87, 0x69, 0x97, 0x5e, 0x00, 0x58, 0x85, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c,
-	0x41, 0x50, 0xf5, 0x4e, 0x81, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65,
-	0x9e, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0xe2, 0x9e, 0x32, 0x13,
-	0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x7d, 0xb8, 0x48, 0x05, 0xd4, 0x8d, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0x71, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0x2c, 0x96, 0x10,
-	0x12, 0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0x3a, 0x31,
+	0x1c, 0xc7, 0xaf, 0xf0, 0xff, 0xa3, 0x16, 0x75, 0x68, 0x30, 0x39, 0x19, 0xca, 0xc9, 0x84, 0x03,
+	0x77, 0x09, 0x92, 0xe8, 0xe2, 0x20, 0x8b, 0xab, 0x1e, 0x89, 0x83, 0x0b, 0x29, 0x77, 0xf5, 0xb8,
+	0x04, 0x28, 0xf9, 0xb5, 0x1a, 0x78, 0x07, 0x07, 0x1f, 0xc6, 0x87, 0x60, 0x24, 0xc6, 0xc1, 0xc9,
+	0x18, 0x78, 0x11, 0x43, 0x5b, 0x08, 0xc4, 0xd3, 0xe5, 0x72, 0xf7, 0xfd, 0x7e, 0xda, 0x7e, 0xae,
+	0x3f, 0xec, 0xc5, 0x93, 0x78, 0x3c, 0x02, 0xa1, 0x44, 0x24, 0xfa, 0x81, 0x54, 0x4c, 0xc9, 0x20,
+	0xe1, 0x43, 0x2e, 0x53, 0xe9, 0xeb, 0x98, 0x90, 0x4d, 0xc2, 0xd7, 0x44, 0xb9, 0x94, 0x88, 0x44,
+	0xe8, 0x2c, 0x58, 0xbe, 0x19, 0xb2, 0x7c, 0x1c, 0x09, 0x39, 0x10, 0xb2, 0x63, 0x0a, 0xf3, 0x61,
+	0xab, 0x4a, 0xc6, 0x31, 0x23, 0x06, 0x6c, 0xb0, 0x02, 0x68, 0x06, 0xa0, 0x9f, 0xa6, 0xaf, 0xbe,
+	0x23, 0xbc, 0x7f, 0x6d, 0xbc, 0xda, 0x8a, 0x29, 0x4e, 0x2e, 0x70, 0xc1, 0x6c, 0xe0, 0x22, 0x0f,
+	0xd5, 0x8a, 0x8d, 0xb2, 0xff, 0xd3, 0xd3, 0xbf, 0xd1, 0x44, 0xeb, 0xdf, 0xf4, 0xb3, 0xe2, 0x84,
+	0x96, 0x27, 0x0d, 0xbc, 0xa3, 0x80, 0xc5, 0x1c, 0xa4, 0x9b, 0xf3, 0xf2, 0xb5, 0xbd, 0x96, 0xfb,
+	0xf6, 0x5a, 0x2f, 0x59, 0xdd, 0xab, 0x38, 0x06, 0x2e, 0x65, 0x5b, 0x41, 0x3a, 0x4c, 0xc2, 0x15,
+	0x48, 0xee, 0xf0, 0x21, 0xf0, 0x07, 0x0e, 0xc0, 0xfa, 0x1d, 0xbd, 0xb5, 0x9b, 0xf7, 0xf2, 0xb5,
+	0x62, 0xe3, 0x34, 0xeb, 0xd4, 0x50, 0x93, 0x1c, 0x42, 0xbb, 0x62, 0x29, 0xbc, 0x92, 0x38, 0x80,
+	0xcd, 0xb0, 0xfa, 0x8c, 0xf0, 0x51, 0x26, 0x4e, 0x9a, 0x78, 0x17, 0x6c, 0xa1, 0xff, 0xf0, 0x2f,
+	0xcd, 0x35, 0x49, 0x2e, 0xf1, 0x7f, 0xa3, 0x97, 0xd3, 0x97, 0x72, 0xf2, 0xbb, 0xde, 0xb6, 0x96,
+	0x59, 0xd5, 0xba, 0x9d, 0xce, 0x29, 0x9a, 0xcd, 0x29, 0xfa, 0x9a, 0x53, 0xf4, 0xb2, 0xa0, 0xce,
+	0x6c, 0x41, 0x9d, 0x8f, 0x05, 0x75, 0xee, 0xcf, 0x93, 0x54, 0xf5, 0x1e, 0xbb, 0x7e, 0x24, 0x06,
+	0xc1, 0xd6, 0xa8, 0x9e, 0x9a, 0xf5, 0xa8, 0xc7, 0xd2, 0x61, 0xb0, 0x4e, 0xc6, 0x76, 0x7c, 0x6a,
+	0x32, 0xe2, 0xb2, 0x5b, 0xd0, 0xf9, 0xd9, 0xf7, 0x00, 0x4e, 0x1b, 0x27, 0xc6, 0x69, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +196,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReferralStats) > 0 {
+		for iNdEx := len(m.ReferralStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Traders) > 0 {
+		for iNdEx := len(m.Traders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Traders[iNdEx])
+			copy(dAtA[i:], m.Traders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Traders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -125,6 +232,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReferrerReferralStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferrerReferralStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferrerReferralStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -144,6 +291,33 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Traders) > 0 {
+		for _, s := range m.Traders {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferralStats) > 0 {
+		for _, e := range m.ReferralStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReferrerReferralStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -215,6 +389,187 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traders = append(m.Traders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralStats = append(m.ReferralStats, ReferrerReferralStats{})
+			if err := m.ReferralStats[len(m.ReferralStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrerReferralStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrerReferralStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrerReferralStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
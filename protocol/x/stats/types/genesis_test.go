@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
 	"github.com/stretchr/testify/require"
 )
@@ -25,6 +26,54 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			err: nil,
 		},
+		"valid traders and referral stats": {
+			genState: &types.GenesisState{
+				Params:  types.DefaultGenesis().Params,
+				Traders: []string{constants.AliceAccAddress.String(), constants.BobAccAddress.String()},
+				ReferralStats: []types.ReferrerReferralStats{
+					{
+						Referrer: constants.AliceAccAddress.String(),
+						Stats: types.ReferralStats{
+							NumReferees:      1,
+							RefereeTakerFees: 100,
+							RebatesEarned:    10,
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		"invalid trader": {
+			genState: &types.GenesisState{
+				Params:  types.DefaultGenesis().Params,
+				Traders: []string{"invalid"},
+			},
+			err: types.ErrInvalidAddress,
+		},
+		"duplicate trader": {
+			genState: &types.GenesisState{
+				Params:  types.DefaultGenesis().Params,
+				Traders: []string{constants.AliceAccAddress.String(), constants.AliceAccAddress.String()},
+			},
+			err: types.ErrDuplicateAddress,
+		},
+		"invalid referrer": {
+			genState: &types.GenesisState{
+				Params:        types.DefaultGenesis().Params,
+				ReferralStats: []types.ReferrerReferralStats{{Referrer: "invalid"}},
+			},
+			err: types.ErrInvalidAddress,
+		},
+		"duplicate referrer": {
+			genState: &types.GenesisState{
+				Params: types.DefaultGenesis().Params,
+				ReferralStats: []types.ReferrerReferralStats{
+					{Referrer: constants.AliceAccAddress.String()},
+					{Referrer: constants.AliceAccAddress.String()},
+				},
+			},
+			err: types.ErrDuplicateAddress,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -32,7 +81,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			if tc.err == nil {
 				require.NoError(t, err)
 			} else {
-				require.ErrorIs(t, tc.err, err)
+				require.ErrorIs(t, err, tc.err)
 			}
 		})
 	}
@@ -20,6 +20,12 @@ const (
 	// UserStatsKeyPrefix is the prefix to retrieve the UserStats for a given user
 	UserStatsKeyPrefix = "User:"
 
+	// ReferralStatsKeyPrefix is the prefix to retrieve the ReferralStats for a given referrer
+	ReferralStatsKeyPrefix = "Referral:"
+
+	// TraderKeyPrefix is the prefix to check whether a given address has ever traded
+	TraderKeyPrefix = "Trader:"
+
 	// StatsMetadataKey is the key to get the StatsMetadata for the module
 	StatsMetadataKey = "Metadata"
 
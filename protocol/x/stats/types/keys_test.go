@@ -16,6 +16,8 @@ func TestModuleKeys(t *testing.T) {
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "Epoch:", types.EpochStatsKeyPrefix)
 	require.Equal(t, "User:", types.UserStatsKeyPrefix)
+	require.Equal(t, "Referral:", types.ReferralStatsKeyPrefix)
+	require.Equal(t, "Trader:", types.TraderKeyPrefix)
 	require.Equal(t, "Metadata", types.StatsMetadataKey)
 	require.Equal(t, "Global", types.GlobalStatsKey)
 	require.Equal(t, "Block", types.BlockStatsKey)
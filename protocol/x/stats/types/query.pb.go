@@ -366,6 +366,98 @@ func (m *QueryUserStatsResponse) GetStats() *UserStats {
 	return nil
 }
 
+// QueryReferralStatsRequest is a request type for the ReferralStats RPC
+// method.
+type QueryReferralStatsRequest struct {
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *QueryReferralStatsRequest) Reset()         { *m = QueryReferralStatsRequest{} }
+func (m *QueryReferralStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralStatsRequest) ProtoMessage()    {}
+func (*QueryReferralStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17835dac31373c4f, []int{8}
+}
+func (m *QueryReferralStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReferralStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralStatsRequest.Merge(m, src)
+}
+func (m *QueryReferralStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReferralStatsRequest proto.InternalMessageInfo
+
+func (m *QueryReferralStatsRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+// QueryReferralStatsResponse is a response type for the ReferralStats RPC
+// method.
+type QueryReferralStatsResponse struct {
+	Stats *ReferralStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (m *QueryReferralStatsResponse) Reset()         { *m = QueryReferralStatsResponse{} }
+func (m *QueryReferralStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralStatsResponse) ProtoMessage()    {}
+func (*QueryReferralStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17835dac31373c4f, []int{9}
+}
+func (m *QueryReferralStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReferralStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralStatsResponse.Merge(m, src)
+}
+func (m *QueryReferralStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReferralStatsResponse proto.InternalMessageInfo
+
+func (m *QueryReferralStatsResponse) GetStats() *ReferralStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.stats.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.stats.QueryParamsResponse")
@@ -375,44 +467,49 @@ func init() {
 	proto.RegisterType((*QueryGlobalStatsResponse)(nil), "dydxprotocol.stats.QueryGlobalStatsResponse")
 	proto.RegisterType((*QueryUserStatsRequest)(nil), "dydxprotocol.stats.QueryUserStatsRequest")
 	proto.RegisterType((*QueryUserStatsResponse)(nil), "dydxprotocol.stats.QueryUserStatsResponse")
+	proto.RegisterType((*QueryReferralStatsRequest)(nil), "dydxprotocol.stats.QueryReferralStatsRequest")
+	proto.RegisterType((*QueryReferralStatsResponse)(nil), "dydxprotocol.stats.QueryReferralStatsResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/stats/query.proto", fileDescriptor_17835dac31373c4f) }
 
 var fileDescriptor_17835dac31373c4f = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6f, 0x12, 0x41,
-	0x14, 0xc6, 0x19, 0x43, 0x89, 0x7d, 0x8d, 0x97, 0x67, 0xd5, 0x76, 0xb5, 0x8b, 0xdd, 0x06, 0x6b,
-	0x45, 0x76, 0x93, 0x56, 0xa3, 0x17, 0x2f, 0xbd, 0x78, 0x6a, 0x14, 0x8c, 0x17, 0x3d, 0x34, 0x03,
-	0x4c, 0xb6, 0x24, 0xb0, 0xb3, 0xdd, 0x19, 0x4c, 0xb9, 0x19, 0xef, 0x26, 0x26, 0xc6, 0xa3, 0xff,
-	0x4f, 0x8f, 0x44, 0x2f, 0x9e, 0x8c, 0x01, 0xff, 0x10, 0xc3, 0x9b, 0x01, 0x59, 0xd8, 0x4d, 0xb9,
-	0x6c, 0x86, 0xf7, 0xbe, 0xf7, 0xfd, 0x3e, 0xf2, 0x1e, 0xb8, 0xed, 0x41, 0xfb, 0x22, 0x4e, 0xa4,
-	0x96, 0x2d, 0xd9, 0x0d, 0x94, 0xe6, 0x5a, 0x05, 0xe7, 0x7d, 0x91, 0x0c, 0x7c, 0x2a, 0x22, 0xce,
-	0xf7, 0x7d, 0xea, 0x3b, 0x9b, 0xa1, 0x0c, 0x25, 0xd5, 0x82, 0xc9, 0xcb, 0x28, 0x9d, 0x7b, 0xa1,
-	0x94, 0x61, 0x57, 0x04, 0x3c, 0xee, 0x04, 0x3c, 0x8a, 0xa4, 0xe6, 0xba, 0x23, 0x23, 0x65, 0xbb,
-	0xe5, 0x0c, 0x4e, 0xcc, 0x13, 0xde, 0x9b, 0x0a, 0xb2, 0x82, 0xd0, 0xd7, 0xf4, 0xbd, 0x4d, 0xc0,
-	0xfa, 0x24, 0xd7, 0x6b, 0x1a, 0x6a, 0x88, 0xf3, 0xbe, 0x50, 0xda, 0x7b, 0x05, 0x37, 0x53, 0x55,
-	0x15, 0xcb, 0x48, 0x09, 0x7c, 0x0e, 0x25, 0x63, 0xbe, 0xc5, 0xee, 0xb3, 0x87, 0x1b, 0x87, 0x8e,
-	0xbf, 0xfc, 0x37, 0x7c, 0x33, 0x73, 0x5c, 0xbc, 0xfc, 0x5d, 0x2e, 0x34, 0xac, 0xde, 0xbb, 0x0b,
-	0xdb, 0x64, 0xf8, 0x66, 0x22, 0x39, 0x11, 0x9a, 0xb7, 0xb9, 0xe6, 0x53, 0xda, 0x7b, 0x70, 0xb2,
-	0x9a, 0x16, 0xfa, 0x02, 0xae, 0xf7, 0x6c, 0xcd, 0x62, 0x77, 0xb3, 0xb0, 0xe9, 0xe1, 0xd9, 0x88,
-	0xb7, 0x0d, 0x77, 0xc8, 0xfc, 0x65, 0x57, 0x36, 0x79, 0x97, 0x54, 0x53, 0x6e, 0x1d, 0xb6, 0x96,
-	0x5b, 0x96, 0xfa, 0x14, 0xd6, 0xc8, 0xd7, 0x22, 0xcb, 0x59, 0xc8, 0xf9, 0x39, 0xa3, 0xf6, 0xaa,
-	0x70, 0x8b, 0x2c, 0xdf, 0x2a, 0x91, 0xcc, 0xb3, 0x10, 0xa1, 0xd8, 0x57, 0x22, 0x21, 0xbb, 0xf5,
-	0x06, 0xbd, 0xbd, 0x13, 0xb8, 0xbd, 0x28, 0xb6, 0xf4, 0xa3, 0x34, 0x7d, 0x27, 0x8b, 0xfe, 0x7f,
-	0xca, 0x68, 0x0f, 0x7f, 0x14, 0x61, 0x8d, 0xfc, 0xf0, 0x23, 0x83, 0x92, 0x59, 0x03, 0x3e, 0xc8,
-	0x1a, 0x5d, 0xde, 0xb8, 0xb3, 0x7f, 0xa5, 0xce, 0x44, 0xf3, 0x2a, 0x9f, 0x7e, 0xfe, 0xfd, 0x7a,
-	0xad, 0x8c, 0x3b, 0x41, 0xea, 0xb2, 0x3e, 0x3c, 0x49, 0x5d, 0x1f, 0x7e, 0x67, 0x70, 0x23, 0xb5,
-	0x12, 0xac, 0xe5, 0x12, 0xb2, 0x8e, 0xc2, 0xf1, 0x57, 0x95, 0xdb, 0x5c, 0x35, 0xca, 0xb5, 0x8f,
-	0x95, 0x9c, 0x5c, 0xf4, 0x3d, 0x9d, 0x9e, 0x05, 0x7e, 0x63, 0xb0, 0x31, 0xb7, 0x3f, 0xac, 0xe6,
-	0xe2, 0x96, 0x0f, 0xc7, 0x79, 0xbc, 0x9a, 0xd8, 0x26, 0xab, 0x52, 0xb2, 0x0a, 0xee, 0xe5, 0x24,
-	0x0b, 0x69, 0xe6, 0x94, 0x7e, 0xe0, 0x67, 0x06, 0xeb, 0xb3, 0xcd, 0xe2, 0x41, 0x2e, 0x68, 0xf1,
-	0xc0, 0x9c, 0x47, 0xab, 0x48, 0x6d, 0xa2, 0x03, 0x4a, 0xb4, 0x87, 0xbb, 0x39, 0x89, 0x26, 0xd7,
-	0x69, 0xf2, 0x1c, 0xd7, 0x2f, 0x47, 0x2e, 0x1b, 0x8e, 0x5c, 0xf6, 0x67, 0xe4, 0xb2, 0x2f, 0x63,
-	0xb7, 0x30, 0x1c, 0xbb, 0x85, 0x5f, 0x63, 0xb7, 0xf0, 0xee, 0x59, 0xd8, 0xd1, 0x67, 0xfd, 0xa6,
-	0xdf, 0x92, 0xbd, 0x45, 0x9b, 0x5a, 0xeb, 0x8c, 0x77, 0xa2, 0x60, 0x56, 0xb9, 0xb0, 0xbe, 0x7a,
-	0x10, 0x0b, 0xd5, 0x2c, 0x51, 0xfd, 0xe8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xdb, 0xd8,
-	0x74, 0x24, 0x05, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x51, 0x13, 0xd1, 0x57, 0xb1, 0x1c, 0x05, 0xda, 0x83, 0x3a, 0xd4, 0x55, 0x28, 0x25,
+	0xc4, 0x96, 0x5a, 0x50, 0x59, 0x58, 0xba, 0x30, 0x55, 0x90, 0xa0, 0x2e, 0x30, 0x54, 0x97, 0xe4,
+	0x70, 0x23, 0x39, 0x3e, 0xd7, 0xbe, 0xa0, 0x66, 0x43, 0xec, 0x48, 0x48, 0x88, 0x91, 0xef, 0xd3,
+	0xb1, 0x12, 0x0b, 0x13, 0x42, 0x09, 0x9f, 0x80, 0x4f, 0x80, 0xfc, 0x7c, 0x09, 0xb9, 0xe4, 0x4c,
+	0xbc, 0x58, 0xce, 0x7b, 0xbf, 0x7f, 0xf1, 0xfd, 0x0e, 0xec, 0xee, 0xb0, 0x7b, 0x11, 0xc5, 0x42,
+	0x8a, 0x8e, 0x08, 0xbc, 0x44, 0x32, 0x99, 0x78, 0xe7, 0x03, 0x1e, 0x0f, 0x5d, 0x1c, 0x12, 0x32,
+	0xbb, 0x77, 0x71, 0x4f, 0xd7, 0x7d, 0xe1, 0x0b, 0x9c, 0x79, 0xe9, 0x5b, 0x86, 0xa4, 0xf7, 0x7c,
+	0x21, 0xfc, 0x80, 0x7b, 0x2c, 0xea, 0x79, 0x2c, 0x0c, 0x85, 0x64, 0xb2, 0x27, 0xc2, 0x44, 0x6d,
+	0xab, 0x06, 0x9f, 0x88, 0xc5, 0xac, 0x3f, 0x01, 0x98, 0x82, 0xe0, 0x33, 0xdb, 0x3b, 0xeb, 0x40,
+	0x9a, 0x69, 0xae, 0x57, 0x48, 0x6a, 0xf1, 0xf3, 0x01, 0x4f, 0xa4, 0xf3, 0x12, 0x6e, 0x6a, 0xd3,
+	0x24, 0x12, 0x61, 0xc2, 0xc9, 0x33, 0xa8, 0x64, 0xe2, 0x1b, 0xd6, 0x7d, 0xeb, 0xe1, 0xda, 0x3e,
+	0x75, 0x17, 0xff, 0x86, 0x9b, 0x71, 0x8e, 0x56, 0x2e, 0x7f, 0x56, 0x4b, 0x2d, 0x85, 0x77, 0xee,
+	0xc2, 0x26, 0x0a, 0xbe, 0x4e, 0x21, 0xc7, 0x5c, 0xb2, 0x2e, 0x93, 0x6c, 0xe2, 0xf6, 0x16, 0xa8,
+	0x69, 0xa9, 0x4c, 0x9f, 0xc3, 0xf5, 0xbe, 0x9a, 0x29, 0xdb, 0x6d, 0x93, 0xad, 0x4e, 0x9e, 0x52,
+	0x9c, 0x4d, 0xb8, 0x83, 0xe2, 0x2f, 0x02, 0xd1, 0x66, 0x01, 0xa2, 0x26, 0xbe, 0x4d, 0xd8, 0x58,
+	0x5c, 0x29, 0xd7, 0xa7, 0x50, 0x46, 0x5d, 0x65, 0x59, 0x35, 0x59, 0xce, 0xf2, 0x32, 0xb4, 0x53,
+	0x87, 0x5b, 0x28, 0x79, 0x92, 0xf0, 0x78, 0xd6, 0x8b, 0x10, 0x58, 0x19, 0x24, 0x3c, 0x46, 0xb9,
+	0xd5, 0x16, 0xbe, 0x3b, 0xc7, 0x70, 0x7b, 0x1e, 0xac, 0xdc, 0x0f, 0x74, 0xf7, 0x2d, 0x93, 0xfb,
+	0x3f, 0x96, 0xf2, 0xf6, 0xd4, 0x37, 0x6e, 0xf1, 0x77, 0x3c, 0x8e, 0x59, 0xb0, 0xd4, 0xff, 0x04,
+	0xa8, 0x89, 0xa0, 0x32, 0x1c, 0xea, 0x19, 0x8c, 0x1f, 0x5d, 0x67, 0x66, 0xf8, 0xfd, 0x3f, 0x65,
+	0x28, 0xa3, 0x2e, 0xf9, 0x60, 0x41, 0x25, 0xab, 0x03, 0x79, 0x60, 0xa2, 0x2f, 0x36, 0x8f, 0xee,
+	0x2e, 0xc5, 0x65, 0xf1, 0x9c, 0xda, 0xc7, 0xef, 0xbf, 0xbf, 0x5c, 0xab, 0x92, 0x2d, 0x4f, 0x6b,
+	0xf8, 0xfb, 0x27, 0xda, 0x2d, 0x20, 0xdf, 0x2c, 0xb8, 0xa1, 0x55, 0x83, 0x34, 0x72, 0x1d, 0x4c,
+	0xe5, 0xa4, 0x6e, 0x51, 0xb8, 0xca, 0xd5, 0xc0, 0x5c, 0xbb, 0xa4, 0x96, 0x93, 0x0b, 0x9f, 0xa7,
+	0x93, 0x7a, 0x92, 0xaf, 0x16, 0xac, 0xcd, 0xf4, 0x88, 0xd4, 0x73, 0xed, 0x16, 0x0b, 0x4c, 0x1f,
+	0x17, 0x03, 0xab, 0x64, 0x75, 0x4c, 0x56, 0x23, 0x3b, 0x39, 0xc9, 0x7c, 0xe4, 0x9c, 0xe2, 0x0f,
+	0xf2, 0xc9, 0x82, 0xd5, 0x69, 0xc3, 0xc8, 0x5e, 0xae, 0xd1, 0x7c, 0xd1, 0xe9, 0xa3, 0x22, 0x50,
+	0x95, 0x68, 0x0f, 0x13, 0xed, 0x90, 0xed, 0x9c, 0x44, 0x69, 0x4b, 0x55, 0x9e, 0xf4, 0x1c, 0xb5,
+	0xb6, 0xfd, 0xe7, 0x1c, 0x4d, 0x17, 0x80, 0xba, 0x45, 0xe1, 0x05, 0xcf, 0x31, 0x56, 0xac, 0x2c,
+	0xdf, 0x51, 0xf3, 0x72, 0x64, 0x5b, 0x57, 0x23, 0xdb, 0xfa, 0x35, 0xb2, 0xad, 0xcf, 0x63, 0xbb,
+	0x74, 0x35, 0xb6, 0x4b, 0x3f, 0xc6, 0x76, 0xe9, 0xcd, 0xa1, 0xdf, 0x93, 0x67, 0x83, 0xb6, 0xdb,
+	0x11, 0xfd, 0x79, 0xa9, 0x46, 0xe7, 0x8c, 0xf5, 0x42, 0x6f, 0x3a, 0xb9, 0x50, 0xda, 0x72, 0x18,
+	0xf1, 0xa4, 0x5d, 0xc1, 0xf9, 0xc1, 0xdf, 0x01, 0x00, 0xa7, 0x0d, 0xe7, 0x8c, 0x4c, 0x06, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -435,6 +532,8 @@ type QueryClient interface {
 	GlobalStats(ctx context.Context, in *QueryGlobalStatsRequest, opts ...grpc.CallOption) (*QueryGlobalStatsResponse, error)
 	// Queries UserStats.
 	UserStats(ctx context.Context, in *QueryUserStatsRequest, opts ...grpc.CallOption) (*QueryUserStatsResponse, error)
+	// Queries ReferralStats.
+	ReferralStats(ctx context.Context, in *QueryReferralStatsRequest, opts ...grpc.CallOption) (*QueryReferralStatsResponse, error)
 }
 
 type queryClient struct {
@@ -481,6 +580,15 @@ func (c *queryClient) UserStats(ctx context.Context, in *QueryUserStatsRequest,
 	return out, nil
 }
 
+func (c *queryClient) ReferralStats(ctx context.Context, in *QueryReferralStatsRequest, opts ...grpc.CallOption) (*QueryReferralStatsResponse, error) {
+	out := new(QueryReferralStatsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.stats.Query/ReferralStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the Params.
@@ -491,6 +599,8 @@ type QueryServer interface {
 	GlobalStats(context.Context, *QueryGlobalStatsRequest) (*QueryGlobalStatsResponse, error)
 	// Queries UserStats.
 	UserStats(context.Context, *QueryUserStatsRequest) (*QueryUserStatsResponse, error)
+	// Queries ReferralStats.
+	ReferralStats(context.Context, *QueryReferralStatsRequest) (*QueryReferralStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -509,6 +619,9 @@ func (*UnimplementedQueryServer) GlobalStats(ctx context.Context, req *QueryGlob
 func (*UnimplementedQueryServer) UserStats(ctx context.Context, req *QueryUserStatsRequest) (*QueryUserStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserStats not implemented")
 }
+func (*UnimplementedQueryServer) ReferralStats(ctx context.Context, req *QueryReferralStatsRequest) (*QueryReferralStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferralStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -586,6 +699,24 @@ func _Query_UserStats_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReferralStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferralStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReferralStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.stats.Query/ReferralStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReferralStats(ctx, req.(*QueryReferralStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.stats.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -606,6 +737,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserStats",
 			Handler:    _Query_UserStats_Handler,
 		},
+		{
+			MethodName: "ReferralStats",
+			Handler:    _Query_ReferralStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/stats/query.proto",
@@ -848,6 +983,71 @@ func (m *QueryUserStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryReferralStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -949,6 +1149,32 @@ func (m *QueryUserStatsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryReferralStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReferralStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1528,6 +1754,174 @@ func (m *QueryUserStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReferralStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferralStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ReferralStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -393,6 +393,70 @@ func (m *UserStats) GetMakerNotional() uint64 {
 	return 0
 }
 
+// ReferralStats stores referral stats for a referrer
+type ReferralStats struct {
+	// Number of addresses referred
+	NumReferees uint32 `protobuf:"varint,1,opt,name=num_referees,json=numReferees,proto3" json:"num_referees,omitempty"`
+	// Taker fees paid by referees in USDC quantums
+	RefereeTakerFees uint64 `protobuf:"varint,2,opt,name=referee_taker_fees,json=refereeTakerFees,proto3" json:"referee_taker_fees,omitempty"`
+	// Rebates earned from referees in USDC quantums
+	RebatesEarned uint64 `protobuf:"varint,3,opt,name=rebates_earned,json=rebatesEarned,proto3" json:"rebates_earned,omitempty"`
+}
+
+func (m *ReferralStats) Reset()         { *m = ReferralStats{} }
+func (m *ReferralStats) String() string { return proto.CompactTextString(m) }
+func (*ReferralStats) ProtoMessage()    {}
+func (*ReferralStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07475747e6dcccdc, []int{5}
+}
+func (m *ReferralStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferralStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralStats.Merge(m, src)
+}
+func (m *ReferralStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferralStats proto.InternalMessageInfo
+
+func (m *ReferralStats) GetNumReferees() uint32 {
+	if m != nil {
+		return m.NumReferees
+	}
+	return 0
+}
+
+func (m *ReferralStats) GetRefereeTakerFees() uint64 {
+	if m != nil {
+		return m.RefereeTakerFees
+	}
+	return 0
+}
+
+func (m *ReferralStats) GetRebatesEarned() uint64 {
+	if m != nil {
+		return m.RebatesEarned
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BlockStats)(nil), "dydxprotocol.stats.BlockStats")
 	proto.RegisterType((*BlockStats_Fill)(nil), "dydxprotocol.stats.BlockStats.Fill")
@@ -401,41 +465,47 @@ func init() {
 	proto.RegisterType((*EpochStats_UserWithStats)(nil), "dydxprotocol.stats.EpochStats.UserWithStats")
 	proto.RegisterType((*GlobalStats)(nil), "dydxprotocol.stats.GlobalStats")
 	proto.RegisterType((*UserStats)(nil), "dydxprotocol.stats.UserStats")
+	proto.RegisterType((*ReferralStats)(nil), "dydxprotocol.stats.ReferralStats")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/stats/stats.proto", fileDescriptor_07475747e6dcccdc) }
 
 var fileDescriptor_07475747e6dcccdc = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x6b, 0xdb, 0x30,
-	0x18, 0xc6, 0xa3, 0x34, 0x19, 0xed, 0x9b, 0x39, 0x03, 0xd1, 0x43, 0x30, 0xcc, 0x09, 0x1e, 0x63,
-	0x39, 0x6c, 0x32, 0xb4, 0xa3, 0x63, 0xd7, 0x40, 0x37, 0x18, 0xac, 0x30, 0xaf, 0x63, 0x7f, 0x2e,
-	0x46, 0xb1, 0x5d, 0x47, 0x54, 0xb6, 0x82, 0xad, 0x8c, 0xf6, 0x5b, 0xf4, 0xb6, 0xaf, 0xd4, 0x63,
-	0x8f, 0x3b, 0x6d, 0x23, 0xf9, 0x0e, 0x3b, 0x0f, 0xbd, 0xaa, 0x9d, 0x84, 0xe5, 0x62, 0xf4, 0xfe,
-	0xf4, 0xbc, 0xef, 0x63, 0x3d, 0xb2, 0xc1, 0x4b, 0xae, 0x93, 0xab, 0x79, 0xa9, 0xb4, 0x8a, 0x95,
-	0x0c, 0x2a, 0xcd, 0x75, 0x65, 0x9f, 0x0c, 0x21, 0xa5, 0x9b, 0xfb, 0x0c, 0x77, 0xdc, 0xc3, 0x4c,
-	0x65, 0x0a, 0x59, 0x60, 0x56, 0x56, 0xe9, 0x0e, 0x33, 0xa5, 0x32, 0x99, 0x06, 0x58, 0x4d, 0x17,
-	0x17, 0x81, 0x16, 0x79, 0x5a, 0x69, 0x9e, 0xcf, 0xad, 0xc0, 0xff, 0x41, 0x00, 0x26, 0x52, 0xc5,
-	0x97, 0x1f, 0xcd, 0x14, 0xfa, 0x1a, 0xba, 0x17, 0x42, 0xca, 0x6a, 0x40, 0x46, 0x7b, 0xe3, 0xde,
-	0xd1, 0x13, 0xf6, 0xbf, 0x13, 0x5b, 0xcb, 0xd9, 0x1b, 0x21, 0x65, 0x68, 0x3b, 0xdc, 0x33, 0xe8,
-	0x98, 0x92, 0x1e, 0x42, 0x57, 0xf3, 0xcb, 0xb4, 0x1c, 0x90, 0x11, 0x19, 0x1f, 0x84, 0xb6, 0x30,
-	0x34, 0x47, 0xda, 0xb6, 0x14, 0x0b, 0xea, 0xc2, 0x7e, 0xa1, 0xb4, 0x50, 0x05, 0x97, 0x83, 0xbd,
-	0x11, 0x19, 0x77, 0xc2, 0xa6, 0xf6, 0x4f, 0xc0, 0x41, 0x93, 0xf7, 0xa9, 0xe6, 0x09, 0xd7, 0x9c,
-	0x3e, 0x85, 0xbe, 0x2e, 0xb9, 0x90, 0xa2, 0xc8, 0xa2, 0x74, 0xae, 0xe2, 0x19, 0x3a, 0x38, 0xa1,
-	0x53, 0xd3, 0x53, 0x03, 0xfd, 0xbf, 0x04, 0x00, 0x57, 0xf6, 0x44, 0xef, 0xa0, 0x8f, 0xe2, 0x28,
-	0x2d, 0x92, 0xc8, 0x9c, 0x1e, 0xbb, 0x7a, 0x47, 0x2e, 0xb3, 0xd1, 0xb0, 0x3a, 0x1a, 0x76, 0x5e,
-	0x47, 0x33, 0xd9, 0xbf, 0xfd, 0x35, 0x6c, 0xdd, 0xfc, 0x1e, 0x92, 0xf0, 0x21, 0xf6, 0x9e, 0x16,
-	0x89, 0xd9, 0xa4, 0x13, 0xe8, 0x62, 0x04, 0x83, 0x36, 0xa6, 0xf3, 0x7c, 0x57, 0x3a, 0x6b, 0x6b,
-	0xf6, 0xa9, 0x4a, 0xcb, 0xcf, 0x42, 0xdb, 0x2a, 0xb4, 0xad, 0xee, 0x17, 0x70, 0xb6, 0x38, 0xa5,
-	0xd0, 0x59, 0x54, 0x4d, 0x5c, 0xb8, 0xa6, 0xc7, 0x6b, 0x23, 0xf3, 0xae, 0x8f, 0x77, 0x19, 0x99,
-	0x29, 0x9b, 0x93, 0xfd, 0x13, 0xe8, 0xbd, 0x95, 0x6a, 0xca, 0xa5, 0x9d, 0xfb, 0x0c, 0x1e, 0xd5,
-	0x59, 0x46, 0xba, 0xe4, 0x49, 0x9a, 0xa0, 0x45, 0x27, 0xec, 0xd7, 0xf8, 0x1c, 0xa9, 0xff, 0x15,
-	0x0e, 0x9a, 0x59, 0x18, 0xb2, 0xb9, 0x9a, 0xa8, 0xb9, 0x17, 0xdb, 0xe4, 0x20, 0x3d, 0xbb, 0x87,
-	0x46, 0x96, 0x6f, 0xcb, 0xda, 0x56, 0x96, 0x6f, 0xca, 0x26, 0x1f, 0x6e, 0x97, 0x1e, 0xb9, 0x5b,
-	0x7a, 0xe4, 0xcf, 0xd2, 0x23, 0x37, 0x2b, 0xaf, 0x75, 0xb7, 0xf2, 0x5a, 0x3f, 0x57, 0x5e, 0xeb,
-	0xdb, 0xab, 0x4c, 0xe8, 0xd9, 0x62, 0xca, 0x62, 0x95, 0x07, 0x5b, 0x5f, 0xfb, 0xf7, 0x97, 0x2f,
-	0xe2, 0x19, 0x17, 0x45, 0xd0, 0x90, 0xab, 0xfb, 0x3f, 0x40, 0x5f, 0xcf, 0xd3, 0x6a, 0xfa, 0x00,
-	0xf9, 0xf1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x20, 0xac, 0x35, 0x24, 0x03, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4d, 0x6b, 0xdb, 0x4c,
+	0x10, 0xc7, 0xbd, 0x8e, 0xfd, 0x90, 0x8c, 0x23, 0x3f, 0x65, 0xc9, 0xc1, 0x08, 0x2a, 0xbb, 0x2a,
+	0xa1, 0x3e, 0xa4, 0x12, 0x24, 0x25, 0xa5, 0x57, 0x83, 0x53, 0x28, 0x34, 0x50, 0xd5, 0xa5, 0x2f,
+	0x17, 0xb1, 0xb6, 0xd6, 0xb2, 0xc8, 0x4a, 0x6b, 0xa4, 0x75, 0x49, 0x3e, 0x41, 0xaf, 0xb9, 0xf5,
+	0x2b, 0xe5, 0x98, 0x63, 0x4f, 0x6d, 0xb1, 0xbf, 0x43, 0xcf, 0x65, 0x67, 0x25, 0xbf, 0xd0, 0x5c,
+	0xc4, 0xce, 0x6f, 0xfe, 0x33, 0xb3, 0xfb, 0x5f, 0x2d, 0x38, 0xd1, 0x4d, 0x74, 0x3d, 0xcf, 0xa5,
+	0x92, 0x13, 0x29, 0xfc, 0x42, 0x31, 0x55, 0x98, 0xaf, 0x87, 0x90, 0xd2, 0xed, 0xbc, 0x87, 0x19,
+	0xfb, 0x28, 0x96, 0xb1, 0x44, 0xe6, 0xeb, 0x95, 0x51, 0xda, 0xdd, 0x58, 0xca, 0x58, 0x70, 0x1f,
+	0xa3, 0xf1, 0x62, 0xea, 0xab, 0x24, 0xe5, 0x85, 0x62, 0xe9, 0xdc, 0x08, 0xdc, 0xef, 0x04, 0x60,
+	0x20, 0xe4, 0xe4, 0xea, 0xbd, 0xee, 0x42, 0x5f, 0x41, 0x73, 0x9a, 0x08, 0x51, 0x74, 0x48, 0x6f,
+	0xaf, 0xdf, 0x3a, 0x7d, 0xea, 0xfd, 0x3b, 0xc9, 0xdb, 0xc8, 0xbd, 0x8b, 0x44, 0x88, 0xc0, 0x54,
+	0xd8, 0x97, 0xd0, 0xd0, 0x21, 0x3d, 0x82, 0xa6, 0x62, 0x57, 0x3c, 0xef, 0x90, 0x1e, 0xe9, 0x1f,
+	0x04, 0x26, 0xd0, 0x34, 0x45, 0x5a, 0x37, 0x14, 0x03, 0x6a, 0xc3, 0x7e, 0x26, 0x55, 0x22, 0x33,
+	0x26, 0x3a, 0x7b, 0x3d, 0xd2, 0x6f, 0x04, 0xeb, 0xd8, 0x3d, 0x07, 0x0b, 0x87, 0xbc, 0xe5, 0x8a,
+	0x45, 0x4c, 0x31, 0x7a, 0x0c, 0x6d, 0x95, 0xb3, 0x44, 0x24, 0x59, 0x1c, 0xf2, 0xb9, 0x9c, 0xcc,
+	0x70, 0x82, 0x15, 0x58, 0x15, 0x1d, 0x6a, 0xe8, 0xfe, 0x21, 0x00, 0xb8, 0x32, 0x27, 0x7a, 0x03,
+	0x6d, 0x14, 0x87, 0x3c, 0x8b, 0x42, 0x7d, 0x7a, 0xac, 0x6a, 0x9d, 0xda, 0x9e, 0xb1, 0xc6, 0xab,
+	0xac, 0xf1, 0x46, 0x95, 0x35, 0x83, 0xfd, 0xbb, 0x9f, 0xdd, 0xda, 0xed, 0xaf, 0x2e, 0x09, 0x0e,
+	0xb1, 0x76, 0x98, 0x45, 0x3a, 0x49, 0x07, 0xd0, 0x44, 0x0b, 0x3a, 0x75, 0x74, 0xe7, 0xe4, 0x21,
+	0x77, 0x36, 0xa3, 0xbd, 0x0f, 0x05, 0xcf, 0x3f, 0x26, 0xca, 0x44, 0x81, 0x29, 0xb5, 0x3f, 0x81,
+	0xb5, 0xc3, 0x29, 0x85, 0xc6, 0xa2, 0x58, 0xdb, 0x85, 0x6b, 0x7a, 0xb6, 0x19, 0xa4, 0xf7, 0xfa,
+	0xf8, 0xa1, 0x41, 0xba, 0xcb, 0x76, 0x67, 0xf7, 0x1c, 0x5a, 0xaf, 0x85, 0x1c, 0x33, 0x61, 0xfa,
+	0x3e, 0x83, 0xff, 0x2b, 0x2f, 0x43, 0x95, 0xb3, 0x88, 0x47, 0x38, 0xa2, 0x11, 0xb4, 0x2b, 0x3c,
+	0x42, 0xea, 0x7e, 0x86, 0x83, 0x75, 0x2f, 0x34, 0x59, 0x5f, 0x4d, 0xb8, 0xbe, 0x17, 0x53, 0x64,
+	0x21, 0xbd, 0x2c, 0xa1, 0x96, 0xa5, 0xbb, 0xb2, 0xba, 0x91, 0xa5, 0xdb, 0x32, 0xf7, 0x1b, 0x01,
+	0x2b, 0xe0, 0x53, 0x9e, 0xe7, 0xd5, 0xae, 0x9e, 0xc0, 0x61, 0xb6, 0x48, 0xc3, 0x5c, 0x43, 0xce,
+	0x8b, 0xf2, 0x0a, 0x5b, 0xd9, 0x22, 0x0d, 0x4a, 0x44, 0x4f, 0x80, 0x96, 0xe9, 0xd0, 0x6c, 0x65,
+	0xaa, 0x85, 0xa6, 0xff, 0xa3, 0x32, 0x33, 0xd2, 0x89, 0x0b, 0xad, 0x3e, 0x86, 0x76, 0xce, 0xc7,
+	0x4c, 0xf1, 0x22, 0xe4, 0x2c, 0xcf, 0x78, 0x54, 0xfe, 0x48, 0x56, 0x49, 0x87, 0x08, 0x07, 0xef,
+	0xee, 0x96, 0x0e, 0xb9, 0x5f, 0x3a, 0xe4, 0xf7, 0xd2, 0x21, 0xb7, 0x2b, 0xa7, 0x76, 0xbf, 0x72,
+	0x6a, 0x3f, 0x56, 0x4e, 0xed, 0xcb, 0xcb, 0x38, 0x51, 0xb3, 0xc5, 0xd8, 0x9b, 0xc8, 0xd4, 0xdf,
+	0x79, 0x77, 0x5f, 0x5f, 0x3c, 0x9f, 0xcc, 0x58, 0x92, 0xf9, 0x6b, 0x72, 0x5d, 0xbe, 0x45, 0x75,
+	0x33, 0xe7, 0xc5, 0xf8, 0x3f, 0xe4, 0x67, 0x7f, 0x07, 0x00, 0xac, 0x26, 0x16, 0xa6, 0xae, 0x03,
+	0x00, 0x00,
 }
 
 func (m *BlockStats) Marshal() (dAtA []byte, err error) {
@@ -693,6 +763,44 @@ func (m *UserStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReferralStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RebatesEarned != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.RebatesEarned))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RefereeTakerFees != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.RefereeTakerFees))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumReferees != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.NumReferees))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStats(v)
 	base := offset
@@ -812,6 +920,24 @@ func (m *UserStats) Size() (n int) {
 	return n
 }
 
+func (m *ReferralStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumReferees != 0 {
+		n += 1 + sovStats(uint64(m.NumReferees))
+	}
+	if m.RefereeTakerFees != 0 {
+		n += 1 + sovStats(uint64(m.RefereeTakerFees))
+	}
+	if m.RebatesEarned != 0 {
+		n += 1 + sovStats(uint64(m.RebatesEarned))
+	}
+	return n
+}
+
 func sovStats(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1496,6 +1622,113 @@ func (m *UserStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReferralStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReferees", wireType)
+			}
+			m.NumReferees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReferees |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefereeTakerFees", wireType)
+			}
+			m.RefereeTakerFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefereeTakerFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebatesEarned", wireType)
+			}
+			m.RebatesEarned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebatesEarned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStats(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -77,3 +77,38 @@ func CmdShowSubaccount() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowSubaccountMargin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-subaccount-margin [owner] [number]",
+		Short: "shows the net collateral and margin requirements of a subaccount",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySubaccountMarginRequest{
+				Owner:  argOwner,
+				Number: argNumber,
+			}
+
+			res, err := queryClient.SubaccountMargin(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubaccountMargin returns the net collateral and margin requirements of a subaccount and each of its
+// perpetual positions as if unsettled funding were settled and the hypothetical position deltas of the
+// request were applied. No state is modified.
+func (k Keeper) SubaccountMargin(
+	c context.Context,
+	req *types.QuerySubaccountMarginRequest,
+) (*types.QuerySubaccountMarginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	subaccount := k.GetSubaccount(
+		ctx,
+		types.SubaccountId{
+			Owner:  req.Owner,
+			Number: req.Number,
+		},
+	)
+	settledSubaccount, _, err := k.getSettledSubaccount(ctx, subaccount)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	settledUpdate := settledUpdate{
+		SettledSubaccount: settledSubaccount,
+		AssetUpdates:      make([]types.AssetUpdate, 0, len(req.AssetDeltas)),
+		PerpetualUpdates:  make([]types.PerpetualUpdate, 0, len(req.PerpetualDeltas)),
+	}
+	for _, delta := range req.AssetDeltas {
+		if delta.QuantumsDelta.IsNil() {
+			return nil, status.Errorf(codes.InvalidArgument, "nil quantums delta for asset %d", delta.AssetId)
+		}
+		settledUpdate.AssetUpdates = append(settledUpdate.AssetUpdates, types.AssetUpdate{
+			AssetId:          delta.AssetId,
+			BigQuantumsDelta: delta.QuantumsDelta.BigInt(),
+		})
+	}
+	for _, delta := range req.PerpetualDeltas {
+		if delta.QuantumsDelta.IsNil() {
+			return nil, status.Errorf(codes.InvalidArgument, "nil quantums delta for perpetual %d", delta.PerpetualId)
+		}
+		settledUpdate.PerpetualUpdates = append(settledUpdate.PerpetualUpdates, types.PerpetualUpdate{
+			PerpetualId:      delta.PerpetualId,
+			BigQuantumsDelta: delta.QuantumsDelta.BigInt(),
+		})
+	}
+
+	bigNetCollateral,
+		bigInitialMargin,
+		bigMaintenanceMargin,
+		err := k.internalGetNetCollateralAndMarginRequirements(ctx, settledUpdate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	perpetualPositions, err := k.getPerpetualPositionMargins(
+		ctx,
+		settledUpdate,
+		bigNetCollateral,
+		bigMaintenanceMargin,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySubaccountMarginResponse{
+		NetCollateral:      dtypes.NewIntFromBigInt(bigNetCollateral),
+		InitialMargin:      dtypes.NewIntFromBigInt(bigInitialMargin),
+		MaintenanceMargin:  dtypes.NewIntFromBigInt(bigMaintenanceMargin),
+		FreeCollateral:     dtypes.NewIntFromBigInt(new(big.Int).Sub(bigNetCollateral, bigInitialMargin)),
+		PerpetualPositions: perpetualPositions,
+	}, nil
+}
+
+// getPerpetualPositionMargins returns the margin information of each non-zero perpetual position of
+// the settled update after the update is applied, ordered by perpetual id. The total net collateral
+// and maintenance margin of the subaccount are used to estimate the liquidation price of each position.
+func (k Keeper) getPerpetualPositionMargins(
+	ctx sdk.Context,
+	settledUpdate settledUpdate,
+	bigTotalNetCollateral *big.Int,
+	bigTotalMaintenanceMargin *big.Int,
+) (
+	perpetualPositions []types.PerpetualPositionMargin,
+	err error,
+) {
+	perpetualSizes, err := applyUpdatesToPositions(
+		settledUpdate.SettledSubaccount.PerpetualPositions,
+		settledUpdate.PerpetualUpdates,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(perpetualSizes, func(i, j int) bool {
+		return perpetualSizes[i].GetId() < perpetualSizes[j].GetId()
+	})
+
+	perpetualPositions = make([]types.PerpetualPositionMargin, 0, len(perpetualSizes))
+	for _, size := range perpetualSizes {
+		id := size.GetId()
+		bigQuantums := size.GetBigQuantums()
+		if bigQuantums.Sign() == 0 {
+			continue
+		}
+
+		bigNetNotional, err := k.perpetualsKeeper.GetNetCollateral(ctx, id, bigQuantums)
+		if err != nil {
+			return nil, err
+		}
+		bigInitialMargin, bigMaintenanceMargin, err := k.perpetualsKeeper.GetMarginRequirements(
+			ctx,
+			id,
+			bigQuantums,
+		)
+		if err != nil {
+			return nil, err
+		}
+		_, marketPrice, err := k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		perpetualPositions = append(perpetualPositions, types.PerpetualPositionMargin{
+			PerpetualId:       id,
+			Quantums:          dtypes.NewIntFromBigInt(bigQuantums),
+			NetNotional:       dtypes.NewIntFromBigInt(bigNetNotional),
+			InitialMargin:     dtypes.NewIntFromBigInt(bigInitialMargin),
+			MaintenanceMargin: dtypes.NewIntFromBigInt(bigMaintenanceMargin),
+			LiquidationPrice: getLiquidationPrice(
+				marketPrice.Price,
+				bigNetNotional,
+				bigMaintenanceMargin,
+				new(big.Int).Sub(bigTotalNetCollateral, bigNetNotional),
+				new(big.Int).Sub(bigTotalMaintenanceMargin, bigMaintenanceMargin),
+			),
+		})
+	}
+	return perpetualPositions, nil
+}
+
+// getLiquidationPrice estimates the oracle price at which the net collateral of a subaccount equals its
+// maintenance margin requirement, assuming the prices of all other markets are unchanged and that the
+// net notional and maintenance margin of the position scale linearly with the oracle price. That is, it
+// solves `otherNetCollateral + netNotional * x = otherMaintenanceMargin + maintenanceMargin * x` for the
+// ratio `x` of the liquidation price to the current oracle price.
+//
+// Returns zero if there is no positive price at which the subaccount becomes liquidatable.
+func getLiquidationPrice(
+	oraclePrice uint64,
+	bigNetNotional *big.Int,
+	bigMaintenanceMargin *big.Int,
+	bigOtherNetCollateral *big.Int,
+	bigOtherMaintenanceMargin *big.Int,
+) uint64 {
+	bigDenominator := new(big.Int).Sub(bigNetNotional, bigMaintenanceMargin)
+	if bigDenominator.Sign() == 0 {
+		return 0
+	}
+
+	bigNumerator := new(big.Int).Sub(bigOtherMaintenanceMargin, bigOtherNetCollateral)
+	ratio := new(big.Rat).SetFrac(bigNumerator, bigDenominator)
+	if ratio.Sign() <= 0 {
+		return 0
+	}
+
+	bigLiquidationPrice := lib.BigRatRound(
+		ratio.Mul(ratio, new(big.Rat).SetUint64(oraclePrice)),
+		false,
+	)
+	return lib.BigUint64Clamp(bigLiquidationPrice, 0, math.MaxUint64)
+}
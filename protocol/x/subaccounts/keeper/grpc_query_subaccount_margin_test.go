@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestSubaccountMarginQuery(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _ := keepertest.SubaccountsKeepers(t, true)
+	wctx := sdk.WrapSDKContext(ctx)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	perpetual := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.AtomicResolution,
+		perpetual.Params.DefaultFundingPpm,
+		perpetual.Params.LiquidityTier,
+	)
+	require.NoError(t, err)
+
+	// The subaccount is long 1 BTC at $50,000 and has -$40,000 of USDC.
+	subaccount := types.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*types.AssetPosition{
+			{
+				AssetId:  constants.Usdc.Id,
+				Quantums: dtypes.NewInt(-40_000_000_000),
+			},
+		},
+		PerpetualPositions: []*types.PerpetualPosition{
+			{
+				PerpetualId:  perpetual.Params.Id,
+				Quantums:     dtypes.NewInt(100_000_000),
+				FundingIndex: dtypes.NewInt(0),
+			},
+		},
+	}
+	keeper.SetSubaccount(ctx, subaccount)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QuerySubaccountMarginRequest
+		response *types.QuerySubaccountMarginResponse
+		err      error
+	}{
+		{
+			desc: "Current state",
+			request: &types.QuerySubaccountMarginRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+			response: &types.QuerySubaccountMarginResponse{
+				NetCollateral:     dtypes.NewInt(10_000_000_000),
+				InitialMargin:     dtypes.NewInt(10_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(5_000_000_000),
+				FreeCollateral:    dtypes.NewInt(0),
+				PerpetualPositions: []types.PerpetualPositionMargin{
+					{
+						PerpetualId:       perpetual.Params.Id,
+						Quantums:          dtypes.NewInt(100_000_000),
+						NetNotional:       dtypes.NewInt(50_000_000_000),
+						InitialMargin:     dtypes.NewInt(10_000_000_000),
+						MaintenanceMargin: dtypes.NewInt(5_000_000_000),
+						// $44,444.44. $40,000 / ($50,000 - $5,000) * $50,000.
+						LiquidationPrice: 4_444_444_444,
+					},
+				},
+			},
+		},
+		{
+			desc: "Buying another BTC at $50,000",
+			request: &types.QuerySubaccountMarginRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				AssetDeltas: []types.AssetPositionDelta{
+					{
+						AssetId:       constants.Usdc.Id,
+						QuantumsDelta: dtypes.NewInt(-50_000_000_000),
+					},
+				},
+				PerpetualDeltas: []types.PerpetualPositionDelta{
+					{
+						PerpetualId:   perpetual.Params.Id,
+						QuantumsDelta: dtypes.NewInt(100_000_000),
+					},
+				},
+			},
+			response: &types.QuerySubaccountMarginResponse{
+				NetCollateral:     dtypes.NewInt(10_000_000_000),
+				InitialMargin:     dtypes.NewInt(20_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(10_000_000_000),
+				FreeCollateral:    dtypes.NewInt(-10_000_000_000),
+				PerpetualPositions: []types.PerpetualPositionMargin{
+					{
+						PerpetualId:       perpetual.Params.Id,
+						Quantums:          dtypes.NewInt(200_000_000),
+						NetNotional:       dtypes.NewInt(100_000_000_000),
+						InitialMargin:     dtypes.NewInt(20_000_000_000),
+						MaintenanceMargin: dtypes.NewInt(10_000_000_000),
+						// $50,000. The subaccount is already at the maintenance margin requirement.
+						LiquidationPrice: 5_000_000_000,
+					},
+				},
+			},
+		},
+		{
+			desc: "Closing the position",
+			request: &types.QuerySubaccountMarginRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				PerpetualDeltas: []types.PerpetualPositionDelta{
+					{
+						PerpetualId:   perpetual.Params.Id,
+						QuantumsDelta: dtypes.NewInt(-100_000_000),
+					},
+				},
+			},
+			response: &types.QuerySubaccountMarginResponse{
+				NetCollateral:      dtypes.NewInt(-40_000_000_000),
+				InitialMargin:      dtypes.NewInt(0),
+				MaintenanceMargin:  dtypes.NewInt(0),
+				FreeCollateral:     dtypes.NewInt(-40_000_000_000),
+				PerpetualPositions: []types.PerpetualPositionMargin{},
+			},
+		},
+		{
+			desc: "Duplicate perpetual deltas",
+			request: &types.QuerySubaccountMarginRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				PerpetualDeltas: []types.PerpetualPositionDelta{
+					{
+						PerpetualId:   perpetual.Params.Id,
+						QuantumsDelta: dtypes.NewInt(1),
+					},
+					{
+						PerpetualId:   perpetual.Params.Id,
+						QuantumsDelta: dtypes.NewInt(1),
+					},
+				},
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"Multiple updates exist for position 0: multiple updates were specified for the same position id",
+			),
+		},
+		{
+			desc: "Nil asset quantums delta",
+			request: &types.QuerySubaccountMarginRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				AssetDeltas: []types.AssetPositionDelta{
+					{
+						AssetId: constants.Usdc.Id,
+					},
+				},
+			},
+			err: status.Error(codes.InvalidArgument, "nil quantums delta for asset 0"),
+		},
+		{
+			desc: "Nil perpetual quantums delta",
+			request: &types.QuerySubaccountMarginRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				PerpetualDeltas: []types.PerpetualPositionDelta{
+					{
+						PerpetualId: perpetual.Params.Id,
+					},
+				},
+			},
+			err: status.Error(codes.InvalidArgument, "nil quantums delta for perpetual 0"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.SubaccountMargin(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				// Compare string representations since zero values may have different `big.Int` internals.
+				require.Equal(t, tc.response.String(), response.String())
+			}
+		})
+	}
+}
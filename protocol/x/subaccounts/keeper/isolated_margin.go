@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// SetIsolatedMargin sets the isolated margin of a subaccount.
+//
+// If `isolatedMargin` is set, the subaccount is isolated to its perpetual and cannot hold positions in any
+// other perpetual, so its collateral only backs that position and its losses are limited to its own
+// collateral instead of spilling into the owner's other subaccounts. Collateral is moved in and out of the
+// isolated subaccount with regular transfers. The subaccount must not hold positions in any other perpetual
+// when it is isolated. If `isolatedMargin` is nil, the subaccount is cross-margined.
+func (k Keeper) SetIsolatedMargin(
+	ctx sdk.Context,
+	id types.SubaccountId,
+	isolatedMargin *types.IsolatedMargin,
+) error {
+	subaccount := k.GetSubaccount(ctx, id)
+
+	if isolatedMargin != nil {
+		if _, err := k.perpetualsKeeper.GetPerpetual(ctx, isolatedMargin.PerpetualId); err != nil {
+			return err
+		}
+
+		for _, position := range subaccount.PerpetualPositions {
+			if position.PerpetualId != isolatedMargin.PerpetualId {
+				return errorsmod.Wrapf(
+					types.ErrViolatesIsolatedMargin,
+					"subaccount %+v has a position in perpetual %d",
+					id,
+					position.PerpetualId,
+				)
+			}
+		}
+	}
+
+	subaccount.IsolatedMargin = isolatedMargin
+	k.SetSubaccount(ctx, subaccount)
+	return nil
+}
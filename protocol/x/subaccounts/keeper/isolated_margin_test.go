@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetIsolatedMargin(t *testing.T) {
+	tests := map[string]struct {
+		// Subaccount state.
+		perpetualPositions []*types.PerpetualPosition
+		isolatedMargin     *types.IsolatedMargin
+
+		// Parameters.
+		newIsolatedMargin *types.IsolatedMargin
+
+		// Expectations.
+		expectedErr error
+	}{
+		"isolates empty subaccount": {
+			newIsolatedMargin: &types.IsolatedMargin{PerpetualId: 0},
+		},
+		"isolates subaccount with position in the perpetual": {
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  0,
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			newIsolatedMargin: &types.IsolatedMargin{PerpetualId: 0},
+		},
+		"isolated subaccount becomes cross-margined": {
+			isolatedMargin:    &types.IsolatedMargin{PerpetualId: 0},
+			newIsolatedMargin: nil,
+		},
+		"cannot isolate subaccount with position in another perpetual": {
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  1,
+					Quantums:     dtypes.NewInt(1_000_000_000), // 1 ETH
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			newIsolatedMargin: &types.IsolatedMargin{PerpetualId: 0},
+			expectedErr:       types.ErrViolatesIsolatedMargin,
+		},
+		"cannot isolate subaccount to non-existent perpetual": {
+			newIsolatedMargin: &types.IsolatedMargin{PerpetualId: 100},
+			expectedErr:       perptypes.ErrPerpetualDoesNotExist,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, _, _ := testutil.SubaccountsKeepers(t, true)
+			testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+			testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_NoMarginRequirement,
+				constants.EthUsd_NoMarginRequirement,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+				)
+				require.NoError(t, err)
+			}
+
+			subaccountId := constants.Alice_Num0
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &subaccountId,
+				PerpetualPositions: tc.perpetualPositions,
+				IsolatedMargin:     tc.isolatedMargin,
+			})
+
+			err := keeper.SetIsolatedMargin(ctx, subaccountId, tc.newIsolatedMargin)
+			subaccount := keeper.GetSubaccount(ctx, subaccountId)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, tc.isolatedMargin, subaccount.IsolatedMargin)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.newIsolatedMargin, subaccount.IsolatedMargin)
+			// Isolated subaccounts are kept in state even when they hold no positions.
+			require.Equal(
+				t,
+				tc.newIsolatedMargin != nil || len(tc.perpetualPositions) > 0,
+				len(keeper.GetAllSubaccount(ctx)) == 1,
+			)
+		})
+	}
+}
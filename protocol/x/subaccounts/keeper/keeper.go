@@ -6,6 +6,7 @@ import (
 	sdklog "cosmossdk.io/log"
 
 	"github.com/cometbft/cometbft/libs/log"
+	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/liquidations"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -21,7 +22,10 @@ type (
 		assetsKeeper        types.AssetsKeeper
 		bankKeeper          types.BankKeeper
 		perpetualsKeeper    types.PerpetualsKeeper
+		clobKeeper          types.SubaccountsClobKeeper
 		indexerEventManager indexer_manager.IndexerEventManager
+		// Ids of updated subaccounts shared with the liquidation daemon server. May be nil.
+		updatedSubaccountIds *liquidationtypes.UpdatedSubaccountIds
 	}
 )
 
@@ -32,17 +36,28 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	perpetualsKeeper types.PerpetualsKeeper,
 	indexerEventManager indexer_manager.IndexerEventManager,
+	updatedSubaccountIds *liquidationtypes.UpdatedSubaccountIds,
 ) *Keeper {
 	return &Keeper{
-		cdc:                 cdc,
-		storeKey:            storeKey,
-		assetsKeeper:        assetsKeeper,
-		bankKeeper:          bankKeeper,
-		perpetualsKeeper:    perpetualsKeeper,
-		indexerEventManager: indexerEventManager,
+		cdc:                  cdc,
+		storeKey:             storeKey,
+		assetsKeeper:         assetsKeeper,
+		bankKeeper:           bankKeeper,
+		perpetualsKeeper:     perpetualsKeeper,
+		indexerEventManager:  indexerEventManager,
+		updatedSubaccountIds: updatedSubaccountIds,
 	}
 }
 
+// SetClobKeeper sets the `SubaccountsClobKeeper` reference, which is a Clob Keeper,
+// for this Subaccounts Keeper.
+// This method is called after the Subaccounts Keeper struct is initialized.
+// This reference is set with an explicit method call rather than during `NewKeeper`
+// due to the bidirectional dependency between the Subaccounts Keeper and the Clob Keeper.
+func (k *Keeper) SetClobKeeper(getter types.SubaccountsClobKeeper) {
+	k.clobKeeper = getter
+}
+
 func (k Keeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
 	return k.indexerEventManager
 }
@@ -51,5 +66,13 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With(sdklog.ModuleKey, fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// PublishUpdatedSubaccountIds makes the ids of subaccounts updated in the block that was just
+// committed available to the liquidation daemon.
+func (k Keeper) PublishUpdatedSubaccountIds() {
+	if k.updatedSubaccountIds != nil {
+		k.updatedSubaccountIds.PublishPendingSubaccountIds()
+	}
+}
+
 func (k Keeper) InitializeForGenesis(ctx sdk.Context) {
 }
@@ -17,16 +17,19 @@ import (
 	indexer_manager "github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // SetSubaccount set a specific subaccount in the store from its index.
-// Note that empty subaccounts are removed from state.
+// Note that empty cross-margined subaccounts are removed from state.
 func (k Keeper) SetSubaccount(ctx sdk.Context, subaccount types.Subaccount) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
 	key := subaccount.Id.ToStateKey()
 
-	if len(subaccount.PerpetualPositions) == 0 && len(subaccount.AssetPositions) == 0 {
+	if len(subaccount.PerpetualPositions) == 0 &&
+		len(subaccount.AssetPositions) == 0 &&
+		subaccount.IsolatedMargin == nil {
 		if store.Has(key) {
 			store.Delete(key)
 		}
@@ -34,6 +37,13 @@ func (k Keeper) SetSubaccount(ctx sdk.Context, subaccount types.Subaccount) {
 		b := k.cdc.MustMarshal(&subaccount)
 		store.Set(key, b)
 	}
+
+	// Record the update for the liquidation daemon. Updates in `CheckTx` are not recorded since they
+	// are never committed. Updates of transactions that fail later are recorded, which only causes the
+	// subaccount to be rechecked.
+	if k.updatedSubaccountIds != nil && lib.IsDeliverTxMode(ctx) {
+		k.updatedSubaccountIds.AddPendingSubaccountId(*subaccount.Id)
+	}
 }
 
 // GetSubaccount returns a subaccount from its index.
@@ -420,6 +430,7 @@ func (k Keeper) getSettledSubaccount(
 		AssetPositions:     subaccount.AssetPositions,
 		PerpetualPositions: newPerpetualPositions,
 		MarginEnabled:      subaccount.MarginEnabled,
+		IsolatedMargin:     subaccount.IsolatedMargin,
 	}
 	newUsdcPosition := new(big.Int).Add(
 		subaccount.GetUsdcPosition(),
@@ -498,6 +509,21 @@ func (k Keeper) internalCanUpdateSubaccounts(
 			}
 		}
 
+		// Margin-trading of non-USDC assets is not supported, so the update must not leave the
+		// subaccount with a negative balance of any non-USDC asset.
+		if hasNegativeNonUsdcAssetBalance(u) {
+			success = false
+			successPerUpdate[i] = types.InsufficientAssetBalance
+			continue
+		}
+
+		// An isolated subaccount cannot open positions in perpetuals other than the one it is isolated to.
+		if violatesIsolatedMargin(u) {
+			success = false
+			successPerUpdate[i] = types.ViolatesIsolatedMargin
+			continue
+		}
+
 		// Get the new collateralization and margin requirements with the update applied.
 		bigNewNetCollateral,
 			bigNewInitialMargin,
@@ -549,6 +575,38 @@ func (k Keeper) internalCanUpdateSubaccounts(
 	return success, successPerUpdate, nil
 }
 
+// hasNegativeNonUsdcAssetBalance returns true if applying the asset updates of the settled update
+// would result in a negative balance for any asset other than USDC.
+func hasNegativeNonUsdcAssetBalance(u settledUpdate) bool {
+	for _, assetUpdate := range u.AssetUpdates {
+		if assetUpdate.AssetId == assettypes.AssetUsdc.Id {
+			continue
+		}
+
+		bigNewQuantums := new(big.Int).Set(assetUpdate.GetBigQuantums())
+		if position, exists := u.SettledSubaccount.GetAssetPositionForId(assetUpdate.AssetId); exists {
+			bigNewQuantums.Add(bigNewQuantums, position.GetBigQuantums())
+		}
+
+		if bigNewQuantums.Sign() < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// violatesIsolatedMargin returns true if the settled update changes the position of an isolated
+// subaccount in a perpetual other than the one it is isolated to.
+func violatesIsolatedMargin(u settledUpdate) bool {
+	for _, perpetualUpdate := range u.PerpetualUpdates {
+		if perpetualUpdate.GetBigQuantums().Sign() != 0 &&
+			!u.SettledSubaccount.CanHoldPerpetualPosition(perpetualUpdate.PerpetualId) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsValidStateTransitionForUndercollateralizedSubaccount returns an `UpdateResult`
 // denoting whether this state transition is valid. This function accepts the collateral and
 // margin requirements of a subaccount before and after an update ("cur" and
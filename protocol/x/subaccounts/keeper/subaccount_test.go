@@ -8,8 +8,10 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	big_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/big"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
@@ -295,6 +297,7 @@ func TestUpdateSubaccounts(t *testing.T) {
 		// subaccount state
 		perpetualPositions []*types.PerpetualPosition
 		assetPositions     []*types.AssetPosition
+		isolatedMargin     *types.IsolatedMargin
 
 		// updates
 		updates []types.Update
@@ -1549,6 +1552,107 @@ func TestUpdateSubaccounts(t *testing.T) {
 			},
 			msgSenderEnabled: true,
 		},
+		"isolated subaccount opens new long position in isolated perpetual": {
+			assetPositions:           testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+			isolatedMargin:           &types.IsolatedMargin{PerpetualId: 0},
+			expectedQuoteBalance:     big.NewInt(50_000_000_000), // $50,000
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_NoMarginRequirement,
+				constants.EthUsd_NoMarginRequirement,
+			},
+			perpetualPositions: []*types.PerpetualPosition{},
+			expectedPerpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  uint32(0),
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  uint32(0),
+					Quantums: dtypes.NewInt(50_000_000_000),
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-50_000_000_000)), // -$50,000
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(0),
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+		},
+		"isolated subaccount cannot open position in other perpetual": {
+			assetPositions:           testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+			isolatedMargin:           &types.IsolatedMargin{PerpetualId: 0},
+			expectedQuoteBalance:     big.NewInt(100_000_000_000), // $100,000
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.ViolatesIsolatedMargin},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_NoMarginRequirement,
+				constants.EthUsd_NoMarginRequirement,
+			},
+			perpetualPositions: []*types.PerpetualPosition{},
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  uint32(0),
+					Quantums: dtypes.NewInt(100_000_000_000),
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-3_000_000_000)), // -$3,000
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(1),
+							BigQuantumsDelta: big.NewInt(1_000_000_000), // 1 ETH
+						},
+					},
+				},
+			},
+			msgSenderEnabled: true,
+		},
+		"isolated subaccount transfers out collateral": {
+			assetPositions:           testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+			isolatedMargin:           &types.IsolatedMargin{PerpetualId: 0},
+			expectedQuoteBalance:     big.NewInt(99_000_000_000), // $99,000
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_SmallMarginRequirement,
+			},
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  uint32(0),
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			expectedPerpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  uint32(0),
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  uint32(0),
+					Quantums: dtypes.NewInt(99_000_000_000),
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-1_000_000_000)), // -$1,000
+				},
+			},
+		},
 		"update would make account undercollateralized": {
 			expectedQuoteBalance:     big.NewInt(0),
 			expectedSuccess:          false,
@@ -2207,6 +2311,7 @@ func TestUpdateSubaccounts(t *testing.T) {
 			subaccount := createNSubaccount(keeper, ctx, 1, big.NewInt(1_000))[0]
 			subaccount.PerpetualPositions = tc.perpetualPositions
 			subaccount.AssetPositions = tc.assetPositions
+			subaccount.IsolatedMargin = tc.isolatedMargin
 			keeper.SetSubaccount(ctx, subaccount)
 			subaccountId := *subaccount.Id
 
@@ -2256,6 +2361,7 @@ func TestUpdateSubaccounts(t *testing.T) {
 			for i, ep := range tc.expectedAssetPositions {
 				require.Equal(t, *ep, *newSubaccount.AssetPositions[i])
 			}
+			require.Equal(t, tc.isolatedMargin, newSubaccount.IsolatedMargin)
 		})
 	}
 }
@@ -2307,6 +2413,76 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 			expectedSuccess:          true,
 			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
 		},
+		"non-USDC asset balance is decreased and USDC balance is increased": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(50_000_000_000), // $50,000
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(-100_000_000), // -1 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+		},
+		"non-USDC asset balance cannot become negative": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000_000), // $100,000
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(-200_000_000), // -2 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.InsufficientAssetBalance},
+		},
+		"non-USDC asset purchase is limited by USDC collateral": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000_000_000)), // $1,000
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(-50_000_000_000), // -$50,000
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized},
+		},
 		"perpetual does not exist (should never happen)": {
 			expectedErr: perptypes.ErrPerpetualDoesNotExist,
 			perpetualPositions: []*types.PerpetualPosition{
@@ -3163,7 +3339,9 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 			},
 		},
 		"asset with no balance and update": {
-			expectedErr: asstypes.ErrNotImplementedMulticollateral,
+			expectedNetCollateral:     big.NewInt(0),
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -3178,7 +3356,9 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 			},
 		},
 		"single positive asset": {
-			expectedErr: asstypes.ErrNotImplementedMulticollateral,
+			expectedNetCollateral:     big.NewInt(0),
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -3357,3 +3537,41 @@ func TestIsValidStateTransitionForUndercollateralizedSubaccount_ZeroMarginRequir
 		})
 	}
 }
+
+func TestSetSubaccount_RecordsUpdatedSubaccountIds(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	response, err := tApp.App.Server.GetUpdatedSubaccountIds(ctx, &api.GetUpdatedSubaccountIdsRequest{})
+	require.NoError(t, err)
+	sequence := response.Sequence
+
+	subaccount := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Alice_Num0)
+
+	// Updates in `CheckTx` are not recorded.
+	tApp.App.SubaccountsKeeper.SetSubaccount(ctx.WithIsCheckTx(true), subaccount)
+	tApp.App.SubaccountsKeeper.PublishUpdatedSubaccountIds()
+	response, err = tApp.App.Server.GetUpdatedSubaccountIds(
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: sequence},
+	)
+	require.NoError(t, err)
+	require.Empty(t, response.SubaccountIds)
+	require.Equal(t, sequence+1, response.Sequence)
+
+	// Updates in `DeliverTx` are recorded once published.
+	tApp.App.SubaccountsKeeper.SetSubaccount(ctx.WithIsCheckTx(false), subaccount)
+	response, err = tApp.App.Server.GetUpdatedSubaccountIds(
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: sequence + 1},
+	)
+	require.NoError(t, err)
+	require.Empty(t, response.SubaccountIds)
+	tApp.App.SubaccountsKeeper.PublishUpdatedSubaccountIds()
+	response, err = tApp.App.Server.GetUpdatedSubaccountIds(
+		ctx,
+		&api.GetUpdatedSubaccountIdsRequest{SinceSequence: sequence + 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.SubaccountId{constants.Alice_Num0}, response.SubaccountIds)
+	require.Equal(t, sequence+2, response.Sequence)
+}
@@ -14,6 +14,19 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
+// validateTransferAsset returns an error if the asset can not be transferred into, out of or between
+// subaccounts. Only USDC and the base assets of spot CLOB pairs, which subaccounts hold to trade on spot
+// CLOB pairs, can be transferred.
+func (k Keeper) validateTransferAsset(
+	ctx sdk.Context,
+	assetId uint32,
+) error {
+	if assetId == assettypes.AssetUsdc.Id || k.clobKeeper.IsSpotClobPairBaseAsset(ctx, assetId) {
+		return nil
+	}
+	return errorsmod.Wrapf(types.ErrAssetTransferNotSupported, "asset ID %d", assetId)
+}
+
 // getValidSubaccountUpdatesForTransfer generates subaccount updates and check
 // for validity with `CanUpdateSubaccount()`
 // Returns the subaccount updates if check is successful.
@@ -32,21 +45,16 @@ func (k Keeper) getValidSubaccountUpdatesForTransfer(
 		bigBalanceDelta.Neg(bigBalanceDelta)
 	}
 
-	if assetId == 0 {
-		updates = []types.Update{
-			{
-				SubaccountId: subaccountId,
-				AssetUpdates: []types.AssetUpdate{
-					{
-						AssetId:          assettypes.AssetUsdc.Id,
-						BigQuantumsDelta: bigBalanceDelta,
-					},
+	updates = []types.Update{
+		{
+			SubaccountId: subaccountId,
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assetId,
+					BigQuantumsDelta: bigBalanceDelta,
 				},
 			},
-		}
-	} else {
-		// TODO(DEC-715): Support non-USDC assets.
-		return nil, types.ErrAssetTransferThroughBankNotImplemented
+		},
 	}
 
 	success, successPerUpdate, err := k.CanUpdateSubaccounts(ctx, updates)
@@ -93,9 +101,8 @@ func (k Keeper) TransferFundsFromSubaccountToModule(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	if err := k.validateTransferAsset(ctx, assetId); err != nil {
+		return err
 	}
 
 	if quantums.Sign() <= 0 {
@@ -154,9 +161,8 @@ func (k Keeper) TransferFundsFromModuleToSubaccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	if err := k.validateTransferAsset(ctx, assetId); err != nil {
+		return err
 	}
 
 	if quantums.Sign() <= 0 {
@@ -213,9 +219,8 @@ func (k Keeper) DepositFundsFromAccountToSubaccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	if err := k.validateTransferAsset(ctx, assetId); err != nil {
+		return err
 	}
 
 	if quantums.Sign() <= 0 {
@@ -270,9 +275,8 @@ func (k Keeper) WithdrawFundsFromSubaccountToAccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	if err := k.validateTransferAsset(ctx, assetId); err != nil {
+		return err
 	}
 
 	if quantums.Sign() <= 0 {
@@ -409,3 +413,46 @@ func (k Keeper) TransferInsuranceFundPayments(
 		[]sdk.Coin{coinToTransfer},
 	)
 }
+
+// TransferFundsFromSubaccountToSubaccount returns an error if the update of either subaccount fails.
+// Otherwise, moves the asset quantums from the sender subaccount to the recipient subaccount.
+func (k Keeper) TransferFundsFromSubaccountToSubaccount(
+	ctx sdk.Context,
+	senderSubaccountId types.SubaccountId,
+	recipientSubaccountId types.SubaccountId,
+	assetId uint32,
+	quantums *big.Int,
+) error {
+	if err := k.validateTransferAsset(ctx, assetId); err != nil {
+		return err
+	}
+
+	updates := []types.Update{
+		{
+			SubaccountId: senderSubaccountId,
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assetId,
+					BigQuantumsDelta: new(big.Int).Neg(quantums),
+				},
+			},
+		},
+		{
+			SubaccountId: recipientSubaccountId,
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assetId,
+					BigQuantumsDelta: new(big.Int).Set(quantums),
+				},
+			},
+		},
+	}
+
+	success, successPerUpdate, err := k.UpdateSubaccounts(ctx, updates)
+	if err != nil {
+		return err
+	}
+
+	// If not successful, return error indicating why.
+	return types.GetErrorFromUpdateResults(success, successPerUpdate, updates)
+}
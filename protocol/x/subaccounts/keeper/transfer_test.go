@@ -12,7 +12,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	auth_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/auth"
 	bank_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/bank"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
@@ -20,10 +22,23 @@ import (
 	sample_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
 	asstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// setSpotClobPairBaseAssets sets a mock clob keeper on the subaccounts keeper under which only the
+// provided assets are the base assets of spot CLOB pairs.
+func setSpotClobPairBaseAssets(k *keeper.Keeper, assetIds ...uint32) {
+	mockClobKeeper := &mocks.SubaccountsClobKeeper{}
+	for _, assetId := range assetIds {
+		mockClobKeeper.On("IsSpotClobPairBaseAsset", mock.Anything, assetId).Return(true)
+	}
+	mockClobKeeper.On("IsSpotClobPairBaseAsset", mock.Anything, mock.Anything).Return(false)
+	k.SetClobKeeper(mockClobKeeper)
+}
+
 func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccount_Success(t *testing.T) {
 	tests := map[string]struct {
 		testTransferFundToAccount bool
@@ -126,9 +141,58 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			),
 			expectedAccAddressBalance: big.NewInt(0),
 		},
+		"WithdrawFundsFromSubaccountToAccount: send a non-USDC asset from subaccount to an account address": {
+			testTransferFundToAccount:  true,
+			asset:                      *constants.BtcUsd,
+			accAddressBalance:          big.NewInt(2500),
+			subaccountModuleAccBalance: big.NewInt(600),
+			quantums:                   big.NewInt(500),
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(600),
+				},
+			},
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(100), // 600 - 500
+				},
+			},
+			expectedQuoteBalance:                big.NewInt(500),  // unchanged
+			expectedSubaccountsModuleAccBalance: big.NewInt(100),  // 600 - 500
+			expectedAccAddressBalance:           big.NewInt(3000), // 2500 + 500
+		},
+		"DepositFundsFromAccountToSubaccount: send a non-USDC asset from account to subaccount": {
+			testTransferFundToAccount:  false,
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(200),
+			accAddressBalance:          big.NewInt(2000),
+			quantums:                   big.NewInt(500),
+			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(150)),
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(150),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+			},
+			expectedQuoteBalance:                big.NewInt(150),  // unchanged
+			expectedSubaccountsModuleAccBalance: big.NewInt(700),  // 200 + 500
+			expectedAccAddressBalance:           big.NewInt(1500), // 2000 - 500
+		},
 
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
 		// TODO(CORE-169): Add tests for when the input quantums is rounded down to
 		// a integer denom amount.
 	}
@@ -136,6 +200,8 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctx, keeper, pricesKeeper, _, accountKeeper, bankKeeper, assetsKeeper, _ := keepertest.SubaccountsKeepers(t, true)
+			// Only BTC is the base asset of a spot CLOB pair.
+			setSpotClobPairBaseAssets(keeper, constants.BtcUsd.Id)
 			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
 
 			// Set up Subaccounts module account.
@@ -174,6 +240,12 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 				require.NoError(t, err)
 			}
 
+			if tc.asset.Denom != constants.Usdc.Denom {
+				// Always create USDC as the first asset.
+				err := keepertest.CreateUsdcAsset(ctx, assetsKeeper)
+				require.NoError(t, err)
+			}
+
 			_, err = assetsKeeper.CreateAsset(
 				ctx,
 				tc.asset.Id,
@@ -248,6 +320,7 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccount_Failure(t *testing.T) {
 	tests := map[string]struct {
 		skipSetUpUsdc             bool
+		notSpotClobPairBaseAsset  bool
 		testTransferFundToAccount bool
 		asset                     asstypes.Asset
 
@@ -300,14 +373,14 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedErr:                types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"WithdrawFundsFromSubaccountToAccount: do not support assets other than USDC": {
+		"WithdrawFundsFromSubaccountToAccount: subaccount does not have enough of a non-USDC asset": {
 			testTransferFundToAccount:  true,
 			accAddressBalance:          big.NewInt(500),
 			asset:                      *constants.BtcUsd,
 			subaccountModuleAccBalance: big.NewInt(500),
 			quantums:                   big.NewInt(500),
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                types.ErrFailedToUpdateSubaccounts,
 		},
 		"WithdrawFundsFromSubaccountToAccount: asset ID doesn't exist": {
 			testTransferFundToAccount:  true,
@@ -337,14 +410,34 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedErr:                types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"DepositFundsFromAccountToSubaccount: do not support assets other than USDC": {
+		"DepositFundsFromAccountToSubaccount: account does not have enough of a non-USDC asset": {
+			testTransferFundToAccount:  false,
+			accAddressBalance:          big.NewInt(100),
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(500),
+			quantums:                   big.NewInt(500),
+			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
+			expectedErr:                sdkerrors.ErrInsufficientFunds,
+		},
+		"WithdrawFundsFromSubaccountToAccount: asset is not USDC or the base asset of a spot CLOB pair": {
+			testTransferFundToAccount:  true,
+			accAddressBalance:          big.NewInt(500),
+			asset:                      *constants.BtcUsd,
+			notSpotClobPairBaseAsset:   true,
+			subaccountModuleAccBalance: big.NewInt(500),
+			quantums:                   big.NewInt(500),
+			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
+			expectedErr:                types.ErrAssetTransferNotSupported,
+		},
+		"DepositFundsFromAccountToSubaccount: asset is not USDC or the base asset of a spot CLOB pair": {
 			testTransferFundToAccount:  false,
 			accAddressBalance:          big.NewInt(500),
 			asset:                      *constants.BtcUsd,
+			notSpotClobPairBaseAsset:   true,
 			subaccountModuleAccBalance: big.NewInt(500),
 			quantums:                   big.NewInt(500),
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                types.ErrAssetTransferNotSupported,
 		},
 		"DepositFundsFromAccountToSubaccount: failure, asset ID doesn't exist": {
 			testTransferFundToAccount:  false,
@@ -363,6 +456,10 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctx, keeper, pricesKeeper, _, accountKeeper, bankKeeper, assetsKeeper, _ := keepertest.SubaccountsKeepers(t, true)
+			// Only BTC is the base asset of a spot CLOB pair, unless specified otherwise.
+			if !tc.notSpotClobPairBaseAsset {
+				setSpotClobPairBaseAssets(keeper, constants.BtcUsd.Id)
+			}
 			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
 
 			// Set up Subaccounts module account.
@@ -481,6 +578,7 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount(t *testing.T) {
 	tests := map[string]struct {
 		skipSetUpUsdc            bool
+		notSpotClobPairBaseAsset bool
 		testTransferFundToModule bool
 		asset                    asstypes.Asset
 
@@ -553,7 +651,7 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 			expectedOtherModuleAccBalance:       big.NewInt(2500),
 			expectedErr:                         types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"TransferFundsFromSubaccountToModule: failure, do not support assets other than USDC": {
+		"TransferFundsFromSubaccountToModule: failure, subaccount does not have enough of a non-USDC asset": {
 			testTransferFundToModule:            true,
 			otherModuleName:                     authtypes.FeeCollectorName,
 			otherModuleAccBalance:               big.NewInt(500),
@@ -561,7 +659,7 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 			subaccountModuleAccBalance:          big.NewInt(500),
 			quantums:                            big.NewInt(500),
 			assetPositions:                      keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                         types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                         types.ErrFailedToUpdateSubaccounts,
 			expectedQuoteBalance:                big.NewInt(500),
 			expectedSubaccountsModuleAccBalance: big.NewInt(500),
 			expectedOtherModuleAccBalance:       big.NewInt(500),
@@ -618,15 +716,38 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 			expectedOtherModuleAccBalance:       big.NewInt(2500),
 			expectedErr:                         types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"TransferFundsFromModuleToSubaccount: failure, do not support assets other than USDC": {
+		"TransferFundsFromModuleToSubaccount: successfully send a non-USDC asset to subaccount": {
+			testTransferFundToModule:   false,
+			otherModuleName:            authtypes.FeeCollectorName,
+			otherModuleAccBalance:      big.NewInt(500),
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(500),
+			quantums:                   big.NewInt(500),
+			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+			},
+			expectedQuoteBalance:                big.NewInt(500),
+			expectedSubaccountsModuleAccBalance: big.NewInt(1000), // 500 + 500
+			expectedOtherModuleAccBalance:       big.NewInt(0),    // 500 - 500
+		},
+		"TransferFundsFromModuleToSubaccount: failure, asset is not USDC or the base asset of a spot CLOB pair": {
 			testTransferFundToModule:            false,
 			otherModuleName:                     authtypes.FeeCollectorName,
 			otherModuleAccBalance:               big.NewInt(500),
 			asset:                               *constants.BtcUsd,
+			notSpotClobPairBaseAsset:            true,
 			subaccountModuleAccBalance:          big.NewInt(500),
 			quantums:                            big.NewInt(500),
 			assetPositions:                      keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                         types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                         types.ErrAssetTransferNotSupported,
 			expectedQuoteBalance:                big.NewInt(500),
 			expectedSubaccountsModuleAccBalance: big.NewInt(500),
 			expectedOtherModuleAccBalance:       big.NewInt(500),
@@ -645,13 +766,15 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 			expectedSubaccountsModuleAccBalance: big.NewInt(500),
 			expectedOtherModuleAccBalance:       big.NewInt(500),
 		},
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctx, keeper, pricesKeeper, _, accountKeeper, bankKeeper, assetsKeeper, _ := keepertest.SubaccountsKeepers(t, true)
+			// Only BTC is the base asset of a spot CLOB pair, unless specified otherwise.
+			if !tc.notSpotClobPairBaseAsset {
+				setSpotClobPairBaseAssets(keeper, constants.BtcUsd.Id)
+			}
 			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
 
 			// Create a mint module for funding test accounts and module accounts.
@@ -157,3 +157,8 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
+
+// Commit executes all ABCI Commit logic respective to the subaccounts module.
+func (am AppModule) Commit(_ sdk.Context) {
+	am.keeper.PublishUpdatedSubaccountIds()
+}
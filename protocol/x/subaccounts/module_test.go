@@ -224,9 +224,10 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "subaccounts", cmd.Use)
-	require.Equal(t, 2, len(cmd.Commands()))
+	require.Equal(t, 3, len(cmd.Commands()))
 	require.Equal(t, "list-subaccount", cmd.Commands()[0].Name())
 	require.Equal(t, "show-subaccount", cmd.Commands()[1].Name())
+	require.Equal(t, "show-subaccount-margin", cmd.Commands()[2].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -277,7 +278,7 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	genesisJson := am.ExportGenesis(ctx, cdc)
 	expected := `{"subaccounts":[{"id":{"owner":"foo","number":127},`
 	expected += `"asset_positions":[{"asset_id":0,"quantums":"1000","index":"0"}],`
-	expected += `"perpetual_positions":[],"margin_enabled":false}]}`
+	expected += `"perpetual_positions":[],"margin_enabled":false,"isolated_margin":null}]}`
 	require.Equal(t, expected, string(genesisJson))
 }
 
@@ -38,4 +38,10 @@ var (
 		ModuleName, 500, "asset transfer quantums is not positive")
 	ErrAssetTransferThroughBankNotImplemented = errorsmod.Register(
 		ModuleName, 501, "asset transfer (other than USDC) through the bank module is not implemented")
+	ErrAssetTransferNotSupported = errorsmod.Register(
+		ModuleName, 502, "only USDC and the base assets of spot CLOB pairs can be transferred")
+
+	// 600 - 699: isolated margin related.
+	ErrViolatesIsolatedMargin = errorsmod.Register(
+		ModuleName, 600, "isolated subaccount cannot hold positions in other perpetuals")
 )
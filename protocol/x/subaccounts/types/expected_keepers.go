@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
 // ProductKeeper represents a generic interface for a keeper
@@ -63,6 +64,20 @@ type PerpetualsKeeper interface {
 		err error,
 	)
 	GetAllPerpetuals(ctx sdk.Context) []perptypes.Perpetual
+	GetPerpetual(ctx sdk.Context, id uint32) (perptypes.Perpetual, error)
+	GetPerpetualAndMarketPrice(
+		ctx sdk.Context,
+		perpetualId uint32,
+	) (perptypes.Perpetual, pricestypes.MarketPrice, error)
+}
+
+// SubaccountsClobKeeper defines the expected clob keeper used to determine which assets can be transferred
+// into or out of subaccounts.
+type SubaccountsClobKeeper interface {
+	IsSpotClobPairBaseAsset(
+		ctx sdk.Context,
+		assetId uint32,
+	) bool
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -27,22 +28,22 @@ func (gs GenesisState) Validate() error {
 		includedAccounts[*subaccountId] = true
 
 		// Validate AssetPositions.
-		// TODO(DEC-582): once we support different assets, remove this validation.
-		if len(sa.GetAssetPositions()) > 1 {
-			return ErrMultAssetPositionsNotSupported
-		}
 		for i := 0; i < len(sa.GetAssetPositions()); i++ {
 			assetP := sa.GetAssetPositions()[i]
 			if i > 0 && assetP.AssetId <= sa.GetAssetPositions()[i-1].AssetId {
 				return ErrAssetPositionsOutOfOrder
 			}
-			// TODO(DEC-582): once we support different assets, remove this validation.
-			if assetP.AssetId != 0 {
-				return ErrAssetPositionNotSupported
-			}
 			if assetP.GetBigQuantums().Sign() == 0 {
 				return ErrAssetPositionZeroQuantum
 			}
+			// Only USDC balances may be negative.
+			if assetP.AssetId != assettypes.AssetUsdc.Id && assetP.GetBigQuantums().Sign() < 0 {
+				return errorsmod.Wrapf(
+					ErrAssetPositionNotSupported,
+					"negative balance for asset %d",
+					assetP.AssetId,
+				)
+			}
 		}
 
 		// Validate PerpetualPositions.
@@ -54,6 +55,14 @@ func (gs GenesisState) Validate() error {
 			if perpP.GetBigQuantums().Sign() == 0 {
 				return ErrPerpPositionZeroQuantum
 			}
+			if !sa.CanHoldPerpetualPosition(perpP.PerpetualId) {
+				return errorsmod.Wrapf(
+					ErrViolatesIsolatedMargin,
+					"subaccount %+v has a position in perpetual %d",
+					subaccountId,
+					perpP.PerpetualId,
+				)
+			}
 		}
 	}
 	return nil
@@ -117,7 +117,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedError: types.ErrDuplicateSubaccountIds,
 		},
-		"invalid: multiple asset positions": {
+		"valid: multiple asset positions": {
 			genState: &types.GenesisState{
 				Subaccounts: []types.Subaccount{
 					{
@@ -125,21 +125,21 @@ func TestGenesisState_Validate(t *testing.T) {
 							Owner:  sample.AccAddress(),
 							Number: uint32(127),
 						},
-						AssetPositions: []*types.AssetPosition{ // multiple asset positions.
+						AssetPositions: []*types.AssetPosition{
 							{
-								AssetId: 0,
+								AssetId:  0,
+								Quantums: dtypes.NewInt(-1_000),
 							},
 							{
-								AssetId: 1,
+								AssetId:  1,
+								Quantums: dtypes.NewInt(1_000),
 							},
 						},
 					},
 				},
 			},
-			expectedError: types.ErrMultAssetPositionsNotSupported,
 		},
-		// TODO(DEC-582): once we support different assets, add a test case for the asset ordering.
-		"invalid: asset position id != 0": {
+		"invalid: asset positions out of order": {
 			genState: &types.GenesisState{
 				Subaccounts: []types.Subaccount{
 					{
@@ -149,13 +149,36 @@ func TestGenesisState_Validate(t *testing.T) {
 						},
 						AssetPositions: []*types.AssetPosition{
 							{
-								AssetId:  1, // asset id must be zero (0 = USDC).
+								AssetId:  1,
+								Quantums: dtypes.NewInt(1_000),
+							},
+							{
+								AssetId:  0,
 								Quantums: dtypes.NewInt(1_000),
 							},
 						},
 					},
 				},
 			},
+			expectedError: types.ErrAssetPositionsOutOfOrder,
+		},
+		"invalid: negative non-USDC asset position": {
+			genState: &types.GenesisState{
+				Subaccounts: []types.Subaccount{
+					{
+						Id: &types.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(127),
+						},
+						AssetPositions: []*types.AssetPosition{
+							{
+								AssetId:  1,
+								Quantums: dtypes.NewInt(-1_000), // only USDC balances may be negative.
+							},
+						},
+					},
+				},
+			},
 			expectedError: types.ErrAssetPositionNotSupported,
 		},
 		"invalid: asset position quantum == 0": {
@@ -227,6 +250,46 @@ func TestGenesisState_Validate(t *testing.T) {
 				"perpetual position (perpetual Id: 0) has zero quantum",
 			),
 		},
+		"valid: isolated subaccount with position in isolated perpetual": {
+			genState: &types.GenesisState{
+				Subaccounts: []types.Subaccount{
+					{
+						Id: &types.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(127),
+						},
+						PerpetualPositions: []*types.PerpetualPosition{
+							{
+								PerpetualId: 1,
+								Quantums:    dtypes.NewInt(1_000),
+							},
+						},
+						IsolatedMargin: &types.IsolatedMargin{PerpetualId: 1},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		"invalid: isolated subaccount with position in other perpetual": {
+			genState: &types.GenesisState{
+				Subaccounts: []types.Subaccount{
+					{
+						Id: &types.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(127),
+						},
+						PerpetualPositions: []*types.PerpetualPosition{
+							{
+								PerpetualId: 1,
+								Quantums:    dtypes.NewInt(1_000),
+							},
+						},
+						IsolatedMargin: &types.IsolatedMargin{PerpetualId: 0},
+					},
+				},
+			},
+			expectedError: types.ErrViolatesIsolatedMargin,
+		},
 	}
 
 	for name, tc := range tests {
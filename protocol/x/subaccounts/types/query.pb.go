@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -227,11 +228,310 @@ func (m *QuerySubaccountAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySubaccountMarginRequest is request type for the SubaccountMargin RPC
+// method.
+type QuerySubaccountMarginRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Hypothetical changes to the asset positions of the subaccount, applied
+	// before calculating collateral and margin requirements.
+	AssetDeltas []AssetPositionDelta `protobuf:"bytes,3,rep,name=asset_deltas,json=assetDeltas,proto3" json:"asset_deltas"`
+	// Hypothetical changes to the perpetual positions of the subaccount, applied
+	// before calculating collateral and margin requirements.
+	PerpetualDeltas []PerpetualPositionDelta `protobuf:"bytes,4,rep,name=perpetual_deltas,json=perpetualDeltas,proto3" json:"perpetual_deltas"`
+}
+
+func (m *QuerySubaccountMarginRequest) Reset()         { *m = QuerySubaccountMarginRequest{} }
+func (m *QuerySubaccountMarginRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountMarginRequest) ProtoMessage()    {}
+func (*QuerySubaccountMarginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{4}
+}
+func (m *QuerySubaccountMarginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountMarginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountMarginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountMarginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountMarginRequest.Merge(m, src)
+}
+func (m *QuerySubaccountMarginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountMarginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountMarginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountMarginRequest proto.InternalMessageInfo
+
+func (m *QuerySubaccountMarginRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QuerySubaccountMarginRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QuerySubaccountMarginRequest) GetAssetDeltas() []AssetPositionDelta {
+	if m != nil {
+		return m.AssetDeltas
+	}
+	return nil
+}
+
+func (m *QuerySubaccountMarginRequest) GetPerpetualDeltas() []PerpetualPositionDelta {
+	if m != nil {
+		return m.PerpetualDeltas
+	}
+	return nil
+}
+
+// AssetPositionDelta is a hypothetical change to an asset position.
+type AssetPositionDelta struct {
+	// The `Id` of the `Asset`.
+	AssetId uint32 `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The change in the size of the position in quantums.
+	QuantumsDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums_delta,json=quantumsDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums_delta"`
+}
+
+func (m *AssetPositionDelta) Reset()         { *m = AssetPositionDelta{} }
+func (m *AssetPositionDelta) String() string { return proto.CompactTextString(m) }
+func (*AssetPositionDelta) ProtoMessage()    {}
+func (*AssetPositionDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{5}
+}
+func (m *AssetPositionDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPositionDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPositionDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetPositionDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPositionDelta.Merge(m, src)
+}
+func (m *AssetPositionDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPositionDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPositionDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPositionDelta proto.InternalMessageInfo
+
+func (m *AssetPositionDelta) GetAssetId() uint32 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
+}
+
+// PerpetualPositionDelta is a hypothetical change to a perpetual position.
+type PerpetualPositionDelta struct {
+	// The `Id` of the `Perpetual`.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The change in the size of the position in base quantums.
+	QuantumsDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums_delta,json=quantumsDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums_delta"`
+}
+
+func (m *PerpetualPositionDelta) Reset()         { *m = PerpetualPositionDelta{} }
+func (m *PerpetualPositionDelta) String() string { return proto.CompactTextString(m) }
+func (*PerpetualPositionDelta) ProtoMessage()    {}
+func (*PerpetualPositionDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{6}
+}
+func (m *PerpetualPositionDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualPositionDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualPositionDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualPositionDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualPositionDelta.Merge(m, src)
+}
+func (m *PerpetualPositionDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualPositionDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualPositionDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualPositionDelta proto.InternalMessageInfo
+
+func (m *PerpetualPositionDelta) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QuerySubaccountMarginResponse is response type for the SubaccountMargin RPC
+// method. All amounts are in quote quantums.
+type QuerySubaccountMarginResponse struct {
+	// The total net collateral of the subaccount.
+	NetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	// The total initial margin requirement of the subaccount.
+	InitialMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=initial_margin,json=initialMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin"`
+	// The total maintenance margin requirement of the subaccount.
+	MaintenanceMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=maintenance_margin,json=maintenanceMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin"`
+	// The net collateral in excess of the initial margin requirement. Negative
+	// if the subaccount is undercollateralized.
+	FreeCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=free_collateral,json=freeCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"free_collateral"`
+	// The margin information of each open perpetual position, ordered by
+	// perpetual id.
+	PerpetualPositions []PerpetualPositionMargin `protobuf:"bytes,5,rep,name=perpetual_positions,json=perpetualPositions,proto3" json:"perpetual_positions"`
+}
+
+func (m *QuerySubaccountMarginResponse) Reset()         { *m = QuerySubaccountMarginResponse{} }
+func (m *QuerySubaccountMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountMarginResponse) ProtoMessage()    {}
+func (*QuerySubaccountMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{7}
+}
+func (m *QuerySubaccountMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountMarginResponse.Merge(m, src)
+}
+func (m *QuerySubaccountMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountMarginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountMarginResponse proto.InternalMessageInfo
+
+func (m *QuerySubaccountMarginResponse) GetPerpetualPositions() []PerpetualPositionMargin {
+	if m != nil {
+		return m.PerpetualPositions
+	}
+	return nil
+}
+
+// PerpetualPositionMargin contains the margin information of a single
+// perpetual position.
+type PerpetualPositionMargin struct {
+	// The `Id` of the `Perpetual`.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The size of the position in base quantums.
+	Quantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums"`
+	// The net notional of the position in quote quantums.
+	NetNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=net_notional,json=netNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_notional"`
+	// The initial margin requirement of the position in quote quantums.
+	InitialMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=initial_margin,json=initialMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin"`
+	// The maintenance margin requirement of the position in quote quantums.
+	MaintenanceMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,5,opt,name=maintenance_margin,json=maintenanceMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin"`
+	// The estimated oracle price of the perpetual's market at which the
+	// subaccount becomes liquidatable, assuming the prices of all other markets
+	// are unchanged. Uses the exponent of the market's price. Zero if the
+	// subaccount cannot become liquidatable through a change in this price.
+	LiquidationPrice uint64 `protobuf:"varint,6,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+}
+
+func (m *PerpetualPositionMargin) Reset()         { *m = PerpetualPositionMargin{} }
+func (m *PerpetualPositionMargin) String() string { return proto.CompactTextString(m) }
+func (*PerpetualPositionMargin) ProtoMessage()    {}
+func (*PerpetualPositionMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{8}
+}
+func (m *PerpetualPositionMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualPositionMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualPositionMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualPositionMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualPositionMargin.Merge(m, src)
+}
+func (m *PerpetualPositionMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualPositionMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualPositionMargin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualPositionMargin proto.InternalMessageInfo
+
+func (m *PerpetualPositionMargin) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *PerpetualPositionMargin) GetLiquidationPrice() uint64 {
+	if m != nil {
+		return m.LiquidationPrice
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryGetSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryGetSubaccountRequest")
 	proto.RegisterType((*QuerySubaccountResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountResponse")
 	proto.RegisterType((*QueryAllSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryAllSubaccountRequest")
 	proto.RegisterType((*QuerySubaccountAllResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountAllResponse")
+	proto.RegisterType((*QuerySubaccountMarginRequest)(nil), "dydxprotocol.subaccounts.QuerySubaccountMarginRequest")
+	proto.RegisterType((*AssetPositionDelta)(nil), "dydxprotocol.subaccounts.AssetPositionDelta")
+	proto.RegisterType((*PerpetualPositionDelta)(nil), "dydxprotocol.subaccounts.PerpetualPositionDelta")
+	proto.RegisterType((*QuerySubaccountMarginResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountMarginResponse")
+	proto.RegisterType((*PerpetualPositionMargin)(nil), "dydxprotocol.subaccounts.PerpetualPositionMargin")
 }
 
 func init() {
@@ -239,38 +539,63 @@ func init() {
 }
 
 var fileDescriptor_adc19ff1d5b72954 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0x14, 0x31,
-	0x14, 0xc7, 0x37, 0xab, 0x2d, 0x18, 0xe9, 0x25, 0x14, 0xdd, 0x0e, 0x32, 0x96, 0x61, 0xa9, 0x55,
-	0x6c, 0xc2, 0xb6, 0x15, 0x4f, 0x3d, 0xec, 0x1e, 0x2c, 0x78, 0xd2, 0xe9, 0x41, 0xf0, 0x22, 0x99,
-	0xd9, 0x90, 0x0e, 0xcc, 0x26, 0xd3, 0x49, 0xa6, 0x76, 0x29, 0xbd, 0xf8, 0x09, 0x04, 0xbf, 0x84,
-	0x57, 0xd1, 0x0f, 0xd1, 0x63, 0xd1, 0x8b, 0x27, 0x91, 0x5d, 0x0f, 0x7e, 0x0c, 0xd9, 0x24, 0xee,
-	0xcc, 0xba, 0x0e, 0xbb, 0xf4, 0x96, 0xe4, 0xbd, 0xff, 0x7b, 0xbf, 0xff, 0xcb, 0x83, 0xed, 0xfe,
-	0xb0, 0x7f, 0x96, 0xe5, 0x52, 0xcb, 0x58, 0xa6, 0x44, 0x15, 0x11, 0x8d, 0x63, 0x59, 0x08, 0xad,
-	0xc8, 0x49, 0xc1, 0xf2, 0x21, 0x36, 0x21, 0xd4, 0xaa, 0x66, 0xe1, 0x4a, 0x96, 0xb7, 0x11, 0x4b,
-	0x35, 0x90, 0xea, 0x8d, 0x09, 0x12, 0x7b, 0xb1, 0x22, 0x6f, 0x9d, 0x4b, 0x2e, 0xed, 0xfb, 0xe4,
-	0xe4, 0x5e, 0xef, 0x71, 0x29, 0x79, 0xca, 0x08, 0xcd, 0x12, 0x42, 0x85, 0x90, 0x9a, 0xea, 0x44,
-	0x8a, 0xbf, 0x9a, 0x47, 0xb6, 0x02, 0x89, 0xa8, 0x62, 0x96, 0x80, 0x9c, 0x76, 0x22, 0xa6, 0x69,
-	0x87, 0x64, 0x94, 0x27, 0xc2, 0x24, 0xbb, 0xdc, 0x87, 0xb5, 0xe8, 0xe5, 0xd9, 0xa6, 0x06, 0x31,
-	0xdc, 0x78, 0x39, 0x29, 0x76, 0xc8, 0xf4, 0xd1, 0x34, 0x16, 0xb2, 0x93, 0x82, 0x29, 0x8d, 0x30,
-	0x5c, 0x91, 0x6f, 0x05, 0xcb, 0x5b, 0x60, 0x13, 0x6c, 0xdf, 0xea, 0xb5, 0xbe, 0x7e, 0xd9, 0x59,
-	0x77, 0x46, 0xba, 0xfd, 0x7e, 0xce, 0x94, 0x3a, 0xd2, 0x79, 0x22, 0x78, 0x68, 0xd3, 0xd0, 0x1d,
-	0xb8, 0x2a, 0x8a, 0x41, 0xc4, 0xf2, 0x56, 0x73, 0x13, 0x6c, 0xaf, 0x85, 0xee, 0x16, 0x30, 0x78,
-	0xd7, 0x34, 0xa9, 0x76, 0x50, 0x99, 0x14, 0x8a, 0xa1, 0xe7, 0x10, 0x96, 0x4c, 0xa6, 0xcf, 0xed,
-	0xdd, 0x36, 0xae, 0x1b, 0x2a, 0x2e, 0x2b, 0xf4, 0x6e, 0x5e, 0xfe, 0xb8, 0xdf, 0x08, 0x2b, 0xea,
-	0xa9, 0x97, 0x6e, 0x9a, 0xce, 0x7b, 0x79, 0x06, 0x61, 0x39, 0x27, 0xd7, 0x68, 0x0b, 0x3b, 0x37,
-	0x93, 0xa1, 0x62, 0xfb, 0xad, 0x6e, 0xa8, 0xf8, 0x05, 0xe5, 0xcc, 0x69, 0xc3, 0x8a, 0x32, 0xf8,
-	0x04, 0xa0, 0xf7, 0x8f, 0x99, 0x6e, 0x9a, 0xd6, 0xfa, 0xb9, 0x71, 0x7d, 0x3f, 0xe8, 0x70, 0x06,
-	0xb9, 0x69, 0x90, 0x1f, 0x2c, 0x44, 0xb6, 0x20, 0x55, 0xe6, 0xdd, 0xdf, 0x4d, 0xb8, 0x62, 0x98,
-	0xd1, 0x67, 0x00, 0x61, 0xd9, 0x13, 0xed, 0xd5, 0x93, 0xd5, 0x6e, 0x85, 0xd7, 0x59, 0x20, 0x9a,
-	0xff, 0xe5, 0xe0, 0xe0, 0xdd, 0xb7, 0x5f, 0x1f, 0x9a, 0x4f, 0xd1, 0x13, 0xb2, 0xc4, 0x66, 0x92,
-	0x73, 0xb3, 0x4d, 0x17, 0xe4, 0xdc, 0xae, 0xcf, 0x05, 0xfa, 0x08, 0xe0, 0xda, 0xcc, 0xb8, 0x17,
-	0x82, 0xff, 0x6f, 0x05, 0xbc, 0xfd, 0xa5, 0xc1, 0x2b, 0x3f, 0x1a, 0x3c, 0x36, 0xec, 0x5b, 0xa8,
-	0xbd, 0x0c, 0x7b, 0xef, 0xd5, 0xe5, 0xc8, 0x07, 0x57, 0x23, 0x1f, 0xfc, 0x1c, 0xf9, 0xe0, 0xfd,
-	0xd8, 0x6f, 0x5c, 0x8d, 0xfd, 0xc6, 0xf7, 0xb1, 0xdf, 0x78, 0x7d, 0xc0, 0x13, 0x7d, 0x5c, 0x44,
-	0x38, 0x96, 0x83, 0xd9, 0x4a, 0xa7, 0xfb, 0x3b, 0xf1, 0x31, 0x4d, 0x04, 0x99, 0xbe, 0x9c, 0xcd,
-	0x54, 0xd7, 0xc3, 0x8c, 0xa9, 0x68, 0xd5, 0x44, 0xf7, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3a,
-	0x73, 0x87, 0x23, 0x97, 0x04, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x93, 0x4d, 0x28, 0x2f, 0x7f, 0x9a, 0x0e, 0x55, 0xeb, 0xac, 0xca, 0x36, 0x58, 0xa1,
+	0x84, 0xfe, 0xb1, 0x49, 0x5b, 0xa8, 0x84, 0x54, 0x89, 0x04, 0x44, 0x29, 0x12, 0x28, 0x38, 0x42,
+	0x48, 0x5c, 0x56, 0xb3, 0xf6, 0xe0, 0x8c, 0x98, 0x9d, 0xf1, 0x7a, 0xc6, 0x6d, 0x43, 0xd5, 0x0b,
+	0x9f, 0x00, 0x89, 0x23, 0x97, 0x9e, 0x10, 0x07, 0x0e, 0x20, 0xf8, 0x10, 0x3d, 0x56, 0x70, 0x00,
+	0x71, 0xa8, 0x50, 0xc2, 0x07, 0x41, 0x9e, 0x99, 0xf5, 0x7a, 0x93, 0xb5, 0xb2, 0x45, 0x8b, 0x72,
+	0xb3, 0x67, 0xde, 0xfb, 0xfd, 0x79, 0xf3, 0xc6, 0x7e, 0xb0, 0x16, 0xef, 0xc5, 0x0f, 0xd2, 0x4c,
+	0x28, 0x11, 0x09, 0x16, 0xc8, 0xbc, 0x83, 0xa3, 0x48, 0xe4, 0x5c, 0xc9, 0xa0, 0x97, 0x93, 0x6c,
+	0xcf, 0xd7, 0x5b, 0xc8, 0xad, 0x46, 0xf9, 0x95, 0xa8, 0xe6, 0x4a, 0x24, 0x64, 0x57, 0xc8, 0xb6,
+	0xde, 0x0c, 0xcc, 0x8b, 0x49, 0x6a, 0x9e, 0x4d, 0x44, 0x22, 0xcc, 0x7a, 0xf1, 0x64, 0x57, 0x2f,
+	0x24, 0x42, 0x24, 0x8c, 0x04, 0x38, 0xa5, 0x01, 0xe6, 0x5c, 0x28, 0xac, 0xa8, 0xe0, 0xfd, 0x9c,
+	0xcb, 0x06, 0x21, 0xe8, 0x60, 0x49, 0x8c, 0x82, 0xe0, 0xde, 0x46, 0x87, 0x28, 0xbc, 0x11, 0xa4,
+	0x38, 0xa1, 0x5c, 0x07, 0xdb, 0xd8, 0xd7, 0x6b, 0xa5, 0x0f, 0x9e, 0x4d, 0xa8, 0x17, 0xc1, 0xca,
+	0x27, 0x05, 0xd8, 0x1d, 0xa2, 0x76, 0xca, 0xbd, 0x90, 0xf4, 0x72, 0x22, 0x15, 0xf2, 0x61, 0x56,
+	0xdc, 0xe7, 0x24, 0x73, 0x9d, 0x55, 0x67, 0xfd, 0xc5, 0x2d, 0xf7, 0xb7, 0x5f, 0xaf, 0x9d, 0xb5,
+	0x46, 0x36, 0xe3, 0x38, 0x23, 0x52, 0xee, 0xa8, 0x8c, 0xf2, 0x24, 0x34, 0x61, 0xe8, 0x1c, 0xcc,
+	0xf1, 0xbc, 0xdb, 0x21, 0x99, 0x3b, 0xbd, 0xea, 0xac, 0x2f, 0x86, 0xf6, 0xcd, 0x23, 0x70, 0x5e,
+	0x93, 0x54, 0x19, 0x64, 0x2a, 0xb8, 0x24, 0xe8, 0x43, 0x80, 0x81, 0x26, 0xcd, 0x33, 0x7f, 0x7d,
+	0xcd, 0xaf, 0x2b, 0xaa, 0x3f, 0x40, 0xd8, 0x6a, 0x3c, 0x79, 0x76, 0x71, 0x2a, 0xac, 0x64, 0x97,
+	0x5e, 0x36, 0x19, 0x3b, 0xea, 0xe5, 0x7d, 0x80, 0x41, 0x9d, 0x2c, 0xd1, 0x25, 0xdf, 0xba, 0x29,
+	0x8a, 0xea, 0x9b, 0x63, 0xb5, 0x45, 0xf5, 0xb7, 0x71, 0x42, 0x6c, 0x6e, 0x58, 0xc9, 0xf4, 0x7e,
+	0x76, 0xa0, 0x79, 0xc8, 0xcc, 0x26, 0x63, 0xb5, 0x7e, 0x66, 0xfe, 0xbb, 0x1f, 0x74, 0x67, 0x48,
+	0xf2, 0xb4, 0x96, 0xfc, 0xda, 0xb1, 0x92, 0x8d, 0x90, 0x21, 0xcd, 0x8f, 0xa7, 0xe1, 0xc2, 0x21,
+	0xcd, 0x1f, 0xe1, 0x2c, 0xa1, 0x7c, 0xc2, 0x07, 0x8d, 0x3e, 0x85, 0x05, 0x2c, 0x25, 0x51, 0xed,
+	0x98, 0x30, 0x85, 0xa5, 0x3b, 0xa3, 0xfd, 0x5f, 0xad, 0xf7, 0xbf, 0x59, 0x44, 0x6f, 0x0b, 0x49,
+	0x0b, 0x9d, 0xef, 0x15, 0x49, 0xb6, 0x0e, 0xf3, 0x1a, 0x47, 0xaf, 0x48, 0x84, 0x61, 0x39, 0x25,
+	0x59, 0x4a, 0x54, 0x8e, 0x59, 0x1f, 0xba, 0xa1, 0xa1, 0xdf, 0xa8, 0x87, 0xde, 0xee, 0x67, 0x8c,
+	0x82, 0x3f, 0x5d, 0xe2, 0x19, 0x0a, 0xef, 0xb1, 0x03, 0xe8, 0xa8, 0x18, 0xb4, 0x02, 0xa7, 0x8c,
+	0x21, 0x1a, 0xeb, 0xda, 0x2c, 0x86, 0x2f, 0xe8, 0xf7, 0xbb, 0x31, 0x12, 0xb0, 0xd4, 0xcb, 0x31,
+	0x57, 0x79, 0x57, 0x1a, 0x4d, 0xba, 0x16, 0x0b, 0x5b, 0x1f, 0x14, 0x04, 0x7f, 0x3d, 0xbb, 0xf8,
+	0x4e, 0x42, 0xd5, 0x6e, 0xde, 0xf1, 0x23, 0xd1, 0x0d, 0x86, 0xee, 0xe3, 0xbd, 0x9b, 0xd7, 0xa2,
+	0x5d, 0x4c, 0x79, 0x50, 0xae, 0xc4, 0x6a, 0x2f, 0x25, 0xd2, 0xdf, 0x21, 0x19, 0xc5, 0x8c, 0x7e,
+	0x85, 0x3b, 0x8c, 0xdc, 0xe5, 0x2a, 0x5c, 0xec, 0xe3, 0x6b, 0x2d, 0xde, 0x8f, 0x0e, 0x9c, 0x1b,
+	0x6d, 0x0a, 0xbd, 0x02, 0x0b, 0x83, 0x02, 0x95, 0x52, 0xe7, 0xcb, 0xb5, 0x93, 0x90, 0xfb, 0x47,
+	0x03, 0x5e, 0xae, 0x69, 0x3a, 0x7b, 0x57, 0x04, 0x2c, 0x71, 0xa2, 0xda, 0x91, 0x60, 0x0c, 0x2b,
+	0x92, 0x61, 0xe6, 0x3a, 0x93, 0x96, 0xc4, 0x89, 0x7a, 0xb7, 0x84, 0x2f, 0x08, 0x29, 0xa7, 0x8a,
+	0x62, 0xd6, 0xee, 0x6a, 0x29, 0x93, 0xaf, 0x81, 0xc5, 0x37, 0x4e, 0xd1, 0x7d, 0x40, 0x5d, 0x4c,
+	0xb9, 0x22, 0x1c, 0xf3, 0x88, 0xf4, 0x49, 0x67, 0x26, 0x4c, 0x7a, 0xa6, 0xc2, 0x61, 0x89, 0x7b,
+	0x70, 0xfa, 0x8b, 0x8c, 0x90, 0x6a, 0x6d, 0x1b, 0x13, 0x66, 0x5d, 0x2a, 0x08, 0x2a, 0xc5, 0xdd,
+	0x85, 0x97, 0x06, 0x3d, 0x98, 0xda, 0xf6, 0x94, 0xee, 0xac, 0xbe, 0xa7, 0x1b, 0xcf, 0x71, 0x4f,
+	0x8d, 0x05, 0x7b, 0x51, 0x51, 0x7a, 0x78, 0x5b, 0x7a, 0xdf, 0x37, 0xe0, 0x7c, 0x4d, 0xd6, 0x38,
+	0x37, 0x21, 0x86, 0x53, 0xfd, 0x4e, 0x9d, 0xf8, 0xf9, 0x97, 0xc8, 0xe8, 0x4b, 0x58, 0x28, 0x9a,
+	0x9b, 0x8b, 0x42, 0x1c, 0x66, 0x13, 0x3f, 0xf4, 0x79, 0x4e, 0xd4, 0xc7, 0x16, 0x7c, 0x44, 0x63,
+	0x37, 0x4e, 0xa2, 0xb1, 0x67, 0xff, 0xff, 0xc6, 0xbe, 0x02, 0x67, 0x18, 0xed, 0xe5, 0x34, 0xd6,
+	0x7f, 0xb6, 0x76, 0x9a, 0xd1, 0x88, 0xb8, 0x73, 0xab, 0xce, 0x7a, 0x23, 0x5c, 0xae, 0x6c, 0x6c,
+	0x17, 0xeb, 0xd7, 0xbf, 0x6b, 0xc0, 0xac, 0xfe, 0x04, 0xa1, 0x5f, 0x1c, 0x80, 0xc1, 0x77, 0x08,
+	0xdd, 0xa8, 0x6f, 0xc7, 0xda, 0x69, 0xa8, 0xb9, 0x71, 0x4c, 0xd2, 0xd1, 0xe9, 0xc6, 0xbb, 0xfd,
+	0xf5, 0xef, 0xff, 0x7c, 0x3b, 0x7d, 0x0b, 0xbd, 0x19, 0x8c, 0x31, 0x91, 0x05, 0x0f, 0xf5, 0xcf,
+	0xf5, 0x51, 0xf0, 0xd0, 0xfc, 0x4d, 0x1f, 0xa1, 0x1f, 0x1c, 0x58, 0x1c, 0x1a, 0x33, 0x8e, 0x15,
+	0x3e, 0x6a, 0xf4, 0x69, 0xde, 0x1c, 0x5b, 0x78, 0x65, 0x92, 0xf1, 0xae, 0x6a, 0xed, 0x97, 0xd0,
+	0xda, 0x38, 0xda, 0xd1, 0x4f, 0x0e, 0x2c, 0x1f, 0xfe, 0xd0, 0xa3, 0xb7, 0xc6, 0x26, 0x1e, 0x1a,
+	0x47, 0x9a, 0xb7, 0x9e, 0x3b, 0xcf, 0x6a, 0xbe, 0xa2, 0x35, 0xbf, 0xea, 0xad, 0xd6, 0x6b, 0x36,
+	0xad, 0xfa, 0xb6, 0x73, 0x79, 0xeb, 0xb3, 0x27, 0xfb, 0x2d, 0xe7, 0xe9, 0x7e, 0xcb, 0xf9, 0x7b,
+	0xbf, 0xe5, 0x7c, 0x73, 0xd0, 0x9a, 0x7a, 0x7a, 0xd0, 0x9a, 0xfa, 0xf3, 0xa0, 0x35, 0xf5, 0xf9,
+	0xed, 0xf1, 0x3b, 0xf7, 0xc1, 0x10, 0xb8, 0x6e, 0xe3, 0xce, 0x9c, 0xde, 0xbd, 0xf1, 0xef, 0x00,
+	0xce, 0x58, 0x4c, 0x01, 0x42, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -289,6 +614,10 @@ type QueryClient interface {
 	Subaccount(ctx context.Context, in *QueryGetSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountResponse, error)
 	// Queries a list of Subaccount items.
 	SubaccountAll(ctx context.Context, in *QueryAllSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountAllResponse, error)
+	// Queries the net collateral and margin requirements of a Subaccount and
+	// each of its perpetual positions, optionally after applying hypothetical
+	// position changes.
+	SubaccountMargin(ctx context.Context, in *QuerySubaccountMarginRequest, opts ...grpc.CallOption) (*QuerySubaccountMarginResponse, error)
 }
 
 type queryClient struct {
@@ -317,12 +646,25 @@ func (c *queryClient) SubaccountAll(ctx context.Context, in *QueryAllSubaccountR
 	return out, nil
 }
 
+func (c *queryClient) SubaccountMargin(ctx context.Context, in *QuerySubaccountMarginRequest, opts ...grpc.CallOption) (*QuerySubaccountMarginResponse, error) {
+	out := new(QuerySubaccountMarginResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/SubaccountMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a Subaccount by id
 	Subaccount(context.Context, *QueryGetSubaccountRequest) (*QuerySubaccountResponse, error)
 	// Queries a list of Subaccount items.
 	SubaccountAll(context.Context, *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error)
+	// Queries the net collateral and margin requirements of a Subaccount and
+	// each of its perpetual positions, optionally after applying hypothetical
+	// position changes.
+	SubaccountMargin(context.Context, *QuerySubaccountMarginRequest) (*QuerySubaccountMarginResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -335,6 +677,9 @@ func (*UnimplementedQueryServer) Subaccount(ctx context.Context, req *QueryGetSu
 func (*UnimplementedQueryServer) SubaccountAll(ctx context.Context, req *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubaccountAll not implemented")
 }
+func (*UnimplementedQueryServer) SubaccountMargin(ctx context.Context, req *QuerySubaccountMarginRequest) (*QuerySubaccountMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountMargin not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -376,6 +721,24 @@ func _Query_SubaccountAll_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SubaccountMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountMarginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/SubaccountMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountMargin(ctx, req.(*QuerySubaccountMarginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.subaccounts.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -388,6 +751,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubaccountAll",
 			Handler:    _Query_SubaccountAll_Handler,
 		},
+		{
+			MethodName: "SubaccountMargin",
+			Handler:    _Query_SubaccountMargin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/subaccounts/query.proto",
@@ -545,49 +912,338 @@ func (m *QuerySubaccountAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySubaccountMarginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetSubaccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySubaccountMarginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountMarginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PerpetualDeltas) > 0 {
+		for iNdEx := len(m.PerpetualDeltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerpetualDeltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AssetDeltas) > 0 {
+		for iNdEx := len(m.AssetDeltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetDeltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.Number != 0 {
-		n += 1 + sovQuery(uint64(m.Number))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySubaccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssetPositionDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Subaccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllSubaccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *AssetPositionDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPositionDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuantumsDelta.Size()
+		i -= size
+		if _, err := m.QuantumsDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerpetualPositionDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualPositionDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualPositionDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuantumsDelta.Size()
+		i -= size
+		if _, err := m.QuantumsDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerpetualPositions) > 0 {
+		for iNdEx := len(m.PerpetualPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerpetualPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.FreeCollateral.Size()
+		i -= size
+		if _, err := m.FreeCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaintenanceMargin.Size()
+		i -= size
+		if _, err := m.MaintenanceMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialMargin.Size()
+		i -= size
+		if _, err := m.InitialMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PerpetualPositionMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualPositionMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualPositionMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidationPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LiquidationPrice))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaintenanceMargin.Size()
+		i -= size
+		if _, err := m.MaintenanceMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InitialMargin.Size()
+		i -= size
+		if _, err := m.InitialMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetNotional.Size()
+		i -= size
+		if _, err := m.NetNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quantums.Size()
+		i -= size
+		if _, err := m.Quantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetSubaccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QuerySubaccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subaccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSubaccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
@@ -608,20 +1264,512 @@ func (m *QuerySubaccountAllResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubaccountMarginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if len(m.AssetDeltas) > 0 {
+		for _, e := range m.AssetDeltas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PerpetualDeltas) > 0 {
+		for _, e := range m.PerpetualDeltas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetPositionDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	l = m.QuantumsDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PerpetualPositionDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = m.QuantumsDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubaccountMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FreeCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PerpetualPositions) > 0 {
+		for _, e := range m.PerpetualPositions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PerpetualPositionMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = m.Quantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LiquidationPrice != 0 {
+		n += 1 + sovQuery(uint64(m.LiquidationPrice))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSubaccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSubaccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subaccount = append(m.Subaccount, Subaccount{})
+			if err := m.Subaccount[len(m.Subaccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountMarginRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -644,10 +1792,10 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSubaccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountMarginRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountMarginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -701,6 +1849,176 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDeltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDeltas = append(m.AssetDeltas, AssetPositionDelta{})
+			if err := m.AssetDeltas[len(m.AssetDeltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualDeltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerpetualDeltas = append(m.PerpetualDeltas, PerpetualPositionDelta{})
+			if err := m.PerpetualDeltas[len(m.PerpetualDeltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetPositionDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetPositionDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetPositionDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumsDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuantumsDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -722,7 +2040,7 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
+func (m *PerpetualPositionDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -745,17 +2063,36 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubaccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerpetualPositionDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubaccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerpetualPositionDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumsDelta", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -765,22 +2102,22 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuantumsDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -805,7 +2142,7 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountMarginResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -828,15 +2165,147 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSubaccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountMarginResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreeCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualPositions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -863,10 +2332,8 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PerpetualPositions = append(m.PerpetualPositions, PerpetualPositionMargin{})
+			if err := m.PerpetualPositions[len(m.PerpetualPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -891,7 +2358,7 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
+func (m *PerpetualPositionMargin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -914,17 +2381,36 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubaccountAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerpetualPositionMargin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubaccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerpetualPositionMargin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -934,31 +2420,30 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subaccount = append(m.Subaccount, Subaccount{})
-			if err := m.Subaccount[len(m.Subaccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetNotional", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -968,28 +2453,110 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.NetNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+			}
+			m.LiquidationPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
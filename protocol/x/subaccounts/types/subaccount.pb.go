@@ -93,6 +93,9 @@ type Subaccount struct {
 	// Set by the owner. If true, then margin trades can be made in this
 	// subaccount.
 	MarginEnabled bool `protobuf:"varint,4,opt,name=margin_enabled,json=marginEnabled,proto3" json:"margin_enabled,omitempty"`
+	// Set by the owner. If set, then the subaccount is isolated to a single
+	// perpetual and cannot hold positions in any other perpetual.
+	IsolatedMargin *IsolatedMargin `protobuf:"bytes,5,opt,name=isolated_margin,json=isolatedMargin,proto3" json:"isolated_margin,omitempty"`
 }
 
 func (m *Subaccount) Reset()         { *m = Subaccount{} }
@@ -156,9 +159,64 @@ func (m *Subaccount) GetMarginEnabled() bool {
 	return false
 }
 
+func (m *Subaccount) GetIsolatedMargin() *IsolatedMargin {
+	if m != nil {
+		return m.IsolatedMargin
+	}
+	return nil
+}
+
+// IsolatedMargin defines the perpetual that an isolated-margin subaccount is
+// restricted to.
+type IsolatedMargin struct {
+	// The Id of the `Perpetual` the subaccount is isolated to.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *IsolatedMargin) Reset()         { *m = IsolatedMargin{} }
+func (m *IsolatedMargin) String() string { return proto.CompactTextString(m) }
+func (*IsolatedMargin) ProtoMessage()    {}
+func (*IsolatedMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a7b1af2704a634c, []int{2}
+}
+func (m *IsolatedMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedMargin.Merge(m, src)
+}
+func (m *IsolatedMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedMargin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedMargin proto.InternalMessageInfo
+
+func (m *IsolatedMargin) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SubaccountId)(nil), "dydxprotocol.subaccounts.SubaccountId")
 	proto.RegisterType((*Subaccount)(nil), "dydxprotocol.subaccounts.Subaccount")
+	proto.RegisterType((*IsolatedMargin)(nil), "dydxprotocol.subaccounts.IsolatedMargin")
 }
 
 func init() {
@@ -166,30 +224,33 @@ func init() {
 }
 
 var fileDescriptor_5a7b1af2704a634c = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0x9b, 0x54, 0x8b, 0x6e, 0x6d, 0x85, 0x55, 0x24, 0xf6, 0x10, 0x42, 0x41, 0x8d, 0x48,
-	0x13, 0xac, 0xe2, 0xcd, 0x43, 0x0b, 0x1e, 0xbc, 0x95, 0x14, 0x14, 0x44, 0x28, 0x49, 0x76, 0x69,
-	0x17, 0x9a, 0xdd, 0x90, 0xd9, 0x68, 0xfb, 0x16, 0xde, 0x7d, 0x0d, 0x1f, 0xc2, 0x63, 0xf1, 0xe4,
-	0x51, 0xda, 0x17, 0x11, 0x93, 0x58, 0xd3, 0x4a, 0x6e, 0x3b, 0xff, 0xfc, 0xff, 0xc7, 0xec, 0x0c,
-	0x3a, 0x25, 0x53, 0x32, 0x09, 0x23, 0x21, 0x85, 0x2f, 0xc6, 0x36, 0xc4, 0x9e, 0xeb, 0xfb, 0x22,
-	0xe6, 0x12, 0x72, 0x6f, 0x2b, 0xe9, 0x63, 0x2d, 0x6f, 0xb5, 0x72, 0xd6, 0xc6, 0xa1, 0x2f, 0x20,
-	0x10, 0x30, 0x48, 0x9a, 0x76, 0x5a, 0xa4, 0xa1, 0x46, 0xab, 0x90, 0xef, 0x02, 0x50, 0x39, 0x08,
-	0x05, 0x30, 0xc9, 0x04, 0xcf, 0xec, 0xe7, 0x85, 0xf6, 0x90, 0x46, 0x21, 0x95, 0xb1, 0x3b, 0x5e,
-	0x8b, 0x34, 0xef, 0xd0, 0x4e, 0x7f, 0xe9, 0xbb, 0x25, 0xd8, 0x42, 0x9b, 0xe2, 0x99, 0xd3, 0x48,
-	0x53, 0x0c, 0xc5, 0xdc, 0xee, 0x6a, 0x1f, 0x6f, 0xad, 0xfd, 0x6c, 0xa4, 0x0e, 0x21, 0x11, 0x05,
-	0xe8, 0xcb, 0x88, 0xf1, 0xa1, 0x93, 0xda, 0xf0, 0x01, 0xaa, 0xf0, 0x38, 0xf0, 0x68, 0xa4, 0xa9,
-	0x86, 0x62, 0xd6, 0x9c, 0xac, 0x6a, 0xbe, 0xaa, 0x08, 0xfd, 0x81, 0xf1, 0x15, 0x52, 0x19, 0x49,
-	0x98, 0xd5, 0xf6, 0xb1, 0x55, 0xb4, 0x0a, 0x2b, 0x3f, 0x8a, 0xa3, 0x32, 0x82, 0x7b, 0x68, 0x77,
-	0xf5, 0xa7, 0xa0, 0xa9, 0x46, 0xd9, 0xac, 0xb6, 0x4f, 0x8a, 0x21, 0x9d, 0x9f, 0x40, 0x2f, 0xf3,
-	0x3b, 0x75, 0x37, 0x5f, 0x02, 0x7e, 0x44, 0x7b, 0xff, 0x97, 0x01, 0x5a, 0x39, 0xa1, 0x9e, 0x15,
-	0x53, 0x7b, 0xbf, 0xa1, 0x25, 0x19, 0x87, 0xeb, 0x12, 0xe0, 0x23, 0x54, 0x0f, 0xdc, 0x68, 0xc8,
-	0xf8, 0x80, 0x72, 0xd7, 0x1b, 0x53, 0xa2, 0x6d, 0x18, 0x8a, 0xb9, 0xe5, 0xd4, 0x52, 0xf5, 0x26,
-	0x15, 0xbb, 0xf7, 0xef, 0x73, 0x5d, 0x99, 0xcd, 0x75, 0xe5, 0x6b, 0xae, 0x2b, 0x2f, 0x0b, 0xbd,
-	0x34, 0x5b, 0xe8, 0xa5, 0xcf, 0x85, 0x5e, 0x7a, 0xb8, 0x1e, 0x32, 0x39, 0x8a, 0x3d, 0xcb, 0x17,
-	0x81, 0xbd, 0x72, 0xcd, 0xa7, 0xcb, 0x96, 0x3f, 0x72, 0x19, 0xb7, 0x97, 0xca, 0x64, 0xe5, 0xc2,
-	0x72, 0x1a, 0x52, 0xf0, 0x2a, 0x49, 0xf7, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x62, 0x2c, 0xfc,
-	0xe7, 0x99, 0x02, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0xca, 0xd3, 0x40,
+	0x14, 0x85, 0x9b, 0xfc, 0xb6, 0xe8, 0xb4, 0x4d, 0x61, 0x14, 0x19, 0xbb, 0x08, 0xb1, 0xa0, 0x46,
+	0xa4, 0x09, 0xb6, 0xe2, 0xce, 0x45, 0x0b, 0x2e, 0xba, 0x10, 0x6a, 0x0a, 0x0a, 0x22, 0x84, 0x49,
+	0x66, 0x68, 0x07, 0x92, 0x99, 0x90, 0x99, 0x68, 0xfb, 0x16, 0x3e, 0x8c, 0x0f, 0xe1, 0xb2, 0xb8,
+	0x72, 0x29, 0xed, 0xde, 0x67, 0x10, 0x93, 0x98, 0x26, 0xfd, 0xc9, 0x6e, 0xee, 0xb9, 0xdf, 0x39,
+	0xdc, 0xb9, 0x5c, 0xf0, 0x9c, 0x1c, 0xc8, 0x3e, 0x49, 0x85, 0x12, 0xa1, 0x88, 0x5c, 0x99, 0x05,
+	0x38, 0x0c, 0x45, 0xc6, 0x95, 0xac, 0xbd, 0x9d, 0xbc, 0x0f, 0x51, 0x1d, 0x75, 0x6a, 0xe8, 0xf8,
+	0x51, 0x28, 0x64, 0x2c, 0xa4, 0x9f, 0x37, 0xdd, 0xa2, 0x28, 0x4c, 0xe3, 0x69, 0x6b, 0x3e, 0x96,
+	0x92, 0x2a, 0x3f, 0x11, 0x92, 0x29, 0x26, 0x78, 0x89, 0xbf, 0x6c, 0xc5, 0x13, 0x9a, 0x26, 0x54,
+	0x65, 0x38, 0xba, 0xb2, 0x4c, 0x3e, 0x80, 0xc1, 0xa6, 0xe2, 0x56, 0x04, 0x3a, 0xa0, 0x2b, 0xbe,
+	0x72, 0x9a, 0x22, 0xcd, 0xd2, 0xec, 0x7b, 0x4b, 0xf4, 0xf3, 0xfb, 0xf4, 0x41, 0x39, 0xd2, 0x82,
+	0x90, 0x94, 0x4a, 0xb9, 0x51, 0x29, 0xe3, 0x5b, 0xaf, 0xc0, 0xe0, 0x43, 0xd0, 0xe3, 0x59, 0x1c,
+	0xd0, 0x14, 0xe9, 0x96, 0x66, 0x0f, 0xbd, 0xb2, 0x9a, 0xfc, 0xd1, 0x01, 0xb8, 0x04, 0xc3, 0xd7,
+	0x40, 0x67, 0x24, 0xcf, 0xec, 0xcf, 0x9e, 0x3a, 0x6d, 0xab, 0x70, 0xea, 0xa3, 0x78, 0x3a, 0x23,
+	0x70, 0x0d, 0x46, 0xcd, 0x9f, 0x4a, 0xa4, 0x5b, 0x37, 0x76, 0x7f, 0xf6, 0xac, 0x3d, 0x64, 0xf1,
+	0xcf, 0xb0, 0x2e, 0x79, 0xcf, 0xc0, 0xf5, 0x52, 0xc2, 0xcf, 0xe0, 0xfe, 0xed, 0x65, 0x48, 0x74,
+	0x93, 0xa7, 0xbe, 0x68, 0x4f, 0x5d, 0xff, 0x37, 0x55, 0xc9, 0x30, 0xb9, 0x96, 0x24, 0x7c, 0x02,
+	0x8c, 0x18, 0xa7, 0x5b, 0xc6, 0x7d, 0xca, 0x71, 0x10, 0x51, 0x82, 0xee, 0x58, 0x9a, 0x7d, 0xd7,
+	0x1b, 0x16, 0xea, 0xdb, 0x42, 0x84, 0xef, 0xc1, 0x88, 0x49, 0x11, 0x61, 0x45, 0x89, 0x5f, 0x74,
+	0x50, 0x37, 0xdf, 0x8d, 0xdd, 0x3e, 0xc0, 0xaa, 0x34, 0xbc, 0xcb, 0x79, 0xcf, 0x60, 0x8d, 0x7a,
+	0x32, 0x07, 0x46, 0x93, 0x80, 0x8f, 0xc1, 0xe0, 0xf2, 0xd3, 0x72, 0xfb, 0x43, 0xaf, 0x5f, 0x69,
+	0x2b, 0xb2, 0xfc, 0xf8, 0xe3, 0x64, 0x6a, 0xc7, 0x93, 0xa9, 0xfd, 0x3e, 0x99, 0xda, 0xb7, 0xb3,
+	0xd9, 0x39, 0x9e, 0xcd, 0xce, 0xaf, 0xb3, 0xd9, 0xf9, 0xf4, 0x66, 0xcb, 0xd4, 0x2e, 0x0b, 0x9c,
+	0x50, 0xc4, 0x6e, 0xe3, 0xaa, 0xbe, 0xbc, 0x9a, 0x86, 0x3b, 0xcc, 0xb8, 0x5b, 0x29, 0xfb, 0xc6,
+	0xa5, 0xa9, 0x43, 0x42, 0x65, 0xd0, 0xcb, 0xbb, 0xf3, 0xbf, 0x03, 0x00, 0x2b, 0x88, 0xc3, 0x87,
+	0x21, 0x03, 0x00, 0x00,
 }
 
 func (m *SubaccountId) Marshal() (dAtA []byte, err error) {
@@ -247,6 +308,18 @@ func (m *Subaccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsolatedMargin != nil {
+		{
+			size, err := m.IsolatedMargin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubaccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.MarginEnabled {
 		i--
 		if m.MarginEnabled {
@@ -300,6 +373,34 @@ func (m *Subaccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IsolatedMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IsolatedMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsolatedMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintSubaccount(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSubaccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSubaccount(v)
 	base := offset
@@ -352,6 +453,22 @@ func (m *Subaccount) Size() (n int) {
 	if m.MarginEnabled {
 		n += 2
 	}
+	if m.IsolatedMargin != nil {
+		l = m.IsolatedMargin.Size()
+		n += 1 + l + sovSubaccount(uint64(l))
+	}
+	return n
+}
+
+func (m *IsolatedMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovSubaccount(uint64(m.PerpetualId))
+	}
 	return n
 }
 
@@ -615,6 +732,111 @@ func (m *Subaccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.MarginEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedMargin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IsolatedMargin == nil {
+				m.IsolatedMargin = &IsolatedMargin{}
+			}
+			if err := m.IsolatedMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubaccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubaccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsolatedMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubaccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsolatedMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsolatedMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubaccount(dAtA[iNdEx:])
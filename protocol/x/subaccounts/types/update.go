@@ -54,6 +54,8 @@ var updateResultStringMap = map[UpdateResult]string{
 	1: "NewlyUndercollateralized",
 	2: "StillUndercollateralized",
 	3: "UpdateCausedError",
+	4: "InsufficientAssetBalance",
+	5: "ViolatesIsolatedMargin",
 }
 
 const (
@@ -61,6 +63,8 @@ const (
 	NewlyUndercollateralized
 	StillUndercollateralized
 	UpdateCausedError
+	InsufficientAssetBalance
+	ViolatesIsolatedMargin
 )
 
 // Update is used by the subaccounts keeper to allow other modules
@@ -88,8 +88,12 @@ func TestUpdateResultString(t *testing.T) {
 			value:          types.UpdateCausedError,
 			expectedResult: "UpdateCausedError",
 		},
+		"ViolatesIsolatedMargin": {
+			value:          types.ViolatesIsolatedMargin,
+			expectedResult: "ViolatesIsolatedMargin",
+		},
 		"UnexpectedError": {
-			value:          types.UpdateResult(5),
+			value:          types.UpdateResult(6),
 			expectedResult: "UnexpectedError",
 		},
 	}
@@ -125,7 +129,7 @@ func TestUpdateResultIsSuccess(t *testing.T) {
 			expectedResult: false,
 		},
 		"UnexpectedError": {
-			value:          types.UpdateResult(5),
+			value:          types.UpdateResult(6),
 			expectedResult: false,
 		},
 	}